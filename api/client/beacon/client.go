@@ -32,9 +32,15 @@ const (
 	getWeakSubjectivityPath  = "/prysm/v1/beacon/weak_subjectivity"
 	getForkSchedulePath      = "/eth/v1/config/fork_schedule"
 	getConfigSpecPath        = "/eth/v1/config/spec"
+	getEffectiveConfigPath   = "/prysm/v1/node/config"
 	getStatePath             = "/eth/v2/debug/beacon/states"
 	getNodeVersionPath       = "/eth/v1/node/version"
+	getIdentityPath          = "/eth/v1/node/identity"
+	refreshENRPath           = "/prysm/v1/node/enr/refresh"
 	changeBLStoExecutionPath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	getDepositSnapshotPath   = "/eth/v1/beacon/deposit_snapshot"
+	getCommitteesPath        = "/eth/v1/beacon/states/{{.Id}}/committees"
+	getAttesterDutiesPath    = "/eth/v1/validator/duties/attester"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -189,6 +195,60 @@ func (c *Client) GetConfigSpec(ctx context.Context) (*structs.GetSpecResponse, e
 	return fsr, nil
 }
 
+// GetIdentity retrieves the network identity of the beacon node: its peer id, ENR and p2p and discovery addresses.
+func (c *Client) GetIdentity(ctx context.Context) (*structs.GetIdentityResponse, error) {
+	body, err := c.Get(ctx, getIdentityPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting node identity")
+	}
+	ir := &structs.GetIdentityResponse{}
+	if err := json.Unmarshal(body, ir); err != nil {
+		return nil, errors.Wrapf(err, "problem unmarshaling %s response", getIdentityPath)
+	}
+	return ir, nil
+}
+
+// RefreshENR asks the beacon node to re-evaluate its external address and re-sign its ENR with a bumped sequence number.
+// The endpoint requires the admin token of the beacon node, see client.WithAuthenticationToken.
+func (c *Client) RefreshENR(ctx context.Context) (*structs.RefreshENRResponse, error) {
+	u := c.BaseURL().ResolveReference(&url.URL{Path: refreshENRPath})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid format, failed to create new POST request object")
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token()))
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, client.Non200Err(resp)
+	}
+	rr := &structs.RefreshENRResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(rr); err != nil {
+		return nil, errors.Wrapf(err, "problem unmarshaling %s response", refreshENRPath)
+	}
+	return rr, nil
+}
+
+// GetEffectiveConfig retrieves the complete configuration the beacon node is running with,
+// including Prysm specific values, feature flags and sanitized command line flags.
+func (c *Client) GetEffectiveConfig(ctx context.Context) (*structs.GetEffectiveConfigResponse, error) {
+	body, err := c.Get(ctx, getEffectiveConfigPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting effective config")
+	}
+	ecr := &structs.GetEffectiveConfigResponse{}
+	if err := json.Unmarshal(body, ecr); err != nil {
+		return nil, errors.Wrapf(err, "problem unmarshaling %s response", getEffectiveConfigPath)
+	}
+	return ecr, nil
+}
+
 type NodeVersion struct {
 	implementation string
 	semver         string
@@ -280,6 +340,22 @@ func (c *Client) GetWeakSubjectivity(ctx context.Context) (*WeakSubjectivityData
 	}, nil
 }
 
+// GetDepositSnapshot retrieves the EIP-4881 snapshot of the finalized deposit tree of the beacon node.
+func (c *Client) GetDepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	body, err := c.Get(ctx, getDepositSnapshotPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting deposit snapshot")
+	}
+	resp := &structs.GetDepositSnapshotResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling response body: %s", string(body))
+	}
+	if resp.Data == nil {
+		return nil, errors.New("deposit snapshot response has no data")
+	}
+	return resp.Data.ToConsensus()
+}
+
 // SubmitChangeBLStoExecution calls a beacon API endpoint to set the withdrawal addresses based on the given signed messages.
 // If the API responds with something other than OK there will be failure messages associated to the corresponding request message.
 func (c *Client) SubmitChangeBLStoExecution(ctx context.Context, request []*structs.SignedBLSToExecutionChange) error {
@@ -334,6 +410,56 @@ func (c *Client) GetBLStoExecutionChanges(ctx context.Context) (*structs.BLSToEx
 	return poolResponse, nil
 }
 
+var getCommitteesTpl = idTemplate(getCommitteesPath)
+
+// GetCommittees retrieves the committees of the given slot, computed from the state with the given state id.
+func (c *Client) GetCommittees(ctx context.Context, stateId StateOrBlockId, slot primitives.Slot) ([]*structs.Committee, error) {
+	body, err := c.Get(ctx, getCommitteesTpl(stateId), func(req *http.Request) {
+		req.URL.RawQuery = url.Values{"slot": {strconv.FormatUint(uint64(slot), 10)}}.Encode()
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting committees of slot %d", slot)
+	}
+	cr := &structs.GetCommitteesResponse{}
+	if err := json.Unmarshal(body, cr); err != nil {
+		return nil, errors.Wrapf(err, "problem unmarshaling %s response", getCommitteesPath)
+	}
+	return cr.Data, nil
+}
+
+// GetAttesterDuties retrieves the attester duties of the given validators for the given epoch.
+func (c *Client) GetAttesterDuties(ctx context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex) (*structs.GetAttesterDutiesResponse, error) {
+	u := c.BaseURL().ResolveReference(&url.URL{Path: path.Join(getAttesterDutiesPath, strconv.FormatUint(uint64(epoch), 10))})
+	request := make([]string, len(indices))
+	for i, idx := range indices {
+		request[i] = strconv.FormatUint(uint64(idx), 10)
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid format, failed to create new POST request object")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, client.Non200Err(resp)
+	}
+	dr := &structs.GetAttesterDutiesResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(dr); err != nil {
+		return nil, errors.Wrapf(err, "problem unmarshaling %s response", getAttesterDutiesPath)
+	}
+	return dr, nil
+}
+
 type forkScheduleResponse struct {
 	Data []structs.Fork
 }
@@ -0,0 +1,201 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"math/bits"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
+)
+
+const (
+	getValidatorProofPath = "/prysm/v1/beacon/states"
+
+	// headerStateRootGeneralizedIndex is the generalized index of the state root in a BeaconBlockHeader.
+	headerStateRootGeneralizedIndex = 11
+	// validatorsFieldPosition is the position of the validator registry in the BeaconState container of every fork.
+	validatorsFieldPosition = 11
+	validatorRegistryDepth  = 40
+	validatorContainerDepth = 3
+	// Positions of the withdrawal credentials and effective balance in the Validator container.
+	withdrawalCredentialsPosition = 1
+	effectiveBalancePosition      = 2
+)
+
+// ErrInvalidValidatorProof is returned when a validator proof does not verify.
+var ErrInvalidValidatorProof = errors.New("invalid validator proof")
+
+// GetValidatorProof calls a Prysm specific API endpoint that returns a Merkle multiproof of the withdrawal credentials
+// of the validator with the given index, and of its effective balance if requested, against the root of the
+// "finalized" or "justified" state. The proof is not verified, use VerifyValidatorProof for that purpose.
+func (c *Client) GetValidatorProof(
+	ctx context.Context,
+	stateId StateOrBlockId,
+	index primitives.ValidatorIndex,
+	withEffectiveBalance bool,
+) (*structs.ValidatorProof, error) {
+	p := path.Join(getValidatorProofPath, string(stateId), "validator_proofs", strconv.FormatUint(uint64(index), 10))
+	body, err := c.Get(ctx, p, func(req *http.Request) {
+		if withEffectiveBalance {
+			req.URL.RawQuery = "effective_balance=true"
+		}
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting proof of validator %d in state %s", index, stateId)
+	}
+	resp := &structs.GetValidatorProofResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling response body: %s", string(body))
+	}
+	if resp.Data == nil {
+		return nil, errors.New("validator proof response has no data")
+	}
+	return resp.Data, nil
+}
+
+// VerifyValidatorProof checks that the withdrawal credentials of a validator proof, and its effective balance when
+// present, are the fields of the validator with the proof's index in the state with the proof's state root, and that
+// this state root is the state root of the block header whose hash tree root is the proof's block root.
+func VerifyValidatorProof(p *structs.ValidatorProof) error {
+	if p == nil || p.Header == nil {
+		return errors.Wrap(ErrInvalidValidatorProof, "missing block header")
+	}
+	header, err := p.Header.ToConsensus()
+	if err != nil {
+		return errors.Wrap(err, "could not decode block header")
+	}
+	blockRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute block root")
+	}
+	if hexutil.Encode(blockRoot[:]) != p.BlockRoot {
+		return errors.Wrapf(ErrInvalidValidatorProof, "block root %s is not the root of the block header", p.BlockRoot)
+	}
+	stateRoot, err := hexutil.Decode(p.StateRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not decode state root")
+	}
+	if !bytes.Equal(header.StateRoot, stateRoot) {
+		return errors.Wrapf(ErrInvalidValidatorProof, "state root %s is not the state root of the block header", p.StateRoot)
+	}
+	stateRootProof, err := decodeRoots(p.StateRootProof)
+	if err != nil {
+		return errors.Wrap(err, "could not decode state root proof")
+	}
+	branch := make([][]byte, len(stateRootProof))
+	for i := range stateRootProof {
+		branch[i] = stateRootProof[i][:]
+	}
+	if !trie.VerifyMerkleProof(blockRoot[:], stateRoot, headerStateRootGeneralizedIndex, branch) {
+		return errors.Wrap(ErrInvalidValidatorProof, "state root proof does not verify against the block root")
+	}
+
+	validatorIndex, err := strconv.ParseUint(p.ValidatorIndex, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "could not decode validator index")
+	}
+	indices := make([]uint64, len(p.GeneralizedIndices))
+	for i, gi := range p.GeneralizedIndices {
+		if indices[i], err = strconv.ParseUint(gi, 10, 64); err != nil {
+			return errors.Wrap(err, "could not decode generalized index")
+		}
+	}
+	leaves, err := decodeRoots(p.Leaves)
+	if err != nil {
+		return errors.Wrap(err, "could not decode leaves")
+	}
+	proof, err := decodeRoots(p.Proof)
+	if err != nil {
+		return errors.Wrap(err, "could not decode proof")
+	}
+	if len(indices) == 0 || len(indices) != len(leaves) {
+		return errors.Wrapf(ErrInvalidValidatorProof, "got %d leaves for %d generalized indices", len(leaves), len(indices))
+	}
+
+	// The first leaf is the withdrawal credentials field of the validator.
+	validatorGIndex := indices[0] >> validatorContainerDepth
+	if err := checkValidatorGeneralizedIndex(validatorGIndex, validatorIndex); err != nil {
+		return err
+	}
+	if indices[0] != ssz.ConcatGeneralizedIndices(validatorGIndex, 1<<validatorContainerDepth+withdrawalCredentialsPosition) {
+		return errors.Wrap(ErrInvalidValidatorProof, "first leaf is not the withdrawal credentials of the validator")
+	}
+	withdrawalCredentials, err := hexutil.Decode(p.WithdrawalCredentials)
+	if err != nil {
+		return errors.Wrap(err, "could not decode withdrawal credentials")
+	}
+	if !bytes.Equal(leaves[0][:], withdrawalCredentials) {
+		return errors.Wrap(ErrInvalidValidatorProof, "withdrawal credentials do not match the proven leaf")
+	}
+	wantLeaves := 1
+	if p.EffectiveBalance != "" {
+		wantLeaves = 2
+		effectiveBalance, err := strconv.ParseUint(p.EffectiveBalance, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "could not decode effective balance")
+		}
+		if len(indices) < 2 || indices[1] != ssz.ConcatGeneralizedIndices(validatorGIndex, 1<<validatorContainerDepth+effectiveBalancePosition) {
+			return errors.Wrap(ErrInvalidValidatorProof, "second leaf is not the effective balance of the validator")
+		}
+		var leaf [32]byte
+		binary.LittleEndian.PutUint64(leaf[:8], effectiveBalance)
+		if leaves[1] != leaf {
+			return errors.Wrap(ErrInvalidValidatorProof, "effective balance does not match the proven leaf")
+		}
+	}
+	if len(leaves) != wantLeaves {
+		return errors.Wrapf(ErrInvalidValidatorProof, "got %d leaves, expected %d", len(leaves), wantLeaves)
+	}
+
+	if !ssz.VerifyMultiproof(bytesutil.ToBytes32(stateRoot), leaves, proof, indices) {
+		return errors.Wrap(ErrInvalidValidatorProof, "multiproof does not verify against the state root")
+	}
+	return nil
+}
+
+// checkValidatorGeneralizedIndex checks that a generalized index designates the validator with the given index
+// in the validator registry of a beacon state.
+func checkValidatorGeneralizedIndex(gIndex, validatorIndex uint64) error {
+	if bits.Len64(gIndex) <= validatorRegistryDepth+2 {
+		return errors.Wrap(ErrInvalidValidatorProof, "generalized index is not within the validator registry")
+	}
+	if gIndex&(1<<validatorRegistryDepth-1) != validatorIndex {
+		return errors.Wrapf(ErrInvalidValidatorProof, "generalized index %d does not designate validator %d", gIndex, validatorIndex)
+	}
+	// The registry is the left child of its length mix-in.
+	listIndex := gIndex >> validatorRegistryDepth
+	if listIndex&1 != 0 {
+		return errors.Wrap(ErrInvalidValidatorProof, "generalized index designates the length of the validator registry")
+	}
+	fieldIndex := listIndex >> 1
+	if fieldIndex-uint64(1)<<(bits.Len64(fieldIndex)-1) != validatorsFieldPosition {
+		return errors.Wrap(ErrInvalidValidatorProof, "generalized index is not within the validator registry")
+	}
+	return nil
+}
+
+func decodeRoots(hexRoots []string) ([][32]byte, error) {
+	roots := make([][32]byte, len(hexRoots))
+	for i, h := range hexRoots {
+		b, err := hexutil.Decode(h)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != 32 {
+			return nil, errors.Errorf("node %s is not 32 bytes long", h)
+		}
+		roots[i] = bytesutil.ToBytes32(b)
+	}
+	return roots, nil
+}
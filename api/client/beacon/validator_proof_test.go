@@ -0,0 +1,132 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// loadValidatorProof loads a proof of the validator with index 1 in a state with 3 validators, generated
+// with an independent SSZ implementation.
+func loadValidatorProof(t *testing.T) *structs.ValidatorProof {
+	enc, err := os.ReadFile("testdata/validator_proof.json")
+	require.NoError(t, err)
+	p := &structs.ValidatorProof{}
+	require.NoError(t, json.Unmarshal(enc, p))
+	return p
+}
+
+func TestVerifyValidatorProof(t *testing.T) {
+	require.NoError(t, VerifyValidatorProof(loadValidatorProof(t)))
+
+	t.Run("without effective balance", func(t *testing.T) {
+		p := loadValidatorProof(t)
+		// The helper nodes 11 and 8 of the validator container are replaced by 8 and 5, the parent of the
+		// effective balance and its sibling.
+		leaves, err := decodeRoots(p.Leaves)
+		require.NoError(t, err)
+		proof, err := decodeRoots(p.Proof)
+		require.NoError(t, err)
+		parent := hash.Hash(append(leaves[1][:], proof[0][:]...))
+		p.Proof = append([]string{p.Proof[1], hexutil.Encode(parent[:])}, p.Proof[2:]...)
+		p.GeneralizedIndices = p.GeneralizedIndices[:1]
+		p.Leaves = p.Leaves[:1]
+		p.EffectiveBalance = ""
+		require.NoError(t, VerifyValidatorProof(p))
+	})
+
+	tests := []struct {
+		name   string
+		tamper func(p *structs.ValidatorProof)
+	}{
+		{
+			name: "withdrawal credentials",
+			tamper: func(p *structs.ValidatorProof) {
+				p.WithdrawalCredentials = "0x010000000000000000000000000000000000000000000000000000000000dead"
+				p.Leaves[0] = p.WithdrawalCredentials
+			},
+		},
+		{
+			name: "effective balance",
+			tamper: func(p *structs.ValidatorProof) {
+				p.EffectiveBalance = "31000000000"
+			},
+		},
+		{
+			name: "proof node",
+			tamper: func(p *structs.ValidatorProof) {
+				p.Proof[len(p.Proof)-1] = "0x0000000000000000000000000000000000000000000000000000000000000001"
+			},
+		},
+		{
+			name: "missing proof node",
+			tamper: func(p *structs.ValidatorProof) {
+				p.Proof = p.Proof[1:]
+			},
+		},
+		{
+			name: "validator index",
+			tamper: func(p *structs.ValidatorProof) {
+				p.ValidatorIndex = "2"
+			},
+		},
+		{
+			name: "block root",
+			tamper: func(p *structs.ValidatorProof) {
+				p.BlockRoot = "0x0000000000000000000000000000000000000000000000000000000000000001"
+			},
+		},
+		{
+			name: "state root",
+			tamper: func(p *structs.ValidatorProof) {
+				p.StateRoot = p.StateRootProof[0]
+			},
+		},
+		{
+			name: "state root proof",
+			tamper: func(p *structs.ValidatorProof) {
+				p.StateRootProof[1] = p.StateRootProof[0]
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := loadValidatorProof(t)
+			tt.tamper(p)
+			require.ErrorIs(t, VerifyValidatorProof(p), ErrInvalidValidatorProof)
+		})
+	}
+}
+
+func TestGetValidatorProof(t *testing.T) {
+	p := loadValidatorProof(t)
+	enc, err := json.Marshal(&structs.GetValidatorProofResponse{Finalized: true, Data: p})
+	require.NoError(t, err)
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		res := &http.Response{Request: req}
+		if req.URL.Path == "/prysm/v1/beacon/states/finalized/validator_proofs/1" && req.URL.Query().Get("effective_balance") == "true" {
+			res.StatusCode = http.StatusOK
+			res.Body = io.NopCloser(bytes.NewBuffer(enc))
+		} else {
+			res.StatusCode = http.StatusNotFound
+			res.Body = io.NopCloser(bytes.NewBuffer(nil))
+		}
+		return res, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+	got, err := c.GetValidatorProof(context.Background(), IdFinalized, 1, true)
+	require.NoError(t, err)
+	require.DeepEqual(t, p, got)
+	require.NoError(t, VerifyValidatorProof(got))
+}
@@ -6,11 +6,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"text/template"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api"
@@ -31,6 +34,9 @@ const (
 	getStatus                  = "/eth/v1/builder/status"
 	postBlindedBeaconBlockPath = "/eth/v1/builder/blinded_blocks"
 	postRegisterValidatorPath  = "/eth/v1/builder/validators"
+
+	// sszAcceptHeader prefers SSZ responses, while accepting JSON from builders that do not support SSZ.
+	sszAcceptHeader = api.OctetStreamMediaType + ";q=1.0," + api.JsonMediaType + ";q=0.9"
 )
 
 var errMalformedHostname = errors.New("hostname must include port, separated by one colon, like example.com:3500")
@@ -96,6 +102,8 @@ type Client struct {
 	hc      *http.Client
 	baseURL *url.URL
 	obvs    []observer
+	// sszEnabled is whether requests are made in SSZ. It is unset once the builder fails to serve SSZ.
+	sszEnabled atomic.Bool
 }
 
 // NewClient constructs a new client with the provided options (ex WithTimeout).
@@ -110,6 +118,7 @@ func NewClient(host string, opts ...ClientOpt) (*Client, error) {
 		hc:      &http.Client{},
 		baseURL: u,
 	}
+	c.sszEnabled.Store(true)
 	for _, o := range opts {
 		o(c)
 	}
@@ -137,7 +146,7 @@ func (c *Client) NodeURL() string {
 type reqOption func(*http.Request)
 
 // do is a generic, opinionated request function to reduce boilerplate amongst the methods in this package api/client/builder.
-func (c *Client) do(ctx context.Context, method string, path string, body io.Reader, opts ...reqOption) (res []byte, err error) {
+func (c *Client) do(ctx context.Context, method string, path string, body io.Reader, opts ...reqOption) (res []byte, header http.Header, err error) {
 	ctx, span := trace.StartSpan(ctx, "builder.client.do")
 	defer func() {
 		tracing.AnnotateError(span, err)
@@ -176,6 +185,7 @@ func (c *Client) do(ctx context.Context, method string, path string, body io.Rea
 		err = non200Err(r)
 		return
 	}
+	header = r.Header
 	res, err = io.ReadAll(r.Body)
 	if err != nil {
 		err = errors.Wrap(err, "error reading http response body from builder server")
@@ -205,24 +215,54 @@ func execHeaderPath(slot primitives.Slot, parentHash [32]byte, pubkey [48]byte)
 }
 
 // GetHeader is used by a proposing validator to request an execution payload header from the Builder node.
+// The header is requested in SSZ, unless the builder previously failed to serve SSZ, and JSON is accepted as well.
 func (c *Client) GetHeader(ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubkey [48]byte) (SignedBid, error) {
 	path, err := execHeaderPath(slot, parentHash, pubkey)
 	if err != nil {
 		return nil, err
 	}
-	hb, err := c.do(ctx, http.MethodGet, path, nil)
+	if c.sszEnabled.Load() {
+		bid, err := c.getHeader(ctx, path, sszAcceptHeader)
+		if !c.fallBackToJSON(err) {
+			return bid, err
+		}
+	}
+	return c.getHeader(ctx, path, api.JsonMediaType)
+}
+
+func (c *Client) getHeader(ctx context.Context, path string, accept string) (SignedBid, error) {
+	hb, header, err := c.do(ctx, http.MethodGet, path, nil, func(r *http.Request) {
+		r.Header.Set("Accept", accept)
+	})
 	if err != nil {
 		return nil, err
 	}
+	start := time.Now()
+	var bid SignedBid
+	encoding := api.JsonMediaType
+	if isSSZ(header) {
+		encoding = api.OctetStreamMediaType
+		bid, err = unmarshalSignedBidSSZ(hb, header.Get(api.VersionHeader))
+	} else {
+		bid, err = unmarshalSignedBidJSON(hb)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error unmarshaling the builder GetHeader response, using path=%s", path)
+	}
+	logBodySerialization("getHeader response", encoding, len(hb), time.Since(start))
+	return bid, nil
+}
+
+func unmarshalSignedBidJSON(hb []byte) (SignedBid, error) {
 	v := &VersionResponse{}
 	if err := json.Unmarshal(hb, v); err != nil {
-		return nil, errors.Wrapf(err, "error unmarshaling the builder GetHeader response, using slot=%d, parentHash=%#x, pubkey=%#x", slot, parentHash, pubkey)
+		return nil, err
 	}
 	switch strings.ToLower(v.Version) {
 	case strings.ToLower(version.String(version.Deneb)):
 		hr := &ExecHeaderResponseDeneb{}
 		if err := json.Unmarshal(hb, hr); err != nil {
-			return nil, errors.Wrapf(err, "error unmarshaling the builder GetHeader response, using slot=%d, parentHash=%#x, pubkey=%#x", slot, parentHash, pubkey)
+			return nil, err
 		}
 		p, err := hr.ToProto()
 		if err != nil {
@@ -232,7 +272,7 @@ func (c *Client) GetHeader(ctx context.Context, slot primitives.Slot, parentHash
 	case strings.ToLower(version.String(version.Capella)):
 		hr := &ExecHeaderResponseCapella{}
 		if err := json.Unmarshal(hb, hr); err != nil {
-			return nil, errors.Wrapf(err, "error unmarshaling the builder GetHeader response, using slot=%d, parentHash=%#x, pubkey=%#x", slot, parentHash, pubkey)
+			return nil, err
 		}
 		p, err := hr.ToProto()
 		if err != nil {
@@ -242,7 +282,7 @@ func (c *Client) GetHeader(ctx context.Context, slot primitives.Slot, parentHash
 	case strings.ToLower(version.String(version.Bellatrix)):
 		hr := &ExecHeaderResponse{}
 		if err := json.Unmarshal(hb, hr); err != nil {
-			return nil, errors.Wrapf(err, "error unmarshaling the builder GetHeader response, using slot=%d, parentHash=%#x, pubkey=%#x", slot, parentHash, pubkey)
+			return nil, err
 		}
 		p, err := hr.ToProto()
 		if err != nil {
@@ -277,7 +317,7 @@ func (c *Client) RegisterValidator(ctx context.Context, svr []*ethpb.SignedValid
 		return err
 	}
 
-	_, err = c.do(ctx, http.MethodPost, postRegisterValidatorPath, bytes.NewBuffer(body))
+	_, _, err = c.do(ctx, http.MethodPost, postRegisterValidatorPath, bytes.NewBuffer(body))
 	if err != nil {
 		return err
 	}
@@ -288,12 +328,59 @@ func (c *Client) RegisterValidator(ctx context.Context, svr []*ethpb.SignedValid
 var errResponseVersionMismatch = errors.New("builder API response uses a different version than requested in " + api.VersionHeader + " header")
 
 // SubmitBlindedBlock calls the builder API endpoint that binds the validator to the builder and submits the block.
-// The response is the full execution payload used to create the blinded block.
+// The response is the full execution payload used to create the blinded block. The block is submitted in SSZ,
+// unless the builder previously failed to serve SSZ, and it is submitted again in JSON if the builder rejects SSZ.
 func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
 	if !sb.IsBlinded() {
 		return nil, nil, errNotBlinded
 	}
+	if c.sszEnabled.Load() {
+		ed, bundle, err := c.submitBlindedBlockSSZ(ctx, sb)
+		if !c.fallBackToJSON(err) {
+			return ed, bundle, err
+		}
+	}
+	return c.submitBlindedBlockJSON(ctx, sb)
+}
+
+func (c *Client) submitBlindedBlockSSZ(ctx context.Context, sb interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	start := time.Now()
+	body, err := sb.MarshalSSZ()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error marshaling blinded block post request to ssz")
+	}
+	logBodySerialization("submitBlindedBlock request", api.OctetStreamMediaType, len(body), time.Since(start))
+	postOpts := func(r *http.Request) {
+		r.Header.Add("Eth-Consensus-Version", version.String(sb.Version()))
+		r.Header.Set("Content-Type", api.OctetStreamMediaType)
+		r.Header.Set("Accept", sszAcceptHeader)
+	}
+	rb, header, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), postOpts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error posting the blinded block to the builder api")
+	}
+	if !isSSZ(header) {
+		return parseExecutionPayloadJSON(rb, sb.Version())
+	}
+
+	start = time.Now()
+	if v := header.Get(api.VersionHeader); v != "" && strings.ToLower(v) != version.String(sb.Version()) {
+		return nil, nil, errors.Wrapf(errResponseVersionMismatch, "req=%s, recv=%s", version.String(sb.Version()), strings.ToLower(v))
+	}
+	pb, bundle, err := unmarshalExecutionPayloadSSZ(rb, sb.Version())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "error unmarshaling the builder execution payload response")
+	}
+	ed, err := blocks.NewWrappedExecutionData(pb)
+	if err != nil {
+		return nil, nil, err
+	}
+	logBodySerialization("submitBlindedBlock response", api.OctetStreamMediaType, len(rb), time.Since(start))
+	return ed, bundle, nil
+}
 
+func (c *Client) submitBlindedBlockJSON(ctx context.Context, sb interfaces.ReadOnlySignedBeaconBlock) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	start := time.Now()
 	// massage the proto struct type data into the api response type.
 	mj, err := structs.SignedBeaconBlockMessageJsoner(sb)
 	if err != nil {
@@ -304,6 +391,7 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "error marshaling blinded block post request to json")
 	}
+	logBodySerialization("submitBlindedBlock request", api.JsonMediaType, len(body), time.Since(start))
 	postOpts := func(r *http.Request) {
 		r.Header.Add("Eth-Consensus-Version", version.String(sb.Version()))
 		r.Header.Set("Content-Type", api.JsonMediaType)
@@ -311,18 +399,23 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 	}
 	// post the blinded block - the execution payload response should contain the unblinded payload, along with the
 	// blobs bundle if it is post deneb.
-	rb, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), postOpts)
+	rb, _, err := c.do(ctx, http.MethodPost, postBlindedBeaconBlockPath, bytes.NewBuffer(body), postOpts)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "error posting the blinded block to the builder api")
 	}
+	return parseExecutionPayloadJSON(rb, sb.Version())
+}
+
+func parseExecutionPayloadJSON(rb []byte, v int) (interfaces.ExecutionData, *v1.BlobsBundle, error) {
+	start := time.Now()
 	// ExecutionPayloadResponse parses just the outer container and the Value key, enabling it to use the .Value
 	// key to determine which underlying data type to use to finish the unmarshaling.
 	ep := &ExecutionPayloadResponse{}
 	if err := json.Unmarshal(rb, ep); err != nil {
 		return nil, nil, errors.Wrap(err, "error unmarshaling the builder ExecutionPayloadResponse")
 	}
-	if strings.ToLower(ep.Version) != version.String(sb.Version()) {
-		return nil, nil, errors.Wrapf(errResponseVersionMismatch, "req=%s, recv=%s", strings.ToLower(ep.Version), version.String(sb.Version()))
+	if strings.ToLower(ep.Version) != version.String(v) {
+		return nil, nil, errors.Wrapf(errResponseVersionMismatch, "req=%s, recv=%s", strings.ToLower(ep.Version), version.String(v))
 	}
 	// This parses the rest of the response and returns the inner data field.
 	pp, err := ep.ParsePayload()
@@ -338,22 +431,54 @@ func (c *Client) SubmitBlindedBlock(ctx context.Context, sb interfaces.ReadOnlyS
 	if err != nil {
 		return nil, nil, err
 	}
-	bb, ok := pp.(BlobBundler)
-	if ok {
-		bbpb, err := bb.BundleProto()
+	var bbpb *v1.BlobsBundle
+	if bb, ok := pp.(BlobBundler); ok {
+		bbpb, err = bb.BundleProto()
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to extract blobs bundle from builder response with version=%s", ep.Version)
 		}
-		return ed, bbpb, nil
 	}
-	return ed, nil, nil
+	logBodySerialization("submitBlindedBlock response", api.JsonMediaType, len(rb), time.Since(start))
+	return ed, bbpb, nil
+}
+
+// fallBackToJSON reports whether a request made in SSZ must be made again in JSON, which is the case when the builder
+// rejected the SSZ request or did not respond with valid SSZ. SSZ is then no longer used with this builder.
+func (c *Client) fallBackToJSON(err error) bool {
+	if err == nil {
+		return false
+	}
+	if !errors.Is(err, ErrUnsupportedMediaType) && !errors.Is(err, ErrNotAcceptable) && !errors.Is(err, errInvalidSSZ) {
+		return false
+	}
+	if c.sszEnabled.CompareAndSwap(true, false) {
+		log.WithError(err).WithField("url", c.NodeURL()).Warn("Builder does not support SSZ, falling back to JSON")
+	}
+	return true
+}
+
+// isSSZ reports whether the response body is SSZ encoded, based on the Content-Type header of the response.
+func isSSZ(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	return err == nil && mediaType == api.OctetStreamMediaType
+}
+
+// logBodySerialization logs the size of a builder API body and the time spent serializing or deserializing it,
+// to compare SSZ and JSON.
+func logBodySerialization(body string, encoding string, size int, d time.Duration) {
+	log.WithFields(log.Fields{
+		"body":     body,
+		"encoding": encoding,
+		"size":     size,
+		"duration": d,
+	}).Info("Serialized builder API body")
 }
 
 // Status asks the remote builder server for a health check. A response of 200 with an empty body is the success/healthy
 // response, and an error response may have an error message. This method will return a nil value for error in the
 // happy path, and an error with information about the server response body for a non-200 response.
 func (c *Client) Status(ctx context.Context) error {
-	_, err := c.do(ctx, http.MethodGet, getStatus, nil)
+	_, _, err := c.do(ctx, http.MethodGet, getStatus, nil)
 	return err
 }
 
@@ -383,6 +508,12 @@ func non200Err(response *http.Response) error {
 			return errors.Wrap(jsonErr, "unable to read response body")
 		}
 		return errors.Wrap(ErrNotFound, errMessage.Message)
+	case http.StatusNotAcceptable:
+		log.WithError(ErrNotAcceptable).Debug(msg)
+		return ErrNotAcceptable
+	case http.StatusUnsupportedMediaType:
+		log.WithError(ErrUnsupportedMediaType).Debug(msg)
+		return ErrUnsupportedMediaType
 	case http.StatusInternalServerError:
 		log.WithError(ErrNotOK).Debug(msg)
 		if jsonErr := json.Unmarshal(bodyBytes, &errMessage); jsonErr != nil {
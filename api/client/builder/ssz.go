@@ -0,0 +1,105 @@
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"google.golang.org/protobuf/proto"
+)
+
+var errInvalidSSZ = errors.New("invalid ssz response from builder API")
+
+const (
+	// signedBidFixedSize is the size of the fixed part of a signed builder bid: the offset of the bid and the signature.
+	signedBidFixedSize = 4 + fieldparams.BLSSignatureLength
+	// payloadAndBlobsBundleFixedSize is the size of the fixed part of an execution payload and blobs bundle:
+	// the offsets of the execution payload and of the blobs bundle.
+	payloadAndBlobsBundleFixedSize = 8
+)
+
+// unmarshalSignedBidSSZ decodes the SSZ encoded signed builder bid of the getHeader response, of the fork version
+// given by the Eth-Consensus-Version header of the response.
+func unmarshalSignedBidSSZ(b []byte, v string) (SignedBid, error) {
+	if v == "" {
+		return nil, errors.Wrap(errInvalidSSZ, "missing Eth-Consensus-Version header")
+	}
+	if len(b) < signedBidFixedSize {
+		return nil, errors.Wrapf(errInvalidSSZ, "signed builder bid size %d is too small", len(b))
+	}
+	if o := ssz.ReadOffset(b[:4]); o != signedBidFixedSize {
+		return nil, errors.Wrapf(errInvalidSSZ, "invalid builder bid offset %d", o)
+	}
+	sig := bytesutil.SafeCopyBytes(b[4:signedBidFixedSize])
+	msg := b[signedBidFixedSize:]
+
+	switch strings.ToLower(v) {
+	case version.String(version.Deneb):
+		bid := &ethpb.BuilderBidDeneb{}
+		if err := bid.UnmarshalSSZ(msg); err != nil {
+			return nil, errors.Wrapf(errInvalidSSZ, "could not unmarshal builder bid: %v", err)
+		}
+		if len(bid.BlobKzgCommitments) > fieldparams.MaxBlobsPerBlock {
+			return nil, fmt.Errorf("too many blob commitments: %d", len(bid.BlobKzgCommitments))
+		}
+		return WrappedSignedBuilderBidDeneb(&ethpb.SignedBuilderBidDeneb{Message: bid, Signature: sig})
+	case version.String(version.Capella):
+		bid := &ethpb.BuilderBidCapella{}
+		if err := bid.UnmarshalSSZ(msg); err != nil {
+			return nil, errors.Wrapf(errInvalidSSZ, "could not unmarshal builder bid: %v", err)
+		}
+		return WrappedSignedBuilderBidCapella(&ethpb.SignedBuilderBidCapella{Message: bid, Signature: sig})
+	case version.String(version.Bellatrix):
+		bid := &ethpb.BuilderBid{}
+		if err := bid.UnmarshalSSZ(msg); err != nil {
+			return nil, errors.Wrapf(errInvalidSSZ, "could not unmarshal builder bid: %v", err)
+		}
+		return WrappedSignedBuilderBid(&ethpb.SignedBuilderBid{Message: bid, Signature: sig})
+	default:
+		return nil, fmt.Errorf("unsupported header version %s", strings.ToLower(v))
+	}
+}
+
+// unmarshalExecutionPayloadSSZ decodes the SSZ encoded submitBlindedBlock response for a block of the given version.
+// From Deneb, the response holds the blobs bundle along with the execution payload.
+func unmarshalExecutionPayloadSSZ(b []byte, v int) (proto.Message, *v1.BlobsBundle, error) {
+	switch v {
+	case version.Deneb:
+		if len(b) < payloadAndBlobsBundleFixedSize {
+			return nil, nil, errors.Wrapf(errInvalidSSZ, "execution payload and blobs bundle size %d is too small", len(b))
+		}
+		payloadOffset, bundleOffset := ssz.ReadOffset(b[:4]), ssz.ReadOffset(b[4:8])
+		if payloadOffset != payloadAndBlobsBundleFixedSize || bundleOffset < payloadOffset || bundleOffset > uint64(len(b)) {
+			return nil, nil, errors.Wrapf(errInvalidSSZ, "invalid execution payload offset %d or blobs bundle offset %d", payloadOffset, bundleOffset)
+		}
+		payload := &v1.ExecutionPayloadDeneb{}
+		if err := payload.UnmarshalSSZ(b[payloadOffset:bundleOffset]); err != nil {
+			return nil, nil, errors.Wrapf(errInvalidSSZ, "could not unmarshal execution payload: %v", err)
+		}
+		bundle := &v1.BlobsBundle{}
+		if err := bundle.UnmarshalSSZ(b[bundleOffset:]); err != nil {
+			return nil, nil, errors.Wrapf(errInvalidSSZ, "could not unmarshal blobs bundle: %v", err)
+		}
+		return payload, bundle, nil
+	case version.Capella:
+		payload := &v1.ExecutionPayloadCapella{}
+		if err := payload.UnmarshalSSZ(b); err != nil {
+			return nil, nil, errors.Wrapf(errInvalidSSZ, "could not unmarshal execution payload: %v", err)
+		}
+		return payload, nil, nil
+	case version.Bellatrix:
+		payload := &v1.ExecutionPayload{}
+		if err := payload.UnmarshalSSZ(b); err != nil {
+			return nil, nil, errors.Wrapf(errInvalidSSZ, "could not unmarshal execution payload: %v", err)
+		}
+		return payload, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported block version %s", version.String(v))
+	}
+}
@@ -0,0 +1,232 @@
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// relayEncoding is the behavior of a mock relay regarding SSZ.
+type relayEncoding int
+
+const (
+	// relaySSZ serves and accepts SSZ.
+	relaySSZ relayEncoding = iota
+	// relayJSON only serves JSON, following the Accept header, and rejects SSZ request bodies.
+	relayJSON
+	// relayStrictJSON rejects requests which do not only accept JSON.
+	relayStrictJSON
+	// relayLying claims to serve SSZ, but its response bodies are JSON.
+	relayLying
+)
+
+type mockRelay struct {
+	t        *testing.T
+	encoding relayEncoding
+	block    *eth.SignedBlindedBeaconBlockDeneb
+	// sszRequests and jsonRequests count the requests received per requested encoding.
+	sszRequests  atomic.Int32
+	jsonRequests atomic.Int32
+}
+
+func (m *mockRelay) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	t := m.t
+	sszRequested := r.Header.Get("Accept") == sszAcceptHeader || r.Header.Get("Content-Type") == api.OctetStreamMediaType
+	if sszRequested {
+		m.sszRequests.Add(1)
+	} else {
+		m.jsonRequests.Add(1)
+	}
+	switch {
+	case m.encoding == relayStrictJSON && r.Header.Get("Accept") != api.JsonMediaType:
+		w.WriteHeader(http.StatusNotAcceptable)
+		return
+	case m.encoding != relaySSZ && r.Header.Get("Content-Type") == api.OctetStreamMediaType && m.encoding != relayLying:
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+	respondSSZ := sszRequested && m.encoding == relaySSZ
+
+	var jsonBody string
+	var sszBody []byte
+	switch r.URL.Path {
+	case postBlindedBeaconBlockPath:
+		require.Equal(t, "deneb", r.Header.Get(api.VersionHeader))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if r.Header.Get("Content-Type") == api.OctetStreamMediaType {
+			block := &eth.SignedBlindedBeaconBlockDeneb{}
+			require.NoError(t, block.UnmarshalSSZ(body))
+			require.DeepEqual(t, m.block, block)
+		} else {
+			require.Equal(t, api.JsonMediaType, r.Header.Get("Content-Type"))
+		}
+		jsonBody = testExampleExecutionPayloadDeneb
+		if respondSSZ {
+			sszBody = testExecutionPayloadAndBlobsBundleSSZ(t)
+		}
+	default:
+		jsonBody = testExampleHeaderResponseDeneb
+		if respondSSZ {
+			sszBody = testSignedBidSSZ(t)
+		}
+	}
+
+	if respondSSZ {
+		w.Header().Set("Content-Type", api.OctetStreamMediaType)
+		w.Header().Set(api.VersionHeader, "deneb")
+		_, err := w.Write(sszBody)
+		require.NoError(t, err)
+		return
+	}
+	if sszRequested && m.encoding == relayLying {
+		w.Header().Set("Content-Type", api.OctetStreamMediaType)
+		w.Header().Set(api.VersionHeader, "deneb")
+	} else {
+		w.Header().Set("Content-Type", api.JsonMediaType)
+	}
+	_, err := w.Write([]byte(jsonBody))
+	require.NoError(t, err)
+}
+
+func testSignedBidSSZ(t *testing.T) []byte {
+	hr := &ExecHeaderResponseDeneb{}
+	require.NoError(t, json.Unmarshal([]byte(testExampleHeaderResponseDeneb), hr))
+	p, err := hr.ToProto()
+	require.NoError(t, err)
+	msg, err := p.Message.MarshalSSZ()
+	require.NoError(t, err)
+	b := ssz.WriteOffset(nil, signedBidFixedSize)
+	b = append(b, p.Signature...)
+	return append(b, msg...)
+}
+
+func testExecutionPayloadAndBlobsBundleSSZ(t *testing.T) []byte {
+	ep := &ExecutionPayloadResponse{}
+	require.NoError(t, json.Unmarshal([]byte(testExampleExecutionPayloadDeneb), ep))
+	pp, err := ep.ParsePayload()
+	require.NoError(t, err)
+	pb, err := pp.PayloadProto()
+	require.NoError(t, err)
+	payload, ok := pb.(*v1.ExecutionPayloadDeneb)
+	require.Equal(t, true, ok)
+	bb, ok := pp.(BlobBundler)
+	require.Equal(t, true, ok)
+	bundle, err := bb.BundleProto()
+	require.NoError(t, err)
+
+	payloadBytes, err := payload.MarshalSSZ()
+	require.NoError(t, err)
+	bundleBytes, err := bundle.MarshalSSZ()
+	require.NoError(t, err)
+	b := ssz.WriteOffset(nil, payloadAndBlobsBundleFixedSize)
+	b = ssz.WriteOffset(b, payloadAndBlobsBundleFixedSize+len(payloadBytes))
+	b = append(b, payloadBytes...)
+	return append(b, bundleBytes...)
+}
+
+func TestClient_ContentNegotiation(t *testing.T) {
+	ctx := context.Background()
+	parentHash := bytesutil.ToBytes32(ezDecode(t, "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"))
+	pubkey := bytesutil.ToBytes48(ezDecode(t, "0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"))
+
+	tests := []struct {
+		name         string
+		encoding     relayEncoding
+		sszRequests  int32
+		jsonRequests int32
+		sszEnabled   bool
+	}{
+		{name: "ssz", encoding: relaySSZ, sszRequests: 4, sszEnabled: true},
+		// The relay answers SSZ header requests in JSON, then rejects the SSZ block, which disables SSZ.
+		{name: "json", encoding: relayJSON, sszRequests: 3, jsonRequests: 2},
+		{name: "strict json", encoding: relayStrictJSON, sszRequests: 1, jsonRequests: 4},
+		{name: "lying about ssz support", encoding: relayLying, sszRequests: 1, jsonRequests: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block := testSignedBlindedBeaconBlockDeneb(t)
+			// The deposit proof of the test block must have the full depth to be encoded in SSZ.
+			proof := make([][]byte, params.BeaconConfig().DepositContractTreeDepth+1)
+			for i := range proof {
+				proof[i] = make([]byte, 32)
+			}
+			block.Message.Body.Deposits[0].Proof = proof
+			relay := &mockRelay{t: t, encoding: tt.encoding, block: block}
+			srv := httptest.NewServer(relay)
+			defer srv.Close()
+			c, err := NewClient(srv.URL)
+			require.NoError(t, err)
+
+			for i := 0; i < 2; i++ {
+				h, err := c.GetHeader(ctx, primitives.Slot(23), parentHash, pubkey)
+				require.NoError(t, err)
+				assert.Equal(t, version.Deneb, h.Version())
+				bid, err := h.Message()
+				require.NoError(t, err)
+				commitments, err := bid.BlobKzgCommitments()
+				require.NoError(t, err)
+				assert.Equal(t, 1, len(commitments))
+			}
+			for i := 0; i < 2; i++ {
+				sbb, err := blocks.NewSignedBeaconBlock(block)
+				require.NoError(t, err)
+				ep, bundle, err := c.SubmitBlindedBlock(ctx, sbb)
+				require.NoError(t, err)
+				withdrawals, err := ep.Withdrawals()
+				require.NoError(t, err)
+				require.Equal(t, 1, len(withdrawals))
+				assert.Equal(t, primitives.ValidatorIndex(1), withdrawals[0].ValidatorIndex)
+				require.NotNil(t, bundle)
+				assert.Equal(t, 1, len(bundle.Blobs))
+			}
+
+			assert.Equal(t, tt.sszRequests, relay.sszRequests.Load())
+			assert.Equal(t, tt.jsonRequests, relay.jsonRequests.Load())
+			assert.Equal(t, tt.sszEnabled, c.sszEnabled.Load())
+		})
+	}
+}
+
+func TestUnmarshalSignedBidSSZ(t *testing.T) {
+	b := testSignedBidSSZ(t)
+	t.Run("ok", func(t *testing.T) {
+		bid, err := unmarshalSignedBidSSZ(b, "Deneb")
+		require.NoError(t, err)
+		hr := &ExecHeaderResponseDeneb{}
+		require.NoError(t, json.Unmarshal([]byte(testExampleHeaderResponseDeneb), hr))
+		expected, err := hr.ToProto()
+		require.NoError(t, err)
+		w, ok := bid.(signedBuilderBidDeneb)
+		require.Equal(t, true, ok)
+		assert.DeepEqual(t, expected, w.p)
+	})
+	t.Run("missing version", func(t *testing.T) {
+		_, err := unmarshalSignedBidSSZ(b, "")
+		require.ErrorIs(t, err, errInvalidSSZ)
+	})
+	t.Run("wrong version", func(t *testing.T) {
+		_, err := unmarshalSignedBidSSZ(b, "capella")
+		require.ErrorIs(t, err, errInvalidSSZ)
+	})
+	t.Run("truncated", func(t *testing.T) {
+		_, err := unmarshalSignedBidSSZ(b[:signedBidFixedSize-1], "deneb")
+		require.ErrorIs(t, err, errInvalidSSZ)
+	})
+}
@@ -2,19 +2,25 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
 // Client is a wrapper object around the HTTP client.
 type Client struct {
-	hc      *http.Client
-	baseURL *url.URL
-	token   string
+	hc         *http.Client
+	baseURL    *url.URL
+	token      string
+	retry      RetryConfig
+	hedgeHost  string
+	hedgeURL   *url.URL
+	hedgeDelay time.Duration
 }
 
 // NewClient constructs a new client with the provided options (ex WithTimeout).
@@ -32,6 +38,12 @@ func NewClient(host string, opts ...ClientOpt) (*Client, error) {
 	for _, o := range opts {
 		o(c)
 	}
+	if c.hedgeHost != "" {
+		c.hedgeURL, err = urlForHost(c.hedgeHost)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid hedge endpoint %s", c.hedgeHost)
+		}
+	}
 	return c, nil
 }
 
@@ -70,8 +82,46 @@ func (c *Client) NodeURL() string {
 }
 
 // Get is a generic, opinionated GET function to reduce boilerplate amongst the getters in this package.
+// Since GET requests are idempotent, transient failures are retried according to the client's RetryConfig (see WithRetry),
+// and if a hedge endpoint is configured (see WithHedging) a slow request is raced against the same request
+// to the hedge endpoint. The context deadline bounds the total time spent across all attempts.
 func (c *Client) Get(ctx context.Context, path string, opts ...ReqOption) ([]byte, error) {
-	u := c.baseURL.ResolveReference(&url.URL{Path: path})
+	if c.hedgeURL == nil {
+		return c.getWithRetry(ctx, c.baseURL, path, opts...)
+	}
+	return c.getHedged(ctx, path, opts...)
+}
+
+// getHedged sends the request to the primary endpoint, and if no response has been received after hedgeDelay,
+// sends the same request to the hedge endpoint. See Hedge.
+func (c *Client) getHedged(ctx context.Context, path string, opts ...ReqOption) ([]byte, error) {
+	return Hedge(ctx, c.hedgeDelay, func(err error) bool { return isRetryable(ctx, err) },
+		func(ctx context.Context) ([]byte, error) {
+			return c.getWithRetry(ctx, c.baseURL, path, opts...)
+		},
+		func(ctx context.Context) ([]byte, error) {
+			return c.getWithRetry(ctx, c.hedgeURL, path, opts...)
+		},
+	)
+}
+
+// getWithRetry performs the GET request against the given base url, retrying transient failures.
+func (c *Client) getWithRetry(ctx context.Context, base *url.URL, path string, opts ...ReqOption) ([]byte, error) {
+	var b []byte
+	err := c.retry.Do(ctx, func(err error) bool { return isRetryable(ctx, err) }, func(ctx context.Context) error {
+		var err error
+		b, err = c.get(ctx, base, path, opts...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// get performs a single GET request.
+func (c *Client) get(ctx context.Context, base *url.URL, path string, opts ...ReqOption) ([]byte, error) {
+	u := base.ResolveReference(&url.URL{Path: path})
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
 		return nil, err
@@ -81,10 +131,15 @@ func (c *Client) Get(ctx context.Context, path string, opts ...ReqOption) ([]byt
 	}
 	r, err := c.hc.Do(req)
 	if err != nil {
-		return nil, err
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %w", ErrConnectionIssue, err)
 	}
 	defer func() {
-		err = r.Body.Close()
+		if err := r.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
 	}()
 	if r.StatusCode != http.StatusOK {
 		return nil, Non200Err(r)
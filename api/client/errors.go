@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -15,8 +18,24 @@ var ErrMalformedHostname = errors.New("hostname must include port, separated by
 // More specific errors may be returned, but an error in reaction to a non-2xx response will always wrap ErrNotOK.
 var ErrNotOK = errors.New("did not receive 2xx response from API")
 
+// ErrClientError is used to indicate that the API responded with a 4xx status code.
+var ErrClientError = errors.Wrap(ErrNotOK, "recv 4xx response from API")
+
+// ErrServerError is used to indicate that the API responded with a 5xx status code.
+var ErrServerError = errors.Wrap(ErrNotOK, "recv 5xx response from API")
+
 // ErrNotFound specifically means that a '404 - NOT FOUND' response was received from the API.
-var ErrNotFound = errors.Wrap(ErrNotOK, "recv 404 NotFound response from API")
+var ErrNotFound = errors.Wrap(ErrClientError, "recv 404 NotFound response from API")
+
+// ErrTooManyRequests specifically means that a '429 - TOO MANY REQUESTS' response was received from the API.
+var ErrTooManyRequests = errors.Wrap(ErrClientError, "recv 429 TooManyRequests response from API")
+
+// ErrServiceUnavailable specifically means that a '503 - SERVICE UNAVAILABLE' response was received from the API.
+var ErrServiceUnavailable = errors.Wrap(ErrServerError, "recv 503 ServiceUnavailable response from API")
+
+// ErrNodeSyncing specifically means that a '503 - SERVICE UNAVAILABLE' response was received from a beacon node,
+// which is how the Beacon API reports that the node is syncing and cannot serve the request yet.
+var ErrNodeSyncing = errors.Wrap(ErrServiceUnavailable, "beacon node is syncing")
 
 // ErrInvalidNodeVersion indicates that the /eth/v1/node/version API response format was not recognized.
 var ErrInvalidNodeVersion = errors.New("invalid node version response")
@@ -24,7 +43,85 @@ var ErrInvalidNodeVersion = errors.New("invalid node version response")
 // ErrConnectionIssue represents a connection problem.
 var ErrConnectionIssue = errors.New("could not connect")
 
+// StatusError is returned for any non-2xx response. It carries the status code so callers can make decisions
+// (such as retrying) without parsing the message, and unwraps to the sentinel error for the status class,
+// so errors.Is(err, ErrNotFound), errors.Is(err, ErrServerError) etc. work as expected.
+type StatusError struct {
+	Code int
+	URL  string
+	Body string
+	err  error
+}
+
+// Error implements the error interface.
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: code=%d, url=%s, body=%s", e.err.Error(), e.Code, e.URL, e.Body)
+}
+
+// Unwrap returns the sentinel error for the status class of the response.
+func (e *StatusError) Unwrap() error {
+	return e.err
+}
+
+// NodeSyncingError is returned when a beacon node cannot serve a request because it is syncing. It unwraps to
+// ErrNodeSyncing and to the error built from the response, so the usual error handling of the response keeps working.
+type NodeSyncingError struct {
+	// RetryAfter is the delay the node asked to wait before retrying, zero when the response had no Retry-After header.
+	RetryAfter time.Duration
+	Err        error
+}
+
+// Error implements the error interface.
+func (e *NodeSyncingError) Error() string {
+	if e.Err == nil {
+		return ErrNodeSyncing.Error()
+	}
+	return fmt.Sprintf("%s: %s", ErrNodeSyncing.Error(), e.Err.Error())
+}
+
+// Unwrap returns ErrNodeSyncing and the error built from the response.
+func (e *NodeSyncingError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{ErrNodeSyncing}
+	}
+	return []error{ErrNodeSyncing, e.Err}
+}
+
+// ParseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or an HTTP date.
+// Zero is returned when the header is empty or malformed, or when the date is not in the future.
+func ParseRetryAfter(header string, now time.Time) time.Duration {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseUint(header, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+func errForStatus(code int) error {
+	switch {
+	case code == http.StatusNotFound:
+		return ErrNotFound
+	case code == http.StatusTooManyRequests:
+		return ErrTooManyRequests
+	case code == http.StatusServiceUnavailable:
+		return ErrServiceUnavailable
+	case code >= 400 && code < 500:
+		return ErrClientError
+	case code >= 500 && code < 600:
+		return ErrServerError
+	default:
+		return ErrNotOK
+	}
+}
+
 // Non200Err is a function that parses an HTTP response to handle responses that are not 200 with a formatted error.
+// The returned error is always a *StatusError.
 func Non200Err(response *http.Response) error {
 	bodyBytes, err := io.ReadAll(response.Body)
 	var body string
@@ -33,11 +130,14 @@ func Non200Err(response *http.Response) error {
 	} else {
 		body = "response body:\n" + string(bodyBytes)
 	}
-	msg := fmt.Sprintf("code=%d, url=%s, body=%s", response.StatusCode, response.Request.URL, body)
-	switch response.StatusCode {
-	case http.StatusNotFound:
-		return errors.Wrap(ErrNotFound, msg)
-	default:
-		return errors.Wrap(ErrNotOK, msg)
+	var u string
+	if response.Request != nil && response.Request.URL != nil {
+		u = response.Request.URL.String()
+	}
+	return &StatusError{
+		Code: response.StatusCode,
+		URL:  u,
+		Body: body,
+		err:  errForStatus(response.StatusCode),
 	}
 }
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+	}{
+		{name: "empty", header: "", want: 0},
+		{name: "seconds", header: "12", want: 12 * time.Second},
+		{name: "seconds with spaces", header: " 3 ", want: 3 * time.Second},
+		{name: "negative seconds", header: "-1", want: 0},
+		{name: "http date", header: now.Add(30 * time.Second).Format(http.TimeFormat), want: 30 * time.Second},
+		{name: "http date in the past", header: now.Add(-time.Minute).Format(http.TimeFormat), want: 0},
+		{name: "malformed", header: "soon", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ParseRetryAfter(tt.header, now))
+		})
+	}
+}
+
+func TestNodeSyncingError(t *testing.T) {
+	respErr := &StatusError{Code: http.StatusServiceUnavailable, err: ErrServiceUnavailable}
+	var err error = &NodeSyncingError{RetryAfter: time.Second, Err: respErr}
+	err = errors.Wrap(err, "could not get duties")
+
+	require.ErrorIs(t, err, ErrNodeSyncing)
+	require.ErrorIs(t, err, ErrServiceUnavailable)
+	var se *StatusError
+	require.Equal(t, true, errors.As(err, &se))
+	require.Equal(t, http.StatusServiceUnavailable, se.Code)
+	var syncingErr *NodeSyncingError
+	require.Equal(t, true, errors.As(err, &syncingErr))
+	require.Equal(t, time.Second, syncingErr.RetryAfter)
+}
@@ -32,12 +32,23 @@ const (
 	EventConnectionError             = "connection_error"
 )
 
+// EventDutiesInvalidated is a Prysm-only topic announcing a change of the duty dependent roots of the head.
+const EventDutiesInvalidated = "duties_invalidated"
+
 var (
 	_ = EventStreamClient(&EventStream{})
 )
 
 var DefaultEventTopics = []string{EventHead}
 
+const (
+	eventsPath      = "/eth/v1/events"
+	prysmEventsPath = "/prysm/v1/events"
+)
+
+// prysmOnlyTopics are only served by the Prysm events endpoint.
+var prysmOnlyTopics = map[string]bool{EventDutiesInvalidated: true}
+
 type EventStreamClient interface {
 	Subscribe(eventsChannel chan<- *Event)
 }
@@ -74,27 +85,52 @@ func NewEventStream(ctx context.Context, httpClient *http.Client, host string, t
 	}, nil
 }
 
+// Subscribe listens to the requested topics and sends the received events to the events channel.
+// Prysm-only topics are requested from the Prysm events endpoint. When the beacon node does not serve it,
+// they are dropped and the standard topics are requested from the standard endpoint instead.
 func (h *EventStream) Subscribe(eventsChannel chan<- *Event) {
-	allTopics := strings.Join(h.topics, ",")
+	standard := standardTopics(h.topics)
+	if len(standard) == len(h.topics) {
+		resp, err := h.connect(eventsPath, h.topics)
+		h.read(resp, err, eventsChannel)
+		return
+	}
+	resp, err := h.connect(prysmEventsPath, h.topics)
+	if err == nil && resp.StatusCode == http.StatusNotFound && len(standard) > 0 {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close events response body")
+		}
+		log.Warn("Beacon node does not serve Prysm events, listening to standard events only")
+		resp, err = h.connect(eventsPath, standard)
+	}
+	h.read(resp, err, eventsChannel)
+}
+
+func (h *EventStream) connect(path string, topics []string) (*http.Response, error) {
+	allTopics := strings.Join(topics, ",")
 	log.WithField("topics", allTopics).Info("Listening to Beacon API events")
-	fullUrl := h.host + "/eth/v1/events?topics=" + allTopics
+	fullUrl := h.host + path + "?topics=" + allTopics
 	req, err := http.NewRequestWithContext(h.ctx, http.MethodGet, fullUrl, nil)
 	if err != nil {
-		eventsChannel <- &Event{
-			EventType: EventConnectionError,
-			Data:      []byte(errors.Wrap(err, "failed to create HTTP request").Error()),
-		}
+		return nil, errors.Wrap(err, "failed to create HTTP request")
 	}
 	req.Header.Set("Accept", api.EventStreamMediaType)
 	req.Header.Set("Connection", api.KeepAlive)
 	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, client.ErrConnectionIssue.Error())
+	}
+	return resp, nil
+}
+
+func (h *EventStream) read(resp *http.Response, err error, eventsChannel chan<- *Event) {
 	if err != nil {
 		eventsChannel <- &Event{
 			EventType: EventConnectionError,
-			Data:      []byte(errors.Wrap(err, client.ErrConnectionIssue.Error()).Error()),
+			Data:      []byte(err.Error()),
 		}
+		return
 	}
-
 	defer func() {
 		if closeErr := resp.Body.Close(); closeErr != nil {
 			log.WithError(closeErr).Error("Failed to close events response body")
@@ -148,3 +184,13 @@ func (h *EventStream) Subscribe(eventsChannel chan<- *Event) {
 		}
 	}
 }
+
+func standardTopics(topics []string) []string {
+	standard := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		if !prysmOnlyTopics[topic] {
+			standard = append(standard, topic)
+		}
+	}
+	return standard
+}
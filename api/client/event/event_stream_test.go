@@ -79,3 +79,34 @@ func TestEventStream(t *testing.T) {
 		}
 	}
 }
+
+func TestEventStream_PrysmTopics(t *testing.T) {
+	serve := func(w http.ResponseWriter, r *http.Request) {
+		_, err := fmt.Fprintf(w, "event: head\ndata: %s\n\n", r.URL.Query().Get("topics"))
+		require.NoError(t, err)
+	}
+	t.Run("prysm endpoint", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/prysm/v1/events", serve)
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		eventsChannel := make(chan *Event, 1)
+		stream, err := NewEventStream(context.Background(), http.DefaultClient, server.URL, []string{EventHead, EventDutiesInvalidated})
+		require.NoError(t, err)
+		go stream.Subscribe(eventsChannel)
+		require.Equal(t, "head,duties_invalidated", string((<-eventsChannel).Data))
+	})
+	t.Run("falls back to standard endpoint", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/eth/v1/events", serve)
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		eventsChannel := make(chan *Event, 1)
+		stream, err := NewEventStream(context.Background(), http.DefaultClient, server.URL, []string{EventHead, EventDutiesInvalidated})
+		require.NoError(t, err)
+		go stream.Subscribe(eventsChannel)
+		require.Equal(t, "head", string((<-eventsChannel).Data))
+	})
+}
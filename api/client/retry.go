@@ -0,0 +1,227 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultRetryAttempts  = 5
+	defaultInitialBackoff = 250 * time.Millisecond
+	defaultMaxBackoff     = 8 * time.Second
+	defaultHedgeDelay     = 500 * time.Millisecond
+	maxBackoffShift       = 30
+)
+
+// RetryConfig controls how idempotent GET requests are retried when they fail with a transient error.
+// The delay between attempts grows exponentially from InitialBackoff up to MaxBackoff, with random jitter
+// applied so that many clients failing at once do not retry in lockstep.
+type RetryConfig struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values < 1 are treated as 1.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryConfig returns the retry settings used by checkpoint sync and prysmctl.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts:    defaultRetryAttempts,
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+	}
+}
+
+func (rc RetryConfig) attempts() int {
+	if rc.MaxAttempts < 1 {
+		return 1
+	}
+	return rc.MaxAttempts
+}
+
+// backoff computes the delay before the given retry (1-indexed), using "equal jitter":
+// half of the exponential delay is fixed and the other half is random.
+func (rc RetryConfig) backoff(retry int, gen *rand.Rand) time.Duration {
+	if rc.InitialBackoff <= 0 {
+		return 0
+	}
+	shift := retry - 1
+	if shift > maxBackoffShift {
+		shift = maxBackoffShift
+	}
+	d := rc.InitialBackoff << uint(shift)
+	if rc.MaxBackoff > 0 && (d > rc.MaxBackoff || d <= 0) {
+		d = rc.MaxBackoff
+	}
+	half := d / 2
+	if half <= 0 {
+		return d
+	}
+	return half + time.Duration(gen.Int63n(int64(half)))
+}
+
+// Do calls f until it succeeds, fails with an error for which retryable returns false, or the attempts of the
+// config are used up, waiting for the backoff of the config between attempts. The context deadline bounds the
+// total time spent: no attempt is made if the deadline would pass during the backoff.
+func (rc RetryConfig) Do(ctx context.Context, retryable func(error) bool, f func(context.Context) error) error {
+	attempts := rc.attempts()
+	gen := rand.NewGenerator()
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			d := rc.backoff(i, gen)
+			log.WithError(err).WithFields(logrus.Fields{
+				"attempt": i + 1,
+				"backoff": d,
+			}).Debug("Retrying failed request")
+			if serr := sleepCtx(ctx, d); serr != nil {
+				return errors.Wrapf(err, "request aborted after %d attempts: %v", i, serr)
+			}
+		}
+		if err = f(ctx); err == nil {
+			return nil
+		}
+		if !retryable(err) {
+			return err
+		}
+	}
+	if attempts > 1 {
+		return errors.Wrapf(err, "giving up after %d attempts", attempts)
+	}
+	return err
+}
+
+// Hedge calls primary, and if it has not returned after delay, or has failed with an error for which retryable
+// returns true, also calls hedge. The first successful result is returned and the context of the other call is
+// canceled. If both calls fail, the error of primary is returned.
+func Hedge[T any](ctx context.Context, delay time.Duration, retryable func(error) bool, primary, hedge func(context.Context) (T, error)) (T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		val T
+		err error
+	}
+	primaryCh := make(chan result, 1)
+	hedgeCh := make(chan result, 1)
+	go func() {
+		v, err := primary(ctx)
+		primaryCh <- result{val: v, err: err}
+	}()
+	startHedge := func() {
+		go func() {
+			v, err := hedge(ctx)
+			hedgeCh <- result{val: v, err: err}
+		}()
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	var zero T
+	var primaryErr, hedgeErr error
+	hedgeStarted := false
+	for {
+		select {
+		case r := <-primaryCh:
+			if r.err == nil {
+				return r.val, nil
+			}
+			primaryErr = r.err
+			if !retryable(r.err) {
+				// Errors like a 404 are not specific to the primary endpoint.
+				return zero, r.err
+			}
+			if !hedgeStarted {
+				// The primary failed before the hedge delay elapsed; there's no reason to keep waiting.
+				t.Stop()
+				hedgeStarted = true
+				startHedge()
+			}
+		case r := <-hedgeCh:
+			if r.err == nil {
+				return r.val, nil
+			}
+			hedgeErr = r.err
+		case <-t.C:
+			if !hedgeStarted {
+				hedgeStarted = true
+				startHedge()
+			}
+		}
+		if primaryErr != nil && hedgeErr != nil {
+			log.WithError(hedgeErr).Debug("Hedged request failed")
+			return zero, primaryErr
+		}
+	}
+}
+
+// IsRetryableStatus reports whether a response status indicates a transient condition,
+// typically caused by a load balancer or an overloaded node, that is worth retrying.
+func IsRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryable decides whether the error from a single attempt should be retried.
+// Errors caused by the caller's context being canceled or timing out are never retried.
+func isRetryable(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	var se *StatusError
+	if errors.As(err, &se) {
+		return IsRetryableStatus(se.Code)
+	}
+	// Anything that didn't produce an http response (connection refused, reset, EOF) is worth another try.
+	return errors.Is(err, ErrConnectionIssue)
+}
+
+// sleepCtx waits for the given duration, returning early with an error if the context is done
+// or if its deadline would pass before the wait completes.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return errors.Wrap(context.DeadlineExceeded, "not enough time left before context deadline to retry")
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// WithRetry enables retrying idempotent GET requests that fail with a transient error.
+func WithRetry(cfg RetryConfig) ClientOpt {
+	return func(c *Client) {
+		c.retry = cfg
+	}
+}
+
+// WithHedging configures a second endpoint, which must serve the same API, to be used for hedged requests.
+// When a GET request to the primary endpoint has not completed after delay, an identical request is sent to the
+// hedge endpoint and whichever returns a successful response first wins; the other request is canceled.
+// A delay <= 0 uses a default of 500ms.
+func WithHedging(host string, delay time.Duration) ClientOpt {
+	return func(c *Client) {
+		if delay <= 0 {
+			delay = defaultHedgeDelay
+		}
+		c.hedgeHost = host
+		c.hedgeDelay = delay
+	}
+}
@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+var testRetryConfig = RetryConfig{
+	MaxAttempts:    4,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     5 * time.Millisecond,
+}
+
+// failingServer responds with the given status code for the first `failures` requests, then 200 with body "ok".
+func failingServer(t *testing.T, failures int32, code int, count *int32) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := atomic.AddInt32(count, 1)
+		if n <= failures {
+			w.WriteHeader(code)
+			return
+		}
+		_, err := w.Write([]byte("ok"))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// slowServer waits for delay (or for the request to be canceled) before responding with body.
+func slowServer(t *testing.T, delay time.Duration, body string, count *int32) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(count, 1)
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		_, err := w.Write([]byte(body))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGet_RetriesTransientFailures(t *testing.T) {
+	var count int32
+	srv := failingServer(t, 2, http.StatusBadGateway, &count)
+	c, err := NewClient(srv.URL, WithRetry(testRetryConfig))
+	require.NoError(t, err)
+
+	b, err := c.Get(context.Background(), "/eth/v1/node/version")
+	require.NoError(t, err)
+	require.Equal(t, "ok", string(b))
+	require.Equal(t, int32(3), atomic.LoadInt32(&count))
+}
+
+func TestGet_GivesUpAfterMaxAttempts(t *testing.T) {
+	var count int32
+	srv := failingServer(t, 100, http.StatusServiceUnavailable, &count)
+	c, err := NewClient(srv.URL, WithRetry(testRetryConfig))
+	require.NoError(t, err)
+
+	_, err = c.Get(context.Background(), "/eth/v1/node/version")
+	require.ErrorIs(t, err, ErrServiceUnavailable)
+	require.ErrorIs(t, err, ErrServerError)
+	require.ErrorIs(t, err, ErrNotOK)
+	require.Equal(t, int32(testRetryConfig.MaxAttempts), atomic.LoadInt32(&count))
+}
+
+func TestGet_DoesNotRetryClientErrors(t *testing.T) {
+	var count int32
+	srv := failingServer(t, 100, http.StatusNotFound, &count)
+	c, err := NewClient(srv.URL, WithRetry(testRetryConfig))
+	require.NoError(t, err)
+
+	_, err = c.Get(context.Background(), "/eth/v1/node/version")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.ErrorIs(t, err, ErrClientError)
+	var se *StatusError
+	require.Equal(t, true, errors.As(err, &se))
+	require.Equal(t, http.StatusNotFound, se.Code)
+	require.Equal(t, int32(1), atomic.LoadInt32(&count))
+}
+
+func TestGet_NoRetryByDefault(t *testing.T) {
+	var count int32
+	srv := failingServer(t, 1, http.StatusBadGateway, &count)
+	c, err := NewClient(srv.URL)
+	require.NoError(t, err)
+
+	_, err = c.Get(context.Background(), "/eth/v1/node/version")
+	require.ErrorIs(t, err, ErrServerError)
+	require.Equal(t, int32(1), atomic.LoadInt32(&count))
+}
+
+func TestGet_RetriesConnectionErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	host := srv.URL
+	srv.Close()
+	c, err := NewClient(host, WithRetry(testRetryConfig))
+	require.NoError(t, err)
+
+	_, err = c.Get(context.Background(), "/eth/v1/node/version")
+	require.ErrorIs(t, err, ErrConnectionIssue)
+}
+
+func TestGet_RespectsContextDeadline(t *testing.T) {
+	var count int32
+	srv := failingServer(t, 100, http.StatusBadGateway, &count)
+	cfg := RetryConfig{MaxAttempts: 10, InitialBackoff: time.Second, MaxBackoff: time.Second}
+	c, err := NewClient(srv.URL, WithRetry(cfg))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err = c.Get(ctx, "/eth/v1/node/version")
+	require.ErrorIs(t, err, ErrServerError)
+	// The backoff can't fit before the deadline, so the client should give up right away instead of sleeping.
+	require.Equal(t, true, time.Since(start) < time.Second)
+	require.Equal(t, int32(1), atomic.LoadInt32(&count))
+}
+
+func TestGet_Hedging(t *testing.T) {
+	t.Run("hedge wins when primary is slow", func(t *testing.T) {
+		var pc, hc int32
+		primary := slowServer(t, 5*time.Second, "primary", &pc)
+		hedge := slowServer(t, 0, "hedge", &hc)
+		c, err := NewClient(primary.URL, WithHedging(hedge.URL, 10*time.Millisecond))
+		require.NoError(t, err)
+
+		b, err := c.Get(context.Background(), "/eth/v1/node/version")
+		require.NoError(t, err)
+		require.Equal(t, "hedge", string(b))
+		require.Equal(t, int32(1), atomic.LoadInt32(&pc))
+		require.Equal(t, int32(1), atomic.LoadInt32(&hc))
+	})
+	t.Run("hedge not sent when primary is fast", func(t *testing.T) {
+		var pc, hc int32
+		primary := slowServer(t, 0, "primary", &pc)
+		hedge := slowServer(t, 0, "hedge", &hc)
+		c, err := NewClient(primary.URL, WithHedging(hedge.URL, time.Second))
+		require.NoError(t, err)
+
+		b, err := c.Get(context.Background(), "/eth/v1/node/version")
+		require.NoError(t, err)
+		require.Equal(t, "primary", string(b))
+		require.Equal(t, int32(1), atomic.LoadInt32(&pc))
+		require.Equal(t, int32(0), atomic.LoadInt32(&hc))
+	})
+	t.Run("hedge sent immediately when primary fails", func(t *testing.T) {
+		var pc, hc int32
+		primary := failingServer(t, 100, http.StatusBadGateway, &pc)
+		hedge := slowServer(t, 0, "hedge", &hc)
+		c, err := NewClient(primary.URL, WithHedging(hedge.URL, 5*time.Second))
+		require.NoError(t, err)
+
+		start := time.Now()
+		b, err := c.Get(context.Background(), "/eth/v1/node/version")
+		require.NoError(t, err)
+		require.Equal(t, "hedge", string(b))
+		require.Equal(t, true, time.Since(start) < 5*time.Second)
+		require.Equal(t, int32(1), atomic.LoadInt32(&hc))
+	})
+	t.Run("primary error returned when both fail", func(t *testing.T) {
+		var pc, hc int32
+		primary := failingServer(t, 100, http.StatusServiceUnavailable, &pc)
+		hedge := failingServer(t, 100, http.StatusBadGateway, &hc)
+		c, err := NewClient(primary.URL, WithHedging(hedge.URL, time.Millisecond), WithRetry(testRetryConfig))
+		require.NoError(t, err)
+
+		_, err = c.Get(context.Background(), "/eth/v1/node/version")
+		require.ErrorIs(t, err, ErrServiceUnavailable)
+		require.Equal(t, int32(testRetryConfig.MaxAttempts), atomic.LoadInt32(&pc))
+		require.Equal(t, int32(testRetryConfig.MaxAttempts), atomic.LoadInt32(&hc))
+	})
+	t.Run("not found is not hedged", func(t *testing.T) {
+		var pc, hc int32
+		primary := failingServer(t, 100, http.StatusNotFound, &pc)
+		hedge := slowServer(t, 0, "hedge", &hc)
+		c, err := NewClient(primary.URL, WithHedging(hedge.URL, time.Second))
+		require.NoError(t, err)
+
+		_, err = c.Get(context.Background(), "/eth/v1/node/version")
+		require.ErrorIs(t, err, ErrNotFound)
+		require.Equal(t, int32(0), atomic.LoadInt32(&hc))
+	})
+}
+
+func TestWithHedging_InvalidHost(t *testing.T) {
+	_, err := NewClient("http://localhost:3500", WithHedging("mydomain.org", time.Second))
+	require.ErrorIs(t, err, ErrMalformedHostname)
+}
+
+func TestRetryConfig_Backoff(t *testing.T) {
+	cfg := RetryConfig{MaxAttempts: 10, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	gen := rand.NewDeterministicGenerator()
+	for i := 1; i < 10; i++ {
+		expected := cfg.InitialBackoff << uint(i-1)
+		if expected > cfg.MaxBackoff {
+			expected = cfg.MaxBackoff
+		}
+		d := cfg.backoff(i, gen)
+		require.Equal(t, true, d >= expected/2, "retry %d: backoff %s below lower bound", i, d)
+		require.Equal(t, true, d <= expected, "retry %d: backoff %s above upper bound", i, d)
+	}
+	require.Equal(t, time.Duration(0), RetryConfig{}.backoff(1, gen))
+	require.Equal(t, 1, RetryConfig{}.attempts())
+}
@@ -45,3 +45,23 @@ type IndexedVerificationFailure struct {
 	Index   int    `json:"index"`
 	Message string `json:"message"`
 }
+
+// PublishBlockError is the error returned when a submitted block could not be fully published. Stage and Reason are
+// Prysm extensions naming the step of the publishing pipeline that failed and why it failed.
+type PublishBlockError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Stage   string `json:"stage,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+func (e *PublishBlockError) StatusCode() int {
+	return e.Code
+}
+
+func (e *PublishBlockError) Error() string {
+	if e.Stage == "" {
+		return fmt.Sprintf("HTTP request unsuccessful (%d: %s)", e.Code, e.Message)
+	}
+	return fmt.Sprintf("HTTP request unsuccessful (%d: %s, stage %s: %s)", e.Code, e.Message, e.Stage, e.Reason)
+}
@@ -1,6 +1,7 @@
 package httprest
 
 import (
+	"os"
 	"time"
 
 	"net/http"
@@ -27,6 +28,16 @@ func WithHTTPAddr(addr string) Option {
 	}
 }
 
+// WithUnixSocket makes the server listen on the unix domain socket at path, with the given
+// file permissions, instead of the HTTP address.
+func WithUnixSocket(path string, perm os.FileMode) Option {
+	return func(g *Server) error {
+		g.cfg.unixSocketPath = path
+		g.cfg.unixSocketPermissions = perm
+		return nil
+	}
+}
+
 // WithRouter sets the internal router of the server, this is required.
 func WithRouter(r *http.ServeMux) Option {
 	return func(g *Server) error {
@@ -3,10 +3,12 @@ package httprest
 import (
 	"context"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/middleware"
+	"github.com/prysmaticlabs/prysm/v5/network"
 	"github.com/prysmaticlabs/prysm/v5/runtime"
 )
 
@@ -14,10 +16,12 @@ var _ runtime.Service = (*Server)(nil)
 
 // Config parameters for setting up the http-rest service.
 type config struct {
-	httpAddr    string
-	middlewares []middleware.Middleware
-	router      http.Handler
-	timeout     time.Duration
+	httpAddr              string
+	unixSocketPath        string
+	unixSocketPermissions os.FileMode
+	middlewares           []middleware.Middleware
+	router                http.Handler
+	timeout               time.Duration
 }
 
 // Server serves HTTP traffic.
@@ -63,6 +67,23 @@ func New(ctx context.Context, opts ...Option) (*Server, error) {
 func (g *Server) Start() {
 	g.ctx, g.cancel = context.WithCancel(g.ctx)
 
+	if g.cfg.unixSocketPath != "" {
+		lis, err := network.ListenUnix(g.cfg.unixSocketPath, g.cfg.unixSocketPermissions)
+		if err != nil {
+			log.WithError(err).Error("Failed to start HTTP server")
+			g.startFailure = err
+			return
+		}
+		go func() {
+			log.WithField("socket", g.cfg.unixSocketPath).Info("Starting HTTP server")
+			if err := g.server.Serve(lis); err != http.ErrServerClosed {
+				log.WithError(err).Error("Failed to start HTTP server")
+				g.startFailure = err
+			}
+		}()
+		return
+	}
+
 	go func() {
 		log.WithField("address", g.cfg.httpAddr).Info("Starting HTTP server")
 		if err := g.server.ListenAndServe(); err != http.ErrServerClosed {
@@ -4,13 +4,17 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/network"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -69,3 +73,35 @@ func TestServer_NilHandler_NotFoundHandlerRegistered(t *testing.T) {
 	g.cfg.router.ServeHTTP(writer, &http.Request{Method: "GET", Host: "localhost", URL: &url.URL{Path: "/foo"}})
 	assert.Equal(t, http.StatusNotFound, writer.Code)
 }
+
+func TestServer_UnixSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beacon.sock")
+	handler := http.NewServeMux()
+	handler.HandleFunc("/eth/v1/node/version", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte("prysm"))
+		require.NoError(t, err)
+	})
+
+	g, err := New(context.Background(), WithRouter(handler), WithUnixSocket(path, 0600))
+	require.NoError(t, err)
+	g.Start()
+	require.NoError(t, g.Status())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	baseURL, transport := network.HTTPBaseURLAndTransport(network.UnixSocketScheme + path)
+	client := &http.Client{Transport: transport}
+	resp, err := client.Get(baseURL + "/eth/v1/node/version")
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "prysm", string(body))
+
+	require.NoError(t, g.Stop())
+	_, err = os.Stat(path)
+	assert.Equal(t, true, os.IsNotExist(err))
+}
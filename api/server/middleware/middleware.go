@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"strings"
@@ -112,6 +113,27 @@ func AcceptHeaderHandler(serverAcceptedTypes []string) Middleware {
 	}
 }
 
+// BearerTokenHandler only lets through requests carrying the token in a "Authorization: Bearer <token>" header.
+// Requests are rejected with http.StatusForbidden when no token is configured.
+func BearerTokenHandler(token string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token == "" {
+				http.Error(w, "Endpoint is disabled: no admin token is configured", http.StatusForbidden)
+				return
+			}
+			authHeader := r.Header.Get("Authorization")
+			reqToken, ok := strings.CutPrefix(authHeader, "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(strings.TrimSpace(reqToken)), []byte(token)) != 1 {
+				http.Error(w, "Invalid or missing bearer token", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 func MiddlewareChain(h http.Handler, mw []Middleware) http.Handler {
 	if len(mw) < 1 {
 		return h
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
 
@@ -202,3 +203,63 @@ func TestAcceptHeaderHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestBearerTokenHandler(t *testing.T) {
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte("next handler"))
+		require.NoError(t, err)
+	})
+
+	tests := []struct {
+		name               string
+		token              string
+		authHeader         string
+		expectedStatusCode int
+	}{
+		{
+			name:               "Valid token",
+			token:              "secret",
+			authHeader:         "Bearer secret",
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:               "Wrong token",
+			token:              "secret",
+			authHeader:         "Bearer other",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "Missing Authorization header",
+			token:              "secret",
+			authHeader:         "",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "Not a bearer token",
+			token:              "secret",
+			authHeader:         "Basic secret",
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:               "No token configured",
+			token:              "",
+			authHeader:         "Bearer ",
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := BearerTokenHandler(tt.token)(nextHandler)
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatusCode, rr.Code)
+		})
+	}
+}
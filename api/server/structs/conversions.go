@@ -1476,6 +1476,43 @@ func DepositSnapshotFromConsensus(ds *eth.DepositSnapshot) *DepositSnapshot {
 	}
 }
 
+func (s *DepositSnapshot) ToConsensus() (*eth.DepositSnapshot, error) {
+	if s == nil {
+		return nil, errNilValue
+	}
+	finalized := make([][]byte, len(s.Finalized))
+	for i, f := range s.Finalized {
+		var err error
+		finalized[i], err = bytesutil.DecodeHexWithLength(f, fieldparams.RootLength)
+		if err != nil {
+			return nil, server.NewDecodeError(err, fmt.Sprintf("Finalized[%d]", i))
+		}
+	}
+	depositRoot, err := bytesutil.DecodeHexWithLength(s.DepositRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "DepositRoot")
+	}
+	depositCount, err := strconv.ParseUint(s.DepositCount, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "DepositCount")
+	}
+	executionHash, err := bytesutil.DecodeHexWithLength(s.ExecutionBlockHash, fieldparams.RootLength)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "ExecutionBlockHash")
+	}
+	executionHeight, err := strconv.ParseUint(s.ExecutionBlockHeight, 10, 64)
+	if err != nil {
+		return nil, server.NewDecodeError(err, "ExecutionBlockHeight")
+	}
+	return &eth.DepositSnapshot{
+		Finalized:      finalized,
+		DepositRoot:    depositRoot,
+		DepositCount:   depositCount,
+		ExecutionHash:  executionHash,
+		ExecutionDepth: executionHeight,
+	}, nil
+}
+
 func PendingDepositsFromConsensus(ds []*eth.PendingDeposit) []*PendingDeposit {
 	deposits := make([]*PendingDeposit, len(ds))
 	for i, d := range ds {
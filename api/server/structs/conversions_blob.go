@@ -3,6 +3,7 @@ package structs
 import (
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -59,3 +60,18 @@ func (sc *Sidecar) ToConsensus() (*eth.BlobSidecar, error) {
 
 	return bsc, nil
 }
+
+func SidecarFromConsensus(sc *eth.BlobSidecar) *Sidecar {
+	proofs := make([]string, len(sc.CommitmentInclusionProof))
+	for i := range sc.CommitmentInclusionProof {
+		proofs[i] = hexutil.Encode(sc.CommitmentInclusionProof[i])
+	}
+	return &Sidecar{
+		Index:                    strconv.FormatUint(sc.Index, 10),
+		Blob:                     hexutil.Encode(sc.Blob),
+		KzgCommitment:            hexutil.Encode(sc.KzgCommitment),
+		SignedBeaconBlockHeader:  SignedBeaconBlockHeaderFromConsensus(sc.SignedBlockHeader),
+		KzgProof:                 hexutil.Encode(sc.KzgProof),
+		CommitmentInclusionProof: proofs,
+	}
+}
@@ -1,6 +1,7 @@
 package structs
 
 import (
+	"bytes"
 	"testing"
 
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -24,3 +25,21 @@ func TestDepositSnapshotFromConsensus(t *testing.T) {
 	require.Equal(t, "0x1234", res.ExecutionBlockHash)
 	require.Equal(t, "67890", res.ExecutionBlockHeight)
 }
+
+func TestDepositSnapshot_ToConsensus(t *testing.T) {
+	ds := &eth.DepositSnapshot{
+		Finalized:      [][]byte{bytes.Repeat([]byte{0x01}, 32), bytes.Repeat([]byte{0x02}, 32)},
+		DepositRoot:    bytes.Repeat([]byte{0x03}, 32),
+		DepositCount:   12345,
+		ExecutionHash:  bytes.Repeat([]byte{0x04}, 32),
+		ExecutionDepth: 67890,
+	}
+	res, err := DepositSnapshotFromConsensus(ds).ToConsensus()
+	require.NoError(t, err)
+	require.DeepEqual(t, ds, res)
+
+	invalid := DepositSnapshotFromConsensus(ds)
+	invalid.DepositRoot = "0xabcd"
+	_, err = invalid.ToConsensus()
+	require.ErrorContains(t, "DepositRoot", err)
+}
@@ -249,3 +249,64 @@ type ChainHead struct {
 	PreviousJustifiedBlockRoot string `json:"previous_justified_block_root"`
 	OptimisticStatus           bool   `json:"optimistic_status"`
 }
+
+type GetValidatorProofResponse struct {
+	ExecutionOptimistic bool            `json:"execution_optimistic"`
+	Finalized           bool            `json:"finalized"`
+	Data                *ValidatorProof `json:"data"`
+}
+
+// ValidatorProof is a Merkle multiproof of validator fields against a state root,
+// together with the block header and the branch tying the state root to a block root.
+type ValidatorProof struct {
+	ValidatorIndex        string             `json:"validator_index"`
+	WithdrawalCredentials string             `json:"withdrawal_credentials"`
+	EffectiveBalance      string             `json:"effective_balance,omitempty"`
+	StateRoot             string             `json:"state_root"`
+	GeneralizedIndices    []string           `json:"generalized_indices"`
+	Leaves                []string           `json:"leaves"`
+	Proof                 []string           `json:"proof"`
+	Header                *BeaconBlockHeader `json:"header"`
+	BlockRoot             string             `json:"block_root"`
+	StateRootProof        []string           `json:"state_root_proof"`
+}
+
+// GetStateFieldsResponse holds the requested fields of a state, keyed by their names in the full state response.
+type GetStateFieldsResponse struct {
+	Version             string                     `json:"version"`
+	ExecutionOptimistic bool                       `json:"execution_optimistic"`
+	Finalized           bool                       `json:"finalized"`
+	Data                map[string]json.RawMessage `json:"data"`
+}
+
+type GetSlotOutcomesResponse struct {
+	Data []*SlotOutcome `json:"data"`
+}
+
+// SlotOutcome tells whether a slot has a canonical block, only orphaned blocks, or no block observed by the node.
+type SlotOutcome struct {
+	Slot          string   `json:"slot"`
+	Outcome       string   `json:"outcome"`
+	ProposerIndex string   `json:"proposer_index,omitempty"`
+	CanonicalRoot string   `json:"canonical_root,omitempty"`
+	OrphanedRoots []string `json:"orphaned_roots"`
+}
+
+type GetGraffitiStatsResponse struct {
+	Data []*EpochGraffitiStats `json:"data"`
+}
+
+// EpochGraffitiStats counts the canonical blocks of an epoch by the client matching their graffiti, and by graffiti
+// for the most frequent ones.
+type EpochGraffitiStats struct {
+	Epoch         string           `json:"epoch"`
+	Blocks        string           `json:"blocks"`
+	Clients       []*GraffitiCount `json:"clients"`
+	Graffiti      []*GraffitiCount `json:"graffiti"`
+	OtherGraffiti string           `json:"other_graffiti"`
+}
+
+type GraffitiCount struct {
+	Value string `json:"value"`
+	Count string `json:"count"`
+}
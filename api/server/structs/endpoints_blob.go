@@ -13,6 +13,11 @@ type Sidecar struct {
 	CommitmentInclusionProof []string                 `json:"kzg_commitment_inclusion_proof"`
 }
 
+type GetBlobSidecarByVersionedHashResponse struct {
+	BlockRoot string   `json:"block_root"`
+	Data      *Sidecar `json:"data"`
+}
+
 type BlobSidecars struct {
 	Sidecars []*Sidecar `json:"sidecars"`
 }
@@ -21,6 +21,12 @@ type ForkChoiceHead struct {
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
 }
 
+// ForkChoiceDumpSchemaVersion is the version of the schema of the fork choice dump, in the extra data of the dump
+// and in its summary. It is incremented when a field is removed or its meaning changes, not when a field is added.
+const ForkChoiceDumpSchemaVersion = "1"
+
+// GetForkChoiceDumpResponse is the response of /eth/v1/debug/fork_choice. The nodes are listed parents before their
+// children, and only include the nodes matching the filters of the request.
 type GetForkChoiceDumpResponse struct {
 	JustifiedCheckpoint *Checkpoint              `json:"justified_checkpoint"`
 	FinalizedCheckpoint *Checkpoint              `json:"finalized_checkpoint"`
@@ -28,14 +34,21 @@ type GetForkChoiceDumpResponse struct {
 	ExtraData           *ForkChoiceDumpExtraData `json:"extra_data"`
 }
 
+// ForkChoiceDumpExtraData holds the Prysm specific data of the fork choice dump.
 type ForkChoiceDumpExtraData struct {
+	SchemaVersion                 string      `json:"schema_version"`
 	UnrealizedJustifiedCheckpoint *Checkpoint `json:"unrealized_justified_checkpoint"`
 	UnrealizedFinalizedCheckpoint *Checkpoint `json:"unrealized_finalized_checkpoint"`
 	ProposerBoostRoot             string      `json:"proposer_boost_root"`
 	PreviousProposerBoostRoot     string      `json:"previous_proposer_boost_root"`
 	HeadRoot                      string      `json:"head_root"`
+	HeadSlot                      string      `json:"head_slot"`
+	// NodeCount is the number of nodes in fork choice, MatchingNodeCount the number of nodes matching the filters.
+	NodeCount         string `json:"node_count"`
+	MatchingNodeCount string `json:"matching_node_count"`
 }
 
+// ForkChoiceNode is a block of the fork choice dump. Validity is one of valid, invalid and optimistic.
 type ForkChoiceNode struct {
 	Slot               string                   `json:"slot"`
 	BlockRoot          string                   `json:"block_root"`
@@ -48,6 +61,7 @@ type ForkChoiceNode struct {
 	ExtraData          *ForkChoiceNodeExtraData `json:"extra_data"`
 }
 
+// ForkChoiceNodeExtraData holds the Prysm specific data of a node of the fork choice dump.
 type ForkChoiceNodeExtraData struct {
 	UnrealizedJustifiedEpoch string `json:"unrealized_justified_epoch"`
 	UnrealizedFinalizedEpoch string `json:"unrealized_finalized_epoch"`
@@ -55,3 +69,60 @@ type ForkChoiceNodeExtraData struct {
 	ExecutionOptimistic      bool   `json:"execution_optimistic"`
 	TimeStamp                string `json:"timestamp"`
 }
+
+// GetForkChoiceDumpSummaryResponse is the response of /eth/v1/debug/fork_choice in summary mode, which counts the
+// nodes matching the filters of the request instead of listing them.
+type GetForkChoiceDumpSummaryResponse struct {
+	SchemaVersion                 string      `json:"schema_version"`
+	HeadRoot                      string      `json:"head_root"`
+	HeadSlot                      string      `json:"head_slot"`
+	JustifiedCheckpoint           *Checkpoint `json:"justified_checkpoint"`
+	FinalizedCheckpoint           *Checkpoint `json:"finalized_checkpoint"`
+	UnrealizedJustifiedCheckpoint *Checkpoint `json:"unrealized_justified_checkpoint"`
+	UnrealizedFinalizedCheckpoint *Checkpoint `json:"unrealized_finalized_checkpoint"`
+	NodeCount                     string      `json:"node_count"`
+	MatchingNodeCount             string      `json:"matching_node_count"`
+	// MatchingLeafCount is the number of matching nodes without children, the heads of the matching branches.
+	MatchingLeafCount string `json:"matching_leaf_count"`
+}
+
+type GetForkChoiceSnapshotResponse struct {
+	HeadRoot            string                    `json:"head_root"`
+	JustifiedCheckpoint *Checkpoint               `json:"justified_checkpoint"`
+	FinalizedCheckpoint *Checkpoint               `json:"finalized_checkpoint"`
+	Nodes               []*ForkChoiceSnapshotNode `json:"nodes"`
+}
+
+type ForkChoiceSnapshotNode struct {
+	Root           string `json:"root"`
+	ParentRoot     string `json:"parent_root"`
+	Slot           string `json:"slot"`
+	Weight         string `json:"weight"`
+	Validity       string `json:"validity"`
+	JustifiedEpoch string `json:"justified_epoch"`
+	FinalizedEpoch string `json:"finalized_epoch"`
+	BestChild      string `json:"best_child"`
+	BestDescendant string `json:"best_descendant"`
+}
+
+type GetBlobSidecarVerificationsResponse struct {
+	BlockRoot string                     `json:"block_root"`
+	Data      []*BlobSidecarVerification `json:"data"`
+}
+
+type VerifyBlobSidecarResponse struct {
+	BlockRoot string                   `json:"block_root"`
+	Data      *BlobSidecarVerification `json:"data"`
+}
+
+type BlobSidecarVerification struct {
+	Index                  string   `json:"index"`
+	KzgCommitment          string   `json:"kzg_commitment"`
+	KzgProof               string   `json:"kzg_proof"`
+	Valid                  bool     `json:"valid"`
+	HeaderMatchesBlock     bool     `json:"header_matches_block"`
+	CommitmentMatchesBlock bool     `json:"commitment_matches_block"`
+	InclusionProofValid    bool     `json:"inclusion_proof_valid"`
+	KzgProofValid          bool     `json:"kzg_proof_valid"`
+	Errors                 []string `json:"errors"`
+}
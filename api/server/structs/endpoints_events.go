@@ -14,6 +14,14 @@ type HeadEvent struct {
 	CurrentDutyDependentRoot  string `json:"current_duty_dependent_root"`
 }
 
+// DutiesInvalidatedEvent is sent on Prysm's duties_invalidated topic when the duty dependent roots of the head change.
+type DutiesInvalidatedEvent struct {
+	Slot                      string `json:"slot"`
+	Epoch                     string `json:"epoch"`
+	PreviousDutyDependentRoot string `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  string `json:"current_duty_dependent_root"`
+}
+
 type BlockEvent struct {
 	Slot                string `json:"slot"`
 	Block               string `json:"block"`
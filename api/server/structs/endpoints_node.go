@@ -71,3 +71,123 @@ type AddrRequest struct {
 type PeersResponse struct {
 	Peers []*Peer `json:"peers"`
 }
+
+type GetAttestationSubnetStatsResponse struct {
+	Data *AttestationSubnetStats `json:"data"`
+}
+
+type AttestationSubnetStats struct {
+	DeliveryRatios []*SubnetDeliveryRatio `json:"delivery_ratios"`
+	Slots          []*SubnetSlotStats     `json:"slots"`
+}
+
+type SubnetDeliveryRatio struct {
+	Subnet string `json:"subnet"`
+	Ratio  string `json:"ratio"`
+}
+
+type SubnetSlotStats struct {
+	Slot              string `json:"slot"`
+	Subnet            string `json:"subnet"`
+	Received          string `json:"received"`
+	UniqueAttesters   string `json:"unique_attesters"`
+	ExpectedAttesters string `json:"expected_attesters"`
+	DeliveryRatio     string `json:"delivery_ratio"`
+	AggregateBits     string `json:"aggregate_bits"`
+}
+
+type GetProposalAttestationSourcesResponse struct {
+	Data []*ProposalAttestationSources `json:"data"`
+}
+
+type ProposalAttestationSources struct {
+	Slot          string                        `json:"slot"`
+	ProposerIndex string                        `json:"proposer_index"`
+	BlockRoot     string                        `json:"block_root"`
+	Attestations  []*IncludedAttestationSources `json:"attestations"`
+}
+
+type IncludedAttestationSources struct {
+	Slot             string                   `json:"slot"`
+	CommitteeIndex   string                   `json:"committee_index"`
+	Subnet           string                   `json:"subnet"`
+	Bits             string                   `json:"bits"`
+	UnattributedBits string                   `json:"unattributed_bits"`
+	Sources          []*AttestationSourceBits `json:"sources"`
+}
+
+type AttestationSourceBits struct {
+	Source          string `json:"source"`
+	AggregatorIndex string `json:"aggregator_index,omitempty"`
+	Bits            string `json:"bits"`
+}
+
+type GetEffectiveConfigResponse struct {
+	Data *EffectiveConfig `json:"data"`
+}
+
+type EffectiveConfig struct {
+	BeaconConfig  map[string]string `json:"beacon_config"`
+	NetworkConfig map[string]string `json:"network_config"`
+	Features      map[string]string `json:"features"`
+	Flags         map[string]string `json:"flags"`
+}
+
+type RefreshENRResponse struct {
+	Data *ENR `json:"data"`
+}
+
+type ENR struct {
+	Enr string `json:"enr"`
+	Seq string `json:"seq"`
+}
+
+type GetExecutionCapabilitiesResponse struct {
+	Data *ExecutionCapabilities `json:"data"`
+}
+
+type ExecutionCapabilities struct {
+	Exchanged    bool                   `json:"exchanged"`
+	Methods      []*EngineMethodSupport `json:"methods"`
+	Capabilities []string               `json:"capabilities"`
+}
+
+type EngineMethodSupport struct {
+	Method    string `json:"method"`
+	Supported bool   `json:"supported"`
+}
+
+type GetPeerScoresResponse struct {
+	Data []*PeerScores `json:"data"`
+}
+
+type PeerScores struct {
+	PeerId                    string        `json:"peer_id"`
+	Enr                       string        `json:"enr,omitempty"`
+	LastSeenP2PAddress        string        `json:"last_seen_p2p_address"`
+	State                     string        `json:"state"`
+	Direction                 string        `json:"direction"`
+	Client                    string        `json:"client"`
+	AgentVersion              string        `json:"agent_version"`
+	Score                     string        `json:"score"`
+	BadResponses              string        `json:"bad_responses"`
+	BadResponsesScore         string        `json:"bad_responses_score"`
+	ProcessedBlocks           string        `json:"processed_blocks"`
+	BlockProviderScore        string        `json:"block_provider_score"`
+	PeerStatusScore           string        `json:"peer_status_score"`
+	ChainStateValidationError string        `json:"chain_state_validation_error,omitempty"`
+	GossipScore               string        `json:"gossip_score"`
+	BehaviourPenalty          string        `json:"behaviour_penalty"`
+	TopicScores               []*TopicScore `json:"topic_scores"`
+	BadPeerReasons            []string      `json:"bad_peer_reasons"`
+	DisconnectReason          string        `json:"disconnect_reason,omitempty"`
+	DisconnectedAt            string        `json:"disconnected_at,omitempty"`
+}
+
+type TopicScore struct {
+	Topic                    string `json:"topic"`
+	TimeInMeshMs             string `json:"time_in_mesh_ms"`
+	FirstMessageDeliveries   string `json:"first_message_deliveries"`
+	MeshMessageDeliveries    string `json:"mesh_message_deliveries"`
+	InvalidMessageDeliveries string `json:"invalid_message_deliveries"`
+}
@@ -31,6 +31,7 @@ type IdealAttestationReward struct {
 	Head             string `json:"head"`
 	Target           string `json:"target"`
 	Source           string `json:"source"`
+	InclusionDelay   string `json:"inclusion_delay,omitempty"`
 	Inactivity       string `json:"inactivity"`
 }
 
@@ -39,6 +40,7 @@ type TotalAttestationReward struct {
 	Head           string `json:"head"`
 	Target         string `json:"target"`
 	Source         string `json:"source"`
+	InclusionDelay string `json:"inclusion_delay,omitempty"`
 	Inactivity     string `json:"inactivity"`
 }
 
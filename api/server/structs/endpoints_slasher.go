@@ -0,0 +1,9 @@
+package structs
+
+type IsSlashableAttestationResponse struct {
+	Data []*AttesterSlashing `json:"data"`
+}
+
+type IsSlashableBlockResponse struct {
+	Data []*ProposerSlashing `json:"data"`
+}
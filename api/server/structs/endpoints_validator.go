@@ -48,6 +48,10 @@ type AttesterDuty struct {
 	CommitteesAtSlot        string `json:"committees_at_slot"`
 	ValidatorCommitteeIndex string `json:"validator_committee_index"`
 	Slot                    string `json:"slot"`
+	// AttestationDeadlineMs and AggregationDeadlineMs are not part of the Beacon API. Distributed validator middlewares
+	// can set them to the time into the slot, in milliseconds, at which validators should attest and aggregate.
+	AttestationDeadlineMs string `json:"attestation_deadline_ms,omitempty"`
+	AggregationDeadlineMs string `json:"aggregation_deadline_ms,omitempty"`
 }
 
 type GetProposerDutiesResponse struct {
@@ -117,6 +121,10 @@ type GetValidatorPerformanceResponse struct {
 	BalancesAfterEpochTransition  []uint64 `json:"balances_after_epoch_transition,omitempty"`
 	MissingValidators             [][]byte `json:"missing_validators,omitempty"`
 	InactivityScores              []uint64 `json:"inactivity_scores,omitempty"`
+	// Epoch, NextPageToken and TotalSize are only set when the epoch or a page is requested.
+	Epoch         string `json:"epoch,omitempty"`
+	NextPageToken string `json:"next_page_token,omitempty"`
+	TotalSize     string `json:"total_size,omitempty"`
 }
 
 type GetValidatorParticipationResponse struct {
@@ -138,6 +146,29 @@ type ValidatorParticipation struct {
 	PreviousEpochHeadAttestingGwei   string `json:"previous_epoch_head_attesting_gwei"`
 }
 
+type GetOperationTotalsResponse struct {
+	IndexedSlot string                      `json:"indexed_slot"`
+	Data        []*ValidatorOperationTotals `json:"data"`
+}
+
+type ValidatorOperationTotals struct {
+	Index       string            `json:"index"`
+	Pubkey      string            `json:"pubkey"`
+	Deposits    *DepositTotals    `json:"deposits"`
+	Withdrawals *WithdrawalTotals `json:"withdrawals"`
+}
+
+type DepositTotals struct {
+	Count     string `json:"count"`
+	TotalGwei string `json:"total_gwei"`
+}
+
+type WithdrawalTotals struct {
+	Count              string `json:"count"`
+	TotalGwei          string `json:"total_gwei"`
+	LastWithdrawalSlot string `json:"last_withdrawal_slot"`
+}
+
 type ActiveSetChanges struct {
 	Epoch               string   `json:"epoch"`
 	ActivatedPublicKeys []string `json:"activated_public_keys"`
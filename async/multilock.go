@@ -15,6 +15,7 @@ limitations under the License.
 package async
 
 import (
+	"context"
 	"runtime"
 	"sort"
 )
@@ -51,6 +52,39 @@ func (lk *Lock) Lock() {
 	lk.unlock <- 1
 }
 
+// LockWithContext locks like Lock, unless the context is done before all the keys are acquired. The keys acquired so
+// far are then released and the context error is returned, in which case Unlock must not be called.
+func (lk *Lock) LockWithContext(ctx context.Context) error {
+	select {
+	case lk.lock <- 1:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	lk.chans = make([]chan byte, 0, len(lk.keys))
+	for i := 0; i < len(lk.keys); {
+		ch := getChan(lk.keys[i])
+		select {
+		case _, ok := <-ch:
+			if ok {
+				lk.chans = append(lk.chans, ch)
+				i++
+			}
+		case <-ctx.Done():
+			for _, acquired := range lk.chans {
+				acquired <- 1
+			}
+			lk.chans = nil
+			Clean()
+			<-lk.lock
+			return ctx.Err()
+		}
+	}
+
+	lk.unlock <- 1
+	return nil
+}
+
 // Unlock unlocks this lock. Must be called after Lock.
 // Can only be invoked if there is a previous call to Lock.
 func (lk *Lock) Unlock() {
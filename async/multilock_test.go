@@ -15,6 +15,7 @@ limitations under the License.
 package async
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
@@ -152,6 +153,25 @@ func TestLockUnlock_DoesNotCleanIfHeldElsewhere(t *testing.T) {
 	assert.Equal(t, 0, len(locks.list))
 }
 
+func TestLockWithContext(t *testing.T) {
+	held := NewMultilock("cat")
+	held.Lock()
+
+	// The lock on "ant", acquired first, is released when giving up on "cat".
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	lock := NewMultilock("cat", "ant")
+	assert.ErrorIs(t, lock.LockWithContext(ctx), context.DeadlineExceeded)
+	other := NewMultilock("ant")
+	assert.NoError(t, other.LockWithContext(context.Background()))
+	other.Unlock()
+
+	held.Unlock()
+	assert.NoError(t, lock.LockWithContext(context.Background()))
+	lock.Unlock()
+	assert.Equal(t, 0, len(locks.list))
+}
+
 func TestYield(t *testing.T) {
 	var wg sync.WaitGroup
 
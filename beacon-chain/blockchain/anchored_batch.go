@@ -0,0 +1,46 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+)
+
+// anchoredSignatureSamplePercent is the percentage of the blocks of an anchored batch whose proposer signature is
+// verified. At least one block of every batch is sampled.
+const anchoredSignatureSamplePercent = 5
+
+// ReceiveAnchoredBlockBatch processes a linear block batch like ReceiveBlockBatch, except that only the proposer
+// signatures of a random sample of the blocks are verified. All the other signatures of the batch are skipped.
+//
+// It must only be used for blocks of a chain shown to end at a finalized checkpoint agreed on by multiple peers. The
+// parent root of every block commits to the whole history before it, so that chain cannot be altered without breaking
+// the hash function, and the state transition still checks the state root of every block. The sampled signatures are
+// a safety net against peers agreeing on a checkpoint the proposers never signed. A failed sample is reported with
+// ErrSampledSignatureVerification and the caller should verify the following batches in full.
+func (s *Service) ReceiveAnchoredBlockBatch(ctx context.Context, blocks []blocks.ROBlock, avs das.AvailabilityStore) error {
+	return s.receiveBlockBatch(ctx, blocks, avs, true)
+}
+
+// sampleProposerSignatures returns the proposer signatures of a random sample of the given per block signature batches.
+func sampleProposerSignatures(sets []*bls.SignatureBatch) *bls.SignatureBatch {
+	n := (len(sets)*anchoredSignatureSamplePercent + 99) / 100
+	sample := bls.NewSet()
+	for _, i := range rand.NewGenerator().Perm(len(sets))[:n] {
+		set := sets[i]
+		for j, desc := range set.Descriptions {
+			if desc != signing.BlockSignature {
+				continue
+			}
+			sample.Signatures = append(sample.Signatures, set.Signatures[j])
+			sample.PublicKeys = append(sample.PublicKeys, set.PublicKeys[j])
+			sample.Messages = append(sample.Messages, set.Messages[j])
+			sample.Descriptions = append(sample.Descriptions, desc)
+		}
+	}
+	return sample
+}
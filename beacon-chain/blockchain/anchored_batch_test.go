@@ -0,0 +1,153 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// generateAnchoredBatch generates n signed blocks on top of the given state and returns them with the post state.
+func generateAnchoredBatch(t testing.TB, st state.BeaconState, keys []bls.SecretKey, conf *util.BlockGenConfig, n int) ([]*ethpb.SignedBeaconBlock, state.BeaconState) {
+	blks := make([]*ethpb.SignedBeaconBlock, n)
+	for i := range blks {
+		b, err := util.GenerateFullBlock(st, keys, conf, st.Slot()+1)
+		require.NoError(t, err)
+		wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(context.Background(), st, wsb)
+		require.NoError(t, err)
+		blks[i] = b
+	}
+	return blks, st
+}
+
+func toROBlocks(t testing.TB, blks []*ethpb.SignedBeaconBlock) []consensusblocks.ROBlock {
+	robs := make([]consensusblocks.ROBlock, len(blks))
+	for i, b := range blks {
+		wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		robs[i], err = consensusblocks.NewROBlock(wsb)
+		require.NoError(t, err)
+	}
+	return robs
+}
+
+func TestStore_OnBlockBatch_Anchored(t *testing.T) {
+	t.Run("valid batch", func(t *testing.T) {
+		service, tr := minimalTestService(t)
+		st, keys := util.DeterministicGenesisState(t, 64)
+		require.NoError(t, service.saveGenesisData(tr.ctx, st))
+		blks, _ := generateAnchoredBatch(t, st.Copy(), keys, util.DefaultBlockGenConfig(), 8)
+		require.NoError(t, service.onBlockBatch(tr.ctx, toROBlocks(t, blks), &das.MockAvailabilityStore{}, true))
+	})
+	t.Run("invalid proposer signatures", func(t *testing.T) {
+		service, tr := minimalTestService(t)
+		st, keys := util.DeterministicGenesisState(t, 64)
+		require.NoError(t, service.saveGenesisData(tr.ctx, st))
+		blks, _ := generateAnchoredBatch(t, st.Copy(), keys, util.DefaultBlockGenConfig(), 8)
+		// Every block carries the signature of another block, so any sample fails.
+		first := blks[0].Signature
+		for i := 0; i < len(blks)-1; i++ {
+			blks[i].Signature = blks[i+1].Signature
+		}
+		blks[len(blks)-1].Signature = first
+
+		err := service.onBlockBatch(tr.ctx, toROBlocks(t, blks), &das.MockAvailabilityStore{}, true)
+		require.ErrorIs(t, err, ErrSampledSignatureVerification)
+		err = service.onBlockBatch(tr.ctx, toROBlocks(t, blks), &das.MockAvailabilityStore{}, false)
+		require.ErrorContains(t, "batch block signature verification failed", err)
+	})
+}
+
+func TestSampleProposerSignatures(t *testing.T) {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	newSets := func(n int) []*bls.SignatureBatch {
+		sets := make([]*bls.SignatureBatch, n)
+		for i := range sets {
+			sets[i] = &bls.SignatureBatch{
+				Signatures:   [][]byte{{byte(i)}, {byte(i)}},
+				PublicKeys:   []bls.PublicKey{key.PublicKey(), key.PublicKey()},
+				Messages:     [][32]byte{{byte(i)}, {byte(i)}},
+				Descriptions: []string{signing.BlockSignature, signing.RandaoSignature},
+			}
+		}
+		return sets
+	}
+
+	for _, tt := range []struct {
+		blocks int
+		want   int
+	}{
+		{blocks: 1, want: 1},
+		{blocks: 3, want: 1},
+		{blocks: 64, want: 4},
+		{blocks: 100, want: 5},
+	} {
+		sample := sampleProposerSignatures(newSets(tt.blocks))
+		require.Equal(t, tt.want, len(sample.Signatures))
+		seen := make(map[byte]bool)
+		for i, desc := range sample.Descriptions {
+			require.Equal(t, signing.BlockSignature, desc)
+			require.Equal(t, sample.Signatures[i][0], sample.Messages[i][0])
+			require.Equal(t, false, seen[sample.Messages[i][0]], "block sampled twice")
+			seen[sample.Messages[i][0]] = true
+		}
+	}
+}
+
+// BenchmarkBatchSignatureVerification compares the signature verification of a batch of 64 blocks, the default
+// initial sync batch size, block by block, in a single batch and in anchored mode.
+func BenchmarkBatchSignatureVerification(b *testing.B) {
+	ctx := context.Background()
+	st, keys := util.DeterministicGenesisState(b, 64)
+	blks, _ := generateAnchoredBatch(b, st.Copy(), keys, util.DefaultBlockGenConfig(), 64)
+
+	sets := make([]*bls.SignatureBatch, len(blks))
+	preState := st.Copy()
+	for i, blk := range toROBlocks(b, blks) {
+		var err error
+		sets[i], preState, err = transition.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, blk)
+		require.NoError(b, err)
+	}
+
+	b.Run("per block", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, s := range sets {
+				verified, err := s.Verify()
+				require.NoError(b, err)
+				require.Equal(b, true, verified)
+			}
+		}
+	})
+	b.Run("full", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			set := bls.NewSet()
+			for _, s := range sets {
+				set.Join(s)
+			}
+			verified, err := set.Verify()
+			require.NoError(b, err)
+			require.Equal(b, true, verified)
+		}
+	})
+	b.Run("anchored", func(b *testing.B) {
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			verified, err := sampleProposerSignatures(sets).Verify()
+			require.NoError(b, err)
+			require.Equal(b, true, verified)
+		}
+	})
+}
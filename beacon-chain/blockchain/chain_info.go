@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	f "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -46,7 +47,8 @@ type ForkchoiceFetcher interface {
 	HighestReceivedBlockSlot() primitives.Slot
 	ReceivedBlocksLastEpoch() (uint64, error)
 	InsertNode(context.Context, state.BeaconState, consensus_blocks.ROBlock) error
-	ForkChoiceDump(context.Context) (*forkchoice.Dump, error)
+	ForkChoiceDump(context.Context, *forkchoice.DumpFilter) (*forkchoice.Dump, error)
+	ForkChoiceSnapshot(context.Context) (*forkchoice.Snapshot, error)
 	NewSlot(context.Context, primitives.Slot) error
 	ProposerBoost() [32]byte
 	RecentBlockSlot(root [32]byte) (primitives.Slot, error)
@@ -517,18 +519,11 @@ func (s *Service) SetGenesisTime(t time.Time) {
 }
 
 func (s *Service) recoverStateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.StateSummary, error) {
-	if s.cfg.BeaconDB.HasBlock(ctx, blockRoot) {
-		b, err := s.cfg.BeaconDB.Block(ctx, blockRoot)
-		if err != nil {
-			return nil, err
-		}
-		summary := &ethpb.StateSummary{Slot: b.Block().Slot(), Root: blockRoot[:]}
-		if err := s.cfg.BeaconDB.SaveStateSummary(ctx, summary); err != nil {
-			return nil, err
-		}
-		return summary, nil
+	summary, err := s.cfg.BeaconDB.BackfillStateSummary(ctx, blockRoot)
+	if errors.Is(err, db.ErrNotFound) {
+		return nil, errBlockDoesNotExist
 	}
-	return nil, errBlockDoesNotExist
+	return summary, err
 }
 
 // BlockBeingSynced returns whether the block with the given root is currently being synced
@@ -52,10 +52,17 @@ func (s *Service) InsertNode(ctx context.Context, st state.BeaconState, block co
 }
 
 // ForkChoiceDump returns the corresponding value from forkchoice
-func (s *Service) ForkChoiceDump(ctx context.Context) (*forkchoice.Dump, error) {
+func (s *Service) ForkChoiceDump(ctx context.Context, filter *forkchoice.DumpFilter) (*forkchoice.Dump, error) {
 	s.cfg.ForkChoiceStore.RLock()
 	defer s.cfg.ForkChoiceStore.RUnlock()
-	return s.cfg.ForkChoiceStore.ForkChoiceDump(ctx)
+	return s.cfg.ForkChoiceStore.ForkChoiceDump(ctx, filter)
+}
+
+// ForkChoiceSnapshot returns a snapshot of all the forkchoice nodes, taken under the forkchoice read lock.
+func (s *Service) ForkChoiceSnapshot(ctx context.Context) (*forkchoice.Snapshot, error) {
+	s.cfg.ForkChoiceStore.RLock()
+	defer s.cfg.ForkChoiceStore.RUnlock()
+	return s.cfg.ForkChoiceStore.Snapshot(ctx)
 }
 
 // NewSlot returns the corresponding value from forkchoice
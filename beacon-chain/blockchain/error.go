@@ -30,6 +30,8 @@ var (
 	ErrNotCheckpoint = errors.New("not a checkpoint in forkchoice")
 	// ErrNilHead is returned when no head is present in the blockchain service.
 	ErrNilHead = errors.New("nil head")
+	// ErrSampledSignatureVerification is returned when a proposer signature sampled from an anchored block batch is invalid.
+	ErrSampledSignatureVerification = errors.New("sampled proposer signature verification failed")
 )
 
 var errMaxBlobsExceeded = errors.New("Expected commitments in block exceeds MAX_BLOBS_PER_BLOCK")
@@ -10,6 +10,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations/kv"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -413,6 +414,9 @@ func (s *Service) saveOrphanedOperations(ctx context.Context, orphanedRoot [32]b
 					return err
 				}
 			}
+			if err := s.cfg.AttPool.SaveAttestationProvenance(a, kv.SourceOrphanedBlock, 0); err != nil {
+				log.WithError(err).Debug("Could not save attestation provenance")
+			}
 			saveOrphanedAttCount.Inc()
 		}
 		for _, as := range orphanedBlk.Block().Body().AttesterSlashings() {
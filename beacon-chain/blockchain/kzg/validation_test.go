@@ -9,6 +9,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	GoKZG "github.com/crate-crypto/go-kzg-4844"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/sirupsen/logrus"
 )
@@ -82,3 +83,58 @@ func TestGenerateCommitmentAndProof(t *testing.T) {
 	require.Equal(t, expectedCommitment, commitment)
 	require.Equal(t, expectedProof, proof)
 }
+
+func generateValidSidecars(t testing.TB, n int) []blocks.ROBlob {
+	sidecars := make([]blocks.ROBlob, n)
+	for i := range sidecars {
+		blob := GetRandBlob(int64(i))
+		commitment, proof, err := GenerateCommitmentAndProof(blob)
+		require.NoError(t, err)
+		sc, err := blocks.NewROBlobWithRoot(&ethpb.BlobSidecar{
+			Index:         uint64(i),
+			Blob:          blob[:],
+			KzgCommitment: commitment[:],
+			KzgProof:      proof[:],
+			SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{
+				Header:    &ethpb.BeaconBlockHeader{},
+				Signature: make([]byte, 96),
+			},
+		}, [32]byte{})
+		require.NoError(t, err)
+		sidecars[i] = sc
+	}
+	return sidecars
+}
+
+func TestVerify_Batch(t *testing.T) {
+	sidecars := generateValidSidecars(t, 6)
+	require.NoError(t, Verify(sidecars...))
+
+	// Swap the proofs of two sidecars so that the batch contains invalid proofs.
+	sidecars[2].KzgProof, sidecars[3].KzgProof = sidecars[3].KzgProof, sidecars[2].KzgProof
+	require.NotNil(t, Verify(sidecars...))
+	require.NoError(t, Verify(sidecars[0]))
+	require.NotNil(t, Verify(sidecars[2]))
+}
+
+func BenchmarkVerify_SixBlobsIndividually(b *testing.B) {
+	sidecars := generateValidSidecars(b, 6)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range sidecars {
+			if err := Verify(sidecars[j]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkVerify_SixBlobsBatch(b *testing.B) {
+	sidecars := generateValidSidecars(b, 6)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := Verify(sidecars...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
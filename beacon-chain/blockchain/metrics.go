@@ -214,6 +214,14 @@ var (
 			Buckets: []float64{1, 2, 4, 8, 16, 32, 64},
 		},
 	)
+	proposalPreparationStepTime = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "proposal_preparation_step_milliseconds",
+			Help:    "Captures latency of each step of preparing for a proposal by a tracked validator in the next slot",
+			Buckets: []float64{1, 5, 20, 100, 500, 1000},
+		},
+		[]string{"step"},
+	)
 	reorgDepth = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "reorg_depth",
@@ -85,6 +85,30 @@ func WithTrackedValidatorsCache(c *cache.TrackedValidatorsCache) Option {
 	}
 }
 
+// WithSlotObservations for recording the blocks and proposers observed for recent slots.
+func WithSlotObservations(o *cache.SlotObservations) Option {
+	return func(s *Service) error {
+		s.cfg.SlotObservations = o
+		return nil
+	}
+}
+
+// WithValidatorLiveness for recording the validators observed proposing blocks and attesting in blocks.
+func WithValidatorLiveness(l *cache.ValidatorLiveness) Option {
+	return func(s *Service) error {
+		s.cfg.ValidatorLiveness = l
+		return nil
+	}
+}
+
+// WithAttestationCache for attestation consensus data cache.
+func WithAttestationCache(c *cache.AttestationCache) Option {
+	return func(s *Service) error {
+		s.cfg.AttestationCache = c
+		return nil
+	}
+}
+
 // WithAttestationPool for attestation lifecycle after chain inclusion.
 func WithAttestationPool(p attestations.Pool) Option {
 	return func(s *Service) error {
@@ -205,3 +229,13 @@ func WithSyncChecker(checker Checker) Option {
 		return nil
 	}
 }
+
+// WithOperationTotalsIndex enables the operation totals index, using the given reconstructor for the execution
+// payloads of blinded blocks.
+func WithOperationTotalsIndex(r db.PayloadReconstructor) Option {
+	return func(s *Service) error {
+		s.cfg.OperationTotalsIndex = true
+		s.cfg.PayloadReconstructor = r
+		return nil
+	}
+}
@@ -78,9 +78,12 @@ func (s *Service) getAttPreState(ctx context.Context, c *ethpb.Checkpoint) (stat
 	}
 	// Use a multilock to allow scoped holding of a mutex by a checkpoint root + epoch
 	// allowing us to behave smarter in terms of how this function is used concurrently.
+	// Callers waiting for the state to be regenerated by another call give up when their context is done.
 	epochKey := strconv.FormatUint(uint64(c.Epoch), 10 /* base 10 */)
 	lock := async.NewMultilock(string(c.Root) + epochKey)
-	lock.Lock()
+	if err := lock.LockWithContext(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not wait for attestation pre state")
+	}
 	defer lock.Unlock()
 	cachedState, err := s.checkpointStateCache.StateByCheckpoint(c)
 	if err != nil {
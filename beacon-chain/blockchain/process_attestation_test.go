@@ -2,10 +2,13 @@ package blockchain
 
 import (
 	"context"
+	"runtime"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v5/async"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
@@ -236,6 +239,45 @@ func TestService_GetAttPreState_Concurrency(t *testing.T) {
 	}
 }
 
+func TestService_GetAttPreState_StalledRegeneration(t *testing.T) {
+	service, _ := minimalTestService(t)
+	cp := &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'A'}, fieldparams.RootLength)}
+	// A regeneration of the checkpoint state which never completes holds the lock of the checkpoint.
+	stalled := async.NewMultilock(string(cp.Root) + strconv.FormatUint(uint64(cp.Epoch), 10))
+	stalled.Lock()
+	defer stalled.Unlock()
+
+	baseline := runtime.NumGoroutine()
+	var wg sync.WaitGroup
+	errs := make(chan error, 1000)
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			_, err := service.getAttPreState(ctx, cp)
+			errs <- err
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Callers waiting for a stalled regeneration did not give up")
+	}
+	close(errs)
+	for err := range errs {
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	}
+	// Every waiting goroutine returned rather than piling up behind the stalled regeneration.
+	assert.Equal(t, true, runtime.NumGoroutine() <= baseline+1)
+}
+
 func TestStore_SaveCheckpointState(t *testing.T) {
 	service, tr := minimalTestService(t)
 	ctx := tr.ctx
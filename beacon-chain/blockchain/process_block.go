@@ -78,6 +78,12 @@ func (s *Service) postBlockProcess(cfg *postBlockProcessConfig) error {
 	if err != nil {
 		return errors.Wrapf(err, "could not insert block %d to fork choice store", cfg.roblock.Block().Slot())
 	}
+	if s.cfg.SlotObservations != nil {
+		s.cfg.SlotObservations.AddBlock(cfg.roblock.Block().Slot(), cfg.roblock.Block().ProposerIndex(), cfg.roblock.Root())
+	}
+	if s.cfg.ValidatorLiveness != nil {
+		s.cfg.ValidatorLiveness.MarkLive(slots.ToEpoch(cfg.roblock.Block().Slot()), cfg.roblock.Block().ProposerIndex())
+	}
 	if err := s.handleBlockAttestations(ctx, cfg.roblock.Block(), cfg.postState); err != nil {
 		return errors.Wrap(err, "could not handle block's attestations")
 	}
@@ -127,7 +133,9 @@ func getStateVersionAndPayload(st state.BeaconState) (int, interfaces.ExecutionD
 	return preStateVersion, preStateHeader, nil
 }
 
-func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlock, avs das.AvailabilityStore) error {
+// onBlockBatch transitions the state through a linear batch of blocks and verifies their signatures in a single
+// batch. Anchored batches only have the proposer signatures of a sample of their blocks verified.
+func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlock, avs das.AvailabilityStore, anchored bool) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.onBlockBatch")
 	defer span.End()
 
@@ -160,6 +168,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 	jCheckpoints := make([]*ethpb.Checkpoint, len(blks))
 	fCheckpoints := make([]*ethpb.Checkpoint, len(blks))
 	sigSet := bls.NewSet()
+	blockSets := make([]*bls.SignatureBatch, len(blks))
 	type versionAndHeader struct {
 		version int
 		header  interfaces.ExecutionData
@@ -197,20 +206,28 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 			version: v,
 			header:  h,
 		}
+		blockSets[i] = set
 		sigSet.Join(set)
 	}
 
+	// The state transition above skips all signature checks, the signatures of the whole batch are only verified here.
 	var verify bool
-	if features.Get().EnableVerboseSigVerification {
+	switch {
+	case anchored:
+		verify, err = sampleProposerSignatures(blockSets).Verify()
+	case features.Get().EnableVerboseSigVerification:
 		verify, err = sigSet.VerifyVerbosely()
-	} else {
+	default:
 		verify, err = sigSet.Verify()
 	}
 	if err != nil {
 		return invalidBlock{error: err}
 	}
 	if !verify {
-		return errors.New("batch block signature verification failed")
+		if anchored {
+			return errors.Wrapf(ErrSampledSignatureVerification, "batch starting at slot %d", blks[0].Block().Slot())
+		}
+		return invalidBatchSignatureError(blks, blockSets)
 	}
 
 	// blocks have been verified, save them and call the engine
@@ -298,6 +315,18 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 	return s.saveHeadNoDB(ctx, lastB, lastBR, preState, !isValidPayload)
 }
 
+// invalidBatchSignatureError verifies the signatures of each block of a batch that failed batch verification on their
+// own, and returns an error identifying the first block with an invalid signature.
+func invalidBatchSignatureError(blks []consensusblocks.ROBlock, blockSets []*bls.SignatureBatch) error {
+	for i, set := range blockSets {
+		verify, err := set.Verify()
+		if err != nil || !verify {
+			return fmt.Errorf("batch block signature verification failed: invalid signature in block at slot %d with root %#x", blks[i].Block().Slot(), blks[i].Root())
+		}
+	}
+	return errors.New("batch block signature verification failed")
+}
+
 func (s *Service) updateEpochBoundaryCaches(ctx context.Context, st state.BeaconState) error {
 	e := coreTime.CurrentEpoch(st)
 	if err := helpers.UpdateCommitteeCache(ctx, st, e); err != nil {
@@ -372,6 +401,13 @@ func (s *Service) handleBlockAttestations(ctx context.Context, blk interfaces.Re
 		if err != nil {
 			return err
 		}
+		if s.cfg.ValidatorLiveness != nil {
+			live := make([]primitives.ValidatorIndex, len(indices))
+			for i, index := range indices {
+				live[i] = primitives.ValidatorIndex(index)
+			}
+			s.cfg.ValidatorLiveness.MarkLive(a.GetData().Target.Epoch, live...)
+		}
 		r := bytesutil.ToBytes32(a.GetData().BeaconBlockRoot)
 		if s.cfg.ForkChoiceStore.HasNode(r) {
 			s.cfg.ForkChoiceStore.ProcessAttestation(ctx, indices, r, a.GetData().Target.Epoch)
@@ -637,38 +673,35 @@ func (s *Service) lateBlockTasks(ctx context.Context) {
 	if err := s.handleEpochBoundary(ctx, currentSlot, headState, headRoot[:]); err != nil {
 		log.WithError(err).Error("lateBlockTasks: could not update epoch boundary caches")
 	}
-	// return early if we already started building a block for the current
-	// head root
-	_, has := s.cfg.PayloadIDCache.PayloadID(s.CurrentSlot()+1, headRoot)
-	if has {
-		return
-	}
-
-	attribute := s.getPayloadAttribute(ctx, headState, s.CurrentSlot()+1, headRoot[:])
-	// return early if we are not proposing next slot
-	if attribute.IsEmpty() {
-		return
+	s.recordSlotProposer(ctx, currentSlot, headRoot, headState)
+	if err := s.preparePayload(ctx, headRoot, headState); err != nil {
+		log.WithError(err).Debug("could not perform late block tasks")
 	}
+}
 
-	s.headLock.RLock()
-	headBlock, err := s.headBlock()
-	if err != nil {
-		s.headLock.RUnlock()
-		log.WithError(err).Debug("could not perform late block tasks: failed to retrieve head block")
+// recordSlotProposer records the proposer assigned to a slot without a block by the time of the late block tasks,
+// so that the slot can be reported as missed by its proposer if no block shows up.
+func (s *Service) recordSlotProposer(ctx context.Context, slot primitives.Slot, headRoot [32]byte, headState state.BeaconState) {
+	if s.cfg.SlotObservations == nil || headState == nil || headState.IsNil() {
 		return
 	}
-	s.headLock.RUnlock()
-
-	fcuArgs := &fcuConfig{
-		headState:  headState,
-		headRoot:   headRoot,
-		headBlock:  headBlock,
-		attributes: attribute,
+	st := state.ReadOnlyBeaconState(headState)
+	if slots.ToEpoch(slot) > slots.ToEpoch(headState.Slot()) {
+		// The shuffling of the new epoch requires processing the epoch transition, which is cheap as the next slot
+		// cache was just updated.
+		advanced, err := transition.ProcessSlotsUsingNextSlotCache(ctx, headState.Copy(), headRoot[:], slot)
+		if err != nil {
+			log.WithError(err).Debug("Could not process slots to record the slot proposer")
+			return
+		}
+		st = advanced
 	}
-	_, err = s.notifyForkchoiceUpdate(ctx, fcuArgs)
+	proposer, err := helpers.BeaconProposerIndexAtSlot(ctx, st, slot)
 	if err != nil {
-		log.WithError(err).Debug("could not perform late block tasks: failed to update forkchoice with engine")
+		log.WithError(err).Debug("Could not record the slot proposer")
+		return
 	}
+	s.cfg.SlotObservations.SetProposer(slot, proposer)
 }
 
 // waitForSync blocks until the node is synced to the head.
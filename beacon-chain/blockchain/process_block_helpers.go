@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -411,9 +412,24 @@ func (s *Service) updateFinalized(ctx context.Context, cp *ethpb.Checkpoint) err
 			log.WithError(err).Error("could not migrate to cold")
 		}
 	}()
+	if s.cfg.OperationTotalsIndex {
+		go s.indexOperationTotals()
+	}
 	return nil
 }
 
+// indexOperationTotals adds the newly finalized blocks to the operation totals index. Finalizations happening
+// while a previous run is in progress are picked up by that run or by the next one.
+func (s *Service) indexOperationTotals() {
+	if !s.indexingOperationTotals.CompareAndSwap(false, true) {
+		return
+	}
+	defer s.indexingOperationTotals.Store(false)
+	if _, err := db.IndexOperationTotals(s.ctx, s.cfg.BeaconDB, s.cfg.PayloadReconstructor, nil); err != nil {
+		log.WithError(err).Error("Could not index operation totals")
+	}
+}
+
 // This retrieves an ancestor root using DB. The look up is recursively looking up DB. Slower than `ancestorByForkChoiceStore`.
 func (s *Service) ancestorByDB(ctx context.Context, r [32]byte, slot primitives.Slot) (root [32]byte, err error) {
 	ctx, span := trace.StartSpan(ctx, "blockChain.ancestorByDB")
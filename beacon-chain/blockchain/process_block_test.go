@@ -68,7 +68,7 @@ func TestStore_OnBlockBatch(t *testing.T) {
 		require.NoError(t, err)
 		blks = append(blks, rwsb)
 	}
-	err := service.onBlockBatch(ctx, blks, &das.MockAvailabilityStore{})
+	err := service.onBlockBatch(ctx, blks, &das.MockAvailabilityStore{}, false)
 	require.NoError(t, err)
 	jcp := service.CurrentJustifiedCheckpt()
 	jroot := bytesutil.ToBytes32(jcp.Root)
@@ -76,6 +76,21 @@ func TestStore_OnBlockBatch(t *testing.T) {
 	require.Equal(t, primitives.Epoch(2), service.cfg.ForkChoiceStore.JustifiedCheckpoint().Epoch)
 }
 
+func TestStore_OnBlockBatch_InvalidSignature(t *testing.T) {
+	service, tr := minimalTestService(t)
+	st, keys := util.DeterministicGenesisState(t, 64)
+	require.NoError(t, service.saveGenesisData(tr.ctx, st))
+	blks, _ := generateAnchoredBatch(t, st.Copy(), keys, util.DefaultBlockGenConfig(), 8)
+	// Only the proposer signature of the fourth block is invalid.
+	blks[3].Signature = blks[4].Signature
+
+	robs := toROBlocks(t, blks)
+	err := service.onBlockBatch(tr.ctx, robs, &das.MockAvailabilityStore{}, false)
+	require.ErrorContains(t, fmt.Sprintf("invalid signature in block at slot %d with root %#x", robs[3].Block().Slot(), robs[3].Root()), err)
+	var invalidBlockErr invalidBlockError
+	require.Equal(t, false, errors.As(err, &invalidBlockErr), "signature failures must not mark the block root invalid")
+}
+
 func TestStore_OnBlockBatch_NotifyNewPayload(t *testing.T) {
 	service, tr := minimalTestService(t)
 	ctx := tr.ctx
@@ -98,7 +113,7 @@ func TestStore_OnBlockBatch_NotifyNewPayload(t *testing.T) {
 		require.NoError(t, service.saveInitSyncBlock(ctx, rwsb.Root(), wsb))
 		blks = append(blks, rwsb)
 	}
-	require.NoError(t, service.onBlockBatch(ctx, blks, &das.MockAvailabilityStore{}))
+	require.NoError(t, service.onBlockBatch(ctx, blks, &das.MockAvailabilityStore{}, false))
 }
 
 func TestCachedPreState_CanGetFromStateSummary(t *testing.T) {
@@ -2009,7 +2024,7 @@ func TestNoViableHead_Reboot(t *testing.T) {
 	rwsb, err := consensusblocks.NewROBlock(wsb)
 	require.NoError(t, err)
 	// We use onBlockBatch here because the valid chain is missing in forkchoice
-	require.NoError(t, service.onBlockBatch(ctx, []consensusblocks.ROBlock{rwsb}, &das.MockAvailabilityStore{}))
+	require.NoError(t, service.onBlockBatch(ctx, []consensusblocks.ROBlock{rwsb}, &das.MockAvailabilityStore{}, false))
 	// Check that the head is now VALID and the node is not optimistic
 	require.Equal(t, genesisRoot, service.ensureRootNotZeros(service.cfg.ForkChoiceStore.CachedHeadRoot()))
 	headRoot, err = service.HeadRoot(ctx)
@@ -0,0 +1,190 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// Steps of the proposal preparation routine, used to label the proposal_preparation_step_milliseconds metric.
+const (
+	preparationStepParentState       = "parent_state"
+	preparationStepEpochCaches       = "epoch_caches"
+	preparationStepPayloadAttributes = "payload_attributes"
+)
+
+// runProposalPreparation prepares for proposals by validators connected to this node. It runs three quarters
+// of the way into every slot, once the head for the slot has most likely settled, so that the work needed to
+// propose in the next slot is done ahead of time instead of on the critical path at the start of that slot.
+// The routine is only started with the --prepare-proposals flag, as it copies the head state every slot.
+func (s *Service) runProposalPreparation() {
+	if err := s.waitForSync(); err != nil {
+		log.WithError(err).Error("failed to wait for initial sync")
+		return
+	}
+
+	offset := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second * 3 / 4
+	ticker := slots.NewSlotTickerWithOffset(s.genesisTime, offset, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case <-ticker.C():
+			s.prepareProposal(s.ctx)
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// prepareProposal does the preparatory work for a proposal in the next slot, if the proposer is a validator
+// connected to this node:
+//   - the head state, advanced to the proposal slot, is placed in the next slot cache,
+//   - the committee and proposer caches for the proposal epoch are populated,
+//   - the payload attributes, including the expected withdrawals, are computed and sent to the execution
+//     client so that it starts building the payload.
+//
+// Each step is close to free if its work was already done, for instance when the head block arrived on time.
+func (s *Service) prepareProposal(ctx context.Context) {
+	ctx, span := trace.StartSpan(ctx, "blockChain.prepareProposal")
+	defer span.End()
+
+	if !s.inRegularSync() {
+		return
+	}
+	slot := s.CurrentSlot() + 1
+	s.cfg.ForkChoiceStore.RLock()
+	defer s.cfg.ForkChoiceStore.RUnlock()
+	s.headLock.RLock()
+	if s.head == nil || s.head.state == nil {
+		s.headLock.RUnlock()
+		return
+	}
+	headRoot := s.headRoot()
+	headState := s.headState(ctx)
+	s.headLock.RUnlock()
+	if headState.Slot() >= slot {
+		return
+	}
+
+	// When the proposal slot is in the same epoch as the head state, the proposer can be looked up cheaply,
+	// so we don't do any work for slots that aren't proposed by a tracked validator. Otherwise, the state has
+	// to be advanced into the new epoch first to know the proposer.
+	sameEpoch := slots.ToEpoch(slot) == slots.ToEpoch(headState.Slot())
+	if sameEpoch {
+		if _, ok := s.trackedProposer(headState, slot); !ok {
+			return
+		}
+	}
+	logFields := logrus.Fields{
+		"slot":     slot,
+		"headRoot": fmt.Sprintf("%#x", headRoot),
+	}
+
+	var st state.BeaconState
+	err := timePreparationStep(preparationStepParentState, func() error {
+		var err error
+		st, err = advanceNextSlotCache(ctx, headRoot, headState, slot)
+		return err
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logFields).Error("Could not prepare parent state for proposal")
+		return
+	}
+	if !sameEpoch {
+		if _, ok := s.trackedProposer(st, slot); !ok {
+			return
+		}
+	}
+	err = timePreparationStep(preparationStepEpochCaches, func() error {
+		return warmProposalEpochCaches(ctx, st, slots.ToEpoch(slot))
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logFields).Error("Could not prepare epoch caches for proposal")
+	}
+	err = timePreparationStep(preparationStepPayloadAttributes, func() error {
+		return s.preparePayload(ctx, headRoot, headState)
+	})
+	if err != nil {
+		log.WithError(err).WithFields(logFields).Error("Could not prepare payload for proposal")
+		return
+	}
+	log.WithFields(logFields).Debug("Prepared for upcoming proposal")
+}
+
+// advanceNextSlotCache makes sure the next slot cache holds the head state processed up to the given slot,
+// and returns that state. Normally the cache already holds it, as it is updated when the head block is
+// processed, but after skipped slots the state has to be advanced further.
+func advanceNextSlotCache(ctx context.Context, headRoot [32]byte, headState state.BeaconState, slot primitives.Slot) (state.BeaconState, error) {
+	if st := transition.NextSlotState(headRoot[:], slot); st != nil && st.Slot() == slot {
+		return st, nil
+	}
+	pre, err := transition.ProcessSlotsIfPossible(ctx, headState, slot-1)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slots")
+	}
+	if err := transition.UpdateNextSlotCache(ctx, headRoot[:], pre); err != nil {
+		return nil, errors.Wrap(err, "could not update next slot cache")
+	}
+	st := transition.NextSlotState(headRoot[:], slot)
+	if st == nil {
+		return nil, errors.New("next slot cache was updated concurrently")
+	}
+	return st, nil
+}
+
+// warmProposalEpochCaches populates the committee and proposer caches for the given epoch. Both helpers
+// return early when the cache is already populated.
+func warmProposalEpochCaches(ctx context.Context, st state.BeaconState, epoch primitives.Epoch) error {
+	if err := helpers.UpdateCommitteeCache(ctx, st, epoch); err != nil {
+		return errors.Wrap(err, "could not update committee cache")
+	}
+	if err := helpers.UpdateProposerIndicesInCache(ctx, st, epoch); err != nil {
+		return errors.Wrap(err, "could not update proposer index cache")
+	}
+	return nil
+}
+
+// preparePayload sends forkchoice updated with payload attributes for the next slot to the execution client, unless a
+// payload is already being built on top of the given head. It is a no-op if the next slot's proposer isn't tracked.
+// The caller must hold a read lock on forkchoice.
+func (s *Service) preparePayload(ctx context.Context, headRoot [32]byte, headState state.BeaconState) error {
+	slot := s.CurrentSlot() + 1
+	if _, has := s.cfg.PayloadIDCache.PayloadID(slot, headRoot); has {
+		return nil
+	}
+	attribute := s.getPayloadAttribute(ctx, headState, slot, headRoot[:])
+	if attribute.IsEmpty() {
+		return nil
+	}
+	s.headLock.RLock()
+	headBlock, err := s.headBlock()
+	s.headLock.RUnlock()
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve head block")
+	}
+	_, err = s.notifyForkchoiceUpdate(ctx, &fcuConfig{
+		headState:  headState,
+		headRoot:   headRoot,
+		headBlock:  headBlock,
+		attributes: attribute,
+	})
+	return errors.Wrap(err, "failed to update forkchoice with engine")
+}
+
+func timePreparationStep(step string, f func() error) error {
+	start := time.Now()
+	err := f()
+	proposalPreparationStepTime.WithLabelValues(step).Observe(float64(time.Since(start).Milliseconds()))
+	return err
+}
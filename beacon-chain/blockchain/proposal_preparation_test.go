@@ -0,0 +1,161 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	payloadattribute "github.com/prysmaticlabs/prysm/v5/consensus-types/payload-attribute"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestPrepareProposal_PreparesStateAndPayload(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{
+		PrepareAllPayloads: true,
+	})
+	defer resetCfg()
+
+	service, tr := minimalTestService(t, WithPayloadIDCache(cache.NewPayloadIDCache()))
+	ctx, fcs := tr.ctx, tr.fcs
+	service.SetGenesisTime(time.Now())
+	pid := &v1.PayloadIDBytes{1}
+	service.cfg.ExecutionEngineCaller = &mockExecution.EngineClient{PayloadIDBytes: pid}
+
+	st, _ := util.DeterministicGenesisStateDeneb(t, 64)
+	blk := util.NewBeaconBlockDeneb()
+	blk.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte{'a'}, 32)
+	wsb, err := consensusblocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	headRoot := [32]byte{'p', 'r', 'e', 'p'}
+	service.head = &head{root: headRoot, block: wsb, state: st, slot: 0}
+	cp := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	fst, roblock, err := prepareForkchoiceState(ctx, 0, headRoot, [32]byte{}, [32]byte{'a'}, cp, cp)
+	require.NoError(t, err)
+	require.NoError(t, fcs.InsertNode(ctx, fst, roblock))
+
+	proposalSlot := primitives.Slot(1)
+	require.IsNil(t, transition.NextSlotState(headRoot[:], proposalSlot))
+	_, ok := service.cfg.PayloadIDCache.PayloadID(proposalSlot, headRoot)
+	require.Equal(t, false, ok)
+
+	service.prepareProposal(ctx)
+
+	// The payload ID looked up by the proposer at the start of the slot is the one returned by the engine.
+	got, ok := service.cfg.PayloadIDCache.PayloadID(proposalSlot, headRoot)
+	require.Equal(t, true, ok)
+	require.DeepEqual(t, primitives.PayloadID(*pid), got)
+
+	// The proposer advances the parent state with ProcessSlotsUsingNextSlotCache. Hand it a parent state whose
+	// balances differ from the head state: the state it gets back must be the prepared one, not a new advance.
+	want, err := transition.ProcessSlots(ctx, st.Copy(), proposalSlot)
+	require.NoError(t, err)
+	wantRoot, err := want.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	stale := st.Copy()
+	require.NoError(t, stale.UpdateBalancesAtIndex(0, 1))
+	parent, err := transition.ProcessSlotsUsingNextSlotCache(ctx, stale, headRoot[:], proposalSlot)
+	require.NoError(t, err)
+	require.Equal(t, proposalSlot, parent.Slot())
+	parentRoot, err := parent.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	require.Equal(t, wantRoot, parentRoot)
+}
+
+// forkchoiceUpdateCounter counts the forkchoice updates sent to the execution client.
+type forkchoiceUpdateCounter struct {
+	*mockExecution.EngineClient
+	calls int
+}
+
+func (e *forkchoiceUpdateCounter) ForkchoiceUpdated(
+	ctx context.Context, fcs *v1.ForkchoiceState, attrs payloadattribute.Attributer,
+) (*v1.PayloadIDBytes, []byte, error) {
+	e.calls++
+	return e.EngineClient.ForkchoiceUpdated(ctx, fcs, attrs)
+}
+
+func TestPrepareProposal_ShortensCriticalPath(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{
+		PrepareAllPayloads: true,
+	})
+	defer resetCfg()
+
+	service, tr := minimalTestService(t, WithPayloadIDCache(cache.NewPayloadIDCache()))
+	ctx, fcs := tr.ctx, tr.fcs
+	engine := &forkchoiceUpdateCounter{EngineClient: &mockExecution.EngineClient{PayloadIDBytes: &v1.PayloadIDBytes{1}}}
+	service.cfg.ExecutionEngineCaller = engine
+
+	// The head is the last slot of an epoch, so that getting the parent state of the proposal includes an epoch
+	// transition, which is the slow part of the proposal when it is not prepared.
+	proposalSlot := params.BeaconConfig().SlotsPerEpoch
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	service.SetGenesisTime(time.Now().Add(-time.Duration(proposalSlot-1) * secondsPerSlot))
+	st, _ := util.DeterministicGenesisStateDeneb(t, 64)
+	require.NoError(t, st.SetSlot(proposalSlot-1))
+	blk := util.NewBeaconBlockDeneb()
+	blk.Block.Slot = proposalSlot - 1
+	blk.Block.Body.ExecutionPayload.BlockHash = bytesutil.PadTo([]byte{'a'}, 32)
+	wsb, err := consensusblocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	headRoot := [32]byte{'c', 'r', 'i', 't'}
+	service.head = &head{root: headRoot, block: wsb, state: st, slot: proposalSlot - 1}
+	cp := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	fst, roblock, err := prepareForkchoiceState(ctx, proposalSlot-1, headRoot, [32]byte{}, [32]byte{'a'}, cp, cp)
+	require.NoError(t, err)
+	require.NoError(t, fcs.InsertNode(ctx, fst, roblock))
+
+	// criticalPath is the work done at the start of the proposal slot before the execution client is asked for
+	// the payload: advance the parent state to the proposal slot, as the validator RPC does, and have the execution
+	// client start building a payload unless it already is. It returns how long this took and how many forkchoice
+	// updates were sent.
+	criticalPath := func() (time.Duration, int) {
+		parent := st.Copy()
+		calls := engine.calls
+		start := time.Now()
+		parent, err := transition.ProcessSlotsUsingNextSlotCache(ctx, parent, headRoot[:], proposalSlot)
+		require.NoError(t, err)
+		require.Equal(t, proposalSlot, parent.Slot())
+		if _, ok := service.cfg.PayloadIDCache.PayloadID(proposalSlot, headRoot); !ok {
+			service.cfg.ForkChoiceStore.RLock()
+			require.NoError(t, service.preparePayload(ctx, headRoot, st))
+			service.cfg.ForkChoiceStore.RUnlock()
+		}
+		_, ok := service.cfg.PayloadIDCache.PayloadID(proposalSlot, headRoot)
+		require.Equal(t, true, ok)
+		return time.Since(start), engine.calls - calls
+	}
+
+	unprepared, unpreparedCalls := criticalPath()
+	require.Equal(t, 1, unpreparedCalls)
+
+	// Start over with nothing cached, then prepare during the previous slot.
+	service.cfg.PayloadIDCache = cache.NewPayloadIDCache()
+	require.IsNil(t, transition.NextSlotState(headRoot[:], proposalSlot))
+	service.prepareProposal(ctx)
+
+	prepared, preparedCalls := criticalPath()
+	require.Equal(t, 0, preparedCalls)
+	require.Equal(t, true, prepared < unprepared, "prepared critical path took %v, unprepared %v", prepared, unprepared)
+}
+
+func TestPrepareProposal_SkipsUntrackedProposer(t *testing.T) {
+	service, tr := minimalTestService(t, WithPayloadIDCache(cache.NewPayloadIDCache()))
+	service.SetGenesisTime(time.Now())
+	st, _ := util.DeterministicGenesisStateDeneb(t, 64)
+	headRoot := [32]byte{'u', 'n', 't', 'r', 'a', 'c', 'k', 'e', 'd'}
+	service.head = &head{root: headRoot, state: st}
+
+	service.prepareProposal(tr.ctx)
+	require.IsNil(t, transition.NextSlotState(headRoot[:], 1))
+}
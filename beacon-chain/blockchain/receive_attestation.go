@@ -53,7 +53,7 @@ func (s *Service) AttestationTargetState(ctx context.Context, target *ethpb.Chec
 
 // VerifyLmdFfgConsistency verifies that attestation's LMD and FFG votes are consistency to each other.
 func (s *Service) VerifyLmdFfgConsistency(ctx context.Context, a ethpb.Att) error {
-	r, err := s.TargetRootForEpoch([32]byte(a.GetData().BeaconBlockRoot), a.GetData().Target.Epoch)
+	r, err := s.targetRootForAttestation(a.GetData())
 	if err != nil {
 		return err
 	}
@@ -63,6 +63,19 @@ func (s *Service) VerifyLmdFfgConsistency(ctx context.Context, a ethpb.Att) erro
 	return nil
 }
 
+// targetRootForAttestation returns the canonical target root of the attestation's head block for its target epoch.
+// The attestation data produced for the same slot and head root is reused when present, which avoids walking
+// fork choice for every attestation of a committee.
+func (s *Service) targetRootForAttestation(data *ethpb.AttestationData) ([32]byte, error) {
+	if s.cfg.AttestationCache != nil {
+		cached := s.cfg.AttestationCache.Get(data.Slot, bytesutil.ToBytes32(data.BeaconBlockRoot))
+		if cached != nil && cached.Target.Epoch == data.Target.Epoch {
+			return cached.Target.Root, nil
+		}
+	}
+	return s.TargetRootForEpoch(bytesutil.ToBytes32(data.BeaconBlockRoot), data.Target.Epoch)
+}
+
 // This routine processes fork choice attestations from the pool to account for validator votes and fork choice.
 func (s *Service) spawnProcessAttestationsRoutine() {
 	go func() {
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
@@ -65,6 +66,57 @@ func TestVerifyLMDFFGConsistent(t *testing.T) {
 	require.NoError(t, err, "Could not verify LMD and FFG votes to be consistent")
 }
 
+func TestVerifyLMDFFGConsistent_TargetRoot(t *testing.T) {
+	service, tr := minimalTestService(t)
+	ctx := tr.ctx
+
+	f := service.cfg.ForkChoiceStore
+	fc := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	state, r31, err := prepareForkchoiceState(ctx, 31, [32]byte{'a'}, params.BeaconConfig().ZeroHash, params.BeaconConfig().ZeroHash, fc, fc)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, state, r31))
+	state, r32, err := prepareForkchoiceState(ctx, 32, [32]byte{'b'}, r31.Root(), params.BeaconConfig().ZeroHash, fc, fc)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, state, r32))
+	state, r34, err := prepareForkchoiceState(ctx, 34, [32]byte{'c'}, r32.Root(), params.BeaconConfig().ZeroHash, fc, fc)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, state, r34))
+
+	newAtt := func(slot primitives.Slot, head, target [32]byte) *ethpb.Attestation {
+		a := util.NewAttestation()
+		a.Data.Slot = slot
+		a.Data.BeaconBlockRoot = head[:]
+		a.Data.Target.Epoch = slots.ToEpoch(slot)
+		a.Data.Target.Root = target[:]
+		return a
+	}
+	wanted := "FFG and LMD votes are not consistent"
+
+	t.Run("head past the epoch boundary", func(t *testing.T) {
+		require.NoError(t, service.VerifyLmdFfgConsistency(ctx, newAtt(34, r34.Root(), r32.Root())))
+		require.ErrorContains(t, wanted, service.VerifyLmdFfgConsistency(ctx, newAtt(34, r34.Root(), r34.Root())))
+	})
+	t.Run("head from a prior epoch", func(t *testing.T) {
+		require.NoError(t, service.VerifyLmdFfgConsistency(ctx, newAtt(40, r31.Root(), r31.Root())))
+		require.ErrorContains(t, wanted, service.VerifyLmdFfgConsistency(ctx, newAtt(40, r31.Root(), r32.Root())))
+	})
+	t.Run("cached attestation data", func(t *testing.T) {
+		service.cfg.AttestationCache = cache.NewAttestationCache()
+		cachedTarget := [32]byte{'d'}
+		require.NoError(t, service.cfg.AttestationCache.Put(&cache.AttestationConsensusData{
+			Slot:     34,
+			HeadRoot: r34.RootSlice(),
+			Target:   forkchoicetypes.Checkpoint{Epoch: 1, Root: cachedTarget},
+		}))
+		// The cached target is used for the same slot and head root.
+		require.NoError(t, service.VerifyLmdFfgConsistency(ctx, newAtt(34, r34.Root(), cachedTarget)))
+		// Other slots are resolved through fork choice, even in the next epoch with the same head.
+		require.ErrorContains(t, wanted, service.VerifyLmdFfgConsistency(ctx, newAtt(35, r34.Root(), cachedTarget)))
+		require.NoError(t, service.VerifyLmdFfgConsistency(ctx, newAtt(35, r34.Root(), r32.Root())))
+		require.NoError(t, service.VerifyLmdFfgConsistency(ctx, newAtt(66, r34.Root(), r34.Root())))
+	})
+}
+
 func TestProcessAttestations_Ok(t *testing.T) {
 	service, tr := minimalTestService(t)
 	hook := logTest.NewGlobal()
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -68,6 +69,7 @@ type Service struct {
 	blockBeingSynced              *currentlySyncingBlock
 	blobStorage                   *filesystem.BlobStorage
 	lastPublishedLightClientEpoch primitives.Epoch
+	indexingOperationTotals       atomic.Bool
 }
 
 // config options for the service.
@@ -78,6 +80,9 @@ type config struct {
 	DepositCache            cache.DepositCache
 	PayloadIDCache          *cache.PayloadIDCache
 	TrackedValidatorsCache  *cache.TrackedValidatorsCache
+	SlotObservations        *cache.SlotObservations
+	ValidatorLiveness       *cache.ValidatorLiveness
+	AttestationCache        *cache.AttestationCache
 	AttPool                 attestations.Pool
 	ExitPool                voluntaryexits.PoolManager
 	SlashingPool            slashings.PoolManager
@@ -94,6 +99,8 @@ type config struct {
 	FinalizedStateAtStartUp state.BeaconState
 	ExecutionEngineCaller   execution.EngineCaller
 	SyncChecker             Checker
+	OperationTotalsIndex    bool
+	PayloadReconstructor    db.PayloadReconstructor
 }
 
 // Checker is an interface used to determine if a node is in initial sync
@@ -212,6 +219,9 @@ func (s *Service) Start() {
 	}
 	s.spawnProcessAttestationsRoutine()
 	go s.runLateBlockTasks()
+	if features.Get().PrepareProposals {
+		go s.runProposalPreparation()
+	}
 }
 
 // Stop the blockchain service's main event loop and associated goroutines.
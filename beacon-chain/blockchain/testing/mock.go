@@ -68,6 +68,8 @@ type ChainService struct {
 	Genesis                     time.Time
 	ForkChoiceStore             forkchoice.ForkChoicer
 	ReceiveBlockMockErr         error
+	AnchoredBatchMockErr        error
+	AnchoredBatchesReceived     int
 	OptimisticCheckRootReceived [32]byte
 	FinalizedRoots              map[[32]byte]bool
 	OptimisticRoots             map[[32]byte]bool
@@ -301,6 +303,15 @@ func (s *ChainService) ReceiveBlockBatch(ctx context.Context, blks []blocks.ROBl
 	return nil
 }
 
+// ReceiveAnchoredBlockBatch mocks ReceiveAnchoredBlockBatch method in chain service.
+func (s *ChainService) ReceiveAnchoredBlockBatch(ctx context.Context, blks []blocks.ROBlock, avs das.AvailabilityStore) error {
+	if s.AnchoredBatchMockErr != nil {
+		return s.AnchoredBatchMockErr
+	}
+	s.AnchoredBatchesReceived++
+	return s.ReceiveBlockBatch(ctx, blks, avs)
+}
+
 // ReceiveBlock mocks ReceiveBlock method in chain service.
 func (s *ChainService) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, _ [32]byte, _ das.AvailabilityStore) error {
 	if s.ReceiveBlockMockErr != nil {
@@ -658,9 +669,17 @@ func (s *ChainService) InsertNode(ctx context.Context, st state.BeaconState, blo
 }
 
 // ForkChoiceDump mocks the same method in the chain service
-func (s *ChainService) ForkChoiceDump(ctx context.Context) (*forkchoice2.Dump, error) {
+func (s *ChainService) ForkChoiceDump(ctx context.Context, filter *forkchoice2.DumpFilter) (*forkchoice2.Dump, error) {
+	if s.ForkChoiceStore != nil {
+		return s.ForkChoiceStore.ForkChoiceDump(ctx, filter)
+	}
+	return nil, nil
+}
+
+// ForkChoiceSnapshot mocks the same method in the chain service
+func (s *ChainService) ForkChoiceSnapshot(ctx context.Context) (*forkchoice2.Snapshot, error) {
 	if s.ForkChoiceStore != nil {
-		return s.ForkChoiceStore.ForkChoiceDump(ctx)
+		return s.ForkChoiceStore.Snapshot(ctx)
 	}
 	return nil, nil
 }
@@ -6,8 +6,11 @@ import (
 
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 )
 
+// AttestationConsensusData is the part of the attestation data derived from the head:
+// the block root voted for, and the source and target checkpoints.
 type AttestationConsensusData struct {
 	Slot     primitives.Slot
 	HeadRoot []byte
@@ -15,27 +18,48 @@ type AttestationConsensusData struct {
 	Source   forkchoicetypes.Checkpoint
 }
 
-// AttestationCache stores cached results of AttestationData requests.
+type attestationCacheKey struct {
+	slot     primitives.Slot
+	headRoot [32]byte
+}
+
+// AttestationCache stores the consensus data of attestations, keyed by slot and head root, so that it is
+// derived once and shared between attestation production and gossip validation. Entries never need to be
+// invalidated as a new head has a different key; entries older than the previous slot are pruned on Put.
+//
+// Because of the head root in the key, the data served for a slot changes when the head changes within
+// the slot, instead of staying the data computed for the first request of the slot. Validators that attest
+// after a head change vote for the new head, so attestations of a committee for the same slot can differ,
+// and only the attestations with the same data are aggregated together.
 type AttestationCache struct {
-	a *AttestationConsensusData
-	sync.RWMutex
+	entries map[attestationCacheKey]*AttestationConsensusData
+	lock    sync.RWMutex
 }
 
 // NewAttestationCache creates a new instance of AttestationCache.
 func NewAttestationCache() *AttestationCache {
-	return &AttestationCache{}
+	return &AttestationCache{entries: make(map[attestationCacheKey]*AttestationConsensusData)}
 }
 
-// Get retrieves cached attestation data, recording a cache hit or miss. This method is lock free.
-func (c *AttestationCache) Get() *AttestationConsensusData {
-	return c.a
+// Get retrieves the cached attestation consensus data for the slot and head root, or nil.
+func (c *AttestationCache) Get(slot primitives.Slot, headRoot [32]byte) *AttestationConsensusData {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.entries[attestationCacheKey{slot: slot, headRoot: headRoot}]
 }
 
-// Put adds a response to the cache. This method is lock free.
+// Put adds attestation consensus data to the cache, and prunes the entries older than the previous slot.
 func (c *AttestationCache) Put(a *AttestationConsensusData) error {
 	if a == nil {
 		return errors.New("attestation cannot be nil")
 	}
-	c.a = a
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for k := range c.entries {
+		if k.slot+1 < a.Slot {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[attestationCacheKey{slot: a.Slot, headRoot: bytesutil.ToBytes32(a.HeadRoot)}] = a
 	return nil
 }
@@ -11,7 +11,7 @@ import (
 func TestAttestationCache_RoundTrip(t *testing.T) {
 	c := cache.NewAttestationCache()
 
-	a := c.Get()
+	a := c.Get(1, [32]byte{1})
 	require.Nil(t, a)
 
 	insert := &cache.AttestationConsensusData{
@@ -29,11 +29,14 @@ func TestAttestationCache_RoundTrip(t *testing.T) {
 	err := c.Put(insert)
 	require.NoError(t, err)
 
-	a = c.Get()
+	a = c.Get(1, [32]byte{1})
 	require.Equal(t, insert, a)
+	// A different head or slot is a different entry.
+	require.Nil(t, c.Get(1, [32]byte{2}))
+	require.Nil(t, c.Get(2, [32]byte{1}))
 
-	insert = &cache.AttestationConsensusData{
-		Slot:     6,
+	reorged := &cache.AttestationConsensusData{
+		Slot:     2,
 		HeadRoot: []byte{7},
 		Target: forkchoicetypes.Checkpoint{
 			Epoch: 8,
@@ -44,10 +47,17 @@ func TestAttestationCache_RoundTrip(t *testing.T) {
 			Root:  [32]byte{11},
 		},
 	}
+	err = c.Put(reorged)
+	require.NoError(t, err)
+
+	require.Equal(t, reorged, c.Get(2, [32]byte{7}))
+	// The entry of the previous slot is kept.
+	require.Equal(t, insert, c.Get(1, [32]byte{1}))
 
-	err = c.Put(insert)
+	err = c.Put(&cache.AttestationConsensusData{Slot: 3, HeadRoot: []byte{12}})
 	require.NoError(t, err)
+	require.Nil(t, c.Get(1, [32]byte{1}), "entry older than the previous slot was not pruned")
+	require.Equal(t, reorged, c.Get(2, [32]byte{7}))
 
-	a = c.Get()
-	require.Equal(t, insert, a)
+	require.Error(t, c.Put(nil))
 }
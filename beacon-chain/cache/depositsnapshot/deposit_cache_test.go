@@ -1189,3 +1189,104 @@ func BenchmarkDepositTree_HashTreeRootOldImplementation(b *testing.B) {
 		require.NoError(b, err)
 	}
 }
+
+func TestInitializeFromSnapshot(t *testing.T) {
+	ctx := context.Background()
+	deposits := make([]*ethpb.Deposit, 5)
+	fullTree := NewDepositTree()
+	snapshotTree := NewDepositTree()
+	for i := range deposits {
+		deposits[i] = &ethpb.Deposit{
+			Proof: [][]byte{bytesutil.PadTo([]byte{byte(i)}, 32)},
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+		}
+		root, err := deposits[i].Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, fullTree.pushLeaf(root))
+		if i < 3 {
+			require.NoError(t, snapshotTree.pushLeaf(root))
+		}
+	}
+	require.NoError(t, snapshotTree.Finalize(2, [32]byte{'a'}, 0))
+	snapshot, err := snapshotTree.ToProto()
+	require.NoError(t, err)
+
+	dc, err := New()
+	require.NoError(t, err)
+	require.NoError(t, dc.InitializeFromSnapshot(ctx, snapshot))
+	require.ErrorContains(t, "deposit cache is not empty", dc.InitializeFromSnapshot(ctx, snapshot))
+
+	require.ErrorContains(t, "wanted deposit with index 3 to be inserted but received 0", dc.InsertDeposit(ctx, deposits[0], 9, 0, [32]byte{}))
+	require.NoError(t, dc.InsertDeposit(ctx, deposits[3], 10, 3, [32]byte{'b'}))
+	require.NoError(t, dc.InsertDeposit(ctx, deposits[4], 11, 4, [32]byte{'c'}))
+
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(9))
+	assert.Equal(t, uint64(3), count)
+	assert.DeepEqual(t, bytesutil.ToBytes32(snapshot.DepositRoot), root)
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(10))
+	assert.Equal(t, uint64(4), count)
+	assert.Equal(t, [32]byte{'b'}, root)
+
+	require.NoError(t, dc.InsertFinalizedDeposits(ctx, 4, [32]byte{}, 0))
+	finalized, err := dc.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), finalized.MerkleTrieIndex())
+	wantRoot, err := fullTree.HashTreeRoot()
+	require.NoError(t, err)
+	gotRoot, err := finalized.Deposits().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, gotRoot)
+
+	require.NoError(t, dc.PruneProofs(ctx, 3))
+	assert.Equal(t, true, dc.deposits[0].Deposit.Proof == nil)
+	assert.Equal(t, false, dc.deposits[1].Deposit.Proof == nil)
+}
+
+func TestRemoveDepositsAfterBlock(t *testing.T) {
+	ctx := context.Background()
+	dc, err := New()
+	require.NoError(t, err)
+
+	deposits := make([]*ethpb.Deposit, 5)
+	for i := range deposits {
+		deposits[i] = &ethpb.Deposit{
+			Proof: makeDepositProof(),
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i % 3)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+		}
+		blockNum := uint64(10 + i)
+		require.NoError(t, dc.InsertDeposit(ctx, deposits[i], blockNum, int64(i), [32]byte{byte(i)}))
+		dc.InsertPendingDeposit(ctx, deposits[i], blockNum, int64(i), [32]byte{byte(i)})
+	}
+	require.NoError(t, dc.InsertFinalizedDeposits(ctx, 1, [32]byte{}, 0))
+
+	require.ErrorContains(t, "cannot remove finalized deposit with index 1", dc.RemoveDepositsAfterBlock(ctx, 10))
+
+	require.NoError(t, dc.RemoveDepositsAfterBlock(ctx, 12))
+	ctrs := dc.AllDepositContainers(ctx)
+	require.Equal(t, 3, len(ctrs))
+	assert.Equal(t, int64(2), ctrs[2].Index)
+	pending := dc.PendingContainers(ctx, nil)
+	require.Equal(t, 3, len(pending))
+	assert.Equal(t, uint64(12), pending[2].Eth1BlockHeight)
+	// The deposit of the first key at index 3 was removed, the one at index 0 is still present.
+	assert.Equal(t, 1, len(dc.depositsByKey[bytesutil.ToBytes48(deposits[0].Data.PublicKey)]))
+	assert.Equal(t, 1, len(dc.depositsByKey[bytesutil.ToBytes48(deposits[1].Data.PublicKey)]))
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(100))
+	assert.Equal(t, uint64(3), count)
+	assert.Equal(t, [32]byte{2}, root)
+
+	// Deposits can be inserted again from the block following the one the cache was rolled back to.
+	require.NoError(t, dc.InsertDeposit(ctx, deposits[4], 13, 3, [32]byte{'a'}))
+
+	// Nothing is removed when there are no deposits after the block.
+	require.NoError(t, dc.RemoveDepositsAfterBlock(ctx, 13))
+	assert.Equal(t, 4, len(dc.AllDepositContainers(ctx)))
+}
@@ -31,6 +31,10 @@ type Cache struct {
 	finalizedDeposits finalizedDepositsContainer
 	depositsByKey     map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer
 	depositsLock      sync.RWMutex
+	// snapshotDepositCount and snapshotDepositRoot describe the deposit snapshot the cache was initialized from.
+	// The deposits covered by the snapshot are not held in the cache.
+	snapshotDepositCount int64
+	snapshotDepositRoot  [32]byte
 }
 
 // finalizedDepositsContainer stores the trie of deposits that have been included
@@ -134,9 +138,13 @@ func (c *Cache) DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
+		if c.snapshotDepositCount > 0 {
+			// The deposits covered by the snapshot were finalized before any block height considered for eth1 votes.
+			return uint64(c.snapshotDepositCount), c.snapshotDepositRoot
+		}
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(c.deposits[heightIdx-1].DepositRoot)
+	return uint64(c.deposits[heightIdx-1].Index + 1), bytesutil.ToBytes32(c.deposits[heightIdx-1].DepositRoot)
 }
 
 // FinalizedDeposits returns the finalized deposits trie.
@@ -185,11 +193,16 @@ func (c *Cache) PruneProofs(ctx context.Context, untilDepositIndex int64) error
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
-	if untilDepositIndex >= int64(len(c.deposits)) {
-		untilDepositIndex = int64(len(c.deposits) - 1)
+	if len(c.deposits) == 0 {
+		return nil
+	}
+	// Positions in the cache are offset by the deposits covered by the snapshot the cache was initialized from.
+	untilPosition := untilDepositIndex - c.deposits[0].Index
+	if untilPosition >= int64(len(c.deposits)) {
+		untilPosition = int64(len(c.deposits) - 1)
 	}
 
-	for i := untilDepositIndex; i >= 0; i-- {
+	for i := untilPosition; i >= 0; i-- {
 		// Finding a nil proof means that all proofs up to this deposit have been already pruned.
 		if c.deposits[i].Deposit.Proof == nil {
 			break
@@ -43,8 +43,12 @@ func (c *Cache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blockNum ui
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
-	if int(index) != len(c.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(c.deposits), index)
+	want := c.snapshotDepositCount
+	if len(c.deposits) > 0 {
+		want = c.deposits[len(c.deposits)-1].Index + 1
+	}
+	if index != want {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", want, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(c.deposits), func(i int) bool { return c.deposits[i].Index >= index })
@@ -106,8 +110,8 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex in
 	}
 	// In the event we have less deposits than we need to
 	// finalize we finalize till the index on which we do have it.
-	if len(c.deposits) <= int(eth1DepositIndex) {
-		eth1DepositIndex = int64(len(c.deposits)) - 1
+	if lastIndex := c.deposits[len(c.deposits)-1].Index; lastIndex < eth1DepositIndex {
+		eth1DepositIndex = lastIndex
 	}
 	// If we finalize to some lower deposit index, we
 	// ignore it.
@@ -139,3 +143,74 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex in
 	}
 	return nil
 }
+
+// InitializeFromSnapshot initializes the finalized deposits of an empty cache from an EIP-4881 deposit tree snapshot.
+// The deposits covered by the snapshot are not available from the cache, the next deposit to be inserted is the
+// one following them.
+func (c *Cache) InitializeFromSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	_, span := trace.StartSpan(ctx, "Cache.InitializeFromSnapshot")
+	defer span.End()
+	if snapshot == nil || snapshot.DepositCount == 0 {
+		return errors.New("empty deposit snapshot")
+	}
+	tree, err := DepositTreeFromSnapshotProto(snapshot)
+	if err != nil {
+		return errors.Wrap(err, "could not build deposit tree from snapshot")
+	}
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+
+	if len(c.deposits) != 0 || c.finalizedDeposits.merkleTrieIndex != -1 {
+		return errors.New("deposit cache is not empty")
+	}
+	count := int64(snapshot.DepositCount) // lint:ignore uintcast -- Deposit count should not exceed int64 in your lifetime.
+	c.finalizedDeposits = toFinalizedDepositsContainer(tree, count-1)
+	c.snapshotDepositCount = count
+	c.snapshotDepositRoot = bytesutil.ToBytes32(snapshot.DepositRoot)
+	return nil
+}
+
+// RemoveDepositsAfterBlock removes all deposits and pending deposits made in execution blocks after the given
+// block number from the cache. This is used to roll back the cache to the common ancestor of an execution chain
+// reorg. Deposits which have already been finalized cannot be removed.
+func (c *Cache) RemoveDepositsAfterBlock(ctx context.Context, blockNum uint64) error {
+	_, span := trace.StartSpan(ctx, "Cache.RemoveDepositsAfterBlock")
+	defer span.End()
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+
+	// Deposits are sorted by index, and therefore by block number.
+	keep := sort.Search(len(c.deposits), func(i int) bool { return c.deposits[i].Eth1BlockHeight > blockNum })
+	if keep == len(c.deposits) {
+		return nil
+	}
+	if c.deposits[keep].Index <= c.finalizedDeposits.merkleTrieIndex {
+		return errors.Errorf("cannot remove finalized deposit with index %d, last finalized index is %d",
+			c.deposits[keep].Index, c.finalizedDeposits.merkleTrieIndex)
+	}
+	for _, ctr := range c.deposits[keep:] {
+		pubkey := bytesutil.ToBytes48(ctr.Deposit.Data.PublicKey)
+		remaining := make([]*ethpb.DepositContainer, 0, len(c.depositsByKey[pubkey]))
+		for _, d := range c.depositsByKey[pubkey] {
+			if d != ctr {
+				remaining = append(remaining, d)
+			}
+		}
+		if len(remaining) == 0 {
+			delete(c.depositsByKey, pubkey)
+		} else {
+			c.depositsByKey[pubkey] = remaining
+		}
+	}
+	c.deposits = c.deposits[:keep]
+
+	pending := make([]*ethpb.DepositContainer, 0, len(c.pendingDeposits))
+	for _, dp := range c.pendingDeposits {
+		if dp.Eth1BlockHeight <= blockNum {
+			pending = append(pending, dp)
+		}
+	}
+	c.pendingDeposits = pending
+	pendingDepositsCount.Set(float64(len(c.pendingDeposits)))
+	return nil
+}
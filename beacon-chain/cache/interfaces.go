@@ -33,6 +33,8 @@ type DepositInserter interface {
 	InsertDeposit(ctx context.Context, d *ethpb.Deposit, blockNum uint64, index int64, depositRoot [32]byte) error
 	InsertDepositContainers(ctx context.Context, ctrs []*ethpb.DepositContainer)
 	InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex int64, executionHash common.Hash, executionNumber uint64) error
+	InitializeFromSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error
+	RemoveDepositsAfterBlock(ctx context.Context, blockNum uint64) error
 }
 
 // FinalizedFetcher is a smaller interface defined to be the bare minimum to satisfy “Service”.
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// maxProposalAttestationSources is the number of proposals for which the attestation source breakdown is kept.
+const maxProposalAttestationSources = 64
+
+// AttestationSourceBits is the number of participation bits of an included attestation
+// provided by the pool entries of a given source.
+type AttestationSourceBits struct {
+	Source string
+	// AggregatorIndex is the index of the aggregator, only set for gossip aggregates.
+	AggregatorIndex primitives.ValidatorIndex
+	Bits            uint64
+}
+
+// IncludedAttestationSources breaks down where the participation bits of a single committee's
+// attestation included in a proposed block came from.
+type IncludedAttestationSources struct {
+	Slot           primitives.Slot
+	CommitteeIndex primitives.CommitteeIndex
+	Subnet         uint64
+	// Bits is the number of participation bits included in the block.
+	Bits uint64
+	// UnattributedBits is the number of included participation bits no tagged pool entry provided.
+	UnattributedBits uint64
+	// Sources may add up to more than Bits, as a participation bit can be provided by several pool entries.
+	Sources []AttestationSourceBits
+}
+
+// ProposalAttestationSources is the attestation source breakdown of a block proposed through this node.
+type ProposalAttestationSources struct {
+	Slot          primitives.Slot
+	ProposerIndex primitives.ValidatorIndex
+	BlockRoot     [32]byte
+	Attestations  []IncludedAttestationSources
+}
+
+// ProposalAttestationSourcesCache keeps the attestation source breakdown of the most recent blocks
+// proposed through this node, to help diagnose blocks with a low attestation count.
+type ProposalAttestationSourcesCache struct {
+	sync.RWMutex
+	proposals []*ProposalAttestationSources
+}
+
+// NewProposalAttestationSourcesCache initializes a ProposalAttestationSourcesCache.
+func NewProposalAttestationSourcesCache() *ProposalAttestationSourcesCache {
+	return &ProposalAttestationSourcesCache{}
+}
+
+// Add records the attestation source breakdown of a proposed block, evicting the oldest proposal when full.
+func (c *ProposalAttestationSourcesCache) Add(p *ProposalAttestationSources) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.proposals = append(c.proposals, p)
+	sort.SliceStable(c.proposals, func(i, j int) bool { return c.proposals[i].Slot < c.proposals[j].Slot })
+	if len(c.proposals) > maxProposalAttestationSources {
+		c.proposals = c.proposals[len(c.proposals)-maxProposalAttestationSources:]
+	}
+}
+
+// Proposals returns the recorded proposals in ascending slot order.
+func (c *ProposalAttestationSourcesCache) Proposals() []*ProposalAttestationSources {
+	c.RLock()
+	defer c.RUnlock()
+
+	proposals := make([]*ProposalAttestationSources, len(c.proposals))
+	copy(proposals, c.proposals)
+	return proposals
+}
+
+// ProposalsAtSlot returns the recorded proposals for the slot.
+func (c *ProposalAttestationSourcesCache) ProposalsAtSlot(slot primitives.Slot) []*ProposalAttestationSources {
+	c.RLock()
+	defer c.RUnlock()
+
+	var proposals []*ProposalAttestationSources
+	for _, p := range c.proposals {
+		if p.Slot == slot {
+			proposals = append(proposals, p)
+		}
+	}
+	return proposals
+}
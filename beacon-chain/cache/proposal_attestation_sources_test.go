@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestProposalAttestationSourcesCache_Add(t *testing.T) {
+	c := NewProposalAttestationSourcesCache()
+	c.Add(&ProposalAttestationSources{Slot: 5, ProposerIndex: 1})
+	c.Add(&ProposalAttestationSources{Slot: 3, ProposerIndex: 2})
+	c.Add(&ProposalAttestationSources{Slot: 5, ProposerIndex: 3})
+
+	proposals := c.Proposals()
+	require.Equal(t, 3, len(proposals))
+	assert.Equal(t, primitives.Slot(3), proposals[0].Slot)
+	assert.Equal(t, primitives.ValidatorIndex(1), proposals[1].ProposerIndex)
+	assert.Equal(t, primitives.ValidatorIndex(3), proposals[2].ProposerIndex)
+
+	atSlot := c.ProposalsAtSlot(5)
+	require.Equal(t, 2, len(atSlot))
+	assert.Equal(t, primitives.ValidatorIndex(1), atSlot[0].ProposerIndex)
+	assert.Equal(t, primitives.ValidatorIndex(3), atSlot[1].ProposerIndex)
+	assert.Equal(t, 0, len(c.ProposalsAtSlot(4)))
+}
+
+func TestProposalAttestationSourcesCache_EvictsOldest(t *testing.T) {
+	c := NewProposalAttestationSourcesCache()
+	for i := 0; i < maxProposalAttestationSources+10; i++ {
+		c.Add(&ProposalAttestationSources{Slot: primitives.Slot(i)})
+	}
+
+	proposals := c.Proposals()
+	require.Equal(t, maxProposalAttestationSources, len(proposals))
+	assert.Equal(t, primitives.Slot(10), proposals[0].Slot)
+	assert.Equal(t, primitives.Slot(maxProposalAttestationSources+9), proposals[len(proposals)-1].Slot)
+}
@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// SlotObservation is what the node observed for a slot: the proposer assigned to the slot, if known,
+// and the roots of the blocks seen for the slot through gossip or fork choice.
+type SlotObservation struct {
+	Slot          primitives.Slot
+	ProposerIndex primitives.ValidatorIndex
+	ProposerKnown bool
+	Roots         [][32]byte
+}
+
+// SlotObservations tracks the blocks observed for the slots of the recent past along with their assigned
+// proposers, so that slots without a canonical block can be told apart as orphaned proposals or empty slots,
+// after fork choice pruned the nodes of orphaned blocks.
+type SlotObservations struct {
+	sync.RWMutex
+	slots     map[primitives.Slot]*SlotObservation
+	highest   primitives.Slot
+	retention primitives.Slot
+}
+
+// NewSlotObservations initializes a SlotObservations tracker keeping the given number of slots.
+func NewSlotObservations(retention primitives.Slot) *SlotObservations {
+	return &SlotObservations{
+		slots:     make(map[primitives.Slot]*SlotObservation),
+		retention: retention,
+	}
+}
+
+// Retention returns the number of slots kept by the tracker.
+func (s *SlotObservations) Retention() primitives.Slot {
+	return s.retention
+}
+
+// AddBlock records a block observed for the slot, along with its proposer.
+func (s *SlotObservations) AddBlock(slot primitives.Slot, proposer primitives.ValidatorIndex, root [32]byte) {
+	s.Lock()
+	defer s.Unlock()
+	o := s.observation(slot)
+	if o == nil {
+		return
+	}
+	if !o.ProposerKnown {
+		o.ProposerIndex = proposer
+		o.ProposerKnown = true
+	}
+	for _, r := range o.Roots {
+		if r == root {
+			return
+		}
+	}
+	o.Roots = append(o.Roots, root)
+}
+
+// SetProposer records the proposer assigned to the slot.
+func (s *SlotObservations) SetProposer(slot primitives.Slot, proposer primitives.ValidatorIndex) {
+	s.Lock()
+	defer s.Unlock()
+	o := s.observation(slot)
+	if o == nil {
+		return
+	}
+	o.ProposerIndex = proposer
+	o.ProposerKnown = true
+}
+
+// Observation returns what was observed for the slot, and whether the slot is still tracked.
+func (s *SlotObservations) Observation(slot primitives.Slot) (SlotObservation, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	o, ok := s.slots[slot]
+	if !ok {
+		return SlotObservation{Slot: slot}, !s.tooOld(slot)
+	}
+	cp := *o
+	cp.Roots = make([][32]byte, len(o.Roots))
+	copy(cp.Roots, o.Roots)
+	return cp, true
+}
+
+func (s *SlotObservations) observation(slot primitives.Slot) *SlotObservation {
+	if slot > s.highest {
+		s.highest = slot
+		s.prune()
+	}
+	if s.tooOld(slot) {
+		return nil
+	}
+	o, ok := s.slots[slot]
+	if !ok {
+		o = &SlotObservation{Slot: slot}
+		s.slots[slot] = o
+	}
+	return o
+}
+
+func (s *SlotObservations) tooOld(slot primitives.Slot) bool {
+	return slot+s.retention <= s.highest
+}
+
+func (s *SlotObservations) prune() {
+	for slot := range s.slots {
+		if s.tooOld(slot) {
+			delete(s.slots, slot)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSlotObservations(t *testing.T) {
+	s := NewSlotObservations(10)
+	s.SetProposer(100, 7)
+	s.AddBlock(101, 8, [32]byte{'a'})
+	s.AddBlock(101, 8, [32]byte{'b'})
+	// Duplicate roots are recorded once.
+	s.AddBlock(101, 8, [32]byte{'a'})
+
+	o, ok := s.Observation(100)
+	require.Equal(t, true, ok)
+	assert.Equal(t, true, o.ProposerKnown)
+	assert.Equal(t, 7, int(o.ProposerIndex))
+	assert.Equal(t, 0, len(o.Roots))
+
+	o, ok = s.Observation(101)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 8, int(o.ProposerIndex))
+	assert.DeepEqual(t, [][32]byte{{'a'}, {'b'}}, o.Roots)
+
+	// A tracked slot without observations.
+	o, ok = s.Observation(99)
+	require.Equal(t, true, ok)
+	assert.Equal(t, false, o.ProposerKnown)
+
+	// Slots older than the retention are pruned and no longer recorded.
+	s.SetProposer(110, 9)
+	_, ok = s.Observation(100)
+	assert.Equal(t, false, ok)
+	s.AddBlock(100, 7, [32]byte{'c'})
+	_, ok = s.Observation(100)
+	assert.Equal(t, false, ok)
+	o, ok = s.Observation(101)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 2, len(o.Roots))
+}
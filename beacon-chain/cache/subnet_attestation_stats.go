@@ -0,0 +1,212 @@
+package cache
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+var (
+	subnetAttestationsReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "attestation_subnet_received_total",
+		Help: "The number of valid unaggregated attestations received per attestation subnet.",
+	}, []string{"subnet"})
+	subnetUniqueAttesters = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "attestation_subnet_unique_attesters_total",
+		Help: "The number of distinct attesters seen per attestation subnet, counted once per slot.",
+	}, []string{"subnet"})
+	subnetDeliveryRatio = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "attestation_subnet_delivery_ratio",
+		Help: "The ratio of distinct attesters seen to expected committee members per attestation subnet, over the last epoch.",
+	}, []string{"subnet"})
+	subnetAggregateBits = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "attestation_subnet_aggregate_participation_bits",
+		Help: "The number of participation bits set in the most recent aggregate produced by this node per attestation subnet.",
+	}, []string{"subnet"})
+)
+
+// SubnetSlotStats summarizes the unaggregated attestations received for a single subnet in a single slot.
+type SubnetSlotStats struct {
+	Slot   primitives.Slot
+	Subnet uint64
+	// Received is the number of valid unaggregated attestations received.
+	Received uint64
+	// UniqueAttesters is the number of distinct validators those attestations were from.
+	UniqueAttesters uint64
+	// ExpectedAttesters is the size of the committee assigned to the subnet for the slot.
+	ExpectedAttesters uint64
+	// AggregateBits is the number of participation bits in the aggregate produced by this node, if any.
+	AggregateBits uint64
+}
+
+// DeliveryRatio returns the fraction of the committee we received attestations from, or 0 if the committee size is unknown.
+func (s SubnetSlotStats) DeliveryRatio() float64 {
+	if s.ExpectedAttesters == 0 {
+		return 0
+	}
+	return float64(s.UniqueAttesters) / float64(s.ExpectedAttesters)
+}
+
+type subnetSlotEntry struct {
+	SubnetSlotStats
+	// attesters has a bit set for each committee position we have seen an attestation from.
+	attesters bitfield.Bitlist
+}
+
+// SubnetAttestationStats tracks per-subnet attestation delivery over the most recent epoch,
+// to help diagnose subnets where we are poorly peered.
+type SubnetAttestationStats struct {
+	sync.Mutex
+	entries  map[primitives.Slot]map[uint64]*subnetSlotEntry
+	highest  primitives.Slot
+	retained primitives.Slot
+}
+
+// NewSubnetAttestationStats initializes a SubnetAttestationStats tracker.
+func NewSubnetAttestationStats() *SubnetAttestationStats {
+	return &SubnetAttestationStats{
+		entries: make(map[primitives.Slot]map[uint64]*subnetSlotEntry),
+		// Keep a couple of extra slots beyond an epoch, because attestations for a slot
+		// are still received during the following slot.
+		retained: params.BeaconConfig().SlotsPerEpoch + 2,
+	}
+}
+
+func (s *SubnetAttestationStats) entry(slot primitives.Slot, subnet, committeeSize uint64) *subnetSlotEntry {
+	if slot > s.highest {
+		s.highest = slot
+		s.prune()
+		s.updateDeliveryRatios()
+	}
+	bySubnet, ok := s.entries[slot]
+	if !ok {
+		bySubnet = make(map[uint64]*subnetSlotEntry)
+		s.entries[slot] = bySubnet
+	}
+	e, ok := bySubnet[subnet]
+	if !ok {
+		e = &subnetSlotEntry{SubnetSlotStats: SubnetSlotStats{Slot: slot, Subnet: subnet}}
+		bySubnet[subnet] = e
+	}
+	if committeeSize > 0 && e.attesters == nil {
+		e.ExpectedAttesters = committeeSize
+		e.attesters = bitfield.NewBitlist(committeeSize)
+	}
+	return e
+}
+
+// RecordUnaggregated records a valid unaggregated attestation received on the given subnet, from the attester
+// at position indexInCommittee in the committee assigned to the subnet.
+func (s *SubnetAttestationStats) RecordUnaggregated(slot primitives.Slot, subnet, committeeSize, indexInCommittee uint64) {
+	s.Lock()
+	defer s.Unlock()
+	if s.tooOld(slot) {
+		return
+	}
+	e := s.entry(slot, subnet, committeeSize)
+	e.Received++
+	label := strconv.FormatUint(subnet, 10)
+	subnetAttestationsReceived.WithLabelValues(label).Inc()
+	if indexInCommittee >= e.attesters.Len() || e.attesters.BitAt(indexInCommittee) {
+		return
+	}
+	e.attesters.SetBitAt(indexInCommittee, true)
+	e.UniqueAttesters++
+	subnetUniqueAttesters.WithLabelValues(label).Inc()
+}
+
+// RecordAggregate records the number of participation bits in an aggregate this node produced for the given subnet.
+func (s *SubnetAttestationStats) RecordAggregate(slot primitives.Slot, subnet, committeeSize, bits uint64) {
+	s.Lock()
+	defer s.Unlock()
+	if s.tooOld(slot) {
+		return
+	}
+	e := s.entry(slot, subnet, committeeSize)
+	if bits > e.AggregateBits {
+		e.AggregateBits = bits
+	}
+	subnetAggregateBits.WithLabelValues(strconv.FormatUint(subnet, 10)).Set(float64(bits))
+}
+
+// Breakdown returns the stats for every subnet with recorded activity in the epoch leading up to
+// and including the given slot, ordered by slot and then subnet.
+func (s *SubnetAttestationStats) Breakdown(current primitives.Slot) []SubnetSlotStats {
+	s.Lock()
+	defer s.Unlock()
+	var start primitives.Slot
+	if current >= params.BeaconConfig().SlotsPerEpoch {
+		start = current - params.BeaconConfig().SlotsPerEpoch + 1
+	}
+	stats := make([]SubnetSlotStats, 0)
+	for slot, bySubnet := range s.entries {
+		if slot < start || slot > current {
+			continue
+		}
+		for _, e := range bySubnet {
+			stats = append(stats, e.SubnetSlotStats)
+		}
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Slot != stats[j].Slot {
+			return stats[i].Slot < stats[j].Slot
+		}
+		return stats[i].Subnet < stats[j].Subnet
+	})
+	return stats
+}
+
+// DeliveryRatios returns, for each subnet, the ratio of distinct attesters seen to expected attesters
+// across the completed slots currently tracked. The most recent two slots are excluded because their
+// attestations are still propagating.
+func (s *SubnetAttestationStats) DeliveryRatios() map[uint64]float64 {
+	s.Lock()
+	defer s.Unlock()
+	return s.deliveryRatios()
+}
+
+func (s *SubnetAttestationStats) deliveryRatios() map[uint64]float64 {
+	seen := make(map[uint64]uint64)
+	expected := make(map[uint64]uint64)
+	for slot, bySubnet := range s.entries {
+		if slot+2 > s.highest {
+			continue
+		}
+		for subnet, e := range bySubnet {
+			if e.ExpectedAttesters == 0 {
+				continue
+			}
+			seen[subnet] += e.UniqueAttesters
+			expected[subnet] += e.ExpectedAttesters
+		}
+	}
+	ratios := make(map[uint64]float64, len(expected))
+	for subnet, exp := range expected {
+		ratios[subnet] = float64(seen[subnet]) / float64(exp)
+	}
+	return ratios
+}
+
+func (s *SubnetAttestationStats) updateDeliveryRatios() {
+	for subnet, ratio := range s.deliveryRatios() {
+		subnetDeliveryRatio.WithLabelValues(strconv.FormatUint(subnet, 10)).Set(ratio)
+	}
+}
+
+func (s *SubnetAttestationStats) tooOld(slot primitives.Slot) bool {
+	return slot+s.retained <= s.highest
+}
+
+func (s *SubnetAttestationStats) prune() {
+	for slot := range s.entries {
+		if s.tooOld(slot) {
+			delete(s.entries, slot)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSubnetAttestationStats_RecordUnaggregated(t *testing.T) {
+	s := NewSubnetAttestationStats()
+	slot := primitives.Slot(100)
+	s.RecordUnaggregated(slot, 3, 4, 0)
+	s.RecordUnaggregated(slot, 3, 4, 1)
+	// A duplicate from the same committee position is counted as received, but not as a new attester.
+	s.RecordUnaggregated(slot, 3, 4, 1)
+	// Out of range committee positions are ignored.
+	s.RecordUnaggregated(slot, 3, 4, 4)
+	s.RecordUnaggregated(slot, 7, 2, 1)
+
+	stats := s.Breakdown(slot)
+	require.Equal(t, 2, len(stats))
+	assert.DeepEqual(t, SubnetSlotStats{Slot: slot, Subnet: 3, Received: 4, UniqueAttesters: 2, ExpectedAttesters: 4}, stats[0])
+	assert.DeepEqual(t, SubnetSlotStats{Slot: slot, Subnet: 7, Received: 1, UniqueAttesters: 1, ExpectedAttesters: 2}, stats[1])
+	assert.Equal(t, 0.5, stats[0].DeliveryRatio())
+	assert.Equal(t, 0.5, stats[1].DeliveryRatio())
+}
+
+func TestSubnetAttestationStats_RecordAggregate(t *testing.T) {
+	s := NewSubnetAttestationStats()
+	slot := primitives.Slot(10)
+	s.RecordAggregate(slot, 1, 8, 5)
+	s.RecordAggregate(slot, 1, 8, 3)
+	stats := s.Breakdown(slot)
+	require.Equal(t, 1, len(stats))
+	assert.Equal(t, uint64(5), stats[0].AggregateBits)
+	assert.Equal(t, uint64(8), stats[0].ExpectedAttesters)
+	assert.Equal(t, uint64(0), stats[0].Received)
+}
+
+func TestSubnetAttestationStats_BreakdownWindowAndPruning(t *testing.T) {
+	s := NewSubnetAttestationStats()
+	spe := params.BeaconConfig().SlotsPerEpoch
+	for slot := primitives.Slot(0); slot < 3*spe; slot++ {
+		s.RecordUnaggregated(slot, uint64(slot)%4, 1, 0)
+	}
+	current := 3*spe - 1
+	stats := s.Breakdown(current)
+	require.Equal(t, int(spe), len(stats))
+	assert.Equal(t, current-spe+1, stats[0].Slot)
+	assert.Equal(t, current, stats[len(stats)-1].Slot)
+
+	s.Lock()
+	for slot := range s.entries {
+		assert.Equal(t, true, slot+spe+2 > current, "slot %d should have been pruned", slot)
+	}
+	s.Unlock()
+
+	// Attestations that are too old to be retained are dropped.
+	s.RecordUnaggregated(0, 0, 1, 0)
+	s.Lock()
+	_, ok := s.entries[0]
+	s.Unlock()
+	assert.Equal(t, false, ok)
+}
+
+func TestSubnetAttestationStats_DeliveryRatios(t *testing.T) {
+	s := NewSubnetAttestationStats()
+	// Subnet 0 receives 3 out of 4 attesters in slots 1 and 2, subnet 1 receives 1 out of 4.
+	for _, slot := range []primitives.Slot{1, 2} {
+		for i := uint64(0); i < 3; i++ {
+			s.RecordUnaggregated(slot, 0, 4, i)
+		}
+		s.RecordUnaggregated(slot, 1, 4, 0)
+	}
+	// Attestations for the two most recent slots are still propagating, so they aren't included yet.
+	ratios := s.DeliveryRatios()
+	assert.Equal(t, 0, len(ratios))
+
+	s.RecordUnaggregated(4, 1, 4, 0)
+	ratios = s.DeliveryRatios()
+	require.Equal(t, 2, len(ratios))
+	assert.Equal(t, 0.75, ratios[0])
+	assert.Equal(t, 0.25, ratios[1])
+}
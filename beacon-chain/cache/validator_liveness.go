@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// livenessEpochs is the number of most recent epochs for which validator liveness is kept.
+const livenessEpochs = 4
+
+type livenessEpoch struct {
+	epoch primitives.Epoch
+	used  bool
+	// live has the bit of a validator index set when the validator was observed performing a duty.
+	live []byte
+}
+
+// ValidatorLiveness records the validators observed performing duties in the most recent epochs, by attesting on
+// gossip or in blocks and by proposing blocks, so that the liveness of validators in those epochs can be answered
+// without regenerating states. Epochs are kept in a ring buffer indexed by epoch.
+type ValidatorLiveness struct {
+	sync.RWMutex
+	epochs  [livenessEpochs]livenessEpoch
+	started bool
+	first   primitives.Epoch
+	highest primitives.Epoch
+}
+
+// NewValidatorLiveness initializes an empty ValidatorLiveness cache.
+func NewValidatorLiveness() *ValidatorLiveness {
+	return &ValidatorLiveness{}
+}
+
+// MarkLive records the validators as having performed a duty in the epoch.
+func (l *ValidatorLiveness) MarkLive(epoch primitives.Epoch, indices ...primitives.ValidatorIndex) {
+	l.Lock()
+	defer l.Unlock()
+	if !l.started {
+		l.started = true
+		l.first = epoch
+		l.highest = epoch
+	}
+	if epoch+livenessEpochs <= l.highest {
+		return
+	}
+	if epoch > l.highest {
+		l.highest = epoch
+	}
+	e := &l.epochs[epoch%livenessEpochs]
+	if !e.used || e.epoch != epoch {
+		e.epoch = epoch
+		e.used = true
+		clear(e.live)
+	}
+	for _, i := range indices {
+		b := int(i / 8)
+		if b >= len(e.live) {
+			e.live = append(e.live, make([]byte, b+1-len(e.live))...)
+		}
+		e.live[b] |= 1 << (i % 8)
+	}
+}
+
+// Covers returns true when the liveness of every validator in the epoch is known. This is the case for the retained
+// epochs which started after the first observation of the cache, as duties of earlier epochs may have been missed.
+func (l *ValidatorLiveness) Covers(epoch primitives.Epoch) bool {
+	l.RLock()
+	defer l.RUnlock()
+	return l.started && epoch > l.first && epoch+livenessEpochs > l.highest
+}
+
+// IsLive returns true when the validator was observed performing a duty in the epoch. The answer is only meaningful
+// for epochs covered by the cache.
+func (l *ValidatorLiveness) IsLive(epoch primitives.Epoch, index primitives.ValidatorIndex) bool {
+	l.RLock()
+	defer l.RUnlock()
+	e := &l.epochs[epoch%livenessEpochs]
+	if !e.used || e.epoch != epoch {
+		return false
+	}
+	b := int(index / 8)
+	return b < len(e.live) && e.live[b]&(1<<(index%8)) != 0
+}
@@ -0,0 +1,45 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+)
+
+func TestValidatorLiveness(t *testing.T) {
+	l := NewValidatorLiveness()
+	assert.Equal(t, false, l.Covers(0))
+
+	l.MarkLive(10, 1, 2)
+	l.MarkLive(11, 3, 1000)
+	l.MarkLive(12)
+
+	// The first epoch may have been observed partially.
+	assert.Equal(t, false, l.Covers(10))
+	assert.Equal(t, true, l.Covers(11))
+	assert.Equal(t, true, l.Covers(12))
+	// An epoch without observations yet.
+	assert.Equal(t, true, l.Covers(13))
+
+	assert.Equal(t, true, l.IsLive(10, 1))
+	assert.Equal(t, true, l.IsLive(10, 2))
+	assert.Equal(t, false, l.IsLive(10, 3))
+	assert.Equal(t, true, l.IsLive(11, 3))
+	assert.Equal(t, true, l.IsLive(11, 1000))
+	assert.Equal(t, false, l.IsLive(11, 999))
+	assert.Equal(t, false, l.IsLive(11, 100000))
+	assert.Equal(t, false, l.IsLive(12, 1))
+
+	// Epoch 15 reuses the entry of epoch 11, which is no longer retained.
+	l.MarkLive(15, 7)
+	assert.Equal(t, false, l.Covers(11))
+	assert.Equal(t, true, l.Covers(12))
+	assert.Equal(t, false, l.IsLive(11, 3))
+	assert.Equal(t, false, l.IsLive(15, 3))
+	assert.Equal(t, true, l.IsLive(15, 7))
+
+	// Observations of epochs no longer retained are dropped.
+	l.MarkLive(11, 3)
+	assert.Equal(t, false, l.IsLive(11, 3))
+	assert.Equal(t, true, l.IsLive(15, 7))
+}
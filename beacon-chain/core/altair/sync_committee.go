@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/math"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
@@ -218,7 +219,7 @@ func ValidateSyncMessageTime(slot primitives.Slot, genesisTime time.Time, clockD
 	}
 
 	lowestSlotBound := slotStartTime.Add(-clockDisparity)
-	currentLowerBound := time.Now().Add(-clockDisparity)
+	currentLowerBound := prysmTime.Now().Add(-clockDisparity)
 	// In the event the Slot's start time, is before the
 	// current allowable bound, we set the slot's start
 	// time as the bound.
@@ -227,7 +228,7 @@ func ValidateSyncMessageTime(slot primitives.Slot, genesisTime time.Time, clockD
 	}
 
 	lowerBound := lowestSlotBound
-	upperBound := time.Now().Add(clockDisparity)
+	upperBound := prysmTime.Now().Add(clockDisparity)
 	// Verify sync message slot is within the time range.
 	if messageTime.Before(lowerBound) || messageTime.After(upperBound) {
 		syncErr := fmt.Errorf(
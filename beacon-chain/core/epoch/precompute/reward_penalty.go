@@ -79,78 +79,114 @@ func AttestationsDelta(state state.ReadOnlyBeaconState, pBal *Balance, vp []*Val
 	return rewards, penalties, nil
 }
 
+// AttDelta contains the rewards and penalties of a validator for its attestation of the previous epoch,
+// broken down by component.
+type AttDelta struct {
+	SourceReward         uint64
+	SourcePenalty        uint64
+	TargetReward         uint64
+	TargetPenalty        uint64
+	HeadReward           uint64
+	HeadPenalty          uint64
+	InclusionDelayReward uint64
+	InactivityPenalty    uint64
+}
+
+// AttestationsDeltaComponents computes the rewards and penalties differences for individual validators based on the
+// voting records, broken down by component. Unlike AttestationsDelta, the result holds one entry for each of the
+// given validators.
+func AttestationsDeltaComponents(state state.ReadOnlyBeaconState, pBal *Balance, vp []*Validator) ([]*AttDelta, error) {
+	deltas := make([]*AttDelta, len(vp))
+	prevEpoch := time.PrevEpoch(state)
+	finalizedEpoch := state.FinalizedCheckpointEpoch()
+
+	sqrtActiveCurrentEpoch := math.CachedSquareRoot(pBal.ActiveCurrentEpoch)
+	for i, v := range vp {
+		deltas[i] = attestationDeltaComponents(pBal, sqrtActiveCurrentEpoch, v, prevEpoch, finalizedEpoch)
+	}
+	return deltas, nil
+}
+
 func attestationDelta(pBal *Balance, sqrtActiveCurrentEpoch uint64, v *Validator, prevEpoch, finalizedEpoch primitives.Epoch) (uint64, uint64) {
+	d := attestationDeltaComponents(pBal, sqrtActiveCurrentEpoch, v, prevEpoch, finalizedEpoch)
+	r := d.SourceReward + d.TargetReward + d.HeadReward + d.InclusionDelayReward
+	p := d.SourcePenalty + d.TargetPenalty + d.HeadPenalty + d.InactivityPenalty
+	return r, p
+}
+
+func attestationDeltaComponents(pBal *Balance, sqrtActiveCurrentEpoch uint64, v *Validator, prevEpoch, finalizedEpoch primitives.Epoch) *AttDelta {
+	d := &AttDelta{}
 	if !EligibleForRewards(v) || pBal.ActiveCurrentEpoch == 0 {
-		return 0, 0
+		return d
 	}
 
 	baseRewardsPerEpoch := params.BeaconConfig().BaseRewardsPerEpoch
 	effectiveBalanceIncrement := params.BeaconConfig().EffectiveBalanceIncrement
 	vb := v.CurrentEpochEffectiveBalance
 	br := vb * params.BeaconConfig().BaseRewardFactor / sqrtActiveCurrentEpoch / baseRewardsPerEpoch
-	r, p := uint64(0), uint64(0)
 	currentEpochBalance := pBal.ActiveCurrentEpoch / effectiveBalanceIncrement
+	leak := helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch)
 
 	// Process source reward / penalty
 	if v.IsPrevEpochAttester && !v.IsSlashed {
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
 		maxAttesterReward := br - proposerReward
-		r += maxAttesterReward / uint64(v.InclusionDistance)
+		d.InclusionDelayReward = maxAttesterReward / uint64(v.InclusionDistance)
 
-		if helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch) {
+		if leak {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.SourceReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			d.SourceReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		d.SourcePenalty = br
 	}
 
 	// Process target reward / penalty
 	if v.IsPrevEpochTargetAttester && !v.IsSlashed {
-		if helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch) {
+		if leak {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.TargetReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochTargetAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			d.TargetReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		d.TargetPenalty = br
 	}
 
 	// Process head reward / penalty
 	if v.IsPrevEpochHeadAttester && !v.IsSlashed {
-		if helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch) {
+		if leak {
 			// Since full base reward will be canceled out by inactivity penalty deltas,
 			// optimal participation receives full base reward compensation here.
-			r += br
+			d.HeadReward = br
 		} else {
 			rewardNumerator := br * (pBal.PrevEpochHeadAttested / effectiveBalanceIncrement)
-			r += rewardNumerator / currentEpochBalance
+			d.HeadReward = rewardNumerator / currentEpochBalance
 		}
 	} else {
-		p += br
+		d.HeadPenalty = br
 	}
 
 	// Process finality delay penalty
-	if helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch) {
+	if leak {
 		// If validator is performing optimally, this cancels all rewards for a neutral balance.
 		proposerReward := br / params.BeaconConfig().ProposerRewardQuotient
-		p += baseRewardsPerEpoch*br - proposerReward
+		d.InactivityPenalty = baseRewardsPerEpoch*br - proposerReward
 		// Apply an additional penalty to validators that did not vote on the correct target or has been slashed.
 		// Equivalent to the following condition from the spec:
 		// `index not in get_unslashed_attesting_indices(state, matching_target_attestations)`
 		if !v.IsPrevEpochTargetAttester || v.IsSlashed {
 			finalityDelay := helpers.FinalityDelay(prevEpoch, finalizedEpoch)
-			p += vb * uint64(finalityDelay) / params.BeaconConfig().InactivityPenaltyQuotient
+			d.InactivityPenalty += vb * uint64(finalityDelay) / params.BeaconConfig().InactivityPenaltyQuotient
 		}
 	}
-	return r, p
+	return d
 }
 
 // ProposersDelta computes and returns the rewards and penalties differences for individual validators based on the
@@ -58,6 +58,58 @@ func TestProcessRewardsAndPenaltiesPrecompute(t *testing.T) {
 	assert.Equal(t, wanted, beaconState.Balances()[0], "Unexpected balance")
 }
 
+func TestAttestationsDeltaComponents(t *testing.T) {
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := uint64(2048)
+	base := buildState(e+3, validatorCount)
+	atts := make([]*ethpb.PendingAttestation, 3)
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &ethpb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Target: &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+				Source: &ethpb.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+			},
+			AggregationBits: bitfield.Bitlist{0x00, 0x00, 0x00, 0x00, 0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+			InclusionDelay:  2,
+		}
+	}
+	base.PreviousEpochAttestations = atts
+	beaconState, err := state_native.InitializeFromProtoPhase0(base)
+	require.NoError(t, err)
+
+	vp, bp, err := New(context.Background(), beaconState)
+	require.NoError(t, err)
+	vp, bp, err = ProcessAttestations(context.Background(), beaconState, vp, bp)
+	require.NoError(t, err)
+
+	rewards, penalties, err := AttestationsDelta(beaconState, bp, vp)
+	require.NoError(t, err)
+	deltas, err := AttestationsDeltaComponents(beaconState, bp, vp)
+	require.NoError(t, err)
+	require.Equal(t, len(vp), len(deltas))
+	for i, d := range deltas {
+		assert.Equal(t, rewards[i], d.SourceReward+d.TargetReward+d.HeadReward+d.InclusionDelayReward, "Unexpected rewards of validator %d", i)
+		assert.Equal(t, penalties[i], d.SourcePenalty+d.TargetPenalty+d.HeadPenalty+d.InactivityPenalty, "Unexpected penalties of validator %d", i)
+	}
+
+	// Validators voting for source and target, but not head, included with a delay of 2.
+	attester := -1
+	for i, v := range vp {
+		if v.IsPrevEpochAttester && !v.IsPrevEpochHeadAttester {
+			attester = i
+			break
+		}
+	}
+	require.NotEqual(t, -1, attester, "No attester in the previous epoch")
+	br := vp[attester].CurrentEpochEffectiveBalance * params.BeaconConfig().BaseRewardFactor / math.CachedSquareRoot(bp.ActiveCurrentEpoch) / params.BeaconConfig().BaseRewardsPerEpoch
+	assert.Equal(t, (br-br/params.BeaconConfig().ProposerRewardQuotient)/2, deltas[attester].InclusionDelayReward)
+	assert.NotEqual(t, uint64(0), deltas[attester].SourceReward)
+	assert.NotEqual(t, uint64(0), deltas[attester].TargetReward)
+	assert.Equal(t, br, deltas[attester].HeadPenalty)
+	// Validators not voting are penalized for every component.
+	assert.DeepEqual(t, &AttDelta{SourcePenalty: br, TargetPenalty: br, HeadPenalty: br}, deltas[0])
+}
+
 func TestAttestationDeltas_ZeroEpoch(t *testing.T) {
 	e := params.BeaconConfig().SlotsPerEpoch
 	validatorCount := uint64(2048)
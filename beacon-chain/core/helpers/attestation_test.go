@@ -45,6 +45,38 @@ func TestAttestation_IsAggregator(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, false, agg, "Wanted aggregator false")
 	})
+
+	t.Run("heterogeneous effective balances", func(t *testing.T) {
+		helpers.ClearCache()
+
+		// Aggregator selection depends on the committee size only, which is not weighted by effective balance.
+		beaconState, privKeys := util.DeterministicGenesisStateElectra(t, 256)
+		uniform, err := helpers.BeaconCommitteeFromState(context.Background(), beaconState, 0, 0)
+		require.NoError(t, err)
+		want := make([]bool, len(privKeys))
+		for i, k := range privKeys {
+			want[i], err = helpers.IsAggregator(uint64(len(uniform)), k.Sign([]byte{'A'}).Marshal())
+			require.NoError(t, err)
+		}
+
+		helpers.ClearCache()
+		increment := params.BeaconConfig().EffectiveBalanceIncrement
+		minEB := params.BeaconConfig().MinActivationBalance
+		maxEB := params.BeaconConfig().MaxEffectiveBalanceElectra
+		vals := beaconState.Validators()
+		for i, v := range vals {
+			v.EffectiveBalance = minEB + (uint64(i)*97*increment)%(maxEB-minEB+increment)
+		}
+		require.NoError(t, beaconState.SetValidators(vals))
+		committee, err := helpers.BeaconCommitteeFromState(context.Background(), beaconState, 0, 0)
+		require.NoError(t, err)
+		require.DeepEqual(t, uniform, committee)
+		for i, k := range privKeys {
+			agg, err := helpers.IsAggregator(uint64(len(committee)), k.Sign([]byte{'A'}).Marshal())
+			require.NoError(t, err)
+			require.Equal(t, want[i], agg, "validator %d", i)
+		}
+	})
 }
 
 func TestAttestation_ComputeSubnetForAttestation(t *testing.T) {
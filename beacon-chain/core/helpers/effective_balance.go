@@ -0,0 +1,86 @@
+package helpers
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// EffectiveBalanceHysteresis is the outcome of the hysteresis rules of process_effective_balance_updates for a
+// validator, computed from its current balance and effective balance.
+type EffectiveBalanceHysteresis struct {
+	// Next is the effective balance of the validator after the next epoch transition.
+	Next uint64
+	// WillChange is true when the effective balance changes at the next epoch transition.
+	WillChange bool
+	// CanDecrease is false when no balance is low enough to decrease the effective balance.
+	CanDecrease bool
+	// DownwardDistance is the balance the validator can lose before its effective balance decreases, 0 when
+	// it already decreases at the next epoch transition.
+	DownwardDistance uint64
+	// CanIncrease is false when the effective balance is at the limit of the validator.
+	CanIncrease bool
+	// UpwardDistance is the balance the validator must gain for its effective balance to increase, 0 when it
+	// already increases at the next epoch transition.
+	UpwardDistance uint64
+}
+
+// EffectiveBalanceLimit returns the highest effective balance of a validator with the withdrawal credentials at the
+// fork version: MIN_ACTIVATION_BALANCE from Electra, unless the credentials are compounding ones.
+//
+// Spec pseudocode definition:
+//
+//	EFFECTIVE_BALANCE_LIMIT = (
+//	    MAX_EFFECTIVE_BALANCE_EIP7251 if has_compounding_withdrawal_credential(validator)
+//	    else MIN_ACTIVATION_BALANCE
+//	)
+func EffectiveBalanceLimit(withdrawalCredentials []byte, v int) uint64 {
+	cfg := params.BeaconConfig()
+	if v < version.Electra {
+		return cfg.MaxEffectiveBalance
+	}
+	if IsCompoundingWithdrawalCredential(withdrawalCredentials) {
+		return cfg.MaxEffectiveBalanceElectra
+	}
+	return cfg.MinActivationBalance
+}
+
+// ComputeEffectiveBalanceHysteresis applies the hysteresis rules of process_effective_balance_updates to a
+// validator, and computes how far its balance is from the thresholds which would change its effective balance.
+//
+// Spec pseudocode definition:
+//
+//	HYSTERESIS_INCREMENT = uint64(EFFECTIVE_BALANCE_INCREMENT // HYSTERESIS_QUOTIENT)
+//	DOWNWARD_THRESHOLD = HYSTERESIS_INCREMENT * HYSTERESIS_DOWNWARD_MULTIPLIER
+//	UPWARD_THRESHOLD = HYSTERESIS_INCREMENT * HYSTERESIS_UPWARD_MULTIPLIER
+//	if (
+//	    balance + DOWNWARD_THRESHOLD < validator.effective_balance
+//	    or validator.effective_balance + UPWARD_THRESHOLD < balance
+//	):
+//	    validator.effective_balance = min(balance - balance % EFFECTIVE_BALANCE_INCREMENT, EFFECTIVE_BALANCE_LIMIT)
+func ComputeEffectiveBalanceHysteresis(balance, effectiveBalance, limit uint64) *EffectiveBalanceHysteresis {
+	cfg := params.BeaconConfig()
+	effBalanceInc := cfg.EffectiveBalanceIncrement
+	hysteresisInc := effBalanceInc / cfg.HysteresisQuotient
+	downwardThreshold := hysteresisInc * cfg.HysteresisDownwardMultiplier
+	upwardThreshold := hysteresisInc * cfg.HysteresisUpwardMultiplier
+
+	h := &EffectiveBalanceHysteresis{Next: effectiveBalance}
+	decreases := balance+downwardThreshold < effectiveBalance
+	increases := effectiveBalance+upwardThreshold < balance
+	if decreases || increases {
+		h.Next = min(balance-balance%effBalanceInc, limit)
+	}
+	h.WillChange = h.Next != effectiveBalance
+
+	// The effective balance decreases once the balance is lower than effectiveBalance - downwardThreshold.
+	h.CanDecrease = effectiveBalance > downwardThreshold
+	if h.CanDecrease && !decreases {
+		h.DownwardDistance = balance + downwardThreshold - effectiveBalance + 1
+	}
+	// The effective balance increases once the balance is higher than effectiveBalance + upwardThreshold.
+	h.CanIncrease = effectiveBalance < limit
+	if h.CanIncrease && !increases {
+		h.UpwardDistance = effectiveBalance + upwardThreshold + 1 - balance
+	}
+	return h
+}
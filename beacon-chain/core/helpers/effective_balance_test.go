@@ -0,0 +1,96 @@
+package helpers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestEffectiveBalanceLimit(t *testing.T) {
+	cfg := params.BeaconConfig()
+	eth1Creds := []byte{cfg.ETH1AddressWithdrawalPrefixByte}
+	compoundingCreds := []byte{cfg.CompoundingWithdrawalPrefixByte}
+	assert.Equal(t, cfg.MaxEffectiveBalance, EffectiveBalanceLimit(compoundingCreds, version.Deneb))
+	assert.Equal(t, cfg.MinActivationBalance, EffectiveBalanceLimit(eth1Creds, version.Electra))
+	assert.Equal(t, cfg.MaxEffectiveBalanceElectra, EffectiveBalanceLimit(compoundingCreds, version.Electra))
+}
+
+// The cases of test_effective_balance_hysteresis and test_effective_balance_hysteresis_with_compounding_credentials
+// of the consensus spec tests.
+func TestComputeEffectiveBalanceHysteresis_SpecVectors(t *testing.T) {
+	cfg := params.BeaconConfig()
+	for _, limit := range []uint64{cfg.MaxEffectiveBalance, cfg.MinActivationBalance, cfg.MaxEffectiveBalanceElectra} {
+		maxBal := limit
+		inc := cfg.EffectiveBalanceIncrement
+		minBal := maxBal - inc*4
+		div := cfg.HysteresisQuotient
+		hysInc := inc / div
+		down := cfg.HysteresisDownwardMultiplier
+		up := cfg.HysteresisUpwardMultiplier
+		for _, tc := range []struct {
+			pre, balance, post uint64
+			name               string
+		}{
+			{maxBal, maxBal, maxBal, "as-is"},
+			{maxBal, maxBal - 1, maxBal, "round up"},
+			{maxBal, maxBal + 1, maxBal, "round down"},
+			{maxBal, maxBal - hysInc*down, maxBal, "lower balance, but not low enough"},
+			{maxBal, maxBal - hysInc*down - 1, maxBal - inc, "lower balance, step down"},
+			{maxBal, maxBal + hysInc*up + 1, maxBal, "already at max, as is"},
+			{maxBal, maxBal - inc, maxBal - inc, "exactly 1 step lower"},
+			{maxBal, maxBal - inc - 1, maxBal - 2*inc, "past 1 step lower, double step"},
+			{maxBal, maxBal - inc + 1, maxBal - inc, "close to 1 step lower"},
+			{minBal, minBal + hysInc*up, minBal, "bigger balance, but not high enough"},
+			{minBal, minBal + hysInc*up + 1, minBal + inc, "bigger balance, high enough, but small step"},
+			{minBal, minBal + hysInc*div*2 - 1, minBal + inc, "bigger balance, high enough, close to double step"},
+			{minBal, minBal + hysInc*div*2, minBal + 2*inc, "exact two step balance increment"},
+			{minBal, minBal + hysInc*div*2 + 1, minBal + 2*inc, "over two steps, round down"},
+		} {
+			t.Run(fmt.Sprintf("limit %d %s", limit, tc.name), func(t *testing.T) {
+				h := ComputeEffectiveBalanceHysteresis(tc.balance, tc.pre, limit)
+				assert.Equal(t, tc.post, h.Next)
+				assert.Equal(t, tc.post != tc.pre, h.WillChange)
+			})
+		}
+	}
+}
+
+func TestComputeEffectiveBalanceHysteresis_Distances(t *testing.T) {
+	cfg := params.BeaconConfig()
+	hysInc := cfg.EffectiveBalanceIncrement / cfg.HysteresisQuotient
+	down := hysInc * cfg.HysteresisDownwardMultiplier
+	up := hysInc * cfg.HysteresisUpwardMultiplier
+	minActivation := cfg.MinActivationBalance
+	compoundingLimit := cfg.MaxEffectiveBalanceElectra
+
+	// At the limit, the effective balance can only decrease.
+	h := ComputeEffectiveBalanceHysteresis(minActivation+up+1, minActivation, minActivation)
+	require.Equal(t, false, h.CanIncrease)
+	require.Equal(t, true, h.CanDecrease)
+	assert.Equal(t, up+down+2, h.DownwardDistance)
+	assert.Equal(t, false, h.WillChange)
+
+	// The same balance increases the effective balance of a compounding validator.
+	h = ComputeEffectiveBalanceHysteresis(minActivation+up+1, minActivation, compoundingLimit)
+	require.Equal(t, true, h.CanIncrease)
+	assert.Equal(t, uint64(0), h.UpwardDistance)
+	assert.Equal(t, true, h.WillChange)
+	assert.Equal(t, minActivation+cfg.EffectiveBalanceIncrement, h.Next)
+
+	// Losing one more Gwei, or gaining two, would change the effective balance.
+	h = ComputeEffectiveBalanceHysteresis(minActivation-down, minActivation, compoundingLimit)
+	assert.Equal(t, uint64(1), h.DownwardDistance)
+	assert.Equal(t, up+down+1, h.UpwardDistance)
+	h = ComputeEffectiveBalanceHysteresis(minActivation+up-1, minActivation, compoundingLimit)
+	assert.Equal(t, uint64(2), h.UpwardDistance)
+	assert.Equal(t, false, h.WillChange)
+
+	// A validator without effective balance cannot decrease it.
+	h = ComputeEffectiveBalanceHysteresis(0, 0, minActivation)
+	assert.Equal(t, false, h.CanDecrease)
+	assert.Equal(t, up+1, h.UpwardDistance)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/math"
 	v1alpha1 "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
@@ -57,6 +58,10 @@ import (
 //
 //	return ws_period
 func ComputeWeakSubjectivityPeriod(ctx context.Context, st state.ReadOnlyBeaconState, cfg *params.BeaconChainConfig) (primitives.Epoch, error) {
+	if st.Version() >= version.Electra {
+		return computeWeakSubjectivityPeriodElectra(st, cfg)
+	}
+
 	// Weak subjectivity period cannot be smaller than withdrawal delay.
 	wsp := uint64(cfg.MinValidatorWithdrawabilityDelay)
 
@@ -103,6 +108,31 @@ func ComputeWeakSubjectivityPeriod(ctx context.Context, st state.ReadOnlyBeaconS
 	return primitives.Epoch(wsp), nil
 }
 
+// computeWeakSubjectivityPeriodElectra returns the weak subjectivity period post Electra, where the churn is
+// bounded by balance rather than by validator count.
+//
+// Reference spec implementation:
+// https://github.com/ethereum/consensus-specs/blob/master/specs/electra/weak-subjectivity.md#modified-compute_weak_subjectivity_period
+//
+// def compute_weak_subjectivity_period(state: BeaconState) -> uint64:
+//
+//	t = get_total_active_balance(state)
+//	delta = get_balance_churn_limit(state)
+//	epochs_for_validator_set_churn = SAFETY_DECAY * t // (2 * delta * 100)
+//	return MIN_VALIDATOR_WITHDRAWABILITY_DELAY + epochs_for_validator_set_churn
+func computeWeakSubjectivityPeriodElectra(st state.ReadOnlyBeaconState, cfg *params.BeaconChainConfig) (primitives.Epoch, error) {
+	t, err := TotalActiveBalance(st)
+	if err != nil {
+		return 0, fmt.Errorf("cannot find total active balance of validators: %w", err)
+	}
+	delta := uint64(BalanceChurnLimit(primitives.Gwei(t)))
+	if delta == 0 {
+		return 0, errors.New("balance churn limit is zero")
+	}
+	epochsForValidatorSetChurn := cfg.SafetyDecay * t / (2 * delta * 100)
+	return cfg.MinValidatorWithdrawabilityDelay + primitives.Epoch(epochsForValidatorSetChurn), nil
+}
+
 // IsWithinWeakSubjectivityPeriod verifies if a given weak subjectivity checkpoint is not stale i.e.
 // the current node is so far beyond, that a given state and checkpoint are not for the latest weak
 // subjectivity point. Provided checkpoint still can be used to double-check that node's block root
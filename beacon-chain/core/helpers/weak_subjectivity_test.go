@@ -56,6 +56,41 @@ func TestWeakSubjectivity_ComputeWeakSubjectivityPeriod(t *testing.T) {
 	}
 }
 
+func TestWeakSubjectivity_ComputeWeakSubjectivityPeriod_Electra(t *testing.T) {
+	tests := []struct {
+		valCount   uint64
+		avgBalance uint64
+		want       primitives.Epoch
+	}{
+		// Asserting that we get the same numbers as defined in the reference table:
+		// https://github.com/ethereum/consensus-specs/blob/master/specs/electra/weak-subjectivity.md#modified-compute_weak_subjectivity_period
+		{valCount: 32768, avgBalance: 32, want: 665},
+		{valCount: 65536, avgBalance: 32, want: 1075},
+		{valCount: 131072, avgBalance: 32, want: 1894},
+		{valCount: 262144, avgBalance: 32, want: 3532},
+		{valCount: 524288, avgBalance: 32, want: 3532},
+		// The period depends on the total active balance only, not on the validator count.
+		{valCount: 512, avgBalance: 2048, want: 665},
+		{valCount: 4096, avgBalance: 2048, want: 3532},
+		{valCount: 1024, avgBalance: 1024, want: 665},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("valCount: %d, avgBalance: %d", tt.valCount, tt.avgBalance), func(t *testing.T) {
+			helpers.ClearCache()
+
+			beaconState, err := util.NewBeaconStateElectra()
+			require.NoError(t, err)
+			st := genState(t, tt.valCount, tt.avgBalance)
+			require.NoError(t, beaconState.SetValidators(st.Validators()))
+			require.NoError(t, beaconState.SetBalances(st.Balances()))
+
+			got, err := helpers.ComputeWeakSubjectivityPeriod(context.Background(), beaconState, params.BeaconConfig())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got, "valCount: %v, avgBalance: %v", tt.valCount, tt.avgBalance)
+		})
+	}
+}
+
 type mockWsCheckpoint func() (stateRoot [32]byte, blockRoot [32]byte, e primitives.Epoch)
 
 func TestWeakSubjectivity_IsWithinWeakSubjectivityPeriod(t *testing.T) {
@@ -0,0 +1,81 @@
+package peerdas
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+// ErrCustodySubnetCountTooLarge is returned when a node would custody more data column sidecar subnets than exist.
+var ErrCustodySubnetCountTooLarge = errors.New("custody subnet count larger than data column sidecar subnet count")
+
+// CustodySubnets returns the data column sidecar subnets custodied by the node with the given ID.
+//
+// Spec pseudocode definition:
+//
+//	def get_custody_columns(node_id: NodeID, custody_subnet_count: uint64) -> Sequence[ColumnIndex]:
+//	  assert custody_subnet_count <= DATA_COLUMN_SIDECAR_SUBNET_COUNT
+//
+//	  subnet_ids: List[uint64] = []
+//	  current_id = uint256(node_id)
+//	  while len(subnet_ids) < custody_subnet_count:
+//	      subnet_id = (
+//	          bytes_to_uint64(hash(uint_to_bytes(uint256(current_id)))[0:8])
+//	          % DATA_COLUMN_SIDECAR_SUBNET_COUNT
+//	      )
+//	      if subnet_id not in subnet_ids:
+//	          subnet_ids.append(subnet_id)
+//	      if current_id == UINT256_MAX:
+//	          # Overflow prevention
+//	          current_id = NodeID(0)
+//	      else:
+//	          current_id += 1
+//	  ...
+func CustodySubnets(nodeID enode.ID, custodySubnetCount uint64) (map[uint64]bool, error) {
+	subnetCount := params.BeaconConfig().DataColumnSidecarSubnetCount
+	if custodySubnetCount > subnetCount {
+		return nil, errors.Wrapf(ErrCustodySubnetCountTooLarge, "%d > %d", custodySubnetCount, subnetCount)
+	}
+	subnets := make(map[uint64]bool, custodySubnetCount)
+	one := uint256.NewInt(1)
+	// The addition wraps around to 0 after the maximum value, as the spec does.
+	for currentID := new(uint256.Int).SetBytes(nodeID.Bytes()); uint64(len(subnets)) < custodySubnetCount; currentID.Add(currentID, one) {
+		bigEndian := currentID.Bytes32()
+		h := hash.Hash(bytesutil.ReverseByteOrder(bigEndian[:]))
+		subnets[binary.LittleEndian.Uint64(h[:8])%subnetCount] = true
+	}
+	return subnets, nil
+}
+
+// CustodyColumns returns the indices of the data columns custodied by the node with the given ID, the columns of
+// its custody subnets.
+func CustodyColumns(nodeID enode.ID, custodySubnetCount uint64) (map[uint64]bool, error) {
+	subnets, err := CustodySubnets(nodeID, custodySubnetCount)
+	if err != nil {
+		return nil, err
+	}
+	subnetCount := params.BeaconConfig().DataColumnSidecarSubnetCount
+	columnsPerSubnet := params.BeaconConfig().NumberOfColumns / subnetCount
+	columns := make(map[uint64]bool, uint64(len(subnets))*columnsPerSubnet)
+	for i := uint64(0); i < columnsPerSubnet; i++ {
+		for subnet := range subnets {
+			columns[subnetCount*i+subnet] = true
+		}
+	}
+	return columns, nil
+}
+
+// ComputeSubnetForDataColumnSidecar returns the subnet of the data column sidecar with the given column index.
+//
+// Spec pseudocode definition:
+//
+//	def compute_subnet_for_data_column_sidecar(column_index: ColumnIndex) -> SubnetID:
+//	  return SubnetID(column_index % DATA_COLUMN_SIDECAR_SUBNET_COUNT)
+func ComputeSubnetForDataColumnSidecar(columnIndex uint64) uint64 {
+	return columnIndex % params.BeaconConfig().DataColumnSidecarSubnetCount
+}
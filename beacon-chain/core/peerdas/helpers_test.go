@@ -0,0 +1,95 @@
+package peerdas_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func subnetSet(subnets ...uint64) map[uint64]bool {
+	set := make(map[uint64]bool, len(subnets))
+	for _, s := range subnets {
+		set[s] = true
+	}
+	return set
+}
+
+func TestCustodySubnets(t *testing.T) {
+	var maxID enode.ID
+	for i := range maxID {
+		maxID[i] = 0xff
+	}
+	var abID enode.ID
+	for i := range abID {
+		abID[i] = 0xab
+	}
+	// Computed with the pseudocode of the spec.
+	for _, tc := range []struct {
+		name   string
+		nodeID enode.ID
+		want   map[uint64]bool
+	}{
+		{"zero node ID", enode.ID{}, subnetSet(1, 17, 87, 102)},
+		{"node ID", abID, subnetSet(26, 43, 100, 113)},
+		{"max node ID wraps around", maxID, subnetSet(1, 47, 87, 102)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			subnets, err := peerdas.CustodySubnets(tc.nodeID, 4)
+			require.NoError(t, err)
+			require.DeepEqual(t, tc.want, subnets)
+			// The subnets only depend on the node ID and the custody subnet count.
+			again, err := peerdas.CustodySubnets(tc.nodeID, 4)
+			require.NoError(t, err)
+			require.DeepEqual(t, subnets, again)
+		})
+	}
+}
+
+func TestCustodySubnets_Count(t *testing.T) {
+	subnetCount := params.BeaconConfig().DataColumnSidecarSubnetCount
+	nodeID := enode.ID{'a'}
+
+	fewer, err := peerdas.CustodySubnets(nodeID, 4)
+	require.NoError(t, err)
+	more, err := peerdas.CustodySubnets(nodeID, 8)
+	require.NoError(t, err)
+	assert.Equal(t, 8, len(more))
+	// Custodying more subnets keeps the subnets custodied with fewer.
+	for subnet := range fewer {
+		assert.Equal(t, true, more[subnet])
+	}
+
+	all, err := peerdas.CustodySubnets(nodeID, subnetCount)
+	require.NoError(t, err)
+	assert.Equal(t, int(subnetCount), len(all))
+	none, err := peerdas.CustodySubnets(nodeID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(none))
+
+	_, err = peerdas.CustodySubnets(nodeID, subnetCount+1)
+	require.ErrorIs(t, err, peerdas.ErrCustodySubnetCountTooLarge)
+}
+
+func TestCustodyColumns(t *testing.T) {
+	cfg := params.BeaconConfig().Copy()
+	cfg.NumberOfColumns = 2 * cfg.DataColumnSidecarSubnetCount
+	undo, err := params.SetActiveWithUndo(cfg)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, undo())
+	}()
+
+	nodeID := enode.ID{'a'}
+	subnets, err := peerdas.CustodySubnets(nodeID, 4)
+	require.NoError(t, err)
+	columns, err := peerdas.CustodyColumns(nodeID, 4)
+	require.NoError(t, err)
+	require.Equal(t, 8, len(columns))
+	for column := range columns {
+		assert.Equal(t, true, subnets[peerdas.ComputeSubnetForDataColumnSidecar(column)])
+	}
+}
@@ -14,12 +14,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/afero"
 )
@@ -84,6 +86,16 @@ func NewBlobStorage(opts ...BlobStorageOption) (*BlobStorage, error) {
 		return nil, errors.Wrapf(err, "failed to create blob storage at %s", b.base)
 	}
 	b.fs = afero.NewBasePathFs(afero.NewOsFs(), b.base)
+	// Blobs must be served for the whole data availability window.
+	if minRetention := params.BeaconConfig().MinEpochsForBlobsSidecarsRequest; b.retentionEpochs < minRetention {
+		if b.retentionEpochs != 0 {
+			log.WithFields(logrus.Fields{
+				"retentionEpochs": b.retentionEpochs,
+				"minimum":         minRetention,
+			}).Warn("Blob retention epochs is below the data availability window, using the minimum instead")
+		}
+		b.retentionEpochs = minRetention
+	}
 	pruner, err := newBlobPruner(b.fs, b.retentionEpochs)
 	if err != nil {
 		return nil, err
@@ -101,6 +113,37 @@ type BlobStorage struct {
 	pruner          *blobPruner
 }
 
+// PruneBefore removes the blobs of the blocks before the start of the epoch, along with the partial files left over
+// by interrupted saves, and returns what was removed. It is meant to reclaim space while the beacon node is not
+// running. Blobs within the data availability window of the newest blobs in storage are never removed, so the epoch
+// is lowered to the start of the window when it is within it.
+func (bs *BlobStorage) PruneBefore(epoch primitives.Epoch) (*PruneStats, error) {
+	before, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not compute start slot of epoch %d", epoch)
+	}
+	pruner, err := newBlobPruner(bs.fs, bs.retentionEpochs, withAbandonedPartAge(0), withWarmedCache())
+	if err != nil {
+		return nil, err
+	}
+	window, err := slots.EpochStart(params.BeaconConfig().MinEpochsForBlobsSidecarsRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute data availability window")
+	}
+	if latest, ok := pruner.cache.latest(); ok && before > windowMin(latest, window) {
+		minBefore := windowMin(latest, window)
+		log.WithFields(logrus.Fields{
+			"requestedSlot": before,
+			"prunedSlot":    minBefore,
+			"latestSlot":    latest,
+		}).Warn("Not pruning blobs within the data availability window of the latest blobs")
+		before = minBefore
+	}
+	pruner.Lock()
+	defer pruner.Unlock()
+	return pruner.pruneWithStats(before)
+}
+
 // WarmCache runs the prune routine with an expiration of slot of 0, so nothing will be pruned, but the pruner's cache
 // will be populated at node startup, avoiding a costly cold prune (~4s in syscalls) during syncing.
 func (bs *BlobStorage) WarmCache() {
@@ -216,6 +259,9 @@ func (bs *BlobStorage) Save(sidecar blocks.VerifiedROBlob) error {
 		return errors.Wrap(err, "failed to rename partial file to final name")
 	}
 	partialMoved = true
+	if bs.pruner != nil {
+		bs.pruner.hashes.add(sidecar.KzgCommitment, sidecar.BlockRoot(), sidecar.Index)
+	}
 	blobsWrittenCounter.Inc()
 	blobSaveLatency.Observe(float64(time.Since(startTime).Milliseconds()))
 	return nil
@@ -249,9 +295,31 @@ func (bs *BlobStorage) Get(root [32]byte, idx uint64) (blocks.VerifiedROBlob, er
 // Remove removes all blobs for a given root.
 func (bs *BlobStorage) Remove(root [32]byte) error {
 	rootDir := blobNamer{root: root}.dir()
+	if bs.pruner != nil {
+		bs.pruner.hashes.evict(root)
+	}
 	return bs.fs.RemoveAll(rootDir)
 }
 
+// LocateVersionedHash returns the root of the block and the index of the stored blob whose kzg commitment has the
+// versioned hash, or ErrVersionedHashNotFound if there is no such blob.
+func (bs *BlobStorage) LocateVersionedHash(hash [32]byte) (BlobLocation, error) {
+	if bs.pruner == nil {
+		return BlobLocation{}, ErrVersionedHashNotFound
+	}
+	if loc, ok := bs.pruner.hashes.location(hash); ok {
+		return loc, nil
+	}
+	// The blob may have been stored before the node started.
+	if err := bs.pruner.indexStored(); err != nil {
+		return BlobLocation{}, errors.Wrap(err, "could not index stored blobs")
+	}
+	if loc, ok := bs.pruner.hashes.location(hash); ok {
+		return loc, nil
+	}
+	return BlobLocation{}, ErrVersionedHashNotFound
+}
+
 // Indices generates a bitmap representing which BlobSidecar.Index values are present on disk for a given root.
 // This value can be compared to the commitments observed in a block to determine which indices need to be found
 // on the network to confirm data availability.
@@ -11,11 +11,13 @@ import (
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/spf13/afero"
 )
 
@@ -242,8 +244,57 @@ func BenchmarkPruning(b *testing.B) {
 func TestNewBlobStorage(t *testing.T) {
 	_, err := NewBlobStorage()
 	require.ErrorIs(t, err, errNoBasePath)
-	_, err = NewBlobStorage(WithBasePath(path.Join(t.TempDir(), "good")))
+	bs, err := NewBlobStorage(WithBasePath(path.Join(t.TempDir(), "good")))
 	require.NoError(t, err)
+	require.Equal(t, params.BeaconConfig().MinEpochsForBlobsSidecarsRequest, bs.retentionEpochs)
+
+	// Blobs are retained for at least the data availability window.
+	bs, err = NewBlobStorage(WithBasePath(path.Join(t.TempDir(), "short")), WithBlobRetentionEpochs(1))
+	require.NoError(t, err)
+	require.Equal(t, params.BeaconConfig().MinEpochsForBlobsSidecarsRequest, bs.retentionEpochs)
+}
+
+func TestBlobStorage_PruneBefore(t *testing.T) {
+	window := params.BeaconConfig().MinEpochsForBlobsSidecarsRequest
+	latestEpoch := window + 10
+	// Retain all the blobs saved by the test, so that they are only pruned by PruneBefore.
+	fs := afero.NewMemMapFs()
+	pruner, err := newBlobPruner(fs, latestEpoch, withWarmedCache())
+	require.NoError(t, err)
+	bs := &BlobStorage{fs: fs, pruner: pruner}
+	for _, epoch := range []primitives.Epoch{1, 5, 10, latestEpoch} {
+		slot, err := slots.EpochStart(epoch)
+		require.NoError(t, err)
+		root := bytesutil.ToBytes32(bytesutil.ToBytes(uint64(slot), 32))
+		_, sidecars := util.GenerateTestDenebBlockWithSidecar(t, root, slot, 2)
+		testSidecars, err := verification.BlobSidecarSliceNoop(sidecars)
+		require.NoError(t, err)
+		for _, sc := range testSidecars {
+			require.NoError(t, bs.Save(sc))
+		}
+	}
+	// A partial file left over by a crash is removed.
+	partRoot := rootString(bytesutil.ToBytes32([]byte("abandoned")))
+	require.NoError(t, afero.WriteFile(fs, path.Join(partRoot, "0-1.part"), []byte("derp"), 0600))
+
+	stats, err := bs.PruneBefore(5)
+	require.NoError(t, err)
+	require.Equal(t, 2, stats.Files)
+	require.Equal(t, uint64(2*fieldparams.BlobSidecarSize+4), stats.Bytes)
+	remaining, err := afero.ReadDir(fs, ".")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(remaining))
+
+	// The blobs within the data availability window of the latest blobs are kept.
+	stats, err = bs.PruneBefore(latestEpoch + 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, stats.Files)
+	wantBefore, err := slots.EpochStart(latestEpoch - window)
+	require.NoError(t, err)
+	require.Equal(t, wantBefore, stats.Before)
+	remaining, err = afero.ReadDir(fs, ".")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(remaining))
 }
 
 func TestConfig_WithinRetentionPeriod(t *testing.T) {
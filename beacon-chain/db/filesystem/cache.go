@@ -93,6 +93,19 @@ func (s *blobStorageCache) slot(key [32]byte) (primitives.Slot, bool) {
 	return v.slot, ok
 }
 
+// latest returns the highest slot of the cached blobs.
+func (s *blobStorageCache) latest() (primitives.Slot, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var latest primitives.Slot
+	for _, v := range s.cache {
+		if v.slot > latest {
+			latest = v.slot
+		}
+	}
+	return latest, len(s.cache) > 0
+}
+
 func (s *blobStorageCache) evict(key [32]byte) {
 	var deleted float64
 	s.mu.Lock()
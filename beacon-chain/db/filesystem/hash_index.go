@@ -0,0 +1,149 @@
+package filesystem
+
+import (
+	"io"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/spf13/afero"
+)
+
+// ErrVersionedHashNotFound is returned when no stored blob has a kzg commitment matching the versioned hash.
+var ErrVersionedHashNotFound = errors.New("no blob stored for versioned hash")
+
+const (
+	// commitmentOffset is the offset of the kzg commitment in the ssz encoding of a BlobSidecar, after the index and
+	// the blob.
+	commitmentOffset = 8 + fieldparams.BlobLength
+	commitmentLength = 48
+)
+
+// BlobLocation identifies a stored blob by the root of its block and its index in the block.
+type BlobLocation struct {
+	Root  [32]byte
+	Index uint64
+}
+
+// versionedHashIndex maps the versioned hashes of the kzg commitments of the stored blobs to their location. Blobs are
+// indexed as they are saved, and the blobs stored before the node started are indexed on the first lookup missing
+// from the index. Entries are evicted along with the blobs of their root when they are pruned.
+type versionedHashIndex struct {
+	mu        sync.RWMutex
+	locations map[[32]byte]BlobLocation
+	byRoot    map[[32]byte]map[uint64][32]byte
+	// storedIndexed is set once the blobs stored before the node started have been indexed. It is guarded by the
+	// lock of the pruner.
+	storedIndexed bool
+}
+
+func newVersionedHashIndex() *versionedHashIndex {
+	return &versionedHashIndex{
+		locations: make(map[[32]byte]BlobLocation),
+		byRoot:    make(map[[32]byte]map[uint64][32]byte),
+	}
+}
+
+func (x *versionedHashIndex) add(commitment []byte, root [32]byte, idx uint64) {
+	hash := primitives.ConvertKzgCommitmentToVersionedHash(commitment)
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.locations[hash] = BlobLocation{Root: root, Index: idx}
+	if x.byRoot[root] == nil {
+		x.byRoot[root] = make(map[uint64][32]byte)
+	}
+	x.byRoot[root][idx] = hash
+}
+
+func (x *versionedHashIndex) has(root [32]byte, idx uint64) bool {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	_, ok := x.byRoot[root][idx]
+	return ok
+}
+
+func (x *versionedHashIndex) location(hash [32]byte) (BlobLocation, bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	loc, ok := x.locations[hash]
+	return loc, ok
+}
+
+func (x *versionedHashIndex) evict(root [32]byte) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for _, hash := range x.byRoot[root] {
+		delete(x.locations, hash)
+	}
+	delete(x.byRoot, root)
+}
+
+// indexStored indexes the blobs stored before the node started, reading the kzg commitment of each blob missing from
+// the index. This is only done once, as the blobs saved since then are indexed when they are saved.
+func (p *blobPruner) indexStored() error {
+	// Holding the pruner lock ensures no directory is pruned while its blobs are being indexed.
+	p.Lock()
+	defer p.Unlock()
+	if p.hashes.storedIndexed {
+		return nil
+	}
+	entries, err := listDir(p.fs, ".")
+	if err != nil {
+		return errors.Wrap(err, "unable to list root blobs directory")
+	}
+	for _, dir := range filter(entries, filterRoot) {
+		root, err := rootFromDir(dir)
+		if err != nil {
+			return errors.Wrapf(err, "invalid directory, could not parse subdir as root %s", dir)
+		}
+		files, err := listDir(p.fs, dir)
+		if err != nil {
+			return errors.Wrapf(err, "failed to list blobs in directory %s", dir)
+		}
+		for _, fname := range filter(files, filterSsz) {
+			idx, err := idxFromPath(fname)
+			if err != nil {
+				return errors.Wrapf(err, "index could not be determined for blob file %s", fname)
+			}
+			if p.hashes.has(root, idx) {
+				continue
+			}
+			commitment, err := commitmentFromFile(path.Join(dir, fname), p.fs)
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					// The blob was removed since the directory was listed.
+					continue
+				}
+				return errors.Wrapf(err, "kzg commitment could not be read from blob file %s", fname)
+			}
+			p.hashes.add(commitment, root, idx)
+		}
+	}
+	p.hashes.storedIndexed = true
+	return nil
+}
+
+func commitmentFromFile(file string, fs afero.Fs) ([]byte, error) {
+	f, err := fs.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("Could not close blob file")
+		}
+	}()
+	return commitmentFromBlob(f)
+}
+
+// commitmentFromBlob reads the kzg commitment from the ssz encoding of a BlobSidecar.
+func commitmentFromBlob(at io.ReaderAt) ([]byte, error) {
+	b := make([]byte, commitmentLength)
+	if _, err := at.ReadAt(b, commitmentOffset); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,49 @@
+package filesystem
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestBlobStorage_LocateVersionedHash(t *testing.T) {
+	fs, bs := NewEphemeralBlobStorageWithFs(t)
+	_, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 2)
+	scs, err := verification.BlobSidecarSliceNoop(sidecars)
+	require.NoError(t, err)
+	for _, sc := range scs {
+		require.NoError(t, bs.Save(sc))
+	}
+	root := scs[0].BlockRoot()
+
+	// Blobs are indexed as they are saved.
+	for _, sc := range scs {
+		loc, err := bs.LocateVersionedHash(primitives.ConvertKzgCommitmentToVersionedHash(sc.KzgCommitment))
+		require.NoError(t, err)
+		require.Equal(t, BlobLocation{Root: root, Index: sc.Index}, loc)
+	}
+	_, err = bs.LocateVersionedHash([32]byte{0x01})
+	require.ErrorIs(t, err, ErrVersionedHashNotFound)
+
+	// Blobs stored before the node started are indexed on the first lookup.
+	pruner, err := newBlobPruner(fs, 0, withWarmedCache())
+	require.NoError(t, err)
+	restarted := &BlobStorage{fs: fs, pruner: pruner}
+	hash := primitives.ConvertKzgCommitmentToVersionedHash(scs[1].KzgCommitment)
+	_, ok := pruner.hashes.location(hash)
+	require.Equal(t, false, ok)
+	loc, err := restarted.LocateVersionedHash(hash)
+	require.NoError(t, err)
+	require.Equal(t, BlobLocation{Root: root, Index: 1}, loc)
+
+	// The index is pruned along with the blobs.
+	require.NoError(t, restarted.pruner.prune(2))
+	_, err = restarted.LocateVersionedHash(hash)
+	require.ErrorIs(t, err, ErrVersionedHashNotFound)
+	require.NoError(t, bs.Remove(root))
+	_, err = bs.LocateVersionedHash(primitives.ConvertKzgCommitmentToVersionedHash(scs[0].KzgCommitment))
+	require.ErrorIs(t, err, ErrVersionedHashNotFound)
+}
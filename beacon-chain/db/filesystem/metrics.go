@@ -21,6 +21,10 @@ var (
 		Name: "blob_pruned",
 		Help: "Number of BlobSidecar files pruned.",
 	})
+	blobsPrunedBytesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blob_pruned_bytes",
+		Help: "Number of bytes of the BlobSidecar files pruned, including partial files.",
+	})
 	blobsWrittenCounter = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "blob_written",
 		Help: "Number of BlobSidecar files written",
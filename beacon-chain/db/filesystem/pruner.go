@@ -22,6 +22,10 @@ import (
 
 const retentionBuffer primitives.Epoch = 2
 
+// abandonedPartAge is how old a partial file must be to be considered left over by an interrupted save, such as
+// after a crash, rather than being written.
+const abandonedPartAge = 10 * time.Minute
+
 var (
 	errPruningFailures = errors.New("blobs could not be pruned for some roots")
 	errNotBlobSSZ      = errors.New("not a blob ssz file")
@@ -32,9 +36,22 @@ type blobPruner struct {
 	prunedBefore atomic.Uint64
 	windowSize   primitives.Slot
 	cache        *blobStorageCache
+	hashes       *versionedHashIndex
 	cacheReady   chan struct{}
 	warmed       bool
 	fs           afero.Fs
+	// partAge is how old partial files in directories without blob files must be to be pruned.
+	partAge time.Duration
+}
+
+// PruneStats summarizes the files pruned from blob storage.
+type PruneStats struct {
+	// Before is the slot before which the blobs were pruned.
+	Before primitives.Slot
+	// Files is the number of blob files pruned.
+	Files int
+	// Bytes is the size of all the files pruned, including partial files.
+	Bytes uint64
 }
 
 type prunerOpt func(*blobPruner) error
@@ -45,13 +62,27 @@ func withWarmedCache() prunerOpt {
 	}
 }
 
+func withAbandonedPartAge(age time.Duration) prunerOpt {
+	return func(p *blobPruner) error {
+		p.partAge = age
+		return nil
+	}
+}
+
 func newBlobPruner(fs afero.Fs, retain primitives.Epoch, opts ...prunerOpt) (*blobPruner, error) {
 	r, err := slots.EpochStart(retain + retentionBuffer)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not set retentionSlots")
 	}
 	cw := make(chan struct{})
-	p := &blobPruner{fs: fs, windowSize: r, cache: newBlobStorageCache(), cacheReady: cw}
+	p := &blobPruner{
+		fs:         fs,
+		windowSize: r,
+		cache:      newBlobStorageCache(),
+		hashes:     newVersionedHashIndex(),
+		cacheReady: cw,
+		partAge:    abandonedPartAge,
+	}
 	for _, o := range opts {
 		if err := o(p); err != nil {
 			return nil, err
@@ -117,8 +148,15 @@ func (p *blobPruner) waitForCache(ctx context.Context) (*blobStorageCache, error
 // It deletes blobs older than currentEpoch - (retentionEpochs+bufferEpochs).
 // This is so that we keep a slight buffer and blobs are deleted after n+2 epochs.
 func (p *blobPruner) prune(pruneBefore primitives.Slot) error {
+	_, err := p.pruneWithStats(pruneBefore)
+	return err
+}
+
+// pruneWithStats prunes the blobs before the slot, and returns what was pruned.
+func (p *blobPruner) pruneWithStats(pruneBefore primitives.Slot) (*PruneStats, error) {
 	start := time.Now()
-	totalPruned, totalErr := 0, 0
+	stats := &PruneStats{Before: pruneBefore}
+	totalErr := 0
 	// Customize logging/metrics behavior for the initial cache warmup when slot=0.
 	// We'll never see a prune request for slot 0, unless this is the initial call to warm up the cache.
 	if pruneBefore == 0 {
@@ -130,85 +168,97 @@ func (p *blobPruner) prune(pruneBefore primitives.Slot) error {
 			log.WithFields(logrus.Fields{
 				"upToEpoch":    slots.ToEpoch(pruneBefore),
 				"duration":     time.Since(start).String(),
-				"filesRemoved": totalPruned,
+				"filesRemoved": stats.Files,
+				"bytesRemoved": stats.Bytes,
 			}).Debug("Pruned old blobs")
-			blobsPrunedCounter.Add(float64(totalPruned))
+			blobsPrunedCounter.Add(float64(stats.Files))
+			blobsPrunedBytesCounter.Add(float64(stats.Bytes))
 		}()
 	}
 
 	entries, err := listDir(p.fs, ".")
 	if err != nil {
-		return errors.Wrap(err, "unable to list root blobs directory")
+		return stats, errors.Wrap(err, "unable to list root blobs directory")
 	}
 	dirs := filter(entries, filterRoot)
 	for _, dir := range dirs {
-		pruned, err := p.tryPruneDir(dir, pruneBefore)
+		pruned, bytes, err := p.tryPruneDir(dir, pruneBefore)
 		if err != nil {
 			totalErr += 1
 			log.WithError(err).WithField("directory", dir).Error("Unable to prune directory")
 		}
-		totalPruned += pruned
+		stats.Files += pruned
+		stats.Bytes += bytes
 	}
 
 	if totalErr > 0 {
-		return errors.Wrapf(errPruningFailures, "pruning failed for %d root directories", totalErr)
+		return stats, errors.Wrapf(errPruningFailures, "pruning failed for %d root directories", totalErr)
 	}
-	return nil
+	return stats, nil
 }
 
 func shouldRetain(slot, pruneBefore primitives.Slot) bool {
 	return slot >= pruneBefore
 }
 
-func (p *blobPruner) tryPruneDir(dir string, pruneBefore primitives.Slot) (int, error) {
+// tryPruneDir prunes the directory of the blobs of a root if they are before the slot, and returns the number of blob
+// files pruned along with the size of all the files pruned.
+func (p *blobPruner) tryPruneDir(dir string, pruneBefore primitives.Slot) (int, uint64, error) {
 	root, err := rootFromDir(dir)
 	if err != nil {
-		return 0, errors.Wrapf(err, "invalid directory, could not parse subdir as root %s", dir)
+		return 0, 0, errors.Wrapf(err, "invalid directory, could not parse subdir as root %s", dir)
 	}
 	slot, slotCached := p.cache.slot(root)
 	// Return early if the slot is cached and doesn't need pruning.
 	if slotCached && shouldRetain(slot, pruneBefore) {
-		return 0, nil
+		return 0, 0, nil
 	}
 
 	// entries will include things that aren't ssz files, like dangling .part files. We need these to
 	// completely clean up the directory.
 	entries, err := listDir(p.fs, dir)
 	if err != nil {
-		return 0, errors.Wrapf(err, "failed to list blobs in directory %s", dir)
+		return 0, 0, errors.Wrapf(err, "failed to list blobs in directory %s", dir)
 	}
 	// scFiles filters the dir listing down to the ssz encoded BlobSidecar files. This allows us to peek
 	// at the first one in the list to figure out the slot.
 	scFiles := filter(entries, filterSsz)
 	if len(scFiles) == 0 {
-		log.WithField("dir", dir).Warn("Pruner ignoring directory with no blob files")
-		return 0, nil
+		if pruneBefore == 0 {
+			// Nothing is pruned while warming up the cache.
+			return 0, 0, nil
+		}
+		bytes, err := p.pruneAbandonedParts(dir, entries)
+		return 0, bytes, err
 	}
 	if !slotCached {
 		slot, err = slotFromFile(path.Join(dir, scFiles[0]), p.fs)
 		if err != nil {
-			return 0, errors.Wrapf(err, "slot could not be read from blob file %s", scFiles[0])
+			return 0, 0, errors.Wrapf(err, "slot could not be read from blob file %s", scFiles[0])
 		}
 		for i := range scFiles {
 			idx, err := idxFromPath(scFiles[i])
 			if err != nil {
-				return 0, errors.Wrapf(err, "index could not be determined for blob file %s", scFiles[i])
+				return 0, 0, errors.Wrapf(err, "index could not be determined for blob file %s", scFiles[i])
 			}
 			if err := p.cache.ensure(root, slot, idx); err != nil {
-				return 0, errors.Wrapf(err, "could not update prune cache for blob file %s", scFiles[i])
+				return 0, 0, errors.Wrapf(err, "could not update prune cache for blob file %s", scFiles[i])
 			}
 		}
 		if shouldRetain(slot, pruneBefore) {
-			return 0, nil
+			return 0, 0, nil
 		}
 	}
 
 	removed := 0
+	var bytes uint64
 	for _, fname := range entries {
 		fullName := path.Join(dir, fname)
-		if err := p.fs.Remove(fullName); err != nil {
-			return removed, errors.Wrapf(err, "unable to remove %s", fullName)
+		size, err := p.remove(fullName)
+		if err != nil {
+			return removed, bytes, err
 		}
+		bytes += size
 		// Don't count other files that happen to be in the dir, like dangling .part files.
 		if filterSsz(fname) {
 			removed += 1
@@ -219,11 +269,62 @@ func (p *blobPruner) tryPruneDir(dir string, pruneBefore primitives.Slot) (int,
 		}
 	}
 	if err := p.fs.Remove(dir); err != nil {
-		return removed, errors.Wrapf(err, "unable to remove blob directory %s", dir)
+		return removed, bytes, errors.Wrapf(err, "unable to remove blob directory %s", dir)
 	}
 
 	p.cache.evict(root)
-	return len(scFiles), nil
+	p.hashes.evict(root)
+	return len(scFiles), bytes, nil
+}
+
+// pruneAbandonedParts removes the partial files of a directory without blob files, such as when the beacon node
+// crashed while saving the first blob of a block, once they are old enough not to be written anymore. The directory
+// is removed along with its last partial file, but empty directories are left for the blobs being saved.
+func (p *blobPruner) pruneAbandonedParts(dir string, entries []string) (uint64, error) {
+	var bytes uint64
+	kept, removed := 0, 0
+	for _, fname := range entries {
+		fullName := path.Join(dir, fname)
+		if !filterPart(fname) {
+			kept++
+			continue
+		}
+		info, err := p.fs.Stat(fullName)
+		if err != nil {
+			return bytes, errors.Wrapf(err, "unable to stat %s", fullName)
+		}
+		if time.Since(info.ModTime()) < p.partAge {
+			kept++
+			continue
+		}
+		log.WithField("file", fullName).Warn("Deleting abandoned blob .part file")
+		size, err := p.remove(fullName)
+		if err != nil {
+			return bytes, err
+		}
+		bytes += size
+		removed++
+	}
+	if kept > 0 || removed == 0 {
+		log.WithField("dir", dir).Warn("Pruner ignoring directory with no blob files")
+		return bytes, nil
+	}
+	if err := p.fs.Remove(dir); err != nil {
+		return bytes, errors.Wrapf(err, "unable to remove blob directory %s", dir)
+	}
+	return bytes, nil
+}
+
+// remove removes a file and returns its size.
+func (p *blobPruner) remove(name string) (uint64, error) {
+	info, err := p.fs.Stat(name)
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to stat %s", name)
+	}
+	if err := p.fs.Remove(name); err != nil {
+		return 0, errors.Wrapf(err, "unable to remove %s", name)
+	}
+	return uint64(info.Size()), nil
 }
 
 func idxFromPath(fname string) (uint64, error) {
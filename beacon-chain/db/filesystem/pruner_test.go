@@ -32,7 +32,7 @@ func TestTryPruneDir_CachedNotExpired(t *testing.T) {
 	// This slot is right on the edge of what would need to be pruned, so by adding it to the cache and
 	// skipping any other test setup, we can be certain the hot cache path never touches the filesystem.
 	require.NoError(t, pr.cache.ensure(sc.BlockRoot(), sc.Slot(), 0))
-	pruned, err := pr.tryPruneDir(rootStr, pr.windowSize)
+	pruned, _, err := pr.tryPruneDir(rootStr, pr.windowSize)
 	require.NoError(t, err)
 	require.Equal(t, 0, pruned)
 }
@@ -77,7 +77,7 @@ func TestTryPruneDir_CachedExpired(t *testing.T) {
 		rootStr := rootString(sc.BlockRoot())
 		require.NoError(t, fs.Mkdir(rootStr, directoryPermissions)) // make empty directory
 		require.NoError(t, pr.cache.ensure(sc.BlockRoot(), sc.Slot(), 0))
-		pruned, err := pr.tryPruneDir(rootStr, slot+1)
+		pruned, _, err := pr.tryPruneDir(rootStr, slot+1)
 		require.NoError(t, err)
 		require.Equal(t, 0, pruned)
 	})
@@ -103,15 +103,70 @@ func TestTryPruneDir_CachedExpired(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 2, len(files))
 
-		pruned, err := bs.pruner.tryPruneDir(rootStr, slot+1)
+		pruned, bytes, err := bs.pruner.tryPruneDir(rootStr, slot+1)
 		require.NoError(t, err)
 		require.Equal(t, 2, pruned)
+		require.Equal(t, uint64(2*fieldparams.BlobSidecarSize), bytes)
 		files, err = listDir(fs, rootStr)
 		require.ErrorIs(t, err, os.ErrNotExist)
 		require.Equal(t, 0, len(files))
 	})
 }
 
+func TestTryPruneDir_AbandonedParts(t *testing.T) {
+	root := bytesutil.ToBytes32([]byte("abandoned"))
+	rootStr := rootString(root)
+	writePart := func(t *testing.T, fs afero.Fs, name string, modTime time.Time) {
+		require.NoError(t, afero.WriteFile(fs, path.Join(rootStr, name), []byte("derp"), 0600))
+		require.NoError(t, fs.Chtimes(path.Join(rootStr, name), modTime, modTime))
+	}
+
+	t.Run("old parts deleted", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		pr, err := newBlobPruner(fs, 0)
+		require.NoError(t, err)
+		old := time.Now().Add(-2 * abandonedPartAge)
+		writePart(t, fs, "0-1.part", old)
+		writePart(t, fs, "1-2.part", old)
+
+		// Nothing is deleted while warming up the cache.
+		pruned, bytes, err := pr.tryPruneDir(rootStr, 0)
+		require.NoError(t, err)
+		require.Equal(t, 0, pruned)
+		require.Equal(t, uint64(0), bytes)
+
+		pruned, bytes, err = pr.tryPruneDir(rootStr, 1)
+		require.NoError(t, err)
+		require.Equal(t, 0, pruned)
+		require.Equal(t, uint64(8), bytes)
+		_, err = listDir(fs, rootStr)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+	t.Run("recent part kept", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		pr, err := newBlobPruner(fs, 0)
+		require.NoError(t, err)
+		writePart(t, fs, "0-1.part", time.Now().Add(-2*abandonedPartAge))
+		writePart(t, fs, "1-2.part", time.Now())
+
+		_, bytes, err := pr.tryPruneDir(rootStr, 1)
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), bytes)
+		files, err := listDir(fs, rootStr)
+		require.NoError(t, err)
+		require.DeepEqual(t, []string{"1-2.part"}, files)
+
+		// Offline, all the partial files are abandoned.
+		pr, err = newBlobPruner(fs, 0, withAbandonedPartAge(0))
+		require.NoError(t, err)
+		_, bytes, err = pr.tryPruneDir(rootStr, 1)
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), bytes)
+		_, err = listDir(fs, rootStr)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
 func TestTryPruneDir_SlotFromFile(t *testing.T) {
 	t.Run("expired blobs deleted", func(t *testing.T) {
 		fs, bs := NewEphemeralBlobStorageWithFs(t)
@@ -139,7 +194,7 @@ func TestTryPruneDir_SlotFromFile(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 2, len(files))
 
-		pruned, err := bs.pruner.tryPruneDir(rootStr, slot+1)
+		pruned, _, err := bs.pruner.tryPruneDir(rootStr, slot+1)
 		require.NoError(t, err)
 		require.Equal(t, 2, pruned)
 		files, err = listDir(fs, rootStr)
@@ -171,7 +226,7 @@ func TestTryPruneDir_SlotFromFile(t *testing.T) {
 
 		// This should use the slotFromFile code (simulating restart).
 		// Setting pruneBefore == slot, so that the slot will be outside the window (at the boundary).
-		pruned, err := bs.pruner.tryPruneDir(rootStr, slot)
+		pruned, _, err := bs.pruner.tryPruneDir(rootStr, slot)
 		require.NoError(t, err)
 		require.Equal(t, 0, pruned)
 
@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
 	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
@@ -65,6 +66,13 @@ type ReadOnlyDatabase interface {
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillStatus(context.Context) (*dbval.BackfillStatus, error)
+	// Operation totals index.
+	OperationTotalsIndexedSlot(ctx context.Context) (primitives.Slot, error)
+	DepositTotals(ctx context.Context, pubKeys [][fieldparams.BLSPubkeyLength]byte) ([]*DepositTotals, error)
+	WithdrawalTotals(ctx context.Context, indices []primitives.ValidatorIndex) ([]*WithdrawalTotals, error)
+	NextOperationTotalsBlocks(ctx context.Context, limit int) ([]interfaces.ReadOnlySignedBeaconBlock, error)
+	// Attestation pool saved across restarts.
+	AttestationPool(ctx context.Context) (primitives.Slot, []ethpb.Att, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -84,6 +92,7 @@ type NoHeadAccessDatabase interface {
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
 	SaveStateSummary(ctx context.Context, summary *ethpb.StateSummary) error
 	SaveStateSummaries(ctx context.Context, summaries []*ethpb.StateSummary) error
+	BackfillStateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.StateSummary, error)
 	// Checkpoint operations.
 	SaveJustifiedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error
 	SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error
@@ -99,6 +108,11 @@ type NoHeadAccessDatabase interface {
 	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []primitives.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
 	// light client operations
 	SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpbv2.LightClientUpdateWithVersion) error
+	// Operation totals index.
+	IndexOperationTotals(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock) error
+	// Attestation pool saved across restarts.
+	SaveAttestationPool(ctx context.Context, slot primitives.Slot, atts []ethpb.Att) error
+	DeleteAttestationPool(ctx context.Context) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint primitives.Slot) error
 }
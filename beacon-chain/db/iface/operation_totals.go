@@ -0,0 +1,16 @@
+package iface
+
+import "github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+
+// DepositTotals are the cumulative deposits made to a validator public key in the finalized canonical chain.
+type DepositTotals struct {
+	Count     uint64
+	TotalGwei uint64
+}
+
+// WithdrawalTotals are the cumulative withdrawals of a validator in the finalized canonical chain.
+type WithdrawalTotals struct {
+	Count              uint64
+	TotalGwei          uint64
+	LastWithdrawalSlot primitives.Slot
+}
@@ -0,0 +1,115 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	bolt "go.etcd.io/bbolt"
+)
+
+// The attestation pool bucket temporarily holds the contents of the attestation pool while the node restarts.
+// Attestations are keyed by their big endian position and Electra attestations are prefixed with electraKey. The
+// slot at which the pool was saved is kept under attestationPoolSlotKey.
+var attestationPoolSlotKey = []byte("attestation-pool-slot")
+
+// SaveAttestationPool replaces the saved attestation pool with the given attestations, saved at the given slot.
+func (s *Store) SaveAttestationPool(ctx context.Context, slot primitives.Slot, atts []ethpb.Att) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveAttestationPool")
+	defer span.End()
+
+	encoded := make([][]byte, len(atts))
+	for i, att := range atts {
+		enc, err := encode(ctx, att)
+		if err != nil {
+			return errors.Wrapf(err, "could not encode attestation %d", i)
+		}
+		if att.Version() >= version.Electra {
+			enc = append(electraKey, enc...)
+		}
+		encoded[i] = enc
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt, err := resetAttestationPoolBucket(tx)
+		if err != nil {
+			return err
+		}
+		for i, enc := range encoded {
+			if err := bkt.Put(bytesutil.Uint64ToBytesBigEndian(uint64(i)), enc); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(chainMetadataBucket).Put(attestationPoolSlotKey, bytesutil.SlotToBytesBigEndian(slot))
+	})
+}
+
+// AttestationPool returns the saved attestation pool and the slot at which it was saved. ErrNotFound is returned
+// when no attestation pool was saved.
+func (s *Store) AttestationPool(ctx context.Context) (primitives.Slot, []ethpb.Att, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AttestationPool")
+	defer span.End()
+
+	var (
+		slot primitives.Slot
+		atts []ethpb.Att
+	)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(attestationPoolSlotKey)
+		if len(enc) != 8 {
+			return errors.Wrap(ErrNotFound, "attestation pool")
+		}
+		slot = bytesutil.BytesToSlotBigEndian(enc)
+		return tx.Bucket(attestationPoolBucket).ForEach(func(_, v []byte) error {
+			att, err := unmarshalAttestation(ctx, v)
+			if err != nil {
+				return err
+			}
+			atts = append(atts, att)
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return slot, atts, nil
+}
+
+// DeleteAttestationPool deletes the saved attestation pool.
+func (s *Store) DeleteAttestationPool(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteAttestationPool")
+	defer span.End()
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if _, err := resetAttestationPoolBucket(tx); err != nil {
+			return err
+		}
+		return tx.Bucket(chainMetadataBucket).Delete(attestationPoolSlotKey)
+	})
+}
+
+func resetAttestationPoolBucket(tx *bolt.Tx) (*bolt.Bucket, error) {
+	if err := tx.DeleteBucket(attestationPoolBucket); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+		return nil, err
+	}
+	return tx.CreateBucket(attestationPoolBucket)
+}
+
+func unmarshalAttestation(ctx context.Context, enc []byte) (ethpb.Att, error) {
+	if hasElectraKey(enc) {
+		att := &ethpb.AttestationElectra{}
+		if err := decode(ctx, enc[len(electraKey):], att); err != nil {
+			return nil, err
+		}
+		return att, nil
+	}
+	att := &ethpb.Attestation{}
+	if err := decode(ctx, enc, att); err != nil {
+		return nil, err
+	}
+	return att, nil
+}
@@ -0,0 +1,50 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestStore_AttestationPool_CRUD(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	_, _, err := db.AttestationPool(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	phase0 := util.HydrateAttestation(&ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0b1101},
+		Data:            &ethpb.AttestationData{Slot: 3},
+	})
+	electra := util.HydrateAttestationElectra(&ethpb.AttestationElectra{
+		AggregationBits: bitfield.Bitlist{0b1011},
+		Data:            &ethpb.AttestationData{Slot: 4},
+		CommitteeBits:   bitfield.NewBitvector64(),
+	})
+	require.NoError(t, db.SaveAttestationPool(ctx, 5, []ethpb.Att{phase0, electra}))
+
+	slot, atts, err := db.AttestationPool(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), uint64(slot))
+	require.Equal(t, 2, len(atts))
+	assert.DeepEqual(t, phase0, atts[0])
+	assert.DeepEqual(t, electra, atts[1])
+
+	// Saving the pool again replaces its previous contents.
+	require.NoError(t, db.SaveAttestationPool(ctx, 6, []ethpb.Att{electra}))
+	slot, atts, err = db.AttestationPool(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(6), uint64(slot))
+	require.Equal(t, 1, len(atts))
+	assert.DeepEqual(t, electra, atts[0])
+
+	require.NoError(t, db.DeleteAttestationPool(ctx))
+	_, _, err = db.AttestationPool(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,270 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	bolt "go.etcd.io/bbolt"
+)
+
+// ErrInconsistentChainPointers is returned when the persisted head block root or the justified or finalized
+// checkpoints reference blocks or states that are missing from the database.
+var ErrInconsistentChainPointers = errors.New("persisted head or checkpoints reference missing blocks or states")
+
+var errNoConsistentCheckpoint = errors.New("no checkpoint with both block and state found in the database")
+
+const (
+	headPointer      = "head"
+	justifiedPointer = "justified"
+	finalizedPointer = "finalized"
+)
+
+// ChainPointerIssue describes a persisted chain pointer that does not resolve to data in the database.
+type ChainPointerIssue struct {
+	Pointer string
+	Root    [32]byte
+	Reason  string
+}
+
+func (i *ChainPointerIssue) String() string {
+	return fmt.Sprintf("%s root %#x: %s", i.Pointer, bytesutil.Trunc(i.Root[:]), i.Reason)
+}
+
+// ChainPointerRepair describes a persisted chain pointer replaced by RepairChainPointers.
+type ChainPointerRepair struct {
+	Pointer  string
+	OldRoot  [32]byte
+	OldEpoch primitives.Epoch
+	NewRoot  [32]byte
+	NewEpoch primitives.Epoch
+}
+
+// VerifyChainPointers checks that the persisted head block root and the justified and finalized checkpoints
+// all resolve to blocks and states present in the database. The issues found are returned together with an
+// error wrapping ErrInconsistentChainPointers.
+func (s *Store) VerifyChainPointers(ctx context.Context) ([]*ChainPointerIssue, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.VerifyChainPointers")
+	defer span.End()
+
+	var issues []*ChainPointerIssue
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		issues, err = s.chainPointerIssues(ctx, tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(issues) == 0 {
+		return nil, nil
+	}
+	descriptions := make([]string, len(issues))
+	for i, issue := range issues {
+		descriptions[i] = issue.String()
+	}
+	return issues, errors.Wrap(ErrInconsistentChainPointers, strings.Join(descriptions, ", "))
+}
+
+// RepairChainPointers resets the persisted chain pointers that do not resolve to data in the database.
+// When the finalized checkpoint is intact, a broken head or justified pointer is reset to it. Otherwise,
+// the newest finalized block at or before the persisted finalized epoch that has both its block and its
+// state in the database becomes the finalized checkpoint, and the justified checkpoint and head are reset to
+// it, so that fork choice is rebuilt from there on startup.
+func (s *Store) RepairChainPointers(ctx context.Context) ([]*ChainPointerRepair, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RepairChainPointers")
+	defer span.End()
+
+	var repairs []*ChainPointerRepair
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		issues, err := s.chainPointerIssues(ctx, tx)
+		if err != nil || len(issues) == 0 {
+			return err
+		}
+		broken := make(map[string]bool, len(issues))
+		for _, issue := range issues {
+			broken[issue.Pointer] = true
+		}
+
+		finalized, err := checkpointFromTx(ctx, tx, finalizedCheckpointKey)
+		if err != nil {
+			return err
+		}
+		target := finalized
+		if broken[finalizedPointer] {
+			target, err = newestConsistentCheckpoint(tx, finalized.Epoch)
+			if err != nil {
+				return err
+			}
+			// A justified checkpoint and head descending from the lost finalized checkpoint cannot be trusted.
+			broken[justifiedPointer] = true
+			broken[headPointer] = true
+		}
+		enc, err := encode(ctx, target)
+		if err != nil {
+			return err
+		}
+
+		if broken[finalizedPointer] {
+			if err := tx.Bucket(checkpointBucket).Put(finalizedCheckpointKey, enc); err != nil {
+				return err
+			}
+			// Re-index the finalized block roots from the new checkpoint onwards on the next finalization.
+			if err := tx.Bucket(finalizedBlockRootsIndexBucket).Put(previousFinalizedCheckpointKey, enc); err != nil {
+				return err
+			}
+			repairs = append(repairs, &ChainPointerRepair{
+				Pointer:  finalizedPointer,
+				OldRoot:  bytesutil.ToBytes32(finalized.Root),
+				OldEpoch: finalized.Epoch,
+				NewRoot:  bytesutil.ToBytes32(target.Root),
+				NewEpoch: target.Epoch,
+			})
+		}
+		if broken[justifiedPointer] {
+			justified, err := checkpointFromTx(ctx, tx, justifiedCheckpointKey)
+			if err != nil {
+				return err
+			}
+			if err := tx.Bucket(checkpointBucket).Put(justifiedCheckpointKey, enc); err != nil {
+				return err
+			}
+			repairs = append(repairs, &ChainPointerRepair{
+				Pointer:  justifiedPointer,
+				OldRoot:  bytesutil.ToBytes32(justified.Root),
+				OldEpoch: justified.Epoch,
+				NewRoot:  bytesutil.ToBytes32(target.Root),
+				NewEpoch: target.Epoch,
+			})
+		}
+		if broken[headPointer] {
+			headRoot := bytesutil.ToBytes32(tx.Bucket(blocksBucket).Get(headBlockRootKey))
+			newHeadRoot := target.Root
+			if bytes.Equal(newHeadRoot, params.BeaconConfig().ZeroHash[:]) {
+				newHeadRoot = bytesutil.SafeCopyBytes(tx.Bucket(blocksBucket).Get(genesisBlockRootKey))
+			}
+			if err := tx.Bucket(blocksBucket).Put(headBlockRootKey, newHeadRoot); err != nil {
+				return err
+			}
+			repairs = append(repairs, &ChainPointerRepair{
+				Pointer: headPointer,
+				OldRoot: headRoot,
+				NewRoot: bytesutil.ToBytes32(newHeadRoot),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return repairs, nil
+}
+
+// chainPointerIssues returns the persisted chain pointers that do not resolve to a block and a state or state summary.
+func (s *Store) chainPointerIssues(ctx context.Context, tx *bolt.Tx) ([]*ChainPointerIssue, error) {
+	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	if genesisRoot == nil {
+		// Nothing was persisted yet.
+		return nil, nil
+	}
+
+	var issues []*ChainPointerIssue
+	check := func(pointer string, root []byte) {
+		// Zero hashes stand for the genesis block root.
+		if bytes.Equal(root, params.BeaconConfig().ZeroHash[:]) {
+			root = genesisRoot
+		}
+		if reason := s.missingChainData(tx, root); reason != "" {
+			issues = append(issues, &ChainPointerIssue{Pointer: pointer, Root: bytesutil.ToBytes32(root), Reason: reason})
+		}
+	}
+
+	if headRoot := tx.Bucket(blocksBucket).Get(headBlockRootKey); headRoot != nil {
+		check(headPointer, headRoot)
+	}
+	justified, err := checkpointFromTx(ctx, tx, justifiedCheckpointKey)
+	if err != nil {
+		return nil, err
+	}
+	check(justifiedPointer, justified.Root)
+	finalized, err := checkpointFromTx(ctx, tx, finalizedCheckpointKey)
+	if err != nil {
+		return nil, err
+	}
+	check(finalizedPointer, finalized.Root)
+	return issues, nil
+}
+
+func (s *Store) missingChainData(tx *bolt.Tx, root []byte) string {
+	if tx.Bucket(blocksBucket).Get(root) == nil {
+		return "block missing"
+	}
+	hasStateSummary := s.stateSummaryCache.has(bytesutil.ToBytes32(root)) || tx.Bucket(stateSummaryBucket).Get(root) != nil
+	if tx.Bucket(stateBucket).Get(root) == nil && !hasStateSummary {
+		return "state missing"
+	}
+	return ""
+}
+
+func checkpointFromTx(ctx context.Context, tx *bolt.Tx, key []byte) (*ethpb.Checkpoint, error) {
+	enc := tx.Bucket(checkpointBucket).Get(key)
+	if enc == nil {
+		return &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}, nil
+	}
+	cp := &ethpb.Checkpoint{}
+	if err := decode(ctx, enc, cp); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// newestConsistentCheckpoint scans the saved states backwards from the start of the given epoch and returns
+// the first finalized block, genesis or checkpoint sync origin that has both its block and its full state in the
+// database. The checkpoint epoch is the first epoch starting at or after the block slot, as the block is the
+// checkpoint block of that epoch when the slots in between are skipped. Starting the scan at the start of the given
+// epoch keeps the checkpoint epoch at or below it.
+func newestConsistentCheckpoint(tx *bolt.Tx, maxEpoch primitives.Epoch) (*ethpb.Checkpoint, error) {
+	maxSlot, err := slots.EpochStart(maxEpoch)
+	if err != nil {
+		return nil, err
+	}
+	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	originRoot := tx.Bucket(blocksBucket).Get(originCheckpointBlockRootKey)
+	finalizedIndex := tx.Bucket(finalizedBlockRootsIndexBucket)
+
+	c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+	k, v := c.Seek(bytesutil.SlotToBytesBigEndian(maxSlot + 1))
+	if k == nil {
+		k, v = c.Last()
+	} else {
+		k, v = c.Prev()
+	}
+	for ; k != nil; k, v = c.Prev() {
+		slot := bytesutil.BytesToSlotBigEndian(k)
+		if slot > maxSlot {
+			continue
+		}
+		for i := 0; i+fieldparams.RootLength <= len(v); i += fieldparams.RootLength {
+			root := v[i : i+fieldparams.RootLength]
+			canonical := bytes.Equal(root, genesisRoot) || bytes.Equal(root, originRoot) || finalizedIndex.Get(root) != nil
+			if !canonical || tx.Bucket(blocksBucket).Get(root) == nil || tx.Bucket(stateBucket).Get(root) == nil {
+				continue
+			}
+			epoch := slots.ToEpoch(slot)
+			if !slots.IsEpochStart(slot) {
+				epoch++
+			}
+			return &ethpb.Checkpoint{Epoch: epoch, Root: bytesutil.SafeCopyBytes(root)}, nil
+		}
+	}
+	return nil, errNoConsistentCheckpoint
+}
@@ -0,0 +1,184 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+// saveChain saves a genesis block at slot 0 followed by the given blocks, and returns the block roots by slot.
+// Skipped slots have a zero root.
+func saveChain(t *testing.T, db *Store, blks func(genesisRoot [32]byte) []interfaces.ReadOnlySignedBeaconBlock) [][32]byte {
+	ctx := context.Background()
+	genesis, err := consensusblocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	chain := append([]interfaces.ReadOnlySignedBeaconBlock{genesis}, blks(genesisRoot)...)
+	require.NoError(t, db.SaveBlocks(ctx, chain))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	roots := make([][32]byte, chain[len(chain)-1].Block().Slot()+1)
+	for _, b := range chain {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		roots[b.Block().Slot()] = r
+	}
+	return roots
+}
+
+// saveStateAt saves an empty state for the block of the given slot.
+func saveStateAt(t *testing.T, db *Store, roots [][32]byte, slot primitives.Slot) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, db.SaveState(context.Background(), st, roots[slot]))
+}
+
+// setupChainPointers saves a chain of three epochs finalized at epoch 1, justified at epoch 2 and with its head in epoch 2.
+func setupChainPointers(t *testing.T) (*Store, [][32]byte) {
+	ctx := context.Background()
+	db := setupDB(t)
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	roots := saveChain(t, db, func(genesisRoot [32]byte) []interfaces.ReadOnlySignedBeaconBlock {
+		return makeBlocks(t, 0, slotsPerEpoch*3, genesisRoot)
+	})
+	for _, slot := range []uint64{0, slotsPerEpoch, slotsPerEpoch*3 - 1} {
+		saveStateAt(t, db, roots, primitives.Slot(slot))
+	}
+	for _, slot := range []uint64{slotsPerEpoch * 2, slotsPerEpoch * 3} {
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: primitives.Slot(slot), Root: roots[slot][:]}))
+	}
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[slotsPerEpoch][:]}))
+	require.NoError(t, db.SaveJustifiedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: roots[slotsPerEpoch*2][:]}))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, roots[slotsPerEpoch*3]))
+	return db, roots
+}
+
+func TestStore_ChainPointers(t *testing.T) {
+	ctx := context.Background()
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	missing := bytesutil.ToBytes32([]byte("missing"))
+
+	t.Run("consistent", func(t *testing.T) {
+		db, _ := setupChainPointers(t)
+		issues, err := db.VerifyChainPointers(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(issues))
+		repairs, err := db.RepairChainPointers(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(repairs))
+	})
+	t.Run("missing head block", func(t *testing.T) {
+		db, roots := setupChainPointers(t)
+		require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket(blocksBucket).Put(headBlockRootKey, missing[:])
+		}))
+
+		issues, err := db.VerifyChainPointers(ctx)
+		require.ErrorIs(t, err, ErrInconsistentChainPointers)
+		require.Equal(t, 1, len(issues))
+		require.Equal(t, headPointer, issues[0].Pointer)
+		require.Equal(t, "block missing", issues[0].Reason)
+
+		repairs, err := db.RepairChainPointers(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(repairs))
+		require.Equal(t, missing, repairs[0].OldRoot)
+		require.Equal(t, roots[slotsPerEpoch], repairs[0].NewRoot)
+		head, err := db.HeadBlock(ctx)
+		require.NoError(t, err)
+		headRoot, err := head.Block().HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, roots[slotsPerEpoch], headRoot)
+		// The justified checkpoint was intact.
+		justified, err := db.JustifiedCheckpoint(ctx)
+		require.NoError(t, err)
+		require.Equal(t, primitives.Epoch(2), justified.Epoch)
+
+		_, err = db.VerifyChainPointers(ctx)
+		require.NoError(t, err)
+	})
+	t.Run("missing finalized block", func(t *testing.T) {
+		db, roots := setupChainPointers(t)
+		enc, err := encode(ctx, &ethpb.Checkpoint{Epoch: 1, Root: missing[:]})
+		require.NoError(t, err)
+		require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket(checkpointBucket).Put(finalizedCheckpointKey, enc)
+		}))
+
+		repairs, err := db.RepairChainPointers(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(repairs))
+		for _, r := range repairs {
+			require.Equal(t, roots[slotsPerEpoch], r.NewRoot, r.Pointer)
+		}
+		// The state saved after the persisted finalized epoch is not used.
+		for _, cp := range []func(context.Context) (*ethpb.Checkpoint, error){db.FinalizedCheckpoint, db.JustifiedCheckpoint} {
+			c, err := cp(ctx)
+			require.NoError(t, err)
+			require.Equal(t, primitives.Epoch(1), c.Epoch)
+			require.DeepEqual(t, roots[slotsPerEpoch][:], c.Root)
+		}
+		_, err = db.VerifyChainPointers(ctx)
+		require.NoError(t, err)
+	})
+	t.Run("falls back to genesis", func(t *testing.T) {
+		db, roots := setupChainPointers(t)
+		finalizedRoot := roots[slotsPerEpoch]
+		require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+			if err := tx.Bucket(stateBucket).Delete(finalizedRoot[:]); err != nil {
+				return err
+			}
+			return tx.Bucket(stateSummaryBucket).Delete(finalizedRoot[:])
+		}))
+
+		issues, err := db.VerifyChainPointers(ctx)
+		require.ErrorIs(t, err, ErrInconsistentChainPointers)
+		require.Equal(t, 1, len(issues))
+		require.Equal(t, finalizedPointer, issues[0].Pointer)
+		require.Equal(t, "state missing", issues[0].Reason)
+
+		_, err = db.RepairChainPointers(ctx)
+		require.NoError(t, err)
+		finalized, err := db.FinalizedCheckpoint(ctx)
+		require.NoError(t, err)
+		require.Equal(t, primitives.Epoch(0), finalized.Epoch)
+		require.DeepEqual(t, roots[0][:], finalized.Root)
+	})
+	t.Run("skipped boundary slot", func(t *testing.T) {
+		db := setupDB(t)
+		// The first slot of epoch 1 is skipped, so the last block of epoch 0 is the checkpoint block of epoch 1.
+		roots := saveChain(t, db, func(genesisRoot [32]byte) []interfaces.ReadOnlySignedBeaconBlock {
+			blks := makeBlocks(t, 0, slotsPerEpoch-1, genesisRoot)
+			lastRoot, err := blks[len(blks)-1].Block().HashTreeRoot()
+			require.NoError(t, err)
+			return append(blks, makeBlocks(t, slotsPerEpoch, slotsPerEpoch, lastRoot)...)
+		})
+		require.Equal(t, [32]byte{}, roots[slotsPerEpoch])
+		saveStateAt(t, db, roots, 0)
+		saveStateAt(t, db, roots, primitives.Slot(slotsPerEpoch-1))
+		saveStateAt(t, db, roots, primitives.Slot(slotsPerEpoch+1))
+		require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[slotsPerEpoch-1][:]}))
+		enc, err := encode(ctx, &ethpb.Checkpoint{Epoch: 1, Root: missing[:]})
+		require.NoError(t, err)
+		require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+			return tx.Bucket(checkpointBucket).Put(finalizedCheckpointKey, enc)
+		}))
+
+		_, err = db.RepairChainPointers(ctx)
+		require.NoError(t, err)
+		finalized, err := db.FinalizedCheckpoint(ctx)
+		require.NoError(t, err)
+		require.Equal(t, primitives.Epoch(1), finalized.Epoch)
+		require.DeepEqual(t, roots[slotsPerEpoch-1][:], finalized.Root)
+	})
+}
@@ -64,6 +64,10 @@ var (
 		Name: "db_beacon_state_saving_milliseconds",
 		Help: "Milliseconds it takes to save a beacon state to the DB",
 	})
+	stateSummaryBackfillCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "db_state_summary_backfill_total",
+		Help: "The total number of missing state summaries derived from their blocks.",
+	})
 )
 
 // BlockCacheSize specifies 1000 slots worth of blocks cached, which
@@ -114,11 +118,15 @@ var Buckets = [][]byte{
 	blockParentRootIndicesBucket,
 	finalizedBlockRootsIndexBucket,
 	blockRootValidatorHashesBucket,
+	stateValidatorRefCountsBucket,
+	depositTotalsBucket,
+	withdrawalTotalsBucket,
 	// Migrations
 	migrationsBucket,
 
 	feeRecipientBucket,
 	registrationBucket,
+	attestationPoolBucket,
 }
 
 // KVStoreOption is a functional option that modifies a kv.Store.
@@ -204,11 +212,16 @@ func (s *Store) ClearDB() error {
 	if err := s.Close(); err != nil {
 		return fmt.Errorf("failed to close db: %w", err)
 	}
-	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
+	prometheus.Unregister(createBoltCollector(s.db))
+	return RemoveDatabaseFile(s.databasePath)
+}
+
+// RemoveDatabaseFile removes the file of the closed database stored in the directory.
+func RemoveDatabaseFile(dirPath string) error {
+	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
-	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
+	if err := os.Remove(path.Join(dirPath, DatabaseFileName)); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
 	return nil
@@ -3,6 +3,7 @@ package kv
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -14,7 +15,44 @@ var migrations = []migration{
 	migrateArchivedIndex,
 	migrateBlockSlotIndex,
 	migrateStateValidators,
+	migrateStateValidatorRefCounts,
 	migrateFinalizedParent,
+	migrateStateSummaryEncoding,
+}
+
+// destructiveMigrations are the migrations which rewrite or delete stored data, with the check of whether each is
+// pending, so that the database can be backed up before running them.
+var destructiveMigrations = []struct {
+	name    string
+	pending func(*bolt.DB) (bool, error)
+}{
+	{name: "state validators", pending: shouldMigrateValidators},
+	{name: "state validator reference counts", pending: shouldCountValidatorRefs},
+	{name: "state summary encoding", pending: shouldMigrateStateSummaryEncoding},
+}
+
+// PendingDestructiveMigrations returns the names of the migrations which will rewrite or delete stored data when
+// running the migrations. It returns none for a database without blocks, which has no data to lose.
+func (s *Store) PendingDestructiveMigrations() ([]string, error) {
+	var empty bool
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		k, _ := tx.Bucket(blocksBucket).Cursor().First()
+		empty = k == nil
+		return nil
+	}); err != nil || empty {
+		return nil, err
+	}
+	var pending []string
+	for _, m := range destructiveMigrations {
+		ok, err := m.pending(s.db)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not check if the %s migration is pending", m.name)
+		}
+		if ok {
+			pending = append(pending, m.name)
+		}
+	}
+	return pending, nil
 }
 
 // RunMigrations defined in the migrations array.
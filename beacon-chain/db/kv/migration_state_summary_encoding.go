@@ -0,0 +1,92 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+var migrationStateSummaryEncoding0Key = []byte("state_summary_encoding_0")
+
+// stateSummaryMigrationBatchSize bounds the number of summaries rewritten in a single transaction.
+var stateSummaryMigrationBatchSize = 10000
+
+// migrateStateSummaryEncoding rewrites the state summaries stored in the legacy snappy compressed protobuf
+// encoding in the fixed-width encoding. Summaries are rewritten in batches, each in its own transaction, so
+// the migration can be interrupted and resumed; both encodings are read in the meantime.
+func migrateStateSummaryEncoding(ctx context.Context, db *bolt.DB) error {
+	if migrate, err := shouldMigrateStateSummaryEncoding(db); err != nil || !migrate {
+		return err
+	}
+
+	var (
+		next     []byte
+		migrated int
+	)
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := db.Update(func(tx *bolt.Tx) error {
+			bkt := tx.Bucket(stateSummaryBucket)
+			keys := make([][]byte, 0, stateSummaryMigrationBatchSize)
+			encs := make([][]byte, 0, stateSummaryMigrationBatchSize)
+			c := bkt.Cursor()
+			k, v := c.First()
+			if next != nil {
+				k, v = c.Seek(next)
+			}
+			for ; k != nil && len(keys) < stateSummaryMigrationBatchSize; k, v = c.Next() {
+				if isCompactStateSummary(v) {
+					continue
+				}
+				summary, err := decodeStateSummary(ctx, v)
+				if err != nil {
+					return errors.Wrapf(err, "could not decode state summary for root %#x", k)
+				}
+				enc, err := encodeStateSummary(summary)
+				if err != nil {
+					// The legacy encoding of the summary is still readable.
+					log.WithError(err).WithField("root", fmt.Sprintf("%#x", k)).Warn("Could not migrate state summary")
+					continue
+				}
+				keys = append(keys, bytes.Clone(k))
+				encs = append(encs, enc)
+			}
+			// Keys and values are only valid for the life of the transaction.
+			next = bytes.Clone(k)
+			for i := range keys {
+				if err := bkt.Put(keys[i], encs[i]); err != nil {
+					return err
+				}
+			}
+			migrated += len(keys)
+			if next == nil {
+				return tx.Bucket(migrationsBucket).Put(migrationStateSummaryEncoding0Key, migrationCompleted)
+			}
+			return nil
+		}); err != nil {
+			log.WithError(err).Errorf("could not migrate bucket: %s", stateSummaryBucket)
+			return err
+		}
+		if next == nil {
+			break
+		}
+	}
+	if migrated > 0 {
+		log.WithField("count", migrated).Info("Migrated state summaries to the fixed-width encoding")
+	}
+	return nil
+}
+
+func shouldMigrateStateSummaryEncoding(db *bolt.DB) (bool, error) {
+	var done bool
+	err := db.View(func(tx *bolt.Tx) error {
+		done = bytes.Equal(tx.Bucket(migrationsBucket).Get(migrationStateSummaryEncoding0Key), migrationCompleted)
+		return nil
+	})
+	return !done, err
+}
@@ -0,0 +1,72 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func Test_migrateStateSummaryEncoding(t *testing.T) {
+	ctx := context.Background()
+	store := setupDB(t)
+	defaultBatchSize := stateSummaryMigrationBatchSize
+	stateSummaryMigrationBatchSize = 3
+	defer func() {
+		stateSummaryMigrationBatchSize = defaultBatchSize
+	}()
+
+	summaries := make([]*ethpb.StateSummary, 10)
+	require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		for i := range summaries {
+			summaries[i] = &ethpb.StateSummary{Slot: primitives.Slot(i), Root: bytesutil.PadTo([]byte{byte(i)}, 32)}
+			enc, err := encode(ctx, summaries[i])
+			require.NoError(t, err)
+			// Every other summary was already migrated.
+			if i%2 == 0 {
+				enc, err = encodeStateSummary(summaries[i])
+				require.NoError(t, err)
+			}
+			if err := tx.Bucket(stateSummaryBucket).Put(summaries[i].Root, enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	// Summaries are readable during the migration window.
+	for _, s := range summaries {
+		saved, err := store.StateSummary(ctx, bytesutil.ToBytes32(s.Root))
+		require.NoError(t, err)
+		require.DeepEqual(t, s, saved)
+	}
+
+	require.NoError(t, migrateStateSummaryEncoding(ctx, store.db))
+	require.NoError(t, store.db.View(func(tx *bolt.Tx) error {
+		for _, s := range summaries {
+			enc := tx.Bucket(stateSummaryBucket).Get(s.Root)
+			require.Equal(t, true, isCompactStateSummary(enc))
+			saved, err := decodeStateSummary(ctx, enc)
+			require.NoError(t, err)
+			require.DeepEqual(t, s, saved)
+		}
+		require.DeepEqual(t, migrationCompleted, tx.Bucket(migrationsBucket).Get(migrationStateSummaryEncoding0Key))
+		return nil
+	}))
+
+	// The migration only runs once.
+	legacy, err := encode(ctx, summaries[0])
+	require.NoError(t, err)
+	require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(stateSummaryBucket).Put(summaries[0].Root, legacy)
+	}))
+	require.NoError(t, migrateStateSummaryEncoding(ctx, store.db))
+	require.NoError(t, store.db.View(func(tx *bolt.Tx) error {
+		require.DeepEqual(t, legacy, tx.Bucket(stateSummaryBucket).Get(summaries[0].Root))
+		return nil
+	}))
+}
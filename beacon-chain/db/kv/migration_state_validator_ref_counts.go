@@ -0,0 +1,166 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// refCountsBatchSize is the number of states counted per transaction.
+	refCountsBatchSize = 64
+	// unreferencedValidatorsBatchSize is the number of validator entries checked for references per transaction.
+	unreferencedValidatorsBatchSize = 4096
+)
+
+var (
+	migrationStateValidatorRefCountsKey = []byte("migration_state_validator_ref_counts")
+	// stateValidatorRefCountsProgressKey holds the root of the last state counted by the migration, so that an
+	// interrupted migration resumes without counting states twice.
+	stateValidatorRefCountsProgressKey = []byte("state_validator_ref_counts_progress")
+)
+
+// migrateStateValidatorRefCounts counts the references of stored states to validator entries, for databases which
+// stored validator entries separately before reference counting, and deletes the entries no state references.
+// The states are counted in batches, each committed along with the progress of the migration.
+func migrateStateValidatorRefCounts(ctx context.Context, db *bolt.DB) error {
+	if migrate, err := shouldCountValidatorRefs(db); err != nil || !migrate {
+		return err
+	}
+
+	log.Info("Counting the references of stored states to validator entries")
+	for done := false; !done; {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := db.Update(func(tx *bolt.Tx) error {
+			var err error
+			done, err = countValidatorRefsBatch(tx)
+			return err
+		}); err != nil {
+			return errors.Wrap(err, "could not count validator entry references")
+		}
+	}
+
+	var from []byte
+	deleted := 0
+	for done := false; !done; {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := db.Update(func(tx *bolt.Tx) error {
+			var n int
+			var err error
+			n, from, err = deleteUnreferencedValidatorsBatch(tx, from)
+			deleted += n
+			done = from == nil
+			return err
+		}); err != nil {
+			return errors.Wrap(err, "could not delete unreferenced validator entries")
+		}
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		mb := tx.Bucket(migrationsBucket)
+		if err := mb.Delete(stateValidatorRefCountsProgressKey); err != nil {
+			return err
+		}
+		return mb.Put(migrationStateValidatorRefCountsKey, migrationCompleted)
+	}); err != nil {
+		return err
+	}
+	log.WithField("deletedEntries", deleted).Info("Counted the references of stored states to validator entries")
+	return nil
+}
+
+func shouldCountValidatorRefs(db *bolt.DB) (bool, error) {
+	var migrate bool
+	err := db.View(func(tx *bolt.Tx) error {
+		mb := tx.Bucket(migrationsBucket)
+		if bytes.Equal(mb.Get(migrationStateValidatorRefCountsKey), migrationCompleted) {
+			return nil
+		}
+		// States only reference validator entries once validators are stored separately.
+		migrate = features.Get().EnableHistoricalSpaceRepresentation || bytes.Equal(mb.Get(migrationStateValidatorsKey), migrationCompleted)
+		return nil
+	})
+	return migrate, err
+}
+
+// countValidatorRefsBatch adds the references of the next batch of stored states to the reference counts, and
+// reports whether all states were counted.
+func countValidatorRefsBatch(tx *bolt.Tx) (bool, error) {
+	mb := tx.Bucket(migrationsBucket)
+	c := tx.Bucket(blockRootValidatorHashesBucket).Cursor()
+	var k, v []byte
+	if last := mb.Get(stateValidatorRefCountsProgressKey); last == nil {
+		// Reference counts maintained before the migration started are incomplete, start from scratch.
+		if err := tx.DeleteBucket(stateValidatorRefCountsBucket); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+			return false, err
+		}
+		if _, err := tx.CreateBucket(stateValidatorRefCountsBucket); err != nil {
+			return false, err
+		}
+		k, v = c.First()
+	} else {
+		k, v = c.Seek(last)
+		if bytes.Equal(k, last) {
+			k, v = c.Next()
+		}
+	}
+
+	var lastKey []byte
+	refs := make(validatorRefDeltas)
+	for i := 0; k != nil && i < refCountsBatchSize; k, v = c.Next() {
+		if err := refs.add(v, 1); err != nil {
+			// The entries of the state are kept when deleting it, as they have no reference count.
+			log.WithError(err).WithField("root", fmt.Sprintf("%#x", k)).Warn("Could not count validator entries of state")
+		}
+		lastKey = bytesutil.SafeCopyBytes(k)
+		i++
+	}
+	if _, err := refs.apply(tx); err != nil {
+		return false, err
+	}
+	if lastKey != nil {
+		if err := mb.Put(stateValidatorRefCountsProgressKey, lastKey); err != nil {
+			return false, err
+		}
+	}
+	return k == nil, nil
+}
+
+// deleteUnreferencedValidatorsBatch deletes the validator entries without references among the next batch of
+// entries starting at the given key, and returns the number of deleted entries and the key of the next batch,
+// which is nil once all entries were visited.
+func deleteUnreferencedValidatorsBatch(tx *bolt.Tx, from []byte) (int, []byte, error) {
+	valBkt := tx.Bucket(stateValidatorsBucket)
+	refBkt := tx.Bucket(stateValidatorRefCountsBucket)
+	c := valBkt.Cursor()
+	k, _ := c.First()
+	if from != nil {
+		k, _ = c.Seek(from)
+	}
+	var unreferenced [][]byte
+	for i := 0; k != nil && i < unreferencedValidatorsBatchSize; k, _ = c.Next() {
+		if refBkt.Get(k) == nil {
+			unreferenced = append(unreferenced, bytesutil.SafeCopyBytes(k))
+		}
+		i++
+	}
+	var next []byte
+	if k != nil {
+		next = bytesutil.SafeCopyBytes(k)
+	}
+	for _, key := range unreferenced {
+		if err := valBkt.Delete(key); err != nil {
+			return 0, nil, err
+		}
+	}
+	return len(unreferenced), next, nil
+}
@@ -0,0 +1,304 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/iface"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	bolt "go.etcd.io/bbolt"
+)
+
+// The operation totals index accumulates, for the finalized canonical chain, the deposits made to each validator
+// public key and the withdrawals of each validator index. Deposits are keyed by public key because a deposit only
+// receives a validator index once it is processed by the state transition, which may happen after the block
+// containing it. Values are fixed-width big endian integers: count and total gwei for deposits, followed by the
+// last withdrawal slot for withdrawals. The root and slot of the last indexed block are kept under
+// operationTotalsLastIndexedKey so that blocks are indexed exactly once, in chain order.
+var operationTotalsLastIndexedKey = []byte("operation-totals-last-indexed")
+
+const (
+	depositTotalsLength    = 16
+	withdrawalTotalsLength = 24
+)
+
+var (
+	// ErrBlindedOperationTotalsBlock is returned when a block given to the operation totals index lacks
+	// its execution payload withdrawals.
+	ErrBlindedOperationTotalsBlock = errors.New("blinded block cannot be indexed, reconstruct its execution payload first")
+	errOperationTotalsGap          = errors.New("block does not extend the last indexed block")
+)
+
+// OperationTotalsIndexedSlot returns the slot of the last block added to the operation totals index.
+func (s *Store) OperationTotalsIndexedSlot(ctx context.Context) (primitives.Slot, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.OperationTotalsIndexedSlot")
+	defer span.End()
+
+	var slot primitives.Slot
+	err := s.db.View(func(tx *bolt.Tx) error {
+		_, sl, ok := lastIndexedOperationTotals(tx)
+		if !ok {
+			return errors.Wrap(ErrNotFound, "operation totals index is empty")
+		}
+		slot = sl
+		return nil
+	})
+	return slot, err
+}
+
+// DepositTotals returns the cumulative deposits of the given public keys. Keys without deposits have zero totals.
+func (s *Store) DepositTotals(ctx context.Context, pubKeys [][fieldparams.BLSPubkeyLength]byte) ([]*iface.DepositTotals, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DepositTotals")
+	defer span.End()
+
+	totals := make([]*iface.DepositTotals, len(pubKeys))
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(depositTotalsBucket)
+		for i, pubKey := range pubKeys {
+			totals[i] = decodeDepositTotals(bkt.Get(pubKey[:]))
+		}
+		return nil
+	})
+	return totals, err
+}
+
+// WithdrawalTotals returns the cumulative withdrawals of the given validator indices. Validators without
+// withdrawals have zero totals.
+func (s *Store) WithdrawalTotals(ctx context.Context, indices []primitives.ValidatorIndex) ([]*iface.WithdrawalTotals, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.WithdrawalTotals")
+	defer span.End()
+
+	totals := make([]*iface.WithdrawalTotals, len(indices))
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(withdrawalTotalsBucket)
+		for i, idx := range indices {
+			totals[i] = decodeWithdrawalTotals(bkt.Get(bytesutil.Uint64ToBytesBigEndian(uint64(idx))))
+		}
+		return nil
+	})
+	return totals, err
+}
+
+// NextOperationTotalsBlocks returns up to limit finalized canonical blocks following the last block of the
+// operation totals index, in chain order. The index starts at the checkpoint sync origin block if there is one,
+// or at the child of the genesis block otherwise, and stops at the latest finalized block.
+func (s *Store) NextOperationTotalsBlocks(ctx context.Context, limit int) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.NextOperationTotalsBlocks")
+	defer span.End()
+
+	var (
+		root    [32]byte
+		indexed bool
+	)
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		root, _, indexed = lastIndexedOperationTotals(tx)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	if !indexed {
+		first, err := s.firstOperationTotalsBlock(ctx)
+		if err != nil || first == nil {
+			return nil, err
+		}
+		blks = append(blks, first)
+		if root, err = first.Block().HashTreeRoot(); err != nil {
+			return nil, err
+		}
+	}
+	for len(blks) < limit {
+		child, err := s.FinalizedChildBlock(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if child == nil {
+			break
+		}
+		blks = append(blks, child)
+		if root, err = child.Block().HashTreeRoot(); err != nil {
+			return nil, err
+		}
+	}
+	return blks, nil
+}
+
+func (s *Store) firstOperationTotalsBlock(ctx context.Context) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	originRoot, err := s.OriginCheckpointBlockRoot(ctx)
+	switch {
+	case err == nil:
+		return s.Block(ctx, originRoot)
+	case !errors.Is(err, ErrNotFoundOriginBlockRoot):
+		return nil, err
+	}
+
+	genesisRoot, err := s.GenesisBlockRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The genesis block is not part of the finalized block roots index, so its canonical child is looked up
+	// among the blocks built on top of it.
+	blks, roots, err := s.Blocks(ctx, filters.NewFilter().SetParentRoot(genesisRoot[:]))
+	if err != nil {
+		return nil, err
+	}
+	var canonical interfaces.ReadOnlySignedBeaconBlock
+	err = s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+		for i, r := range roots {
+			if enc := bkt.Get(r[:]); enc != nil && !bytes.Equal(enc, containerFinalizedButNotCanonical) {
+				canonical = blks[i]
+				return nil
+			}
+		}
+		return nil
+	})
+	return canonical, err
+}
+
+// IndexOperationTotals adds the deposits and withdrawals of a contiguous segment of blocks to the operation totals
+// index. The first block must be the child of the last indexed block, unless the index is empty. Blocks must carry
+// their full execution payload.
+func (s *Store) IndexOperationTotals(ctx context.Context, blks []interfaces.ReadOnlySignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.IndexOperationTotals")
+	defer span.End()
+	if len(blks) == 0 {
+		return nil
+	}
+
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		if b.Version() >= version.Capella && b.IsBlinded() {
+			return errors.Wrapf(ErrBlindedOperationTotalsBlock, "slot %d", b.Block().Slot())
+		}
+		r, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots[i] = r
+		if i > 0 && b.Block().ParentRoot() != roots[i-1] {
+			return errors.Wrapf(errOperationTotalsGap, "block at slot %d", b.Block().Slot())
+		}
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if lastRoot, _, ok := lastIndexedOperationTotals(tx); ok && blks[0].Block().ParentRoot() != lastRoot {
+			return errors.Wrapf(errOperationTotalsGap, "block at slot %d", blks[0].Block().Slot())
+		}
+		depositBkt := tx.Bucket(depositTotalsBucket)
+		withdrawalBkt := tx.Bucket(withdrawalTotalsBucket)
+		for _, b := range blks {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := indexBlockOperationTotals(depositBkt, withdrawalBkt, b); err != nil {
+				return err
+			}
+		}
+		last := len(blks) - 1
+		enc := append(bytesutil.SafeCopyBytes(roots[last][:]), bytesutil.SlotToBytesBigEndian(blks[last].Block().Slot())...)
+		return tx.Bucket(chainMetadataBucket).Put(operationTotalsLastIndexedKey, enc)
+	})
+}
+
+func indexBlockOperationTotals(depositBkt, withdrawalBkt *bolt.Bucket, b interfaces.ReadOnlySignedBeaconBlock) error {
+	body := b.Block().Body()
+	for _, d := range body.Deposits() {
+		if err := addDeposit(depositBkt, d.Data.PublicKey, d.Data.Amount); err != nil {
+			return err
+		}
+	}
+	if b.Version() >= version.Electra {
+		requests, err := body.ExecutionRequests()
+		if err != nil {
+			return err
+		}
+		for _, d := range requests.GetDeposits() {
+			if err := addDeposit(depositBkt, d.Pubkey, d.Amount); err != nil {
+				return err
+			}
+		}
+	}
+	if b.Version() < version.Capella {
+		return nil
+	}
+	payload, err := body.Execution()
+	if err != nil {
+		return err
+	}
+	withdrawals, err := payload.Withdrawals()
+	if err != nil {
+		return err
+	}
+	for _, w := range withdrawals {
+		key := bytesutil.Uint64ToBytesBigEndian(uint64(w.ValidatorIndex))
+		totals := decodeWithdrawalTotals(withdrawalBkt.Get(key))
+		totals.Count++
+		totals.TotalGwei += w.Amount
+		totals.LastWithdrawalSlot = b.Block().Slot()
+		if err := withdrawalBkt.Put(key, encodeWithdrawalTotals(totals)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addDeposit(bkt *bolt.Bucket, pubKey []byte, amount uint64) error {
+	totals := decodeDepositTotals(bkt.Get(pubKey))
+	totals.Count++
+	totals.TotalGwei += amount
+	return bkt.Put(pubKey, encodeDepositTotals(totals))
+}
+
+func lastIndexedOperationTotals(tx *bolt.Tx) ([32]byte, primitives.Slot, bool) {
+	enc := tx.Bucket(chainMetadataBucket).Get(operationTotalsLastIndexedKey)
+	if len(enc) != fieldparams.RootLength+8 {
+		return [32]byte{}, 0, false
+	}
+	return bytesutil.ToBytes32(enc[:fieldparams.RootLength]), bytesutil.BytesToSlotBigEndian(enc[fieldparams.RootLength:]), true
+}
+
+func encodeDepositTotals(t *iface.DepositTotals) []byte {
+	enc := make([]byte, depositTotalsLength)
+	binary.BigEndian.PutUint64(enc[0:8], t.Count)
+	binary.BigEndian.PutUint64(enc[8:16], t.TotalGwei)
+	return enc
+}
+
+func decodeDepositTotals(enc []byte) *iface.DepositTotals {
+	if len(enc) != depositTotalsLength {
+		return &iface.DepositTotals{}
+	}
+	return &iface.DepositTotals{
+		Count:     binary.BigEndian.Uint64(enc[0:8]),
+		TotalGwei: binary.BigEndian.Uint64(enc[8:16]),
+	}
+}
+
+func encodeWithdrawalTotals(t *iface.WithdrawalTotals) []byte {
+	enc := make([]byte, withdrawalTotalsLength)
+	binary.BigEndian.PutUint64(enc[0:8], t.Count)
+	binary.BigEndian.PutUint64(enc[8:16], t.TotalGwei)
+	binary.BigEndian.PutUint64(enc[16:24], uint64(t.LastWithdrawalSlot))
+	return enc
+}
+
+func decodeWithdrawalTotals(enc []byte) *iface.WithdrawalTotals {
+	if len(enc) != withdrawalTotalsLength {
+		return &iface.WithdrawalTotals{}
+	}
+	return &iface.WithdrawalTotals{
+		Count:              binary.BigEndian.Uint64(enc[0:8]),
+		TotalGwei:          binary.BigEndian.Uint64(enc[8:16]),
+		LastWithdrawalSlot: primitives.Slot(binary.BigEndian.Uint64(enc[16:24])),
+	}
+}
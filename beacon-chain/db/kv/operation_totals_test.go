@@ -0,0 +1,178 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/iface"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+const operationTotalsTestValidators = 5
+
+// makeOperationBlocks builds a chain of Capella blocks, each with a deposit and a withdrawal.
+func makeOperationBlocks(t *testing.T, start, n uint64, previousRoot [32]byte) []interfaces.ReadOnlySignedBeaconBlock {
+	blks := make([]interfaces.ReadOnlySignedBeaconBlock, n)
+	for j := start; j < start+n; j++ {
+		b := util.NewBeaconBlockCapella()
+		b.Block.Slot = primitives.Slot(j + 1)
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(previousRoot[:])
+		proof := make([][]byte, params.BeaconConfig().DepositContractTreeDepth+1)
+		for i := range proof {
+			proof[i] = make([]byte, 32)
+		}
+		b.Block.Body.Deposits = []*ethpb.Deposit{{
+			Proof: proof,
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(j % operationTotalsTestValidators)}, fieldparams.BLSPubkeyLength),
+				WithdrawalCredentials: make([]byte, 32),
+				Amount:                params.BeaconConfig().MinDepositAmount * (j + 1),
+				Signature:             make([]byte, fieldparams.BLSSignatureLength),
+			},
+		}}
+		b.Block.Body.ExecutionPayload.Withdrawals = []*enginev1.Withdrawal{{
+			Index:          j,
+			ValidatorIndex: primitives.ValidatorIndex(j % operationTotalsTestValidators),
+			Address:        make([]byte, fieldparams.FeeRecipientLength),
+			Amount:         j + 1,
+		}}
+		var err error
+		previousRoot, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		blks[j-start], err = consensusblocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+	}
+	return blks
+}
+
+// bruteForceOperationTotals sums the deposits and withdrawals of the given blocks.
+func bruteForceOperationTotals(t *testing.T, blks []interfaces.ReadOnlySignedBeaconBlock) (map[[fieldparams.BLSPubkeyLength]byte]*iface.DepositTotals, map[primitives.ValidatorIndex]*iface.WithdrawalTotals) {
+	deposits := make(map[[fieldparams.BLSPubkeyLength]byte]*iface.DepositTotals)
+	withdrawals := make(map[primitives.ValidatorIndex]*iface.WithdrawalTotals)
+	for _, b := range blks {
+		for _, d := range b.Block().Body().Deposits() {
+			k := bytesutil.ToBytes48(d.Data.PublicKey)
+			if deposits[k] == nil {
+				deposits[k] = &iface.DepositTotals{}
+			}
+			deposits[k].Count++
+			deposits[k].TotalGwei += d.Data.Amount
+		}
+		payload, err := b.Block().Body().Execution()
+		require.NoError(t, err)
+		ws, err := payload.Withdrawals()
+		require.NoError(t, err)
+		for _, w := range ws {
+			if withdrawals[w.ValidatorIndex] == nil {
+				withdrawals[w.ValidatorIndex] = &iface.WithdrawalTotals{}
+			}
+			withdrawals[w.ValidatorIndex].Count++
+			withdrawals[w.ValidatorIndex].TotalGwei += w.Amount
+			withdrawals[w.ValidatorIndex].LastWithdrawalSlot = b.Block().Slot()
+		}
+	}
+	return deposits, withdrawals
+}
+
+func requireOperationTotals(t *testing.T, db *Store, blks []interfaces.ReadOnlySignedBeaconBlock) {
+	ctx := context.Background()
+	wantDeposits, wantWithdrawals := bruteForceOperationTotals(t, blks)
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, operationTotalsTestValidators)
+	indices := make([]primitives.ValidatorIndex, operationTotalsTestValidators)
+	for i := range pubKeys {
+		pubKeys[i] = bytesutil.ToBytes48(bytesutil.PadTo([]byte{byte(i)}, fieldparams.BLSPubkeyLength))
+		indices[i] = primitives.ValidatorIndex(i)
+	}
+	deposits, err := db.DepositTotals(ctx, pubKeys)
+	require.NoError(t, err)
+	withdrawals, err := db.WithdrawalTotals(ctx, indices)
+	require.NoError(t, err)
+	for i := range pubKeys {
+		want := wantDeposits[pubKeys[i]]
+		if want == nil {
+			want = &iface.DepositTotals{}
+		}
+		require.DeepEqual(t, want, deposits[i], "deposits of key %d", i)
+		wantWithdrawal := wantWithdrawals[indices[i]]
+		if wantWithdrawal == nil {
+			wantWithdrawal = &iface.WithdrawalTotals{}
+		}
+		require.DeepEqual(t, wantWithdrawal, withdrawals[i], "withdrawals of validator %d", i)
+	}
+}
+
+func TestStore_OperationTotals(t *testing.T) {
+	ctx := context.Background()
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	// Save full execution payloads.
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Delete(saveBlindedBeaconBlocksKey)
+	}))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+
+	blks := makeOperationBlocks(t, 0, slotsPerEpoch*3, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	// A fork from the fourth block is never finalized and must not be counted.
+	forkParent, err := blks[3].Block().HashTreeRoot()
+	require.NoError(t, err)
+	fork := makeOperationBlocks(t, 100, 2, forkParent)
+	require.NoError(t, db.SaveBlocks(ctx, fork))
+
+	finalize := func(epoch primitives.Epoch, i uint64) {
+		root, err := blks[i].Block().HashTreeRoot()
+		require.NoError(t, err)
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveState(ctx, st, root))
+		require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: epoch, Root: root[:]}))
+	}
+	index := func() primitives.Slot {
+		for {
+			next, err := db.NextOperationTotalsBlocks(ctx, 7)
+			require.NoError(t, err)
+			if len(next) == 0 {
+				break
+			}
+			require.NoError(t, db.IndexOperationTotals(ctx, next))
+		}
+		slot, err := db.OperationTotalsIndexedSlot(ctx)
+		require.NoError(t, err)
+		return slot
+	}
+
+	_, err = db.OperationTotalsIndexedSlot(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	finalize(1, slotsPerEpoch)
+	require.Equal(t, blks[slotsPerEpoch].Block().Slot(), index())
+	requireOperationTotals(t, db, blks[:slotsPerEpoch+1])
+
+	// Indexing resumes from the last indexed block on the next finalization.
+	finalize(2, slotsPerEpoch*2)
+	require.Equal(t, blks[slotsPerEpoch*2].Block().Slot(), index())
+	requireOperationTotals(t, db, blks[:slotsPerEpoch*2+1])
+
+	// Blocks must extend the last indexed block.
+	err = db.IndexOperationTotals(ctx, blks[1:2])
+	require.ErrorIs(t, err, errOperationTotalsGap)
+}
+
+func TestStore_IndexOperationTotals_Blinded(t *testing.T) {
+	db := setupDB(t)
+	blks := makeOperationBlocks(t, 0, 1, genesisBlockRoot)
+	blinded, err := blks[0].ToBlinded()
+	require.NoError(t, err)
+	err = db.IndexOperationTotals(context.Background(), []interfaces.ReadOnlySignedBeaconBlock{blinded})
+	require.ErrorIs(t, err, ErrBlindedOperationTotalsBlock)
+}
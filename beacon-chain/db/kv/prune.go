@@ -0,0 +1,228 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	bolt "go.etcd.io/bbolt"
+)
+
+// pruneBatchSlots is the number of slots pruned in a single transaction.
+const pruneBatchSlots = 256
+
+// ErrPruneAfterFinalized is returned when pruning up to a slot after the finalized block.
+var ErrPruneAfterFinalized = errors.New("cannot prune after the finalized block")
+
+// PruneStats describes the objects deleted by PruneBefore, or which would be deleted in a dry run.
+type PruneStats struct {
+	Blocks         int
+	States         int
+	StateSummaries int
+	// Bytes is the size of the values of the deleted blocks and states.
+	Bytes uint64
+	// FreeBytesBefore and FreeBytesAfter are the bytes of the free pages of the database before and after pruning,
+	// which bolt reuses for new data as it never shrinks the database file.
+	FreeBytesBefore int
+	FreeBytesAfter  int
+}
+
+// PruneBefore deletes the blocks, states and state summaries of the slots before the given slot, and rewrites the
+// block and state slot indices accordingly. The genesis, origin checkpoint, backfill low and finalized blocks and
+// their states are kept, so that the node can start from the pruned database. Validator entries no longer referenced
+// by a kept state are deleted along with the pruned states. With dryRun, the database is left untouched and the
+// returned stats describe what would be deleted. The beacon node must not be running.
+func (s *Store) PruneBefore(ctx context.Context, before primitives.Slot, dryRun bool) (*PruneStats, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneBefore")
+	defer span.End()
+
+	finalized, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	finalizedRoot := bytesutil.ToBytes32(finalized.Root)
+	finalizedBlock, err := s.Block(ctx, finalizedRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized block")
+	}
+	if finalizedBlock == nil || finalizedBlock.IsNil() {
+		return nil, errors.Wrapf(ErrNotFound, "finalized block %#x", finalizedRoot)
+	}
+	if finalizedSlot := finalizedBlock.Block().Slot(); before > finalizedSlot {
+		return nil, errors.Wrapf(ErrPruneAfterFinalized, "slot %d is after finalized slot %d", before, finalizedSlot)
+	}
+	keep, err := s.pruneKeptRoots(ctx, finalizedRoot)
+	if err != nil {
+		return nil, err
+	}
+	validatorsMigrated, err := s.isStateValidatorMigrationOver()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &PruneStats{FreeBytesBefore: s.db.Stats().FreeAlloc}
+	for start := primitives.Slot(0); start < before; start += pruneBatchSlots {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		end := start + pruneBatchSlots
+		if end > before {
+			end = before
+		}
+		prune := func(tx *bolt.Tx) error {
+			return s.pruneSlots(ctx, tx, start, end, keep, validatorsMigrated, dryRun, stats)
+		}
+		if dryRun {
+			err = s.db.View(prune)
+		} else {
+			err = s.db.Update(prune)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not prune slots %d to %d", start, end)
+		}
+	}
+	stats.FreeBytesAfter = s.db.Stats().FreeAlloc
+	return stats, nil
+}
+
+// pruneKeptRoots returns the roots of the blocks and states that pruning must keep.
+func (s *Store) pruneKeptRoots(ctx context.Context, finalizedRoot [32]byte) (map[[32]byte]bool, error) {
+	keep := map[[32]byte]bool{finalizedRoot: true}
+	genesisRoot, err := s.GenesisBlockRoot(ctx)
+	if err != nil && !errors.Is(err, ErrNotFoundGenesisBlockRoot) {
+		return nil, errors.Wrap(err, "could not get genesis block root")
+	}
+	if err == nil {
+		keep[genesisRoot] = true
+	}
+	originRoot, err := s.OriginCheckpointBlockRoot(ctx)
+	if err != nil && !errors.Is(err, ErrNotFoundOriginBlockRoot) {
+		return nil, errors.Wrap(err, "could not get origin checkpoint block root")
+	}
+	if err == nil {
+		keep[originRoot] = true
+	}
+	bf, err := s.BackfillStatus(ctx)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, errors.Wrap(err, "could not get backfill status")
+	}
+	if err == nil {
+		keep[bytesutil.ToBytes32(bf.LowRoot)] = true
+	}
+	return keep, nil
+}
+
+// pruneSlots deletes the blocks and states of the slots in [start, end), except the kept roots.
+func (s *Store) pruneSlots(
+	ctx context.Context,
+	tx *bolt.Tx,
+	start, end primitives.Slot,
+	keep map[[32]byte]bool,
+	validatorsMigrated, dryRun bool,
+	stats *PruneStats,
+) error {
+	stateRoots, err := rootsBySlotRange(tx.Bucket(stateSlotIndicesBucket), start, end)
+	if err != nil {
+		return errors.Wrap(err, "could not get state roots")
+	}
+	refs := make(validatorRefDeltas)
+	for _, r := range stateRoots {
+		if keep[r] {
+			continue
+		}
+		enc := tx.Bucket(stateBucket).Get(r[:])
+		if enc == nil {
+			continue
+		}
+		stats.States++
+		stats.Bytes += uint64(len(enc))
+		if dryRun {
+			continue
+		}
+		if err := s.deleteState(ctx, tx, r, validatorsMigrated, refs); err != nil {
+			return errors.Wrapf(err, "could not delete state %#x", r)
+		}
+	}
+	if err := s.applyValidatorRefDeltas(tx, refs); err != nil {
+		return errors.Wrap(err, "could not release validator entries")
+	}
+
+	slotIndex := tx.Bucket(blockSlotIndicesBucket)
+	c := slotIndex.Cursor()
+	var keys, kept [][]byte
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(start)); k != nil && bytesutil.BytesToSlotBigEndian(k) < end; k, v = c.Next() {
+		roots, err := splitRoots(v)
+		if err != nil {
+			return err
+		}
+		var keptAtSlot []byte
+		for _, r := range roots {
+			if keep[r] {
+				keptAtSlot = append(keptAtSlot, r[:]...)
+				continue
+			}
+			if err := s.pruneBlock(tx, r, dryRun, stats); err != nil {
+				return errors.Wrapf(err, "could not delete block %#x", r)
+			}
+		}
+		if !bytes.Equal(keptAtSlot, v) {
+			keys = append(keys, bytesutil.SafeCopyBytes(k))
+			kept = append(kept, keptAtSlot)
+		}
+	}
+	if dryRun {
+		return nil
+	}
+	// The slot index is rewritten once the cursor is done, as bolt cursors do not support concurrent writes.
+	for i, k := range keys {
+		if len(kept[i]) == 0 {
+			if err := slotIndex.Delete(k); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := slotIndex.Put(k, kept[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneBlock deletes a block along with its state summary and the entries of the block root in root keyed indices.
+func (s *Store) pruneBlock(tx *bolt.Tx, root [32]byte, dryRun bool, stats *PruneStats) error {
+	if enc := tx.Bucket(blocksBucket).Get(root[:]); enc != nil {
+		stats.Blocks++
+		stats.Bytes += uint64(len(enc))
+	}
+	if s.stateSummaryCache.has(root) || tx.Bucket(stateSummaryBucket).Get(root[:]) != nil {
+		stats.StateSummaries++
+	}
+	if dryRun {
+		return nil
+	}
+	s.stateSummaryCache.delete(root)
+	s.blockCache.Del(string(root[:]))
+	for _, b := range [][]byte{blocksBucket, stateSummaryBucket, blockParentRootIndicesBucket, finalizedBlockRootsIndexBucket} {
+		if err := tx.Bucket(b).Delete(root[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rootsBySlotRange returns the roots indexed under the slots in [start, end) of a slot index bucket.
+func rootsBySlotRange(bkt *bolt.Bucket, start, end primitives.Slot) ([][32]byte, error) {
+	var roots [][32]byte
+	c := bkt.Cursor()
+	for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(start)); k != nil && bytesutil.BytesToSlotBigEndian(k) < end; k, v = c.Next() {
+		r, err := splitRoots(v)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, r...)
+	}
+	return roots, nil
+}
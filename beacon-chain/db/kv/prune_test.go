@@ -0,0 +1,103 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestStore_PruneBefore(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+
+	genesis, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	genesisRoot, err := genesis.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	// Blocks of slots 1 to 3 epochs.
+	blks := makeBlocks(t, 0, 3*slotsPerEpoch, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	summaries := make([]*ethpb.StateSummary, len(blks))
+	for i, blk := range blks {
+		roots[i], err = blk.Block().HashTreeRoot()
+		require.NoError(t, err)
+		summaries[i] = &ethpb.StateSummary{Slot: blk.Block().Slot(), Root: roots[i][:]}
+	}
+	require.NoError(t, db.SaveStateSummaries(ctx, summaries))
+	originRoot := roots[2]
+	require.NoError(t, db.SaveOriginCheckpointBlockRoot(ctx, originRoot))
+
+	saveState := func(root [32]byte, slot primitives.Slot) {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, db.SaveState(ctx, st, root))
+	}
+	saveState(genesisRoot, 0)
+	prunedStateRoot := roots[slotsPerEpoch-1]
+	saveState(prunedStateRoot, primitives.Slot(slotsPerEpoch))
+	finalizedRoot := roots[2*slotsPerEpoch-1]
+	finalizedSlot := primitives.Slot(2 * slotsPerEpoch)
+	saveState(finalizedRoot, finalizedSlot)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: finalizedRoot[:]}))
+
+	// All blocks of slots 1 to the finalized slot, except the origin checkpoint block.
+	wantBlocks := int(finalizedSlot) - 2
+
+	t.Run("after finalized", func(t *testing.T) {
+		_, err := db.PruneBefore(ctx, finalizedSlot+1, false)
+		require.ErrorIs(t, err, ErrPruneAfterFinalized)
+	})
+	t.Run("dry run", func(t *testing.T) {
+		stats, err := db.PruneBefore(ctx, finalizedSlot, true)
+		require.NoError(t, err)
+		assert.Equal(t, wantBlocks, stats.Blocks)
+		assert.Equal(t, wantBlocks, stats.StateSummaries)
+		assert.Equal(t, 1, stats.States)
+		assert.Equal(t, true, db.HasBlock(ctx, roots[0]))
+		assert.Equal(t, true, db.HasState(ctx, prunedStateRoot))
+	})
+	t.Run("prune", func(t *testing.T) {
+		stats, err := db.PruneBefore(ctx, finalizedSlot, false)
+		require.NoError(t, err)
+		assert.Equal(t, wantBlocks, stats.Blocks)
+		assert.Equal(t, wantBlocks, stats.StateSummaries)
+		assert.Equal(t, 1, stats.States)
+
+		for i, r := range roots {
+			kept := r == originRoot || i >= 2*int(slotsPerEpoch)-1
+			assert.Equal(t, kept, db.HasBlock(ctx, r), "block of slot %d", i+1)
+			assert.Equal(t, kept, db.HasStateSummary(ctx, r), "state summary of slot %d", i+1)
+		}
+		assert.Equal(t, true, db.HasBlock(ctx, genesisRoot))
+		assert.Equal(t, true, db.HasState(ctx, genesisRoot))
+		assert.Equal(t, true, db.HasState(ctx, finalizedRoot))
+		assert.Equal(t, false, db.HasState(ctx, prunedStateRoot))
+
+		// The slot index only holds the kept blocks.
+		ok, _, err := db.BlockRootsBySlot(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, false, ok)
+		ok, slotRoots, err := db.BlockRootsBySlot(ctx, 3)
+		require.NoError(t, err)
+		assert.Equal(t, true, ok)
+		assert.DeepEqual(t, [][32]byte{originRoot}, slotRoots)
+
+		// Pruning again is a no-op.
+		stats, err = db.PruneBefore(ctx, finalizedSlot, false)
+		require.NoError(t, err)
+		assert.Equal(t, 0, stats.Blocks)
+		assert.Equal(t, 0, stats.States)
+	})
+}
@@ -17,6 +17,9 @@ var (
 	feeRecipientBucket    = []byte("fee-recipient")
 	registrationBucket    = []byte("registration")
 
+	// Attestation pool saved while the node restarts.
+	attestationPoolBucket = []byte("attestation-pool")
+
 	// Light Client Updates Bucket
 	lightClientUpdatesBucket = []byte("light-client-updates")
 
@@ -31,6 +34,9 @@ var (
 	stateSlotIndicesBucket         = []byte("state-slot-indices")
 	finalizedBlockRootsIndexBucket = []byte("finalized-block-roots-index")
 	blockRootValidatorHashesBucket = []byte("block-root-validator-hashes")
+	stateValidatorRefCountsBucket  = []byte("state-validator-ref-counts")
+	depositTotalsBucket            = []byte("deposit-totals")
+	withdrawalTotalsBucket         = []byte("withdrawal-totals")
 
 	// Specific item keys.
 	headBlockRootKey           = []byte("head-root")
@@ -0,0 +1,222 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	snapshotPrefix = "prysm_beacondb_snapshot_"
+	snapshotSuffix = ".backup"
+	// snapshotTimeFormat sorts the snapshots by name from the oldest to the newest.
+	snapshotTimeFormat = "20060102T150405.000Z"
+)
+
+// ErrInsufficientDiskSpace is returned when the free disk space is lower than the size of the database to copy.
+var ErrInsufficientDiskSpace = errors.New("insufficient disk space")
+
+// SnapshotOptions configure the snapshots of the database taken before operations deleting or rewriting stored data.
+type SnapshotOptions struct {
+	// Dir is the directory of the snapshots, the backups directory of the database when empty.
+	Dir string
+	// Retention is the number of snapshots kept in the directory, the oldest ones being deleted. All are kept when 0.
+	Retention uint64
+}
+
+// Snapshot copies the database to a timestamped file of the snapshot directory, and checks the meta pages of the
+// copy are readable. It returns ErrInsufficientDiskSpace, without copying anything, when the free disk space of the
+// directory is lower than the size of the database.
+// Example: $DATADIR/beaconchaindata/backups/prysm_beacondb_snapshot_20240102T150405.000Z.backup
+func (s *Store) Snapshot(ctx context.Context, opts SnapshotOptions) (string, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.Snapshot")
+	defer span.End()
+
+	dir, snapshotPath, err := snapshotPaths(s.databasePath, opts)
+	if err != nil {
+		return "", err
+	}
+	// Copying within a read transaction gives a consistent copy of the open database.
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		if err := checkDiskSpace(dir, tx.Size()); err != nil {
+			return err
+		}
+		return tx.CopyFile(snapshotPath, params.BeaconIoConfig().ReadWritePermissions)
+	}); err != nil {
+		removeSnapshot(snapshotPath)
+		return "", errors.Wrap(err, "could not copy database")
+	}
+	if err := finishSnapshot(dir, snapshotPath, opts); err != nil {
+		return "", err
+	}
+	return snapshotPath, nil
+}
+
+// SnapshotFile saves the file of the closed database stored in the directory to a timestamped file of the snapshot
+// directory, and checks the meta pages of the snapshot are readable. The file is hard-linked where the filesystem
+// supports it, so the database file must be deleted rather than modified after the snapshot. Otherwise, it is copied
+// and ErrInsufficientDiskSpace is returned when the free disk space of the directory is lower than its size.
+func SnapshotFile(ctx context.Context, dirPath string, opts SnapshotOptions) (string, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.SnapshotFile")
+	defer span.End()
+
+	dir, snapshotPath, err := snapshotPaths(dirPath, opts)
+	if err != nil {
+		return "", err
+	}
+	databaseFile := filepath.Join(dirPath, DatabaseFileName)
+	// Hard links fail across filesystems and on filesystems which do not support them.
+	if err := os.Link(databaseFile, snapshotPath); err != nil {
+		log.WithError(err).Debug("Could not hard-link the database file, copying it")
+		if err := copyDatabaseFile(databaseFile, dir, snapshotPath); err != nil {
+			return "", errors.Wrap(err, "could not copy database file")
+		}
+	}
+	if err := finishSnapshot(dir, snapshotPath, opts); err != nil {
+		return "", err
+	}
+	return snapshotPath, nil
+}
+
+func snapshotPaths(databasePath string, opts SnapshotOptions) (string, string, error) {
+	dir := opts.Dir
+	if dir == "" {
+		dir = filepath.Join(databasePath, backupsDirectoryName)
+	}
+	dir, err := file.ExpandPath(dir)
+	if err != nil {
+		return "", "", err
+	}
+	if err := file.MkdirAll(dir); err != nil {
+		return "", "", errors.Wrapf(err, "could not create snapshot directory %s", dir)
+	}
+	return dir, filepath.Join(dir, snapshotPrefix+time.Now().UTC().Format(snapshotTimeFormat)+snapshotSuffix), nil
+}
+
+func checkDiskSpace(dir string, size int64) error {
+	available, err := file.AvailableDiskSpace(dir)
+	if errors.Is(err, file.ErrDiskSpaceUnsupported) {
+		log.WithError(err).Debug("Could not check the free disk space of the snapshot directory")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if size > 0 && uint64(size) > available { // lint:ignore uintcast -- The size is checked to be positive.
+		return errors.Wrapf(ErrInsufficientDiskSpace, "database size is %d bytes but %d bytes are available in %s", size, available, dir)
+	}
+	return nil
+}
+
+func copyDatabaseFile(databaseFile, dir, snapshotPath string) error {
+	src, err := os.Open(databaseFile) // #nosec G304
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Could not close database file")
+		}
+	}()
+	info, err := src.Stat()
+	if err != nil {
+		return err
+	}
+	if err := checkDiskSpace(dir, info.Size()); err != nil {
+		return err
+	}
+	dst, err := os.OpenFile(snapshotPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, src)
+	if err == nil {
+		err = dst.Sync()
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		removeSnapshot(snapshotPath)
+	}
+	return err
+}
+
+// finishSnapshot verifies the snapshot, deleting it when it is invalid, then deletes the oldest snapshots.
+func finishSnapshot(dir, snapshotPath string, opts SnapshotOptions) error {
+	if err := verifySnapshot(snapshotPath); err != nil {
+		removeSnapshot(snapshotPath)
+		return errors.Wrapf(err, "could not verify database snapshot %s", snapshotPath)
+	}
+	if err := pruneSnapshots(dir, opts.Retention); err != nil {
+		log.WithError(err).Error("Could not delete old database snapshots")
+	}
+	return nil
+}
+
+// verifySnapshot opens the snapshot, which fails when neither of its meta pages is valid, and reads its buckets.
+func verifySnapshot(snapshotPath string) (err error) {
+	db, err := bolt.Open(snapshotPath, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		ReadOnly: true,
+		Timeout:  params.BeaconIoConfig().BoltTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := db.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+	return db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(blocksBucket) == nil {
+			return fmt.Errorf("missing bucket %s", blocksBucket)
+		}
+		return nil
+	})
+}
+
+func removeSnapshot(snapshotPath string) {
+	if err := os.Remove(snapshotPath); err != nil && !os.IsNotExist(err) {
+		log.WithError(err).WithField("snapshot", snapshotPath).Error("Could not remove database snapshot")
+	}
+}
+
+// pruneSnapshots deletes the oldest snapshots of the directory, keeping the given number of them.
+func pruneSnapshots(dir string, retention uint64) error {
+	if retention == 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	var snapshots []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasPrefix(e.Name(), snapshotPrefix) && strings.HasSuffix(e.Name(), snapshotSuffix) {
+			snapshots = append(snapshots, e.Name())
+		}
+	}
+	if uint64(len(snapshots)) <= retention {
+		return nil
+	}
+	sort.Strings(snapshots)
+	for _, name := range snapshots[:uint64(len(snapshots))-retention] {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil {
+			return err
+		}
+		log.WithField("snapshot", name).Debug("Deleted old database snapshot")
+	}
+	return nil
+}
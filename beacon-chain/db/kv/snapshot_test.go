@@ -0,0 +1,136 @@
+package kv
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func saveSnapshotTestBlock(t *testing.T, db *Store) [32]byte {
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 100
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(context.Background(), wsb))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return root
+}
+
+// openSnapshot opens the snapshot as a database, in a new directory. Only one database can be open at once.
+func openSnapshot(t *testing.T, snapshotPath string) *Store {
+	dir := t.TempDir()
+	require.NoError(t, os.Rename(snapshotPath, filepath.Join(dir, DatabaseFileName)))
+	db, err := NewKVStore(context.Background(), dir)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	return db
+}
+
+func TestStore_Snapshot(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir())
+	require.NoError(t, err)
+	root := saveSnapshotTestBlock(t, db)
+
+	// Older snapshots beyond the retention are deleted, other backups are kept.
+	backupsDir := filepath.Join(db.databasePath, backupsDirectoryName)
+	require.NoError(t, os.MkdirAll(backupsDir, 0700))
+	for _, name := range []string{
+		snapshotPrefix + "20200101T000000.000Z" + snapshotSuffix,
+		snapshotPrefix + "20210101T000000.000Z" + snapshotSuffix,
+		"prysm_beacondb_at_slot_0000100.backup",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(backupsDir, name), []byte("backup"), 0600))
+	}
+
+	snapshotPath, err := db.Snapshot(ctx, SnapshotOptions{Retention: 2})
+	require.NoError(t, err)
+	assert.Equal(t, backupsDir, filepath.Dir(snapshotPath))
+	entries, err := os.ReadDir(backupsDir)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	require.DeepEqual(t, []string{
+		"prysm_beacondb_at_slot_0000100.backup",
+		snapshotPrefix + "20210101T000000.000Z" + snapshotSuffix,
+		filepath.Base(snapshotPath),
+	}, names)
+
+	// The snapshot is a copy, unchanged by later writes to the database.
+	require.NoError(t, db.DeleteBlock(ctx, root))
+	require.NoError(t, db.Close())
+	snapshot := openSnapshot(t, snapshotPath)
+	assert.Equal(t, true, snapshot.HasBlock(ctx, root))
+}
+
+func TestSnapshotFile(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir)
+	require.NoError(t, err)
+	root := saveSnapshotTestBlock(t, db)
+	require.NoError(t, db.Close())
+
+	snapshotDir := filepath.Join(t.TempDir(), "snapshots")
+	snapshotPath, err := SnapshotFile(ctx, dir, SnapshotOptions{Dir: snapshotDir, Retention: 1})
+	require.NoError(t, err)
+	assert.Equal(t, snapshotDir, filepath.Dir(snapshotPath))
+
+	// The snapshot outlives the database file.
+	require.NoError(t, RemoveDatabaseFile(dir))
+	_, err = os.Stat(filepath.Join(dir, DatabaseFileName))
+	assert.Equal(t, true, os.IsNotExist(err))
+	snapshot := openSnapshot(t, snapshotPath)
+	assert.Equal(t, true, snapshot.HasBlock(ctx, root))
+
+	_, err = SnapshotFile(ctx, dir, SnapshotOptions{Dir: snapshotDir})
+	require.ErrorContains(t, "could not copy database file", err)
+}
+
+func TestSnapshot_InvalidSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	snapshotPath := filepath.Join(dir, snapshotPrefix+"20200101T000000.000Z"+snapshotSuffix)
+	require.NoError(t, os.WriteFile(snapshotPath, make([]byte, 8192), 0600))
+
+	require.ErrorContains(t, "could not verify database snapshot", finishSnapshot(dir, snapshotPath, SnapshotOptions{}))
+	_, err := os.Stat(snapshotPath)
+	assert.Equal(t, true, os.IsNotExist(err), "invalid snapshot was not removed")
+}
+
+func TestSnapshot_InsufficientDiskSpace(t *testing.T) {
+	dir := t.TempDir()
+	require.ErrorIs(t, checkDiskSpace(dir, math.MaxInt64), ErrInsufficientDiskSpace)
+	require.NoError(t, checkDiskSpace(dir, 1))
+}
+
+func TestStore_PendingDestructiveMigrations(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	// An empty database has nothing to lose.
+	pending, err := db.PendingDestructiveMigrations()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(pending))
+
+	saveSnapshotTestBlock(t, db)
+	pending, err = db.PendingDestructiveMigrations()
+	require.NoError(t, err)
+	require.DeepEqual(t, []string{"state summary encoding"}, pending)
+
+	require.NoError(t, migrateStateSummaryEncoding(ctx, db.db))
+	pending, err = db.PendingDestructiveMigrations()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(pending))
+}
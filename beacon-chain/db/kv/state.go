@@ -216,11 +216,14 @@ func getValidators(states []state.ReadOnlyBeaconState) ([][]byte, map[string]*et
 func (s *Store) saveStatesEfficientInternal(ctx context.Context, tx *bolt.Tx, blockRoots [][32]byte, states []state.ReadOnlyBeaconState, validatorKeys [][]byte, validatorsEntries map[string]*ethpb.Validator) error {
 	bucket := tx.Bucket(stateBucket)
 	valIdxBkt := tx.Bucket(blockRootValidatorHashesBucket)
+	refs := make(validatorRefDeltas)
 	for i, rt := range blockRoots {
 		indicesByBucket := createStateIndicesFromStateSlot(ctx, states[i].Slot())
 		if err := updateValueForIndices(ctx, indicesByBucket, rt[:], tx); err != nil {
 			return errors.Wrap(err, "could not update DB indices")
 		}
+		// The validator entries of a state saved again are released once the new ones are referenced.
+		previousValidatorKey := bytesutil.SafeCopyBytes(valIdxBkt.Get(rt[:]))
 
 		// There is a gap when the states that are passed are used outside this
 		// thread. But while storing the state object, we should not store the
@@ -255,6 +258,18 @@ func (s *Store) saveStatesEfficientInternal(ctx context.Context, tx *bolt.Tx, bl
 		default:
 			return errors.New("invalid state type")
 		}
+
+		if err := refs.add(validatorKeys[i], 1); err != nil {
+			return errors.Wrap(err, "could not reference validator entries")
+		}
+		if len(previousValidatorKey) > 0 {
+			if err := refs.add(previousValidatorKey, -1); err != nil {
+				return errors.Wrap(err, "could not release validator entries")
+			}
+		}
+	}
+	if err := s.applyValidatorRefDeltas(tx, refs); err != nil {
+		return errors.Wrap(err, "could not update validator entry reference counts")
 	}
 
 	return s.storeValidatorEntriesSeparately(ctx, tx, validatorsEntries)
@@ -449,48 +464,57 @@ func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
 			return nil
 		}
 
-		slot, err := s.slotByBlockRoot(ctx, tx, blockRoot[:])
+		ok, err := s.isStateValidatorMigrationOver()
 		if err != nil {
 			return err
 		}
-		indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
-		if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
-			return errors.Wrap(err, "could not delete root for DB indices")
+		refs := make(validatorRefDeltas)
+		if err := s.deleteState(ctx, tx, blockRoot, ok, refs); err != nil {
+			return err
 		}
+		return s.applyValidatorRefDeltas(tx, refs)
+	})
+}
 
-		ok, err := s.isStateValidatorMigrationOver()
+// deleteState deletes the state of the block root along with its slot index entry and, once states are saved with
+// their validators stored separately, the validator entry keys of the state. The released references to validator
+// entries are recorded in refs, which the caller applies once all the states of the transaction are deleted.
+func (s *Store) deleteState(ctx context.Context, tx *bolt.Tx, blockRoot [32]byte, validatorsMigrated bool, refs validatorRefDeltas) error {
+	slot, err := s.slotByBlockRoot(ctx, tx, blockRoot[:])
+	if err != nil {
+		return err
+	}
+	indicesByBucket := createStateIndicesFromStateSlot(ctx, slot)
+	if err := deleteValueForIndices(ctx, indicesByBucket, blockRoot[:], tx); err != nil {
+		return errors.Wrap(err, "could not delete root for DB indices")
+	}
+
+	if validatorsMigrated {
+		// remove the validator entry keys for the corresponding state.
+		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
+		compressedValidatorHashes := bytesutil.SafeCopyBytes(idxBkt.Get(blockRoot[:]))
+		err = idxBkt.Delete(blockRoot[:])
 		if err != nil {
 			return err
 		}
-		if ok {
-			// remove the validator entry keys for the corresponding state.
-			idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
-			compressedValidatorHashes := idxBkt.Get(blockRoot[:])
-			err = idxBkt.Delete(blockRoot[:])
-			if err != nil {
-				return err
-			}
 
-			// remove the respective validator entries from the cache.
-			if len(compressedValidatorHashes) == 0 {
-				return errors.Errorf("invalid compressed validator keys length")
-			}
-			validatorHashes, sErr := snappy.Decode(nil, compressedValidatorHashes)
-			if sErr != nil {
-				return errors.Wrap(sErr, "failed to uncompress validator keys")
-			}
-			if len(validatorHashes)%hashLength != 0 {
-				return errors.Errorf("invalid validator keys length: %d", len(validatorHashes))
-			}
-			for i := 0; i < len(validatorHashes); i += hashLength {
-				key := validatorHashes[i : i+hashLength]
-				s.validatorEntryCache.Del(key)
-				validatorEntryCacheDelete.Inc()
-			}
+		// remove the respective validator entries from the cache.
+		validatorHashes, err := decodeValidatorHashes(compressedValidatorHashes)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(validatorHashes); i += hashLength {
+			key := validatorHashes[i : i+hashLength]
+			s.validatorEntryCache.Del(key)
+			validatorEntryCacheDelete.Inc()
+		}
+		// release the references of the state, the entries no other state references are deleted with them.
+		if err := refs.add(compressedValidatorHashes, -1); err != nil {
+			return errors.Wrap(err, "could not release validator entries")
 		}
+	}
 
-		return bkt.Delete(blockRoot[:])
-	})
+	return tx.Bucket(stateBucket).Delete(blockRoot[:])
 }
 
 // DeleteStates by block roots.
@@ -814,8 +838,8 @@ func (s *Store) slotByBlockRoot(ctx context.Context, tx *bolt.Tx, blockRoot []by
 		}
 		return b.Block.Slot, nil
 	}
-	stateSummary := &ethpb.StateSummary{}
-	if err := decode(ctx, enc, stateSummary); err != nil {
+	stateSummary, err := decodeStateSummary(ctx, enc)
+	if err != nil {
 		return 0, err
 	}
 	return stateSummary.Slot, nil
@@ -3,6 +3,8 @@ package kv
 import (
 	"context"
 
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -22,6 +24,13 @@ func (s *Store) SaveStateSummaries(ctx context.Context, summaries []*ethpb.State
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateSummaries")
 	defer span.End()
 
+	// Reject malformed summaries before they reach the cache, otherwise every later flush of the cache would fail.
+	for _, ss := range summaries {
+		if len(ss.Root) != fieldparams.RootLength {
+			return errors.Wrapf(errInvalidStateSummaryRoot, "got %d bytes", len(ss.Root))
+		}
+	}
+
 	// When we reach the state summary cache prune count,
 	// dump the cached state summaries to the DB.
 	if s.stateSummaryCache.len() >= stateSummaryCachePruneCount {
@@ -55,10 +64,27 @@ func (s *Store) StateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.St
 	if len(enc) == 0 {
 		return nil, nil
 	}
-	summary := &ethpb.StateSummary{}
-	if err := decode(ctx, enc, summary); err != nil {
+	return decodeStateSummary(ctx, enc)
+}
+
+// BackfillStateSummary derives the state summary of a block root from the block saved in the DB and saves it.
+// Databases created by older versions may lack summaries of some blocks, which are recovered this way on demand.
+func (s *Store) BackfillStateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.StateSummary, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillStateSummary")
+	defer span.End()
+
+	b, err := s.Block(ctx, blockRoot)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, errors.Wrapf(ErrNotFound, "could not find block %#x to backfill its state summary", blockRoot)
+	}
+	summary := &ethpb.StateSummary{Slot: b.Block().Slot(), Root: bytesutil.SafeCopyBytes(blockRoot[:])}
+	if err := s.SaveStateSummary(ctx, summary); err != nil {
 		return nil, err
 	}
+	stateSummaryBackfillCount.Inc()
 	return summary, nil
 }
 
@@ -87,7 +113,7 @@ func (s *Store) saveCachedStateSummariesDB(ctx context.Context) error {
 	summaries := s.stateSummaryCache.getAll()
 	encs := make([][]byte, len(summaries))
 	for i, s := range summaries {
-		enc, err := encode(ctx, s)
+		enc, err := encodeStateSummary(s)
 		if err != nil {
 			return err
 		}
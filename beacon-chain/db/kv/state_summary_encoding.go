@@ -0,0 +1,56 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// State summaries are stored as a format version byte followed by the big endian slot and the block root.
+// Summaries written by older versions are snappy compressed protobuf messages, whose first byte is the
+// length of the uncompressed message and therefore never equal to the version byte of a fixed-width summary.
+const (
+	stateSummaryFormatV1      byte = 0x01
+	compactStateSummaryLength      = 1 + 8 + fieldparams.RootLength
+)
+
+var errInvalidStateSummaryRoot = errors.New("state summary root must be 32 bytes")
+
+// encodeStateSummary returns the fixed-width encoding of a state summary.
+func encodeStateSummary(summary *ethpb.StateSummary) ([]byte, error) {
+	if summary == nil {
+		return nil, errors.New("cannot encode nil state summary")
+	}
+	if len(summary.Root) != fieldparams.RootLength {
+		return nil, errors.Wrapf(errInvalidStateSummaryRoot, "got %d bytes", len(summary.Root))
+	}
+	enc := make([]byte, compactStateSummaryLength)
+	enc[0] = stateSummaryFormatV1
+	binary.BigEndian.PutUint64(enc[1:9], uint64(summary.Slot))
+	copy(enc[9:], summary.Root)
+	return enc, nil
+}
+
+// decodeStateSummary decodes a state summary in either the fixed-width or the legacy encoding.
+func decodeStateSummary(ctx context.Context, enc []byte) (*ethpb.StateSummary, error) {
+	if isCompactStateSummary(enc) {
+		return &ethpb.StateSummary{
+			Slot: primitives.Slot(binary.BigEndian.Uint64(enc[1:9])),
+			Root: bytesutil.SafeCopyBytes(enc[9:]),
+		}, nil
+	}
+	summary := &ethpb.StateSummary{}
+	if err := decode(ctx, enc, summary); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func isCompactStateSummary(enc []byte) bool {
+	return len(enc) == compactStateSummaryLength && enc[0] == stateSummaryFormatV1
+}
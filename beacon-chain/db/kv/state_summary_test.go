@@ -4,11 +4,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
 
 func TestStateSummary_CanSaveRetrieve(t *testing.T) {
@@ -51,10 +53,10 @@ func TestStateSummary_CacheToDB(t *testing.T) {
 	require.NoError(t, db.SaveStateSummaries(context.Background(), summaries))
 	require.Equal(t, db.stateSummaryCache.len(), stateSummaryCachePruneCount-1)
 
-	require.NoError(t, db.SaveStateSummary(context.Background(), &ethpb.StateSummary{Slot: 1000, Root: []byte{'a', 'b'}}))
+	require.NoError(t, db.SaveStateSummary(context.Background(), &ethpb.StateSummary{Slot: 1000, Root: bytesutil.PadTo([]byte{'a', 'b'}, 32)}))
 	require.Equal(t, db.stateSummaryCache.len(), stateSummaryCachePruneCount)
 
-	require.NoError(t, db.SaveStateSummary(context.Background(), &ethpb.StateSummary{Slot: 1001, Root: []byte{'c', 'd'}}))
+	require.NoError(t, db.SaveStateSummary(context.Background(), &ethpb.StateSummary{Slot: 1001, Root: bytesutil.PadTo([]byte{'c', 'd'}, 32)}))
 	require.Equal(t, db.stateSummaryCache.len(), 1)
 
 	for i := range summaries {
@@ -76,3 +78,53 @@ func TestStateSummary_CanDelete(t *testing.T) {
 	require.NoError(t, db.deleteStateSummary(r1))
 	require.Equal(t, false, db.HasStateSummary(ctx, r1), "State summary should be deleted")
 }
+
+func TestStateSummary_RejectsInvalidRoot(t *testing.T) {
+	_, err := encodeStateSummary(&ethpb.StateSummary{Slot: 1, Root: []byte{'a'}})
+	require.ErrorIs(t, err, errInvalidStateSummaryRoot)
+
+	db := setupDB(t)
+	err = db.SaveStateSummary(context.Background(), &ethpb.StateSummary{Slot: 1, Root: []byte{'a'}})
+	require.ErrorIs(t, err, errInvalidStateSummaryRoot)
+	require.Equal(t, 0, db.stateSummaryCache.len())
+}
+
+func TestStateSummary_CloseAfterInvalidRoot(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir())
+	require.NoError(t, err)
+	r := bytesutil.ToBytes32([]byte{'A'})
+	require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: 1, Root: r[:]}))
+	require.ErrorIs(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: 2, Root: []byte("root")}), errInvalidStateSummaryRoot)
+
+	// The rejected summary must not prevent the cached summaries from being flushed when the database closes.
+	require.NoError(t, db.Close())
+	reopened, err := NewKVStore(ctx, db.databasePath)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, reopened.Close())
+	})
+	require.Equal(t, true, reopened.HasStateSummary(ctx, r))
+}
+
+func TestStore_BackfillStateSummary(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 10
+	wsb, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wsb))
+	root, err := wsb.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	require.Equal(t, false, db.HasStateSummary(ctx, root))
+	summary, err := db.BackfillStateSummary(ctx, root)
+	require.NoError(t, err)
+	require.DeepEqual(t, &ethpb.StateSummary{Slot: 10, Root: root[:]}, summary)
+	require.Equal(t, true, db.HasStateSummary(ctx, root))
+
+	_, err = db.BackfillStateSummary(ctx, [32]byte{'a'})
+	require.ErrorIs(t, err, ErrNotFound)
+}
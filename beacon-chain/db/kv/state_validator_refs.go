@@ -0,0 +1,104 @@
+package kv
+
+import (
+	"sort"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Validator entries are stored once in the state validators bucket, keyed by their hash, and referenced by the
+// validator hashes of every stored state. The reference counts bucket holds the number of stored states referencing
+// each entry, which is updated in the same transaction as the states, so that entries no longer referenced by any
+// state are deleted along with the last state referencing them.
+
+// decodeValidatorHashes decompresses the validator hashes stored for a state.
+func decodeValidatorHashes(compressed []byte) ([]byte, error) {
+	if len(compressed) == 0 {
+		return nil, errors.Errorf("invalid compressed validator keys length")
+	}
+	validatorHashes, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to uncompress validator keys")
+	}
+	if len(validatorHashes)%hashLength != 0 {
+		return nil, errors.Errorf("invalid validator keys length: %d", len(validatorHashes))
+	}
+	return validatorHashes, nil
+}
+
+// validatorRefDeltas accumulates the reference count changes of the validator entries touched by a transaction, so
+// that each entry is read and written at most once however many states of the transaction reference it. Entries
+// referenced both by a released and by an added state, such as the unchanged validators of a state saved again, are
+// not written at all.
+type validatorRefDeltas map[string]int64
+
+// add records a change of the reference counts of the validator entries referenced by a stored state.
+func (d validatorRefDeltas) add(compressedHashes []byte, delta int64) error {
+	validatorHashes, err := decodeValidatorHashes(compressedHashes)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(validatorHashes); i += hashLength {
+		d[string(validatorHashes[i:i+hashLength])] += delta
+	}
+	return nil
+}
+
+// apply writes the accumulated reference count changes, deletes the validator entries no state references anymore
+// and returns their keys. Entries without a reference count are kept, as they may be referenced by states saved
+// before reference counting. Keys are written in order, which keeps bolt from shifting the keys of a page on every
+// insertion.
+func (d validatorRefDeltas) apply(tx *bolt.Tx) ([][]byte, error) {
+	keys := make([]string, 0, len(d))
+	for k, delta := range d {
+		if delta != 0 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	refBkt := tx.Bucket(stateValidatorRefCountsBucket)
+	valBkt := tx.Bucket(stateValidatorsBucket)
+	var deleted [][]byte
+	for _, k := range keys {
+		key := []byte(k)
+		delta := d[k]
+		enc := refBkt.Get(key)
+		if enc == nil && delta < 0 {
+			continue
+		}
+		count := int64(0)
+		if enc != nil {
+			count = int64(bytesutil.BytesToUint64BigEndian(enc)) // lint:ignore uintcast -- a reference count never exceeds the number of stored states.
+		}
+		if count+delta > 0 {
+			if err := refBkt.Put(key, bytesutil.Uint64ToBytesBigEndian(uint64(count+delta))); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := refBkt.Delete(key); err != nil {
+			return nil, err
+		}
+		if err := valBkt.Delete(key); err != nil {
+			return nil, err
+		}
+		deleted = append(deleted, key)
+	}
+	return deleted, nil
+}
+
+// applyValidatorRefDeltas applies the reference count changes of a transaction and evicts the deleted validator
+// entries from the cache.
+func (s *Store) applyValidatorRefDeltas(tx *bolt.Tx, d validatorRefDeltas) error {
+	deleted, err := d.apply(tx)
+	if err != nil {
+		return err
+	}
+	for _, key := range deleted {
+		s.validatorEntryCache.Del(key)
+	}
+	return nil
+}
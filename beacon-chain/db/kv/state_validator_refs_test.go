@@ -0,0 +1,203 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	bolt "go.etcd.io/bbolt"
+)
+
+// validatorRefs returns the reference count of each validator entry, and whether the entry is stored.
+func validatorRefs(t testing.TB, db *Store, vals []*ethpb.Validator) ([]uint64, []bool) {
+	counts := make([]uint64, len(vals))
+	stored := make([]bool, len(vals))
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		for i, val := range vals {
+			hash, err := val.HashTreeRoot()
+			require.NoError(t, err)
+			if enc := tx.Bucket(stateValidatorRefCountsBucket).Get(hash[:]); enc != nil {
+				counts[i] = bytesutil.BytesToUint64BigEndian(enc)
+			}
+			stored[i] = tx.Bucket(stateValidatorsBucket).Get(hash[:]) != nil
+		}
+		return nil
+	}))
+	return counts, stored
+}
+
+func TestState_ValidatorEntriesRefCounts(t *testing.T) {
+	db := setupDB(t)
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableHistoricalSpaceRepresentation: true,
+	})
+	defer resetCfg()
+	ctx := context.Background()
+
+	shared := validators(3)
+	onlyFirst := validators(1)
+	onlySecond := validators(1)
+	saveState := func(r [32]byte, vals []*ethpb.Validator) {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetValidators(vals))
+		require.NoError(t, db.SaveState(ctx, st, r))
+	}
+	r1, r2 := [32]byte{'A'}, [32]byte{'B'}
+	saveState(r1, append(append([]*ethpb.Validator{}, shared...), onlyFirst...))
+	saveState(r2, append(append([]*ethpb.Validator{}, shared...), onlySecond...))
+
+	counts, stored := validatorRefs(t, db, shared)
+	assert.DeepEqual(t, []uint64{2, 2, 2}, counts)
+	assert.DeepEqual(t, []bool{true, true, true}, stored)
+
+	t.Run("delete state", func(t *testing.T) {
+		require.NoError(t, db.DeleteState(ctx, r1))
+		counts, stored := validatorRefs(t, db, shared)
+		assert.DeepEqual(t, []uint64{1, 1, 1}, counts)
+		assert.DeepEqual(t, []bool{true, true, true}, stored)
+		// The entry only referenced by the deleted state is collected.
+		counts, stored = validatorRefs(t, db, onlyFirst)
+		assert.DeepEqual(t, []uint64{0}, counts)
+		assert.DeepEqual(t, []bool{false}, stored)
+
+		loaded, err := db.State(ctx, r2)
+		require.NoError(t, err)
+		assert.Equal(t, 4, loaded.NumValidators())
+	})
+	t.Run("save state again", func(t *testing.T) {
+		replacement := validators(1)
+		saveState(r2, append(append([]*ethpb.Validator{}, shared...), replacement...))
+		counts, _ := validatorRefs(t, db, shared)
+		assert.DeepEqual(t, []uint64{1, 1, 1}, counts)
+		_, stored := validatorRefs(t, db, onlySecond)
+		assert.DeepEqual(t, []bool{false}, stored)
+		counts, stored = validatorRefs(t, db, replacement)
+		assert.DeepEqual(t, []uint64{1}, counts)
+		assert.DeepEqual(t, []bool{true}, stored)
+	})
+	t.Run("delete last state", func(t *testing.T) {
+		require.NoError(t, db.DeleteState(ctx, r2))
+		_, stored := validatorRefs(t, db, shared)
+		assert.DeepEqual(t, []bool{false, false, false}, stored)
+		require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+			assert.Equal(t, 0, tx.Bucket(stateValidatorRefCountsBucket).Stats().KeyN)
+			return nil
+		}))
+	})
+}
+
+func TestState_ValidatorEntriesRefCounts_SameTransaction(t *testing.T) {
+	db := setupDB(t)
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableHistoricalSpaceRepresentation: true,
+	})
+	defer resetCfg()
+	ctx := context.Background()
+
+	vals := validators(2)
+	states := make([]state.ReadOnlyBeaconState, 2)
+	for i := range states {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetValidators(vals))
+		states[i] = st
+	}
+	// Both references of the entries are counted, although each entry is written once.
+	require.NoError(t, db.SaveStatesEfficient(ctx, states, [][32]byte{{'A'}, {'B'}}))
+	counts, stored := validatorRefs(t, db, vals)
+	assert.DeepEqual(t, []uint64{2, 2}, counts)
+	assert.DeepEqual(t, []bool{true, true}, stored)
+
+	// Saving a state again with the same validators leaves the counts unchanged.
+	require.NoError(t, db.SaveState(ctx, states[0], [32]byte{'A'}))
+	counts, _ = validatorRefs(t, db, vals)
+	assert.DeepEqual(t, []uint64{2, 2}, counts)
+}
+
+func TestMigrateStateValidatorRefCounts(t *testing.T) {
+	db := setupDB(t)
+	resetCfg := features.InitWithReset(&features.Flags{
+		EnableHistoricalSpaceRepresentation: true,
+	})
+	defer resetCfg()
+	ctx := context.Background()
+
+	vals := validators(4)
+	for i := 0; i < 3; i++ {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		// The first state references all entries, the others all but the first entries.
+		require.NoError(t, st.SetValidators(vals[i:]))
+		require.NoError(t, db.SaveState(ctx, st, [32]byte{byte(i + 1)}))
+	}
+	orphan := validators(1)
+	// Drop the reference counts, as in a database storing validator entries before reference counting, along
+	// with an entry left behind by a deleted state.
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, tx.DeleteBucket(stateValidatorRefCountsBucket))
+		_, err := tx.CreateBucket(stateValidatorRefCountsBucket)
+		require.NoError(t, err)
+		hash, err := orphan[0].HashTreeRoot()
+		require.NoError(t, err)
+		enc, err := encode(ctx, orphan[0])
+		require.NoError(t, err)
+		return tx.Bucket(stateValidatorsBucket).Put(hash[:], enc)
+	}))
+
+	require.NoError(t, migrateStateValidatorRefCounts(ctx, db.db))
+	counts, stored := validatorRefs(t, db, vals)
+	assert.DeepEqual(t, []uint64{1, 2, 3, 3}, counts)
+	assert.DeepEqual(t, []bool{true, true, true, true}, stored)
+	_, stored = validatorRefs(t, db, orphan)
+	assert.DeepEqual(t, []bool{false}, stored)
+
+	// The migration does not run again.
+	require.NoError(t, db.db.View(func(tx *bolt.Tx) error {
+		mb := tx.Bucket(migrationsBucket)
+		assert.DeepEqual(t, migrationCompleted, mb.Get(migrationStateValidatorRefCountsKey))
+		assert.Equal(t, 0, len(mb.Get(stateValidatorRefCountsProgressKey)))
+		return nil
+	}))
+	require.NoError(t, migrateStateValidatorRefCounts(ctx, db.db))
+	counts, _ = validatorRefs(t, db, vals)
+	assert.DeepEqual(t, []uint64{1, 2, 3, 3}, counts)
+}
+
+// BenchmarkState_SaveStatesSharingValidators saves states whose validator registries barely change between each
+// other, like the ones of adjacent archive points, and reports the database size per saved state.
+func BenchmarkState_SaveStatesSharingValidators(b *testing.B) {
+	for _, historical := range []bool{false, true} {
+		b.Run(fmt.Sprintf("historical space representation=%t", historical), func(b *testing.B) {
+			resetCfg := features.InitWithReset(&features.Flags{
+				EnableHistoricalSpaceRepresentation: historical,
+			})
+			defer resetCfg()
+			db := setupDB(b)
+			vals := validators(100000)
+			st, err := util.NewBeaconState()
+			require.NoError(b, err)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// One percent of the validators change between states.
+				copy(vals[i%100*1000:], validators(1000))
+				require.NoError(b, st.SetValidators(vals))
+				require.NoError(b, db.SaveState(context.Background(), st, bytesutil.ToBytes32(bytesutil.Bytes8(uint64(i+1)))))
+			}
+			b.StopTimer()
+			info, err := os.Stat(StoreDatafilePath(db.DatabasePath()))
+			require.NoError(b, err)
+			b.ReportMetric(float64(info.Size())/float64(b.N), "db-bytes/state")
+		})
+	}
+}
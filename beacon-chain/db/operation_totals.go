@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/urfave/cli/v2"
+)
+
+// operationTotalsBatchSize is the number of blocks added to the operation totals index in a single transaction.
+const operationTotalsBatchSize = 256
+
+// PayloadReconstructor reconstructs the execution payloads of blocks saved in the blinded format.
+type PayloadReconstructor interface {
+	ReconstructFullBellatrixBlockBatch(ctx context.Context, blindedBlocks []interfaces.ReadOnlySignedBeaconBlock) ([]interfaces.SignedBeaconBlock, error)
+}
+
+// IndexOperationTotals adds the finalized blocks missing from the operation totals index to it, in chain order,
+// and returns the slot of the last indexed block. Withdrawals are part of the execution payload, so blocks saved in
+// the blinded format are reconstructed with the given reconstructor, which may be nil for databases saving full
+// payloads. The progress function, if any, is called with the slot of the last indexed block after each batch.
+func IndexOperationTotals(ctx context.Context, d iface.NoHeadAccessDatabase, r PayloadReconstructor, progress func(primitives.Slot)) (primitives.Slot, error) {
+	for {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		blks, err := d.NextOperationTotalsBlocks(ctx, operationTotalsBatchSize)
+		if err != nil {
+			return 0, errors.Wrap(err, "could not get blocks to index")
+		}
+		if len(blks) == 0 {
+			break
+		}
+		if blks, err = unblindOperationTotalsBlocks(ctx, r, blks); err != nil {
+			return 0, err
+		}
+		if err := d.IndexOperationTotals(ctx, blks); err != nil {
+			return 0, errors.Wrap(err, "could not index operation totals")
+		}
+		if progress != nil {
+			progress(blks[len(blks)-1].Block().Slot())
+		}
+	}
+	slot, err := d.OperationTotalsIndexedSlot(ctx)
+	if errors.Is(err, ErrNotFound) {
+		return 0, nil
+	}
+	return slot, err
+}
+
+// BackfillOperationTotals indexes the deposits and withdrawals of the finalized blocks of a beacon chain database
+// which are not part of its operation totals index yet. The beacon node must not be running. Databases saving
+// blinded blocks need an execution client to reconstruct withdrawals, so they can only be indexed by the beacon
+// node itself with the operation totals index enabled.
+func BackfillOperationTotals(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	d, err := kv.NewKVStore(cliCtx.Context, path.Join(dataDir, kv.BeaconNodeDbDirName))
+	if err != nil {
+		return errors.Wrapf(err, "could not open database in %s", dataDir)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	slot, err := IndexOperationTotals(cliCtx.Context, d, nil, func(slot primitives.Slot) {
+		log.WithField("slot", slot).Info("Indexed operation totals")
+	})
+	if errors.Is(err, kv.ErrBlindedOperationTotalsBlock) {
+		return errors.Wrap(err, "database saves blinded blocks, run the beacon node with the operation totals index enabled instead")
+	}
+	if err != nil {
+		return err
+	}
+	log.WithField("slot", slot).Info("Operation totals index is up to date")
+	return nil
+}
+
+func unblindOperationTotalsBlocks(ctx context.Context, r PayloadReconstructor, blks []interfaces.ReadOnlySignedBeaconBlock) ([]interfaces.ReadOnlySignedBeaconBlock, error) {
+	var (
+		blinded   []interfaces.ReadOnlySignedBeaconBlock
+		positions []int
+	)
+	for i, b := range blks {
+		if b.Version() >= version.Capella && b.IsBlinded() {
+			blinded = append(blinded, b)
+			positions = append(positions, i)
+		}
+	}
+	if len(blinded) == 0 {
+		return blks, nil
+	}
+	if r == nil {
+		return nil, kv.ErrBlindedOperationTotalsBlock
+	}
+	full, err := r.ReconstructFullBellatrixBlockBatch(ctx, blinded)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reconstruct execution payloads")
+	}
+	if len(full) != len(blinded) {
+		return nil, errors.Errorf("reconstructed %d blocks, wanted %d", len(full), len(blinded))
+	}
+	for i, pos := range positions {
+		blks[pos] = full[i]
+	}
+	return blks, nil
+}
@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type fullBlocksReconstructor struct {
+	full []interfaces.SignedBeaconBlock
+}
+
+func (r *fullBlocksReconstructor) ReconstructFullBellatrixBlockBatch(_ context.Context, blinded []interfaces.ReadOnlySignedBeaconBlock) ([]interfaces.SignedBeaconBlock, error) {
+	return r.full[:len(blinded)], nil
+}
+
+func TestUnblindOperationTotalsBlocks(t *testing.T) {
+	ctx := context.Background()
+	phase0, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	full, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+	require.NoError(t, err)
+	blinded, err := full.ToBlinded()
+	require.NoError(t, err)
+
+	blks := []interfaces.ReadOnlySignedBeaconBlock{phase0, blinded}
+	_, err = unblindOperationTotalsBlocks(ctx, nil, blks)
+	require.ErrorIs(t, err, kv.ErrBlindedOperationTotalsBlock)
+
+	blks, err = unblindOperationTotalsBlocks(ctx, &fullBlocksReconstructor{full: []interfaces.SignedBeaconBlock{full}}, blks)
+	require.NoError(t, err)
+	require.Equal(t, phase0, blks[0])
+	require.Equal(t, false, blks[1].IsBlinded())
+
+	// Full blocks are returned as is without a reconstructor.
+	blks, err = unblindOperationTotalsBlocks(ctx, nil, []interfaces.ReadOnlySignedBeaconBlock{phase0, full})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(blks))
+}
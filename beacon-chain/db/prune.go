@@ -0,0 +1,83 @@
+package db
+
+import (
+	"context"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Prune deletes the blocks and states of a beacon chain database before the slot given with --before-slot, or before
+// the finalized block with --keep-finalized-only. The beacon node must not be running.
+func Prune(cliCtx *cli.Context) error {
+	beforeSet := cliCtx.IsSet(cmd.PruneBeforeSlotFlag.Name)
+	finalizedOnly := cliCtx.Bool(cmd.PruneKeepFinalizedOnlyFlag.Name)
+	if beforeSet == finalizedOnly {
+		return errors.Errorf("exactly one of --%s and --%s must be set", cmd.PruneBeforeSlotFlag.Name, cmd.PruneKeepFinalizedOnlyFlag.Name)
+	}
+	dryRun := cliCtx.Bool(cmd.PruneDryRunFlag.Name)
+
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	d, err := kv.NewKVStore(cliCtx.Context, path.Join(dataDir, kv.BeaconNodeDbDirName))
+	if err != nil {
+		return errors.Wrapf(err, "could not open database in %s", dataDir)
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	before := primitives.Slot(cliCtx.Uint64(cmd.PruneBeforeSlotFlag.Name))
+	if finalizedOnly {
+		if before, err = finalizedSlot(cliCtx.Context, d); err != nil {
+			return err
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"beforeSlot": before,
+		"dryRun":     dryRun,
+	}).Info("Pruning database")
+	stats, err := d.PruneBefore(cliCtx.Context, before, dryRun)
+	if err != nil {
+		return err
+	}
+
+	fields := logrus.Fields{
+		"blocks":         stats.Blocks,
+		"states":         stats.States,
+		"stateSummaries": stats.StateSummaries,
+		"bytes":          stats.Bytes,
+	}
+	if dryRun {
+		log.WithFields(fields).Info("Dry run, the database was not modified")
+		return nil
+	}
+	fields["freeBytesBefore"] = stats.FreeBytesBefore
+	fields["freeBytesAfter"] = stats.FreeBytesAfter
+	fields["reclaimedBytes"] = stats.FreeBytesAfter - stats.FreeBytesBefore
+	log.WithFields(fields).Info("Pruned database, reclaimed space is reused by the beacon node but the database file does not shrink")
+	return nil
+}
+
+// finalizedSlot returns the slot of the finalized block of the database.
+func finalizedSlot(ctx context.Context, d *kv.Store) (primitives.Slot, error) {
+	finalized, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	blk, err := d.Block(ctx, bytesutil.ToBytes32(finalized.Root))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get finalized block")
+	}
+	if blk == nil || blk.IsNil() {
+		return 0, errors.Errorf("finalized block %#x not found", finalized.Root)
+	}
+	return blk.Block().Slot(), nil
+}
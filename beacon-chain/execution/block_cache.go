@@ -153,6 +153,33 @@ func (c *headerCache) AddHeader(hdr *types.HeaderInfo) error {
 	return nil
 }
 
+// RemoveHeadersAfter removes all header info objects with a header number greater than the given height
+// from the cache. This is used to drop the headers of blocks which are no longer canonical after a reorg.
+func (c *headerCache) RemoveHeadersAfter(height *big.Int) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, obj := range c.heightCache.List() {
+		hInfo, ok := obj.(*types.HeaderInfo)
+		if !ok {
+			return ErrNotAHeaderInfo
+		}
+		if hInfo.Number.Cmp(height) <= 0 {
+			continue
+		}
+		if err := c.heightCache.Delete(hInfo); err != nil {
+			return err
+		}
+		if err := c.hashCache.Delete(hInfo); err != nil {
+			return err
+		}
+	}
+
+	headerCacheSize.Set(float64(len(c.hashCache.ListKeys())))
+
+	return nil
+}
+
 // trim the FIFO queue to the maxSize.
 func trim(queue *cache.FIFO, maxSize uint64) {
 	for s := uint64(len(queue.ListKeys())); s > maxSize; s-- {
@@ -100,3 +100,36 @@ func TestBlockCache_maxSize(t *testing.T) {
 	assert.Equal(t, int(maxCacheSize), len(cache.hashCache.ListKeys()))
 	assert.Equal(t, int(maxCacheSize), len(cache.heightCache.ListKeys()))
 }
+
+func TestBlockCache_RemoveHeadersAfter(t *testing.T) {
+	cache := newHeaderCache()
+
+	for i := int64(0); i < 10; i++ {
+		header := &types.HeaderInfo{
+			Number: big.NewInt(i),
+			Hash:   common.Hash(bytesutil.ToBytes32(bytesutil.Bytes32(uint64(i)))),
+		}
+		require.NoError(t, cache.AddHeader(header))
+	}
+	require.NoError(t, cache.RemoveHeadersAfter(big.NewInt(6)))
+
+	assert.Equal(t, 7, len(cache.hashCache.ListKeys()))
+	assert.Equal(t, 7, len(cache.heightCache.ListKeys()))
+	exists, _, err := cache.HeaderInfoByHeight(big.NewInt(6))
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	exists, _, err = cache.HeaderInfoByHeight(big.NewInt(7))
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+	exists, _, err = cache.HeaderInfoByHash(common.Hash(bytesutil.ToBytes32(bytesutil.Bytes32(7))))
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	// A header of the new canonical chain can be added at a removed height.
+	replacement := &types.HeaderInfo{Number: big.NewInt(7), Hash: common.Hash{'a'}}
+	require.NoError(t, cache.AddHeader(replacement))
+	exists, fetchedInfo, err := cache.HeaderInfoByHeight(big.NewInt(7))
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, replacement.Hash, fetchedInfo.Hash)
+}
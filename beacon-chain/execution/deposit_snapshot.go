@@ -0,0 +1,160 @@
+package execution
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/sirupsen/logrus"
+)
+
+// applyDepositSnapshot bootstraps the deposit tree from the configured EIP-4881 deposit snapshot when the node has
+// no deposit data yet, so that only the deposit contract logs following the snapshot have to be processed. The
+// returned execution chain data references the snapshot and is persisted.
+func (s *Service) applyDepositSnapshot(ctx context.Context, eth1Data *ethpb.ETH1ChainData) (*ethpb.ETH1ChainData, error) {
+	snapshot := s.cfg.depositSnapshot
+	if eth1Data == nil {
+		return nil, errors.New("no genesis state to bootstrap deposits for")
+	}
+	if len(eth1Data.DepositContainers) > 0 || (eth1Data.DepositSnapshot != nil && eth1Data.DepositSnapshot.DepositCount > 0) {
+		log.Info("Deposits found in the database, ignoring deposit snapshot")
+		return eth1Data, nil
+	}
+	if err := validateDepositSnapshot(snapshot, s.cfg.finalizedStateAtStartup); err != nil {
+		return nil, err
+	}
+
+	latest := &ethpb.LatestETH1Data{}
+	if eth1Data.CurrentEth1Data != nil {
+		latest.BlockHeight = eth1Data.CurrentEth1Data.BlockHeight
+		latest.BlockTime = eth1Data.CurrentEth1Data.BlockTime
+		latest.BlockHash = eth1Data.CurrentEth1Data.BlockHash
+	}
+	// Deposit logs are only requested after the execution block of the snapshot.
+	latest.LastRequestedBlock = snapshot.ExecutionDepth
+	bootstrapped := &ethpb.ETH1ChainData{
+		CurrentEth1Data:   latest,
+		ChainstartData:    eth1Data.ChainstartData,
+		BeaconState:       eth1Data.BeaconState,
+		DepositContainers: eth1Data.DepositContainers,
+		DepositSnapshot:   snapshot,
+	}
+	if err := s.cfg.beaconDB.SaveExecutionChainData(ctx, bootstrapped); err != nil {
+		return nil, errors.Wrap(err, "could not save execution chain data")
+	}
+	if snapshot.ExecutionDepth == 0 {
+		// Snapshots of the deposit tree finalized by the beacon chain do not record the execution block height.
+		s.depositSnapshotBlockHash = common.BytesToHash(snapshot.ExecutionHash)
+	}
+	log.WithFields(logrus.Fields{
+		"depositCount":   snapshot.DepositCount,
+		"depositRoot":    fmt.Sprintf("%#x", snapshot.DepositRoot),
+		"executionBlock": fmt.Sprintf("%#x", snapshot.ExecutionHash),
+	}).Info("Initialized deposit tree from deposit snapshot")
+	return bootstrapped, nil
+}
+
+// validateDepositSnapshot checks that a deposit snapshot can be the deposit tree of the finalized state. A snapshot
+// served by a Prysm node covers the deposits processed by its finalized state, which can be fewer than the deposit
+// count of the eth1 data of that state. The root of such a snapshot can only be checked against the eth1 data once
+// the following deposits were processed from the deposit contract logs, see verifyDepositSnapshotRoot. Deposits
+// covered by the snapshot cannot be included in proposed blocks, as the snapshot only holds the hashes needed to
+// compute the roots of the deposit tree, so the finalized state must have processed all of them.
+func validateDepositSnapshot(snapshot *ethpb.DepositSnapshot, finalized state.ReadOnlyBeaconState) error {
+	if snapshot == nil || snapshot.DepositCount == 0 {
+		return errors.New("empty deposit snapshot")
+	}
+	if _, err := depositsnapshot.DepositTreeFromSnapshotProto(snapshot); err != nil {
+		return errors.Wrap(err, "invalid deposit snapshot")
+	}
+	if finalized == nil || finalized.IsNil() {
+		return errors.New("no finalized state to validate the deposit snapshot against")
+	}
+	eth1Data := finalized.Eth1Data()
+	if snapshot.DepositCount > eth1Data.DepositCount {
+		return errors.Errorf("deposit snapshot count %d exceeds the deposit count %d of the finalized eth1 data",
+			snapshot.DepositCount, eth1Data.DepositCount)
+	}
+	if snapshot.DepositCount == eth1Data.DepositCount && !bytes.Equal(snapshot.DepositRoot, eth1Data.DepositRoot) {
+		return errors.Errorf("deposit snapshot root %#x does not match the deposit root %#x of the finalized eth1 data",
+			snapshot.DepositRoot, eth1Data.DepositRoot)
+	}
+	depositLimit := eth1Data.DepositCount
+	if finalized.Version() >= version.Electra {
+		requestsStartIndex, err := finalized.DepositRequestsStartIndex()
+		if err != nil {
+			return errors.Wrap(err, "could not get deposit requests start index")
+		}
+		depositLimit = min(depositLimit, requestsStartIndex)
+	}
+	if covered := min(snapshot.DepositCount, depositLimit); finalized.Eth1DepositIndex() < covered {
+		return errors.Errorf("finalized state has only processed %d of the %d deposits of the snapshot",
+			finalized.Eth1DepositIndex(), covered)
+	}
+	return nil
+}
+
+// verifyDepositSnapshotRoot checks the root of the deposit tree of a node bootstrapped from a deposit snapshot
+// against the finalized eth1 data, once the tree holds the deposit count of that eth1 data. A mismatch means that
+// the snapshot is not the deposit tree of the finalized chain. Deposit logs are then no longer processed, and the
+// snapshot is discarded from the database so that the deposits are synced from the deposit contract logs after a
+// restart.
+func (s *Service) verifyDepositSnapshotRoot(ctx context.Context) error {
+	want := s.depositSnapshotCheck
+	if want == nil || uint64(s.depositTrie.NumOfItems()) != want.DepositCount {
+		return nil
+	}
+	s.depositSnapshotCheck = nil
+	root, err := s.depositTrie.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not compute deposit tree root")
+	}
+	if bytes.Equal(root[:], want.DepositRoot) {
+		log.WithField("depositCount", want.DepositCount).Info("Deposit tree bootstrapped from deposit snapshot matches the finalized eth1 data")
+		return nil
+	}
+	s.depositSnapshotMismatch = true
+	if err := s.discardDepositSnapshot(ctx); err != nil {
+		log.WithError(err).Error("Could not discard deposit snapshot")
+	}
+	return errors.Errorf("deposit tree root %#x does not match the deposit root %#x of the finalized eth1 data: "+
+		"the deposit snapshot is invalid, restart the node without a deposit snapshot", root, want.DepositRoot)
+}
+
+// discardDepositSnapshot replaces the execution chain data of a node bootstrapped from an invalid deposit snapshot
+// with execution chain data without any deposit.
+func (s *Service) discardDepositSnapshot(ctx context.Context) error {
+	pbState, err := native.ProtobufBeaconStatePhase0(s.preGenesisState.ToProtoUnsafe())
+	if err != nil {
+		return err
+	}
+	return s.cfg.beaconDB.SaveExecutionChainData(ctx, &ethpb.ETH1ChainData{
+		CurrentEth1Data: &ethpb.LatestETH1Data{},
+		ChainstartData:  s.chainStartData,
+		BeaconState:     pbState,
+	})
+}
+
+// resolveDepositSnapshotBlock retrieves the height of the execution block of the deposit snapshot the node was
+// bootstrapped from, when the snapshot does not record it, so that deposit logs are requested from there on.
+func (s *Service) resolveDepositSnapshotBlock(ctx context.Context) error {
+	if s.depositSnapshotBlockHash == (common.Hash{}) {
+		return nil
+	}
+	header, err := s.HeaderByHash(ctx, s.depositSnapshotBlockHash)
+	if err != nil {
+		return errors.Wrapf(err, "could not get execution block %#x of the deposit snapshot", s.depositSnapshotBlockHash)
+	}
+	s.latestEth1DataLock.Lock()
+	s.latestEth1Data.LastRequestedBlock = max(s.latestEth1Data.LastRequestedBlock, header.Number.Uint64())
+	s.latestEth1DataLock.Unlock()
+	s.depositSnapshotBlockHash = common.Hash{}
+	return nil
+}
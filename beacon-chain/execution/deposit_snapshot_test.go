@@ -0,0 +1,204 @@
+package execution
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	contracts "github.com/prysmaticlabs/prysm/v5/contracts/deposit"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestValidateDepositSnapshot(t *testing.T) {
+	tree := depositsnapshot.NewDepositTree()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, tree.Insert([]byte{byte(i + 1), 31: 0}, i))
+	}
+	require.NoError(t, tree.Finalize(3, [32]byte{'a'}, 0))
+	snapshot, err := tree.ToProto()
+	require.NoError(t, err)
+
+	finalizedState := func(t *testing.T, depositCount, depositIndex uint64, root []byte) state.BeaconState {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetEth1Data(&ethpb.Eth1Data{DepositCount: depositCount, DepositRoot: root, BlockHash: make([]byte, 32)}))
+		require.NoError(t, st.SetEth1DepositIndex(depositIndex))
+		return st
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, validateDepositSnapshot(snapshot, finalizedState(t, 4, 4, snapshot.DepositRoot)))
+	})
+	t.Run("empty snapshot", func(t *testing.T) {
+		err := validateDepositSnapshot(&ethpb.DepositSnapshot{}, finalizedState(t, 4, 4, snapshot.DepositRoot))
+		require.ErrorContains(t, "empty deposit snapshot", err)
+	})
+	t.Run("no finalized state", func(t *testing.T) {
+		require.ErrorContains(t, "no finalized state", validateDepositSnapshot(snapshot, nil))
+	})
+	t.Run("pending deposits", func(t *testing.T) {
+		require.NoError(t, validateDepositSnapshot(snapshot, finalizedState(t, 6, 4, make([]byte, 32))))
+	})
+	t.Run("count exceeds eth1 data", func(t *testing.T) {
+		err := validateDepositSnapshot(snapshot, finalizedState(t, 3, 3, snapshot.DepositRoot))
+		require.ErrorContains(t, "deposit snapshot count 4 exceeds the deposit count 3", err)
+	})
+	t.Run("root mismatch", func(t *testing.T) {
+		err := validateDepositSnapshot(snapshot, finalizedState(t, 4, 4, make([]byte, 32)))
+		require.ErrorContains(t, "does not match the deposit root", err)
+	})
+	t.Run("unprocessed deposits", func(t *testing.T) {
+		err := validateDepositSnapshot(snapshot, finalizedState(t, 6, 3, make([]byte, 32)))
+		require.ErrorContains(t, "finalized state has only processed 3 of the 4 deposits of the snapshot", err)
+	})
+	t.Run("deposit requests started", func(t *testing.T) {
+		st, err := util.NewBeaconStateElectra()
+		require.NoError(t, err)
+		require.NoError(t, st.SetEth1Data(&ethpb.Eth1Data{DepositCount: 4, DepositRoot: snapshot.DepositRoot, BlockHash: make([]byte, 32)}))
+		require.NoError(t, st.SetEth1DepositIndex(2))
+		require.NoError(t, st.SetDepositRequestsStartIndex(2))
+		require.NoError(t, validateDepositSnapshot(snapshot, st))
+	})
+}
+
+// depositLog returns the deposit contract log of the given deposit.
+func depositLog(t *testing.T, d *ethpb.Deposit, index uint64, blockNumber uint64) *gethtypes.Log {
+	contractAbi, err := abi.JSON(strings.NewReader(contracts.DepositContractABI))
+	require.NoError(t, err)
+	amount := make([]byte, 8)
+	binary.LittleEndian.PutUint64(amount, d.Data.Amount)
+	idx := make([]byte, 8)
+	binary.LittleEndian.PutUint64(idx, index)
+	data, err := contractAbi.Events["DepositEvent"].Inputs.Pack(d.Data.PublicKey, d.Data.WithdrawalCredentials, amount, d.Data.Signature, idx)
+	require.NoError(t, err)
+	return &gethtypes.Log{Data: data, BlockNumber: blockNumber}
+}
+
+// TestDepositSnapshot_Handoff bootstraps a node from the deposit snapshot served by another node, whose finalized
+// state has not processed all the deposits of its eth1 data yet, and checks that the deposits following the snapshot
+// can be included in proposed blocks.
+func TestDepositSnapshot_Handoff(t *testing.T) {
+	ctx := context.Background()
+	const depositCount, processed = 10, 6
+	deposits, _, err := util.DeterministicDepositsAndKeys(depositCount)
+	require.NoError(t, err)
+	fullTree := depositsnapshot.NewDepositTree()
+	roots := make([][32]byte, depositCount)
+	for i, d := range deposits {
+		h, err := d.Data.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, fullTree.Insert(h[:], i))
+		roots[i], err = fullTree.HashTreeRoot()
+		require.NoError(t, err)
+	}
+
+	// The serving node finalized its deposit tree up to the eth1 deposit index of its finalized state, like
+	// blockchain.insertFinalizedDeposits does, and serves it the way the deposit snapshot endpoint does.
+	serving, err := depositsnapshot.New()
+	require.NoError(t, err)
+	for i, d := range deposits {
+		require.NoError(t, serving.InsertDeposit(ctx, d, uint64(i+1), int64(i), roots[i]))
+	}
+	require.NoError(t, serving.InsertFinalizedDeposits(ctx, processed-1, [32]byte{'a'}, 0))
+	fd, err := serving.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	tree, ok := fd.Deposits().(*depositsnapshot.DepositTree)
+	require.Equal(t, true, ok)
+	served, err := tree.ToProto()
+	require.NoError(t, err)
+	enc, err := json.Marshal(&structs.GetDepositSnapshotResponse{Data: structs.DepositSnapshotFromConsensus(served)})
+	require.NoError(t, err)
+	resp := &structs.GetDepositSnapshotResponse{}
+	require.NoError(t, json.Unmarshal(enc, resp))
+	snapshot, err := resp.Data.ToConsensus()
+	require.NoError(t, err)
+	require.Equal(t, uint64(processed), snapshot.DepositCount)
+
+	genState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	finalized := genState.Copy()
+	require.NoError(t, finalized.SetEth1Data(&ethpb.Eth1Data{DepositCount: depositCount, DepositRoot: roots[depositCount-1][:], BlockHash: make([]byte, 32)}))
+	require.NoError(t, finalized.SetEth1DepositIndex(processed))
+
+	newReceivingNode := func(t *testing.T) (*Service, *depositsnapshot.Cache) {
+		beaconDB := dbutil.SetupDB(t)
+		require.NoError(t, beaconDB.SaveGenesisData(ctx, genState))
+		depositCache, err := depositsnapshot.New()
+		require.NoError(t, err)
+		srv, endpoint, err := mockExecution.SetupRPCServer()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			srv.Stop()
+		})
+		s, err := NewService(ctx,
+			WithHttpEndpoint(endpoint),
+			WithDatabase(beaconDB),
+			WithDepositCache(depositCache),
+			WithFinalizedStateAtStartup(finalized),
+			WithDepositSnapshot(snapshot),
+		)
+		require.NoError(t, err)
+		require.Equal(t, processed, s.depositTrie.NumOfItems())
+		return s, depositCache
+	}
+
+	t.Run("deposits included in proposed blocks", func(t *testing.T) {
+		s, depositCache := newReceivingNode(t)
+		for i := processed; i < depositCount; i++ {
+			require.NoError(t, s.ProcessDepositLog(ctx, depositLog(t, deposits[i], uint64(i), uint64(i+1))))
+		}
+		require.Equal(t, false, s.depositSnapshotMismatch)
+		require.Equal(t, true, s.depositSnapshotCheck == nil)
+
+		// Build the deposit trie and the proofs the way the proposer does.
+		fd, err := depositCache.FinalizedDeposits(ctx)
+		require.NoError(t, err)
+		trie := fd.Deposits()
+		pending := depositCache.NonFinalizedDeposits(ctx, fd.MerkleTrieIndex(), nil)
+		require.Equal(t, depositCount-processed, len(pending))
+		for i, d := range pending {
+			h, err := d.Data.HashTreeRoot()
+			require.NoError(t, err)
+			require.NoError(t, trie.Insert(h[:], processed+i))
+		}
+		root, err := trie.HashTreeRoot()
+		require.NoError(t, err)
+		require.DeepEqual(t, roots[depositCount-1], root)
+		// Blocks include the pending deposits in order, each one proven at the eth1 deposit index of the state.
+		st := finalized.Copy()
+		for i, d := range pending {
+			proof, err := trie.MerkleProof(processed + i)
+			require.NoError(t, err)
+			require.NoError(t, st.SetEth1DepositIndex(uint64(processed+i)))
+			require.NoError(t, blocks.VerifyDeposit(st, &ethpb.Deposit{Proof: proof, Data: d.Data}))
+		}
+	})
+	t.Run("snapshot not matching the finalized eth1 data", func(t *testing.T) {
+		s, _ := newReceivingNode(t)
+		for i := processed; i < depositCount-1; i++ {
+			require.NoError(t, s.ProcessDepositLog(ctx, depositLog(t, deposits[i], uint64(i), uint64(i+1))))
+		}
+		err := s.ProcessDepositLog(ctx, depositLog(t, deposits[0], depositCount-1, depositCount))
+		require.ErrorContains(t, "does not match the deposit root", err)
+		require.Equal(t, true, s.depositSnapshotMismatch)
+		err = s.ProcessDepositLog(ctx, depositLog(t, deposits[depositCount-1], depositCount, depositCount+1))
+		require.ErrorContains(t, "deposit snapshot does not match the finalized eth1 data", err)
+
+		eth1Data, err := s.cfg.beaconDB.ExecutionChainData(ctx)
+		require.NoError(t, err)
+		require.Equal(t, true, eth1Data.DepositSnapshot == nil)
+		require.Equal(t, 0, len(eth1Data.DepositContainers))
+	})
+}
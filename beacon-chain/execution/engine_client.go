@@ -47,6 +47,14 @@ var (
 		GetPayloadBodiesByHashV1,
 		GetPayloadBodiesByRangeV1,
 	}
+
+	// optionalEngineMethods are the engine API methods which are not called when the execution client did not list
+	// them in its capabilities. Prysm does without them, such as by falling back to other sources.
+	optionalEngineMethods = map[string]bool{
+		GetPayloadBodiesByHashV1:  true,
+		GetPayloadBodiesByRangeV1: true,
+		GetBlobsV1:                true,
+	}
 )
 
 const (
@@ -333,6 +341,48 @@ func (s *Service) ExchangeCapabilities(ctx context.Context) ([]string, error) {
 	return result, handleRPCError(err)
 }
 
+// EngineMethods are the engine API methods Prysm may call, whose support by the execution client is reported
+// after exchanging capabilities.
+func EngineMethods() []string {
+	methods := make([]string, 0, len(supportedEngineEndpoints)+1)
+	methods = append(methods, supportedEngineEndpoints...)
+	return append(methods, GetBlobsV1)
+}
+
+// refreshCapabilities exchanges capabilities with the execution client, so that the engine API methods it does not
+// support are not called. It is done whenever the connection to the execution client is established, every epoch so
+// that an updated execution client is noticed, and when the failover client switches to another endpoint.
+func (s *Service) refreshCapabilities(ctx context.Context) {
+	c, err := s.ExchangeCapabilities(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not exchange capabilities with execution client")
+		return
+	}
+	s.capabilityCache.save(c)
+	for _, method := range EngineMethods() {
+		supported := float64(0)
+		if s.capabilityCache.has(method) {
+			supported = 1
+		}
+		engineMethodSupported.WithLabelValues(method).Set(supported)
+	}
+}
+
+// checkEngineMethod returns ErrUnsupportedEngineMethod when the execution client does not support the optional method,
+// according to the capabilities it exchanged, warning once instead of calling the method and failing every time.
+// Methods are assumed to be supported until capabilities are exchanged. The methods required to follow the chain and
+// propose blocks are always called, so that a stale or incomplete capabilities list never stops the node.
+func (s *Service) checkEngineMethod(method string) error {
+	if !optionalEngineMethods[method] || s.capabilityCache.supports(method) {
+		return nil
+	}
+	if s.capabilityCache.warnOnce(method) {
+		log.WithField("method", method).Warn("Execution client does not support engine API method, " +
+			"calls to this method are skipped until the execution client is updated")
+	}
+	return errors.Wrap(ErrUnsupportedEngineMethod, method)
+}
+
 // GetTerminalBlockHash returns the valid terminal block hash based on total difficulty.
 //
 // Spec code:
@@ -531,7 +581,12 @@ func (s *Service) ReconstructFullBlock(
 func (s *Service) ReconstructFullBellatrixBlockBatch(
 	ctx context.Context, blindedBlocks []interfaces.ReadOnlySignedBeaconBlock,
 ) ([]interfaces.SignedBeaconBlock, error) {
-	unb, err := reconstructBlindedBlockBatch(ctx, s.rpcClient, blindedBlocks)
+	if len(blindedBlocks) > 0 {
+		if err := s.checkEngineMethod(GetPayloadBodiesByHashV1); err != nil {
+			return nil, err
+		}
+	}
+	unb, err := reconstructBlindedBlockBatch(ctx, s.rpcClient, s.payloadBodyCache, blindedBlocks)
 	if err != nil {
 		return nil, err
 	}
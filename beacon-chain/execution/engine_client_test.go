@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/ethereum/go-ethereum"
@@ -2303,7 +2304,7 @@ func TestReconstructBlindedBlockBatch(t *testing.T) {
 
 			t.Fatal("http request should not be made")
 		})
-		results, err := reconstructBlindedBlockBatch(ctx, cli, []interfaces.ReadOnlySignedBeaconBlock{})
+		results, err := reconstructBlindedBlockBatch(ctx, cli, nil, []interfaces.ReadOnlySignedBeaconBlock{})
 		require.NoError(t, err)
 		require.Equal(t, 0, len(results))
 	})
@@ -2323,7 +2324,7 @@ func TestReconstructBlindedBlockBatch(t *testing.T) {
 		service := &Service{}
 		service.rpcClient = cli
 		_, err = service.ReconstructFullBlock(ctx, blinded)
-		require.ErrorIs(t, err, errNilPayloadBody)
+		require.ErrorIs(t, err, ErrNilPayloadBody)
 	})
 }
 
@@ -2499,3 +2500,73 @@ func testNewBlobVerifier() verification.NewBlobVerifier {
 		}
 	}
 }
+
+func TestCheckEngineMethod(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	var capabilities atomic.Value
+	capabilities.Store([]string{NewPayloadMethodV3, ForkchoiceUpdatedMethodV3})
+	var calls atomic.Uint64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		calls.Add(1)
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+		}
+		switch req.Method {
+		case ExchangeCapabilities:
+			resp["result"] = capabilities.Load()
+		default:
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+	rpcClient, err := rpc.DialHTTP(srv.URL)
+	require.NoError(t, err)
+	defer rpcClient.Close()
+	service := &Service{rpcClient: rpcClient, capabilityCache: &capabilityCache{}}
+
+	// Methods are assumed to be supported until capabilities are exchanged.
+	require.NoError(t, service.checkEngineMethod(GetPayloadBodiesByHashV1))
+	assert.Equal(t, 0, len(service.ExecutionClientCapabilities()))
+
+	service.refreshCapabilities(ctx)
+	assert.DeepEqual(t, []string{ForkchoiceUpdatedMethodV3, NewPayloadMethodV3}, service.ExecutionClientCapabilities())
+
+	// The methods required to follow the chain are called even when missing from the capabilities.
+	execPayload, ok := fixtures()["ExecutionPayloadDeneb"].(*pb.ExecutionPayloadDeneb)
+	require.Equal(t, true, ok)
+	wrappedPayload, err := blocks.WrappedExecutionPayloadDeneb(execPayload)
+	require.NoError(t, err)
+	require.NoError(t, service.checkEngineMethod(NewPayloadMethodV4))
+	calls.Store(0)
+	_, err = service.NewPayload(ctx, wrappedPayload, []common.Hash{}, &common.Hash{}, &pb.ExecutionRequests{})
+	require.ErrorContains(t, "method not found", err)
+	assert.Equal(t, uint64(1), calls.Load())
+
+	// An unsupported optional method is only warned about once.
+	for i := 0; i < 3; i++ {
+		require.ErrorIs(t, service.checkEngineMethod(GetPayloadBodiesByHashV1), ErrUnsupportedEngineMethod)
+	}
+	warnings := 0
+	for _, e := range hook.AllEntries() {
+		if strings.HasPrefix(e.Message, "Execution client does not support engine API method") {
+			warnings++
+		}
+	}
+	assert.Equal(t, 1, warnings)
+
+	// Capabilities are replaced when exchanged again, such as after the execution client is updated.
+	capabilities.Store([]string{NewPayloadMethodV4, GetPayloadBodiesByHashV1})
+	service.refreshCapabilities(ctx)
+	assert.DeepEqual(t, []string{GetPayloadBodiesByHashV1, NewPayloadMethodV4}, service.ExecutionClientCapabilities())
+	require.NoError(t, service.checkEngineMethod(GetPayloadBodiesByHashV1))
+	require.ErrorIs(t, service.checkEngineMethod(GetPayloadBodiesByRangeV1), ErrUnsupportedEngineMethod)
+}
@@ -35,4 +35,7 @@ var (
 	ErrRequestTooLarge = errors.New("request too large")
 	// ErrUnsupportedVersion represents a case where a payload is requested for a block type that doesn't have a known mapping.
 	ErrUnsupportedVersion = errors.New("unknown ExecutionPayload schema for block version")
+	// ErrUnsupportedEngineMethod when the execution client does not support an engine API method, according to the
+	// capabilities it exchanged.
+	ErrUnsupportedEngineMethod = errors.New("engine API method is not supported by the execution client")
 )
@@ -0,0 +1,398 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	contracts "github.com/prysmaticlabs/prysm/v5/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultFailoverTimeout is how long a call to an execution endpoint may take before the endpoint is considered
+// unhealthy and the call is sent to the next endpoint, when fallback endpoints are configured. It is also the timeout
+// and the interval of the health checks of the endpoints.
+// The timeout is below the engine API timeouts, so that calls can still fail over before they time out.
+const defaultFailoverTimeout = 4 * time.Second
+
+// maxPayloadEndpoints is the number of payload IDs whose endpoint is remembered, so that getPayload is routed to the
+// execution client building the payload.
+const maxPayloadEndpoints = 32
+
+var errNoExecutionEndpoint = errors.New("no execution endpoint")
+
+// failoverEndpoint is an execution endpoint along with its health, as observed from recent calls and health checks.
+type failoverEndpoint struct {
+	endpoint network.Endpoint
+	client   RPCClient
+	healthy  bool
+	syncing  bool
+	// failures counts the consecutive failed calls to the endpoint.
+	failures uint64
+}
+
+// failoverClient routes the calls to the first healthy execution endpoint, in the order they were configured, and
+// fails over to the next one when a call fails or does not complete within the failover timeout. Endpoints are
+// considered healthy again once they answer health checks and are not syncing, so the first endpoint is preferred
+// again once it recovers.
+//
+// The forkchoiceUpdated and newPayload calls are the exception. An execution client keeps executing such a call after
+// the beacon node gave up on it, so they are only sent to another endpoint when the endpoint could not be connected
+// to, and otherwise go to the next endpoint only once a health check marked the endpoint unhealthy. getPayload is
+// sent to the endpoint which returned the payload ID.
+type failoverClient struct {
+	lock      sync.RWMutex
+	endpoints []*failoverEndpoint
+	timeout   time.Duration
+	// cancel stops the health checks of the endpoints.
+	cancel context.CancelFunc
+	// payloadLock ensures forkchoiceUpdated and newPayload calls are never sent to two endpoints simultaneously, such
+	// as when failing over while a call to the previous endpoint is still in flight, which could make both execution
+	// clients build conflicting payloads.
+	payloadLock sync.Mutex
+	// payloadEndpoints are the endpoints which returned the most recent payload IDs, in payloadIDs.
+	payloadEndpoints map[pb.PayloadIDBytes]*failoverEndpoint
+	payloadIDs       []pb.PayloadIDBytes
+	// onSwitch is called when calls start going to another endpoint, such as to exchange capabilities with it.
+	onSwitch func()
+}
+
+var _ RPCClient = &failoverClient{}
+
+func newFailoverClient(endpoints []network.Endpoint, clients []RPCClient, timeout time.Duration) (*failoverClient, error) {
+	if timeout <= 0 {
+		timeout = defaultFailoverTimeout
+	}
+	if len(endpoints) == 0 || len(endpoints) != len(clients) {
+		return nil, errors.Errorf("expected a client for each of the %d execution endpoints, got %d", len(endpoints), len(clients))
+	}
+	f := &failoverClient{
+		endpoints:        make([]*failoverEndpoint, len(endpoints)),
+		timeout:          timeout,
+		payloadEndpoints: make(map[pb.PayloadIDBytes]*failoverEndpoint),
+	}
+	for i := range endpoints {
+		f.endpoints[i] = &failoverEndpoint{endpoint: endpoints[i], client: clients[i], healthy: true}
+	}
+	return f, nil
+}
+
+// setupFailoverConnections dials the execution endpoints, in order of preference, and routes the calls to the
+// execution client through a failover client. Deposit logs are fetched from the first reachable endpoint.
+// Unreachable endpoints are failed over until they answer health checks, but an endpoint on the wrong chain is an error.
+func (s *Service) setupFailoverConnections(ctx context.Context, endpoints []network.Endpoint) error {
+	clients := make([]RPCClient, 0, len(endpoints))
+	closeClients := func() {
+		for _, c := range clients {
+			c.Close()
+		}
+	}
+	chainErrs := make([]error, len(endpoints))
+	var fetcher *ethclient.Client
+	for i, e := range endpoints {
+		client, err := s.newRPCClientWithAuth(ctx, e)
+		if err != nil {
+			closeClients()
+			return errors.Wrapf(err, "could not dial execution node %s", logs.MaskCredentialsLogging(e.Url))
+		}
+		clients = append(clients, client)
+		ethClient := ethclient.NewClient(client)
+		chainCtx, cancel := context.WithTimeout(ctx, s.failoverTimeout())
+		chainErrs[i] = ensureCorrectExecutionChain(chainCtx, ethClient)
+		cancel()
+		if errors.Is(chainErrs[i], errWrongExecutionChain) {
+			closeClients()
+			return errors.Wrapf(chainErrs[i], "execution endpoint %s", logs.MaskCredentialsLogging(e.Url))
+		}
+		if chainErrs[i] == nil && fetcher == nil {
+			fetcher = ethClient
+		}
+	}
+	if fetcher == nil {
+		closeClients()
+		return executionChainError(chainErrs[0])
+	}
+
+	f, err := newFailoverClient(endpoints, clients, s.cfg.failoverTimeout)
+	if err != nil {
+		closeClients()
+		return err
+	}
+	depositContractCaller, err := contracts.NewDepositContractCaller(s.cfg.depositContractAddr, fetcher)
+	if err != nil {
+		f.Close()
+		return errors.Wrap(err, "could not initialize deposit contract caller")
+	}
+	for i, e := range f.endpoints {
+		if chainErrs[i] != nil {
+			f.update(e, chainErrs[i], nil)
+		}
+	}
+	f.onSwitch = func() {
+		s.refreshCapabilities(ctx)
+	}
+	f.start(ctx)
+	s.rpcClient = f
+	s.httpLogger = fetcher
+	s.depositContractCaller = depositContractCaller
+	s.updateConnectedETH1(true)
+	s.runError = nil
+	return nil
+}
+
+func (s *Service) failoverTimeout() time.Duration {
+	if s.cfg.failoverTimeout <= 0 {
+		return defaultFailoverTimeout
+	}
+	return s.cfg.failoverTimeout
+}
+
+// start checks the health of the endpoints periodically, until the client is closed.
+func (f *failoverClient) start(ctx context.Context) {
+	ctx, f.cancel = context.WithCancel(ctx)
+	go f.monitorHealth(ctx)
+}
+
+// Close stops the health checks and closes the clients of all the endpoints.
+func (f *failoverClient) Close() {
+	if f.cancel != nil {
+		f.cancel()
+	}
+	for _, e := range f.endpoints {
+		e.client.Close()
+	}
+}
+
+// CallContext sends the call to the first healthy endpoint, failing over to the next ones if it fails.
+func (f *failoverClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if isPayloadMethod(method) {
+		return f.callPayloadMethod(ctx, result, method, args...)
+	}
+	if e, ok := f.payloadEndpoint(method, args); ok {
+		err := e.client.CallContext(ctx, result, method, args...)
+		f.record(ctx, e, err)
+		return err
+	}
+	err := errNoExecutionEndpoint
+	for _, e := range f.candidates() {
+		attemptCtx, cancel := context.WithTimeout(ctx, f.timeout)
+		err = e.client.CallContext(attemptCtx, result, method, args...)
+		cancel()
+		if !f.record(ctx, e, err) {
+			return err
+		}
+	}
+	return err
+}
+
+// callPayloadMethod sends a forkchoiceUpdated or newPayload call to the first healthy endpoint, within the deadline of
+// the caller. The call is only sent to the next endpoint when the endpoint could not be connected to, as the call was
+// then never received.
+func (f *failoverClient) callPayloadMethod(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	f.payloadLock.Lock()
+	defer f.payloadLock.Unlock()
+	err := errNoExecutionEndpoint
+	for _, e := range f.candidates() {
+		err = e.client.CallContext(ctx, result, method, args...)
+		if isConnectionError(err) {
+			f.update(e, err, nil)
+			continue
+		}
+		var rpcErr gethRPC.Error
+		if err == nil || errors.As(err, &rpcErr) {
+			f.update(e, nil, nil)
+		}
+		if r, ok := result.(*ForkchoiceUpdatedResponse); ok && err == nil && r.PayloadId != nil {
+			f.rememberPayloadEndpoint(*r.PayloadId, e)
+		}
+		return err
+	}
+	return err
+}
+
+// rememberPayloadEndpoint records the endpoint which returned the payload ID.
+func (f *failoverClient) rememberPayloadEndpoint(id pb.PayloadIDBytes, e *failoverEndpoint) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, ok := f.payloadEndpoints[id]; !ok {
+		f.payloadIDs = append(f.payloadIDs, id)
+	}
+	f.payloadEndpoints[id] = e
+	if len(f.payloadIDs) > maxPayloadEndpoints {
+		delete(f.payloadEndpoints, f.payloadIDs[0])
+		f.payloadIDs = f.payloadIDs[1:]
+	}
+}
+
+// payloadEndpoint returns the endpoint which returned the payload ID of a getPayload call.
+func (f *failoverClient) payloadEndpoint(method string, args []interface{}) (*failoverEndpoint, bool) {
+	if !isGetPayloadMethod(method) || len(args) == 0 {
+		return nil, false
+	}
+	id, ok := args[0].(pb.PayloadIDBytes)
+	if !ok {
+		return nil, false
+	}
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	e, ok := f.payloadEndpoints[id]
+	return e, ok
+}
+
+// BatchCall sends the batch to the first healthy endpoint, failing over to the next ones if it fails.
+func (f *failoverClient) BatchCall(b []gethRPC.BatchElem) error {
+	err := errNoExecutionEndpoint
+	for _, e := range f.candidates() {
+		err = e.client.BatchCall(b)
+		if !f.record(context.Background(), e, err) {
+			return err
+		}
+	}
+	return err
+}
+
+// candidates returns the healthy endpoints in order, or all of them when none is healthy so that calls are still
+// attempted until an endpoint recovers.
+func (f *failoverClient) candidates() []*failoverEndpoint {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	healthy := make([]*failoverEndpoint, 0, len(f.endpoints))
+	for _, e := range f.endpoints {
+		if e.healthy {
+			healthy = append(healthy, e)
+		}
+	}
+	if len(healthy) == 0 {
+		return f.endpoints
+	}
+	return healthy
+}
+
+// preferred returns the endpoint calls are sent to first, which is nil when no endpoint is healthy. The lock must be
+// held.
+func (f *failoverClient) preferred() *failoverEndpoint {
+	for _, e := range f.endpoints {
+		if e.healthy {
+			return e
+		}
+	}
+	return nil
+}
+
+// record updates the health of the endpoint after a call, and returns whether the call should be sent to the next
+// endpoint. Errors returned by the execution client itself, such as invalid params, mean the endpoint is reachable and
+// are returned as is.
+func (f *failoverClient) record(ctx context.Context, e *failoverEndpoint, err error) bool {
+	var rpcErr gethRPC.Error
+	if err == nil || errors.As(err, &rpcErr) {
+		f.update(e, nil, nil)
+		return false
+	}
+	if ctx.Err() != nil {
+		// The caller gave up, which tells nothing about the endpoint.
+		return false
+	}
+	f.update(e, err, nil)
+	return true
+}
+
+// update records the outcome of a call to the endpoint, along with its syncing status when known. The endpoint is
+// healthy when its last call succeeded and it is not syncing.
+func (f *failoverClient) update(e *failoverEndpoint, err error, syncing *bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	preferred := f.preferred()
+	if syncing != nil {
+		e.syncing = *syncing
+	}
+	if err != nil {
+		e.failures++
+	} else {
+		e.failures = 0
+	}
+	healthy := err == nil && !e.syncing
+	if e.healthy == healthy {
+		return
+	}
+	e.healthy = healthy
+	if f.onSwitch != nil && f.preferred() != preferred {
+		go f.onSwitch()
+	}
+	fields := logrus.Fields{
+		"endpoint": logs.MaskCredentialsLogging(e.endpoint.Url),
+		"syncing":  e.syncing,
+		"failures": e.failures,
+	}
+	if healthy {
+		log.WithFields(fields).Info("Execution endpoint is healthy again")
+		return
+	}
+	log.WithError(err).WithFields(fields).Warn("Execution endpoint is unhealthy, failing over to the next endpoint")
+}
+
+// checkHealth checks whether each endpoint answers and is not syncing, using eth_syncing.
+func (f *failoverClient) checkHealth(ctx context.Context) {
+	for _, e := range f.endpoints {
+		checkCtx, cancel := context.WithTimeout(ctx, f.timeout)
+		var result json.RawMessage
+		err := e.client.CallContext(checkCtx, &result, "eth_syncing")
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			f.update(e, err, nil)
+			continue
+		}
+		syncing := string(result) != "false"
+		f.update(e, nil, &syncing)
+	}
+}
+
+// monitorHealth checks the health of the endpoints periodically, until the context is done.
+func (f *failoverClient) monitorHealth(ctx context.Context) {
+	ticker := time.NewTicker(f.timeout)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			f.checkHealth(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// isGetPayloadMethod returns whether the engine API method retrieves a payload built by the execution client.
+func isGetPayloadMethod(method string) bool {
+	switch method {
+	case GetPayloadMethod, GetPayloadMethodV2, GetPayloadMethodV3, GetPayloadMethodV4:
+		return true
+	}
+	return false
+}
+
+// isConnectionError returns whether the error means that the call could not be sent to the endpoint.
+func isConnectionError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// isPayloadMethod returns whether the engine API method may make the execution client build a payload, or change
+// the head it builds on.
+func isPayloadMethod(method string) bool {
+	switch method {
+	case NewPayloadMethod, NewPayloadMethodV2, NewPayloadMethodV3, NewPayloadMethodV4,
+		ForkchoiceUpdatedMethod, ForkchoiceUpdatedMethodV2, ForkchoiceUpdatedMethodV3:
+		return true
+	}
+	return false
+}
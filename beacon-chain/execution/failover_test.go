@@ -0,0 +1,311 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// payloadCalls tracks the forkchoiceUpdated and newPayload calls being served by any of the mock engines.
+type payloadCalls struct {
+	sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+// failoverEngine is an execution client which answers the calls used by the failover client, and which can go down by
+// never answering.
+type failoverEngine struct {
+	t        *testing.T
+	srv      *httptest.Server
+	payloads *payloadCalls
+	down     atomic.Bool
+	syncing  atomic.Bool
+	// calls counts the calls answered, except for the health checks and capability exchanges which run in the
+	// background.
+	calls atomic.Uint64
+	// payloadID is returned by forkchoiceUpdated.
+	payloadID [8]byte
+}
+
+func newFailoverEngine(t *testing.T, payloads *payloadCalls) *failoverEngine {
+	m := &failoverEngine{t: t, payloads: payloads}
+	m.srv = httptest.NewServer(m)
+	t.Cleanup(m.srv.Close)
+	return m
+}
+
+func (m *failoverEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}{}
+	require.NoError(m.t, json.NewDecoder(r.Body).Decode(&req))
+	if m.down.Load() {
+		<-r.Context().Done()
+		return
+	}
+	if req.Method != "eth_syncing" && req.Method != ExchangeCapabilities {
+		m.calls.Add(1)
+	}
+	resp := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      req.ID,
+	}
+	switch req.Method {
+	case "eth_chainId":
+		resp["result"] = hexutil.Uint64(params.BeaconConfig().DepositChainID)
+	case "eth_syncing":
+		if m.syncing.Load() {
+			resp["result"] = map[string]string{"currentBlock": "0x1", "highestBlock": "0x2"}
+		} else {
+			resp["result"] = false
+		}
+	case ForkchoiceUpdatedMethod:
+		m.payloads.Lock()
+		m.payloads.inFlight++
+		if m.payloads.inFlight > m.payloads.maxInFlight {
+			m.payloads.maxInFlight = m.payloads.inFlight
+		}
+		m.payloads.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		m.payloads.Lock()
+		m.payloads.inFlight--
+		m.payloads.Unlock()
+		resp["result"] = map[string]interface{}{
+			"payloadStatus": map[string]string{"status": "VALID"},
+			"payloadId":     pb.PayloadIDBytes(m.payloadID),
+		}
+	case GetPayloadMethod:
+		resp["result"] = map[string]interface{}{}
+	default:
+		resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(m.t, json.NewEncoder(w).Encode(resp))
+}
+
+func forkchoiceUpdated(ctx context.Context, c RPCClient) error {
+	result := &ForkchoiceUpdatedResponse{}
+	return c.CallContext(ctx, result, ForkchoiceUpdatedMethod, struct{}{}, nil)
+}
+
+func chainID(ctx context.Context, c RPCClient) error {
+	var result hexutil.Uint64
+	return c.CallContext(ctx, &result, "eth_chainId")
+}
+
+// newTestFailoverClient returns a failover client for the given engines, without the background health checks.
+func newTestFailoverClient(t *testing.T, timeout time.Duration, engines ...*failoverEngine) *failoverClient {
+	clients := make([]RPCClient, len(engines))
+	endpoints := make([]network.Endpoint, len(engines))
+	for i, m := range engines {
+		endpoints[i] = network.HttpEndpoint(m.srv.URL)
+		c, err := gethRPC.DialHTTP(endpoints[i].Url)
+		require.NoError(t, err)
+		clients[i] = c
+	}
+	f, err := newFailoverClient(endpoints, clients, timeout)
+	require.NoError(t, err)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func TestFailoverClient_PrimaryGoesDown(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	payloads := &payloadCalls{}
+	primary, fallback := newFailoverEngine(t, payloads), newFailoverEngine(t, payloads)
+
+	timeout := 200 * time.Millisecond
+	s := &Service{ctx: ctx, cfg: &config{
+		beaconNodeStatsUpdater: &NopBeaconNodeStatsUpdater{},
+		failoverTimeout:        timeout,
+	}}
+	endpoints := []network.Endpoint{network.HttpEndpoint(primary.srv.URL), network.HttpEndpoint(fallback.srv.URL)}
+	require.NoError(t, s.setupFailoverConnections(ctx, endpoints))
+	defer s.rpcClient.Close()
+	f, ok := s.rpcClient.(*failoverClient)
+	require.Equal(t, true, ok)
+	assert.Equal(t, true, s.ExecutionClientConnected())
+
+	// Concurrent calls, as for several blocks and attestations, are served one at a time.
+	primaryCalls, fallbackCalls := primary.calls.Load(), fallback.calls.Load()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, forkchoiceUpdated(ctx, s.rpcClient))
+		}()
+	}
+	wg.Wait()
+	payloads.Lock()
+	assert.Equal(t, 1, payloads.maxInFlight)
+	payloads.Unlock()
+	assert.Equal(t, primaryCalls+4, primary.calls.Load())
+	assert.Equal(t, fallbackCalls, fallback.calls.Load())
+
+	// A payload call the primary endpoint does not answer in time is not sent to the fallback endpoint, as the
+	// primary execution client may still be executing it.
+	primary.down.Store(true)
+	callCtx, callCancel := context.WithTimeout(ctx, 3*timeout)
+	require.ErrorIs(t, forkchoiceUpdated(callCtx, s.rpcClient), context.DeadlineExceeded)
+	callCancel()
+	assert.Equal(t, fallbackCalls, fallback.calls.Load())
+
+	// Other calls fail over within the failover timeout.
+	require.NoError(t, chainID(ctx, s.rpcClient))
+	assert.Equal(t, fallbackCalls+1, fallback.calls.Load())
+
+	// Payload calls go to the fallback endpoint once a health check marked the primary endpoint unhealthy.
+	f.checkHealth(ctx)
+	require.LogsContain(t, hook, "Execution endpoint is unhealthy")
+	primaryCalls, fallbackCalls = primary.calls.Load(), fallback.calls.Load()
+	require.NoError(t, forkchoiceUpdated(ctx, s.rpcClient))
+	assert.Equal(t, primaryCalls, primary.calls.Load())
+	assert.Equal(t, fallbackCalls+1, fallback.calls.Load())
+
+	// Errors returned by the execution client do not fail over.
+	var result json.RawMessage
+	err := s.rpcClient.CallContext(ctx, &result, "engine_unknown")
+	var rpcErr gethRPC.Error
+	require.Equal(t, true, errors.As(err, &rpcErr))
+	assert.Equal(t, fallbackCalls+2, fallback.calls.Load())
+	assert.Equal(t, primaryCalls, primary.calls.Load())
+
+	// The primary endpoint is preferred again once it answers health checks.
+	primary.down.Store(false)
+	f.checkHealth(ctx)
+	require.LogsContain(t, hook, "Execution endpoint is healthy again")
+	primaryCalls, fallbackCalls = primary.calls.Load(), fallback.calls.Load()
+	require.NoError(t, forkchoiceUpdated(ctx, s.rpcClient))
+	assert.Equal(t, primaryCalls+1, primary.calls.Load())
+	assert.Equal(t, fallbackCalls, fallback.calls.Load())
+
+	// A syncing endpoint is failed over.
+	primary.syncing.Store(true)
+	f.checkHealth(ctx)
+	primaryCalls, fallbackCalls = primary.calls.Load(), fallback.calls.Load()
+	require.NoError(t, forkchoiceUpdated(ctx, s.rpcClient))
+	assert.Equal(t, primaryCalls, primary.calls.Load())
+	assert.Equal(t, fallbackCalls+1, fallback.calls.Load())
+}
+
+func TestFailoverClient_ConnectionError(t *testing.T) {
+	ctx := context.Background()
+	payloads := &payloadCalls{}
+	primary, fallback := newFailoverEngine(t, payloads), newFailoverEngine(t, payloads)
+	f := newTestFailoverClient(t, time.Second, primary, fallback)
+
+	// The call could not be sent to the primary endpoint, so it is sent to the fallback endpoint right away.
+	primary.srv.Close()
+	require.NoError(t, forkchoiceUpdated(ctx, f))
+	assert.Equal(t, uint64(1), fallback.calls.Load())
+	assert.Equal(t, 1, len(f.candidates()))
+}
+
+func TestFailoverClient_GetPayloadFromBuildingEndpoint(t *testing.T) {
+	ctx := context.Background()
+	payloads := &payloadCalls{}
+	primary, fallback := newFailoverEngine(t, payloads), newFailoverEngine(t, payloads)
+	primary.payloadID = [8]byte{1}
+	fallback.payloadID = [8]byte{2}
+	f := newTestFailoverClient(t, time.Second, primary, fallback)
+
+	result := &ForkchoiceUpdatedResponse{}
+	require.NoError(t, f.CallContext(ctx, result, ForkchoiceUpdatedMethod, struct{}{}, struct{}{}))
+	require.NotNil(t, result.PayloadId)
+	assert.Equal(t, pb.PayloadIDBytes{1}, *result.PayloadId)
+
+	// The payload is retrieved from the endpoint building it, even once another endpoint is preferred.
+	primary.syncing.Store(true)
+	f.checkHealth(ctx)
+	primaryCalls, fallbackCalls := primary.calls.Load(), fallback.calls.Load()
+	var payload json.RawMessage
+	require.NoError(t, f.CallContext(ctx, &payload, GetPayloadMethod, *result.PayloadId))
+	assert.Equal(t, primaryCalls+1, primary.calls.Load())
+	assert.Equal(t, fallbackCalls, fallback.calls.Load())
+
+	// Unknown payload IDs are routed as any other call.
+	require.NoError(t, f.CallContext(ctx, &payload, GetPayloadMethod, pb.PayloadIDBytes{3}))
+	assert.Equal(t, primaryCalls+1, primary.calls.Load())
+	assert.Equal(t, fallbackCalls+1, fallback.calls.Load())
+
+	for i := 0; i < maxPayloadEndpoints+1; i++ {
+		f.rememberPayloadEndpoint(pb.PayloadIDBytes{byte(i), 1}, f.endpoints[1])
+	}
+	assert.Equal(t, maxPayloadEndpoints, len(f.payloadEndpoints))
+	assert.Equal(t, maxPayloadEndpoints, len(f.payloadIDs))
+}
+
+func TestFailoverClient_AllEndpointsDown(t *testing.T) {
+	ctx := context.Background()
+	payloads := &payloadCalls{}
+	primary, fallback := newFailoverEngine(t, payloads), newFailoverEngine(t, payloads)
+	f := newTestFailoverClient(t, 100*time.Millisecond, primary, fallback)
+
+	primary.down.Store(true)
+	fallback.down.Store(true)
+	require.ErrorIs(t, chainID(ctx, f), context.DeadlineExceeded)
+	assert.Equal(t, 2, len(f.candidates()))
+
+	// Calls are still attempted on all the endpoints, so that the first one to recover is used.
+	fallback.down.Store(false)
+	require.NoError(t, chainID(ctx, f))
+	assert.Equal(t, uint64(1), fallback.calls.Load())
+
+	endpoints := []network.Endpoint{network.HttpEndpoint(primary.srv.URL), network.HttpEndpoint(fallback.srv.URL)}
+	_, err := newFailoverClient(endpoints, []RPCClient{f.endpoints[0].client}, 0)
+	require.ErrorContains(t, "expected a client for each of the 2 execution endpoints", err)
+}
+
+func TestFailoverClient_OnSwitch(t *testing.T) {
+	ctx := context.Background()
+	payloads := &payloadCalls{}
+	primary, fallback := newFailoverEngine(t, payloads), newFailoverEngine(t, payloads)
+	f := newTestFailoverClient(t, 100*time.Millisecond, primary, fallback)
+	switches := make(chan struct{}, 4)
+	f.onSwitch = func() {
+		switches <- struct{}{}
+	}
+
+	// Capabilities are exchanged again when calls go to another endpoint, but not when an endpoint after the
+	// preferred one changes health.
+	fallback.syncing.Store(true)
+	f.checkHealth(ctx)
+	assert.Equal(t, 0, len(switches))
+	fallback.syncing.Store(false)
+	f.checkHealth(ctx)
+	primary.syncing.Store(true)
+	f.checkHealth(ctx)
+	select {
+	case <-switches:
+	case <-time.After(time.Second):
+		t.Fatal("Capabilities were not exchanged after failing over")
+	}
+	primary.syncing.Store(false)
+	f.checkHealth(ctx)
+	select {
+	case <-switches:
+	case <-time.After(time.Second):
+		t.Fatal("Capabilities were not exchanged after the primary endpoint recovered")
+	}
+	assert.Equal(t, 0, len(switches))
+}
@@ -111,6 +111,9 @@ func (s *Service) ProcessLog(ctx context.Context, depositLog *gethtypes.Log) err
 // the eth1 chain by trying to ascertain which participant deposited
 // in the contract.
 func (s *Service) ProcessDepositLog(ctx context.Context, depositLog *gethtypes.Log) error {
+	if s.depositSnapshotMismatch {
+		return errors.New("deposit snapshot does not match the finalized eth1 data, restart the node without a deposit snapshot")
+	}
 	pubkey, withdrawalCredentials, amount, signature, merkleTreeIndex, err := contracts.UnpackDepositLogData(depositLog.Data)
 	if err != nil {
 		return errors.Wrap(err, "Could not unpack log")
@@ -150,6 +153,9 @@ func (s *Service) ProcessDepositLog(ctx context.Context, depositLog *gethtypes.L
 	if err = s.depositTrie.Insert(depositHash[:], int(index)); err != nil {
 		return err
 	}
+	if err := s.verifyDepositSnapshotRoot(ctx); err != nil {
+		return err
+	}
 	deposit := &ethpb.Deposit{
 		Data: depositData,
 	}
@@ -326,6 +332,8 @@ func (s *Service) processPastLogs(ctx context.Context) error {
 	s.latestEth1DataLock.Lock()
 	s.latestEth1Data.LastRequestedBlock = currentBlockNum
 	s.latestEth1DataLock.Unlock()
+	// The hashes of the blocks processed in batches are not recorded, so the recorded ones are not recent anymore.
+	s.processedBlockHashes = make(map[uint64]common.Hash)
 
 	c, err := s.cfg.beaconDB.FinalizedCheckpoint(ctx)
 	if err != nil {
@@ -473,9 +481,20 @@ func (s *Service) requestBatchedHeadersAndLogs(ctx context.Context) error {
 		return s.processPastLogs(ctx)
 	}
 	for i := s.latestEth1Data.LastRequestedBlock + 1; i <= requestedBlock; i++ {
-		// Cache eth1 block header here.
-		_, err := s.BlockHashByHeight(ctx, new(big.Int).SetUint64(i))
+		// The header is requested rather than read from the cache, as a cached header may
+		// not be canonical anymore.
+		header, err := s.HeaderByNumber(ctx, new(big.Int).SetUint64(i))
 		if err != nil {
+			return errors.Wrapf(err, "could not query header with height %d", i)
+		}
+		parentHash, ok := s.processedBlockHashes[i-1]
+		if ok && header.ParentHash != (common.Hash{}) && header.ParentHash != parentHash {
+			// The block does not build on the last processed block, the logs of the new
+			// canonical blocks are requested on the next poll.
+			return s.handleExecutionReorg(ctx, i-1)
+		}
+		// Cache eth1 block header here.
+		if err := s.headerCache.AddHeader(header); err != nil {
 			return err
 		}
 		err = s.ProcessETH1Block(ctx, new(big.Int).SetUint64(i))
@@ -485,8 +504,136 @@ func (s *Service) requestBatchedHeadersAndLogs(ctx context.Context) error {
 		s.latestEth1DataLock.Lock()
 		s.latestEth1Data.LastRequestedBlock = i
 		s.latestEth1DataLock.Unlock()
+		s.recordProcessedBlock(i, header.Hash)
+	}
+
+	return nil
+}
+
+// recordProcessedBlock records the hash of a processed execution block, keeping the hashes of as many blocks
+// as the header cache.
+func (s *Service) recordProcessedBlock(height uint64, hash common.Hash) {
+	if s.processedBlockHashes == nil {
+		s.processedBlockHashes = make(map[uint64]common.Hash)
+	}
+	s.processedBlockHashes[height] = hash
+	if height >= maxCacheSize {
+		delete(s.processedBlockHashes, height-maxCacheSize)
+	}
+}
+
+// handleExecutionReorg rolls back the deposits processed from execution blocks that are not canonical anymore,
+// after the block following the given height was found not to build on the block processed at that height. The
+// deposit cache, pending deposits and deposit trie are rolled back to the common ancestor of both chains, and the
+// last requested block is moved back to it so that the logs of the new canonical blocks are processed again.
+func (s *Service) handleExecutionReorg(ctx context.Context, height uint64) error {
+	if !s.chainStartData.Chainstarted {
+		return errors.Errorf("execution chain reorg detected at height %d before chain start, chain start deposits cannot be rolled back", height)
+	}
+	ancestor, err := s.commonAncestorHeight(ctx, height)
+	if err != nil {
+		return errors.Wrap(err, "could not find common ancestor of reorged execution chain")
+	}
+
+	s.processingLock.Lock()
+	defer s.processingLock.Unlock()
+	if err := s.cfg.depositCache.RemoveDepositsAfterBlock(ctx, ancestor); err != nil {
+		return errors.Wrap(err, "could not remove reorged deposits from cache")
+	}
+	if err := s.rebuildDepositTrie(ctx); err != nil {
+		return errors.Wrap(err, "could not rebuild deposit trie")
+	}
+	if err := s.headerCache.RemoveHeadersAfter(new(big.Int).SetUint64(ancestor)); err != nil {
+		return errors.Wrap(err, "could not remove reorged headers from cache")
+	}
+	for h := range s.processedBlockHashes {
+		if h > ancestor {
+			delete(s.processedBlockHashes, h)
+		}
+	}
+	s.latestEth1DataLock.Lock()
+	s.latestEth1Data.LastRequestedBlock = ancestor
+	s.latestEth1DataLock.Unlock()
+
+	log.WithFields(logrus.Fields{
+		"depth":              height - ancestor,
+		"commonAncestor":     ancestor,
+		"lastProcessedBlock": height,
+		"depositCount":       s.lastReceivedMerkleIndex + 1,
+	}).Warn("Execution chain reorg detected, resyncing deposits from the common ancestor")
+	return s.savePowchainData(ctx)
+}
+
+// commonAncestorHeight walks back from the given height through the recorded hashes of the processed blocks
+// until one of them is canonical. If the reorg is deeper than the recorded blocks, the height of the execution
+// block of the last finalized deposit is used, as the deposits up to it cannot be reorged.
+func (s *Service) commonAncestorHeight(ctx context.Context, height uint64) (uint64, error) {
+	finalizedHeight, err := s.finalizedDepositBlockHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if height <= finalizedHeight {
+		return 0, errors.Errorf("reorged block at height %d is not after the block of the last finalized deposit at height %d", height, finalizedHeight)
+	}
+	for h := height; h > finalizedHeight; h-- {
+		recorded, ok := s.processedBlockHashes[h]
+		if !ok {
+			log.WithFields(logrus.Fields{
+				"height":          height,
+				"finalizedHeight": finalizedHeight,
+			}).Warn("Execution chain reorg is deeper than the processed block history, resyncing deposits from the last finalized deposit")
+			return finalizedHeight, nil
+		}
+		header, err := s.HeaderByNumber(ctx, new(big.Int).SetUint64(h))
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not query header with height %d", h)
+		}
+		if header.Hash == recorded {
+			return h, nil
+		}
+	}
+	return finalizedHeight, nil
+}
+
+// finalizedDepositBlockHeight returns the height of the execution block of the last finalized deposit in the
+// deposit cache, or the deposit contract deployment block if the cache does not hold it.
+func (s *Service) finalizedDepositBlockHeight(ctx context.Context) (uint64, error) {
+	height := params.BeaconNetworkConfig().ContractDeploymentBlock
+	fd, err := s.cfg.depositCache.FinalizedDeposits(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get finalized deposits")
 	}
+	index := fd.MerkleTrieIndex()
+	for _, ctr := range s.cfg.depositCache.AllDepositContainers(ctx) {
+		if ctr.Index == index {
+			return max(height, ctr.Eth1BlockHeight), nil
+		}
+	}
+	return height, nil
+}
 
+// rebuildDepositTrie rebuilds the deposit trie from the finalized deposits and the non-finalized deposits
+// in the deposit cache.
+func (s *Service) rebuildDepositTrie(ctx context.Context) error {
+	fd, err := s.cfg.depositCache.FinalizedDeposits(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized deposits")
+	}
+	depositTrie, ok := fd.Deposits().(*depositsnapshot.DepositTree)
+	if !ok {
+		return errors.New("deposit tree was not EIP4881 DepositTree")
+	}
+	for _, d := range s.cfg.depositCache.NonFinalizedDeposits(ctx, fd.MerkleTrieIndex(), nil) {
+		root, err := d.Data.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash deposit data")
+		}
+		if err := depositTrie.Insert(root[:], depositTrie.NumOfItems()); err != nil {
+			return errors.Wrap(err, "could not insert deposit into trie")
+		}
+	}
+	s.depositTrie = depositTrie
+	s.lastReceivedMerkleIndex = int64(depositTrie.NumOfItems() - 1)
 	return nil
 }
 
@@ -565,6 +712,10 @@ func (s *Service) processChainStartIfReady(ctx context.Context, blockHash [32]by
 
 // savePowchainData saves all powchain related metadata to disk.
 func (s *Service) savePowchainData(ctx context.Context) error {
+	if s.depositSnapshotMismatch {
+		// The execution chain data of an invalid deposit snapshot was discarded.
+		return nil
+	}
 	pbState, err := statenative.ProtobufBeaconStatePhase0(s.preGenesisState.ToProtoUnsafe())
 	if err != nil {
 		return err
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
@@ -619,3 +620,84 @@ func newPowchainService(t *testing.T, eth1Backend *mock.TestAccount, beaconDB db
 	params.OverrideBeaconConfig(bConfig)
 	return web3Service
 }
+
+func TestRequestBatchedHeadersAndLogs_ExecutionReorg(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	testAcc, err := mock.Setup()
+	require.NoError(t, err, "Unable to set up simulated backend")
+	beaconDB := testDB.SetupDB(t)
+	web3Service := newPowchainService(t, testAcc, beaconDB)
+	web3Service.httpLogger = testAcc.Backend
+	web3Service.chainStartData.Chainstarted = true
+	bConfig := params.BeaconConfig().Copy()
+	bConfig.Eth1FollowDistance = 0
+	params.OverrideBeaconConfig(bConfig)
+	nConfig := params.BeaconNetworkConfig()
+	nConfig.ContractDeploymentBlock = 0
+	params.OverrideBeaconNetworkConfig(nConfig)
+
+	deposits, _, err := util.DeterministicDepositsAndKeys(4)
+	require.NoError(t, err)
+	_, depositRoots, err := util.DeterministicDepositTrie(len(deposits))
+	require.NoError(t, err)
+	deposit := func(i int) {
+		data := deposits[i].Data
+		testAcc.TxOpts.Value = mock.Amount32Eth()
+		testAcc.TxOpts.GasLimit = 1000000
+		_, err := testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature, depositRoots[i])
+		require.NoError(t, err, "Could not deposit to deposit contract")
+	}
+	poll := func() {
+		head := testAcc.Backend.Blockchain().CurrentBlock()
+		web3Service.latestEth1Data.BlockHeight = head.Number.Uint64()
+		web3Service.latestEth1Data.BlockTime = head.Time
+		require.NoError(t, web3Service.requestBatchedHeadersAndLogs(ctx))
+	}
+	requireCanonicalDepositRoot := func() {
+		want, err := web3Service.depositContractCaller.GetDepositRoot(&bind.CallOpts{})
+		require.NoError(t, err)
+		got, err := web3Service.depositTrie.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	web3Service.latestEth1Data.LastRequestedBlock = testAcc.Backend.Blockchain().CurrentBlock().Number.Uint64()
+
+	// The common ancestor holds the first deposit, the chain that is reorged out two more.
+	deposit(0)
+	testAcc.Backend.Commit()
+	ancestor := testAcc.Backend.Blockchain().CurrentBlock()
+	deposit(1)
+	deposit(2)
+	testAcc.Backend.Commit()
+	testAcc.Backend.Commit()
+	poll()
+	require.Equal(t, 3, web3Service.depositTrie.NumOfItems())
+	require.Equal(t, 3, len(web3Service.cfg.depositCache.PendingContainers(ctx, nil)))
+	requireCanonicalDepositRoot()
+
+	// The longer competing chain holds a different deposit.
+	require.NoError(t, testAcc.Backend.Fork(ctx, ancestor.Hash()))
+	deposit(3)
+	testAcc.Backend.Commit()
+	testAcc.Backend.Commit()
+	testAcc.Backend.Commit()
+
+	// The reorg is detected and the deposits are rolled back to the common ancestor.
+	poll()
+	require.LogsContain(t, hook, "Execution chain reorg detected")
+	require.LogsContain(t, hook, "depth=2")
+	assert.Equal(t, ancestor.Number.Uint64(), web3Service.latestEth1Data.LastRequestedBlock)
+	require.Equal(t, 1, web3Service.depositTrie.NumOfItems())
+	require.Equal(t, 1, len(web3Service.cfg.depositCache.AllDepositContainers(ctx)))
+	require.Equal(t, 1, len(web3Service.cfg.depositCache.PendingContainers(ctx, nil)))
+
+	// The logs of the new canonical chain are processed on the next poll.
+	poll()
+	ctrs := web3Service.cfg.depositCache.AllDepositContainers(ctx)
+	require.Equal(t, 2, len(ctrs))
+	assert.DeepEqual(t, deposits[0].Data.PublicKey, ctrs[0].Deposit.Data.PublicKey)
+	assert.DeepEqual(t, deposits[3].Data.PublicKey, ctrs[1].Deposit.Data.PublicKey)
+	require.Equal(t, 2, len(web3Service.cfg.depositCache.PendingContainers(ctx, nil)))
+	requireCanonicalDepositRoot()
+}
@@ -35,6 +35,10 @@ var (
 		Name: "execution_invalid_request_count",
 		Help: "The number of errors that occurred due to invalid request",
 	})
+	engineMethodSupported = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "execution_engine_method_supported",
+		Help: "Whether the execution client supports the engine API method (1) or not (0), according to the exchanged capabilities",
+	}, []string{"method"})
 	errMethodNotFoundCount = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "execution_method_not_found_count",
 		Help: "The number of errors that occurred due to method not found",
@@ -67,6 +71,14 @@ var (
 		Name: "reconstructed_execution_payload_count",
 		Help: "Count the number of execution payloads that are reconstructed using JSON-RPC from payload headers",
 	})
+	payloadBodyCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_payload_body_cache_hit",
+		Help: "The number of payload bodies found in the cache when reconstructing blinded blocks",
+	})
+	payloadBodyCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "execution_payload_body_cache_miss",
+		Help: "The number of payload bodies requested from the execution client when reconstructing blinded blocks",
+	})
 	errRequestTooLargeCount = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "execution_payload_bodies_count",
 		Help: "The number of requested payload bodies is too large",
@@ -1,6 +1,8 @@
 package execution
 
 import (
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -10,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	"github.com/prysmaticlabs/prysm/v5/network"
 	"github.com/prysmaticlabs/prysm/v5/network/authorization"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
 type Option func(s *Service) error
@@ -38,6 +41,30 @@ func WithHttpEndpointAndJWTSecret(endpointString string, secret []byte) Option {
 	}
 }
 
+// WithFallbackHttpEndpoint adds an execution node JSON-RPC endpoint which engine API calls fail over to, in the order
+// the fallback endpoints are added, when the previous endpoints are unhealthy. The endpoint is authenticated with the
+// JWT secret when provided.
+func WithFallbackHttpEndpoint(endpointString string, secret []byte) Option {
+	return func(s *Service) error {
+		hEndpoint := network.HttpEndpoint(endpointString)
+		if len(secret) > 0 {
+			hEndpoint.Auth.Method = authorization.Bearer
+			hEndpoint.Auth.Value = string(secret)
+		}
+		s.cfg.fallbackEndpoints = append(s.cfg.fallbackEndpoints, hEndpoint)
+		return nil
+	}
+}
+
+// WithFailoverTimeout sets how long a call to an execution endpoint may take before failing over to the next
+// endpoint, when fallback endpoints are configured.
+func WithFailoverTimeout(timeout time.Duration) Option {
+	return func(s *Service) error {
+		s.cfg.failoverTimeout = timeout
+		return nil
+	}
+}
+
 // WithHeaders adds headers to the execution node JSON-RPC requests.
 func WithHeaders(headers []string) Option {
 	return func(s *Service) error {
@@ -110,6 +137,15 @@ func WithFinalizedStateAtStartup(st state.BeaconState) Option {
 	}
 }
 
+// WithDepositSnapshot sets an EIP-4881 deposit snapshot to bootstrap the deposit tree from, instead of
+// processing all deposit contract logs, when the node has no deposit data yet.
+func WithDepositSnapshot(snapshot *ethpb.DepositSnapshot) Option {
+	return func(s *Service) error {
+		s.cfg.depositSnapshot = snapshot
+		return nil
+	}
+}
+
 func WithJwtId(jwtId string) Option {
 	return func(s *Service) error {
 		s.cfg.jwtId = jwtId
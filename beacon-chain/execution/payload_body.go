@@ -6,7 +6,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
@@ -15,7 +17,13 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-var errNilPayloadBody = errors.New("nil payload body for block")
+// ErrNilPayloadBody is returned when the execution client cannot supply the payload body of a blinded block, such as
+// when it has pruned the payload or was synced after the block, so that the full block cannot be reconstructed.
+var ErrNilPayloadBody = errors.New("nil payload body for block")
+
+// payloadBodyCacheSize is the number of payload bodies kept once fetched, enough for a couple of BeaconBlocksByRange
+// batches.
+const payloadBodyCacheSize = 128
 
 type blockWithHeader struct {
 	block  interfaces.ReadOnlySignedBeaconBlock
@@ -25,14 +33,51 @@ type blockWithHeader struct {
 // reconstructionBatch is a map of block hashes to block numbers.
 type reconstructionBatch map[[32]byte]uint64
 
+// payloadBodyCache keeps the most recently fetched payload bodies by block hash, so that the blinded blocks requested
+// repeatedly, such as by several peers syncing from the node, are not reconstructed from the execution client each time.
+type payloadBodyCache struct {
+	cache *lru.Cache
+}
+
+func newPayloadBodyCache(size int) *payloadBodyCache {
+	return &payloadBodyCache{cache: lruwrpr.New(size)}
+}
+
+func (c *payloadBodyCache) get(blockHash [32]byte) (*pb.ExecutionPayloadBody, bool) {
+	if c == nil {
+		return nil, false
+	}
+	v, ok := c.cache.Get(blockHash)
+	if !ok {
+		payloadBodyCacheMiss.Inc()
+		return nil, false
+	}
+	body, ok := v.(*pb.ExecutionPayloadBody)
+	if !ok {
+		return nil, false
+	}
+	payloadBodyCacheHit.Inc()
+	return body, true
+}
+
+func (c *payloadBodyCache) add(blockHash [32]byte, body *pb.ExecutionPayloadBody) {
+	if c == nil {
+		return
+	}
+	c.cache.Add(blockHash, body)
+}
+
 type blindedBlockReconstructor struct {
 	orderedBlocks []*blockWithHeader
 	bodies        map[[32]byte]*pb.ExecutionPayloadBody
 	batches       map[string]reconstructionBatch
+	cache         *payloadBodyCache
 }
 
-func reconstructBlindedBlockBatch(ctx context.Context, client RPCClient, sbb []interfaces.ReadOnlySignedBeaconBlock) ([]interfaces.SignedBeaconBlock, error) {
-	r, err := newBlindedBlockReconstructor(sbb)
+func reconstructBlindedBlockBatch(
+	ctx context.Context, client RPCClient, cache *payloadBodyCache, sbb []interfaces.ReadOnlySignedBeaconBlock,
+) ([]interfaces.SignedBeaconBlock, error) {
+	r, err := newBlindedBlockReconstructor(sbb, cache)
 	if err != nil {
 		return nil, err
 	}
@@ -42,10 +87,13 @@ func reconstructBlindedBlockBatch(ctx context.Context, client RPCClient, sbb []i
 	return r.unblinded()
 }
 
-func newBlindedBlockReconstructor(sbb []interfaces.ReadOnlySignedBeaconBlock) (*blindedBlockReconstructor, error) {
+// newBlindedBlockReconstructor batches the payload bodies to request for the blinded blocks, except the ones found in
+// the cache, which may be nil.
+func newBlindedBlockReconstructor(sbb []interfaces.ReadOnlySignedBeaconBlock, cache *payloadBodyCache) (*blindedBlockReconstructor, error) {
 	r := &blindedBlockReconstructor{
 		orderedBlocks: make([]*blockWithHeader, 0, len(sbb)),
 		bodies:        make(map[[32]byte]*pb.ExecutionPayloadBody),
+		cache:         cache,
 	}
 	for i := range sbb {
 		if err := r.addToBatch(sbb[i]); err != nil {
@@ -74,6 +122,10 @@ func (r *blindedBlockReconstructor) addToBatch(b interfaces.ReadOnlySignedBeacon
 	if blockHash == params.BeaconConfig().ZeroHash {
 		return nil
 	}
+	if body, ok := r.cache.get(blockHash); ok {
+		r.bodies[blockHash] = body
+		return nil
+	}
 
 	method := payloadBodyMethodForBlock(b)
 	if r.batches == nil {
@@ -100,6 +152,9 @@ func (r *blindedBlockReconstructor) requestBodies(ctx context.Context, client RP
 			return err
 		}
 	}
+	for h, body := range r.bodies {
+		r.cache.add(h, body)
+	}
 	return nil
 }
 
@@ -165,7 +220,7 @@ func (r *blindedBlockReconstructor) requestBodiesByRange(ctx context.Context, cl
 	}
 	for i := range result {
 		if result[i] == nil {
-			return errors.Wrapf(errNilPayloadBody, "from %s, hash=%#x", method, req.hbns[i].h)
+			return errors.Wrapf(ErrNilPayloadBody, "from %s, hash=%#x", method, req.hbns[i].h)
 		}
 		r.bodies[req.hbns[i].h] = result[i]
 	}
@@ -213,7 +268,7 @@ func (r *blindedBlockReconstructor) payloadForHeader(header interfaces.Execution
 	}
 	body, ok := r.bodies[bodyKey]
 	if !ok {
-		return nil, errors.Wrapf(errNilPayloadBody, "hash %#x", bodyKey)
+		return nil, errors.Wrapf(ErrNilPayloadBody, "hash %#x", bodyKey)
 	}
 	ed, err := fullPayloadFromPayloadBody(header, body, v)
 	if err != nil {
@@ -125,7 +125,7 @@ func TestPayloadBodiesViaUnblinder(t *testing.T) {
 			fx.denebBlock.blinded.block,
 			fx.emptyDenebBlock.blinded.block,
 		}
-		bbr, err := newBlindedBlockReconstructor(toUnblind)
+		bbr, err := newBlindedBlockReconstructor(toUnblind, nil)
 		require.NoError(t, err)
 		require.NoError(t, bbr.requestBodies(ctx, cli))
 
@@ -265,8 +265,8 @@ func TestReconstructBlindedBlockBatchFallbackToRange(t *testing.T) {
 			fx.denebBlock.blinded.block,
 			fx.emptyDenebBlock.blinded.block,
 		}
-		_, err := reconstructBlindedBlockBatch(ctx, cli, toUnblind)
-		require.ErrorIs(t, err, errNilPayloadBody)
+		_, err := reconstructBlindedBlockBatch(ctx, cli, nil, toUnblind)
+		require.ErrorIs(t, err, ErrNilPayloadBody)
 		require.Equal(t, 1, srv.callCount(GetPayloadBodiesByHashV1))
 		require.Equal(t, 1, srv.callCount(GetPayloadBodiesByRangeV1))
 	})
@@ -288,7 +288,7 @@ func TestReconstructBlindedBlockBatchFallbackToRange(t *testing.T) {
 			fx.denebBlock.blinded.block,
 			fx.emptyDenebBlock.blinded.block,
 		}
-		_, err := reconstructBlindedBlockBatch(ctx, cli, unblind)
+		_, err := reconstructBlindedBlockBatch(ctx, cli, nil, unblind)
 		require.NoError(t, err)
 	})
 	t.Run("separated by block number gap", func(t *testing.T) {
@@ -325,7 +325,7 @@ func TestReconstructBlindedBlockBatchFallbackToRange(t *testing.T) {
 			fx.emptyDenebBlock.blinded.block,
 			fx.afterSkipDeneb.blinded.block,
 		}
-		unblind, err := reconstructBlindedBlockBatch(ctx, cli, blind)
+		unblind, err := reconstructBlindedBlockBatch(ctx, cli, nil, blind)
 		require.NoError(t, err)
 		for i := range unblind {
 			testAssertReconstructedEquivalent(t, blind[i], unblind[i])
@@ -346,7 +346,7 @@ func TestReconstructBlindedBlockBatchDenebAndElectra(t *testing.T) {
 			fx.denebBlock.blinded.block,
 			fx.electra.blinded.block,
 		}
-		unblinded, err := reconstructBlindedBlockBatch(context.Background(), cli, blinded)
+		unblinded, err := reconstructBlindedBlockBatch(context.Background(), cli, nil, blinded)
 		require.NoError(t, err)
 		require.Equal(t, len(blinded), len(unblinded))
 		for i := range unblinded {
@@ -354,3 +354,77 @@ func TestReconstructBlindedBlockBatchDenebAndElectra(t *testing.T) {
 		}
 	})
 }
+
+func TestReconstructBlindedBlockBatchPartialResults(t *testing.T) {
+	defer util.HackElectraMaxuint(t)()
+	ctx := context.Background()
+	fx := testBlindedBlockFixtures(t)
+	// The execution client only has the payloads of denebBlock by hash and afterSkipDeneb by range.
+	bodiesByHash := map[[32]byte]*pb.ExecutionPayloadBody{
+		bytesutil.ToBytes32(fx.denebBlock.blinded.header.BlockHash()): payloadToBody(t, fx.denebBlock.blinded.header),
+	}
+	bodiesByNumber := map[uint64]*pb.ExecutionPayloadBody{
+		fx.afterSkipDeneb.blinded.header.BlockNumber(): payloadToBody(t, fx.afterSkipDeneb.blinded.header),
+	}
+	newPartialEngine := func(t *testing.T) (RPCClient, *mockEngine) {
+		cli, srv := newMockEngine(t)
+		srv.register(GetPayloadBodiesByHashV1, func(msg *jsonrpcMessage, w http.ResponseWriter, r *http.Request) {
+			hashes := mockParseHexByteList(t, msg.Params)
+			executionPayloadBodies := make([]*pb.ExecutionPayloadBody, len(hashes))
+			for i := range hashes {
+				executionPayloadBodies[i] = bodiesByHash[bytesutil.ToBytes32(hashes[i])]
+			}
+			mockWriteResult(t, w, msg, executionPayloadBodies)
+		})
+		srv.register(GetPayloadBodiesByRangeV1, func(msg *jsonrpcMessage, w http.ResponseWriter, r *http.Request) {
+			p := mockParseUintList(t, msg.Params)
+			require.Equal(t, 2, len(p))
+			executionPayloadBodies := make([]*pb.ExecutionPayloadBody, p[1])
+			for i := range executionPayloadBodies {
+				executionPayloadBodies[i] = bodiesByNumber[p[0]+uint64(i)]
+			}
+			mockWriteResult(t, w, msg, executionPayloadBodies)
+		})
+		return cli, srv
+	}
+
+	t.Run("missing bodies by hash are found by range and cached", func(t *testing.T) {
+		cli, srv := newPartialEngine(t)
+		cache := newPayloadBodyCache(payloadBodyCacheSize)
+		blinded := []interfaces.ReadOnlySignedBeaconBlock{
+			fx.denebBlock.blinded.block,
+			fx.afterSkipDeneb.blinded.block,
+		}
+		unblinded, err := reconstructBlindedBlockBatch(ctx, cli, cache, blinded)
+		require.NoError(t, err)
+		require.Equal(t, len(blinded), len(unblinded))
+		for i := range unblinded {
+			testAssertReconstructedEquivalent(t, blinded[i], unblinded[i])
+		}
+		require.Equal(t, 1, srv.callCount(GetPayloadBodiesByHashV1))
+		require.Equal(t, 1, srv.callCount(GetPayloadBodiesByRangeV1))
+
+		// The bodies are served from the cache when the blocks are requested again.
+		unblinded, err = reconstructBlindedBlockBatch(ctx, cli, cache, blinded)
+		require.NoError(t, err)
+		for i := range unblinded {
+			testAssertReconstructedEquivalent(t, blinded[i], unblinded[i])
+		}
+		require.Equal(t, 1, srv.callCount(GetPayloadBodiesByHashV1))
+		require.Equal(t, 1, srv.callCount(GetPayloadBodiesByRangeV1))
+	})
+	t.Run("body missing by hash and by range", func(t *testing.T) {
+		cli, srv := newPartialEngine(t)
+		cache := newPayloadBodyCache(payloadBodyCacheSize)
+		blinded := []interfaces.ReadOnlySignedBeaconBlock{
+			fx.denebBlock.blinded.block,
+			fx.emptyDenebBlock.blinded.block,
+		}
+		_, err := reconstructBlindedBlockBatch(ctx, cli, cache, blinded)
+		require.ErrorIs(t, err, ErrNilPayloadBody)
+		require.Equal(t, 1, srv.callCount(GetPayloadBodiesByHashV1))
+		require.Equal(t, 1, srv.callCount(GetPayloadBodiesByRangeV1))
+		_, ok := cache.get(bytesutil.ToBytes32(fx.emptyDenebBlock.blinded.header.BlockHash()))
+		require.Equal(t, false, ok)
+	})
+}
@@ -2,7 +2,6 @@ package execution
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"strings"
 	"time"
@@ -17,7 +16,12 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/network/authorization"
 )
 
+var errWrongExecutionChain = errors.New("execution client is on the wrong chain")
+
 func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpoint network.Endpoint) error {
+	if len(s.cfg.fallbackEndpoints) > 0 {
+		return s.setupFailoverConnections(ctx, append([]network.Endpoint{currEndpoint}, s.cfg.fallbackEndpoints...))
+	}
 	client, err := s.newRPCClientWithAuth(ctx, currEndpoint)
 	if err != nil {
 		return errors.Wrap(err, "could not dial execution node")
@@ -37,19 +41,24 @@ func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpo
 	// Ensure we have the correct chain and deposit IDs.
 	if err := ensureCorrectExecutionChain(ctx, fetcher); err != nil {
 		client.Close()
-		errStr := err.Error()
-		if strings.Contains(errStr, "401 Unauthorized") {
-			errStr = "could not verify execution chain ID as your connection is not authenticated. " +
-				"If connecting to your execution client via HTTP, you will need to set up JWT authentication. " +
-				"See our documentation here https://docs.prylabs.network/docs/execution-node/authentication"
-		}
-		return errors.Wrap(err, errStr)
+		return executionChainError(err)
 	}
 	s.updateConnectedETH1(true)
 	s.runError = nil
 	return nil
 }
 
+// executionChainError explains why the execution chain could not be verified.
+func executionChainError(err error) error {
+	errStr := err.Error()
+	if strings.Contains(errStr, "401 Unauthorized") {
+		errStr = "could not verify execution chain ID as your connection is not authenticated. " +
+			"If connecting to your execution client via HTTP, you will need to set up JWT authentication. " +
+			"See our documentation here https://docs.prylabs.network/docs/execution-node/authentication"
+	}
+	return errors.Wrap(err, errStr)
+}
+
 // Every N seconds, defined as a backoffPeriod, attempts to re-establish an execution client
 // connection and if this does not work, we fallback to the next endpoint if defined.
 func (s *Service) pollConnectionStatus(ctx context.Context) {
@@ -78,13 +87,7 @@ func (s *Service) pollConnectionStatus(ctx context.Context) {
 				currClient.Close()
 			}
 			log.WithField("endpoint", logs.MaskCredentialsLogging(s.cfg.currHttpEndpoint.Url)).Info("Connected to new endpoint")
-
-			c, err := s.ExchangeCapabilities(ctx)
-			if err != nil {
-				errorLogger(err, "Could not exchange capabilities with execution client")
-			}
-			s.capabilityCache.save(c)
-
+			s.refreshCapabilities(ctx)
 			return
 		case <-s.ctx.Done():
 			log.Debug("Received cancelled context,closing existing powchain service")
@@ -110,6 +113,7 @@ func (s *Service) retryExecutionClientConnection(ctx context.Context, err error)
 	}
 	// Reset run error in the event of a successful connection.
 	s.runError = nil
+	s.refreshCapabilities(ctx)
 }
 
 // Initializes an RPC connection with authentication headers.
@@ -145,7 +149,7 @@ func ensureCorrectExecutionChain(ctx context.Context, client *ethclient.Client)
 	}
 	wantChainID := params.BeaconConfig().DepositChainID
 	if cID.Uint64() != wantChainID {
-		return fmt.Errorf("wanted chain ID %d, got %d", wantChainID, cID.Uint64())
+		return errors.Wrapf(errWrongExecutionChain, "wanted chain ID %d, got %d", wantChainID, cID.Uint64())
 	}
 	return nil
 }
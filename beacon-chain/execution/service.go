@@ -81,6 +81,7 @@ type ChainInfoFetcher interface {
 	ExecutionClientConnected() bool
 	ExecutionClientEndpoint() string
 	ExecutionClientConnectionErr() error
+	ExecutionClientCapabilities() []string
 }
 
 // POWBlockFetcher defines a struct that can retrieve mainchain blocks.
@@ -127,9 +128,12 @@ type config struct {
 	eth1HeaderReqLimit      uint64
 	beaconNodeStatsUpdater  BeaconNodeStatsUpdater
 	currHttpEndpoint        network.Endpoint
+	fallbackEndpoints       []network.Endpoint
+	failoverTimeout         time.Duration
 	headers                 []string
 	finalizedStateAtStartup state.BeaconState
 	jwtId                   string
+	depositSnapshot         *ethpb.DepositSnapshot
 }
 
 // Service fetches important information about the canonical
@@ -139,27 +143,32 @@ type config struct {
 // Validator Registration Contract on the eth1 chain to kick off the beacon
 // chain's validator registration process.
 type Service struct {
-	connectedETH1           bool
-	isRunning               bool
-	processingLock          sync.RWMutex
-	latestEth1DataLock      sync.RWMutex
-	cfg                     *config
-	ctx                     context.Context
-	cancel                  context.CancelFunc
-	eth1HeadTicker          *time.Ticker
-	httpLogger              bind.ContractFilterer
-	rpcClient               RPCClient
-	headerCache             *headerCache // cache to store block hash/block height.
-	latestEth1Data          *ethpb.LatestETH1Data
-	depositContractCaller   *contracts.DepositContractCaller
-	depositTrie             cache.MerkleTree
-	chainStartData          *ethpb.ChainStartData
-	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
-	runError                error
-	preGenesisState         state.BeaconState
-	verifierWaiter          *verification.InitializerWaiter
-	blobVerifier            verification.NewBlobVerifier
-	capabilityCache         *capabilityCache
+	connectedETH1            bool
+	isRunning                bool
+	processingLock           sync.RWMutex
+	latestEth1DataLock       sync.RWMutex
+	cfg                      *config
+	ctx                      context.Context
+	cancel                   context.CancelFunc
+	eth1HeadTicker           *time.Ticker
+	httpLogger               bind.ContractFilterer
+	rpcClient                RPCClient
+	headerCache              *headerCache // cache to store block hash/block height.
+	latestEth1Data           *ethpb.LatestETH1Data
+	depositContractCaller    *contracts.DepositContractCaller
+	depositTrie              cache.MerkleTree
+	chainStartData           *ethpb.ChainStartData
+	lastReceivedMerkleIndex  int64                  // Keeps track of the last received index to prevent log spam.
+	processedBlockHashes     map[uint64]common.Hash // hashes of the recently processed execution blocks by height, to detect reorgs.
+	runError                 error
+	preGenesisState          state.BeaconState
+	verifierWaiter           *verification.InitializerWaiter
+	blobVerifier             verification.NewBlobVerifier
+	capabilityCache          *capabilityCache
+	payloadBodyCache         *payloadBodyCache
+	depositSnapshotBlockHash common.Hash     // execution block of the deposit snapshot whose height is still unknown
+	depositSnapshotCheck     *ethpb.Eth1Data // finalized eth1 data the deposit snapshot root is still to be checked against
+	depositSnapshotMismatch  bool            // set once the deposit snapshot was found not to match the finalized eth1 data
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -195,9 +204,11 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 			ChainstartDeposits: make([]*ethpb.Deposit, 0),
 		},
 		lastReceivedMerkleIndex: -1,
+		processedBlockHashes:    make(map[uint64]common.Hash),
 		preGenesisState:         genState,
 		eth1HeadTicker:          time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerETH1Block) * time.Second),
 		capabilityCache:         &capabilityCache{},
+		payloadBodyCache:        newPayloadBodyCache(payloadBodyCacheSize),
 	}
 
 	for _, opt := range opts {
@@ -210,6 +221,14 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to validate powchain data")
 	}
+	if s.cfg.depositSnapshot != nil {
+		bootstrapped, err := s.applyDepositSnapshot(ctx, eth1Data)
+		if err != nil {
+			log.WithError(err).Warn("Could not use deposit snapshot, deposits will be synced from the deposit contract logs")
+		} else {
+			eth1Data = bootstrapped
+		}
+	}
 	if err := s.initializeEth1Data(ctx, eth1Data); err != nil {
 		return nil, err
 	}
@@ -220,6 +239,8 @@ func NewService(ctx context.Context, opts ...Option) (*Service, error) {
 func (s *Service) Start() {
 	if err := s.setupExecutionClientConnections(s.ctx, s.cfg.currHttpEndpoint); err != nil {
 		log.WithError(err).Error("Could not connect to execution endpoint")
+	} else {
+		s.refreshCapabilities(s.ctx)
 	}
 	// If the chain has not started already and we don't have access to eth1 nodes, we will not be
 	// able to generate the genesis state.
@@ -303,6 +324,12 @@ func (s *Service) ExecutionClientConnectionErr() error {
 	return s.runError
 }
 
+// ExecutionClientCapabilities returns the engine API methods supported by the execution client, as exchanged when
+// connecting to it, or nil until capabilities are exchanged.
+func (s *Service) ExecutionClientCapabilities() []string {
+	return s.capabilityCache.list()
+}
+
 func (s *Service) updateBeaconNodeStats() {
 	bs := clientstats.BeaconNodeStats{}
 	if s.ExecutionClientConnected() {
@@ -385,10 +412,10 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositCo
 		}
 	}
 	validDepositsCount.Add(float64(currIndex))
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// Only add the deposits from the current index in state as pending. The containers
+	// of a node bootstrapped from a deposit snapshot do not start at index 0.
+	for _, c := range ctrs {
+		if uint64(c.Index) >= currIndex {
 			s.cfg.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -530,6 +557,11 @@ func (s *Service) initPOWService() {
 			s.latestEth1Data.BlockTime = header.Time
 			s.latestEth1DataLock.Unlock()
 
+			if err := s.resolveDepositSnapshotBlock(ctx); err != nil {
+				s.retryExecutionClientConnection(ctx, err)
+				errorLogger(err, "Unable to retrieve the execution block of the deposit snapshot")
+				continue
+			}
 			if err := s.processPastLogs(ctx); err != nil {
 				err = errors.Wrap(err, "processPastLogs")
 				s.retryExecutionClientConnection(ctx, err)
@@ -591,6 +623,9 @@ func (s *Service) run(done <-chan struct{}) {
 
 	chainstartTicker := time.NewTicker(logPeriod)
 	defer chainstartTicker.Stop()
+	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * uint64(params.BeaconConfig().SlotsPerEpoch)
+	capabilitiesTicker := time.NewTicker(time.Duration(secondsPerEpoch) * time.Second)
+	defer capabilitiesTicker.Stop()
 
 	for {
 		select {
@@ -616,6 +651,10 @@ func (s *Service) run(done <-chan struct{}) {
 				continue
 			}
 			s.logTillChainStart(context.Background())
+		case <-capabilitiesTicker.C:
+			if s.rpcClient != nil {
+				s.refreshCapabilities(s.ctx)
+			}
 		}
 	}
 }
@@ -745,8 +784,14 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 		return nil
 	}
 	var err error
+	bootstrapped := false
 	if eth1DataInDB.DepositSnapshot != nil {
 		s.depositTrie, err = depositsnapshot.DepositTreeFromSnapshotProto(eth1DataInDB.DepositSnapshot)
+		bootstrapped = eth1DataInDB.DepositSnapshot.DepositCount > 0 && !hasFirstDeposit(eth1DataInDB.DepositContainers)
+		if err == nil && bootstrapped && s.cfg.depositCache != nil {
+			// The node was bootstrapped from a deposit snapshot, the deposits it covers are only available from it.
+			err = s.cfg.depositCache.InitializeFromSnapshot(ctx, eth1DataInDB.DepositSnapshot)
+		}
 	} else {
 		if err = s.migrateOldDepositTree(eth1DataInDB); err != nil {
 			return err
@@ -781,6 +826,13 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 	}
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
+	if finalized := s.cfg.finalizedStateAtStartup; bootstrapped && finalized != nil && !finalized.IsNil() &&
+		uint64(numOfItems) <= finalized.Eth1Data().DepositCount {
+		s.depositSnapshotCheck = finalized.Eth1Data()
+		if err := s.verifyDepositSnapshotRoot(ctx); err != nil {
+			log.WithError(err).Error("Could not verify deposit snapshot")
+		}
+	}
 	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
@@ -788,8 +840,10 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 }
 
 // Validates that all deposit containers are valid and have their relevant indices
-// in order.
-func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
+// in order. Nodes bootstrapped from a deposit snapshot do not hold the containers
+// of the deposits covered by the snapshot, so their containers may start at any
+// index up to the deposit count of the persisted snapshot.
+func validateDepositContainers(ctrs []*ethpb.DepositContainer, snapshotDepositCount uint64) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -800,6 +854,9 @@ func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
 	startIndex := int64(0)
+	if uint64(ctrs[0].Index) <= snapshotDepositCount {
+		startIndex = ctrs[0].Index
+	}
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -824,7 +881,8 @@ func (s *Service) validPowchainData(ctx context.Context) (*ethpb.ETH1ChainData,
 	if genState == nil || genState.IsNil() {
 		return eth1Data, nil
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers) {
+	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted ||
+		!validateDepositContainers(eth1Data.DepositContainers, eth1Data.DepositSnapshot.GetDepositCount()) {
 		pbState, err := native.ProtobufBeaconStatePhase0(s.preGenesisState.ToProtoUnsafe())
 		if err != nil {
 			return nil, err
@@ -857,6 +915,15 @@ func (s *Service) validPowchainData(ctx context.Context) (*ethpb.ETH1ChainData,
 	return eth1Data, nil
 }
 
+func hasFirstDeposit(ctrs []*ethpb.DepositContainer) bool {
+	for _, c := range ctrs {
+		if c.Index == 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func dedupEndpoints(endpoints []string) []string {
 	selectionMap := make(map[string]bool)
 	newEndpoints := make([]string, 0, len(endpoints))
@@ -909,19 +976,21 @@ func newBlobVerifierFromInitializer(ini *verification.Initializer) verification.
 type capabilityCache struct {
 	capabilities     map[string]interface{}
 	capabilitiesLock sync.RWMutex
+	// warned holds the unsupported methods which were already warned about, since the capabilities were saved.
+	warned map[string]bool
 }
 
+// save replaces the capabilities with the ones exchanged with the execution client, such as after reconnecting to an
+// updated execution client.
 func (c *capabilityCache) save(cs []string) {
 	c.capabilitiesLock.Lock()
 	defer c.capabilitiesLock.Unlock()
 
-	if c.capabilities == nil {
-		c.capabilities = make(map[string]interface{})
-	}
-
+	c.capabilities = make(map[string]interface{}, len(cs))
 	for _, capability := range cs {
 		c.capabilities[capability] = struct{}{}
 	}
+	c.warned = make(map[string]bool)
 }
 
 func (c *capabilityCache) has(capability string) bool {
@@ -935,3 +1004,52 @@ func (c *capabilityCache) has(capability string) bool {
 	_, ok := c.capabilities[capability]
 	return ok
 }
+
+// supports returns whether the method is supported, assuming it is until capabilities are exchanged.
+func (c *capabilityCache) supports(method string) bool {
+	if c == nil {
+		return true
+	}
+	c.capabilitiesLock.RLock()
+	defer c.capabilitiesLock.RUnlock()
+
+	if c.capabilities == nil {
+		return true
+	}
+	_, ok := c.capabilities[method]
+	return ok
+}
+
+// warnOnce returns true the first time it is called for an unsupported method, since the capabilities were saved.
+func (c *capabilityCache) warnOnce(method string) bool {
+	c.capabilitiesLock.Lock()
+	defer c.capabilitiesLock.Unlock()
+
+	if c.warned[method] {
+		return false
+	}
+	if c.warned == nil {
+		c.warned = make(map[string]bool)
+	}
+	c.warned[method] = true
+	return true
+}
+
+// list returns the exchanged capabilities in order, or nil until capabilities are exchanged.
+func (c *capabilityCache) list() []string {
+	if c == nil {
+		return nil
+	}
+	c.capabilitiesLock.RLock()
+	defer c.capabilitiesLock.RUnlock()
+
+	if c.capabilities == nil {
+		return nil
+	}
+	cs := make([]string, 0, len(c.capabilities))
+	for capability := range c.capabilities {
+		cs = append(cs, capability)
+	}
+	sort.Strings(cs)
+	return cs
+}
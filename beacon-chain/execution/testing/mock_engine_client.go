@@ -38,6 +38,7 @@ type EngineClient struct {
 	ErrGetPayload               error
 	BlobSidecars                []blocks.VerifiedROBlob
 	ErrorBlobSidecars           error
+	ErrReconstructFullBlock     error
 }
 
 // NewPayload --
@@ -78,6 +79,9 @@ func (e *EngineClient) ExecutionBlockByHash(_ context.Context, h common.Hash, _
 func (e *EngineClient) ReconstructFullBlock(
 	_ context.Context, blindedBlock interfaces.ReadOnlySignedBeaconBlock,
 ) (interfaces.SignedBeaconBlock, error) {
+	if e.ErrReconstructFullBlock != nil {
+		return nil, e.ErrReconstructFullBlock
+	}
 	if !blindedBlock.Block().IsBlinded() {
 		return nil, errors.New("block must be blinded")
 	}
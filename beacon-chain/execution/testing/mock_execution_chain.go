@@ -37,6 +37,7 @@ type Chain struct {
 	CurrError         error
 	Endpoints         []string
 	Errors            []error
+	Capabilities      []string
 }
 
 // GenesisTime represents a static past date - JAN 01 2000.
@@ -131,6 +132,10 @@ func (m *Chain) ExecutionClientConnectionErr() error {
 	return m.CurrError
 }
 
+func (m *Chain) ExecutionClientCapabilities() []string {
+	return m.Capabilities
+}
+
 func (m *Chain) ETH1Endpoints() []string {
 	return m.Endpoints
 }
@@ -204,9 +209,10 @@ func (r *RPCClient) CallContext(ctx context.Context, obj interface{}, methodName
 			return errors.Errorf("wrong argument type provided: %T", obj)
 		}
 		*assertedObj = &types.HeaderInfo{
-			Hash:   h.Hash(),
-			Number: h.Number,
-			Time:   h.Time,
+			Hash:       h.Hash(),
+			ParentHash: h.ParentHash,
+			Number:     h.Number,
+			Time:       h.Time,
 		}
 	case "eth_getBlockByHash":
 		val, ok := args[0].(common.Hash)
@@ -222,9 +228,10 @@ func (r *RPCClient) CallContext(ctx context.Context, obj interface{}, methodName
 			return errors.Errorf("wrong argument type provided: %T", obj)
 		}
 		*assertedObj = &types.HeaderInfo{
-			Hash:   h.Hash(),
-			Number: h.Number,
-			Time:   h.Time,
+			Hash:       h.Hash(),
+			ParentHash: h.ParentHash,
+			Number:     h.Number,
+			Time:       h.Time,
 		}
 	}
 	return nil
@@ -245,7 +252,7 @@ func (r *RPCClient) BatchCall(b []rpc.BatchElem) error {
 		if err != nil {
 			return err
 		}
-		*e.Result.(*types.HeaderInfo) = types.HeaderInfo{Number: h.Number, Time: h.Time, Hash: h.Hash()}
+		*e.Result.(*types.HeaderInfo) = types.HeaderInfo{Number: h.Number, Time: h.Time, Hash: h.Hash(), ParentHash: h.ParentHash}
 	}
 	return nil
 }
@@ -12,31 +12,35 @@ import (
 
 // HeaderInfo specifies the block header information in the ETH 1.0 chain.
 type HeaderInfo struct {
-	Number *big.Int    `json:"number"`
-	Hash   common.Hash `json:"hash"`
-	Time   uint64      `json:"timestamp"`
+	Number     *big.Int    `json:"number"`
+	Hash       common.Hash `json:"hash"`
+	ParentHash common.Hash `json:"parentHash"`
+	Time       uint64      `json:"timestamp"`
 }
 
 // Copy sends out a copy of the current header info.
 func (h *HeaderInfo) Copy() *HeaderInfo {
 	return &HeaderInfo{
-		Hash:   bytesutil.ToBytes32(h.Hash[:]),
-		Number: new(big.Int).Set(h.Number),
-		Time:   h.Time,
+		Hash:       bytesutil.ToBytes32(h.Hash[:]),
+		ParentHash: bytesutil.ToBytes32(h.ParentHash[:]),
+		Number:     new(big.Int).Set(h.Number),
+		Time:       h.Time,
 	}
 }
 
 // MarshalJSON marshals as JSON.
 func (h *HeaderInfo) MarshalJSON() ([]byte, error) {
 	type HeaderInfoJson struct {
-		Number *hexutil.Big   `json:"number"`
-		Hash   common.Hash    `json:"hash"`
-		Time   hexutil.Uint64 `json:"timestamp"`
+		Number     *hexutil.Big   `json:"number"`
+		Hash       common.Hash    `json:"hash"`
+		ParentHash common.Hash    `json:"parentHash"`
+		Time       hexutil.Uint64 `json:"timestamp"`
 	}
 	var enc HeaderInfoJson
 
 	enc.Number = (*hexutil.Big)(h.Number)
 	enc.Hash = h.Hash
+	enc.ParentHash = h.ParentHash
 	enc.Time = hexutil.Uint64(h.Time)
 	return json.Marshal(enc)
 }
@@ -44,9 +48,10 @@ func (h *HeaderInfo) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON unmarshals from JSON.
 func (h *HeaderInfo) UnmarshalJSON(data []byte) error {
 	type HeaderInfoJson struct {
-		Number *hexutil.Big    `json:"number"`
-		Hash   *common.Hash    `json:"hash"`
-		Time   *hexutil.Uint64 `json:"timestamp"`
+		Number     *hexutil.Big    `json:"number"`
+		Hash       *common.Hash    `json:"hash"`
+		ParentHash *common.Hash    `json:"parentHash"`
+		Time       *hexutil.Uint64 `json:"timestamp"`
 	}
 	var dec HeaderInfoJson
 	if err := json.Unmarshal(data, &dec); err != nil {
@@ -64,5 +69,9 @@ func (h *HeaderInfo) UnmarshalJSON(data []byte) error {
 		return errors.New("missing required field 'hash'")
 	}
 	h.Hash = *dec.Hash
+	// The parent hash is not required, as headers built by hand, for example in tests, may not set it.
+	if dec.ParentHash != nil {
+		h.ParentHash = *dec.ParentHash
+	}
 	return nil
 }
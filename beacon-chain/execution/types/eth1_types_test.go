@@ -19,9 +19,10 @@ func TestRoundtrip_HeaderInfo(t *testing.T) {
 		{
 			name: "normal header object",
 			hInfo: HeaderInfo{
-				Number: big.NewInt(1000),
-				Hash:   common.Hash{239, 10, 13, 71, 156, 192, 23, 93, 73, 154, 255, 209, 163, 204, 129, 12, 179, 183, 65, 70, 205, 200, 57, 12, 17, 211, 209, 4, 104, 133, 73, 86},
-				Time:   1000,
+				Number:     big.NewInt(1000),
+				Hash:       common.Hash{239, 10, 13, 71, 156, 192, 23, 93, 73, 154, 255, 209, 163, 204, 129, 12, 179, 183, 65, 70, 205, 200, 57, 12, 17, 211, 209, 4, 104, 133, 73, 86},
+				ParentHash: common.Hash{192, 19, 18, 71, 156, 239, 23, 93, 73, 17, 255, 209, 163, 204, 129, 12, 179, 129, 65, 70, 209, 200, 57, 12, 17, 211, 209, 4, 104, 57, 73, 86},
+				Time:       1000,
 			},
 			wantErr: false,
 		},
@@ -46,9 +47,10 @@ func TestRoundtrip_HeaderInfo(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			h := &HeaderInfo{
-				Number: tt.hInfo.Number,
-				Hash:   tt.hInfo.Hash,
-				Time:   tt.hInfo.Time,
+				Number:     tt.hInfo.Number,
+				Hash:       tt.hInfo.Hash,
+				ParentHash: tt.hInfo.ParentHash,
+				Time:       tt.hInfo.Time,
 			}
 			recv, err := h.MarshalJSON()
 			assert.NoError(t, err)
@@ -537,8 +537,9 @@ func (f *ForkChoice) UnrealizedJustifiedPayloadBlockHash() [32]byte {
 	return node.payloadHash
 }
 
-// ForkChoiceDump returns a full dump of forkchoice.
-func (f *ForkChoice) ForkChoiceDump(ctx context.Context) (*forkchoice2.Dump, error) {
+// ForkChoiceDump returns a dump of forkchoice with the nodes selected by the filter, parents before their children.
+// It returns ErrUnknownDescendantRoot when the root the nodes must descend from is not in forkchoice.
+func (f *ForkChoice) ForkChoiceDump(ctx context.Context, filter *forkchoice2.DumpFilter) (*forkchoice2.Dump, error) {
 	jc := &ethpb.Checkpoint{
 		Epoch: f.store.justifiedCheckpoint.Epoch,
 		Root:  f.store.justifiedCheckpoint.Root[:],
@@ -555,17 +556,11 @@ func (f *ForkChoice) ForkChoiceDump(ctx context.Context) (*forkchoice2.Dump, err
 		Epoch: f.store.unrealizedFinalizedCheckpoint.Epoch,
 		Root:  f.store.unrealizedFinalizedCheckpoint.Root[:],
 	}
-	nodes := make([]*forkchoice2.Node, 0, f.NodeCount())
-	var err error
-	if f.store.treeRootNode != nil {
-		nodes, err = f.store.treeRootNode.nodeTreeDump(ctx, nodes)
-		if err != nil {
-			return nil, err
-		}
-	}
 	var headRoot [32]byte
+	var headSlot primitives.Slot
 	if f.store.headNode != nil {
 		headRoot = f.store.headNode.root
+		headSlot = f.store.headNode.slot
 	}
 	resp := &forkchoice2.Dump{
 		JustifiedCheckpoint:           jc,
@@ -575,11 +570,72 @@ func (f *ForkChoice) ForkChoiceDump(ctx context.Context) (*forkchoice2.Dump, err
 		ProposerBoostRoot:             f.store.proposerBoostRoot[:],
 		PreviousProposerBoostRoot:     f.store.previousProposerBoostRoot[:],
 		HeadRoot:                      headRoot[:],
-		ForkChoiceNodes:               nodes,
+		HeadSlot:                      headSlot,
+		NodeCount:                     f.NodeCount(),
+	}
+	if filter == nil {
+		filter = &forkchoice2.DumpFilter{}
+	}
+	root := f.store.treeRootNode
+	if filter.DescendantOf != [32]byte{} {
+		var ok bool
+		root, ok = f.store.nodeByRoot[filter.DescendantOf]
+		if !ok || root == nil {
+			return nil, errors.Wrapf(forkchoice2.ErrUnknownDescendantRoot, "%#x", filter.DescendantOf)
+		}
+	}
+	if !filter.SummaryOnly {
+		resp.ForkChoiceNodes = make([]*forkchoice2.Node, 0)
+	}
+	if root == nil {
+		return resp, nil
+	}
+	// The nodes are visited in place rather than dumped then filtered, as forkchoice can hold many nodes during
+	// periods of non-finality.
+	if err := root.walk(ctx, filter.EndSlot, func(n *Node) {
+		if n.slot < filter.StartSlot {
+			return
+		}
+		resp.MatchingNodeCount++
+		if len(n.children) == 0 {
+			resp.MatchingLeafCount++
+		}
+		if !filter.SummaryOnly {
+			resp.ForkChoiceNodes = append(resp.ForkChoiceNodes, n.dump())
+		}
+	}); err != nil {
+		return nil, err
 	}
 	return resp, nil
 }
 
+// Snapshot returns a snapshot of all the nodes of forkchoice, parents before their children.
+// The caller must hold the forkchoice read lock, so that the snapshot is consistent.
+func (f *ForkChoice) Snapshot(ctx context.Context) (*forkchoice2.Snapshot, error) {
+	snapshot := &forkchoice2.Snapshot{
+		JustifiedCheckpoint: &ethpb.Checkpoint{
+			Epoch: f.store.justifiedCheckpoint.Epoch,
+			Root:  bytesutil.SafeCopyBytes(f.store.justifiedCheckpoint.Root[:]),
+		},
+		FinalizedCheckpoint: &ethpb.Checkpoint{
+			Epoch: f.store.finalizedCheckpoint.Epoch,
+			Root:  bytesutil.SafeCopyBytes(f.store.finalizedCheckpoint.Root[:]),
+		},
+		Nodes: make([]*forkchoice2.NodeSnapshot, 0, f.NodeCount()),
+	}
+	if f.store.headNode != nil {
+		snapshot.HeadRoot = f.store.headNode.root
+	}
+	if f.store.treeRootNode != nil {
+		var err error
+		snapshot.Nodes, err = f.store.treeRootNode.nodeTreeSnapshot(ctx, snapshot.Nodes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
 // SetBalancesByRooter sets the balanceByRoot handler in forkchoice
 func (f *ForkChoice) SetBalancesByRooter(handler forkchoice.BalancesByRooter) {
 	f.balancesByRoot = handler
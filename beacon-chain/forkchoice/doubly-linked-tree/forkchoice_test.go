@@ -13,6 +13,7 @@ import (
 	state_native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	forkchoice2 "github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
 	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
@@ -924,3 +925,102 @@ func TestForkChoice_CleanupInserting(t *testing.T) {
 	require.NotNil(t, f.InsertNode(ctx, st, roblock))
 	require.Equal(t, false, f.HasNode(roblock.Root()))
 }
+
+func TestForkChoice_Snapshot(t *testing.T) {
+	f := setup(0, 0)
+	ctx := context.Background()
+	st, roblock, err := prepareForkchoiceState(ctx, 1, [32]byte{'1'}, params.BeaconConfig().ZeroHash, params.BeaconConfig().ZeroHash, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, roblock))
+	st, roblock, err = prepareForkchoiceState(ctx, 2, [32]byte{'2'}, [32]byte{'1'}, params.BeaconConfig().ZeroHash, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, roblock))
+	st, roblock, err = prepareForkchoiceState(ctx, 3, [32]byte{'3'}, [32]byte{'1'}, params.BeaconConfig().ZeroHash, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.InsertNode(ctx, st, roblock))
+
+	f.store.nodeByRoot[[32]byte{'3'}].balance = 10
+	require.NoError(t, f.store.treeRootNode.applyWeightChanges(ctx))
+	require.NoError(t, f.store.treeRootNode.updateBestDescendant(ctx, 0, 0, 0))
+	_, err = f.store.head(ctx)
+	require.NoError(t, err)
+	require.NoError(t, f.SetOptimisticToValid(ctx, [32]byte{'1'}))
+
+	f.RLock()
+	snapshot, err := f.Snapshot(ctx)
+	f.RUnlock()
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{'3'}, snapshot.HeadRoot)
+	require.Equal(t, 4, len(snapshot.Nodes))
+	// Parents come before their children.
+	assert.Equal(t, params.BeaconConfig().ZeroHash, snapshot.Nodes[0].Root)
+	assert.Equal(t, [32]byte{'1'}, snapshot.Nodes[1].Root)
+
+	nodes := make(map[[32]byte]*forkchoice2.NodeSnapshot)
+	for _, n := range snapshot.Nodes {
+		nodes[n.Root] = n
+	}
+	n := nodes[[32]byte{'1'}]
+	assert.Equal(t, params.BeaconConfig().ZeroHash, n.ParentRoot)
+	assert.Equal(t, primitives.Slot(1), n.Slot)
+	assert.Equal(t, uint64(10), n.Weight)
+	assert.Equal(t, forkchoice2.Valid, n.Validity)
+	assert.Equal(t, [32]byte{'3'}, n.BestChild)
+	assert.Equal(t, [32]byte{'3'}, n.BestDescendant)
+
+	n = nodes[[32]byte{'2'}]
+	assert.Equal(t, [32]byte{'1'}, n.ParentRoot)
+	assert.Equal(t, uint64(0), n.Weight)
+	assert.Equal(t, forkchoice2.Optimistic, n.Validity)
+	assert.Equal(t, [32]byte{}, n.BestChild)
+	assert.Equal(t, [32]byte{}, n.BestDescendant)
+
+	// The root node leads to the head through its child.
+	assert.Equal(t, [32]byte{'1'}, nodes[params.BeaconConfig().ZeroHash].BestChild)
+	assert.Equal(t, [32]byte{'3'}, nodes[params.BeaconConfig().ZeroHash].BestDescendant)
+}
+
+func TestForkChoice_ForkChoiceDump_Filter(t *testing.T) {
+	f := setup(0, 0)
+	ctx := context.Background()
+	// 0 <- 1 <- 2 <- 4
+	//        \- 3
+	for _, n := range []struct {
+		slot         primitives.Slot
+		root, parent [32]byte
+	}{
+		{1, [32]byte{'1'}, params.BeaconConfig().ZeroHash},
+		{2, [32]byte{'2'}, [32]byte{'1'}},
+		{3, [32]byte{'3'}, [32]byte{'1'}},
+		{4, [32]byte{'4'}, [32]byte{'2'}},
+	} {
+		st, roblock, err := prepareForkchoiceState(ctx, n.slot, n.root, n.parent, params.BeaconConfig().ZeroHash, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, f.InsertNode(ctx, st, roblock))
+	}
+
+	dump, err := f.ForkChoiceDump(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 5, dump.NodeCount)
+	assert.Equal(t, 5, dump.MatchingNodeCount)
+	assert.Equal(t, 2, dump.MatchingLeafCount)
+	require.Equal(t, 5, len(dump.ForkChoiceNodes))
+
+	dump, err = f.ForkChoiceDump(ctx, &forkchoice2.DumpFilter{StartSlot: 2, EndSlot: 3})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(dump.ForkChoiceNodes))
+	assert.DeepEqual(t, []byte{'2'}, dump.ForkChoiceNodes[0].BlockRoot[:1])
+	assert.DeepEqual(t, []byte{'3'}, dump.ForkChoiceNodes[1].BlockRoot[:1])
+	// The leaves are the nodes without children in forkchoice, regardless of the slot range.
+	assert.Equal(t, 1, dump.MatchingLeafCount)
+
+	dump, err = f.ForkChoiceDump(ctx, &forkchoice2.DumpFilter{DescendantOf: [32]byte{'2'}, SummaryOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(dump.ForkChoiceNodes))
+	assert.Equal(t, 5, dump.NodeCount)
+	assert.Equal(t, 2, dump.MatchingNodeCount)
+	assert.Equal(t, 1, dump.MatchingLeafCount)
+
+	_, err = f.ForkChoiceDump(ctx, &forkchoice2.DumpFilter{DescendantOf: [32]byte{'z'}})
+	require.ErrorIs(t, err, forkchoice2.ErrUnknownDescendantRoot)
+}
@@ -147,11 +147,26 @@ func (n *Node) arrivedAfterOrphanCheck(genesisTime uint64) (bool, error) {
 	return secs >= ProcessAttestationsThreshold, err
 }
 
-// nodeTreeDump appends to the given list all the nodes descending from this one
-func (n *Node) nodeTreeDump(ctx context.Context, nodes []*forkchoice2.Node) ([]*forkchoice2.Node, error) {
+// walk calls fn on this node and its descendants, parents before their children. Unless endSlot is 0, the nodes
+// after endSlot are skipped along with their descendants, which are after endSlot too.
+func (n *Node) walk(ctx context.Context, endSlot primitives.Slot, fn func(*Node)) error {
 	if ctx.Err() != nil {
-		return nil, ctx.Err()
+		return ctx.Err()
 	}
+	if endSlot != 0 && n.slot > endSlot {
+		return nil
+	}
+	fn(n)
+	for _, child := range n.children {
+		if err := child.walk(ctx, endSlot, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dump returns the description of this node in a forkchoice dump.
+func (n *Node) dump() *forkchoice2.Node {
 	var parentRoot [32]byte
 	if n.parent != nil {
 		parentRoot = n.parent.root
@@ -175,11 +190,53 @@ func (n *Node) nodeTreeDump(ctx context.Context, nodes []*forkchoice2.Node) ([]*
 	} else {
 		thisNode.Validity = forkchoice2.Valid
 	}
+	return thisNode
+}
+
+// bestChild returns the child of this node leading to its best descendant, or nil if the node has no best descendant.
+func (n *Node) bestChild() *Node {
+	if n.bestDescendant == nil {
+		return nil
+	}
+	for _, child := range n.children {
+		if child == n.bestDescendant || child.bestDescendant == n.bestDescendant {
+			return child
+		}
+	}
+	return nil
+}
+
+// nodeTreeSnapshot appends to the given list the snapshot of all the nodes descending from this one.
+func (n *Node) nodeTreeSnapshot(ctx context.Context, nodes []*forkchoice2.NodeSnapshot) ([]*forkchoice2.NodeSnapshot, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	thisNode := &forkchoice2.NodeSnapshot{
+		Root:           n.root,
+		Slot:           n.slot,
+		Weight:         n.weight,
+		Validity:       forkchoice2.Valid,
+		JustifiedEpoch: n.justifiedEpoch,
+		FinalizedEpoch: n.finalizedEpoch,
+	}
+	if n.parent != nil {
+		thisNode.ParentRoot = n.parent.root
+	}
+	// Nodes with an invalid payload are removed from the store, so stored nodes are either valid or optimistic.
+	if n.optimistic {
+		thisNode.Validity = forkchoice2.Optimistic
+	}
+	if child := n.bestChild(); child != nil {
+		thisNode.BestChild = child.root
+	}
+	if n.bestDescendant != nil {
+		thisNode.BestDescendant = n.bestDescendant.root
+	}
 
 	nodes = append(nodes, thisNode)
 	var err error
 	for _, child := range n.children {
-		nodes, err = child.nodeTreeDump(ctx, nodes)
+		nodes, err = child.nodeTreeSnapshot(ctx, nodes)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,131 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	forkchoice2 "github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+var errStoreNotEmpty = errors.New("fork choice store is not empty")
+
+// Restore rebuilds the nodes and the checkpoints of a fork choice dump in an empty store, so that fork choice can be
+// replayed offline from the state of a node. The nodes of the dump must list every parent before its children, as
+// ForkChoiceDump does, the first node being the root of the tree.
+//
+// The dump does not hold the votes of the validators, only the balance voting for each node, so the restored nodes
+// have no balance and no weight: the caller is expected to replay the votes with ProcessAttestation, and the
+// balances with SetBalancesByRooter. The proposer boost is not restored either, see SetProposerBoostRoot.
+func (f *ForkChoice) Restore(ctx context.Context, dump *forkchoice2.Dump) error {
+	if f.store.treeRootNode != nil {
+		return errStoreNotEmpty
+	}
+	if dump == nil || len(dump.ForkChoiceNodes) == 0 {
+		return errors.New("no node in fork choice dump")
+	}
+	for _, cp := range []*ethpb.Checkpoint{
+		dump.JustifiedCheckpoint,
+		dump.FinalizedCheckpoint,
+		dump.UnrealizedJustifiedCheckpoint,
+		dump.UnrealizedFinalizedCheckpoint,
+	} {
+		if cp == nil {
+			return errInvalidNilCheckpoint
+		}
+	}
+	for _, n := range dump.ForkChoiceNodes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := f.RestoreNode(n); err != nil {
+			return err
+		}
+	}
+	s := f.store
+	s.justifiedCheckpoint = checkpointFromDump(dump.JustifiedCheckpoint)
+	s.prevJustifiedCheckpoint = s.justifiedCheckpoint
+	s.finalizedCheckpoint = checkpointFromDump(dump.FinalizedCheckpoint)
+	s.unrealizedJustifiedCheckpoint = checkpointFromDump(dump.UnrealizedJustifiedCheckpoint)
+	s.unrealizedFinalizedCheckpoint = checkpointFromDump(dump.UnrealizedFinalizedCheckpoint)
+	s.originRoot = s.treeRootNode.root
+	if head, ok := s.nodeByRoot[bytesutil.ToBytes32(dump.HeadRoot)]; ok {
+		s.headNode = head
+	}
+	return nil
+}
+
+// RestoreNode inserts a node described as in a fork choice dump, whose parent must already be in the store unless
+// the store is empty. Unlike InsertNode, it does not need the state of the block: the checkpoints of the node are
+// taken from the dump, and no proposer boost is applied. The balance and weight of the dump are ignored.
+func (f *ForkChoice) RestoreNode(dn *forkchoice2.Node) error {
+	if dn == nil {
+		return ErrNilNode
+	}
+	s := f.store
+	root := bytesutil.ToBytes32(dn.BlockRoot)
+	if _, ok := s.nodeByRoot[root]; ok {
+		return fmt.Errorf("node %#x is already in the store", root)
+	}
+	n := &Node{
+		slot:                     dn.Slot,
+		root:                     root,
+		payloadHash:              bytesutil.ToBytes32(dn.ExecutionBlockHash),
+		justifiedEpoch:           dn.JustifiedEpoch,
+		unrealizedJustifiedEpoch: dn.UnrealizedJustifiedEpoch,
+		finalizedEpoch:           dn.FinalizedEpoch,
+		unrealizedFinalizedEpoch: dn.UnrealizedFinalizedEpoch,
+		optimistic:               dn.Validity != forkchoice2.Valid,
+		timestamp:                dn.Timestamp,
+	}
+	if s.treeRootNode == nil {
+		s.treeRootNode = n
+		s.headNode = n
+		s.highestReceivedNode = n
+	} else {
+		parent, ok := s.nodeByRoot[bytesutil.ToBytes32(dn.ParentRoot)]
+		if !ok {
+			return errors.Wrapf(errInvalidParentRoot, "parent %#x of node %#x is not in the store", dn.ParentRoot, root)
+		}
+		n.parent = parent
+		parent.children = append(parent.children, n)
+		if n.slot > s.highestReceivedNode.slot {
+			s.highestReceivedNode = n
+		}
+	}
+	// Set the node's target checkpoint, as insert does.
+	if n.slot%params.BeaconConfig().SlotsPerEpoch == 0 {
+		n.target = n
+	} else if n.parent != nil {
+		if slots.ToEpoch(n.slot) == slots.ToEpoch(n.parent.slot) {
+			n.target = n.parent.target
+		} else {
+			n.target = n.parent
+		}
+	}
+	s.nodeByRoot[root] = n
+	s.nodeByPayload[n.payloadHash] = n
+	nodeCount.Set(float64(len(s.nodeByRoot)))
+	return nil
+}
+
+// SetProposerBoostRoot sets the block boosted by the next head computation, or removes the boost when the root is the
+// zero hash. Fork choice boosts the timely blocks it inserts on its own: this is meant to replay fork choice offline.
+func (f *ForkChoice) SetProposerBoostRoot(root [32]byte) error {
+	if root != params.BeaconConfig().ZeroHash {
+		if _, ok := f.store.nodeByRoot[root]; !ok {
+			return errors.Wrapf(errInvalidProposerBoostRoot, "%#x is not in the store", root)
+		}
+	}
+	f.store.proposerBoostRoot = root
+	return nil
+}
+
+func checkpointFromDump(cp *ethpb.Checkpoint) *forkchoicetypes.Checkpoint {
+	return &forkchoicetypes.Checkpoint{Epoch: cp.Epoch, Root: bytesutil.ToBytes32(cp.Root)}
+}
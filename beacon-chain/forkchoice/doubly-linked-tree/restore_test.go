@@ -0,0 +1,101 @@
+package doublylinkedtree
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	forkchoice2 "github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestForkChoice_Restore(t *testing.T) {
+	ctx := context.Background()
+	f := setup(0, 0)
+	for _, n := range []struct {
+		slot         primitives.Slot
+		root, parent [32]byte
+	}{
+		{1, indexToHash(1), params.BeaconConfig().ZeroHash},
+		{2, indexToHash(2), indexToHash(1)},
+		{3, indexToHash(3), indexToHash(1)},
+	} {
+		st, blk, err := prepareForkchoiceState(ctx, n.slot, n.root, n.parent, params.BeaconConfig().ZeroHash, 0, 0)
+		require.NoError(t, err)
+		require.NoError(t, f.InsertNode(ctx, st, blk))
+	}
+	f.justifiedBalances = []uint64{32_500_000_000, 33_000_000_000}
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(2), 0)
+	f.ProcessAttestation(ctx, []uint64{1}, indexToHash(3), 0)
+	head, err := f.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, indexToHash(3), head)
+	dump, err := f.ForkChoiceDump(ctx, nil)
+	require.NoError(t, err)
+
+	r := New()
+	require.NoError(t, r.Restore(ctx, dump))
+	assert.Equal(t, f.NodeCount(), r.NodeCount())
+	assert.DeepEqual(t, f.JustifiedCheckpoint(), r.JustifiedCheckpoint())
+	assert.DeepEqual(t, f.FinalizedCheckpoint(), r.FinalizedCheckpoint())
+	assert.Equal(t, indexToHash(3), r.CachedHeadRoot())
+	restored, err := r.ForkChoiceDump(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, len(dump.ForkChoiceNodes), len(restored.ForkChoiceNodes))
+	for i, n := range dump.ForkChoiceNodes {
+		// Only the votes are not restored.
+		want := *n
+		want.Balance, want.Weight = 0, 0
+		assert.DeepEqual(t, &want, restored.ForkChoiceNodes[i])
+	}
+
+	// Replaying the votes gives the same weights and head.
+	r.SetBalancesByRooter(func(context.Context, [32]byte) ([]uint64, error) {
+		return []uint64{32_500_000_000, 33_000_000_000}, nil
+	})
+	require.NoError(t, r.UpdateJustifiedCheckpoint(ctx, r.JustifiedCheckpoint()))
+	r.ProcessAttestation(ctx, []uint64{0}, indexToHash(2), 0)
+	r.ProcessAttestation(ctx, []uint64{1}, indexToHash(3), 0)
+	head, err = r.Head(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), head)
+	for _, root := range [][32]byte{indexToHash(1), indexToHash(2), indexToHash(3)} {
+		want, err := f.Weight(root)
+		require.NoError(t, err)
+		got, err := r.Weight(root)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	// The proposer boost is enough to switch the head.
+	require.ErrorIs(t, r.SetProposerBoostRoot(indexToHash(9)), errInvalidProposerBoostRoot)
+	require.NoError(t, r.SetProposerBoostRoot(indexToHash(2)))
+	head, err = r.Head(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), head)
+	require.NoError(t, r.SetProposerBoostRoot(params.BeaconConfig().ZeroHash))
+	head, err = r.Head(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(3), head)
+}
+
+func TestForkChoice_Restore_Errors(t *testing.T) {
+	ctx := context.Background()
+	f := setup(0, 0)
+	dump, err := f.ForkChoiceDump(ctx, nil)
+	require.NoError(t, err)
+	require.ErrorIs(t, f.Restore(ctx, dump), errStoreNotEmpty)
+
+	r := New()
+	require.ErrorContains(t, "no node", r.Restore(ctx, &forkchoice2.Dump{}))
+	dump.FinalizedCheckpoint = nil
+	require.ErrorIs(t, r.Restore(ctx, dump), errInvalidNilCheckpoint)
+
+	root1, root2, root3 := indexToHash(1), indexToHash(2), indexToHash(3)
+	require.NoError(t, r.RestoreNode(&forkchoice2.Node{Slot: 1, BlockRoot: root1[:]}))
+	err = r.RestoreNode(&forkchoice2.Node{Slot: 2, BlockRoot: root2[:], ParentRoot: root3[:]})
+	require.ErrorIs(t, err, errInvalidParentRoot)
+	require.ErrorContains(t, "already in the store", r.RestoreNode(&forkchoice2.Node{Slot: 1, BlockRoot: root1[:]}))
+}
@@ -56,7 +56,8 @@ type Getter interface {
 	FastGetter
 	AncestorRoot(ctx context.Context, root [32]byte, slot primitives.Slot) ([32]byte, error)
 	CommonAncestor(ctx context.Context, root1 [32]byte, root2 [32]byte) ([32]byte, primitives.Slot, error)
-	ForkChoiceDump(context.Context) (*forkchoice2.Dump, error)
+	ForkChoiceDump(context.Context, *forkchoice2.DumpFilter) (*forkchoice2.Dump, error)
+	Snapshot(context.Context) (*forkchoice2.Snapshot, error)
 	Tips() ([][32]byte, []primitives.Slot)
 }
 
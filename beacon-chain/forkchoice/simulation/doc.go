@@ -0,0 +1,27 @@
+/*
+Package simulation replays fork choice offline, from the dump of the fork choice store of a node served by
+/eth/v1/debug/fork_choice, in order to debug fork choice incidents. A scenario applies hypothetical blocks,
+attestations, proposer boosts and justified checkpoints to the restored store, and the head is computed after each of
+its steps.
+
+The dump is mapped to the store as follows:
+
+  - fork_choice_nodes: one node each, with the same slot, roots, checkpoint epochs, execution block hash and
+    timestamp. A node is optimistic unless its validity is "valid". The weights are not restored: they are computed
+    again from the votes.
+  - extra_data.balance of a node: the balance of the validators voting for the node. The dump does not hold the
+    votes of the validators, so each node with a balance gets a single synthetic validator voting for it with this
+    balance, and the justified balances are the balances of the synthetic validators.
+  - justified_checkpoint, finalized_checkpoint and the unrealized checkpoints of extra_data: the checkpoints of the
+    store.
+  - extra_data.previous_proposer_boost_root: the balance of this node includes the proposer boost score applied by the
+    last head computation, which is removed from the balance of its synthetic validator. The score is not in the dump:
+    it is computed from the total active balance of the scenario, which defaults to the balance of all the synthetic
+    validators, as if every active validator had voted.
+  - extra_data.proposer_boost_root: the block boosted by the next head computation.
+  - extra_data.head_root: the head of the store until the head is computed again.
+
+The clock of the store is set to the slot of the scenario, which defaults to the highest slot of the dump, so that
+the viability of the nodes is the same as when the dump was taken.
+*/
+package simulation
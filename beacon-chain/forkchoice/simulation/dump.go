@@ -0,0 +1,153 @@
+package simulation
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	forkchoice2 "github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+// DumpFromJSON parses a fork choice dump, as served by /eth/v1/debug/fork_choice.
+func DumpFromJSON(data []byte) (*forkchoice2.Dump, error) {
+	var resp structs.GetForkChoiceDumpResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal fork choice dump")
+	}
+	if resp.JustifiedCheckpoint == nil || resp.FinalizedCheckpoint == nil {
+		return nil, errors.New("fork choice dump has no justified or finalized checkpoint")
+	}
+	jc, err := resp.JustifiedCheckpoint.ToConsensus()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid justified checkpoint")
+	}
+	fc, err := resp.FinalizedCheckpoint.ToConsensus()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid finalized checkpoint")
+	}
+	dump := &forkchoice2.Dump{
+		JustifiedCheckpoint:           jc,
+		FinalizedCheckpoint:           fc,
+		UnrealizedJustifiedCheckpoint: jc,
+		UnrealizedFinalizedCheckpoint: fc,
+		ForkChoiceNodes:               make([]*forkchoice2.Node, len(resp.ForkChoiceNodes)),
+	}
+	if extra := resp.ExtraData; extra != nil {
+		if extra.UnrealizedJustifiedCheckpoint != nil {
+			if dump.UnrealizedJustifiedCheckpoint, err = extra.UnrealizedJustifiedCheckpoint.ToConsensus(); err != nil {
+				return nil, errors.Wrap(err, "invalid unrealized justified checkpoint")
+			}
+		}
+		if extra.UnrealizedFinalizedCheckpoint != nil {
+			if dump.UnrealizedFinalizedCheckpoint, err = extra.UnrealizedFinalizedCheckpoint.ToConsensus(); err != nil {
+				return nil, errors.Wrap(err, "invalid unrealized finalized checkpoint")
+			}
+		}
+		if dump.ProposerBoostRoot, err = decodeRoot(extra.ProposerBoostRoot); err != nil {
+			return nil, errors.Wrap(err, "invalid proposer boost root")
+		}
+		if dump.PreviousProposerBoostRoot, err = decodeRoot(extra.PreviousProposerBoostRoot); err != nil {
+			return nil, errors.Wrap(err, "invalid previous proposer boost root")
+		}
+		if dump.HeadRoot, err = decodeRoot(extra.HeadRoot); err != nil {
+			return nil, errors.Wrap(err, "invalid head root")
+		}
+	}
+	for i, n := range resp.ForkChoiceNodes {
+		if n == nil {
+			return nil, errors.Errorf("fork choice node %d is null", i)
+		}
+		if dump.ForkChoiceNodes[i], err = nodeFromJSON(n); err != nil {
+			return nil, errors.Wrapf(err, "invalid fork choice node %d", i)
+		}
+	}
+	return dump, nil
+}
+
+func nodeFromJSON(n *structs.ForkChoiceNode) (*forkchoice2.Node, error) {
+	slot, err := strconv.ParseUint(n.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid slot")
+	}
+	blockRoot, err := bytesutil.DecodeHexWithLength(n.BlockRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid block root")
+	}
+	parentRoot, err := bytesutil.DecodeHexWithLength(n.ParentRoot, fieldparams.RootLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid parent root")
+	}
+	justifiedEpoch, err := strconv.ParseUint(n.JustifiedEpoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid justified epoch")
+	}
+	finalizedEpoch, err := strconv.ParseUint(n.FinalizedEpoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid finalized epoch")
+	}
+	weight, err := strconv.ParseUint(n.Weight, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid weight")
+	}
+	payloadHash, err := decodeRoot(n.ExecutionBlockHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid execution block hash")
+	}
+	node := &forkchoice2.Node{
+		Slot:                     primitives.Slot(slot),
+		BlockRoot:                blockRoot,
+		ParentRoot:               parentRoot,
+		JustifiedEpoch:           primitives.Epoch(justifiedEpoch),
+		FinalizedEpoch:           primitives.Epoch(finalizedEpoch),
+		UnrealizedJustifiedEpoch: primitives.Epoch(justifiedEpoch),
+		UnrealizedFinalizedEpoch: primitives.Epoch(finalizedEpoch),
+		Weight:                   weight,
+		ExecutionBlockHash:       payloadHash,
+	}
+	switch n.Validity {
+	case forkchoice2.Valid.String():
+		node.Validity = forkchoice2.Valid
+	case forkchoice2.Invalid.String():
+		node.Validity = forkchoice2.Invalid
+	case forkchoice2.Optimistic.String():
+		node.Validity = forkchoice2.Optimistic
+	default:
+		return nil, errors.Errorf("invalid validity %q", n.Validity)
+	}
+	if extra := n.ExtraData; extra != nil {
+		uj, err := strconv.ParseUint(extra.UnrealizedJustifiedEpoch, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid unrealized justified epoch")
+		}
+		uf, err := strconv.ParseUint(extra.UnrealizedFinalizedEpoch, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid unrealized finalized epoch")
+		}
+		balance, err := strconv.ParseUint(extra.Balance, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid balance")
+		}
+		timestamp, err := strconv.ParseUint(extra.TimeStamp, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid timestamp")
+		}
+		node.UnrealizedJustifiedEpoch = primitives.Epoch(uj)
+		node.UnrealizedFinalizedEpoch = primitives.Epoch(uf)
+		node.Balance = balance
+		node.Timestamp = timestamp
+		node.ExecutionOptimistic = extra.ExecutionOptimistic
+	}
+	return node, nil
+}
+
+// decodeRoot decodes an optional root, which is the zero hash when empty.
+func decodeRoot(s string) ([]byte, error) {
+	if s == "" || s == "0x" {
+		return make([]byte, fieldparams.RootLength), nil
+	}
+	return bytesutil.DecodeHexWithLength(s, fieldparams.RootLength)
+}
@@ -0,0 +1,145 @@
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+// Scenario is a sequence of hypothetical inputs applied to a restored fork choice store.
+//
+// Example:
+//
+//	slot: 3201
+//	steps:
+//	  - proposer_boost:
+//	      root: ""
+//	  - block:
+//	      slot: 3202
+//	      root: "0x5a..."
+//	      parent_root: "0x3c..."
+//	      balance: 100000000000000
+//	  - attestations:
+//	      root: "0x5a..."
+//	      from: "0x3c..."
+//	      balance: 300000000000000
+//	  - justified_checkpoint:
+//	      epoch: 100
+//	      root: "0x3c..."
+//	  - slot: 3232
+type Scenario struct {
+	// Slot is the current slot of the store, which defaults to the highest slot of the dump.
+	Slot *primitives.Slot `json:"slot,omitempty"`
+	// TotalActiveBalance is the total balance of the active validators, in Gwei, from which the proposer boost score
+	// is computed. It defaults to the balance voting for the nodes of the dump.
+	TotalActiveBalance *uint64 `json:"total_active_balance,omitempty"`
+	Steps              []*Step `json:"steps"`
+}
+
+// Step is one input of a scenario. Exactly one of its fields must be set.
+type Step struct {
+	// Block inserts a block.
+	Block *Block `json:"block,omitempty"`
+	// Attestations makes validators vote for a block.
+	Attestations *Attestations `json:"attestations,omitempty"`
+	// ProposerBoost sets the block boosted by the next head computation.
+	ProposerBoost *ProposerBoost `json:"proposer_boost,omitempty"`
+	// JustifiedCheckpoint updates the justified checkpoint of the store.
+	JustifiedCheckpoint *Checkpoint `json:"justified_checkpoint,omitempty"`
+	// Slot moves the clock of the store to the slot.
+	Slot *primitives.Slot `json:"slot,omitempty"`
+}
+
+// Block is a block inserted in the store. Its checkpoint epochs default to those of its parent.
+type Block struct {
+	Slot           primitives.Slot   `json:"slot"`
+	Root           string            `json:"root"`
+	ParentRoot     string            `json:"parent_root"`
+	JustifiedEpoch *primitives.Epoch `json:"justified_epoch,omitempty"`
+	FinalizedEpoch *primitives.Epoch `json:"finalized_epoch,omitempty"`
+	// Balance is the balance of new validators voting for the block, in Gwei, as an attestations step would add.
+	Balance uint64 `json:"balance,omitempty"`
+}
+
+// Attestations are the votes for a block of validators holding the balance, in Gwei. The validators are new ones
+// unless From is set, in which case they are validators voting for the block From, which change their vote.
+type Attestations struct {
+	Root    string `json:"root"`
+	From    string `json:"from,omitempty"`
+	Balance uint64 `json:"balance"`
+}
+
+// ProposerBoost is the block boosted by the next head computation. An empty root removes the proposer boost.
+type ProposerBoost struct {
+	Root string `json:"root"`
+}
+
+// Checkpoint is a checkpoint of the store.
+type Checkpoint struct {
+	Epoch primitives.Epoch `json:"epoch"`
+	Root  string           `json:"root"`
+}
+
+// ScenarioFromYAML parses a scenario.
+func ScenarioFromYAML(data []byte) (*Scenario, error) {
+	var s Scenario
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal scenario")
+	}
+	for i, step := range s.Steps {
+		if err := step.validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid step %d", i+1)
+		}
+	}
+	return &s, nil
+}
+
+func (s *Step) validate() error {
+	if s == nil {
+		return errors.New("empty step")
+	}
+	set := 0
+	for _, isSet := range []bool{
+		s.Block != nil, s.Attestations != nil, s.ProposerBoost != nil, s.JustifiedCheckpoint != nil, s.Slot != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("a step must have exactly one input, got %d", set)
+	}
+	return nil
+}
+
+// String describes the step.
+func (s *Step) String() string {
+	switch {
+	case s.Block != nil:
+		return fmt.Sprintf("block %s at slot %d on %s", s.Block.Root, s.Block.Slot, s.Block.ParentRoot)
+	case s.Attestations != nil && s.Attestations.From != "":
+		return fmt.Sprintf("attestations moving %d Gwei from %s to %s", s.Attestations.Balance, s.Attestations.From, s.Attestations.Root)
+	case s.Attestations != nil:
+		return fmt.Sprintf("attestations of %d Gwei for %s", s.Attestations.Balance, s.Attestations.Root)
+	case s.ProposerBoost != nil:
+		return fmt.Sprintf("proposer boost for %s", s.ProposerBoost.Root)
+	case s.JustifiedCheckpoint != nil:
+		return fmt.Sprintf("justified checkpoint %s at epoch %d", s.JustifiedCheckpoint.Root, s.JustifiedCheckpoint.Epoch)
+	case s.Slot != nil:
+		return fmt.Sprintf("slot %d", *s.Slot)
+	default:
+		return "empty step"
+	}
+}
+
+func parseRoot(s string) ([fieldparams.RootLength]byte, error) {
+	root, err := decodeRoot(s)
+	if err != nil {
+		return [fieldparams.RootLength]byte{}, errors.Wrapf(err, "invalid root %q", s)
+	}
+	return bytesutil.ToBytes32(root), nil
+}
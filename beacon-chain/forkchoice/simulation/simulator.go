@@ -0,0 +1,296 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	forkchoice2 "github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// Result is the head of the store after a step of a scenario. Step 0 is the store restored from the dump.
+type Result struct {
+	Step        int
+	Description string
+	Head        [fieldparams.RootLength]byte
+	Slot        primitives.Slot
+	Weight      uint64
+}
+
+// String describes the result.
+func (r *Result) String() string {
+	return fmt.Sprintf("step %d (%s): head %#x at slot %d with weight %d", r.Step, r.Description, r.Head, r.Slot, r.Weight)
+}
+
+// Simulator replays a scenario on a fork choice store restored from a dump.
+type Simulator struct {
+	fc *doublylinkedtree.ForkChoice
+	// balances of the synthetic validators, which are the justified balances of the store.
+	balances []uint64
+	// roots the synthetic validators vote for.
+	votes [][fieldparams.RootLength]byte
+	// targetEpoch of the next votes, which must increase for fork choice to take the votes into account.
+	targetEpoch primitives.Epoch
+}
+
+// NewSimulator restores the fork choice store of the dump, with a synthetic validator voting for each node with a
+// balance, see the package documentation.
+func NewSimulator(ctx context.Context, dump *forkchoice2.Dump, scenario *Scenario) (*Simulator, error) {
+	fc := doublylinkedtree.New()
+	if err := fc.Restore(ctx, dump); err != nil {
+		return nil, errors.Wrap(err, "could not restore fork choice dump")
+	}
+	s := &Simulator{fc: fc}
+	fc.SetBalancesByRooter(func(context.Context, [32]byte) ([]uint64, error) {
+		return append([]uint64{}, s.balances...), nil
+	})
+
+	slot := primitives.Slot(0)
+	var total uint64
+	for _, n := range dump.ForkChoiceNodes {
+		slot = max(slot, n.Slot)
+		total += n.Balance
+	}
+	if scenario.Slot != nil {
+		slot = *scenario.Slot
+	}
+	s.setSlot(slot)
+	s.targetEpoch = slots.ToEpoch(slot)
+
+	// The balance of the previously boosted node includes the proposer boost score.
+	prevBoost := bytesutil.ToBytes32(dump.PreviousProposerBoostRoot)
+	cfg := params.BeaconConfig()
+	var score uint64
+	if scenario.TotalActiveBalance != nil {
+		score = *scenario.TotalActiveBalance / uint64(cfg.SlotsPerEpoch) * cfg.ProposerScoreBoost / 100
+	} else if prevBoost != cfg.ZeroHash {
+		// total = active + score, with score = active / SlotsPerEpoch * ProposerScoreBoost / 100.
+		score = total / (uint64(cfg.SlotsPerEpoch)*100 + cfg.ProposerScoreBoost) * cfg.ProposerScoreBoost
+	}
+	var voted uint64
+	for _, n := range dump.ForkChoiceNodes {
+		balance := n.Balance
+		root := bytesutil.ToBytes32(n.BlockRoot)
+		if root == prevBoost {
+			if balance < score {
+				return nil, fmt.Errorf("balance %d of node %#x is lower than the proposer boost score %d", balance, root, score)
+			}
+			balance -= score
+		}
+		if balance == 0 {
+			continue
+		}
+		s.addVoter(ctx, root, balance)
+		voted += balance
+	}
+	if scenario.TotalActiveBalance != nil {
+		if *scenario.TotalActiveBalance < voted {
+			return nil, fmt.Errorf("total active balance %d is lower than the balance voting in the dump %d", *scenario.TotalActiveBalance, voted)
+		}
+		// Validators which did not vote only count in the proposer boost score.
+		s.balances = append(s.balances, *scenario.TotalActiveBalance-voted)
+		s.votes = append(s.votes, cfg.ZeroHash)
+	}
+	if err := s.updateBalances(ctx); err != nil {
+		return nil, err
+	}
+	if err := fc.SetProposerBoostRoot(bytesutil.ToBytes32(dump.ProposerBoostRoot)); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Run applies the steps of the scenario, and returns the head of the store before the first step and after each of
+// them.
+func (s *Simulator) Run(ctx context.Context, scenario *Scenario) ([]*Result, error) {
+	results := make([]*Result, 0, len(scenario.Steps)+1)
+	r, err := s.head(ctx, 0, "restored dump")
+	if err != nil {
+		return nil, err
+	}
+	results = append(results, r)
+	for i, step := range scenario.Steps {
+		if err := s.apply(ctx, step); err != nil {
+			return results, errors.Wrapf(err, "could not apply step %d (%s)", i+1, step)
+		}
+		r, err := s.head(ctx, i+1, step.String())
+		if err != nil {
+			return results, err
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+func (s *Simulator) apply(ctx context.Context, step *Step) error {
+	if err := step.validate(); err != nil {
+		return err
+	}
+	switch {
+	case step.Block != nil:
+		return s.insertBlock(ctx, step.Block)
+	case step.Attestations != nil:
+		return s.attest(ctx, step.Attestations)
+	case step.ProposerBoost != nil:
+		root, err := parseRoot(step.ProposerBoost.Root)
+		if err != nil {
+			return err
+		}
+		return s.fc.SetProposerBoostRoot(root)
+	case step.JustifiedCheckpoint != nil:
+		root, err := parseRoot(step.JustifiedCheckpoint.Root)
+		if err != nil {
+			return err
+		}
+		if !s.fc.HasNode(root) {
+			return fmt.Errorf("justified root %#x is not in the store", root)
+		}
+		return s.fc.UpdateJustifiedCheckpoint(ctx, &forkchoicetypes.Checkpoint{Epoch: step.JustifiedCheckpoint.Epoch, Root: root})
+	default:
+		s.setSlot(*step.Slot)
+		return nil
+	}
+}
+
+func (s *Simulator) insertBlock(ctx context.Context, b *Block) error {
+	root, err := parseRoot(b.Root)
+	if err != nil {
+		return err
+	}
+	parentRoot, err := parseRoot(b.ParentRoot)
+	if err != nil {
+		return err
+	}
+	dump, err := s.fc.ForkChoiceDump(ctx, nil)
+	if err != nil {
+		return err
+	}
+	var parent *forkchoice2.Node
+	for _, n := range dump.ForkChoiceNodes {
+		if bytesutil.ToBytes32(n.BlockRoot) == parentRoot {
+			parent = n
+			break
+		}
+	}
+	if parent == nil {
+		return fmt.Errorf("parent %#x is not in the store", parentRoot)
+	}
+	if b.Slot <= parent.Slot {
+		return fmt.Errorf("slot %d is not after the slot %d of the parent", b.Slot, parent.Slot)
+	}
+	n := &forkchoice2.Node{
+		Slot:                     b.Slot,
+		BlockRoot:                root[:],
+		ParentRoot:               parentRoot[:],
+		JustifiedEpoch:           parent.JustifiedEpoch,
+		FinalizedEpoch:           parent.FinalizedEpoch,
+		UnrealizedJustifiedEpoch: parent.UnrealizedJustifiedEpoch,
+		UnrealizedFinalizedEpoch: parent.UnrealizedFinalizedEpoch,
+		Validity:                 forkchoice2.Valid,
+		Timestamp:                uint64(time.Now().Unix()),
+		ExecutionBlockHash:       make([]byte, fieldparams.RootLength),
+	}
+	if b.JustifiedEpoch != nil {
+		n.JustifiedEpoch, n.UnrealizedJustifiedEpoch = *b.JustifiedEpoch, *b.JustifiedEpoch
+	}
+	if b.FinalizedEpoch != nil {
+		n.FinalizedEpoch, n.UnrealizedFinalizedEpoch = *b.FinalizedEpoch, *b.FinalizedEpoch
+	}
+	if err := s.fc.RestoreNode(n); err != nil {
+		return err
+	}
+	if b.Balance == 0 {
+		return nil
+	}
+	return s.attest(ctx, &Attestations{Root: b.Root, Balance: b.Balance})
+}
+
+// attest makes validators holding the balance vote for the root: new ones, or validators voting for the root From,
+// which are split when only part of their balance changes its vote.
+func (s *Simulator) attest(ctx context.Context, a *Attestations) error {
+	root, err := parseRoot(a.Root)
+	if err != nil {
+		return err
+	}
+	if !s.fc.HasNode(root) {
+		return fmt.Errorf("root %#x is not in the store", root)
+	}
+	if a.From == "" {
+		s.addVoter(ctx, root, a.Balance)
+		return s.updateBalances(ctx)
+	}
+	from, err := parseRoot(a.From)
+	if err != nil {
+		return err
+	}
+	var available uint64
+	for i, vote := range s.votes {
+		if vote == from {
+			available += s.balances[i]
+		}
+	}
+	if available < a.Balance {
+		return fmt.Errorf("only %d Gwei vote for %#x, cannot move %d Gwei", available, from, a.Balance)
+	}
+	remaining := a.Balance
+	for i := 0; i < len(s.votes) && remaining > 0; i++ {
+		if s.votes[i] != from {
+			continue
+		}
+		moved := min(remaining, s.balances[i])
+		remaining -= moved
+		if moved == s.balances[i] {
+			s.vote(ctx, uint64(i), root)
+			continue
+		}
+		s.balances[i] -= moved
+		s.addVoter(ctx, root, moved)
+	}
+	return s.updateBalances(ctx)
+}
+
+func (s *Simulator) addVoter(ctx context.Context, root [fieldparams.RootLength]byte, balance uint64) {
+	s.balances = append(s.balances, balance)
+	s.votes = append(s.votes, root)
+	s.vote(ctx, uint64(len(s.votes)-1), root)
+}
+
+func (s *Simulator) vote(ctx context.Context, index uint64, root [fieldparams.RootLength]byte) {
+	s.votes[index] = root
+	s.targetEpoch++
+	s.fc.ProcessAttestation(ctx, []uint64{index}, root, s.targetEpoch)
+}
+
+// updateBalances makes the store reload the balances of the synthetic validators.
+func (s *Simulator) updateBalances(ctx context.Context) error {
+	return s.fc.UpdateJustifiedCheckpoint(ctx, s.fc.JustifiedCheckpoint())
+}
+
+func (s *Simulator) setSlot(slot primitives.Slot) {
+	now := uint64(time.Now().Unix())
+	s.fc.SetGenesisTime(now - uint64(slot)*params.BeaconConfig().SecondsPerSlot)
+}
+
+func (s *Simulator) head(ctx context.Context, step int, description string) (*Result, error) {
+	head, err := s.fc.Head(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not compute head after step %d (%s)", step, description)
+	}
+	slot, err := s.fc.Slot(head)
+	if err != nil {
+		return nil, err
+	}
+	weight, err := s.fc.Weight(head)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{Step: step, Description: description, Head: head, Slot: slot, Weight: weight}, nil
+}
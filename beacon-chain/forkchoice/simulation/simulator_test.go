@@ -0,0 +1,76 @@
+package simulation
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// The dump of this test is synthetic: it was written by hand to model a late block reorg, it was not captured from
+// a node.
+func TestSimulator_LateBlockReorg(t *testing.T) {
+	ctx := context.Background()
+	data, err := os.ReadFile("./testdata/synthetic_late_block_reorg.json")
+	require.NoError(t, err)
+	dump, err := DumpFromJSON(data)
+	require.NoError(t, err)
+	data, err = os.ReadFile("./testdata/synthetic_late_block_reorg.yaml")
+	require.NoError(t, err)
+	scenario, err := ScenarioFromYAML(data)
+	require.NoError(t, err)
+
+	s, err := NewSimulator(ctx, dump, scenario)
+	require.NoError(t, err)
+	// Once the head is computed, the restored store has the weights of the dump.
+	_, err = s.fc.Head(ctx)
+	require.NoError(t, err)
+	for _, n := range dump.ForkChoiceNodes {
+		weight, err := s.fc.Weight(bytesutil.ToBytes32(n.BlockRoot))
+		require.NoError(t, err)
+		assert.Equal(t, n.Weight, weight, "weight of %#x", n.BlockRoot)
+	}
+
+	results, err := s.Run(ctx, scenario)
+	require.NoError(t, err)
+	b, err := parseRoot("0x3e23e8160039594a33894f6564e1b1348bbd7a0088d42c4acb73eeaed59c009d")
+	require.NoError(t, err)
+	c, err := parseRoot("0x2e7d2c03a9507ae265ecf5b5356885a53393a2029d241394997265a1a25aefc6")
+	require.NoError(t, err)
+	d, err := parseRoot("0x0000000000000000000000000000000000000000000000000000000000000d0d")
+	require.NoError(t, err)
+	wantHeads := [][32]byte{c, b, c, c, d, d, d}
+	require.Equal(t, len(wantHeads), len(results))
+	for i, want := range wantHeads {
+		assert.Equal(t, i, results[i].Step)
+		assert.Equal(t, want, results[i].Head, "head after step %d", i)
+	}
+	assert.Equal(t, bytesutil.ToBytes32(dump.HeadRoot), results[0].Head)
+	assert.Equal(t, uint64(200_000_000_000_000), results[0].Weight)
+	assert.Equal(t, uint64(500_000_000_000_000), results[4].Weight)
+}
+
+func TestSimulator_Errors(t *testing.T) {
+	ctx := context.Background()
+	_, err := ScenarioFromYAML([]byte("steps:\n  - slot: 1\n    proposer_boost:\n      root: \"\"\n"))
+	require.ErrorContains(t, "exactly one input", err)
+	_, err = ScenarioFromYAML([]byte("steps:\n  - {}\n"))
+	require.ErrorContains(t, "got 0", err)
+	_, err = DumpFromJSON([]byte(`{"fork_choice_nodes":[]}`))
+	require.ErrorContains(t, "no justified or finalized checkpoint", err)
+
+	data, err := os.ReadFile("./testdata/synthetic_late_block_reorg.json")
+	require.NoError(t, err)
+	dump, err := DumpFromJSON(data)
+	require.NoError(t, err)
+	scenario, err := ScenarioFromYAML([]byte("steps:\n  - attestations:\n      root: \"0x0000000000000000000000000000000000000000000000000000000000000d0d\"\n      balance: 1\n"))
+	require.NoError(t, err)
+	s, err := NewSimulator(ctx, dump, scenario)
+	require.NoError(t, err)
+	results, err := s.Run(ctx, scenario)
+	require.ErrorContains(t, "could not apply step 1", err)
+	assert.Equal(t, 1, len(results))
+}
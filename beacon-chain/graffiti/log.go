@@ -0,0 +1,5 @@
+package graffiti
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "graffiti")
@@ -0,0 +1,211 @@
+package graffiti
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// tallyDelay is the number of epochs between an epoch and the epoch of the block after which it is tallied, so that
+// the canonical blocks of the epoch are unlikely to be reorganized.
+const tallyDelay = 2
+
+// StatsFetcher provides the graffiti statistics of the most recent tallied epochs.
+type StatsFetcher interface {
+	Stats() []*EpochStats
+}
+
+// Config of the graffiti statistics service.
+type Config struct {
+	StateNotifier       statefeed.Notifier
+	CanonicalFetcher    blockchain.CanonicalFetcher
+	InitialSyncComplete chan struct{}
+	// Epochs is the number of most recent epochs whose statistics are kept.
+	Epochs int
+	// Top is the number of most frequent graffiti counted in the statistics of an epoch.
+	Top int
+	// Log logs the statistics of each epoch once tallied.
+	Log bool
+}
+
+// Service tallies the graffiti of the canonical blocks of each epoch, as an estimate of the client diversity of the
+// proposers. The graffiti of the blocks processed by the node are kept until their epoch is tallied, then only the
+// statistics of the most recent epochs are kept, with the most frequent graffiti of each.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	sync.RWMutex
+	// pending holds the decoded graffiti of the processed blocks by root, for the epochs not tallied yet.
+	pending map[primitives.Epoch]map[[32]byte]string
+	// stats of the tallied epochs, from the oldest to the most recent.
+	stats   []*EpochStats
+	tallied bool
+	last    primitives.Epoch
+}
+
+// NewService creates the graffiti statistics service.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg.Epochs <= 0 {
+		return nil, errors.New("graffiti statistics must be kept for at least one epoch")
+	}
+	if cfg.Top <= 0 {
+		return nil, errors.New("at least one graffiti must be counted in the graffiti statistics")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:     cfg,
+		ctx:     ctx,
+		cancel:  cancel,
+		pending: make(map[primitives.Epoch]map[[32]byte]string),
+	}, nil
+}
+
+// Start tallying the graffiti of the blocks processed once the node is synced.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the service.
+func (*Service) Status() error {
+	return nil
+}
+
+// Stats returns the graffiti statistics of the most recent tallied epochs, from the oldest to the most recent.
+func (s *Service) Stats() []*EpochStats {
+	s.RLock()
+	defer s.RUnlock()
+	stats := make([]*EpochStats, len(s.stats))
+	copy(stats, s.stats)
+	return stats
+}
+
+func (s *Service) run() {
+	select {
+	case <-s.cfg.InitialSyncComplete:
+	case <-s.ctx.Done():
+		return
+	}
+	log.WithField("epochs", s.cfg.Epochs).Info("Tallying graffiti statistics")
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case e := <-stateChannel:
+			if e.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := e.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				log.Error("Event feed data is not of type *statefeed.BlockProcessedData")
+				continue
+			}
+			s.processBlock(s.ctx, data.BlockRoot, data.SignedBlock)
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// processBlock keeps the graffiti of a processed block until its epoch is tallied, and tallies the epochs which are
+// tallyDelay epochs before the epoch of the block.
+func (s *Service) processBlock(ctx context.Context, root [32]byte, blk interfaces.ReadOnlySignedBeaconBlock) {
+	if blk == nil || blk.IsNil() {
+		return
+	}
+	epoch := slots.ToEpoch(blk.Block().Slot())
+	graffiti := blk.Block().Body().Graffiti()
+
+	s.Lock()
+	if s.tallied && epoch <= s.last {
+		// The epoch was already tallied, such as for a late block which is unlikely to be canonical.
+		s.Unlock()
+		return
+	}
+	if s.pending[epoch] == nil {
+		s.pending[epoch] = make(map[[32]byte]string)
+	}
+	s.pending[epoch][root] = Decode(graffiti[:])
+	var ready []primitives.Epoch
+	for e := range s.pending {
+		if e+tallyDelay <= epoch {
+			ready = append(ready, e)
+		}
+	}
+	sort.Slice(ready, func(i, j int) bool { return ready[i] < ready[j] })
+	blocks := make([]map[[32]byte]string, len(ready))
+	for i, e := range ready {
+		blocks[i] = s.pending[e]
+		delete(s.pending, e)
+	}
+	s.Unlock()
+
+	for i, e := range ready {
+		stats, err := s.tally(ctx, e, blocks[i])
+		if err != nil {
+			log.WithError(err).WithField("epoch", e).Error("Could not tally graffiti statistics")
+			continue
+		}
+		s.Lock()
+		s.stats = append(s.stats, stats)
+		if len(s.stats) > s.cfg.Epochs {
+			s.stats = s.stats[len(s.stats)-s.cfg.Epochs:]
+		}
+		s.tallied = true
+		s.last = e
+		s.Unlock()
+		if s.cfg.Log {
+			logStats(stats)
+		}
+	}
+}
+
+// tally computes the statistics of the graffiti of the canonical blocks among the processed blocks of the epoch.
+func (s *Service) tally(ctx context.Context, epoch primitives.Epoch, blocks map[[32]byte]string) (*EpochStats, error) {
+	graffiti := make([]string, 0, len(blocks))
+	for root, g := range blocks {
+		canonical, err := s.cfg.CanonicalFetcher.IsCanonical(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if canonical {
+			graffiti = append(graffiti, g)
+		}
+	}
+	return Tally(epoch, graffiti, s.cfg.Top), nil
+}
+
+func logStats(stats *EpochStats) {
+	clients := make([]string, len(stats.Clients))
+	for i, c := range stats.Clients {
+		clients[i] = fmt.Sprintf("%s=%d", c.Value, c.Count)
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":   stats.Epoch,
+		"blocks":  stats.Blocks,
+		"clients": strings.Join(clients, ","),
+	}).Info("Graffiti statistics")
+}
@@ -0,0 +1,74 @@
+package graffiti
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func blockWithGraffiti(t *testing.T, epoch primitives.Epoch, graffiti []byte) interfaces.ReadOnlySignedBeaconBlock {
+	b := util.NewBeaconBlock()
+	b.Block.Slot = primitives.Slot(epoch) * params.BeaconConfig().SlotsPerEpoch
+	copy(b.Block.Body.Graffiti, graffiti)
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	return blk
+}
+
+func TestNewService(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{Top: 1})
+	require.ErrorContains(t, "at least one epoch", err)
+	_, err = NewService(context.Background(), &Config{Epochs: 1})
+	require.ErrorContains(t, "at least one graffiti", err)
+	_, err = NewService(context.Background(), &Config{Epochs: 1, Top: 1})
+	require.NoError(t, err)
+}
+
+func TestService_ProcessBlock(t *testing.T) {
+	chain := &mock.ChainService{CanonicalRoots: map[[32]byte]bool{{1}: true, {2}: true, {4}: true}}
+	s, err := NewService(context.Background(), &Config{CanonicalFetcher: chain, Epochs: 2, Top: 10, Log: true})
+	require.NoError(t, err)
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+
+	s.processBlock(ctx, [32]byte{1}, blockWithGraffiti(t, 1, []byte("GE1a2bLH3c4d")))
+	s.processBlock(ctx, [32]byte{2}, blockWithGraffiti(t, 1, []byte{0xff, 0xfe}))
+	// A block which was reorganized out is not counted.
+	s.processBlock(ctx, [32]byte{3}, blockWithGraffiti(t, 1, []byte("Prysm")))
+	s.processBlock(ctx, [32]byte{4}, blockWithGraffiti(t, 2, []byte("teku/v24.8.0")))
+	assert.Equal(t, 0, len(s.Stats()))
+
+	// Epoch 1 is tallied once a block of epoch 3 is processed.
+	s.processBlock(ctx, [32]byte{5}, blockWithGraffiti(t, 3, []byte("Prysm")))
+	stats := s.Stats()
+	require.Equal(t, 1, len(stats))
+	assert.Equal(t, primitives.Epoch(1), stats[0].Epoch)
+	assert.Equal(t, 2, stats[0].Blocks)
+	assert.DeepEqual(t, []Count{{Value: "lighthouse", Count: 1}, {Value: UnknownClient, Count: 1}}, stats[0].Clients)
+	assert.DeepEqual(t, []Count{{Value: "0xfffe", Count: 1}, {Value: "GE1a2bLH3c4d", Count: 1}}, stats[0].Graffiti)
+	require.LogsContain(t, hook, "Graffiti statistics")
+	require.LogsContain(t, hook, "lighthouse=1,unknown=1")
+
+	// A late block of a tallied epoch is ignored.
+	s.processBlock(ctx, [32]byte{6}, blockWithGraffiti(t, 1, []byte("Prysm")))
+	_, ok := s.pending[1]
+	assert.Equal(t, false, ok)
+
+	// Skipped epochs are tallied together, and only the statistics of the most recent epochs are kept.
+	s.processBlock(ctx, [32]byte{7}, blockWithGraffiti(t, 6, nil))
+	stats = s.Stats()
+	require.Equal(t, 2, len(stats))
+	assert.Equal(t, primitives.Epoch(2), stats[0].Epoch)
+	assert.Equal(t, 1, stats[0].Blocks)
+	assert.Equal(t, primitives.Epoch(3), stats[1].Epoch)
+	assert.Equal(t, 0, stats[1].Blocks)
+}
@@ -0,0 +1,111 @@
+package graffiti
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// UnknownClient is the client of the graffiti which match no client signature.
+const UnknownClient = "unknown"
+
+// clientSignatures are the patterns of the graffiti consensus clients set by default: either the name of the client,
+// or the client version codes of the execution and consensus clients such as "GE1a2bLH3c4d", possibly followed by a
+// user graffiti. The first matching signature gives the client of a graffiti.
+var clientSignatures = []struct {
+	client  string
+	pattern *regexp.Regexp
+}{
+	{client: "prysm", pattern: clientSignature("prysm", "PM")},
+	{client: "lighthouse", pattern: clientSignature("lighthouse", "LH")},
+	{client: "teku", pattern: clientSignature("teku", "TK")},
+	{client: "nimbus", pattern: clientSignature("nimbus", "NB")},
+	{client: "lodestar", pattern: clientSignature("lodestar", "LS")},
+	{client: "grandine", pattern: clientSignature("grandine", "GR")},
+}
+
+func clientSignature(name, code string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i:` + name + `)|^[A-Z]{2}[0-9a-f]{0,4}` + code + `[0-9a-f]{0,4}(?:$|[^0-9A-Za-z])`)
+}
+
+// Decode returns the graffiti as a string without its trailing zero bytes. Graffiti which are not valid UTF-8 are
+// hex encoded instead, so that distinct graffiti are still counted apart.
+func Decode(graffiti []byte) string {
+	graffiti = bytes.TrimRight(graffiti, "\x00")
+	if utf8.Valid(graffiti) {
+		return string(graffiti)
+	}
+	return hexutil.Encode(graffiti)
+}
+
+// Client returns the consensus client whose signature the decoded graffiti matches, or UnknownClient.
+func Client(graffiti string) string {
+	for _, s := range clientSignatures {
+		if s.pattern.MatchString(graffiti) {
+			return s.client
+		}
+	}
+	return UnknownClient
+}
+
+// Count is the number of canonical blocks of an epoch with a value, either a client or a graffiti.
+type Count struct {
+	Value string
+	Count int
+}
+
+// EpochStats are the graffiti statistics of the canonical blocks of an epoch.
+type EpochStats struct {
+	Epoch  primitives.Epoch
+	Blocks int
+	// Clients counts the blocks by the client whose signature their graffiti matches, most frequent first.
+	Clients []Count
+	// Graffiti counts the blocks with the most frequent graffiti, most frequent first.
+	Graffiti []Count
+	// OtherGraffiti is the number of blocks whose graffiti is not among the most frequent ones.
+	OtherGraffiti int
+}
+
+// Tally computes the statistics of the decoded graffiti of the canonical blocks of an epoch, keeping the top most
+// frequent graffiti.
+func Tally(epoch primitives.Epoch, graffiti []string, top int) *EpochStats {
+	clients := make(map[string]int)
+	raw := make(map[string]int)
+	for _, g := range graffiti {
+		clients[Client(g)]++
+		raw[g]++
+	}
+	stats := &EpochStats{
+		Epoch:    epoch,
+		Blocks:   len(graffiti),
+		Clients:  sortedCounts(clients),
+		Graffiti: sortedCounts(raw),
+	}
+	if len(stats.Graffiti) > top {
+		for _, c := range stats.Graffiti[top:] {
+			stats.OtherGraffiti += c.Count
+		}
+		stats.Graffiti = stats.Graffiti[:top]
+	}
+	return stats
+}
+
+// sortedCounts returns the counts from the most frequent value to the least frequent one, and in the order of the
+// values for equal counts.
+func sortedCounts(counts map[string]int) []Count {
+	sorted := make([]Count, 0, len(counts))
+	for v, c := range counts {
+		sorted = append(sorted, Count{Value: v, Count: c})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Count != sorted[j].Count {
+			return sorted[i].Count > sorted[j].Count
+		}
+		return sorted[i].Value < sorted[j].Value
+	})
+	return sorted
+}
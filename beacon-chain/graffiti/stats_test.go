@@ -0,0 +1,91 @@
+package graffiti
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestDecode(t *testing.T) {
+	var graffiti [32]byte
+	assert.Equal(t, "", Decode(graffiti[:]))
+	copy(graffiti[:], "Lighthouse/v5.3.0")
+	assert.Equal(t, "Lighthouse/v5.3.0", Decode(graffiti[:]))
+	copy(graffiti[:], "✓ staked")
+	assert.Equal(t, "✓ staked/v5.3.0", Decode(graffiti[:]))
+
+	// Invalid UTF-8 is hex encoded.
+	assert.Equal(t, "0xff00fe", Decode([]byte{0xff, 0x00, 0xfe, 0x00, 0x00}))
+	// A truncated multi-byte character is invalid.
+	assert.Equal(t, "0x61e29c", Decode([]byte("a✓")[:3]))
+}
+
+func TestClient(t *testing.T) {
+	tests := []struct {
+		graffiti string
+		client   string
+	}{
+		{graffiti: "Lighthouse/v5.3.0-d6ba8c3", client: "lighthouse"},
+		{graffiti: "teku/v24.8.0", client: "teku"},
+		{graffiti: "Nimbus/v24.9.0-dc3e2a", client: "nimbus"},
+		{graffiti: "Lodestar-v1.21.0", client: "lodestar"},
+		{graffiti: "grandine-1.0.0", client: "grandine"},
+		{graffiti: "Prysm", client: "prysm"},
+		{graffiti: "GE1a2bPM3c4d", client: "prysm"},
+		{graffiti: "NMLH", client: "lighthouse"},
+		{graffiti: "BU12TK34 solo staker", client: "teku"},
+		{graffiti: "RH3c4dNB", client: "nimbus"},
+		{graffiti: "GELS1234|my pool", client: "lodestar"},
+		// A client code must follow an execution client code.
+		{graffiti: "LH", client: UnknownClient},
+		// A client code must not be followed by other letters.
+		{graffiti: "GE1a2bPMx", client: UnknownClient},
+		{graffiti: "hello world", client: UnknownClient},
+		{graffiti: "", client: UnknownClient},
+		{graffiti: "0xff00fe", client: UnknownClient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.graffiti, func(t *testing.T) {
+			assert.Equal(t, tt.client, Client(tt.graffiti))
+		})
+	}
+}
+
+func TestTally(t *testing.T) {
+	graffiti := []string{
+		"GE1a2bLH3c4d",
+		"GE1a2bLH3c4d",
+		"GE1a2bLH3c4d",
+		"teku/v24.8.0",
+		"teku/v24.8.0",
+		"GE1a2bPM3c4d",
+		"hello",
+		"0xff00fe",
+	}
+	stats := Tally(5, graffiti, 2)
+	assert.Equal(t, 5, int(stats.Epoch))
+	assert.Equal(t, 8, stats.Blocks)
+	assert.DeepEqual(t, []Count{
+		{Value: "lighthouse", Count: 3},
+		{Value: "teku", Count: 2},
+		{Value: UnknownClient, Count: 2},
+		{Value: "prysm", Count: 1},
+	}, stats.Clients)
+	assert.DeepEqual(t, []Count{
+		{Value: "GE1a2bLH3c4d", Count: 3},
+		{Value: "teku/v24.8.0", Count: 2},
+	}, stats.Graffiti)
+	assert.Equal(t, 3, stats.OtherGraffiti)
+
+	stats = Tally(5, graffiti, 10)
+	require.Equal(t, 5, len(stats.Graffiti))
+	assert.Equal(t, 0, stats.OtherGraffiti)
+	// Equal counts are ordered by value.
+	assert.Equal(t, "0xff00fe", stats.Graffiti[2].Value)
+
+	stats = Tally(6, nil, 2)
+	assert.Equal(t, 0, stats.Blocks)
+	assert.Equal(t, 0, len(stats.Clients))
+	assert.Equal(t, 0, len(stats.Graffiti))
+}
@@ -25,7 +25,7 @@ func configureTracing(cliCtx *cli.Context) error {
 func configureChainConfig(cliCtx *cli.Context) error {
 	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
 		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
-		return params.LoadChainConfigFile(chainConfigFileName, nil)
+		return params.LoadChainConfigFile(chainConfigFileName, nil, cliCtx.StringSlice(cmd.ChainPresetFileFlag.Name)...)
 	}
 	return nil
 }
@@ -34,6 +34,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/graffiti"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
@@ -57,12 +58,14 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/effective"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/slice"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/prometheus"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime"
 	"github.com/prysmaticlabs/prysm/v5/runtime/debug"
 	"github.com/prysmaticlabs/prysm/v5/runtime/prereqs"
@@ -101,7 +104,13 @@ type BeaconNode struct {
 	blsToExecPool           blstoexec.PoolManager
 	depositCache            cache.DepositCache
 	trackedValidatorsCache  *cache.TrackedValidatorsCache
+	attestationCache        *cache.AttestationCache
 	payloadIDCache          *cache.PayloadIDCache
+	subnetAttestationStats  *cache.SubnetAttestationStats
+	proposalAttSources      *cache.ProposalAttestationSourcesCache
+	slotObservations        *cache.SlotObservations
+	validatorLiveness       *cache.ValidatorLiveness
+	graffitiStats           graffiti.StatsFetcher
 	stateFeed               *event.Feed
 	blockFeed               *event.Feed
 	opFeed                  *event.Feed
@@ -113,6 +122,7 @@ type BeaconNode struct {
 	serviceFlagOpts         *serviceFlagOpts
 	GenesisInitializer      genesis.Initializer
 	CheckpointInitializer   checkpoint.Initializer
+	DepositSnapshotProvider checkpoint.DepositSnapshotProvider
 	forkChoicer             forkchoice.ForkChoicer
 	clockWaiter             startup.ClockWaiter
 	BackfillOpts            []backfill.ServiceOption
@@ -151,7 +161,12 @@ func New(cliCtx *cli.Context, cancel context.CancelFunc, opts ...Option) (*Beaco
 		syncCommitteePool:       synccommittee.NewPool(),
 		blsToExecPool:           blstoexec.NewPool(),
 		trackedValidatorsCache:  cache.NewTrackedValidatorsCache(),
+		attestationCache:        cache.NewAttestationCache(),
 		payloadIDCache:          cache.NewPayloadIDCache(),
+		subnetAttestationStats:  cache.NewSubnetAttestationStats(),
+		proposalAttSources:      cache.NewProposalAttestationSourcesCache(),
+		slotObservations:        cache.NewSlotObservations(primitives.Slot(cliCtx.Uint64(flags.SlotOutcomesRetentionFlag.Name))),
+		validatorLiveness:       cache.NewValidatorLiveness(),
 		slasherBlockHeadersFeed: new(event.Feed),
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
@@ -360,6 +375,11 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register builder service")
 	}
 
+	log.Debugln("Registering Graffiti Statistics Service")
+	if err := beacon.registerGraffitiStatsService(beacon.initialSyncComplete); err != nil {
+		return errors.Wrap(err, "could not register graffiti statistics service")
+	}
+
 	log.Debugln("Registering RPC Service")
 	router := http.NewServeMux()
 	if err := beacon.registerRPCService(router); err != nil {
@@ -461,7 +481,7 @@ func (b *BeaconNode) Close() {
 	close(b.stop)
 }
 
-func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath string) (*kv.Store, error) {
+func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath string, snapshotOpts *kv.SnapshotOptions) (*kv.Store, error) {
 	var err error
 	clearDBConfirmed := false
 
@@ -480,8 +500,19 @@ func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath str
 	}
 
 	if clearDBConfirmed || forceClearDB {
+		// The database is closed first, for its file to be hard-linked rather than copied when possible.
+		if err := d.Close(); err != nil {
+			return nil, errors.Wrap(err, "could not close database")
+		}
+		if snapshotOpts != nil {
+			if err := snapshotDB("clearing it", func() (string, error) {
+				return kv.SnapshotFile(b.ctx, dbPath, *snapshotOpts)
+			}); err != nil {
+				return nil, err
+			}
+		}
 		log.Warning("Removing database")
-		if err := d.ClearDB(); err != nil {
+		if err := kv.RemoveDatabaseFile(dbPath); err != nil {
 			return nil, errors.Wrap(err, "could not clear database")
 		}
 
@@ -498,6 +529,22 @@ func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath str
 	return d, nil
 }
 
+// snapshotDB takes a snapshot of the database before an operation deleting or rewriting stored data. The operation
+// proceeds without a snapshot when there is not enough free disk space for it.
+func snapshotDB(operation string, snapshot func() (string, error)) error {
+	snapshotPath, err := snapshot()
+	if errors.Is(err, kv.ErrInsufficientDiskSpace) {
+		log.WithError(err).Warnf("Not enough free disk space to take a snapshot of the database before %s, proceeding without it", operation)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not take a snapshot of the database before %s, run with --%s to proceed without it",
+			operation, flags.DisableDBSnapshotFlag.Name)
+	}
+	log.WithField("path", snapshotPath).Infof("Saved a snapshot of the database before %s", operation)
+	return nil
+}
+
 func (b *BeaconNode) checkAndSaveDepositContract(depositAddress string) error {
 	knownContract, err := b.db.DepositContractAddress(b.ctx)
 	if err != nil {
@@ -528,6 +575,13 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	dbPath := filepath.Join(baseDir, kv.BeaconNodeDbDirName)
 	clearDBRequired := cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearDBRequired := cliCtx.Bool(cmd.ForceClearDB.Name)
+	var snapshotOpts *kv.SnapshotOptions
+	if !cliCtx.Bool(flags.DisableDBSnapshotFlag.Name) {
+		snapshotOpts = &kv.SnapshotOptions{
+			Dir:       cliCtx.String(flags.DBSnapshotDirFlag.Name),
+			Retention: cliCtx.Uint64(flags.DBSnapshotRetentionFlag.Name),
+		}
+	}
 
 	log.WithField("databasePath", dbPath).Info("Checking DB")
 
@@ -537,12 +591,27 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	}
 
 	if clearDBRequired || forceClearDBRequired {
-		d, err = b.clearDB(clearDBRequired, forceClearDBRequired, d, dbPath)
+		d, err = b.clearDB(clearDBRequired, forceClearDBRequired, d, dbPath, snapshotOpts)
 		if err != nil {
 			return errors.Wrap(err, "could not clear database")
 		}
 	}
 
+	if snapshotOpts != nil {
+		pending, err := d.PendingDestructiveMigrations()
+		if err != nil {
+			return errors.Wrap(err, "could not check pending database migrations")
+		}
+		if len(pending) > 0 {
+			operation := fmt.Sprintf("the %s migrations", strings.Join(pending, ", "))
+			if err := snapshotDB(operation, func() (string, error) {
+				return d.Snapshot(b.ctx, *snapshotOpts)
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := d.RunMigrations(b.ctx); err != nil {
 		return err
 	}
@@ -578,6 +647,10 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 		}
 	}
 
+	if err := verifyChainPointers(b.ctx, d, cliCtx.Bool(flags.StrictStartupFlag.Name)); err != nil {
+		return err
+	}
+
 	if err := b.checkAndSaveDepositContract(depositAddress); err != nil {
 		return errors.Wrap(err, "could not check and save deposit contract")
 	}
@@ -586,6 +659,43 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	return nil
 }
 
+// verifyChainPointers checks that the persisted head and checkpoints resolve to data in the database, which may not
+// be the case after a partial write before a crash. Unless strict is set, inconsistent pointers are rolled back to
+// the newest consistent finalized checkpoint so that fork choice is rebuilt from there.
+func verifyChainPointers(ctx context.Context, d *kv.Store, strict bool) error {
+	issues, err := d.VerifyChainPointers(ctx)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, kv.ErrInconsistentChainPointers) {
+		return errors.Wrap(err, "could not verify chain data")
+	}
+	if strict {
+		return errors.Wrapf(err, "restart without --%s to repair the chain data automatically", flags.StrictStartupFlag.Name)
+	}
+	for _, issue := range issues {
+		log.WithFields(logrus.Fields{
+			"pointer": issue.Pointer,
+			"root":    fmt.Sprintf("%#x", issue.Root),
+			"reason":  issue.Reason,
+		}).Warn("Persisted chain data is inconsistent")
+	}
+	repairs, err := d.RepairChainPointers(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not repair chain data")
+	}
+	for _, r := range repairs {
+		log.WithFields(logrus.Fields{
+			"pointer":  r.Pointer,
+			"oldRoot":  fmt.Sprintf("%#x", r.OldRoot),
+			"oldEpoch": r.OldEpoch,
+			"newRoot":  fmt.Sprintf("%#x", r.NewRoot),
+			"newEpoch": r.NewEpoch,
+		}).Warn("Repaired persisted chain data")
+	}
+	return nil
+}
+
 func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -688,9 +798,11 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		UDPPort:              cliCtx.Uint(cmd.P2PUDPPort.Name),
 		MaxPeers:             cliCtx.Uint(cmd.P2PMaxPeers.Name),
 		QueueSize:            cliCtx.Uint(cmd.PubsubQueueSize.Name),
+		PropagationWindow:    cliCtx.Duration(cmd.PubsubPropagationWindow.Name),
 		AllowListCIDR:        cliCtx.String(cmd.P2PAllowList.Name),
 		DenyListCIDR:         slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
 		EnableUPnP:           cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		CustodySubnetCount:   cliCtx.Uint64(flags.CustodyRequirementFlag.Name),
 		StateNotifier:        b,
 		DB:                   b.db,
 		ClockWaiter:          b.clockWaiter,
@@ -721,6 +833,7 @@ func (b *BeaconNode) registerAttestationPool() error {
 	s, err := attestations.NewService(b.ctx, &attestations.Config{
 		Pool:                b.attestationPool,
 		InitialSyncComplete: b.initialSyncComplete,
+		BeaconDB:            b.db,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register atts pool service")
@@ -761,14 +874,21 @@ func (b *BeaconNode) registerBlockchainService(fc forkchoice.ForkChoicer, gs *st
 		blockchain.WithSyncComplete(syncComplete),
 		blockchain.WithBlobStorage(b.BlobStorage),
 		blockchain.WithTrackedValidatorsCache(b.trackedValidatorsCache),
+		blockchain.WithSlotObservations(b.slotObservations),
+		blockchain.WithValidatorLiveness(b.validatorLiveness),
+		blockchain.WithAttestationCache(b.attestationCache),
 		blockchain.WithPayloadIDCache(b.payloadIDCache),
 		blockchain.WithSyncChecker(b.syncChecker),
 	)
+	if b.cliCtx.Bool(flags.OperationTotalsIndexFlag.Name) {
+		opts = append(opts, blockchain.WithOperationTotalsIndex(web3Service))
+	}
 
 	blockchainService, err := blockchain.NewService(b.ctx, opts...)
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
 	}
+	attService.SetHeadStateFetcher(blockchainService)
 	return b.services.RegisterService(blockchainService)
 }
 
@@ -798,6 +918,9 @@ func (b *BeaconNode) registerPOWChainService() error {
 		execution.WithJwtId(b.cliCtx.String(flags.JwtId.Name)),
 		execution.WithVerifierWaiter(b.verifyInitWaiter),
 	)
+	if snapshot := b.depositSnapshot(b.ctx); snapshot != nil {
+		opts = append(opts, execution.WithDepositSnapshot(snapshot))
+	}
 	web3Service, err := execution.NewService(b.ctx, opts...)
 	if err != nil {
 		return errors.Wrap(err, "could not register proof-of-work chain web3Service")
@@ -806,6 +929,28 @@ func (b *BeaconNode) registerPOWChainService() error {
 	return b.services.RegisterService(web3Service)
 }
 
+// depositSnapshot obtains the deposit snapshot to bootstrap the deposit tree from, when a deposit snapshot provider
+// is configured and the node has no deposit data yet.
+func (b *BeaconNode) depositSnapshot(ctx context.Context) *ethpb.DepositSnapshot {
+	if b.DepositSnapshotProvider == nil {
+		return nil
+	}
+	data, err := b.db.ExecutionChainData(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not get execution chain data, ignoring deposit snapshot")
+		return nil
+	}
+	if data != nil && (len(data.DepositContainers) > 0 || data.DepositSnapshot.GetDepositCount() > 0) {
+		return nil
+	}
+	snapshot, err := b.DepositSnapshotProvider.DepositSnapshot(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not obtain deposit snapshot, deposits will be synced from the deposit contract logs")
+		return nil
+	}
+	return snapshot
+}
+
 func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}, bFillStore *backfill.Store) error {
 	var web3Service *execution.Service
 	if err := b.services.FetchService(&web3Service); err != nil {
@@ -846,6 +991,9 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}, bFil
 		regularsync.WithBlobStorage(b.BlobStorage),
 		regularsync.WithVerifierWaiter(b.verifyInitWaiter),
 		regularsync.WithAvailableBlocker(bFillStore),
+		regularsync.WithSubnetAttestationStats(b.subnetAttestationStats),
+		regularsync.WithSlotObservations(b.slotObservations),
+		regularsync.WithValidatorLiveness(b.validatorLiveness),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -859,6 +1007,7 @@ func (b *BeaconNode) registerInitialSyncService(complete chan struct{}) error {
 	opts := []initialsync.Option{
 		initialsync.WithVerifierWaiter(b.verifyInitWaiter),
 		initialsync.WithSyncChecker(b.syncChecker),
+		initialsync.WithAnchoredSpoolDir(filepath.Join(b.db.DatabasePath(), "anchored-sync")),
 	}
 	is := initialsync.NewService(b.ctx, &initialsync.Config{
 		DB:                  b.db,
@@ -897,6 +1046,7 @@ func (b *BeaconNode) registerSlasherService() error {
 		SyncChecker:             syncService,
 		HeadStateFetcher:        chainService,
 		ClockWaiter:             b.clockWaiter,
+		MemoryBudget:            b.cliCtx.Uint64(flags.SlasherMemoryBudgetFlag.Name) * 1024 * 1024,
 	})
 	if err != nil {
 		return err
@@ -921,10 +1071,12 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 	}
 
 	var slasherService *slasher.Service
+	var slashingChecker slasher.SlashingChecker
 	if features.Get().EnableSlasher {
 		if err := b.services.FetchService(&slasherService); err != nil {
 			return err
 		}
+		slashingChecker = slasherService
 	}
 
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
@@ -951,6 +1103,19 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	enableDebugRPCEndpoints := !b.cliCtx.Bool(flags.DisableDebugRPCEndpoints.Name)
+	unixSocketPath := b.cliCtx.String(flags.RPCUnixSocket.Name)
+	var unixSocketPerm os.FileMode
+	if unixSocketPath != "" {
+		perm, err := b.unixSocketPermissions()
+		if err != nil {
+			return err
+		}
+		unixSocketPerm = perm
+	}
+	adminToken, err := b.adminAPIToken()
+	if err != nil {
+		return err
+	}
 
 	p2pService := b.fetchP2P()
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
@@ -958,6 +1123,8 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 		ExecutionReconstructor:    web3Service,
 		Host:                      host,
 		Port:                      port,
+		UnixSocketPath:            unixSocketPath,
+		UnixSocketPermissions:     unixSocketPerm,
 		BeaconMonitoringHost:      beaconMonitoringHost,
 		BeaconMonitoringPort:      beaconMonitoringPort,
 		CertFlag:                  cert,
@@ -982,6 +1149,7 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 		AttestationsPool:          b.attestationPool,
 		ExitPool:                  b.exitPool,
 		SlashingsPool:             b.slashingsPool,
+		SlashingChecker:           slashingChecker,
 		BLSChangesPool:            b.blsToExecPool,
 		SyncCommitteeObjectPool:   b.syncCommitteePool,
 		ExecutionChainService:     web3Service,
@@ -1002,7 +1170,16 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 		ClockWaiter:               b.clockWaiter,
 		BlobStorage:               b.BlobStorage,
 		TrackedValidatorsCache:    b.trackedValidatorsCache,
+		AttestationCache:          b.attestationCache,
 		PayloadIDCache:            b.payloadIDCache,
+		SubnetAttestationStats:    b.subnetAttestationStats,
+		ProposalAttSources:        b.proposalAttSources,
+		SlotObservations:          b.slotObservations,
+		ValidatorLiveness:         b.validatorLiveness,
+		GraffitiStats:             b.graffitiStats,
+		EffectiveFlags:            effective.FlagValues(b.cliCtx),
+		DisableArchivalAPIQueries: b.cliCtx.Bool(flags.DisableArchivalAPIQueriesFlag.Name),
+		AdminAPIToken:             adminToken,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -1055,6 +1232,13 @@ func (b *BeaconNode) registerHTTPService(router *http.ServeMux) error {
 	if b.cliCtx.IsSet(cmd.ApiTimeoutFlag.Name) {
 		opts = append(opts, httprest.WithTimeout(b.cliCtx.Duration(cmd.ApiTimeoutFlag.Name)))
 	}
+	if socketPath := b.cliCtx.String(flags.HTTPServerUnixSocket.Name); socketPath != "" {
+		perm, err := b.unixSocketPermissions()
+		if err != nil {
+			return err
+		}
+		opts = append(opts, httprest.WithUnixSocket(socketPath, perm))
+	}
 	g, err := httprest.New(b.ctx, opts...)
 	if err != nil {
 		return err
@@ -1062,6 +1246,36 @@ func (b *BeaconNode) registerHTTPService(router *http.ServeMux) error {
 	return b.services.RegisterService(g)
 }
 
+// unixSocketPermissions parses the octal file mode applied to the unix sockets the APIs are served on.
+func (b *BeaconNode) unixSocketPermissions() (os.FileMode, error) {
+	value := b.cliCtx.String(flags.UnixSocketPermissions.Name)
+	perm, err := strconv.ParseUint(value, 8, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not parse --%s value %q as an octal file mode", flags.UnixSocketPermissions.Name, value)
+	}
+	if perm > uint64(os.ModePerm) {
+		return 0, errors.Errorf("--%s value %q is not a valid file mode", flags.UnixSocketPermissions.Name, value)
+	}
+	return os.FileMode(perm), nil
+}
+
+// adminAPIToken reads the bearer token required by the admin HTTP endpoints. No token is returned when no token file is provided.
+func (b *BeaconNode) adminAPIToken() (string, error) {
+	path := b.cliCtx.String(flags.HTTPAdminTokenFileFlag.Name)
+	if path == "" {
+		return "", nil
+	}
+	content, err := os.ReadFile(path) // #nosec G304 -- The path is provided by the node operator.
+	if err != nil {
+		return "", errors.Wrapf(err, "could not read --%s file", flags.HTTPAdminTokenFileFlag.Name)
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", errors.Errorf("--%s file %s is empty", flags.HTTPAdminTokenFileFlag.Name, path)
+	}
+	return token, nil
+}
+
 func (b *BeaconNode) registerDeterministicGenesisService() error {
 	genesisTime := b.cliCtx.Uint64(flags.InteropGenesisTimeFlag.Name)
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
@@ -1116,6 +1330,34 @@ func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan st
 	return b.services.RegisterService(svc)
 }
 
+func (b *BeaconNode) registerGraffitiStatsService(initialSyncComplete chan struct{}) error {
+	epochs := b.cliCtx.Int(flags.GraffitiStatsEpochsFlag.Name)
+	if epochs <= 0 {
+		return nil
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	svc, err := graffiti.NewService(b.ctx, &graffiti.Config{
+		StateNotifier:       b,
+		CanonicalFetcher:    chainService,
+		InitialSyncComplete: initialSyncComplete,
+		Epochs:              epochs,
+		Top:                 b.cliCtx.Int(flags.GraffitiStatsTopFlag.Name),
+		Log:                 b.cliCtx.Bool(flags.GraffitiStatsLogFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	if err := b.services.RegisterService(svc); err != nil {
+		return err
+	}
+	b.graffitiStats = svc
+	return nil
+}
+
 func (b *BeaconNode) registerBuilderService(cliCtx *cli.Context) error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
@@ -23,63 +24,71 @@ import (
 func (c *AttCaches) AggregateUnaggregatedAttestations(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "operations.attestations.kv.AggregateUnaggregatedAttestations")
 	defer span.End()
-	unaggregatedAtts, err := c.UnaggregatedAttestations()
+	groups, err := c.unaggregatedAttestationsByDataId()
 	if err != nil {
 		return err
 	}
-	return c.aggregateUnaggregatedAtts(ctx, unaggregatedAtts)
+	return c.aggregateUnaggregatedAtts(ctx, groups)
 }
 
-func (c *AttCaches) aggregateUnaggregatedAtts(ctx context.Context, unaggregatedAtts []ethpb.Att) error {
+func (c *AttCaches) aggregateUnaggregatedAtts(ctx context.Context, groups map[attestation.Id]*unaggregatedGroup) error {
 	_, span := trace.StartSpan(ctx, "operations.attestations.kv.aggregateUnaggregatedAtts")
 	defer span.End()
 
-	attsByVerAndDataRoot := make(map[attestation.Id][]ethpb.Att, len(unaggregatedAtts))
-	for _, att := range unaggregatedAtts {
-		id, err := attestation.NewId(att, attestation.Data)
-		if err != nil {
-			return errors.Wrap(err, "could not create attestation ID")
-		}
-		attsByVerAndDataRoot[id] = append(attsByVerAndDataRoot[id], att)
+	attsByDataId := make(map[attestation.Id][]ethpb.Att, len(groups))
+	for dataId, g := range groups {
+		// Aggregation replaces the first attestation of the slice it is given, the group is left untouched.
+		attsByDataId[dataId] = append([]ethpb.Att{}, g.atts...)
 	}
 
 	// Aggregate unaggregated attestations from the pool and save them in the pool.
-	// Track the unaggregated attestations that aren't able to aggregate.
-	leftOverUnaggregatedAtt := make(map[attestation.Id]bool)
-
-	leftOverUnaggregatedAtt = c.aggregateParallel(attsByVerAndDataRoot, leftOverUnaggregatedAtt)
+	// Track the groups of unaggregated attestations that aren't able to aggregate.
+	leftOverUnaggregatedAtt := c.aggregateParallel(attsByDataId)
 
 	// Remove the unaggregated attestations from the pool that were successfully aggregated.
-	for _, att := range unaggregatedAtts {
-		id, err := attestation.NewId(att, attestation.Full)
-		if err != nil {
-			return errors.Wrap(err, "could not create attestation ID")
-		}
-		if leftOverUnaggregatedAtt[id] {
+	for dataId, g := range groups {
+		if leftOverUnaggregatedAtt[dataId] {
 			continue
 		}
-		if err := c.DeleteUnaggregatedAttestation(att); err != nil {
+		bits := make([]bitfield.Bitlist, len(g.atts))
+		for i, att := range g.atts {
+			bits[i] = att.GetAggregationBits()
+		}
+		if err := c.insertSeenBits(dataId, bits...); err != nil {
 			return err
 		}
 	}
+	c.unAggregateAttLock.Lock()
+	defer c.unAggregateAttLock.Unlock()
+	for dataId, g := range groups {
+		if leftOverUnaggregatedAtt[dataId] {
+			continue
+		}
+		c.deleteUnaggregated(dataId, g.ids...)
+	}
 	return nil
 }
 
 // aggregateParallel aggregates attestations in parallel for `atts` and saves them in the pool,
-// returns the unaggregated attestations that weren't able to aggregate.
+// returns the data IDs of the unaggregated attestations that weren't able to aggregate.
 // Given `n` CPU cores, it creates a channel of size `n` and spawns `n` goroutines to aggregate attestations
-func (c *AttCaches) aggregateParallel(atts map[attestation.Id][]ethpb.Att, leftOver map[attestation.Id]bool) map[attestation.Id]bool {
+func (c *AttCaches) aggregateParallel(atts map[attestation.Id][]ethpb.Att) map[attestation.Id]bool {
+	type group struct {
+		dataId attestation.Id
+		atts   []ethpb.Att
+	}
+	leftOver := make(map[attestation.Id]bool)
 	var leftoverLock sync.Mutex
 	wg := sync.WaitGroup{}
 
 	n := runtime.GOMAXPROCS(0) // defaults to the value of runtime.NumCPU
-	ch := make(chan []ethpb.Att, n)
+	ch := make(chan group, n)
 	wg.Add(n)
 	for i := 0; i < n; i++ {
 		go func() {
 			defer wg.Done()
-			for as := range ch {
-				aggregated, err := attaggregation.AggregateDisjointOneBitAtts(as)
+			for g := range ch {
+				aggregated, err := attaggregation.AggregateDisjointOneBitAtts(g.atts)
 				if err != nil {
 					log.WithError(err).Error("could not aggregate unaggregated attestations")
 					continue
@@ -93,22 +102,20 @@ func (c *AttCaches) aggregateParallel(atts map[attestation.Id][]ethpb.Att, leftO
 						log.WithError(err).Error("could not save aggregated attestation")
 						continue
 					}
-				} else {
-					id, err := attestation.NewId(aggregated, attestation.Full)
-					if err != nil {
-						log.WithError(err).Error("Could not create attestation ID")
-						continue
+					if err := c.SaveAttestationProvenance(aggregated, SourceLocalAggregation, 0); err != nil {
+						log.WithError(err).Debug("Could not save attestation provenance")
 					}
+				} else {
 					leftoverLock.Lock()
-					leftOver[id] = true
+					leftOver[g.dataId] = true
 					leftoverLock.Unlock()
 				}
 			}
 		}()
 	}
 
-	for _, as := range atts {
-		ch <- as
+	for dataId, as := range atts {
+		ch <- group{dataId: dataId, atts: as}
 	}
 
 	close(ch)
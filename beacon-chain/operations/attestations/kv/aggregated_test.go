@@ -545,3 +545,130 @@ func TestKV_Aggregated_AggregatedAttestationsBySlotIndexElectra(t *testing.T) {
 	returned = cache.AggregatedAttestationsBySlotIndexElectra(ctx, 2, 1)
 	assert.DeepEqual(t, []*ethpb.AttestationElectra{att3}, returned)
 }
+
+// benchmarkUnaggregatedAtts returns 10k unaggregated attestations, spread over 64 committees of 160 validators.
+func benchmarkUnaggregatedAtts(b *testing.B) []ethpb.Att {
+	const committees, committeeSize = 64, 160
+	priv, err := bls.RandKey()
+	require.NoError(b, err)
+	sig := priv.Sign([]byte{'a'}).Marshal()
+	atts := make([]ethpb.Att, 0, 10000)
+	for i := 0; len(atts) < cap(atts); i++ {
+		bits := bitfield.NewBitlist(committeeSize)
+		bits.SetBitAt(uint64(i/committees), true)
+		atts = append(atts, util.HydrateAttestation(&ethpb.Attestation{
+			Data:            &ethpb.AttestationData{Slot: 1, CommitteeIndex: primitives.CommitteeIndex(i % committees)},
+			AggregationBits: bits,
+			Signature:       sig,
+		}))
+	}
+	return atts
+}
+
+// aggregatePerAttestation aggregates the unaggregated attestations of the pool the way it was done before
+// attestations were bucketed by data root: every attestation is hashed again to be checked against the seen
+// bits, grouped, and deleted.
+func aggregatePerAttestation(c *AttCaches) error {
+	atts, err := c.UnaggregatedAttestations()
+	if err != nil {
+		return err
+	}
+	unseen := make([]ethpb.Att, 0, len(atts))
+	for _, att := range atts {
+		seen, err := c.hasSeenBit(att)
+		if err != nil {
+			return err
+		}
+		if !seen {
+			unseen = append(unseen, att)
+		}
+	}
+	groups := make(map[attestation.Id][]ethpb.Att)
+	for _, att := range unseen {
+		id, err := attestation.NewId(att, attestation.Data)
+		if err != nil {
+			return err
+		}
+		groups[id] = append(groups[id], att)
+	}
+	leftOver := c.aggregateParallel(groups)
+	for _, att := range unseen {
+		id, err := attestation.NewId(att, attestation.Data)
+		if err != nil {
+			return err
+		}
+		if leftOver[id] {
+			continue
+		}
+		if err := c.DeleteUnaggregatedAttestation(att); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func BenchmarkAttCaches_AggregateUnaggregatedAttestations(b *testing.B) {
+	atts := benchmarkUnaggregatedAtts(b)
+	for _, tt := range []struct {
+		name      string
+		aggregate func(*AttCaches) error
+	}{
+		{
+			name:      "per attestation",
+			aggregate: aggregatePerAttestation,
+		},
+		{
+			name: "by data root",
+			aggregate: func(c *AttCaches) error {
+				return c.AggregateUnaggregatedAttestations(context.Background())
+			},
+		},
+	} {
+		b.Run(tt.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				c := NewAttCaches()
+				require.NoError(b, c.SaveUnaggregatedAttestations(atts))
+				b.StartTimer()
+				require.NoError(b, tt.aggregate(c))
+			}
+		})
+	}
+}
+
+func BenchmarkAttCaches_UnaggregatedAttestationGroups(b *testing.B) {
+	atts := benchmarkUnaggregatedAtts(b)
+	c := NewAttCaches()
+	require.NoError(b, c.SaveUnaggregatedAttestations(atts))
+	// Mark half of the attestations as seen, as after aggregating attestations received early in the slot.
+	for _, att := range atts[:len(atts)/2] {
+		require.NoError(b, c.insertSeenBit(att))
+	}
+
+	b.Run("per attestation", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			unaggregated, err := c.UnaggregatedAttestations()
+			require.NoError(b, err)
+			groups := make(map[attestation.Id][]ethpb.Att)
+			for _, att := range unaggregated {
+				seen, err := c.hasSeenBit(att)
+				require.NoError(b, err)
+				if seen {
+					continue
+				}
+				id, err := attestation.NewId(att, attestation.Data)
+				require.NoError(b, err)
+				groups[id] = append(groups[id], att)
+			}
+		}
+	})
+	b.Run("by data root", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := c.unaggregatedAttestationsByDataId()
+			require.NoError(b, err)
+		}
+	})
+}
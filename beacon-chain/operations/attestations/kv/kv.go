@@ -20,12 +20,14 @@ type AttCaches struct {
 	aggregatedAttLock  sync.RWMutex
 	aggregatedAtt      map[attestation.Id][]ethpb.Att
 	unAggregateAttLock sync.RWMutex
-	unAggregatedAtt    map[attestation.Id]ethpb.Att
+	unAggregatedAtt    map[attestation.Id]*unaggregatedBucket // keyed by attestation data ID.
 	forkchoiceAttLock  sync.RWMutex
 	forkchoiceAtt      map[attestation.Id]ethpb.Att
 	blockAttLock       sync.RWMutex
 	blockAtt           map[attestation.Id][]ethpb.Att
 	seenAtt            *cache.Cache
+	provenanceLock     sync.Mutex
+	provenance         *cache.Cache
 }
 
 // NewAttCaches initializes a new attestation pool consists of multiple KV store in cache for
@@ -34,11 +36,12 @@ func NewAttCaches() *AttCaches {
 	secsInEpoch := time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot))
 	c := cache.New(2*secsInEpoch*time.Second, 2*secsInEpoch*time.Second)
 	pool := &AttCaches{
-		unAggregatedAtt: make(map[attestation.Id]ethpb.Att),
+		unAggregatedAtt: make(map[attestation.Id]*unaggregatedBucket),
 		aggregatedAtt:   make(map[attestation.Id][]ethpb.Att),
 		forkchoiceAtt:   make(map[attestation.Id]ethpb.Att),
 		blockAtt:        make(map[attestation.Id][]ethpb.Att),
 		seenAtt:         c,
+		provenance:      cache.New(2*secsInEpoch*time.Second, 2*secsInEpoch*time.Second),
 	}
 
 	return pool
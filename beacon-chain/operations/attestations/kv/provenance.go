@@ -0,0 +1,155 @@
+package kv
+
+import (
+	"github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
+)
+
+// AttestationSource identifies how an attestation entered the pool.
+type AttestationSource uint8
+
+const (
+	// SourceUnknown is the source of attestations saved without provenance.
+	SourceUnknown AttestationSource = iota
+	// SourceGossipAggregate is the source of aggregates received on the aggregate and proof gossip topic.
+	SourceGossipAggregate
+	// SourceGossipUnaggregated is the source of unaggregated attestations received on an attestation subnet.
+	SourceGossipUnaggregated
+	// SourceAPI is the source of attestations submitted to this node through the Beacon API or the validator API.
+	SourceAPI
+	// SourceLocalAggregation is the source of aggregates built by this node from the unaggregated attestations in the pool.
+	SourceLocalAggregation
+	// SourceOrphanedBlock is the source of attestations recovered from a block orphaned by a reorg.
+	SourceOrphanedBlock
+)
+
+// String returns the name of the attestation source.
+func (s AttestationSource) String() string {
+	switch s {
+	case SourceGossipAggregate:
+		return "gossip_aggregate"
+	case SourceGossipUnaggregated:
+		return "gossip_unaggregated"
+	case SourceAPI:
+		return "api"
+	case SourceLocalAggregation:
+		return "local_aggregation"
+	case SourceOrphanedBlock:
+		return "orphaned_block"
+	default:
+		return "unknown"
+	}
+}
+
+// AttestationProvenance records where the pool entries for an attestation data came from.
+// Entries with the same source, and the same aggregator for gossip aggregates, are merged
+// into a single record holding the union of their aggregation bits, which keeps the record
+// compact even though unaggregated attestations arrive one validator at a time.
+type AttestationProvenance struct {
+	Source AttestationSource
+	// AggregatorIndex is the index of the aggregator, only set for SourceGossipAggregate.
+	AggregatorIndex primitives.ValidatorIndex
+	AggregationBits bitfield.Bitlist
+}
+
+// AttestationSourceBits is the number of participation bits of an attestation provided by pool entries of a given provenance.
+type AttestationSourceBits struct {
+	Source          AttestationSource
+	AggregatorIndex primitives.ValidatorIndex
+	Bits            uint64
+}
+
+// SaveAttestationProvenance tags the pool entries for the attestation with the source it was received from.
+// The aggregator index is ignored for sources other than SourceGossipAggregate.
+func (c *AttCaches) SaveAttestationProvenance(att ethpb.Att, source AttestationSource, aggregatorIndex primitives.ValidatorIndex) error {
+	id, err := attestation.NewId(att, attestation.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not create attestation ID")
+	}
+	if source != SourceGossipAggregate {
+		aggregatorIndex = 0
+	}
+	bits := att.GetAggregationBits()
+
+	c.provenanceLock.Lock()
+	defer c.provenanceLock.Unlock()
+
+	var records []*AttestationProvenance
+	if v, ok := c.provenance.Get(id.String()); ok {
+		records, ok = v.([]*AttestationProvenance)
+		if !ok {
+			return errors.New("could not convert to attestation provenance type")
+		}
+	}
+	for _, r := range records {
+		if r.Source != source || r.AggregatorIndex != aggregatorIndex || r.AggregationBits.Len() != bits.Len() {
+			continue
+		}
+		merged, err := r.AggregationBits.Or(bits)
+		if err != nil {
+			return err
+		}
+		r.AggregationBits = merged
+		return nil
+	}
+	copiedBits := make(bitfield.Bitlist, len(bits))
+	copy(copiedBits, bits)
+	records = append(records, &AttestationProvenance{
+		Source:          source,
+		AggregatorIndex: aggregatorIndex,
+		AggregationBits: copiedBits,
+	})
+	c.provenance.Set(id.String(), records, cache.DefaultExpiration /* two epochs */)
+	return nil
+}
+
+// AttestationSources attributes the participation bits of the attestation to the provenance of the pool entries
+// with the same attestation data. A bit can be provided by entries of several provenances, so the bits of the
+// returned records can add up to more than the bits of the attestation. The number of participation bits
+// provided by no pool entry is returned as well.
+func (c *AttCaches) AttestationSources(att ethpb.Att) ([]AttestationSourceBits, uint64, error) {
+	id, err := attestation.NewId(att, attestation.Data)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "could not create attestation ID")
+	}
+	bits := att.GetAggregationBits()
+
+	c.provenanceLock.Lock()
+	defer c.provenanceLock.Unlock()
+
+	var records []*AttestationProvenance
+	if v, ok := c.provenance.Get(id.String()); ok {
+		records, ok = v.([]*AttestationProvenance)
+		if !ok {
+			return nil, 0, errors.New("could not convert to attestation provenance type")
+		}
+	}
+
+	covered := bitfield.NewBitlist(bits.Len())
+	sources := make([]AttestationSourceBits, 0, len(records))
+	for _, r := range records {
+		if r.AggregationBits.Len() != bits.Len() {
+			continue
+		}
+		provided, err := r.AggregationBits.And(bits)
+		if err != nil {
+			return nil, 0, err
+		}
+		if provided.Count() == 0 {
+			continue
+		}
+		if covered, err = covered.Or(provided); err != nil {
+			return nil, 0, err
+		}
+		sources = append(sources, AttestationSourceBits{
+			Source:          r.Source,
+			AggregatorIndex: r.AggregatorIndex,
+			Bits:            provided.Count(),
+		})
+	}
+	return sources, bits.Count() - covered.Count(), nil
+}
@@ -0,0 +1,62 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestAttCaches_AttestationSources(t *testing.T) {
+	c := NewAttCaches()
+
+	// Two unaggregated attestations received on the subnet are merged into one record.
+	require.NoError(t, c.SaveAttestationProvenance(util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b10000001}}), SourceGossipUnaggregated, 0))
+	require.NoError(t, c.SaveAttestationProvenance(util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b10000010}}), SourceGossipUnaggregated, 12))
+	require.NoError(t, c.SaveAttestationProvenance(util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b10001110}}), SourceGossipAggregate, 7))
+	require.NoError(t, c.SaveAttestationProvenance(util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b10100000}}), SourceAPI, 0))
+	// Attestations for other data are not attributed.
+	other := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b11111111}})
+	other.Data.Slot = 1
+	require.NoError(t, c.SaveAttestationProvenance(other, SourceGossipAggregate, 8))
+
+	included := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b11001011}})
+	sources, unattributed, err := c.AttestationSources(included)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), unattributed)
+	require.Equal(t, 2, len(sources))
+	assert.DeepEqual(t, AttestationSourceBits{Source: SourceGossipUnaggregated, Bits: 2}, sources[0])
+	assert.DeepEqual(t, AttestationSourceBits{Source: SourceGossipAggregate, AggregatorIndex: 7, Bits: 2}, sources[1])
+}
+
+func TestAttCaches_AttestationSources_NoProvenance(t *testing.T) {
+	c := NewAttCaches()
+
+	included := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b11001011}})
+	sources, unattributed, err := c.AttestationSources(included)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(sources))
+	assert.Equal(t, uint64(4), unattributed)
+}
+
+func TestAttCaches_aggregateUnaggregatedAtts_SavesProvenance(t *testing.T) {
+	c := NewAttCaches()
+
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte{'a'})
+	att1 := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b10000001}, Signature: sig.Marshal()})
+	att2 := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b10000010}, Signature: sig.Marshal()})
+	require.NoError(t, c.SaveUnaggregatedAttestations([]ethpb.Att{att1, att2}))
+	require.NoError(t, c.AggregateUnaggregatedAttestations(context.Background()))
+
+	sources, unattributed, err := c.AttestationSources(util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b10000011}}))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), unattributed)
+	assert.DeepEqual(t, []AttestationSourceBits{{Source: SourceLocalAggregation, Bits: 2}}, sources)
+}
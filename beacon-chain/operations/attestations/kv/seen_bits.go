@@ -13,30 +13,25 @@ func (c *AttCaches) insertSeenBit(att ethpb.Att) error {
 	if err != nil {
 		return errors.Wrap(err, "could not create attestation ID")
 	}
+	return c.insertSeenBits(id, att.GetAggregationBits())
+}
 
-	v, ok := c.seenAtt.Get(id.String())
-	if ok {
-		seenBits, ok := v.([]bitfield.Bitlist)
-		if !ok {
-			return errors.New("could not convert to bitlist type")
-		}
-		alreadyExists := false
-		for _, bit := range seenBits {
-			if c, err := bit.Contains(att.GetAggregationBits()); err != nil {
-				return err
-			} else if c {
-				alreadyExists = true
-				break
-			}
+// insertSeenBits records the given aggregation bits as seen for the attestation data ID.
+func (c *AttCaches) insertSeenBits(id attestation.Id, bits ...bitfield.Bitlist) error {
+	seenBits, err := c.seenBits(id)
+	if err != nil {
+		return err
+	}
+	for _, b := range bits {
+		alreadyExists, err := containsBits(seenBits, b)
+		if err != nil {
+			return err
 		}
 		if !alreadyExists {
-			seenBits = append(seenBits, att.GetAggregationBits())
+			seenBits = append(seenBits, b)
 		}
-		c.seenAtt.Set(id.String(), seenBits, cache.DefaultExpiration /* one epoch */)
-		return nil
 	}
-
-	c.seenAtt.Set(id.String(), []bitfield.Bitlist{att.GetAggregationBits()}, cache.DefaultExpiration /* one epoch */)
+	c.seenAtt.Set(id.String(), seenBits, cache.DefaultExpiration /* one epoch */)
 	return nil
 }
 
@@ -45,19 +40,33 @@ func (c *AttCaches) hasSeenBit(att ethpb.Att) (bool, error) {
 	if err != nil {
 		return false, errors.Wrap(err, "could not create attestation ID")
 	}
+	seenBits, err := c.seenBits(id)
+	if err != nil {
+		return false, err
+	}
+	return containsBits(seenBits, att.GetAggregationBits())
+}
 
+// seenBits returns the aggregation bits seen for the attestation data ID.
+func (c *AttCaches) seenBits(id attestation.Id) ([]bitfield.Bitlist, error) {
 	v, ok := c.seenAtt.Get(id.String())
-	if ok {
-		seenBits, ok := v.([]bitfield.Bitlist)
-		if !ok {
-			return false, errors.New("could not convert to bitlist type")
-		}
-		for _, bit := range seenBits {
-			if c, err := bit.Contains(att.GetAggregationBits()); err != nil {
-				return false, err
-			} else if c {
-				return true, nil
-			}
+	if !ok {
+		return nil, nil
+	}
+	seenBits, ok := v.([]bitfield.Bitlist)
+	if !ok {
+		return nil, errors.New("could not convert to bitlist type")
+	}
+	return seenBits, nil
+}
+
+// containsBits returns whether one of the seen bitlists contains all the given bits.
+func containsBits(seenBits []bitfield.Bitlist, bits bitfield.Bitlist) (bool, error) {
+	for _, seen := range seenBits {
+		if c, err := seen.Contains(bits); err != nil {
+			return false, err
+		} else if c {
+			return true, nil
 		}
 	}
 	return false, nil
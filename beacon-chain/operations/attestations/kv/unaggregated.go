@@ -4,14 +4,129 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
+// unaggregatedBucket holds the unaggregated attestations sharing the same attestation data ID,
+// so that they can be grouped for aggregation without hashing their data again.
+type unaggregatedBucket struct {
+	atts map[attestation.Id]ethpb.Att // keyed by the full attestation ID.
+	// sample is one of the attestations of the bucket, all of which share the same version and data.
+	sample ethpb.Att
+	// coverage is the union of the aggregation bits of the attestations in the bucket. It is nil when
+	// the aggregation bits can't be combined, such as bitlists of different lengths.
+	coverage bitfield.Bitlist
+}
+
+func newUnaggregatedBucket() *unaggregatedBucket {
+	return &unaggregatedBucket{atts: make(map[attestation.Id]ethpb.Att)}
+}
+
+// add adds the attestation with the given full ID to the bucket.
+func (b *unaggregatedBucket) add(id attestation.Id, att ethpb.Att) {
+	if _, ok := b.atts[id]; ok {
+		return
+	}
+	b.atts[id] = att
+	if len(b.atts) == 1 {
+		b.sample = att
+		b.coverage = bytesutil.SafeCopyBytes(att.GetAggregationBits())
+		return
+	}
+	if b.coverage == nil {
+		return
+	}
+	coverage, err := b.coverage.Or(att.GetAggregationBits())
+	if err != nil {
+		b.coverage = nil
+		return
+	}
+	b.coverage = coverage
+}
+
+// remove removes the attestations with the given full IDs from the bucket. The coverage is recomputed
+// once for all of them, and not at all when the bucket ends up empty.
+func (b *unaggregatedBucket) remove(ids ...attestation.Id) {
+	removed := false
+	for _, id := range ids {
+		if _, ok := b.atts[id]; ok {
+			delete(b.atts, id)
+			removed = true
+		}
+	}
+	if !removed {
+		return
+	}
+	if len(b.atts) == 0 {
+		b.sample, b.coverage = nil, nil
+		return
+	}
+	b.updateCoverage()
+}
+
+// updateCoverage recomputes the sample and the coverage of the bucket from its attestations.
+func (b *unaggregatedBucket) updateCoverage() {
+	b.sample, b.coverage = nil, nil
+	combinable := true
+	for _, att := range b.atts {
+		if b.sample == nil {
+			b.sample = att
+			b.coverage = bytesutil.SafeCopyBytes(att.GetAggregationBits())
+			continue
+		}
+		if !combinable {
+			continue
+		}
+		coverage, err := b.coverage.Or(att.GetAggregationBits())
+		if err != nil {
+			combinable = false
+			continue
+		}
+		b.coverage = coverage
+	}
+	if !combinable {
+		b.coverage = nil
+	}
+}
+
+// coveredBy returns whether one of the seen bitlists contains the aggregation bits of every attestation
+// in the bucket, in which case the attestations don't need to be checked one by one.
+func (b *unaggregatedBucket) coveredBy(seenBits []bitfield.Bitlist) bool {
+	if b.coverage == nil {
+		return false
+	}
+	covered, err := containsBits(seenBits, b.coverage)
+	return err == nil && covered
+}
+
+// unseen returns copies of the attestations of the bucket whose aggregation bits have not been seen yet.
+func (b *unaggregatedBucket) unseen(seenBits []bitfield.Bitlist) (*unaggregatedGroup, error) {
+	g := &unaggregatedGroup{}
+	if b.coveredBy(seenBits) {
+		return g, nil
+	}
+	g.ids = make([]attestation.Id, 0, len(b.atts))
+	g.atts = make([]ethpb.Att, 0, len(b.atts))
+	for id, att := range b.atts {
+		seen, err := containsBits(seenBits, att.GetAggregationBits())
+		if err != nil {
+			return nil, err
+		}
+		if !seen {
+			g.ids = append(g.ids, id)
+			g.atts = append(g.atts, att.Clone())
+		}
+	}
+	return g, nil
+}
+
 // SaveUnaggregatedAttestation saves an unaggregated attestation in cache.
 func (c *AttCaches) SaveUnaggregatedAttestation(att ethpb.Att) error {
 	if att == nil {
@@ -21,7 +136,15 @@ func (c *AttCaches) SaveUnaggregatedAttestation(att ethpb.Att) error {
 		return errors.New("attestation is aggregated")
 	}
 
-	seen, err := c.hasSeenBit(att)
+	dataId, err := attestation.NewId(att, attestation.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not create attestation ID")
+	}
+	seenBits, err := c.seenBits(dataId)
+	if err != nil {
+		return err
+	}
+	seen, err := containsBits(seenBits, att.GetAggregationBits())
 	if err != nil {
 		return err
 	}
@@ -36,7 +159,12 @@ func (c *AttCaches) SaveUnaggregatedAttestation(att ethpb.Att) error {
 
 	c.unAggregateAttLock.Lock()
 	defer c.unAggregateAttLock.Unlock()
-	c.unAggregatedAtt[id] = att
+	bucket, ok := c.unAggregatedAtt[dataId]
+	if !ok {
+		bucket = newUnaggregatedBucket()
+		c.unAggregatedAtt[dataId] = bucket
+	}
+	bucket.add(id, att)
 
 	return nil
 }
@@ -54,20 +182,48 @@ func (c *AttCaches) SaveUnaggregatedAttestations(atts []ethpb.Att) error {
 
 // UnaggregatedAttestations returns all the unaggregated attestations in cache.
 func (c *AttCaches) UnaggregatedAttestations() ([]ethpb.Att, error) {
+	groups, err := c.unaggregatedAttestationsByDataId()
+	if err != nil {
+		return nil, err
+	}
+	count := 0
+	for _, g := range groups {
+		count += len(g.atts)
+	}
+	atts := make([]ethpb.Att, 0, count)
+	for _, g := range groups {
+		atts = append(atts, g.atts...)
+	}
+	return atts, nil
+}
+
+// unaggregatedGroup is a copy of the unaggregated attestations of a bucket, along with their full IDs.
+type unaggregatedGroup struct {
+	ids  []attestation.Id
+	atts []ethpb.Att
+}
+
+// unaggregatedAttestationsByDataId returns copies of the unaggregated attestations in cache whose
+// aggregation bits have not been seen yet, grouped by attestation data ID.
+func (c *AttCaches) unaggregatedAttestationsByDataId() (map[attestation.Id]*unaggregatedGroup, error) {
 	c.unAggregateAttLock.RLock()
 	defer c.unAggregateAttLock.RUnlock()
-	unAggregatedAtts := c.unAggregatedAtt
-	atts := make([]ethpb.Att, 0, len(unAggregatedAtts))
-	for _, att := range unAggregatedAtts {
-		seen, err := c.hasSeenBit(att)
+	groups := make(map[attestation.Id]*unaggregatedGroup, len(c.unAggregatedAtt))
+	for dataId, bucket := range c.unAggregatedAtt {
+		seenBits, err := c.seenBits(dataId)
 		if err != nil {
 			return nil, err
 		}
-		if !seen {
-			atts = append(atts, att.Clone())
+		g, err := bucket.unseen(seenBits)
+		if err != nil {
+			return nil, err
+		}
+		if len(g.atts) == 0 {
+			continue
 		}
+		groups[dataId] = g
 	}
-	return atts, nil
+	return groups, nil
 }
 
 // UnaggregatedAttestationsBySlotIndex returns the unaggregated attestations in cache,
@@ -85,9 +241,12 @@ func (c *AttCaches) UnaggregatedAttestationsBySlotIndex(
 	c.unAggregateAttLock.RLock()
 	defer c.unAggregateAttLock.RUnlock()
 
-	unAggregatedAtts := c.unAggregatedAtt
-	for _, a := range unAggregatedAtts {
-		if a.Version() == version.Phase0 && slot == a.GetData().Slot && committeeIndex == a.GetData().CommitteeIndex {
+	for _, bucket := range c.unAggregatedAtt {
+		s := bucket.sample
+		if s == nil || s.Version() != version.Phase0 || slot != s.GetData().Slot || committeeIndex != s.GetData().CommitteeIndex {
+			continue
+		}
+		for _, a := range bucket.atts {
 			att, ok := a.(*ethpb.Attestation)
 			// This will never fail in practice because we asserted the version
 			if ok {
@@ -114,9 +273,13 @@ func (c *AttCaches) UnaggregatedAttestationsBySlotIndexElectra(
 	c.unAggregateAttLock.RLock()
 	defer c.unAggregateAttLock.RUnlock()
 
-	unAggregatedAtts := c.unAggregatedAtt
-	for _, a := range unAggregatedAtts {
-		if a.Version() == version.Electra && slot == a.GetData().Slot && a.CommitteeBitsVal().BitAt(uint64(committeeIndex)) {
+	for _, bucket := range c.unAggregatedAtt {
+		// The attestations of a bucket share the same committee bits as well.
+		s := bucket.sample
+		if s == nil || s.Version() != version.Electra || slot != s.GetData().Slot || !s.CommitteeBitsVal().BitAt(uint64(committeeIndex)) {
+			continue
+		}
+		for _, a := range bucket.atts {
 			att, ok := a.(*ethpb.AttestationElectra)
 			// This will never fail in practice because we asserted the version
 			if ok {
@@ -137,7 +300,11 @@ func (c *AttCaches) DeleteUnaggregatedAttestation(att ethpb.Att) error {
 		return errors.New("attestation is aggregated")
 	}
 
-	if err := c.insertSeenBit(att); err != nil {
+	dataId, err := attestation.NewId(att, attestation.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not create attestation ID")
+	}
+	if err := c.insertSeenBits(dataId, att.GetAggregationBits()); err != nil {
 		return err
 	}
 
@@ -148,11 +315,24 @@ func (c *AttCaches) DeleteUnaggregatedAttestation(att ethpb.Att) error {
 
 	c.unAggregateAttLock.Lock()
 	defer c.unAggregateAttLock.Unlock()
-	delete(c.unAggregatedAtt, id)
+	c.deleteUnaggregated(dataId, id)
 
 	return nil
 }
 
+// deleteUnaggregated removes the attestations with the given full IDs from the bucket of the given data ID,
+// along with the bucket once empty. The caller must hold the unaggregated attestations lock.
+func (c *AttCaches) deleteUnaggregated(dataId attestation.Id, ids ...attestation.Id) {
+	bucket, ok := c.unAggregatedAtt[dataId]
+	if !ok {
+		return
+	}
+	bucket.remove(ids...)
+	if len(bucket.atts) == 0 {
+		delete(c.unAggregatedAtt, dataId)
+	}
+}
+
 // DeleteSeenUnaggregatedAttestations deletes the unaggregated attestations in cache
 // that have been already processed once. Returns number of attestations deleted.
 func (c *AttCaches) DeleteSeenUnaggregatedAttestations() (int, error) {
@@ -160,13 +340,29 @@ func (c *AttCaches) DeleteSeenUnaggregatedAttestations() (int, error) {
 	defer c.unAggregateAttLock.Unlock()
 
 	count := 0
-	for r, att := range c.unAggregatedAtt {
-		if att == nil || helpers.IsAggregated(att) {
+	for dataId, bucket := range c.unAggregatedAtt {
+		seenBits, err := c.seenBits(dataId)
+		if err != nil || len(seenBits) == 0 {
+			continue
+		}
+		if bucket.coveredBy(seenBits) {
+			count += len(bucket.atts)
+			delete(c.unAggregatedAtt, dataId)
 			continue
 		}
-		if seen, err := c.hasSeenBit(att); err == nil && seen {
-			delete(c.unAggregatedAtt, r)
-			count++
+		for id, att := range bucket.atts {
+			if att == nil || helpers.IsAggregated(att) {
+				continue
+			}
+			if seen, err := containsBits(seenBits, att.GetAggregationBits()); err == nil && seen {
+				delete(bucket.atts, id)
+				count++
+			}
+		}
+		if len(bucket.atts) == 0 {
+			delete(c.unAggregatedAtt, dataId)
+		} else {
+			bucket.updateCoverage()
 		}
 	}
 	return count, nil
@@ -176,5 +372,9 @@ func (c *AttCaches) DeleteSeenUnaggregatedAttestations() (int, error) {
 func (c *AttCaches) UnaggregatedAttestationCount() int {
 	c.unAggregateAttLock.RLock()
 	defer c.unAggregateAttLock.RUnlock()
-	return len(c.unAggregatedAtt)
+	count := 0
+	for _, bucket := range c.unAggregatedAtt {
+		count += len(bucket.atts)
+	}
+	return count
 }
@@ -279,3 +279,77 @@ func TestKV_Unaggregated_UnaggregatedAttestationsBySlotIndexElectra(t *testing.T
 	returned = cache.UnaggregatedAttestationsBySlotIndexElectra(ctx, 2, 1)
 	assert.DeepEqual(t, []*ethpb.AttestationElectra{att3}, returned)
 }
+
+func TestKV_Unaggregated_Buckets(t *testing.T) {
+	d := util.HydrateAttestationData(&ethpb.AttestationData{Slot: 1})
+	atts := []ethpb.Att{
+		util.HydrateAttestation(&ethpb.Attestation{Data: d, AggregationBits: bitfield.Bitlist{0b10001}}),
+		util.HydrateAttestation(&ethpb.Attestation{Data: d, AggregationBits: bitfield.Bitlist{0b10010}}),
+		util.HydrateAttestation(&ethpb.Attestation{Data: d, AggregationBits: bitfield.Bitlist{0b10100}}),
+		util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 2}, AggregationBits: bitfield.Bitlist{0b10001}}),
+	}
+	dataId, err := attestation.NewId(atts[0], attestation.Data)
+	require.NoError(t, err)
+
+	t.Run("attestations sharing data share a bucket", func(t *testing.T) {
+		cache := NewAttCaches()
+		require.NoError(t, cache.SaveUnaggregatedAttestations(atts))
+		assert.Equal(t, 2, len(cache.unAggregatedAtt))
+		assert.Equal(t, 4, cache.UnaggregatedAttestationCount())
+		bucket := cache.unAggregatedAtt[dataId]
+		require.NotNil(t, bucket)
+		assert.Equal(t, 3, len(bucket.atts))
+		assert.DeepEqual(t, bitfield.Bitlist{0b10111}, bucket.coverage)
+
+		require.NoError(t, cache.DeleteUnaggregatedAttestation(atts[1]))
+		assert.DeepEqual(t, bitfield.Bitlist{0b10101}, bucket.coverage)
+		require.NoError(t, cache.DeleteUnaggregatedAttestation(atts[0]))
+		require.NoError(t, cache.DeleteUnaggregatedAttestation(atts[2]))
+		// The bucket is dropped once empty.
+		_, ok := cache.unAggregatedAtt[dataId]
+		assert.Equal(t, false, ok)
+		assert.Equal(t, 1, cache.UnaggregatedAttestationCount())
+	})
+	t.Run("remove several attestations at once", func(t *testing.T) {
+		bucket := newUnaggregatedBucket()
+		ids := make([]attestation.Id, 3)
+		for i, att := range atts[:3] {
+			ids[i], err = attestation.NewId(att, attestation.Full)
+			require.NoError(t, err)
+			bucket.add(ids[i], att)
+		}
+		bucket.remove(ids[0], ids[2])
+		assert.Equal(t, 1, len(bucket.atts))
+		assert.DeepEqual(t, bitfield.Bitlist{0b10010}, bucket.coverage)
+		bucket.remove(ids...)
+		assert.Equal(t, 0, len(bucket.atts))
+		assert.Equal(t, true, bucket.sample == nil)
+		assert.DeepEqual(t, bitfield.Bitlist(nil), bucket.coverage)
+	})
+	t.Run("covered bucket is skipped", func(t *testing.T) {
+		cache := NewAttCaches()
+		require.NoError(t, cache.SaveUnaggregatedAttestations(atts[:3]))
+		require.NoError(t, cache.insertSeenBits(dataId, bitfield.Bitlist{0b10111}))
+		returned, err := cache.UnaggregatedAttestations()
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(returned))
+		count, err := cache.DeleteSeenUnaggregatedAttestations()
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		assert.Equal(t, 0, len(cache.unAggregatedAtt))
+	})
+	t.Run("bitlists of different lengths", func(t *testing.T) {
+		cache := NewAttCaches()
+		require.NoError(t, cache.SaveUnaggregatedAttestations(atts[:2]))
+		odd := util.HydrateAttestation(&ethpb.Attestation{Data: d, AggregationBits: bitfield.Bitlist{0b1000}})
+		require.NoError(t, cache.SaveUnaggregatedAttestation(odd))
+		// The bucket has no coverage summary, its attestations are checked one by one.
+		assert.DeepEqual(t, bitfield.Bitlist(nil), cache.unAggregatedAtt[dataId].coverage)
+		returned, err := cache.UnaggregatedAttestations()
+		require.NoError(t, err)
+		assert.Equal(t, 3, len(returned))
+
+		require.NoError(t, cache.DeleteUnaggregatedAttestation(odd))
+		assert.DeepEqual(t, bitfield.Bitlist{0b10011}, cache.unAggregatedAtt[dataId].coverage)
+	})
+}
@@ -0,0 +1,185 @@
+package attestations
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// maxPersistedAttestations bounds the number of attestations saved on shutdown. The most recent attestations are
+// kept when the pool holds more.
+var maxPersistedAttestations = 1 << 16
+
+// HeadStateFetcher provides the head state that reloaded attestations are validated against.
+type HeadStateFetcher interface {
+	HeadState(ctx context.Context) (state.BeaconState, error)
+}
+
+// SetHeadStateFetcher sets the head state fetcher used to validate the attestations reloaded on startup.
+func (s *Service) SetHeadStateFetcher(f HeadStateFetcher) {
+	s.headStateFetcher = f
+}
+
+// savePool saves the aggregated and unaggregated attestations of the pool that have not expired, so that they can
+// be reloaded if the node restarts within the same epoch.
+func (s *Service) savePool(ctx context.Context) error {
+	if s.genesisTime == 0 {
+		return nil
+	}
+	unaggregated, err := s.cfg.Pool.UnaggregatedAttestations()
+	if err != nil {
+		return errors.Wrap(err, "could not get unaggregated attestations")
+	}
+	var atts []ethpb.Att
+	for _, att := range append(s.cfg.Pool.AggregatedAttestations(), unaggregated...) {
+		if !s.expired(att.GetData().Slot) {
+			atts = append(atts, att)
+		}
+	}
+	if len(atts) > maxPersistedAttestations {
+		sort.SliceStable(atts, func(i, j int) bool {
+			return atts[i].GetData().Slot > atts[j].GetData().Slot
+		})
+		atts = atts[:maxPersistedAttestations]
+	}
+
+	currentSlot := slots.CurrentSlot(s.genesisTime)
+	if err := s.cfg.BeaconDB.SaveAttestationPool(ctx, currentSlot, atts); err != nil {
+		return errors.Wrap(err, "could not save attestation pool")
+	}
+	log.WithFields(logrus.Fields{
+		"slot":         currentSlot,
+		"attestations": len(atts),
+	}).Info("Saved attestation pool")
+	return nil
+}
+
+// reloadPool inserts the attestations saved on shutdown back into the pool when the node restarts within the epoch
+// of the shutdown. Expired attestations and attestations that are no longer valid against the head state are
+// discarded. The saved attestations are deleted in all cases.
+func (s *Service) reloadPool(ctx context.Context) error {
+	savedSlot, atts, err := s.cfg.BeaconDB.AttestationPool(ctx)
+	if errors.Is(err, db.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get saved attestation pool")
+	}
+	defer func() {
+		if err := s.cfg.BeaconDB.DeleteAttestationPool(ctx); err != nil {
+			log.WithError(err).Error("Could not delete saved attestation pool")
+		}
+	}()
+
+	currentSlot := slots.CurrentSlot(s.genesisTime)
+	if slots.ToEpoch(savedSlot) != slots.ToEpoch(currentSlot) {
+		log.WithFields(logrus.Fields{
+			"savedSlot":   savedSlot,
+			"currentSlot": currentSlot,
+		}).Debug("Discarding attestation pool saved in a previous epoch")
+		return nil
+	}
+	if s.headStateFetcher == nil {
+		return errors.New("no head state fetcher to validate the saved attestation pool")
+	}
+
+	unexpired := make([]ethpb.Att, 0, len(atts))
+	for _, att := range atts {
+		if !s.expired(att.GetData().Slot) {
+			unexpired = append(unexpired, att)
+		}
+	}
+	st, err := s.headStateFetcher.HeadState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	if st.Slot() < currentSlot {
+		st, err = transition.ProcessSlots(ctx, st, currentSlot)
+		if err != nil {
+			return errors.Wrapf(err, "could not process head state to slot %d", currentSlot)
+		}
+	}
+	valid := validAttestations(ctx, st, unexpired)
+
+	var aggregated, unaggregated []ethpb.Att
+	for _, att := range valid {
+		if helpers.IsAggregated(att) {
+			aggregated = append(aggregated, att)
+		} else {
+			unaggregated = append(unaggregated, att)
+		}
+	}
+	if err := s.cfg.Pool.SaveAggregatedAttestations(aggregated); err != nil {
+		return errors.Wrap(err, "could not save aggregated attestations")
+	}
+	if err := s.cfg.Pool.SaveUnaggregatedAttestations(unaggregated); err != nil {
+		return errors.Wrap(err, "could not save unaggregated attestations")
+	}
+	log.WithFields(logrus.Fields{
+		"saved":    len(atts),
+		"expired":  len(atts) - len(unexpired),
+		"invalid":  len(unexpired) - len(valid),
+		"reloaded": len(valid),
+	}).Info("Reloaded saved attestation pool")
+	return nil
+}
+
+// validAttestations returns the attestations that can be included in a block built on top of the given state: their
+// target is the current or previous epoch of the state, their source is the matching justified checkpoint of the
+// state and their signature verifies against the committees of the state.
+func validAttestations(ctx context.Context, st state.ReadOnlyBeaconState, atts []ethpb.Att) []ethpb.Att {
+	currentEpoch := coreTime.CurrentEpoch(st)
+	previousEpoch := coreTime.PrevEpoch(st)
+	candidates := make([]ethpb.Att, 0, len(atts))
+	for _, att := range atts {
+		data := att.GetData()
+		if err := helpers.ValidateSlotTargetEpoch(data); err != nil {
+			continue
+		}
+		var justified *ethpb.Checkpoint
+		switch data.Target.Epoch {
+		case currentEpoch:
+			justified = st.CurrentJustifiedCheckpoint()
+		case previousEpoch:
+			justified = st.PreviousJustifiedCheckpoint()
+		default:
+			continue
+		}
+		if data.Source.Epoch != justified.Epoch || !bytes.Equal(data.Source.Root, justified.Root) {
+			continue
+		}
+		candidates = append(candidates, att)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	set, err := blocks.AttestationSignatureBatch(ctx, st, candidates)
+	if err == nil {
+		if verified, err := set.Verify(); err == nil && verified {
+			return candidates
+		}
+	}
+	valid := make([]ethpb.Att, 0, len(candidates))
+	for _, att := range candidates {
+		set, err := blocks.AttestationSignatureBatch(ctx, st, []ethpb.Att{att})
+		if err != nil {
+			continue
+		}
+		if verified, err := set.Verify(); err == nil && verified {
+			valid = append(valid, att)
+		}
+	}
+	return valid
+}
@@ -0,0 +1,89 @@
+package attestations
+
+import (
+	"context"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+type mockHeadStateFetcher struct {
+	st state.BeaconState
+}
+
+func (m *mockHeadStateFetcher) HeadState(context.Context) (state.BeaconState, error) {
+	return m.st.Copy(), nil
+}
+
+func TestReloadPool_HeadChange(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	genesisTime := uint64(prysmTime.Now().Unix()) - params.BeaconConfig().SecondsPerSlot
+
+	oldHead, keys := util.DeterministicGenesisState(t, 64)
+	oldAggregated, err := util.GenerateAttestations(oldHead, keys, 1, 0, false)
+	require.NoError(t, err)
+	oldUnaggregated, err := util.GenerateAttestations(oldHead, keys, 2, 0, false)
+	require.NoError(t, err)
+
+	// The new head justifies a different checkpoint, which invalidates the attestations made on top of the old head.
+	newHead := oldHead.Copy()
+	require.NoError(t, newHead.SetCurrentJustifiedCheckpoint(&ethpb.Checkpoint{Root: bytesutil.PadTo([]byte{'a'}, 32)}))
+	newAggregated, err := util.GenerateAttestations(newHead, keys, 1, 0, false)
+	require.NoError(t, err)
+	newUnaggregated, err := util.GenerateAttestations(newHead, keys, 2, 0, false)
+	require.NoError(t, err)
+
+	s, err := NewService(ctx, &Config{Pool: NewPool(), BeaconDB: beaconDB})
+	require.NoError(t, err)
+	s.genesisTime = genesisTime
+	require.NoError(t, s.cfg.Pool.SaveAggregatedAttestations(append(oldAggregated, newAggregated...)))
+	require.NoError(t, s.cfg.Pool.SaveUnaggregatedAttestations(append(oldUnaggregated, newUnaggregated...)))
+	require.NoError(t, s.savePool(ctx))
+
+	restarted, err := NewService(ctx, &Config{Pool: NewPool(), BeaconDB: beaconDB})
+	require.NoError(t, err)
+	restarted.genesisTime = genesisTime
+	restarted.SetHeadStateFetcher(&mockHeadStateFetcher{st: newHead})
+	require.NoError(t, restarted.reloadPool(ctx))
+
+	assert.DeepSSZEqual(t, newAggregated, restarted.cfg.Pool.AggregatedAttestations())
+	unaggregated, err := restarted.cfg.Pool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	require.Equal(t, len(newUnaggregated), len(unaggregated))
+	for _, att := range unaggregated {
+		assert.DeepEqual(t, newHead.CurrentJustifiedCheckpoint(), att.GetData().Source)
+	}
+
+	// The saved attestations are only reloaded once.
+	_, _, err = beaconDB.AttestationPool(ctx)
+	require.ErrorContains(t, "not found", err)
+}
+
+func TestReloadPool_PreviousEpoch(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+
+	head, keys := util.DeterministicGenesisState(t, 64)
+	atts, err := util.GenerateAttestations(head, keys, 2, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveAttestationPool(ctx, 0, atts))
+
+	s, err := NewService(ctx, &Config{Pool: NewPool(), BeaconDB: beaconDB})
+	require.NoError(t, err)
+	s.genesisTime = uint64(prysmTime.Now().Unix()) - uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot))
+	s.SetHeadStateFetcher(&mockHeadStateFetcher{st: head})
+	require.NoError(t, s.reloadPool(ctx))
+
+	assert.Equal(t, 0, s.cfg.Pool.UnaggregatedAttestationCount())
+	_, _, err = beaconDB.AttestationPool(ctx)
+	require.ErrorContains(t, "not found", err)
+}
@@ -42,6 +42,9 @@ type Pool interface {
 	ForkchoiceAttestations() []ethpb.Att
 	DeleteForkchoiceAttestation(att ethpb.Att) error
 	ForkchoiceAttestationCount() int
+	// For the provenance of the attestations in the pool.
+	SaveAttestationProvenance(att ethpb.Att, source kv.AttestationSource, aggregatorIndex primitives.ValidatorIndex) error
+	AttestationSources(att ethpb.Att) ([]kv.AttestationSourceBits, uint64, error)
 }
 
 // NewPool initializes a new attestation pool.
@@ -9,7 +9,9 @@ import (
 	"time"
 
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 )
 
@@ -23,6 +25,7 @@ type Service struct {
 	err                     error
 	forkChoiceProcessedAtts *lru.Cache
 	genesisTime             uint64
+	headStateFetcher        HeadStateFetcher
 }
 
 // Config options for the service.
@@ -30,6 +33,7 @@ type Config struct {
 	Pool                Pool
 	pruneInterval       time.Duration
 	InitialSyncComplete chan struct{}
+	BeaconDB            db.NoHeadAccessDatabase
 }
 
 // NewService instantiates a new attestation pool service instance that will
@@ -57,6 +61,11 @@ func (s *Service) Start() {
 		log.WithError(err).Error("failed to wait for initial sync")
 		return
 	}
+	if s.persistPool() {
+		if err := s.reloadPool(s.ctx); err != nil {
+			log.WithError(err).Error("Could not reload saved attestation pool")
+		}
+	}
 	go s.prepareForkChoiceAtts()
 	go s.pruneAttsPool()
 }
@@ -75,9 +84,19 @@ func (s *Service) waitForSync(syncChan chan struct{}) error {
 // and associated goroutines.
 func (s *Service) Stop() error {
 	defer s.cancel()
+	if s.persistPool() {
+		if err := s.savePool(s.ctx); err != nil {
+			log.WithError(err).Error("Could not save attestation pool")
+		}
+	}
 	return nil
 }
 
+// persistPool returns true if the attestation pool is saved on shutdown and reloaded on startup.
+func (s *Service) persistPool() bool {
+	return features.Get().PersistAttestationPool && s.cfg.BeaconDB != nil
+}
+
 // Status returns the current service err if there's any.
 func (s *Service) Status() error {
 	if s.err != nil {
@@ -2,7 +2,6 @@ package slashings
 
 import (
 	"context"
-	"fmt"
 	"sort"
 
 	"github.com/pkg/errors"
@@ -18,6 +17,15 @@ import (
 	"github.com/trailofbits/go-mutexasserts"
 )
 
+var (
+	// ErrSlashingKnown is returned when a slashing is inserted for validators which already have a slashing pending in
+	// the pool, or recently included in a block.
+	ErrSlashingKnown = errors.New("slashing is already known")
+	// ErrNotSlashable is returned when a slashing is inserted for validators which cannot be slashed, as they are
+	// already slashed or exited.
+	ErrNotSlashable = errors.New("validator is not slashable")
+)
+
 // NewPool returns an initialized attester slashing and proposer slashing pool.
 func NewPool() *Pool {
 	return &Pool{
@@ -117,7 +125,9 @@ func (p *Pool) PendingProposerSlashings(ctx context.Context, state state.ReadOnl
 }
 
 // InsertAttesterSlashing into the pool. This method is a no-op if the attester slashing already exists in the pool,
-// has been included into a block recently, or the validator is already exited.
+// has been included into a block recently, or the validator is already exited. When none of the validators of the
+// slashing can be slashed, the error is ErrSlashingKnown if any of them already has a slashing known to the pool, and
+// ErrNotSlashable otherwise.
 func (p *Pool) InsertAttesterSlashing(
 	ctx context.Context,
 	state state.ReadOnlyBeaconState,
@@ -135,6 +145,7 @@ func (p *Pool) InsertAttesterSlashing(
 	slashedVal := slice.IntersectionUint64(slashing.FirstAttestation().GetAttestingIndices(), slashing.SecondAttestation().GetAttestingIndices())
 	cantSlash := make([]uint64, 0, len(slashedVal))
 	slashingReason := ""
+	known := false
 	for _, val := range slashedVal {
 		// Has this validator index been included recently?
 		ok, err := p.validatorSlashingPreconditionCheck(state, primitives.ValidatorIndex(val))
@@ -146,6 +157,7 @@ func (p *Pool) InsertAttesterSlashing(
 		if !ok {
 			slashingReason = "validator already exited/slashed or already recently included in slashings pool"
 			cantSlash = append(cantSlash, val)
+			known = known || p.included[primitives.ValidatorIndex(val)]
 			continue
 		}
 
@@ -157,6 +169,7 @@ func (p *Pool) InsertAttesterSlashing(
 		if found != len(p.pendingAttesterSlashing) && uint64(p.pendingAttesterSlashing[found].validatorToSlash) == val {
 			slashingReason = "validator already exist in list of pending slashings, no need to attempt to slash again"
 			cantSlash = append(cantSlash, val)
+			known = true
 			continue
 		}
 
@@ -172,7 +185,12 @@ func (p *Pool) InsertAttesterSlashing(
 		numPendingAttesterSlashings.Set(float64(len(p.pendingAttesterSlashing)))
 	}
 	if len(cantSlash) == len(slashedVal) {
-		return fmt.Errorf(
+		cause := ErrNotSlashable
+		if known {
+			cause = ErrSlashingKnown
+		}
+		return errors.Wrapf(
+			cause,
 			"could not slash any of %d validators in submitted slashing: %s",
 			len(slashedVal),
 			slashingReason,
@@ -182,7 +200,9 @@ func (p *Pool) InsertAttesterSlashing(
 }
 
 // InsertProposerSlashing into the pool. This method is a no-op if the pending slashing already exists,
-// has been included recently, the validator is already exited, or the validator was already slashed.
+// has been included recently, the validator is already exited, or the validator was already slashed. The error is
+// ErrSlashingKnown when the slashing of the proposer is already pending or recently included, and ErrNotSlashable when
+// the proposer cannot be slashed.
 func (p *Pool) InsertProposerSlashing(
 	ctx context.Context,
 	state state.ReadOnlyBeaconState,
@@ -206,7 +226,11 @@ func (p *Pool) InsertProposerSlashing(
 	// has been recently included in the pool of slashings, do not process this new
 	// slashing.
 	if !ok {
-		return fmt.Errorf("validator at index %d cannot be slashed", idx)
+		cause := ErrNotSlashable
+		if p.included[idx] {
+			cause = ErrSlashingKnown
+		}
+		return errors.Wrapf(cause, "validator at index %d cannot be slashed", idx)
 	}
 
 	// Check if the validator already exists in the list of slashings.
@@ -216,7 +240,7 @@ func (p *Pool) InsertProposerSlashing(
 	})
 	if found != len(p.pendingProposerSlashing) && p.pendingProposerSlashing[found].Header_1.Header.ProposerIndex ==
 		slashing.Header_1.Header.ProposerIndex {
-		return errors.New("slashing object already exists in pending proposer slashings")
+		return errors.Wrap(ErrSlashingKnown, "slashing object already exists in pending proposer slashings")
 	}
 
 	// Insert into pending list and sort again.
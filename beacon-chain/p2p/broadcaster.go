@@ -289,7 +289,11 @@ func (s *Service) broadcastObject(ctx context.Context, obj ssz.Marshaler, topic
 		iid := int64(id)
 		span = trace.AddMessageSendEvent(span, iid, messageLen /*uncompressed*/, messageLen /*compressed*/)
 	}
-	if err := s.PublishToTopic(ctx, topic+s.Encoding().ProtocolSuffix(), buf.Bytes()); err != nil {
+	topic += s.Encoding().ProtocolSuffix()
+	// Track the message before publishing it, as peers may send it back right away.
+	id := s.propagation.track(s.genesisValidatorsRoot, buf.Bytes(), topic)
+	if err := s.PublishToTopic(ctx, topic, buf.Bytes()); err != nil {
+		s.propagation.untrack(id)
 		err := errors.Wrap(err, "could not publish message")
 		tracing.AnnotateError(span, err)
 		return err
@@ -1,6 +1,8 @@
 package p2p
 
 import (
+	"time"
+
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
@@ -29,8 +31,10 @@ type Config struct {
 	UDPPort              uint
 	MaxPeers             uint
 	QueueSize            uint
+	PropagationWindow    time.Duration
 	AllowListCIDR        string
 	DenyListCIDR         []string
+	CustodySubnetCount   uint64
 	StateNotifier        statefeed.Notifier
 	DB                   db.ReadOnlyDatabase
 	ClockWaiter          startup.ClockWaiter
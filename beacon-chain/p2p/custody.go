@@ -0,0 +1,77 @@
+package p2p
+
+import (
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+)
+
+var custodySubnetCountEnrKey = params.BeaconNetworkConfig().CustodySubnetCountKey
+
+// Csc is the ENR entry of the number of data column sidecar subnets custodied by a node.
+type Csc uint64
+
+// ENRKey returns the key of the custody subnet count entry.
+func (Csc) ENRKey() string { return custodySubnetCountEnrKey }
+
+// validateCustodySubnetCount checks the node custodies at least the required number of data column sidecar subnets,
+// and no more than the number of subnets. A count of 0 disables the custody of data column sidecars.
+func validateCustodySubnetCount(count uint64) error {
+	if count == 0 {
+		return nil
+	}
+	cfg := params.BeaconConfig()
+	if count < cfg.CustodyRequirement || count > cfg.DataColumnSidecarSubnetCount {
+		return errors.Errorf("custody subnet count %d must be between %d and %d", count, cfg.CustodyRequirement, cfg.DataColumnSidecarSubnetCount)
+	}
+	return nil
+}
+
+// Adds the custody subnet count entry to the node's ENR, unless the node custodies no data column sidecar subnet.
+func initializeCustodySubnetCount(node *enode.LocalNode, count uint64) *enode.LocalNode {
+	if count == 0 {
+		return node
+	}
+	node.Set(Csc(count))
+	return node
+}
+
+// Reads the custody subnet count entry from a node's ENR.
+func custodySubnetCountFromRecord(record *enr.Record) (uint64, error) {
+	if record == nil {
+		return 0, errors.New("nil enr record")
+	}
+	var csc Csc
+	if err := record.Load(&csc); err != nil {
+		return 0, errors.Wrap(err, "could not load custody subnet count")
+	}
+	return uint64(csc), nil
+}
+
+// CustodySubnetCount returns the number of data column sidecar subnets custodied by the node, 0 when the node does
+// not custody data column sidecars.
+func (s *Service) CustodySubnetCount() uint64 {
+	return s.cfg.CustodySubnetCount
+}
+
+// CustodySubnets returns the data column sidecar subnets custodied by the node, computed from its node ID.
+func (s *Service) CustodySubnets() map[uint64]bool {
+	return s.custodySubnets
+}
+
+// CustodySubnetCountFromPeer returns the number of data column sidecar subnets custodied by the peer, as advertised
+// in its ENR. Peers which do not advertise it are assumed to custody the required number of subnets.
+func (s *Service) CustodySubnetCountFromPeer(pid peer.ID) uint64 {
+	record, err := s.peers.ENR(pid)
+	if err != nil || record == nil {
+		return params.BeaconConfig().CustodyRequirement
+	}
+	count, err := custodySubnetCountFromRecord(record)
+	if err != nil {
+		log.WithError(err).WithField("peerID", pid).Trace("Could not read the custody subnet count of the peer")
+		return params.BeaconConfig().CustodyRequirement
+	}
+	return count
+}
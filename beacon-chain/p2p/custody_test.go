@@ -0,0 +1,98 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/network"
+	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestCustodySubnetCountRecord(t *testing.T) {
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	defer db.Close()
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	localNode := initializeCustodySubnetCount(enode.NewLocalNode(db, priv), 0)
+	_, err = custodySubnetCountFromRecord(localNode.Node().Record())
+	require.ErrorContains(t, "could not load custody subnet count", err)
+
+	localNode = initializeCustodySubnetCount(localNode, 16)
+	count, err := custodySubnetCountFromRecord(localNode.Node().Record())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(16), count)
+}
+
+func TestValidateCustodySubnetCount(t *testing.T) {
+	cfg := params.BeaconConfig()
+	require.NoError(t, validateCustodySubnetCount(0))
+	require.NoError(t, validateCustodySubnetCount(cfg.CustodyRequirement))
+	require.NoError(t, validateCustodySubnetCount(cfg.DataColumnSidecarSubnetCount))
+	require.ErrorContains(t, "custody subnet count", validateCustodySubnetCount(cfg.CustodyRequirement-1))
+	require.ErrorContains(t, "custody subnet count", validateCustodySubnetCount(cfg.DataColumnSidecarSubnetCount+1))
+}
+
+func TestService_CustodySubnets(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{CustodySubnetCount: 8, ClockWaiter: startup.NewClockSynchronizer()})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), s.CustodySubnetCount())
+	want, err := peerdas.CustodySubnets(enode.PubkeyToIDV4(&s.privKey.PublicKey), 8)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, s.CustodySubnets())
+
+	_, err = NewService(context.Background(), &Config{CustodySubnetCount: 1, ClockWaiter: startup.NewClockSynchronizer()})
+	require.ErrorContains(t, "custody subnet count", err)
+}
+
+func TestService_CustodySubnetCountFromPeer(t *testing.T) {
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	defer db.Close()
+	ids := libp2ptest.GeneratePeerIDs(3)
+
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	s.peers.Add(enode.NewLocalNode(db, priv).Node().Record(), ids[0], nil, network.DirOutbound)
+	priv, err = crypto.GenerateKey()
+	require.NoError(t, err)
+	s.peers.Add(initializeCustodySubnetCount(enode.NewLocalNode(db, priv), 32).Node().Record(), ids[1], nil, network.DirOutbound)
+
+	// Peers which do not advertise their custody subnet count custody the required subnets.
+	assert.Equal(t, params.BeaconConfig().CustodyRequirement, s.CustodySubnetCountFromPeer(ids[0]))
+	assert.Equal(t, uint64(32), s.CustodySubnetCountFromPeer(ids[1]))
+	assert.Equal(t, params.BeaconConfig().CustodyRequirement, s.CustodySubnetCountFromPeer(ids[2]))
+}
+
+func TestService_CanSubscribe_DataColumns(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	genesisTime := time.Now()
+	var valRoot [32]byte
+	digest, err := forks.CreateForkDigest(genesisTime, valRoot[:])
+	require.NoError(t, err)
+	topic := fmt.Sprintf(DataColumnSubnetTopicFormat, digest, 5) + "/" + encoder.ProtocolSuffixSSZSnappy
+
+	s := &Service{genesisValidatorsRoot: valRoot[:], genesisTime: genesisTime, cfg: &Config{}}
+	assert.Equal(t, false, s.CanSubscribe(topic))
+	s.cfg.CustodySubnetCount = params.BeaconConfig().CustodyRequirement
+	assert.Equal(t, true, s.CanSubscribe(topic))
+}
@@ -20,10 +20,15 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	ecdsaprysm "github.com/prysmaticlabs/prysm/v5/crypto/ecdsa"
+	prysmnetwork "github.com/prysmaticlabs/prysm/v5/network"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
 )
 
+// ErrDiscoveryNotRunning is returned when updating the ENR of our node while discovery is not running.
+var ErrDiscoveryNotRunning = errors.New("discovery is not running")
+
 type ListenerRebooter interface {
 	Listener
 	RebootListener() error
@@ -137,6 +142,9 @@ func (l *listenerWrapper) RebootListener() error {
 // with the tracked committee ids for the epoch, allowing our node
 // to be dynamically discoverable by others given our tracked committee ids.
 func (s *Service) RefreshENR() {
+	s.enrLock.Lock()
+	defer s.enrLock.Unlock()
+
 	// return early if discv5 isn't running
 	if s.dv5Listener == nil || !s.isInitialized() {
 		return
@@ -192,6 +200,61 @@ func (s *Service) RefreshENR() {
 	s.pingPeers()
 }
 
+// RebuildENR re-evaluates the external IP address of our node and re-signs its ENR with a bumped
+// sequence number, even when no entry changed, so that peers fetch the current record. Unlike
+// RefreshENR, which runs periodically, it is meant to be triggered on demand, e.g. after the
+// IP address of the node changed.
+func (s *Service) RebuildENR() (*enr.Record, error) {
+	s.enrLock.Lock()
+	defer s.enrLock.Unlock()
+
+	if s.dv5Listener == nil || !s.isInitialized() {
+		return nil, ErrDiscoveryNotRunning
+	}
+	ip, err := s.externalIPAddr()
+	if err != nil {
+		return nil, err
+	}
+	localNode := s.dv5Listener.LocalNode()
+	if s.cfg.HostAddress != "" {
+		localNode.SetStaticIP(ip)
+	}
+	localNode.SetFallbackIP(ip)
+	// Removing and adding back an entry invalidates the record, so it is re-signed with the next sequence number.
+	localNode.Delete(enr.TCP(0))
+	localNode.Set(enr.TCP(s.cfg.TCPPort))
+
+	node := localNode.Node()
+	log.WithFields(logrus.Fields{
+		"seq": node.Seq(),
+		"ip":  node.IP(),
+	}).Info("Rebuilt ENR")
+	return node.Record(), nil
+}
+
+// externalIPAddr returns the IP address to advertise in our ENR, with the same precedence as on startup:
+// the configured host address, then the first address the configured host DNS name resolves to, and
+// finally the address of the local network interface.
+func (s *Service) externalIPAddr() (net.IP, error) {
+	if s.cfg.HostAddress != "" {
+		ip := net.ParseIP(s.cfg.HostAddress)
+		if ip == nil {
+			return nil, errors.Errorf("invalid host address: %s", s.cfg.HostAddress)
+		}
+		return ip, nil
+	}
+	if s.cfg.HostDNS != "" {
+		ips, err := net.LookupIP(s.cfg.HostDNS)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not resolve host address: %s", s.cfg.HostDNS)
+		}
+		if len(ips) > 0 {
+			return ips[0], nil
+		}
+	}
+	return prysmnetwork.IPAddr(), nil
+}
+
 // listen for new nodes watches for new nodes in the network and adds them to the peerstore.
 func (s *Service) listenForNewNodes() {
 	iterator := filterNodes(s.ctx, s.dv5Listener.RandomNodes(), s.filterPeer)
@@ -377,6 +440,9 @@ func (s *Service) createLocalNode(
 
 	localNode = initializeAttSubnets(localNode)
 	localNode = initializeSyncCommSubnets(localNode)
+	if s.cfg != nil {
+		localNode = initializeCustodySubnetCount(localNode, s.cfg.CustodySubnetCount)
+	}
 
 	if s.cfg != nil && s.cfg.HostAddress != "" {
 		hostIP := net.ParseIP(s.cfg.HostAddress)
@@ -688,3 +688,36 @@ func TestRefreshENR_ForkBoundaries(t *testing.T) {
 		})
 	}
 }
+
+func TestRebuildENR(t *testing.T) {
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	s := &Service{
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+		cfg:                   &Config{UDPPort: 2100, TCPPort: 2101, HostAddress: "192.168.0.1"},
+	}
+	_, err := s.RebuildENR()
+	require.ErrorIs(t, err, ErrDiscoveryNotRunning)
+
+	createListener := func() (*discover.UDPv5, error) {
+		return s.createListener(ipAddr, pkey)
+	}
+	listener, err := newListener(createListener)
+	require.NoError(t, err)
+	defer listener.Close()
+	s.dv5Listener = listener
+	currentSeq := listener.Self().Seq()
+
+	record, err := s.RebuildENR()
+	require.NoError(t, err)
+	assert.Equal(t, currentSeq+1, record.Seq())
+	node, err := enode.New(enode.ValidSchemes, record)
+	require.NoError(t, err)
+	assert.Equal(t, "192.168.0.1", node.IP().String())
+	assert.Equal(t, 2101, node.TCP())
+
+	// The record is re-signed on every call, even when no entry changed.
+	record, err = s.RebuildENR()
+	require.NoError(t, err)
+	assert.Equal(t, currentSeq+2, record.Seq())
+}
@@ -59,7 +59,8 @@ func (s *Service) AddConnectionHandler(reqFunc, goodByeFunc func(ctx context.Con
 	s.host.Network().Notify(&network.NotifyBundle{
 		ConnectedF: func(net network.Network, conn network.Conn) {
 			remotePeer := conn.RemotePeer()
-			disconnectFromPeer := func() {
+			disconnectFromPeer := func(reason string) {
+				s.peers.SetDisconnectReason(remotePeer, reason)
 				s.peers.SetConnectionState(remotePeer, peers.PeerDisconnecting)
 				// Only attempt a goodbye if we are still connected to the peer.
 				if s.host.Network().Connectedness(remotePeer) == network.Connected {
@@ -87,7 +88,7 @@ func (s *Service) AddConnectionHandler(reqFunc, goodByeFunc func(ctx context.Con
 				// Defensive check in the event we still get a bad peer.
 				if s.peers.IsBad(remotePeer) {
 					log.WithField("reason", "bad peer").Trace("Ignoring connection request")
-					disconnectFromPeer()
+					disconnectFromPeer("bad peer")
 					return
 				}
 				validPeerConnection := func() {
@@ -117,19 +118,19 @@ func (s *Service) AddConnectionHandler(reqFunc, goodByeFunc func(ctx context.Con
 					// If peer hasn't sent a status request, we disconnect with them
 					if _, err := s.peers.ChainState(remotePeer); errors.Is(err, peerdata.ErrPeerUnknown) || errors.Is(err, peerdata.ErrNoPeerStatus) {
 						statusMessageMissing.Inc()
-						disconnectFromPeer()
+						disconnectFromPeer("no status message received")
 						return
 					}
 					if peerExists {
 						updated, err := s.peers.ChainStateLastUpdated(remotePeer)
 						if err != nil {
-							disconnectFromPeer()
+							disconnectFromPeer("unknown status")
 							return
 						}
 						// exit if we don't receive any current status messages from
 						// peer.
 						if updated.IsZero() || !updated.After(currentTime) {
-							disconnectFromPeer()
+							disconnectFromPeer("no status message received")
 							return
 						}
 					}
@@ -140,7 +141,7 @@ func (s *Service) AddConnectionHandler(reqFunc, goodByeFunc func(ctx context.Con
 				s.peers.SetConnectionState(conn.RemotePeer(), peers.PeerConnecting)
 				if err := reqFunc(context.TODO(), conn.RemotePeer()); err != nil && !errors.Is(err, io.EOF) {
 					log.WithError(err).Trace("Handshake failed")
-					disconnectFromPeer()
+					disconnectFromPeer("handshake failed: " + err.Error())
 					return
 				}
 				validPeerConnection()
@@ -166,6 +167,7 @@ func (s *Service) AddDisconnectionHandler(handler func(ctx context.Context, id p
 					// Can happen if the peer has already disconnected, so...
 					priorState = peers.PeerDisconnected
 				}
+				s.peers.SetDisconnectReason(conn.RemotePeer(), "connection closed")
 				s.peers.SetConnectionState(conn.RemotePeer(), peers.PeerDisconnecting)
 				if err := handler(context.TODO(), conn.RemotePeer()); err != nil {
 					log.WithError(err).Error("Disconnect handler failed")
@@ -28,6 +28,7 @@ type P2P interface {
 	ConnectionHandler
 	PeersProvider
 	MetadataProvider
+	CustodyHandler
 }
 
 // Broadcaster broadcasts messages to peers over the p2p pubsub protocol.
@@ -38,6 +39,13 @@ type Broadcaster interface {
 	BroadcastBlob(ctx context.Context, subnet uint64, blob *ethpb.BlobSidecar) error
 }
 
+// CustodyHandler provides the data column sidecar subnets custodied by the node and its peers.
+type CustodyHandler interface {
+	CustodySubnetCount() uint64
+	CustodySubnets() map[uint64]bool
+	CustodySubnetCountFromPeer(peer.ID) uint64
+}
+
 // SetStreamHandler configures p2p to handle streams of a certain topic ID.
 type SetStreamHandler interface {
 	SetStreamHandler(topic string, handler network.StreamHandler)
@@ -83,6 +91,7 @@ type PeerManager interface {
 	ENR() *enr.Record
 	DiscoveryAddresses() ([]multiaddr.Multiaddr, error)
 	RefreshENR()
+	RebuildENR() (*enr.Record, error)
 	FindPeersWithSubnet(ctx context.Context, topic string, subIndex uint64, threshold int) (bool, error)
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
 }
@@ -114,6 +114,11 @@ var (
 		Help: "The number of messages rejected of a particular topic",
 	},
 		[]string{"topic", "reason"})
+	pubsubSigningPolicyViolation = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_pubsub_signing_policy_violation_total",
+		Help: "The number of messages rejected for carrying a signature or author fields, which the StrictNoSign signing policy forbids, by agent of the sending peer",
+	},
+		[]string{"agent", "reason"})
 	pubsubPeerThrottle = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "p2p_pubsub_throttle_total",
 		Help: "The number of times a peer has been throttled for a particular topic",
@@ -161,6 +166,16 @@ var (
 		Help: "The number of publish messages sent via rpc for a particular topic",
 	},
 		[]string{"topic"})
+	gossipPropagatedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_gossip_propagated_messages_total",
+		Help: "The number of published messages seen at another peer within the propagation window, by kind",
+	},
+		[]string{"kind"})
+	gossipUnpropagatedMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_gossip_unpropagated_messages_total",
+		Help: "The number of published messages not seen at any other peer within the propagation window, by kind",
+	},
+		[]string{"kind"})
 )
 
 func (s *Service) updateMetrics() {
@@ -182,7 +197,7 @@ func (s *Service) updateMetrics() {
 			continue
 		}
 
-		foundName := agentFromPid(pid, store)
+		foundName := AgentFromPid(pid, store)
 		numConnectedPeersByClient[foundName] += 1
 
 		// Get peer scoring data.
@@ -209,7 +224,8 @@ func average(xs []float64) float64 {
 	return total / float64(len(xs))
 }
 
-func agentFromPid(pid peer.ID, store peerstore.Peerstore) string {
+// AgentFromPid returns the name of the client of a peer if it is a known one, or "unknown".
+func AgentFromPid(pid peer.ID, store peerstore.Peerstore) string {
 	// Get the agent data.
 	rawAgent, err := store.Get(pid, "AgentVersion")
 	agent, ok := rawAgent.(string)
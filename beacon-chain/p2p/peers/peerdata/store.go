@@ -10,6 +10,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/metadata"
 )
@@ -52,11 +53,19 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	// DisconnectReason is why the peer was last disconnected, cleared once it is connected again.
+	DisconnectReason string
+	// DisconnectedAt is when the peer was last disconnected.
+	DisconnectedAt time.Time
 	// Chain related data.
 	MetaData                  metadata.Metadata
 	ChainState                *ethpb.Status
 	ChainStateLastUpdated     time.Time
 	ChainStateValidationError error
+	// EarliestAvailableSlot is a lower bound on the earliest slot the peer can serve blocks for, learned from
+	// ResourceUnavailable responses to our requests. A zero value means no such limit is known.
+	EarliestAvailableSlot        primitives.Slot
+	EarliestAvailableSlotUpdated time.Time
 	// Scorers internal data.
 	BadResponses         int
 	ProcessedBlocks      uint64
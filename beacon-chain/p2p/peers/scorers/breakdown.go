@@ -0,0 +1,65 @@
+package scorers
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	pbrpc "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// PeerScores is the breakdown of the score of a peer by scorer, along with the data the scores are computed from.
+type PeerScores struct {
+	// Score is the overall score of the peer.
+	Score float64
+	// BadResponses is the number of bad responses received from the peer.
+	BadResponses       int
+	BadResponsesScore  float64
+	ProcessedBlocks    uint64
+	BlockProviderScore float64
+	PeerStatusScore    float64
+	// ChainStateValidationError is the error of the validation of the last status of the peer, if any.
+	ChainStateValidationError error
+	// GossipScore, BehaviourPenalty and TopicScores are computed by the gossipsub router.
+	GossipScore      float64
+	BehaviourPenalty float64
+	TopicScores      map[string]*pbrpc.TopicScoreSnapshot
+	// BadPeerReasons are the reasons the scorers consider the peer bad, empty for a good peer.
+	BadPeerReasons []string
+}
+
+// PeerScoresNoLock returns the breakdown of the score of the peer, or false when the peer is unknown.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Service) PeerScoresNoLock(pid peer.ID) (*PeerScores, bool) {
+	peerData, ok := s.store.PeerData(pid)
+	if !ok {
+		return nil, false
+	}
+	scores := &PeerScores{
+		Score:                     s.ScoreNoLock(pid),
+		BadResponses:              peerData.BadResponses,
+		BadResponsesScore:         s.scorers.badResponsesScorer.scoreNoLock(pid),
+		ProcessedBlocks:           s.scorers.blockProviderScorer.processedBlocksNoLock(pid),
+		BlockProviderScore:        s.scorers.blockProviderScorer.scoreNoLock(pid),
+		PeerStatusScore:           s.scorers.peerStatusScorer.scoreNoLock(pid),
+		ChainStateValidationError: peerData.ChainStateValidationError,
+		GossipScore:               peerData.GossipScore,
+		BehaviourPenalty:          peerData.BehaviourPenalty,
+		// The topic scores are replaced rather than updated by the gossipsub router, copying the map is enough.
+		TopicScores: maps.Clone(peerData.TopicScores),
+	}
+
+	if s.scorers.badResponsesScorer.isBadPeerNoLock(pid) {
+		scores.BadPeerReasons = append(scores.BadPeerReasons, fmt.Sprintf("%d bad responses, the threshold is %d",
+			peerData.BadResponses, s.scorers.badResponsesScorer.Params().Threshold))
+	}
+	if s.scorers.peerStatusScorer.isBadPeerNoLock(pid) {
+		scores.BadPeerReasons = append(scores.BadPeerReasons, fmt.Sprintf("invalid chain status: %v", peerData.ChainStateValidationError))
+	}
+	if features.Get().EnablePeerScorer && s.scorers.gossipScorer.isBadPeerNoLock(pid) {
+		scores.BadPeerReasons = append(scores.BadPeerReasons, fmt.Sprintf("gossip score %.2f is below %.2f",
+			peerData.GossipScore, gossipThreshold))
+	}
+	return scores, true
+}
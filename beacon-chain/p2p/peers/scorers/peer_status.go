@@ -54,6 +54,10 @@ func (s *PeerStatusScorer) scoreNoLock(pid peer.ID) float64 {
 	if !ok || peerData.ChainState == nil {
 		return score
 	}
+	// A peer whose finalized checkpoint could not be checked against our chain is not scored by its head.
+	if errors.Is(peerData.ChainStateValidationError, p2ptypes.ErrUnverifiedFinalizedRoot) {
+		return score
+	}
 	if peerData.ChainState.HeadSlot < s.ourHeadSlot {
 		return score
 	}
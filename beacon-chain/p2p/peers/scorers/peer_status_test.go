@@ -106,6 +106,20 @@ func TestScorers_PeerStatus_Score(t *testing.T) {
 				assert.Equal(t, 1.0, scorer.Score("peer1"), "Unexpected score")
 			},
 		},
+		{
+			name: "existent peer unverified finalized root",
+			update: func(scorer *scorers.PeerStatusScorer) {
+				scorer.SetHeadSlot(0)
+				scorer.SetPeerStatus("peer1", &pb.Status{
+					HeadRoot: make([]byte, 32),
+					HeadSlot: 64,
+				}, p2ptypes.ErrUnverifiedFinalizedRoot)
+			},
+			check: func(scorer *scorers.PeerStatusScorer) {
+				assert.Equal(t, false, scorer.IsBadPeer("peer1"))
+				assert.Equal(t, 0.0, scorer.Score("peer1"), "Unexpected score")
+			},
+		},
 		{
 			name: "existent peer no max known slot",
 			update: func(scorer *scorers.PeerStatusScorer) {
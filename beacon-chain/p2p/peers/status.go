@@ -75,6 +75,11 @@ const (
 	MinBackOffDuration = 100
 	// MaxBackOffDuration maximum amount (in milliseconds) to wait before peer is re-dialed.
 	MaxBackOffDuration = 5000
+
+	// earliestAvailableSlotTTL is how long a peer's earliest available slot, learned from a ResourceUnavailable
+	// response, is trusted. Checkpoint synced peers backfill their history over time, so after this period
+	// we are willing to ask them for older blocks again.
+	earliestAvailableSlotTTL = 10 * time.Minute
 )
 
 type InternetProtocol string
@@ -306,9 +311,27 @@ func (p *Status) SetConnectionState(pid peer.ID, state peerdata.PeerConnectionSt
 	defer p.store.Unlock()
 
 	peerData := p.store.PeerDataGetOrCreate(pid)
+	switch {
+	case state == PeerConnected:
+		peerData.DisconnectReason = ""
+	case state == PeerDisconnected && peerData.ConnState != PeerDisconnected:
+		peerData.DisconnectedAt = prysmTime.Now()
+	}
 	peerData.ConnState = state
 }
 
+// SetDisconnectReason records why the given remote peer is disconnected. The first reason recorded since the peer
+// was last connected is kept, as callers closer to the cause of a disconnection record their reason first.
+func (p *Status) SetDisconnectReason(pid peer.ID, reason string) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	if peerData.DisconnectReason == "" {
+		peerData.DisconnectReason = reason
+	}
+}
+
 // ConnectionState gets the connection state of the given remote peer.
 // This will error if the peer does not exist.
 func (p *Status) ConnectionState(pid peer.ID) (peerdata.PeerConnectionState, error) {
@@ -333,6 +356,52 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 	return prysmTime.Now(), peerdata.ErrPeerUnknown
 }
 
+// SetEarliestAvailableSlot records that the given peer is unable to serve blocks before the given slot,
+// for instance because it was checkpoint synced and its backfill has not yet reached that far.
+// The bound is only ever raised while it is fresh. Since the peer's history grows as it backfills,
+// a bound older than earliestAvailableSlotTTL is discarded rather than raised.
+func (p *Status) SetEarliestAvailableSlot(pid peer.ID, slot primitives.Slot) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	if p.earliestAvailableSlotFresh(peerData) && peerData.EarliestAvailableSlot > slot {
+		return
+	}
+	peerData.EarliestAvailableSlot = slot
+	peerData.EarliestAvailableSlotUpdated = prysmTime.Now()
+}
+
+// EarliestAvailableSlot returns the known lower bound on the slots the given peer can serve blocks for.
+// Zero is returned if no bound is known, or if the last known bound has expired.
+func (p *Status) EarliestAvailableSlot(pid peer.ID) (primitives.Slot, error) {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		if !p.earliestAvailableSlotFresh(peerData) {
+			return 0, nil
+		}
+		return peerData.EarliestAvailableSlot, nil
+	}
+	return 0, peerdata.ErrPeerUnknown
+}
+
+// CanServeBlocksFrom returns false if the peer is known to be unable to serve blocks starting at the given slot.
+// Peers we know nothing about are assumed to be able to serve the full history.
+func (p *Status) CanServeBlocksFrom(pid peer.ID, slot primitives.Slot) bool {
+	earliest, err := p.EarliestAvailableSlot(pid)
+	if err != nil {
+		return true
+	}
+	return slot >= earliest
+}
+
+func (p *Status) earliestAvailableSlotFresh(peerData *peerdata.PeerData) bool {
+	return !peerData.EarliestAvailableSlotUpdated.IsZero() &&
+		prysmTime.Since(peerData.EarliestAvailableSlotUpdated) < earliestAvailableSlotTTL
+}
+
 // IsBad states if the peer is to be considered bad (by *any* of the registered scorers).
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
 func (p *Status) IsBad(pid peer.ID) bool {
@@ -577,6 +646,51 @@ func (p *Status) All() []peer.ID {
 	return pids
 }
 
+// PeerScoreSnapshot is the state and the scores of a peer at the time of the snapshot.
+type PeerScoreSnapshot struct {
+	ID               peer.ID
+	Address          ma.Multiaddr
+	Enr              *enr.Record
+	Direction        network.Direction
+	ConnState        peerdata.PeerConnectionState
+	DisconnectReason string
+	DisconnectedAt   time.Time
+	Scores           *scorers.PeerScores
+}
+
+// ScoresSnapshot returns the state and the scores of the peers which are connected or were disconnected since the
+// start of the node, taken under a single read lock of the peer store.
+func (p *Status) ScoresSnapshot() []*PeerScoreSnapshot {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	snapshots := make([]*PeerScoreSnapshot, 0)
+	for pid, peerData := range p.store.Peers() {
+		// Peers which were found through discovery but never connected to have no score.
+		if peerData.ConnState == PeerDisconnected && peerData.DisconnectedAt.IsZero() {
+			continue
+		}
+		scores, ok := p.scorers.PeerScoresNoLock(pid)
+		if !ok {
+			continue
+		}
+		snapshots = append(snapshots, &PeerScoreSnapshot{
+			ID:               pid,
+			Address:          peerData.Address,
+			Enr:              peerData.Enr,
+			Direction:        peerData.Direction,
+			ConnState:        peerData.ConnState,
+			DisconnectReason: peerData.DisconnectReason,
+			DisconnectedAt:   peerData.DisconnectedAt,
+			Scores:           scores,
+		})
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].ID < snapshots[j].ID
+	})
+	return snapshots
+}
+
 // Prune clears out and removes outdated and disconnected peers.
 func (p *Status) Prune() {
 	p.store.Lock()
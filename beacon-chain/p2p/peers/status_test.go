@@ -496,6 +496,43 @@ func TestPeerValidTime(t *testing.T) {
 	assert.Equal(t, numPeersConnected, len(p.Connected()), "Unexpected number of connected peers")
 }
 
+func TestPeerEarliestAvailableSlot(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+
+	id, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	// Nothing is known about the peer, so it is assumed to have the full history.
+	_, err = p.EarliestAvailableSlot(id)
+	assert.ErrorContains(t, peerdata.ErrPeerUnknown.Error(), err)
+	assert.Equal(t, true, p.CanServeBlocksFrom(id, 0))
+
+	pid := addPeer(t, p, peers.PeerConnected)
+	earliest, err := p.EarliestAvailableSlot(pid)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(0), earliest)
+	assert.Equal(t, true, p.CanServeBlocksFrom(pid, 0))
+
+	p.SetEarliestAvailableSlot(pid, 100)
+	assert.Equal(t, false, p.CanServeBlocksFrom(pid, 0))
+	assert.Equal(t, false, p.CanServeBlocksFrom(pid, 99))
+	assert.Equal(t, true, p.CanServeBlocksFrom(pid, 100))
+	assert.Equal(t, true, p.CanServeBlocksFrom(pid, 1000))
+
+	// A fresh bound is only raised, since a lower slot may simply come from an older request.
+	p.SetEarliestAvailableSlot(pid, 80)
+	earliest, err = p.EarliestAvailableSlot(pid)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(100), earliest)
+	p.SetEarliestAvailableSlot(pid, 200)
+	earliest, err = p.EarliestAvailableSlot(pid)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(200), earliest)
+	assert.Equal(t, false, p.CanServeBlocksFrom(pid, 150))
+}
+
 func TestPrune(t *testing.T) {
 	maxBadResponses := 2
 	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
@@ -1293,6 +1330,66 @@ func TestOutboundConnectedWithProtocol(t *testing.T) {
 }
 
 // addPeer is a helper to add a peer with a given connection state)
+func TestStatus_ScoresSnapshot(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 2,
+			},
+		},
+	})
+	connected := addPeer(t, p, peers.PeerConnected)
+	p.Scorers().BadResponsesScorer().Increment(connected)
+	p.Scorers().BadResponsesScorer().Increment(connected)
+	topicScores := map[string]*pb.TopicScoreSnapshot{"beacon_block": {TimeInMesh: 100, FirstMessageDeliveries: 2}}
+	p.Scorers().GossipScorer().SetGossipData(connected, -5, 1, topicScores)
+
+	disconnected := addPeer(t, p, peers.PeerConnected)
+	// The first reason is the most specific one.
+	p.SetDisconnectReason(disconnected, "handshake failed")
+	p.SetDisconnectReason(disconnected, "connection closed")
+	p.SetConnectionState(disconnected, peers.PeerDisconnecting)
+	p.SetConnectionState(disconnected, peers.PeerDisconnected)
+
+	// Peers which were never connected have no score.
+	addPeer(t, p, peers.PeerDisconnected)
+
+	snapshots := p.ScoresSnapshot()
+	require.Equal(t, 2, len(snapshots))
+	byID := map[peer.ID]*peers.PeerScoreSnapshot{}
+	for _, s := range snapshots {
+		byID[s.ID] = s
+	}
+
+	s, ok := byID[connected]
+	require.Equal(t, true, ok)
+	assert.Equal(t, peers.PeerConnected, s.ConnState)
+	assert.Equal(t, "", s.DisconnectReason)
+	assert.Equal(t, true, s.DisconnectedAt.IsZero())
+	assert.Equal(t, 2, s.Scores.BadResponses)
+	assert.Equal(t, scorers.BadPeerScore, s.Scores.BadResponsesScore)
+	assert.Equal(t, float64(-5), s.Scores.GossipScore)
+	assert.Equal(t, float64(1), s.Scores.BehaviourPenalty)
+	assert.DeepEqual(t, topicScores, s.Scores.TopicScores)
+	assert.DeepEqual(t, []string{"2 bad responses, the threshold is 2"}, s.Scores.BadPeerReasons)
+
+	s, ok = byID[disconnected]
+	require.Equal(t, true, ok)
+	assert.Equal(t, peers.PeerDisconnected, s.ConnState)
+	assert.Equal(t, "handshake failed", s.DisconnectReason)
+	assert.Equal(t, false, s.DisconnectedAt.IsZero())
+	assert.Equal(t, 0, len(s.Scores.BadPeerReasons))
+
+	// The reason is cleared once the peer is connected again.
+	p.SetConnectionState(disconnected, peers.PeerConnected)
+	for _, s := range p.ScoresSnapshot() {
+		if s.ID == disconnected {
+			assert.Equal(t, "", s.DisconnectReason)
+		}
+	}
+}
+
 func addPeer(t *testing.T, p *peers.Status, state peerdata.PeerConnectionState) peer.ID {
 	// Set up some peers with different states
 	mhBytes := []byte{0x11, 0x04}
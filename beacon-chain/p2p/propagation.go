@@ -0,0 +1,138 @@
+package p2p
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/sirupsen/logrus"
+)
+
+// propagationCheckInterval is the interval at which published messages are checked for propagation.
+const propagationCheckInterval = time.Second
+
+// propagationKinds maps the gossip messages whose propagation is tracked to the kind used in logs and metrics.
+var propagationKinds = map[string]string{
+	GossipBlockMessage:                "block",
+	GossipAggregateAndProofMessage:    "aggregate",
+	GossipContributionAndProofMessage: "sync_contribution",
+}
+
+type publishedMessage struct {
+	id       string
+	kind     string
+	topic    string
+	deadline time.Time
+}
+
+// propagationTracker keeps the blocks, aggregates and sync contributions published by this node until another peer
+// is seen holding them, either by delivering them to us or by referencing them in an IHAVE gossip message. A message
+// not seen within the window may not have propagated beyond this node.
+type propagationTracker struct {
+	window  time.Duration
+	lock    sync.Mutex
+	pending map[string]*publishedMessage
+}
+
+// newPropagationTracker returns a tracker for the given window, or nil when the window is zero which disables tracking.
+func newPropagationTracker(window time.Duration) *propagationTracker {
+	if window <= 0 {
+		return nil
+	}
+	return &propagationTracker{
+		window:  window,
+		pending: make(map[string]*publishedMessage),
+	}
+}
+
+// propagationKind returns the kind of the message published on the given topic, if its propagation is tracked.
+func propagationKind(topic string) (string, bool) {
+	// Topics are formatted as /eth2/<fork digest>/<message name>/<encoding>.
+	parts := strings.Split(topic, "/")
+	if len(parts) < 4 {
+		return "", false
+	}
+	kind, ok := propagationKinds[parts[3]]
+	return kind, ok
+}
+
+// track registers a message about to be published on the given topic.
+func (t *propagationTracker) track(genesisValidatorsRoot, data []byte, topic string) string {
+	if t == nil {
+		return ""
+	}
+	kind, ok := propagationKind(topic)
+	if !ok {
+		return ""
+	}
+	id := MsgID(genesisValidatorsRoot, &pubsubpb.Message{Data: data, Topic: &topic})
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.pending[id] = &publishedMessage{
+		id:       id,
+		kind:     kind,
+		topic:    topic,
+		deadline: time.Now().Add(t.window),
+	}
+	return id
+}
+
+// untrack removes a message that could not be published.
+func (t *propagationTracker) untrack(id string) {
+	if t == nil || id == "" {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.pending, id)
+}
+
+// observe marks the message with the given ID as seen at another peer.
+func (t *propagationTracker) observe(id string) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	m, ok := t.pending[id]
+	if !ok {
+		return
+	}
+	delete(t.pending, id)
+	gossipPropagatedMessages.WithLabelValues(m.kind).Inc()
+}
+
+// expire removes and returns the messages whose window elapsed at the given time without being observed.
+func (t *propagationTracker) expire(now time.Time) []*publishedMessage {
+	if t == nil {
+		return nil
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var expired []*publishedMessage
+	for id, m := range t.pending {
+		if now.Before(m.deadline) {
+			continue
+		}
+		delete(t.pending, id)
+		expired = append(expired, m)
+	}
+	return expired
+}
+
+// reportUnpropagatedMessages warns about the published messages that no other peer was seen holding within the
+// propagation window.
+func (s *Service) reportUnpropagatedMessages() {
+	for _, m := range s.propagation.expire(time.Now()) {
+		gossipUnpropagatedMessages.WithLabelValues(m.kind).Inc()
+		log.WithFields(logrus.Fields{
+			"kind":       m.kind,
+			"topic":      m.topic,
+			"messageId":  fmt.Sprintf("%#x", []byte(m.id)),
+			"topicPeers": len(s.pubsub.ListPeers(m.topic)),
+			"window":     s.propagation.window,
+		}).Warn("Published message was not seen at any other peer, it may not have propagated")
+	}
+}
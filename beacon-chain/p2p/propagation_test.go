@@ -0,0 +1,144 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func propagationTestTopic(t *testing.T, format string) ([]byte, string) {
+	gvr := bytesutil.PadTo([]byte("genesis validators root"), 32)
+	digest, err := forks.CreateForkDigest(time.Now(), gvr)
+	require.NoError(t, err)
+	return gvr, fmt.Sprintf(format, digest) + "/" + encoder.ProtocolSuffixSSZSnappy
+}
+
+func TestPropagationKind(t *testing.T) {
+	_, topic := propagationTestTopic(t, BlockSubnetTopicFormat)
+	kind, ok := propagationKind(topic)
+	require.Equal(t, true, ok)
+	require.Equal(t, "block", kind)
+	_, topic = propagationTestTopic(t, SyncContributionAndProofSubnetTopicFormat)
+	kind, ok = propagationKind(topic)
+	require.Equal(t, true, ok)
+	require.Equal(t, "sync_contribution", kind)
+	_, ok = propagationKind(fmt.Sprintf(AttestationSubnetTopicFormat, [4]byte{}, 1) + "/" + encoder.ProtocolSuffixSSZSnappy)
+	require.Equal(t, false, ok)
+	_, ok = propagationKind("invalid")
+	require.Equal(t, false, ok)
+	require.Equal(t, (*propagationTracker)(nil), newPropagationTracker(0))
+}
+
+func TestGossipTracer_ObservesPropagation(t *testing.T) {
+	h, err := libp2p.New(libp2p.NoListenAddrs)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, h.Close())
+	}()
+	tracker := newPropagationTracker(time.Second)
+	g := gossipTracer{host: h, propagation: tracker}
+	gvr, topic := propagationTestTopic(t, AggregateAndProofSubnetTopicFormat)
+	message := func(id string, from peer.ID) *pubsub.Message {
+		return &pubsub.Message{Message: &pubsubpb.Message{Topic: &topic}, ID: id, ReceivedFrom: from}
+	}
+
+	duplicated := tracker.track(gvr, []byte("duplicated"), topic)
+	announced := tracker.track(gvr, []byte("announced"), topic)
+	lost := tracker.track(gvr, []byte("lost"), topic)
+	attTopic := fmt.Sprintf(AttestationSubnetTopicFormat, [4]byte{}, 1) + "/" + encoder.ProtocolSuffixSSZSnappy
+	require.Equal(t, "", tracker.track(gvr, []byte("attestation"), attTopic))
+
+	// Our own validation and delivery of the messages we publish do not count.
+	g.ValidateMessage(message(lost, h.ID()))
+	g.DeliverMessage(message(lost, h.ID()))
+	g.DuplicateMessage(message(duplicated, "peer"))
+	g.RecvRPC(&pubsub.RPC{RPC: pubsubpb.RPC{Control: &pubsubpb.ControlMessage{
+		Ihave: []*pubsubpb.ControlIHave{{TopicID: &topic, MessageIDs: []string{"unknown", announced}}},
+	}}})
+
+	require.Equal(t, 0, len(tracker.expire(time.Now())))
+	expired := tracker.expire(time.Now().Add(time.Second))
+	require.Equal(t, 1, len(expired))
+	require.Equal(t, lost, expired[0].id)
+	require.Equal(t, "aggregate", expired[0].kind)
+	require.Equal(t, topic, expired[0].topic)
+}
+
+func newPropagationTestNode(t *testing.T, ctx context.Context, gvr []byte, topic string) (host.Host, *pubsub.Topic, *pubsub.Subscription, *propagationTracker) {
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, h.Close())
+	})
+	tracker := newPropagationTracker(time.Second)
+	ps, err := pubsub.NewGossipSub(ctx, h,
+		pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
+		pubsub.WithNoAuthor(),
+		pubsub.WithMessageIdFn(func(pmsg *pubsubpb.Message) string {
+			return MsgID(gvr, pmsg)
+		}),
+		pubsub.WithRawTracer(gossipTracer{host: h, propagation: tracker}),
+	)
+	require.NoError(t, err)
+	topicHandle, err := ps.Join(topic)
+	require.NoError(t, err)
+	sub, err := topicHandle.Subscribe()
+	require.NoError(t, err)
+	return h, topicHandle, sub, tracker
+}
+
+func nextMessageWithData(ctx context.Context, t *testing.T, sub *pubsub.Subscription, data []byte) *pubsub.Message {
+	for {
+		msg, err := sub.Next(ctx)
+		require.NoError(t, err)
+		if bytes.Equal(msg.Data, data) {
+			return msg
+		}
+	}
+}
+
+func TestPropagationTracker_TwoNodes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	gvr, topic := propagationTestTopic(t, BlockSubnetTopicFormat)
+	hostA, topicA, subA, trackerA := newPropagationTestNode(t, ctx, gvr, topic)
+	hostB, topicB, subB, _ := newPropagationTestNode(t, ctx, gvr, topic)
+	require.NoError(t, hostA.Connect(ctx, peer.AddrInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}))
+	for len(topicA.ListPeers()) == 0 || len(topicB.ListPeers()) == 0 {
+		select {
+		case <-ctx.Done():
+			t.Fatal("nodes did not see each other's subscriptions")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	// Messages published before the first heartbeat grafts the peers into the mesh can be lost.
+	time.Sleep(pubsub.GossipSubHeartbeatInitialDelay + pubsub.GossipSubHeartbeatInterval)
+
+	// The only other node receives the block from us and does not send it back, so nothing tells it propagated.
+	published := []byte("published block")
+	id := trackerA.track(gvr, published, topic)
+	require.NoError(t, topicA.Publish(ctx, published))
+	require.Equal(t, id, nextMessageWithData(ctx, t, subB, published).ID)
+	expired := trackerA.expire(time.Now().Add(time.Second))
+	require.Equal(t, 1, len(expired))
+	require.Equal(t, id, expired[0].id)
+
+	// A block delivered by the other node was seen there.
+	received := []byte("block published by the other node too")
+	trackerA.track(gvr, received, topic)
+	require.NoError(t, topicB.Publish(ctx, received))
+	nextMessageWithData(ctx, t, subA, received)
+	require.Equal(t, 0, len(trackerA.expire(time.Now().Add(time.Second))))
+}
@@ -19,10 +19,15 @@ var _ pubsub.SubscriptionFilter = (*Service)(nil)
 // -> 64 Attestation Subnets * 2.
 // -> 4 Sync Committee Subnets * 2.
 // -> Block,Aggregate,ProposerSlashing,AttesterSlashing,Exits,SyncContribution * 2.
-const pubsubSubscriptionRequestLimit = 200
+// -> 128 Data Column Sidecar Subnets * 2, for the nodes custodying all of them.
+const pubsubSubscriptionRequestLimit = 500
 
 // CanSubscribe returns true if the topic is of interest and we could subscribe to it.
 func (s *Service) CanSubscribe(topic string) bool {
+	// The signing policy self test joins its topic at startup, before the service is initialized.
+	if topic == signingPolicySelfTestTopic {
+		return true
+	}
 	if !s.isInitialized() {
 		return false
 	}
@@ -88,6 +93,12 @@ func (s *Service) CanSubscribe(topic string) bool {
 			return true
 		}
 	}
+	// Data column sidecars have no message mapping yet, their topics are only of interest to custodying nodes.
+	if s.cfg != nil && s.cfg.CustodySubnetCount > 0 {
+		if _, err := scanfcheck(strings.Join(parts[0:4], "/"), DataColumnSubnetTopicFormat); err == nil {
+			return true
+		}
+	}
 
 	return false
 }
@@ -118,6 +118,7 @@ func TestService_CanSubscribe_uninitialized(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	s := &Service{}
 	require.Equal(t, false, s.CanSubscribe("foo"))
+	require.Equal(t, true, s.CanSubscribe(signingPolicySelfTestTopic))
 }
 
 func Test_scanfcheck(t *testing.T) {
@@ -0,0 +1,109 @@
+package p2p
+
+import (
+	"context"
+	"strings"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
+	"github.com/sirupsen/logrus"
+)
+
+// gossipMessageSignaturePolicy is the message signing policy required by the consensus gossip: messages are neither
+// signed nor authored, and messages from peers carrying a signature, a key, a sender or a sequence number are
+// rejected by the router.
+const gossipMessageSignaturePolicy = pubsub.StrictNoSign
+
+// signingPolicySelfTestTopic is the topic of the message published by the signing policy self test. It is never
+// subscribed to, and the message is ignored by its validator, so the message does not leave the node.
+const signingPolicySelfTestTopic = gossipTopicPrefix + "00000000/signing_policy_self_test/" + encoder.ProtocolSuffixSSZSnappy
+
+var errSigningPolicyViolation = errors.New("gossip message violates the StrictNoSign signing policy")
+
+// isSigningPolicyRejection returns true if the router rejected a message because it violates the signing policy.
+func isSigningPolicyRejection(reason string) bool {
+	return reason == pubsub.RejectUnexpectedSignature || reason == pubsub.RejectUnexpectedAuthInfo
+}
+
+// signingPolicyViolations returns the fields of a gossip message that must be empty under the StrictNoSign policy
+// but are set.
+func signingPolicyViolations(msg *pubsubpb.Message) []string {
+	var fields []string
+	if msg.Signature != nil {
+		fields = append(fields, "signature")
+	}
+	if msg.Key != nil {
+		fields = append(fields, "key")
+	}
+	if msg.From != nil {
+		fields = append(fields, "from")
+	}
+	if msg.Seqno != nil {
+		fields = append(fields, "seqno")
+	}
+	return fields
+}
+
+// checkSigningPolicy publishes a message through the given router and checks that it is neither signed nor
+// authored, as required by the consensus gossip. It is run at startup, before any peer is connected.
+func checkSigningPolicy(ctx context.Context, ps *pubsub.PubSub) error {
+	var (
+		validated  bool
+		violations []string
+	)
+	validator := func(_ context.Context, _ peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		validated = true
+		violations = signingPolicyViolations(msg.Message)
+		// The message is only published to be inspected, it must not be delivered nor forwarded.
+		return pubsub.ValidationIgnore
+	}
+	if err := ps.RegisterTopicValidator(signingPolicySelfTestTopic, validator, pubsub.WithValidatorInline(true)); err != nil {
+		return errors.Wrap(err, "could not register signing policy self test validator")
+	}
+	defer func() {
+		if err := ps.UnregisterTopicValidator(signingPolicySelfTestTopic); err != nil {
+			log.WithError(err).Debug("Could not unregister signing policy self test validator")
+		}
+	}()
+	topic, err := ps.Join(signingPolicySelfTestTopic)
+	if err != nil {
+		return errors.Wrap(err, "could not join signing policy self test topic")
+	}
+	defer func() {
+		if err := topic.Close(); err != nil {
+			log.WithError(err).Debug("Could not leave signing policy self test topic")
+		}
+	}()
+
+	// The validator ignores the message, so publishing it always ends with a validation error. The router may also
+	// reject the message itself, before running the validator, if it violates the signing policy.
+	err = topic.Publish(ctx, []byte("signing policy self test"))
+	var validationErr pubsub.ValidationError
+	switch {
+	case errors.As(err, &validationErr) && isSigningPolicyRejection(validationErr.Reason):
+		return errors.Wrap(errSigningPolicyViolation, validationErr.Reason)
+	case err != nil && !errors.As(err, &validationErr):
+		return errors.Wrap(err, "could not publish signing policy self test message")
+	case !validated:
+		return errors.New("signing policy self test message was not validated")
+	case len(violations) > 0:
+		return errors.Wrapf(errSigningPolicyViolation, "published message has %s set", strings.Join(violations, ", "))
+	}
+	return nil
+}
+
+// logSigningPolicyViolation records a message rejected by the router because it violates the signing policy, by
+// the client of the peer that sent it.
+func logSigningPolicyViolation(msg *pubsub.Message, reason, agent string) {
+	pubsubSigningPolicyViolation.WithLabelValues(agent, reason).Inc()
+	log.WithFields(logrus.Fields{
+		"peer":   msg.ReceivedFrom.String(),
+		"agent":  agent,
+		"topic":  msg.GetTopic(),
+		"fields": strings.Join(signingPolicyViolations(msg.Message), ","),
+		"reason": reason,
+	}).Debug("Rejected gossip message violating the StrictNoSign signing policy")
+}
@@ -0,0 +1,85 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestCheckSigningPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []pubsub.Option
+		wantErr string
+	}{
+		{
+			name: "strict no sign",
+			opts: []pubsub.Option{pubsub.WithMessageSignaturePolicy(gossipMessageSignaturePolicy), pubsub.WithNoAuthor()},
+		},
+		{
+			name:    "signed messages",
+			opts:    []pubsub.Option{pubsub.WithMessageSignaturePolicy(pubsub.StrictSign)},
+			wantErr: "published message has signature, from, seqno set",
+		},
+		{
+			name:    "authored messages",
+			opts:    []pubsub.Option{pubsub.WithMessageSignaturePolicy(pubsub.LaxNoSign)},
+			wantErr: "published message has from, seqno set",
+		},
+		{
+			name:    "authored messages without signing",
+			opts:    []pubsub.Option{pubsub.WithMessageSignaturePolicy(gossipMessageSignaturePolicy)},
+			wantErr: "published message has from, seqno set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, h.Close())
+			}()
+			ps, err := pubsub.NewGossipSub(ctx, h, tt.opts...)
+			require.NoError(t, err)
+
+			err = checkSigningPolicy(ctx, ps)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, errSigningPolicyViolation)
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestCheckSigningPolicy_ServiceOptions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, h.Close())
+	}()
+	// The self test runs before the service is initialized, with the subscription filter of the service in place.
+	s := &Service{ctx: ctx, cfg: &Config{QueueSize: 600}, host: h}
+	ps, err := pubsub.NewGossipSub(ctx, h, s.pubsubOptions()...)
+	require.NoError(t, err)
+	require.NoError(t, checkSigningPolicy(ctx, ps))
+}
+
+func TestSigningPolicyViolations(t *testing.T) {
+	assert.Equal(t, 0, len(signingPolicyViolations(&pubsubpb.Message{Data: []byte("data")})))
+	assert.DeepEqual(t, []string{"from", "seqno"}, signingPolicyViolations(&pubsubpb.Message{
+		Data:  []byte("data"),
+		From:  []byte("peer"),
+		Seqno: []byte{1},
+	}))
+}
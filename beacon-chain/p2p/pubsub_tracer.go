@@ -22,7 +22,8 @@ const (
 // This tracer is used to implement metrics collection for messages received
 // and broadcasted through gossipsub.
 type gossipTracer struct {
-	host host.Host
+	host        host.Host
+	propagation *propagationTracker
 }
 
 // AddPeer .
@@ -63,16 +64,21 @@ func (g gossipTracer) ValidateMessage(msg *pubsub.Message) {
 // DeliverMessage .
 func (g gossipTracer) DeliverMessage(msg *pubsub.Message) {
 	pubsubMessageDeliver.WithLabelValues(*msg.Topic).Inc()
+	g.observePropagation(msg)
 }
 
 // RejectMessage .
 func (g gossipTracer) RejectMessage(msg *pubsub.Message, reason string) {
 	pubsubMessageReject.WithLabelValues(*msg.Topic, reason).Inc()
+	if isSigningPolicyRejection(reason) && g.host != nil {
+		logSigningPolicyViolation(msg, reason, AgentFromPid(msg.ReceivedFrom, g.host.Peerstore()))
+	}
 }
 
 // DuplicateMessage .
 func (g gossipTracer) DuplicateMessage(msg *pubsub.Message) {
 	pubsubMessageDuplicate.WithLabelValues(*msg.Topic).Inc()
+	g.observePropagation(msg)
 }
 
 // UndeliverableMessage .
@@ -82,13 +88,21 @@ func (g gossipTracer) UndeliverableMessage(msg *pubsub.Message) {
 
 // ThrottlePeer .
 func (g gossipTracer) ThrottlePeer(p peer.ID) {
-	agent := agentFromPid(p, g.host.Peerstore())
+	agent := AgentFromPid(p, g.host.Peerstore())
 	pubsubPeerThrottle.WithLabelValues(agent).Inc()
 }
 
 // RecvRPC .
 func (g gossipTracer) RecvRPC(rpc *pubsub.RPC) {
 	g.setMetricFromRPC(recv, pubsubRPCSubRecv, pubsubRPCPubRecv, pubsubRPCRecv, rpc)
+	if g.propagation != nil && rpc.Control != nil {
+		// A peer announcing one of our messages received it.
+		for _, ihave := range rpc.Control.Ihave {
+			for _, id := range ihave.MessageIDs {
+				g.propagation.observe(id)
+			}
+		}
+	}
 }
 
 // SendRPC .
@@ -101,6 +115,15 @@ func (g gossipTracer) DropRPC(rpc *pubsub.RPC, p peer.ID) {
 	g.setMetricFromRPC(drop, pubsubRPCSubDrop, pubsubRPCPubDrop, pubsubRPCDrop, rpc)
 }
 
+// observePropagation marks a message received from another peer as propagated. Messages published by this node
+// are validated and delivered locally too, those do not tell anything about propagation.
+func (g gossipTracer) observePropagation(msg *pubsub.Message) {
+	if g.propagation == nil || msg.ReceivedFrom == g.host.ID() {
+		return
+	}
+	g.propagation.observe(msg.ID)
+}
+
 func (g gossipTracer) setMetricFromRPC(act action, subCtr prometheus.Counter, pubCtr, ctrlCtr *prometheus.CounterVec, rpc *pubsub.RPC) {
 	subCtr.Add(float64(len(rpc.Subscriptions)))
 	if rpc.Control != nil {
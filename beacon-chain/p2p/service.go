@@ -20,6 +20,7 @@ import (
 	"github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/async"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/peerdas"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
@@ -71,6 +72,7 @@ type Service struct {
 	subnetsLock           map[uint64]*sync.RWMutex
 	subnetsLockLock       sync.Mutex // Lock access to subnetsLock
 	initializationLock    sync.Mutex
+	enrLock               sync.Mutex // Serializes the updates of the local node's ENR.
 	dv5Listener           ListenerRebooter
 	startupErr            error
 	ctx                   context.Context
@@ -78,6 +80,8 @@ type Service struct {
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
 	activeValidatorCount  uint64
+	propagation           *propagationTracker
+	custodySubnets        map[uint64]bool
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -104,19 +108,29 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		return nil, err
 	}
 
+	if err := validateCustodySubnetCount(cfg.CustodySubnetCount); err != nil {
+		return nil, err
+	}
+	custodySubnets, err := peerdas.CustodySubnets(enode.PubkeyToIDV4(&privKey.PublicKey), cfg.CustodySubnetCount)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute custody subnets")
+	}
+
 	ipLimiter := leakybucket.NewCollector(ipLimit, ipBurst, 30*time.Second, true /* deleteEmptyBuckets */)
 
 	s := &Service{
-		ctx:          ctx,
-		cancel:       cancel,
-		cfg:          cfg,
-		addrFilter:   addrFilter,
-		ipLimiter:    ipLimiter,
-		privKey:      privKey,
-		metaData:     metaData,
-		isPreGenesis: true,
-		joinedTopics: make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
-		subnetsLock:  make(map[uint64]*sync.RWMutex),
+		ctx:            ctx,
+		cancel:         cancel,
+		cfg:            cfg,
+		addrFilter:     addrFilter,
+		ipLimiter:      ipLimiter,
+		privKey:        privKey,
+		metaData:       metaData,
+		isPreGenesis:   true,
+		joinedTopics:   make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
+		subnetsLock:    make(map[uint64]*sync.RWMutex),
+		propagation:    newPropagationTracker(cfg.PropagationWindow),
+		custodySubnets: custodySubnets,
 	}
 
 	ipAddr := prysmnetwork.IPAddr()
@@ -154,6 +168,9 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	}
 
 	s.pubsub = gs
+	if err := checkSigningPolicy(s.ctx, gs); err != nil {
+		return nil, errors.Wrap(err, "pubsub signing policy self test failed")
+	}
 
 	s.peers = peers.NewStatus(ctx, &peers.StatusConfig{
 		PeerLimit: int(s.cfg.MaxPeers),
@@ -235,6 +252,9 @@ func (s *Service) Start() {
 	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
 	async.RunEvery(s.ctx, time.Duration(params.BeaconConfig().RespTimeout)*time.Second, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, s.RefreshENR)
+	if s.propagation != nil {
+		async.RunEvery(s.ctx, propagationCheckInterval, s.reportUnpropagatedMessages)
+	}
 	async.RunEvery(s.ctx, 1*time.Minute, func() {
 		inboundQUICCount := len(s.peers.InboundConnectedWithProtocol(peers.QUIC))
 		inboundTCPCount := len(s.peers.InboundConnectedWithProtocol(peers.TCP))
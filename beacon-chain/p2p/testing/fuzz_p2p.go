@@ -68,6 +68,11 @@ func (_ *FakeP2P) FindPeersWithSubnet(_ context.Context, _ string, _ uint64, _ i
 // RefreshENR mocks the p2p func.
 func (_ *FakeP2P) RefreshENR() {}
 
+// RebuildENR -- fake.
+func (_ *FakeP2P) RebuildENR() (*enr.Record, error) {
+	return new(enr.Record), nil
+}
+
 // LeaveTopic -- fake.
 func (_ *FakeP2P) LeaveTopic(_ string) error {
 	return nil
@@ -103,6 +108,21 @@ func (_ *FakeP2P) MetadataSeq() uint64 {
 	return 0
 }
 
+// CustodySubnetCount -- fake.
+func (_ *FakeP2P) CustodySubnetCount() uint64 {
+	return 0
+}
+
+// CustodySubnets -- fake.
+func (_ *FakeP2P) CustodySubnets() map[uint64]bool {
+	return nil
+}
+
+// CustodySubnetCountFromPeer -- fake.
+func (_ *FakeP2P) CustodySubnetCountFromPeer(peer.ID) uint64 {
+	return 0
+}
+
 // SetStreamHandler -- fake.
 func (_ *FakeP2P) SetStreamHandler(_ string, _ network.StreamHandler) {
 
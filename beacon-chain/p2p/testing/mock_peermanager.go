@@ -50,6 +50,11 @@ func (m MockPeerManager) DiscoveryAddresses() ([]multiaddr.Multiaddr, error) {
 // RefreshENR .
 func (_ MockPeerManager) RefreshENR() {}
 
+// RebuildENR .
+func (m MockPeerManager) RebuildENR() (*enr.Record, error) {
+	return m.Enr, nil
+}
+
 // FindPeersWithSubnet .
 func (_ MockPeerManager) FindPeersWithSubnet(_ context.Context, _ string, _ uint64, _ int) (bool, error) {
 	return true, nil
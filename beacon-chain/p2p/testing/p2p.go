@@ -25,6 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/metadata"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -48,6 +49,8 @@ type TestP2P struct {
 	Digest          [4]byte
 	peers           *peers.Status
 	LocalMetadata   metadata.Metadata
+	// Custody is the set of data column sidecar subnets custodied by the node.
+	Custody map[uint64]bool
 }
 
 // NewTestP2P initializes a new p2p test service.
@@ -370,6 +373,11 @@ func (_ *TestP2P) FindPeersWithSubnet(_ context.Context, _ string, _ uint64, _ i
 // RefreshENR mocks the p2p func.
 func (_ *TestP2P) RefreshENR() {}
 
+// RebuildENR mocks the p2p func.
+func (_ *TestP2P) RebuildENR() (*enr.Record, error) {
+	return new(enr.Record), nil
+}
+
 // ForkDigest mocks the p2p func.
 func (p *TestP2P) ForkDigest() ([4]byte, error) {
 	return p.Digest, nil
@@ -385,6 +393,21 @@ func (p *TestP2P) MetadataSeq() uint64 {
 	return p.LocalMetadata.SequenceNumber()
 }
 
+// CustodySubnetCount mocks the number of data column sidecar subnets custodied by the node.
+func (p *TestP2P) CustodySubnetCount() uint64 {
+	return uint64(len(p.Custody))
+}
+
+// CustodySubnets mocks the data column sidecar subnets custodied by the node.
+func (p *TestP2P) CustodySubnets() map[uint64]bool {
+	return p.Custody
+}
+
+// CustodySubnetCountFromPeer mocks the number of data column sidecar subnets custodied by the peer.
+func (_ *TestP2P) CustodySubnetCountFromPeer(peer.ID) uint64 {
+	return params.BeaconConfig().CustodyRequirement
+}
+
 // AddPingMethod mocks the p2p func.
 func (_ *TestP2P) AddPingMethod(_ func(ctx context.Context, id peer.ID) error) {
 	// no-op
@@ -15,4 +15,8 @@ var (
 	ErrBlobLTMinRequest    = errors.New("blob slot < minimum_request_epoch")
 	ErrMaxBlobReqExceeded  = errors.New("requested more than MAX_REQUEST_BLOB_SIDECARS")
 	ErrResourceUnavailable = errors.New("resource requested unavailable")
+
+	// ErrUnverifiedFinalizedRoot is returned for a finalized root older than our block history, which can be
+	// neither confirmed nor rejected.
+	ErrUnverifiedFinalizedRoot = errors.New("unverified finalized root")
 )
@@ -22,6 +22,10 @@ const (
 	GoodbyeCodeTooManyPeers = RPCGoodbyeCode(129)
 	GoodbyeCodeBadScore     = RPCGoodbyeCode(250)
 	GoodbyeCodeBanned       = RPCGoodbyeCode(251)
+
+	// Prysm specific codes. Peers repeatedly exceeding the RPC rate limits are sent the code Teku
+	// uses for rate limiting, so that both clients report the same reason.
+	GoodbyeCodeRateLimited = RPCGoodbyeCode(130)
 )
 
 // GoodbyeCodeMessages defines a mapping between goodbye codes and string messages.
@@ -30,6 +34,7 @@ var GoodbyeCodeMessages = map[RPCGoodbyeCode]string{
 	GoodbyeCodeWrongNetwork:          "irrelevant network",
 	GoodbyeCodeGenericError:          "fault/error",
 	GoodbyeCodeUnableToVerifyNetwork: "unable to verify network",
+	GoodbyeCodeRateLimited:           "peer exceeded rate limits",
 	GoodbyeCodeTooManyPeers:          "client has too many peers",
 	GoodbyeCodeBadScore:              "peer score too low",
 	GoodbyeCodeBanned:                "client banned this node",
@@ -183,6 +183,7 @@ func TestGoodbyeCodes(t *testing.T) {
 	assert.Equal(t, primitives.SSZUint64(2), GoodbyeCodeWrongNetwork)
 	assert.Equal(t, primitives.SSZUint64(3), GoodbyeCodeGenericError)
 	assert.Equal(t, primitives.SSZUint64(128), GoodbyeCodeUnableToVerifyNetwork)
+	assert.Equal(t, primitives.SSZUint64(130), GoodbyeCodeRateLimited)
 	assert.Equal(t, primitives.SSZUint64(129), GoodbyeCodeTooManyPeers)
 	assert.Equal(t, primitives.SSZUint64(250), GoodbyeCodeBadScore)
 	assert.Equal(t, primitives.SSZUint64(251), GoodbyeCodeBanned)
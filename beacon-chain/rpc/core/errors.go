@@ -3,9 +3,14 @@ package core
 import (
 	"net/http"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 )
 
+// ErrArchivalQueryDisabled is returned when an API request needs a state older than the finalized checkpoint
+// while serving archival queries is disabled.
+var ErrArchivalQueryDisabled = errors.New("archival state queries are disabled on this node")
+
 type ErrorReason uint8
 
 const (
@@ -13,6 +18,7 @@ const (
 	Unavailable
 	BadRequest
 	NotFound
+	Forbidden
 	// Add more errors as needed
 )
 
@@ -31,6 +37,8 @@ func ErrorReasonToGRPC(reason ErrorReason) codes.Code {
 		return codes.InvalidArgument
 	case NotFound:
 		return codes.NotFound
+	case Forbidden:
+		return codes.PermissionDenied
 	// Add more cases for other error reasons as needed
 	default:
 		return codes.Internal
@@ -47,8 +55,18 @@ func ErrorReasonToHTTP(reason ErrorReason) int {
 		return http.StatusBadRequest
 	case NotFound:
 		return http.StatusNotFound
+	case Forbidden:
+		return http.StatusForbidden
 	// Add more cases for other error reasons as needed
 	default:
 		return http.StatusInternalServerError
 	}
 }
+
+// ReplayErrorReason returns the reason of an error replaying a state for an API request.
+func ReplayErrorReason(err error) ErrorReason {
+	if errors.Is(err, ErrArchivalQueryDisabled) {
+		return Forbidden
+	}
+	return Internal
+}
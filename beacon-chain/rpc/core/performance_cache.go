@@ -0,0 +1,68 @@
+package core
+
+import (
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
+	beaconState "github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// maxPerformanceCacheSize is the number of epochs for which the performance of validators is cached. Every entry
+// holds a beacon state, so only the few epochs queried the most, usually the latest ones, are kept.
+const maxPerformanceCacheSize = 3
+
+// performanceSummary is the precomputed performance of all validators at an epoch, along with the state it was
+// computed from.
+type performanceSummary struct {
+	// root identifies the chain the summary was computed from, see performanceRoot.
+	root       [32]byte
+	state      beaconState.ReadOnlyBeaconState
+	validators []*precompute.Validator
+}
+
+// PerformanceCache caches the performance of all validators per epoch, so that paginated performance requests
+// do not precompute the rewards and penalties of the whole validator set for every page.
+type PerformanceCache struct {
+	lock    sync.Mutex
+	entries map[primitives.Epoch]*performanceSummary
+}
+
+// NewPerformanceCache creates a new validator performance cache.
+func NewPerformanceCache() *PerformanceCache {
+	return &PerformanceCache{entries: make(map[primitives.Epoch]*performanceSummary)}
+}
+
+// get returns the performance summary of the epoch if it was computed from the chain identified by the root.
+// A summary computed from another chain was reorged out, and is dropped.
+func (c *PerformanceCache) get(epoch primitives.Epoch, root [32]byte) *performanceSummary {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	summary, ok := c.entries[epoch]
+	if !ok {
+		return nil
+	}
+	if summary.root != root {
+		delete(c.entries, epoch)
+		return nil
+	}
+	return summary
+}
+
+// put caches the performance summary of the epoch. When the cache is full, the lowest other epoch is evicted.
+func (c *PerformanceCache) put(epoch primitives.Epoch, summary *performanceSummary) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.entries[epoch]; !ok && len(c.entries) >= maxPerformanceCacheSize {
+		first := true
+		var lowest primitives.Epoch
+		for e := range c.entries {
+			if first || e < lowest {
+				lowest = e
+				first = false
+			}
+		}
+		delete(c.entries, lowest)
+	}
+	c.entries[epoch] = summary
+}
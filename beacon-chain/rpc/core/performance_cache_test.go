@@ -0,0 +1,35 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestPerformanceCache(t *testing.T) {
+	c := NewPerformanceCache()
+	root := [32]byte{'a'}
+	summary := &performanceSummary{root: root}
+	c.put(5, summary)
+	assert.Equal(t, summary, c.get(5, root))
+	assert.Equal(t, (*performanceSummary)(nil), c.get(6, root))
+
+	t.Run("reorged", func(t *testing.T) {
+		c.put(5, summary)
+		assert.Equal(t, (*performanceSummary)(nil), c.get(5, [32]byte{'b'}))
+		// The summary of the reorged chain is dropped.
+		assert.Equal(t, (*performanceSummary)(nil), c.get(5, root))
+	})
+	t.Run("evicts lowest epoch", func(t *testing.T) {
+		for e := primitives.Epoch(10); e < 10+maxPerformanceCacheSize; e++ {
+			c.put(e, &performanceSummary{root: root})
+		}
+		c.put(3, summary)
+		require.Equal(t, maxPerformanceCacheSize, len(c.entries))
+		assert.Equal(t, (*performanceSummary)(nil), c.get(10, root))
+		assert.Equal(t, summary, c.get(3, root))
+		assert.NotNil(t, c.get(12, root))
+	})
+}
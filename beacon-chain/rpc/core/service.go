@@ -1,6 +1,8 @@
 package core
 
 import (
+	"sync"
+
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	opfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
@@ -8,7 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+	chainSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 )
 
 type Service struct {
@@ -17,7 +19,7 @@ type Service struct {
 	HeadFetcher           blockchain.HeadFetcher
 	FinalizedFetcher      blockchain.FinalizationFetcher
 	GenesisTimeFetcher    blockchain.TimeFetcher
-	SyncChecker           sync.Checker
+	SyncChecker           chainSync.Checker
 	Broadcaster           p2p.Broadcaster
 	SyncCommitteePool     synccommittee.Pool
 	OperationNotifier     opfeed.Notifier
@@ -26,4 +28,8 @@ type Service struct {
 	P2P                   p2p.Broadcaster
 	ReplayerBuilder       stategen.ReplayerBuilder
 	OptimisticModeFetcher blockchain.OptimisticModeFetcher
+	PerformanceCache      *PerformanceCache
+	// attestationDataLock serializes the computation of attestation data missing from AttestationCache,
+	// so that concurrent requests for the same slot and head derive it only once.
+	attestationDataLock sync.Mutex
 }
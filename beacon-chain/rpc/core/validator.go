@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/pagination"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
@@ -67,58 +68,192 @@ func (s *Service) ComputeValidatorPerformance(
 		return nil, &RpcError{Reason: Unavailable, Err: errors.New("Syncing to latest head, not ready to respond")}
 	}
 
-	headState, err := s.HeadFetcher.HeadState(ctx)
+	summary, rpcErr := s.performanceSummary(ctx, slots.ToEpoch(s.GenesisTimeFetcher.CurrentSlot()))
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	validatorIndices, missingValidators := summary.validatorIndices(req)
+	return summary.performance(validatorIndices, missingValidators)
+}
+
+// ValidatorPerformanceAtEpoch reports the performance of validators like ComputeValidatorPerformance, as computed
+// from the state at the end of the given epoch, for a page of the requested validators sorted by index.
+// It also returns the token of the next page and the number of validators of the request found in the state.
+// Public keys not found in the state are only reported as missing in the first page.
+func (s *Service) ValidatorPerformanceAtEpoch(
+	ctx context.Context,
+	epoch primitives.Epoch,
+	req *ethpb.ValidatorPerformanceRequest,
+	pageToken string,
+	pageSize int,
+) (*ethpb.ValidatorPerformanceResponse, string, int, *RpcError) {
+	ctx, span := trace.StartSpan(ctx, "coreService.ValidatorPerformanceAtEpoch")
+	defer span.End()
+
+	if s.SyncChecker.Syncing() {
+		return nil, "", 0, &RpcError{Reason: Unavailable, Err: errors.New("Syncing to latest head, not ready to respond")}
+	}
+	currentEpoch := slots.ToEpoch(s.GenesisTimeFetcher.CurrentSlot())
+	if epoch > currentEpoch {
+		return nil, "", 0, &RpcError{
+			Err:    errors.Errorf("cannot retrieve performance of epoch %d, current epoch is %d", epoch, currentEpoch),
+			Reason: BadRequest,
+		}
+	}
+
+	summary, rpcErr := s.performanceSummary(ctx, epoch)
+	if rpcErr != nil {
+		return nil, "", 0, rpcErr
+	}
+	validatorIndices, missingValidators := summary.validatorIndices(req)
+	totalSize := len(validatorIndices)
+	if totalSize == 0 {
+		resp, rpcErr := summary.performance(nil, missingValidators)
+		return resp, "", 0, rpcErr
+	}
+	start, end, nextPageToken, err := pagination.StartAndEndPage(pageToken, pageSize, totalSize)
 	if err != nil {
-		return nil, &RpcError{Err: errors.Wrap(err, "could not get head state"), Reason: Internal}
+		return nil, "", 0, &RpcError{Err: errors.Wrap(err, "could not paginate results"), Reason: BadRequest}
+	}
+	if start > 0 {
+		missingValidators = nil
 	}
+	resp, rpcErr := summary.performance(validatorIndices[start:end], missingValidators)
+	if rpcErr != nil {
+		return nil, "", 0, rpcErr
+	}
+	return resp, nextPageToken, totalSize, nil
+}
+
+// performanceSummary returns the precomputed performance of all validators at the given epoch, from the cache if
+// it holds the performance of the epoch for the current canonical chain. The performance of the current epoch is
+// computed from the head state, the performance of past epochs from the replayed state at the end of the epoch.
+func (s *Service) performanceSummary(ctx context.Context, epoch primitives.Epoch) (*performanceSummary, *RpcError) {
+	var root [32]byte
+	if s.PerformanceCache != nil {
+		r, err := s.performanceRoot(ctx, epoch)
+		if err != nil {
+			return nil, &RpcError{Err: errors.Wrap(err, "could not get block root of the epoch"), Reason: Internal}
+		}
+		if summary := s.PerformanceCache.get(epoch, r); summary != nil {
+			return summary, nil
+		}
+		root = r
+	}
+
+	var st beaconState.BeaconState
 	currSlot := s.GenesisTimeFetcher.CurrentSlot()
-	if currSlot > headState.Slot() {
-		headRoot, err := s.HeadFetcher.HeadRoot(ctx)
+	if epoch == slots.ToEpoch(currSlot) {
+		headState, err := s.HeadFetcher.HeadState(ctx)
 		if err != nil {
-			return nil, &RpcError{Err: errors.Wrap(err, "could not get head root"), Reason: Internal}
+			return nil, &RpcError{Err: errors.Wrap(err, "could not get head state"), Reason: Internal}
 		}
-		headState, err = transition.ProcessSlotsUsingNextSlotCache(ctx, headState, headRoot, currSlot)
+		if currSlot > headState.Slot() {
+			headRoot, err := s.HeadFetcher.HeadRoot(ctx)
+			if err != nil {
+				return nil, &RpcError{Err: errors.Wrap(err, "could not get head root"), Reason: Internal}
+			}
+			headState, err = transition.ProcessSlotsUsingNextSlotCache(ctx, headState, headRoot, currSlot)
+			if err != nil {
+				return nil, &RpcError{Err: errors.Wrapf(err, "could not process slots up to %d", currSlot), Reason: Internal}
+			}
+		}
+		st = headState
+	} else {
+		endSlot, err := slots.EpochEnd(epoch)
+		if err != nil {
+			return nil, &RpcError{Err: errors.Wrapf(err, "could not get end slot of epoch %d", epoch), Reason: BadRequest}
+		}
+		st, err = s.ReplayerBuilder.ReplayerForSlot(endSlot).ReplayToSlot(ctx, endSlot)
+		if err != nil {
+			return nil, &RpcError{Err: errors.Wrapf(err, "error replaying blocks for state at slot %d", endSlot), Reason: ReplayErrorReason(err)}
+		}
+	}
+
+	summary, rpcErr := computePerformanceSummary(ctx, st)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if s.PerformanceCache != nil {
+		summary.root = root
+		s.PerformanceCache.put(epoch, summary)
+	}
+	return summary, nil
+}
+
+// performanceRoot returns the root identifying the chain the performance of the epoch is computed from: the root of
+// the canonical block at the end of the epoch, or the head root if the head is not past the epoch yet. Finalized
+// epochs cannot be reorged, so their performance is identified by the zero root.
+func (s *Service) performanceRoot(ctx context.Context, epoch primitives.Epoch) ([32]byte, error) {
+	if epoch < s.FinalizedFetcher.FinalizedCheckpt().Epoch {
+		return [32]byte{}, nil
+	}
+	endSlot, err := slots.EpochEnd(epoch)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	headState, err := s.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get head state")
+	}
+	if headState.Slot() <= endSlot {
+		r, err := s.HeadFetcher.HeadRoot(ctx)
 		if err != nil {
-			return nil, &RpcError{Err: errors.Wrapf(err, "could not process slots up to %d", currSlot), Reason: Internal}
+			return [32]byte{}, errors.Wrap(err, "could not get head root")
 		}
+		return bytesutil.ToBytes32(r), nil
+	}
+	r, err := helpers.BlockRootAtSlot(headState, endSlot)
+	if err != nil {
+		return [32]byte{}, err
 	}
+	return bytesutil.ToBytes32(r), nil
+}
+
+// computePerformanceSummary precomputes the rewards and penalties of all validators of the state.
+func computePerformanceSummary(ctx context.Context, st beaconState.BeaconState) (*performanceSummary, *RpcError) {
 	var validatorSummary []*precompute.Validator
-	if headState.Version() == version.Phase0 {
-		vp, bp, err := precompute.New(ctx, headState)
+	if st.Version() == version.Phase0 {
+		vp, bp, err := precompute.New(ctx, st)
 		if err != nil {
 			return nil, &RpcError{Err: err, Reason: Internal}
 		}
-		vp, bp, err = precompute.ProcessAttestations(ctx, headState, vp, bp)
+		vp, bp, err = precompute.ProcessAttestations(ctx, st, vp, bp)
 		if err != nil {
 			return nil, &RpcError{Err: err, Reason: Internal}
 		}
-		headState, err = precompute.ProcessRewardsAndPenaltiesPrecompute(headState, bp, vp, precompute.AttestationsDelta, precompute.ProposersDelta)
+		st, err = precompute.ProcessRewardsAndPenaltiesPrecompute(st, bp, vp, precompute.AttestationsDelta, precompute.ProposersDelta)
 		if err != nil {
 			return nil, &RpcError{Err: err, Reason: Internal}
 		}
 		validatorSummary = vp
-	} else if headState.Version() >= version.Altair {
-		vp, bp, err := altair.InitializePrecomputeValidators(ctx, headState)
+	} else if st.Version() >= version.Altair {
+		vp, bp, err := altair.InitializePrecomputeValidators(ctx, st)
 		if err != nil {
 			return nil, &RpcError{Err: err, Reason: Internal}
 		}
-		vp, bp, err = altair.ProcessEpochParticipation(ctx, headState, bp, vp)
+		vp, bp, err = altair.ProcessEpochParticipation(ctx, st, bp, vp)
 		if err != nil {
 			return nil, &RpcError{Err: err, Reason: Internal}
 		}
-		headState, vp, err = altair.ProcessInactivityScores(ctx, headState, vp)
+		st, vp, err = altair.ProcessInactivityScores(ctx, st, vp)
 		if err != nil {
 			return nil, &RpcError{Err: err, Reason: Internal}
 		}
-		headState, err = altair.ProcessRewardsAndPenaltiesPrecompute(headState, bp, vp)
+		st, err = altair.ProcessRewardsAndPenaltiesPrecompute(st, bp, vp)
 		if err != nil {
 			return nil, &RpcError{Err: err, Reason: Internal}
 		}
 		validatorSummary = vp
 	} else {
-		return nil, &RpcError{Err: errors.Wrapf(err, "head state version %d not supported", headState.Version()), Reason: Internal}
+		return nil, &RpcError{Err: errors.Errorf("state version %d not supported", st.Version()), Reason: Internal}
 	}
+	return &performanceSummary{state: st, validators: validatorSummary}, nil
+}
 
+// validatorIndices resolves the validators of the request to indices sorted in ascending order, and returns the
+// public keys not found in the state.
+func (p *performanceSummary) validatorIndices(req *ethpb.ValidatorPerformanceRequest) ([]primitives.ValidatorIndex, [][]byte) {
 	responseCap := len(req.Indices) + len(req.PublicKeys)
 	validatorIndices := make([]primitives.ValidatorIndex, 0, responseCap)
 	missingValidators := make([][]byte, 0, responseCap)
@@ -131,7 +266,7 @@ func (s *Service) ComputeValidatorPerformance(
 			continue
 		}
 		pubkeyBytes := bytesutil.ToBytes48(pubKey)
-		idx, ok := headState.ValidatorIndexByPubkey(pubkeyBytes)
+		idx, ok := p.state.ValidatorIndexByPubkey(pubkeyBytes)
 		if !ok {
 			// Validator index not found, track as missing.
 			missingValidators = append(missingValidators, pubKey)
@@ -153,9 +288,17 @@ func (s *Service) ComputeValidatorPerformance(
 	sort.Slice(validatorIndices, func(i, j int) bool {
 		return validatorIndices[i] < validatorIndices[j]
 	})
+	return validatorIndices, missingValidators
+}
 
-	currentEpoch := coreTime.CurrentEpoch(headState)
-	responseCap = len(validatorIndices)
+// performance builds the performance response of the given validators, appending inactive validators and
+// validators not in the summary to the missing validators.
+func (p *performanceSummary) performance(
+	validatorIndices []primitives.ValidatorIndex,
+	missingValidators [][]byte,
+) (*ethpb.ValidatorPerformanceResponse, *RpcError) {
+	currentEpoch := coreTime.CurrentEpoch(p.state)
+	responseCap := len(validatorIndices)
 	pubKeys := make([][]byte, 0, responseCap)
 	beforeTransitionBalances := make([]uint64, 0, responseCap)
 	afterTransitionBalances := make([]uint64, 0, responseCap)
@@ -167,12 +310,12 @@ func (s *Service) ComputeValidatorPerformance(
 	// Append performance summaries.
 	// Also track missing validators using public keys.
 	for _, idx := range validatorIndices {
-		val, err := headState.ValidatorAtIndexReadOnly(idx)
+		val, err := p.state.ValidatorAtIndexReadOnly(idx)
 		if err != nil {
 			return nil, &RpcError{Err: errors.Wrap(err, "could not get validator"), Reason: Internal}
 		}
 		pubKey := val.PublicKey()
-		if uint64(idx) >= uint64(len(validatorSummary)) {
+		if uint64(idx) >= uint64(len(p.validators)) {
 			// Not listed in validator summary yet; treat it as missing.
 			missingValidators = append(missingValidators, pubKey[:])
 			continue
@@ -183,7 +326,7 @@ func (s *Service) ComputeValidatorPerformance(
 			continue
 		}
 
-		summary := validatorSummary[idx]
+		summary := p.validators[idx]
 		pubKeys = append(pubKeys, pubKey[:])
 		effectiveBalances = append(effectiveBalances, summary.CurrentEpochEffectiveBalance)
 		beforeTransitionBalances = append(beforeTransitionBalances, summary.BeforeEpochTransitionBalance)
@@ -191,7 +334,7 @@ func (s *Service) ComputeValidatorPerformance(
 		correctlyVotedTarget = append(correctlyVotedTarget, summary.IsPrevEpochTargetAttester)
 		correctlyVotedHead = append(correctlyVotedHead, summary.IsPrevEpochHeadAttester)
 
-		if headState.Version() == version.Phase0 {
+		if p.state.Version() == version.Phase0 {
 			correctlyVotedSource = append(correctlyVotedSource, summary.IsPrevEpochAttester)
 		} else {
 			correctlyVotedSource = append(correctlyVotedSource, summary.IsPrevEpochSourceAttester)
@@ -233,7 +376,7 @@ func (s *Service) IndividualVotes(
 	if err != nil {
 		return nil, &RpcError{
 			Err:    errors.Wrapf(err, "failed to replay blocks for state at epoch %d", req.Epoch),
-			Reason: Internal,
+			Reason: ReplayErrorReason(err),
 		}
 	}
 	// Track filtered validators to prevent duplication in the response.
@@ -499,47 +642,23 @@ func (s *Service) GetAttestationData(
 		committeeIndex = req.CommitteeIndex
 	}
 
-	s.AttestationCache.RLock()
-	res := s.AttestationCache.Get()
-	if res != nil && res.Slot == req.Slot {
-		s.AttestationCache.RUnlock()
-		return &ethpb.AttestationData{
-			Slot:            res.Slot,
-			CommitteeIndex:  committeeIndex,
-			BeaconBlockRoot: res.HeadRoot,
-			Source: &ethpb.Checkpoint{
-				Epoch: res.Source.Epoch,
-				Root:  res.Source.Root[:],
-			},
-			Target: &ethpb.Checkpoint{
-				Epoch: res.Target.Epoch,
-				Root:  res.Target.Root[:],
-			},
-		}, nil
-	}
-	s.AttestationCache.RUnlock()
-
-	s.AttestationCache.Lock()
-	defer s.AttestationCache.Unlock()
+	headRoot, err := s.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, &RpcError{Reason: Internal, Err: errors.Wrap(err, "could not get head root")}
+	}
+
+	if res := s.AttestationCache.Get(req.Slot, bytesutil.ToBytes32(headRoot)); res != nil {
+		return attestationDataFromCache(res, committeeIndex), nil
+	}
+
+	s.attestationDataLock.Lock()
+	defer s.attestationDataLock.Unlock()
 
 	// We check the cache again as in the event there are multiple inflight requests for
 	// the same attestation data, the cache might have been filled while we were waiting
 	// to acquire the lock.
-	res = s.AttestationCache.Get()
-	if res != nil && res.Slot == req.Slot {
-		return &ethpb.AttestationData{
-			Slot:            res.Slot,
-			CommitteeIndex:  committeeIndex,
-			BeaconBlockRoot: res.HeadRoot,
-			Source: &ethpb.Checkpoint{
-				Epoch: res.Source.Epoch,
-				Root:  res.Source.Root[:],
-			},
-			Target: &ethpb.Checkpoint{
-				Epoch: res.Target.Epoch,
-				Root:  res.Target.Root[:],
-			},
-		}, nil
+	if res := s.AttestationCache.Get(req.Slot, bytesutil.ToBytes32(headRoot)); res != nil {
+		return attestationDataFromCache(res, committeeIndex), nil
 	}
 	// cache miss, we need to check for optimistic status before proceeding
 	optimistic, err := s.OptimisticModeFetcher.IsOptimistic(ctx)
@@ -550,10 +669,6 @@ func (s *Service) GetAttestationData(
 		return nil, &RpcError{Reason: Unavailable, Err: errOptimisticMode}
 	}
 
-	headRoot, err := s.HeadFetcher.HeadRoot(ctx)
-	if err != nil {
-		return nil, &RpcError{Reason: Internal, Err: errors.Wrap(err, "could not get head root")}
-	}
 	targetEpoch := slots.ToEpoch(req.Slot)
 	targetRoot, err := s.HeadFetcher.TargetRootForEpoch(bytesutil.ToBytes32(headRoot), targetEpoch)
 	if err != nil {
@@ -602,6 +717,23 @@ func (s *Service) GetAttestationData(
 	}, nil
 }
 
+// attestationDataFromCache builds the attestation data for the committee from cached consensus data.
+func attestationDataFromCache(res *cache.AttestationConsensusData, committeeIndex primitives.CommitteeIndex) *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		Slot:            res.Slot,
+		CommitteeIndex:  committeeIndex,
+		BeaconBlockRoot: res.HeadRoot,
+		Source: &ethpb.Checkpoint{
+			Epoch: res.Source.Epoch,
+			Root:  res.Source.Root[:],
+		},
+		Target: &ethpb.Checkpoint{
+			Epoch: res.Target.Epoch,
+			Root:  res.Target.Root[:],
+		},
+	}
+}
+
 // SubmitSyncMessage submits the sync committee message to the network.
 // It also saves the sync committee message into the pending pool for block inclusion.
 func (s *Service) SubmitSyncMessage(ctx context.Context, msg *ethpb.SyncCommitteeMessage) *RpcError {
@@ -790,7 +922,7 @@ func (s *Service) ValidatorParticipation(
 	// ReplayerBuilder ensures that a canonical chain is followed to the slot
 	beaconSt, err := s.ReplayerBuilder.ReplayerForSlot(endSlot).ReplayBlocks(ctx)
 	if err != nil {
-		return nil, &RpcError{Reason: Internal, Err: errors.Wrapf(err, "error replaying blocks for state at slot %d", endSlot)}
+		return nil, &RpcError{Reason: ReplayErrorReason(err), Err: errors.Wrapf(err, "error replaying blocks for state at slot %d", endSlot)}
 	}
 	var v []*precompute.Validator
 	var b *precompute.Balance
@@ -865,7 +997,7 @@ func (s *Service) ValidatorActiveSetChanges(
 	if err != nil {
 		return nil, &RpcError{
 			Err:    errors.Wrapf(err, "error replaying blocks for state at slot %d", slot),
-			Reason: Internal,
+			Reason: ReplayErrorReason(err),
 		}
 	}
 
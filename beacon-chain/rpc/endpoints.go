@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 	beaconprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/beacon"
 	nodeprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/node"
+	slasherprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/slasher"
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
 	validatorprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/validator"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
@@ -39,6 +40,7 @@ func (e *endpoint) handlerWithMiddleware() http.HandlerFunc {
 	for _, m := range e.middleware {
 		handler = m(handler)
 	}
+	handler = apiCallerHandler(handler)
 	return promhttp.InstrumentHandlerDuration(
 		httpRequestLatency.MustCurryWith(prometheus.Labels{"endpoint": e.name}),
 		promhttp.InstrumentHandlerCounter(
@@ -48,6 +50,13 @@ func (e *endpoint) handlerWithMiddleware() http.HandlerFunc {
 	)
 }
 
+// apiCallerHandler marks the state lookups of HTTP requests as coming from the APIs.
+func apiCallerHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(lookup.WithCaller(r.Context(), lookup.APICaller)))
+	})
+}
+
 func (s *Service) endpoints(
 	enableDebug bool,
 	blocker lookup.Blocker,
@@ -70,8 +79,11 @@ func (s *Service) endpoints(
 	endpoints = append(endpoints, s.prysmBeaconEndpoints(ch, stater, coreService)...)
 	endpoints = append(endpoints, s.prysmNodeEndpoints()...)
 	endpoints = append(endpoints, s.prysmValidatorEndpoints(stater, coreService)...)
+	if s.cfg.SlashingChecker != nil {
+		endpoints = append(endpoints, s.prysmSlasherEndpoints()...)
+	}
 	if enableDebug {
-		endpoints = append(endpoints, s.debugEndpoints(stater)...)
+		endpoints = append(endpoints, s.debugEndpoints(stater, blocker)...)
 	}
 	return endpoints
 }
@@ -186,6 +198,7 @@ func (s *Service) validatorEndpoints(
 		PayloadIDCache:         s.cfg.PayloadIDCache,
 		CoreService:            coreService,
 		BlockRewardFetcher:     rewardFetcher,
+		ValidatorLiveness:      s.cfg.ValidatorLiveness,
 	}
 
 	const namespace = "validator"
@@ -488,6 +501,7 @@ func (s *Service) beaconEndpoints(
 		FinalizationFetcher:     s.cfg.FinalizationFetcher,
 		ForkchoiceFetcher:       s.cfg.ForkchoiceFetcher,
 		CoreService:             coreService,
+		DepositFetcher:          s.cfg.DepositFetcher,
 	}
 
 	const namespace = "beacon"
@@ -905,7 +919,7 @@ func (s *Service) lightClientEndpoints(blocker lookup.Blocker, stater lookup.Sta
 	}
 }
 
-func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
+func (s *Service) debugEndpoints(stater lookup.Stater, blocker lookup.Blocker) []endpoint {
 	server := &debug.Server{
 		BeaconDB:              s.cfg.BeaconDB,
 		HeadFetcher:           s.cfg.HeadFetcher,
@@ -915,6 +929,7 @@ func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
 		ForkchoiceFetcher:     s.cfg.ForkchoiceFetcher,
 		FinalizationFetcher:   s.cfg.FinalizationFetcher,
 		ChainInfoFetcher:      s.cfg.ChainInfoFetcher,
+		Blocker:               blocker,
 	}
 
 	const namespace = "debug"
@@ -946,6 +961,34 @@ func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
 			handler: server.GetForkChoice,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/debug/forkchoice",
+			name:     namespace + ".GetForkChoiceSnapshot",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetForkChoiceSnapshot,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/eth/v1/debug/beacon/blob_sidecars/{block_id}",
+			name:     namespace + ".GetBlobSidecarVerifications",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetBlobSidecarVerifications,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/eth/v1/debug/beacon/blob_sidecars/{block_id}/verify",
+			name:     namespace + ".VerifyBlobSidecar",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.VerifyBlobSidecar,
+			methods: []string{http.MethodPost},
+		},
 	}
 }
 
@@ -969,6 +1012,15 @@ func (s *Service) eventsEndpoints() []endpoint {
 			handler: server.StreamEvents,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/events",
+			name:     namespace + ".StreamPrysmEvents",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.EventStreamMediaType}),
+			},
+			handler: server.StreamPrysmEvents,
+			methods: []string{http.MethodGet},
+		},
 	}
 }
 
@@ -991,6 +1043,9 @@ func (s *Service) prysmBeaconEndpoints(
 		CoreService:           coreService,
 		Broadcaster:           s.cfg.Broadcaster,
 		BlobReceiver:          s.cfg.BlobReceiver,
+		SlotObservations:      s.cfg.SlotObservations,
+		GraffitiStats:         s.cfg.GraffitiStats,
+		BlobStorage:           s.cfg.BlobStorage,
 	}
 
 	const namespace = "prysm.beacon"
@@ -1022,6 +1077,15 @@ func (s *Service) prysmBeaconEndpoints(
 			handler: server.GetValidatorCount,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/beacon/states/{state_id}/validator_proofs/{validator_index}",
+			name:     namespace + ".GetValidatorProof",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetValidatorProof,
+			methods: []string{http.MethodGet},
+		},
 		{
 			template: "/prysm/v1/beacon/individual_votes",
 			name:     namespace + ".GetIndividualVotes",
@@ -1041,6 +1105,33 @@ func (s *Service) prysmBeaconEndpoints(
 			handler: server.GetChainHead,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/beacon/slot_outcomes",
+			name:     namespace + ".GetSlotOutcomes",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetSlotOutcomes,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/graffiti_stats",
+			name:     namespace + ".GetGraffitiStats",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetGraffitiStats,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/states/{state_id}/fields",
+			name:     namespace + ".GetStateFields",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType, api.OctetStreamMediaType}),
+			},
+			handler: server.GetStateFields,
+			methods: []string{http.MethodGet},
+		},
 		{
 			template: "/prysm/v1/beacon/blobs",
 			name:     namespace + ".PublishBlobs",
@@ -1051,6 +1142,15 @@ func (s *Service) prysmBeaconEndpoints(
 			handler: server.PublishBlobs,
 			methods: []string{http.MethodPost},
 		},
+		{
+			template: "/prysm/v1/beacon/blob_sidecars/versioned_hash/{versioned_hash}",
+			name:     namespace + ".GetBlobSidecarByVersionedHash",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetBlobSidecarByVersionedHash,
+			methods: []string{http.MethodGet},
+		},
 	}
 }
 
@@ -1065,6 +1165,9 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 		MetadataProvider:          s.cfg.MetadataProvider,
 		HeadFetcher:               s.cfg.HeadFetcher,
 		ExecutionChainInfoFetcher: s.cfg.ExecutionChainInfoFetcher,
+		SubnetAttestationStats:    s.cfg.SubnetAttestationStats,
+		ProposalAttSources:        s.cfg.ProposalAttSources,
+		Flags:                     s.cfg.EffectiveFlags,
 	}
 
 	const namespace = "prysm.node"
@@ -1125,11 +1228,67 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 			handler: server.RemoveTrustedPeer,
 			methods: []string{http.MethodDelete},
 		},
+		{
+			template: "/prysm/v1/node/peers/scores",
+			name:     namespace + ".GetPeerScores",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetPeerScores,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/attestation_subnet_stats",
+			name:     namespace + ".GetAttestationSubnetStats",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetAttestationSubnetStats,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/proposal_attestation_sources",
+			name:     namespace + ".GetProposalAttestationSources",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetProposalAttestationSources,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/config",
+			name:     namespace + ".GetEffectiveConfig",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetEffectiveConfig,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/execution_capabilities",
+			name:     namespace + ".GetExecutionCapabilities",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetExecutionCapabilities,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/enr/refresh",
+			name:     namespace + ".RefreshENR",
+			middleware: []middleware.Middleware{
+				middleware.BearerTokenHandler(s.cfg.AdminAPIToken),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.RefreshENR,
+			methods: []string{http.MethodPost},
+		},
 	}
 }
 
 func (s *Service) prysmValidatorEndpoints(stater lookup.Stater, coreService *core.Service) []endpoint {
 	server := &validatorprysm.Server{
+		BeaconDB:         s.cfg.BeaconDB,
 		ChainInfoFetcher: s.cfg.ChainInfoFetcher,
 		Stater:           stater,
 		CoreService:      coreService,
@@ -1175,5 +1334,44 @@ func (s *Service) prysmValidatorEndpoints(stater lookup.Stater, coreService *cor
 			handler: server.GetActiveSetChanges,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/validators/operation_totals",
+			name:     namespace + ".GetOperationTotals",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetOperationTotals,
+			methods: []string{http.MethodGet},
+		},
+	}
+}
+
+func (s *Service) prysmSlasherEndpoints() []endpoint {
+	server := &slasherprysm.Server{
+		SlashingChecker: s.cfg.SlashingChecker,
+	}
+
+	const namespace = "prysm.slasher"
+	return []endpoint{
+		{
+			template: "/prysm/v1/slasher/attestations/slashable",
+			name:     namespace + ".IsSlashableAttestation",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.IsSlashableAttestation,
+			methods: []string{http.MethodPost},
+		},
+		{
+			template: "/prysm/v1/slasher/blocks/slashable",
+			name:     namespace + ".IsSlashableBlock",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.IsSlashableBlock,
+			methods: []string{http.MethodPost},
+		},
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"golang.org/x/exp/maps"
 )
@@ -72,13 +73,17 @@ func Test_endpoints(t *testing.T) {
 	}
 
 	debugRoutes := map[string][]string{
-		"/eth/v2/debug/beacon/states/{state_id}": {http.MethodGet},
-		"/eth/v2/debug/beacon/heads":             {http.MethodGet},
-		"/eth/v1/debug/fork_choice":              {http.MethodGet},
+		"/eth/v2/debug/beacon/states/{state_id}":               {http.MethodGet},
+		"/eth/v2/debug/beacon/heads":                           {http.MethodGet},
+		"/eth/v1/debug/fork_choice":                            {http.MethodGet},
+		"/prysm/v1/debug/forkchoice":                           {http.MethodGet},
+		"/eth/v1/debug/beacon/blob_sidecars/{block_id}":        {http.MethodGet},
+		"/eth/v1/debug/beacon/blob_sidecars/{block_id}/verify": {http.MethodPost},
 	}
 
 	eventsRoutes := map[string][]string{
-		"/eth/v1/events": {http.MethodGet},
+		"/eth/v1/events":   {http.MethodGet},
+		"/prysm/v1/events": {http.MethodGet},
 	}
 
 	nodeRoutes := map[string][]string{
@@ -114,18 +119,29 @@ func Test_endpoints(t *testing.T) {
 	}
 
 	prysmBeaconRoutes := map[string][]string{
-		"/prysm/v1/beacon/weak_subjectivity":                 {http.MethodGet},
-		"/eth/v1/beacon/states/{state_id}/validator_count":   {http.MethodGet},
-		"/prysm/v1/beacon/states/{state_id}/validator_count": {http.MethodGet},
-		"/prysm/v1/beacon/chain_head":                        {http.MethodGet},
-		"/prysm/v1/beacon/blobs":                             {http.MethodPost},
+		"/prysm/v1/beacon/weak_subjectivity":                                    {http.MethodGet},
+		"/eth/v1/beacon/states/{state_id}/validator_count":                      {http.MethodGet},
+		"/prysm/v1/beacon/states/{state_id}/validator_count":                    {http.MethodGet},
+		"/prysm/v1/beacon/states/{state_id}/validator_proofs/{validator_index}": {http.MethodGet},
+		"/prysm/v1/beacon/chain_head":                                           {http.MethodGet},
+		"/prysm/v1/beacon/slot_outcomes":                                        {http.MethodGet},
+		"/prysm/v1/beacon/graffiti_stats":                                       {http.MethodGet},
+		"/prysm/v1/beacon/states/{state_id}/fields":                             {http.MethodGet},
+		"/prysm/v1/beacon/blobs":                                                {http.MethodPost},
+		"/prysm/v1/beacon/blob_sidecars/versioned_hash/{versioned_hash}":        {http.MethodGet},
 	}
 
 	prysmNodeRoutes := map[string][]string{
-		"/prysm/node/trusted_peers":              {http.MethodGet, http.MethodPost},
-		"/prysm/v1/node/trusted_peers":           {http.MethodGet, http.MethodPost},
-		"/prysm/node/trusted_peers/{peer_id}":    {http.MethodDelete},
-		"/prysm/v1/node/trusted_peers/{peer_id}": {http.MethodDelete},
+		"/prysm/node/trusted_peers":                   {http.MethodGet, http.MethodPost},
+		"/prysm/v1/node/trusted_peers":                {http.MethodGet, http.MethodPost},
+		"/prysm/node/trusted_peers/{peer_id}":         {http.MethodDelete},
+		"/prysm/v1/node/trusted_peers/{peer_id}":      {http.MethodDelete},
+		"/prysm/v1/node/peers/scores":                 {http.MethodGet},
+		"/prysm/v1/node/attestation_subnet_stats":     {http.MethodGet},
+		"/prysm/v1/node/proposal_attestation_sources": {http.MethodGet},
+		"/prysm/v1/node/config":                       {http.MethodGet},
+		"/prysm/v1/node/execution_capabilities":       {http.MethodGet},
+		"/prysm/v1/node/enr/refresh":                  {http.MethodPost},
 	}
 
 	prysmValidatorRoutes := map[string][]string{
@@ -133,9 +149,15 @@ func Test_endpoints(t *testing.T) {
 		"/prysm/v1/validators/performance":        {http.MethodPost},
 		"/prysm/v1/validators/participation":      {http.MethodGet},
 		"/prysm/v1/validators/active_set_changes": {http.MethodGet},
+		"/prysm/v1/validators/operation_totals":   {http.MethodGet},
 	}
 
-	s := &Service{cfg: &Config{}}
+	prysmSlasherRoutes := map[string][]string{
+		"/prysm/v1/slasher/attestations/slashable": {http.MethodPost},
+		"/prysm/v1/slasher/blocks/slashable":       {http.MethodPost},
+	}
+
+	s := &Service{cfg: &Config{SlashingChecker: &slasher.Service{}}}
 
 	endpoints := s.endpoints(true, nil, nil, nil, nil, nil, nil)
 	actualRoutes := make(map[string][]string, len(endpoints))
@@ -146,7 +168,7 @@ func Test_endpoints(t *testing.T) {
 			actualRoutes[e.template] = e.methods
 		}
 	}
-	expectedRoutes := combineMaps(beaconRoutes, builderRoutes, configRoutes, debugRoutes, eventsRoutes, nodeRoutes, validatorRoutes, rewardsRoutes, lightClientRoutes, blobRoutes, prysmValidatorRoutes, prysmNodeRoutes, prysmBeaconRoutes)
+	expectedRoutes := combineMaps(beaconRoutes, builderRoutes, configRoutes, debugRoutes, eventsRoutes, nodeRoutes, validatorRoutes, rewardsRoutes, lightClientRoutes, blobRoutes, prysmValidatorRoutes, prysmNodeRoutes, prysmBeaconRoutes, prysmSlasherRoutes)
 
 	assert.Equal(t, true, maps.EqualFunc(expectedRoutes, actualRoutes, func(actualMethods []string, expectedMethods []string) bool {
 		return slices.Equal(expectedMethods, actualMethods)
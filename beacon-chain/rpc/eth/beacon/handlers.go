@@ -14,11 +14,13 @@ import (
 	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
 	corehelpers "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
@@ -66,6 +68,10 @@ func (s *Server) GetBlockV2(w http.ResponseWriter, r *http.Request) {
 	// Deal with block unblinding.
 	if blk.Version() >= version.Bellatrix && blk.IsBlinded() {
 		blk, err = s.ExecutionReconstructor.ReconstructFullBlock(ctx, blk)
+		if errors.Is(err, execution.ErrNilPayloadBody) || errors.Is(err, execution.ErrUnsupportedEngineMethod) {
+			httputil.HandleError(w, "Execution client cannot supply the payload of the block: "+err.Error(), http.StatusServiceUnavailable)
+			return
+		}
 		if err != nil {
 			httputil.HandleError(w, errors.Wrapf(err, "could not reconstruct full execution payload to create signed beacon block").Error(), http.StatusBadRequest)
 			return
@@ -94,11 +100,12 @@ func (s *Server) GetBlindedBlock(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Convert to blinded block (if it's not already).
+	// Blocks may be stored either full or blinded, depending on the node's configuration. Full blocks are blinded by
+	// replacing their execution payload with its header, and blocks before Bellatrix have no payload to blind.
 	if blk.Version() >= version.Bellatrix && !blk.IsBlinded() {
 		blk, err = blk.ToBlinded()
 		if err != nil {
-			shared.WriteBlockFetchError(w, blk, errors.Wrapf(err, "could not convert block to blinded block"))
+			httputil.HandleError(w, "Could not convert block to blinded block: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
 	}
@@ -1014,12 +1021,28 @@ func (s *Server) publishBlock(ctx context.Context, w http.ResponseWriter, r *htt
 	httputil.HandleError(w, "Body does not represent a valid block type", http.StatusBadRequest)
 }
 
+// proposeBlock publishes the block and waits for the outcome of its broadcast and import. A block that was broadcast
+// but not imported is reported with 202, along with the stage that failed.
 func (s *Server) proposeBlock(ctx context.Context, w http.ResponseWriter, blk *eth.GenericSignedBeaconBlock) {
 	_, err := s.V1Alpha1ValidatorServer.ProposeBeaconBlock(ctx, blk)
-	if err != nil {
+	if err == nil {
+		return
+	}
+	var publishErr *validator.BlockPublishError
+	if !errors.As(err, &publishErr) {
 		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	errJson := &server.PublishBlockError{
+		Message: "Could not publish block: " + publishErr.Error(),
+		Code:    http.StatusInternalServerError,
+		Stage:   string(publishErr.Stage),
+		Reason:  publishErr.Err.Error(),
+	}
+	if publishErr.Broadcast {
+		errJson.Code = http.StatusAccepted
+	}
+	httputil.WriteError(w, errJson)
 }
 
 func unmarshalStrict(data []byte, v interface{}) error {
@@ -1568,16 +1591,23 @@ func (s *Server) GetDepositSnapshot(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.GetDepositSnapshot")
 	defer span.End()
 
-	eth1data, err := s.BeaconDB.ExecutionChainData(ctx)
+	snapshot, err := s.cachedDepositSnapshot(ctx)
 	if err != nil {
-		httputil.HandleError(w, "Could not retrieve execution chain data: "+err.Error(), http.StatusInternalServerError)
+		httputil.HandleError(w, "Could not retrieve finalized deposits: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
-	if eth1data == nil {
-		httputil.HandleError(w, "Could not retrieve execution chain data: empty Eth1Data", http.StatusInternalServerError)
-		return
+	if snapshot == nil {
+		eth1data, err := s.BeaconDB.ExecutionChainData(ctx)
+		if err != nil {
+			httputil.HandleError(w, "Could not retrieve execution chain data: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if eth1data == nil {
+			httputil.HandleError(w, "Could not retrieve execution chain data: empty Eth1Data", http.StatusInternalServerError)
+			return
+		}
+		snapshot = eth1data.DepositSnapshot
 	}
-	snapshot := eth1data.DepositSnapshot
 	if snapshot == nil || len(snapshot.Finalized) == 0 {
 		httputil.HandleError(w, "No finalized snapshot available", http.StatusNotFound)
 		return
@@ -1603,6 +1633,24 @@ func (s *Server) GetDepositSnapshot(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+// cachedDepositSnapshot returns the snapshot of the finalized deposit tree kept in the deposit cache, which follows
+// finalization, or nil when the cache holds no finalized deposits. The snapshot persisted with the execution chain
+// data is only saved periodically.
+func (s *Server) cachedDepositSnapshot(ctx context.Context) (*eth.DepositSnapshot, error) {
+	if s.DepositFetcher == nil {
+		return nil, nil
+	}
+	fd, err := s.DepositFetcher.FinalizedDeposits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tree, ok := fd.Deposits().(*depositsnapshot.DepositTree)
+	if !ok || tree.NumOfItems() == 0 {
+		return nil, nil
+	}
+	return tree.ToProto()
+}
+
 // Broadcast blob sidecars even if the block of the same slot has been imported.
 // To ensure safety, we will only broadcast blob sidecars if the header references the same block that was previously seen.
 // Otherwise, a proposer could get slashed through a different blob sidecar header reference.
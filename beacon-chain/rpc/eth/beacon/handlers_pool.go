@@ -18,6 +18,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	corehelpers "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
@@ -56,38 +58,27 @@ func (s *Server) ListAttestations(w http.ResponseWriter, r *http.Request) {
 	}
 	attestations = append(attestations, unaggAtts...)
 	isEmptyReq := rawSlot == "" && rawCommitteeIndex == ""
-	if isEmptyReq {
-		allAtts := make([]*structs.Attestation, len(attestations))
-		for i, att := range attestations {
-			a, ok := att.(*eth.Attestation)
-			if ok {
-				allAtts[i] = structs.AttFromConsensus(a)
-			} else {
-				httputil.HandleError(w, fmt.Sprintf("unable to convert attestations of type %T", att), http.StatusInternalServerError)
-				return
-			}
-		}
-		httputil.WriteJson(w, &structs.ListAttestationsResponse{Data: allAtts})
-		return
-	}
-
 	bothDefined := rawSlot != "" && rawCommitteeIndex != ""
-	filteredAtts := make([]*structs.Attestation, 0, len(attestations))
+	filteredAtts := make([]*eth.Attestation, 0, len(attestations))
 	for _, att := range attestations {
-		committeeIndexMatch := rawCommitteeIndex != "" && att.GetData().CommitteeIndex == primitives.CommitteeIndex(committeeIndex)
-		slotMatch := rawSlot != "" && att.GetData().Slot == primitives.Slot(slot)
-		shouldAppend := (bothDefined && committeeIndexMatch && slotMatch) || (!bothDefined && (committeeIndexMatch || slotMatch))
-		if shouldAppend {
-			a, ok := att.(*eth.Attestation)
-			if ok {
-				filteredAtts = append(filteredAtts, structs.AttFromConsensus(a))
-			} else {
-				httputil.HandleError(w, fmt.Sprintf("unable to convert attestations of type %T", att), http.StatusInternalServerError)
-				return
+		if !isEmptyReq {
+			committeeIndexMatch := rawCommitteeIndex != "" && att.GetData().CommitteeIndex == primitives.CommitteeIndex(committeeIndex)
+			slotMatch := rawSlot != "" && att.GetData().Slot == primitives.Slot(slot)
+			shouldAppend := (bothDefined && committeeIndexMatch && slotMatch) || (!bothDefined && (committeeIndexMatch || slotMatch))
+			if !shouldAppend {
+				continue
 			}
 		}
+		a, ok := att.(*eth.Attestation)
+		if !ok {
+			httputil.HandleError(w, fmt.Sprintf("unable to convert attestations of type %T", att), http.StatusInternalServerError)
+			return
+		}
+		filteredAtts = append(filteredAtts, a)
 	}
-	httputil.WriteJson(w, &structs.ListAttestationsResponse{Data: filteredAtts})
+	httputil.WriteJsonDataStream(w, struct{}{}, len(filteredAtts), func(i int) any {
+		return structs.AttFromConsensus(filteredAtts[i])
+	})
 }
 
 // SubmitAttestations submits an attestation object to node. If the attestation passes all validation
@@ -169,6 +160,9 @@ func (s *Server) SubmitAttestations(w http.ResponseWriter, r *http.Request) {
 				log.WithError(err).Error("could not save unaggregated attestation")
 			}
 		}
+		if err = s.AttestationsPool.SaveAttestationProvenance(att, kv.SourceAPI, 0); err != nil {
+			log.WithError(err).Debug("Could not save attestation provenance")
+		}
 	}
 	if len(failedBroadcasts) > 0 {
 		httputil.HandleError(
@@ -631,7 +625,14 @@ func (s *Server) submitAttesterSlashing(
 		return
 	}
 	err = s.SlashingsPool.InsertAttesterSlashing(ctx, headState, slashing)
-	if err != nil {
+	switch {
+	case errors.Is(err, slashings.ErrSlashingKnown):
+		// The slashing is valid and already pending or included, so it was already broadcast.
+		return
+	case errors.Is(err, slashings.ErrNotSlashable):
+		httputil.HandleError(w, "Invalid attester slashing: "+err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
 		httputil.HandleError(w, "Could not insert attester slashing into pool: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -706,7 +707,14 @@ func (s *Server) SubmitProposerSlashing(w http.ResponseWriter, r *http.Request)
 	}
 
 	err = s.SlashingsPool.InsertProposerSlashing(ctx, headState, slashing)
-	if err != nil {
+	switch {
+	case errors.Is(err, slashings.ErrSlashingKnown):
+		// The slashing is valid and already pending or included, so it was already broadcast.
+		return
+	case errors.Is(err, slashings.ErrNotSlashable):
+		httputil.HandleError(w, "Invalid proposer slashing: "+err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
 		httputil.HandleError(w, "Could not insert proposer slashing into pool: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -23,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/blstoexec"
 	blstoexecmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/blstoexec/mock"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings"
 	slashingsmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings/mock"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits/mock"
@@ -1659,6 +1660,122 @@ func TestSubmitProposerSlashing_InvalidSlashing(t *testing.T) {
 	assert.StringContains(t, "Invalid proposer slashing", e.Message)
 }
 
+func TestSubmitProposerSlashing_Pool(t *testing.T) {
+	ctx := context.Background()
+	bs, keys := util.DeterministicGenesisState(t, 64)
+	slashing, err := util.GenerateProposerSlashingForValidator(bs, keys[1], 1)
+	require.NoError(t, err)
+	b, err := json.Marshal(structs.ProposerSlashingsFromConsensus([]*ethpbv1alpha1.ProposerSlashing{slashing})[0])
+	require.NoError(t, err)
+	submit := func(s *Server) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/beacon/pool/proposer_slashings", bytes.NewReader(b))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SubmitProposerSlashing(writer, request)
+		return writer
+	}
+
+	t.Run("already known", func(t *testing.T) {
+		chainmock := &blockchainmock.ChainService{State: bs}
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher:  chainmock,
+			SlashingsPool:     slashings.NewPool(),
+			Broadcaster:       broadcaster,
+			OperationNotifier: chainmock.OperationNotifier(),
+		}
+		require.Equal(t, http.StatusOK, submit(s).Code)
+		// The slashing is packed in the next block proposal.
+		pending := s.SlashingsPool.PendingProposerSlashings(ctx, bs, false)
+		require.Equal(t, 1, len(pending))
+		assert.DeepEqual(t, slashing, pending[0])
+		require.Equal(t, 1, broadcaster.NumMessages())
+
+		// A valid slashing already in the pool is accepted, and not broadcast again.
+		require.Equal(t, http.StatusOK, submit(s).Code)
+		assert.Equal(t, 1, len(s.SlashingsPool.PendingProposerSlashings(ctx, bs, true)))
+		assert.Equal(t, 1, broadcaster.NumMessages())
+	})
+	t.Run("validator already slashed", func(t *testing.T) {
+		slashed := bs.Copy()
+		val, err := slashed.ValidatorAtIndex(1)
+		require.NoError(t, err)
+		val.Slashed = true
+		require.NoError(t, slashed.UpdateValidatorAtIndex(1, val))
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: slashed},
+			SlashingsPool:    slashings.NewPool(),
+			Broadcaster:      broadcaster,
+		}
+		writer := submit(s)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Invalid proposer slashing", e.Message)
+		assert.Equal(t, 0, broadcaster.NumMessages())
+	})
+}
+
+func TestSubmitAttesterSlashings_Pool(t *testing.T) {
+	ctx := context.Background()
+	bs, keys := util.DeterministicGenesisState(t, 64)
+	generated, err := util.GenerateAttesterSlashingForValidator(bs, keys[1], 1)
+	require.NoError(t, err)
+	slashing, ok := generated.(*ethpbv1alpha1.AttesterSlashing)
+	require.Equal(t, true, ok)
+	b, err := json.Marshal(structs.AttesterSlashingFromConsensus(slashing))
+	require.NoError(t, err)
+	submit := func(s *Server) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/beacon/pool/attester_slashings", bytes.NewReader(b))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SubmitAttesterSlashings(writer, request)
+		return writer
+	}
+
+	t.Run("already known", func(t *testing.T) {
+		chainmock := &blockchainmock.ChainService{State: bs}
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher:  chainmock,
+			SlashingsPool:     slashings.NewPool(),
+			Broadcaster:       broadcaster,
+			OperationNotifier: chainmock.OperationNotifier(),
+		}
+		require.Equal(t, http.StatusOK, submit(s).Code)
+		// The slashing is packed in the next block proposal.
+		pending := s.SlashingsPool.PendingAttesterSlashings(ctx, bs, false)
+		require.Equal(t, 1, len(pending))
+		assert.DeepEqual(t, slashing, pending[0])
+		require.Equal(t, 1, broadcaster.NumMessages())
+
+		// A valid slashing already in the pool is accepted, and not broadcast again.
+		require.Equal(t, http.StatusOK, submit(s).Code)
+		assert.Equal(t, 1, len(s.SlashingsPool.PendingAttesterSlashings(ctx, bs, true)))
+		assert.Equal(t, 1, broadcaster.NumMessages())
+	})
+	t.Run("validator already slashed", func(t *testing.T) {
+		slashed := bs.Copy()
+		val, err := slashed.ValidatorAtIndex(1)
+		require.NoError(t, err)
+		val.Slashed = true
+		require.NoError(t, slashed.UpdateValidatorAtIndex(1, val))
+		broadcaster := &p2pMock.MockBroadcaster{}
+		s := &Server{
+			ChainInfoFetcher: &blockchainmock.ChainService{State: slashed},
+			SlashingsPool:    slashings.NewPool(),
+			Broadcaster:      broadcaster,
+		}
+		writer := submit(s)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Invalid attester slashing", e.Message)
+		assert.Equal(t, 0, broadcaster.NumMessages())
+	})
+}
+
 var (
 	singleAtt = `[
   {
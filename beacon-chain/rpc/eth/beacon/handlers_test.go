@@ -16,16 +16,20 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	rpctesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
@@ -35,6 +39,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -102,6 +107,26 @@ func TestGetBlockV2(t *testing.T) {
 		s.GetBlockV2(writer, request)
 		require.Equal(t, http.StatusNotFound, writer.Code)
 	})
+	t.Run("payload unavailable", func(t *testing.T) {
+		sb, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockDeneb())
+		require.NoError(t, err)
+		s := &Server{
+			Blocker:                &testutil.MockBlocker{BlockToReturn: sb},
+			ExecutionReconstructor: &mockExecution.EngineClient{ErrReconstructFullBlock: errors.Wrap(execution.ErrNilPayloadBody, "hash 0x01")},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v2/beacon/blocks/{block_id}", nil)
+		request.SetPathValue("block_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBlockV2(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusServiceUnavailable, e.Code)
+		assert.StringContains(t, "Execution client cannot supply the payload of the block", e.Message)
+	})
 	t.Run("phase0", func(t *testing.T) {
 		b := util.NewBeaconBlock()
 		b.Block.Slot = 123
@@ -1033,6 +1058,60 @@ func TestGetBlindedBlock(t *testing.T) {
 		require.NoError(t, err)
 		assert.DeepEqual(t, blk, b)
 	})
+	t.Run("full block stored", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			version int
+			block   interface{}
+		}{
+			{name: "bellatrix", version: version.Bellatrix, block: util.NewBeaconBlockBellatrix()},
+			{name: "capella", version: version.Capella, block: util.NewBeaconBlockCapella()},
+			{name: "deneb", version: version.Deneb, block: util.NewBeaconBlockDeneb()},
+			{name: "electra", version: version.Electra, block: util.NewBeaconBlockElectra()},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				sb, err := blocks.NewSignedBeaconBlock(tt.block)
+				require.NoError(t, err)
+				require.NoError(t, sb.SetExecution(fullExecutionPayload(t, sb)))
+				expected, err := sb.ToBlinded()
+				require.NoError(t, err)
+				mj, err := structs.SignedBeaconBlockMessageJsoner(expected)
+				require.NoError(t, err)
+				expectedJson, err := mj.MessageRawJson()
+				require.NoError(t, err)
+
+				mockChainService := &chainMock.ChainService{}
+				s := &Server{
+					OptimisticModeFetcher: mockChainService,
+					FinalizationFetcher:   mockChainService,
+					Blocker:               &testutil.MockBlocker{BlockToReturn: sb},
+				}
+
+				request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/blinded_blocks/{block_id}", nil)
+				request.SetPathValue("block_id", "head")
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.GetBlindedBlock(writer, request)
+				require.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, version.String(tt.version), writer.Header().Get(api.VersionHeader))
+				resp := &structs.GetBlockV2Response{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+				assert.Equal(t, version.String(tt.version), resp.Version)
+				var message, expectedMessage map[string]interface{}
+				require.NoError(t, json.Unmarshal(resp.Data.Message, &message))
+				require.NoError(t, json.Unmarshal(expectedJson, &expectedMessage))
+				assert.DeepEqual(t, expectedMessage, message)
+				body, ok := message["body"].(map[string]interface{})
+				require.Equal(t, true, ok)
+				_, ok = body["execution_payload_header"]
+				assert.Equal(t, true, ok)
+				_, ok = body["execution_payload"]
+				assert.Equal(t, false, ok)
+			})
+		}
+	})
 	t.Run("execution optimistic", func(t *testing.T) {
 		b := util.NewBlindedBeaconBlockBellatrix()
 		sb, err := blocks.NewSignedBeaconBlock(b)
@@ -1225,6 +1304,79 @@ func TestGetBlindedBlockSSZ(t *testing.T) {
 		require.NoError(t, err)
 		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
 	})
+	t.Run("Electra", func(t *testing.T) {
+		b := util.NewBlindedBeaconBlockElectra()
+		sb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+
+		s := &Server{
+			Blocker: &testutil.MockBlocker{BlockToReturn: sb},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/blinded_blocks/{block_id}", nil)
+		request.SetPathValue("block_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBlindedBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, version.String(version.Electra), writer.Header().Get(api.VersionHeader))
+		sszExpected, err := b.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
+	})
+	t.Run("full block stored", func(t *testing.T) {
+		sb, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockDeneb())
+		require.NoError(t, err)
+		require.NoError(t, sb.SetExecution(fullExecutionPayload(t, sb)))
+		expected, err := sb.ToBlinded()
+		require.NoError(t, err)
+		pb, err := expected.Proto()
+		require.NoError(t, err)
+		b, ok := pb.(*eth.SignedBlindedBeaconBlockDeneb)
+		require.Equal(t, true, ok)
+
+		s := &Server{
+			Blocker: &testutil.MockBlocker{BlockToReturn: sb},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://foo.example/eth/v1/beacon/blinded_blocks/{block_id}", nil)
+		request.SetPathValue("block_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBlindedBlock(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, version.String(version.Deneb), writer.Header().Get(api.VersionHeader))
+		sszExpected, err := b.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
+	})
+}
+
+// fullExecutionPayload returns the execution payload of the block with transactions, so that blinding the block
+// actually strips data from it.
+func fullExecutionPayload(t *testing.T, blk interfaces.ReadOnlySignedBeaconBlock) interfaces.ExecutionData {
+	execution, err := blk.Block().Body().Execution()
+	require.NoError(t, err)
+	var payload interfaces.ExecutionData
+	switch p := execution.Proto().(type) {
+	case *enginev1.ExecutionPayload:
+		p.Transactions = [][]byte{{0x01, 0x02}, {0x03}}
+		payload, err = blocks.WrappedExecutionPayload(p)
+	case *enginev1.ExecutionPayloadCapella:
+		p.Transactions = [][]byte{{0x01, 0x02}, {0x03}}
+		payload, err = blocks.WrappedExecutionPayloadCapella(p)
+	case *enginev1.ExecutionPayloadDeneb:
+		p.Transactions = [][]byte{{0x01, 0x02}, {0x03}}
+		payload, err = blocks.WrappedExecutionPayloadDeneb(p)
+	default:
+		t.Fatalf("unexpected execution payload type %T", p)
+	}
+	require.NoError(t, err)
+	return payload
 }
 
 func TestPublishBlock(t *testing.T) {
@@ -1409,6 +1561,53 @@ func TestPublishBlock(t *testing.T) {
 		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
 		assert.StringContains(t, "Beacon node is currently syncing and not serving request on that endpoint", writer.Body.String())
 	})
+	t.Run("broadcast failed", func(t *testing.T) {
+		v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().ProposeBeaconBlock(gomock.Any(), gomock.Any()).Return(nil, &validator.BlockPublishError{
+			Stage: validator.BlockPublishStageBroadcast,
+			Err:   errors.New("no peers"),
+		})
+		s := &Server{
+			V1Alpha1ValidatorServer: v1alpha1Server,
+			SyncChecker:             &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := httptest.NewRequest(http.MethodPost, "http://foo.example", bytes.NewReader([]byte(rpctesting.Phase0Block)))
+		request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.PublishBlock(writer, request)
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+		e := &server.PublishBlockError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusInternalServerError, e.Code)
+		assert.Equal(t, string(validator.BlockPublishStageBroadcast), e.Stage)
+		assert.Equal(t, "no peers", e.Reason)
+	})
+	t.Run("import failed", func(t *testing.T) {
+		v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().ProposeBeaconBlock(gomock.Any(), gomock.Any()).Return(nil, &validator.BlockPublishError{
+			Stage:     validator.BlockPublishStageImport,
+			Broadcast: true,
+			Err:       errors.New("invalid state root"),
+		})
+		s := &Server{
+			V1Alpha1ValidatorServer: v1alpha1Server,
+			SyncChecker:             &mockSync.Sync{IsSyncing: false},
+		}
+
+		request := httptest.NewRequest(http.MethodPost, "http://foo.example", bytes.NewReader([]byte(rpctesting.Phase0Block)))
+		request.Header.Set(api.VersionHeader, version.String(version.Phase0))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.PublishBlock(writer, request)
+		assert.Equal(t, http.StatusAccepted, writer.Code)
+		e := &server.PublishBlockError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusAccepted, e.Code)
+		assert.Equal(t, string(validator.BlockPublishStageImport), e.Stage)
+		assert.Equal(t, "invalid state root", e.Reason)
+	})
 }
 
 func TestPublishBlockSSZ(t *testing.T) {
@@ -4127,6 +4326,35 @@ func TestGetDepositSnapshot(t *testing.T) {
 		assert.Equal(t, uint64(mockTrie.NumOfItems()), resp.DepositCount)
 		assert.Equal(t, finalized, len(resp.Finalized))
 	})
+	t.Run("from deposit cache", func(t *testing.T) {
+		cachedTrie := depositsnapshot.NewDepositTree()
+		for _, leaf := range append(deposits, bytesutil.ToBytes32([]byte{4})) {
+			require.NoError(t, cachedTrie.Insert(leaf[:], 0))
+		}
+		require.NoError(t, cachedTrie.Finalize(3, deposits[0], 0))
+		cachedSnapshot, err := cachedTrie.ToProto()
+		require.NoError(t, err)
+		depositCache, err := depositsnapshot.New()
+		require.NoError(t, err)
+		require.NoError(t, depositCache.InitializeFromSnapshot(context.Background(), cachedSnapshot))
+		s := Server{
+			BeaconDB:       beaconDB,
+			DepositFetcher: depositCache,
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "/eth/v1/beacon/deposit_snapshot", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetDepositSnapshot(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetDepositSnapshotResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+
+		assert.Equal(t, hexutil.Encode(cachedSnapshot.DepositRoot), resp.Data.DepositRoot)
+		assert.Equal(t, hexutil.Encode(deposits[0][:]), resp.Data.ExecutionBlockHash)
+		assert.Equal(t, "4", resp.Data.DepositCount)
+	})
 }
 
 func TestServer_broadcastBlobSidecars(t *testing.T) {
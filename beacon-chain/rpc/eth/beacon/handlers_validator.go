@@ -99,32 +99,14 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 	}
 	epoch := slots.ToEpoch(st.Slot())
 
-	// Exit early if no matching validators were found or we don't want to further filter validators by status.
-	if len(readOnlyVals) == 0 || len(statuses) == 0 {
-		containers := make([]*structs.ValidatorContainer, len(readOnlyVals))
-		for i, val := range readOnlyVals {
-			valStatus, err := helpers.ValidatorSubStatus(val, epoch)
-			if err != nil {
-				httputil.HandleError(w, "Could not get validator status: "+err.Error(), http.StatusInternalServerError)
-				return
-			}
-			id := primitives.ValidatorIndex(i)
-			if len(ids) > 0 {
-				id = ids[i]
-			}
-			balance, err := st.BalanceAtIndex(id)
-			if err != nil {
-				httputil.HandleError(w, "Could not get validator balance: "+err.Error(), http.StatusInternalServerError)
-				return
-			}
-			containers[i] = valContainerFromReadOnlyVal(val, id, balance, valStatus)
-		}
-		resp := &structs.GetValidatorsResponse{
-			Data:                containers,
-			ExecutionOptimistic: isOptimistic,
-			Finalized:           isFinalized,
-		}
-		httputil.WriteJson(w, resp)
+	meta := &stateResponseMetadata{
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+	}
+
+	// Exit early if no matching validators were found.
+	if len(readOnlyVals) == 0 {
+		httputil.WriteJsonDataStream(w, meta, 0, nil)
 		return
 	}
 
@@ -137,40 +119,47 @@ func (s *Server) GetValidators(w http.ResponseWriter, r *http.Request) {
 		}
 		filteredStatuses[vs] = true
 	}
-	valContainers := make([]*structs.ValidatorContainer, 0, len(readOnlyVals))
+
+	// Statuses and balances are resolved before the response is started, so that a failure is still reported with an
+	// error status. Only the encoding of the containers is streamed.
+	valIds := make([]primitives.ValidatorIndex, 0, len(readOnlyVals))
+	valSubStatuses := make([]validator.Status, 0, len(readOnlyVals))
+	balances := make([]uint64, 0, len(readOnlyVals))
+	included := make([]int, 0, len(readOnlyVals))
 	for i, val := range readOnlyVals {
-		valStatus, err := helpers.ValidatorStatus(val, epoch)
-		if err != nil {
-			httputil.HandleError(w, "Could not get validator status: "+err.Error(), http.StatusInternalServerError)
-			return
-		}
 		valSubStatus, err := helpers.ValidatorSubStatus(val, epoch)
 		if err != nil {
 			httputil.HandleError(w, "Could not get validator status: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
-		if filteredStatuses[valStatus] || filteredStatuses[valSubStatus] {
-			var container *structs.ValidatorContainer
-			id := primitives.ValidatorIndex(i)
-			if len(ids) > 0 {
-				id = ids[i]
-			}
-			balance, err := st.BalanceAtIndex(id)
+		if len(filteredStatuses) > 0 {
+			valStatus, err := helpers.ValidatorStatus(val, epoch)
 			if err != nil {
-				httputil.HandleError(w, "Could not get validator balance: "+err.Error(), http.StatusInternalServerError)
+				httputil.HandleError(w, "Could not get validator status: "+err.Error(), http.StatusInternalServerError)
 				return
 			}
-			container = valContainerFromReadOnlyVal(val, id, balance, valSubStatus)
-			valContainers = append(valContainers, container)
+			if !filteredStatuses[valStatus] && !filteredStatuses[valSubStatus] {
+				continue
+			}
+		}
+		id := primitives.ValidatorIndex(i)
+		if len(ids) > 0 {
+			id = ids[i]
+		}
+		balance, err := st.BalanceAtIndex(id)
+		if err != nil {
+			httputil.HandleError(w, "Could not get validator balance: "+err.Error(), http.StatusInternalServerError)
+			return
 		}
+		included = append(included, i)
+		valIds = append(valIds, id)
+		valSubStatuses = append(valSubStatuses, valSubStatus)
+		balances = append(balances, balance)
 	}
 
-	resp := &structs.GetValidatorsResponse{
-		Data:                valContainers,
-		ExecutionOptimistic: isOptimistic,
-		Finalized:           isFinalized,
-	}
-	httputil.WriteJson(w, resp)
+	httputil.WriteJsonDataStream(w, meta, len(included), func(i int) any {
+		return valContainerFromReadOnlyVal(readOnlyVals[included[i]], valIds[i], balances[i], valSubStatuses[i])
+	})
 }
 
 // GetValidator returns a validator specified by state and id or public key along with status and balance.
@@ -296,32 +285,33 @@ func (s *Server) GetValidatorBalances(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	meta := &stateResponseMetadata{
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+	}
 	bals := st.Balances()
-	var valBalances []*structs.ValidatorBalance
 	if len(ids) == 0 {
-		valBalances = make([]*structs.ValidatorBalance, len(bals))
-		for i, b := range bals {
-			valBalances[i] = &structs.ValidatorBalance{
+		httputil.WriteJsonDataStream(w, meta, len(bals), func(i int) any {
+			return &structs.ValidatorBalance{
 				Index:   strconv.FormatUint(uint64(i), 10),
-				Balance: strconv.FormatUint(b, 10),
-			}
-		}
-	} else {
-		valBalances = make([]*structs.ValidatorBalance, len(ids))
-		for i, id := range ids {
-			valBalances[i] = &structs.ValidatorBalance{
-				Index:   strconv.FormatUint(uint64(id), 10),
-				Balance: strconv.FormatUint(bals[id], 10),
+				Balance: strconv.FormatUint(bals[i], 10),
 			}
-		}
+		})
+		return
 	}
+	httputil.WriteJsonDataStream(w, meta, len(ids), func(i int) any {
+		return &structs.ValidatorBalance{
+			Index:   strconv.FormatUint(uint64(ids[i]), 10),
+			Balance: strconv.FormatUint(bals[ids[i]], 10),
+		}
+	})
+}
 
-	resp := &structs.GetValidatorBalancesResponse{
-		Data:                valBalances,
-		ExecutionOptimistic: isOptimistic,
-		Finalized:           isFinalized,
-	}
-	httputil.WriteJson(w, resp)
+// stateResponseMetadata holds the fields, other than data, of responses that are streamed
+// with httputil.WriteJsonDataStream.
+type stateResponseMetadata struct {
+	ExecutionOptimistic bool `json:"execution_optimistic"`
+	Finalized           bool `json:"finalized"`
 }
 
 // decodeIds takes in a list of validator ID strings (as either a pubkey or a validator index)
@@ -7,7 +7,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
@@ -95,18 +94,7 @@ loop:
 func buildSidecarsJsonResponse(verifiedBlobs []*blocks.VerifiedROBlob) *structs.SidecarsResponse {
 	resp := &structs.SidecarsResponse{Data: make([]*structs.Sidecar, len(verifiedBlobs))}
 	for i, sc := range verifiedBlobs {
-		proofs := make([]string, len(sc.CommitmentInclusionProof))
-		for j := range sc.CommitmentInclusionProof {
-			proofs[j] = hexutil.Encode(sc.CommitmentInclusionProof[j])
-		}
-		resp.Data[i] = &structs.Sidecar{
-			Index:                    strconv.FormatUint(sc.Index, 10),
-			Blob:                     hexutil.Encode(sc.Blob),
-			KzgCommitment:            hexutil.Encode(sc.KzgCommitment),
-			SignedBeaconBlockHeader:  structs.SignedBeaconBlockHeaderFromConsensus(sc.SignedBlockHeader),
-			KzgProof:                 hexutil.Encode(sc.KzgProof),
-			CommitmentInclusionProof: proofs,
-		}
+		resp.Data[i] = structs.SidecarFromConsensus(sc.BlobSidecar)
 	}
 	return resp
 }
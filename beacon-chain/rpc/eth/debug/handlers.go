@@ -1,16 +1,28 @@
 package debug
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
@@ -156,17 +168,43 @@ func (s *Server) GetForkChoiceHeadsV2(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJson(w, resp)
 }
 
-// GetForkChoice returns a dump fork choice store.
+// GetForkChoice returns a dump of the fork choice store. The nodes can be filtered by slot with start_slot and
+// end_slot, and restricted to a block and its descendants with descendant_of. With summary=true, the matching nodes
+// are counted rather than listed, along with the head and the checkpoints of the store.
 func (s *Server) GetForkChoice(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "debug.GetForkChoice")
 	defer span.End()
 
-	dump, err := s.ForkchoiceFetcher.ForkChoiceDump(ctx)
+	filter, ok := forkChoiceDumpFilter(w, r)
+	if !ok {
+		return
+	}
+	dump, err := s.ForkchoiceFetcher.ForkChoiceDump(ctx, filter)
+	if errors.Is(err, forkchoice.ErrUnknownDescendantRoot) {
+		httputil.HandleError(w, "Could not get forkchoice dump: "+err.Error(), http.StatusNotFound)
+		return
+	}
 	if err != nil {
 		httputil.HandleError(w, "Could not get forkchoice dump: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	if filter.SummaryOnly {
+		httputil.WriteJson(w, &structs.GetForkChoiceDumpSummaryResponse{
+			SchemaVersion:                 structs.ForkChoiceDumpSchemaVersion,
+			HeadRoot:                      hexutil.Encode(dump.HeadRoot),
+			HeadSlot:                      fmt.Sprintf("%d", dump.HeadSlot),
+			JustifiedCheckpoint:           structs.CheckpointFromConsensus(dump.JustifiedCheckpoint),
+			FinalizedCheckpoint:           structs.CheckpointFromConsensus(dump.FinalizedCheckpoint),
+			UnrealizedJustifiedCheckpoint: structs.CheckpointFromConsensus(dump.UnrealizedJustifiedCheckpoint),
+			UnrealizedFinalizedCheckpoint: structs.CheckpointFromConsensus(dump.UnrealizedFinalizedCheckpoint),
+			NodeCount:                     fmt.Sprintf("%d", dump.NodeCount),
+			MatchingNodeCount:             fmt.Sprintf("%d", dump.MatchingNodeCount),
+			MatchingLeafCount:             fmt.Sprintf("%d", dump.MatchingLeafCount),
+		})
+		return
+	}
+
 	nodes := make([]*structs.ForkChoiceNode, len(dump.ForkChoiceNodes))
 	for i, n := range dump.ForkChoiceNodes {
 		nodes[i] = &structs.ForkChoiceNode{
@@ -192,12 +230,257 @@ func (s *Server) GetForkChoice(w http.ResponseWriter, r *http.Request) {
 		FinalizedCheckpoint: structs.CheckpointFromConsensus(dump.FinalizedCheckpoint),
 		ForkChoiceNodes:     nodes,
 		ExtraData: &structs.ForkChoiceDumpExtraData{
+			SchemaVersion:                 structs.ForkChoiceDumpSchemaVersion,
 			UnrealizedJustifiedCheckpoint: structs.CheckpointFromConsensus(dump.UnrealizedJustifiedCheckpoint),
 			UnrealizedFinalizedCheckpoint: structs.CheckpointFromConsensus(dump.UnrealizedFinalizedCheckpoint),
 			ProposerBoostRoot:             hexutil.Encode(dump.ProposerBoostRoot),
 			PreviousProposerBoostRoot:     hexutil.Encode(dump.PreviousProposerBoostRoot),
 			HeadRoot:                      hexutil.Encode(dump.HeadRoot),
+			HeadSlot:                      fmt.Sprintf("%d", dump.HeadSlot),
+			NodeCount:                     fmt.Sprintf("%d", dump.NodeCount),
+			MatchingNodeCount:             fmt.Sprintf("%d", dump.MatchingNodeCount),
 		},
 	}
 	httputil.WriteJson(w, resp)
 }
+
+// forkChoiceDumpFilter parses the filter of the fork choice dump from the query parameters of the request.
+func forkChoiceDumpFilter(w http.ResponseWriter, r *http.Request) (*forkchoice.DumpFilter, bool) {
+	filter := &forkchoice.DumpFilter{}
+	_, startSlot, ok := shared.UintFromQuery(w, r, "start_slot", false)
+	if !ok {
+		return nil, false
+	}
+	_, endSlot, ok := shared.UintFromQuery(w, r, "end_slot", false)
+	if !ok {
+		return nil, false
+	}
+	if endSlot != 0 && endSlot < startSlot {
+		httputil.HandleError(w, "end_slot must not be lower than start_slot", http.StatusBadRequest)
+		return nil, false
+	}
+	filter.StartSlot = primitives.Slot(startSlot)
+	filter.EndSlot = primitives.Slot(endSlot)
+	_, root, ok := shared.HexFromQuery(w, r, "descendant_of", fieldparams.RootLength, false)
+	if !ok {
+		return nil, false
+	}
+	filter.DescendantOf = bytesutil.ToBytes32(root)
+	if raw := r.URL.Query().Get("summary"); raw != "" {
+		var err error
+		filter.SummaryOnly, err = strconv.ParseBool(raw)
+		if err != nil {
+			httputil.HandleError(w, "Invalid summary query parameter: "+err.Error(), http.StatusBadRequest)
+			return nil, false
+		}
+	}
+	return filter, true
+}
+
+// GetForkChoiceSnapshot returns a consistent snapshot of all the fork choice nodes, with their weight, validity and
+// best descendant. With head_only=true, only the nodes of the chain from the head down to the finalized block are
+// returned, head first.
+func (s *Server) GetForkChoiceSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetForkChoiceSnapshot")
+	defer span.End()
+
+	headOnly := false
+	if raw := r.URL.Query().Get("head_only"); raw != "" {
+		var err error
+		headOnly, err = strconv.ParseBool(raw)
+		if err != nil {
+			httputil.HandleError(w, "Invalid head_only query parameter: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	snapshot, err := s.ForkchoiceFetcher.ForkChoiceSnapshot(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get forkchoice snapshot: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	nodes := snapshot.Nodes
+	if headOnly {
+		nodes = headChain(snapshot)
+	}
+
+	resp := &structs.GetForkChoiceSnapshotResponse{
+		HeadRoot:            hexutil.Encode(snapshot.HeadRoot[:]),
+		JustifiedCheckpoint: structs.CheckpointFromConsensus(snapshot.JustifiedCheckpoint),
+		FinalizedCheckpoint: structs.CheckpointFromConsensus(snapshot.FinalizedCheckpoint),
+		Nodes:               make([]*structs.ForkChoiceSnapshotNode, len(nodes)),
+	}
+	for i, n := range nodes {
+		resp.Nodes[i] = &structs.ForkChoiceSnapshotNode{
+			Root:           hexutil.Encode(n.Root[:]),
+			ParentRoot:     hexutil.Encode(n.ParentRoot[:]),
+			Slot:           fmt.Sprintf("%d", n.Slot),
+			Weight:         fmt.Sprintf("%d", n.Weight),
+			Validity:       n.Validity.String(),
+			JustifiedEpoch: fmt.Sprintf("%d", n.JustifiedEpoch),
+			FinalizedEpoch: fmt.Sprintf("%d", n.FinalizedEpoch),
+			BestChild:      hexutil.Encode(n.BestChild[:]),
+			BestDescendant: hexutil.Encode(n.BestDescendant[:]),
+		}
+	}
+	httputil.WriteJson(w, resp)
+}
+
+// headChain returns the nodes of the snapshot from the head down to the finalized block, or down to the oldest
+// node of the snapshot when the finalized block is not part of it.
+func headChain(snapshot *forkchoice.Snapshot) []*forkchoice.NodeSnapshot {
+	byRoot := make(map[[32]byte]*forkchoice.NodeSnapshot, len(snapshot.Nodes))
+	for _, n := range snapshot.Nodes {
+		byRoot[n.Root] = n
+	}
+	finalizedRoot := bytesutil.ToBytes32(snapshot.FinalizedCheckpoint.GetRoot())
+	var chain []*forkchoice.NodeSnapshot
+	for n, ok := byRoot[snapshot.HeadRoot]; ok; n, ok = byRoot[n.ParentRoot] {
+		chain = append(chain, n)
+		// The parent root of the oldest node is the zero hash, which may be the root of a node itself.
+		if n.Root == finalizedRoot || n.ParentRoot == n.Root {
+			break
+		}
+	}
+	return chain
+}
+
+// GetBlobSidecarVerifications returns the commitment and proof of every blob sidecar stored for the given block,
+// together with the result of verifying each sidecar against the stored block.
+func (s *Server) GetBlobSidecarVerifications(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.GetBlobSidecarVerifications")
+	defer span.End()
+
+	blockId := r.PathValue("block_id")
+	if blockId == "" {
+		httputil.HandleError(w, "block_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	blk, root, ok := s.sidecarBlock(ctx, w, blockId)
+	if !ok {
+		return
+	}
+	sidecars, rpcErr := s.Blocker.Blobs(ctx, blockId, nil)
+	if rpcErr != nil {
+		httputil.HandleError(w, "Could not get blob sidecars: "+rpcErr.Err.Error(), core.ErrorReasonToHTTP(rpcErr.Reason))
+		return
+	}
+
+	resp := &structs.GetBlobSidecarVerificationsResponse{
+		BlockRoot: hexutil.Encode(root[:]),
+		Data:      make([]*structs.BlobSidecarVerification, len(sidecars)),
+	}
+	for i, sc := range sidecars {
+		resp.Data[i] = verifyBlobSidecar(blk, root, sc.ROBlob)
+	}
+	httputil.WriteJson(w, resp)
+}
+
+// VerifyBlobSidecar verifies the blob sidecar in the request body against the given block. A sidecar that fails
+// verification is not an error, the result of each check is returned in the response instead.
+func (s *Server) VerifyBlobSidecar(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "debug.VerifyBlobSidecar")
+	defer span.End()
+
+	blockId := r.PathValue("block_id")
+	if blockId == "" {
+		httputil.HandleError(w, "block_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	var req structs.Sidecar
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	sidecar, err := req.ToConsensus()
+	if err != nil {
+		httputil.HandleError(w, "Could not convert request sidecar to consensus sidecar: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	ro, err := blocks.NewROBlob(sidecar)
+	if err != nil {
+		httputil.HandleError(w, "Invalid sidecar: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	blk, root, ok := s.sidecarBlock(ctx, w, blockId)
+	if !ok {
+		return
+	}
+	httputil.WriteJson(w, &structs.VerifyBlobSidecarResponse{
+		BlockRoot: hexutil.Encode(root[:]),
+		Data:      verifyBlobSidecar(blk, root, ro),
+	})
+}
+
+// sidecarBlock fetches the block that sidecars are verified against, writing an error response
+// when the block can't be found or doesn't have blob sidecars.
+func (s *Server) sidecarBlock(ctx context.Context, w http.ResponseWriter, blockId string) (interfaces.ReadOnlySignedBeaconBlock, [32]byte, bool) {
+	blk, err := s.Blocker.Block(ctx, []byte(blockId))
+	if !shared.WriteBlockFetchError(w, blk, err) {
+		return nil, [32]byte{}, false
+	}
+	if blk.Version() < version.Deneb {
+		httputil.HandleError(w, "Blob sidecars are not supported for "+version.String(blk.Version())+" blocks", http.StatusBadRequest)
+		return nil, [32]byte{}, false
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not compute block root: "+err.Error(), http.StatusInternalServerError)
+		return nil, [32]byte{}, false
+	}
+	return blk, root, true
+}
+
+// verifyBlobSidecar checks the sidecar against the block using the same inclusion proof and KZG proof
+// verification as gossip validation. Each check is run even if an earlier one fails, so that the response
+// shows exactly what is wrong with the sidecar.
+func verifyBlobSidecar(blk interfaces.ReadOnlySignedBeaconBlock, root [32]byte, sc blocks.ROBlob) *structs.BlobSidecarVerification {
+	v := &structs.BlobSidecarVerification{
+		Index:         strconv.FormatUint(sc.Index, 10),
+		KzgCommitment: hexutil.Encode(sc.KzgCommitment),
+		KzgProof:      hexutil.Encode(sc.KzgProof),
+		Errors:        make([]string, 0),
+	}
+
+	sig := blk.Signature()
+	switch {
+	case sc.BlockRoot() != root:
+		v.Errors = append(v.Errors, fmt.Sprintf("sidecar block header root %#x does not match block root %#x", sc.BlockRoot(), root))
+	case !bytes.Equal(sc.SignedBlockHeader.Signature, sig[:]):
+		v.Errors = append(v.Errors, "sidecar block header signature does not match block signature")
+	default:
+		v.HeaderMatchesBlock = true
+	}
+
+	commitments, err := blk.Block().Body().BlobKzgCommitments()
+	switch {
+	case err != nil:
+		v.Errors = append(v.Errors, "could not get block commitments: "+err.Error())
+	case sc.Index >= uint64(len(commitments)):
+		v.Errors = append(v.Errors, fmt.Sprintf("sidecar index %d is out of range for block with %d commitments", sc.Index, len(commitments)))
+	case !bytes.Equal(sc.KzgCommitment, commitments[sc.Index]):
+		v.Errors = append(v.Errors, fmt.Sprintf("sidecar commitment does not match block commitment %#x", commitments[sc.Index]))
+	default:
+		v.CommitmentMatchesBlock = true
+	}
+
+	if err := blocks.VerifyKZGInclusionProof(sc); err != nil {
+		v.Errors = append(v.Errors, "inclusion proof: "+err.Error())
+	} else {
+		v.InclusionProofValid = true
+	}
+
+	if err := kzg.Verify(sc); err != nil {
+		v.Errors = append(v.Errors, "kzg proof: "+err.Error())
+	} else {
+		v.KzgProofValid = true
+	}
+
+	v.Valid = v.HeaderMatchesBlock && v.CommitmentMatchesBlock && v.InclusionProofValid && v.KzgProofValid
+	return v
+}
@@ -6,17 +6,24 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
 	blockchainmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -390,6 +397,30 @@ func TestGetBeaconStateSSZV2(t *testing.T) {
 		require.NoError(t, err)
 		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
 	})
+	t.Run("Electra", func(t *testing.T) {
+		fakeState, err := util.NewBeaconStateElectra()
+		require.NoError(t, err)
+		require.NoError(t, fakeState.SetSlot(123))
+
+		s := &Server{
+			Stater: &testutil.MockStater{
+				BeaconState: fakeState,
+			},
+		}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v2/debug/beacon/states/{state_id}", nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBeaconStateV2(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, version.String(version.Electra), writer.Header().Get(api.VersionHeader))
+		sszExpected, err := fakeState.MarshalSSZ()
+		require.NoError(t, err)
+		assert.DeepEqual(t, sszExpected, writer.Body.Bytes())
+	})
 }
 
 func TestGetForkChoiceHeadsV2(t *testing.T) {
@@ -486,3 +517,282 @@ func TestGetForkChoice(t *testing.T) {
 	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
 	require.Equal(t, "2", resp.FinalizedCheckpoint.Epoch)
 }
+
+func TestGetForkChoiceSnapshot(t *testing.T) {
+	ctx := context.Background()
+	store := doublylinkedtree.New()
+	// A chain of three blocks, with a fork at the second one.
+	parent := [32]byte{}
+	for i, root := range [][32]byte{{'a'}, {'b'}, {'c'}} {
+		st, blk, err := prepareForkchoiceNode(primitives.Slot(i), root, parent)
+		require.NoError(t, err)
+		require.NoError(t, store.InsertNode(ctx, st, blk))
+		parent = root
+	}
+	st, blk, err := prepareForkchoiceNode(2, [32]byte{'d'}, [32]byte{'b'})
+	require.NoError(t, err)
+	require.NoError(t, store.InsertNode(ctx, st, blk))
+	require.NoError(t, store.UpdateFinalizedCheckpoint(&forkchoicetypes.Checkpoint{Root: [32]byte{'a'}}))
+	_, err = store.Head(ctx)
+	require.NoError(t, err)
+	s := &Server{ForkchoiceFetcher: &blockchainmock.ChainService{ForkChoiceStore: store}}
+
+	t.Run("all nodes", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/forkchoice", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceSnapshot(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetForkChoiceSnapshotResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 4, len(resp.Nodes))
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{'a'}, 32)), resp.Nodes[0].Root)
+		assert.Equal(t, "optimistic", resp.Nodes[0].Validity)
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{'b'}, 32)), resp.Nodes[0].BestChild)
+		assert.Equal(t, resp.HeadRoot, resp.Nodes[0].BestDescendant)
+	})
+	t.Run("head only", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/forkchoice?head_only=true", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceSnapshot(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetForkChoiceSnapshotResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 3, len(resp.Nodes))
+		assert.Equal(t, resp.HeadRoot, resp.Nodes[0].Root)
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{'b'}, 32)), resp.Nodes[1].Root)
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{'a'}, 32)), resp.Nodes[2].Root)
+	})
+	t.Run("invalid head_only", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/debug/forkchoice?head_only=foo", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetForkChoiceSnapshot(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetForkChoice_Filters(t *testing.T) {
+	ctx := context.Background()
+	store := doublylinkedtree.New()
+	// A chain of three blocks, with a fork at the second one.
+	parent := [32]byte{}
+	for i, root := range [][32]byte{{'a'}, {'b'}, {'c'}} {
+		st, blk, err := prepareForkchoiceNode(primitives.Slot(i), root, parent)
+		require.NoError(t, err)
+		require.NoError(t, store.InsertNode(ctx, st, blk))
+		parent = root
+	}
+	st, blk, err := prepareForkchoiceNode(2, [32]byte{'d'}, [32]byte{'b'})
+	require.NoError(t, err)
+	require.NoError(t, store.InsertNode(ctx, st, blk))
+	require.NoError(t, store.UpdateFinalizedCheckpoint(&forkchoicetypes.Checkpoint{Root: [32]byte{'a'}}))
+	_, err = store.Head(ctx)
+	require.NoError(t, err)
+	s := &Server{ForkchoiceFetcher: &blockchainmock.ChainService{ForkChoiceStore: store}}
+	rootB := hexutil.Encode(bytesutil.PadTo([]byte{'b'}, 32))
+	rootD := hexutil.Encode(bytesutil.PadTo([]byte{'d'}, 32))
+
+	getForkChoice := func(t *testing.T, query string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/debug/fork_choice?"+query, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetForkChoice(writer, request)
+		return writer
+	}
+
+	t.Run("slot range", func(t *testing.T) {
+		writer := getForkChoice(t, "start_slot=1&end_slot=1")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetForkChoiceDumpResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.ForkChoiceNodes))
+		assert.Equal(t, rootB, resp.ForkChoiceNodes[0].BlockRoot)
+		assert.Equal(t, structs.ForkChoiceDumpSchemaVersion, resp.ExtraData.SchemaVersion)
+		assert.Equal(t, "4", resp.ExtraData.NodeCount)
+		assert.Equal(t, "1", resp.ExtraData.MatchingNodeCount)
+	})
+	t.Run("descendant_of", func(t *testing.T) {
+		writer := getForkChoice(t, "descendant_of="+rootD)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetForkChoiceDumpResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.ForkChoiceNodes))
+		assert.Equal(t, rootD, resp.ForkChoiceNodes[0].BlockRoot)
+		assert.Equal(t, rootB, resp.ForkChoiceNodes[0].ParentRoot)
+	})
+	t.Run("summary", func(t *testing.T) {
+		writer := getForkChoice(t, "summary=true&descendant_of="+rootB)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetForkChoiceDumpSummaryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, structs.ForkChoiceDumpSchemaVersion, resp.SchemaVersion)
+		assert.Equal(t, "2", resp.HeadSlot)
+		assert.Equal(t, "4", resp.NodeCount)
+		assert.Equal(t, "3", resp.MatchingNodeCount)
+		assert.Equal(t, "2", resp.MatchingLeafCount)
+		assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{'a'}, 32)), resp.FinalizedCheckpoint.Root)
+		assert.Equal(t, false, strings.Contains(writer.Body.String(), "fork_choice_nodes"))
+	})
+	t.Run("unknown descendant_of", func(t *testing.T) {
+		writer := getForkChoice(t, "descendant_of="+hexutil.Encode(bytesutil.PadTo([]byte{'z'}, 32)))
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+	})
+	t.Run("end_slot lower than start_slot", func(t *testing.T) {
+		writer := getForkChoice(t, "start_slot=2&end_slot=1")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("invalid summary", func(t *testing.T) {
+		writer := getForkChoice(t, "summary=foo")
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+// prepareForkchoiceNode returns a state and block to insert a node with the given slot, root and parent root in
+// forkchoice.
+func prepareForkchoiceNode(slot primitives.Slot, root, parentRoot [32]byte) (state.BeaconState, blocks.ROBlock, error) {
+	st, err := util.NewBeaconState()
+	if err != nil {
+		return nil, blocks.ROBlock{}, err
+	}
+	if err := st.SetSlot(slot); err != nil {
+		return nil, blocks.ROBlock{}, err
+	}
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = parentRoot[:]
+	signed, err := blocks.NewSignedBeaconBlock(blk)
+	if err != nil {
+		return nil, blocks.ROBlock{}, err
+	}
+	roblock, err := blocks.NewROBlockWithRoot(signed, root)
+	return st, roblock, err
+}
+
+func TestGetBlobSidecarVerifications(t *testing.T) {
+	require.NoError(t, kzg.Start())
+	blk, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 2)
+	verified := make([]*blocks.VerifiedROBlob, len(sidecars))
+	for i := range sidecars {
+		vsc := blocks.NewVerifiedROBlob(sidecars[i])
+		verified[i] = &vsc
+	}
+	s := &Server{
+		Blocker: &testutil.MockBlocker{BlockToReturn: blk, BlobsToReturn: verified},
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/debug/beacon/blob_sidecars/{block_id}", nil)
+	request.SetPathValue("block_id", "head")
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetBlobSidecarVerifications(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetBlobSidecarVerificationsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	root := blk.Root()
+	assert.Equal(t, hexutil.Encode(root[:]), resp.BlockRoot)
+	require.Equal(t, 2, len(resp.Data))
+	for i, v := range resp.Data {
+		assert.Equal(t, strconv.Itoa(i), v.Index)
+		assert.Equal(t, hexutil.Encode(sidecars[i].KzgCommitment), v.KzgCommitment)
+		assert.Equal(t, hexutil.Encode(sidecars[i].KzgProof), v.KzgProof)
+		assert.Equal(t, true, v.HeaderMatchesBlock)
+		assert.Equal(t, true, v.CommitmentMatchesBlock)
+		assert.Equal(t, true, v.InclusionProofValid)
+		// The test sidecars don't carry real KZG proofs.
+		assert.Equal(t, false, v.KzgProofValid)
+		assert.Equal(t, false, v.Valid)
+		require.Equal(t, 1, len(v.Errors))
+	}
+
+	t.Run("pre-Deneb block", func(t *testing.T) {
+		b, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		s := &Server{Blocker: &testutil.MockBlocker{BlockToReturn: b}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/debug/beacon/blob_sidecars/{block_id}", nil)
+		request.SetPathValue("block_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetBlobSidecarVerifications(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "not supported for capella blocks", writer.Body.String())
+	})
+}
+
+func TestVerifyBlobSidecar(t *testing.T) {
+	require.NoError(t, kzg.Start())
+	blk, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 2)
+	s := &Server{Blocker: &testutil.MockBlocker{BlockToReturn: blk}}
+
+	verify := func(t *testing.T, sc *ethpb.BlobSidecar) *structs.VerifyBlobSidecarResponse {
+		proofs := make([]string, len(sc.CommitmentInclusionProof))
+		for i := range sc.CommitmentInclusionProof {
+			proofs[i] = hexutil.Encode(sc.CommitmentInclusionProof[i])
+		}
+		body, err := json.Marshal(&structs.Sidecar{
+			Index:                    strconv.FormatUint(sc.Index, 10),
+			Blob:                     hexutil.Encode(sc.Blob),
+			KzgCommitment:            hexutil.Encode(sc.KzgCommitment),
+			KzgProof:                 hexutil.Encode(sc.KzgProof),
+			SignedBeaconBlockHeader:  structs.SignedBeaconBlockHeaderFromConsensus(sc.SignedBlockHeader),
+			CommitmentInclusionProof: proofs,
+		})
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/debug/beacon/blob_sidecars/{block_id}/verify", bytes.NewReader(body))
+		request.SetPathValue("block_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.VerifyBlobSidecar(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.VerifyBlobSidecarResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp
+	}
+
+	t.Run("matching sidecar", func(t *testing.T) {
+		resp := verify(t, sidecars[1].BlobSidecar)
+		assert.Equal(t, "1", resp.Data.Index)
+		assert.Equal(t, true, resp.Data.HeaderMatchesBlock)
+		assert.Equal(t, true, resp.Data.CommitmentMatchesBlock)
+		assert.Equal(t, true, resp.Data.InclusionProofValid)
+	})
+	t.Run("sidecar for another index", func(t *testing.T) {
+		orig := sidecars[1]
+		resp := verify(t, &ethpb.BlobSidecar{
+			Index:                    0,
+			Blob:                     orig.Blob,
+			KzgCommitment:            orig.KzgCommitment,
+			KzgProof:                 orig.KzgProof,
+			SignedBlockHeader:        orig.SignedBlockHeader,
+			CommitmentInclusionProof: orig.CommitmentInclusionProof,
+		})
+		assert.Equal(t, true, resp.Data.HeaderMatchesBlock)
+		assert.Equal(t, false, resp.Data.CommitmentMatchesBlock)
+		assert.Equal(t, false, resp.Data.InclusionProofValid)
+		assert.Equal(t, false, resp.Data.Valid)
+	})
+	t.Run("sidecar for another block", func(t *testing.T) {
+		_, other := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{'a'}, 2, 1)
+		resp := verify(t, other[0].BlobSidecar)
+		assert.Equal(t, false, resp.Data.HeaderMatchesBlock)
+		assert.Equal(t, true, resp.Data.InclusionProofValid)
+		assert.Equal(t, false, resp.Data.Valid)
+	})
+	t.Run("no body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/debug/beacon/blob_sidecars/{block_id}/verify", nil)
+		request.SetPathValue("block_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.VerifyBlobSidecar(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "No data submitted", writer.Body.String())
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
@@ -62,6 +63,9 @@ const (
 	LightClientFinalityUpdateTopic = "light_client_finality_update"
 	// LightClientOptimisticUpdateTopic represents a new light client optimistic update event topic.
 	LightClientOptimisticUpdateTopic = "light_client_optimistic_update"
+	// DutiesInvalidatedTopic is a Prysm-only topic, served by the Prysm events endpoint, announcing a change
+	// of the duty dependent roots of the head.
+	DutiesInvalidatedTopic = "duties_invalidated"
 )
 
 var (
@@ -112,6 +116,13 @@ type topicRequest struct {
 	topics        map[string]bool
 	needStateFeed bool
 	needOpsFeed   bool
+	// dutyRoots holds the duty dependent roots last sent on the duties_invalidated topic.
+	dutyRoots *dutyDependentRoots
+}
+
+type dutyDependentRoots struct {
+	previous [32]byte
+	current  [32]byte
 }
 
 func (req *topicRequest) requested(topic string) bool {
@@ -137,6 +148,64 @@ func newTopicRequest(topics []string) (*topicRequest, error) {
 	return req, nil
 }
 
+// newPrysmTopicRequest is like newTopicRequest, but also accepts the Prysm-only topics.
+func newPrysmTopicRequest(topics []string) (*topicRequest, error) {
+	standard := make([]string, 0, len(topics))
+	dutiesInvalidated := false
+	for _, name := range topics {
+		if name == DutiesInvalidatedTopic {
+			dutiesInvalidated = true
+			continue
+		}
+		standard = append(standard, name)
+	}
+	if !dutiesInvalidated {
+		return newTopicRequest(standard)
+	}
+	req := &topicRequest{topics: make(map[string]bool)}
+	if len(standard) > 0 {
+		var err error
+		req, err = newTopicRequest(standard)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Duties invalidation is derived from head events.
+	req.topics[DutiesInvalidatedTopic] = true
+	req.needStateFeed = true
+	return req, nil
+}
+
+// dutiesInvalidatedReader returns the duties_invalidated event to send for the given event, or nil when the
+// topic was not requested, the event is not a head event or the duty dependent roots did not change since the
+// last duties_invalidated event of the stream. The first head event of a stream is always sent.
+func (req *topicRequest) dutiesInvalidatedReader(event *feed.Event) lazyReader {
+	if !req.requested(DutiesInvalidatedTopic) {
+		return nil
+	}
+	head, ok := event.Data.(*ethpb.EventHead)
+	if !ok {
+		return nil
+	}
+	roots := &dutyDependentRoots{
+		previous: bytesutil.ToBytes32(head.PreviousDutyDependentRoot),
+		current:  bytesutil.ToBytes32(head.CurrentDutyDependentRoot),
+	}
+	if req.dutyRoots != nil && *req.dutyRoots == *roots {
+		return nil
+	}
+	req.dutyRoots = roots
+	ev := &structs.DutiesInvalidatedEvent{
+		Slot:                      fmt.Sprintf("%d", head.Slot),
+		Epoch:                     fmt.Sprintf("%d", slots.ToEpoch(head.Slot)),
+		PreviousDutyDependentRoot: hexutil.Encode(head.PreviousDutyDependentRoot),
+		CurrentDutyDependentRoot:  hexutil.Encode(head.CurrentDutyDependentRoot),
+	}
+	return func() io.Reader {
+		return jsonMarshalReader(DutiesInvalidatedTopic, ev)
+	}
+}
+
 // StreamEvents provides an endpoint to subscribe to the beacon node Server-Sent-Events stream.
 // Consumers should use the eventsource implementation to listen for those events.
 // Servers may send SSE comments beginning with ':' for any purpose,
@@ -151,7 +220,28 @@ func (s *Server) StreamEvents(w http.ResponseWriter, r *http.Request) {
 		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	s.streamEvents(ctx, w, topics)
+}
+
+// StreamPrysmEvents is a Prysm extension of StreamEvents accepting the standard topics as well as the Prysm-only
+// duties_invalidated topic. A duties_invalidated event is sent for the first head of the stream and then whenever
+// the previous or current duty dependent root of the head changes, which happens at epoch transitions and on
+// reorgs crossing a dependent root. Validator clients can use it to re-fetch their duties only when they change,
+// instead of after every head event.
+func (s *Server) StreamPrysmEvents(w http.ResponseWriter, r *http.Request) {
+	log.Debug("Starting StreamPrysmEvents handler")
+	ctx, span := trace.StartSpan(r.Context(), "events.StreamPrysmEvents")
+	defer span.End()
+
+	topics, err := newPrysmTopicRequest(r.URL.Query()["topics"])
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.streamEvents(ctx, w, topics)
+}
 
+func (s *Server) streamEvents(ctx context.Context, w http.ResponseWriter, topics *topicRequest) {
 	timeout := s.EventWriteTimeout
 	if timeout == 0 {
 		timeout = time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
@@ -428,7 +518,11 @@ func topicForEvent(event *feed.Event) string {
 
 func (s *Server) lazyReaderForEvent(ctx context.Context, event *feed.Event, topics *topicRequest) (lazyReader, error) {
 	eventName := topicForEvent(event)
+	dutiesReader := topics.dutiesInvalidatedReader(event)
 	if !topics.requested(eventName) {
+		if dutiesReader != nil {
+			return dutiesReader, nil
+		}
 		return nil, errNotRequested
 	}
 	if eventName == PayloadAttributesTopic {
@@ -442,6 +536,9 @@ func (s *Server) lazyReaderForEvent(ctx context.Context, event *feed.Event, topi
 		// The head event is a special case because, if the client requested the payload attributes topic,
 		// we send two event messages in reaction; the head event and the payload attributes.
 		headReader := func() io.Reader {
+			if dutiesReader != nil {
+				return io.MultiReader(jsonMarshalReader(eventName, structs.HeadEventFromV1(v)), dutiesReader())
+			}
 			return jsonMarshalReader(eventName, structs.HeadEventFromV1(v))
 		}
 		// Don't do the expensive attr lookup unless the client requested it.
@@ -1,6 +1,7 @@
 package events
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -587,3 +588,88 @@ func wedgedWriterTestCase(t *testing.T, queueDepth func([]*feed.Event) int) {
 		t.Fatalf("context canceled / timed out waiting to write all events, err=%v", ctx.Err())
 	}
 }
+
+func TestNewPrysmTopicRequest(t *testing.T) {
+	_, err := newTopicRequest([]string{DutiesInvalidatedTopic})
+	require.ErrorIs(t, err, errInvalidTopicName)
+
+	req, err := newPrysmTopicRequest([]string{DutiesInvalidatedTopic})
+	require.NoError(t, err)
+	require.Equal(t, true, req.needStateFeed)
+	require.Equal(t, false, req.needOpsFeed)
+
+	req, err = newPrysmTopicRequest([]string{AttestationTopic, DutiesInvalidatedTopic})
+	require.NoError(t, err)
+	require.Equal(t, true, req.needStateFeed)
+	require.Equal(t, true, req.needOpsFeed)
+	require.Equal(t, true, req.requested(AttestationTopic))
+
+	_, err = newPrysmTopicRequest([]string{"foo", DutiesInvalidatedTopic})
+	require.ErrorIs(t, err, errInvalidTopicName)
+}
+
+func TestStreamPrysmEvents_DutiesInvalidated(t *testing.T) {
+	testSync := newStreamTestSync(t)
+	defer testSync.cleanup()
+	stn := mockChain.NewEventFeedWrapper()
+	opn := mockChain.NewEventFeedWrapper()
+	s := &Server{
+		StateNotifier:     &mockChain.SimpleNotifier{Feed: stn},
+		OperationNotifier: &mockChain.SimpleNotifier{Feed: opn},
+		EventWriteTimeout: testEventWriteTimeout,
+	}
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/events?topics="+DutiesInvalidatedTopic, nil)
+	request = request.WithContext(testSync.ctx)
+	w := NewStreamingResponseWriterRecorder(testSync.ctx)
+	go func() {
+		s.StreamPrysmEvents(w, request)
+		testSync.markDone()
+	}()
+
+	head := func(slot primitives.Slot, current byte) *feed.Event {
+		return &feed.Event{
+			Type: statefeed.NewHead,
+			Data: &ethpb.EventHead{
+				Slot:                      slot,
+				Block:                     make([]byte, 32),
+				State:                     make([]byte, 32),
+				PreviousDutyDependentRoot: make([]byte, 32),
+				CurrentDutyDependentRoot:  bytes.Repeat([]byte{current}, 32),
+			},
+		}
+	}
+	sseR := sse.NewEventStreamReader(w.Body(), 1<<24)
+	events := make(chan string, 10)
+	go func() {
+		for {
+			ev, err := sseR.ReadEvent()
+			if err != nil {
+				return
+			}
+			if strings.HasPrefix(string(ev), "event: ") {
+				events <- string(ev)
+			}
+		}
+	}()
+	ctx, cancel := context.WithTimeout(testSync.ctx, time.Second)
+	defer cancel()
+	require.NoError(t, stn.WaitForSubscription(ctx))
+	// Only the first head and the head changing the dependent roots invalidate duties.
+	for _, ev := range []*feed.Event{head(1, 1), head(2, 1), head(3, 2)} {
+		s.StateNotifier.StateFeed().Send(ev)
+	}
+
+	var received []string
+	for len(received) < 2 {
+		select {
+		case ev := <-events:
+			received = append(received, ev)
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for events, received %v", received)
+		}
+	}
+	require.StringContains(t, "event: "+DutiesInvalidatedTopic, received[0])
+	require.StringContains(t, `"slot":"1"`, received[0])
+	require.StringContains(t, `"slot":"3"`, received[1])
+	require.StringContains(t, `"current_duty_dependent_root":"0x0202`, received[1])
+}
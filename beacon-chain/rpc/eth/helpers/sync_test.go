@@ -237,7 +237,7 @@ func TestIsOptimistic(t *testing.T) {
 		})
 		t.Run("is before validated slot when head is optimistic", func(t *testing.T) {
 			db := dbtest.SetupDB(t)
-			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: []byte("root")}))
+			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: bytesutil.PadTo([]byte("root"), 32)}))
 			require.NoError(t, db.SaveLastValidatedCheckpoint(ctx, &eth.Checkpoint{Epoch: 1, Root: []byte("root")}))
 			cs := &chainmock.ChainService{Optimistic: true, FinalizedCheckPoint: &eth.Checkpoint{Epoch: 1}}
 			o, err := IsOptimistic(ctx, []byte("0"), cs, nil, cs, db)
@@ -246,7 +246,7 @@ func TestIsOptimistic(t *testing.T) {
 		})
 		t.Run("is equal to validated slot when head is optimistic", func(t *testing.T) {
 			db := dbtest.SetupDB(t)
-			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: []byte("root")}))
+			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: bytesutil.PadTo([]byte("root"), 32)}))
 			require.NoError(t, db.SaveLastValidatedCheckpoint(ctx, &eth.Checkpoint{Epoch: 1, Root: []byte("root")}))
 			cs := &chainmock.ChainService{Optimistic: true, FinalizedCheckPoint: &eth.Checkpoint{Epoch: 1}}
 			o, err := IsOptimistic(ctx, []byte("32"), cs, nil, cs, db)
@@ -255,7 +255,7 @@ func TestIsOptimistic(t *testing.T) {
 		})
 		t.Run("is after validated slot and validated slot is before finalized slot", func(t *testing.T) {
 			db := dbtest.SetupDB(t)
-			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: []byte("root")}))
+			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: bytesutil.PadTo([]byte("root"), 32)}))
 			require.NoError(t, db.SaveLastValidatedCheckpoint(ctx, &eth.Checkpoint{Epoch: 1, Root: []byte("root")}))
 			cs := &chainmock.ChainService{Optimistic: true, FinalizedCheckPoint: &eth.Checkpoint{Epoch: 2}}
 			o, err := IsOptimistic(ctx, []byte("33"), cs, nil, cs, db)
@@ -264,7 +264,7 @@ func TestIsOptimistic(t *testing.T) {
 		})
 		t.Run("is head", func(t *testing.T) {
 			db := dbtest.SetupDB(t)
-			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: []byte("root")}))
+			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: bytesutil.PadTo([]byte("root"), 32)}))
 			require.NoError(t, db.SaveLastValidatedCheckpoint(ctx, &eth.Checkpoint{Epoch: 1, Root: []byte("root")}))
 			fetcherSt, err := util.NewBeaconState()
 			require.NoError(t, err)
@@ -279,7 +279,7 @@ func TestIsOptimistic(t *testing.T) {
 		})
 		t.Run("ancestor is optimistic", func(t *testing.T) {
 			db := dbtest.SetupDB(t)
-			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: []byte("root")}))
+			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: bytesutil.PadTo([]byte("root"), 32)}))
 			require.NoError(t, db.SaveLastValidatedCheckpoint(ctx, &eth.Checkpoint{Epoch: 1, Root: []byte("root")}))
 			r := bytesutil.ToBytes32([]byte("root"))
 			fcs := doublylinkedtree.New()
@@ -299,7 +299,7 @@ func TestIsOptimistic(t *testing.T) {
 		})
 		t.Run("ancestor is not optimistic", func(t *testing.T) {
 			db := dbtest.SetupDB(t)
-			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: []byte("root")}))
+			require.NoError(t, db.SaveStateSummary(ctx, &eth.StateSummary{Slot: fieldparams.SlotsPerEpoch, Root: bytesutil.PadTo([]byte("root"), 32)}))
 			require.NoError(t, db.SaveLastValidatedCheckpoint(ctx, &eth.Checkpoint{Epoch: 1, Root: []byte("root")}))
 			r := bytesutil.ToBytes32([]byte("root"))
 			fcs := doublylinkedtree.New()
@@ -7,11 +7,13 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/epoch/precompute"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -204,10 +206,6 @@ func (s *Server) attRewardsState(w http.ResponseWriter, r *http.Request) (state.
 		httputil.HandleError(w, "Could not decode epoch: "+err.Error(), http.StatusBadRequest)
 		return nil, false
 	}
-	if primitives.Epoch(requestedEpoch) < params.BeaconConfig().AltairForkEpoch {
-		httputil.HandleError(w, "Attestation rewards are not supported for Phase 0", http.StatusNotFound)
-		return nil, false
-	}
 	currentEpoch := uint64(slots.ToEpoch(s.TimeFetcher.CurrentSlot()))
 	if requestedEpoch+1 >= currentEpoch {
 		httputil.HandleError(w,
@@ -222,7 +220,15 @@ func (s *Server) attRewardsState(w http.ResponseWriter, r *http.Request) (state.
 	}
 	st, err := s.Stater.StateBySlot(r.Context(), nextEpochEnd)
 	if err != nil {
-		httputil.HandleError(w, "Could not get state for epoch's starting slot: "+err.Error(), http.StatusInternalServerError)
+		// The state can't be regenerated when the node lacks the history, e.g. after checkpoint sync.
+		// Requests refused by the archival policy are reported as forbidden by WriteStateFetchError.
+		if errors.Is(err, stategen.ErrNoDataForSlot) || errors.Is(err, stategen.ErrNoBlocksBelowSlot) {
+			httputil.HandleError(w,
+				fmt.Sprintf("Attestation rewards for epoch %d are not available on this node: %s", requestedEpoch, err.Error()),
+				http.StatusNotFound)
+			return nil, false
+		}
+		shared.WriteStateFetchError(w, err)
 		return nil, false
 	}
 	return st, true
@@ -233,15 +239,31 @@ func attRewardsBalancesAndVals(
 	r *http.Request,
 	st state.BeaconState,
 ) (*precompute.Balance, []*precompute.Validator, []primitives.ValidatorIndex, bool) {
-	allVals, bal, err := altair.InitializePrecomputeValidators(r.Context(), st)
-	if err != nil {
-		httputil.HandleError(w, "Could not initialize precompute validators: "+err.Error(), http.StatusBadRequest)
-		return nil, nil, nil, false
-	}
-	allVals, bal, err = altair.ProcessEpochParticipation(r.Context(), st, bal, allVals)
-	if err != nil {
-		httputil.HandleError(w, "Could not process epoch participation: "+err.Error(), http.StatusBadRequest)
-		return nil, nil, nil, false
+	var allVals []*precompute.Validator
+	var bal *precompute.Balance
+	var err error
+	if st.Version() == version.Phase0 {
+		allVals, bal, err = precompute.New(r.Context(), st)
+		if err != nil {
+			httputil.HandleError(w, "Could not initialize precompute validators: "+err.Error(), http.StatusBadRequest)
+			return nil, nil, nil, false
+		}
+		allVals, bal, err = precompute.ProcessAttestations(r.Context(), st, allVals, bal)
+		if err != nil {
+			httputil.HandleError(w, "Could not process attestations: "+err.Error(), http.StatusBadRequest)
+			return nil, nil, nil, false
+		}
+	} else {
+		allVals, bal, err = altair.InitializePrecomputeValidators(r.Context(), st)
+		if err != nil {
+			httputil.HandleError(w, "Could not initialize precompute validators: "+err.Error(), http.StatusBadRequest)
+			return nil, nil, nil, false
+		}
+		allVals, bal, err = altair.ProcessEpochParticipation(r.Context(), st, bal, allVals)
+		if err != nil {
+			httputil.HandleError(w, "Could not process epoch participation: "+err.Error(), http.StatusBadRequest)
+			return nil, nil, nil, false
+		}
 	}
 	valIndices, ok := requestedValIndices(w, r, st, allVals)
 	if !ok {
@@ -280,9 +302,11 @@ func idealAttRewards(
 					IsActivePrevEpoch:            true,
 					IsSlashed:                    false,
 					CurrentEpochEffectiveBalance: effectiveBalance,
+					IsPrevEpochAttester:          true,
 					IsPrevEpochSourceAttester:    true,
 					IsPrevEpochTargetAttester:    true,
 					IsPrevEpochHeadAttester:      true,
+					InclusionDistance:            1,
 				})
 				idealRewards = append(idealRewards, structs.IdealAttestationReward{
 					EffectiveBalance: strconv.FormatUint(effectiveBalance, 10),
@@ -292,27 +316,18 @@ func idealAttRewards(
 			}
 		}
 	}
-	deltas, err := altair.AttestationsDelta(st, bal, idealVals)
+	deltas, err := attDeltas(st, bal, idealVals)
 	if err != nil {
 		httputil.HandleError(w, "Could not get attestations delta: "+err.Error(), http.StatusInternalServerError)
 		return nil, false
 	}
 	for i, d := range deltas {
-		idealRewards[i].Head = strconv.FormatUint(d.HeadReward, 10)
-		if d.SourcePenalty > 0 {
-			idealRewards[i].Source = fmt.Sprintf("-%s", strconv.FormatUint(d.SourcePenalty, 10))
-		} else {
-			idealRewards[i].Source = strconv.FormatUint(d.SourceReward, 10)
-		}
-		if d.TargetPenalty > 0 {
-			idealRewards[i].Target = fmt.Sprintf("-%s", strconv.FormatUint(d.TargetPenalty, 10))
-		} else {
-			idealRewards[i].Target = strconv.FormatUint(d.TargetReward, 10)
-		}
-		if d.InactivityPenalty > 0 {
-			idealRewards[i].Inactivity = fmt.Sprintf("-%s", strconv.FormatUint(d.InactivityPenalty, 10))
-		} else {
-			idealRewards[i].Inactivity = strconv.FormatUint(d.InactivityPenalty, 10)
+		idealRewards[i].Head = signedGwei(d.HeadReward, d.HeadPenalty)
+		idealRewards[i].Source = signedGwei(d.SourceReward, d.SourcePenalty)
+		idealRewards[i].Target = signedGwei(d.TargetReward, d.TargetPenalty)
+		idealRewards[i].Inactivity = signedGwei(0, d.InactivityPenalty)
+		if st.Version() == version.Phase0 {
+			idealRewards[i].InclusionDelay = strconv.FormatUint(d.InclusionDelayReward, 10)
 		}
 	}
 	return idealRewards, true
@@ -329,32 +344,55 @@ func totalAttRewards(
 	for i, v := range valIndices {
 		totalRewards[i] = structs.TotalAttestationReward{ValidatorIndex: strconv.FormatUint(uint64(v), 10)}
 	}
-	deltas, err := altair.AttestationsDelta(st, bal, vals)
+	deltas, err := attDeltas(st, bal, vals)
 	if err != nil {
 		httputil.HandleError(w, "Could not get attestations delta: "+err.Error(), http.StatusInternalServerError)
 		return nil, false
 	}
 	for i, d := range deltas {
-		totalRewards[i].Head = strconv.FormatUint(d.HeadReward, 10)
-		if d.SourcePenalty > 0 {
-			totalRewards[i].Source = fmt.Sprintf("-%s", strconv.FormatUint(d.SourcePenalty, 10))
-		} else {
-			totalRewards[i].Source = strconv.FormatUint(d.SourceReward, 10)
-		}
-		if d.TargetPenalty > 0 {
-			totalRewards[i].Target = fmt.Sprintf("-%s", strconv.FormatUint(d.TargetPenalty, 10))
-		} else {
-			totalRewards[i].Target = strconv.FormatUint(d.TargetReward, 10)
-		}
-		if d.InactivityPenalty > 0 {
-			totalRewards[i].Inactivity = fmt.Sprintf("-%s", strconv.FormatUint(d.InactivityPenalty, 10))
-		} else {
-			totalRewards[i].Inactivity = strconv.FormatUint(d.InactivityPenalty, 10)
+		totalRewards[i].Head = signedGwei(d.HeadReward, d.HeadPenalty)
+		totalRewards[i].Source = signedGwei(d.SourceReward, d.SourcePenalty)
+		totalRewards[i].Target = signedGwei(d.TargetReward, d.TargetPenalty)
+		totalRewards[i].Inactivity = signedGwei(0, d.InactivityPenalty)
+		if st.Version() == version.Phase0 {
+			totalRewards[i].InclusionDelay = strconv.FormatUint(d.InclusionDelayReward, 10)
 		}
 	}
 	return totalRewards, true
 }
 
+// attDeltas returns the attestation rewards and penalties of the validators, following the rules of phase 0
+// for phase 0 states, and the participation flag rules of Altair otherwise.
+func attDeltas(st state.BeaconState, bal *precompute.Balance, vals []*precompute.Validator) ([]*precompute.AttDelta, error) {
+	if st.Version() == version.Phase0 {
+		return precompute.AttestationsDeltaComponents(st, bal, vals)
+	}
+	deltas, err := altair.AttestationsDelta(st, bal, vals)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*precompute.AttDelta, len(deltas))
+	for i, d := range deltas {
+		result[i] = &precompute.AttDelta{
+			SourceReward:      d.SourceReward,
+			SourcePenalty:     d.SourcePenalty,
+			TargetReward:      d.TargetReward,
+			TargetPenalty:     d.TargetPenalty,
+			HeadReward:        d.HeadReward,
+			InactivityPenalty: d.InactivityPenalty,
+		}
+	}
+	return result, nil
+}
+
+// signedGwei formats a reward and a penalty, at most one of which is non-zero, as a signed Gwei amount.
+func signedGwei(reward, penalty uint64) string {
+	if penalty > 0 {
+		return fmt.Sprintf("-%s", strconv.FormatUint(penalty, 10))
+	}
+	return strconv.FormatUint(reward, 10)
+}
+
 func syncRewardsVals(
 	w http.ResponseWriter,
 	r *http.Request,
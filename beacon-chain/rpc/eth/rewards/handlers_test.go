@@ -18,9 +18,12 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	mockstategen "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen/mock"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -30,6 +33,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls/blst"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/math"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -93,6 +97,7 @@ func BlockRewardTestSetup(t *testing.T, forkName string) (state.BeaconState, int
 	require.NoError(t, st.SetValidators(validators))
 	require.NoError(t, st.SetBalances(balances))
 	require.NoError(t, st.SetCurrentParticipationBits(make([]byte, valCount)))
+	require.NoError(t, st.SetInactivityScores(make([]uint64, valCount)))
 	syncCommittee, err := altair.NextSyncCommittee(context.Background(), st)
 	require.NoError(t, err)
 	require.NoError(t, st.SetCurrentSyncCommittee(syncCommittee))
@@ -361,6 +366,114 @@ func TestBlockRewards(t *testing.T) {
 		assert.Equal(t, true, resp.ExecutionOptimistic)
 		assert.Equal(t, false, resp.Finalized)
 	})
+	t.Run("archival query disabled", func(t *testing.T) {
+		st, sbb, err := BlockRewardTestSetup(t, "altair")
+		require.NoError(t, err)
+
+		mockChainService := &mock.ChainService{Optimistic: true, FinalizedCheckPoint: &eth.Checkpoint{Epoch: 1}}
+		policy := &lookup.ArchivalPolicy{DisableAPIQueries: true, FinalizationFetcher: mockChainService}
+		s := &Server{
+			Blocker: &testutil.MockBlocker{SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
+				2: sbb,
+			}},
+			OptimisticModeFetcher: mockChainService,
+			FinalizationFetcher:   mockChainService,
+			BlockRewardFetcher: &BlockRewardService{
+				Replayer: &lookup.BeaconDbStater{
+					ReplayerBuilder: mockstategen.NewReplayerBuilder(mockstategen.WithMockState(st)),
+					ArchivalPolicy:  policy,
+				},
+				DB: db,
+			},
+		}
+
+		url := "http://only.the.slot.number.at.the.end.is.important/2"
+		request := httptest.NewRequest("GET", url, nil)
+		request = request.WithContext(lookup.WithCaller(request.Context(), lookup.APICaller))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.BlockRewards(writer, request)
+		assert.Equal(t, http.StatusForbidden, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusForbidden, e.Code)
+		assert.StringContains(t, "archival state queries are disabled", e.Message)
+	})
+}
+
+func TestBlockRewards_MatchStateTransition(t *testing.T) {
+	st, sbb, err := BlockRewardTestSetup(t, "altair")
+	require.NoError(t, err)
+	preState := st.Copy()
+
+	mockChainService := &mock.ChainService{Optimistic: true}
+	s := &Server{
+		Blocker: &testutil.MockBlocker{SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
+			2: sbb,
+		}},
+		OptimisticModeFetcher: mockChainService,
+		FinalizationFetcher:   mockChainService,
+		BlockRewardFetcher: &BlockRewardService{
+			Replayer: mockstategen.NewReplayerBuilder(mockstategen.WithMockState(st)),
+			DB:       dbutil.SetupDB(t),
+		},
+	}
+	url := "http://only.the.slot.number.at.the.end.is.important/2"
+
+	request := httptest.NewRequest(http.MethodGet, url, nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.BlockRewards(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	blockResp := &structs.BlockRewardsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), blockResp))
+	total, err := strconv.ParseUint(blockResp.Data.Total, 10, 64)
+	require.NoError(t, err)
+
+	// The sync committee rewards are computed from the cached pre-state, not from the state mutated above.
+	request = httptest.NewRequest(http.MethodPost, url, nil)
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.SyncCommitteeRewards(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	syncResp := &structs.SyncCommitteeRewardsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), syncResp))
+	syncRewards := make(map[primitives.ValidatorIndex]int64, len(syncResp.Data))
+	for _, r := range syncResp.Data {
+		idx, err := strconv.ParseUint(r.ValidatorIndex, 10, 64)
+		require.NoError(t, err)
+		reward, err := strconv.ParseInt(r.Reward, 10, 64)
+		require.NoError(t, err)
+		syncRewards[primitives.ValidatorIndex(idx)] = reward
+	}
+
+	// Apply the block operations and the sync aggregate as the state transition does.
+	ctx := context.Background()
+	postState, err := transition.ProcessOperationsNoVerifyAttsSigs(ctx, preState.Copy(), sbb.Block())
+	require.NoError(t, err)
+	sa, err := sbb.Block().Body().SyncAggregate()
+	require.NoError(t, err)
+	postState, _, err = altair.ProcessSyncAggregate(ctx, postState, sa)
+	require.NoError(t, err)
+
+	proposerIndex := sbb.Block().ProposerIndex()
+	slashed := map[primitives.ValidatorIndex]bool{0: true, 1: true}
+	for i := 0; i < preState.NumValidators(); i++ {
+		idx := primitives.ValidatorIndex(i)
+		if slashed[idx] {
+			continue
+		}
+		preBal, err := preState.BalanceAtIndex(idx)
+		require.NoError(t, err)
+		postBal, err := postState.BalanceAtIndex(idx)
+		require.NoError(t, err)
+		expected := syncRewards[idx]
+		if idx == proposerIndex {
+			expected += int64(total) // lint:ignore uintcast -- test code
+		}
+		assert.Equal(t, expected, int64(postBal)-int64(preBal), "unexpected balance change of validator %d", idx) // lint:ignore uintcast -- test code
+	}
 }
 
 func TestAttestationRewards(t *testing.T) {
@@ -612,8 +725,15 @@ func TestAttestationRewards(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, e.Code)
 		assert.Equal(t, "Validator index 999 is too large. Maximum allowed index is 63", e.Message)
 	})
-	t.Run("phase 0", func(t *testing.T) {
-		url := "http://only.the.epoch.number.at.the.end.is.important/0"
+	t.Run("state not available", func(t *testing.T) {
+		s := &Server{
+			Stater:                &testutil.MockStater{StateBySlotErr: errors.Wrap(stategen.ErrNoDataForSlot, "slot 1 not in db due to checkpoint sync")},
+			TimeFetcher:           mockChainService,
+			OptimisticModeFetcher: mockChainService,
+			FinalizationFetcher:   mockChainService,
+		}
+
+		url := "http://only.the.epoch.number.at.the.end.is.important/1"
 		request := httptest.NewRequest("POST", url, nil)
 		writer := httptest.NewRecorder()
 		writer.Body = &bytes.Buffer{}
@@ -623,7 +743,27 @@ func TestAttestationRewards(t *testing.T) {
 		e := &httputil.DefaultJsonError{}
 		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
 		assert.Equal(t, http.StatusNotFound, e.Code)
-		assert.Equal(t, "Attestation rewards are not supported for Phase 0", e.Message)
+		assert.StringContains(t, "Attestation rewards for epoch 1 are not available on this node", e.Message)
+	})
+	t.Run("archival query disabled", func(t *testing.T) {
+		s := &Server{
+			Stater:                &testutil.MockStater{StateBySlotErr: errors.Wrap(lookup.ErrArchivalQueryDisabled, "slot 63 is before the finalized slot 64")},
+			TimeFetcher:           mockChainService,
+			OptimisticModeFetcher: mockChainService,
+			FinalizationFetcher:   mockChainService,
+		}
+
+		url := "http://only.the.epoch.number.at.the.end.is.important/1"
+		request := httptest.NewRequest("POST", url, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.AttestationRewards(writer, request)
+		assert.Equal(t, http.StatusForbidden, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.Equal(t, http.StatusForbidden, e.Code)
+		assert.StringContains(t, "archival state queries are disabled", e.Message)
 	})
 	t.Run("invalid epoch", func(t *testing.T) {
 		url := "http://only.the.epoch.number.at.the.end.is.important/foo"
@@ -653,6 +793,93 @@ func TestAttestationRewards(t *testing.T) {
 	})
 }
 
+func TestAttestationRewards_Phase0(t *testing.T) {
+	helpers.ClearCache()
+	ctx := context.Background()
+	valCount := uint64(64)
+
+	st, _ := util.DeterministicGenesisState(t, valCount)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*2-1))
+	// Every validator but validator 0 attested in epoch 0 and got included right away.
+	for slot := primitives.Slot(0); slot < params.BeaconConfig().SlotsPerEpoch; slot++ {
+		committee, err := helpers.BeaconCommitteeFromState(ctx, st, slot, 0)
+		require.NoError(t, err)
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		for i, valIdx := range committee {
+			bits.SetBitAt(uint64(i), valIdx != 0)
+		}
+		require.NoError(t, st.AppendPreviousEpochAttestations(&eth.PendingAttestation{
+			AggregationBits: bits,
+			Data: &eth.AttestationData{
+				Slot:            slot,
+				BeaconBlockRoot: make([]byte, fieldparams.RootLength),
+				Source:          &eth.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+				Target:          &eth.Checkpoint{Root: make([]byte, fieldparams.RootLength)},
+			},
+			InclusionDelay: 1,
+		}))
+	}
+
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	mockChainService := &mock.ChainService{Slot: &currentSlot}
+	s := &Server{
+		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
+			params.BeaconConfig().SlotsPerEpoch*2 - 1: st,
+		}},
+		TimeFetcher:           mockChainService,
+		OptimisticModeFetcher: mockChainService,
+		FinalizationFetcher:   mockChainService,
+	}
+
+	cfg := params.BeaconConfig()
+	totalBalance := valCount * cfg.MaxEffectiveBalance
+	br := cfg.MaxEffectiveBalance * cfg.BaseRewardFactor / math.IntegerSquareRoot(totalBalance) / cfg.BaseRewardsPerEpoch
+	attestedReward := strconv.FormatUint(br*((valCount-1)*cfg.MaxEffectiveBalance/cfg.EffectiveBalanceIncrement)/(totalBalance/cfg.EffectiveBalanceIncrement), 10)
+	inclusionDelayReward := strconv.FormatUint(br-br/cfg.ProposerRewardQuotient, 10)
+	penalty := fmt.Sprintf("-%d", br)
+
+	url := "http://only.the.epoch.number.at.the.end.is.important/0"
+	var body bytes.Buffer
+	valIds, err := json.Marshal([]string{"0", "1"})
+	require.NoError(t, err)
+	_, err = body.Write(valIds)
+	require.NoError(t, err)
+	request := httptest.NewRequest("POST", url, &body)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.AttestationRewards(writer, request)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.AttestationRewardsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data.TotalRewards))
+	assert.DeepEqual(t, structs.TotalAttestationReward{
+		ValidatorIndex: "0",
+		Head:           penalty,
+		Target:         penalty,
+		Source:         penalty,
+		InclusionDelay: "0",
+		Inactivity:     "0",
+	}, resp.Data.TotalRewards[0])
+	assert.DeepEqual(t, structs.TotalAttestationReward{
+		ValidatorIndex: "1",
+		Head:           attestedReward,
+		Target:         attestedReward,
+		Source:         attestedReward,
+		InclusionDelay: inclusionDelayReward,
+		Inactivity:     "0",
+	}, resp.Data.TotalRewards[1])
+	require.Equal(t, 1, len(resp.Data.IdealRewards))
+	assert.DeepEqual(t, structs.IdealAttestationReward{
+		EffectiveBalance: strconv.FormatUint(cfg.MaxEffectiveBalance, 10),
+		Head:             attestedReward,
+		Target:           attestedReward,
+		Source:           attestedReward,
+		InclusionDelay:   inclusionDelayReward,
+		Inactivity:       "0",
+	}, resp.Data.IdealRewards[0])
+}
+
 func TestSyncCommiteeRewards(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	cfg := params.BeaconConfig()
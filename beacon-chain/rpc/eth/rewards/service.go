@@ -4,15 +4,20 @@ import (
 	"context"
 	"net/http"
 	"strconv"
+	"sync"
 
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	coreblocks "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
 	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
@@ -25,10 +30,17 @@ type BlockRewardsFetcher interface {
 	GetStateForRewards(context.Context, interfaces.ReadOnlyBeaconBlock) (state.BeaconState, *httputil.DefaultJsonError)
 }
 
+// preStateCacheSize is the number of block pre-states kept by the BlockRewardService. Block rewards and
+// sync committee rewards are usually requested one after the other for the same few recent blocks.
+const preStateCacheSize = 4
+
 // BlockRewardService implements BlockRewardsFetcher and can be declared to access the underlying functions
 type BlockRewardService struct {
 	Replayer stategen.ReplayerBuilder
 	DB       db.HeadAccessDatabase
+
+	preStatesOnce sync.Once
+	preStates     *lru.Cache
 }
 
 // GetBlockRewardsData returns the BlockRewards object which is used for the BlockRewardsResponse and ProduceBlockV3.
@@ -54,14 +66,16 @@ func (rs *BlockRewardService) GetBlockRewardsData(ctx context.Context, blk inter
 			Code:    http.StatusInternalServerError,
 		}
 	}
-	st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
+	// The operations are processed in the order of the state transition, so that each reward
+	// component matches the balance change applied on chain.
+	st, err = coreblocks.ProcessProposerSlashings(ctx, st, blk.Body().ProposerSlashings(), validators.SlashValidator)
 	if err != nil {
 		return nil, &httputil.DefaultJsonError{
-			Message: "Could not get attestation rewards: " + err.Error(),
+			Message: "Could not get proposer slashing rewards: " + err.Error(),
 			Code:    http.StatusInternalServerError,
 		}
 	}
-	attBalance, err := st.BalanceAtIndex(proposerIndex)
+	proposerSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
 	if err != nil {
 		return nil, &httputil.DefaultJsonError{
 			Message: "Could not get proposer's balance: " + err.Error(),
@@ -82,14 +96,14 @@ func (rs *BlockRewardService) GetBlockRewardsData(ctx context.Context, blk inter
 			Code:    http.StatusInternalServerError,
 		}
 	}
-	st, err = coreblocks.ProcessProposerSlashings(ctx, st, blk.Body().ProposerSlashings(), validators.SlashValidator)
+	st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
 	if err != nil {
 		return nil, &httputil.DefaultJsonError{
-			Message: "Could not get proposer slashing rewards: " + err.Error(),
+			Message: "Could not get attestation rewards: " + err.Error(),
 			Code:    http.StatusInternalServerError,
 		}
 	}
-	proposerSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
+	attBalance, err := st.BalanceAtIndex(proposerIndex)
 	if err != nil {
 		return nil, &httputil.DefaultJsonError{
 			Message: "Could not get proposer's balance: " + err.Error(),
@@ -114,16 +128,43 @@ func (rs *BlockRewardService) GetBlockRewardsData(ctx context.Context, blk inter
 
 	return &structs.BlockRewards{
 		ProposerIndex:     strconv.FormatUint(uint64(proposerIndex), 10),
-		Total:             strconv.FormatUint(proposerSlashingsBalance-initBalance+syncCommitteeReward, 10),
-		Attestations:      strconv.FormatUint(attBalance-initBalance, 10),
+		Total:             strconv.FormatUint(attBalance-initBalance+syncCommitteeReward, 10),
+		Attestations:      strconv.FormatUint(attBalance-attSlashingsBalance, 10),
 		SyncAggregate:     strconv.FormatUint(syncCommitteeReward, 10),
-		ProposerSlashings: strconv.FormatUint(proposerSlashingsBalance-attSlashingsBalance, 10),
-		AttesterSlashings: strconv.FormatUint(attSlashingsBalance-attBalance, 10),
+		ProposerSlashings: strconv.FormatUint(proposerSlashingsBalance-initBalance, 10),
+		AttesterSlashings: strconv.FormatUint(attSlashingsBalance-proposerSlashingsBalance, 10),
 	}, nil
 }
 
-// GetStateForRewards returns the state replayed up to the block's slot
+// GetStateForRewards returns the state replayed up to the block's slot. The pre-state of the most recently
+// requested blocks is cached, so that the block and sync committee rewards of a block are computed from a
+// single lookup. The returned state is a copy which the caller is free to mutate.
 func (rs *BlockRewardService) GetStateForRewards(ctx context.Context, blk interfaces.ReadOnlyBeaconBlock) (state.BeaconState, *httputil.DefaultJsonError) {
+	rs.preStatesOnce.Do(func() {
+		rs.preStates = lruwrpr.New(preStateCacheSize)
+	})
+	blkRoot, err := blk.HashTreeRoot()
+	if err != nil {
+		return nil, &httputil.DefaultJsonError{
+			Message: "Could not get block root: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	if v, ok := rs.preStates.Get(blkRoot); ok {
+		if st, ok := v.(state.BeaconState); ok {
+			return st.Copy(), nil
+		}
+	}
+
+	st, httpErr := rs.preState(ctx, blk)
+	if httpErr != nil {
+		return nil, httpErr
+	}
+	rs.preStates.Add(blkRoot, st.Copy())
+	return st, nil
+}
+
+func (rs *BlockRewardService) preState(ctx context.Context, blk interfaces.ReadOnlyBeaconBlock) (state.BeaconState, *httputil.DefaultJsonError) {
 	// We want to run several block processing functions that update the proposer's balance.
 	// This will allow us to calculate proposer rewards for each operation (atts, slashings etc).
 	// To do this, we replay the state up to the block's slot, but before processing the block.
@@ -145,9 +186,13 @@ func (rs *BlockRewardService) GetStateForRewards(ctx context.Context, blk interf
 
 	st, err := rs.Replayer.ReplayerForSlot(slots.PrevSlot(blk.Slot())).ReplayToSlot(ctx, blk.Slot())
 	if err != nil {
+		code := http.StatusInternalServerError
+		if errors.Is(err, lookup.ErrArchivalQueryDisabled) {
+			code = http.StatusForbidden
+		}
 		return nil, &httputil.DefaultJsonError{
 			Message: "Could not get state: " + err.Error(),
-			Code:    http.StatusInternalServerError,
+			Code:    code,
 		}
 	}
 	return st, nil
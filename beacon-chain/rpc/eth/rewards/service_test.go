@@ -6,7 +6,9 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	mockstategen "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen/mock"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -44,3 +46,33 @@ func TestGetStateForRewards_NextSlotCacheHit(t *testing.T) {
 	require.NoError(t, err)
 	assert.DeepEqual(t, expected, actual)
 }
+
+func TestGetStateForRewards_CachesPreState(t *testing.T) {
+	ctx := context.Background()
+	db := dbutil.SetupDB(t)
+
+	st, err := util.NewBeaconStateDeneb()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(10))
+	// The pre-state of a block is replayed from the slot preceding it.
+	rb := mockstategen.NewReplayerBuilder()
+	rb.SetMockStateForSlot(st, 9)
+	s := &BlockRewardService{
+		Replayer: rb,
+		DB:       db,
+	}
+	b := util.HydrateSignedBeaconBlockDeneb(util.NewBeaconBlockDeneb())
+	sbb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	sbb.SetSlot(10)
+
+	first, err := s.GetStateForRewards(ctx, sbb.Block())
+	require.NoError(t, err)
+	// Mutating the returned state must not affect the cached pre-state.
+	require.NoError(t, first.SetSlot(11))
+
+	s.Replayer = nil // setting to nil because replayer must not be invoked on a cache hit
+	second, err := s.GetStateForRewards(ctx, sbb.Block())
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(10), second.Slot())
+}
@@ -23,6 +23,10 @@ func WriteStateFetchError(w http.ResponseWriter, err error) {
 		httputil.HandleError(w, "Invalid state ID: "+parseErr.Error(), http.StatusBadRequest)
 		return
 	}
+	if errors.Is(err, lookup.ErrArchivalQueryDisabled) {
+		httputil.HandleError(w, "Could not get state: "+err.Error(), http.StatusForbidden)
+		return
+	}
 	httputil.HandleError(w, "Could not get state: "+err.Error(), http.StatusInternalServerError)
 }
 
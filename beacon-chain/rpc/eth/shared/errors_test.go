@@ -31,6 +31,11 @@ func TestWriteStateFetchError(t *testing.T) {
 			expectedMessage: "Invalid state ID",
 			expectedCode:    http.StatusBadRequest,
 		},
+		{
+			err:             errors.Wrap(lookup.ErrArchivalQueryDisabled, "slot 1 is before the finalized slot 64"),
+			expectedMessage: "archival state queries are disabled",
+			expectedCode:    http.StatusForbidden,
+		},
 		{
 			err:             errors.New("state not found"),
 			expectedMessage: "Could not get state",
@@ -1140,6 +1140,26 @@ func (s *Server) GetLiveness(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Recent epochs are answered from the duties observed by the node, without looking up states.
+	if s.ValidatorLiveness != nil && s.ValidatorLiveness.Covers(requestedEpoch) {
+		numVals := primitives.ValidatorIndex(headSt.NumValidators())
+		resp := &structs.GetLivenessResponse{
+			Data: make([]*structs.Liveness, len(requestedValIndices)),
+		}
+		for i, vi := range requestedValIndices {
+			if vi >= numVals {
+				httputil.HandleError(w, fmt.Sprintf("Validator index %d is invalid", vi), http.StatusBadRequest)
+				return
+			}
+			resp.Data[i] = &structs.Liveness{
+				Index:  strconv.FormatUint(uint64(vi), 10),
+				IsLive: s.ValidatorLiveness.IsLive(requestedEpoch, vi),
+			}
+		}
+		httputil.WriteJson(w, resp)
+		return
+	}
+
 	var st state.BeaconState
 	var participation []byte
 	if requestedEpoch == currEpoch {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -166,6 +168,15 @@ func (s *Server) ProduceBlockV3(w http.ResponseWriter, r *http.Request) {
 	if rawBbFactor != "" {
 		bbFactor = &wrapperspb.UInt64Value{Value: bbValue}
 	}
+	// The thresholds of the builder bid are Prysm specific, they are set by the Prysm validator client.
+	_, minBuilderBid, ok := shared.UintFromQuery(w, r, "min_builder_bid", false)
+	if !ok {
+		return
+	}
+	minBuilderBidToLocalRatio, ok := ratioFromQuery(w, r, "min_builder_bid_to_local_ratio")
+	if !ok {
+		return
+	}
 
 	slot, valid := shared.ValidateUint(w, "slot", rawSlot)
 	if !valid {
@@ -193,15 +204,34 @@ func (s *Server) ProduceBlockV3(w http.ResponseWriter, r *http.Request) {
 		graffiti = g
 	}
 
+	// A builder boost factor of 0 always selects the local payload, so the builder is not asked for a bid.
+	skipMevBoost := bbFactor != nil && bbFactor.Value == 0
+
 	s.produceBlockV3(ctx, w, r, &eth.BlockRequest{
-		Slot:               primitives.Slot(slot),
-		RandaoReveal:       randaoReveal,
-		Graffiti:           graffiti,
-		SkipMevBoost:       false,
-		BuilderBoostFactor: bbFactor,
+		Slot:                      primitives.Slot(slot),
+		RandaoReveal:              randaoReveal,
+		Graffiti:                  graffiti,
+		SkipMevBoost:              skipMevBoost,
+		BuilderBoostFactor:        bbFactor,
+		MinBuilderBid:             minBuilderBid,
+		MinBuilderBidToLocalRatio: minBuilderBidToLocalRatio,
 	}, any)
 }
 
+// ratioFromQuery parses the optional, non-negative ratio of the query parameter. It is 0 when the parameter is missing.
+func ratioFromQuery(w http.ResponseWriter, r *http.Request, name string) (float64, bool) {
+	raw := strings.ReplaceAll(r.URL.Query().Get(name), " ", "")
+	if raw == "" {
+		return 0, true
+	}
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) || v < 0 {
+		httputil.HandleError(w, fmt.Sprintf("%s is not a non-negative number: %s", name, raw), http.StatusBadRequest)
+		return 0, false
+	}
+	return v, true
+}
+
 func (s *Server) produceBlockV3(ctx context.Context, w http.ResponseWriter, r *http.Request, v1alpha1req *eth.BlockRequest, requiredType blockType) {
 	isSSZ := httputil.RespondWithSsz(r)
 	v1alpha1resp, err := s.V1Alpha1Server.GetBeaconBlock(ctx, v1alpha1req)
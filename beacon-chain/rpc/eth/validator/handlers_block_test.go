@@ -22,6 +22,7 @@ import (
 	mock2 "github.com/prysmaticlabs/prysm/v5/testing/mock"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestProduceBlockV2(t *testing.T) {
@@ -1631,6 +1632,44 @@ func TestProduceBlockV3(t *testing.T) {
 		server.ProduceBlockV3(writer, request)
 		assert.Equal(t, http.StatusBadRequest, writer.Code)
 	})
+	t.Run("builder bid thresholds", func(t *testing.T) {
+		var block *structs.SignedBeaconBlock
+		err := json.Unmarshal([]byte(rpctesting.Phase0Block), &block)
+		require.NoError(t, err)
+		v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+		v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), &eth.BlockRequest{
+			Slot:                      1,
+			RandaoReveal:              bRandao,
+			Graffiti:                  bGraffiti,
+			MinBuilderBid:             5,
+			MinBuilderBidToLocalRatio: 1.25,
+		}).Return(
+			func() (*eth.GenericBeaconBlock, error) {
+				return block.Message.ToGeneric()
+			}())
+		server := &Server{
+			V1Alpha1Server: v1alpha1Server,
+			SyncChecker:    syncChecker,
+		}
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://foo.example/eth/v3/validator/blocks/1?randao_reveal=%s&graffiti=%s&min_builder_bid=5&min_builder_bid_to_local_ratio=1.25", randao, graffiti), nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		assert.Equal(t, http.StatusOK, writer.Code)
+	})
+	t.Run("invalid query parameter min_builder_bid_to_local_ratio", func(t *testing.T) {
+		v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+		server := &Server{
+			V1Alpha1Server: v1alpha1Server,
+			SyncChecker:    syncChecker,
+		}
+		request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://foo.example/eth/v3/validator/blocks/1?randao_reveal=%s&min_builder_bid_to_local_ratio=-1", randao), nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		server.ProduceBlockV3(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "min_builder_bid_to_local_ratio is not a non-negative number", writer.Body.String())
+	})
 	t.Run("syncing", func(t *testing.T) {
 		server := &Server{
 			SyncChecker:           &mockSync.Sync{IsSyncing: true},
@@ -1648,6 +1687,32 @@ func TestProduceBlockV3(t *testing.T) {
 	})
 }
 
+func TestProduceBlockV3_BuilderBoostFactorZero(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	randao := "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505"
+	bRandao, err := hexutil.Decode(randao)
+	require.NoError(t, err)
+	var block *structs.SignedBeaconBlock
+	require.NoError(t, json.Unmarshal([]byte(rpctesting.Phase0Block), &block))
+	v1alpha1Server := mock2.NewMockBeaconNodeValidatorServer(ctrl)
+	// The local payload is always selected, so the builder is skipped.
+	v1alpha1Server.EXPECT().GetBeaconBlock(gomock.Any(), &eth.BlockRequest{
+		Slot:               1,
+		RandaoReveal:       bRandao,
+		SkipMevBoost:       true,
+		BuilderBoostFactor: &wrapperspb.UInt64Value{Value: 0},
+	}).Return(block.Message.ToGeneric())
+	server := &Server{
+		V1Alpha1Server: v1alpha1Server,
+		SyncChecker:    &mockSync.Sync{IsSyncing: false},
+	}
+	request := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://foo.example/eth/v3/validator/blocks/1?randao_reveal=%s&builder_boost_factor=0", randao), nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	server.ProduceBlockV3(writer, request)
+	assert.Equal(t, http.StatusOK, writer.Code)
+}
+
 func TestProduceBlockV3SSZ(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	randao := "0x1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505cc411d61252fb6cb3fa0017b679f8bb2305b26a285fa2737f175668d0dff91cc1b66ac1fb663c9bc59509846d6ec05345bd908eda73e670af888da41af171505"
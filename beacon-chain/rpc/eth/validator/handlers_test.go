@@ -2319,7 +2319,7 @@ func TestGetSyncCommitteeDuties(t *testing.T) {
 	t.Run("execution optimistic", func(t *testing.T) {
 		ctx := context.Background()
 		db := dbutil.SetupDB(t)
-		require.NoError(t, db.SaveStateSummary(ctx, &ethpbalpha.StateSummary{Slot: 0, Root: []byte("root")}))
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpbalpha.StateSummary{Slot: 0, Root: bytesutil.PadTo([]byte("root"), 32)}))
 		require.NoError(t, db.SaveLastValidatedCheckpoint(ctx, &ethpbalpha.Checkpoint{Epoch: 0, Root: []byte("root")}))
 
 		parentRoot := [32]byte{'a'}
@@ -2753,6 +2753,62 @@ func TestGetLiveness(t *testing.T) {
 	})
 }
 
+func TestGetLiveness_ObservedDuties(t *testing.T) {
+	headSt, _ := util.DeterministicGenesisStateBellatrix(t, 4)
+	require.NoError(t, headSt.SetSlot(params.BeaconConfig().SlotsPerEpoch*3))
+	liveness := cache.NewValidatorLiveness()
+	liveness.MarkLive(1, 3)
+	liveness.MarkLive(2, 1)
+	liveness.MarkLive(3, 2)
+
+	s := &Server{
+		HeadFetcher:       &mockChain.ChainService{State: headSt},
+		ValidatorLiveness: liveness,
+		// Epochs covered by the cache must be answered without looking up states.
+		Stater: &testutil.MockStater{},
+	}
+
+	livenessForEpoch := func(t *testing.T, epoch string, indices string) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		_, err := body.WriteString(indices)
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/validator/liveness/{epoch}", &body)
+		request.SetPathValue("epoch", epoch)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetLiveness(writer, request)
+		return writer
+	}
+
+	t.Run("previous epoch", func(t *testing.T) {
+		writer := livenessForEpoch(t, "2", "[\"0\",\"1\",\"2\",\"3\"]")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetLivenessResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 4, len(resp.Data))
+		for i, d := range resp.Data {
+			assert.Equal(t, strconv.Itoa(i), d.Index)
+			assert.Equal(t, i == 1, d.IsLive)
+		}
+	})
+	t.Run("current epoch", func(t *testing.T) {
+		writer := livenessForEpoch(t, "3", "[\"1\",\"2\"]")
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetLivenessResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, false, resp.Data[0].IsLive)
+		assert.Equal(t, true, resp.Data[1].IsLive)
+	})
+	t.Run("unknown validator index", func(t *testing.T) {
+		writer := livenessForEpoch(t, "2", "[\"4\"]")
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Validator index 4 is invalid", e.Message)
+	})
+}
+
 var (
 	singleContribution = `[
   {
@@ -37,4 +37,5 @@ type Server struct {
 	BlockRewardFetcher     rewards.BlockRewardsFetcher
 	TrackedValidatorsCache *cache.TrackedValidatorsCache
 	PayloadIDCache         *cache.PayloadIDCache
+	ValidatorLiveness      *cache.ValidatorLiveness
 }
@@ -0,0 +1,72 @@
+package lookup
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// ErrArchivalQueryDisabled is returned when an API request needs a state older than the finalized checkpoint
+// while serving archival queries is disabled. It is defined in core, which reports it as a forbidden request.
+var ErrArchivalQueryDisabled = core.ErrArchivalQueryDisabled
+
+// Caller identifies the consumer of a state lookup.
+type Caller uint8
+
+const (
+	// InternalCaller identifies beacon node services, such as the slasher backfill or the validator monitor.
+	// It is the caller of lookups whose context was not marked with WithCaller.
+	InternalCaller Caller = iota
+	// APICaller identifies requests originating from the HTTP or gRPC APIs.
+	APICaller
+)
+
+type callerKey struct{}
+
+// WithCaller returns a copy of ctx marking the state lookups made with it as coming from the given caller.
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns the caller set by WithCaller, or InternalCaller if there is none.
+func CallerFromContext(ctx context.Context) Caller {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+	if !ok {
+		return InternalCaller
+	}
+	return caller
+}
+
+// ArchivalPolicy decides which callers may request states from before the finalized checkpoint. Such states are
+// loaded from cold storage and replayed by stategen, which makes them expensive to serve.
+// The policy is enforced by BeaconDbStater, which all API servers use to get states by id or to replay them.
+type ArchivalPolicy struct {
+	DisableAPIQueries   bool
+	FinalizationFetcher blockchain.FinalizationFetcher
+}
+
+// restricts returns whether the policy may refuse requests from the given caller.
+func (p *ArchivalPolicy) restricts(caller Caller) bool {
+	return p != nil && p.DisableAPIQueries && caller == APICaller
+}
+
+// Check returns an error wrapping ErrArchivalQueryDisabled if the caller may not request the state at the given
+// slot. A nil policy allows all requests. The genesis state is always allowed as it is saved in full.
+func (p *ArchivalPolicy) Check(caller Caller, slot primitives.Slot) error {
+	if !p.restricts(caller) || slot == params.BeaconConfig().GenesisSlot {
+		return nil
+	}
+	finalizedSlot, err := slots.EpochStart(p.FinalizationFetcher.FinalizedCheckpt().Epoch)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized slot")
+	}
+	if slot < finalizedSlot {
+		return errors.Wrapf(ErrArchivalQueryDisabled, "slot %d is before the finalized slot %d", slot, finalizedSlot)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package lookup
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	mockstategen "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen/mock"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestArchivalPolicy_Check(t *testing.T) {
+	finalizedSlot := params.BeaconConfig().SlotsPerEpoch * 3
+	policy := &ArchivalPolicy{
+		DisableAPIQueries:   true,
+		FinalizationFetcher: &chainMock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 3}},
+	}
+
+	require.ErrorIs(t, policy.Check(APICaller, finalizedSlot-1), ErrArchivalQueryDisabled)
+	require.NoError(t, policy.Check(APICaller, finalizedSlot))
+	require.NoError(t, policy.Check(APICaller, params.BeaconConfig().GenesisSlot))
+	require.NoError(t, policy.Check(InternalCaller, finalizedSlot-1))
+
+	policy.DisableAPIQueries = false
+	require.NoError(t, policy.Check(APICaller, finalizedSlot-1))
+	var nilPolicy *ArchivalPolicy
+	require.NoError(t, nilPolicy.Check(APICaller, finalizedSlot-1))
+}
+
+func TestCallerFromContext(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, InternalCaller, CallerFromContext(ctx))
+	require.Equal(t, APICaller, CallerFromContext(WithCaller(ctx, APICaller)))
+	require.Equal(t, InternalCaller, CallerFromContext(WithCaller(WithCaller(ctx, APICaller), InternalCaller)))
+}
+
+func TestBeaconDbStater_ArchivalPolicy(t *testing.T) {
+	ctx := WithCaller(context.Background(), APICaller)
+	policy := &ArchivalPolicy{
+		DisableAPIQueries:   true,
+		FinalizationFetcher: &chainMock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 1}},
+	}
+	finalizedSlot := params.BeaconConfig().SlotsPerEpoch
+	builder := mockstategen.NewReplayerBuilder()
+	for _, slot := range []primitives.Slot{finalizedSlot - 1, finalizedSlot} {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(slot))
+		builder.SetMockState(st)
+	}
+	currentSlot := finalizedSlot * 2
+	stater := &BeaconDbStater{
+		GenesisTimeFetcher: &chainMock.ChainService{Slot: &currentSlot},
+		ReplayerBuilder:    builder,
+		ArchivalPolicy:     policy,
+	}
+
+	t.Run("slot", func(t *testing.T) {
+		_, err := stater.State(ctx, []byte(strconv.FormatUint(uint64(finalizedSlot-1), 10)))
+		require.ErrorIs(t, err, ErrArchivalQueryDisabled)
+		st, err := stater.State(ctx, []byte(strconv.FormatUint(uint64(finalizedSlot), 10)))
+		require.NoError(t, err)
+		require.Equal(t, finalizedSlot, st.Slot())
+
+		// Internal callers are not subject to the policy.
+		st, err = stater.State(context.Background(), []byte(strconv.FormatUint(uint64(finalizedSlot-1), 10)))
+		require.NoError(t, err)
+		require.Equal(t, finalizedSlot-1, st.Slot())
+	})
+
+	t.Run("replayer", func(t *testing.T) {
+		_, err := stater.ReplayerForSlot(finalizedSlot - 1).ReplayBlocks(ctx)
+		require.ErrorIs(t, err, ErrArchivalQueryDisabled)
+		_, err = stater.ReplayerForSlot(finalizedSlot-1).ReplayToSlot(ctx, finalizedSlot)
+		require.ErrorIs(t, err, ErrArchivalQueryDisabled)
+		st, err := stater.ReplayerForSlot(finalizedSlot).ReplayBlocks(ctx)
+		require.NoError(t, err)
+		require.Equal(t, finalizedSlot, st.Slot())
+
+		st, err = stater.ReplayerForSlot(finalizedSlot - 1).ReplayBlocks(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, finalizedSlot-1, st.Slot())
+	})
+
+	t.Run("state root", func(t *testing.T) {
+		beaconDB := testDB.SetupDB(t)
+		stateGen := mockstategen.NewService()
+		headState, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, headState.SetSlot(currentSlot))
+		stateRoots := make(map[primitives.Slot][32]byte)
+		for _, slot := range []primitives.Slot{finalizedSlot - 1, finalizedSlot} {
+			b := util.NewBeaconBlock()
+			b.Block.Slot = slot
+			util.SaveBlock(t, ctx, beaconDB, b)
+			blockRoot, err := b.Block.HashTreeRoot()
+			require.NoError(t, err)
+			st, err := util.NewBeaconState()
+			require.NoError(t, err)
+			require.NoError(t, st.SetSlot(slot))
+			stateRoot, err := st.HashTreeRoot(ctx)
+			require.NoError(t, err)
+			require.NoError(t, headState.UpdateBlockRootAtIndex(uint64(slot), blockRoot))
+			require.NoError(t, headState.UpdateStateRootAtIndex(uint64(slot), stateRoot))
+			stateGen.StatesByRoot[blockRoot] = st
+			stateRoots[slot] = stateRoot
+		}
+		stater := &BeaconDbStater{
+			BeaconDB:         beaconDB,
+			ChainInfoFetcher: &chainMock.ChainService{State: headState},
+			StateGenService:  stateGen,
+			ArchivalPolicy:   policy,
+		}
+
+		root := stateRoots[finalizedSlot-1]
+		_, err = stater.State(ctx, root[:])
+		require.ErrorIs(t, err, ErrArchivalQueryDisabled)
+		root = stateRoots[finalizedSlot]
+		st, err := stater.State(ctx, root[:])
+		require.NoError(t, err)
+		require.Equal(t, finalizedSlot, st.Slot())
+
+		// Internal callers are not subject to the policy.
+		root = stateRoots[finalizedSlot-1]
+		st, err = stater.State(context.Background(), root[:])
+		require.NoError(t, err)
+		require.Equal(t, finalizedSlot-1, st.Slot())
+	})
+}
@@ -80,12 +80,15 @@ type Stater interface {
 }
 
 // BeaconDbStater is an implementation of Stater. It retrieves states from the beacon chain database.
+// It also implements stategen.ReplayerBuilder, so that API servers replaying states themselves go through it.
+// States requested with a context marked by WithCaller are subject to ArchivalPolicy, if set.
 type BeaconDbStater struct {
 	BeaconDB           db.ReadOnlyDatabase
 	ChainInfoFetcher   blockchain.ChainInfoFetcher
 	GenesisTimeFetcher blockchain.TimeFetcher
 	StateGenService    stategen.StateManager
 	ReplayerBuilder    stategen.ReplayerBuilder
+	ArchivalPolicy     *ArchivalPolicy
 }
 
 // State returns the BeaconState for a given identifier. The identifier can be one of:
@@ -124,7 +127,7 @@ func (p *BeaconDbStater) State(ctx context.Context, stateId []byte) (state.Beaco
 		// replay it to the start slot of our checkpoint's epoch. The replayer
 		// only ever accesses our canonical history, so the state retrieved will
 		// always be the finalized state at that epoch.
-		s, err = p.ReplayerBuilder.ReplayerForSlot(targetSlot).ReplayToSlot(ctx, targetSlot)
+		s, err = p.ReplayerForSlot(targetSlot).ReplayToSlot(ctx, targetSlot)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not get finalized state")
 		}
@@ -138,7 +141,7 @@ func (p *BeaconDbStater) State(ctx context.Context, stateId []byte) (state.Beaco
 		// replay it to the start slot of our checkpoint's epoch. The replayer
 		// only ever accesses our canonical history, so the state retrieved will
 		// always be the justified state at that epoch.
-		s, err = p.ReplayerBuilder.ReplayerForSlot(targetSlot).ReplayToSlot(ctx, targetSlot)
+		s, err = p.ReplayerForSlot(targetSlot).ReplayToSlot(ctx, targetSlot)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not get justified state")
 		}
@@ -209,8 +212,20 @@ func (p *BeaconDbStater) stateByRoot(ctx context.Context, stateRoot []byte) (sta
 	}
 	for i, root := range headState.StateRoots() {
 		if bytes.Equal(root, stateRoot) {
-			blockRoot := headState.BlockRoots()[i]
-			return p.StateGenService.StateByRoot(ctx, bytesutil.ToBytes32(blockRoot))
+			blockRoot := bytesutil.ToBytes32(headState.BlockRoots()[i])
+			if p.ArchivalPolicy.restricts(CallerFromContext(ctx)) {
+				b, err := p.BeaconDB.Block(ctx, blockRoot)
+				if err != nil {
+					return nil, errors.Wrap(err, "could not get block")
+				}
+				if err := blocks.BeaconBlockIsNil(b); err != nil {
+					return nil, err
+				}
+				if err := p.checkArchivalPolicy(ctx, b.Block().Slot()); err != nil {
+					return nil, err
+				}
+			}
+			return p.StateGenService.StateByRoot(ctx, blockRoot)
 		}
 	}
 
@@ -231,7 +246,7 @@ func (p *BeaconDbStater) StateBySlot(ctx context.Context, target primitives.Slot
 		return nil, errors.New("requested slot is in the future")
 	}
 
-	st, err := p.ReplayerBuilder.ReplayerForSlot(target).ReplayBlocks(ctx)
+	st, err := p.ReplayerForSlot(target).ReplayBlocks(ctx)
 	if err != nil {
 		msg := fmt.Sprintf("error while replaying history to slot=%d", target)
 		return nil, errors.Wrap(err, msg)
@@ -239,6 +254,39 @@ func (p *BeaconDbStater) StateBySlot(ctx context.Context, target primitives.Slot
 	return st, nil
 }
 
+// checkArchivalPolicy returns an error if the archival policy refuses the state at the given slot to the caller
+// of the request.
+func (p *BeaconDbStater) checkArchivalPolicy(ctx context.Context, slot primitives.Slot) error {
+	return p.ArchivalPolicy.Check(CallerFromContext(ctx), slot)
+}
+
+// ReplayerForSlot returns a replayer to the target slot which checks the archival policy before replaying.
+func (p *BeaconDbStater) ReplayerForSlot(target primitives.Slot) stategen.Replayer {
+	return &archivalReplayer{replayer: p.ReplayerBuilder.ReplayerForSlot(target), stater: p, target: target}
+}
+
+type archivalReplayer struct {
+	replayer stategen.Replayer
+	stater   *BeaconDbStater
+	target   primitives.Slot
+}
+
+// ReplayBlocks replays the blocks up to the target slot if the archival policy allows it.
+func (r *archivalReplayer) ReplayBlocks(ctx context.Context) (state.BeaconState, error) {
+	if err := r.stater.checkArchivalPolicy(ctx, r.target); err != nil {
+		return nil, err
+	}
+	return r.replayer.ReplayBlocks(ctx)
+}
+
+// ReplayToSlot replays the blocks up to the target slot and advances the state if the archival policy allows it.
+func (r *archivalReplayer) ReplayToSlot(ctx context.Context, target primitives.Slot) (state.BeaconState, error) {
+	if err := r.stater.checkArchivalPolicy(ctx, r.target); err != nil {
+		return nil, err
+	}
+	return r.replayer.ReplayToSlot(ctx, target)
+}
+
 func (p *BeaconDbStater) headStateRoot(ctx context.Context) ([]byte, error) {
 	b, err := p.ChainInfoFetcher.HeadBlock(ctx)
 	if err != nil {
@@ -0,0 +1,61 @@
+package beacon
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetBlobSidecarByVersionedHash returns the stored blob sidecar whose kzg commitment has the versioned hash, as found
+// in the blob transactions of the execution layer, along with the root of its block. The sidecar includes the
+// inclusion proof of its commitment in the block.
+func (s *Server) GetBlobSidecarByVersionedHash(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetBlobSidecarByVersionedHash")
+	defer span.End()
+
+	rawHash := r.PathValue("versioned_hash")
+	if rawHash == "" {
+		httputil.HandleError(w, "versioned_hash is required in URL params", http.StatusBadRequest)
+		return
+	}
+	hash, err := bytesutil.DecodeHexWithLength(rawHash, 32)
+	if err != nil {
+		httputil.HandleError(w, "Could not decode versioned hash: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if s.BlobStorage == nil {
+		httputil.HandleError(w, "Blob storage is not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	loc, err := s.BlobStorage.LocateVersionedHash(bytesutil.ToBytes32(hash))
+	if errors.Is(err, filesystem.ErrVersionedHashNotFound) {
+		httputil.HandleError(w, "No blob sidecar found for versioned hash "+rawHash, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httputil.HandleError(w, "Could not look up versioned hash: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sidecar, err := s.BlobStorage.Get(loc.Root, loc.Index)
+	if errors.Is(err, os.ErrNotExist) {
+		// The blob was pruned since it was looked up.
+		httputil.HandleError(w, "No blob sidecar found for versioned hash "+rawHash, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httputil.HandleError(w, "Could not get blob sidecar: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetBlobSidecarByVersionedHashResponse{
+		BlockRoot: hexutil.Encode(loc.Root[:]),
+		Data:      structs.SidecarFromConsensus(sidecar.BlobSidecar),
+	})
+}
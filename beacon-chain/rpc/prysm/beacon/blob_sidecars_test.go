@@ -0,0 +1,69 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestGetBlobSidecarByVersionedHash(t *testing.T) {
+	bs := filesystem.NewEphemeralBlobStorage(t)
+	_, sidecars := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 2)
+	scs, err := verification.BlobSidecarSliceNoop(sidecars)
+	require.NoError(t, err)
+	for _, sc := range scs {
+		require.NoError(t, bs.Save(sc))
+	}
+	s := &Server{BlobStorage: bs}
+
+	t.Run("found", func(t *testing.T) {
+		hash := primitives.ConvertKzgCommitmentToVersionedHash(sidecars[1].KzgCommitment)
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request.SetPathValue("versioned_hash", hexutil.Encode(hash[:]))
+		writer := httptest.NewRecorder()
+		writer.Body = new(bytes.Buffer)
+
+		s.GetBlobSidecarByVersionedHash(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetBlobSidecarByVersionedHashResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		root := sidecars[1].BlockRoot()
+		assert.Equal(t, hexutil.Encode(root[:]), resp.BlockRoot)
+		assert.Equal(t, "1", resp.Data.Index)
+		assert.Equal(t, hexutil.Encode(sidecars[1].KzgCommitment), resp.Data.KzgCommitment)
+		assert.Equal(t, len(sidecars[1].CommitmentInclusionProof), len(resp.Data.CommitmentInclusionProof))
+	})
+	t.Run("not found", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request.SetPathValue("versioned_hash", hexutil.Encode(make([]byte, 32)))
+		writer := httptest.NewRecorder()
+		writer.Body = new(bytes.Buffer)
+
+		s.GetBlobSidecarByVersionedHash(writer, request)
+		require.Equal(t, http.StatusNotFound, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "No blob sidecar found", e.Message)
+	})
+	t.Run("invalid hash", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request.SetPathValue("versioned_hash", "0x01")
+		writer := httptest.NewRecorder()
+		writer.Body = new(bytes.Buffer)
+
+		s.GetBlobSidecarByVersionedHash(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
@@ -0,0 +1,44 @@
+package beacon
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/graffiti"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetGraffitiStats returns the graffiti statistics of the most recent tallied epochs, from the oldest to the most
+// recent: the number of canonical blocks whose graffiti matches each client, and the most frequent graffiti. The
+// statistics are only tallied when enabled with --graffiti-stats-epochs.
+func (s *Server) GetGraffitiStats(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetGraffitiStats")
+	defer span.End()
+
+	if s.GraffitiStats == nil {
+		httputil.HandleError(w, "Graffiti statistics are not being tallied", http.StatusServiceUnavailable)
+		return
+	}
+	stats := s.GraffitiStats.Stats()
+	data := make([]*structs.EpochGraffitiStats, len(stats))
+	for i, e := range stats {
+		data[i] = &structs.EpochGraffitiStats{
+			Epoch:         strconv.FormatUint(uint64(e.Epoch), 10),
+			Blocks:        strconv.Itoa(e.Blocks),
+			Clients:       graffitiCounts(e.Clients),
+			Graffiti:      graffitiCounts(e.Graffiti),
+			OtherGraffiti: strconv.Itoa(e.OtherGraffiti),
+		}
+	}
+	httputil.WriteJson(w, &structs.GetGraffitiStatsResponse{Data: data})
+}
+
+func graffitiCounts(counts []graffiti.Count) []*structs.GraffitiCount {
+	result := make([]*structs.GraffitiCount, len(counts))
+	for i, c := range counts {
+		result[i] = &structs.GraffitiCount{Value: c.Value, Count: strconv.Itoa(c.Count)}
+	}
+	return result
+}
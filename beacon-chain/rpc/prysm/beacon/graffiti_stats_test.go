@@ -0,0 +1,56 @@
+package beacon
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/graffiti"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockGraffitiStats []*graffiti.EpochStats
+
+func (m mockGraffitiStats) Stats() []*graffiti.EpochStats {
+	return m
+}
+
+func TestServer_GetGraffitiStats(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		s := &Server{GraffitiStats: mockGraffitiStats{
+			graffiti.Tally(3, []string{"GE1a2bLH3c4d", "GE1a2bLH3c4d", "0xff00fe"}, 1),
+			graffiti.Tally(4, nil, 1),
+		}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/graffiti_stats", nil)
+		writer := httptest.NewRecorder()
+		s.GetGraffitiStats(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetGraffitiStatsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+
+		assert.Equal(t, "3", resp.Data[0].Epoch)
+		assert.Equal(t, "3", resp.Data[0].Blocks)
+		assert.DeepEqual(t, []*structs.GraffitiCount{
+			{Value: "lighthouse", Count: "2"},
+			{Value: graffiti.UnknownClient, Count: "1"},
+		}, resp.Data[0].Clients)
+		assert.DeepEqual(t, []*structs.GraffitiCount{{Value: "GE1a2bLH3c4d", Count: "2"}}, resp.Data[0].Graffiti)
+		assert.Equal(t, "1", resp.Data[0].OtherGraffiti)
+
+		assert.Equal(t, "4", resp.Data[1].Epoch)
+		assert.Equal(t, "0", resp.Data[1].Blocks)
+		assert.Equal(t, 0, len(resp.Data[1].Clients))
+	})
+	t.Run("not tallied", func(t *testing.T) {
+		s := &Server{}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/graffiti_stats", nil)
+		writer := httptest.NewRecorder()
+		s.GetGraffitiStats(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+		assert.StringContains(t, "Graffiti statistics are not being tallied", writer.Body.String())
+	})
+}
@@ -0,0 +1,129 @@
+package beacon
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+const (
+	// SlotOutcomeCanonical is the outcome of a slot with a block on the canonical chain.
+	SlotOutcomeCanonical = "canonical"
+	// SlotOutcomeOrphaned is the outcome of a slot whose blocks were all left out of the canonical chain.
+	SlotOutcomeOrphaned = "orphaned"
+	// SlotOutcomeMissed is the outcome of a slot for which no block was observed.
+	SlotOutcomeMissed = "missed"
+
+	maxSlotOutcomesRange = 1024
+)
+
+// GetSlotOutcomes returns, for each slot in the inclusive start_slot to end_slot range, whether the slot has a block
+// on the canonical chain, only blocks which were orphaned, or no block observed by the node, along with the proposer
+// assigned to the slot when known. Blocks are observed through gossip and fork choice for the slots within the
+// retention configured by --slot-outcomes-retention, so that range must be within it.
+func (s *Server) GetSlotOutcomes(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetSlotOutcomes")
+	defer span.End()
+
+	if s.SlotObservations == nil {
+		httputil.HandleError(w, "Slot outcomes are not being tracked", http.StatusServiceUnavailable)
+		return
+	}
+	_, startSlot, ok := shared.UintFromQuery(w, r, "start_slot", true)
+	if !ok {
+		return
+	}
+	_, endSlot, ok := shared.UintFromQuery(w, r, "end_slot", true)
+	if !ok {
+		return
+	}
+	start, end := primitives.Slot(startSlot), primitives.Slot(endSlot)
+	current := s.TimeFetcher.CurrentSlot()
+	if end < start {
+		httputil.HandleError(w, "End slot must not be before start slot", http.StatusBadRequest)
+		return
+	}
+	if end > current {
+		httputil.HandleError(w, fmt.Sprintf("End slot %d is after the current slot %d", end, current), http.StatusBadRequest)
+		return
+	}
+	if end-start >= maxSlotOutcomesRange {
+		httputil.HandleError(w, fmt.Sprintf("Slot range must not exceed %d slots", maxSlotOutcomesRange), http.StatusBadRequest)
+		return
+	}
+	if start+s.SlotObservations.Retention() <= current {
+		httputil.HandleError(
+			w,
+			fmt.Sprintf("Start slot %d is older than the %d slots retained by the node", start, s.SlotObservations.Retention()),
+			http.StatusBadRequest,
+		)
+		return
+	}
+
+	data := make([]*structs.SlotOutcome, 0, end-start+1)
+	for slot := start; slot <= end; slot++ {
+		o, _ := s.SlotObservations.Observation(slot)
+		roots := o.Roots
+		// Blocks which were not received through gossip or fork choice since the node started, such as blocks
+		// synced before a restart, are only known by the database.
+		_, dbRoots, err := s.BeaconDB.BlockRootsBySlot(ctx, slot)
+		if err != nil {
+			httputil.HandleError(w, fmt.Sprintf("Could not get block roots of slot %d: %v", slot, err), http.StatusInternalServerError)
+			return
+		}
+		for _, r := range dbRoots {
+			if !containsRoot(roots, r) {
+				roots = append(roots, r)
+			}
+		}
+
+		outcome := &structs.SlotOutcome{
+			Slot:          strconv.FormatUint(uint64(slot), 10),
+			Outcome:       SlotOutcomeMissed,
+			OrphanedRoots: make([]string, 0),
+		}
+		if o.ProposerKnown {
+			outcome.ProposerIndex = strconv.FormatUint(uint64(o.ProposerIndex), 10)
+		}
+		for _, r := range roots {
+			canonical, err := s.ChainInfoFetcher.IsCanonical(ctx, r)
+			if err != nil {
+				httputil.HandleError(w, fmt.Sprintf("Could not check if block %#x is canonical: %v", r, err), http.StatusInternalServerError)
+				return
+			}
+			if !canonical {
+				outcome.OrphanedRoots = append(outcome.OrphanedRoots, hexutil.Encode(r[:]))
+				continue
+			}
+			outcome.Outcome = SlotOutcomeCanonical
+			outcome.CanonicalRoot = hexutil.Encode(r[:])
+			if !o.ProposerKnown {
+				blk, err := s.BeaconDB.Block(ctx, r)
+				if err == nil && blk != nil && !blk.IsNil() {
+					outcome.ProposerIndex = strconv.FormatUint(uint64(blk.Block().ProposerIndex()), 10)
+				}
+			}
+		}
+		if outcome.Outcome == SlotOutcomeMissed && len(outcome.OrphanedRoots) > 0 {
+			outcome.Outcome = SlotOutcomeOrphaned
+		}
+		data = append(data, outcome)
+	}
+	httputil.WriteJson(w, &structs.GetSlotOutcomesResponse{Data: data})
+}
+
+func containsRoot(roots [][32]byte, root [32]byte) bool {
+	for _, r := range roots {
+		if r == root {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_GetSlotOutcomes(t *testing.T) {
+	ctx := context.Background()
+	db := dbTest.SetupDB(t)
+
+	canonical := util.NewBeaconBlock()
+	canonical.Block.Slot = 10
+	canonical.Block.ProposerIndex = 3
+	util.SaveBlock(t, ctx, db, canonical)
+	canonicalRoot, err := canonical.Block.HashTreeRoot()
+	require.NoError(t, err)
+	orphaned := util.NewBeaconBlock()
+	orphaned.Block.Slot = 11
+	orphaned.Block.ProposerIndex = 4
+	util.SaveBlock(t, ctx, db, orphaned)
+	orphanedRoot, err := orphaned.Block.HashTreeRoot()
+	require.NoError(t, err)
+	// A block seen on gossip for slot 10 which lost to the canonical block, and never made it to the database.
+	gossipRoot := [32]byte{'g'}
+
+	observations := cache.NewSlotObservations(64)
+	observations.AddBlock(10, 3, gossipRoot)
+	observations.AddBlock(11, 4, orphanedRoot)
+	observations.SetProposer(12, 5)
+
+	currentSlot := primitives.Slot(20)
+	s := &Server{
+		BeaconDB:         db,
+		TimeFetcher:      &chainMock.ChainService{Slot: &currentSlot},
+		ChainInfoFetcher: &chainMock.ChainService{CanonicalRoots: map[[32]byte]bool{canonicalRoot: true}},
+		SlotObservations: observations,
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/slot_outcomes?start_slot=10&end_slot=13", nil)
+		writer := httptest.NewRecorder()
+		s.GetSlotOutcomes(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetSlotOutcomesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 4, len(resp.Data))
+
+		assert.Equal(t, SlotOutcomeCanonical, resp.Data[0].Outcome)
+		assert.Equal(t, "3", resp.Data[0].ProposerIndex)
+		assert.Equal(t, hexutil.Encode(canonicalRoot[:]), resp.Data[0].CanonicalRoot)
+		assert.DeepEqual(t, []string{hexutil.Encode(gossipRoot[:])}, resp.Data[0].OrphanedRoots)
+
+		assert.Equal(t, SlotOutcomeOrphaned, resp.Data[1].Outcome)
+		assert.Equal(t, "4", resp.Data[1].ProposerIndex)
+		assert.DeepEqual(t, []string{hexutil.Encode(orphanedRoot[:])}, resp.Data[1].OrphanedRoots)
+
+		assert.Equal(t, SlotOutcomeMissed, resp.Data[2].Outcome)
+		assert.Equal(t, "5", resp.Data[2].ProposerIndex)
+		assert.Equal(t, 0, len(resp.Data[2].OrphanedRoots))
+
+		assert.Equal(t, SlotOutcomeMissed, resp.Data[3].Outcome)
+		assert.Equal(t, "", resp.Data[3].ProposerIndex)
+	})
+	t.Run("invalid ranges", func(t *testing.T) {
+		for _, query := range []string{
+			"start_slot=12&end_slot=10",
+			"start_slot=10&end_slot=21",
+			"end_slot=10",
+		} {
+			request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/slot_outcomes?"+query, nil)
+			writer := httptest.NewRecorder()
+			s.GetSlotOutcomes(writer, request)
+			assert.Equal(t, http.StatusBadRequest, writer.Code, query)
+		}
+	})
+	t.Run("before retention", func(t *testing.T) {
+		currentSlot := primitives.Slot(100)
+		s := &Server{
+			BeaconDB:         db,
+			TimeFetcher:      &chainMock.ChainService{Slot: &currentSlot},
+			ChainInfoFetcher: &chainMock.ChainService{},
+			SlotObservations: observations,
+		}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/slot_outcomes?start_slot=10&end_slot=13", nil)
+		writer := httptest.NewRecorder()
+		s.GetSlotOutcomes(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "older than the 64 slots retained", writer.Body.String())
+	})
+	t.Run("not tracked", func(t *testing.T) {
+		s := &Server{}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/slot_outcomes?start_slot=10&end_slot=13", nil)
+		writer := httptest.NewRecorder()
+		s.GetSlotOutcomes(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+}
@@ -0,0 +1,549 @@
+package beacon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// GetStateFields serves the GET /prysm/v1/beacon/states/{state_id}/fields endpoint. It returns only the top-level
+// fields of the state named in the fields query parameter, which can be repeated or hold a comma-separated list,
+// sparing clients the transfer and decoding of the whole state. The JSON response maps each field name to its value,
+// formatted as in the full state response. When SSZ is requested, exactly one field must be named and the response
+// body is the SSZ encoding of that field.
+func (s *Server) GetStateFields(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetStateFields")
+	defer span.End()
+
+	stateID := r.PathValue("state_id")
+	if stateID == "" {
+		httputil.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return
+	}
+	names := stateFieldNamesFromQuery(r)
+	if len(names) == 0 {
+		httputil.HandleError(w, "At least one field must be requested", http.StatusBadRequest)
+		return
+	}
+	for _, name := range names {
+		if _, ok := stateFields[name]; !ok {
+			httputil.HandleError(w, fmt.Sprintf("Unknown state field %s", name), http.StatusBadRequest)
+			return
+		}
+	}
+	respondWithSsz := httputil.RespondWithSsz(r)
+	if respondWithSsz && len(names) != 1 {
+		httputil.HandleError(w, "Exactly one field must be requested for an SSZ response", http.StatusBadRequest)
+		return
+	}
+
+	st, err := s.Stater.State(ctx, []byte(stateID))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return
+	}
+	for _, name := range names {
+		if !stateFields[name].inVersion(st.Version()) {
+			httputil.HandleError(
+				w,
+				fmt.Sprintf("%s is not a field of %s states", name, version.String(st.Version())),
+				http.StatusBadRequest,
+			)
+			return
+		}
+	}
+	ver := version.String(st.Version())
+
+	if respondWithSsz {
+		sszResp, err := stateFields[names[0]].ssz(st)
+		if err != nil {
+			httputil.HandleError(w, fmt.Sprintf("Could not marshal %s into SSZ: %v", names[0], err), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set(api.VersionHeader, ver)
+		httputil.WriteSsz(w, sszResp, names[0]+".ssz")
+		return
+	}
+
+	isOptimistic, err := helpers.IsOptimistic(ctx, []byte(stateID), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
+	if err != nil {
+		httputil.HandleError(w, "Could not check if state is optimistic: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	blockRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not calculate root of latest block header: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	isFinalized := s.FinalizationFetcher.IsFinalized(ctx, blockRoot)
+
+	data := make(map[string]json.RawMessage, len(names))
+	for _, name := range names {
+		v, err := stateFields[name].json(st)
+		if err != nil {
+			httputil.HandleError(w, fmt.Sprintf("Could not convert %s: %v", name, err), http.StatusInternalServerError)
+			return
+		}
+		data[name], err = json.Marshal(v)
+		if err != nil {
+			httputil.HandleError(w, fmt.Sprintf("Could not marshal %s into JSON: %v", name, err), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.Header().Set(api.VersionHeader, ver)
+	httputil.WriteJson(w, &structs.GetStateFieldsResponse{
+		Version:             ver,
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           isFinalized,
+		Data:                data,
+	})
+}
+
+// stateFieldNamesFromQuery returns the deduplicated field names of the request, in the order they were given.
+func stateFieldNamesFromQuery(r *http.Request) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, param := range r.URL.Query()["fields"] {
+		for _, name := range strings.Split(param, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// stateField knows how to read a top-level field of the state and encode it on its own.
+type stateField struct {
+	// since is the first fork whose states contain the field.
+	since int
+	// removedIn is the first fork whose states no longer contain the field, or 0 when no fork removed it.
+	removedIn int
+	json      func(st state.BeaconState) (interface{}, error)
+	ssz       func(st state.BeaconState) ([]byte, error)
+}
+
+func (f *stateField) inVersion(v int) bool {
+	return v >= f.since && (f.removedIn == 0 || v < f.removedIn)
+}
+
+// stateFields is keyed by the JSON name of the field in the full state response.
+var stateFields = map[string]*stateField{
+	"genesis_time": uint64Field(version.Phase0, func(st state.BeaconState) (uint64, error) {
+		return st.GenesisTime(), nil
+	}),
+	"genesis_validators_root": rootField(version.Phase0, func(st state.BeaconState) ([]byte, error) {
+		return st.GenesisValidatorsRoot(), nil
+	}),
+	"slot": uint64Field(version.Phase0, func(st state.BeaconState) (uint64, error) {
+		return uint64(st.Slot()), nil
+	}),
+	"fork": containerField(version.Phase0, func(st state.BeaconState) (*eth.Fork, error) {
+		return st.Fork(), nil
+	}, structs.ForkFromConsensus),
+	"latest_block_header": containerField(version.Phase0, func(st state.BeaconState) (*eth.BeaconBlockHeader, error) {
+		return st.LatestBlockHeader(), nil
+	}, structs.BeaconBlockHeaderFromConsensus),
+	"block_roots": rootsField(version.Phase0, func(st state.BeaconState) ([][]byte, error) {
+		return st.BlockRoots(), nil
+	}),
+	"state_roots": rootsField(version.Phase0, func(st state.BeaconState) ([][]byte, error) {
+		return st.StateRoots(), nil
+	}),
+	"historical_roots": rootsField(version.Phase0, func(st state.BeaconState) ([][]byte, error) {
+		return st.HistoricalRoots()
+	}),
+	"eth1_data": containerField(version.Phase0, func(st state.BeaconState) (*eth.Eth1Data, error) {
+		return st.Eth1Data(), nil
+	}, structs.Eth1DataFromConsensus),
+	"eth1_data_votes": containerListField(version.Phase0, func(st state.BeaconState) ([]*eth.Eth1Data, error) {
+		return st.Eth1DataVotes(), nil
+	}, structs.Eth1DataFromConsensus, false),
+	"eth1_deposit_index": uint64Field(version.Phase0, func(st state.BeaconState) (uint64, error) {
+		return st.Eth1DepositIndex(), nil
+	}),
+	"validators": containerListField(version.Phase0, func(st state.BeaconState) ([]*eth.Validator, error) {
+		return st.Validators(), nil
+	}, structs.ValidatorFromConsensus, false),
+	"balances": uint64sField(version.Phase0, func(st state.BeaconState) ([]uint64, error) {
+		return st.Balances(), nil
+	}),
+	"randao_mixes": rootsField(version.Phase0, func(st state.BeaconState) ([][]byte, error) {
+		return st.RandaoMixes(), nil
+	}),
+	"slashings": uint64sField(version.Phase0, func(st state.BeaconState) ([]uint64, error) {
+		return st.Slashings(), nil
+	}),
+	"previous_epoch_attestations": {
+		since:     version.Phase0,
+		removedIn: version.Altair,
+		json: func(st state.BeaconState) (interface{}, error) {
+			atts, err := st.PreviousEpochAttestations()
+			if err != nil {
+				return nil, err
+			}
+			return convertAll(atts, structs.PendingAttestationFromConsensus), nil
+		},
+		ssz: func(st state.BeaconState) ([]byte, error) {
+			atts, err := st.PreviousEpochAttestations()
+			if err != nil {
+				return nil, err
+			}
+			return sszList(atts, true)
+		},
+	},
+	"current_epoch_attestations": {
+		since:     version.Phase0,
+		removedIn: version.Altair,
+		json: func(st state.BeaconState) (interface{}, error) {
+			atts, err := st.CurrentEpochAttestations()
+			if err != nil {
+				return nil, err
+			}
+			return convertAll(atts, structs.PendingAttestationFromConsensus), nil
+		},
+		ssz: func(st state.BeaconState) ([]byte, error) {
+			atts, err := st.CurrentEpochAttestations()
+			if err != nil {
+				return nil, err
+			}
+			return sszList(atts, true)
+		},
+	},
+	"previous_epoch_participation": participationField(func(st state.BeaconState) ([]byte, error) {
+		return st.PreviousEpochParticipation()
+	}),
+	"current_epoch_participation": participationField(func(st state.BeaconState) ([]byte, error) {
+		return st.CurrentEpochParticipation()
+	}),
+	"justification_bits": rootField(version.Phase0, func(st state.BeaconState) ([]byte, error) {
+		return st.JustificationBits(), nil
+	}),
+	"previous_justified_checkpoint": containerField(version.Phase0, func(st state.BeaconState) (*eth.Checkpoint, error) {
+		return st.PreviousJustifiedCheckpoint(), nil
+	}, structs.CheckpointFromConsensus),
+	"current_justified_checkpoint": containerField(version.Phase0, func(st state.BeaconState) (*eth.Checkpoint, error) {
+		return st.CurrentJustifiedCheckpoint(), nil
+	}, structs.CheckpointFromConsensus),
+	"finalized_checkpoint": containerField(version.Phase0, func(st state.BeaconState) (*eth.Checkpoint, error) {
+		return st.FinalizedCheckpoint(), nil
+	}, structs.CheckpointFromConsensus),
+	"inactivity_scores": uint64sField(version.Altair, func(st state.BeaconState) ([]uint64, error) {
+		return st.InactivityScores()
+	}),
+	"current_sync_committee": containerField(version.Altair, func(st state.BeaconState) (*eth.SyncCommittee, error) {
+		return st.CurrentSyncCommittee()
+	}, structs.SyncCommitteeFromConsensus),
+	"next_sync_committee": containerField(version.Altair, func(st state.BeaconState) (*eth.SyncCommittee, error) {
+		return st.NextSyncCommittee()
+	}, structs.SyncCommitteeFromConsensus),
+	"latest_execution_payload_header": {
+		since: version.Bellatrix,
+		json:  latestExecutionPayloadHeaderJson,
+		ssz: func(st state.BeaconState) ([]byte, error) {
+			execData, err := st.LatestExecutionPayloadHeader()
+			if err != nil {
+				return nil, err
+			}
+			return execData.MarshalSSZ()
+		},
+	},
+	"next_withdrawal_index": uint64Field(version.Capella, func(st state.BeaconState) (uint64, error) {
+		return st.NextWithdrawalIndex()
+	}),
+	"next_withdrawal_validator_index": uint64Field(version.Capella, func(st state.BeaconState) (uint64, error) {
+		i, err := st.NextWithdrawalValidatorIndex()
+		return uint64(i), err
+	}),
+	"historical_summaries": containerListField(version.Capella, func(st state.BeaconState) ([]*eth.HistoricalSummary, error) {
+		return st.HistoricalSummaries()
+	}, structs.HistoricalSummaryFromConsensus, false),
+	"deposit_requests_start_index": uint64Field(version.Electra, func(st state.BeaconState) (uint64, error) {
+		return st.DepositRequestsStartIndex()
+	}),
+	"deposit_balance_to_consume": uint64Field(version.Electra, func(st state.BeaconState) (uint64, error) {
+		b, err := st.DepositBalanceToConsume()
+		return uint64(b), err
+	}),
+	"exit_balance_to_consume": uint64Field(version.Electra, func(st state.BeaconState) (uint64, error) {
+		b, err := st.ExitBalanceToConsume()
+		return uint64(b), err
+	}),
+	"earliest_exit_epoch": uint64Field(version.Electra, func(st state.BeaconState) (uint64, error) {
+		e, err := st.EarliestExitEpoch()
+		return uint64(e), err
+	}),
+	"consolidation_balance_to_consume": uint64Field(version.Electra, func(st state.BeaconState) (uint64, error) {
+		b, err := st.ConsolidationBalanceToConsume()
+		return uint64(b), err
+	}),
+	"earliest_consolidation_epoch": uint64Field(version.Electra, func(st state.BeaconState) (uint64, error) {
+		e, err := st.EarliestConsolidationEpoch()
+		return uint64(e), err
+	}),
+	"pending_deposits": {
+		since: version.Electra,
+		json: func(st state.BeaconState) (interface{}, error) {
+			deposits, err := st.PendingDeposits()
+			if err != nil {
+				return nil, err
+			}
+			return structs.PendingDepositsFromConsensus(deposits), nil
+		},
+		ssz: func(st state.BeaconState) ([]byte, error) {
+			deposits, err := st.PendingDeposits()
+			if err != nil {
+				return nil, err
+			}
+			return sszList(deposits, false)
+		},
+	},
+	"pending_partial_withdrawals": {
+		since: version.Electra,
+		json: func(st state.BeaconState) (interface{}, error) {
+			withdrawals, err := st.PendingPartialWithdrawals()
+			if err != nil {
+				return nil, err
+			}
+			return structs.PendingPartialWithdrawalsFromConsensus(withdrawals), nil
+		},
+		ssz: func(st state.BeaconState) ([]byte, error) {
+			withdrawals, err := st.PendingPartialWithdrawals()
+			if err != nil {
+				return nil, err
+			}
+			return sszList(withdrawals, false)
+		},
+	},
+	"pending_consolidations": {
+		since: version.Electra,
+		json: func(st state.BeaconState) (interface{}, error) {
+			consolidations, err := st.PendingConsolidations()
+			if err != nil {
+				return nil, err
+			}
+			return structs.PendingConsolidationsFromConsensus(consolidations), nil
+		},
+		ssz: func(st state.BeaconState) ([]byte, error) {
+			consolidations, err := st.PendingConsolidations()
+			if err != nil {
+				return nil, err
+			}
+			return sszList(consolidations, false)
+		},
+	},
+}
+
+func latestExecutionPayloadHeaderJson(st state.BeaconState) (interface{}, error) {
+	execData, err := st.LatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+	switch h := execData.Proto().(type) {
+	case *enginev1.ExecutionPayloadHeader:
+		return structs.ExecutionPayloadHeaderFromConsensus(h)
+	case *enginev1.ExecutionPayloadHeaderCapella:
+		return structs.ExecutionPayloadHeaderCapellaFromConsensus(h)
+	case *enginev1.ExecutionPayloadHeaderDeneb:
+		return structs.ExecutionPayloadHeaderDenebFromConsensus(h)
+	default:
+		return nil, fmt.Errorf("unsupported execution payload header type %T", h)
+	}
+}
+
+type sszMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+func uint64Field(since int, get func(st state.BeaconState) (uint64, error)) *stateField {
+	return &stateField{
+		since: since,
+		json: func(st state.BeaconState) (interface{}, error) {
+			v, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			return fmt.Sprintf("%d", v), nil
+		},
+		ssz: func(st state.BeaconState) ([]byte, error) {
+			v, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			return bytesutil.Bytes8(v), nil
+		},
+	}
+}
+
+func uint64sField(since int, get func(st state.BeaconState) ([]uint64, error)) *stateField {
+	return &stateField{
+		since: since,
+		json: func(st state.BeaconState) (interface{}, error) {
+			vs, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			return convertAll(vs, func(v uint64) string { return fmt.Sprintf("%d", v) }), nil
+		},
+		ssz: func(st state.BeaconState) ([]byte, error) {
+			vs, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			b := make([]byte, 0, len(vs)*8)
+			for _, v := range vs {
+				b = append(b, bytesutil.Bytes8(v)...)
+			}
+			return b, nil
+		},
+	}
+}
+
+// rootField serves a fixed-size byte vector as a hex string.
+func rootField(since int, get func(st state.BeaconState) ([]byte, error)) *stateField {
+	return &stateField{
+		since: since,
+		json: func(st state.BeaconState) (interface{}, error) {
+			b, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			return hexutil.Encode(b), nil
+		},
+		ssz: get,
+	}
+}
+
+func rootsField(since int, get func(st state.BeaconState) ([][]byte, error)) *stateField {
+	return &stateField{
+		since: since,
+		json: func(st state.BeaconState) (interface{}, error) {
+			roots, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			return convertAll(roots, hexutil.Encode), nil
+		},
+		ssz: func(st state.BeaconState) ([]byte, error) {
+			roots, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			b := make([]byte, 0, len(roots)*32)
+			for _, r := range roots {
+				b = append(b, r...)
+			}
+			return b, nil
+		},
+	}
+}
+
+// participationField serves epoch participation flags, which appear in states from Altair onwards.
+func participationField(get func(st state.BeaconState) ([]byte, error)) *stateField {
+	return &stateField{
+		since: version.Altair,
+		json: func(st state.BeaconState) (interface{}, error) {
+			flags, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			return convertAll(flags, func(f byte) string { return fmt.Sprintf("%d", f) }), nil
+		},
+		ssz: get,
+	}
+}
+
+func containerField[T sszMarshaler, J any](since int, get func(st state.BeaconState) (T, error), toJson func(T) J) *stateField {
+	return &stateField{
+		since: since,
+		json: func(st state.BeaconState) (interface{}, error) {
+			v, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			return toJson(v), nil
+		},
+		ssz: func(st state.BeaconState) ([]byte, error) {
+			v, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			return v.MarshalSSZ()
+		},
+	}
+}
+
+func containerListField[T sszMarshaler, J any](
+	since int,
+	get func(st state.BeaconState) ([]T, error),
+	toJson func(T) J,
+	variableSize bool,
+) *stateField {
+	return &stateField{
+		since: since,
+		json: func(st state.BeaconState) (interface{}, error) {
+			items, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			return convertAll(items, toJson), nil
+		},
+		ssz: func(st state.BeaconState) ([]byte, error) {
+			items, err := get(st)
+			if err != nil {
+				return nil, err
+			}
+			return sszList(items, variableSize)
+		},
+	}
+}
+
+// sszList encodes a list of containers. Elements of variable size are preceded by their offsets.
+func sszList[T sszMarshaler](items []T, variableSize bool) ([]byte, error) {
+	encoded := make([][]byte, len(items))
+	for i, item := range items {
+		b, err := item.MarshalSSZ()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal element %d", i)
+		}
+		encoded[i] = b
+	}
+	var b []byte
+	if variableSize {
+		offset := 4 * len(items)
+		for _, e := range encoded {
+			b = append(b, bytesutil.Bytes4(uint64(offset))...)
+			offset += len(e)
+		}
+	}
+	for _, e := range encoded {
+		b = append(b, e...)
+	}
+	return b, nil
+}
+
+func convertAll[T, J any](items []T, convert func(T) J) []J {
+	converted := make([]J, len(items))
+	for i, item := range items {
+		converted[i] = convert(item)
+	}
+	return converted
+}
@@ -0,0 +1,183 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestGetStateFields(t *testing.T) {
+	phase0State, err := util.NewBeaconState()
+	require.NoError(t, err)
+	altairState, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	bellatrixState, err := util.NewBeaconStateBellatrix()
+	require.NoError(t, err)
+	capellaState, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	denebState, err := util.NewBeaconStateDeneb()
+	require.NoError(t, err)
+	electraState, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+
+	for _, st := range []state.BeaconState{phase0State, altairState, bellatrixState, capellaState, denebState, electraState} {
+		require.NoError(t, st.SetSlot(123))
+		ver := version.String(st.Version())
+		s := &Server{
+			OptimisticModeFetcher: &chainMock.ChainService{},
+			FinalizationFetcher:   &chainMock.ChainService{},
+			Stater:                &testutil.MockStater{BeaconState: st},
+		}
+		fullJson := stateJsonFields(t, st)
+		fullSsz, err := st.MarshalSSZ()
+		require.NoError(t, err)
+
+		for name, field := range stateFields {
+			t.Run(ver+" "+name, func(t *testing.T) {
+				request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/states/{state_id}/fields?fields="+name, nil)
+				request.SetPathValue("state_id", "head")
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.GetStateFields(writer, request)
+				if !field.inVersion(st.Version()) {
+					require.Equal(t, http.StatusBadRequest, writer.Code)
+					e := &httputil.DefaultJsonError{}
+					require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+					assert.StringContains(t, fmt.Sprintf("%s is not a field of %s states", name, ver), e.Message)
+					return
+				}
+				require.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, ver, writer.Header().Get(api.VersionHeader))
+				resp := &structs.GetStateFieldsResponse{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+				assert.Equal(t, ver, resp.Version)
+				require.Equal(t, 1, len(resp.Data))
+				expected, ok := fullJson[name]
+				require.Equal(t, true, ok)
+				assert.Equal(t, string(expected), string(resp.Data[name]))
+
+				request = httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/states/{state_id}/fields?fields="+name, nil)
+				request.SetPathValue("state_id", "head")
+				request.Header.Set("Accept", api.OctetStreamMediaType)
+				writer = httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.GetStateFields(writer, request)
+				require.Equal(t, http.StatusOK, writer.Code)
+				assert.Equal(t, ver, writer.Header().Get(api.VersionHeader))
+				// The encoding of every field appears as is in the encoding of the whole state.
+				assert.Equal(t, true, bytes.Contains(fullSsz, writer.Body.Bytes()))
+			})
+		}
+	}
+	t.Run("several fields", func(t *testing.T) {
+		s := &Server{
+			OptimisticModeFetcher: &chainMock.ChainService{},
+			FinalizationFetcher:   &chainMock.ChainService{},
+			Stater:                &testutil.MockStater{BeaconState: altairState},
+		}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/states/{state_id}/fields?fields=slot,fork&fields=slot&fields=inactivity_scores", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetStateFields(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetStateFieldsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 3, len(resp.Data))
+		assert.Equal(t, `"123"`, string(resp.Data["slot"]))
+		_, ok := resp.Data["fork"]
+		assert.Equal(t, true, ok)
+		_, ok = resp.Data["inactivity_scores"]
+		assert.Equal(t, true, ok)
+	})
+	t.Run("slot SSZ", func(t *testing.T) {
+		s := &Server{Stater: &testutil.MockStater{BeaconState: electraState}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/states/{state_id}/fields?fields=slot", nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetStateFields(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.DeepEqual(t, bytesutil.Bytes8(123), writer.Body.Bytes())
+	})
+	t.Run("invalid requests", func(t *testing.T) {
+		s := &Server{Stater: &testutil.MockStater{BeaconState: phase0State}}
+		tests := []struct {
+			name   string
+			query  string
+			ssz    bool
+			errMsg string
+		}{
+			{name: "no fields", query: "", errMsg: "At least one field must be requested"},
+			{name: "empty fields", query: "?fields=,", errMsg: "At least one field must be requested"},
+			{name: "unknown field", query: "?fields=slot,foo", errMsg: "Unknown state field foo"},
+			{name: "several fields as SSZ", query: "?fields=slot,fork", ssz: true, errMsg: "Exactly one field must be requested for an SSZ response"},
+			{name: "field of a later fork", query: "?fields=inactivity_scores", errMsg: "inactivity_scores is not a field of phase0 states"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/states/{state_id}/fields"+tt.query, nil)
+				request.SetPathValue("state_id", "head")
+				if tt.ssz {
+					request.Header.Set("Accept", api.OctetStreamMediaType)
+				}
+				writer := httptest.NewRecorder()
+				writer.Body = &bytes.Buffer{}
+
+				s.GetStateFields(writer, request)
+				require.Equal(t, http.StatusBadRequest, writer.Code)
+				e := &httputil.DefaultJsonError{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+				assert.StringContains(t, tt.errMsg, e.Message)
+			})
+		}
+	})
+}
+
+// stateJsonFields returns the JSON encoding of each field of the full state response.
+func stateJsonFields(t *testing.T, st state.BeaconState) map[string]json.RawMessage {
+	var full interface{}
+	var err error
+	switch st.Version() {
+	case version.Phase0:
+		full, err = structs.BeaconStateFromConsensus(st)
+	case version.Altair:
+		full, err = structs.BeaconStateAltairFromConsensus(st)
+	case version.Bellatrix:
+		full, err = structs.BeaconStateBellatrixFromConsensus(st)
+	case version.Capella:
+		full, err = structs.BeaconStateCapellaFromConsensus(st)
+	case version.Deneb:
+		full, err = structs.BeaconStateDenebFromConsensus(st)
+	case version.Electra:
+		full, err = structs.BeaconStateElectraFromConsensus(st)
+	default:
+		t.Fatalf("unsupported state version %d", st.Version())
+	}
+	require.NoError(t, err)
+	b, err := json.Marshal(full)
+	require.NoError(t, err)
+	fields := make(map[string]json.RawMessage)
+	require.NoError(t, json.Unmarshal(b, &fields))
+	return fields
+}
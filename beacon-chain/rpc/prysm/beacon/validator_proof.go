@@ -0,0 +1,222 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+const (
+	// Generalized indices of validator fields within the validator container.
+	validatorWithdrawalCredentialsIndex = uint64(9)
+	validatorEffectiveBalanceIndex      = uint64(10)
+	validatorContainerDepth             = 3
+)
+
+// GetValidatorProof is a HTTP handler that serves the GET /prysm/v1/beacon/states/{state_id}/validator_proofs/{validator_index} endpoint.
+// It returns a Merkle multiproof of the withdrawal credentials of a validator, and of its effective balance when
+// the effective_balance query parameter is set, against the root of the finalized or justified state. The response
+// also contains the header of the block whose post-state is proven, so that the proof can be tied to a block root,
+// for instance the parent beacon block root exposed to the execution layer by EIP-4788.
+//
+// Example usage:
+//
+//	GET /prysm/v1/beacon/states/finalized/validator_proofs/12345?effective_balance=true
+func (s *Server) GetValidatorProof(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetValidatorProof")
+	defer span.End()
+
+	stateID := r.PathValue("state_id")
+	if stateID != "finalized" && stateID != "justified" {
+		httputil.HandleError(w, "Validator proofs are only served for the finalized or justified state", http.StatusBadRequest)
+		return
+	}
+	_, index, ok := shared.UintFromRoute(w, r, "validator_index")
+	if !ok {
+		return
+	}
+	withEffectiveBalance := false
+	if raw := r.URL.Query().Get("effective_balance"); raw != "" {
+		var err error
+		withEffectiveBalance, err = strconv.ParseBool(raw)
+		if err != nil {
+			httputil.HandleError(w, "Invalid effective_balance query parameter: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	isOptimistic, err := helpers.IsOptimistic(ctx, []byte(stateID), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
+	if err != nil {
+		httputil.HandleError(w, "Could not check if slot's block is optimistic: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	st, err := s.Stater.State(ctx, []byte(stateID))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return
+	}
+	// The checkpoint state is advanced to the start of the epoch when the checkpoint block is older.
+	// The post-state of the checkpoint block is proven instead, as only its root is part of a block header.
+	if header := st.LatestBlockHeader(); !bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		st, err = s.Stater.StateBySlot(ctx, header.Slot)
+		if err != nil {
+			shared.WriteStateFetchError(w, err)
+			return
+		}
+	}
+	if index >= uint64(st.NumValidators()) {
+		httputil.HandleError(w, fmt.Sprintf("Validator index %d does not exist", index), http.StatusNotFound)
+		return
+	}
+	idx := primitives.ValidatorIndex(index)
+	val, err := st.ValidatorAtIndex(idx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get validator: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	gIndex, err := st.ValidatorGeneralizedIndex(ctx, idx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get generalized index of validator: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	branch, err := st.ValidatorProof(ctx, idx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get validator proof: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get state root: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	fieldIndices := []uint64{validatorWithdrawalCredentialsIndex}
+	if withEffectiveBalance {
+		fieldIndices = append(fieldIndices, validatorEffectiveBalanceIndex)
+	}
+	indices, leaves, proof, err := validatorMultiproof(val, gIndex, branch, fieldIndices)
+	if err != nil {
+		httputil.HandleError(w, "Could not build validator multiproof: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	header := st.LatestBlockHeader()
+	header.StateRoot = stateRoot[:]
+	blockRoot, err := header.HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not get block root: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	stateRootProof := blockHeaderStateRootProof(header)
+
+	data := &structs.ValidatorProof{
+		ValidatorIndex:        strconv.FormatUint(index, 10),
+		WithdrawalCredentials: hexutil.Encode(val.WithdrawalCredentials),
+		StateRoot:             hexutil.Encode(stateRoot[:]),
+		GeneralizedIndices:    make([]string, len(indices)),
+		Leaves:                make([]string, len(leaves)),
+		Proof:                 make([]string, len(proof)),
+		Header:                structs.BeaconBlockHeaderFromConsensus(header),
+		BlockRoot:             hexutil.Encode(blockRoot[:]),
+		StateRootProof:        make([]string, len(stateRootProof)),
+	}
+	if withEffectiveBalance {
+		data.EffectiveBalance = strconv.FormatUint(val.EffectiveBalance, 10)
+	}
+	for i := range indices {
+		data.GeneralizedIndices[i] = strconv.FormatUint(indices[i], 10)
+		data.Leaves[i] = hexutil.Encode(leaves[i][:])
+	}
+	for i := range proof {
+		data.Proof[i] = hexutil.Encode(proof[i][:])
+	}
+	for i := range stateRootProof {
+		data.StateRootProof[i] = hexutil.Encode(stateRootProof[i][:])
+	}
+	httputil.WriteJson(w, &structs.GetValidatorProofResponse{
+		ExecutionOptimistic: isOptimistic,
+		Finalized:           stateID == "finalized" || s.FinalizationFetcher.IsFinalized(ctx, blockRoot),
+		Data:                data,
+	})
+}
+
+// validatorMultiproof returns the generalized indices within the state of the given fields of a validator,
+// their leaves and the helper nodes of their multiproof. The helper nodes within the validator container are
+// computed from the validator, and the ones above it are taken from the branch of the validator in the state.
+func validatorMultiproof(
+	val *ethpb.Validator,
+	validatorGIndex uint64,
+	branch [][]byte,
+	fieldIndices []uint64,
+) (indices []uint64, leaves, proof [][32]byte, err error) {
+	fieldRoots, err := stateutil.ValidatorFieldRoots(val)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// layers[0] holds the validator root and layers[validatorContainerDepth] the field roots.
+	layers := make([][][32]byte, validatorContainerDepth+1)
+	layers[validatorContainerDepth] = fieldRoots
+	for d := validatorContainerDepth; d > 0; d-- {
+		layers[d-1] = make([][32]byte, len(layers[d])/2)
+		for i := range layers[d-1] {
+			layers[d-1][i] = hash.Hash(append(layers[d][2*i][:], layers[d][2*i+1][:]...))
+		}
+	}
+
+	indices = make([]uint64, len(fieldIndices))
+	leaves = make([][32]byte, len(fieldIndices))
+	for i, f := range fieldIndices {
+		indices[i] = ssz.ConcatGeneralizedIndices(validatorGIndex, f)
+		leaves[i] = fieldRoots[f-uint64(len(fieldRoots))]
+	}
+	validatorDepth := bits.Len64(validatorGIndex)
+	for _, h := range ssz.MultiproofHelperIndices(indices) {
+		depth := bits.Len64(h)
+		if depth > validatorDepth {
+			// A node of the validator container.
+			d := depth - validatorDepth
+			proof = append(proof, layers[d][h-validatorGIndex<<d])
+			continue
+		}
+		// A sibling of the validator or of one of its ancestors.
+		i := validatorDepth - depth
+		if i >= len(branch) {
+			return nil, nil, nil, fmt.Errorf("validator branch of length %d does not reach generalized index %d", len(branch), h)
+		}
+		proof = append(proof, bytesutil.ToBytes32(branch[i]))
+	}
+	return indices, leaves, proof, nil
+}
+
+// blockHeaderStateRootProof returns the Merkle branch of the state root of a block header against the block root.
+func blockHeaderStateRootProof(header *ethpb.BeaconBlockHeader) [][32]byte {
+	var slot, proposerIndex [32]byte
+	binary.LittleEndian.PutUint64(slot[:8], uint64(header.Slot))
+	binary.LittleEndian.PutUint64(proposerIndex[:8], uint64(header.ProposerIndex))
+	bodyRoot := bytesutil.ToBytes32(header.BodyRoot)
+	var zero [32]byte
+	bodyNode := hash.Hash(append(bodyRoot[:], zero[:]...))
+	zeroNode := hash.Hash(append(zero[:], zero[:]...))
+	return [][32]byte{
+		bytesutil.ToBytes32(header.ParentRoot),
+		hash.Hash(append(slot[:], proposerIndex[:]...)),
+		hash.Hash(append(bodyNode[:], zeroNode[:]...)),
+	}
+}
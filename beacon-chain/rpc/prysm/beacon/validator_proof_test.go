@@ -0,0 +1,121 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestGetValidatorProof(t *testing.T) {
+	st, _ := util.DeterministicGenesisState(t, 64)
+	val, err := st.ValidatorAtIndex(37)
+	require.NoError(t, err)
+	val.WithdrawalCredentials = hexutil.MustDecode("0x010000000000000000000000b9d7934878b5fb9610b3fe8a5e441e8fad7e293f")
+	require.NoError(t, st.UpdateValidatorAtIndex(37, val))
+
+	// The checkpoint state is advanced past the checkpoint block, whose header then has a state root.
+	advanced := st.Copy()
+	header := advanced.LatestBlockHeader()
+	header.StateRoot = []byte("state root of the checkpoint blk")
+	require.NoError(t, advanced.SetLatestBlockHeader(header))
+	require.NoError(t, advanced.SetSlot(8))
+
+	chainService := &chainMock.ChainService{
+		FinalizedCheckPoint:        &eth.Checkpoint{Root: make([]byte, 32)},
+		CurrentJustifiedCheckPoint: &eth.Checkpoint{Root: make([]byte, 32)},
+		FinalizedRoots:             make(map[[32]byte]bool),
+	}
+	server := &Server{
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		ChainInfoFetcher:      chainService,
+		Stater: &testutil.MockStater{
+			BeaconState:  advanced,
+			StatesBySlot: map[primitives.Slot]state.BeaconState{0: st},
+		},
+	}
+	testRouter := http.NewServeMux()
+	testRouter.HandleFunc("/prysm/v1/beacon/states/{state_id}/validator_proofs/{validator_index}", server.GetValidatorProof)
+	s := httptest.NewServer(testRouter)
+	defer s.Close()
+
+	t.Run("withdrawal credentials and effective balance", func(t *testing.T) {
+		resp, err := http.Get(s.URL + "/prysm/v1/beacon/states/finalized/validator_proofs/37?effective_balance=true")
+		require.NoError(t, err)
+		defer func() { require.NoError(t, resp.Body.Close()) }()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body := &structs.GetValidatorProofResponse{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(body))
+		require.Equal(t, true, body.Finalized)
+		require.Equal(t, hexutil.Encode(val.WithdrawalCredentials), body.Data.WithdrawalCredentials)
+		require.Equal(t, "32000000000", body.Data.EffectiveBalance)
+		require.Equal(t, 2, len(body.Data.Leaves))
+		require.NoError(t, beacon.VerifyValidatorProof(body.Data))
+		stateRoot, err := st.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, hexutil.Encode(stateRoot[:]), body.Data.StateRoot)
+	})
+	t.Run("withdrawal credentials", func(t *testing.T) {
+		resp, err := http.Get(s.URL + "/prysm/v1/beacon/states/justified/validator_proofs/0")
+		require.NoError(t, err)
+		defer func() { require.NoError(t, resp.Body.Close()) }()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body := &structs.GetValidatorProofResponse{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(body))
+		require.Equal(t, false, body.Finalized)
+		require.Equal(t, "", body.Data.EffectiveBalance)
+		require.Equal(t, 1, len(body.Data.Leaves))
+		require.NoError(t, beacon.VerifyValidatorProof(body.Data))
+	})
+
+	errTests := []struct {
+		name    string
+		path    string
+		code    int
+		message string
+	}{
+		{
+			name:    "unsupported state ID",
+			path:    "/prysm/v1/beacon/states/head/validator_proofs/0",
+			code:    http.StatusBadRequest,
+			message: "only served for the finalized or justified state",
+		},
+		{
+			name:    "invalid effective balance parameter",
+			path:    "/prysm/v1/beacon/states/finalized/validator_proofs/0?effective_balance=maybe",
+			code:    http.StatusBadRequest,
+			message: "Invalid effective_balance query parameter",
+		},
+		{
+			name:    "unknown validator",
+			path:    "/prysm/v1/beacon/states/finalized/validator_proofs/64",
+			code:    http.StatusNotFound,
+			message: "Validator index 64 does not exist",
+		},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Get(s.URL + tt.path)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+			require.Equal(t, tt.code, resp.StatusCode)
+			e := &httputil.DefaultJsonError{}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(e))
+			require.StringContains(t, tt.message, e.Message)
+		})
+	}
+}
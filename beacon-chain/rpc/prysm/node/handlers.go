@@ -4,15 +4,26 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	corenet "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations/kv"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/config/effective"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -129,6 +140,227 @@ func (s *Server) RemoveTrustedPeer(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetAttestationSubnetStats returns per-subnet unaggregated attestation delivery statistics for the last epoch,
+// with a per-slot breakdown, to help diagnose subnets where the node is poorly peered.
+func (s *Server) GetAttestationSubnetStats(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetAttestationSubnetStats")
+	defer span.End()
+
+	if s.SubnetAttestationStats == nil {
+		httputil.HandleError(w, "Attestation subnet stats are not being tracked", http.StatusServiceUnavailable)
+		return
+	}
+
+	ratios := s.SubnetAttestationStats.DeliveryRatios()
+	subnets := make([]uint64, 0, len(ratios))
+	for subnet := range ratios {
+		subnets = append(subnets, subnet)
+	}
+	sort.Slice(subnets, func(i, j int) bool { return subnets[i] < subnets[j] })
+	deliveryRatios := make([]*structs.SubnetDeliveryRatio, len(subnets))
+	for i, subnet := range subnets {
+		deliveryRatios[i] = &structs.SubnetDeliveryRatio{
+			Subnet: strconv.FormatUint(subnet, 10),
+			Ratio:  strconv.FormatFloat(ratios[subnet], 'f', 4, 64),
+		}
+	}
+
+	breakdown := s.SubnetAttestationStats.Breakdown(s.GenesisTimeFetcher.CurrentSlot())
+	slotStats := make([]*structs.SubnetSlotStats, len(breakdown))
+	for i, st := range breakdown {
+		slotStats[i] = &structs.SubnetSlotStats{
+			Slot:              strconv.FormatUint(uint64(st.Slot), 10),
+			Subnet:            strconv.FormatUint(st.Subnet, 10),
+			Received:          strconv.FormatUint(st.Received, 10),
+			UniqueAttesters:   strconv.FormatUint(st.UniqueAttesters, 10),
+			ExpectedAttesters: strconv.FormatUint(st.ExpectedAttesters, 10),
+			DeliveryRatio:     strconv.FormatFloat(st.DeliveryRatio(), 'f', 4, 64),
+			AggregateBits:     strconv.FormatUint(st.AggregateBits, 10),
+		}
+	}
+	httputil.WriteJson(w, &structs.GetAttestationSubnetStatsResponse{
+		Data: &structs.AttestationSubnetStats{
+			DeliveryRatios: deliveryRatios,
+			Slots:          slotStats,
+		},
+	})
+}
+
+// GetProposalAttestationSources returns, for the recent blocks proposed through this node, where the participation
+// bits of each included attestation came from: gossip aggregates by aggregator, unaggregated attestations received
+// on the subnet, attestations submitted through the API or aggregated locally. The optional slot query parameter
+// restricts the response to the blocks proposed at that slot.
+func (s *Server) GetProposalAttestationSources(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetProposalAttestationSources")
+	defer span.End()
+
+	if s.ProposalAttSources == nil {
+		httputil.HandleError(w, "Proposal attestation sources are not being tracked", http.StatusServiceUnavailable)
+		return
+	}
+	rawSlot, slot, ok := shared.UintFromQuery(w, r, "slot", false)
+	if !ok {
+		return
+	}
+
+	var proposals []*cache.ProposalAttestationSources
+	if rawSlot != "" {
+		proposals = s.ProposalAttSources.ProposalsAtSlot(primitives.Slot(slot))
+	} else {
+		proposals = s.ProposalAttSources.Proposals()
+	}
+	data := make([]*structs.ProposalAttestationSources, len(proposals))
+	for i, p := range proposals {
+		atts := make([]*structs.IncludedAttestationSources, len(p.Attestations))
+		for j, a := range p.Attestations {
+			sources := make([]*structs.AttestationSourceBits, len(a.Sources))
+			for k, src := range a.Sources {
+				sources[k] = &structs.AttestationSourceBits{
+					Source: src.Source,
+					Bits:   strconv.FormatUint(src.Bits, 10),
+				}
+				if src.Source == kv.SourceGossipAggregate.String() {
+					sources[k].AggregatorIndex = strconv.FormatUint(uint64(src.AggregatorIndex), 10)
+				}
+			}
+			atts[j] = &structs.IncludedAttestationSources{
+				Slot:             strconv.FormatUint(uint64(a.Slot), 10),
+				CommitteeIndex:   strconv.FormatUint(uint64(a.CommitteeIndex), 10),
+				Subnet:           strconv.FormatUint(a.Subnet, 10),
+				Bits:             strconv.FormatUint(a.Bits, 10),
+				UnattributedBits: strconv.FormatUint(a.UnattributedBits, 10),
+				Sources:          sources,
+			}
+		}
+		data[i] = &structs.ProposalAttestationSources{
+			Slot:          strconv.FormatUint(uint64(p.Slot), 10),
+			ProposerIndex: strconv.FormatUint(uint64(p.ProposerIndex), 10),
+			BlockRoot:     hexutil.Encode(p.BlockRoot[:]),
+			Attestations:  atts,
+		}
+	}
+	httputil.WriteJson(w, &structs.GetProposalAttestationSourcesResponse{Data: data})
+}
+
+// GetEffectiveConfig returns the complete configuration the node is running with: the full beacon chain config
+// including values overridden by flags, the network config, the feature flags and the sanitized command line flags.
+func (s *Server) GetEffectiveConfig(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetEffectiveConfig")
+	defer span.End()
+
+	flags := s.Flags
+	if flags == nil {
+		flags = make(map[string]string)
+	}
+	httputil.WriteJson(w, &structs.GetEffectiveConfigResponse{
+		Data: &structs.EffectiveConfig{
+			BeaconConfig:  effective.BeaconConfigFields(params.BeaconConfig()),
+			NetworkConfig: effective.NetworkConfigFields(params.BeaconNetworkConfig()),
+			Features:      effective.FeatureFields(features.Get()),
+			Flags:         flags,
+		},
+	})
+}
+
+// RefreshENR re-evaluates the external IP address of the node and re-signs its ENR with a bumped sequence number,
+// so that peers pick up the current record without restarting the node.
+func (s *Server) RefreshENR(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.RefreshENR")
+	defer span.End()
+
+	record, err := s.PeerManager.RebuildENR()
+	if err != nil {
+		if errors.Is(err, p2p.ErrDiscoveryNotRunning) {
+			httputil.HandleError(w, "Could not refresh ENR: "+err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		httputil.HandleError(w, "Could not refresh ENR: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	serializedEnr, err := p2p.SerializeENR(record)
+	if err != nil {
+		httputil.HandleError(w, "Could not serialize ENR: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.RefreshENRResponse{
+		Data: &structs.ENR{
+			Enr: "enr:" + serializedEnr,
+			Seq: strconv.FormatUint(record.Seq(), 10),
+		},
+	})
+}
+
+// GetPeerScores returns the scores of the peers which are connected or were disconnected since the start of the node,
+// broken down by scorer with the reasons a peer is considered bad, along with the gossipsub topic scores, the client
+// of the peer and the reason of its last disconnection, to help understand why peers are pruned.
+func (s *Server) GetPeerScores(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetPeerScores")
+	defer span.End()
+
+	peerStore := s.PeerManager.Host().Peerstore()
+	snapshots := s.PeersFetcher.Peers().ScoresSnapshot()
+	data := make([]*structs.PeerScores, len(snapshots))
+	for i, snapshot := range snapshots {
+		scores := snapshot.Scores
+		p := &structs.PeerScores{
+			PeerId:             snapshot.ID.String(),
+			State:              eth.ConnectionState(snapshot.ConnState).String(),
+			Direction:          eth.PeerDirection(snapshot.Direction).String(),
+			Client:             p2p.AgentFromPid(snapshot.ID, peerStore),
+			Score:              formatScore(scores.Score),
+			BadResponses:       strconv.Itoa(scores.BadResponses),
+			BadResponsesScore:  formatScore(scores.BadResponsesScore),
+			ProcessedBlocks:    strconv.FormatUint(scores.ProcessedBlocks, 10),
+			BlockProviderScore: formatScore(scores.BlockProviderScore),
+			PeerStatusScore:    formatScore(scores.PeerStatusScore),
+			GossipScore:        formatScore(scores.GossipScore),
+			BehaviourPenalty:   formatScore(scores.BehaviourPenalty),
+			TopicScores:        make([]*structs.TopicScore, 0, len(scores.TopicScores)),
+			BadPeerReasons:     scores.BadPeerReasons,
+			DisconnectReason:   snapshot.DisconnectReason,
+		}
+		if p.BadPeerReasons == nil {
+			p.BadPeerReasons = []string{}
+		}
+		if snapshot.Enr != nil {
+			serializedEnr, err := p2p.SerializeENR(snapshot.Enr)
+			if err != nil {
+				httputil.HandleError(w, "Could not serialize ENR: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+			p.Enr = "enr:" + serializedEnr
+		}
+		if snapshot.Address != nil {
+			p.LastSeenP2PAddress = snapshot.Address.String()
+		}
+		if agent, err := peerStore.Get(snapshot.ID, "AgentVersion"); err == nil {
+			p.AgentVersion, _ = agent.(string)
+		}
+		if scores.ChainStateValidationError != nil {
+			p.ChainStateValidationError = scores.ChainStateValidationError.Error()
+		}
+		if !snapshot.DisconnectedAt.IsZero() {
+			p.DisconnectedAt = strconv.FormatInt(snapshot.DisconnectedAt.Unix(), 10)
+		}
+		for topic, ts := range scores.TopicScores {
+			p.TopicScores = append(p.TopicScores, &structs.TopicScore{
+				Topic:                    topic,
+				TimeInMeshMs:             strconv.FormatUint(ts.TimeInMesh, 10),
+				FirstMessageDeliveries:   formatScore(float64(ts.FirstMessageDeliveries)),
+				MeshMessageDeliveries:    formatScore(float64(ts.MeshMessageDeliveries)),
+				InvalidMessageDeliveries: formatScore(float64(ts.InvalidMessageDeliveries)),
+			})
+		}
+		sort.Slice(p.TopicScores, func(i, j int) bool { return p.TopicScores[i].Topic < p.TopicScores[j].Topic })
+		data[i] = p
+	}
+	httputil.WriteJson(w, &structs.GetPeerScoresResponse{Data: data})
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', 4, 64)
+}
+
 // httpPeerInfo does the same thing as peerInfo function in node.go but returns the
 // http peer response.
 func httpPeerInfo(peerStatus *peers.Status, id peer.ID) (*structs.Peer, error) {
@@ -186,3 +418,32 @@ func httpPeerInfo(peerStatus *peers.Status, id peer.ID) (*structs.Peer, error) {
 
 	return &p, nil
 }
+
+// GetExecutionCapabilities returns the engine API methods supported by the execution client, as exchanged when
+// connecting to it. Until capabilities are exchanged, the engine API methods Prysm may call are not known to be
+// supported.
+func (s *Server) GetExecutionCapabilities(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetExecutionCapabilities")
+	defer span.End()
+
+	capabilities := s.ExecutionChainInfoFetcher.ExecutionClientCapabilities()
+	supported := make(map[string]bool, len(capabilities))
+	for _, c := range capabilities {
+		supported[c] = true
+	}
+	engineMethods := execution.EngineMethods()
+	methods := make([]*structs.EngineMethodSupport, len(engineMethods))
+	for i, m := range engineMethods {
+		methods[i] = &structs.EngineMethodSupport{Method: m, Supported: supported[m]}
+	}
+	if capabilities == nil {
+		capabilities = []string{}
+	}
+	httputil.WriteJson(w, &structs.GetExecutionCapabilitiesResponse{
+		Data: &structs.ExecutionCapabilities{
+			Exchanged:    len(supported) > 0,
+			Methods:      methods,
+			Capabilities: capabilities,
+		},
+	})
+}
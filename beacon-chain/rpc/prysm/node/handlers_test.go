@@ -15,10 +15,17 @@ import (
 	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
@@ -249,3 +256,273 @@ func TestRemoveTrustedPeer_EmptyParameter(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, writer.Code)
 	assert.Equal(t, "Could not decode peer id: failed to parse peer ID: invalid cid: cid too short", e.Message)
 }
+
+func TestGetPeerScores(t *testing.T) {
+	ids := libp2ptest.GeneratePeerIDs(3)
+	peerFetcher := &mockp2p.MockPeersProvider{}
+	peerFetcher.ClearPeers()
+	peerStatus := peerFetcher.Peers()
+	p2pMultiAddr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/13000")
+	require.NoError(t, err)
+	for _, id := range ids {
+		peerStatus.Add(nil, id, p2pMultiAddr, corenet.DirOutbound)
+	}
+	peerStatus.SetConnectionState(ids[0], peers.PeerConnected)
+	for i := 0; i < peerStatus.Scorers().BadResponsesScorer().Params().Threshold; i++ {
+		peerStatus.Scorers().BadResponsesScorer().Increment(ids[0])
+	}
+	peerStatus.Scorers().GossipScorer().SetGossipData(ids[0], 1.5, 0, map[string]*eth.TopicScoreSnapshot{
+		"/eth2/b5303f2a/beacon_block/ssz_snappy": {TimeInMesh: 1200, FirstMessageDeliveries: 3},
+	})
+	peerStatus.SetConnectionState(ids[1], peers.PeerConnected)
+	peerStatus.SetDisconnectReason(ids[1], "goodbye sent: client has too many peers")
+	peerStatus.SetConnectionState(ids[1], peers.PeerDisconnected)
+	// ids[2] was never connected.
+
+	testP2P := mockp2p.NewTestP2P(t)
+	require.NoError(t, testP2P.BHost.Peerstore().Put(ids[0], "AgentVersion", "Prysm/v5.1.0/abcdef"))
+	s := Server{PeersFetcher: peerFetcher, PeerManager: &mockp2p.MockPeerManager{BHost: testP2P.BHost}}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peers/scores", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetPeerScores(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetPeerScoresResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data))
+	byID := map[string]*structs.PeerScores{}
+	for _, p := range resp.Data {
+		byID[p.PeerId] = p
+	}
+
+	connected := byID[ids[0].String()]
+	require.NotNil(t, connected)
+	assert.Equal(t, "CONNECTED", connected.State)
+	assert.Equal(t, "OUTBOUND", connected.Direction)
+	assert.Equal(t, "/ip4/127.0.0.1/tcp/13000", connected.LastSeenP2PAddress)
+	assert.Equal(t, "prysm", connected.Client)
+	assert.Equal(t, "Prysm/v5.1.0/abcdef", connected.AgentVersion)
+	assert.Equal(t, strconv.Itoa(peerStatus.Scorers().BadResponsesScorer().Params().Threshold), connected.BadResponses)
+	assert.Equal(t, "1.5000", connected.GossipScore)
+	assert.Equal(t, 1, len(connected.BadPeerReasons))
+	require.Equal(t, 1, len(connected.TopicScores))
+	assert.Equal(t, "/eth2/b5303f2a/beacon_block/ssz_snappy", connected.TopicScores[0].Topic)
+	assert.Equal(t, "1200", connected.TopicScores[0].TimeInMeshMs)
+	assert.Equal(t, "3.0000", connected.TopicScores[0].FirstMessageDeliveries)
+	assert.Equal(t, "", connected.DisconnectedAt)
+
+	disconnected := byID[ids[1].String()]
+	require.NotNil(t, disconnected)
+	assert.Equal(t, "DISCONNECTED", disconnected.State)
+	assert.Equal(t, "unknown", disconnected.Client)
+	assert.Equal(t, "goodbye sent: client has too many peers", disconnected.DisconnectReason)
+	assert.NotEqual(t, "", disconnected.DisconnectedAt)
+	assert.Equal(t, 0, len(disconnected.BadPeerReasons))
+}
+
+func TestGetAttestationSubnetStats(t *testing.T) {
+	stats := cache.NewSubnetAttestationStats()
+	for slot := primitives.Slot(1); slot <= 4; slot++ {
+		stats.RecordUnaggregated(slot, 2, 4, 0)
+		stats.RecordUnaggregated(slot, 2, 4, 1)
+	}
+	stats.RecordAggregate(4, 2, 4, 3)
+	currentSlot := primitives.Slot(4)
+	s := Server{
+		GenesisTimeFetcher:     &mock.ChainService{Slot: &currentSlot},
+		SubnetAttestationStats: stats,
+	}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/attestation_subnet_stats", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetAttestationSubnetStats(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	resp := &structs.GetAttestationSubnetStatsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.NotNil(t, resp.Data)
+	require.Equal(t, 1, len(resp.Data.DeliveryRatios))
+	assert.Equal(t, "2", resp.Data.DeliveryRatios[0].Subnet)
+	assert.Equal(t, "0.5000", resp.Data.DeliveryRatios[0].Ratio)
+	require.Equal(t, 4, len(resp.Data.Slots))
+	last := resp.Data.Slots[3]
+	assert.Equal(t, "4", last.Slot)
+	assert.Equal(t, "2", last.Received)
+	assert.Equal(t, "2", last.UniqueAttesters)
+	assert.Equal(t, "4", last.ExpectedAttesters)
+	assert.Equal(t, "3", last.AggregateBits)
+}
+
+func TestGetAttestationSubnetStats_NotTracked(t *testing.T) {
+	s := Server{}
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/attestation_subnet_stats", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetAttestationSubnetStats(writer, request)
+	assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+}
+
+func TestGetProposalAttestationSources(t *testing.T) {
+	sources := cache.NewProposalAttestationSourcesCache()
+	sources.Add(&cache.ProposalAttestationSources{
+		Slot:          10,
+		ProposerIndex: 3,
+		BlockRoot:     [32]byte{'a'},
+		Attestations: []cache.IncludedAttestationSources{
+			{
+				Slot:             9,
+				CommitteeIndex:   1,
+				Subnet:           5,
+				Bits:             6,
+				UnattributedBits: 1,
+				Sources: []cache.AttestationSourceBits{
+					{Source: "gossip_aggregate", AggregatorIndex: 42, Bits: 4},
+					{Source: "gossip_unaggregated", Bits: 2},
+				},
+			},
+		},
+	})
+	sources.Add(&cache.ProposalAttestationSources{Slot: 11, ProposerIndex: 4})
+	s := Server{ProposalAttSources: sources}
+
+	t.Run("all proposals", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/proposal_attestation_sources", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetProposalAttestationSources(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		resp := &structs.GetProposalAttestationSourcesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "10", resp.Data[0].Slot)
+		assert.Equal(t, "11", resp.Data[1].Slot)
+	})
+	t.Run("at slot", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/proposal_attestation_sources?slot=10", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetProposalAttestationSources(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		resp := &structs.GetProposalAttestationSourcesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		p := resp.Data[0]
+		assert.Equal(t, "3", p.ProposerIndex)
+		require.Equal(t, 1, len(p.Attestations))
+		a := p.Attestations[0]
+		assert.Equal(t, "9", a.Slot)
+		assert.Equal(t, "1", a.CommitteeIndex)
+		assert.Equal(t, "5", a.Subnet)
+		assert.Equal(t, "6", a.Bits)
+		assert.Equal(t, "1", a.UnattributedBits)
+		require.Equal(t, 2, len(a.Sources))
+		assert.DeepEqual(t, &structs.AttestationSourceBits{Source: "gossip_aggregate", AggregatorIndex: "42", Bits: "4"}, a.Sources[0])
+		assert.DeepEqual(t, &structs.AttestationSourceBits{Source: "gossip_unaggregated", Bits: "2"}, a.Sources[1])
+	})
+	t.Run("invalid slot", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/proposal_attestation_sources?slot=foo", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetProposalAttestationSources(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetProposalAttestationSources_NotTracked(t *testing.T) {
+	s := Server{}
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/proposal_attestation_sources", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetProposalAttestationSources(writer, request)
+	assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+}
+
+func TestGetEffectiveConfig(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.TerminalBlockHashActivationEpoch = 1234
+	params.OverrideBeaconConfig(cfg)
+
+	s := Server{Flags: map[string]string{"blob-retention-epochs": "4096"}}
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/config", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetEffectiveConfig(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	resp := &structs.GetEffectiveConfigResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.NotNil(t, resp.Data)
+	assert.Equal(t, "1234", resp.Data.BeaconConfig["TerminalBlockHashActivationEpoch"])
+	assert.Equal(t, params.BeaconNetworkConfig().ETH2Key, resp.Data.NetworkConfig["ETH2Key"])
+	_, ok := resp.Data.Features["EnableSlasher"]
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "4096", resp.Data.Flags["blob-retention-epochs"])
+}
+
+func TestGetExecutionCapabilities(t *testing.T) {
+	t.Run("exchanged", func(t *testing.T) {
+		s := Server{ExecutionChainInfoFetcher: &testutil.MockExecutionChainInfoFetcher{
+			Capabilities: []string{execution.ForkchoiceUpdatedMethodV3, execution.NewPayloadMethodV3, "engine_otherV1"},
+		}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/execution_capabilities", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetExecutionCapabilities(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		resp := &structs.GetExecutionCapabilitiesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, true, resp.Data.Exchanged)
+		assert.Equal(t, 3, len(resp.Data.Capabilities))
+		require.Equal(t, len(execution.EngineMethods()), len(resp.Data.Methods))
+		supported := make(map[string]bool)
+		for _, m := range resp.Data.Methods {
+			supported[m.Method] = m.Supported
+		}
+		assert.Equal(t, true, supported[execution.NewPayloadMethodV3])
+		assert.Equal(t, false, supported[execution.NewPayloadMethodV4])
+		assert.Equal(t, false, supported[execution.GetBlobsV1])
+	})
+	t.Run("not exchanged", func(t *testing.T) {
+		s := Server{ExecutionChainInfoFetcher: &testutil.MockExecutionChainInfoFetcher{}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/execution_capabilities", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetExecutionCapabilities(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		resp := &structs.GetExecutionCapabilitiesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, false, resp.Data.Exchanged)
+		assert.Equal(t, 0, len(resp.Data.Capabilities))
+	})
+}
+
+func TestRefreshENR(t *testing.T) {
+	record := &enr.Record{}
+	record.SetSeq(7)
+	record.Set(enr.IPv4{7, 7, 7, 7})
+	require.NoError(t, record.SetSig(testIdentity{}, []byte{}))
+
+	s := Server{PeerManager: &mockp2p.MockPeerManager{Enr: record}}
+	request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/enr/refresh", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.RefreshENR(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	resp := &structs.RefreshENRResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.NotNil(t, resp.Data)
+	expectedEnr, err := p2p.SerializeENR(record)
+	require.NoError(t, err)
+	assert.Equal(t, "enr:"+expectedEnr, resp.Data.Enr)
+	assert.Equal(t, "7", resp.Data.Seq)
+}
@@ -0,0 +1,93 @@
+package slasher
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// IsSlashableAttestation returns the attester slashings the submitted indexed attestation would cause
+// with respect to the attestations recorded by the slasher. The attestation is not recorded.
+func (s *Server) IsSlashableAttestation(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.IsSlashableAttestation")
+	defer span.End()
+
+	var req structs.IndexedAttestation
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.Data == nil {
+		httputil.HandleError(w, "Attestation data is required", http.StatusBadRequest)
+		return
+	}
+	att, err := req.ToConsensus()
+	if err != nil {
+		httputil.HandleError(w, "Could not convert request attestation to consensus attestation: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	slashings, err := s.SlashingChecker.IsSlashableAttestation(ctx, att)
+	if err != nil {
+		httputil.HandleError(w, "Could not check if attestation is slashable: "+err.Error(), checkErrorCode(err))
+		return
+	}
+	httputil.WriteJson(w, &structs.IsSlashableAttestationResponse{
+		Data: structs.AttesterSlashingsFromConsensus(slashings),
+	})
+}
+
+// IsSlashableBlock returns the proposer slashing the submitted signed block header would cause
+// with respect to the block proposals recorded by the slasher. The block proposal is not recorded.
+func (s *Server) IsSlashableBlock(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "slasher.IsSlashableBlock")
+	defer span.End()
+
+	var req structs.SignedBeaconBlockHeader
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	header, err := req.ToConsensus()
+	if err != nil {
+		httputil.HandleError(w, "Could not convert request block header to consensus block header: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	slashing, err := s.SlashingChecker.IsSlashableBlock(ctx, header)
+	if err != nil {
+		httputil.HandleError(w, "Could not check if block is slashable: "+err.Error(), checkErrorCode(err))
+		return
+	}
+	slashings := make([]*ethpb.ProposerSlashing, 0, 1)
+	if slashing != nil {
+		slashings = append(slashings, slashing)
+	}
+	httputil.WriteJson(w, &structs.IsSlashableBlockResponse{
+		Data: structs.ProposerSlashingsFromConsensus(slashings),
+	})
+}
+
+func checkErrorCode(err error) int {
+	if errors.Is(err, slasher.ErrInvalidAttestation) || errors.Is(err, slasher.ErrInvalidBlockHeader) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,170 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockSlashingChecker struct {
+	attesterSlashings []*ethpb.AttesterSlashing
+	proposerSlashing  *ethpb.ProposerSlashing
+	err               error
+}
+
+func (m *mockSlashingChecker) IsSlashableAttestation(_ context.Context, _ *ethpb.IndexedAttestation) ([]*ethpb.AttesterSlashing, error) {
+	return m.attesterSlashings, m.err
+}
+
+func (m *mockSlashingChecker) IsSlashableBlock(_ context.Context, _ *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error) {
+	return m.proposerSlashing, m.err
+}
+
+func postRequest(t *testing.T, body interface{}) *http.Request {
+	var buf bytes.Buffer
+	if body != nil {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		_, err = buf.Write(b)
+		require.NoError(t, err)
+	}
+	return httptest.NewRequest(http.MethodPost, "http://example.com", &buf)
+}
+
+func TestIsSlashableAttestation(t *testing.T) {
+	att := util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{AttestingIndices: []uint64{1}})
+	slashing := &ethpb.AttesterSlashing{
+		Attestation_1: att,
+		Attestation_2: util.HydrateIndexedAttestation(&ethpb.IndexedAttestation{
+			AttestingIndices: []uint64{1},
+			Data:             &ethpb.AttestationData{Slot: 1},
+		}),
+	}
+	reqAtt := structs.AttesterSlashingFromConsensus(slashing).Attestation1
+
+	t.Run("slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{attesterSlashings: []*ethpb.AttesterSlashing{slashing}}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableAttestation(writer, postRequest(t, reqAtt))
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.IsSlashableAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, structs.AttesterSlashingFromConsensus(slashing), resp.Data[0])
+	})
+	t.Run("not slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableAttestation(writer, postRequest(t, reqAtt))
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.IsSlashableAttestationResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, 0, len(resp.Data))
+	})
+	t.Run("no body", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableAttestation(writer, postRequest(t, nil))
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "No data submitted", e.Message)
+	})
+	t.Run("missing data", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableAttestation(writer, postRequest(t, &structs.IndexedAttestation{AttestingIndices: []string{"1"}}))
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("invalid attestation", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{err: slasher.ErrInvalidAttestation}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableAttestation(writer, postRequest(t, reqAtt))
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("internal error", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{err: errors.New("bad")}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableAttestation(writer, postRequest(t, reqAtt))
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+	})
+}
+
+func TestIsSlashableBlock(t *testing.T) {
+	header := util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{})
+	header.Signature = bytes.Repeat([]byte{1}, 96)
+	slashing := &ethpb.ProposerSlashing{
+		Header_1: header,
+		Header_2: util.HydrateSignedBeaconHeader(&ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{StateRoot: bytes.Repeat([]byte{1}, 32)},
+			Signature: bytes.Repeat([]byte{1}, 96),
+		}),
+	}
+	reqHeader := structs.ProposerSlashingFromConsensus(slashing).SignedHeader1
+
+	t.Run("slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{proposerSlashing: slashing}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableBlock(writer, postRequest(t, reqHeader))
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.IsSlashableBlockResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.DeepEqual(t, structs.ProposerSlashingFromConsensus(slashing), resp.Data[0])
+	})
+	t.Run("not slashable", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableBlock(writer, postRequest(t, reqHeader))
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.IsSlashableBlockResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, 0, len(resp.Data))
+	})
+	t.Run("no body", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableBlock(writer, postRequest(t, nil))
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "No data submitted", e.Message)
+	})
+	t.Run("invalid block header", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{err: slasher.ErrInvalidBlockHeader}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableBlock(writer, postRequest(t, reqHeader))
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("internal error", func(t *testing.T) {
+		s := &Server{SlashingChecker: &mockSlashingChecker{err: errors.New("bad")}}
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.IsSlashableBlock(writer, postRequest(t, reqHeader))
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+	})
+}
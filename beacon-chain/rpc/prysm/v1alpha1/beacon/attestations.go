@@ -60,31 +60,7 @@ func (bs *Server) ListAttestations(
 		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
 			req.PageSize, cmd.Get().MaxRPCPageSize)
 	}
-	var blocks []interfaces.ReadOnlySignedBeaconBlock
-	var err error
-	switch q := req.QueryFilter.(type) {
-	case *ethpb.ListAttestationsRequest_GenesisEpoch:
-		blocks, _, err = bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(0).SetEndEpoch(0))
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not fetch attestations: %v", err)
-		}
-	case *ethpb.ListAttestationsRequest_Epoch:
-		if q.Epoch >= params.BeaconConfig().ElectraForkEpoch {
-			return &ethpb.ListAttestationsResponse{
-				Attestations:  make([]*ethpb.Attestation, 0),
-				TotalSize:     int32(0),
-				NextPageToken: strconv.Itoa(0),
-			}, nil
-		}
-		blocks, _, err = bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(q.Epoch).SetEndEpoch(q.Epoch))
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not fetch attestations: %v", err)
-		}
-	default:
-		return nil, status.Error(codes.InvalidArgument, "Must specify a filter criteria for fetching attestations")
-	}
-
-	atts, err := blockAttestations[*ethpb.Attestation](blocks)
+	atts, err := bs.requestedAttestations(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +87,51 @@ func (bs *Server) ListAttestations(
 	}, nil
 }
 
+// StreamAttestations sends the attestations matched by a ListAttestations request one message
+// at a time, sorted by data slot. Pagination fields of the request are ignored.
+func (bs *Server) StreamAttestations(
+	req *ethpb.ListAttestationsRequest, stream ethpb.BeaconChain_StreamAttestationsServer,
+) error {
+	atts, err := bs.requestedAttestations(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	for _, att := range atts {
+		if err := stream.Send(att); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+	}
+	return nil
+}
+
+// requestedAttestations returns the pre-Electra block attestations matched by a
+// ListAttestationsRequest, sorted by data slot.
+func (bs *Server) requestedAttestations(
+	ctx context.Context, req *ethpb.ListAttestationsRequest,
+) ([]*ethpb.Attestation, error) {
+	var blocks []interfaces.ReadOnlySignedBeaconBlock
+	var err error
+	switch q := req.QueryFilter.(type) {
+	case *ethpb.ListAttestationsRequest_GenesisEpoch:
+		blocks, _, err = bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(0).SetEndEpoch(0))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not fetch attestations: %v", err)
+		}
+	case *ethpb.ListAttestationsRequest_Epoch:
+		if q.Epoch >= params.BeaconConfig().ElectraForkEpoch {
+			return []*ethpb.Attestation{}, nil
+		}
+		blocks, _, err = bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(q.Epoch).SetEndEpoch(q.Epoch))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not fetch attestations: %v", err)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Must specify a filter criteria for fetching attestations")
+	}
+
+	return blockAttestations[*ethpb.Attestation](blocks)
+}
+
 // ListAttestationsElectra retrieves attestations by block root, slot, or epoch.
 // Attestations are sorted by data slot by default.
 //
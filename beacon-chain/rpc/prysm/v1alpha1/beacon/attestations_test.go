@@ -26,9 +26,11 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	mock2 "github.com/prysmaticlabs/prysm/v5/testing/mock"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -462,6 +464,52 @@ func TestServer_ListAttestations_Pagination_DefaultPageSize(t *testing.T) {
 	assert.DeepEqual(t, atts[i:j], res.Attestations, "Incorrect attestations response")
 }
 
+func TestServer_StreamAttestations(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	count := primitives.Slot(params.BeaconConfig().DefaultPageSize + 1)
+	atts := make([]*ethpb.Attestation, 0, count)
+	for i := primitives.Slot(0); i < count; i++ {
+		blockExample := util.NewBeaconBlock()
+		blockExample.Block.Body.Attestations = []*ethpb.Attestation{
+			{
+				Data: &ethpb.AttestationData{
+					BeaconBlockRoot: bytesutil.PadTo([]byte("root"), 32),
+					Target:          &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte("root"), 32)},
+					Source:          &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte("root"), 32)},
+					Slot:            i,
+				},
+				Signature:       bytesutil.PadTo([]byte("root"), fieldparams.BLSSignatureLength),
+				AggregationBits: bitfield.Bitlist{0b11},
+			},
+		}
+		util.SaveBlock(t, ctx, db, blockExample)
+		atts = append(atts, blockExample.Block.Body.Attestations...)
+	}
+
+	bs := &Server{
+		BeaconDB: db,
+	}
+
+	ctrl := gomock.NewController(t)
+	mockStream := mock2.NewMockBeaconChain_StreamAttestationsServer(ctrl)
+	mockStream.EXPECT().Context().Return(ctx)
+	sent := make([]*ethpb.Attestation, 0, count)
+	mockStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(att *ethpb.Attestation) error {
+		sent = append(sent, att)
+		return nil
+	}).Times(int(count))
+
+	req := &ethpb.ListAttestationsRequest{
+		QueryFilter: &ethpb.ListAttestationsRequest_GenesisEpoch{
+			GenesisEpoch: true,
+		},
+	}
+	require.NoError(t, bs.StreamAttestations(req, mockStream))
+	assert.DeepEqual(t, atts, sent, "Incorrect attestations streamed")
+}
+
 func TestServer_ListAttestationsElectra(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	cfg := params.BeaconConfig()
@@ -36,8 +36,117 @@ func (bs *Server) ListValidatorBalances(
 			req.PageSize, cmd.Get().MaxRPCPageSize)
 	}
 
+	requestedState, requestedEpoch, err := bs.validatorBalancesState(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, balancesCount, err := requestedBalances(requestedState, req, requestedEpoch)
+	if err != nil {
+		return nil, err
+	}
+	vals := requestedState.Validators()
+	balances := requestedState.Balances()
+
+	// If there are no balances, we simply return a response specifying this.
+	// Otherwise, attempting to paginate 0 balances below would result in an error.
+	if balancesCount == 0 {
+		return &ethpb.ValidatorBalances{
+			Epoch:         requestedEpoch,
+			Balances:      make([]*ethpb.ValidatorBalances_Balance, 0),
+			TotalSize:     int32(0),
+			NextPageToken: strconv.Itoa(0),
+		}, nil
+	}
+
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), balancesCount)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			"Could not paginate results: %v",
+			err,
+		)
+	}
+
+	if len(req.Indices) == 0 && len(req.PublicKeys) == 0 {
+		// Return everything.
+		for i := start; i < end; i++ {
+			pubkey := requestedState.PubkeyAtIndex(primitives.ValidatorIndex(i))
+			val := vals[i]
+			st := validatorStatus(val, requestedEpoch)
+			res = append(res, &ethpb.ValidatorBalances_Balance{
+				PublicKey: pubkey[:],
+				Index:     primitives.ValidatorIndex(i),
+				Balance:   balances[i],
+				Status:    st.String(),
+			})
+		}
+		return &ethpb.ValidatorBalances{
+			Epoch:         requestedEpoch,
+			Balances:      res,
+			TotalSize:     int32(balancesCount),
+			NextPageToken: nextPageToken,
+		}, nil
+	}
+
+	if end > len(res) || end < start {
+		return nil, status.Error(codes.OutOfRange, "Request exceeds response length")
+	}
+
+	return &ethpb.ValidatorBalances{
+		Epoch:         requestedEpoch,
+		Balances:      res[start:end],
+		TotalSize:     int32(balancesCount),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// StreamValidatorBalances sends the balances matched by a ListValidatorBalances request one
+// message at a time, in validator index order. Pagination fields of the request are ignored.
+func (bs *Server) StreamValidatorBalances(
+	req *ethpb.ListValidatorBalancesRequest,
+	stream ethpb.BeaconChain_StreamValidatorBalancesServer,
+) error {
+	requestedState, requestedEpoch, err := bs.validatorBalancesState(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+	res, _, err := requestedBalances(requestedState, req, requestedEpoch)
+	if err != nil {
+		return err
+	}
+	if len(req.Indices) == 0 && len(req.PublicKeys) == 0 {
+		vals := requestedState.Validators()
+		balances := requestedState.Balances()
+		for i := range balances {
+			pubkey := requestedState.PubkeyAtIndex(primitives.ValidatorIndex(i))
+			st := validatorStatus(vals[i], requestedEpoch)
+			if err := stream.Send(&ethpb.ValidatorBalances_Balance{
+				PublicKey: pubkey[:],
+				Index:     primitives.ValidatorIndex(i),
+				Balance:   balances[i],
+				Status:    st.String(),
+			}); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		}
+		return nil
+	}
+	for _, b := range res {
+		if err := stream.Send(b); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+	}
+	return nil
+}
+
+// validatorBalancesState returns the state at the start of the epoch requested by a
+// ListValidatorBalancesRequest, along with that epoch.
+func (bs *Server) validatorBalancesState(
+	ctx context.Context,
+	req *ethpb.ListValidatorBalancesRequest,
+) (state.BeaconState, primitives.Epoch, error) {
 	if bs.GenesisTimeFetcher == nil {
-		return nil, status.Errorf(codes.Internal, "Nil genesis time fetcher")
+		return nil, 0, status.Errorf(codes.Internal, "Nil genesis time fetcher")
 	}
 	currentEpoch := slots.ToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
 	requestedEpoch := currentEpoch
@@ -49,24 +158,33 @@ func (bs *Server) ListValidatorBalances(
 	}
 
 	if requestedEpoch > currentEpoch {
-		return nil, status.Errorf(
+		return nil, 0, status.Errorf(
 			codes.InvalidArgument,
 			errEpoch,
 			currentEpoch,
 			requestedEpoch,
 		)
 	}
-	res := make([]*ethpb.ValidatorBalances_Balance, 0)
-	filtered := map[primitives.ValidatorIndex]bool{} // Track filtered validators to prevent duplication in the response.
-
 	startSlot, err := slots.EpochStart(requestedEpoch)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	requestedState, err := bs.ReplayerBuilder.ReplayerForSlot(startSlot).ReplayBlocks(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("error replaying blocks for state at slot %d: %v", startSlot, err))
+		return nil, 0, status.Error(core.ErrorReasonToGRPC(core.ReplayErrorReason(err)), fmt.Sprintf("error replaying blocks for state at slot %d: %v", startSlot, err))
 	}
+	return requestedState, requestedEpoch, nil
+}
+
+// requestedBalances returns the balances of the validators explicitly requested by index or
+// public key, sorted by validator index, along with the number of balances the request matches.
+func requestedBalances(
+	requestedState state.BeaconState,
+	req *ethpb.ListValidatorBalancesRequest,
+	requestedEpoch primitives.Epoch,
+) ([]*ethpb.ValidatorBalances_Balance, int, error) {
+	res := make([]*ethpb.ValidatorBalances_Balance, 0)
+	filtered := map[primitives.ValidatorIndex]bool{} // Track filtered validators to prevent duplication in the response.
 
 	vals := requestedState.Validators()
 	balances := requestedState.Balances()
@@ -89,7 +207,7 @@ func (bs *Server) ListValidatorBalances(
 		filtered[index] = true
 
 		if uint64(index) >= uint64(len(balances)) {
-			return nil, status.Errorf(codes.OutOfRange, "Validator index %d >= balance list %d",
+			return nil, 0, status.Errorf(codes.OutOfRange, "Validator index %d >= balance list %d",
 				index, len(balances))
 		}
 
@@ -106,7 +224,7 @@ func (bs *Server) ListValidatorBalances(
 
 	for _, index := range req.Indices {
 		if uint64(index) >= uint64(len(balances)) {
-			return nil, status.Errorf(codes.OutOfRange, "Validator index %d >= balance list %d",
+			return nil, 0, status.Errorf(codes.OutOfRange, "Validator index %d >= balance list %d",
 				index, len(balances))
 		}
 
@@ -126,19 +244,37 @@ func (bs *Server) ListValidatorBalances(
 	sort.Slice(res, func(i, j int) bool {
 		return res[i].Index < res[j].Index
 	})
+	return res, balancesCount, nil
+}
 
-	// If there are no balances, we simply return a response specifying this.
-	// Otherwise, attempting to paginate 0 balances below would result in an error.
-	if balancesCount == 0 {
-		return &ethpb.ValidatorBalances{
-			Epoch:         requestedEpoch,
-			Balances:      make([]*ethpb.ValidatorBalances_Balance, 0),
+// ListValidators retrieves the current list of active validators with an optional historical epoch flag to
+// retrieve validator set in time.
+func (bs *Server) ListValidators(
+	ctx context.Context,
+	req *ethpb.ListValidatorsRequest,
+) (*ethpb.Validators, error) {
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, cmd.Get().MaxRPCPageSize)
+	}
+
+	res, err := bs.requestedValidators(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorCount := len(res)
+	// If there are no items, we simply return a response specifying this.
+	// Otherwise, attempting to paginate 0 validators below would result in an error.
+	if validatorCount == 0 {
+		return &ethpb.Validators{
+			ValidatorList: make([]*ethpb.Validators_ValidatorContainer, 0),
 			TotalSize:     int32(0),
 			NextPageToken: strconv.Itoa(0),
 		}, nil
 	}
 
-	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), balancesCount)
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), validatorCount)
 	if err != nil {
 		return nil, status.Errorf(
 			codes.Internal,
@@ -147,50 +283,37 @@ func (bs *Server) ListValidatorBalances(
 		)
 	}
 
-	if len(req.Indices) == 0 && len(req.PublicKeys) == 0 {
-		// Return everything.
-		for i := start; i < end; i++ {
-			pubkey := requestedState.PubkeyAtIndex(primitives.ValidatorIndex(i))
-			val := vals[i]
-			st := validatorStatus(val, requestedEpoch)
-			res = append(res, &ethpb.ValidatorBalances_Balance{
-				PublicKey: pubkey[:],
-				Index:     primitives.ValidatorIndex(i),
-				Balance:   balances[i],
-				Status:    st.String(),
-			})
-		}
-		return &ethpb.ValidatorBalances{
-			Epoch:         requestedEpoch,
-			Balances:      res,
-			TotalSize:     int32(balancesCount),
-			NextPageToken: nextPageToken,
-		}, nil
-	}
-
-	if end > len(res) || end < start {
-		return nil, status.Error(codes.OutOfRange, "Request exceeds response length")
-	}
-
-	return &ethpb.ValidatorBalances{
-		Epoch:         requestedEpoch,
-		Balances:      res[start:end],
-		TotalSize:     int32(balancesCount),
+	return &ethpb.Validators{
+		ValidatorList: res[start:end],
+		TotalSize:     int32(validatorCount),
 		NextPageToken: nextPageToken,
 	}, nil
 }
 
-// ListValidators retrieves the current list of active validators with an optional historical epoch flag to
-// retrieve validator set in time.
-func (bs *Server) ListValidators(
-	ctx context.Context,
+// StreamValidators sends the validators matched by a ListValidators request one message at a
+// time, in validator index order. Pagination fields of the request are ignored.
+func (bs *Server) StreamValidators(
 	req *ethpb.ListValidatorsRequest,
-) (*ethpb.Validators, error) {
-	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
-		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
-			req.PageSize, cmd.Get().MaxRPCPageSize)
+	stream ethpb.BeaconChain_StreamValidatorsServer,
+) error {
+	res, err := bs.requestedValidators(stream.Context(), req)
+	if err != nil {
+		return err
 	}
+	for _, v := range res {
+		if err := stream.Send(v); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+	}
+	return nil
+}
 
+// requestedValidators returns the validators matched by a ListValidatorsRequest, sorted by
+// validator index.
+func (bs *Server) requestedValidators(
+	ctx context.Context,
+	req *ethpb.ListValidatorsRequest,
+) ([]*ethpb.Validators_ValidatorContainer, error) {
 	currentEpoch := slots.ToEpoch(bs.GenesisTimeFetcher.CurrentSlot())
 	requestedEpoch := currentEpoch
 
@@ -220,7 +343,7 @@ func (bs *Server) ListValidators(
 		}
 		reqState, err = bs.ReplayerBuilder.ReplayerForSlot(s).ReplayBlocks(ctx)
 		if err != nil {
-			return nil, status.Error(codes.Internal, fmt.Sprintf("error replaying blocks for state at slot %d: %v", s, err))
+			return nil, status.Error(core.ErrorReasonToGRPC(core.ReplayErrorReason(err)), fmt.Sprintf("error replaying blocks for state at slot %d: %v", s, err))
 		}
 	} else {
 		reqState, err = bs.HeadFetcher.HeadState(ctx)
@@ -310,32 +433,7 @@ func (bs *Server) ListValidators(
 		}
 		res = filteredValidators
 	}
-
-	validatorCount := len(res)
-	// If there are no items, we simply return a response specifying this.
-	// Otherwise, attempting to paginate 0 validators below would result in an error.
-	if validatorCount == 0 {
-		return &ethpb.Validators{
-			ValidatorList: make([]*ethpb.Validators_ValidatorContainer, 0),
-			TotalSize:     int32(0),
-			NextPageToken: strconv.Itoa(0),
-		}, nil
-	}
-
-	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), validatorCount)
-	if err != nil {
-		return nil, status.Errorf(
-			codes.Internal,
-			"Could not paginate results: %v",
-			err,
-		)
-	}
-
-	return &ethpb.Validators{
-		ValidatorList: res[start:end],
-		TotalSize:     int32(validatorCount),
-		NextPageToken: nextPageToken,
-	}, nil
+	return res, nil
 }
 
 // GetValidator information from any validator in the registry by index or public key.
@@ -477,14 +575,32 @@ func (bs *Server) GetValidatorQueue(
 			exitQueueEpoch = i
 		}
 	}
+	// Post Electra, the churn is bounded by the exiting balance rather than by the number of validators, as
+	// effective balances range up to MAX_EFFECTIVE_BALANCE_ELECTRA.
+	electra := headState.Version() >= version.Electra
+	var activationExitChurnLimit uint64
+	if electra {
+		totalActiveBalance, err := helpers.TotalActiveBalance(headState)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get total active balance: %v", err)
+		}
+		activationExitChurnLimit = uint64(helpers.ActivationExitChurnLimit(primitives.Gwei(totalActiveBalance)))
+	}
 	exitQueueChurn := uint64(0)
 	for _, val := range vals {
 		if val.ExitEpoch == exitQueueEpoch {
-			exitQueueChurn++
+			if electra {
+				exitQueueChurn += val.EffectiveBalance
+			} else {
+				exitQueueChurn++
+			}
 		}
 	}
 	// Prevent churn limit from causing index out of bound issues.
 	exitChurnLimit := helpers.ValidatorExitChurnLimit(activeValidatorCount)
+	if electra {
+		exitChurnLimit = activationExitChurnLimit
+	}
 	if exitChurnLimit < exitQueueChurn {
 		// If we are above the churn limit, we simply increase the churn by one.
 		exitQueueEpoch++
@@ -512,7 +628,10 @@ func (bs *Server) GetValidatorQueue(
 	}
 
 	churnLimit := helpers.ValidatorActivationChurnLimit(activeValidatorCount)
-	if headState.Version() >= version.Deneb {
+	switch {
+	case electra:
+		churnLimit = activationExitChurnLimit
+	case headState.Version() >= version.Deneb:
 		churnLimit = helpers.ValidatorActivationChurnLimitDeneb(activeValidatorCount)
 	}
 	return &ethpb.ValidatorQueue{
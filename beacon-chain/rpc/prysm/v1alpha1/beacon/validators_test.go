@@ -35,10 +35,12 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	mock2 "github.com/prysmaticlabs/prysm/v5/testing/mock"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -194,6 +196,56 @@ func TestServer_ListValidatorBalances_DefaultResponse_NoArchive(t *testing.T) {
 	assert.DeepEqual(t, balancesResponse, res.Balances)
 }
 
+func TestServer_StreamValidatorBalances(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	_, balances, headState := setupValidators(t, beaconDB, params.BeaconConfig().DefaultPageSize+1)
+	bs := &Server{
+		GenesisTimeFetcher: &mock.ChainService{},
+		HeadFetcher: &mock.ChainService{
+			State: headState,
+		},
+		ReplayerBuilder: mockstategen.NewReplayerBuilder(mockstategen.WithMockState(headState)),
+	}
+
+	t.Run("all balances", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStream := mock2.NewMockBeaconChain_StreamValidatorBalancesServer(ctrl)
+		mockStream.EXPECT().Context().Return(ctx)
+		sent := make([]*ethpb.ValidatorBalances_Balance, 0, len(balances))
+		mockStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(b *ethpb.ValidatorBalances_Balance) error {
+			sent = append(sent, b)
+			return nil
+		}).Times(len(balances))
+
+		req := &ethpb.ListValidatorBalancesRequest{QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 0}}
+		require.NoError(t, bs.StreamValidatorBalances(req, mockStream))
+		for i, b := range sent {
+			assert.Equal(t, primitives.ValidatorIndex(i), b.Index)
+			assert.Equal(t, balances[i], b.Balance)
+		}
+	})
+	t.Run("requested indices", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockStream := mock2.NewMockBeaconChain_StreamValidatorBalancesServer(ctrl)
+		mockStream.EXPECT().Context().Return(ctx)
+		sent := make([]*ethpb.ValidatorBalances_Balance, 0)
+		mockStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(b *ethpb.ValidatorBalances_Balance) error {
+			sent = append(sent, b)
+			return nil
+		}).Times(2)
+
+		req := &ethpb.ListValidatorBalancesRequest{
+			QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 0},
+			Indices:     []primitives.ValidatorIndex{7, 3},
+		}
+		require.NoError(t, bs.StreamValidatorBalances(req, mockStream))
+		assert.Equal(t, primitives.ValidatorIndex(3), sent[0].Index)
+		assert.Equal(t, primitives.ValidatorIndex(7), sent[1].Index)
+	})
+}
+
 func TestServer_ListValidatorBalances_PaginationOutOfRange(t *testing.T) {
 	beaconDB := dbTest.SetupDB(t)
 	ctx := context.Background()
@@ -1024,6 +1076,41 @@ func TestServer_ListValidators_DefaultPageSize(t *testing.T) {
 	assert.DeepEqual(t, want[i:j], res.ValidatorList, "Incorrect respond of validators")
 }
 
+func TestServer_StreamValidators(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+
+	validators, _, headState := setupValidators(t, beaconDB, params.BeaconConfig().DefaultPageSize+1)
+	want := make([]*ethpb.Validators_ValidatorContainer, len(validators))
+	for i := 0; i < len(validators); i++ {
+		want[i] = &ethpb.Validators_ValidatorContainer{
+			Index:     primitives.ValidatorIndex(i),
+			Validator: validators[i],
+		}
+	}
+
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{
+			State: headState,
+		},
+		GenesisTimeFetcher: &mock.ChainService{
+			// We are in epoch 0.
+			Genesis: time.Now(),
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	mockStream := mock2.NewMockBeaconChain_StreamValidatorsServer(ctrl)
+	mockStream.EXPECT().Context().Return(context.Background())
+	sent := make([]*ethpb.Validators_ValidatorContainer, 0, len(want))
+	mockStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(v *ethpb.Validators_ValidatorContainer) error {
+		sent = append(sent, v)
+		return nil
+	}).Times(len(want))
+
+	require.NoError(t, bs.StreamValidators(&ethpb.ListValidatorsRequest{}, mockStream))
+	assert.DeepEqual(t, want, sent, "Incorrect validators streamed")
+}
+
 func TestServer_ListValidators_FromOldEpoch(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	params.OverrideBeaconConfig(params.BeaconConfig())
@@ -1479,6 +1566,47 @@ func TestServer_GetValidatorQueue_PendingExit(t *testing.T) {
 	assert.DeepEqual(t, wanted, res.ExitPublicKeys)
 }
 
+func TestServer_GetValidatorQueue_PendingExitElectra(t *testing.T) {
+	maxEB := params.BeaconConfig().MaxEffectiveBalanceElectra
+	withdrawableEpoch := 4 + params.BeaconConfig().MinValidatorWithdrawabilityDelay
+	validators := []*ethpb.Validator{
+		{
+			ActivationEpoch:       0,
+			ExitEpoch:             4,
+			WithdrawableEpoch:     withdrawableEpoch,
+			EffectiveBalance:      maxEB,
+			PublicKey:             pubKey(1),
+			WithdrawalCredentials: make([]byte, 32),
+		},
+		{
+			ActivationEpoch:       0,
+			ExitEpoch:             4,
+			WithdrawableEpoch:     withdrawableEpoch,
+			EffectiveBalance:      maxEB,
+			PublicKey:             pubKey(2),
+			WithdrawalCredentials: make([]byte, 32),
+		},
+	}
+	headState, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	require.NoError(t, headState.SetValidators(validators))
+	require.NoError(t, headState.SetBalances([]uint64{maxEB, maxEB}))
+	require.NoError(t, headState.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)}))
+	bs := &Server{
+		HeadFetcher: &mock.ChainService{
+			State: headState,
+		},
+	}
+	res, err := bs.GetValidatorQueue(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	// The churn limit is a balance post Electra.
+	wantChurn := helpers.ActivationExitChurnLimit(primitives.Gwei(2 * maxEB))
+	assert.Equal(t, uint64(wantChurn), res.ChurnLimit)
+	// Two validators are below the validator count churn limit, but their exiting balance exceeds the balance
+	// churn limit, which moves the exit queue to the next epoch.
+	assert.DeepEqual(t, [][]byte{pubKey(1), pubKey(2)}, res.ExitPublicKeys)
+}
+
 func TestServer_GetValidatorParticipation_CannotRequestFutureEpoch(t *testing.T) {
 	ctx := context.Background()
 	headState, err := util.NewBeaconState()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	pbrpc "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"google.golang.org/grpc/codes"
@@ -31,7 +32,7 @@ func (ds *Server) GetBeaconState(
 
 		st, err := ds.ReplayerBuilder.ReplayerForSlot(q.Slot).ReplayBlocks(ctx)
 		if err != nil {
-			return nil, status.Error(codes.Internal, fmt.Sprintf("error replaying blocks for state at slot %d: %v", q.Slot, err))
+			return nil, status.Error(core.ErrorReasonToGRPC(core.ReplayErrorReason(err)), fmt.Sprintf("error replaying blocks for state at slot %d: %v", q.Slot, err))
 		}
 
 		encoded, err := st.MarshalSSZ()
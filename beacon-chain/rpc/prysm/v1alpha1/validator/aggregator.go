@@ -36,6 +36,7 @@ func (vs *Server) SubmitAggregateSelectionProof(ctx context.Context, req *ethpb.
 		}
 	}
 	best := bestAggregate(atts, req.CommitteeIndex, indexInCommittee)
+	vs.recordAggregateStats(ctx, req.Slot, req.CommitteeIndex, best)
 	attAndProof := &ethpb.AggregateAttestationAndProof{
 		Aggregate:       best,
 		SelectionProof:  req.SlotSignature,
@@ -67,6 +68,7 @@ func (vs *Server) SubmitAggregateSelectionProofElectra(
 		}
 	}
 	best := bestAggregate(atts, req.CommitteeIndex, indexInCommittee)
+	vs.recordAggregateStats(ctx, req.Slot, req.CommitteeIndex, best)
 	attAndProof := &ethpb.AggregateAttestationAndProofElectra{
 		Aggregate:       best,
 		SelectionProof:  req.SlotSignature,
@@ -128,6 +130,31 @@ func (vs *Server) processAggregateSelection(ctx context.Context, req *ethpb.Aggr
 	return indexInCommittee, validatorIndex, nil
 }
 
+// recordAggregateStats records the participation of an aggregate we are about to hand to an aggregator,
+// so that operators can compare it against the attestations received on the subnet.
+func (vs *Server) recordAggregateStats(ctx context.Context, slot primitives.Slot, committeeIndex primitives.CommitteeIndex, agg ethpb.Att) {
+	if vs.SubnetAttestationStats == nil {
+		return
+	}
+	st, err := vs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not get head state for subnet stats")
+		return
+	}
+	valCount, err := helpers.ActiveValidatorCount(ctx, st, slots.ToEpoch(slot))
+	if err != nil {
+		log.WithError(err).Debug("Could not get active validator count for subnet stats")
+		return
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, st, slot, committeeIndex)
+	if err != nil {
+		log.WithError(err).Debug("Could not get committee for subnet stats")
+		return
+	}
+	subnet := helpers.ComputeSubnetFromCommitteeAndSlot(valCount, committeeIndex, slot)
+	vs.SubnetAttestationStats.RecordAggregate(slot, subnet, uint64(len(committee)), agg.GetAggregationBits().Count())
+}
+
 // SubmitSignedAggregateSelectionProof is called by a validator to broadcast a signed
 // aggregated and proof object.
 func (vs *Server) SubmitSignedAggregateSelectionProof(
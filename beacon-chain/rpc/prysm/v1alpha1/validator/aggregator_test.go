@@ -8,6 +8,7 @@ import (
 
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
@@ -180,6 +181,56 @@ func TestSubmitAggregateAndProof_AggregateOk(t *testing.T) {
 	}
 }
 
+func TestSubmitAggregateAndProof_RecordsSubnetStats(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.MinimalSpecConfig().Copy()
+	c.TargetAggregatorsPerCommittee = 16
+	params.OverrideBeaconConfig(c)
+
+	ctx := context.Background()
+
+	beaconState, privKeys := util.DeterministicGenesisState(t, 32)
+	att0, err := generateAtt(beaconState, 0, privKeys)
+	require.NoError(t, err)
+	att1, err := generateAtt(beaconState, 2, privKeys)
+	require.NoError(t, err)
+
+	err = beaconState.SetSlot(beaconState.Slot() + params.BeaconConfig().MinAttestationInclusionDelay)
+	require.NoError(t, err)
+
+	stats := cache.NewSubnetAttestationStats()
+	aggregatorServer := &Server{
+		HeadFetcher:            &mock.ChainService{State: beaconState},
+		SyncChecker:            &mockSync.Sync{IsSyncing: false},
+		AttPool:                attestations.NewPool(),
+		P2P:                    &mockp2p.MockBroadcaster{},
+		TimeFetcher:            &mock.ChainService{Genesis: time.Now()},
+		SubnetAttestationStats: stats,
+	}
+
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign([]byte{'B'})
+	v, err := beaconState.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	req := &ethpb.AggregateSelectionRequest{CommitteeIndex: 1, SlotSignature: sig.Marshal(), PublicKey: v.PublicKey}
+
+	require.NoError(t, aggregatorServer.AttPool.SaveAggregatedAttestation(att0))
+	require.NoError(t, aggregatorServer.AttPool.SaveAggregatedAttestation(att1))
+	res, err := aggregatorServer.SubmitAggregateSelectionProof(ctx, req)
+	require.NoError(t, err)
+
+	valCount, err := helpers.ActiveValidatorCount(ctx, beaconState, 0)
+	require.NoError(t, err)
+	committee, err := helpers.BeaconCommitteeFromState(ctx, beaconState, req.Slot, req.CommitteeIndex)
+	require.NoError(t, err)
+	breakdown := stats.Breakdown(req.Slot)
+	require.Equal(t, 1, len(breakdown))
+	assert.Equal(t, helpers.ComputeSubnetFromCommitteeAndSlot(valCount, req.CommitteeIndex, req.Slot), breakdown[0].Subnet)
+	assert.Equal(t, uint64(len(committee)), breakdown[0].ExpectedAttesters)
+	assert.Equal(t, res.AggregateAndProof.Aggregate.AggregationBits.Count(), breakdown[0].AggregateBits)
+}
+
 func TestSubmitAggregateAndProof_AggregateNotOk(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	c := params.MinimalSpecConfig().Copy()
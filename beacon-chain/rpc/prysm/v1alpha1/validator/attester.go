@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations/kv"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
@@ -55,6 +56,9 @@ func (vs *Server) ProposeAttestation(ctx context.Context, att *ethpb.Attestation
 			log.WithError(err).Error("Could not save unaggregated attestation")
 			return
 		}
+		if err := vs.AttPool.SaveAttestationProvenance(attCopy, kv.SourceAPI, 0); err != nil {
+			log.WithError(err).Debug("Could not save attestation provenance")
+		}
 	}()
 
 	return resp, nil
@@ -83,6 +87,9 @@ func (vs *Server) ProposeAttestationElectra(ctx context.Context, att *ethpb.Atte
 			log.WithError(err).Error("Could not save unaggregated attestation")
 			return
 		}
+		if err := vs.AttPool.SaveAttestationProvenance(attCopy, kv.SourceAPI, 0); err != nil {
+			log.WithError(err).Debug("Could not save attestation provenance")
+		}
 	}()
 
 	return resp, nil
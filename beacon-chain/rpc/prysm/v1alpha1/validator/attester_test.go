@@ -232,6 +232,14 @@ func TestGetAttestationData_OK(t *testing.T) {
 	if !proto.Equal(res, expectedInfo) {
 		t.Errorf("Expected attestation info to match, received %v, wanted %v", res, expectedInfo)
 	}
+	require.NotNil(t, attesterServer.CoreService.AttestationCache.Get(req.Slot, blockRoot))
+
+	// A new head in the same slot is not served from the data cached for the previous head.
+	newHeadRoot := [32]byte{'a'}
+	attesterServer.CoreService.HeadFetcher = &mock.ChainService{TargetRoot: targetRoot, Root: newHeadRoot[:], State: beaconState}
+	res, err = attesterServer.GetAttestationData(context.Background(), req)
+	require.NoError(t, err)
+	require.DeepEqual(t, newHeadRoot[:], res.BeaconBlockRoot)
 }
 
 func BenchmarkGetAttestationDataConcurrent(b *testing.B) {
@@ -98,7 +98,7 @@ func (vs *Server) GetBeaconBlock(ctx context.Context, req *ethpb.BlockRequest) (
 		builderBoostFactor = primitives.Gwei(req.BuilderBoostFactor.Value)
 	}
 
-	resp, err := vs.BuildBlockParallel(ctx, sBlk, head, req.SkipMevBoost, builderBoostFactor)
+	resp, err := vs.BuildBlockParallel(ctx, sBlk, head, req.SkipMevBoost, builderBoostFactor, builderBidThresholdsFromRequest(req))
 	log.WithFields(logrus.Fields{
 		"slot":               req.Slot,
 		"sinceSlotStartTime": time.Since(t),
@@ -178,51 +178,13 @@ func (vs *Server) getParentState(ctx context.Context, slot primitives.Slot) (sta
 	return head, parentRoot, err
 }
 
-func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.SignedBeaconBlock, head state.BeaconState, skipMevBoost bool, builderBoostFactor primitives.Gwei) (*ethpb.GenericBeaconBlock, error) {
+func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.SignedBeaconBlock, head state.BeaconState, skipMevBoost bool, builderBoostFactor primitives.Gwei, thresholds builderBidThresholds) (*ethpb.GenericBeaconBlock, error) {
 	// Build consensus fields in background
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-
-		// Set eth1 data.
-		eth1Data, err := vs.eth1DataMajorityVote(ctx, head)
-		if err != nil {
-			eth1Data = &ethpb.Eth1Data{DepositRoot: params.BeaconConfig().ZeroHash[:], BlockHash: params.BeaconConfig().ZeroHash[:]}
-			log.WithError(err).Error("Could not get eth1data")
-		}
-		sBlk.SetEth1Data(eth1Data)
-
-		// Set deposit and attestation.
-		deposits, atts, err := vs.packDepositsAndAttestations(ctx, head, sBlk.Block().Slot(), eth1Data) // TODO: split attestations and deposits
-		if err != nil {
-			sBlk.SetDeposits([]*ethpb.Deposit{})
-			if err := sBlk.SetAttestations([]ethpb.Att{}); err != nil {
-				log.WithError(err).Error("Could not set attestations on block")
-			}
-			log.WithError(err).Error("Could not pack deposits and attestations")
-		} else {
-			sBlk.SetDeposits(deposits)
-			if err := sBlk.SetAttestations(atts); err != nil {
-				log.WithError(err).Error("Could not set attestations on block")
-			}
-		}
-
-		// Set slashings.
-		validProposerSlashings, validAttSlashings := vs.getSlashings(ctx, head)
-		sBlk.SetProposerSlashings(validProposerSlashings)
-		if err := sBlk.SetAttesterSlashings(validAttSlashings); err != nil {
-			log.WithError(err).Error("Could not set attester slashings on block")
-		}
-
-		// Set exits.
-		sBlk.SetVoluntaryExits(vs.getExits(head, sBlk.Block().Slot()))
-
-		// Set sync aggregate. New in Altair.
-		vs.setSyncAggregate(ctx, sBlk)
-
-		// Set bls to execution change. New in Capella.
-		vs.setBlsToExecData(sBlk, head)
+		vs.setConsensusFields(ctx, sBlk, head)
 	}()
 
 	winningBid := primitives.ZeroWei()
@@ -240,10 +202,16 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 			if err != nil {
 				builderGetPayloadMissCount.Inc()
 				log.WithError(err).Error("Could not get builder payload")
+				// The builder bid is not waited for any longer, whatever the thresholds.
+				reason := "builder_error"
+				if errors.Is(err, context.DeadlineExceeded) {
+					reason = "builder_timeout"
+				}
+				logPayloadSource(payloadSourceLocal, reason, primitives.WeiToGwei(local.Bid), 0)
 			}
 		}
 
-		winningBid, bundle, err = setExecutionData(ctx, sBlk, local, builderBid, builderBoostFactor)
+		winningBid, bundle, err = setExecutionData(ctx, sBlk, local, builderBid, builderBoostFactor, thresholds)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not set execution data: %v", err)
 		}
@@ -260,6 +228,49 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 	return vs.constructGenericBeaconBlock(sBlk, bundle, winningBid)
 }
 
+// setConsensusFields sets everything but the execution data and the state root in the block: the eth1 data,
+// deposits, attestations, slashings, exits, sync aggregate and BLS to execution changes.
+func (vs *Server) setConsensusFields(ctx context.Context, sBlk interfaces.SignedBeaconBlock, head state.BeaconState) {
+	// Set eth1 data.
+	eth1Data, err := vs.eth1DataMajorityVote(ctx, head)
+	if err != nil {
+		eth1Data = &ethpb.Eth1Data{DepositRoot: params.BeaconConfig().ZeroHash[:], BlockHash: params.BeaconConfig().ZeroHash[:]}
+		log.WithError(err).Error("Could not get eth1data")
+	}
+	sBlk.SetEth1Data(eth1Data)
+
+	// Set deposit and attestation.
+	deposits, atts, err := vs.packDepositsAndAttestations(ctx, head, sBlk.Block().Slot(), eth1Data) // TODO: split attestations and deposits
+	if err != nil {
+		sBlk.SetDeposits([]*ethpb.Deposit{})
+		if err := sBlk.SetAttestations([]ethpb.Att{}); err != nil {
+			log.WithError(err).Error("Could not set attestations on block")
+		}
+		log.WithError(err).Error("Could not pack deposits and attestations")
+	} else {
+		sBlk.SetDeposits(deposits)
+		if err := sBlk.SetAttestations(atts); err != nil {
+			log.WithError(err).Error("Could not set attestations on block")
+		}
+	}
+
+	// Set slashings.
+	validProposerSlashings, validAttSlashings := vs.getSlashings(ctx, head)
+	sBlk.SetProposerSlashings(validProposerSlashings)
+	if err := sBlk.SetAttesterSlashings(validAttSlashings); err != nil {
+		log.WithError(err).Error("Could not set attester slashings on block")
+	}
+
+	// Set exits.
+	sBlk.SetVoluntaryExits(vs.getExits(head, sBlk.Block().Slot()))
+
+	// Set sync aggregate. New in Altair.
+	vs.setSyncAggregate(ctx, sBlk)
+
+	// Set bls to execution change. New in Capella.
+	vs.setBlsToExecData(sBlk, head)
+}
+
 // ProposeBeaconBlock handles the proposal of beacon blocks.
 func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.ProposeBeaconBlock")
@@ -295,20 +306,20 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		if err := vs.broadcastReceiveBlock(ctx, block, root); err != nil {
-			errChan <- errors.Wrap(err, "broadcast/receive block failed")
-			return
-		}
-		errChan <- nil
+		errChan <- vs.broadcastReceiveBlock(ctx, block, root)
 	}()
 
 	if err := vs.broadcastAndReceiveBlobs(ctx, sidecars, root); err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not broadcast/receive blobs: %v", err)
+		return nil, &BlockPublishError{Stage: BlockPublishStageBlobs, Err: err}
 	}
 
 	wg.Wait()
 	if err := <-errChan; err != nil {
-		return nil, status.Errorf(codes.Internal, "Could not broadcast/receive block: %v", err)
+		return nil, err
+	}
+
+	if err := vs.recordAttestationSources(ctx, block, root); err != nil {
+		log.WithError(err).Debug("Could not record attestation sources of proposed block")
 	}
 
 	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
@@ -353,20 +364,39 @@ func (vs *Server) blobSidecarsFromUnblindedBlock(block interfaces.SignedBeaconBl
 	return BuildBlobSidecars(block, rawBlobs, proofs)
 }
 
-// broadcastReceiveBlock broadcasts a block and handles its reception.
+// broadcastReceiveBlock broadcasts a block and handles its reception. It waits at most blockImportTimeout for the
+// block to be imported, the import carries on in the background past that point.
 func (vs *Server) broadcastReceiveBlock(ctx context.Context, block interfaces.SignedBeaconBlock, root [32]byte) error {
 	protoBlock, err := block.Proto()
 	if err != nil {
-		return errors.Wrap(err, "protobuf conversion failed")
+		return &BlockPublishError{Stage: BlockPublishStageBroadcast, Err: errors.Wrap(err, "protobuf conversion failed")}
 	}
 	if err := vs.P2P.Broadcast(ctx, protoBlock); err != nil {
-		return errors.Wrap(err, "broadcast failed")
+		return &BlockPublishError{Stage: BlockPublishStageBroadcast, Err: err}
 	}
 	vs.BlockNotifier.BlockFeed().Send(&feed.Event{
 		Type: blockfeed.ReceivedBlock,
 		Data: &blockfeed.ReceivedBlockData{SignedBlock: block},
 	})
-	return vs.BlockReceiver.ReceiveBlock(ctx, block, root, nil)
+
+	// The block is out on the network, its import must not be interrupted when the caller goes away.
+	importCtx := context.WithoutCancel(ctx)
+	importErr := make(chan error, 1)
+	go func() {
+		importErr <- vs.BlockReceiver.ReceiveBlock(importCtx, block, root, nil)
+	}()
+	timer := time.NewTimer(blockImportTimeout())
+	defer timer.Stop()
+	select {
+	case err := <-importErr:
+		if err != nil {
+			return &BlockPublishError{Stage: BlockPublishStageImport, Broadcast: true, Err: err}
+		}
+		return nil
+	case <-timer.C:
+		log.WithField("blockRoot", fmt.Sprintf("%#x", root)).Warn("Proposed block was broadcast but is still being imported")
+		return &BlockPublishError{Stage: BlockPublishStageImport, Broadcast: true, Err: errBlockImportTimeout}
+	}
 }
 
 // broadcastAndReceiveBlobs handles the broadcasting and reception of blob sidecars.
@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// committeeAttestation is the part of an included attestation covering a single committee.
+type committeeAttestation struct {
+	committeeIndex primitives.CommitteeIndex
+	att            ethpb.Att
+}
+
+// recordAttestationSources records, for each attestation included in a block proposed through this node,
+// the pool entries its participation bits came from, as tagged by the attestation pool when they were saved.
+func (vs *Server) recordAttestationSources(ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock, root [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.recordAttestationSources")
+	defer span.End()
+
+	if vs.ProposalAttSources == nil {
+		return nil
+	}
+	st, err := vs.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+
+	b := blk.Block()
+	record := &cache.ProposalAttestationSources{
+		Slot:          b.Slot(),
+		ProposerIndex: b.ProposerIndex(),
+		BlockRoot:     root,
+	}
+	for _, att := range b.Body().Attestations() {
+		committeeAtts, err := splitAttestationByCommittee(ctx, st, att)
+		if err != nil {
+			return err
+		}
+		activeValCount, err := helpers.ActiveValidatorCount(ctx, st, slots.ToEpoch(att.GetData().Slot))
+		if err != nil {
+			return errors.Wrap(err, "could not get active validator count")
+		}
+		for _, ca := range committeeAtts {
+			sources, unattributed, err := vs.AttPool.AttestationSources(ca.att)
+			if err != nil {
+				return errors.Wrap(err, "could not get attestation sources")
+			}
+			included := cache.IncludedAttestationSources{
+				Slot:             att.GetData().Slot,
+				CommitteeIndex:   ca.committeeIndex,
+				Subnet:           helpers.ComputeSubnetFromCommitteeAndSlot(activeValCount, ca.committeeIndex, att.GetData().Slot),
+				Bits:             ca.att.GetAggregationBits().Count(),
+				UnattributedBits: unattributed,
+				Sources:          make([]cache.AttestationSourceBits, len(sources)),
+			}
+			for i, s := range sources {
+				included.Sources[i] = cache.AttestationSourceBits{
+					Source:          s.Source.String(),
+					AggregatorIndex: s.AggregatorIndex,
+					Bits:            s.Bits,
+				}
+			}
+			record.Attestations = append(record.Attestations, included)
+		}
+	}
+	vs.ProposalAttSources.Add(record)
+	return nil
+}
+
+// splitAttestationByCommittee splits an on-chain aggregate into the single committee attestations the
+// attestation pool holds. Attestations from before Electra cover a single committee and are returned as is.
+func splitAttestationByCommittee(ctx context.Context, st state.ReadOnlyBeaconState, att ethpb.Att) ([]committeeAttestation, error) {
+	if att.Version() < version.Electra {
+		return []committeeAttestation{{committeeIndex: att.GetData().CommitteeIndex, att: att}}, nil
+	}
+
+	bits := att.GetAggregationBits()
+	committeeIndices := helpers.CommitteeIndices(att.CommitteeBitsVal())
+	result := make([]committeeAttestation, 0, len(committeeIndices))
+	offset := uint64(0)
+	for _, ci := range committeeIndices {
+		committee, err := helpers.BeaconCommitteeFromState(ctx, st, att.GetData().Slot, ci)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get committee %d at slot %d", ci, att.GetData().Slot)
+		}
+		size := uint64(len(committee))
+		if offset+size > bits.Len() {
+			return nil, errors.Errorf("aggregation bits length %d is too short for committee %d", bits.Len(), ci)
+		}
+		committeeBits := bitfield.NewBitlist(size)
+		for i := uint64(0); i < size; i++ {
+			committeeBits.SetBitAt(i, bits.BitAt(offset+i))
+		}
+		cb := primitives.NewAttestationCommitteeBits()
+		cb.SetBitAt(uint64(ci), true)
+		result = append(result, committeeAttestation{
+			committeeIndex: ci,
+			att: &ethpb.AttestationElectra{
+				AggregationBits: committeeBits,
+				Data:            att.GetData(),
+				CommitteeBits:   cb,
+				Signature:       att.GetSignature(),
+			},
+		})
+		offset += size
+	}
+	return result, nil
+}
@@ -0,0 +1,96 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations/kv"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_recordAttestationSources(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisState(t, 64)
+	pool := attestations.NewPool()
+
+	included := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b10111}})
+	fromAggregate := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b10011}})
+	require.NoError(t, pool.SaveAttestationProvenance(fromAggregate, kv.SourceGossipAggregate, 7))
+	fromSubnet := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b10010}})
+	require.NoError(t, pool.SaveAttestationProvenance(fromSubnet, kv.SourceGossipUnaggregated, 0))
+
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 1
+	blk.Block.ProposerIndex = 5
+	blk.Block.Body.Attestations = []*ethpb.Attestation{included}
+	signed, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+
+	sources := cache.NewProposalAttestationSourcesCache()
+	vs := &Server{
+		HeadFetcher:        &mock.ChainService{State: st},
+		AttPool:            pool,
+		ProposalAttSources: sources,
+	}
+	require.NoError(t, vs.recordAttestationSources(ctx, signed, [32]byte{'r'}))
+
+	proposals := sources.ProposalsAtSlot(1)
+	require.Equal(t, 1, len(proposals))
+	p := proposals[0]
+	assert.Equal(t, primitives.ValidatorIndex(5), p.ProposerIndex)
+	assert.Equal(t, [32]byte{'r'}, p.BlockRoot)
+	require.Equal(t, 1, len(p.Attestations))
+	activeValCount, err := helpers.ActiveValidatorCount(ctx, st, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, cache.IncludedAttestationSources{
+		Subnet:           helpers.ComputeSubnetFromCommitteeAndSlot(activeValCount, 0, 0),
+		Bits:             3,
+		UnattributedBits: 1,
+		Sources: []cache.AttestationSourceBits{
+			{Source: "gossip_aggregate", AggregatorIndex: 7, Bits: 2},
+			{Source: "gossip_unaggregated", Bits: 1},
+		},
+	}, p.Attestations[0])
+}
+
+func TestSplitAttestationByCommittee_Electra(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateElectra(t, 256)
+
+	committee0, err := helpers.BeaconCommitteeFromState(ctx, st, 0, 0)
+	require.NoError(t, err)
+	committee1, err := helpers.BeaconCommitteeFromState(ctx, st, 0, 1)
+	require.NoError(t, err)
+	size0, size1 := uint64(len(committee0)), uint64(len(committee1))
+
+	bits := bitfield.NewBitlist(size0 + size1)
+	bits.SetBitAt(0, true)
+	bits.SetBitAt(size0, true)
+	bits.SetBitAt(size0+1, true)
+	cb := primitives.NewAttestationCommitteeBits()
+	cb.SetBitAt(0, true)
+	cb.SetBitAt(1, true)
+	att := util.HydrateAttestationElectra(&ethpb.AttestationElectra{AggregationBits: bits, CommitteeBits: cb})
+
+	split, err := splitAttestationByCommittee(ctx, st, att)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(split))
+	assert.Equal(t, primitives.CommitteeIndex(0), split[0].committeeIndex)
+	assert.Equal(t, size0, split[0].att.GetAggregationBits().Len())
+	assert.DeepEqual(t, []int{0}, split[0].att.GetAggregationBits().BitIndices())
+	assert.DeepEqual(t, []int{0}, split[0].att.CommitteeBitsVal().BitIndices())
+	assert.Equal(t, primitives.CommitteeIndex(1), split[1].committeeIndex)
+	assert.Equal(t, size1, split[1].att.GetAggregationBits().Len())
+	assert.DeepEqual(t, []int{0, 1}, split[1].att.GetAggregationBits().BitIndices())
+	assert.DeepEqual(t, []int{1}, split[1].att.CommitteeBitsVal().BitIndices())
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/forks"
 	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
@@ -42,8 +43,45 @@ var (
 		Name: "builder_get_payload_miss_count",
 		Help: "The number of get payload misses for validator requests to builder",
 	})
+	payloadSourceCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "proposer_payload_source_total",
+		Help: "The number of proposals using the builder or the local execution payload, by reason of the choice",
+	}, []string{"source", "reason"})
 )
 
+const (
+	payloadSourceBuilder = "builder"
+	payloadSourceLocal   = "local"
+)
+
+// builderBidThresholds are the minimum values, requested by the proposer, the builder bid must attain for the builder
+// payload to be used. They are ignored when 0.
+type builderBidThresholds struct {
+	// minBid is the minimum value of the builder bid. The --min-builder-bid value of the node applies when higher.
+	minBid primitives.Gwei
+	// minRatio is the minimum ratio of the builder bid value to the local payload value. It is ignored when the
+	// execution client does not return the value of the local payload.
+	minRatio float64
+}
+
+func builderBidThresholdsFromRequest(req *ethpb.BlockRequest) builderBidThresholds {
+	return builderBidThresholds{
+		minBid:   primitives.Gwei(req.MinBuilderBid),
+		minRatio: req.MinBuilderBidToLocalRatio,
+	}
+}
+
+// logPayloadSource logs and counts the choice of the execution payload of the proposal.
+func logPayloadSource(source, reason string, localValueGwei, builderValueGwei primitives.Gwei) {
+	payloadSourceCount.WithLabelValues(source, reason).Inc()
+	log.WithFields(logrus.Fields{
+		"source":           source,
+		"reason":           reason,
+		"localGweiValue":   localValueGwei,
+		"builderGweiValue": builderValueGwei,
+	}).Info("Proposer: selected execution payload")
+}
+
 // emptyTransactionsRoot represents the returned value of ssz.TransactionsRoot([][]byte{}) and
 // can be used as a constant to avoid recomputing this value in every call.
 var emptyTransactionsRoot = [32]byte{127, 254, 36, 30, 166, 1, 135, 253, 176, 24, 123, 250, 34, 222, 53, 209, 249, 190, 215, 171, 6, 29, 148, 1, 253, 71, 227, 74, 84, 251, 237, 225}
@@ -53,7 +91,7 @@ var emptyTransactionsRoot = [32]byte{127, 254, 36, 30, 166, 1, 135, 253, 176, 24
 const blockBuilderTimeout = 1 * time.Second
 
 // Sets the execution data for the block. Execution data can come from local EL client or remote builder depends on validator registration and circuit breaker conditions.
-func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, local *blocks.GetPayloadResponse, bid builder.Bid, builderBoostFactor primitives.Gwei) (primitives.Wei, *enginev1.BlobsBundle, error) {
+func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, local *blocks.GetPayloadResponse, bid builder.Bid, builderBoostFactor primitives.Gwei, thresholds builderBidThresholds) (primitives.Wei, *enginev1.BlobsBundle, error) {
 	_, span := trace.StartSpan(ctx, "ProposerServer.setExecutionData")
 	defer span.End()
 
@@ -87,23 +125,28 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 
 	switch {
 	case blk.Version() >= version.Capella:
+		// Compare payload values between local and builder. Default to the local value if it is higher.
+		localValueGwei := primitives.WeiToGwei(local.Bid)
+		builderValueGwei := primitives.WeiToGwei(bid.Value())
+		builderValueGweiGauge.Set(float64(builderValueGwei))
+		localValueGweiGauge.Set(float64(localValueGwei))
+
 		withdrawalsMatched, err := matchingWithdrawalsRoot(local.ExecutionData, builderPayload)
 		if err != nil {
 			tracing.AnnotateError(span, err)
 			log.WithError(err).Warn("Proposer: failed to match withdrawals root")
+			logPayloadSource(payloadSourceLocal, "withdrawals_mismatch", localValueGwei, builderValueGwei)
 			return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 		}
 
-		// Compare payload values between local and builder. Default to the local value if it is higher.
-		localValueGwei := primitives.WeiToGwei(local.Bid)
-		builderValueGwei := primitives.WeiToGwei(bid.Value())
-		minBid := primitives.Gwei(params.BeaconConfig().MinBuilderBid)
+		minBid := max(primitives.Gwei(params.BeaconConfig().MinBuilderBid), thresholds.minBid)
 		// Use local block if min bid is not attained
 		if builderValueGwei < minBid {
 			log.WithFields(logrus.Fields{
 				"minBuilderBid":    minBid,
 				"builderGweiValue": builderValueGwei,
 			}).Warn("Proposer: using local execution payload because min bid not attained")
+			logPayloadSource(payloadSourceLocal, "min_bid", localValueGwei, builderValueGwei)
 			return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 		}
 
@@ -115,9 +158,27 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 				"minBidDiff":       minDiff,
 				"builderGweiValue": builderValueGwei,
 			}).Warn("Proposer: using local execution payload because min difference with local value was not attained")
+			logPayloadSource(payloadSourceLocal, "min_difference", localValueGwei, builderValueGwei)
 			return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 		}
 
+		// Use local block if the min ratio to the local value is not attained. Execution clients predating
+		// engine_getPayloadV2 do not return the value of the local payload, the ratio cannot be checked then.
+		if thresholds.minRatio > 0 {
+			if localValueGwei == 0 {
+				log.WithField("minBidToLocalRatio", thresholds.minRatio).
+					Debug("Proposer: local payload value is unknown, not checking the min ratio of the builder bid")
+			} else if float64(builderValueGwei) < thresholds.minRatio*float64(localValueGwei) {
+				log.WithFields(logrus.Fields{
+					"localGweiValue":     localValueGwei,
+					"minBidToLocalRatio": thresholds.minRatio,
+					"builderGweiValue":   builderValueGwei,
+				}).Warn("Proposer: using local execution payload because min ratio to local value was not attained")
+				logPayloadSource(payloadSourceLocal, "min_ratio", localValueGwei, builderValueGwei)
+				return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
+			}
+		}
+
 		// Use builder payload if the following in true:
 		// builder_bid_value * builderBoostFactor(default 100) > local_block_value * (local-block-value-boost + 100)
 		boost := primitives.Gwei(params.BeaconConfig().LocalBlockValueBoost)
@@ -130,18 +191,23 @@ func setExecutionData(ctx context.Context, blk interfaces.SignedBeaconBlock, loc
 				"builderBoostFactor":   builderBoostFactor,
 			}).Warn("Proposer: both local boost and builder boost are using non default values")
 		}
-		builderValueGweiGauge.Set(float64(builderValueGwei))
-		localValueGweiGauge.Set(float64(localValueGwei))
 
 		// If we can't get the builder value, just use local block.
 		if higherValueBuilder && withdrawalsMatched { // Builder value is higher and withdrawals match.
 			if err := setBuilderExecution(blk, builderPayload, builderKzgCommitments); err != nil {
 				log.WithError(err).Warn("Proposer: failed to set builder payload")
+				logPayloadSource(payloadSourceLocal, "invalid_builder_payload", localValueGwei, builderValueGwei)
 				return local.Bid, local.BlobsBundle, setLocalExecution(blk, local)
 			} else {
+				logPayloadSource(payloadSourceBuilder, "higher_value", localValueGwei, builderValueGwei)
 				return bid.Value(), nil, nil
 			}
 		}
+		if !withdrawalsMatched {
+			logPayloadSource(payloadSourceLocal, "withdrawals_mismatch", localValueGwei, builderValueGwei)
+		} else {
+			logPayloadSource(payloadSourceLocal, "higher_value", localValueGwei, builderValueGwei)
+		}
 		if !higherValueBuilder {
 			log.WithFields(logrus.Fields{
 				"localGweiValue":       localValueGwei,
@@ -104,7 +104,7 @@ func TestServer_setExecutionData(t *testing.T) {
 		builderBid, err := vs.getBuilderPayloadAndBlobs(ctx, b.Slot(), b.ProposerIndex())
 		require.NoError(t, err)
 		require.IsNil(t, builderBid)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, builderBidThresholds{})
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -173,7 +173,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, builderBidThresholds{})
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -245,7 +245,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, builderBidThresholds{})
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -316,7 +316,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, math.MaxUint64)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, math.MaxUint64, builderBidThresholds{})
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -387,7 +387,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, 0)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, 0, builderBidThresholds{})
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -413,7 +413,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, builderBidThresholds{})
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -445,7 +445,7 @@ func TestServer_setExecutionData(t *testing.T) {
 			require.NoError(t, err)
 		}
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, builderBidThresholds{})
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -456,6 +456,66 @@ func TestServer_setExecutionData(t *testing.T) {
 		cfg.MinBuilderBid = 0
 		params.OverrideBeaconConfig(cfg)
 	})
+	t.Run("Builder configured. Builder block does not achieve min bid of the proposer", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		ed, err := blocks.NewWrappedExecutionData(&v1.ExecutionPayloadCapella{BlockNumber: 3})
+		require.NoError(t, err)
+		vs.ExecutionEngineCaller = &powtesting.EngineClient{PayloadIDBytes: id, GetPayloadResponse: &blocks.GetPayloadResponse{ExecutionData: ed}}
+		b := blk.Block()
+		res, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		require.NoError(t, err)
+		builderBid, err := vs.getBuilderPayloadAndBlobs(ctx, b.Slot(), b.ProposerIndex())
+		require.NoError(t, err)
+		_, _, err = setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, builderBidThresholds{minBid: 4})
+		require.NoError(t, err)
+		e, err := blk.Block().Body().Execution()
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), e.BlockNumber()) // Local block
+
+		require.LogsContain(t, hook, "\"Proposer: using local execution payload because min bid not attained\" builderGweiValue=1 minBuilderBid=4")
+		require.LogsContain(t, hook, "reason=min_bid source=local")
+	})
+	t.Run("Builder configured. Builder block does not achieve min ratio to local value", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+		require.NoError(t, err)
+		ed, err := blocks.NewWrappedExecutionData(&v1.ExecutionPayloadCapella{BlockNumber: 3})
+		require.NoError(t, err)
+		elBid := primitives.Uint64ToWei(1e9)
+		vs.ExecutionEngineCaller = &powtesting.EngineClient{PayloadIDBytes: id, GetPayloadResponse: &blocks.GetPayloadResponse{ExecutionData: ed, Bid: elBid}}
+		b := blk.Block()
+		res, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		require.NoError(t, err)
+		builderBid, err := vs.getBuilderPayloadAndBlobs(ctx, b.Slot(), b.ProposerIndex())
+		require.NoError(t, err)
+		_, _, err = setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, builderBidThresholds{minRatio: 1.5})
+		require.NoError(t, err)
+		e, err := blk.Block().Body().Execution()
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), e.BlockNumber()) // Local block
+
+		require.LogsContain(t, hook, "\"Proposer: using local execution payload because min ratio to local value was not attained\" builderGweiValue=1 localGweiValue=1 minBidToLocalRatio=1.5")
+		require.LogsContain(t, hook, "reason=min_ratio source=local")
+	})
+	t.Run("Builder configured. Min ratio is not checked without local value", func(t *testing.T) {
+		blk, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockCapella())
+		require.NoError(t, err)
+		ed, err := blocks.NewWrappedExecutionData(&v1.ExecutionPayloadCapella{BlockNumber: 3, Withdrawals: withdrawals})
+		require.NoError(t, err)
+		vs.ExecutionEngineCaller = &powtesting.EngineClient{PayloadIDBytes: id, GetPayloadResponse: &blocks.GetPayloadResponse{ExecutionData: ed}}
+		b := blk.Block()
+		res, err := vs.getLocalPayload(ctx, b, capellaTransitionState)
+		require.NoError(t, err)
+		builderBid, err := vs.getBuilderPayloadAndBlobs(ctx, b.Slot(), b.ProposerIndex())
+		require.NoError(t, err)
+		_, _, err = setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, builderBidThresholds{minRatio: 1.5})
+		require.NoError(t, err)
+		e, err := blk.Block().Body().Execution()
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), e.BlockNumber()) // Builder block
+
+		require.LogsContain(t, hook, "reason=higher_value source=builder")
+	})
 	t.Run("Builder configured. Local block and local boost has higher value", func(t *testing.T) {
 		cfg := params.BeaconConfig().Copy()
 		cfg.LocalBlockValueBoost = 1 // Boost 1%.
@@ -480,7 +540,7 @@ func TestServer_setExecutionData(t *testing.T) {
 		_, err = builderBid.Header()
 		require.NoError(t, err)
 		require.DeepEqual(t, [][]uint8{}, builderKzgCommitments)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, builderBidThresholds{})
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -506,7 +566,7 @@ func TestServer_setExecutionData(t *testing.T) {
 		builderBid, err := vs.getBuilderPayloadAndBlobs(ctx, b.Slot(), b.ProposerIndex())
 		require.ErrorIs(t, consensus_types.ErrNilObjectWrapped, err) // Builder returns fault. Use local block
 		require.IsNil(t, builderBid)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, nil, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, nil, defaultBuilderBoostFactor, builderBidThresholds{})
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 		e, err := blk.Block().Body().Execution()
@@ -630,7 +690,7 @@ func TestServer_setExecutionData(t *testing.T) {
 
 		res, err := vs.getLocalPayload(ctx, blk.Block(), denebTransitionState)
 		require.NoError(t, err)
-		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor)
+		_, bundle, err := setExecutionData(context.Background(), blk, res, builderBid, defaultBuilderBoostFactor, builderBidThresholds{})
 		require.NoError(t, err)
 		require.IsNil(t, bundle)
 
@@ -24,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
 )
@@ -39,6 +40,12 @@ var (
 		Name: "payload_id_cache_hit",
 		Help: "The number of payload id get requests that are present in the cache.",
 	})
+	// getPayloadSinceSlotStart tracks how far into the proposal slot the execution client is asked for the payload.
+	getPayloadSinceSlotStart = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "get_payload_since_slot_start_milliseconds",
+		Help:    "Captures the time from the start of the proposal slot to the get payload call to the execution client.",
+		Buckets: []float64{10, 50, 100, 200, 400, 800, 1600, 3200},
+	})
 )
 
 func setFeeRecipientIfBurnAddress(val *cache.TrackedValidator) {
@@ -90,6 +97,7 @@ func (vs *Server) getLocalPayloadFromEngine(
 		var pid primitives.PayloadID
 		copy(pid[:], payloadId[:])
 		payloadIDCacheHit.Inc()
+		observeGetPayloadSinceSlotStart(st, slot)
 		res, err := vs.ExecutionEngineCaller.GetPayload(ctx, pid, slot)
 		if err == nil {
 			warnIfFeeRecipientDiffers(val.FeeRecipient[:], res.ExecutionData.FeeRecipient())
@@ -112,6 +120,30 @@ func (vs *Server) getLocalPayloadFromEngine(
 	}
 	payloadIDCacheMiss.Inc()
 
+	payloadID, err := vs.requestPayloadBuild(ctx, st, parentRoot, parentHash, slot, val.FeeRecipient[:])
+	if err != nil {
+		return nil, err
+	}
+	observeGetPayloadSinceSlotStart(st, slot)
+	res, err := vs.ExecutionEngineCaller.GetPayload(ctx, *payloadID, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	warnIfFeeRecipientDiffers(val.FeeRecipient[:], res.ExecutionData.FeeRecipient())
+	log.WithField("value", res.Bid).Debug("received execution payload from local engine")
+	return res, nil
+}
+
+// requestPayloadBuild asks the execution client to start building a payload for the slot on top of the block with
+// the given hash, paying the fees to the given fee recipient, and returns the ID of the payload being built.
+func (vs *Server) requestPayloadBuild(
+	ctx context.Context,
+	st state.BeaconState,
+	parentRoot [32]byte,
+	parentHash []byte,
+	slot primitives.Slot,
+	feeRecipient []byte) (*enginev1.PayloadIDBytes, error) {
 	random, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
 	if err != nil {
 		return nil, err
@@ -145,7 +177,7 @@ func (vs *Server) getLocalPayloadFromEngine(
 		attr, err = payloadattribute.New(&enginev1.PayloadAttributesV3{
 			Timestamp:             uint64(t.Unix()),
 			PrevRandao:            random,
-			SuggestedFeeRecipient: val.FeeRecipient[:],
+			SuggestedFeeRecipient: feeRecipient,
 			Withdrawals:           withdrawals,
 			ParentBeaconBlockRoot: parentRoot[:],
 		})
@@ -160,7 +192,7 @@ func (vs *Server) getLocalPayloadFromEngine(
 		attr, err = payloadattribute.New(&enginev1.PayloadAttributesV2{
 			Timestamp:             uint64(t.Unix()),
 			PrevRandao:            random,
-			SuggestedFeeRecipient: val.FeeRecipient[:],
+			SuggestedFeeRecipient: feeRecipient,
 			Withdrawals:           withdrawals,
 		})
 		if err != nil {
@@ -170,7 +202,7 @@ func (vs *Server) getLocalPayloadFromEngine(
 		attr, err = payloadattribute.New(&enginev1.PayloadAttributes{
 			Timestamp:             uint64(t.Unix()),
 			PrevRandao:            random,
-			SuggestedFeeRecipient: val.FeeRecipient[:],
+			SuggestedFeeRecipient: feeRecipient,
 		})
 		if err != nil {
 			return nil, err
@@ -185,14 +217,16 @@ func (vs *Server) getLocalPayloadFromEngine(
 	if payloadID == nil {
 		return nil, fmt.Errorf("nil payload with block hash: %#x", parentHash)
 	}
-	res, err := vs.ExecutionEngineCaller.GetPayload(ctx, *payloadID, slot)
+	return payloadID, nil
+}
+
+// observeGetPayloadSinceSlotStart records how long after the start of the slot the payload is requested.
+func observeGetPayloadSinceSlotStart(st state.ReadOnlyBeaconState, slot primitives.Slot) {
+	start, err := slots.ToTime(st.GenesisTime(), slot)
 	if err != nil {
-		return nil, err
+		return
 	}
-
-	warnIfFeeRecipientDiffers(val.FeeRecipient[:], res.ExecutionData.FeeRecipient())
-	log.WithField("value", res.Bid).Debug("received execution payload from local engine")
-	return res, nil
+	getPayloadSinceSlotStart.Observe(float64(prysmTime.Since(start).Milliseconds()))
 }
 
 // warnIfFeeRecipientDiffers logs a warning if the fee recipient in the payload (eg the EL engine get payload response) does not
@@ -0,0 +1,61 @@
+package validator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlockPublishStage names the stage of the pipeline publishing a proposed block.
+type BlockPublishStage string
+
+const (
+	// BlockPublishStageBroadcast is the gossip broadcast of the block.
+	BlockPublishStageBroadcast BlockPublishStage = "broadcast"
+	// BlockPublishStageBlobs is the broadcast and reception of the blob sidecars of the block.
+	BlockPublishStageBlobs BlockPublishStage = "blobs"
+	// BlockPublishStageImport is the import of the block into the chain.
+	BlockPublishStageImport BlockPublishStage = "import"
+)
+
+var errBlockImportTimeout = errors.New("block import did not complete in time")
+
+// blockImportTimeout bounds how long a proposal waits for the import of the proposed block. Attesters of the slot
+// vote by a third of the slot, waiting beyond that tells the proposer nothing it can act on.
+var blockImportTimeout = func() time.Duration {
+	return slots.DivideSlotBy(3)
+}
+
+// BlockPublishError is returned by ProposeBeaconBlock when a block could not be published. It records the stage that
+// failed, and whether the block had already been broadcast to the network at that point.
+type BlockPublishError struct {
+	Stage     BlockPublishStage
+	Broadcast bool
+	Err       error
+}
+
+// Error returns the message of the error, prefixed with the stage that failed.
+func (e *BlockPublishError) Error() string {
+	if e.Broadcast {
+		return fmt.Sprintf("block was broadcast but %s failed: %v", e.Stage, e.Err)
+	}
+	return fmt.Sprintf("%s failed: %v", e.Stage, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *BlockPublishError) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus allows the error to be returned from gRPC handlers.
+func (e *BlockPublishError) GRPCStatus() *status.Status {
+	code := codes.Internal
+	if errors.Is(e.Err, errBlockImportTimeout) {
+		code = codes.DeadlineExceeded
+	}
+	return status.New(code, "Could not publish block: "+e.Error())
+}
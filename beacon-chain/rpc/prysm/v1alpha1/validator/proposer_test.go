@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	coretime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
@@ -38,6 +39,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/trie"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
@@ -1002,6 +1004,66 @@ func TestProposer_ProposeBlock_OK(t *testing.T) {
 	}
 }
 
+// blockingReceiver is a block receiver whose import only completes once release is closed.
+type blockingReceiver struct {
+	*mock.ChainService
+	release chan struct{}
+}
+
+func (r *blockingReceiver) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, root [32]byte, avs das.AvailabilityStore) error {
+	<-r.release
+	return r.ChainService.ReceiveBlock(ctx, block, root, avs)
+}
+
+func TestProposer_ProposeBlock_PublishErrors(t *testing.T) {
+	ctx := context.Background()
+	beaconState, _ := util.DeterministicGenesisState(t, 64)
+	bsRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 5
+	blk.Block.ParentRoot = bsRoot[:]
+	req := &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_Phase0{Phase0: blk}}
+
+	t.Run("import failed", func(t *testing.T) {
+		c := &mock.ChainService{Root: bsRoot[:], State: beaconState, ReceiveBlockMockErr: errors.New("invalid block")}
+		p2p := mockp2p.NewTestP2P(t)
+		proposerServer := &Server{
+			BlockReceiver: c,
+			BlockNotifier: c.BlockNotifier(),
+			P2P:           p2p,
+		}
+		_, err := proposerServer.ProposeBeaconBlock(ctx, req)
+		var publishErr *BlockPublishError
+		require.Equal(t, true, errors.As(err, &publishErr))
+		assert.Equal(t, BlockPublishStageImport, publishErr.Stage)
+		assert.Equal(t, true, publishErr.Broadcast)
+		assert.Equal(t, true, p2p.BroadcastCalled.Load())
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.ErrorContains(t, "block was broadcast but import failed: invalid block", err)
+	})
+	t.Run("import timed out", func(t *testing.T) {
+		defaultTimeout := blockImportTimeout
+		blockImportTimeout = func() time.Duration { return 10 * time.Millisecond }
+		defer func() { blockImportTimeout = defaultTimeout }()
+
+		c := &mock.ChainService{Root: bsRoot[:], State: beaconState}
+		receiver := &blockingReceiver{ChainService: c, release: make(chan struct{})}
+		defer close(receiver.release)
+		proposerServer := &Server{
+			BlockReceiver: receiver,
+			BlockNotifier: c.BlockNotifier(),
+			P2P:           mockp2p.NewTestP2P(t),
+		}
+		_, err := proposerServer.ProposeBeaconBlock(ctx, req)
+		var publishErr *BlockPublishError
+		require.Equal(t, true, errors.As(err, &publishErr))
+		assert.Equal(t, BlockPublishStageImport, publishErr.Stage)
+		assert.Equal(t, true, publishErr.Broadcast)
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+}
+
 func TestProposer_ComputeStateRoot_OK(t *testing.T) {
 	db := dbutil.SetupDB(t)
 	ctx := context.Background()
@@ -45,6 +45,9 @@ type Server struct {
 	Ctx                    context.Context
 	PayloadIDCache         *cache.PayloadIDCache
 	TrackedValidatorsCache *cache.TrackedValidatorsCache
+	SubnetAttestationStats *cache.SubnetAttestationStats
+	ProposalAttSources     *cache.ProposalAttestationSourcesCache
+	ValidatorLiveness      *cache.ValidatorLiveness
 	HeadFetcher            blockchain.HeadFetcher
 	ForkFetcher            blockchain.ForkFetcher
 	ForkchoiceFetcher      blockchain.ForkchoiceFetcher
@@ -0,0 +1,340 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	payloadattribute "github.com/prysmaticlabs/prysm/v5/consensus-types/payload-attribute"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// Shadow proposals are blocks built with the regular block production pipeline for the proposer of a slot that is
+// not connected to this node, to measure how good our block production would be without waiting for a real proposal.
+// They are internal only: they have a fake randao reveal, a throwaway fee recipient, and they are never signed,
+// cached, broadcast or returned by any API. The payloads built by the execution client for them are never imported.
+// When a builder is configured, it is also asked for a bid on behalf of the actual proposer of the slot, to compare
+// builder and local payload values.
+
+var (
+	// shadowProposalFeeRecipient is the throwaway fee recipient of the payloads built for shadow proposals.
+	shadowProposalFeeRecipient = primitives.ExecutionAddress{18: 0xde, 19: 0xad}
+	// shadowProposalGraffiti marks shadow proposals as internal only.
+	shadowProposalGraffiti = bytesutil.ToBytes32([]byte("prysm shadow proposal, not valid"))
+)
+
+var (
+	shadowProposalCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "shadow_proposal_total",
+		Help: "The number of shadow proposals attempted, by result.",
+	}, []string{"result"})
+	shadowProposalLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "shadow_proposal_production_milliseconds",
+		Help:    "Captures the time taken to build a shadow proposal, from getting the parent state to computing the state root.",
+		Buckets: []float64{100, 250, 500, 1000, 2000, 4000, 8000},
+	})
+	shadowProposalAttestations = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "shadow_proposal_attestations_packed",
+		Help:    "The number of attestations packed in shadow proposals.",
+		Buckets: []float64{0, 8, 16, 32, 64, 96, 128},
+	})
+	shadowProposalLocalValue = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "shadow_proposal_local_payload_value_gwei",
+		Help:    "The value of the payloads built by the local execution client for shadow proposals.",
+		Buckets: prometheus.ExponentialBuckets(1e6, 2, 16),
+	})
+	shadowProposalBuilderValue = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "shadow_proposal_builder_bid_value_gwei",
+		Help:    "The value of the builder bids received for shadow proposals.",
+		Buckets: prometheus.ExponentialBuckets(1e6, 2, 16),
+	})
+)
+
+// shadowProposal holds what is measured when building a shadow proposal.
+type shadowProposal struct {
+	slot          primitives.Slot
+	proposerIndex primitives.ValidatorIndex
+	attestations  int
+	localValue    primitives.Gwei
+	builderValue  primitives.Gwei
+	hasBuilderBid bool
+	latency       time.Duration
+}
+
+// RunShadowProposals builds shadow proposals for --shadow-proposals-per-epoch random slots of every epoch, and records
+// metrics about them. It returns immediately if shadow proposals are disabled.
+func (vs *Server) RunShadowProposals(ctx context.Context) {
+	perEpoch := features.Get().ShadowProposalsPerEpoch
+	if perEpoch == 0 {
+		return
+	}
+	clock, err := vs.ClockWaiter.WaitForClock(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not wait for clock, not running shadow proposals")
+		return
+	}
+
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	gen := rand.NewGenerator()
+	var (
+		epoch  primitives.Epoch
+		chosen map[primitives.Slot]bool
+	)
+	for {
+		select {
+		case slot := <-ticker.C():
+			if chosen == nil || slots.ToEpoch(slot) != epoch {
+				epoch = slots.ToEpoch(slot)
+				chosen, err = pickShadowProposalSlots(gen, epoch, perEpoch)
+				if err != nil {
+					log.WithError(err).Error("Could not pick shadow proposal slots")
+					continue
+				}
+			}
+			if !chosen[slot] || vs.SyncChecker.Syncing() {
+				continue
+			}
+			if slots.ToEpoch(slot) >= params.BeaconConfig().BellatrixForkEpoch {
+				if err := vs.optimisticStatus(ctx); err != nil {
+					continue
+				}
+			}
+			p, err := vs.shadowPropose(ctx, slot)
+			if err != nil {
+				shadowProposalCount.WithLabelValues("failed").Inc()
+				log.WithError(err).WithField("slot", slot).Warn("Could not build shadow proposal")
+				continue
+			}
+			if p == nil {
+				shadowProposalCount.WithLabelValues("skipped").Inc()
+				continue
+			}
+			shadowProposalCount.WithLabelValues("built").Inc()
+			p.record()
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting shadow proposals routine")
+			return
+		}
+	}
+}
+
+// pickShadowProposalSlots picks n distinct random slots of the epoch.
+func pickShadowProposalSlots(gen *rand.Rand, epoch primitives.Epoch, n uint64) (map[primitives.Slot]bool, error) {
+	start, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, err
+	}
+	slotsPerEpoch := int(params.BeaconConfig().SlotsPerEpoch)
+	if n > uint64(slotsPerEpoch) {
+		n = uint64(slotsPerEpoch)
+	}
+	chosen := make(map[primitives.Slot]bool, n)
+	for _, i := range gen.Perm(slotsPerEpoch)[:n] {
+		chosen[start+primitives.Slot(i)] = true
+	}
+	return chosen, nil
+}
+
+// shadowPropose builds a shadow proposal for the slot on top of the current head, using the same pipeline as
+// GetBeaconBlock. It returns nil when the slot is proposed by a validator connected to this node, so that shadow
+// proposals never compete with a real proposal.
+func (vs *Server) shadowPropose(ctx context.Context, slot primitives.Slot) (*shadowProposal, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.shadowPropose")
+	defer span.End()
+	span.SetAttributes(trace.Int64Attribute("slot", int64(slot)))
+
+	start := time.Now()
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head root")
+	}
+	head, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	if head.Slot() >= slot {
+		return nil, nil
+	}
+	head, err = transition.ProcessSlotsUsingNextSlotCache(ctx, head, headRoot, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not process slots up to %d", slot)
+	}
+	idx, err := helpers.BeaconProposerIndex(ctx, head)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not calculate proposer index")
+	}
+	if _, tracked := vs.TrackedValidatorsCache.Validator(idx); tracked {
+		return nil, nil
+	}
+
+	sBlk, err := getEmptyBlock(slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not prepare block")
+	}
+	sBlk.SetSlot(slot)
+	sBlk.SetGraffiti(shadowProposalGraffiti[:])
+	sBlk.SetRandaoReveal(make([]byte, fieldparams.BLSSignatureLength))
+	sBlk.SetParentRoot(headRoot)
+	sBlk.SetProposerIndex(idx)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		vs.setConsensusFields(ctx, sBlk, head)
+	}()
+
+	p := &shadowProposal{slot: slot, proposerIndex: idx}
+	if sBlk.Version() >= version.Bellatrix {
+		local, err := vs.getShadowLocalPayload(ctx, head, bytesutil.ToBytes32(headRoot), slot)
+		if err != nil {
+			wg.Wait()
+			return nil, errors.Wrap(err, "could not get local payload")
+		}
+		p.localValue = primitives.WeiToGwei(local.Bid)
+		bid, err := vs.getShadowBuilderBid(ctx, head, slot, idx)
+		if err != nil {
+			log.WithError(err).WithField("slot", slot).Debug("Could not get builder bid for shadow proposal")
+		} else if bid != nil {
+			p.builderValue, p.hasBuilderBid = primitives.WeiToGwei(bid.Value()), true
+		}
+		if err := setLocalExecution(sBlk, local); err != nil {
+			wg.Wait()
+			return nil, errors.Wrap(err, "could not set execution data")
+		}
+	}
+	wg.Wait()
+
+	sr, err := vs.computeStateRoot(ctx, sBlk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute state root")
+	}
+	sBlk.SetStateRoot(sr)
+	p.latency = time.Since(start)
+	p.attestations = len(sBlk.Block().Body().Attestations())
+	return p, nil
+}
+
+// getShadowLocalPayload asks the execution client to build a payload for a shadow proposal, paying the fees to the
+// throwaway fee recipient. It never uses or fills the payload ID cache. Once the payload is retrieved, the execution
+// client is sent a forkchoice update without payload attributes, so that it stops building on it.
+func (vs *Server) getShadowLocalPayload(ctx context.Context, st state.BeaconState, parentRoot [32]byte, slot primitives.Slot) (*consensusblocks.GetPayloadResponse, error) {
+	parentHash, err := vs.getParentBlockHash(ctx, st, slot)
+	switch {
+	case errors.Is(err, errActivationNotReached) || errors.Is(err, errNoTerminalBlockHash):
+		return consensusblocks.NewGetPayloadResponse(emptyPayload())
+	case err != nil:
+		return nil, err
+	}
+	defer vs.discardShadowPayload(ctx)
+	payloadID, err := vs.requestPayloadBuild(ctx, st, parentRoot, parentHash, slot, shadowProposalFeeRecipient[:])
+	if err != nil {
+		return nil, err
+	}
+	return vs.ExecutionEngineCaller.GetPayload(ctx, *payloadID, slot)
+}
+
+// getShadowBuilderBid asks the builder for a bid for the slot on behalf of its proposer, whose public key is read from
+// the state advanced to the slot. Relays know the registration of the proposer independently of this node, so this is
+// the bid the proposer would receive. It returns nil if no builder is configured or the merge has not happened.
+func (vs *Server) getShadowBuilderBid(ctx context.Context, st state.BeaconState, slot primitives.Slot, idx primitives.ValidatorIndex) (builder.Bid, error) {
+	if vs.BlockBuilder == nil || !vs.BlockBuilder.Configured() {
+		return nil, nil
+	}
+	parentHash, err := vs.getParentBlockHash(ctx, st, slot)
+	switch {
+	case errors.Is(err, errActivationNotReached) || errors.Is(err, errNoTerminalBlockHash):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	pk := st.PubkeyAtIndex(idx)
+
+	ctx, cancel := context.WithTimeout(ctx, blockBuilderTimeout)
+	defer cancel()
+	signedBid, err := vs.BlockBuilder.GetHeader(ctx, slot, bytesutil.ToBytes32(parentHash), pk)
+	if err != nil {
+		return nil, err
+	}
+	if signedBid == nil || signedBid.IsNil() {
+		return nil, errors.New("builder returned nil bid")
+	}
+	if err := validateBuilderSignature(signedBid); err != nil {
+		return nil, errors.Wrap(err, "could not validate builder signature")
+	}
+	bid, err := signedBid.Message()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid")
+	}
+	header, err := bid.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get bid header")
+	}
+	if !bytes.Equal(header.ParentHash(), parentHash) {
+		return nil, fmt.Errorf("incorrect parent hash %#x != %#x", header.ParentHash(), parentHash)
+	}
+	return bid, nil
+}
+
+// discardShadowPayload sends the execution client a forkchoice update for the current head without payload
+// attributes, which leaves out the payload built for a shadow proposal.
+func (vs *Server) discardShadowPayload(ctx context.Context) {
+	head, err := vs.HeadFetcher.HeadBlock(ctx)
+	if err != nil || head == nil || head.IsNil() || head.Version() < version.Bellatrix {
+		return
+	}
+	execution, err := head.Block().Body().Execution()
+	if err != nil {
+		log.WithError(err).Debug("Could not get head execution data after shadow proposal")
+		return
+	}
+	finalizedBlockHash := vs.FinalizationFetcher.FinalizedBlockHash()
+	justifiedBlockHash := vs.FinalizationFetcher.UnrealizedJustifiedPayloadBlockHash()
+	f := &enginev1.ForkchoiceState{
+		HeadBlockHash:      execution.BlockHash(),
+		SafeBlockHash:      justifiedBlockHash[:],
+		FinalizedBlockHash: finalizedBlockHash[:],
+	}
+	if _, _, err := vs.ExecutionEngineCaller.ForkchoiceUpdated(ctx, f, payloadattribute.EmptyWithVersion(head.Version())); err != nil {
+		log.WithError(err).Debug("Could not send forkchoice update after shadow proposal")
+	}
+}
+
+func (p *shadowProposal) record() {
+	shadowProposalLatency.Observe(float64(p.latency.Milliseconds()))
+	shadowProposalAttestations.Observe(float64(p.attestations))
+	shadowProposalLocalValue.Observe(float64(p.localValue))
+	fields := logrus.Fields{
+		"slot":              p.slot,
+		"proposerIndex":     p.proposerIndex,
+		"attestations":      p.attestations,
+		"localValueGwei":    p.localValue,
+		"productionLatency": p.latency,
+	}
+	if p.hasBuilderBid {
+		shadowProposalBuilderValue.Observe(float64(p.builderValue))
+		fields["builderValueGwei"] = p.builderValue
+	}
+	log.WithFields(fields).Info("Built shadow proposal (internal only, not signed or published)")
+}
@@ -0,0 +1,230 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	builderTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	b "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	payloadattribute "github.com/prysmaticlabs/prysm/v5/consensus-types/payload-attribute"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// pubkeyRecordingBuilder records the proposer public keys of the headers requested from the builder.
+type pubkeyRecordingBuilder struct {
+	*builderTest.MockBuilderService
+	pubkeys [][fieldparams.BLSPubkeyLength]byte
+}
+
+func (b *pubkeyRecordingBuilder) GetHeader(
+	ctx context.Context, slot primitives.Slot, parentHash [32]byte, pubKey [fieldparams.BLSPubkeyLength]byte,
+) (builder.SignedBid, error) {
+	b.pubkeys = append(b.pubkeys, pubKey)
+	return b.MockBuilderService.GetHeader(ctx, slot, parentHash, pubKey)
+}
+
+// attributesRecordingEngine records the payload attributes of the forkchoice updates sent to the execution client.
+type attributesRecordingEngine struct {
+	*mockExecution.EngineClient
+	attributes []payloadattribute.Attributer
+}
+
+func (e *attributesRecordingEngine) ForkchoiceUpdated(
+	ctx context.Context, fcs *enginev1.ForkchoiceState, attrs payloadattribute.Attributer,
+) (*enginev1.PayloadIDBytes, []byte, error) {
+	e.attributes = append(e.attributes, attrs)
+	return e.EngineClient.ForkchoiceUpdated(ctx, fcs, attrs)
+}
+
+func TestPickShadowProposalSlots(t *testing.T) {
+	gen := rand.NewGenerator()
+	start, err := slots.EpochStart(3)
+	require.NoError(t, err)
+
+	chosen, err := pickShadowProposalSlots(gen, 3, 4)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(chosen))
+	for slot := range chosen {
+		require.Equal(t, true, slot >= start && slot < start+params.BeaconConfig().SlotsPerEpoch)
+	}
+
+	chosen, err = pickShadowProposalSlots(gen, 3, uint64(params.BeaconConfig().SlotsPerEpoch)+1)
+	require.NoError(t, err)
+	require.Equal(t, int(params.BeaconConfig().SlotsPerEpoch), len(chosen))
+}
+
+func TestServer_shadowPropose(t *testing.T) {
+	db := dbutil.SetupDB(t)
+	ctx := context.Background()
+	transition.SkipSlotCache.Disable()
+
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.CapellaForkEpoch = 3
+	cfg.BellatrixForkEpoch = 2
+	cfg.AltairForkEpoch = 1
+	params.OverrideBeaconConfig(cfg)
+	beaconState, _ := util.DeterministicGenesisState(t, 64)
+
+	stateRoot, err := beaconState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	util.SaveBlock(t, ctx, db, genesis)
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, beaconState, parentRoot))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, parentRoot))
+
+	capellaSlot, err := slots.EpochStart(params.BeaconConfig().CapellaForkEpoch)
+	require.NoError(t, err)
+	slot := capellaSlot + 1
+
+	random, err := helpers.RandaoMix(beaconState, slots.ToEpoch(beaconState.Slot()))
+	require.NoError(t, err)
+	timeStamp, err := slots.ToTime(beaconState.GenesisTime(), slot)
+	require.NoError(t, err)
+	payload := &enginev1.ExecutionPayloadCapella{
+		ParentHash:    make([]byte, fieldparams.RootLength),
+		FeeRecipient:  shadowProposalFeeRecipient[:],
+		StateRoot:     make([]byte, fieldparams.RootLength),
+		ReceiptsRoot:  make([]byte, fieldparams.RootLength),
+		LogsBloom:     make([]byte, fieldparams.LogsBloomLength),
+		PrevRandao:    random,
+		BlockNumber:   1,
+		GasLimit:      2,
+		GasUsed:       3,
+		Timestamp:     uint64(timeStamp.Unix()),
+		ExtraData:     make([]byte, 0),
+		BaseFeePerGas: make([]byte, fieldparams.RootLength),
+		BlockHash:     make([]byte, fieldparams.RootLength),
+		Transactions:  make([][]byte, 0),
+		Withdrawals:   make([]*enginev1.Withdrawal, 0),
+	}
+	ed, err := blocks.NewWrappedExecutionData(payload)
+	require.NoError(t, err)
+	headBlock, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+	require.NoError(t, err)
+
+	newServer := func() (*Server, *attributesRecordingEngine) {
+		vs := getProposerServer(db, beaconState.Copy(), parentRoot[:])
+		vs.HeadFetcher = &mock.ChainService{State: beaconState.Copy(), Root: parentRoot[:], Block: headBlock, ForkChoiceStore: doublylinkedtree.New()}
+		engine := &attributesRecordingEngine{EngineClient: &mockExecution.EngineClient{
+			PayloadIDBytes:     &enginev1.PayloadIDBytes{1},
+			GetPayloadResponse: &blocks.GetPayloadResponse{ExecutionData: ed, Bid: primitives.Uint64ToWei(params.BeaconConfig().GweiPerEth * params.BeaconConfig().GweiPerEth)},
+		}}
+		vs.ExecutionEngineCaller = engine
+		return vs, engine
+	}
+
+	t.Run("built and discarded", func(t *testing.T) {
+		vs, engine := newServer()
+		p, err := vs.shadowPropose(ctx, slot)
+		require.NoError(t, err)
+		require.NotNil(t, p)
+		require.Equal(t, slot, p.slot)
+		require.Equal(t, primitives.Gwei(params.BeaconConfig().GweiPerEth), p.localValue)
+		require.Equal(t, false, p.hasBuilderBid)
+		require.Equal(t, true, p.latency > 0)
+
+		// The payload is built for the throwaway fee recipient, and the last forkchoice update leaves it out.
+		require.Equal(t, 2, len(engine.attributes))
+		require.DeepEqual(t, shadowProposalFeeRecipient[:], engine.attributes[0].SuggestedFeeRecipient())
+		require.Equal(t, true, engine.attributes[1].IsEmpty())
+		// Nothing is cached for the slot.
+		_, ok := vs.PayloadIDCache.PayloadID(slot, parentRoot)
+		require.Equal(t, false, ok)
+	})
+	t.Run("skips tracked proposer", func(t *testing.T) {
+		vs, engine := newServer()
+		vs.TrackedValidatorsCache = cache.NewTrackedValidatorsCache()
+		for i := 0; i < 64; i++ {
+			vs.TrackedValidatorsCache.Set(cache.TrackedValidator{Active: true, Index: primitives.ValidatorIndex(i)})
+		}
+		p, err := vs.shadowPropose(ctx, slot)
+		require.NoError(t, err)
+		require.IsNil(t, p)
+		require.Equal(t, 0, len(engine.attributes))
+	})
+}
+
+func TestServer_getShadowBuilderBid(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateCapella(t, 64)
+	header, err := st.LatestExecutionPayloadHeader()
+	require.NoError(t, err)
+
+	sk, err := bls.RandKey()
+	require.NoError(t, err)
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainApplicationBuilder, nil, nil)
+	require.NoError(t, err)
+	signedBid := func(parentHash []byte) *ethpb.SignedBuilderBidCapella {
+		bid := &ethpb.BuilderBidCapella{
+			Header: &enginev1.ExecutionPayloadHeaderCapella{
+				ParentHash:       parentHash,
+				FeeRecipient:     make([]byte, fieldparams.FeeRecipientLength),
+				StateRoot:        make([]byte, fieldparams.RootLength),
+				ReceiptsRoot:     make([]byte, fieldparams.RootLength),
+				LogsBloom:        make([]byte, fieldparams.LogsBloomLength),
+				PrevRandao:       make([]byte, fieldparams.RootLength),
+				BaseFeePerGas:    make([]byte, fieldparams.RootLength),
+				BlockHash:        make([]byte, fieldparams.RootLength),
+				TransactionsRoot: bytesutil.PadTo([]byte{1}, fieldparams.RootLength),
+				WithdrawalsRoot:  make([]byte, fieldparams.RootLength),
+			},
+			Pubkey: sk.PublicKey().Marshal(),
+			Value:  bytesutil.PadTo([]byte{1, 2, 3}, 32),
+		}
+		sr, err := signing.ComputeSigningRoot(bid, domain)
+		require.NoError(t, err)
+		return &ethpb.SignedBuilderBidCapella{Message: bid, Signature: sk.Sign(sr[:]).Marshal()}
+	}
+	const proposer = primitives.ValidatorIndex(5)
+
+	t.Run("requested for the proposer of the slot", func(t *testing.T) {
+		bb := &pubkeyRecordingBuilder{MockBuilderService: &builderTest.MockBuilderService{
+			HasConfigured: true,
+			BidCapella:    signedBid(header.BlockHash()),
+		}}
+		vs := &Server{BlockBuilder: bb}
+		bid, err := vs.getShadowBuilderBid(ctx, st, st.Slot()+1, proposer)
+		require.NoError(t, err)
+		require.NotNil(t, bid)
+		require.DeepEqual(t, primitives.LittleEndianBytesToWei(bytesutil.PadTo([]byte{1, 2, 3}, 32)), bid.Value())
+		require.Equal(t, 1, len(bb.pubkeys))
+		require.Equal(t, st.PubkeyAtIndex(proposer), bb.pubkeys[0])
+	})
+	t.Run("no builder", func(t *testing.T) {
+		vs := &Server{BlockBuilder: &builderTest.MockBuilderService{}}
+		bid, err := vs.getShadowBuilderBid(ctx, st, st.Slot()+1, proposer)
+		require.NoError(t, err)
+		require.IsNil(t, bid)
+	})
+	t.Run("wrong parent hash", func(t *testing.T) {
+		vs := &Server{BlockBuilder: &builderTest.MockBuilderService{
+			HasConfigured: true,
+			BidCapella:    signedBid(bytesutil.PadTo([]byte{1}, fieldparams.RootLength)),
+		}}
+		_, err := vs.getShadowBuilderBid(ctx, st, st.Slot()+1, proposer)
+		require.ErrorContains(t, "incorrect parent hash", err)
+	})
+}
@@ -143,6 +143,13 @@ func (vs *Server) CheckDoppelGanger(ctx context.Context, req *ethpb.DoppelGanger
 		return resp, nil
 	}
 
+	// Prefer the duties observed by the node over replaying the state of the previous epoch,
+	// when they are known for all the epochs checked.
+	if currEpoch >= 2 && vs.ValidatorLiveness != nil &&
+		vs.ValidatorLiveness.Covers(currEpoch-2) && vs.ValidatorLiveness.Covers(currEpoch) {
+		return vs.doppelGangerFromLiveness(headState, currEpoch, req), nil
+	}
+
 	// We request a state 32 slots ago. We are guaranteed to have
 	// currentSlot > 32 since we assume that we are in Altair's fork.
 	prevStateSlot := headSlot - params.BeaconConfig().SlotsPerEpoch
@@ -214,6 +221,50 @@ func (vs *Server) CheckDoppelGanger(ctx context.Context, req *ethpb.DoppelGanger
 	return resp, nil
 }
 
+// doppelGangerFromLiveness checks the provided keys against the validators observed performing duties in the current
+// epoch and the two epochs before, which are the epochs covered by the participation of the head and previous states.
+func (vs *Server) doppelGangerFromLiveness(
+	headState state.ReadOnlyBeaconState,
+	currEpoch primitives.Epoch,
+	req *ethpb.DoppelGangerRequest,
+) *ethpb.DoppelGangerResponse {
+	resp := &ethpb.DoppelGangerResponse{
+		Responses: []*ethpb.DoppelGangerResponse_ValidatorResponse{},
+	}
+	for _, v := range req.ValidatorRequests {
+		// As for participation, duties of recent validators may be their own ones.
+		if v.Epoch+2 >= currEpoch {
+			resp.Responses = append(resp.Responses,
+				&ethpb.DoppelGangerResponse_ValidatorResponse{
+					PublicKey:       v.PublicKey,
+					DuplicateExists: false,
+				})
+			continue
+		}
+		valIndex, ok := headState.ValidatorIndexByPubkey(bytesutil.ToBytes48(v.PublicKey))
+		if !ok {
+			// Ignore if validator pubkey doesn't exist.
+			continue
+		}
+		live := false
+		for e := currEpoch - 2; e <= currEpoch; e++ {
+			if vs.ValidatorLiveness.IsLive(e, valIndex) {
+				live = true
+				break
+			}
+		}
+		if live {
+			log.WithField("validatorIndex", valIndex).Info("Duty observed")
+		}
+		resp.Responses = append(resp.Responses,
+			&ethpb.DoppelGangerResponse_ValidatorResponse{
+				PublicKey:       v.PublicKey,
+				DuplicateExists: live,
+			})
+	}
+	return resp
+}
+
 // activationStatus returns the validator status response for the set of validators
 // requested by their pub keys.
 func (vs *Server) activationStatus(
@@ -427,10 +478,12 @@ func assignmentStatus(beaconState state.ReadOnlyBeaconState, validatorIndex prim
 	return ethpb.ValidatorStatus_EXITED
 }
 
+// depositStatus compares the deposit or balance of a validator to the activation balance. Post Electra, the
+// maximum effective balance of compounding validators exceeds the balance required for activation.
 func depositStatus(depositOrBalance uint64) ethpb.ValidatorStatus {
 	if depositOrBalance == 0 {
 		return ethpb.ValidatorStatus_PENDING
-	} else if depositOrBalance < params.BeaconConfig().MaxEffectiveBalance {
+	} else if depositOrBalance < params.BeaconConfig().MinActivationBalance {
 		return ethpb.ValidatorStatus_PARTIALLY_DEPOSITED
 	}
 	return ethpb.ValidatorStatus_DEPOSITED
@@ -8,6 +8,7 @@ import (
 
 	"github.com/d4l3k/messagediff"
 	mockChain "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
@@ -950,6 +951,9 @@ func Test_DepositStatus(t *testing.T) {
 	assert.Equal(t, depositStatus(0), ethpb.ValidatorStatus_PENDING)
 	assert.Equal(t, depositStatus(params.BeaconConfig().MinDepositAmount), ethpb.ValidatorStatus_PARTIALLY_DEPOSITED)
 	assert.Equal(t, depositStatus(params.BeaconConfig().MaxEffectiveBalance), ethpb.ValidatorStatus_DEPOSITED)
+	// A compounding validator is fully deposited once it reaches the activation balance.
+	assert.Equal(t, depositStatus(params.BeaconConfig().MinActivationBalance), ethpb.ValidatorStatus_DEPOSITED)
+	assert.Equal(t, depositStatus(params.BeaconConfig().MaxEffectiveBalanceElectra), ethpb.ValidatorStatus_DEPOSITED)
 }
 
 func TestServer_CheckDoppelGanger(t *testing.T) {
@@ -1247,6 +1251,41 @@ func TestServer_CheckDoppelGanger(t *testing.T) {
 				return vs, request, response
 			},
 		},
+		{
+			name:    "doppelganger from observed duties",
+			wantErr: false,
+			svSetup: func(t *testing.T) (*Server, *ethpb.DoppelGangerRequest, *ethpb.DoppelGangerResponse) {
+				hs, _, keys := createStateSetupAltair(t, 4)
+				liveness := cache.NewValidatorLiveness()
+				liveness.MarkLive(1)
+				liveness.MarkLive(3, 2)
+
+				// No replayer is set up as the previous state must not be replayed.
+				vs := &Server{
+					HeadFetcher: &mockChain.ChainService{
+						State: hs,
+					},
+					SyncChecker:       &mockSync.Sync{IsSyncing: false},
+					ValidatorLiveness: liveness,
+				}
+				request := &ethpb.DoppelGangerRequest{
+					ValidatorRequests: make([]*ethpb.DoppelGangerRequest_ValidatorRequest, 0),
+				}
+				response := &ethpb.DoppelGangerResponse{Responses: make([]*ethpb.DoppelGangerResponse_ValidatorResponse, 0)}
+				for i := 0; i < 3; i++ {
+					request.ValidatorRequests = append(request.ValidatorRequests, &ethpb.DoppelGangerRequest_ValidatorRequest{
+						PublicKey:  keys[i].PublicKey().Marshal(),
+						Epoch:      1,
+						SignedRoot: []byte{'A'},
+					})
+					response.Responses = append(response.Responses, &ethpb.DoppelGangerResponse_ValidatorResponse{
+						PublicKey:       keys[i].PublicKey().Marshal(),
+						DuplicateExists: i == 2,
+					})
+				}
+				return vs, request, response
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,120 @@
+package validator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// maxOperationTotalsIds is the maximum number of validators queried in a single operation totals request.
+const maxOperationTotalsIds = 1000
+
+// GetOperationTotals retrieves the cumulative deposits and withdrawals of the requested validators, identified by
+// index or public key, over the finalized chain up to the slot of the last indexed block. Validators are resolved
+// against the head state. Deposits of validators included in the genesis state are not part of the totals. Public
+// keys without a validator still report the deposits made to them.
+func (s *Server) GetOperationTotals(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetOperationTotals")
+	defer span.End()
+
+	rawIds := r.URL.Query()["id"]
+	if len(rawIds) == 0 {
+		httputil.HandleError(w, "At least one validator id is required", http.StatusBadRequest)
+		return
+	}
+	if len(rawIds) > maxOperationTotalsIds {
+		httputil.HandleError(w, fmt.Sprintf("Too many validator ids, maximum is %d", maxOperationTotalsIds), http.StatusBadRequest)
+		return
+	}
+
+	indexedSlot, err := s.BeaconDB.OperationTotalsIndexedSlot(ctx)
+	if errors.Is(err, db.ErrNotFound) {
+		httputil.HandleError(w, "Operation totals index is empty, it is enabled with the --operation-totals-index flag", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		httputil.HandleError(w, "Could not get operation totals indexed slot: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	st, err := s.Stater.State(ctx, []byte("head"))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return
+	}
+	numVals := uint64(st.NumValidators())
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, len(rawIds))
+	indices := make([]primitives.ValidatorIndex, len(rawIds))
+	known := make([]bool, len(rawIds))
+	for i, rawId := range rawIds {
+		pubKey, err := hexutil.Decode(rawId)
+		if err == nil {
+			if len(pubKey) != fieldparams.BLSPubkeyLength {
+				httputil.HandleError(w, fmt.Sprintf("Pubkey length is %d instead of %d", len(pubKey), fieldparams.BLSPubkeyLength), http.StatusBadRequest)
+				return
+			}
+			pubKeys[i] = bytesutil.ToBytes48(pubKey)
+			indices[i], known[i] = st.ValidatorIndexByPubkey(pubKeys[i])
+			continue
+		}
+		index, err := strconv.ParseUint(rawId, 10, 64)
+		if err != nil {
+			httputil.HandleError(w, fmt.Sprintf("Invalid validator index %s", rawId), http.StatusBadRequest)
+			return
+		}
+		if index >= numVals {
+			httputil.HandleError(w, fmt.Sprintf("Invalid validator index %d", index), http.StatusBadRequest)
+			return
+		}
+		indices[i] = primitives.ValidatorIndex(index)
+		pubKeys[i] = st.PubkeyAtIndex(indices[i])
+		known[i] = true
+	}
+
+	deposits, err := s.BeaconDB.DepositTotals(ctx, pubKeys)
+	if err != nil {
+		httputil.HandleError(w, "Could not get deposit totals: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	withdrawals, err := s.BeaconDB.WithdrawalTotals(ctx, indices)
+	if err != nil {
+		httputil.HandleError(w, "Could not get withdrawal totals: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data := make([]*structs.ValidatorOperationTotals, len(rawIds))
+	for i := range rawIds {
+		totals := &structs.ValidatorOperationTotals{
+			Pubkey: hexutil.Encode(pubKeys[i][:]),
+			Deposits: &structs.DepositTotals{
+				Count:     strconv.FormatUint(deposits[i].Count, 10),
+				TotalGwei: strconv.FormatUint(deposits[i].TotalGwei, 10),
+			},
+			Withdrawals: &structs.WithdrawalTotals{Count: "0", TotalGwei: "0", LastWithdrawalSlot: "0"},
+		}
+		if known[i] {
+			totals.Index = strconv.FormatUint(uint64(indices[i]), 10)
+			totals.Withdrawals = &structs.WithdrawalTotals{
+				Count:              strconv.FormatUint(withdrawals[i].Count, 10),
+				TotalGwei:          strconv.FormatUint(withdrawals[i].TotalGwei, 10),
+				LastWithdrawalSlot: strconv.FormatUint(uint64(withdrawals[i].LastWithdrawalSlot), 10),
+			}
+		}
+		data[i] = totals
+	}
+	httputil.WriteJson(w, &structs.GetOperationTotalsResponse{
+		IndexedSlot: strconv.FormatUint(uint64(indexedSlot), 10),
+		Data:        data,
+	})
+}
@@ -0,0 +1,80 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_GetOperationTotals(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisState(t, 4)
+	beaconDB := dbTest.SetupDB(t)
+	s := &Server{
+		BeaconDB: beaconDB,
+		Stater:   &testutil.MockStater{BeaconState: st},
+	}
+	request := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/operation_totals"+query, nil)
+		writer := httptest.NewRecorder()
+		s.GetOperationTotals(writer, req)
+		return writer
+	}
+
+	writer := request("?id=1")
+	require.Equal(t, http.StatusNotFound, writer.Code)
+	require.StringContains(t, "Operation totals index is empty", writer.Body.String())
+
+	pubKey0 := st.PubkeyAtIndex(0)
+	// The deposit of the fifth key is made by a validator that is not in the state yet.
+	deposits, _, err := util.DepositsWithBalance([]uint64{params.BeaconConfig().MinDepositAmount, 0, 0, 0, 2})
+	require.NoError(t, err)
+	require.DeepEqual(t, pubKey0[:], deposits[0].Data.PublicKey)
+	unknown := deposits[4].Data.PublicKey
+	b := util.NewBeaconBlockCapella()
+	b.Block.Slot = 5
+	b.Block.Body.Deposits = []*ethpb.Deposit{deposits[0], deposits[4]}
+	b.Block.Body.ExecutionPayload.Withdrawals = []*enginev1.Withdrawal{
+		{Index: 0, ValidatorIndex: 1, Address: make([]byte, 20), Amount: 10},
+		{Index: 1, ValidatorIndex: 1, Address: make([]byte, 20), Amount: 20},
+	}
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.IndexOperationTotals(ctx, []interfaces.ReadOnlySignedBeaconBlock{blk}))
+
+	writer = request("?id=" + hexutil.Encode(pubKey0[:]) + "&id=1&id=" + hexutil.Encode(unknown))
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetOperationTotalsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, "5", resp.IndexedSlot)
+	require.Equal(t, 3, len(resp.Data))
+	require.DeepEqual(t, &structs.ValidatorOperationTotals{
+		Index:       "0",
+		Pubkey:      hexutil.Encode(pubKey0[:]),
+		Deposits:    &structs.DepositTotals{Count: "1", TotalGwei: "1000000000"},
+		Withdrawals: &structs.WithdrawalTotals{Count: "0", TotalGwei: "0", LastWithdrawalSlot: "0"},
+	}, resp.Data[0])
+	require.DeepEqual(t, &structs.WithdrawalTotals{Count: "2", TotalGwei: "30", LastWithdrawalSlot: "5"}, resp.Data[1].Withdrawals)
+	require.DeepEqual(t, &structs.DepositTotals{Count: "0", TotalGwei: "0"}, resp.Data[1].Deposits)
+	require.Equal(t, "", resp.Data[2].Index)
+	require.DeepEqual(t, &structs.DepositTotals{Count: "1", TotalGwei: "2"}, resp.Data[2].Deposits)
+
+	writer = request("?id=4")
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	writer = request("")
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+}
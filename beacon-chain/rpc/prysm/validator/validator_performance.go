@@ -2,15 +2,21 @@ package validator
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 // GetPerformance is an HTTP handler for GetPerformance.
@@ -30,18 +36,53 @@ func (s *Server) GetPerformance(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	computed, rpcError := s.CoreService.ComputeValidatorPerformance(
-		ctx,
-		&ethpb.ValidatorPerformanceRequest{
-			PublicKeys: req.PublicKeys,
-			Indices:    req.Indices,
-		},
-	)
+	rawEpoch, epoch, ok := shared.UintFromQuery(w, r, "epoch", false)
+	if !ok {
+		return
+	}
+	rawPageSize, pageSize, ok := shared.UintFromQuery(w, r, "page_size", false)
+	if !ok {
+		return
+	}
+	if pageSize > uint64(cmd.Get().MaxRPCPageSize) {
+		httputil.HandleError(w, fmt.Sprintf("Requested page size %d can not be greater than max size %d", pageSize, cmd.Get().MaxRPCPageSize), http.StatusBadRequest)
+		return
+	}
+	pageToken := r.URL.Query().Get("page_token")
+
+	performanceReq := &ethpb.ValidatorPerformanceRequest{
+		PublicKeys: req.PublicKeys,
+		Indices:    req.Indices,
+	}
+	// Without an epoch or a page, the performance of all requested validators in the current epoch is returned.
+	if rawEpoch == "" && rawPageSize == "" && pageToken == "" {
+		computed, rpcError := s.CoreService.ComputeValidatorPerformance(ctx, performanceReq)
+		if rpcError != nil {
+			handleHTTPError(w, "Could not compute validator performance: "+rpcError.Err.Error(), core.ErrorReasonToHTTP(rpcError.Reason))
+			return
+		}
+		httputil.WriteJson(w, performanceResponse(computed))
+		return
+	}
+
+	requestedEpoch := primitives.Epoch(epoch)
+	if rawEpoch == "" {
+		requestedEpoch = slots.ToEpoch(s.CoreService.GenesisTimeFetcher.CurrentSlot())
+	}
+	computed, nextPageToken, totalSize, rpcError := s.CoreService.ValidatorPerformanceAtEpoch(ctx, requestedEpoch, performanceReq, pageToken, int(pageSize))
 	if rpcError != nil {
 		handleHTTPError(w, "Could not compute validator performance: "+rpcError.Err.Error(), core.ErrorReasonToHTTP(rpcError.Reason))
 		return
 	}
-	response := &structs.GetValidatorPerformanceResponse{
+	response := performanceResponse(computed)
+	response.Epoch = strconv.FormatUint(uint64(requestedEpoch), 10)
+	response.NextPageToken = nextPageToken
+	response.TotalSize = strconv.Itoa(totalSize)
+	httputil.WriteJson(w, response)
+}
+
+func performanceResponse(computed *ethpb.ValidatorPerformanceResponse) *structs.GetValidatorPerformanceResponse {
+	return &structs.GetValidatorPerformanceResponse{
 		PublicKeys:                    computed.PublicKeys,
 		CorrectlyVotedSource:          computed.CorrectlyVotedSource,
 		CorrectlyVotedTarget:          computed.CorrectlyVotedTarget, // In altair, when this is true then the attestation was definitely included.
@@ -52,7 +93,6 @@ func (s *Server) GetPerformance(w http.ResponseWriter, r *http.Request) {
 		MissingValidators:             computed.MissingValidators,
 		InactivityScores:              computed.InactivityScores, // Only populated in Altair
 	}
-	httputil.WriteJson(w, response)
 }
 
 func handleHTTPError(w http.ResponseWriter, message string, code int) {
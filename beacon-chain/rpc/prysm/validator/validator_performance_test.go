@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -17,7 +18,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	mockstategen "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen/mock"
 	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
@@ -466,3 +469,87 @@ func setHeadState(t *testing.T, headState state.BeaconState, publicKeys [][48]by
 	require.NoError(t, headState.SetValidators(validators))
 	return headState
 }
+
+func TestServer_GetValidatorPerformance_Paginated(t *testing.T) {
+	helpers.ClearCache()
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+
+	publicKeys := [][48]byte{
+		bytesutil.ToBytes48([]byte{1}),
+		bytesutil.ToBytes48([]byte{2}),
+		bytesutil.ToBytes48([]byte{3}),
+	}
+	unknownKey := bytesutil.ToBytes48([]byte{4})
+	headState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	headState = setHeadState(t, headState, publicKeys)
+	// The state at the end of epoch 1, which the head state at the start of epoch 2 is built upon.
+	pastState := headState.Copy()
+	require.NoError(t, pastState.SetSlot(headState.Slot()-1))
+
+	offset := int64(headState.Slot().Mul(params.BeaconConfig().SecondsPerSlot))
+	vs := &Server{
+		CoreService: &core.Service{
+			HeadFetcher:        &mock.ChainService{State: headState, Root: make([]byte, 32)},
+			FinalizedFetcher:   &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 0}},
+			GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now().Add(time.Duration(-1*offset) * time.Second)},
+			SyncChecker:        &mockSync.Sync{IsSyncing: false},
+			ReplayerBuilder:    mockstategen.NewReplayerBuilder(mockstategen.WithMockState(pastState)),
+			PerformanceCache:   core.NewPerformanceCache(),
+		},
+	}
+	request := &structs.GetValidatorPerformanceRequest{
+		PublicKeys: [][]byte{unknownKey[:], publicKeys[2][:], publicKeys[1][:], publicKeys[0][:]},
+	}
+	getPerformance := func(t *testing.T, query string) (int, *structs.GetValidatorPerformanceResponse) {
+		var buf bytes.Buffer
+		require.NoError(t, json.NewEncoder(&buf).Encode(request))
+		req := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validators/performance?"+query, &buf)
+		writer := httptest.NewRecorder()
+		vs.GetPerformance(writer, req)
+		response := &structs.GetValidatorPerformanceResponse{}
+		if writer.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), response))
+		}
+		return writer.Code, response
+	}
+
+	t.Run("pages of the current epoch", func(t *testing.T) {
+		code, resp := getPerformance(t, "page_size=2")
+		require.Equal(t, http.StatusOK, code)
+		// Validator 0 is not active yet.
+		require.DeepEqual(t, [][]byte{publicKeys[1][:]}, resp.PublicKeys)
+		require.DeepEqual(t, [][]byte{unknownKey[:], publicKeys[0][:]}, resp.MissingValidators)
+		require.Equal(t, "2", resp.Epoch)
+		require.Equal(t, "1", resp.NextPageToken)
+		require.Equal(t, "3", resp.TotalSize)
+
+		code, resp = getPerformance(t, "page_size=2&page_token="+resp.NextPageToken)
+		require.Equal(t, http.StatusOK, code)
+		require.DeepEqual(t, [][]byte{publicKeys[2][:]}, resp.PublicKeys)
+		// Unknown public keys are only reported in the first page.
+		require.Equal(t, 0, len(resp.MissingValidators))
+		require.Equal(t, "", resp.NextPageToken)
+		require.Equal(t, "3", resp.TotalSize)
+	})
+	t.Run("past epoch", func(t *testing.T) {
+		code, resp := getPerformance(t, "epoch=1")
+		require.Equal(t, http.StatusOK, code)
+		require.DeepEqual(t, [][]byte{publicKeys[1][:], publicKeys[2][:]}, resp.PublicKeys)
+		require.Equal(t, "1", resp.Epoch)
+		require.Equal(t, "", resp.NextPageToken)
+	})
+	t.Run("future epoch", func(t *testing.T) {
+		code, _ := getPerformance(t, "epoch=3")
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("page size too large", func(t *testing.T) {
+		code, _ := getPerformance(t, fmt.Sprintf("page_size=%d", cmd.Get().MaxRPCPageSize+1))
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("page out of range", func(t *testing.T) {
+		code, _ := getPerformance(t, "page_size=2&page_token=2")
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 	"sync"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -26,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/graffiti"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/blstoexec"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings"
@@ -39,6 +41,7 @@ import (
 	debugv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/debug"
 	nodev1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/node"
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
@@ -46,6 +49,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/io/logs"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	"github.com/prysmaticlabs/prysm/v5/network"
 	ethpbv1alpha1 "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
@@ -94,6 +98,8 @@ type Config struct {
 	ExecutionReconstructor    execution.Reconstructor
 	Host                      string
 	Port                      string
+	UnixSocketPath            string
+	UnixSocketPermissions     os.FileMode
 	CertFlag                  string
 	KeyFlag                   string
 	BeaconMonitoringHost      string
@@ -118,6 +124,7 @@ type Config struct {
 	AttestationsPool          attestations.Pool
 	ExitPool                  voluntaryexits.PoolManager
 	SlashingsPool             slashings.PoolManager
+	SlashingChecker           slasher.SlashingChecker
 	SyncCommitteeObjectPool   synccommittee.Pool
 	BLSChangesPool            blstoexec.PoolManager
 	SyncService               chainSync.Checker
@@ -139,7 +146,16 @@ type Config struct {
 	ClockWaiter               startup.ClockWaiter
 	BlobStorage               *filesystem.BlobStorage
 	TrackedValidatorsCache    *cache.TrackedValidatorsCache
+	AttestationCache          *cache.AttestationCache
 	PayloadIDCache            *cache.PayloadIDCache
+	SubnetAttestationStats    *cache.SubnetAttestationStats
+	ProposalAttSources        *cache.ProposalAttestationSourcesCache
+	SlotObservations          *cache.SlotObservations
+	GraffitiStats             graffiti.StatsFetcher
+	ValidatorLiveness         *cache.ValidatorLiveness
+	EffectiveFlags            map[string]string
+	DisableArchivalAPIQueries bool
+	AdminAPIToken             string
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -154,13 +170,23 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		connectedRPCClients: make(map[net.Addr]bool),
 	}
 
-	address := net.JoinHostPort(s.cfg.Host, s.cfg.Port)
-	lis, err := net.Listen("tcp", address)
-	if err != nil {
-		log.WithError(err).Errorf("Could not listen to port in Start() %s", address)
+	if s.cfg.UnixSocketPath != "" {
+		lis, err := network.ListenUnix(s.cfg.UnixSocketPath, s.cfg.UnixSocketPermissions)
+		if err != nil {
+			log.WithError(err).Errorf("Could not listen to unix socket in Start() %s", s.cfg.UnixSocketPath)
+		} else {
+			s.listener = lis
+			log.WithField("socket", s.cfg.UnixSocketPath).Info("gRPC server listening on unix socket")
+		}
+	} else {
+		address := net.JoinHostPort(s.cfg.Host, s.cfg.Port)
+		lis, err := net.Listen("tcp", address)
+		if err != nil {
+			log.WithError(err).Errorf("Could not listen to port in Start() %s", address)
+		}
+		s.listener = lis
+		log.WithField("address", address).Info("gRPC server listening on port")
 	}
-	s.listener = lis
-	log.WithField("address", address).Info("gRPC server listening on port")
 
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
@@ -171,6 +197,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 			grpcprometheus.StreamServerInterceptor,
 			grpcopentracing.StreamServerInterceptor(),
 			s.validatorStreamConnectionInterceptor,
+			apiCallerStreamInterceptor,
 		)),
 		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
 			recovery.UnaryServerInterceptor(
@@ -179,6 +206,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 			grpcprometheus.UnaryServerInterceptor,
 			grpcopentracing.UnaryServerInterceptor(),
 			s.validatorUnaryConnectionInterceptor,
+			apiCallerUnaryInterceptor,
 		)),
 		grpc.MaxRecvMsgSize(s.cfg.MaxMsgSize),
 	}
@@ -201,12 +229,18 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 	}
 	withCache := stategen.WithCache(stateCache)
 	ch := stategen.NewCanonicalHistory(s.cfg.BeaconDB, s.cfg.ChainInfoFetcher, s.cfg.ChainInfoFetcher, withCache)
+	// The API servers get states by id or replay them through the stater, which enforces the archival policy
+	// for the requests marked by the API interceptors.
 	stater := &lookup.BeaconDbStater{
 		BeaconDB:           s.cfg.BeaconDB,
 		ChainInfoFetcher:   s.cfg.ChainInfoFetcher,
 		GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
 		StateGenService:    s.cfg.StateGen,
 		ReplayerBuilder:    ch,
+		ArchivalPolicy: &lookup.ArchivalPolicy{
+			DisableAPIQueries:   s.cfg.DisableArchivalAPIQueries,
+			FinalizationFetcher: s.cfg.FinalizationFetcher,
+		},
 	}
 	blocker := &lookup.BeaconDbBlocker{
 		BeaconDB:           s.cfg.BeaconDB,
@@ -214,7 +248,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
 		BlobStorage:        s.cfg.BlobStorage,
 	}
-	rewardFetcher := &rewards.BlockRewardService{Replayer: ch, DB: s.cfg.BeaconDB}
+	rewardFetcher := &rewards.BlockRewardService{Replayer: stater, DB: s.cfg.BeaconDB}
 	coreService := &core.Service{
 		BeaconDB:              s.cfg.BeaconDB,
 		HeadFetcher:           s.cfg.HeadFetcher,
@@ -223,12 +257,13 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		Broadcaster:           s.cfg.Broadcaster,
 		SyncCommitteePool:     s.cfg.SyncCommitteeObjectPool,
 		OperationNotifier:     s.cfg.OperationNotifier,
-		AttestationCache:      cache.NewAttestationCache(),
+		AttestationCache:      s.cfg.AttestationCache,
 		StateGen:              s.cfg.StateGen,
 		P2P:                   s.cfg.Broadcaster,
 		FinalizedFetcher:      s.cfg.FinalizationFetcher,
-		ReplayerBuilder:       ch,
+		ReplayerBuilder:       stater,
 		OptimisticModeFetcher: s.cfg.OptimisticModeFetcher,
+		PerformanceCache:      core.NewPerformanceCache(),
 	}
 	validatorServer := &validatorv1alpha1.Server{
 		Ctx:                    s.ctx,
@@ -267,6 +302,9 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		CoreService:            coreService,
 		TrackedValidatorsCache: s.cfg.TrackedValidatorsCache,
 		PayloadIDCache:         s.cfg.PayloadIDCache,
+		SubnetAttestationStats: s.cfg.SubnetAttestationStats,
+		ProposalAttSources:     s.cfg.ProposalAttSources,
+		ValidatorLiveness:      s.cfg.ValidatorLiveness,
 	}
 	s.validatorServer = validatorServer
 	nodeServer := &nodev1alpha1.Server{
@@ -304,7 +342,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		SyncChecker:                 s.cfg.SyncService,
 		ReceivedAttestationsBuffer:  make(chan *ethpbv1alpha1.Attestation, attestationBufferSize),
 		CollectedAttestationsBuffer: make(chan []*ethpbv1alpha1.Attestation, attestationBufferSize),
-		ReplayerBuilder:             ch,
+		ReplayerBuilder:             stater,
 		CoreService:                 coreService,
 	}
 
@@ -329,7 +367,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 			HeadFetcher:        s.cfg.HeadFetcher,
 			PeerManager:        s.cfg.PeerManager,
 			PeersFetcher:       s.cfg.PeersFetcher,
-			ReplayerBuilder:    ch,
+			ReplayerBuilder:    stater,
 		}
 		ethpbv1alpha1.RegisterDebugServer(s.grpcServer, debugServer)
 	}
@@ -347,6 +385,7 @@ var _ stategen.CurrentSlotter = blockchain.ChainInfoFetcher(nil)
 // Start the gRPC server.
 func (s *Service) Start() {
 	grpcprometheus.EnableHandlingTimeHistogram()
+	go s.validatorServer.RunShadowProposals(s.ctx)
 	go func() {
 		if s.listener != nil {
 			if err := s.grpcServer.Serve(s.listener); err != nil {
@@ -407,6 +446,28 @@ func (s *Service) validatorUnaryConnectionInterceptor(
 	return handler(ctx, req)
 }
 
+// Stream interceptor marking the state lookups of gRPC requests as coming from the APIs.
+func apiCallerStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	wrapped := middleware.WrapServerStream(ss)
+	wrapped.WrappedContext = lookup.WithCaller(ss.Context(), lookup.APICaller)
+	return handler(srv, wrapped)
+}
+
+// Unary interceptor marking the state lookups of gRPC requests as coming from the APIs.
+func apiCallerUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	return handler(lookup.WithCaller(ctx, lookup.APICaller), req)
+}
+
 func (s *Service) logNewClientConnection(ctx context.Context) {
 	if features.Get().DisableGRPCConnectionLogs {
 		return
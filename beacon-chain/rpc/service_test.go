@@ -5,11 +5,15 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -101,3 +105,47 @@ func TestRPC_InsecureEndpoint(t *testing.T) {
 	require.LogsContain(t, hook, "You are using an insecure gRPC server")
 	assert.NoError(t, rpcService.Stop())
 }
+
+func TestRPC_UnixSocket(t *testing.T) {
+	hook := logTest.NewGlobal()
+	chainService := &mock.ChainService{Genesis: time.Now()}
+	socketPath := filepath.Join(t.TempDir(), "beacon.sock")
+	rpcService := NewService(context.Background(), &Config{
+		UnixSocketPath:        socketPath,
+		UnixSocketPermissions: 0600,
+		SyncService:           &mockSync.Sync{IsSyncing: false},
+		BlockReceiver:         chainService,
+		GenesisTimeFetcher:    chainService,
+		AttestationReceiver:   chainService,
+		HeadFetcher:           chainService,
+		ExecutionChainService: &mockExecution.Chain{},
+		StateNotifier:         chainService.StateNotifier(),
+		Router:                http.NewServeMux(),
+		ClockWaiter:           startup.NewClockSynchronizer(),
+	})
+
+	rpcService.Start()
+
+	require.LogsContain(t, hook, "listening on unix socket")
+	info, err := os.Stat(socketPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	assert.NoError(t, rpcService.Stop())
+}
+
+func TestAPICaller(t *testing.T) {
+	var caller lookup.Caller
+	handler := apiCallerHandler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		caller = lookup.CallerFromContext(r.Context())
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/eth/v1/beacon/states/1/root", nil))
+	assert.Equal(t, lookup.APICaller, caller)
+
+	caller = lookup.InternalCaller
+	_, err := apiCallerUnaryInterceptor(context.Background(), nil, nil, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		caller = lookup.CallerFromContext(ctx)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, lookup.APICaller, caller)
+}
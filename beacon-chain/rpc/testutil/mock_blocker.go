@@ -17,6 +17,9 @@ type MockBlocker struct {
 	ErrorToReturn error
 	SlotBlockMap  map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock
 	RootBlockMap  map[[32]byte]interfaces.ReadOnlySignedBeaconBlock
+	// BlobsToReturn and BlobsErrToReturn are returned by Blobs, regardless of the requested block.
+	BlobsToReturn    []*blocks.VerifiedROBlob
+	BlobsErrToReturn *core.RpcError
 }
 
 // Block --
@@ -37,5 +40,5 @@ func (m *MockBlocker) Block(_ context.Context, b []byte) (interfaces.ReadOnlySig
 
 // Blobs --
 func (m *MockBlocker) Blobs(_ context.Context, _ string, _ []uint64) ([]*blocks.VerifiedROBlob, *core.RpcError) {
-	panic("implement me")
+	return m.BlobsToReturn, m.BlobsErrToReturn
 }
@@ -15,6 +15,7 @@ type MockStater struct {
 	BeaconStateRoot   []byte
 	StatesBySlot      map[primitives.Slot]state.BeaconState
 	StatesByRoot      map[[32]byte]state.BeaconState
+	StateBySlotErr    error
 }
 
 // State --
@@ -37,5 +38,8 @@ func (m *MockStater) StateRoot(context.Context, []byte) ([]byte, error) {
 
 // StateBySlot --
 func (m *MockStater) StateBySlot(_ context.Context, s primitives.Slot) (state.BeaconState, error) {
+	if m.StateBySlotErr != nil {
+		return nil, m.StateBySlotErr
+	}
 	return m.StatesBySlot[s], nil
 }
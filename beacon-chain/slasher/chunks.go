@@ -230,8 +230,6 @@ func (m *MinSpanChunksSlice) CheckSlashable(
 		return nil, nil
 	}
 
-	surroundingVotesTotal.Inc()
-
 	existing, ok := existingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestation)
 	if !ok {
 		return nil, fmt.Errorf(
@@ -326,8 +324,6 @@ func (m *MaxSpanChunksSlice) CheckSlashable(
 		return nil, nil
 	}
 
-	surroundedVotesTotal.Inc()
-
 	existing, ok := existingAttWrapper.IndexedAttestation.(*ethpb.IndexedAttestation)
 	if !ok {
 		return nil, fmt.Errorf(
@@ -2,8 +2,13 @@ package slasher
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
@@ -11,7 +16,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
+	"golang.org/x/sync/errgroup"
 )
 
 // Takes in a list of indexed attestation wrappers and returns any
@@ -51,100 +58,271 @@ func (s *Service) checkSlashableAttestations(
 	return slashings, nil
 }
 
+// maxChunksBeforeFlush is the maximum number of chunks loaded in memory by checkSurroundVotes
+// before the updated chunks are saved to disk, when no memory budget is configured.
+// With 256 validators and 16 epochs per chunk, there is 4096 `uint16` elements per chunk.
+// 4096 `uint16` elements = 8192 bytes = 8KB
+// 25_600 chunks * 8KB = 200MB
+var maxChunksBeforeFlush = 25_600
+
+// defaultAttestationsBatchBytes is the estimated memory taken by the attestations of a batch processed by
+// checkSlashableAttestationsInBatches, when no memory budget is configured.
+const defaultAttestationsBatchBytes = 200 * 1024 * 1024
+
+// attestationWrapperOverhead is an estimate of the memory taken by an attestation wrapper on top of the
+// SSZ size of its indexed attestation.
+const attestationWrapperOverhead = 256
+
+// checkSlashableAttestationsInBatches checks the attestations for slashings by batches of bounded memory, so that
+// catching up on many epochs of queued attestations does not load them all at once. Attestations are sorted by
+// target epoch, keeping the order of the attestations of the same epoch, and each batch is fully processed,
+// including saving the attestation records and updated chunks to disk, before the next one.
+// The attestations of a target epoch are never split across batches, so double votes are found within a batch, and
+// spans are updated in target epoch order either way, so the slashings found are the same as when checking all the
+// attestations at once.
+func (s *Service) checkSlashableAttestationsInBatches(
+	ctx context.Context, currentEpoch primitives.Epoch, atts []*slashertypes.IndexedAttestationWrapper,
+) (map[[fieldparams.RootLength]byte]ethpb.AttSlashing, error) {
+	batches := s.attestationBatches(atts)
+	if len(batches) <= 1 {
+		return s.checkSlashableAttestations(ctx, currentEpoch, atts)
+	}
+
+	slashings := map[[fieldparams.RootLength]byte]ethpb.AttSlashing{}
+	for i, batch := range batches {
+		start := time.Now()
+		batchSlashings, err := s.checkSlashableAttestations(ctx, currentEpoch, batch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not check batch %d of %d", i+1, len(batches))
+		}
+		for root, slashing := range batchSlashings {
+			slashings[root] = slashing
+		}
+		log.WithFields(logrus.Fields{
+			"batch":        i + 1,
+			"batches":      len(batches),
+			"numAtts":      len(batch),
+			"fromEpoch":    batch[0].IndexedAttestation.GetData().Target.Epoch,
+			"toEpoch":      batch[len(batch)-1].IndexedAttestation.GetData().Target.Epoch,
+			"numSlashings": len(batchSlashings),
+			"elapsed":      time.Since(start),
+		}).Info("Processed batch of queued attestations")
+	}
+	return slashings, nil
+}
+
+// attestationBatches sorts the attestations by target epoch and splits them into batches whose estimated memory
+// stays within the attestations budget, unless the attestations of a single target epoch exceed it.
+func (s *Service) attestationBatches(atts []*slashertypes.IndexedAttestationWrapper) [][]*slashertypes.IndexedAttestationWrapper {
+	if len(atts) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(atts)
+	slices.SortStableFunc(sorted, func(a, b *slashertypes.IndexedAttestationWrapper) int {
+		return cmp.Compare(a.IndexedAttestation.GetData().Target.Epoch, b.IndexedAttestation.GetData().Target.Epoch)
+	})
+
+	budget := s.attestationsBatchBytes()
+	var batches [][]*slashertypes.IndexedAttestationWrapper
+	first, size := 0, uint64(0)
+	for i, att := range sorted {
+		attSize := uint64(att.IndexedAttestation.SizeSSZ()) + attestationWrapperOverhead
+		newEpoch := i > first && att.IndexedAttestation.GetData().Target.Epoch != sorted[i-1].IndexedAttestation.GetData().Target.Epoch
+		if size+attSize > budget && newEpoch {
+			batches = append(batches, sorted[first:i])
+			first, size = i, 0
+		}
+		size += attSize
+	}
+	return append(batches, sorted[first:])
+}
+
+// attestationsBatchBytes returns the estimated memory the attestations of a batch may take, which is half the
+// memory budget when one is configured.
+func (s *Service) attestationsBatchBytes() uint64 {
+	if s.serviceCfg == nil || s.serviceCfg.MemoryBudget == 0 {
+		return defaultAttestationsBatchBytes
+	}
+	return max(1, s.serviceCfg.MemoryBudget/2)
+}
+
+// chunksBeforeFlush returns the number of chunks loaded in memory by checkSurroundVotes before the updated chunks are
+// saved to disk, which is half the memory budget when one is configured.
+func (s *Service) chunksBeforeFlush() int {
+	if s.serviceCfg == nil || s.serviceCfg.MemoryBudget == 0 {
+		return maxChunksBeforeFlush
+	}
+	// A chunk holds a `uint16` element for each epoch of the chunk of each validator of the validator chunk.
+	chunkBytes := 2 * s.params.chunkSize * s.params.validatorChunkSize
+	return int(max(1, s.serviceCfg.MemoryBudget/2/chunkBytes))
+}
+
 // Check for surrounding and surrounded votes in our database given a list of incoming attestations.
+// Validator chunk indexes are processed by batches of at most `chunksBeforeFlush` chunks:
+// all the chunks needed by a batch are loaded from the database at once, then the validator
+// chunk indexes of the batch are processed concurrently, and finally the updated chunks are saved.
 func (s *Service) checkSurroundVotes(
 	ctx context.Context,
 	attWrappers []*slashertypes.IndexedAttestationWrapper,
 	currentEpoch primitives.Epoch,
 ) (map[[fieldparams.RootLength]byte]ethpb.AttSlashing, error) {
-	// With 256 validators and 16 epochs per chunk, there is 4096 `uint16` elements per chunk.
-	// 4096 `uint16` elements = 8192 bytes = 8KB
-	// 25_600 chunks * 8KB = 200MB
-	const maxChunkBeforeFlush = 25_600
-
 	slashings := map[[fieldparams.RootLength]byte]ethpb.AttSlashing{}
 
 	// Group attestation wrappers by validator chunk index.
 	attWrappersByValidatorChunkIndex := s.groupByValidatorChunkIndex(attWrappers)
-	attWrappersByValidatorChunkIndexCount := len(attWrappersByValidatorChunkIndex)
 
-	minChunkByChunkIndexByValidatorChunkIndex := make(map[uint64]map[uint64]Chunker, attWrappersByValidatorChunkIndexCount)
-	maxChunkByChunkIndexByValidatorChunkIndex := make(map[uint64]map[uint64]Chunker, attWrappersByValidatorChunkIndexCount)
+	// Sort validator chunk indexes so batches do not depend on the map iteration order.
+	validatorChunkIndexes := maps.Keys(attWrappersByValidatorChunkIndex)
+	slices.Sort(validatorChunkIndexes)
 
-	chunksCounts := 0
+	neededChunkIndexesByValidatorChunkIndex := make(map[uint64][]uint64)
+	chunksCount := 0
+	flushThreshold := s.chunksBeforeFlush()
 
-	for validatorChunkIndex, attWrappers := range attWrappersByValidatorChunkIndex {
-		minChunkByChunkIndex, err := s.updatedChunkByChunkIndex(ctx, slashertypes.MinSpan, currentEpoch, validatorChunkIndex)
+	for i, validatorChunkIndex := range validatorChunkIndexes {
+		neededChunkIndexes, err := s.neededChunkIndexes(validatorChunkIndex, currentEpoch)
 		if err != nil {
-			return nil, errors.Wrap(err, "could not update updatedMinChunks")
+			return nil, errors.Wrap(err, "could not find the needed chunk indexes")
 		}
 
-		maxChunkByChunkIndex, err := s.updatedChunkByChunkIndex(ctx, slashertypes.MaxSpan, currentEpoch, validatorChunkIndex)
-		if err != nil {
-			return nil, errors.Wrap(err, "could not update updatedMaxChunks")
-		}
+		neededChunkIndexesByValidatorChunkIndex[validatorChunkIndex] = neededChunkIndexes
 
-		chunksCounts += len(minChunkByChunkIndex) + len(maxChunkByChunkIndex)
+		// Both min and max chunks are needed.
+		chunksCount += 2 * len(neededChunkIndexes)
 
-		// Group (already grouped by validator chunk index) attestation wrappers by chunk index.
-		attWrappersByChunkIndex := s.groupByChunkIndex(attWrappers)
+		if chunksCount < flushThreshold && i < len(validatorChunkIndexes)-1 {
+			continue
+		}
 
-		// Check for surrounding votes.
-		surroundingSlashings, err := s.updateSpans(ctx, minChunkByChunkIndex, attWrappersByChunkIndex, slashertypes.MinSpan, validatorChunkIndex, currentEpoch)
+		batchSlashings, err := s.checkSurroundVotesBatch(
+			ctx, attWrappersByValidatorChunkIndex, neededChunkIndexesByValidatorChunkIndex, currentEpoch,
+		)
 		if err != nil {
-			return nil, errors.Wrapf(err, "could not update min attestation spans for validator chunk index %d", validatorChunkIndex)
+			return nil, err
 		}
 
-		for root, slashing := range surroundingSlashings {
+		for root, slashing := range batchSlashings {
 			slashings[root] = slashing
 		}
 
-		// Check for surrounded votes.
-		surroundedSlashings, err := s.updateSpans(ctx, maxChunkByChunkIndex, attWrappersByChunkIndex, slashertypes.MaxSpan, validatorChunkIndex, currentEpoch)
-		if err != nil {
-			return nil, errors.Wrapf(err, "could not update max attestation spans for validator chunk index %d", validatorChunkIndex)
-		}
+		// Reset the batch.
+		neededChunkIndexesByValidatorChunkIndex = make(map[uint64][]uint64)
+		chunksCount = 0
+	}
 
-		for root, slashing := range surroundedSlashings {
-			slashings[root] = slashing
-		}
+	return slashings, nil
+}
 
-		// Memoize the updated chunks for the current validator chunk index.
-		minChunkByChunkIndexByValidatorChunkIndex[validatorChunkIndex] = minChunkByChunkIndex
-		maxChunkByChunkIndexByValidatorChunkIndex[validatorChunkIndex] = maxChunkByChunkIndex
+// checkSurroundVotesBatch loads the min and max chunks needed by the validator chunk indexes of the batch,
+// updates them with the attestations, concurrently across validator chunk indexes, then saves them to disk.
+func (s *Service) checkSurroundVotesBatch(
+	ctx context.Context,
+	attWrappersByValidatorChunkIndex map[uint64][]*slashertypes.IndexedAttestationWrapper,
+	neededChunkIndexesByValidatorChunkIndex map[uint64][]uint64,
+	currentEpoch primitives.Epoch,
+) (map[[fieldparams.RootLength]byte]ethpb.AttSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "Slasher.checkSurroundVotesBatch")
+	defer span.End()
 
-		if chunksCounts >= maxChunkBeforeFlush {
-			// Save the updated chunks to disk if we have reached the maximum number of chunks to store in memory.
-			if err := s.saveChunksToDisk(ctx, slashertypes.MinSpan, minChunkByChunkIndexByValidatorChunkIndex); err != nil {
-				return nil, errors.Wrap(err, "could not save updated min chunks to disk")
+	minChunkByChunkIndexByValidatorChunkIndex, err := s.loadChunksByValidatorChunkIndex(ctx, slashertypes.MinSpan, neededChunkIndexesByValidatorChunkIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load min chunks from disk")
+	}
+
+	maxChunkByChunkIndexByValidatorChunkIndex, err := s.loadChunksByValidatorChunkIndex(ctx, slashertypes.MaxSpan, neededChunkIndexesByValidatorChunkIndex)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load max chunks from disk")
+	}
+
+	// Validator chunk indexes cover disjoint sets of validators, and so disjoint chunks.
+	// They can thus be processed concurrently.
+	var mu sync.Mutex
+	slashings := map[[fieldparams.RootLength]byte]ethpb.AttSlashing{}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(runtime.GOMAXPROCS(0))
+	for validatorChunkIndex := range neededChunkIndexesByValidatorChunkIndex {
+		minChunkByChunkIndex := minChunkByChunkIndexByValidatorChunkIndex[validatorChunkIndex]
+		maxChunkByChunkIndex := maxChunkByChunkIndexByValidatorChunkIndex[validatorChunkIndex]
+		attWrappers := attWrappersByValidatorChunkIndex[validatorChunkIndex]
+
+		g.Go(func() error {
+			validatorChunkSlashings, err := s.checkSurroundVotesForValidatorChunk(
+				gctx, minChunkByChunkIndex, maxChunkByChunkIndex, attWrappers, validatorChunkIndex, currentEpoch,
+			)
+			if err != nil {
+				return err
 			}
 
-			if err := s.saveChunksToDisk(ctx, slashertypes.MaxSpan, maxChunkByChunkIndexByValidatorChunkIndex); err != nil {
-				return nil, errors.Wrap(err, "could not save updated max chunks to disk")
+			mu.Lock()
+			defer mu.Unlock()
+			for root, slashing := range validatorChunkSlashings {
+				slashings[root] = slashing
 			}
+			return nil
+		})
+	}
 
-			// Reset the chunks counts.
-			chunksCounts = 0
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
 
-			// Reset memoized chunks.
-			minChunkByChunkIndexByValidatorChunkIndex = make(map[uint64]map[uint64]Chunker, attWrappersByValidatorChunkIndexCount)
-			maxChunkByChunkIndexByValidatorChunkIndex = make(map[uint64]map[uint64]Chunker, attWrappersByValidatorChunkIndexCount)
-		}
+	// Save the updated chunks to disk.
+	if err := s.saveChunksToDisk(ctx, slashertypes.MinSpan, minChunkByChunkIndexByValidatorChunkIndex); err != nil {
+		return nil, errors.Wrap(err, "could not save updated min chunks to disk")
+	}
+
+	if err := s.saveChunksToDisk(ctx, slashertypes.MaxSpan, maxChunkByChunkIndexByValidatorChunkIndex); err != nil {
+		return nil, errors.Wrap(err, "could not save updated max chunks to disk")
+	}
 
-		// Update the latest updated epoch for all validators involved to the current chunk.
+	// Update the latest updated epoch for all validators involved to the current chunk.
+	for validatorChunkIndex := range neededChunkIndexesByValidatorChunkIndex {
 		indexes := s.params.ValidatorIndexesInChunk(validatorChunkIndex)
 		for _, index := range indexes {
 			s.latestEpochUpdatedForValidator[index] = currentEpoch
 		}
 	}
 
-	// Save the updated chunks to disk.
-	if err := s.saveChunksToDisk(ctx, slashertypes.MinSpan, minChunkByChunkIndexByValidatorChunkIndex); err != nil {
-		return nil, errors.Wrap(err, "could not save updated min chunks to disk")
+	return slashings, nil
+}
+
+// checkSurroundVotesForValidatorChunk updates the min and max chunks of a validator chunk index with the
+// neutral element up to the current epoch, then applies the attestations to them and returns any slashing found.
+// It only reads `latestEpochUpdatedForValidator`, so it is safe to call concurrently for distinct validator chunk indexes.
+func (s *Service) checkSurroundVotesForValidatorChunk(
+	ctx context.Context,
+	minChunkByChunkIndex map[uint64]Chunker,
+	maxChunkByChunkIndex map[uint64]Chunker,
+	attWrappers []*slashertypes.IndexedAttestationWrapper,
+	validatorChunkIndex uint64,
+	currentEpoch primitives.Epoch,
+) (map[[fieldparams.RootLength]byte]ethpb.AttSlashing, error) {
+	if err := s.neutralizeChunks(minChunkByChunkIndex, validatorChunkIndex, currentEpoch); err != nil {
+		return nil, errors.Wrap(err, "could not update updatedMinChunks")
 	}
 
-	if err := s.saveChunksToDisk(ctx, slashertypes.MaxSpan, maxChunkByChunkIndexByValidatorChunkIndex); err != nil {
-		return nil, errors.Wrap(err, "could not save updated max chunks to disk")
+	if err := s.neutralizeChunks(maxChunkByChunkIndex, validatorChunkIndex, currentEpoch); err != nil {
+		return nil, errors.Wrap(err, "could not update updatedMaxChunks")
+	}
+
+	// Group (already grouped by validator chunk index) attestation wrappers by chunk index.
+	attWrappersByChunkIndex := s.groupByChunkIndex(attWrappers)
+
+	// Check for surrounding votes.
+	slashings, err := s.updateSpans(ctx, minChunkByChunkIndex, attWrappersByChunkIndex, slashertypes.MinSpan, validatorChunkIndex, currentEpoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not update min attestation spans for validator chunk index %d", validatorChunkIndex)
+	}
+
+	// Check for surrounded votes.
+	surroundedSlashings, err := s.updateSpans(ctx, maxChunkByChunkIndex, attWrappersByChunkIndex, slashertypes.MaxSpan, validatorChunkIndex, currentEpoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not update max attestation spans for validator chunk index %d", validatorChunkIndex)
+	}
+
+	for root, slashing := range surroundedSlashings {
+		slashings[root] = slashing
 	}
 
 	return slashings, nil
@@ -239,9 +417,24 @@ func (s *Service) checkDoubleVotes(
 		return nil, errors.Wrap(err, "could not retrieve potential double votes from disk")
 	}
 
-	for _, doubleVote := range doubleVotes {
-		doubleVotesTotal.Inc()
+	doubleVotesTotal.Add(float64(len(doubleVotes)))
+	databaseSlashings, err := doubleVoteSlashings(doubleVotes)
+	if err != nil {
+		return nil, err
+	}
+
+	for root, slashing := range databaseSlashings {
+		slashings[root] = slashing
+	}
+
+	return slashings, nil
+}
 
+// doubleVoteSlashings builds the attester slashings corresponding to double votes found in the database.
+func doubleVoteSlashings(doubleVotes []*slashertypes.AttesterDoubleVote) (map[[fieldparams.RootLength]byte]ethpb.AttSlashing, error) {
+	slashings := map[[fieldparams.RootLength]byte]ethpb.AttSlashing{}
+
+	for _, doubleVote := range doubleVotes {
 		wrapper_1 := doubleVote.Wrapper_1
 		wrapper_2 := doubleVote.Wrapper_2
 
@@ -297,6 +490,27 @@ func (s *Service) updatedChunkByChunkIndex(
 	currentEpoch primitives.Epoch,
 	validatorChunkIndex uint64,
 ) (map[uint64]Chunker, error) {
+	neededChunkIndexes, err := s.neededChunkIndexes(validatorChunkIndex, currentEpoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not find the needed chunk indexed")
+	}
+
+	// Retrieve needed chunks from the database.
+	chunkByChunkIndex, err := s.loadChunksFromDisk(ctx, validatorChunkIndex, chunkKind, neededChunkIndexes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load chunks from disk")
+	}
+
+	if err := s.neutralizeChunks(chunkByChunkIndex, validatorChunkIndex, currentEpoch); err != nil {
+		return nil, err
+	}
+
+	return chunkByChunkIndex, nil
+}
+
+// neededChunkIndexes returns the indexes of the chunks to update with the neutral element
+// for validators corresponding to the `validatorChunkIndex`.
+func (s *Service) neededChunkIndexes(validatorChunkIndex uint64, currentEpoch primitives.Epoch) ([]uint64, error) {
 	// Every validator may have a first epoch to update.
 	// For a given validator,
 	// - If it has no latest updated epoch, then the first epoch to update is set to 0.
@@ -306,32 +520,33 @@ func (s *Service) updatedChunkByChunkIndex(
 
 	// minFirstEpochToUpdate is set to the smallest first epoch to update for all validators in the chunk
 	// corresponding to the `validatorChunkIndex`.
-	var (
-		minFirstEpochToUpdate *primitives.Epoch
-		neededChunkIndexesMap map[uint64]bool
+	var minFirstEpochToUpdate *primitives.Epoch
 
-		err error
-	)
 	validatorIndexes := s.params.ValidatorIndexesInChunk(validatorChunkIndex)
 
-	if neededChunkIndexesMap, err = s.findNeededChunkIndexes(validatorIndexes, currentEpoch, minFirstEpochToUpdate); err != nil {
-		return nil, errors.Wrap(err, "could not find the needed chunk indexed")
+	neededChunkIndexesMap, err := s.findNeededChunkIndexes(validatorIndexes, currentEpoch, minFirstEpochToUpdate)
+	if err != nil {
+		return nil, err
 	}
 
 	// Transform the map of needed chunk indexes to a slice.
-	neededChunkIndexes := maps.Keys(neededChunkIndexesMap)
+	return maps.Keys(neededChunkIndexesMap), nil
+}
 
-	// Retrieve needed chunks from the database.
-	chunkByChunkIndex, err := s.loadChunksFromDisk(ctx, validatorChunkIndex, chunkKind, neededChunkIndexes)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not load chunks from disk")
-	}
+// neutralizeChunks updates the chunks with the neutral element for validators corresponding to
+// the `validatorChunkIndex`, from the epoch just after their latest updated epoch to the current epoch.
+func (s *Service) neutralizeChunks(
+	chunkByChunkIndex map[uint64]Chunker,
+	validatorChunkIndex uint64,
+	currentEpoch primitives.Epoch,
+) error {
+	validatorIndexes := s.params.ValidatorIndexesInChunk(validatorChunkIndex)
 
 	for _, validatorIndex := range validatorIndexes {
 		// Retrieve the first epoch to write for the validator index.
 		isAnEpochToUpdate, firstEpochToUpdate, err := s.firstEpochToUpdate(validatorIndex, currentEpoch)
 		if err != nil {
-			return nil, errors.Wrapf(err, "could not get first epoch to write for validator index %d with current epoch %d", validatorIndex, currentEpoch)
+			return errors.Wrapf(err, "could not get first epoch to write for validator index %d with current epoch %d", validatorIndex, currentEpoch)
 		}
 
 		if !isAnEpochToUpdate {
@@ -348,7 +563,7 @@ func (s *Service) updatedChunkByChunkIndex(
 			// Get the chunk corresponding to the chunk index from the `chunkByChunkIndex` map.
 			currentChunk, ok := chunkByChunkIndex[chunkIndex]
 			if !ok {
-				return nil, errors.Errorf("chunk at index %d does not exist", chunkIndex)
+				return errors.Errorf("chunk at index %d does not exist", chunkIndex)
 			}
 
 			// Update the current chunk with the neutral element for the validator index for the epoch to write.
@@ -360,7 +575,7 @@ func (s *Service) updatedChunkByChunkIndex(
 					epochToUpdate,
 					currentChunk.NeutralElement(),
 				); err != nil {
-					return nil, err
+					return err
 				}
 
 				epochToUpdate++
@@ -370,7 +585,7 @@ func (s *Service) updatedChunkByChunkIndex(
 		}
 	}
 
-	return chunkByChunkIndex, nil
+	return nil
 }
 
 // findNeededChunkIndexes returns a map of chunk indexes
@@ -469,44 +684,55 @@ func (s *Service) updateSpans(
 	// slashings along the way.
 	slashings := map[[fieldparams.RootLength]byte]ethpb.AttSlashing{}
 
-	for _, attWrappers := range attWrapperByChunkIdx {
-		for _, attWrapper := range attWrappers {
-			for _, validatorIdx := range attWrapper.IndexedAttestation.GetAttestingIndices() {
-				validatorIndex := primitives.ValidatorIndex(validatorIdx)
-				computedValidatorChunkIdx := s.params.validatorChunkIndex(validatorIndex)
-
-				// Every validator chunk index represents a range of validators.
-				// It is possible that the validator index in this loop iteration is
-				// not part of the validator chunk index we are updating chunks for.
-				//
-				// For example, if there are 4 validators per validator chunk index,
-				// then validator chunk index 0 contains validator indices [0, 1, 2, 3]
-				// If we see an attestation with attesting indices [3, 4, 5] and we are updating
-				// chunks for validator chunk index 0, only validator index 3 should make
-				// it past this line.
-				if validatorChunkIndex != computedValidatorChunkIdx {
-					continue
-				}
-
-				slashing, err := s.applyAttestationForValidator(
-					ctx, updatedChunks, attWrapper, kind, validatorChunkIndex, validatorIndex, currentEpoch,
-				)
+	// Which of two conflicting attestations is found slashable depends on the order they are applied in,
+	// so they are applied in target epoch order, then chunk index order, to find the same slashings
+	// whether attestations are checked at once or by batches of target epochs.
+	chunkIndexes := maps.Keys(attWrapperByChunkIdx)
+	slices.Sort(chunkIndexes)
+	orderedAttWrappers := make([]*slashertypes.IndexedAttestationWrapper, 0)
+	for _, chunkIndex := range chunkIndexes {
+		orderedAttWrappers = append(orderedAttWrappers, attWrapperByChunkIdx[chunkIndex]...)
+	}
+	slices.SortStableFunc(orderedAttWrappers, func(a, b *slashertypes.IndexedAttestationWrapper) int {
+		return cmp.Compare(a.IndexedAttestation.GetData().Target.Epoch, b.IndexedAttestation.GetData().Target.Epoch)
+	})
+
+	for _, attWrapper := range orderedAttWrappers {
+		for _, validatorIdx := range attWrapper.IndexedAttestation.GetAttestingIndices() {
+			validatorIndex := primitives.ValidatorIndex(validatorIdx)
+			computedValidatorChunkIdx := s.params.validatorChunkIndex(validatorIndex)
+
+			// Every validator chunk index represents a range of validators.
+			// It is possible that the validator index in this loop iteration is
+			// not part of the validator chunk index we are updating chunks for.
+			//
+			// For example, if there are 4 validators per validator chunk index,
+			// then validator chunk index 0 contains validator indices [0, 1, 2, 3]
+			// If we see an attestation with attesting indices [3, 4, 5] and we are updating
+			// chunks for validator chunk index 0, only validator index 3 should make
+			// it past this line.
+			if validatorChunkIndex != computedValidatorChunkIdx {
+				continue
+			}
 
-				if err != nil {
-					return nil, errors.Wrapf(err, "could not apply attestation for validator index %d", validatorIndex)
-				}
+			slashing, err := s.applyAttestationForValidator(
+				ctx, updatedChunks, attWrapper, kind, validatorChunkIndex, validatorIndex, currentEpoch,
+			)
 
-				if slashing == nil {
-					continue
-				}
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not apply attestation for validator index %d", validatorIndex)
+			}
 
-				root, err := slashing.HashTreeRoot()
-				if err != nil {
-					return nil, errors.Wrap(err, "could not hash tree root for attester slashing")
-				}
+			if slashing == nil {
+				continue
+			}
 
-				slashings[root] = slashing
+			root, err := slashing.HashTreeRoot()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not hash tree root for attester slashing")
 			}
+
+			slashings[root] = slashing
 		}
 	}
 
@@ -561,6 +787,12 @@ func (s *Service) applyAttestationForValidator(
 		)
 	}
 	if slashing != nil {
+		switch chunkKind {
+		case slashertypes.MinSpan:
+			surroundingVotesTotal.Inc()
+		case slashertypes.MaxSpan:
+			surroundedVotesTotal.Inc()
+		}
 		return slashing, nil
 	}
 
@@ -648,20 +880,49 @@ func (s *Service) loadChunksFromDisk(
 	chunkKind slashertypes.ChunkKind,
 	chunkIndexes []uint64,
 ) (map[uint64]Chunker, error) {
+	chunkByChunkIndexByValidatorChunkIndex, err := s.loadChunksByValidatorChunkIndex(
+		ctx, chunkKind, map[uint64][]uint64{validatorChunkIndex: chunkIndexes},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunkByChunkIndexByValidatorChunkIndex[validatorChunkIndex], nil
+}
+
+// loadChunksByValidatorChunkIndex loads, with a single database call, the chunks of the specified kind for
+// every (validator chunk index, chunk index) pair. Chunks which do not exist in the database are initialized empty.
+// A map is returned for every requested validator chunk index, even if no chunk index is requested for it.
+func (s *Service) loadChunksByValidatorChunkIndex(
+	ctx context.Context,
+	chunkKind slashertypes.ChunkKind,
+	chunkIndexesByValidatorChunkIndex map[uint64][]uint64,
+) (map[uint64]map[uint64]Chunker, error) {
 	ctx, span := trace.StartSpan(ctx, "Slasher.loadChunks")
 	defer span.End()
 
-	chunksCount := len(chunkIndexes)
+	chunkByChunkIndexByValidatorChunkIndex := make(map[uint64]map[uint64]Chunker, len(chunkIndexesByValidatorChunkIndex))
+
+	// Build chunk keys.
+	chunksCount := 0
+	for validatorChunkIndex, chunkIndexes := range chunkIndexesByValidatorChunkIndex {
+		chunkByChunkIndexByValidatorChunkIndex[validatorChunkIndex] = make(map[uint64]Chunker, len(chunkIndexes))
+		chunksCount += len(chunkIndexes)
+	}
 
 	if chunksCount == 0 {
-		return map[uint64]Chunker{}, nil
+		return chunkByChunkIndexByValidatorChunkIndex, nil
 	}
 
-	// Build chunk keys.
 	chunkKeys := make([][]byte, 0, chunksCount)
-	for _, chunkIndex := range chunkIndexes {
-		chunkKey := s.params.flatSliceID(validatorChunkIndex, chunkIndex)
-		chunkKeys = append(chunkKeys, chunkKey)
+	validatorChunkIndexes := make([]uint64, 0, chunksCount)
+	chunkIndexes := make([]uint64, 0, chunksCount)
+	for validatorChunkIndex, indexes := range chunkIndexesByValidatorChunkIndex {
+		for _, chunkIndex := range indexes {
+			chunkKeys = append(chunkKeys, s.params.flatSliceID(validatorChunkIndex, chunkIndex))
+			validatorChunkIndexes = append(validatorChunkIndexes, validatorChunkIndex)
+			chunkIndexes = append(chunkIndexes, chunkIndex)
+		}
 	}
 
 	// Load the chunks from the database.
@@ -680,7 +941,6 @@ func (s *Service) loadChunksFromDisk(
 	}
 
 	// Initialize the chunks.
-	chunksByChunkIdx := make(map[uint64]Chunker, chunksCount)
 	for i := 0; i < len(rawChunks); i++ {
 		// If the chunk exists in the database, we initialize it from the raw bytes data.
 		// If it does not exist, we initialize an empty chunk.
@@ -711,10 +971,10 @@ func (s *Service) loadChunksFromDisk(
 			return nil, errors.Wrap(err, "could not initialize chunk")
 		}
 
-		chunksByChunkIdx[chunkIndexes[i]] = chunk
+		chunkByChunkIndexByValidatorChunkIndex[validatorChunkIndexes[i]][chunkIndexes[i]] = chunk
 	}
 
-	return chunksByChunkIdx, nil
+	return chunkByChunkIndexByValidatorChunkIndex, nil
 }
 
 func (s *Service) saveChunksToDisk(
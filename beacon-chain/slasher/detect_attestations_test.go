@@ -1132,6 +1132,261 @@ func Test_updatedChunkByChunkIndex(t *testing.T) {
 	}
 }
 
+// checkSurroundVotesSerial is the reference implementation of checkSurroundVotes,
+// loading and updating the chunks one validator chunk index after the other.
+func checkSurroundVotesSerial(
+	ctx context.Context,
+	s *Service,
+	attWrappers []*slashertypes.IndexedAttestationWrapper,
+	currentEpoch primitives.Epoch,
+	maxChunkBeforeFlush int,
+) (map[[fieldparams.RootLength]byte]ethpb.AttSlashing, error) {
+	slashings := map[[fieldparams.RootLength]byte]ethpb.AttSlashing{}
+	minChunkByChunkIndexByValidatorChunkIndex := map[uint64]map[uint64]Chunker{}
+	maxChunkByChunkIndexByValidatorChunkIndex := map[uint64]map[uint64]Chunker{}
+	chunksCounts := 0
+
+	for validatorChunkIndex, attWrappers := range s.groupByValidatorChunkIndex(attWrappers) {
+		minChunkByChunkIndex, err := s.updatedChunkByChunkIndex(ctx, slashertypes.MinSpan, currentEpoch, validatorChunkIndex)
+		if err != nil {
+			return nil, err
+		}
+		maxChunkByChunkIndex, err := s.updatedChunkByChunkIndex(ctx, slashertypes.MaxSpan, currentEpoch, validatorChunkIndex)
+		if err != nil {
+			return nil, err
+		}
+		chunksCounts += len(minChunkByChunkIndex) + len(maxChunkByChunkIndex)
+
+		attWrappersByChunkIndex := s.groupByChunkIndex(attWrappers)
+		surroundingSlashings, err := s.updateSpans(ctx, minChunkByChunkIndex, attWrappersByChunkIndex, slashertypes.MinSpan, validatorChunkIndex, currentEpoch)
+		if err != nil {
+			return nil, err
+		}
+		surroundedSlashings, err := s.updateSpans(ctx, maxChunkByChunkIndex, attWrappersByChunkIndex, slashertypes.MaxSpan, validatorChunkIndex, currentEpoch)
+		if err != nil {
+			return nil, err
+		}
+		for root, slashing := range surroundingSlashings {
+			slashings[root] = slashing
+		}
+		for root, slashing := range surroundedSlashings {
+			slashings[root] = slashing
+		}
+
+		minChunkByChunkIndexByValidatorChunkIndex[validatorChunkIndex] = minChunkByChunkIndex
+		maxChunkByChunkIndexByValidatorChunkIndex[validatorChunkIndex] = maxChunkByChunkIndex
+
+		if chunksCounts >= maxChunkBeforeFlush {
+			if err := s.saveChunksToDisk(ctx, slashertypes.MinSpan, minChunkByChunkIndexByValidatorChunkIndex); err != nil {
+				return nil, err
+			}
+			if err := s.saveChunksToDisk(ctx, slashertypes.MaxSpan, maxChunkByChunkIndexByValidatorChunkIndex); err != nil {
+				return nil, err
+			}
+			chunksCounts = 0
+			minChunkByChunkIndexByValidatorChunkIndex = map[uint64]map[uint64]Chunker{}
+			maxChunkByChunkIndexByValidatorChunkIndex = map[uint64]map[uint64]Chunker{}
+		}
+
+		for _, index := range s.params.ValidatorIndexesInChunk(validatorChunkIndex) {
+			s.latestEpochUpdatedForValidator[index] = currentEpoch
+		}
+	}
+
+	if err := s.saveChunksToDisk(ctx, slashertypes.MinSpan, minChunkByChunkIndexByValidatorChunkIndex); err != nil {
+		return nil, err
+	}
+	if err := s.saveChunksToDisk(ctx, slashertypes.MaxSpan, maxChunkByChunkIndexByValidatorChunkIndex); err != nil {
+		return nil, err
+	}
+	return slashings, nil
+}
+
+// randomAttestationHistory returns, for every epoch from 0 to `epochsCount - 1`, attestations for a random subset
+// of the validators. Every validator attests at most once per epoch.
+func randomAttestationHistory(
+	t *testing.T, r *rand.Rand, p *Parameters, validatorsCount uint64, epochsCount primitives.Epoch,
+) [][]*slashertypes.IndexedAttestationWrapper {
+	history := make([][]*slashertypes.IndexedAttestationWrapper, 0, epochsCount)
+	for currentEpoch := primitives.Epoch(0); currentEpoch < epochsCount; currentEpoch++ {
+		lowestSource := primitives.Epoch(0)
+		if currentEpoch >= p.historyLength {
+			lowestSource = currentEpoch - p.historyLength + 1
+		}
+
+		// Pick a few attestation data, then assign each attesting validator to one of them.
+		const dataCount = 3
+		indicesByData := make([][]uint64, dataCount)
+		for validatorIndex := uint64(0); validatorIndex < validatorsCount; validatorIndex++ {
+			if r.Intn(2) == 0 {
+				continue
+			}
+			i := r.Intn(dataCount)
+			indicesByData[i] = append(indicesByData[i], validatorIndex)
+		}
+
+		atts := make([]*slashertypes.IndexedAttestationWrapper, 0, dataCount)
+		for _, indices := range indicesByData {
+			if len(indices) == 0 {
+				continue
+			}
+			target := currentEpoch - primitives.Epoch(r.Intn(int(min(currentEpoch+1, 4))))
+			source := target
+			if target > lowestSource {
+				source = lowestSource + primitives.Epoch(r.Intn(int(target-lowestSource)))
+			} else if target != 0 {
+				continue
+			}
+			beaconBlockRoot := []byte{byte(r.Intn(2))}
+			atts = append(atts, createAttestationWrapperEmptySig(t, source, target, indices, beaconBlockRoot))
+		}
+		history = append(history, atts)
+	}
+	return history
+}
+
+func Test_checkSurroundVotes_MatchesSerial(t *testing.T) {
+	const (
+		validatorsCount = 64
+		epochsCount     = 80
+	)
+	p := &Parameters{
+		chunkSize:          4,
+		validatorChunkSize: 4,
+		historyLength:      32,
+	}
+
+	for _, flush := range []int{8, 25_600} {
+		for seed := int64(0); seed < 3; seed++ {
+			t.Run(fmt.Sprintf("flush=%d/seed=%d", flush, seed), func(t *testing.T) {
+				defer func(previous int) { maxChunksBeforeFlush = previous }(maxChunksBeforeFlush)
+				maxChunksBeforeFlush = flush
+
+				ctx := context.Background()
+				history := randomAttestationHistory(t, rand.New(rand.NewSource(seed)), p, validatorsCount, epochsCount)
+
+				newService := func() *Service {
+					return &Service{
+						params:                         p,
+						serviceCfg:                     &ServiceConfig{Database: dbtest.SetupSlasherDB(t)},
+						latestEpochUpdatedForValidator: map[primitives.ValidatorIndex]primitives.Epoch{},
+					}
+				}
+				batched, serial := newService(), newService()
+
+				for currentEpoch, atts := range history {
+					epoch := primitives.Epoch(currentEpoch)
+					require.NoError(t, batched.serviceCfg.Database.SaveAttestationRecordsForValidators(ctx, atts))
+					require.NoError(t, serial.serviceCfg.Database.SaveAttestationRecordsForValidators(ctx, atts))
+
+					batchedSlashings, err := batched.checkSurroundVotes(ctx, atts, epoch)
+					require.NoError(t, err)
+					serialSlashings, err := checkSurroundVotesSerial(ctx, serial, atts, epoch, flush)
+					require.NoError(t, err)
+
+					require.Equal(t, len(serialSlashings), len(batchedSlashings), "slashings count at epoch %d", epoch)
+					for root := range serialSlashings {
+						_, ok := batchedSlashings[root]
+						require.Equal(t, true, ok, "missing slashing at epoch %d", epoch)
+					}
+					require.DeepEqual(t, serial.latestEpochUpdatedForValidator, batched.latestEpochUpdatedForValidator)
+				}
+
+				chunksCount := uint64(p.historyLength) / p.chunkSize
+				for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+					for validatorChunkIndex := uint64(0); validatorChunkIndex < validatorsCount/p.validatorChunkSize; validatorChunkIndex++ {
+						chunkIndexes := make([]uint64, 0, chunksCount)
+						for chunkIndex := uint64(0); chunkIndex < chunksCount; chunkIndex++ {
+							chunkIndexes = append(chunkIndexes, chunkIndex)
+						}
+						batchedChunks, err := batched.loadChunksFromDisk(ctx, validatorChunkIndex, kind, chunkIndexes)
+						require.NoError(t, err)
+						serialChunks, err := serial.loadChunksFromDisk(ctx, validatorChunkIndex, kind, chunkIndexes)
+						require.NoError(t, err)
+						for _, chunkIndex := range chunkIndexes {
+							require.DeepEqual(t, serialChunks[chunkIndex].Chunk(), batchedChunks[chunkIndex].Chunk())
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+func Test_checkSlashableAttestationsInBatches_MatchesSinglePass(t *testing.T) {
+	const (
+		validatorsCount = 64
+		epochsCount     = 24
+	)
+	p := &Parameters{
+		chunkSize:          4,
+		validatorChunkSize: 4,
+		historyLength:      32,
+	}
+
+	for seed := int64(0); seed < 3; seed++ {
+		t.Run(fmt.Sprintf("seed=%d", seed), func(t *testing.T) {
+			ctx := context.Background()
+
+			// Attestations of many epochs queued at once, as when catching up after downtime.
+			var atts []*slashertypes.IndexedAttestationWrapper
+			for _, epochAtts := range randomAttestationHistory(t, rand.New(rand.NewSource(seed)), p, validatorsCount, epochsCount) {
+				atts = append(atts, epochAtts...)
+			}
+			currentEpoch := primitives.Epoch(epochsCount - 1)
+
+			newService := func(memoryBudget uint64) *Service {
+				return &Service{
+					params:                         p,
+					serviceCfg:                     &ServiceConfig{Database: dbtest.SetupSlasherDB(t), MemoryBudget: memoryBudget},
+					latestEpochUpdatedForValidator: map[primitives.ValidatorIndex]primitives.Epoch{},
+				}
+			}
+			singlePass := newService(0)
+			// A budget of a few attestations and 64 chunks at once.
+			streaming := newService(4096)
+
+			batches := streaming.attestationBatches(atts)
+			require.Equal(t, true, len(batches) > 1)
+			for _, batch := range batches {
+				for i := 1; i < len(batch); i++ {
+					require.Equal(t, true, batch[i-1].IndexedAttestation.GetData().Target.Epoch <= batch[i].IndexedAttestation.GetData().Target.Epoch)
+				}
+			}
+
+			singlePassSlashings, err := singlePass.checkSlashableAttestations(ctx, currentEpoch, atts)
+			require.NoError(t, err)
+			streamingSlashings, err := streaming.checkSlashableAttestationsInBatches(ctx, currentEpoch, atts)
+			require.NoError(t, err)
+
+			require.NotEqual(t, 0, len(singlePassSlashings))
+			require.Equal(t, len(singlePassSlashings), len(streamingSlashings))
+			for root := range singlePassSlashings {
+				_, ok := streamingSlashings[root]
+				require.Equal(t, true, ok, "missing slashing %#x", root)
+			}
+			require.DeepEqual(t, singlePass.latestEpochUpdatedForValidator, streaming.latestEpochUpdatedForValidator)
+
+			chunksCount := uint64(p.historyLength) / p.chunkSize
+			for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+				for validatorChunkIndex := uint64(0); validatorChunkIndex < validatorsCount/p.validatorChunkSize; validatorChunkIndex++ {
+					chunkIndexes := make([]uint64, 0, chunksCount)
+					for chunkIndex := uint64(0); chunkIndex < chunksCount; chunkIndex++ {
+						chunkIndexes = append(chunkIndexes, chunkIndex)
+					}
+					singlePassChunks, err := singlePass.loadChunksFromDisk(ctx, validatorChunkIndex, kind, chunkIndexes)
+					require.NoError(t, err)
+					streamingChunks, err := streaming.loadChunksFromDisk(ctx, validatorChunkIndex, kind, chunkIndexes)
+					require.NoError(t, err)
+					for _, chunkIndex := range chunkIndexes {
+						require.DeepEqual(t, singlePassChunks[chunkIndex].Chunk(), streamingChunks[chunkIndex].Chunk())
+					}
+				}
+			}
+		})
+	}
+}
+
 func Test_applyAttestationForValidator_MinSpanChunk(t *testing.T) {
 	ctx := context.Background()
 	slasherDB := dbtest.SetupSlasherDB(t)
@@ -141,8 +141,9 @@ func (s *Service) processAttestations(
 
 	start := time.Now()
 
-	// Check for attestations slashings (double, surrounding, surrounded votes).
-	slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAttestations)
+	// Check for attestations slashings (double, surrounding, surrounded votes), by batches
+	// of bounded memory when catching up on many queued attestations.
+	slashings, err := s.checkSlashableAttestationsInBatches(ctx, currentEpoch, validAttestations)
 	if err != nil {
 		log.WithError(err).Error(couldNotCheckSlashableAtt)
 		return nil
@@ -0,0 +1,139 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+var (
+	// ErrInvalidAttestation is returned when an attestation to check is missing required fields.
+	ErrInvalidAttestation = errors.New("invalid attestation")
+	// ErrInvalidBlockHeader is returned when a block header to check is missing required fields.
+	ErrInvalidBlockHeader = errors.New("invalid block header")
+)
+
+// SlashingChecker defines a service able to check whether attestations and block proposals
+// are slashable with respect to the history recorded by the slasher, without recording them.
+type SlashingChecker interface {
+	IsSlashableAttestation(ctx context.Context, attestation *ethpb.IndexedAttestation) ([]*ethpb.AttesterSlashing, error)
+	IsSlashableBlock(ctx context.Context, proposal *ethpb.SignedBeaconBlockHeader) (*ethpb.ProposerSlashing, error)
+}
+
+// IsSlashableAttestation checks if an indexed attestation is a double vote, a surrounding vote or a surrounded
+// vote with respect to the attestations recorded by the slasher, and returns the corresponding attester slashings.
+//
+// The check only reads the slasher database: neither the attestation nor the min/max spans are written. Each
+// database read is its own read transaction, so the check does not block, nor is blocked by, the detection loop
+// updating the chunks. Attestations still queued for detection are not taken into account.
+func (s *Service) IsSlashableAttestation(
+	ctx context.Context, attestation *ethpb.IndexedAttestation,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.IsSlashableAttestation")
+	defer span.End()
+
+	if !validateAttestationIntegrity(attestation) {
+		return nil, ErrInvalidAttestation
+	}
+	dataRoot, err := attestation.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get hash tree root of attestation")
+	}
+	attWrapper := &slashertypes.IndexedAttestationWrapper{
+		IndexedAttestation: attestation,
+		DataRoot:           dataRoot,
+	}
+
+	// Double votes
+	doubleVotes, err := s.serviceCfg.Database.CheckAttesterDoubleVotes(ctx, []*slashertypes.IndexedAttestationWrapper{attWrapper})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve potential double votes from disk")
+	}
+	slashings, err := doubleVoteSlashings(doubleVotes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Surrounding / surrounded votes
+	chunkIndex := s.params.chunkIndex(attestation.Data.Source.Epoch)
+	chunksByKind := map[slashertypes.ChunkKind]map[uint64]Chunker{
+		slashertypes.MinSpan: {},
+		slashertypes.MaxSpan: {},
+	}
+	for _, validatorIdx := range attestation.AttestingIndices {
+		validatorIndex := primitives.ValidatorIndex(validatorIdx)
+		validatorChunkIndex := s.params.validatorChunkIndex(validatorIndex)
+
+		for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+			chunk, ok := chunksByKind[kind][validatorChunkIndex]
+			if !ok {
+				chunk, err = s.getChunkFromDatabase(ctx, kind, validatorChunkIndex, chunkIndex)
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not get %s chunk at index %d", kind, chunkIndex)
+				}
+				chunksByKind[kind][validatorChunkIndex] = chunk
+			}
+
+			slashing, err := chunk.CheckSlashable(ctx, s.serviceCfg.Database, validatorIndex, attWrapper)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not check if attestation for validator index %d is slashable", validatorIndex)
+			}
+			if slashing == nil {
+				continue
+			}
+			root, err := slashing.HashTreeRoot()
+			if err != nil {
+				return nil, errors.Wrap(err, "could not hash tree root for attester slashing")
+			}
+			slashings[root] = slashing
+		}
+	}
+
+	return attesterSlashings(slashings)
+}
+
+// IsSlashableBlock checks if a signed block header is a double proposal with respect to the block proposals
+// recorded by the slasher, and returns the corresponding proposer slashing. The block proposal is not recorded.
+func (s *Service) IsSlashableBlock(
+	ctx context.Context, proposal *ethpb.SignedBeaconBlockHeader,
+) (*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "slasher.IsSlashableBlock")
+	defer span.End()
+
+	if !validateBlockHeaderIntegrity(proposal) {
+		return nil, ErrInvalidBlockHeader
+	}
+	headerRoot, err := proposal.Header.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get hash tree root of signed block header")
+	}
+	wrappedProposal := &slashertypes.SignedBlockHeaderWrapper{
+		SignedBeaconBlockHeader: proposal,
+		HeaderRoot:              headerRoot,
+	}
+	proposerSlashings, err := s.serviceCfg.Database.CheckDoubleBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{wrappedProposal})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check for double proposals on disk")
+	}
+	if len(proposerSlashings) == 0 {
+		return nil, nil
+	}
+	return proposerSlashings[0], nil
+}
+
+func attesterSlashings(slashings map[[fieldparams.RootLength]byte]ethpb.AttSlashing) ([]*ethpb.AttesterSlashing, error) {
+	result := make([]*ethpb.AttesterSlashing, 0, len(slashings))
+	for _, slashing := range slashings {
+		s, ok := slashing.(*ethpb.AttesterSlashing)
+		if !ok {
+			return nil, errors.Errorf("attester slashing has wrong type (expected %T, got %T)", &ethpb.AttesterSlashing{}, slashing)
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
@@ -0,0 +1,159 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	slashingsmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings/mock"
+	slashertypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func setupSlashingCheckerService(t *testing.T) *Service {
+	slasherDB := dbtest.SetupSlasherDB(t)
+	beaconState, err := util.NewBeaconState()
+	require.NoError(t, err)
+	mockChain := &mock.ChainService{State: beaconState}
+	s, err := New(context.Background(), &ServiceConfig{
+		Database:                slasherDB,
+		HeadStateFetcher:        mockChain,
+		AttestationStateFetcher: mockChain,
+		SlashingPoolInserter:    &slashingsmock.PoolMock{},
+	})
+	require.NoError(t, err)
+	return s
+}
+
+func TestService_IsSlashableAttestation(t *testing.T) {
+	ctx := context.Background()
+	s := setupSlashingCheckerService(t)
+
+	// Record (source 1, target 2) for validators 0 and 1.
+	currentSlot, err := slots.EpochStart(4)
+	require.NoError(t, err)
+	recorded := createAttestationWrapperEmptySig(t, 1, 2, []uint64{0, 1}, nil)
+	processed := s.processAttestations(ctx, []*slashertypes.IndexedAttestationWrapper{recorded}, currentSlot)
+	require.Equal(t, 0, len(processed))
+
+	tests := []struct {
+		name            string
+		source, target  primitives.Epoch
+		indices         []uint64
+		beaconBlockRoot []byte
+		wantSlashings   int
+	}{
+		{
+			name:    "not slashable",
+			source:  2,
+			target:  3,
+			indices: []uint64{0, 1},
+		},
+		{
+			name:    "same attestation is not slashable",
+			source:  1,
+			target:  2,
+			indices: []uint64{0, 1},
+		},
+		{
+			name:            "double vote",
+			source:          1,
+			target:          2,
+			indices:         []uint64{0},
+			beaconBlockRoot: []byte{1},
+			wantSlashings:   1,
+		},
+		{
+			name:          "surrounding vote",
+			source:        0,
+			target:        3,
+			indices:       []uint64{0, 1},
+			wantSlashings: 1,
+		},
+		{
+			name:          "not slashable for validator without history",
+			source:        0,
+			target:        3,
+			indices:       []uint64{2},
+			wantSlashings: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			att := createAttestationWrapperEmptySig(t, tt.source, tt.target, tt.indices, tt.beaconBlockRoot)
+			slashings, err := s.IsSlashableAttestation(ctx, att.IndexedAttestation.(*ethpb.IndexedAttestation))
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSlashings, len(slashings))
+		})
+	}
+
+	// The checked attestations must not have been recorded.
+	records, err := s.serviceCfg.Database.AttestationRecordForValidator(ctx, 0, 3)
+	require.NoError(t, err)
+	require.IsNil(t, records)
+	slashings, err := s.IsSlashableAttestation(ctx, createAttestationWrapperEmptySig(t, 1, 3, []uint64{0}, nil).IndexedAttestation.(*ethpb.IndexedAttestation))
+	require.NoError(t, err)
+	require.Equal(t, 0, len(slashings))
+}
+
+func TestService_IsSlashableAttestation_Surrounded(t *testing.T) {
+	ctx := context.Background()
+	s := setupSlashingCheckerService(t)
+
+	currentSlot, err := slots.EpochStart(4)
+	require.NoError(t, err)
+	recorded := createAttestationWrapperEmptySig(t, 0, 3, []uint64{0}, nil)
+	processed := s.processAttestations(ctx, []*slashertypes.IndexedAttestationWrapper{recorded}, currentSlot)
+	require.Equal(t, 0, len(processed))
+
+	att := createAttestationWrapperEmptySig(t, 1, 2, []uint64{0}, nil)
+	slashings, err := s.IsSlashableAttestation(ctx, att.IndexedAttestation.(*ethpb.IndexedAttestation))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+}
+
+func TestService_IsSlashableAttestation_Invalid(t *testing.T) {
+	s := setupSlashingCheckerService(t)
+	_, err := s.IsSlashableAttestation(context.Background(), &ethpb.IndexedAttestation{})
+	require.ErrorIs(t, err, ErrInvalidAttestation)
+}
+
+func TestService_IsSlashableBlock(t *testing.T) {
+	ctx := context.Background()
+	s := setupSlashingCheckerService(t)
+
+	recorded := createProposalWrapper(t, 4, 1, []byte{1})
+	err := s.serviceCfg.Database.SaveBlockProposals(ctx, []*slashertypes.SignedBlockHeaderWrapper{recorded})
+	require.NoError(t, err)
+
+	slashing, err := s.IsSlashableBlock(ctx, createProposalWrapper(t, 4, 1, []byte{1}).SignedBeaconBlockHeader)
+	require.NoError(t, err)
+	require.IsNil(t, slashing)
+
+	slashing, err = s.IsSlashableBlock(ctx, createProposalWrapper(t, 5, 1, []byte{2}).SignedBeaconBlockHeader)
+	require.NoError(t, err)
+	require.IsNil(t, slashing)
+
+	doubleProposal := createProposalWrapper(t, 4, 1, []byte{2})
+	slashing, err = s.IsSlashableBlock(ctx, doubleProposal.SignedBeaconBlockHeader)
+	require.NoError(t, err)
+	require.NotNil(t, slashing)
+	require.DeepEqual(t, recorded.SignedBeaconBlockHeader, slashing.Header_1)
+	require.DeepEqual(t, doubleProposal.SignedBeaconBlockHeader, slashing.Header_2)
+
+	// The checked proposal must not have been recorded.
+	slashing, err = s.IsSlashableBlock(ctx, createProposalWrapper(t, 5, 1, []byte{3}).SignedBeaconBlockHeader)
+	require.NoError(t, err)
+	require.IsNil(t, slashing)
+}
+
+func TestService_IsSlashableBlock_Invalid(t *testing.T) {
+	s := setupSlashingCheckerService(t)
+	_, err := s.IsSlashableBlock(context.Background(), &ethpb.SignedBeaconBlockHeader{})
+	require.ErrorIs(t, err, ErrInvalidBlockHeader)
+}
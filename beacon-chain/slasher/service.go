@@ -42,6 +42,9 @@ type ServiceConfig struct {
 	HeadStateFetcher        blockchain.HeadFetcher
 	SyncChecker             beaconChainSync.Checker
 	ClockWaiter             startup.ClockWaiter
+	// MemoryBudget is the memory, in bytes, that the attestations and span chunks processed at once may take.
+	// Defaults to about 400MB when zero.
+	MemoryBudget uint64
 }
 
 // Service defining a slasher implementation as part of
@@ -1,6 +1,7 @@
 package fieldtrie
 
 import (
+	"encoding/binary"
 	"reflect"
 	"sync"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stateutil"
 	multi_value_slice "github.com/prysmaticlabs/prysm/v5/container/multi-value-slice"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
 	pmath "github.com/prysmaticlabs/prysm/v5/math"
 )
 
@@ -255,6 +257,43 @@ func (f *FieldTrie) TrieRoot() ([32]byte, error) {
 	}
 }
 
+// Proof returns the Merkle branch of the element at the given index against the root of the trie.
+// For variable length fields, the length mix-in is the last node of the branch.
+func (f *FieldTrie) Proof(index uint64) ([][32]byte, error) {
+	f.RLock()
+	defer f.RUnlock()
+	if f.Empty() {
+		return nil, ErrEmptyFieldTrie
+	}
+	if index >= uint64(len(f.fieldLayers[0])) {
+		return nil, errors.Errorf("index %d is out of range of field trie with %d leaves", index, len(f.fieldLayers[0]))
+	}
+	branch := make([][32]byte, 0, len(f.fieldLayers))
+	for i := 0; i < len(f.fieldLayers)-1; i++ {
+		sibling := index ^ 1
+		if sibling < uint64(len(f.fieldLayers[i])) {
+			branch = append(branch, *f.fieldLayers[i][sibling])
+		} else {
+			branch = append(branch, trie.ZeroHashes[i])
+		}
+		index /= 2
+	}
+	var length uint64
+	switch f.dataType {
+	case types.BasicArray:
+		return branch, nil
+	case types.CompositeArray:
+		length = uint64(len(f.fieldLayers[0]))
+	case types.CompressedArray:
+		length = uint64(f.numOfElems)
+	default:
+		return nil, errors.Errorf("unrecognized data type in field map: %v", reflect.TypeOf(f.dataType).Name())
+	}
+	var mixin [32]byte
+	binary.LittleEndian.PutUint64(mixin[:8], length)
+	return append(branch, mixin), nil
+}
+
 // FieldReference returns the underlying field reference
 // object for the trie.
 func (f *FieldTrie) FieldReference() *stateutil.Reference {
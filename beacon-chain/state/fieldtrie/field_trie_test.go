@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	mvslice "github.com/prysmaticlabs/prysm/v5/container/multi-value-slice"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -265,3 +266,33 @@ type mockIdentifier struct{}
 func (_ mockIdentifier) Id() mvslice.Id {
 	return 0
 }
+
+func TestFieldTrie_Proof(t *testing.T) {
+	newState, _ := util.DeterministicGenesisState(t, 5)
+	vals := newState.Validators()
+	fieldTrie, err := NewFieldTrie(types.Validators, types.CompositeArray, vals, params.BeaconConfig().ValidatorRegistryLimit)
+	require.NoError(t, err)
+	root, err := fieldTrie.TrieRoot()
+	require.NoError(t, err)
+
+	for i, val := range vals {
+		leaf, err := val.HashTreeRoot()
+		require.NoError(t, err)
+		branch, err := fieldTrie.Proof(uint64(i))
+		require.NoError(t, err)
+		// The 40 levels of the validator registry and the length mix-in.
+		require.Equal(t, 41, len(branch))
+		proof := make([][]byte, len(branch))
+		for j := range branch {
+			proof[j] = branch[j][:]
+		}
+		require.Equal(t, true, trie.VerifyMerkleProof(root[:], leaf[:], uint64(i), proof), "validator %d", i)
+	}
+
+	_, err = fieldTrie.Proof(uint64(len(vals)))
+	require.ErrorContains(t, "out of range", err)
+	empty, err := NewFieldTrie(types.Validators, types.CompositeArray, nil, params.BeaconConfig().ValidatorRegistryLimit)
+	require.NoError(t, err)
+	_, err = empty.Proof(0)
+	require.ErrorIs(t, err, ErrEmptyFieldTrie)
+}
@@ -41,6 +41,8 @@ type Prover interface {
 	FinalizedRootProof(ctx context.Context) ([][]byte, error)
 	CurrentSyncCommitteeProof(ctx context.Context) ([][]byte, error)
 	NextSyncCommitteeProof(ctx context.Context) ([][]byte, error)
+	ValidatorGeneralizedIndex(ctx context.Context, idx primitives.ValidatorIndex) (uint64, error)
+	ValidatorProof(ctx context.Context, idx primitives.ValidatorIndex) ([][]byte, error)
 }
 
 // ReadOnlyBeaconState defines a struct which only has read access to beacon state methods.
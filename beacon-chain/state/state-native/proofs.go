@@ -4,14 +4,18 @@ import (
 	"context"
 	"encoding/binary"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native/types"
+	consensus_types "github.com/prysmaticlabs/prysm/v5/consensus-types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/trie"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
 const (
-	finalizedRootIndex = uint64(105) // Precomputed value.
+	finalizedRootIndex     = uint64(105) // Precomputed value.
+	validatorRegistryDepth = 40          // log2(VALIDATOR_REGISTRY_LIMIT)
 )
 
 // FinalizedRootGeneralizedIndex for the beacon state.
@@ -106,3 +110,52 @@ func (b *BeaconState) FinalizedRootProof(ctx context.Context) ([][]byte, error)
 	proof = append(proof, branch...)
 	return proof, nil
 }
+
+// ValidatorGeneralizedIndex returns the generalized index of the validator at the given index
+// within the Merkle tree of the beacon state.
+func (b *BeaconState) ValidatorGeneralizedIndex(ctx context.Context, idx primitives.ValidatorIndex) (uint64, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if err := b.initializeMerkleLayers(ctx); err != nil {
+		return 0, err
+	}
+	stateDepth := uint64(len(b.merkleLayers) - 1)
+	fieldIndex := uint64(1)<<stateDepth + uint64(types.Validators.RealPosition())
+	// The validator registry is the left child of its length mix-in.
+	return (fieldIndex*2)<<validatorRegistryDepth + uint64(idx), nil
+}
+
+// ValidatorProof crafts a Merkle proof for the validator at the given index against the state root.
+// The proof goes through the field trie of the validator registry, including its length mix-in,
+// and then through the state's Merkle trie representation.
+func (b *BeaconState) ValidatorProof(ctx context.Context, idx primitives.ValidatorIndex) ([][]byte, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if uint64(idx) >= uint64(b.validatorsLen()) {
+		return nil, errors.Wrapf(consensus_types.ErrOutOfBounds, "validator index %d does not exist", idx)
+	}
+	if err := b.initializeMerkleLayers(ctx); err != nil {
+		return nil, err
+	}
+	if err := b.recomputeDirtyFields(ctx); err != nil {
+		return nil, err
+	}
+	if b.stateFieldLeaves[types.Validators].Empty() {
+		// The registry root was computed without its field trie, which needs to be built first.
+		b.rebuildTrie[types.Validators] = true
+		if _, err := b.validatorsRootSelector(types.Validators); err != nil {
+			return nil, err
+		}
+	}
+	branch, err := b.stateFieldLeaves[types.Validators].Proof(uint64(idx))
+	if err != nil {
+		return nil, err
+	}
+	proof := make([][]byte, 0, len(branch)+len(b.merkleLayers)-1)
+	for i := range branch {
+		proof = append(proof, bytesutil.SafeCopyBytes(branch[i][:]))
+	}
+	return append(proof, trie.ProofFromMerkleLayers(b.merkleLayers, types.Validators.RealPosition())...), nil
+}
@@ -5,8 +5,13 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	statenative "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	consensus_types "github.com/prysmaticlabs/prysm/v5/consensus-types"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/trie"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
@@ -164,3 +169,42 @@ func TestBeaconStateMerkleProofs_bellatrix(t *testing.T) {
 		require.Equal(t, true, valid)
 	})
 }
+
+func TestBeaconStateMerkleProofs_validator(t *testing.T) {
+	ctx := context.Background()
+	for name, genesis := range map[string]func(testing.TB, uint64) (state.BeaconState, []bls.SecretKey){
+		"phase0":  util.DeterministicGenesisState,
+		"electra": util.DeterministicGenesisStateElectra,
+	} {
+		t.Run(name, func(t *testing.T) {
+			st, _ := genesis(t, 64)
+			verify := func(idx primitives.ValidatorIndex) {
+				proof, err := st.ValidatorProof(ctx, idx)
+				require.NoError(t, err)
+				gIndex, err := st.ValidatorGeneralizedIndex(ctx, idx)
+				require.NoError(t, err)
+				// The generalized index has one bit per level of the proof below its leading bit.
+				require.Equal(t, uint64(1), gIndex>>len(proof))
+				htr, err := st.HashTreeRoot(ctx)
+				require.NoError(t, err)
+				v, err := st.ValidatorAtIndex(idx)
+				require.NoError(t, err)
+				leaf, err := v.HashTreeRoot()
+				require.NoError(t, err)
+				require.Equal(t, true, trie.VerifyMerkleProof(htr[:], leaf[:], gIndex, proof), "validator %d", idx)
+			}
+			verify(0)
+			verify(37)
+
+			// The proof follows changes of the validator registry.
+			v, err := st.ValidatorAtIndex(37)
+			require.NoError(t, err)
+			v.WithdrawalCredentials = append([]byte{params.BeaconConfig().ETH1AddressWithdrawalPrefixByte}, make([]byte, 31)...)
+			require.NoError(t, st.UpdateValidatorAtIndex(37, v))
+			verify(37)
+
+			_, err = st.ValidatorProof(ctx, 64)
+			require.ErrorIs(t, err, consensus_types.ErrOutOfBounds)
+		})
+	}
+}
@@ -148,38 +148,19 @@ func (s *State) StateByRootInitialSync(ctx context.Context, blockRoot [32]byte)
 	return startState, nil
 }
 
-// This returns the state summary object of a given block root. It first checks the cache, then checks the DB.
+// This returns the state summary object of a given block root. Summaries missing from the DB are derived
+// from the saved block and persisted.
 func (s *State) stateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.StateSummary, error) {
-	var summary *ethpb.StateSummary
-	var err error
-
-	summary, err = s.beaconDB.StateSummary(ctx, blockRoot)
+	summary, err := s.beaconDB.StateSummary(ctx, blockRoot)
 	if err != nil {
 		return nil, err
 	}
-
 	if summary == nil {
-		return s.recoverStateSummary(ctx, blockRoot)
+		return s.beaconDB.BackfillStateSummary(ctx, blockRoot)
 	}
 	return summary, nil
 }
 
-// RecoverStateSummary recovers state summary object of a given block root by using the saved block in DB.
-func (s *State) recoverStateSummary(ctx context.Context, blockRoot [32]byte) (*ethpb.StateSummary, error) {
-	if s.beaconDB.HasBlock(ctx, blockRoot) {
-		b, err := s.beaconDB.Block(ctx, blockRoot)
-		if err != nil {
-			return nil, err
-		}
-		summary := &ethpb.StateSummary{Slot: b.Block().Slot(), Root: blockRoot[:]}
-		if err := s.beaconDB.SaveStateSummary(ctx, summary); err != nil {
-			return nil, err
-		}
-		return summary, nil
-	}
-	return nil, errors.New("could not find block in DB")
-}
-
 // DeleteStateFromCaches deletes the state from the caches.
 func (s *State) DeleteStateFromCaches(_ context.Context, blockRoot [32]byte) error {
 	s.hotStateCache.delete(blockRoot)
@@ -505,3 +505,24 @@ func TestState_HasStateInCache(t *testing.T) {
 		require.Equal(t, tc.want, got)
 	}
 }
+
+func TestStateSummary_BackfillsMissingSummary(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB, doublylinkedtree.New())
+
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 5
+	util.SaveBlock(t, ctx, beaconDB, b)
+	bRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, false, beaconDB.HasStateSummary(ctx, bRoot))
+
+	summary, err := service.stateSummary(ctx, bRoot)
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(5), summary.Slot)
+	require.Equal(t, true, beaconDB.HasStateSummary(ctx, bRoot))
+
+	_, err = service.stateSummary(ctx, [32]byte{'a'})
+	require.ErrorContains(t, "could not find block", err)
+}
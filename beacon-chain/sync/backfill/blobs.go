@@ -58,6 +58,27 @@ func (bs *blobSync) blobsNeeded() int {
 	return len(bs.expected) - bs.next
 }
 
+// missingBlocks returns the blocks with blob sidecars which have not been received yet.
+func (bs *blobSync) missingBlocks(blks []blocks.ROBlock) []blocks.ROBlock {
+	roots := make(map[[32]byte]bool)
+	for _, s := range bs.expected[bs.next:] {
+		roots[s.blockRoot] = true
+	}
+	missing := make([]blocks.ROBlock, 0, len(roots))
+	for _, b := range blks {
+		if roots[b.Root()] {
+			missing = append(missing, b)
+		}
+	}
+	return missing
+}
+
+// markFetched records that the blob sidecars which have not been received were stored by other means, so that the
+// availability check finds them in storage.
+func (bs *blobSync) markFetched() {
+	bs.next = len(bs.expected)
+}
+
 func (bs *blobSync) validateNext(rb blocks.ROBlob) error {
 	if bs.next >= len(bs.expected) {
 		return errUnexpectedResponseSize
@@ -112,7 +133,12 @@ func (bbv *blobBatchVerifier) newVerifier(rb blocks.ROBlob) verification.BlobVer
 	return m[rb.Index]
 }
 
-func (bbv *blobBatchVerifier) VerifiedROBlobs(_ context.Context, blk blocks.ROBlock, _ []blocks.ROBlob) ([]blocks.VerifiedROBlob, error) {
+// VerifiedROBlobs returns the verified sidecars of the given ones. The availability check only passes the sidecars
+// which are not in storage yet, those fetched by root having been stored once verified.
+func (bbv *blobBatchVerifier) VerifiedROBlobs(_ context.Context, blk blocks.ROBlock, scs []blocks.ROBlob) ([]blocks.VerifiedROBlob, error) {
+	if len(scs) == 0 {
+		return nil, nil
+	}
 	m, ok := bbv.verifiers[blk.Root()]
 	if !ok {
 		return nil, errors.Wrapf(verification.ErrMissingVerification, "no record of verifiers for root %#x", blk.Root())
@@ -121,17 +147,18 @@ func (bbv *blobBatchVerifier) VerifiedROBlobs(_ context.Context, blk blocks.ROBl
 	if err != nil {
 		return nil, errors.Wrapf(errUnexpectedCommitment, "error reading commitments from block root %#x", blk.Root())
 	}
-	vbs := make([]blocks.VerifiedROBlob, len(c))
-	for i := range c {
-		if m[i] == nil {
-			return nil, errors.Wrapf(errBatchVerifierMismatch, "do not have verifier for block root %#x idx %d", blk.Root(), i)
+	vbs := make([]blocks.VerifiedROBlob, len(scs))
+	for i := range scs {
+		idx := scs[i].Index
+		if idx >= uint64(len(c)) || m[idx] == nil {
+			return nil, errors.Wrapf(errBatchVerifierMismatch, "do not have verifier for block root %#x idx %d", blk.Root(), idx)
 		}
-		vb, err := m[i].VerifiedROBlob()
+		vb, err := m[idx].VerifiedROBlob()
 		if err != nil {
 			return nil, err
 		}
-		if !bytes.Equal(vb.KzgCommitment, c[i]) {
-			return nil, errors.Wrapf(errBatchVerifierMismatch, "commitments do not match, verified=%#x da check=%#x for root %#x", vb.KzgCommitment, c[i], vb.BlockRoot())
+		if !bytes.Equal(vb.KzgCommitment, c[idx]) {
+			return nil, errors.Wrapf(errBatchVerifierMismatch, "commitments do not match, verified=%#x da check=%#x for root %#x", vb.KzgCommitment, c[idx], vb.BlockRoot())
 		}
 		vbs[i] = vb
 	}
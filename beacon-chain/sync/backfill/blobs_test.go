@@ -1,6 +1,7 @@
 package backfill
 
 import (
+	"context"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
@@ -8,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
@@ -126,3 +128,39 @@ func testNewBlobVerifier(opts ...func(*verification.MockBlobVerifier)) verificat
 		return v
 	}
 }
+
+func TestBlobSync_FetchedByRoot(t *testing.T) {
+	current := primitives.Slot(128)
+	blks, blobs := testBlobGen(t, 63, 3)
+	store := filesystem.NewEphemeralBlobStorage(t)
+	cfg := &blobSyncConfig{
+		retentionStart: 0,
+		nbv: func(b blocks.ROBlob, _ []verification.Requirement) verification.BlobVerifier {
+			return &verification.MockBlobVerifier{CbVerifiedROBlob: func() (blocks.VerifiedROBlob, error) {
+				return blocks.NewVerifiedROBlob(b), nil
+			}}
+		},
+		store: store,
+	}
+	bsync, err := newBlobSync(current, blks, cfg)
+	require.NoError(t, err)
+	// The peer only serves the sidecars of the first block and the first sidecar of the second one.
+	for _, sc := range append(blobs[0], blobs[1][0]) {
+		require.NoError(t, bsync.validateNext(sc))
+	}
+	require.Equal(t, 5, bsync.blobsNeeded())
+	missing := bsync.missingBlocks(blks)
+	require.Equal(t, 2, len(missing))
+	assert.Equal(t, blks[1].Root(), missing[0].Root())
+	assert.Equal(t, blks[2].Root(), missing[1].Root())
+
+	// The sidecars fetched by root are stored once verified.
+	for _, sc := range append(blobs[1], blobs[2]...) {
+		require.NoError(t, store.Save(verification.FakeVerifyForTest(t, sc)))
+	}
+	bsync.markFetched()
+	require.Equal(t, 0, bsync.blobsNeeded())
+	for _, b := range blks {
+		require.NoError(t, bsync.store.IsDataAvailable(context.Background(), current, b))
+	}
+}
@@ -27,6 +27,12 @@ var (
 			Help: "Backfill remaining batches.",
 		},
 	)
+	backfillLowestSlot = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "backfill_lowest_slot",
+			Help: "Slot of the lowest block backfilled so far, which decreases towards the minimum backfill slot.",
+		},
+	)
 	backfillBatchesImported = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "backfill_batches_imported",
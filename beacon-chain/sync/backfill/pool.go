@@ -28,14 +28,32 @@ type worker interface {
 
 type newWorker func(id workerId, in, out chan batch, c *startup.Clock, v *verifier, cm sync.ContextByteVersions, nbv verification.NewBlobVerifier, bfs *filesystem.BlobStorage) worker
 
-func defaultNewWorker(p p2p.P2P) newWorker {
+func defaultNewWorker(p p2p.P2P, l *blockRateLimiter) newWorker {
+	// Workers share the scores of the peers their missing blob sidecars were requested from.
+	providers := sync.NewBlobProviders()
 	return func(id workerId, in, out chan batch, c *startup.Clock, v *verifier, cm sync.ContextByteVersions, nbv verification.NewBlobVerifier, bfs *filesystem.BlobStorage) worker {
-		return newP2pWorker(id, p, in, out, c, v, cm, nbv, bfs)
+		blobs := sync.NewBlobFetcher(&sync.BlobFetcherConfig{
+			P2P:             p,
+			Clock:           c,
+			CtxMap:          cm,
+			BlobStorage:     bfs,
+			NewBlobVerifier: nbv,
+			Requirements:    verification.BackfillSidecarRequirements,
+			Providers:       providers,
+		})
+		return newP2pWorker(id, p, in, out, c, v, cm, nbv, bfs, l, blobs)
 	}
 }
 
+// peerBlockAvailability is used by the pool to avoid assigning batches to peers that are known to be missing
+// the blocks in the batch's range, such as checkpoint synced peers that are still backfilling themselves.
+type peerBlockAvailability interface {
+	CanServeBlocksFrom(pid peer.ID, slot primitives.Slot) bool
+}
+
 type p2pBatchWorkerPool struct {
 	maxBatches  int
+	avail       peerBlockAvailability
 	newWorker   newWorker
 	toWorkers   chan batch
 	fromWorkers chan batch
@@ -49,10 +67,11 @@ type p2pBatchWorkerPool struct {
 
 var _ batchWorkerPool = &p2pBatchWorkerPool{}
 
-func newP2PBatchWorkerPool(p p2p.P2P, maxBatches int) *p2pBatchWorkerPool {
-	nw := defaultNewWorker(p)
+func newP2PBatchWorkerPool(p p2p.P2P, maxBatches int, l *blockRateLimiter) *p2pBatchWorkerPool {
+	nw := defaultNewWorker(p, l)
 	return &p2pBatchWorkerPool{
 		newWorker:   nw,
+		avail:       p.Peers(),
 		toRouter:    make(chan batch, maxBatches),
 		fromRouter:  make(chan batch, maxBatches),
 		toWorkers:   make(chan batch),
@@ -132,34 +151,65 @@ func (p *p2pBatchWorkerPool) batchRouter(pa PeerAssigner) {
 			continue
 		}
 		// Try to assign as many outstanding batches as possible to peers and feed the assigned batches to workers.
-		assigned, err := pa.Assign(busy, len(todo))
-		if err != nil {
-			if errors.Is(err, peers.ErrInsufficientSuitable) {
-				// Transient error resulting from insufficient number of connected peers. Leave batches in
-				// queue and get to them whenever the peer situation is resolved.
-				continue
-			}
-			p.shutdown(err)
-			return
+		// Peers that can't serve the range of the next batch are excluded and the assigner is asked again,
+		// so that peers which are still backfilling don't hold up the batches they can't serve.
+		exclude := make(map[peer.ID]bool, len(busy))
+		for pid, isBusy := range busy {
+			exclude[pid] = isBusy
 		}
-		for _, pid := range assigned {
-			if err := todo[0].waitUntilReady(p.ctx); err != nil {
-				log.WithError(p.ctx.Err()).Info("p2pBatchWorkerPool context canceled, shutting down")
-				p.shutdown(p.ctx.Err())
+		for len(todo) > 0 {
+			assigned, err := pa.Assign(exclude, len(todo))
+			if err != nil {
+				if errors.Is(err, peers.ErrInsufficientSuitable) {
+					// Transient error resulting from insufficient number of connected peers. Leave batches in
+					// queue and get to them whenever the peer situation is resolved.
+					break
+				}
+				p.shutdown(err)
 				return
 			}
-			busy[pid] = true
-			todo[0].busy = pid
-			p.toWorkers <- todo[0].withPeer(pid)
-			if todo[0].begin < earliest {
-				earliest = todo[0].begin
-				oldestBatch.Set(float64(earliest))
+			unservable := 0
+			for _, pid := range assigned {
+				if exclude[pid] {
+					// Guard against assigners that ignore the busy map.
+					continue
+				}
+				exclude[pid] = true
+				// Batches are sorted in descending order, so if the peer can't serve the first batch,
+				// it can't serve any of the batches after it either.
+				if !p.canServe(pid, todo[0]) {
+					unservable++
+					continue
+				}
+				if err := todo[0].waitUntilReady(p.ctx); err != nil {
+					log.WithError(p.ctx.Err()).Info("p2pBatchWorkerPool context canceled, shutting down")
+					p.shutdown(p.ctx.Err())
+					return
+				}
+				busy[pid] = true
+				todo[0].busy = pid
+				p.toWorkers <- todo[0].withPeer(pid)
+				if todo[0].begin < earliest {
+					earliest = todo[0].begin
+					oldestBatch.Set(float64(earliest))
+				}
+				todo = todo[1:]
+			}
+			// Only ask for more peers if some of the ones we were given couldn't serve the next batch.
+			if unservable == 0 {
+				break
 			}
-			todo = todo[1:]
 		}
 	}
 }
 
+func (p *p2pBatchWorkerPool) canServe(pid peer.ID, b batch) bool {
+	if p.avail == nil {
+		return true
+	}
+	return p.avail.CanServeBlocksFrom(pid, b.begin)
+}
+
 func (p *p2pBatchWorkerPool) shutdown(err error) {
 	p.cancel()
 	p.shutdownErr <- err
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -42,7 +43,7 @@ func TestPoolDetectAllEnded(t *testing.T) {
 	p2p := p2ptest.NewTestP2P(t)
 	ctx := context.Background()
 	ma := &mockAssigner{}
-	pool := newP2PBatchWorkerPool(p2p, nw)
+	pool := newP2PBatchWorkerPool(p2p, nw, nil)
 	st, err := util.NewBeaconState()
 	require.NoError(t, err)
 	keys, err := st.PublicKeys()
@@ -89,3 +90,68 @@ func (m *mockPool) complete() (batch, error) {
 }
 
 var _ batchWorkerPool = &mockPool{}
+
+// busyAwareAssigner returns the first n peers that aren't busy, like the real Assigner does.
+type busyAwareAssigner struct {
+	peers []peer.ID
+}
+
+func (m busyAwareAssigner) Assign(busy map[peer.ID]bool, n int) ([]peer.ID, error) {
+	assigned := make([]peer.ID, 0, n)
+	for _, pid := range m.peers {
+		if len(assigned) == n {
+			break
+		}
+		if !busy[pid] {
+			assigned = append(assigned, pid)
+		}
+	}
+	return assigned, nil
+}
+
+type mockPeerAvailability struct {
+	earliest map[peer.ID]primitives.Slot
+}
+
+func (m mockPeerAvailability) CanServeBlocksFrom(pid peer.ID, slot primitives.Slot) bool {
+	return slot >= m.earliest[pid]
+}
+
+func TestPoolSkipsPeersMissingBatchRange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// The first two peers were checkpoint synced and haven't backfilled far enough to serve the batch.
+	pids := []peer.ID{"behind", "further-behind", "caught-up"}
+	pool := &p2pBatchWorkerPool{
+		avail: mockPeerAvailability{earliest: map[peer.ID]primitives.Slot{
+			"behind":         150,
+			"further-behind": 1000,
+		}},
+		toRouter:    make(chan batch, 1),
+		toWorkers:   make(chan batch),
+		fromWorkers: make(chan batch),
+		fromRouter:  make(chan batch, 1),
+		shutdownErr: make(chan error),
+	}
+	pool.ctx, pool.cancel = ctx, cancel
+	go pool.batchRouter(busyAwareAssigner{peers: pids})
+
+	pool.toRouter <- batch{begin: 100, end: 132, state: batchSequenced}
+	select {
+	case b := <-pool.toWorkers:
+		require.Equal(t, peer.ID("caught-up"), b.busy)
+		require.Equal(t, primitives.Slot(100), b.begin)
+	case <-time.After(time.Second):
+		t.Fatal("batch was not assigned to a worker")
+	}
+
+	// Once the first peer's backfill has progressed far enough, it is preferred again.
+	pool.avail = mockPeerAvailability{earliest: map[peer.ID]primitives.Slot{"further-behind": 1000}}
+	pool.toRouter <- batch{begin: 68, end: 100, state: batchSequenced}
+	select {
+	case b := <-pool.toWorkers:
+		require.Equal(t, peer.ID("behind"), b.busy)
+	case <-time.After(time.Second):
+		t.Fatal("batch was not assigned to a worker")
+	}
+}
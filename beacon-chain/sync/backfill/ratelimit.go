@@ -0,0 +1,54 @@
+package backfill
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
+)
+
+// blockRateLimiter limits the number of blocks requested by all backfill workers per second, so that backfill
+// leaves enough bandwidth and peer capacity to regular sync and validator duties. A nil blockRateLimiter does
+// not limit requests.
+type blockRateLimiter struct {
+	sync.Mutex
+	bucket *leakybucket.LeakyBucket
+}
+
+// newBlockRateLimiter returns a limiter allowing blocksPerSecond blocks to be requested per second, or nil when
+// blocksPerSecond is zero. The capacity of the limiter fits at least one batch, so that any batch can be requested.
+func newBlockRateLimiter(blocksPerSecond, batchSize uint64) *blockRateLimiter {
+	if blocksPerSecond == 0 {
+		return nil
+	}
+	capacity := blocksPerSecond
+	if batchSize > capacity {
+		capacity = batchSize
+	}
+	return &blockRateLimiter{bucket: leakybucket.NewLeakyBucket(float64(blocksPerSecond), int64(capacity), time.Second)}
+}
+
+// wait blocks until n more blocks can be requested without exceeding the rate limit, or the context is canceled.
+func (l *blockRateLimiter) wait(ctx context.Context, n uint64) error {
+	if l == nil {
+		return nil
+	}
+	for {
+		l.Lock()
+		remaining := l.bucket.Remaining()
+		if remaining >= int64(n) || remaining == l.bucket.Capacity() {
+			l.bucket.Add(int64(n))
+			l.Unlock()
+			return nil
+		}
+		// Wait for the bucket to leak enough to fit the request.
+		until := time.Duration(float64(int64(n)-remaining) / l.bucket.Rate() * float64(time.Second))
+		l.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(until):
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestBlockRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	t.Run("disabled", func(t *testing.T) {
+		l := newBlockRateLimiter(0, 32)
+		require.Equal(t, (*blockRateLimiter)(nil), l)
+		require.NoError(t, l.wait(ctx, 1000))
+	})
+	t.Run("waits for capacity", func(t *testing.T) {
+		l := newBlockRateLimiter(100, 10)
+		start := time.Now()
+		require.NoError(t, l.wait(ctx, 100))
+		require.Equal(t, true, time.Since(start) < 100*time.Millisecond)
+		// The bucket is full, so the next 20 blocks fit after 200ms.
+		require.NoError(t, l.wait(ctx, 20))
+		require.Equal(t, true, time.Since(start) >= 150*time.Millisecond)
+	})
+	t.Run("batch larger than rate", func(t *testing.T) {
+		l := newBlockRateLimiter(10, 64)
+		require.NoError(t, l.wait(ctx, 64))
+	})
+	t.Run("canceled", func(t *testing.T) {
+		l := newBlockRateLimiter(1, 1)
+		require.NoError(t, l.wait(ctx, 1))
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		require.ErrorIs(t, l.wait(cctx, 1), context.Canceled)
+	})
+}
@@ -31,6 +31,7 @@ type Service struct {
 	nWorkers        int
 	batchSeq        *batchSequencer
 	batchSize       uint64
+	blocksPerSecond uint64
 	pool            batchWorkerPool
 	verifier        *verifier
 	ctxMap          sync.ContextByteVersions
@@ -93,6 +94,15 @@ func WithBatchSize(n uint64) ServiceOption {
 	}
 }
 
+// WithBlocksPerSecond limits the number of blocks backfill requests from peers per second, so that backfill
+// doesn't compete with regular sync and validator duties for bandwidth and peers. Zero disables the limit.
+func WithBlocksPerSecond(n uint64) ServiceOption {
+	return func(s *Service) error {
+		s.blocksPerSecond = n
+		return nil
+	}
+}
+
 // WithInitSyncWaiter sets a function on the service which will block until init-sync
 // completes for the first time, or returns an error if context is canceled.
 func WithInitSyncWaiter(w func() error) ServiceOption {
@@ -120,7 +130,12 @@ func WithVerifierWaiter(viw InitializerWaiter) ServiceOption {
 
 // WithMinimumSlot allows the user to specify a different backfill minimum slot than the spec default of current - MIN_EPOCHS_FOR_BLOCK_REQUESTS.
 // If this value is greater than current - MIN_EPOCHS_FOR_BLOCK_REQUESTS, it will be ignored with a warning log.
+// A value of 0 backfills all blocks down to genesis.
 func WithMinimumSlot(s primitives.Slot) ServiceOption {
+	if s == 0 {
+		// The genesis block is not signed, so backfill stops at slot 1, like minimumBackfillSlot.
+		s = 1
+	}
 	ms := func(current primitives.Slot) primitives.Slot {
 		specMin := minimumBackfillSlot(current)
 		if s < specMin {
@@ -154,7 +169,7 @@ func NewService(ctx context.Context, su *Store, bStore *filesystem.BlobStorage,
 			return nil, err
 		}
 	}
-	s.pool = newP2PBatchWorkerPool(p, s.nWorkers)
+	s.pool = newP2PBatchWorkerPool(p, s.nWorkers, newBlockRateLimiter(s.blocksPerSecond, s.batchSize))
 
 	return s, nil
 }
@@ -220,11 +235,16 @@ func (s *Service) importBatches(ctx context.Context) {
 	}
 
 	nt := s.batchSeq.numTodo()
+	low := primitives.Slot(s.store.status().LowSlot)
+	minimum := s.ms(current)
 	log.WithField("imported", imported).WithField("importable", len(importable)).
 		WithField("batchesRemaining", nt).
+		WithField("lowestBackfilledSlot", low).
+		WithField("slotsRemaining", low.FlooredSubSlot(minimum)).
 		Info("Backfill batches processed")
 
 	backfillRemainingBatches.Set(float64(nt))
+	backfillLowestSlot.Set(float64(low))
 }
 
 func (s *Service) scheduleTodos() {
@@ -276,6 +296,7 @@ func (s *Service) Start() {
 		return
 	}
 	status := s.store.status()
+	backfillLowestSlot.Set(float64(status.LowSlot))
 	// Exit early if there aren't going to be any batches to backfill.
 	if primitives.Slot(status.LowSlot) <= s.ms(s.clock.CurrentSlot()) {
 		log.WithField("minimumRequiredSlot", s.ms(s.clock.CurrentSlot())).
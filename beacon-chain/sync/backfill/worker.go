@@ -4,9 +4,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
@@ -14,16 +16,22 @@ import (
 
 type workerId int
 
+// missingBlobsTimeout bounds the time spent requesting the blob sidecars a peer left out of a by range response
+// from other peers, before the batch is retried.
+var missingBlobsTimeout = 30 * time.Second
+
 type p2pWorker struct {
-	id   workerId
-	todo chan batch
-	done chan batch
-	p2p  p2p.P2P
-	v    *verifier
-	c    *startup.Clock
-	cm   sync.ContextByteVersions
-	nbv  verification.NewBlobVerifier
-	bfs  *filesystem.BlobStorage
+	id    workerId
+	todo  chan batch
+	done  chan batch
+	p2p   p2p.P2P
+	v     *verifier
+	c     *startup.Clock
+	cm    sync.ContextByteVersions
+	nbv   verification.NewBlobVerifier
+	bfs   *filesystem.BlobStorage
+	rl    *blockRateLimiter
+	blobs *sync.BlobFetcher
 }
 
 func (w *p2pWorker) run(ctx context.Context) {
@@ -50,11 +58,18 @@ func (w *p2pWorker) handleBlocks(ctx context.Context, b batch) batch {
 		return b.withRetryableError(errors.Wrap(err, "configuration issue, could not compute minimum blob retention slot"))
 	}
 	b.blockPid = b.busy
+	if err := w.rl.wait(ctx, b.blockRequest().Count); err != nil {
+		return b.withRetryableError(err)
+	}
 	start := time.Now()
 	results, err := sync.SendBeaconBlocksByRangeRequest(ctx, w.c, w.p2p, b.blockPid, b.blockRequest(), blockValidationMetrics)
 	dlt := time.Now()
 	backfillBatchTimeDownloadingBlocks.Observe(float64(dlt.Sub(start).Milliseconds()))
 	if err != nil {
+		if errors.Is(err, p2ptypes.ErrResourceUnavailable) {
+			// The peer hasn't backfilled this far yet, so the pool shouldn't assign it batches this old for a while.
+			w.p2p.Peers().SetEarliestAvailableSlot(b.blockPid, b.begin+1)
+		}
 		log.WithError(err).WithFields(b.logFields()).Debug("Batch requesting failed")
 		return b.withRetryableError(err)
 	}
@@ -98,19 +113,42 @@ func (w *p2pWorker) handleBlobs(ctx context.Context, b batch) batch {
 		backfillBlobsApproximateBytes.Add(float64(sz))
 		log.WithFields(b.logFields()).WithField("dlbytes", sz).Debug("Backfill batch blob bytes downloaded")
 	}
+	if b.blobsNeeded() > 0 {
+		w.fetchMissingBlobs(ctx, b)
+	}
 	return b.postBlobSync()
 }
 
-func newP2pWorker(id workerId, p p2p.P2P, todo, done chan batch, c *startup.Clock, v *verifier, cm sync.ContextByteVersions, nbv verification.NewBlobVerifier, bfs *filesystem.BlobStorage) *p2pWorker {
+// fetchMissingBlobs requests the blob sidecars the peer left out of its by range response from other peers, by
+// root. They are stored once verified, so the batch only needs the sidecars it received by range.
+func (w *p2pWorker) fetchMissingBlobs(ctx context.Context, b batch) {
+	var pids []peer.ID
+	for _, pid := range w.p2p.Peers().Connected() {
+		if pid != b.blobPid && w.p2p.Peers().CanServeBlocksFrom(pid, b.begin) {
+			pids = append(pids, pid)
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, missingBlobsTimeout)
+	defer cancel()
+	if err := w.blobs.FetchMissing(ctx, b.bs.missingBlocks(b.results), pids); err != nil {
+		log.WithError(err).WithFields(b.logFields()).Debug("Could not fetch missing blob sidecars by root")
+		return
+	}
+	b.bs.markFetched()
+}
+
+func newP2pWorker(id workerId, p p2p.P2P, todo, done chan batch, c *startup.Clock, v *verifier, cm sync.ContextByteVersions, nbv verification.NewBlobVerifier, bfs *filesystem.BlobStorage, rl *blockRateLimiter, blobs *sync.BlobFetcher) *p2pWorker {
 	return &p2pWorker{
-		id:   id,
-		todo: todo,
-		done: done,
-		p2p:  p,
-		v:    v,
-		c:    c,
-		cm:   cm,
-		nbv:  nbv,
-		bfs:  bfs,
+		id:    id,
+		todo:  todo,
+		done:  done,
+		p2p:   p,
+		v:     v,
+		c:     c,
+		cm:    cm,
+		nbv:   nbv,
+		bfs:   bfs,
+		rl:    rl,
+		blobs: blobs,
 	}
 }
@@ -0,0 +1,365 @@
+package sync
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/verify"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// blobFetchPeers is the number of peers the missing blob sidecars are spread over in each round of requests.
+	blobFetchPeers = 3
+	// blobProvidersSize is the number of peers for which blob sidecar requests by root are scored.
+	blobProvidersSize = 1000
+)
+
+// BlobFetcherConfig holds the dependencies of a BlobFetcher.
+type BlobFetcherConfig struct {
+	P2P             p2p.P2P
+	Clock           blockchain.TemporalOracle
+	CtxMap          ContextByteVersions
+	BlobStorage     *filesystem.BlobStorage
+	NewBlobVerifier verification.NewBlobVerifier
+	// Requirements are the verifications every fetched sidecar must pass before it is stored.
+	Requirements []verification.Requirement
+	// Receive stores a verified sidecar. Sidecars are saved to BlobStorage when nil.
+	Receive func(context.Context, blocks.VerifiedROBlob) error
+	// Providers ranks the peers by how well they served previous requests. Peers are asked in the given order when nil.
+	Providers *BlobProviders
+	// Reconstructor rebuilds sidecars from the blobs in the execution layer mempool. Only needed by FetchFromExecution.
+	Reconstructor execution.Reconstructor
+	// Broadcast publishes the sidecars reconstructed by FetchFromExecution before they are stored. Nothing is
+	// broadcast when nil.
+	Broadcast func(context.Context, blocks.VerifiedROBlob) error
+}
+
+// BlobFetcher retrieves the blob sidecars of blocks which are missing from storage, either from the execution layer
+// mempool or from peers with BlobSidecarsByRoot requests. It is used by every path recovering the sidecars of known
+// blocks: the pending blocks queue, blocks received over gossip whose sidecars are late, the checkpoint sync origin
+// block and backfill batches whose by range responses were incomplete.
+type BlobFetcher struct {
+	cfg *BlobFetcherConfig
+}
+
+// NewBlobFetcher creates a BlobFetcher.
+func NewBlobFetcher(cfg *BlobFetcherConfig) *BlobFetcher {
+	return &BlobFetcher{cfg: cfg}
+}
+
+// FetchMissing requests the blob sidecars of the blocks which are missing from storage, verifies them and stores
+// them. The missing sidecars of all the blocks are combined and spread over the best blob providers among the peers.
+// Sidecars left out of a response, or part of an invalid response, are requested again from other peers until all of
+// them are stored, no peer is left to ask or the context is done. The context deadline should therefore be the
+// deadline by which the blobs must be available.
+func (f *BlobFetcher) FetchMissing(ctx context.Context, blks []blocks.ROBlock, pids []peer.ID) error {
+	byRoot := make(map[[32]byte]blocks.ROBlock, len(blks))
+	for _, b := range blks {
+		byRoot[b.Root()] = b
+	}
+	candidates := f.cfg.Providers.rank(pids)
+	for {
+		// Storage is checked again at each round, as the sidecars may have arrived over gossip meanwhile.
+		missing, err := f.missing(blks)
+		if err != nil {
+			return err
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return errors.Wrapf(err, "%d blob sidecars still missing", len(missing))
+		}
+		if len(candidates) == 0 {
+			return errors.Errorf("no peer left to request %d missing blob sidecars from", len(missing))
+		}
+		n := min(blobFetchPeers, len(candidates), len(missing))
+		round := candidates[:n]
+		candidates = candidates[n:]
+
+		reqs := splitBlobRequests(missing, n)
+		responses := make([][]blocks.ROBlob, n)
+		errs := make([]error, n)
+		var wg sync.WaitGroup
+		for i := range reqs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				responses[i], errs[i] = SendBlobSidecarByRoot(ctx, f.cfg.Clock, f.cfg.P2P, round[i], f.cfg.CtxMap, &reqs[i])
+			}(i)
+		}
+		wg.Wait()
+
+		for i := range reqs {
+			stored := 0
+			err := errs[i]
+			if err == nil {
+				stored, err = f.store(ctx, byRoot, responses[i])
+				if err != nil {
+					f.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(round[i])
+				}
+			}
+			f.cfg.Providers.record(round[i], len(reqs[i]), stored)
+			fields := logrus.Fields{"peer": round[i], "requested": len(reqs[i]), "stored": stored}
+			switch {
+			case err != nil:
+				blobFetchRequestsTotal.WithLabelValues("failed").Inc()
+				log.WithFields(fields).WithError(err).Debug("Could not fetch missing blob sidecars from peer")
+			case stored < len(reqs[i]):
+				blobFetchRequestsTotal.WithLabelValues("partial").Inc()
+				log.WithFields(fields).Debug("Peer returned part of the missing blob sidecars")
+			default:
+				blobFetchRequestsTotal.WithLabelValues("complete").Inc()
+				// A peer which served the whole request may be asked for the sidecars other peers did not serve.
+				candidates = append(candidates, round[i])
+			}
+		}
+	}
+}
+
+// FetchFromExecution reconstructs the blob sidecars of the block which are missing from storage from the blobs in
+// the execution layer mempool, broadcasts them and stores them. It returns the number of sidecars stored.
+func (f *BlobFetcher) FetchFromExecution(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, root [32]byte) (int, error) {
+	indices, err := f.cfg.BlobStorage.Indices(root)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not check stored blobs of block root %#x", root)
+	}
+	for _, index := range indices {
+		if index {
+			blobExistedInDBTotal.Inc()
+		}
+	}
+	sidecars, err := f.cfg.Reconstructor.ReconstructBlobSidecars(ctx, block, root, indices[:])
+	if err != nil {
+		return 0, errors.Wrap(err, "could not reconstruct blob sidecars")
+	}
+	if len(sidecars) == 0 {
+		return 0, nil
+	}
+
+	// Sidecars may have been stored meanwhile, those are neither broadcast nor stored again.
+	indices, err = f.cfg.BlobStorage.Indices(root)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not check stored blobs of block root %#x", root)
+	}
+	reconstructed := make([]blocks.VerifiedROBlob, 0, len(sidecars))
+	for _, sc := range sidecars {
+		if sc.Index >= uint64(len(indices)) || indices[sc.Index] {
+			blobExistedInDBTotal.Inc()
+			continue
+		}
+		reconstructed = append(reconstructed, sc)
+	}
+	// Sidecars are broadcast before they are stored, so that peers get them as early as possible.
+	if f.cfg.Broadcast != nil {
+		for _, sc := range reconstructed {
+			if err := f.cfg.Broadcast(ctx, sc); err != nil {
+				log.WithFields(blobFields(sc.ROBlob)).WithError(err).Error("Failed to broadcast blob sidecar")
+			}
+		}
+	}
+
+	startTime, err := slots.ToTime(uint64(f.cfg.Clock.GenesisTime().Unix()), block.Block().Slot())
+	if err != nil {
+		log.WithError(err).Error("Failed to convert slot to time")
+	}
+	stored := 0
+	for _, sc := range reconstructed {
+		if err := f.receive(ctx, sc); err != nil {
+			log.WithFields(blobFields(sc.ROBlob)).WithError(err).Error("Failed to receive blob")
+			continue
+		}
+		stored++
+		blobRecoveredFromELTotal.Inc()
+		blobSidecarsObtainedTotal.WithLabelValues(blobSourceExecution).Inc()
+		fields := blobFields(sc.ROBlob)
+		fields["sinceSlotStartTime"] = time.Since(startTime)
+		log.WithFields(fields).Debug("Processed blob sidecar from EL")
+	}
+	return stored, nil
+}
+
+// missing returns the identifiers of the blob sidecars of the blocks which are missing from storage.
+func (f *BlobFetcher) missing(blks []blocks.ROBlock) (p2ptypes.BlobSidecarsByRootReq, error) {
+	var missing p2ptypes.BlobSidecarsByRootReq
+	for _, b := range blks {
+		ids, err := MissingBlobIdentifiers(b, f.cfg.BlobStorage)
+		if err != nil {
+			return nil, err
+		}
+		missing = append(missing, ids...)
+	}
+	return missing, nil
+}
+
+// store verifies the sidecars of a response against their blocks and stores them, returning the number of sidecars
+// stored. The sidecars of a response are only stored when they are all valid.
+func (f *BlobFetcher) store(ctx context.Context, byRoot map[[32]byte]blocks.ROBlock, sidecars []blocks.ROBlob) (int, error) {
+	// Responses are ordered by block, so sidecars are grouped in the order their blocks were first seen.
+	var roots [][32]byte
+	grouped := make(map[[32]byte][]blocks.ROBlob)
+	for _, sc := range sidecars {
+		blk, ok := byRoot[sc.BlockRoot()]
+		if !ok {
+			return 0, errors.Wrapf(errUnrequested, "root=%#x", sc.BlockRoot())
+		}
+		if err := verify.BlobAlignsWithBlock(sc, blk); err != nil {
+			return 0, err
+		}
+		if _, ok := grouped[sc.BlockRoot()]; !ok {
+			roots = append(roots, sc.BlockRoot())
+		}
+		grouped[sc.BlockRoot()] = append(grouped[sc.BlockRoot()], sc)
+	}
+	bv := verification.NewBlobBatchVerifier(f.cfg.NewBlobVerifier, f.cfg.Requirements)
+	verified := make([]blocks.VerifiedROBlob, 0, len(sidecars))
+	for _, r := range roots {
+		vscs, err := bv.VerifiedROBlobs(ctx, byRoot[r], grouped[r])
+		if err != nil {
+			return 0, err
+		}
+		verified = append(verified, vscs...)
+	}
+	for i := range verified {
+		if err := f.receive(ctx, verified[i]); err != nil {
+			return i, err
+		}
+		blobSidecarsObtainedTotal.WithLabelValues(blobSourceByRoot).Inc()
+		log.WithFields(blobFields(verified[i].ROBlob)).Debug("Received blob sidecar RPC")
+	}
+	return len(verified), nil
+}
+
+func (f *BlobFetcher) receive(ctx context.Context, sc blocks.VerifiedROBlob) error {
+	if f.cfg.Receive != nil {
+		return f.cfg.Receive(ctx, sc)
+	}
+	return f.cfg.BlobStorage.Save(sc)
+}
+
+// splitBlobRequests spreads the identifiers over n requests, each of them within the request size limit. Identifiers
+// beyond the limit of all the requests are left for the next round.
+func splitBlobRequests(ids p2ptypes.BlobSidecarsByRootReq, n int) []p2ptypes.BlobSidecarsByRootReq {
+	limit := params.BeaconConfig().MaxRequestBlobSidecars
+	reqs := make([]p2ptypes.BlobSidecarsByRootReq, n)
+	for i, id := range ids {
+		r := i % n
+		if uint64(len(reqs[r])) >= limit {
+			break
+		}
+		reqs[r] = append(reqs[r], id)
+	}
+	return reqs
+}
+
+// MissingBlobIdentifiers returns the identifiers of the blob sidecars committed to by the block which are missing
+// from storage.
+func MissingBlobIdentifiers(blk blocks.ROBlock, store *filesystem.BlobStorage) (p2ptypes.BlobSidecarsByRootReq, error) {
+	if blk.Version() < version.Deneb {
+		return nil, nil // Block before deneb has no blob.
+	}
+	cmts, err := blk.Block().Body().BlobKzgCommitments()
+	if err != nil {
+		return nil, err
+	}
+	if len(cmts) == 0 {
+		return nil, nil
+	}
+	stored, err := store.Indices(blk.Root())
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check stored blobs of block root %#x", blk.Root())
+	}
+	return requestsForMissingIndices(stored, len(cmts), blk.Root()), nil
+}
+
+// BlobProviders scores peers by the share of the blob sidecars requested by root they served, so that missing
+// sidecars are requested from the peers most likely to have them.
+type BlobProviders struct {
+	sync.Mutex
+	records *lru.Cache
+}
+
+type blobProviderRecord struct {
+	requested int
+	served    int
+}
+
+// NewBlobProviders creates an empty BlobProviders.
+func NewBlobProviders() *BlobProviders {
+	return &BlobProviders{records: lruwrpr.New(blobProvidersSize)}
+}
+
+// rank returns the distinct peers ordered from the best blob provider to the worst one. Peers which have not been
+// asked yet are scored as neutral, and peers with equal scores keep their order.
+func (p *BlobProviders) rank(pids []peer.ID) []peer.ID {
+	seen := make(map[peer.ID]bool, len(pids))
+	ranked := make([]peer.ID, 0, len(pids))
+	for _, pid := range pids {
+		if seen[pid] {
+			continue
+		}
+		seen[pid] = true
+		ranked = append(ranked, pid)
+	}
+	if p == nil {
+		return ranked
+	}
+	scores := make(map[peer.ID]float64, len(ranked))
+	p.Lock()
+	for _, pid := range ranked {
+		scores[pid] = p.score(pid)
+	}
+	p.Unlock()
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i]] > scores[ranked[j]]
+	})
+	return ranked
+}
+
+// score is the share of the requested sidecars served by the peer, smoothed so that a peer with no record scores 0.5.
+func (p *BlobProviders) score(pid peer.ID) float64 {
+	v, ok := p.records.Get(pid)
+	if !ok {
+		return 0.5
+	}
+	r, ok := v.(*blobProviderRecord)
+	if !ok {
+		return 0.5
+	}
+	return float64(r.served+1) / float64(r.requested+2)
+}
+
+func (p *BlobProviders) record(pid peer.ID, requested, served int) {
+	if p == nil {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	r := &blobProviderRecord{}
+	if v, ok := p.records.Get(pid); ok {
+		if existing, ok := v.(*blobProviderRecord); ok {
+			r = existing
+		}
+	}
+	r.requested += requested
+	r.served += served
+	p.records.Add(pid, r)
+}
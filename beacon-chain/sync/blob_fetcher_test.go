@@ -0,0 +1,209 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestMissingBlobIdentifiers(t *testing.T) {
+	cases := []struct {
+		name  string
+		setup func(t *testing.T) (blocks.ROBlock, *filesystem.BlobStorage)
+		nReq  int
+	}{
+		{
+			name: "pre-deneb",
+			setup: func(t *testing.T) (blocks.ROBlock, *filesystem.BlobStorage) {
+				cb, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockCapella())
+				require.NoError(t, err)
+				rob, err := blocks.NewROBlockWithRoot(cb, [32]byte{})
+				require.NoError(t, err)
+				return rob, nil
+			},
+			nReq: 0,
+		},
+		{
+			name: "deneb zero commitments",
+			setup: func(t *testing.T) (blocks.ROBlock, *filesystem.BlobStorage) {
+				bk, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 0)
+				return bk, nil
+			},
+			nReq: 0,
+		},
+		{
+			name: "2 commitments, all missing",
+			setup: func(t *testing.T) (blocks.ROBlock, *filesystem.BlobStorage) {
+				bk, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 2)
+				fs := filesystem.NewEphemeralBlobStorage(t)
+				return bk, fs
+			},
+			nReq: 2,
+		},
+		{
+			name: "2 commitments, 1 missing",
+			setup: func(t *testing.T) (blocks.ROBlock, *filesystem.BlobStorage) {
+				bk, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 2)
+				bm, fs := filesystem.NewEphemeralBlobStorageWithMocker(t)
+				require.NoError(t, bm.CreateFakeIndices(bk.Root(), 1))
+				return bk, fs
+			},
+			nReq: 1,
+		},
+		{
+			name: "2 commitments, 0 missing",
+			setup: func(t *testing.T) (blocks.ROBlock, *filesystem.BlobStorage) {
+				bk, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 2)
+				bm, fs := filesystem.NewEphemeralBlobStorageWithMocker(t)
+				require.NoError(t, bm.CreateFakeIndices(bk.Root(), 0, 1))
+				return bk, fs
+			},
+			nReq: 0,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			blk, store := c.setup(t)
+			req, err := MissingBlobIdentifiers(blk, store)
+			require.NoError(t, err)
+			require.Equal(t, c.nReq, len(req))
+		})
+	}
+}
+
+func TestSplitBlobRequests(t *testing.T) {
+	root := [32]byte{1}
+	ids := make(p2ptypes.BlobSidecarsByRootReq, 0, 7)
+	for i := uint64(0); i < 7; i++ {
+		ids = append(ids, &ethpb.BlobIdentifier{BlockRoot: root[:], Index: i})
+	}
+
+	reqs := splitBlobRequests(ids, 3)
+	require.Equal(t, 3, len(reqs))
+	assert.Equal(t, 3, len(reqs[0]))
+	assert.Equal(t, 2, len(reqs[1]))
+	assert.Equal(t, 2, len(reqs[2]))
+	assert.Equal(t, uint64(0), reqs[0][0].Index)
+	assert.Equal(t, uint64(1), reqs[1][0].Index)
+	assert.Equal(t, uint64(3), reqs[0][1].Index)
+
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxRequestBlobSidecars = 2
+	params.OverrideBeaconConfig(cfg)
+	reqs = splitBlobRequests(ids, 2)
+	require.Equal(t, 2, len(reqs))
+	assert.Equal(t, 2, len(reqs[0]))
+	assert.Equal(t, 2, len(reqs[1]))
+}
+
+func TestBlobProviders(t *testing.T) {
+	var nilProviders *BlobProviders
+	assert.DeepEqual(t, []peer.ID{"a", "b"}, nilProviders.rank([]peer.ID{"a", "b", "a"}))
+	nilProviders.record("a", 1, 0)
+
+	p := NewBlobProviders()
+	p.record("a", 6, 0)
+	p.record("c", 6, 6)
+	p.record("d", 6, 3)
+	assert.DeepEqual(t, []peer.ID{"c", "b", "d", "e", "a"}, p.rank([]peer.ID{"a", "b", "c", "d", "e", "c"}))
+
+	p.record("a", 12, 12)
+	assert.DeepEqual(t, []peer.ID{"c", "a"}, p.rank([]peer.ID{"a", "c"}))
+}
+
+func TestFetchMissing(t *testing.T) {
+	t.Run("nothing missing", func(t *testing.T) {
+		f := NewBlobFetcher(&BlobFetcherConfig{BlobStorage: filesystem.NewEphemeralBlobStorage(t)})
+		phase0, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+		require.NoError(t, err)
+		rob, err := blocks.NewROBlock(phase0)
+		require.NoError(t, err)
+		noBlobs, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 0)
+		require.NoError(t, f.FetchMissing(context.Background(), []blocks.ROBlock{rob, noBlobs}, nil))
+	})
+	t.Run("no peer", func(t *testing.T) {
+		f := NewBlobFetcher(&BlobFetcherConfig{BlobStorage: filesystem.NewEphemeralBlobStorage(t)})
+		blk, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 2)
+		require.ErrorContains(t, "no peer left to request 2 missing blob sidecars", f.FetchMissing(context.Background(), []blocks.ROBlock{blk}, nil))
+	})
+	t.Run("context done", func(t *testing.T) {
+		f := NewBlobFetcher(&BlobFetcherConfig{BlobStorage: filesystem.NewEphemeralBlobStorage(t)})
+		blk, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 2)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.ErrorContains(t, "2 blob sidecars still missing", f.FetchMissing(ctx, []blocks.ROBlock{blk}, []peer.ID{"a"}))
+	})
+	t.Run("unsupported protocol", func(t *testing.T) {
+		p1 := p2ptest.NewTestP2P(t)
+		p2 := p2ptest.NewTestP2P(t)
+		p1.Connect(p2)
+		require.Equal(t, 1, len(p1.BHost.Network().Peers()))
+		p1.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirOutbound)
+		p1.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
+		providers := NewBlobProviders()
+		f := NewBlobFetcher(&BlobFetcherConfig{
+			P2P:         p1,
+			Clock:       startup.NewClock(time.Unix(0, 0), [32]byte{}),
+			BlobStorage: filesystem.NewEphemeralBlobStorage(t),
+			Providers:   providers,
+		})
+		blk, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 1)
+		require.ErrorContains(t, "no peer left to request 1 missing blob sidecars", f.FetchMissing(context.Background(), []blocks.ROBlock{blk}, []peer.ID{p2.PeerID()}))
+		assert.Equal(t, 1.0/3, providers.score(p2.PeerID()))
+	})
+}
+
+func TestRecoverBlobs_CombinesPendingBlocks(t *testing.T) {
+	delay := blobRecoveryDelay
+	blobRecoveryDelay = time.Hour
+	defer func() {
+		blobRecoveryDelay = delay
+	}()
+
+	s := &Service{
+		ctx: context.Background(),
+		cfg: &config{
+			p2p:                    p2ptest.NewTestP2P(t),
+			chain:                  &mock.ChainService{Genesis: time.Now()},
+			clock:                  startup.NewClock(time.Now(), [32]byte{}),
+			blobStorage:            filesystem.NewEphemeralBlobStorage(t),
+			executionReconstructor: &mockExecution.EngineClient{},
+		},
+	}
+	first, _ := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 2)
+	second, _ := util.GenerateTestDenebBlockWithSidecar(t, first.Root(), 1, 1)
+	noBlobs, _ := util.GenerateTestDenebBlockWithSidecar(t, second.Root(), 2, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+	s.recoverBlobs(ctx, first, first.Root())
+	s.recoverBlobs(context.Background(), second, second.Root())
+	s.recoverBlobs(ctx, noBlobs, noBlobs.Root())
+
+	s.blobRecovery.Lock()
+	defer s.blobRecovery.Unlock()
+	require.Equal(t, 2, len(s.blobRecovery.pending))
+	assert.Equal(t, first.Root(), s.blobRecovery.pending[0].Root())
+	assert.Equal(t, second.Root(), s.blobRecovery.pending[1].Root())
+	// The latest deadline of the pending blocks bounds the requests.
+	assert.Equal(t, true, s.blobRecovery.deadline.After(deadline))
+}
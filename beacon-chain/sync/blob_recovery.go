@@ -0,0 +1,102 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// blobRecoveryDelay is how long the missing blob sidecars of blocks received over gossip are left to arrive over
+// gossip, or to be reconstructed from the execution layer mempool, before they are requested from peers.
+var blobRecoveryDelay = slots.DivideSlotBy(6 /* times per slot */)
+
+// blobRecovery holds the blocks received over gossip whose missing blob sidecars are about to be requested from peers.
+// Blocks received within blobRecoveryDelay of each other have their sidecars requested together.
+type blobRecovery struct {
+	sync.Mutex
+	pending  []blocks.ROBlock
+	deadline time.Time
+}
+
+// blobFetcher returns the fetcher of the missing blob sidecars of the blocks handled by the sync service. Fetched
+// sidecars are received like gossiped ones, so that blocks waiting for their blobs to be available are notified.
+func (s *Service) blobFetcher() *BlobFetcher {
+	return NewBlobFetcher(&BlobFetcherConfig{
+		P2P:             s.cfg.p2p,
+		Clock:           s.cfg.clock,
+		CtxMap:          s.ctxMap,
+		BlobStorage:     s.cfg.blobStorage,
+		NewBlobVerifier: s.newBlobVerifier,
+		Requirements:    verification.PendingQueueSidecarRequirements,
+		Receive:         s.subscribeBlob,
+		Providers:       s.blobProviders,
+		Reconstructor:   s.cfg.executionReconstructor,
+		Broadcast: func(ctx context.Context, sc blocks.VerifiedROBlob) error {
+			return s.cfg.p2p.BroadcastBlob(ctx, sc.Index, sc.BlobSidecar)
+		},
+	})
+}
+
+// recoverBlobs makes the blob sidecars of a block received over gossip available when they are late. They are first
+// reconstructed from the execution layer mempool. Sidecars still missing after blobRecoveryDelay are then requested
+// from peers until the deadline of the context, which is the deadline of the block's data availability check.
+func (s *Service) recoverBlobs(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, root [32]byte) {
+	if block.Version() < version.Deneb || s.cfg.blobStorage == nil {
+		return
+	}
+	f := s.blobFetcher()
+	if _, err := f.FetchFromExecution(ctx, block, root); err != nil {
+		log.WithError(err).Error("Could not recover blob sidecars from the execution layer")
+	}
+	rob, err := blocks.NewROBlockWithRoot(block, root)
+	if err != nil {
+		log.WithError(err).Error("Could not create read-only block")
+		return
+	}
+	missing, err := MissingBlobIdentifiers(rob, s.cfg.blobStorage)
+	if err != nil {
+		log.WithError(err).Error("Could not determine missing blob sidecars")
+		return
+	}
+	if len(missing) == 0 {
+		return
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(pubsubMessageTimeout)
+	}
+
+	s.blobRecovery.Lock()
+	defer s.blobRecovery.Unlock()
+	s.blobRecovery.pending = append(s.blobRecovery.pending, rob)
+	if deadline.After(s.blobRecovery.deadline) {
+		s.blobRecovery.deadline = deadline
+	}
+	// Blocks joining the pending ones are requested along with them.
+	if len(s.blobRecovery.pending) == 1 {
+		time.AfterFunc(blobRecoveryDelay, s.fetchPendingBlobs)
+	}
+}
+
+// fetchPendingBlobs requests the missing blob sidecars of the blocks pending recovery from the best peers.
+func (s *Service) fetchPendingBlobs() {
+	s.blobRecovery.Lock()
+	blks, deadline := s.blobRecovery.pending, s.blobRecovery.deadline
+	s.blobRecovery.pending, s.blobRecovery.deadline = nil, time.Time{}
+	s.blobRecovery.Unlock()
+	if len(blks) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithDeadline(s.ctx, deadline)
+	defer cancel()
+	if err := s.blobFetcher().FetchMissing(ctx, blks, s.getBestPeers()); err != nil {
+		log.WithError(err).WithField("blocks", len(blks)).Debug("Could not recover missing blob sidecars from peers")
+	}
+}
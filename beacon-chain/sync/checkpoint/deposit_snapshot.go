@@ -0,0 +1,67 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// DepositSnapshotProvider describes a type that is able to obtain an EIP-4881 snapshot of the finalized deposit tree,
+// which the beacon node uses to bootstrap its deposit tree instead of processing all deposit contract logs.
+// See FileDepositSnapshot and APIInitializer.
+type DepositSnapshotProvider interface {
+	DepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error)
+}
+
+// NewFileDepositSnapshot validates the given path and creates a DepositSnapshotProvider reading the deposit snapshot
+// from that file.
+func NewFileDepositSnapshot(path string) (*FileDepositSnapshot, error) {
+	if err := existsAndIsFile(path); err != nil {
+		return nil, err
+	}
+	return &FileDepositSnapshot{path: path}, nil
+}
+
+// FileDepositSnapshot reads a deposit snapshot stored in a file on the local filesystem, either ssz-encoded or in
+// the JSON format of the /eth/v1/beacon/deposit_snapshot Beacon API endpoint.
+type FileDepositSnapshot struct {
+	path string
+}
+
+// DepositSnapshot reads and decodes the deposit snapshot file.
+func (f *FileDepositSnapshot) DepositSnapshot(_ context.Context) (*ethpb.DepositSnapshot, error) {
+	enc, err := file.ReadFileAsBytes(f.path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading deposit snapshot file %s", f.path)
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(enc), []byte("{")) {
+		resp := &structs.GetDepositSnapshotResponse{}
+		if err := json.Unmarshal(enc, resp); err != nil {
+			return nil, errors.Wrapf(err, "error decoding deposit snapshot file %s", f.path)
+		}
+		if resp.Data == nil {
+			return nil, errors.Errorf("deposit snapshot file %s has no data", f.path)
+		}
+		return resp.Data.ToConsensus()
+	}
+	snapshot := &ethpb.DepositSnapshot{}
+	if err := snapshot.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrapf(err, "error decoding ssz-encoded deposit snapshot file %s", f.path)
+	}
+	return snapshot, nil
+}
+
+// DepositSnapshot downloads the finalized deposit snapshot of the checkpoint sync origin.
+func (dl *APIInitializer) DepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	return dl.c.GetDepositSnapshot(ctx)
+}
+
+var (
+	_ DepositSnapshotProvider = &FileDepositSnapshot{}
+	_ DepositSnapshotProvider = &APIInitializer{}
+)
@@ -0,0 +1,60 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestFileDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	snapshot := &ethpb.DepositSnapshot{
+		Finalized:      [][]byte{bytes.Repeat([]byte{0x01}, 32)},
+		DepositRoot:    bytes.Repeat([]byte{0x02}, 32),
+		DepositCount:   1,
+		ExecutionHash:  bytes.Repeat([]byte{0x03}, 32),
+		ExecutionDepth: 100,
+	}
+	dir := t.TempDir()
+
+	_, err := NewFileDepositSnapshot(filepath.Join(dir, "missing"))
+	require.ErrorContains(t, "error checking existence", err)
+
+	t.Run("ssz", func(t *testing.T) {
+		enc, err := snapshot.MarshalSSZ()
+		require.NoError(t, err)
+		path := filepath.Join(dir, "deposit_snapshot.ssz")
+		require.NoError(t, os.WriteFile(path, enc, 0600))
+		f, err := NewFileDepositSnapshot(path)
+		require.NoError(t, err)
+		got, err := f.DepositSnapshot(ctx)
+		require.NoError(t, err)
+		require.DeepEqual(t, snapshot, got)
+	})
+	t.Run("json", func(t *testing.T) {
+		enc, err := json.Marshal(&structs.GetDepositSnapshotResponse{Data: structs.DepositSnapshotFromConsensus(snapshot)})
+		require.NoError(t, err)
+		path := filepath.Join(dir, "deposit_snapshot.json")
+		require.NoError(t, os.WriteFile(path, enc, 0600))
+		f, err := NewFileDepositSnapshot(path)
+		require.NoError(t, err)
+		got, err := f.DepositSnapshot(ctx)
+		require.NoError(t, err)
+		require.DeepEqual(t, snapshot, got)
+	})
+	t.Run("json without data", func(t *testing.T) {
+		path := filepath.Join(dir, "empty.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"data":null}`), 0600))
+		f, err := NewFileDepositSnapshot(path)
+		require.NoError(t, err)
+		_, err = f.DepositSnapshot(ctx)
+		require.ErrorContains(t, "has no data", err)
+	})
+}
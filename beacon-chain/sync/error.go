@@ -3,6 +3,7 @@ package sync
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
 
 	libp2pcore "github.com/libp2p/go-libp2p/core"
@@ -23,6 +24,9 @@ var responseCodeInvalidRequest = byte(0x01)
 var responseCodeServerError = byte(0x02)
 var responseCodeResourceUnavailable = byte(0x03)
 
+// responseCodeRateLimited is the client specific response code for requests over the rate limits, as used by Lighthouse.
+var responseCodeRateLimited = byte(139)
+
 func (s *Service) generateErrorResponse(code byte, reason string) ([]byte, error) {
 	return createErrorResponse(code, reason, s.cfg.p2p)
 }
@@ -54,6 +58,24 @@ func ReadStatusCode(stream network.Stream, encoding encoder.NetworkEncoding) (ui
 	return b[0], string(*msg), nil
 }
 
+// errorForResponseCode converts a non-success response code and its error message into an error.
+// ResourceUnavailable responses wrap types.ErrResourceUnavailable, so that callers can tell a peer that
+// lacks the requested history apart from one that failed to respond properly. Likewise, rate limited
+// responses wrap types.ErrRateLimited.
+func errorForResponseCode(code uint8, msg string) error {
+	switch code {
+	case responseCodeResourceUnavailable:
+		if msg == types.ErrResourceUnavailable.Error() {
+			return types.ErrResourceUnavailable
+		}
+		return fmt.Errorf("%w: %s", types.ErrResourceUnavailable, msg)
+	case responseCodeRateLimited:
+		return fmt.Errorf("%w: %s", types.ErrRateLimited, msg)
+	default:
+		return errors.New(msg)
+	}
+}
+
 func writeErrorResponseToStream(responseCode byte, reason string, stream libp2pcore.Stream, encoder p2p.EncodingProvider) {
 	resp, err := createErrorResponse(responseCode, reason, encoder)
 	if err != nil {
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"bytes"
+	"errors"
 	"testing"
 
 	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
@@ -26,3 +27,21 @@ func TestRegularSync_generateErrorResponse(t *testing.T) {
 	require.NoError(t, r.cfg.p2p.Encoding().DecodeWithMaxLength(buf, msg))
 	assert.Equal(t, "something bad happened", string(*msg), "Received the wrong message")
 }
+
+func TestErrorForResponseCode(t *testing.T) {
+	err := errorForResponseCode(responseCodeServerError, "something bad happened")
+	assert.ErrorContains(t, "something bad happened", err)
+	assert.Equal(t, false, errors.Is(err, types.ErrResourceUnavailable))
+
+	err = errorForResponseCode(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error())
+	require.ErrorIs(t, err, types.ErrResourceUnavailable)
+	assert.Equal(t, types.ErrResourceUnavailable.Error(), err.Error())
+
+	err = errorForResponseCode(responseCodeResourceUnavailable, "blocks before slot 100 are not available")
+	require.ErrorIs(t, err, types.ErrResourceUnavailable)
+	assert.ErrorContains(t, "blocks before slot 100 are not available", err)
+
+	err = errorForResponseCode(responseCodeRateLimited, "rate limited, retry in 1.5s")
+	require.ErrorIs(t, err, types.ErrRateLimited)
+	assert.ErrorContains(t, "retry in 1.5s", err)
+}
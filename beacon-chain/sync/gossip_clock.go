@@ -0,0 +1,203 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// Short topic names used to label early gossip arrivals, so that subnets don't blow up the metric cardinality.
+const (
+	blockArrivalTopic            = "beacon_block"
+	attestationArrivalTopic      = "beacon_attestation"
+	aggregateArrivalTopic        = "beacon_aggregate_and_proof"
+	syncMessageArrivalTopic      = "sync_committee"
+	syncContributionArrivalTopic = "sync_committee_contribution_and_proof"
+)
+
+// When more than this share of the gossip messages received in an epoch arrived before the start of their slot,
+// it is much more likely that the local clock is behind than that most of the network's clocks are ahead.
+const localClockBehindThreshold = 0.5
+
+// gossipArrival describes when a gossip message arrived relative to the start of its slot by the local clock.
+type gossipArrival int
+
+const (
+	// arrivalOnTime means that the slot of the message had already started.
+	arrivalOnTime gossipArrival = iota
+	// arrivalEarlyWithinTolerance means that the slot of the message starts within MAXIMUM_GOSSIP_CLOCK_DISPARITY.
+	arrivalEarlyWithinTolerance
+	// arrivalFutureBeyondTolerance means that the slot of the message starts after MAXIMUM_GOSSIP_CLOCK_DISPARITY.
+	arrivalFutureBeyondTolerance
+)
+
+func (a gossipArrival) String() string {
+	switch a {
+	case arrivalOnTime:
+		return "on_time"
+	case arrivalEarlyWithinTolerance:
+		return "early_within_tolerance"
+	case arrivalFutureBeyondTolerance:
+		return "future_beyond_tolerance"
+	default:
+		return "unknown"
+	}
+}
+
+// classifyGossipArrival compares the time a message was received with the start of its slot, allowing for the
+// spec's MAXIMUM_GOSSIP_CLOCK_DISPARITY. It also returns how long before the start of the slot the message arrived.
+func classifyGossipArrival(genesis time.Time, slot primitives.Slot, received time.Time) (gossipArrival, time.Duration) {
+	slotStart, err := slots.ToTime(uint64(genesis.Unix()), slot)
+	if err != nil {
+		return arrivalFutureBeyondTolerance, 0
+	}
+	early := slotStart.Sub(received)
+	switch {
+	case early <= 0:
+		return arrivalOnTime, 0
+	case early <= params.BeaconConfig().MaximumGossipClockDisparityDuration():
+		return arrivalEarlyWithinTolerance, early
+	default:
+		return arrivalFutureBeyondTolerance, early
+	}
+}
+
+// recordGossipArrival classifies the arrival of a gossip message on the given topic, and tracks messages that
+// arrived before the start of their slot in metrics and in the summary that is logged every epoch.
+func (s *Service) recordGossipArrival(topic string, slot primitives.Slot, received time.Time, pid peer.ID) gossipArrival {
+	arrival, early := classifyGossipArrival(s.cfg.clock.GenesisTime(), slot, received)
+	if arrival != arrivalOnTime {
+		gossipEarlyArrivalCounter.WithLabelValues(topic, arrival.String()).Inc()
+	}
+	s.earlyArrivals.record(arrival, early, pid)
+	return arrival
+}
+
+// waitForSlotStart blocks until the given slot has started by the local clock.
+func waitForSlotStart(ctx context.Context, genesis time.Time, slot primitives.Slot) error {
+	slotStart, err := slots.ToTime(uint64(genesis.Unix()), slot)
+	if err != nil {
+		return err
+	}
+	wait := time.Until(slotStart)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Upper bounds of the buckets that early arrivals are counted in for the epoch summary.
+var earlyArrivalBuckets = []time.Duration{
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2 * time.Second,
+}
+
+// earlyArrivalStats accumulates how early gossip messages arrive over an epoch.
+type earlyArrivalStats struct {
+	sync.Mutex
+	messages        uint64
+	withinTolerance uint64
+	beyondTolerance uint64
+	maxEarly        time.Duration
+	buckets         []uint64
+	peers           map[peer.ID]struct{}
+}
+
+func newEarlyArrivalStats() *earlyArrivalStats {
+	return &earlyArrivalStats{
+		buckets: make([]uint64, len(earlyArrivalBuckets)+1),
+		peers:   make(map[peer.ID]struct{}),
+	}
+}
+
+func (e *earlyArrivalStats) record(arrival gossipArrival, early time.Duration, pid peer.ID) {
+	if e == nil {
+		return
+	}
+	e.Lock()
+	defer e.Unlock()
+	e.messages++
+	switch arrival {
+	case arrivalEarlyWithinTolerance:
+		e.withinTolerance++
+	case arrivalFutureBeyondTolerance:
+		e.beyondTolerance++
+	default:
+		return
+	}
+	if early > e.maxEarly {
+		e.maxEarly = early
+	}
+	i := 0
+	for i < len(earlyArrivalBuckets) && early > earlyArrivalBuckets[i] {
+		i++
+	}
+	e.buckets[i]++
+	e.peers[pid] = struct{}{}
+}
+
+// logEarlyArrivals logs the distribution of gossip messages that arrived before the start of their slot since the
+// last call, and resets the counts. It logs nothing if no message arrived early.
+func (s *Service) logEarlyArrivals() {
+	e := s.earlyArrivals
+	if e == nil {
+		return
+	}
+	e.Lock()
+	messages, within, beyond, maxEarly := e.messages, e.withinTolerance, e.beyondTolerance, e.maxEarly
+	buckets := make([]string, 0, len(e.buckets))
+	for i, n := range e.buckets {
+		if n == 0 {
+			continue
+		}
+		if i < len(earlyArrivalBuckets) {
+			buckets = append(buckets, fmt.Sprintf("<=%s:%d", earlyArrivalBuckets[i], n))
+		} else {
+			buckets = append(buckets, fmt.Sprintf(">%s:%d", earlyArrivalBuckets[i-1], n))
+		}
+	}
+	peers := len(e.peers)
+	e.messages, e.withinTolerance, e.beyondTolerance, e.maxEarly = 0, 0, 0, 0
+	e.buckets = make([]uint64, len(earlyArrivalBuckets)+1)
+	e.peers = make(map[peer.ID]struct{})
+	e.Unlock()
+
+	early := within + beyond
+	if early == 0 {
+		return
+	}
+	share := float64(early) / float64(messages)
+	l := log.WithFields(logrus.Fields{
+		"messages":              messages,
+		"earlyWithinTolerance":  within,
+		"futureBeyondTolerance": beyond,
+		"earlyPercentage":       fmt.Sprintf("%.2f", 100*share),
+		"maxEarly":              maxEarly,
+		"earliness":             strings.Join(buckets, " "),
+		"peers":                 peers,
+	})
+	if share > localClockBehindThreshold {
+		l.Warn("Most gossip messages arrived before the start of their slot, the local clock is likely behind. " +
+			"Check that the system clock is synchronized")
+		return
+	}
+	l.Info("Some gossip messages arrived before the start of their slot, the clocks of the peers that sent them are likely ahead")
+}
@@ -0,0 +1,89 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestClassifyGossipArrival(t *testing.T) {
+	slotStart := time.Now().Round(time.Second)
+	genesis := slotStart.Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	slot := primitives.Slot(1)
+
+	arrival, early := classifyGossipArrival(genesis, slot, slotStart.Add(100*time.Millisecond))
+	assert.Equal(t, arrivalOnTime, arrival)
+	assert.Equal(t, time.Duration(0), early)
+
+	// Slot time within MAXIMUM_GOSSIP_CLOCK_DISPARITY.
+	arrival, early = classifyGossipArrival(genesis, slot, slotStart.Add(-400*time.Millisecond))
+	assert.Equal(t, arrivalEarlyWithinTolerance, arrival)
+	assert.Equal(t, 400*time.Millisecond, early)
+
+	// Slot time just above MAXIMUM_GOSSIP_CLOCK_DISPARITY.
+	arrival, early = classifyGossipArrival(genesis, slot, slotStart.Add(-600*time.Millisecond))
+	assert.Equal(t, arrivalFutureBeyondTolerance, arrival)
+	assert.Equal(t, 600*time.Millisecond, early)
+}
+
+func TestWaitForSlotStart(t *testing.T) {
+	// Genesis times are whole seconds.
+	slotStart := time.Now().Truncate(time.Second).Add(time.Second)
+	genesis := slotStart.Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+
+	untilSlot := time.Until(slotStart)
+	start := time.Now()
+	require.NoError(t, waitForSlotStart(context.Background(), genesis, 1))
+	assert.Equal(t, true, time.Since(start) >= untilSlot-10*time.Millisecond)
+
+	// The slot has already started.
+	start = time.Now()
+	require.NoError(t, waitForSlotStart(context.Background(), genesis, 0))
+	assert.Equal(t, true, time.Since(start) < 100*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, waitForSlotStart(ctx, genesis, 2), context.Canceled)
+}
+
+func TestService_logEarlyArrivals(t *testing.T) {
+	hook := logTest.NewGlobal()
+	slotStart := time.Now().Round(time.Second)
+	genesis := slotStart.Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	s := &Service{
+		cfg:           &config{clock: startup.NewClock(genesis, [32]byte{})},
+		earlyArrivals: newEarlyArrivalStats(),
+	}
+
+	// Nothing is logged when every message arrived on time.
+	s.recordGossipArrival(blockArrivalTopic, 1, slotStart.Add(time.Second), peer.ID("a"))
+	s.logEarlyArrivals()
+	require.Equal(t, 0, len(hook.AllEntries()))
+
+	// A single peer sending messages early points at that peer's clock.
+	for i := 0; i < 9; i++ {
+		s.recordGossipArrival(attestationArrivalTopic, 1, slotStart, peer.ID("a"))
+	}
+	s.recordGossipArrival(attestationArrivalTopic, 1, slotStart.Add(-300*time.Millisecond), peer.ID("b"))
+	s.logEarlyArrivals()
+	require.LogsContain(t, hook, "the clocks of the peers that sent them are likely ahead")
+	require.LogsContain(t, hook, "earlyWithinTolerance=1")
+	require.LogsContain(t, hook, "peers=1")
+	hook.Reset()
+
+	// Most messages arriving early points at the local clock.
+	s.recordGossipArrival(syncMessageArrivalTopic, 1, slotStart.Add(-300*time.Millisecond), peer.ID("a"))
+	s.recordGossipArrival(syncMessageArrivalTopic, 1, slotStart.Add(-3*time.Second), peer.ID("b"))
+	s.logEarlyArrivals()
+	require.LogsContain(t, hook, "the local clock is likely behind")
+	require.LogsContain(t, hook, "futureBeyondTolerance=1")
+	require.LogsContain(t, hook, "maxEarly=3s")
+}
@@ -0,0 +1,135 @@
+package initialsync
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// spoolBatch writes the blocks and blob sidecars of a held batch to the file at path, as snappy compressed SSZ. Each
+// block is written with its root and version, followed by its blob sidecars, each of them prefixed with its length.
+func spoolBatch(path string, bwb []blocks.BlockWithROBlobs) error {
+	var buf []byte
+	for _, b := range bwb {
+		enc, err := b.Block.MarshalSSZ()
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal block at slot %d", b.Block.Block().Slot())
+		}
+		root := b.Block.Root()
+		buf = append(buf, root[:]...)
+		buf = binary.AppendUvarint(buf, uint64(b.Block.Version()))
+		buf = binary.AppendUvarint(buf, uint64(len(enc)))
+		buf = append(buf, enc...)
+		buf = binary.AppendUvarint(buf, uint64(len(b.Blobs)))
+		for _, blob := range b.Blobs {
+			enc, err := blob.MarshalSSZ()
+			if err != nil {
+				return errors.Wrapf(err, "could not marshal blob sidecar %d of block at slot %d", blob.Index, blob.Slot())
+			}
+			buf = binary.AppendUvarint(buf, uint64(len(enc)))
+			buf = append(buf, enc...)
+		}
+	}
+	return file.WriteFile(path, snappy.Encode(nil, buf))
+}
+
+// loadSpooledBatch reads back a batch written by spoolBatch.
+func loadSpooledBatch(path string) ([]blocks.BlockWithROBlobs, error) {
+	enc, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	buf, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress spooled batch")
+	}
+	var bwb []blocks.BlockWithROBlobs
+	for len(buf) > 0 {
+		if len(buf) < 32 {
+			return nil, errors.New("truncated spooled block root")
+		}
+		root := [32]byte(buf[:32])
+		v, n := binary.Uvarint(buf[32:])
+		if n <= 0 {
+			return nil, errors.New("truncated spooled block version")
+		}
+		var enc []byte
+		if enc, buf, err = readSpooled(buf[32+n:]); err != nil {
+			return nil, err
+		}
+		blk, err := unmarshalSpooledBlock(int(v), enc)
+		if err != nil {
+			return nil, err
+		}
+		rob, err := blocks.NewROBlockWithRoot(blk, root)
+		if err != nil {
+			return nil, err
+		}
+		count, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return nil, errors.New("truncated spooled blob sidecar count")
+		}
+		buf = buf[n:]
+		b := blocks.BlockWithROBlobs{Block: rob}
+		for i := uint64(0); i < count; i++ {
+			if enc, buf, err = readSpooled(buf); err != nil {
+				return nil, err
+			}
+			sidecar := &ethpb.BlobSidecar{}
+			if err := sidecar.UnmarshalSSZ(enc); err != nil {
+				return nil, errors.Wrap(err, "could not unmarshal spooled blob sidecar")
+			}
+			blob, err := blocks.NewROBlobWithRoot(sidecar, root)
+			if err != nil {
+				return nil, err
+			}
+			b.Blobs = append(b.Blobs, blob)
+		}
+		bwb = append(bwb, b)
+	}
+	return bwb, nil
+}
+
+// unmarshalSpooledBlock unmarshals a spooled block of the given version.
+func unmarshalSpooledBlock(v int, enc []byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	var rawBlock ssz.Unmarshaler
+	switch v {
+	case version.Phase0:
+		rawBlock = &ethpb.SignedBeaconBlock{}
+	case version.Altair:
+		rawBlock = &ethpb.SignedBeaconBlockAltair{}
+	case version.Bellatrix:
+		rawBlock = &ethpb.SignedBeaconBlockBellatrix{}
+	case version.Capella:
+		rawBlock = &ethpb.SignedBeaconBlockCapella{}
+	case version.Deneb:
+		rawBlock = &ethpb.SignedBeaconBlockDeneb{}
+	case version.Electra:
+		rawBlock = &ethpb.SignedBeaconBlockElectra{}
+	default:
+		return nil, fmt.Errorf("unknown spooled block version %d", v)
+	}
+	if err := rawBlock.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal spooled %s block", version.String(v))
+	}
+	return blocks.NewSignedBeaconBlock(rawBlock)
+}
+
+// readSpooled returns the length prefixed value at the start of buf, and the rest of buf.
+func readSpooled(buf []byte) ([]byte, []byte, error) {
+	size, n := binary.Uvarint(buf)
+	if n <= 0 || uint64(len(buf)-n) < size {
+		return nil, nil, fmt.Errorf("truncated spooled value of %d bytes", size)
+	}
+	return buf[n : n+int(size)], buf[n+int(size):], nil
+}
@@ -0,0 +1,357 @@
+package initialsync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// Anchored verification of the finalized portion of initial sync.
+//
+// Blocks at or below the finalized checkpoint agreed on by the sync peers belong to the chain finalized by the
+// network. The parent root of every block commits to the whole history before it, so once a chain of blocks is shown
+// to end at the checkpoint root, all of its blocks are authenticated by that root alone, and verifying every signature
+// on the way there mostly costs CPU. With --initial-sync-verification=anchored, such chains are imported with
+// ReceiveAnchoredBlockBatch, which still runs the full state transition, including the state root check, but only
+// verifies the proposer signatures of a random sample of the blocks of every batch.
+//
+// Implementation notes:
+//   - A block is only anchored while at least MinimumSyncPeers of the peers with the best finalized epoch report the
+//     same checkpoint root, and only when its slot is at or below the start slot of that epoch. Every other block,
+//     including all of the non-finalized portion of the sync, is verified in full.
+//   - Nothing is imported with sampled signatures before it is linked to the checkpoint. Anchored batches are held,
+//     each one chaining onto the previous one by parent root, until the last held block is the checkpoint
+//     block. The held batches are then imported in order with anchored verification.
+//   - The blocks queue only moves forward when the head does, so it is given the slot of the last held block as the
+//     head slot, see heldHeadChain.
+//   - The held batches are spooled to disk, under the anchored-sync directory next to the database, so that a whole
+//     range of finalized blocks can be held, keeping only the root and slot of the last block of each batch in memory.
+//     At most maxSpooledAnchoredBlocks blocks are held, or maxHeldAnchoredBlocks in memory when no spool directory is
+//     configured. Older batches are imported with full verification as the window moves forward. The held batches
+//     are verified in full as well when the chain goes past the checkpoint slot without reaching its root, when the
+//     peers stop agreeing on a checkpoint, and at the end of the finalized portion of the sync.
+//   - A failed sample proves that a peer serves blocks that were never signed, even though they lead to the
+//     checkpoint. It is counted in initial_sync_sampled_signature_failures_total, the remaining held batches are
+//     dropped like any other invalid batch, and every following batch is verified in full until the node restarts.
+var (
+	anchoredBatchesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "initial_sync_anchored_batches_total",
+		Help: "The number of finalized block batches imported with sampled proposer signature verification.",
+	})
+	sampledSignatureFailuresTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "initial_sync_sampled_signature_failures_total",
+		Help: "The number of anchored block batches with an invalid sampled proposer signature, each of which makes initial sync fall back to full verification.",
+	})
+)
+
+// maxHeldAnchoredBlocks is the maximum number of blocks held in memory until they link to the agreed finalized
+// checkpoint, when no spool directory is configured.
+const maxHeldAnchoredBlocks = 512
+
+// maxSpooledAnchoredBlocks is the maximum number of blocks held in the spool directory until they link to the agreed
+// finalized checkpoint. It matches the range blob sidecars are fetched for, so that the blocks of a node a few weeks
+// behind are all imported with anchored verification while the disk used by the spool stays bounded.
+const maxSpooledAnchoredBlocks = 1 << 17
+
+// heldBatch is a batch of blocks waiting to be linked to the agreed finalized checkpoint. Only the root and slot of
+// its last block, which the next batch must chain onto, are kept in memory when the batch is spooled to disk.
+type heldBatch struct {
+	bwb      []blocks.BlockWithROBlobs
+	path     string
+	count    int
+	lastRoot [32]byte
+	lastSlot primitives.Slot
+}
+
+// heldBatches are consecutive batches of the finalized portion of the sync, waiting to be linked to the agreed
+// finalized checkpoint. They are written to files in dir when set, and held in memory otherwise.
+type heldBatches struct {
+	sync.RWMutex
+	dir     string
+	seq     uint64
+	batches []heldBatch
+	count   int
+}
+
+// last returns the root and slot of the last held block.
+func (h *heldBatches) last() ([32]byte, primitives.Slot, bool) {
+	h.RLock()
+	defer h.RUnlock()
+	if len(h.batches) == 0 {
+		return [32]byte{}, 0, false
+	}
+	b := h.batches[len(h.batches)-1]
+	return b.lastRoot, b.lastSlot, true
+}
+
+// limit returns the maximum number of blocks held.
+func (h *heldBatches) limit() int {
+	if h.dir == "" {
+		return maxHeldAnchoredBlocks
+	}
+	return maxSpooledAnchoredBlocks
+}
+
+func (h *heldBatches) push(bwb []blocks.BlockWithROBlobs) error {
+	h.Lock()
+	defer h.Unlock()
+	last := bwb[len(bwb)-1].Block
+	b := heldBatch{count: len(bwb), lastRoot: last.Root(), lastSlot: last.Block().Slot()}
+	if h.dir == "" {
+		b.bwb = bwb
+	} else {
+		if err := file.MkdirAll(h.dir); err != nil {
+			return errors.Wrap(err, "could not create the anchored sync spool directory")
+		}
+		b.path = filepath.Join(h.dir, fmt.Sprintf("%d.ssz_snappy", h.seq))
+		if err := spoolBatch(b.path, bwb); err != nil {
+			h.remove(b)
+			return errors.Wrap(err, "could not spool held batch")
+		}
+		h.seq++
+	}
+	h.batches = append(h.batches, b)
+	h.count += b.count
+	return nil
+}
+
+// popOldest removes the oldest held batch while more blocks than the limit are held.
+func (h *heldBatches) popOldest() (heldBatch, bool) {
+	h.Lock()
+	defer h.Unlock()
+	if h.count <= h.limit() {
+		return heldBatch{}, false
+	}
+	b := h.batches[0]
+	h.batches = h.batches[1:]
+	h.count -= b.count
+	return b, true
+}
+
+func (h *heldBatches) popAll() []heldBatch {
+	h.Lock()
+	defer h.Unlock()
+	batches := h.batches
+	h.batches = nil
+	h.count = 0
+	return batches
+}
+
+// load returns the blocks of a popped batch, removing its spool file.
+func (h *heldBatches) load(b heldBatch) ([]blocks.BlockWithROBlobs, error) {
+	if b.path == "" {
+		return b.bwb, nil
+	}
+	defer h.remove(b)
+	return loadSpooledBatch(b.path)
+}
+
+// drop discards the popped batches.
+func (h *heldBatches) drop(batches []heldBatch) {
+	for _, b := range batches {
+		h.remove(b)
+	}
+}
+
+func (h *heldBatches) remove(b heldBatch) {
+	if b.path == "" {
+		return
+	}
+	if err := os.Remove(b.path); err != nil && !os.IsNotExist(err) {
+		log.WithError(err).WithField("path", b.path).Warn("Could not remove spooled batch")
+	}
+}
+
+// clear removes the batches spooled by a previous run, which were never linked to a checkpoint.
+func (h *heldBatches) clear() {
+	if h.dir == "" {
+		return
+	}
+	if err := os.RemoveAll(h.dir); err != nil {
+		log.WithError(err).WithField("path", h.dir).Warn("Could not remove the anchored sync spool directory")
+	}
+}
+
+// heldHeadChain reports the slot of the last held block as the head slot, so that the blocks queue keeps fetching the
+// batches following the held ones.
+type heldHeadChain struct {
+	blockchainService
+	held *heldBatches
+}
+
+// HeadSlot returns the slot of the last held block, or the head slot of the chain when no block is held.
+func (c *heldHeadChain) HeadSlot() primitives.Slot {
+	headSlot := c.blockchainService.HeadSlot()
+	if _, lastSlot, ok := c.held.last(); ok && lastSlot > headSlot {
+		return lastSlot
+	}
+	return headSlot
+}
+
+// holdAnchored holds the part of the batch at or below the agreed finalized checkpoint until it links to the checkpoint
+// root, and imports the held batches once it does. It returns the blocks that must be imported with full verification.
+func (s *Service) holdAnchored(ctx context.Context, genesis time.Time, bwb []blocks.BlockWithROBlobs) ([]blocks.BlockWithROBlobs, error) {
+	cp, ok := s.anchorCheckpoint()
+	if !ok {
+		s.releaseHeld(ctx, genesis, s.cfg.Chain.ReceiveBlockBatch)
+		return bwb, nil
+	}
+	cpSlot, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		s.releaseHeld(ctx, genesis, s.cfg.Chain.ReceiveBlockBatch)
+		return bwb, nil
+	}
+	i := sort.Search(len(bwb), func(i int) bool {
+		return bwb[i].Block.Block().Slot() > cpSlot
+	})
+	finalized, rest := bwb[:i], bwb[i:]
+	if err := s.hold(ctx, finalized); err != nil {
+		if !errors.Is(err, errParentDoesNotExist) {
+			// The batch could not be held, so it is verified in full along with the batches before it.
+			log.WithError(err).Warn("Could not hold anchored batch, verifying it in full")
+			s.releaseHeld(ctx, genesis, s.cfg.Chain.ReceiveBlockBatch)
+			return bwb, nil
+		}
+		return nil, err
+	}
+
+	lastRoot, _, ok := s.held.last()
+	switch {
+	case !ok:
+	case lastRoot == bytesutil.ToBytes32(cp.Root):
+		s.releaseHeld(ctx, genesis, s.anchoredReceiver)
+	case len(rest) > 0:
+		// The chain went past the checkpoint slot without reaching the checkpoint root.
+		s.releaseHeld(ctx, genesis, s.cfg.Chain.ReceiveBlockBatch)
+	default:
+		for {
+			oldest, ok := s.held.popOldest()
+			if !ok {
+				break
+			}
+			oldestBwb, err := s.held.load(oldest)
+			if err == nil {
+				err = s.processBatchedBlocks(ctx, genesis, oldestBwb, s.cfg.Chain.ReceiveBlockBatch)
+			}
+			if err != nil {
+				s.held.drop(s.held.popAll())
+				return nil, err
+			}
+		}
+	}
+	return rest, nil
+}
+
+// hold appends the unprocessed blocks of the batch to the held batches, provided that they chain onto the last held
+// block, or onto a block we already have when no block is held.
+func (s *Service) hold(ctx context.Context, bwb []blocks.BlockWithROBlobs) error {
+	if len(bwb) == 0 {
+		return nil
+	}
+	bwb, err := validUnprocessed(ctx, bwb, s.cfg.Chain.HeadSlot(), s.isProcessedBlock)
+	if err != nil {
+		return err
+	}
+	lastRoot, lastSlot, ok := s.held.last()
+	if !ok {
+		if len(bwb) > 0 && !s.cfg.Chain.HasBlock(ctx, bwb[0].Block.Block().ParentRoot()) {
+			return fmt.Errorf("%w: %#x (in hold, slot=%d)",
+				errParentDoesNotExist, bwb[0].Block.Block().ParentRoot(), bwb[0].Block.Block().Slot())
+		}
+	} else {
+		i := sort.Search(len(bwb), func(i int) bool {
+			return bwb[i].Block.Block().Slot() > lastSlot
+		})
+		bwb = bwb[i:]
+		if len(bwb) > 0 && bwb[0].Block.Block().ParentRoot() != lastRoot {
+			return fmt.Errorf("%w: %#x (in hold, slot=%d)",
+				errParentDoesNotExist, bwb[0].Block.Block().ParentRoot(), bwb[0].Block.Block().Slot())
+		}
+	}
+	if len(bwb) > 0 {
+		return s.held.push(bwb)
+	}
+	return nil
+}
+
+// releaseHeld imports the held batches in order with the given receiver. The batches following a failed one cannot
+// chain onto the head and are dropped.
+func (s *Service) releaseHeld(ctx context.Context, genesis time.Time, bFunc batchBlockReceiverFn) {
+	batches := s.held.popAll()
+	for i, b := range batches {
+		bwb, err := s.held.load(b)
+		if err == nil {
+			err = s.processBatchedBlocks(ctx, genesis, bwb, bFunc)
+		}
+		if err != nil {
+			log.WithError(err).Warn("Skip processing held batched blocks")
+			s.held.drop(batches[i+1:])
+			return
+		}
+	}
+}
+
+// anchoredReceiver imports a batch linked to the agreed finalized checkpoint with anchored verification.
+func (s *Service) anchoredReceiver(ctx context.Context, blks []blocks.ROBlock, avs das.AvailabilityStore) error {
+	err := s.cfg.Chain.ReceiveAnchoredBlockBatch(ctx, blks, avs)
+	switch {
+	case err == nil:
+		anchoredBatchesTotal.Inc()
+	case errors.Is(err, blockchain.ErrSampledSignatureVerification):
+		sampledSignatureFailuresTotal.Inc()
+		s.anchoredFallback = true
+		log.WithError(err).Warn("Invalid proposer signature sampled, verifying every signature for the rest of initial sync")
+	}
+	return err
+}
+
+// anchorCheckpoint returns the finalized checkpoint the blocks below it can be anchored to, if any.
+func (s *Service) anchorCheckpoint() (*ethpb.Checkpoint, bool) {
+	if !flags.Get().AnchoredInitialSync || s.anchoredFallback {
+		return nil, false
+	}
+	return s.agreedFinalizedCheckpoint()
+}
+
+// agreedFinalizedCheckpoint returns the finalized checkpoint of the plurality of the peers, provided that at least
+// MinimumSyncPeers of them report the same checkpoint root.
+func (s *Service) agreedFinalizedCheckpoint() (*ethpb.Checkpoint, bool) {
+	epoch, pids := s.cfg.P2P.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, s.cfg.Chain.FinalizedCheckpt().Epoch)
+	var root []byte
+	agreeing := 0
+	for _, pid := range pids {
+		cs, err := s.cfg.P2P.Peers().ChainState(pid)
+		if err != nil || cs == nil || cs.FinalizedEpoch != epoch {
+			continue
+		}
+		if root == nil {
+			root = cs.FinalizedRoot
+		} else if !bytes.Equal(root, cs.FinalizedRoot) {
+			return nil, false
+		}
+		agreeing++
+	}
+	if agreeing == 0 || agreeing < flags.Get().MinimumSyncPeers {
+		return nil, false
+	}
+	return &ethpb.Checkpoint{Epoch: epoch, Root: root}, true
+}
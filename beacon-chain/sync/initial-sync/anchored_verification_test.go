@@ -0,0 +1,409 @@
+package initialsync
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/paulbellamy/ratecounter"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	p2pt "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// anchoredTestChain returns a linear chain of blocks from slot 1 to the given slot, built on top of the given parent.
+func anchoredTestChain(t *testing.T, parent [32]byte, lastSlot primitives.Slot) []blocks.BlockWithROBlobs {
+	var bwb []blocks.BlockWithROBlobs
+	for i := primitives.Slot(1); i <= lastSlot; i++ {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = i
+		b.Block.ParentRoot = parent[:]
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		rob, err := blocks.NewROBlock(wsb)
+		require.NoError(t, err)
+		bwb = append(bwb, blocks.BlockWithROBlobs{Block: rob})
+		parent = rob.Root()
+	}
+	return bwb
+}
+
+// newAnchoredTestService returns a service whose chain only has a genesis block, along with the chain of blocks
+// following it up to the given slot.
+func newAnchoredTestService(t *testing.T, lastSlot primitives.Slot) (*Service, *p2pt.TestP2P, *mock.ChainService, []blocks.BlockWithROBlobs) {
+	beaconDB := dbtest.SetupDB(t)
+	genesisBlk := util.NewBeaconBlock()
+	genesisRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, context.Background(), beaconDB, genesisBlk)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+
+	p := p2pt.NewTestP2P(t)
+	mc := &mock.ChainService{
+		State:               st,
+		Root:                genesisRoot[:],
+		DB:                  beaconDB,
+		FinalizedCheckPoint: &ethpb.Checkpoint{},
+	}
+	s := NewService(context.Background(), &Config{P2P: p, DB: beaconDB, Chain: mc})
+	s.counter = ratecounter.NewRateCounter(counterSeconds * time.Second)
+	return s, p, mc, anchoredTestChain(t, genesisRoot, lastSlot)
+}
+
+func addFinalizedPeer(p *p2pt.TestP2P, id string, epoch primitives.Epoch, root [32]byte) {
+	pid := peer.ID(id)
+	p.Peers().Add(new(enr.Record), pid, nil, network.DirOutbound)
+	p.Peers().SetConnectionState(pid, peers.PeerConnected)
+	p.Peers().SetChainState(pid, &ethpb.Status{FinalizedEpoch: epoch, FinalizedRoot: root[:]})
+}
+
+func TestService_HoldAnchored(t *testing.T) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{AnchoredInitialSync: true, MinimumSyncPeers: 2})
+	defer flags.Init(resetFlags)
+
+	ctx := context.Background()
+	genesis := makeGenesisTime(128)
+	cpSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	lastSlot := cpSlot + 8
+
+	t.Run("linked to the checkpoint", func(t *testing.T) {
+		s, p, mc, bwb := newAnchoredTestService(t, lastSlot)
+		// Blocks start at slot 1, so the checkpoint block is the last block of bwb[:cpSlot].
+		cpRoot := bwb[cpSlot-1].Block.Root()
+		addFinalizedPeer(p, "a", 2, cpRoot)
+		addFinalizedPeer(p, "b", 2, cpRoot)
+		queueChain := &heldHeadChain{blockchainService: mc, held: &s.held}
+
+		rest, err := s.holdAnchored(ctx, genesis, bwb[:20])
+		require.NoError(t, err)
+		require.Equal(t, 0, len(rest))
+		rest, err = s.holdAnchored(ctx, genesis, bwb[20:40])
+		require.NoError(t, err)
+		require.Equal(t, 0, len(rest))
+		require.Equal(t, 0, len(mc.BlocksReceived), "blocks imported before reaching the checkpoint")
+		require.Equal(t, primitives.Slot(0), mc.HeadSlot())
+		require.Equal(t, primitives.Slot(40), queueChain.HeadSlot())
+
+		rest, err = s.holdAnchored(ctx, genesis, bwb[40:])
+		require.NoError(t, err)
+		require.DeepEqual(t, bwb[cpSlot:], rest)
+		require.Equal(t, 3, mc.AnchoredBatchesReceived)
+		require.Equal(t, int(cpSlot), len(mc.BlocksReceived))
+		require.Equal(t, cpSlot, queueChain.HeadSlot())
+	})
+	t.Run("spooled", func(t *testing.T) {
+		s, p, mc, bwb := newAnchoredTestService(t, lastSlot)
+		s.held.dir = filepath.Join(t.TempDir(), "anchored-sync")
+		cpRoot := bwb[cpSlot-1].Block.Root()
+		addFinalizedPeer(p, "a", 2, cpRoot)
+		addFinalizedPeer(p, "b", 2, cpRoot)
+
+		rest, err := s.holdAnchored(ctx, genesis, bwb[:20])
+		require.NoError(t, err)
+		require.Equal(t, 0, len(rest))
+		rest, err = s.holdAnchored(ctx, genesis, bwb[20:40])
+		require.NoError(t, err)
+		require.Equal(t, 0, len(rest))
+		s.held.RLock()
+		for _, b := range s.held.batches {
+			require.Equal(t, 0, len(b.bwb), "spooled blocks kept in memory")
+		}
+		s.held.RUnlock()
+		spooled, err := os.ReadDir(s.held.dir)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(spooled))
+
+		rest, err = s.holdAnchored(ctx, genesis, bwb[40:])
+		require.NoError(t, err)
+		require.DeepEqual(t, bwb[cpSlot:], rest)
+		require.Equal(t, 3, mc.AnchoredBatchesReceived)
+		require.Equal(t, int(cpSlot), len(mc.BlocksReceived))
+		for i, b := range mc.BlocksReceived {
+			root, err := b.Block().HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, bwb[i].Block.Root(), root)
+		}
+		spooled, err = os.ReadDir(s.held.dir)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(spooled))
+	})
+	t.Run("checkpoint root not reached", func(t *testing.T) {
+		s, p, mc, bwb := newAnchoredTestService(t, lastSlot)
+		addFinalizedPeer(p, "a", 2, [32]byte{'a'})
+		addFinalizedPeer(p, "b", 2, [32]byte{'a'})
+
+		rest, err := s.holdAnchored(ctx, genesis, bwb[:40])
+		require.NoError(t, err)
+		require.Equal(t, 0, len(rest))
+		rest, err = s.holdAnchored(ctx, genesis, bwb[40:])
+		require.NoError(t, err)
+		require.DeepEqual(t, bwb[cpSlot:], rest)
+		require.Equal(t, 0, mc.AnchoredBatchesReceived)
+		require.Equal(t, int(cpSlot), len(mc.BlocksReceived))
+	})
+	t.Run("peers stop agreeing", func(t *testing.T) {
+		s, p, mc, bwb := newAnchoredTestService(t, lastSlot)
+		cpRoot := bwb[cpSlot-1].Block.Root()
+		addFinalizedPeer(p, "a", 2, cpRoot)
+		addFinalizedPeer(p, "b", 2, cpRoot)
+
+		rest, err := s.holdAnchored(ctx, genesis, bwb[:20])
+		require.NoError(t, err)
+		require.Equal(t, 0, len(rest))
+		addFinalizedPeer(p, "c", 2, [32]byte{'c'})
+		rest, err = s.holdAnchored(ctx, genesis, bwb[20:40])
+		require.NoError(t, err)
+		require.DeepEqual(t, bwb[20:40], rest)
+		require.Equal(t, 0, mc.AnchoredBatchesReceived)
+		require.Equal(t, 20, len(mc.BlocksReceived))
+	})
+	t.Run("batch not chaining onto the held blocks", func(t *testing.T) {
+		s, p, mc, bwb := newAnchoredTestService(t, lastSlot)
+		cpRoot := bwb[cpSlot-1].Block.Root()
+		addFinalizedPeer(p, "a", 2, cpRoot)
+		addFinalizedPeer(p, "b", 2, cpRoot)
+
+		_, err := s.holdAnchored(ctx, genesis, bwb[:20])
+		require.NoError(t, err)
+		_, err = s.holdAnchored(ctx, genesis, bwb[21:40])
+		require.ErrorIs(t, err, errParentDoesNotExist)
+		_, lastSlot, ok := s.held.last()
+		require.Equal(t, true, ok)
+		require.Equal(t, primitives.Slot(20), lastSlot)
+		require.Equal(t, 0, len(mc.BlocksReceived))
+	})
+	t.Run("not anchored", func(t *testing.T) {
+		s, p, _, bwb := newAnchoredTestService(t, lastSlot)
+		cpRoot := bwb[cpSlot-1].Block.Root()
+		addFinalizedPeer(p, "a", 2, cpRoot)
+		rest, err := s.holdAnchored(ctx, genesis, bwb[:20])
+		require.NoError(t, err)
+		require.DeepEqual(t, bwb[:20], rest, "a single peer is not enough")
+
+		addFinalizedPeer(p, "b", 2, cpRoot)
+		flags.Init(&flags.GlobalFlags{MinimumSyncPeers: 2})
+		defer flags.Init(&flags.GlobalFlags{AnchoredInitialSync: true, MinimumSyncPeers: 2})
+		rest, err = s.holdAnchored(ctx, genesis, bwb[:20])
+		require.NoError(t, err)
+		require.DeepEqual(t, bwb[:20], rest, "anchored verification disabled")
+	})
+}
+
+func TestService_HoldAnchored_Window(t *testing.T) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{AnchoredInitialSync: true, MinimumSyncPeers: 2})
+	defer flags.Init(resetFlags)
+
+	ctx := context.Background()
+	batchSize := int(params.BeaconConfig().SlotsPerEpoch)
+	cpEpoch := primitives.Epoch(maxHeldAnchoredBlocks/batchSize + 2)
+	cpSlot, err := params.BeaconConfig().SlotsPerEpoch.SafeMul(uint64(cpEpoch))
+	require.NoError(t, err)
+	s, p, mc, bwb := newAnchoredTestService(t, cpSlot)
+	cpRoot := bwb[cpSlot-1].Block.Root()
+	addFinalizedPeer(p, "a", cpEpoch, cpRoot)
+	addFinalizedPeer(p, "b", cpEpoch, cpRoot)
+
+	for i := 0; i < len(bwb); i += batchSize {
+		_, err := s.holdAnchored(ctx, makeGenesisTime(cpSlot), bwb[i:i+batchSize])
+		require.NoError(t, err)
+		s.held.RLock()
+		require.Equal(t, true, s.held.count <= maxHeldAnchoredBlocks+batchSize)
+		s.held.RUnlock()
+	}
+	// The oldest batches are imported in full as the window moves forward, the rest once the checkpoint is reached.
+	require.Equal(t, maxHeldAnchoredBlocks/batchSize+1, mc.AnchoredBatchesReceived)
+	require.Equal(t, int(cpSlot), len(mc.BlocksReceived))
+}
+
+func TestSpoolBatch(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch, cfg.BellatrixForkEpoch, cfg.CapellaForkEpoch, cfg.DenebForkEpoch = 1, 2, 3, 4
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+
+	var bwb []blocks.BlockWithROBlobs
+	parent := [32]byte{}
+	denebSlot := 4 * cfg.SlotsPerEpoch
+	for slot := denebSlot; slot < denebSlot+4; slot++ {
+		blk, blobs := util.GenerateTestDenebBlockWithSidecar(t, parent, slot, int(slot)%3)
+		bwb = append(bwb, blocks.BlockWithROBlobs{Block: blk, Blobs: blobs})
+		parent = blk.Root()
+	}
+	path := filepath.Join(t.TempDir(), "0.ssz_snappy")
+	require.NoError(t, spoolBatch(path, bwb))
+	loaded, err := loadSpooledBatch(path)
+	require.NoError(t, err)
+	require.Equal(t, len(bwb), len(loaded))
+	for i := range bwb {
+		require.Equal(t, bwb[i].Block.Root(), loaded[i].Block.Root())
+		require.Equal(t, bwb[i].Block.Version(), loaded[i].Block.Version())
+		require.Equal(t, len(bwb[i].Blobs), len(loaded[i].Blobs))
+		for j := range bwb[i].Blobs {
+			require.DeepEqual(t, bwb[i].Blobs[j].BlobSidecar, loaded[i].Blobs[j].BlobSidecar)
+			require.Equal(t, bwb[i].Blobs[j].BlockRoot(), loaded[i].Blobs[j].BlockRoot())
+		}
+	}
+
+	require.NoError(t, os.WriteFile(path, []byte("not a batch"), 0600))
+	_, err = loadSpooledBatch(path)
+	require.ErrorContains(t, "could not decompress spooled batch", err)
+}
+
+func TestService_AnchoredReceiver_FallsBackToFull(t *testing.T) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{AnchoredInitialSync: true, MinimumSyncPeers: 2})
+	defer flags.Init(resetFlags)
+
+	cpSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	s, p, mc, bwb := newAnchoredTestService(t, cpSlot)
+	mc.AnchoredBatchMockErr = fmt.Errorf("could not process block in batch: %w", blockchain.ErrSampledSignatureVerification)
+	cpRoot := bwb[cpSlot-1].Block.Root()
+	addFinalizedPeer(p, "a", 2, cpRoot)
+	addFinalizedPeer(p, "b", 2, cpRoot)
+
+	ctx := context.Background()
+	genesis := makeGenesisTime(cpSlot)
+	_, err := s.holdAnchored(ctx, genesis, bwb[:20])
+	require.NoError(t, err)
+	_, err = s.holdAnchored(ctx, genesis, bwb[20:])
+	require.NoError(t, err)
+	require.Equal(t, true, s.anchoredFallback)
+	require.Equal(t, 0, len(mc.BlocksReceived))
+	_, _, ok := s.held.last()
+	require.Equal(t, false, ok, "batches following the failed one are dropped")
+	_, ok = s.anchorCheckpoint()
+	require.Equal(t, false, ok)
+}
+
+// anchoredBenchP2P only provides the peers of the anchored verification benchmark.
+type anchoredBenchP2P struct {
+	p2p.P2P
+	peers *peers.Status
+}
+
+func (p *anchoredBenchP2P) Peers() *peers.Status {
+	return p.peers
+}
+
+// anchoredBenchChain returns a linear chain of blocks from slot 1 to the given slot, built on top of the given parent,
+// with 128 attestations in each block like on mainnet.
+func anchoredBenchChain(b *testing.B, parent [32]byte, lastSlot primitives.Slot) []blocks.BlockWithROBlobs {
+	bwb := make([]blocks.BlockWithROBlobs, 0, lastSlot)
+	for i := primitives.Slot(1); i <= lastSlot; i++ {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = i
+		blk.Block.ParentRoot = parent[:]
+		for j := 0; j < 128; j++ {
+			att := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.NewBitlist(512)})
+			att.Data.Slot = i - 1
+			att.Data.CommitteeIndex = primitives.CommitteeIndex(j % 64)
+			att.Signature = bytesutil.PadTo([]byte{byte(j), byte(i), byte(i >> 8)}, 96)
+			blk.Block.Body.Attestations = append(blk.Block.Body.Attestations, att)
+		}
+		wsb, err := blocks.NewSignedBeaconBlock(blk)
+		require.NoError(b, err)
+		rob, err := blocks.NewROBlock(wsb)
+		require.NoError(b, err)
+		bwb = append(bwb, blocks.BlockWithROBlobs{Block: rob})
+		parent = rob.Root()
+	}
+	return bwb
+}
+
+// BenchmarkSpoolBatch measures the cost of holding a batch of 64 blocks on disk rather than in memory.
+func BenchmarkSpoolBatch(b *testing.B) {
+	bwb := anchoredBenchChain(b, [32]byte{}, 64)
+	path := filepath.Join(b.TempDir(), "0.ssz_snappy")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, spoolBatch(path, bwb))
+		loaded, err := loadSpooledBatch(path)
+		require.NoError(b, err)
+		require.Equal(b, len(bwb), len(loaded))
+	}
+}
+
+// BenchmarkHoldAnchored_Range holds a range of 4096 finalized blocks until they reach the checkpoint, as for a node
+// about 14 hours behind the head. The mock chain does not verify signatures, so anchored-blocks reports how many of
+// the blocks are imported with anchored verification rather than in full.
+func BenchmarkHoldAnchored_Range(b *testing.B) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{AnchoredInitialSync: true, MinimumSyncPeers: 2})
+	defer flags.Init(resetFlags)
+
+	ctx := context.Background()
+	const rangeSize, batchSize = 4096, 64
+	beaconDB := dbtest.SetupDB(b)
+	genesisBlk := util.NewBeaconBlock()
+	genesisRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(b, err)
+	util.SaveBlock(b, ctx, beaconDB, genesisBlk)
+
+	bwb := anchoredBenchChain(b, genesisRoot, rangeSize)
+	cpEpoch := primitives.Epoch(rangeSize / params.BeaconConfig().SlotsPerEpoch)
+	cpRoot := bwb[rangeSize-1].Block.Root()
+
+	for _, spooled := range []bool{false, true} {
+		name := "memory"
+		if spooled {
+			name = "spooled"
+		}
+		b.Run(name, func(b *testing.B) {
+			anchored := 0
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				p := &anchoredBenchP2P{peers: peers.NewStatus(ctx, &peers.StatusConfig{
+					PeerLimit:    30,
+					ScorerParams: &scorers.Config{},
+				})}
+				for _, id := range []string{"a", "b"} {
+					pid := peer.ID(id)
+					p.peers.Add(new(enr.Record), pid, nil, network.DirOutbound)
+					p.peers.SetConnectionState(pid, peers.PeerConnected)
+					p.peers.SetChainState(pid, &ethpb.Status{FinalizedEpoch: cpEpoch, FinalizedRoot: cpRoot[:]})
+				}
+				st, err := util.NewBeaconState()
+				require.NoError(b, err)
+				mc := &mock.ChainService{State: st, Root: genesisRoot[:], DB: beaconDB, FinalizedCheckPoint: &ethpb.Checkpoint{}}
+				s := NewService(ctx, &Config{P2P: p, DB: beaconDB, Chain: mc})
+				s.counter = ratecounter.NewRateCounter(counterSeconds * time.Second)
+				if spooled {
+					s.held.dir = filepath.Join(b.TempDir(), "anchored-sync")
+				}
+				genesis := makeGenesisTime(rangeSize)
+				b.StartTimer()
+
+				for j := 0; j < rangeSize; j += batchSize {
+					_, err := s.holdAnchored(ctx, genesis, bwb[j:j+batchSize])
+					require.NoError(b, err)
+				}
+				require.Equal(b, rangeSize, len(mc.BlocksReceived))
+				anchored += mc.AnchoredBatchesReceived * batchSize
+			}
+			b.ReportMetric(float64(anchored)/float64(b.N), "anchored-blocks/op")
+		})
+	}
+}
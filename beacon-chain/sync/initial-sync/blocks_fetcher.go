@@ -334,7 +334,7 @@ func (f *blocksFetcher) fetchBlocksFromPeer(
 	ctx, span := trace.StartSpan(ctx, "initialsync.fetchBlocksFromPeer")
 	defer span.End()
 
-	peers = f.filterPeers(ctx, peers, peersPercentagePerRequest)
+	peers = f.filterPeers(ctx, f.peersServingFrom(peers, start), peersPercentagePerRequest)
 	req := &p2ppb.BeaconBlocksByRangeRequest{
 		StartSlot: start,
 		Count:     count,
@@ -349,6 +349,11 @@ func (f *blocksFetcher) fetchBlocksFromPeer(
 		p := peers[i]
 		blocks, err := f.requestBlocks(ctx, req, p)
 		if err != nil {
+			if errors.Is(err, p2pTypes.ErrResourceUnavailable) {
+				// The peer doesn't have the start of the range, most likely because it was checkpoint synced
+				// and hasn't backfilled that far yet. Avoid asking it for blocks this old for a while.
+				f.p2p.Peers().SetEarliestAvailableSlot(p, start+1)
+			}
 			log.WithField("peer", p).WithError(err).Debug("Could not request blocks by range from peer")
 			continue
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	mathutil "github.com/prysmaticlabs/prysm/v5/math"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
@@ -121,6 +122,17 @@ func (f *blocksFetcher) filterPeers(ctx context.Context, peers []peer.ID, peersP
 	return trimPeers(peers, peersPercentage)
 }
 
+// peersServingFrom filters out peers that are known to be unable to serve blocks starting at the given slot.
+func (f *blocksFetcher) peersServingFrom(peers []peer.ID, start primitives.Slot) []peer.ID {
+	filtered := make([]peer.ID, 0, len(peers))
+	for _, pid := range peers {
+		if f.p2p.Peers().CanServeBlocksFrom(pid, start) {
+			filtered = append(filtered, pid)
+		}
+	}
+	return filtered
+}
+
 // trimPeers limits peer list, returning only specified percentage of peers.
 // Takes system constraints into account (min/max peers to sync).
 func trimPeers(peers []peer.ID, peersPercentage float64) []peer.ID {
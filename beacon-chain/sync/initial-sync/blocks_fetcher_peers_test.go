@@ -301,6 +301,21 @@ func TestBlocksFetcher_filterPeers(t *testing.T) {
 	}
 }
 
+func TestBlocksFetcher_peersServingFrom(t *testing.T) {
+	mc, p2p, _ := initializeTestServices(t, []primitives.Slot{}, []*peerData{})
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+		chain: mc,
+		p2p:   p2p,
+	})
+	peerIDs := []peer.ID{"a", "b", "c"}
+	// Peer "b" responded that it doesn't have blocks before slot 64, as it is still backfilling.
+	p2p.Peers().SetEarliestAvailableSlot("b", 64)
+
+	assert.DeepEqual(t, []peer.ID{"a", "c"}, fetcher.peersServingFrom(peerIDs, 0))
+	assert.DeepEqual(t, []peer.ID{"a", "c"}, fetcher.peersServingFrom(peerIDs, 63))
+	assert.DeepEqual(t, peerIDs, fetcher.peersServingFrom(peerIDs, 64))
+}
+
 func TestBlocksFetcher_removeStalePeerLocks(t *testing.T) {
 	type peerData struct {
 		peerID   peer.ID
@@ -81,7 +81,7 @@ func (s *Service) startBlocksQueue(ctx context.Context, highestSlot primitives.S
 	cfg := &blocksQueueConfig{
 		p2p:                 s.cfg.P2P,
 		db:                  s.cfg.DB,
-		chain:               s.cfg.Chain,
+		chain:               &heldHeadChain{blockchainService: s.cfg.Chain, held: &s.held},
 		clock:               s.clock,
 		ctxMap:              ctxMap,
 		highestExpectedSlot: highestSlot,
@@ -115,6 +115,8 @@ func (s *Service) syncToFinalizedEpoch(ctx context.Context, genesis time.Time) e
 	for data := range queue.fetchedData {
 		s.processFetchedData(ctx, genesis, s.cfg.Chain.HeadSlot(), data)
 	}
+	// Batches that never reached the checkpoint root are verified in full.
+	s.releaseHeld(ctx, genesis, s.cfg.Chain.ReceiveBlockBatch)
 
 	log.WithFields(logrus.Fields{
 		"syncedSlot":  s.cfg.Chain.HeadSlot(),
@@ -153,8 +155,16 @@ func (s *Service) processFetchedData(
 	ctx context.Context, genesis time.Time, startSlot primitives.Slot, data *blocksQueueFetchedData) {
 	defer s.updatePeerScorerStats(data.pid, startSlot)
 
+	bwb, err := s.holdAnchored(ctx, genesis, data.bwb)
+	if err != nil {
+		log.WithError(err).Warn("Skip processing batched blocks")
+		return
+	}
+	if len(bwb) == 0 {
+		return
+	}
 	// Use Batch Block Verify to process and verify batches directly.
-	if err := s.processBatchedBlocks(ctx, genesis, data.bwb, s.cfg.Chain.ReceiveBlockBatch); err != nil {
+	if err := s.processBatchedBlocks(ctx, genesis, bwb, s.cfg.Chain.ReceiveBlockBatch); err != nil {
 		log.WithError(err).Warn("Skip processing batched blocks")
 	}
 }
@@ -334,6 +344,7 @@ func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
 	bv := verification.NewBlobBatchVerifier(s.newBlobVerifier, verification.InitsyncSidecarRequirements)
 	avs := das.NewLazilyPersistentStore(s.cfg.BlobStorage, bv)
 	s.logBatchSyncStatus(genesis, first, len(bwb))
+	sidecarsByBlock := make([][]blocks.ROBlob, 0, len(bwb))
 	for _, bb := range bwb {
 		if len(bb.Blobs) == 0 {
 			continue
@@ -341,6 +352,12 @@ func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
 		if err := avs.Persist(s.clock.CurrentSlot(), bb.Blobs...); err != nil {
 			return err
 		}
+		sidecarsByBlock = append(sidecarsByBlock, bb.Blobs)
+	}
+	// Verify the kzg proofs of all blocks in the batch concurrently, rather than one block at a time
+	// as each block goes through the data availability check.
+	if err := bv.PreverifyKzgProofs(ctx, sidecarsByBlock); err != nil {
+		return err
 	}
 
 	return bFunc(ctx, blocks.BlockWithROBlobsSlice(bwb).ROBlocks(), avs)
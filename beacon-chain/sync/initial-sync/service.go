@@ -15,11 +15,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/das"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
-	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
@@ -27,9 +25,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
-	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime"
-	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
@@ -68,6 +64,10 @@ type Service struct {
 	verifierWaiter  *verification.InitializerWaiter
 	newBlobVerifier verification.NewBlobVerifier
 	ctxMap          sync.ContextByteVersions
+	// anchoredFallback is set once a sampled proposer signature fails, disabling anchored verification.
+	anchoredFallback bool
+	// held are the finalized batches waiting to be linked to the agreed finalized checkpoint.
+	held heldBatches
 }
 
 // Option is a functional option for the initial-sync Service.
@@ -81,6 +81,14 @@ func WithVerifierWaiter(viw *verification.InitializerWaiter) Option {
 	}
 }
 
+// WithAnchoredSpoolDir sets the directory the batches held by anchored verification are spooled to, until they link
+// to the agreed finalized checkpoint.
+func WithAnchoredSpoolDir(dir string) Option {
+	return func(s *Service) {
+		s.held.dir = dir
+	}
+}
+
 // WithSyncChecker registers the initial sync service
 // in the checker.
 func WithSyncChecker(checker *SyncChecker) Option {
@@ -126,6 +134,7 @@ func NewService(ctx context.Context, cfg *Config, opts ...Option) *Service {
 
 // Start the initial sync service.
 func (s *Service) Start() {
+	s.held.clear()
 	log.Info("Waiting for state to be initialized")
 	clock, err := s.cfg.ClockWaiter.WaitForClock(s.ctx)
 	if err != nil {
@@ -279,33 +288,6 @@ func (s *Service) markSynced() {
 	close(s.cfg.InitialSyncComplete)
 }
 
-func missingBlobRequest(blk blocks.ROBlock, store *filesystem.BlobStorage) (p2ptypes.BlobSidecarsByRootReq, error) {
-	r := blk.Root()
-	if blk.Version() < version.Deneb {
-		return nil, nil
-	}
-	cmts, err := blk.Block().Body().BlobKzgCommitments()
-	if err != nil {
-		log.WithField("root", r).Error("Error reading commitments from checkpoint sync origin block")
-		return nil, err
-	}
-	if len(cmts) == 0 {
-		return nil, nil
-	}
-	onDisk, err := store.Indices(r)
-	if err != nil {
-		return nil, errors.Wrapf(err, "error checking existing blobs for checkpoint sync block root %#x", r)
-	}
-	req := make(p2ptypes.BlobSidecarsByRootReq, 0, len(cmts))
-	for i := range cmts {
-		if onDisk[i] {
-			continue
-		}
-		req = append(req, &eth.BlobIdentifier{BlockRoot: r[:], Index: uint64(i)})
-	}
-	return req, nil
-}
-
 func (s *Service) fetchOriginBlobs(pids []peer.ID) error {
 	r, err := s.cfg.DB.OriginCheckpointBlockRoot(s.ctx)
 	if errors.Is(err, db.ErrNotFoundOriginBlockRoot) {
@@ -323,7 +305,7 @@ func (s *Service) fetchOriginBlobs(pids []peer.ID) error {
 	if err != nil {
 		return err
 	}
-	req, err := missingBlobRequest(rob, s.cfg.BlobStorage)
+	req, err := sync.MissingBlobIdentifiers(rob, s.cfg.BlobStorage)
 	if err != nil {
 		return err
 	}
@@ -332,28 +314,19 @@ func (s *Service) fetchOriginBlobs(pids []peer.ID) error {
 		return nil
 	}
 	shufflePeers(pids)
-	for i := range pids {
-		sidecars, err := sync.SendBlobSidecarByRoot(s.ctx, s.clock, s.cfg.P2P, pids[i], s.ctxMap, &req)
-		if err != nil {
-			continue
-		}
-		if len(sidecars) != len(req) {
-			continue
-		}
-		bv := verification.NewBlobBatchVerifier(s.newBlobVerifier, verification.InitsyncSidecarRequirements)
-		avs := das.NewLazilyPersistentStore(s.cfg.BlobStorage, bv)
-		current := s.clock.CurrentSlot()
-		if err := avs.Persist(current, sidecars...); err != nil {
-			return err
-		}
-		if err := avs.IsDataAvailable(s.ctx, current, rob); err != nil {
-			log.WithField("root", fmt.Sprintf("%#x", r)).WithField("peerID", pids[i]).Warn("Blobs from peer for origin block were unusable")
-			continue
-		}
-		log.WithField("nBlobs", len(sidecars)).WithField("root", fmt.Sprintf("%#x", r)).Info("Successfully downloaded blobs for checkpoint sync block")
-		return nil
+	f := sync.NewBlobFetcher(&sync.BlobFetcherConfig{
+		P2P:             s.cfg.P2P,
+		Clock:           s.clock,
+		CtxMap:          s.ctxMap,
+		BlobStorage:     s.cfg.BlobStorage,
+		NewBlobVerifier: s.newBlobVerifier,
+		Requirements:    verification.InitsyncSidecarRequirements,
+	})
+	if err := f.FetchMissing(s.ctx, []blocks.ROBlock{rob}, pids); err != nil {
+		return errors.Wrapf(err, "could not download blobs for checkpoint sync block %#x", r)
 	}
-	return fmt.Errorf("no connected peer able to provide blobs for checkpoint sync block %#x", r)
+	log.WithField("nBlobs", len(req)).WithField("root", fmt.Sprintf("%#x", r)).Info("Successfully downloaded blobs for checkpoint sync block")
+	return nil
 }
 
 func shufflePeers(pids []peer.ID) {
@@ -15,6 +15,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
+// Sources of stored blob sidecars.
+const (
+	blobSourceGossip    = "gossip"
+	blobSourceExecution = "execution"
+	blobSourceByRoot    = "by_root"
+)
+
 var (
 	topicPeerCount = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -49,6 +56,13 @@ var (
 		},
 		[]string{"topic"},
 	)
+	messageAbandonedValidationCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_message_abandoned_validation_total",
+			Help: "Count of messages that were ignored because their validation deadline passed.",
+		},
+		[]string{"topic"},
+	)
 	messageFailedProcessingCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "p2p_message_failed_processing_total",
@@ -56,6 +70,13 @@ var (
 		},
 		[]string{"topic"},
 	)
+	throttledRequestCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_rpc_requests_throttled_total",
+			Help: "Count of inbound rpc requests rejected by the rate limiter, because the peer exceeded its budget or too many requests were being served.",
+		},
+		[]string{"topic", "agent", "reason"},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
@@ -96,6 +117,13 @@ var (
 			Buckets: []float64{100, 250, 500, 750, 1000, 1500, 2000, 4000, 8000, 12000, 16000, 20000, 24000},
 		},
 	)
+	gossipEarlyArrivalCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gossip_early_arrival_total",
+			Help: "Count of gossip messages received before the start of their slot, by topic and by whether they arrived within MAXIMUM_GOSSIP_CLOCK_DISPARITY.",
+		},
+		[]string{"topic", "arrival"},
+	)
 	arrivalBlockPropagationGauge = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "block_arrival_latency_milliseconds_gauge",
 		Help: "Captures blocks propagation time. Blocks arrival in milliseconds",
@@ -114,6 +142,14 @@ var (
 		Name: "gossip_attestation_bad_selection_proof_total",
 		Help: "Increased when a gossip attestation has a bad selection proof",
 	})
+	slashedProposerBlockRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gossip_block_slashed_proposer_rejections_total",
+		Help: "The number of gossip blocks rejected because their proposer is slashed, by the state the slashing is known from",
+	}, []string{"source"})
+	aggregateRejectionCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gossip_aggregate_rejections_total",
+		Help: "The number of aggregate and proofs rejected, by reason",
+	}, []string{"reason"})
 	attBadSignatureBatchCount = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "gossip_attestation_bad_signature_batch_total",
 		Help: "Increased when a gossip attestation has a bad signature batch",
@@ -184,6 +220,22 @@ var (
 			Help: "Count the number of times blobs have been found in the database.",
 		},
 	)
+
+	blobSidecarsObtainedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "blob_sidecars_obtained_total",
+			Help: "The number of blob sidecars stored, by source: gossip, the execution layer mempool or by root requests for missing sidecars.",
+		},
+		[]string{"source"},
+	)
+
+	blobFetchRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "blob_fetch_requests_total",
+			Help: "The number of by root requests for missing blob sidecars, by result: complete, partial or failed.",
+		},
+		[]string{"result"},
+	)
 )
 
 func (s *Service) updateMetrics() {
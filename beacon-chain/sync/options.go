@@ -2,6 +2,7 @@ package sync
 
 import (
 	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -180,3 +181,27 @@ func WithAvailableBlocker(avb coverage.AvailableBlocker) Option {
 		return nil
 	}
 }
+
+// WithSlotObservations gives the sync package a tracker to record the blocks seen on gossip for recent slots.
+func WithSlotObservations(o *cache.SlotObservations) Option {
+	return func(s *Service) error {
+		s.cfg.slotObservations = o
+		return nil
+	}
+}
+
+// WithValidatorLiveness gives the sync package a cache to record the validators seen attesting on gossip.
+func WithValidatorLiveness(l *cache.ValidatorLiveness) Option {
+	return func(s *Service) error {
+		s.cfg.validatorLiveness = l
+		return nil
+	}
+}
+
+// WithSubnetAttestationStats gives the sync package a tracker to record per-subnet attestation delivery statistics.
+func WithSubnetAttestationStats(stats *cache.SubnetAttestationStats) Option {
+	return func(s *Service) error {
+		s.cfg.subnetAttestationStats = stats
+		return nil
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/async"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations/kv"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
@@ -107,6 +108,9 @@ func (s *Service) processAttestations(ctx context.Context, attestations []ethpb.
 					log.WithError(err).Debug("Could not save aggregate attestation")
 					continue
 				}
+				if err := s.cfg.attPool.SaveAttestationProvenance(aggregate, kv.SourceGossipAggregate, signedAtt.AggregateAttestationAndProof().GetAggregatorIndex()); err != nil {
+					log.WithError(err).Debug("Could not save attestation provenance")
+				}
 				s.setAggregatorIndexEpochSeen(data.Target.Epoch, signedAtt.AggregateAttestationAndProof().GetAggregatorIndex())
 
 				// Broadcasting the signed attestation again once a node is able to process it.
@@ -142,6 +146,9 @@ func (s *Service) processAttestations(ctx context.Context, attestations []ethpb.
 					log.WithError(err).Debug("Could not save unaggregated attestation")
 					continue
 				}
+				if err := s.cfg.attPool.SaveAttestationProvenance(aggregate, kv.SourceGossipUnaggregated, 0); err != nil {
+					log.WithError(err).Debug("Could not save attestation provenance")
+				}
 				s.setSeenCommitteeIndicesSlot(data.Slot, data.CommitteeIndex, aggregate.GetAggregationBits())
 
 				valCount, err := helpers.ActiveValidatorCount(ctx, preState, slots.ToEpoch(data.Slot))
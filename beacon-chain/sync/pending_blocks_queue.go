@@ -22,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/equality"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	prysmTrace "github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
 	"github.com/trailofbits/go-mutexasserts"
@@ -205,19 +206,24 @@ func (s *Service) processAndBroadcastBlock(ctx context.Context, b interfaces.Rea
 		}
 	}
 
-	request, err := s.pendingBlobsRequestForBlock(blkRoot, b)
-	if err != nil {
-		return err
-	}
-	if len(request) > 0 {
-		peers := s.getBestPeers()
-		peerCount := len(peers)
-		if peerCount == 0 {
-			return errors.Wrapf(errNoPeersForPending, "block root=%#x", blkRoot)
+	if b.Version() >= version.Deneb {
+		rob, err := blocks.NewROBlockWithRoot(b, blkRoot)
+		if err != nil {
+			return err
 		}
-		if err := s.sendAndSaveBlobSidecars(ctx, request, peers[rand.NewGenerator().Int()%peerCount], b); err != nil {
+		missing, err := MissingBlobIdentifiers(rob, s.cfg.blobStorage)
+		if err != nil {
 			return err
 		}
+		if len(missing) > 0 {
+			peers := s.getBestPeers()
+			if len(peers) == 0 {
+				return errors.Wrapf(errNoPeersForPending, "block root=%#x", blkRoot)
+			}
+			if err := s.blobFetcher().FetchMissing(ctx, []blocks.ROBlock{rob}, peers); err != nil {
+				return err
+			}
+		}
 	}
 
 	if err := s.cfg.chain.ReceiveBlock(ctx, b, blkRoot, nil); err != nil {
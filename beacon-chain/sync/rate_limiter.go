@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"fmt"
 	"reflect"
 	"sync"
 	"time"
@@ -25,8 +26,20 @@ const blockBucketPeriod = 30 * time.Second
 // Dummy topic to validate all incoming rpc requests.
 const rpcLimiterTopic = "rpc-limiter-topic"
 
+// Number of inbound rpc requests served at the same time, across all peers.
+const maxConcurrentInboundRequests = 256
+
+// Number of requests over its budget a peer can send in quick succession
+// before it is considered abusive and disconnected.
+const maxRateLimitViolations = 2 * defaultBurstLimit
+
+// errRateLimitAbuse is returned for peers that keep sending requests over their budget.
+var errRateLimitAbuse = errors.Wrap(p2ptypes.ErrRateLimited, "peer kept exceeding its rate limits")
+
 type limiter struct {
 	limiterMap map[string]*leakybucket.Collector
+	violations *leakybucket.Collector
+	inflight   chan struct{}
 	p2p        p2p.P2P
 	sync.RWMutex
 }
@@ -46,43 +59,40 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	allowedBlobsPerSecond := float64(flags.Get().BlobBatchLimit)
 	allowedBlobsBurst := int64(flags.Get().BlobBatchLimitBurstFactor * flags.Get().BlobBatchLimit)
 
-	// Set topic map for all rpc topics.
+	// Set topic map for all rpc topics. Topics of the same family share a collector,
+	// so that a peer has a single budget for all of them.
 	topicMap := make(map[string]*leakybucket.Collector, len(p2p.RPCTopicMappings))
 	// Goodbye Message
 	topicMap[addEncoding(p2p.RPCGoodByeTopicV1)] = leakybucket.NewCollector(1, 1, leakyBucketPeriod, false /* deleteEmptyBuckets */)
-	// MetadataV0 Message
-	topicMap[addEncoding(p2p.RPCMetaDataTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
-	topicMap[addEncoding(p2p.RPCMetaDataTopicV2)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
-	// Ping Message
-	topicMap[addEncoding(p2p.RPCPingTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
-	// Status Message
-	topicMap[addEncoding(p2p.RPCStatusTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
-
-	// Use a single collector for block requests
-	blockCollector := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
-	// Collector for V2
-	blockCollectorV2 := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
 
-	// for BlobSidecarsByRoot and BlobSidecarsByRange
-	blobCollector := leakybucket.NewCollector(allowedBlobsPerSecond, allowedBlobsBurst, blockBucketPeriod, false)
+	// Collector for MetaData, Ping and Status requests.
+	controlCollector := leakybucket.NewCollector(3, 3*defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
+	topicMap[addEncoding(p2p.RPCMetaDataTopicV1)] = controlCollector
+	topicMap[addEncoding(p2p.RPCMetaDataTopicV2)] = controlCollector
+	topicMap[addEncoding(p2p.RPCPingTopicV1)] = controlCollector
+	topicMap[addEncoding(p2p.RPCStatusTopicV1)] = controlCollector
 
-	// BlocksByRoots requests
+	// Collector for BlocksByRange and BlocksByRoot requests, in all their versions.
+	blockCollector := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
 	topicMap[addEncoding(p2p.RPCBlocksByRootTopicV1)] = blockCollector
-	topicMap[addEncoding(p2p.RPCBlocksByRootTopicV2)] = blockCollectorV2
-
-	// BlockByRange requests
+	topicMap[addEncoding(p2p.RPCBlocksByRootTopicV2)] = blockCollector
 	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV1)] = blockCollector
-	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV2)] = blockCollectorV2
+	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV2)] = blockCollector
 
-	// BlobSidecarsByRootV1
+	// Collector for BlobSidecarsByRange and BlobSidecarsByRoot requests.
+	blobCollector := leakybucket.NewCollector(allowedBlobsPerSecond, allowedBlobsBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
 	topicMap[addEncoding(p2p.RPCBlobSidecarsByRootTopicV1)] = blobCollector
-	// BlobSidecarsByRangeV1
 	topicMap[addEncoding(p2p.RPCBlobSidecarsByRangeTopicV1)] = blobCollector
 
 	// General topic for all rpc requests.
 	topicMap[rpcLimiterTopic] = leakybucket.NewCollector(5, defaultBurstLimit*2, leakyBucketPeriod, false /* deleteEmptyBuckets */)
 
-	return &limiter{limiterMap: topicMap, p2p: p2pProvider}
+	return &limiter{
+		limiterMap: topicMap,
+		violations: leakybucket.NewCollector(1, maxRateLimitViolations, leakyBucketPeriod, false /* deleteEmptyBuckets */),
+		inflight:   make(chan struct{}, maxConcurrentInboundRequests),
+		p2p:        p2pProvider,
+	}
 }
 
 // Returns the current topic collector for the provided topic.
@@ -103,18 +113,11 @@ func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
 	if err != nil {
 		return err
 	}
-	key := stream.Conn().RemotePeer().String()
-	remaining := collector.Remaining(key)
 	// Treat each request as a minimum of 1.
 	if amt == 0 {
 		amt = 1
 	}
-	if amt > uint64(remaining) {
-		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
-		writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
-		return p2ptypes.ErrRateLimited
-	}
-	return nil
+	return l.checkBudget(stream, collector, int64(amt))
 }
 
 // This is used to validate all incoming rpc streams from external peers.
@@ -128,16 +131,46 @@ func (l *limiter) validateRawRpcRequest(stream network.Stream) error {
 	if err != nil {
 		return err
 	}
-	key := stream.Conn().RemotePeer().String()
-	remaining := collector.Remaining(key)
 	// Treat each request as a minimum of 1.
-	amt := int64(1)
-	if amt > remaining {
-		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
-		writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
-		return p2ptypes.ErrRateLimited
+	return l.checkBudget(stream, collector, 1)
+}
+
+// checkBudget rejects a request of the given cost if it exceeds the remaining budget of the peer in the collector.
+// The peer is told how long to wait in a rate limited response, and is only penalized, with errRateLimitAbuse,
+// if it keeps sending requests over its budget.
+func (l *limiter) checkBudget(stream network.Stream, collector *leakybucket.Collector, amt int64) error {
+	pid := stream.Conn().RemotePeer()
+	key := pid.String()
+	if amt <= collector.Remaining(key) {
+		return nil
+	}
+	throttledRequestCounter.WithLabelValues(string(stream.Protocol()), p2p.AgentFromPid(pid, l.p2p.Host().Peerstore()), "peer").Inc()
+	writeRateLimitedResponse(stream, collector.TillEmpty(key), l.p2p)
+	if l.violations.Add(key, 1) == 0 {
+		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		return errRateLimitAbuse
+	}
+	return p2ptypes.ErrRateLimited
+}
+
+// acquire reserves one of the slots for inbound requests served at the same time. When all of them are in use,
+// the peer is told to retry later, without being penalized, and an error is returned. Slots must be released
+// once the request is served.
+func (l *limiter) acquire(stream network.Stream) error {
+	select {
+	case l.inflight <- struct{}{}:
+		return nil
+	default:
 	}
-	return nil
+	pid := stream.Conn().RemotePeer()
+	throttledRequestCounter.WithLabelValues(string(stream.Protocol()), p2p.AgentFromPid(pid, l.p2p.Host().Peerstore()), "global").Inc()
+	writeRateLimitedResponse(stream, leakyBucketPeriod, l.p2p)
+	return p2ptypes.ErrRateLimited
+}
+
+// release frees a slot reserved with acquire.
+func (l *limiter) release() {
+	<-l.inflight
 }
 
 // adds the cost to our leaky bucket for the topic.
@@ -194,6 +227,7 @@ func (l *limiter) free() {
 		delete(l.limiterMap, t)
 		tempMap[ptr] = true
 	}
+	l.violations.Free()
 }
 
 // not to be used outside the rate limiter file as it is unsafe for concurrent usage
@@ -212,3 +246,9 @@ func (l *limiter) retrieveCollector(topic string) (*leakybucket.Collector, error
 func (_ *limiter) topicLogger(topic string) *logrus.Entry {
 	return log.WithField("rateLimiter", topic)
 }
+
+// writeRateLimitedResponse responds to a request over the rate limits with how long the peer should wait before retrying.
+func writeRateLimitedResponse(stream network.Stream, wait time.Duration, encoder p2p.EncodingProvider) {
+	reason := fmt.Sprintf("%s, retry in %s", p2ptypes.ErrRateLimited, wait.Round(time.Millisecond))
+	writeErrorResponseToStream(responseCodeRateLimited, reason, stream, encoder)
+}
@@ -2,15 +2,20 @@ package sync
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	libp2pcore "github.com/libp2p/go-libp2p/core"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -41,8 +46,8 @@ func TestRateLimiter_ExceedCapacity(t *testing.T) {
 		defer wg.Done()
 		code, errMsg, err := readStatusCodeNoDeadline(stream, p2.Encoding())
 		require.NoError(t, err, "could not read incoming stream")
-		assert.Equal(t, responseCodeInvalidRequest, code, "not equal response codes")
-		assert.Equal(t, p2ptypes.ErrRateLimited.Error(), errMsg, "not equal errors")
+		assert.Equal(t, responseCodeRateLimited, code, "not equal response codes")
+		assert.Equal(t, true, strings.HasPrefix(errMsg, p2ptypes.ErrRateLimited.Error()+", retry in "), "unexpected error message %s", errMsg)
 	})
 	wg.Add(1)
 	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
@@ -78,8 +83,8 @@ func TestRateLimiter_ExceedRawCapacity(t *testing.T) {
 		defer wg.Done()
 		code, errMsg, err := readStatusCodeNoDeadline(stream, p2.Encoding())
 		require.NoError(t, err, "could not read incoming stream")
-		assert.Equal(t, responseCodeInvalidRequest, code, "not equal response codes")
-		assert.Equal(t, p2ptypes.ErrRateLimited.Error(), errMsg, "not equal errors")
+		assert.Equal(t, responseCodeRateLimited, code, "not equal response codes")
+		assert.Equal(t, true, strings.HasPrefix(errMsg, p2ptypes.ErrRateLimited.Error()+", retry in "), "unexpected error message %s", errMsg)
 	})
 	wg.Add(1)
 	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
@@ -90,14 +95,22 @@ func TestRateLimiter_ExceedRawCapacity(t *testing.T) {
 		rlimiter.addRawStream(stream)
 		require.NoError(t, err, "could not validate incoming request")
 	}
-	// Triggers rate limit error on burst.
-	assert.ErrorContains(t, p2ptypes.ErrRateLimited.Error(), rlimiter.validateRawRpcRequest(stream))
-
-	// Make Peer bad.
-	for i := 0; i < defaultBurstLimit; i++ {
-		assert.ErrorContains(t, p2ptypes.ErrRateLimited.Error(), rlimiter.validateRawRpcRequest(stream))
+	// Triggers rate limit errors on burst, without penalizing the peer.
+	for i := 0; i < maxRateLimitViolations; i++ {
+		err = rlimiter.validateRawRpcRequest(stream)
+		require.ErrorIs(t, err, p2ptypes.ErrRateLimited)
+		require.NotEqual(t, errRateLimitAbuse, err)
 	}
-	assert.Equal(t, true, p1.Peers().IsBad(p2.PeerID()), "peer is not marked as a bad peer")
+	count, err := p1.Peers().Scorers().BadResponsesScorer().Count(p2.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// Keeping on is abusive.
+	err = rlimiter.validateRawRpcRequest(stream)
+	require.ErrorIs(t, err, errRateLimitAbuse)
+	count, err = p1.Peers().Scorers().BadResponsesScorer().Count(p2.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
 	require.NoError(t, stream.Close(), "could not close stream")
 
 	if util.WaitTimeout(&wg, 1*time.Second) {
@@ -110,3 +123,115 @@ func Test_limiter_retrieveCollector_requiresLock(t *testing.T) {
 	_, err := l.retrieveCollector("")
 	require.ErrorContains(t, "caller must hold read/write lock", err)
 }
+
+func TestRateLimiter_SharedFamilyBudget(t *testing.T) {
+	p := mockp2p.NewTestP2P(t)
+	rlimiter := newRateLimiter(p)
+	suffix := p.Encoding().ProtocolSuffix()
+
+	byRange, err := rlimiter.topicCollector(p2p.RPCBlocksByRangeTopicV2 + suffix)
+	require.NoError(t, err)
+	byRoot, err := rlimiter.topicCollector(p2p.RPCBlocksByRootTopicV1 + suffix)
+	require.NoError(t, err)
+	assert.Equal(t, byRange, byRoot, "blocks requests do not share a collector")
+
+	ping, err := rlimiter.topicCollector(p2p.RPCPingTopicV1 + suffix)
+	require.NoError(t, err)
+	status, err := rlimiter.topicCollector(p2p.RPCStatusTopicV1 + suffix)
+	require.NoError(t, err)
+	assert.Equal(t, ping, status, "ping and status requests do not share a collector")
+}
+
+// sendTestRequest opens a stream to the test topic, writes a request and returns the response code and message.
+func sendTestRequest(from, to *mockp2p.TestP2P, topic string) (uint8, string, error) {
+	stream, err := from.BHost.NewStream(context.Background(), to.PeerID(), protocol.ID(topic+from.Encoding().ProtocolSuffix()))
+	if err != nil {
+		return 0, "", err
+	}
+	defer func() {
+		_ = stream.Close()
+	}()
+	if _, err := from.Encoding().EncodeWithMaxLength(stream, &ethpb.Fork{CurrentVersion: []byte("fooo"), PreviousVersion: []byte("barr")}); err != nil {
+		return 0, "", err
+	}
+	return readStatusCodeNoDeadline(stream, from.Encoding())
+}
+
+func TestRateLimiter_RegisterRPC_Throttling(t *testing.T) {
+	topic := "/testing/foobar/1"
+	p2p.RPCTopicMappings[topic] = new(ethpb.Fork)
+	defer func() {
+		delete(p2p.RPCTopicMappings, topic)
+	}()
+	handler := func(_ context.Context, _ interface{}, stream libp2pcore.Stream) error {
+		if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+			return err
+		}
+		closeStream(stream, log)
+		return nil
+	}
+	newService := func(t *testing.T) (*Service, *mockp2p.TestP2P, *mockp2p.TestP2P) {
+		p1 := mockp2p.NewTestP2P(t)
+		p2 := mockp2p.NewTestP2P(t)
+		p1.Connect(p2)
+		p1.Peers().Add(nil, p2.PeerID(), p2.BHost.Addrs()[0], network.DirOutbound)
+		r := &Service{
+			ctx:         context.Background(),
+			cfg:         &config{p2p: p1, clock: startup.NewClock(time.Now(), [32]byte{})},
+			rateLimiter: newRateLimiter(p1),
+		}
+		r.registerRPC(topic, handler)
+		return r, p1, p2
+	}
+
+	t.Run("peer over budget then disconnected", func(t *testing.T) {
+		_, p1, p2 := newService(t)
+		goodbye := make(chan primitives.SSZUint64, 1)
+		p2.BHost.SetStreamHandler(protocol.ID(p2p.RPCGoodByeTopicV1+p2.Encoding().ProtocolSuffix()), func(stream network.Stream) {
+			code := new(primitives.SSZUint64)
+			assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, code))
+			assert.NoError(t, stream.Close())
+			goodbye <- *code
+		})
+
+		served, rateLimited := 0, 0
+		for len(goodbye) == 0 && served+rateLimited < 10*(2*defaultBurstLimit+maxRateLimitViolations) {
+			code, errMsg, err := sendTestRequest(p2, p1, topic)
+			if err != nil {
+				// The peer is being disconnected.
+				break
+			}
+			if code == responseCodeSuccess {
+				served++
+				continue
+			}
+			require.Equal(t, responseCodeRateLimited, code)
+			require.Equal(t, true, strings.HasPrefix(errMsg, p2ptypes.ErrRateLimited.Error()+", retry in "), "unexpected error message %s", errMsg)
+			rateLimited++
+		}
+		require.Equal(t, true, served >= 2*defaultBurstLimit, "burst was not served")
+		require.Equal(t, true, rateLimited > maxRateLimitViolations, "peer was disconnected before being abusive")
+
+		select {
+		case code := <-goodbye:
+			assert.Equal(t, p2ptypes.GoodbyeCodeRateLimited, code)
+		case <-time.After(2 * time.Second):
+			t.Fatal("Did not receive goodbye within 2 sec")
+		}
+	})
+	t.Run("global limit", func(t *testing.T) {
+		r, p1, p2 := newService(t)
+		// No request can be served at the same time as another one.
+		r.rateLimiter.inflight = make(chan struct{})
+
+		code, errMsg, err := sendTestRequest(p2, p1, topic)
+		require.NoError(t, err)
+		require.Equal(t, responseCodeRateLimited, code)
+		require.Equal(t, p2ptypes.ErrRateLimited.Error()+", retry in 1s", errMsg)
+		// The peer is not to blame.
+		count, err := p1.Peers().Scorers().BadResponsesScorer().Count(p2.PeerID())
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+		assert.Equal(t, int64(maxRateLimitViolations), r.rateLimiter.violations.Remaining(p2.PeerID().String()))
+	})
+}
@@ -170,9 +170,18 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 		// Validate request according to peer limits.
 		if err := s.rateLimiter.validateRawRpcRequest(stream); err != nil {
 			log.WithError(err).Debug("Could not validate rpc request from peer")
+			s.disconnectRateLimitAbuser(err, stream.Conn().RemotePeer())
 			return
 		}
 		s.rateLimiter.addRawStream(stream)
+		// Goodbye messages are not subject to the global limit, so that peers can always disconnect gracefully.
+		if baseTopic != p2p.RPCGoodByeTopicV1 {
+			if err := s.rateLimiter.acquire(stream); err != nil {
+				log.WithError(err).Debug("Too many rpc requests are being served")
+				return
+			}
+			defer s.rateLimiter.release()
+		}
 
 		if err := stream.SetReadDeadline(time.Now().Add(ttfbTimeout)); err != nil {
 			log.WithError(err).Debug("Could not set stream read deadline")
@@ -196,6 +205,7 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 		if baseTopic == p2p.RPCMetaDataTopicV1 || baseTopic == p2p.RPCMetaDataTopicV2 {
 			if err := handle(ctx, base, stream); err != nil {
 				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
+				s.disconnectRateLimitAbuser(err, stream.Conn().RemotePeer())
 				if !errors.Is(err, p2ptypes.ErrWrongForkDigestVersion) {
 					log.WithError(err).Debug("Could not handle p2p RPC")
 				}
@@ -221,6 +231,7 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 			}
 			if err := handle(ctx, msg, stream); err != nil {
 				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
+				s.disconnectRateLimitAbuser(err, stream.Conn().RemotePeer())
 				if !errors.Is(err, p2ptypes.ErrWrongForkDigestVersion) {
 					log.WithError(err).Debug("Could not handle p2p RPC")
 				}
@@ -241,6 +252,7 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 			}
 			if err := handle(ctx, nTyp.Elem().Interface(), stream); err != nil {
 				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
+				s.disconnectRateLimitAbuser(err, stream.Conn().RemotePeer())
 				if !errors.Is(err, p2ptypes.ErrWrongForkDigestVersion) {
 					log.WithError(err).Debug("Could not handle p2p RPC")
 				}
@@ -40,9 +40,9 @@ func (s *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 	}
 	available := s.validateRangeAvailability(rp)
 	if !available {
-		log.Debug("error in validating range availability")
+		log.WithField("startSlot", rp.start).Debug("Requested block range is before the earliest available block")
 		s.writeErrorResponseToStream(responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
-		tracing.AnnotateError(span, err)
+		tracing.AnnotateError(span, p2ptypes.ErrResourceUnavailable)
 		return nil
 	}
 
@@ -133,9 +133,14 @@ func validateRangeRequest(r *pb.BeaconBlocksByRangeRequest, current primitives.S
 	return rp, nil
 }
 
+// validateRangeAvailability checks that we have the block history needed to serve the requested range.
+// After checkpoint sync, blocks before the origin are only available once backfill has reached them,
+// and we must not respond to requests for them as if the slots were simply empty.
 func (s *Service) validateRangeAvailability(rp rangeParams) bool {
-	startBlock := rp.start
-	return s.availableBlocker.AvailableBlock(startBlock)
+	if s.availableBlocker == nil {
+		return true
+	}
+	return s.availableBlocker.AvailableBlock(rp.start)
 }
 
 func (s *Service) writeBlockBatchToStream(ctx context.Context, batch blockBatch, stream libp2pcore.Stream) error {
@@ -1105,6 +1105,70 @@ func TestRPCBeaconBlocksByRange_FilterBlocks_PreviousRoot(t *testing.T) {
 	require.NotEqual(t, cf.prevRoot, [32]byte{})
 }
 
+func TestRPCBeaconBlocksByRange_BackfillProgress(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	d := db.SetupDB(t)
+
+	req := &ethpb.BeaconBlocksByRangeRequest{
+		StartSlot: 100,
+		Step:      1,
+		Count:     16,
+	}
+	var prevRoot [32]byte
+	var err error
+	for i := req.StartSlot; i < req.StartSlot.Add(req.Count); i++ {
+		blk := util.NewBeaconBlock()
+		blk.Block.Slot = i
+		copy(blk.Block.ParentRoot, prevRoot[:])
+		prevRoot, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		util.SaveBlock(t, context.Background(), d, blk)
+	}
+
+	clock := startup.NewClock(time.Now(), [32]byte{})
+	// The node was checkpoint synced, and backfill has not yet reached the start of the requested range.
+	blocker := &lowSlotBlocker{low: req.StartSlot + 50}
+	r := &Service{cfg: &config{p2p: p2, beaconDB: d, clock: clock, chain: &chainMock.ChainService{}}, availableBlocker: blocker, rateLimiter: newRateLimiter(p2)}
+	topic, err := p2p.TopicFromMessage(p2p.BeaconBlocksByRangeMessageName, slots.ToEpoch(clock.CurrentSlot()))
+	require.NoError(t, err)
+	p2.BHost.SetStreamHandler(protocol.ID(topic+p2.Encoding().ProtocolSuffix()), func(stream network.Stream) {
+		m := &ethpb.BeaconBlocksByRangeRequest{}
+		assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, m))
+		assert.NoError(t, r.beaconBlocksByRangeRPCHandler(context.Background(), m, stream))
+	})
+
+	// Rather than an empty success, which would look like a range of skipped slots, the request is refused.
+	_, err = SendBeaconBlocksByRangeRequest(context.Background(), clock, p1, p2.PeerID(), req, nil)
+	require.ErrorIs(t, err, p2ptypes.ErrResourceUnavailable)
+
+	// Once backfill has filled in the range, the blocks are served.
+	blocker.setLow(req.StartSlot)
+	blks, err := SendBeaconBlocksByRangeRequest(context.Background(), clock, p1, p2.PeerID(), req, nil)
+	require.NoError(t, err)
+	require.Equal(t, int(req.Count), len(blks))
+	assert.Equal(t, req.StartSlot, blks[0].Block().Slot())
+}
+
+// lowSlotBlocker mimics the backfill store, where blocks at or above the backfill low slot are available.
+type lowSlotBlocker struct {
+	sync.Mutex
+	low primitives.Slot
+}
+
+func (b *lowSlotBlocker) setLow(low primitives.Slot) {
+	b.Lock()
+	defer b.Unlock()
+	b.low = low
+}
+
+func (b *lowSlotBlocker) AvailableBlock(sl primitives.Slot) bool {
+	b.Lock()
+	defer b.Unlock()
+	return sl == 0 || sl >= b.low
+}
+
 type mockBlocker struct {
 	avail bool
 }
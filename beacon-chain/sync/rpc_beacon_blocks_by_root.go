@@ -9,8 +9,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/verify"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -46,23 +44,23 @@ func (s *Service) sendRecentBeaconBlocksRequest(ctx context.Context, requests *t
 		}
 		return nil
 	})
+	var withBlobs []blocks.ROBlock
 	for _, blk := range blks {
 		// Skip blocks before deneb because they have no blob.
 		if blk.Version() < version.Deneb {
 			continue
 		}
-		blkRoot, err := blk.Block().HashTreeRoot()
+		rob, err := blocks.NewROBlock(blk)
 		if err != nil {
 			return err
 		}
-		request, err := s.pendingBlobsRequestForBlock(blkRoot, blk)
-		if err != nil {
-			return err
-		}
-		if len(request) == 0 {
-			continue
-		}
-		if err := s.sendAndSaveBlobSidecars(ctx, request, id, blk); err != nil {
+		withBlobs = append(withBlobs, rob)
+	}
+	if len(withBlobs) > 0 {
+		// The missing blobs of all the received blocks are requested at once, from the peer which served the
+		// blocks as well as from the best peers.
+		pids := append([]peer.ID{id}, s.getBestPeers()...)
+		if err := s.blobFetcher().FetchMissing(ctx, withBlobs, pids); err != nil {
 			return err
 		}
 	}
@@ -133,70 +131,6 @@ func (s *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg interface{
 	return nil
 }
 
-// sendAndSaveBlobSidecars sends the blob request and saves received sidecars.
-func (s *Service) sendAndSaveBlobSidecars(ctx context.Context, request types.BlobSidecarsByRootReq, peerID peer.ID, block interfaces.ReadOnlySignedBeaconBlock) error {
-	if len(request) == 0 {
-		return nil
-	}
-
-	sidecars, err := SendBlobSidecarByRoot(ctx, s.cfg.clock, s.cfg.p2p, peerID, s.ctxMap, &request)
-	if err != nil {
-		return err
-	}
-
-	RoBlock, err := blocks.NewROBlock(block)
-	if err != nil {
-		return err
-	}
-	if len(sidecars) != len(request) {
-		return fmt.Errorf("received %d blob sidecars, expected %d for RPC", len(sidecars), len(request))
-	}
-	bv := verification.NewBlobBatchVerifier(s.newBlobVerifier, verification.PendingQueueSidecarRequirements)
-	for _, sidecar := range sidecars {
-		if err := verify.BlobAlignsWithBlock(sidecar, RoBlock); err != nil {
-			return err
-		}
-		log.WithFields(blobFields(sidecar)).Debug("Received blob sidecar RPC")
-	}
-	vscs, err := bv.VerifiedROBlobs(ctx, RoBlock, sidecars)
-	if err != nil {
-		return err
-	}
-	for i := range vscs {
-		if err := s.cfg.blobStorage.Save(vscs[i]); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (s *Service) pendingBlobsRequestForBlock(root [32]byte, b interfaces.ReadOnlySignedBeaconBlock) (types.BlobSidecarsByRootReq, error) {
-	if b.Version() < version.Deneb {
-		return nil, nil // Block before deneb has no blob.
-	}
-	cc, err := b.Block().Body().BlobKzgCommitments()
-	if err != nil {
-		return nil, err
-	}
-	if len(cc) == 0 {
-		return nil, nil
-	}
-	return s.constructPendingBlobsRequest(root, len(cc))
-}
-
-// constructPendingBlobsRequest creates a request for BlobSidecars by root, considering blobs already in DB.
-func (s *Service) constructPendingBlobsRequest(root [32]byte, commitments int) (types.BlobSidecarsByRootReq, error) {
-	if commitments == 0 {
-		return nil, nil
-	}
-	stored, err := s.cfg.blobStorage.Indices(root)
-	if err != nil {
-		return nil, err
-	}
-
-	return requestsForMissingIndices(stored, commitments, root), nil
-}
-
 // requestsForMissingIndices constructs a slice of BlobIdentifiers that are missing from
 // local storage, based on a mapping that represents which indices are locally stored,
 // and the highest expected index.
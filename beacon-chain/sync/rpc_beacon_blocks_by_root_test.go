@@ -9,21 +9,17 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	gcache "github.com/patrickmn/go-cache"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	db "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	p2pTypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -365,101 +361,6 @@ func TestRecentBeaconBlocksRPCHandler_HandleZeroBlocks(t *testing.T) {
 	assert.Equal(t, 1, int(lter.Count(stream1.Conn().RemotePeer().String())))
 }
 
-func TestRequestPendingBlobs(t *testing.T) {
-	s := &Service{cfg: &config{blobStorage: filesystem.NewEphemeralBlobStorage(t)}}
-	t.Run("old block should not fail", func(t *testing.T) {
-		b, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
-		require.NoError(t, err)
-		request, err := s.pendingBlobsRequestForBlock([32]byte{}, b)
-		require.NoError(t, err)
-		require.NoError(t, s.sendAndSaveBlobSidecars(context.Background(), request, "test", b))
-	})
-	t.Run("empty commitment block should not fail", func(t *testing.T) {
-		b, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
-		require.NoError(t, err)
-		request, err := s.pendingBlobsRequestForBlock([32]byte{}, b)
-		require.NoError(t, err)
-		require.NoError(t, s.sendAndSaveBlobSidecars(context.Background(), request, "test", b))
-	})
-	t.Run("unsupported protocol", func(t *testing.T) {
-		p1 := p2ptest.NewTestP2P(t)
-		p2 := p2ptest.NewTestP2P(t)
-		p1.Connect(p2)
-		require.Equal(t, 1, len(p1.BHost.Network().Peers()))
-		chain := &mock.ChainService{
-			FinalizedCheckPoint: &ethpb.Checkpoint{
-				Epoch: 1,
-				Root:  make([]byte, 32),
-			},
-			ValidatorsRoot: [32]byte{},
-			Genesis:        time.Now(),
-		}
-		p1.Peers().Add(new(enr.Record), p2.PeerID(), nil, network.DirOutbound)
-		p1.Peers().SetConnectionState(p2.PeerID(), peers.PeerConnected)
-		p1.Peers().SetChainState(p2.PeerID(), &ethpb.Status{FinalizedEpoch: 1})
-		s := &Service{
-			cfg: &config{
-				p2p:         p1,
-				chain:       chain,
-				clock:       startup.NewClock(time.Unix(0, 0), [32]byte{}),
-				beaconDB:    db.SetupDB(t),
-				blobStorage: filesystem.NewEphemeralBlobStorage(t),
-			},
-		}
-		b := util.NewBeaconBlockDeneb()
-		b.Block.Body.BlobKzgCommitments = make([][]byte, 1)
-		b1, err := blocks.NewSignedBeaconBlock(b)
-		require.NoError(t, err)
-		request, err := s.pendingBlobsRequestForBlock([32]byte{}, b1)
-		require.NoError(t, err)
-		require.ErrorContains(t, "protocols not supported", s.sendAndSaveBlobSidecars(context.Background(), request, p2.PeerID(), b1))
-	})
-}
-
-func TestConstructPendingBlobsRequest(t *testing.T) {
-	d := db.SetupDB(t)
-	bs := filesystem.NewEphemeralBlobStorage(t)
-	s := &Service{cfg: &config{beaconDB: d, blobStorage: bs}}
-
-	// No unknown indices.
-	root := [32]byte{1}
-	count := 3
-	actual, err := s.constructPendingBlobsRequest(root, count)
-	require.NoError(t, err)
-	require.Equal(t, 3, len(actual))
-	for i, id := range actual {
-		require.Equal(t, uint64(i), id.Index)
-		require.DeepEqual(t, root[:], id.BlockRoot)
-	}
-
-	// Has indices.
-	header := &ethpb.SignedBeaconBlockHeader{
-		Header: &ethpb.BeaconBlockHeader{
-			ParentRoot: bytesutil.PadTo([]byte{}, 32),
-			StateRoot:  bytesutil.PadTo([]byte{}, 32),
-			BodyRoot:   bytesutil.PadTo([]byte{}, 32),
-		},
-		Signature: bytesutil.PadTo([]byte{}, 96),
-	}
-	blobSidecars := []blocks.ROBlob{
-		util.GenerateTestDenebBlobSidecar(t, root, header, 0, bytesutil.PadTo([]byte{}, 48), make([][]byte, 0)),
-		util.GenerateTestDenebBlobSidecar(t, root, header, 2, bytesutil.PadTo([]byte{}, 48), make([][]byte, 0)),
-	}
-	vscs, err := verification.BlobSidecarSliceNoop(blobSidecars)
-	require.NoError(t, err)
-	for i := range vscs {
-		require.NoError(t, bs.Save(vscs[i]))
-	}
-
-	expected := []*eth.BlobIdentifier{
-		{Index: 1, BlockRoot: root[:]},
-	}
-	actual, err = s.constructPendingBlobsRequest(root, count)
-	require.NoError(t, err)
-	require.Equal(t, expected[0].Index, actual[0].Index)
-	require.DeepEqual(t, expected[0].BlockRoot, actual[0].BlockRoot)
-}
-
 func TestFilterUnknownIndices(t *testing.T) {
 	haveIndices := [fieldparams.MaxBlobsPerBlock]bool{true, true, true, false, false, false}
 
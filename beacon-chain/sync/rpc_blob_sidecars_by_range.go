@@ -86,6 +86,12 @@ func (s *Service) blobSidecarsByRangeRPCHandler(ctx context.Context, msg interfa
 		tracing.AnnotateError(span, err)
 		return err
 	}
+	if !s.validateRangeAvailability(rp) {
+		log.WithField("startSlot", rp.start).Debug("Requested blob range is before the earliest available block")
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error(), stream)
+		tracing.AnnotateError(span, p2ptypes.ErrResourceUnavailable)
+		return nil
+	}
 
 	// Ticker to stagger out large requests.
 	ticker := time.NewTicker(time.Second)
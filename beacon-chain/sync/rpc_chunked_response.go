@@ -99,7 +99,7 @@ func readFirstChunkedBlock(stream libp2pcore.Stream, tor blockchain.TemporalOrac
 		return nil, err
 	}
 	if code != 0 {
-		return nil, errors.New(errMsg)
+		return nil, errorForResponseCode(code, errMsg)
 	}
 	rpcCtx, err := readContextFromStream(stream)
 	if err != nil {
@@ -122,7 +122,7 @@ func readResponseChunk(stream libp2pcore.Stream, tor blockchain.TemporalOracle,
 		return nil, err
 	}
 	if code != 0 {
-		return nil, errors.New(errMsg)
+		return nil, errorForResponseCode(code, errMsg)
 	}
 	// No-op for now with the rpc context.
 	rpcCtx, err := readContextFromStream(stream)
@@ -8,6 +8,7 @@ import (
 	libp2pcore "github.com/libp2p/go-libp2p/core"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/async"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
@@ -31,6 +32,9 @@ var backOffTime = map[primitives.SSZUint64]time.Duration{
 	// 'full'
 	p2ptypes.GoodbyeCodeTooManyPeers: 5 * time.Minute,
 	p2ptypes.GoodbyeCodeGenericError: 2 * time.Minute,
+	// Give a peer that disconnected us for sending too
+	// many requests some time to forget about it.
+	p2ptypes.GoodbyeCodeRateLimited: 10 * time.Minute,
 }
 
 // goodbyeRPCHandler reads the incoming goodbye rpc message from the peer.
@@ -48,6 +52,7 @@ func (s *Service) goodbyeRPCHandler(_ context.Context, msg interface{}, stream l
 	}
 	log := log.WithField("Reason", goodbyeMessage(*m))
 	log.WithField("peer", stream.Conn().RemotePeer()).Trace("Peer has sent a goodbye message")
+	s.cfg.p2p.Peers().SetDisconnectReason(stream.Conn().RemotePeer(), "goodbye received: "+goodbyeMessage(*m))
 	s.cfg.p2p.Peers().SetNextValidTime(stream.Conn().RemotePeer(), goodByeBackoff(*m))
 	// closes all streams with the peer
 	return s.cfg.p2p.Disconnect(stream.Conn().RemotePeer())
@@ -69,6 +74,17 @@ func (s *Service) disconnectBadPeer(ctx context.Context, id peer.ID) {
 	}
 }
 
+// disconnectRateLimitAbuser says goodbye to, and disconnects from, a peer that kept sending requests
+// over its rate limits, if the error returned while serving its request says so.
+func (s *Service) disconnectRateLimitAbuser(err error, id peer.ID) {
+	if !errors.Is(err, errRateLimitAbuse) {
+		return
+	}
+	if err := s.sendGoodByeAndDisconnect(s.ctx, p2ptypes.GoodbyeCodeRateLimited, id); err != nil {
+		log.WithError(err).Debug("Could not disconnect from peer exceeding its rate limits")
+	}
+}
+
 // A custom goodbye method that is used by our connection handler, in the
 // event we receive bad peers.
 func (s *Service) sendGoodbye(ctx context.Context, id peer.ID) error {
@@ -84,6 +100,7 @@ func (s *Service) sendGoodByeAndDisconnect(ctx context.Context, code p2ptypes.RP
 	if s.cfg.p2p.Host().Network().Connectedness(id) == network.NotConnected {
 		return nil
 	}
+	s.cfg.p2p.Peers().SetDisconnectReason(id, "goodbye sent: "+goodbyeMessage(code))
 	if err := s.sendGoodByeMessage(ctx, code, id); err != nil {
 		log.WithFields(logrus.Fields{
 			"error": err,
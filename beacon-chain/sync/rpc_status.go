@@ -38,6 +38,7 @@ func (s *Service) maintainPeerStatuses() {
 				// If our peer status has not been updated correctly we disconnect over here
 				// and set the connection state over here instead.
 				if s.cfg.p2p.Host().Network().Connectedness(id) != network.Connected {
+					s.cfg.p2p.Peers().SetDisconnectReason(id, "connection lost")
 					s.cfg.p2p.Peers().SetConnectionState(id, peers.PeerDisconnecting)
 					if err := s.cfg.p2p.Disconnect(id); err != nil {
 						log.WithError(err).Debug("Error when disconnecting with peer")
@@ -172,6 +173,10 @@ func (s *Service) sendRPCStatusRequest(ctx context.Context, id peer.ID) error {
 	if s.cfg.p2p.Peers().IsBad(id) {
 		s.disconnectBadPeer(s.ctx, id)
 	}
+	// A peer with an unverified finalized root is kept, its status records that it was not verified.
+	if errors.Is(err, p2ptypes.ErrUnverifiedFinalizedRoot) {
+		return nil
+	}
 	return err
 }
 
@@ -204,7 +209,8 @@ func (s *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 	s.rateLimiter.add(stream, 1)
 
 	remotePeer := stream.Conn().RemotePeer()
-	if err := s.validateStatusMessage(ctx, m); err != nil {
+	validationErr := s.validateStatusMessage(ctx, m)
+	if err := validationErr; err != nil && !errors.Is(err, p2ptypes.ErrUnverifiedFinalizedRoot) {
 		log.WithFields(logrus.Fields{
 			"peer":  remotePeer,
 			"error": err,
@@ -245,7 +251,7 @@ func (s *Service) statusRPCHandler(ctx context.Context, msg interface{}, stream
 		}
 		return originalErr
 	}
-	s.cfg.p2p.Peers().SetChainState(remotePeer, m)
+	s.cfg.p2p.Peers().Scorers().PeerStatusScorer().SetPeerStatus(remotePeer, m, validationErr)
 
 	if err := s.respondWithStatus(ctx, stream); err != nil {
 		return err
@@ -312,6 +318,13 @@ func (s *Service) validateStatusMessage(ctx context.Context, msg *pb.Status) err
 	if finalizedAtGenesis && rootIsEqual {
 		return nil
 	}
+	// If we were checkpoint synced and backfill has not yet reached the peer's finalized checkpoint, the
+	// checkpoint can only be checked if we have its block, for instance when it is our origin checkpoint block
+	// or a block backfill has already saved. Otherwise we can't tell whether it is part of our chain, so the
+	// peer is neither rejected nor considered to be on our chain.
+	if !s.finalizedHistoryAvailable(msg.FinalizedEpoch) && !s.cfg.beaconDB.HasBlock(ctx, bytesutil.ToBytes32(msg.FinalizedRoot)) {
+		return p2ptypes.ErrUnverifiedFinalizedRoot
+	}
 	if !s.cfg.chain.IsFinalized(ctx, bytesutil.ToBytes32(msg.FinalizedRoot)) {
 		log.WithField("root", fmt.Sprintf("%#x", msg.FinalizedRoot)).Debug("Could not validate finalized root")
 		return p2ptypes.ErrInvalidFinalizedRoot
@@ -350,3 +363,15 @@ func (s *Service) validateStatusMessage(ctx context.Context, msg *pb.Status) err
 	}
 	return p2ptypes.ErrInvalidEpoch
 }
+
+// finalizedHistoryAvailable reports whether our block history covers the start of the given epoch.
+func (s *Service) finalizedHistoryAvailable(epoch primitives.Epoch) bool {
+	if s.availableBlocker == nil {
+		return true
+	}
+	start, err := slots.EpochStart(epoch)
+	if err != nil {
+		return true
+	}
+	return s.availableBlocker.AvailableBlock(start)
+}
@@ -1034,3 +1034,62 @@ func makeBlocks(t *testing.T, i, n uint64, previousRoot [32]byte) []interfaces.R
 	}
 	return ifaceBlocks
 }
+
+func TestStatusRPC_ValidateStatusMessage_FinalizedBeforeBackfill(t *testing.T) {
+	ctx := context.Background()
+	db := testingDB.SetupDB(t)
+	// The node was checkpoint synced from a block in the last slot of epoch 2, whose epoch 3 boundary was skipped.
+	origin := util.NewBeaconBlock()
+	origin.Block.Slot = 3*params.BeaconConfig().SlotsPerEpoch - 1
+	util.SaveBlock(t, ctx, db, origin)
+	originRoot, err := origin.Block.HashTreeRoot()
+	require.NoError(t, err)
+	genesisState, err := transition.GenesisBeaconState(ctx, nil, 0, &ethpb.Eth1Data{})
+	require.NoError(t, err)
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)) * time.Second
+	chain := &mock.ChainService{
+		State:               genesisState,
+		FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 5, Root: bytesutil.PadTo([]byte{'f'}, 32)},
+		FinalizedRoots:      map[[32]byte]bool{originRoot: true},
+		Fork: &ethpb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+		},
+		Genesis:        time.Now().Add(-10 * epochDuration),
+		ValidatorsRoot: [32]byte{'A'},
+	}
+	// Backfill has not started yet, our earliest block is the origin checkpoint block.
+	blocker := &lowSlotBlocker{low: origin.Block.Slot}
+	r := &Service{
+		cfg: &config{
+			beaconDB: db,
+			chain:    chain,
+			clock:    startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+		},
+		availableBlocker: blocker,
+		ctx:              ctx,
+	}
+	digest, err := r.currentForkDigest()
+	require.NoError(t, err)
+
+	unknownRoot := [32]byte{'b'}
+	// The peer's finalized checkpoint is older than our earliest block, so it can be neither confirmed nor rejected.
+	require.ErrorIs(t, r.validateStatusMessage(ctx, &ethpb.Status{
+		ForkDigest:     digest[:],
+		FinalizedRoot:  unknownRoot[:],
+		FinalizedEpoch: 2,
+	}), p2ptypes.ErrUnverifiedFinalizedRoot)
+	// The start of epoch 3 is not covered either, but a checkpoint on our origin checkpoint block is checked.
+	require.NoError(t, r.validateStatusMessage(ctx, &ethpb.Status{
+		ForkDigest:     digest[:],
+		FinalizedRoot:  originRoot[:],
+		FinalizedEpoch: 3,
+	}))
+	// Once backfill has covered the checkpoint, the root is checked against our chain.
+	blocker.setLow(2 * params.BeaconConfig().SlotsPerEpoch)
+	require.ErrorIs(t, r.validateStatusMessage(ctx, &ethpb.Status{
+		ForkDigest:     digest[:],
+		FinalizedRoot:  unknownRoot[:],
+		FinalizedEpoch: 2,
+	}), p2ptypes.ErrInvalidFinalizedRoot)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/async/abool"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -54,6 +55,10 @@ const seenBlockSize = 1000
 const seenBlobSize = seenBlockSize * 4 // Each block can have max 4 blobs. Worst case 164kB for cache.
 const seenUnaggregatedAttSize = 20000
 const seenAggregatedAttSize = 16384
+
+// Aggregators of 16 slots, with 64 committees of 16 aggregators per slot.
+const verifiedSelectionProofSize = 16384
+
 const seenSyncMsgSize = 1000         // Maximum of 512 sync committee members, 1000 is a safe amount.
 const seenSyncContributionSize = 512 // Maximum of SYNC_COMMITTEE_SIZE as specified by the spec.
 const seenExitSize = 100
@@ -96,6 +101,9 @@ type config struct {
 	clock                   *startup.Clock
 	stateNotifier           statefeed.Notifier
 	blobStorage             *filesystem.BlobStorage
+	subnetAttestationStats  *cache.SubnetAttestationStats
+	slotObservations        *cache.SlotObservations
+	validatorLiveness       *cache.ValidatorLiveness
 }
 
 // This defines the interface for interacting with block chain service
@@ -135,6 +143,8 @@ type Service struct {
 	seenBlobCache                    *lru.Cache
 	seenAggregatedAttestationLock    sync.RWMutex
 	seenAggregatedAttestationCache   *lru.Cache
+	verifiedSelectionProofLock       sync.RWMutex
+	verifiedSelectionProofCache      *lru.Cache
 	seenUnAggregatedAttestationLock  sync.RWMutex
 	seenUnAggregatedAttestationCache *lru.Cache
 	seenExitLock                     sync.RWMutex
@@ -149,6 +159,7 @@ type Service struct {
 	seenSyncContributionCache        *lru.Cache
 	badBlockCache                    *lru.Cache
 	badBlockLock                     sync.RWMutex
+	slashedProposers                 slashedProposers
 	syncContributionBitsOverlapLock  sync.RWMutex
 	syncContributionBitsOverlapCache *lru.Cache
 	signatureChan                    chan *signatureVerifier
@@ -158,6 +169,9 @@ type Service struct {
 	newBlobVerifier                  verification.NewBlobVerifier
 	availableBlocker                 coverage.AvailableBlocker
 	ctxMap                           ContextByteVersions
+	earlyArrivals                    *earlyArrivalStats
+	blobProviders                    *BlobProviders
+	blobRecovery                     blobRecovery
 }
 
 // NewService initializes new regular sync service.
@@ -173,6 +187,8 @@ func NewService(ctx context.Context, opts ...Option) *Service {
 		seenPendingBlocks:    make(map[[32]byte]bool),
 		blkRootToPendingAtts: make(map[[32]byte][]ethpb.SignedAggregateAttAndProof),
 		signatureChan:        make(chan *signatureVerifier, verifierLimit),
+		earlyArrivals:        newEarlyArrivalStats(),
+		blobProviders:        NewBlobProviders(),
 	}
 	for _, opt := range opts {
 		if err := opt(r); err != nil {
@@ -239,6 +255,8 @@ func (s *Service) Start() {
 
 	// Update sync metrics.
 	async.RunEvery(s.ctx, syncMetricsInterval, s.updateMetrics)
+	// Summarize gossip messages that arrived before their slot once per epoch.
+	async.RunEvery(s.ctx, slots.MultiplySlotBy(int64(params.BeaconConfig().SlotsPerEpoch)), s.logEarlyArrivals)
 }
 
 // Stop the regular sync service.
@@ -277,6 +295,7 @@ func (s *Service) initCaches() {
 	s.seenBlockCache = lruwrpr.New(seenBlockSize)
 	s.seenBlobCache = lruwrpr.New(seenBlobSize)
 	s.seenAggregatedAttestationCache = lruwrpr.New(seenAggregatedAttSize)
+	s.verifiedSelectionProofCache = lruwrpr.New(verifiedSelectionProofSize)
 	s.seenUnAggregatedAttestationCache = lruwrpr.New(seenUnaggregatedAttSize)
 	s.seenSyncMessageCache = lruwrpr.New(seenSyncMsgSize)
 	s.seenSyncContributionCache = lruwrpr.New(seenSyncContributionSize)
@@ -0,0 +1,103 @@
+package sync
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+var errSlashedProposer = errors.New("block proposer is slashed")
+
+const (
+	slashedInFinalizedState = "finalized_state"
+	slashedInParentState    = "parent_state"
+)
+
+// slashedProposers is the set of validators slashed in the finalized state. A slashing is never undone and every
+// block gossiped must descend from the finalized checkpoint, so the blocks of these validators are invalid on any
+// chain, and can be rejected before their parent state is loaded. Slashings included after the finalized checkpoint
+// may not be on the chain of the block, and are checked against the parent state of the block instead, which is
+// also how slashings of the current epoch are caught while the slashed validator still has proposer duties.
+// Slashings only in the operations pool are not used, as they are not yet included in any chain.
+type slashedProposers struct {
+	lock       sync.RWMutex
+	root       [32]byte
+	slashed    bitfield.Bitlist
+	refreshing atomic.Bool
+}
+
+// isSlashed returns true if the validator is slashed in the finalized state the set was built from.
+func (p *slashedProposers) isSlashed(idx primitives.ValidatorIndex) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.slashed.BitAt(uint64(idx))
+}
+
+// builtFrom returns true if the set was built from the state of the finalized root.
+func (p *slashedProposers) builtFrom(root [32]byte) bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.root == root
+}
+
+// update rebuilds the set from the state of the finalized root.
+func (p *slashedProposers) update(root [32]byte, st state.ReadOnlyBeaconState) error {
+	slashed := bitfield.NewBitlist(uint64(st.NumValidators()))
+	if err := st.ReadFromEveryValidator(func(idx int, val state.ReadOnlyValidator) error {
+		if val.Slashed() {
+			slashed.SetBitAt(uint64(idx), true)
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "could not read validators")
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.root = root
+	p.slashed = slashed
+	return nil
+}
+
+// refreshSlashedProposers rebuilds the set of slashed proposers from the finalized state, once the chain finalized
+// another checkpoint than the one the set was built from. It runs in the background, so that block validation never
+// waits for the finalized state.
+func (s *Service) refreshSlashedProposers(ctx context.Context) {
+	cp := s.cfg.chain.FinalizedCheckpt()
+	if cp == nil {
+		return
+	}
+	root := bytesutil.ToBytes32(cp.Root)
+	if root == params.BeaconConfig().ZeroHash || s.slashedProposers.builtFrom(root) {
+		return
+	}
+	if !s.slashedProposers.refreshing.CompareAndSwap(false, true) {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer s.slashedProposers.refreshing.Store(false)
+		st, err := s.cfg.stateGen.StateByRoot(ctx, root)
+		if err != nil {
+			log.WithError(err).Debug("Could not get finalized state to refresh slashed proposers")
+			return
+		}
+		if err := s.slashedProposers.update(root, st); err != nil {
+			log.WithError(err).Debug("Could not refresh slashed proposers")
+		}
+	}()
+}
+
+// rejectSlashedProposer counts the block rejected because its proposer is slashed, and penalizes the peer which sent
+// it.
+func (s *Service) rejectSlashedProposer(pid peer.ID, source string) {
+	slashedProposerBlockRejections.WithLabelValues(source).Inc()
+	s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+}
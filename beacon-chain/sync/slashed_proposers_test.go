@@ -0,0 +1,199 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	gcache "github.com/patrickmn/go-cache"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestSlashedProposers_Update(t *testing.T) {
+	var p slashedProposers
+	assert.Equal(t, false, p.isSlashed(0))
+
+	st, _ := util.DeterministicGenesisState(t, 16)
+	slashValidator(t, st, 3)
+	root := [32]byte{'a'}
+	require.NoError(t, p.update(root, st))
+	assert.Equal(t, true, p.builtFrom(root))
+	assert.Equal(t, false, p.builtFrom([32]byte{'b'}))
+	for i := primitives.ValidatorIndex(0); i < 16; i++ {
+		assert.Equal(t, i == 3, p.isSlashed(i), "validator %d", i)
+	}
+	// Validators beyond the finalized state are not known to be slashed.
+	assert.Equal(t, false, p.isSlashed(16))
+	assert.Equal(t, false, p.isSlashed(1<<40))
+}
+
+func TestRefreshSlashedProposers(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.SetupDB(t)
+	st, _ := util.DeterministicGenesisState(t, 16)
+	slashValidator(t, st, 5)
+	finalized := util.NewBeaconBlock()
+	finalized.Block.Body.Graffiti = bytesutil.PadTo([]byte("finalized"), 32)
+	util.SaveBlock(t, ctx, db, finalized)
+	root, err := finalized.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, root))
+
+	// Nothing is refreshed before the chain finalized a checkpoint.
+	chainService := &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Root: make([]byte, 32)}}
+	s := &Service{cfg: &config{chain: chainService, stateGen: stategen.New(db, doublylinkedtree.New())}}
+	s.refreshSlashedProposers(ctx)
+	assert.Equal(t, false, s.slashedProposers.refreshing.Load())
+
+	chainService.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 1, Root: root[:]}
+	s.refreshSlashedProposers(ctx)
+	for i := 0; i < 500 && !s.slashedProposers.builtFrom(root); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, true, s.slashedProposers.builtFrom(root))
+	assert.Equal(t, true, s.slashedProposers.isSlashed(5))
+	assert.Equal(t, false, s.slashedProposers.isSlashed(4))
+}
+
+func TestValidateBeaconBlockPubSub_SlashedProposer(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.SetupDB(t)
+	p := p2ptest.NewTestP2P(t)
+	beaconState, privKeys := util.DeterministicGenesisState(t, 100)
+	copied := beaconState.Copy()
+	require.NoError(t, copied.SetSlot(1))
+	proposerIdx, err := helpers.BeaconProposerIndex(ctx, copied)
+	require.NoError(t, err)
+
+	// The slashing of the proposer is included in one of two sibling parents only, in the current epoch, while the
+	// proposer is still active and has proposer duties.
+	saveParent := func(graffiti string, slashed bool) [32]byte {
+		parent := util.NewBeaconBlock()
+		parent.Block.Body.Graffiti = bytesutil.PadTo([]byte(graffiti), 32)
+		util.SaveBlock(t, ctx, db, parent)
+		root, err := parent.Block.HashTreeRoot()
+		require.NoError(t, err)
+		st := beaconState.Copy()
+		if slashed {
+			slashValidator(t, st, proposerIdx)
+		}
+		require.NoError(t, db.SaveState(ctx, st, root))
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Root: root[:]}))
+		return root
+	}
+	slashingParent := saveParent("slashing parent", true)
+	siblingParent := saveParent("sibling parent", false)
+
+	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0),
+		State:               beaconState,
+		FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+		DB:                  db,
+	}
+	newService := func() *Service {
+		return &Service{
+			cfg: &config{
+				beaconDB:      db,
+				p2p:           p,
+				initialSync:   &mockSync.Sync{IsSyncing: false},
+				chain:         chainService,
+				clock:         startup.NewClock(chainService.Genesis, chainService.ValidatorsRoot),
+				blockNotifier: chainService.BlockNotifier(),
+				stateGen:      stategen.New(db, doublylinkedtree.New()),
+			},
+			seenBlockCache:      lruwrpr.New(10),
+			badBlockCache:       lruwrpr.New(10),
+			slotToPendingBlocks: gcache.New(time.Second, 2*time.Second),
+			seenPendingBlocks:   make(map[[32]byte]bool),
+		}
+	}
+	blockMessage := func(r *Service, parentRoot [32]byte, graffiti string) (*pubsub.Message, [32]byte) {
+		msg := util.NewBeaconBlock()
+		msg.Block.ParentRoot = parentRoot[:]
+		msg.Block.Slot = 1
+		msg.Block.ProposerIndex = proposerIdx
+		msg.Block.Body.Graffiti = bytesutil.PadTo([]byte(graffiti), 32)
+		msg.Signature, err = signing.ComputeDomainAndSign(beaconState, 0, msg.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
+		require.NoError(t, err)
+		root, err := msg.Block.HashTreeRoot()
+		require.NoError(t, err)
+		buf := new(bytes.Buffer)
+		_, err = p.Encoding().EncodeGossip(buf, msg)
+		require.NoError(t, err)
+		topic := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
+		digest, err := r.currentForkDigest()
+		require.NoError(t, err)
+		topic = r.addDigestToTopic(topic, digest)
+		return &pubsub.Message{Message: &pubsubpb.Message{Data: buf.Bytes(), Topic: &topic}}, root
+	}
+	badResponses := func(pid peer.ID) int {
+		count, err := p.Peers().Scorers().BadResponsesScorer().Count(pid)
+		if err != nil {
+			return 0
+		}
+		return count
+	}
+
+	t.Run("slashed in parent state", func(t *testing.T) {
+		r := newService()
+		m, root := blockMessage(r, slashingParent, "on slashing parent")
+		res, err := r.validateBeaconBlockPubSub(ctx, "parent-state-peer", m)
+		require.ErrorIs(t, err, errSlashedProposer)
+		assert.Equal(t, pubsub.ValidationReject, res)
+		assert.Equal(t, true, r.hasBadBlock(root))
+		assert.Equal(t, 1, badResponses("parent-state-peer"))
+	})
+
+	t.Run("not slashed on the sibling chain", func(t *testing.T) {
+		r := newService()
+		m, _ := blockMessage(r, siblingParent, "on sibling parent")
+		res, err := r.validateBeaconBlockPubSub(ctx, "sibling-peer", m)
+		require.NoError(t, err)
+		assert.Equal(t, pubsub.ValidationAccept, res)
+		assert.Equal(t, 0, badResponses("sibling-peer"))
+	})
+
+	t.Run("slashed in finalized state", func(t *testing.T) {
+		r := newService()
+		finalized := beaconState.Copy()
+		slashValidator(t, finalized, proposerIdx)
+		require.NoError(t, r.slashedProposers.update([32]byte{'f'}, finalized))
+		// The block is rejected without loading its parent state, even on a chain where the slashing is unknown.
+		m, root := blockMessage(r, siblingParent, "after finalization")
+		res, err := r.validateBeaconBlockPubSub(ctx, "finalized-peer", m)
+		require.ErrorIs(t, err, errSlashedProposer)
+		require.ErrorContains(t, "finalized state", err)
+		assert.Equal(t, pubsub.ValidationReject, res)
+		assert.Equal(t, true, r.hasBadBlock(root))
+		assert.Equal(t, 1, badResponses("finalized-peer"))
+	})
+}
+
+func slashValidator(t *testing.T, st state.BeaconState, idx primitives.ValidatorIndex) {
+	val, err := st.ValidatorAtIndex(idx)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(idx, val))
+}
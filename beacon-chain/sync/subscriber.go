@@ -144,6 +144,15 @@ func (s *Service) registerSubscribers(epoch primitives.Epoch, digest [4]byte) {
 			digest,
 			params.BeaconConfig().BlobsidecarSubnetCount,
 		)
+		if custody := s.cfg.p2p.CustodySubnets(); len(custody) > 0 {
+			s.subscribeToSubnets(
+				p2p.DataColumnSubnetTopicFormat,
+				s.validateDataColumn,   /* validator */
+				s.dataColumnSubscriber, /* message handler */
+				digest,
+				sortedSubnets(custody),
+			)
+		}
 	}
 }
 
@@ -285,11 +294,19 @@ func (s *Service) wrapAndReportValidation(topic string, v wrappedVal) (string, p
 			log.WithField("topic", topic).Debugf("Received message from outdated fork digest %#x", retDigest)
 			return pubsub.ValidationIgnore
 		}
+		if ctx.Err() != nil {
+			// The message waited for validation past its deadline.
+			messageAbandonedValidationCounter.WithLabelValues(topic).Inc()
+			messageIgnoredValidationCounter.WithLabelValues(topic).Inc()
+			return pubsub.ValidationIgnore
+		}
 		b, err := v(ctx, pid, msg)
 		// We do not penalize peers if we are hitting pubsub timeouts
-		// trying to process those messages.
-		if b == pubsub.ValidationReject && ctx.Err() != nil {
+		// trying to process those messages, and messages whose validation
+		// deadline passed are dropped rather than waited for.
+		if b != pubsub.ValidationAccept && (ctx.Err() != nil || errors.Is(err, context.DeadlineExceeded)) {
 			b = pubsub.ValidationIgnore
+			messageAbandonedValidationCounter.WithLabelValues(topic).Inc()
 		}
 		if b == pubsub.ValidationReject {
 			fields := logrus.Fields{
@@ -335,9 +352,20 @@ func (s *Service) subscribeStaticWithSubnets(topic string, validator wrappedVal,
 		// Impossible condition as it would mean topic does not exist.
 		panic(fmt.Sprintf("%s is not mapped to any message in GossipTopicMappings", topic))
 	}
-	for i := uint64(0); i < subnetCount; i++ {
+	subnets := make([]uint64, subnetCount)
+	for i := range subnets {
+		subnets[i] = uint64(i)
+	}
+	s.subscribeToSubnets(topic, validator, handle, digest, subnets)
+}
+
+// subscribeToSubnets subscribes to the given subnets of the topic until the digest is no longer valid, searching
+// the network for peers of the subnets lacking them every slot.
+func (s *Service) subscribeToSubnets(topic string, validator wrappedVal, handle subHandler, digest [4]byte, subnets []uint64) {
+	for _, i := range subnets {
 		s.subscribeWithBase(s.addDigestAndIndexToTopic(topic, digest, i), validator, handle)
 	}
+	genRoot := s.cfg.clock.GenesisValidatorsRoot()
 	genesis := s.cfg.clock.GenesisTime()
 	ticker := slots.NewSlotTicker(genesis, params.BeaconConfig().SecondsPerSlot)
 
@@ -359,7 +387,7 @@ func (s *Service) subscribeStaticWithSubnets(topic string, validator wrappedVal,
 				if !valid {
 					log.Warnf("Attestation subnets with digest %#x are no longer valid, unsubscribing from all of them.", digest)
 					// Unsubscribes from all our current subnets.
-					for i := uint64(0); i < subnetCount; i++ {
+					for _, i := range subnets {
 						fullTopic := fmt.Sprintf(topic, digest, i) + s.cfg.p2p.Encoding().ProtocolSuffix()
 						s.unSubscribeFromTopic(fullTopic)
 					}
@@ -367,7 +395,7 @@ func (s *Service) subscribeStaticWithSubnets(topic string, validator wrappedVal,
 					return
 				}
 				// Check every slot that there are enough peers
-				for i := uint64(0); i < subnetCount; i++ {
+				for _, i := range subnets {
 					if !s.validPeersExist(s.addDigestAndIndexToTopic(topic, digest, i)) {
 						log.Debugf("No peers found subscribed to attestation gossip subnet with "+
 							"committee index %d. Searching network for peers subscribed to the subnet.", i)
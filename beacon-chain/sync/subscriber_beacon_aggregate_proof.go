@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations/kv"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"google.golang.org/protobuf/proto"
 )
@@ -26,8 +27,12 @@ func (s *Service) beaconAggregateProofSubscriber(_ context.Context, msg proto.Me
 
 	// An unaggregated attestation can make it here. It’s valid, the aggregator it just itself, although it means poor performance for the subnet.
 	if !helpers.IsAggregated(aggregate) {
-		return s.cfg.attPool.SaveUnaggregatedAttestation(aggregate)
+		if err := s.cfg.attPool.SaveUnaggregatedAttestation(aggregate); err != nil {
+			return err
+		}
+	} else if err := s.cfg.attPool.SaveAggregatedAttestation(aggregate); err != nil {
+		return err
 	}
 
-	return s.cfg.attPool.SaveAggregatedAttestation(aggregate)
+	return s.cfg.attPool.SaveAttestationProvenance(aggregate, kv.SourceGossipAggregate, a.AggregateAttestationAndProof().GetAggregatorIndex())
 }
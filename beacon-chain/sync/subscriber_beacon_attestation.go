@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations/kv"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/slice"
@@ -39,7 +40,10 @@ func (s *Service) committeeIndexBeaconAttestationSubscriber(_ context.Context, m
 		return nil
 	}
 
-	return s.cfg.attPool.SaveUnaggregatedAttestation(a)
+	if err := s.cfg.attPool.SaveUnaggregatedAttestation(a); err != nil {
+		return err
+	}
+	return s.cfg.attPool.SaveAttestationProvenance(a, kv.SourceGossipUnaggregated, 0)
 }
 
 func (*Service) persistentSubnetIndices() []uint64 {
@@ -133,7 +133,7 @@ func TestService_BeaconBlockSubscribe_UndefinedEeError(t *testing.T) {
 	require.Equal(t, 1, len(s.seenBlockCache.Keys()))
 }
 
-func TestReconstructAndBroadcastBlobs(t *testing.T) {
+func TestFetchFromExecution(t *testing.T) {
 	rob, err := blocks.NewROBlob(
 		&ethpb.BlobSidecar{
 			SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{
@@ -189,7 +189,11 @@ func TestReconstructAndBroadcastBlobs(t *testing.T) {
 				},
 				seenBlobCache: lruwrpr.New(1),
 			}
-			s.reconstructAndBroadcastBlobs(context.Background(), sb)
+			root, err := sb.Block().HashTreeRoot()
+			require.NoError(t, err)
+			stored, err := s.blobFetcher().FetchFromExecution(context.Background(), sb, root)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedBlobCount, stored)
 			require.Equal(t, tt.expectedBlobCount, len(chainService.Blobs))
 		})
 	}
@@ -16,7 +16,11 @@ func (s *Service) blobSubscriber(ctx context.Context, msg proto.Message) error {
 		return fmt.Errorf("message was not type blocks.ROBlob, type=%T", msg)
 	}
 
-	return s.subscribeBlob(ctx, b)
+	if err := s.subscribeBlob(ctx, b); err != nil {
+		return err
+	}
+	blobSidecarsObtainedTotal.WithLabelValues(blobSourceGossip).Inc()
+	return nil
 }
 
 func (s *Service) subscribeBlob(ctx context.Context, b blocks.VerifiedROBlob) error {
@@ -0,0 +1,25 @@
+package sync
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// dataColumnSubscriber does nothing as long as data column sidecars are ignored by the validator of the topic.
+func (s *Service) dataColumnSubscriber(_ context.Context, _ proto.Message) error {
+	return nil
+}
+
+// sortedSubnets returns the subnets of the set in ascending order.
+func sortedSubnets(subnets map[uint64]bool) []uint64 {
+	sorted := make([]uint64, 0, len(subnets))
+	for subnet := range subnets {
+		sorted = append(sorted, subnet)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	return sorted
+}
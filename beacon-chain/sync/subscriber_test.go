@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -343,6 +344,38 @@ func TestStaticSubnets(t *testing.T) {
 	cancel()
 }
 
+func TestSubscribeToSubnets_DataColumns(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	p.Custody = map[uint64]bool{3: true, 17: true, 100: true}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chain := &mockChain.ChainService{
+		Genesis:        time.Now(),
+		ValidatorsRoot: [32]byte{'A'},
+	}
+	r := Service{
+		ctx: ctx,
+		cfg: &config{
+			chain: chain,
+			clock: startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+			p2p:   p,
+		},
+		chainStarted: abool.New(),
+		subHandler:   newSubTopicHandler(),
+	}
+	d, err := r.currentForkDigest()
+	require.NoError(t, err)
+	r.subscribeToSubnets(p2p.DataColumnSubnetTopicFormat, r.validateDataColumn, r.dataColumnSubscriber, d, sortedSubnets(p.Custody))
+	topics := r.cfg.p2p.PubSub().GetTopics()
+	sort.Strings(topics)
+	suffix := r.cfg.p2p.Encoding().ProtocolSuffix()
+	require.DeepEqual(t, []string{
+		fmt.Sprintf(p2p.DataColumnSubnetTopicFormat, d, 100) + suffix,
+		fmt.Sprintf(p2p.DataColumnSubnetTopicFormat, d, 17) + suffix,
+		fmt.Sprintf(p2p.DataColumnSubnetTopicFormat, d, 3) + suffix,
+	}, topics)
+}
+
 func Test_wrapAndReportValidation(t *testing.T) {
 	mChain := &mockChain.ChainService{
 		Genesis:        time.Now(),
@@ -420,6 +453,25 @@ func Test_wrapAndReportValidation(t *testing.T) {
 			},
 			want: pubsub.ValidationAccept,
 		},
+		{
+			name: "validation deadline passed",
+			args: args{
+				topic: mockTopic,
+				v: func(ctx context.Context, id peer.ID, message *pubsub.Message) (pubsub.ValidationResult, error) {
+					return pubsub.ValidationReject, fmt.Errorf("could not get state: %w", context.DeadlineExceeded)
+				},
+				chainstarted: true,
+				msg: &pubsub.Message{
+					Message: &pubsubpb.Message{
+						Topic: func() *string {
+							s := mockTopic
+							return &s
+						}(),
+					},
+				},
+			},
+			want: pubsub.ValidationIgnore,
+		},
 		{
 			name: "nil topic",
 			args: args{
@@ -1,6 +1,7 @@
 package sync
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -26,6 +27,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
+var (
+	errNotAggregator         = errors.New("validator is not an aggregator")
+	errInvalidSelectionProof = errors.New("invalid selection proof")
+)
+
 // validateAggregateAndProof verifies the aggregated signature and the selection proof is valid before forwarding to the
 // network and downstream services.
 func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
@@ -88,6 +94,7 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 
 	// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE and early attestation
 	// processing tolerance.
+	s.recordGossipArrival(aggregateArrivalTopic, data.Slot, receivedTime, pid)
 	if err := helpers.ValidateAttestationTime(
 		data.Slot,
 		s.cfg.clock.GenesisTime(),
@@ -96,6 +103,8 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		tracing.AnnotateError(span, err)
 		return pubsub.ValidationIgnore, err
 	}
+	ctx, cancel := s.withValidationDeadline(ctx, data.Slot, params.BeaconConfig().AttestationPropagationSlotRange)
+	defer cancel()
 
 	// Verify this is the first aggregate received from the aggregator with index and slot.
 	if s.hasSeenAggregatorIndexEpoch(data.Target.Epoch, m.AggregateAttestationAndProof().GetAggregatorIndex()) {
@@ -106,6 +115,7 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		s.hasBadBlock(bytesutil.ToBytes32(data.Target.Root)) ||
 		s.hasBadBlock(bytesutil.ToBytes32(data.Source.Root)) {
 		attBadBlockCount.Inc()
+		aggregateRejectionCount.WithLabelValues("bad_block").Inc()
 		return pubsub.ValidationReject, errors.New("bad block referenced in attestation data")
 	}
 
@@ -124,10 +134,17 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 
 	validationRes, err := s.validateAggregatedAtt(ctx, m)
 	if validationRes != pubsub.ValidationAccept {
+		if errors.Is(err, errNotAggregator) || errors.Is(err, errInvalidSelectionProof) {
+			// Peers only forward aggregates which passed their own validation, so this cannot be an honest mistake.
+			s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		}
 		return validationRes, err
 	}
 
 	s.setAggregatorIndexEpochSeen(data.Target.Epoch, m.AggregateAttestationAndProof().GetAggregatorIndex())
+	if s.cfg.validatorLiveness != nil {
+		s.cfg.validatorLiveness.MarkLive(data.Target.Epoch, m.AggregateAttestationAndProof().GetAggregatorIndex())
+	}
 
 	msg.ValidatorData = m
 
@@ -152,6 +169,7 @@ func (s *Service) validateAggregatedAtt(ctx context.Context, signed ethpb.Signed
 	if err := s.cfg.chain.VerifyLmdFfgConsistency(ctx, aggregate); err != nil {
 		tracing.AnnotateError(span, err)
 		attBadLmdConsistencyCount.Inc()
+		aggregateRejectionCount.WithLabelValues("lmd_ffg_inconsistency").Inc()
 		return pubsub.ValidationReject, err
 	}
 
@@ -184,26 +202,19 @@ func (s *Service) validateAggregatedAtt(ctx context.Context, signed ethpb.Signed
 	if result != pubsub.ValidationAccept {
 		wrappedErr := errors.Wrapf(err, "could not validate index in committee")
 		tracing.AnnotateError(span, wrappedErr)
+		aggregateRejectionCount.WithLabelValues("aggregator_not_in_committee").Inc()
 		return result, wrappedErr
 	}
 
 	// Verify selection proof reflects to the right validator.
-	selectionSigSet, err := validateSelectionIndex(
-		ctx,
-		bs,
-		data.Slot,
-		committee,
-		aggregatorIndex,
-		aggregateAndProof.GetSelectionProof(),
-	)
-	if err != nil {
-		wrappedErr := errors.Wrapf(err, "could not validate selection for validator %d", aggregateAndProof.GetAggregatorIndex())
+	result, err = s.validateSelectionProof(ctx, bs, data.Slot, committee, aggregatorIndex, aggregateAndProof.GetSelectionProof())
+	if result != pubsub.ValidationAccept {
+		wrappedErr := errors.Wrapf(err, "could not validate selection for validator %d", aggregatorIndex)
 		tracing.AnnotateError(span, wrappedErr)
-		attBadSelectionProofCount.Inc()
-		return pubsub.ValidationReject, wrappedErr
+		return result, wrappedErr
 	}
 
-	// Verify selection signature, aggregator signature and attestation signature are valid.
+	// Verify aggregator signature and attestation signature are valid.
 	// We use batch verify here to save compute.
 	aggregatorSigSet, err := aggSigSet(bs, signed)
 	if err != nil {
@@ -218,9 +229,79 @@ func (s *Service) validateAggregatedAtt(ctx context.Context, signed ethpb.Signed
 		return pubsub.ValidationIgnore, wrappedErr
 	}
 	set := bls.NewSet()
-	set.Join(selectionSigSet).Join(aggregatorSigSet).Join(attSigSet)
+	set.Join(aggregatorSigSet).Join(attSigSet)
+
+	result, err = s.validateWithBatchVerifier(ctx, "aggregate", set)
+	if result == pubsub.ValidationReject {
+		aggregateRejectionCount.WithLabelValues("invalid_signature").Inc()
+	}
+	return result, err
+}
+
+// validateSelectionProof checks that the validator is an aggregator for the slot and that its selection proof is valid.
+// The selection proof is verified on its own instead of being batched with the other signatures of the aggregate, so
+// that an invalid proof is rejected before any other signature is checked and can be told apart from other failures.
+// Valid proofs are cached, which saves the verification when aggregates of the same aggregator and slot are validated
+// again, e.g. aggregates for several block roots of the slot coming back from the pending attestations queue. The cache is keyed by the dependent root of the attester shuffling, as a reorg changing the
+// shuffling changes the committee, and so whether the validator is an aggregator.
+func (s *Service) validateSelectionProof(
+	ctx context.Context,
+	bs state.ReadOnlyBeaconState,
+	slot primitives.Slot,
+	committee []primitives.ValidatorIndex,
+	validatorIndex primitives.ValidatorIndex,
+	proof []byte,
+) (pubsub.ValidationResult, error) {
+	ctx, span := trace.StartSpan(ctx, "sync.validateSelectionProof")
+	defer span.End()
+
+	dependentRoot, err := attesterDependentRoot(bs, slots.ToEpoch(slot))
+	if err != nil {
+		return pubsub.ValidationIgnore, err
+	}
+	if s.hasVerifiedSelectionProof(slot, validatorIndex, dependentRoot, proof) {
+		return pubsub.ValidationAccept, nil
+	}
 
-	return s.validateWithBatchVerifier(ctx, "aggregate", set)
+	set, err := validateSelectionIndex(ctx, bs, slot, committee, validatorIndex, proof)
+	if err != nil {
+		attBadSelectionProofCount.Inc()
+		if errors.Is(err, errNotAggregator) {
+			aggregateRejectionCount.WithLabelValues("not_aggregator").Inc()
+		} else {
+			aggregateRejectionCount.WithLabelValues("invalid_selection_proof").Inc()
+		}
+		return pubsub.ValidationReject, err
+	}
+	verified, err := set.Verify()
+	if err != nil || !verified {
+		attBadSelectionProofCount.Inc()
+		aggregateRejectionCount.WithLabelValues("invalid_selection_proof").Inc()
+		if err != nil {
+			return pubsub.ValidationReject, fmt.Errorf("%w: %v", errInvalidSelectionProof, err)
+		}
+		return pubsub.ValidationReject, errInvalidSelectionProof
+	}
+	s.setVerifiedSelectionProof(slot, validatorIndex, dependentRoot, proof)
+	return pubsub.ValidationAccept, nil
+}
+
+// attesterDependentRoot returns the root of the block the attester shuffling of the epoch depends on, which is
+// get_block_root_at_slot(state, compute_start_slot_at_epoch(epoch - 1) - 1). The shuffling of the first two epochs
+// only depends on the genesis state, for which the zero root is returned.
+func attesterDependentRoot(st state.ReadOnlyBeaconState, epoch primitives.Epoch) ([32]byte, error) {
+	if epoch <= 1 {
+		return [32]byte{}, nil
+	}
+	prevEpochStart, err := slots.EpochStart(epoch - 1)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	root, err := helpers.BlockRootAtSlot(st, prevEpochStart-1)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not get dependent root")
+	}
+	return bytesutil.ToBytes32(root), nil
 }
 
 func (s *Service) validateBlockInAttestation(ctx context.Context, satt ethpb.SignedAggregateAttAndProof) bool {
@@ -251,6 +332,30 @@ func (s *Service) setAggregatorIndexEpochSeen(epoch primitives.Epoch, aggregator
 	s.seenAggregatedAttestationCache.Add(string(b), true)
 }
 
+// Returns true if the selection proof of the aggregator with index for the slot was verified under the shuffling of the dependent root.
+func (s *Service) hasVerifiedSelectionProof(slot primitives.Slot, aggregatorIndex primitives.ValidatorIndex, dependentRoot [32]byte, proof []byte) bool {
+	s.verifiedSelectionProofLock.RLock()
+	defer s.verifiedSelectionProofLock.RUnlock()
+	v, seen := s.verifiedSelectionProofCache.Get(selectionProofKey(slot, aggregatorIndex, dependentRoot))
+	if !seen {
+		return false
+	}
+	verified, ok := v.([]byte)
+	return ok && bytes.Equal(verified, proof)
+}
+
+// Set the selection proof of the aggregator with index for the slot as verified under the shuffling of the dependent root.
+func (s *Service) setVerifiedSelectionProof(slot primitives.Slot, aggregatorIndex primitives.ValidatorIndex, dependentRoot [32]byte, proof []byte) {
+	s.verifiedSelectionProofLock.Lock()
+	defer s.verifiedSelectionProofLock.Unlock()
+	s.verifiedSelectionProofCache.Add(selectionProofKey(slot, aggregatorIndex, dependentRoot), bytesutil.SafeCopyBytes(proof))
+}
+
+func selectionProofKey(slot primitives.Slot, aggregatorIndex primitives.ValidatorIndex, dependentRoot [32]byte) string {
+	b := append(bytesutil.Bytes8(uint64(slot)), bytesutil.Bytes8(uint64(aggregatorIndex))...)
+	return string(append(b, dependentRoot[:]...))
+}
+
 // This validates the bitfield is correct and aggregator's index in state is within the beacon committee.
 // It implements the following checks from the consensus spec:
 //   - [REJECT] The committee index is within the expected range -- i.e. `aggregate.data.index < get_committee_count_per_slot(state, aggregate.data.target.epoch)`.
@@ -299,7 +404,7 @@ func validateSelectionIndex(
 		return nil, err
 	}
 	if !aggregator {
-		return nil, fmt.Errorf("validator is not an aggregator for slot %d", slot)
+		return nil, fmt.Errorf("%w for slot %d", errNotAggregator, slot)
 	}
 
 	domain := params.BeaconConfig().DomainSelectionProof
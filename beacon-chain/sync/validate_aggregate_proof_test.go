@@ -748,3 +748,192 @@ func TestValidateAggregateAndProof_RejectWhenAttEpochDoesntEqualTargetEpoch(t *t
 	assert.NotNil(t, err)
 	assert.Equal(t, pubsub.ValidationReject, res)
 }
+
+func TestValidateAggregatedAtt_CachesSelectionProof(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	p := p2ptest.NewTestP2P(t)
+
+	validators := uint64(256)
+	beaconState, privKeys := util.DeterministicGenesisState(t, validators)
+	require.NoError(t, beaconState.SetGenesisTime(uint64(time.Now().Unix())))
+
+	// The aggregator sends aggregates for two different block roots of the same slot.
+	b1 := util.NewBeaconBlock()
+	util.SaveBlock(t, context.Background(), db, b1)
+	root1, err := b1.Block.HashTreeRoot()
+	require.NoError(t, err)
+	b2 := util.NewBeaconBlock()
+	b2.Block.Body.Graffiti = bytesutil.PadTo([]byte("other"), 32)
+	util.SaveBlock(t, context.Background(), db, b2)
+	root2, err := b2.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), beaconState, 1, 0)
+	require.NoError(t, err)
+	ai := committee[0]
+	sszUint := primitives.SSZUint64(1)
+	proof, err := signing.ComputeDomainAndSign(beaconState, 0, &sszUint, params.BeaconConfig().DomainSelectionProof, privKeys[ai])
+	require.NoError(t, err)
+
+	signedAggregate := func(root [32]byte) *ethpb.SignedAggregateAttestationAndProof {
+		aggBits := bitfield.NewBitlist(validators / uint64(params.BeaconConfig().SlotsPerEpoch))
+		aggBits.SetBitAt(0, true)
+		att := &ethpb.Attestation{
+			Data: &ethpb.AttestationData{
+				Slot:            1,
+				BeaconBlockRoot: root[:],
+				Source:          &ethpb.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte("hello-world"), 32)},
+				Target:          &ethpb.Checkpoint{Epoch: 0, Root: root[:]},
+			},
+			AggregationBits: aggBits,
+		}
+		attesterDomain, err := signing.Domain(beaconState.Fork(), 0, params.BeaconConfig().DomainBeaconAttester, beaconState.GenesisValidatorsRoot())
+		require.NoError(t, err)
+		signingRoot, err := signing.ComputeSigningRoot(att.Data, attesterDomain)
+		require.NoError(t, err)
+		att.Signature = privKeys[committee[0]].Sign(signingRoot[:]).Marshal()
+		signed := &ethpb.SignedAggregateAttestationAndProof{Message: &ethpb.AggregateAttestationAndProof{
+			SelectionProof:  proof,
+			Aggregate:       att,
+			AggregatorIndex: ai,
+		}}
+		signed.Signature, err = signing.ComputeDomainAndSign(beaconState, 0, signed.Message, params.BeaconConfig().DomainAggregateAndProof, privKeys[ai])
+		require.NoError(t, err)
+		return signed
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chain := &mock.ChainService{Genesis: time.Now().Add(-oneEpoch()),
+		DB:               db,
+		State:            beaconState,
+		ValidAttestation: true,
+		FinalizedCheckPoint: &ethpb.Checkpoint{
+			Epoch: 0,
+			Root:  root1[:],
+		}}
+	r := &Service{
+		ctx: ctx,
+		cfg: &config{
+			p2p:                 p,
+			beaconDB:            db,
+			initialSync:         &mockSync.Sync{IsSyncing: false},
+			chain:               chain,
+			clock:               startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+			attPool:             attestations.NewPool(),
+			attestationNotifier: (&mock.ChainService{}).OperationNotifier(),
+		},
+		signatureChan: make(chan *signatureVerifier, verifierLimit),
+	}
+	r.initCaches()
+	go r.verifierRoutine()
+
+	assert.Equal(t, false, r.hasVerifiedSelectionProof(1, ai, [32]byte{}, proof))
+	res, err := r.validateAggregatedAtt(ctx, signedAggregate(root1))
+	require.NoError(t, err)
+	require.Equal(t, pubsub.ValidationAccept, res)
+	assert.Equal(t, true, r.hasVerifiedSelectionProof(1, ai, [32]byte{}, proof))
+	// The proof is only cached for the shuffling it was verified under.
+	assert.Equal(t, false, r.hasVerifiedSelectionProof(1, ai, [32]byte{'a'}, proof))
+
+	res, err = r.validateAggregatedAtt(ctx, signedAggregate(root2))
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, res)
+}
+
+func TestValidateAggregateAndProof_InvalidSelectionProofPenalizesPeer(t *testing.T) {
+	db := dbtest.SetupDB(t)
+	p := p2ptest.NewTestP2P(t)
+
+	validators := uint64(256)
+	beaconState, privKeys := util.DeterministicGenesisState(t, validators)
+
+	b := util.NewBeaconBlock()
+	util.SaveBlock(t, context.Background(), db, b)
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	s, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(context.Background(), s, root))
+
+	aggBits := bitfield.NewBitlist(validators / uint64(params.BeaconConfig().SlotsPerEpoch))
+	aggBits.SetBitAt(0, true)
+	att := &ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			Slot:            1,
+			BeaconBlockRoot: root[:],
+			Source:          &ethpb.Checkpoint{Epoch: 0, Root: bytesutil.PadTo([]byte("hello-world"), 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 0, Root: root[:]},
+		},
+		AggregationBits: aggBits,
+	}
+	committee, err := helpers.BeaconCommitteeFromState(context.Background(), beaconState, att.Data.Slot, att.Data.CommitteeIndex)
+	require.NoError(t, err)
+	attesterDomain, err := signing.Domain(beaconState.Fork(), 0, params.BeaconConfig().DomainBeaconAttester, beaconState.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	signingRoot, err := signing.ComputeSigningRoot(att.Data, attesterDomain)
+	require.NoError(t, err)
+	att.Signature = privKeys[committee[0]].Sign(signingRoot[:]).Marshal()
+
+	ai := committee[0]
+	sszUint := primitives.SSZUint64(att.Data.Slot)
+	// The selection proof is signed with the key of another validator.
+	proof, err := signing.ComputeDomainAndSign(beaconState, 0, &sszUint, params.BeaconConfig().DomainSelectionProof, privKeys[ai+1])
+	require.NoError(t, err)
+	signedAggregateAndProof := &ethpb.SignedAggregateAttestationAndProof{Message: &ethpb.AggregateAttestationAndProof{
+		SelectionProof:  proof,
+		Aggregate:       att,
+		AggregatorIndex: ai,
+	}}
+	signedAggregateAndProof.Signature, err = signing.ComputeDomainAndSign(beaconState, 0, signedAggregateAndProof.Message, params.BeaconConfig().DomainAggregateAndProof, privKeys[ai])
+	require.NoError(t, err)
+
+	require.NoError(t, beaconState.SetGenesisTime(uint64(time.Now().Unix())))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	chain := &mock.ChainService{Genesis: time.Now().Add(-oneEpoch()),
+		DB:               db,
+		State:            beaconState,
+		ValidAttestation: true,
+		FinalizedCheckPoint: &ethpb.Checkpoint{
+			Epoch: 0,
+			Root:  att.Data.BeaconBlockRoot,
+		}}
+	r := &Service{
+		ctx: ctx,
+		cfg: &config{
+			p2p:                 p,
+			beaconDB:            db,
+			initialSync:         &mockSync.Sync{IsSyncing: false},
+			chain:               chain,
+			clock:               startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+			attPool:             attestations.NewPool(),
+			attestationNotifier: (&mock.ChainService{}).OperationNotifier(),
+		},
+		signatureChan: make(chan *signatureVerifier, verifierLimit),
+	}
+	r.initCaches()
+	go r.verifierRoutine()
+
+	buf := new(bytes.Buffer)
+	_, err = p.Encoding().EncodeGossip(buf, signedAggregateAndProof)
+	require.NoError(t, err)
+	topic := p2p.GossipTypeMapping[reflect.TypeOf(signedAggregateAndProof)]
+	d, err := r.currentForkDigest()
+	require.NoError(t, err)
+	topic = r.addDigestToTopic(topic, d)
+	msg := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  buf.Bytes(),
+			Topic: &topic,
+		},
+	}
+
+	res, err := r.validateAggregateAndProof(context.Background(), "peer1", msg)
+	require.ErrorIs(t, err, errInvalidSelectionProof)
+	assert.Equal(t, pubsub.ValidationReject, res)
+	assert.Equal(t, false, r.hasVerifiedSelectionProof(att.Data.Slot, ai, [32]byte{}, proof))
+	count, err := p.Peers().Scorers().BadResponsesScorer().Count("peer1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
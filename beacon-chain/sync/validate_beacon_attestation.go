@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
@@ -26,6 +27,7 @@ import (
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
@@ -83,11 +85,14 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 
 	// Attestation's slot is within ATTESTATION_PROPAGATION_SLOT_RANGE and early attestation
 	// processing tolerance.
+	s.recordGossipArrival(attestationArrivalTopic, data.Slot, prysmTime.Now(), pid)
 	if err := helpers.ValidateAttestationTime(data.Slot, s.cfg.clock.GenesisTime(),
 		earlyAttestationProcessingTolerance); err != nil {
 		tracing.AnnotateError(span, err)
 		return pubsub.ValidationIgnore, err
 	}
+	ctx, cancel := s.withValidationDeadline(ctx, data.Slot, params.BeaconConfig().AttestationPropagationSlotRange)
+	defer cancel()
 	if err := helpers.ValidateSlotTargetEpoch(data); err != nil {
 		return pubsub.ValidationReject, err
 	}
@@ -194,6 +199,8 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	}
 
 	s.setSeenCommitteeIndicesSlot(data.Slot, committeeIndex, att.GetAggregationBits())
+	s.recordSubnetAttestation(ctx, att, preState, committeeIndex)
+	s.recordAttesterLiveness(ctx, att, preState, committeeIndex)
 
 	msg.ValidatorData = att
 
@@ -321,6 +328,52 @@ func (s *Service) setSeenCommitteeIndicesSlot(slot primitives.Slot, committeeID
 	s.seenUnAggregatedAttestationCache.Add(string(b), true)
 }
 
+// recordSubnetAttestation updates the per-subnet delivery statistics with an accepted unaggregated attestation.
+// The committee and active validator count are served from the committee cache, as they were already computed during validation.
+func (s *Service) recordSubnetAttestation(ctx context.Context, a eth.Att, bs state.ReadOnlyBeaconState, committeeIndex primitives.CommitteeIndex) {
+	if s.cfg.subnetAttestationStats == nil {
+		return
+	}
+	data := a.GetData()
+	bits := a.GetAggregationBits().BitIndices()
+	if len(bits) != 1 {
+		return
+	}
+	valCount, err := helpers.ActiveValidatorCount(ctx, bs, slots.ToEpoch(data.Slot))
+	if err != nil {
+		log.WithError(err).Debug("Could not compute active validator count for subnet stats")
+		return
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, bs, data.Slot, committeeIndex)
+	if err != nil {
+		log.WithError(err).Debug("Could not compute committee for subnet stats")
+		return
+	}
+	subnet := helpers.ComputeSubnetForAttestation(valCount, a)
+	s.cfg.subnetAttestationStats.RecordUnaggregated(data.Slot, subnet, uint64(len(committee)), uint64(bits[0]))
+}
+
+// recordAttesterLiveness marks the attester of an accepted unaggregated attestation as live in the attestation's target epoch.
+func (s *Service) recordAttesterLiveness(ctx context.Context, a eth.Att, bs state.ReadOnlyBeaconState, committeeIndex primitives.CommitteeIndex) {
+	if s.cfg.validatorLiveness == nil {
+		return
+	}
+	data := a.GetData()
+	bits := a.GetAggregationBits().BitIndices()
+	if len(bits) != 1 {
+		return
+	}
+	committee, err := helpers.BeaconCommitteeFromState(ctx, bs, data.Slot, committeeIndex)
+	if err != nil {
+		log.WithError(err).Debug("Could not compute committee for validator liveness")
+		return
+	}
+	if bits[0] >= len(committee) {
+		return
+	}
+	s.cfg.validatorLiveness.MarkLive(data.Target.Epoch, committee[bits[0]])
+}
+
 // hasBlockAndState returns true if the beacon node knows about a block and associated state in the
 // database or cache.
 func (s *Service) hasBlockAndState(ctx context.Context, blockRoot [32]byte) bool {
@@ -11,6 +11,7 @@ import (
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	"github.com/prysmaticlabs/go-bitfield"
 	mockChain "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
@@ -20,6 +21,7 @@ import (
 	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -42,12 +44,13 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 	s := &Service{
 		ctx: ctx,
 		cfg: &config{
-			initialSync:         &mockSync.Sync{IsSyncing: false},
-			p2p:                 p,
-			beaconDB:            db,
-			chain:               chain,
-			clock:               startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
-			attestationNotifier: (&mockChain.ChainService{}).OperationNotifier(),
+			initialSync:            &mockSync.Sync{IsSyncing: false},
+			p2p:                    p,
+			beaconDB:               db,
+			chain:                  chain,
+			clock:                  startup.NewClock(chain.Genesis, chain.ValidatorsRoot),
+			attestationNotifier:    (&mockChain.ChainService{}).OperationNotifier(),
+			subnetAttestationStats: cache.NewSubnetAttestationStats(),
 		},
 		blkRootToPendingAtts:             make(map[[32]byte][]ethpb.SignedAggregateAttAndProof),
 		seenUnAggregatedAttestationCache: lruwrpr.New(10),
@@ -303,6 +306,20 @@ func TestService_validateCommitteeIndexBeaconAttestation(t *testing.T) {
 			}
 		})
 	}
+
+	// The accepted attestations should have been counted towards subnet 1's delivery stats.
+	var found bool
+	for _, st := range s.cfg.subnetAttestationStats.Breakdown(1) {
+		if st.Slot != primitives.Slot(1) || st.Subnet != 1 {
+			continue
+		}
+		found = true
+		require.NotEqual(t, uint64(0), st.UniqueAttesters)
+		com, err := helpers.BeaconCommitteeFromState(context.Background(), savedState, 1, 0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(com)), st.ExpectedAttesters)
+	}
+	require.Equal(t, true, found)
 }
 
 func TestService_setSeenCommitteeIndicesSlot(t *testing.T) {
@@ -118,6 +118,15 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Received block with an invalid parent")
 		return pubsub.ValidationReject, err
 	}
+	// Reject the block if its proposer is slashed in the finalized state, which makes it invalid on any chain.
+	s.refreshSlashedProposers(ctx)
+	if s.slashedProposers.isSlashed(blk.Block().ProposerIndex()) {
+		s.setBadBlock(ctx, blockRoot)
+		s.rejectSlashedProposer(pid, slashedInFinalizedState)
+		err := errors.Wrapf(errSlashedProposer, "proposer %d is slashed in the finalized state", blk.Block().ProposerIndex())
+		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Rejected block from slashed proposer")
+		return pubsub.ValidationReject, err
+	}
 
 	s.pendingQueueLock.RLock()
 	if s.seenPendingBlocks[blockRoot] {
@@ -153,9 +162,9 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		return pubsub.ValidationIgnore, err
 	}
 
-	// Process the block if the clock jitter is less than MAXIMUM_GOSSIP_CLOCK_DISPARITY.
-	// Otherwise queue it for processing in the right slot.
-	if isBlockQueueable(genesisTime, blk.Block().Slot(), receivedTime) {
+	// Process the block if the clock jitter is less than MAXIMUM_GOSSIP_CLOCK_DISPARITY, in which case the
+	// subscriber waits for the start of the slot before importing it. Otherwise queue it for processing in the right slot.
+	if s.recordGossipArrival(blockArrivalTopic, blk.Block().Slot(), receivedTime, pid) == arrivalFutureBeyondTolerance {
 		if res, err := s.verifyPendingBlockSignature(ctx, blk, blockRoot); err != nil {
 			log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not verify block signature")
 			return res, err
@@ -195,6 +204,9 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		// If the parent is optimistic, process the block as usual
 		// This also does not penalize a peer which sends optimistic blocks
 		if !errors.Is(ErrOptimisticParent, err) {
+			if errors.Is(err, errSlashedProposer) {
+				s.rejectSlashedProposer(pid, slashedInParentState)
+			}
 			log.WithError(err).WithFields(getBlockFields(blk)).Debug("Could not validate beacon block")
 			return pubsub.ValidationReject, err
 		}
@@ -208,6 +220,9 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		return pubsub.ValidationIgnore, err
 	}
 	msg.ValidatorData = blkPb // Used in downstream subscriber
+	if s.cfg.slotObservations != nil {
+		s.cfg.slotObservations.AddBlock(blk.Block().Slot(), blk.Block().ProposerIndex(), blockRoot)
+	}
 
 	// Log the arrival time of the accepted block
 	graffiti := blk.Block().Body().Graffiti()
@@ -259,6 +274,7 @@ func (s *Service) validateBeaconBlock(ctx context.Context, blk interfaces.ReadOn
 
 // Validates beacon block according to phase 0 validity conditions.
 // - Checks that the parent is in our forkchoice tree.
+// - Validates that the proposer is not slashed.
 // - Validates that the proposer signature is valid.
 // - Validates that the proposer index is valid.
 func (s *Service) validatePhase0Block(ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte) (state.BeaconState, error) {
@@ -272,6 +288,15 @@ func (s *Service) validatePhase0Block(ctx context.Context, blk interfaces.ReadOn
 		return nil, err
 	}
 
+	// The slashed status of the proposer does not change when advancing the parent state to the slot of the block,
+	// so the block can be rejected before verifying its signature. This covers slashings included on the chain of
+	// the block since the finalized checkpoint, including the ones of the current epoch.
+	proposer, err := parentState.ValidatorAtIndexReadOnly(blk.Block().ProposerIndex())
+	if err == nil && proposer.Slashed() {
+		s.setBadBlock(ctx, blockRoot)
+		return nil, errors.Wrapf(errSlashedProposer, "proposer %d is slashed in the parent state", blk.Block().ProposerIndex())
+	}
+
 	if err := blocks.VerifyBlockSignatureUsingCurrentFork(parentState, blk, blockRoot); err != nil {
 		return nil, err
 	}
@@ -429,20 +454,6 @@ func captureArrivalTimeMetric(genesisTime uint64, currentSlot primitives.Slot) e
 	return nil
 }
 
-// isBlockQueueable checks if the slot_time in the block is greater than
-// current_time +  MAXIMUM_GOSSIP_CLOCK_DISPARITY. in short, this function
-// returns true if the corresponding block should be queued and false if
-// the block should be processed immediately.
-func isBlockQueueable(genesisTime uint64, slot primitives.Slot, receivedTime time.Time) bool {
-	slotTime, err := slots.ToTime(genesisTime, slot)
-	if err != nil {
-		return false
-	}
-
-	currentTimeWithDisparity := receivedTime.Add(params.BeaconConfig().MaximumGossipClockDisparityDuration())
-	return currentTimeWithDisparity.Unix() < slotTime.Unix()
-}
-
 func getBlockFields(b interfaces.ReadOnlySignedBeaconBlock) logrus.Fields {
 	if consensusblocks.BeaconBlockIsNil(b) != nil {
 		return logrus.Fields{}
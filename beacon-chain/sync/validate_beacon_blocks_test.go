@@ -1147,22 +1147,6 @@ func TestService_setBadBlock_DoesntSetWithContextErr(t *testing.T) {
 	}
 }
 
-func TestService_isBlockQueueable(t *testing.T) {
-	currentTime := time.Now().Round(time.Second)
-	genesisTime := uint64(currentTime.Unix() - int64(params.BeaconConfig().SecondsPerSlot))
-	blockSlot := primitives.Slot(1)
-
-	// slot time within MAXIMUM_GOSSIP_CLOCK_DISPARITY, so don't queue the block.
-	receivedTime := currentTime.Add(-400 * time.Millisecond)
-	result := isBlockQueueable(genesisTime, blockSlot, receivedTime)
-	assert.Equal(t, false, result)
-
-	// slot time just above MAXIMUM_GOSSIP_CLOCK_DISPARITY, so queue the block.
-	receivedTime = currentTime.Add(-600 * time.Millisecond)
-	result = isBlockQueueable(genesisTime, blockSlot, receivedTime)
-	assert.Equal(t, true, result)
-}
-
 func TestValidateBeaconBlockPubSub_ValidExecutionPayload(t *testing.T) {
 	db := dbtest.SetupDB(t)
 	p := p2ptest.NewTestP2P(t)
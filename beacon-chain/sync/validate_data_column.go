@@ -0,0 +1,20 @@
+package sync
+
+import (
+	"context"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// validateDataColumn ignores the data column sidecars of the custody subnets. There is no data column sidecar type
+// to decode and verify them yet, so they are never relayed to other peers.
+func (s *Service) validateDataColumn(_ context.Context, _ peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+	if msg.Topic == nil {
+		return pubsub.ValidationReject, errInvalidTopic
+	}
+	return pubsub.ValidationIgnore, nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 )
 
 // Sync committee subnets are used to propagate unaggregated sync committee messages to subsections of the network.
@@ -70,6 +71,7 @@ func (s *Service) validateSyncCommitteeMessage(
 
 	// Validate sync message times before proceeding.
 	// The message's `slot` is for the current slot (with a MAXIMUM_GOSSIP_CLOCK_DISPARITY allowance).
+	s.recordGossipArrival(syncMessageArrivalTopic, m.Slot, prysmTime.Now(), pid)
 	if err := altair.ValidateSyncMessageTime(
 		m.Slot,
 		s.cfg.clock.GenesisTime(),
@@ -78,6 +80,8 @@ func (s *Service) validateSyncCommitteeMessage(
 		tracing.AnnotateError(span, err)
 		return pubsub.ValidationIgnore, err
 	}
+	ctx, cancel := s.withValidationDeadline(ctx, m.Slot, 0)
+	defer cancel()
 
 	committeeIndices, err := s.cfg.chain.HeadSyncCommitteeIndices(ctx, m.ValidatorIndex, m.Slot)
 	if err != nil {
@@ -290,6 +294,9 @@ func seenSyncCommitteeKey(slot primitives.Slot, valIndex primitives.ValidatorInd
 
 func validationPipeline(ctx context.Context, fns ...validationFn) (pubsub.ValidationResult, error) {
 	for _, fn := range fns {
+		if ctx.Err() != nil {
+			return pubsub.ValidationIgnore, ctx.Err()
+		}
 		if result, err := fn(ctx); result != pubsub.ValidationAccept {
 			return result, err
 		}
@@ -0,0 +1,18 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// withValidationDeadline bounds the validation of a gossip message of the slot by its relevance window: the message
+// is no longer propagated once the window of slots following its slot has passed, allowing for the maximum gossip
+// clock disparity, so validating it any longer only holds resources. The deadline never extends the deadline of the
+// parent context.
+func (s *Service) withValidationDeadline(ctx context.Context, slot, window primitives.Slot) (context.Context, context.CancelFunc) {
+	end := slots.BeginsAt(slot+window+1, s.cfg.clock.GenesisTime())
+	return context.WithDeadline(ctx, end.Add(params.BeaconConfig().MaximumGossipClockDisparityDuration()))
+}
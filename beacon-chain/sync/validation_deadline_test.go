@@ -0,0 +1,52 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_WithValidationDeadline(t *testing.T) {
+	genesis := time.Now().Add(-time.Hour)
+	s := &Service{cfg: &config{clock: startup.NewClock(genesis, [32]byte{})}}
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	disparity := params.BeaconConfig().MaximumGossipClockDisparityDuration()
+
+	ctx, cancel := s.withValidationDeadline(context.Background(), 10, 2)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.Equal(t, true, ok)
+	assert.Equal(t, true, deadline.Equal(genesis.Add(13*slotDuration+disparity)))
+	// The relevance window of the message has passed.
+	require.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+
+	// The deadline of the parent context is kept when earlier.
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Second)
+	defer cancelParent()
+	current := s.cfg.clock.CurrentSlot()
+	ctx, cancel = s.withValidationDeadline(parent, current, params.BeaconConfig().AttestationPropagationSlotRange)
+	defer cancel()
+	parentDeadline, _ := parent.Deadline()
+	deadline, _ = ctx.Deadline()
+	assert.Equal(t, true, deadline.Equal(parentDeadline))
+}
+
+func TestValidationPipeline_DeadlinePassed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	fn := func(context.Context) (pubsub.ValidationResult, error) {
+		calls++
+		cancel()
+		return pubsub.ValidationAccept, nil
+	}
+	result, err := validationPipeline(ctx, fn, fn)
+	assert.Equal(t, pubsub.ValidationIgnore, result)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, calls)
+}
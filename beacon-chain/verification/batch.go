@@ -2,11 +2,16 @@ package verification
 
 import (
 	"context"
+	"fmt"
+	"runtime"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"golang.org/x/sync/errgroup"
 )
 
 var (
@@ -18,6 +23,23 @@ var (
 	ErrBatchBlockRootMismatch = errors.New("Sidecar block header root does not match signed block")
 )
 
+// SidecarKzgProofError identifies the sidecar responsible for a failed batch kzg proof verification.
+type SidecarKzgProofError struct {
+	BlockRoot [32]byte
+	Index     uint64
+	err       error
+}
+
+// Error satisfies the standard error interface.
+func (e *SidecarKzgProofError) Error() string {
+	return fmt.Sprintf("%s: index %d of block %#x: %v", ErrSidecarKzgProofInvalid.Error(), e.Index, e.BlockRoot, e.err)
+}
+
+// Unwrap allows errors.Is to match both ErrSidecarKzgProofInvalid and the underlying kzg error.
+func (e *SidecarKzgProofError) Unwrap() []error {
+	return []error{ErrSidecarKzgProofInvalid, e.err}
+}
+
 // NewBlobBatchVerifier initializes a blob batch verifier. It requires the caller to correctly specify
 // verification Requirements and to also pass in a NewBlobVerifier, which is a callback function that
 // returns a new BlobVerifier for handling a single blob in the batch.
@@ -26,6 +48,7 @@ func NewBlobBatchVerifier(newVerifier NewBlobVerifier, reqs []Requirement) *Blob
 		verifyKzg:   kzg.Verify,
 		newVerifier: newVerifier,
 		reqs:        reqs,
+		kzgVerified: make(map[*ethpb.BlobSidecar]struct{}),
 	}
 }
 
@@ -39,6 +62,75 @@ type BlobBatchVerifier struct {
 	verifyKzg   roblobCommitmentVerifier
 	newVerifier NewBlobVerifier
 	reqs        []Requirement
+	kzgMu       sync.Mutex
+	kzgVerified map[*ethpb.BlobSidecar]struct{}
+}
+
+// PreverifyKzgProofs batch verifies the kzg proofs of the sidecars of each block, verifying the blocks concurrently.
+// Sidecars passing verification are remembered so that VerifiedROBlobs does not verify their proofs again.
+// Failures are not reported here; VerifiedROBlobs verifies those sidecars again and attributes the failure.
+func (batch *BlobBatchVerifier) PreverifyKzgProofs(ctx context.Context, sidecarsByBlock [][]blocks.ROBlob) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(runtime.GOMAXPROCS(0))
+	for _, scs := range sidecarsByBlock {
+		if len(scs) == 0 {
+			continue
+		}
+		g.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := batch.verifyKzg(scs...); err != nil {
+				return nil
+			}
+			batch.markKzgVerified(scs)
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+func (batch *BlobBatchVerifier) markKzgVerified(scs []blocks.ROBlob) {
+	batch.kzgMu.Lock()
+	defer batch.kzgMu.Unlock()
+	for i := range scs {
+		batch.kzgVerified[scs[i].BlobSidecar] = struct{}{}
+	}
+}
+
+func (batch *BlobBatchVerifier) kzgUnverified(scs []blocks.ROBlob) []blocks.ROBlob {
+	batch.kzgMu.Lock()
+	defer batch.kzgMu.Unlock()
+	unverified := make([]blocks.ROBlob, 0, len(scs))
+	for i := range scs {
+		if _, ok := batch.kzgVerified[scs[i].BlobSidecar]; !ok {
+			unverified = append(unverified, scs[i])
+		}
+	}
+	return unverified
+}
+
+// verifyKzgProofs batch verifies the kzg proofs of the given sidecars. When the batch fails, each sidecar is verified
+// individually so that the failure can be attributed to the specific sidecar with an invalid proof.
+func (batch *BlobBatchVerifier) verifyKzgProofs(scs []blocks.ROBlob) error {
+	scs = batch.kzgUnverified(scs)
+	if len(scs) == 0 {
+		return nil
+	}
+	err := batch.verifyKzg(scs...)
+	if err == nil {
+		batch.markKzgVerified(scs)
+		return nil
+	}
+	if len(scs) == 1 {
+		return &SidecarKzgProofError{BlockRoot: scs[0].BlockRoot(), Index: scs[0].Index, err: err}
+	}
+	for i := range scs {
+		if ierr := batch.verifyKzg(scs[i]); ierr != nil {
+			return &SidecarKzgProofError{BlockRoot: scs[i].BlockRoot(), Index: scs[i].Index, err: ierr}
+		}
+	}
+	return err
 }
 
 // VerifiedROBlobs satisfies the das.BlobBatchVerifier interface, used by das.AvailabilityStore.
@@ -61,7 +153,7 @@ func (batch *BlobBatchVerifier) VerifiedROBlobs(ctx context.Context, blk blocks.
 		}
 	}
 	// Verify commitments for all blobs at once. verifyOneBlob assumes it is only called once this check succeeds.
-	if err := batch.verifyKzg(scs...); err != nil {
+	if err := batch.verifyKzgProofs(scs); err != nil {
 		return nil, err
 	}
 	vs := make([]blocks.VerifiedROBlob, len(scs))
@@ -2,6 +2,7 @@ package verification
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -187,3 +188,76 @@ func TestBatchVerifier(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchVerifier_KzgFailureAttribution(t *testing.T) {
+	ctx := context.Background()
+	invalidIdx := uint64(4)
+	invCmtErr := errors.New("mock invalid commitment")
+	verified := 0
+	cv := func(scs ...blocks.ROBlob) error {
+		for i := range scs {
+			if scs[i].Index == invalidIdx {
+				return invCmtErr
+			}
+		}
+		verified += len(scs)
+		return nil
+	}
+	nv := func(bl blocks.ROBlob, reqs []Requirement) BlobVerifier {
+		return &MockBlobVerifier{CbVerifiedROBlob: func() (blocks.VerifiedROBlob, error) {
+			t.Fatal("Batch verifier should stop before this point")
+			return blocks.VerifiedROBlob{}, nil
+		}}
+	}
+	blk, blbs := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 6)
+	bbv := NewBlobBatchVerifier(nv, InitsyncSidecarRequirements)
+	bbv.verifyKzg = cv
+	_, err := bbv.VerifiedROBlobs(ctx, blk, blbs)
+	require.ErrorIs(t, err, ErrSidecarKzgProofInvalid)
+	require.ErrorIs(t, err, invCmtErr)
+	var kerr *SidecarKzgProofError
+	require.Equal(t, true, errors.As(err, &kerr))
+	require.Equal(t, invalidIdx, kerr.Index)
+	require.Equal(t, blk.Root(), kerr.BlockRoot)
+	// Every valid sidecar before the invalid one was verified individually to find it.
+	require.Equal(t, int(invalidIdx), verified)
+}
+
+func TestBatchVerifier_PreverifyKzgProofs(t *testing.T) {
+	ctx := context.Background()
+	nv := func(bl blocks.ROBlob, reqs []Requirement) BlobVerifier {
+		return &MockBlobVerifier{CbVerifiedROBlob: func() (blocks.VerifiedROBlob, error) {
+			return blocks.VerifiedROBlob{ROBlob: bl}, nil
+		}}
+	}
+	invCmtErr := errors.New("mock invalid commitment")
+	blkA, blbsA := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 6)
+	blkB, blbsB := util.GenerateTestDenebBlockWithSidecar(t, blkA.Root(), 1, 6)
+	bbv := NewBlobBatchVerifier(nv, InitsyncSidecarRequirements)
+	var mu sync.Mutex
+	calls := 0
+	bbv.verifyKzg = func(scs ...blocks.ROBlob) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if scs[0].BlockRoot() == blkB.Root() {
+			return invCmtErr
+		}
+		return nil
+	}
+	require.NoError(t, bbv.PreverifyKzgProofs(ctx, [][]blocks.ROBlob{blbsA, blbsB}))
+	require.Equal(t, 2, calls)
+
+	// Proofs of block A were verified ahead of time and are not verified again.
+	vb, err := bbv.VerifiedROBlobs(ctx, blkA, blbsA)
+	require.NoError(t, err)
+	require.Equal(t, len(blbsA), len(vb))
+	require.Equal(t, 2, calls)
+
+	// Block B failed pre-verification, so its proofs are verified again and the failure is attributed.
+	_, err = bbv.VerifiedROBlobs(ctx, blkB, blbsB)
+	require.ErrorIs(t, err, ErrSidecarKzgProofInvalid)
+	var kerr *SidecarKzgProofError
+	require.Equal(t, true, errors.As(err, &kerr))
+	require.Equal(t, uint64(0), kerr.Index)
+}
@@ -3,6 +3,7 @@ package db
 import (
 	beacondb "github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/storage"
 	"github.com/prysmaticlabs/prysm/v5/runtime/tos"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -31,5 +32,61 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "index-operation-totals",
+			Description: `indexes the deposits and withdrawals of each validator in the finalized blocks of a beacon chain database`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.BackfillOperationTotals(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not index operation totals")
+				}
+				return nil
+			},
+		},
+		{
+			Name: "prune",
+			Description: `deletes the blocks and states of a beacon chain database before a slot, keeping the genesis, ` +
+				`origin checkpoint and finalized blocks and states. The beacon node must not be running`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.PruneBeforeSlotFlag,
+				cmd.PruneKeepFinalizedOnlyFlag,
+				cmd.PruneDryRunFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.Prune(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not prune database")
+				}
+				return nil
+			},
+		},
+		{
+			Name: "prune-blobs",
+			Description: `deletes the blobs of the blob storage before an epoch, along with the files left over by ` +
+				`interrupted saves, keeping the blobs within the data availability window. The beacon node must not be running`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				storage.BlobStoragePathFlag,
+				storage.BlobRetentionEpochFlag,
+				cmd.PruneBeforeEpochFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				stats, err := storage.PruneBlobs(cliCtx)
+				if err != nil {
+					log.WithError(err).Fatal("Could not prune blobs")
+				}
+				log.WithFields(logrus.Fields{
+					"beforeSlot":   stats.Before,
+					"filesRemoved": stats.Files,
+					"bytesRemoved": stats.Bytes,
+				}).Info("Pruned blobs")
+				return nil
+			},
+		},
 	},
 }
@@ -4,32 +4,56 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/network"
+	"github.com/prysmaticlabs/prysm/v5/network/authorization"
 	"github.com/urfave/cli/v2"
 )
 
 // FlagOptions for execution service flag configurations.
 func FlagOptions(c *cli.Context) ([]execution.Option, error) {
-	endpoint, err := parseExecutionChainEndpoint(c)
+	endpoints, err := parseExecutionChainEndpoint(c)
 	if err != nil {
 		return nil, err
 	}
-	jwtSecret, err := parseJWTSecretFromFile(c)
+	jwtSecrets, err := parseJWTSecretFromFile(c)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not read JWT secret file for authenticating execution API")
 	}
+	if len(jwtSecrets) > 1 && len(jwtSecrets) != len(endpoints) {
+		return nil, fmt.Errorf("%d JWT secret files were provided for %d execution endpoints", len(jwtSecrets), len(endpoints))
+	}
+	// A single JWT secret authenticates all the execution endpoints.
+	endpointSecret := func(i int) []byte {
+		switch len(jwtSecrets) {
+		case 0:
+			return nil
+		case 1:
+			return jwtSecrets[0]
+		default:
+			return jwtSecrets[i]
+		}
+	}
 	headers := strings.Split(c.String(flags.ExecutionEngineHeaders.Name), ",")
 	opts := []execution.Option{
-		execution.WithHttpEndpoint(endpoint),
+		execution.WithHttpEndpoint(endpoints[0]),
 		execution.WithEth1HeaderRequestLimit(c.Uint64(flags.Eth1HeaderReqLimit.Name)),
 		execution.WithHeaders(headers),
 	}
-	if len(jwtSecret) > 0 {
-		opts = append(opts, execution.WithHttpEndpointAndJWTSecret(endpoint, jwtSecret))
+	if secret := endpointSecret(0); len(secret) > 0 {
+		opts = append(opts, execution.WithHttpEndpointAndJWTSecret(endpoints[0], secret))
+	}
+	for i := 1; i < len(endpoints); i++ {
+		opts = append(opts, execution.WithFallbackHttpEndpoint(endpoints[i], endpointSecret(i)))
+	}
+	if len(endpoints) > 1 {
+		timeout := time.Duration(c.Uint64(flags.ExecutionEngineFailoverTimeout.Name)) * time.Second
+		opts = append(opts, execution.WithFailoverTimeout(timeout))
 	}
 	return opts, nil
 }
@@ -42,11 +66,25 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 // The secret must be stored as a hex-encoded string within a file in the filesystem.
 // If the --jwt-secret flag is provided to Prysm, but the file cannot be read, or does not contain a hex-encoded
 // key of at least 256 bits, the client should treat this as an error and abort the startup.
-func parseJWTSecretFromFile(c *cli.Context) ([]byte, error) {
-	jwtSecretFile := c.String(flags.ExecutionJWTSecretFlag.Name)
-	if jwtSecretFile == "" {
+//
+// A comma separated list of paths can be provided, one for each execution endpoint.
+func parseJWTSecretFromFile(c *cli.Context) ([][]byte, error) {
+	jwtSecretFiles := c.String(flags.ExecutionJWTSecretFlag.Name)
+	if jwtSecretFiles == "" {
 		return nil, nil
 	}
+	var secrets [][]byte
+	for _, jwtSecretFile := range strings.Split(jwtSecretFiles, ",") {
+		secret, err := readJWTSecret(strings.TrimSpace(jwtSecretFile))
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
+func readJWTSecret(jwtSecretFile string) ([]byte, error) {
 	enc, err := file.ReadFileAsBytes(jwtSecretFile)
 	if err != nil {
 		return nil, err
@@ -66,9 +104,11 @@ func parseJWTSecretFromFile(c *cli.Context) ([]byte, error) {
 	return secret, nil
 }
 
-func parseExecutionChainEndpoint(c *cli.Context) (string, error) {
+// Parses the comma separated execution endpoints, in order of preference. An endpoint can be followed by its
+// authorization header, such as "http://localhost:8551,Bearer token", which is kept along with the endpoint.
+func parseExecutionChainEndpoint(c *cli.Context) ([]string, error) {
 	if c.String(flags.ExecutionEngineEndpoint.Name) == "" {
-		return "", fmt.Errorf(
+		return nil, fmt.Errorf(
 			"you need to specify %s to provide a connection endpoint to an Ethereum execution client "+
 				"for your Prysm beacon node. This is a requirement for running a node. You can read more about "+
 				"how to configure this execution client connection in our docs here "+
@@ -76,5 +116,19 @@ func parseExecutionChainEndpoint(c *cli.Context) (string, error) {
 			flags.ExecutionEngineEndpoint.Name,
 		)
 	}
-	return c.String(flags.ExecutionEngineEndpoint.Name), nil
+	var endpoints []string
+	for _, value := range strings.Split(c.String(flags.ExecutionEngineEndpoint.Name), ",") {
+		value = strings.TrimSpace(value)
+		if network.Method(value) != authorization.None && len(endpoints) > 0 {
+			endpoints[len(endpoints)-1] += "," + value
+			continue
+		}
+		if value != "" {
+			endpoints = append(endpoints, value)
+		}
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no execution endpoint in %s", flags.ExecutionEngineEndpoint.Name)
+	}
+	return endpoints, nil
 }
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
@@ -22,7 +23,53 @@ func TestExecutionchainCmd(t *testing.T) {
 
 	endpoints, err := parseExecutionChainEndpoint(ctx)
 	require.NoError(t, err)
-	assert.Equal(t, "primary", endpoints)
+	assert.DeepEqual(t, []string{"primary"}, endpoints)
+}
+
+func TestExecutionchainCmd_FallbackEndpoints(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.ExecutionEngineEndpoint.Name, "http://primary:8551,Bearer token, http://fallback:8551,", "")
+	ctx := cli.NewContext(&app, set, nil)
+
+	endpoints, err := parseExecutionChainEndpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"http://primary:8551,Bearer token", "http://fallback:8551"}, endpoints)
+}
+
+func TestFlagOptions_JWTSecretPerEndpoint(t *testing.T) {
+	dir := t.TempDir()
+	var paths []string
+	for _, name := range []string{"a", "b", "c"} {
+		path := filepath.Join(dir, name)
+		secret := bytesutil.ToBytes32([]byte(name))
+		require.NoError(t, file.WriteFile(path, []byte(fmt.Sprintf("%#x", secret))))
+		paths = append(paths, path)
+	}
+	newContext := func(jwtSecrets string) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.ExecutionEngineEndpoint.Name, "http://primary:8551,http://fallback:8551", "")
+		set.String(flags.ExecutionJWTSecretFlag.Name, jwtSecrets, "")
+		set.String(flags.ExecutionEngineHeaders.Name, "", "")
+		set.Uint64(flags.Eth1HeaderReqLimit.Name, 1000, "")
+		set.Uint64(flags.ExecutionEngineFailoverTimeout.Name, 2, "")
+		return cli.NewContext(&cli.App{}, set, nil)
+	}
+
+	secrets, err := parseJWTSecretFromFile(newContext(strings.Join(paths[:2], ",")))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(secrets))
+	a, b := bytesutil.ToBytes32([]byte("a")), bytesutil.ToBytes32([]byte("b"))
+	assert.DeepEqual(t, a[:], secrets[0])
+	assert.DeepEqual(t, b[:], secrets[1])
+
+	_, err = FlagOptions(newContext(strings.Join(paths[:2], ",")))
+	require.NoError(t, err)
+	// A single secret authenticates all the endpoints.
+	_, err = FlagOptions(newContext(paths[0]))
+	require.NoError(t, err)
+	_, err = FlagOptions(newContext(strings.Join(paths, ",")))
+	require.ErrorContains(t, "3 JWT secret files were provided for 2 execution endpoints", err)
 }
 
 func Test_parseJWTSecretFromFile(t *testing.T) {
@@ -87,7 +134,7 @@ func Test_parseJWTSecretFromFile(t *testing.T) {
 		ctx := cli.NewContext(&app, set, nil)
 		got, err := parseJWTSecretFromFile(ctx)
 		require.NoError(t, err)
-		require.DeepEqual(t, secret[:], got)
+		require.DeepEqual(t, [][]byte{secret[:]}, got)
 	})
 }
 
@@ -64,10 +64,20 @@ var (
 	}
 	// ExecutionEngineEndpoint provides an HTTP access endpoint to connect to an execution client on the execution layer
 	ExecutionEngineEndpoint = &cli.StringFlag{
-		Name:  "execution-endpoint",
-		Usage: "An execution client http endpoint. Can contain auth header as well in the format",
+		Name: "execution-endpoint",
+		Usage: "An execution client http endpoint. Can contain auth header as well in the format. " +
+			"A comma separated list of endpoints can be provided, in order of preference, to fail over to the next " +
+			"endpoint when the previous ones are unhealthy.",
 		Value: "http://localhost:8551",
 	}
+	// ExecutionEngineFailoverTimeout is the number of seconds after which a call to an execution endpoint fails over
+	// to the next endpoint, when several execution endpoints are provided.
+	ExecutionEngineFailoverTimeout = &cli.Uint64Flag{
+		Name: "execution-endpoint-failover-timeout",
+		Usage: "The number of seconds after which a call to an execution endpoint which does not answer fails over to " +
+			"the next execution endpoint, when several execution endpoints are provided.",
+		Value: 4,
+	}
 	// ExecutionEngineHeaders defines a list of HTTP headers to send with all execution client requests.
 	ExecutionEngineHeaders = &cli.StringFlag{
 		Name: "execution-headers",
@@ -83,7 +93,8 @@ var (
 			"a hex-encoded string representing a 32 byte secret used for authentication with an execution node via " +
 			"HTTP. If this is not set, all requests to execution nodes via HTTP for consensus-related calls will " +
 			"fail, which will prevent your validators from performing their duties. " +
-			"This is not required if using an IPC connection.",
+			"This is not required if using an IPC connection. When several execution endpoints are provided, " +
+			"a comma separated list of paths can be provided, one for each endpoint.",
 		Value: "",
 	}
 	// JwtId is the id field of the JWT claims. The consensus layer client MAY use this to communicate a unique identifier for the individual consensus layer client
@@ -109,6 +120,11 @@ var (
 		Usage: "RPC port exposed by a beacon node",
 		Value: 4000,
 	}
+	// RPCUnixSocket defines the path of a unix domain socket on which the RPC server should listen.
+	RPCUnixSocket = &cli.StringFlag{
+		Name:  "rpc-unix-socket",
+		Usage: "Path of a unix domain socket on which the RPC server listens instead of --rpc-host and --rpc-port.",
+	}
 	// MonitoringPortFlag defines the http port used to serve prometheus metrics.
 	MonitoringPortFlag = &cli.IntFlag{
 		Name:  "monitoring-port",
@@ -146,6 +162,17 @@ var (
 		Value:   3500,
 		Aliases: []string{"grpc-gateway-port"},
 	}
+	// HTTPServerUnixSocket defines the path of a unix domain socket on which the HTTP server should listen.
+	HTTPServerUnixSocket = &cli.StringFlag{
+		Name:  "http-unix-socket",
+		Usage: "Path of a unix domain socket on which the HTTP server listens instead of --http-host and --http-port.",
+	}
+	// UnixSocketPermissions defines the file permissions of the unix domain sockets of the RPC and HTTP servers.
+	UnixSocketPermissions = &cli.StringFlag{
+		Name:  "unix-socket-permissions",
+		Usage: "File permissions, in octal, of the unix domain sockets given to --rpc-unix-socket and --http-unix-socket.",
+		Value: "0660",
+	}
 	// HTTPServerCorsDomain serves preflight requests when serving HTTP.
 	HTTPServerCorsDomain = &cli.StringFlag{
 		Name:    "http-cors-domain",
@@ -192,6 +219,16 @@ var (
 		Usage: "The factor by which block batch limit may increase on burst.",
 		Value: 2,
 	}
+	// InitialSyncVerification specifies how the blocks of the finalized portion of initial sync are verified.
+	InitialSyncVerification = &cli.StringFlag{
+		Name: "initial-sync-verification",
+		Usage: "How blocks below the finalized checkpoint agreed on by the sync peers are verified during initial sync. " +
+			"'full' verifies every signature. 'anchored' holds the blocks close to the finalized checkpoint until their " +
+			"chain of parent roots reaches the checkpoint root, then only verifies the proposer signatures of a random " +
+			"sample of the blocks of every batch, falling back to 'full' after a sampled signature fails. Held blocks are " +
+			"spooled to the beacon database directory.",
+		Value: "full",
+	}
 	// BlobBatchLimit specifies the requested blob batch size.
 	BlobBatchLimit = &cli.IntFlag{
 		Name:  "blob-batch-limit",
@@ -214,6 +251,13 @@ var (
 		Name:  "subscribe-all-subnets",
 		Usage: "Subscribe to all possible attestation and sync subnets.",
 	}
+	// CustodyRequirementFlag specifies the number of data column sidecar subnets custodied by the node.
+	CustodyRequirementFlag = &cli.Uint64Flag{
+		Name: "custody-requirement",
+		Usage: "(PeerDAS experimentation) Number of data column sidecar subnets to custody, advertised in the ENR. " +
+			"The subnets are derived from the node ID and subscribed to, but their sidecars are not verified nor forwarded yet. " +
+			"Must be between CUSTODY_REQUIREMENT and DATA_COLUMN_SIDECAR_SUBNET_COUNT, no subnet is custodied when 0.",
+	}
 	// HistoricalSlasherNode is a set of beacon node flags required for performing historical detection with a slasher.
 	HistoricalSlasherNode = &cli.BoolFlag{
 		Name:  "historical-slasher-node",
@@ -296,4 +340,77 @@ var (
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// SlasherMemoryBudgetFlag bounds the memory taken by the slasher when processing queued attestations.
+	SlasherMemoryBudgetFlag = &cli.Uint64Flag{
+		Name: "slasher-memory-budget",
+		Usage: "Memory, in megabytes, that the slasher may take for the attestations and span chunks it processes at once. " +
+			"Queued attestations are processed by batches within this budget, such as when catching up after downtime.",
+		Value: 400,
+	}
+	// StrictStartupFlag disables the automatic repair of inconsistent chain data at startup.
+	StrictStartupFlag = &cli.BoolFlag{
+		Name: "strict-startup",
+		Usage: "Fails at startup when the persisted head or checkpoints reference blocks or states missing from the database, " +
+			"instead of rolling back to the newest consistent finalized checkpoint.",
+	}
+	// DBSnapshotDirFlag specifies the directory of the database snapshots taken at startup.
+	DBSnapshotDirFlag = &cli.StringFlag{
+		Name: "db-snapshot-dir",
+		Usage: "Directory of the snapshots of the database taken before clearing it with --clear-db or --force-clear-db, " +
+			"and before migrations rewriting or deleting stored data. Defaults to the backups directory of the database.",
+	}
+	// DisableDBSnapshotFlag disables the database snapshots taken at startup.
+	DisableDBSnapshotFlag = &cli.BoolFlag{
+		Name:  "disable-db-snapshot",
+		Usage: "Clears the database and runs migrations rewriting or deleting stored data without taking a snapshot first.",
+	}
+	// DBSnapshotRetentionFlag specifies the number of database snapshots kept.
+	DBSnapshotRetentionFlag = &cli.Uint64Flag{
+		Name:  "db-snapshot-retention",
+		Usage: "Number of database snapshots kept in the snapshot directory, the oldest ones being deleted. All are kept when 0.",
+		Value: 3,
+	}
+	// OperationTotalsIndexFlag enables the index of deposit and withdrawal totals per validator.
+	OperationTotalsIndexFlag = &cli.BoolFlag{
+		Name: "operation-totals-index",
+		Usage: "Maintains an index of the cumulative deposits and withdrawals of each validator as blocks are finalized, " +
+			"served by the /prysm/v1/validators/operation_totals endpoint. Existing finalized blocks are indexed in the background.",
+	}
+	// DisableArchivalAPIQueriesFlag rejects API requests for states older than the finalized checkpoint.
+	DisableArchivalAPIQueriesFlag = &cli.BoolFlag{
+		Name: "disable-archival-api-queries",
+		Usage: "Rejects HTTP and gRPC API requests needing a state from before the finalized checkpoint, which are served " +
+			"by loading cold states and replaying blocks. Archival data is still stored according to --slots-per-archive-point.",
+	}
+	// HTTPAdminTokenFileFlag specifies the file holding the bearer token required by the admin HTTP endpoints.
+	HTTPAdminTokenFileFlag = &cli.StringFlag{
+		Name: "http-admin-token-file",
+		Usage: "Path to a file holding the bearer token required by the admin HTTP endpoints, such as refreshing the ENR " +
+			"of the node. The admin endpoints are disabled when no token file is provided.",
+	}
+	// SlotOutcomesRetentionFlag specifies the number of recent slots for which observed blocks and proposers are kept.
+	SlotOutcomesRetentionFlag = &cli.Uint64Flag{
+		Name: "slot-outcomes-retention",
+		Usage: "Number of recent slots for which the node keeps the blocks it observed and the assigned proposers, to " +
+			"tell orphaned proposals apart from empty slots in the /prysm/v1/beacon/slot_outcomes endpoint.",
+		Value: 1024,
+	}
+	// GraffitiStatsEpochsFlag enables the graffiti statistics of the most recent epochs.
+	GraffitiStatsEpochsFlag = &cli.IntFlag{
+		Name: "graffiti-stats-epochs",
+		Usage: "Number of recent epochs for which the node tallies the graffiti of the canonical blocks by client, as an " +
+			"estimate of the client diversity of the proposers, served by the /prysm/v1/beacon/graffiti_stats endpoint. " +
+			"Disabled when 0.",
+	}
+	// GraffitiStatsTopFlag specifies the number of most frequent graffiti counted per epoch.
+	GraffitiStatsTopFlag = &cli.IntFlag{
+		Name:  "graffiti-stats-top",
+		Usage: "Number of most frequent graffiti counted in the graffiti statistics of each epoch.",
+		Value: 10,
+	}
+	// GraffitiStatsLogFlag logs the graffiti statistics of each epoch.
+	GraffitiStatsLogFlag = &cli.BoolFlag{
+		Name:  "graffiti-stats-log",
+		Usage: "Logs the graffiti statistics of each epoch once tallied, when --graffiti-stats-epochs is set.",
+	}
 )
@@ -14,6 +14,7 @@ type GlobalFlags struct {
 	MaxConcurrentDials         int
 	BlockBatchLimit            int
 	BlockBatchLimitBurstFactor int
+	AnchoredInitialSync        bool
 	BlobBatchLimit             int
 	BlobBatchLimitBurstFactor  int
 }
@@ -45,6 +46,14 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
 	cfg.BlobBatchLimit = ctx.Int(BlobBatchLimit.Name)
 	cfg.BlobBatchLimitBurstFactor = ctx.Int(BlobBatchLimitBurstFactor.Name)
+	switch v := ctx.String(InitialSyncVerification.Name); v {
+	case "full":
+	case "anchored":
+		log.Warn("Verifying only a sample of the proposer signatures of finalized blocks during initial sync")
+		cfg.AnchoredInitialSync = true
+	default:
+		log.Warnf("Unknown initial sync verification %q, verifying every signature", v)
+	}
 	cfg.MinimumPeersPerSubnet = ctx.Int(MinPeersPerSubnet.Name)
 	cfg.MaxConcurrentDials = ctx.Int(MaxConcurrentDials.Name)
 	configureMinimumPeers(ctx, cfg)
@@ -0,0 +1,115 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/cmd/healthcheck"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// MaxSyncDistanceFlag makes the health check fail when the node is further behind the wall clock slot.
+	MaxSyncDistanceFlag = &cli.Uint64Flag{
+		Name:  "max-sync-distance",
+		Usage: "Reports the node unhealthy when its head is more than this number of slots behind. Not checked if 0.",
+	}
+	// MinPeersFlag makes the health check fail when the node has fewer connected peers.
+	MinPeersFlag = &cli.Uint64Flag{
+		Name:  "min-peers",
+		Usage: "Reports the node unhealthy when it has fewer connected peers. Not checked if 0.",
+	}
+)
+
+// Commands for checking the health of a running beacon node.
+var Commands = &cli.Command{
+	Name:  "healthcheck",
+	Usage: "Checks the health of the beacon node running locally through its HTTP API and exits with code 1 if it is unhealthy.",
+	Description: `Queries the node health endpoint of the beacon node running with the same --http-host and --http-port,
+and optionally its sync distance and peer count. Meant to be used as a container health check.`,
+	Flags: cmd.WrapFlags([]cli.Flag{
+		flags.HTTPServerHost,
+		flags.HTTPServerPort,
+		healthcheck.TimeoutFlag,
+		MaxSyncDistanceFlag,
+		MinPeersFlag,
+		cmd.ConfigFileFlag,
+	}),
+	Before: func(cliCtx *cli.Context) error {
+		return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+	},
+	Action: func(cliCtx *cli.Context) error {
+		c := healthcheck.NewClient(cliCtx.String(flags.HTTPServerHost.Name), cliCtx.Int(flags.HTTPServerPort.Name), "")
+		return healthcheck.Run(cliCtx, c, check(cliCtx.Uint64(MaxSyncDistanceFlag.Name), cliCtx.Uint64(MinPeersFlag.Name)))
+	},
+}
+
+func check(maxSyncDistance, minPeers uint64) healthcheck.Check {
+	return func(ctx context.Context, c *healthcheck.Client) (string, error) {
+		code, err := c.Get(ctx, "/eth/v1/node/health", nil)
+		if err != nil {
+			return "", err
+		}
+		state := "synced"
+		switch code {
+		case http.StatusOK:
+		case http.StatusPartialContent:
+			state = "syncing"
+		default:
+			return "", fmt.Errorf("node health endpoint returned status %d", code)
+		}
+
+		if maxSyncDistance > 0 {
+			resp := &structs.SyncStatusResponse{}
+			if err := get(ctx, c, "/eth/v1/node/syncing", resp); err != nil {
+				return "", err
+			}
+			if resp.Data == nil {
+				return "", errors.New("empty sync status")
+			}
+			distance, err := strconv.ParseUint(resp.Data.SyncDistance, 10, 64)
+			if err != nil {
+				return "", errors.Wrap(err, "could not parse sync distance")
+			}
+			if distance > maxSyncDistance {
+				return "", fmt.Errorf("sync distance %d is above %d", distance, maxSyncDistance)
+			}
+			state += fmt.Sprintf(", sync distance %d", distance)
+		}
+		if minPeers > 0 {
+			resp := &structs.GetPeerCountResponse{}
+			if err := get(ctx, c, "/eth/v1/node/peer_count", resp); err != nil {
+				return "", err
+			}
+			if resp.Data == nil {
+				return "", errors.New("empty peer count")
+			}
+			peers, err := strconv.ParseUint(resp.Data.Connected, 10, 64)
+			if err != nil {
+				return "", errors.Wrap(err, "could not parse peer count")
+			}
+			if peers < minPeers {
+				return "", fmt.Errorf("%d connected peers is below %d", peers, minPeers)
+			}
+			state += fmt.Sprintf(", %d peers", peers)
+		}
+		return state, nil
+	}
+}
+
+func get(ctx context.Context, c *healthcheck.Client, path string, v interface{}) error {
+	code, err := c.Get(ctx, path, v)
+	if err != nil {
+		return err
+	}
+	if code != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", path, code)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package healthcheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/cmd/healthcheck"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func startNode(t *testing.T, healthStatus int, syncDistance, peers string) *healthcheck.Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/node/health":
+			w.WriteHeader(healthStatus)
+		case "/eth/v1/node/syncing":
+			httputil.WriteJson(w, &structs.SyncStatusResponse{Data: &structs.SyncStatusResponseData{SyncDistance: syncDistance}})
+		case "/eth/v1/node/peer_count":
+			httputil.WriteJson(w, &structs.GetPeerCountResponse{Data: &structs.PeerCount{Connected: peers}})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return newClient(t, srv.URL)
+}
+
+func newClient(t *testing.T, rawURL string) *healthcheck.Client {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+	return healthcheck.NewClient(u.Hostname(), port, "")
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unreachable", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		c := newClient(t, srv.URL)
+		srv.Close()
+		_, err := check(0, 0)(ctx, c)
+		require.ErrorContains(t, "could not reach", err)
+	})
+	t.Run("unhealthy", func(t *testing.T) {
+		_, err := check(0, 0)(ctx, startNode(t, http.StatusServiceUnavailable, "0", "0"))
+		require.ErrorContains(t, "node health endpoint returned status 503", err)
+	})
+	t.Run("healthy", func(t *testing.T) {
+		state, err := check(0, 0)(ctx, startNode(t, http.StatusOK, "0", "0"))
+		require.NoError(t, err)
+		require.Equal(t, "synced", state)
+		state, err = check(64, 10)(ctx, startNode(t, http.StatusPartialContent, "32", "50"))
+		require.NoError(t, err)
+		require.Equal(t, "syncing, sync distance 32, 50 peers", state)
+	})
+	t.Run("too far behind", func(t *testing.T) {
+		_, err := check(64, 0)(ctx, startNode(t, http.StatusPartialContent, "65", "50"))
+		require.ErrorContains(t, "sync distance 65 is above 64", err)
+	})
+	t.Run("too few peers", func(t *testing.T) {
+		_, err := check(0, 10)(ctx, startNode(t, http.StatusOK, "0", "9"))
+		require.ErrorContains(t, "9 connected peers is below 10", err)
+	})
+}
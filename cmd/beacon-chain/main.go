@@ -19,6 +19,7 @@ import (
 	dbcommands "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	healthcheckcommands "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/healthcheck"
 	jwtcommands "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/jwt"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/storage"
 	backfill "github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/backfill"
@@ -43,20 +44,25 @@ var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.ExecutionEngineEndpoint,
 	flags.ExecutionEngineHeaders,
+	flags.ExecutionEngineFailoverTimeout,
 	flags.ExecutionJWTSecretFlag,
 	flags.RPCHost,
 	flags.RPCPort,
+	flags.RPCUnixSocket,
 	flags.CertFlag,
 	flags.KeyFlag,
 	flags.HTTPModules,
 	flags.HTTPServerHost,
 	flags.HTTPServerPort,
+	flags.HTTPServerUnixSocket,
+	flags.UnixSocketPermissions,
 	flags.HTTPServerCorsDomain,
 	flags.MinSyncPeers,
 	flags.ContractDeploymentBlock,
 	flags.SetGCPercent,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
+	flags.InitialSyncVerification,
 	flags.BlobBatchLimit,
 	flags.BlobBatchLimitBurstFactor,
 	flags.InteropMockEth1DataVotesFlag,
@@ -65,6 +71,7 @@ var appFlags = []cli.Flag{
 	flags.SlotsPerArchivedPoint,
 	flags.DisableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
+	flags.CustodyRequirementFlag,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
@@ -104,6 +111,7 @@ var appFlags = []cli.Flag{
 	cmd.P2PAllowList,
 	cmd.P2PDenyList,
 	cmd.PubsubQueueSize,
+	cmd.PubsubPropagationWindow,
 	cmd.DataDirFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
@@ -129,6 +137,7 @@ var appFlags = []cli.Flag{
 	cmd.EnableUPnPFlag,
 	cmd.ConfigFileFlag,
 	cmd.ChainConfigFileFlag,
+	cmd.ChainPresetFileFlag,
 	cmd.GrpcMaxCallRecvMsgSizeFlag,
 	cmd.AcceptTosFlag,
 	cmd.RestoreSourceFileFlag,
@@ -138,9 +147,22 @@ var appFlags = []cli.Flag{
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
 	checkpoint.RemoteURL,
+	checkpoint.DepositSnapshotPath,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
 	flags.SlasherDirFlag,
+	flags.SlasherMemoryBudgetFlag,
+	flags.StrictStartupFlag,
+	flags.DBSnapshotDirFlag,
+	flags.DisableDBSnapshotFlag,
+	flags.DBSnapshotRetentionFlag,
+	flags.OperationTotalsIndexFlag,
+	flags.DisableArchivalAPIQueriesFlag,
+	flags.HTTPAdminTokenFileFlag,
+	flags.SlotOutcomesRetentionFlag,
+	flags.GraffitiStatsEpochsFlag,
+	flags.GraffitiStatsTopFlag,
+	flags.GraffitiStatsLogFlag,
 	flags.JwtId,
 	storage.BlobStoragePathFlag,
 	storage.BlobRetentionEpochFlag,
@@ -148,6 +170,7 @@ var appFlags = []cli.Flag{
 	bflags.BackfillBatchSize,
 	bflags.BackfillWorkerCount,
 	bflags.BackfillOldestSlot,
+	bflags.BackfillBlocksPerSecond,
 }
 
 func init() {
@@ -238,6 +261,7 @@ func main() {
 		Commands: []*cli.Command{
 			dbcommands.Commands,
 			jwtcommands.Commands,
+			healthcheckcommands.Commands,
 		},
 		Flags:  appFlags,
 		Before: before,
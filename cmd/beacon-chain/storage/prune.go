@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/urfave/cli/v2"
+)
+
+// PruneBlobs deletes the blobs of the blob storage before the epoch of the --before-epoch flag, along with the files
+// left over by interrupted saves, and returns what was deleted. Blobs within the data availability window are kept.
+// The beacon node must not be running.
+func PruneBlobs(c *cli.Context) (*filesystem.PruneStats, error) {
+	if !c.IsSet(cmd.PruneBeforeEpochFlag.Name) {
+		return nil, errors.Errorf("--%s is required", cmd.PruneBeforeEpochFlag.Name)
+	}
+	e, err := blobRetentionEpoch(c)
+	if err != nil {
+		return nil, err
+	}
+	bs, err := filesystem.NewBlobStorage(filesystem.WithBasePath(blobStoragePath(c)), filesystem.WithBlobRetentionEpochs(e))
+	if err != nil {
+		return nil, err
+	}
+	return bs.PruneBefore(primitives.Epoch(c.Uint64(cmd.PruneBeforeEpochFlag.Name)))
+}
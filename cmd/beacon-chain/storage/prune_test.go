@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"flag"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestPruneBlobs(t *testing.T) {
+	dir := t.TempDir()
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(BlobStoragePathFlag.Name, dir, "")
+	set.Uint64(cmd.PruneBeforeEpochFlag.Name, 0, "")
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	_, err := PruneBlobs(cliCtx)
+	require.ErrorContains(t, "--before-epoch is required", err)
+
+	// A partial file left over by a crash is deleted.
+	partDir := path.Join(dir, "0x0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, os.MkdirAll(partDir, 0700))
+	require.NoError(t, os.WriteFile(path.Join(partDir, "0-1.part"), []byte("derp"), 0600))
+	require.NoError(t, set.Set(cmd.PruneBeforeEpochFlag.Name, "10"))
+	stats, err := PruneBlobs(cliCtx)
+	require.NoError(t, err)
+	require.Equal(t, 0, stats.Files)
+	require.Equal(t, uint64(4), stats.Bytes)
+	_, err = os.Stat(partDir)
+	require.Equal(t, true, os.IsNotExist(err))
+}
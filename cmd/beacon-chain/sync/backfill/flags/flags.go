@@ -35,9 +35,18 @@ var (
 			"This has a multiplicative effect with " + backfillBatchSizeName + ".",
 		Value: 2,
 	}
+	// BackfillOldestSlot allows users to backfill further back than MIN_EPOCHS_FOR_BLOCK_REQUESTS, up to genesis.
 	BackfillOldestSlot = &cli.Uint64Flag{
 		Name: "backfill-oldest-slot",
-		Usage: "Specifies the oldest slot that backfill should download. " +
+		Usage: "Specifies the oldest slot that backfill should download. Set to 0 to backfill all blocks down to genesis. " +
 			"If this value is greater than current_slot - MIN_EPOCHS_FOR_BLOCK_REQUESTS, it will be ignored with a warning log.",
 	}
+	// BackfillBlocksPerSecond limits the rate at which backfill requests blocks, so that it doesn't compete
+	// with regular sync and validator duties for bandwidth and peers.
+	BackfillBlocksPerSecond = &cli.Uint64Flag{
+		Name: "backfill-blocks-per-second",
+		Usage: "Maximum number of blocks backfill requests from peers per second, across all backfill workers. " +
+			"Set to 0 to disable the limit.",
+		Value: 64,
+	}
 )
@@ -28,29 +28,52 @@ var (
 			"As an additional safety measure, it is strongly recommended to only use this option in conjunction with " +
 			"--weak-subjectivity-checkpoint flag",
 	}
+	// DepositSnapshotPath defines a flag to bootstrap the deposit tree from an EIP-4881 deposit snapshot file.
+	DepositSnapshotPath = &cli.PathFlag{
+		Name: "deposit-snapshot",
+		Usage: "Rather than processing all deposit contract logs, you can bootstrap the deposit tree from an EIP-4881 " +
+			"deposit snapshot, ssz-serialized or as returned in JSON by /eth/v1/beacon/deposit_snapshot. " +
+			"The snapshot must match the eth1 data of the finalized state. When checkpoint syncing from a remote " +
+			"beacon node, its deposit snapshot is used unless this flag is set.",
+	}
 )
 
 // BeaconNodeOptions is responsible for determining if the checkpoint sync options have been used, and if so,
 // reading the block and state ssz-serialized values from the filesystem locations specified and preparing a
 // checkpoint.Initializer, which uses the provided io.ReadClosers to initialize the beacon node database.
 func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
+	var opts []node.Option
+	if snapshotPath := c.Path(DepositSnapshotPath.Name); snapshotPath != "" {
+		opts = append(opts, func(node *node.BeaconNode) (err error) {
+			node.DepositSnapshotProvider, err = checkpoint.NewFileDepositSnapshot(snapshotPath)
+			if err != nil {
+				return errors.Wrap(err, "error preparing to read deposit snapshot file")
+			}
+			return nil
+		})
+	}
+
 	blockPath := c.Path(BlockPath.Name)
 	statePath := c.Path(StatePath.Name)
 	remoteURL := c.String(RemoteURL.Name)
 	if remoteURL != "" {
 		opt := func(node *node.BeaconNode) error {
-			var err error
-			node.CheckpointInitializer, err = checkpoint.NewAPIInitializer(remoteURL)
+			initializer, err := checkpoint.NewAPIInitializer(remoteURL)
 			if err != nil {
 				return errors.Wrap(err, "error while constructing beacon node api client for checkpoint sync")
 			}
+			node.CheckpointInitializer = initializer
+			// The deposit snapshot of the checkpoint sync origin is used unless one was provided as a file.
+			if node.DepositSnapshotProvider == nil {
+				node.DepositSnapshotProvider = initializer
+			}
 			return nil
 		}
-		return []node.Option{opt}, nil
+		return append(opts, opt), nil
 	}
 
 	if blockPath == "" && statePath == "" {
-		return nil, nil
+		return opts, nil
 	}
 	if blockPath != "" && statePath == "" {
 		return nil, fmt.Errorf("--checkpoint-block specified, but not --checkpoint-state. both are required")
@@ -66,5 +89,5 @@ func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 		}
 		return nil
 	}
-	return []node.Option{opt}, nil
+	return append(opts, opt), nil
 }
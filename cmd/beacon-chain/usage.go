@@ -71,6 +71,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.ClearDB,
 			cmd.ConfigFileFlag,
 			cmd.ChainConfigFileFlag,
+			cmd.ChainPresetFileFlag,
 			cmd.GrpcMaxCallRecvMsgSizeFlag,
 			cmd.AcceptTosFlag,
 			cmd.RestoreSourceFileFlag,
@@ -100,23 +101,29 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ContractDeploymentBlock,
 			flags.RPCHost,
 			flags.RPCPort,
+			flags.RPCUnixSocket,
 			flags.CertFlag,
 			flags.KeyFlag,
 			flags.HTTPModules,
 			flags.HTTPServerHost,
 			flags.HTTPServerPort,
+			flags.HTTPServerUnixSocket,
+			flags.UnixSocketPermissions,
 			flags.HTTPServerCorsDomain,
 			flags.ExecutionEngineEndpoint,
 			flags.ExecutionEngineHeaders,
+			flags.ExecutionEngineFailoverTimeout,
 			flags.ExecutionJWTSecretFlag,
 			flags.SetGCPercent,
 			flags.SlotsPerArchivedPoint,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
+			flags.InitialSyncVerification,
 			flags.BlobBatchLimit,
 			flags.BlobBatchLimitBurstFactor,
 			flags.DisableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
+			flags.CustodyRequirementFlag,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
 			flags.NetworkID,
@@ -129,6 +136,18 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
+			flags.SlasherMemoryBudgetFlag,
+			flags.StrictStartupFlag,
+			flags.DBSnapshotDirFlag,
+			flags.DisableDBSnapshotFlag,
+			flags.DBSnapshotRetentionFlag,
+			flags.OperationTotalsIndexFlag,
+			flags.DisableArchivalAPIQueriesFlag,
+			flags.HTTPAdminTokenFileFlag,
+			flags.SlotOutcomesRetentionFlag,
+			flags.GraffitiStatsEpochsFlag,
+			flags.GraffitiStatsTopFlag,
+			flags.GraffitiStatsLogFlag,
 			flags.LocalBlockValueBoost,
 			flags.MinBuilderBid,
 			flags.MinBuilderDiff,
@@ -136,6 +155,7 @@ var appHelpFlagGroups = []flagGroup{
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
+			checkpoint.DepositSnapshotPath,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
 			storage.BlobStoragePathFlag,
@@ -144,6 +164,7 @@ var appHelpFlagGroups = []flagGroup{
 			backfill.BackfillWorkerCount,
 			backfill.BackfillBatchSize,
 			backfill.BackfillOldestSlot,
+			backfill.BackfillBlocksPerSecond,
 		},
 	},
 	{
@@ -168,6 +189,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
 			cmd.PubsubQueueSize,
+			cmd.PubsubPropagationWindow,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
@@ -191,6 +191,13 @@ var (
 		Usage: "The size of the pubsub validation and outbound queue for the node.",
 		Value: 1000,
 	}
+	// PubsubPropagationWindow defines how long to wait for a published message to be seen at another peer.
+	PubsubPropagationWindow = &cli.DurationFlag{
+		Name: "pubsub-propagation-window",
+		Usage: "How long to wait for a published block, aggregate or sync contribution to be received from or " +
+			"announced by another peer before warning that it may not have propagated. Set to 0 to disable.",
+		Value: 4 * time.Second,
+	}
 	// ForceClearDB removes any previously stored data at the data directory.
 	ForceClearDB = &cli.BoolFlag{
 		Name:  "force-clear-db",
@@ -230,8 +237,14 @@ var (
 	}
 	// ChainConfigFileFlag specifies the filepath to load flag values.
 	ChainConfigFileFlag = &cli.StringFlag{
-		Name:  "chain-config-file",
-		Usage: "Path to a YAML file with chain config values.",
+		Name: "chain-config-file",
+		Usage: "Path to a YAML file with chain config values, or to a directory with a config.yaml file " +
+			"and optional preset files in presets/<PRESET_BASE>/.",
+	}
+	// ChainPresetFileFlag specifies preset files overriding the preset values of the chain config file.
+	ChainPresetFileFlag = &cli.StringSliceFlag{
+		Name:  "chain-preset-file",
+		Usage: "Path to a YAML file with preset values overriding the PRESET_BASE preset of --chain-config-file. Can be repeated.",
 	}
 	// GrpcMaxCallRecvMsgSizeFlag defines the max call message size for GRPC
 	GrpcMaxCallRecvMsgSizeFlag = &cli.IntFlag{
@@ -267,6 +280,26 @@ var (
 		Usage: "Target directory of the restored database",
 		Value: DefaultDataDir(),
 	}
+	// PruneBeforeSlotFlag specifies the slot before which blocks and states are pruned from the database.
+	PruneBeforeSlotFlag = &cli.Uint64Flag{
+		Name:  "before-slot",
+		Usage: "Prunes the blocks and states of the slots before this slot, which cannot be after the finalized block",
+	}
+	// PruneBeforeEpochFlag specifies the epoch before which blobs are pruned from blob storage.
+	PruneBeforeEpochFlag = &cli.Uint64Flag{
+		Name:  "before-epoch",
+		Usage: "Prunes the blobs of the epochs before this epoch, except for the blobs within the data availability window",
+	}
+	// PruneKeepFinalizedOnlyFlag prunes the blocks and states of the slots before the finalized block.
+	PruneKeepFinalizedOnlyFlag = &cli.BoolFlag{
+		Name:  "keep-finalized-only",
+		Usage: "Prunes the blocks and states of the slots before the finalized block",
+	}
+	// PruneDryRunFlag reports what pruning would delete, without modifying the database.
+	PruneDryRunFlag = &cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "Reports the blocks and states that would be pruned, without modifying the database",
+	}
 	// ApiTimeoutFlag specifies the timeout value for API requests in seconds. A timeout of zero means no timeout.
 	ApiTimeoutFlag = &cli.DurationFlag{
 		Name:  "api-timeout",
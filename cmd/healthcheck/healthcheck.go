@@ -0,0 +1,83 @@
+// Package healthcheck provides the building blocks of the healthcheck subcommands of the Prysm binaries,
+// which query the HTTP API of a local daemon and exit with a non-zero code if it is unhealthy, so that
+// container orchestrators can use them without extra tooling in the image.
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+)
+
+// TimeoutFlag bounds the time a health check may take, whatever the state of the daemon.
+var TimeoutFlag = &cli.DurationFlag{
+	Name:  "healthcheck-timeout",
+	Usage: "Maximum time the health check may take before the daemon is reported unhealthy.",
+	Value: 2 * time.Second,
+}
+
+// Check queries a daemon and returns a short description of its state, or an error explaining why it is unhealthy.
+type Check func(ctx context.Context, c *Client) (string, error)
+
+// Client queries the HTTP API of a local daemon.
+type Client struct {
+	baseURL   string
+	authToken string
+	http      *http.Client
+}
+
+// NewClient returns a client for the HTTP API served at the given host and port. The auth token, if not empty,
+// is sent as a bearer token.
+func NewClient(host string, port int, authToken string) *Client {
+	return &Client{
+		baseURL:   "http://" + net.JoinHostPort(host, strconv.Itoa(port)),
+		authToken: authToken,
+		http:      &http.Client{},
+	}
+}
+
+// Get requests the path and decodes the JSON response into v when it is not nil and the request succeeded.
+// The status code of the response is returned.
+func (c *Client) Get(ctx context.Context, path string, v interface{}) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return 0, err
+	}
+	if c.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.authToken)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not reach %s", c.baseURL)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if v == nil || resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return resp.StatusCode, errors.Wrapf(err, "could not decode response of %s", path)
+	}
+	return resp.StatusCode, nil
+}
+
+// Run runs the check against the client within the timeout given by TimeoutFlag and prints a one line result.
+// An unhealthy daemon is reported with an error making the process exit with code 1.
+func Run(cliCtx *cli.Context, c *Client, check Check) error {
+	ctx, cancel := context.WithTimeout(cliCtx.Context, cliCtx.Duration(TimeoutFlag.Name))
+	defer cancel()
+	state, err := check(ctx, c)
+	if err != nil {
+		return cli.Exit("unhealthy: "+err.Error(), 1)
+	}
+	_, err = fmt.Fprintln(cliCtx.App.Writer, "healthy: "+state)
+	return err
+}
@@ -0,0 +1,67 @@
+package healthcheck
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/urfave/cli/v2"
+)
+
+// newTestClient returns a client for the test server.
+func newTestClient(t *testing.T, srv *httptest.Server, authToken string) *Client {
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+	return NewClient(u.Hostname(), port, authToken)
+}
+
+func TestRun(t *testing.T) {
+	newContext := func(out *bytes.Buffer) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.Duration(TimeoutFlag.Name, 100*time.Millisecond, "")
+		return cli.NewContext(&cli.App{Writer: out}, set, nil)
+	}
+
+	t.Run("healthy", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		}))
+		defer srv.Close()
+		out := &bytes.Buffer{}
+		err := Run(newContext(out), newTestClient(t, srv, "token"), func(ctx context.Context, c *Client) (string, error) {
+			code, err := c.Get(ctx, "/", nil)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, code)
+			return "ok", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "healthy: ok\n", out.String())
+	})
+	t.Run("bounded by the timeout", func(t *testing.T) {
+		release := make(chan struct{})
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer srv.Close()
+		defer close(release)
+		start := time.Now()
+		err := Run(newContext(&bytes.Buffer{}), newTestClient(t, srv, ""), func(ctx context.Context, c *Client) (string, error) {
+			_, err := c.Get(ctx, "/", nil)
+			return "", err
+		})
+		require.ErrorContains(t, "unhealthy: could not reach", err)
+		exitErr, ok := err.(cli.ExitCoder)
+		require.Equal(t, true, ok)
+		require.Equal(t, 1, exitErr.ExitCode())
+		require.Equal(t, true, time.Since(start) < time.Second)
+	})
+}
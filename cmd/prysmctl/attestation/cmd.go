@@ -0,0 +1,14 @@
+package attestation
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:    "attestation",
+		Aliases: []string{"att"},
+		Usage:   "commands for debugging attestations",
+		Subcommands: []*cli.Command{
+			inclusionTraceCmd,
+		},
+	},
+}
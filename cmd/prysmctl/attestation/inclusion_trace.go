@@ -0,0 +1,424 @@
+package attestation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var inclusionTraceFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+	ValidatorIndex uint64
+	Epoch          uint64
+}{}
+
+var inclusionTraceCmd = &cli.Command{
+	Name:  "inclusion-trace",
+	Usage: "Trace whether and where the attestation of a validator for an epoch was included in the canonical chain, with its inclusion delay and the correctness of its head, target and source votes.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionInclusionTrace(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not trace attestation inclusion")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node to query",
+			Destination: &inclusionTraceFlags.BeaconNodeHost,
+			Value:       "http://localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-host (uses duration format, ex: 2m31s). default: 2m",
+			Destination: &inclusionTraceFlags.Timeout,
+			Value:       time.Minute * 2,
+		},
+		&cli.Uint64Flag{
+			Name:        "validator-index",
+			Usage:       "index of the validator whose attestation is traced",
+			Destination: &inclusionTraceFlags.ValidatorIndex,
+			Required:    true,
+		},
+		&cli.Uint64Flag{
+			Name:        "epoch",
+			Usage:       "epoch of the traced attestation",
+			Destination: &inclusionTraceFlags.Epoch,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionInclusionTrace(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	c, err := beacon.NewClient(inclusionTraceFlags.BeaconNodeHost, client.WithTimeout(inclusionTraceFlags.Timeout))
+	if err != nil {
+		return err
+	}
+	// Slots, epochs and block types depend on the network of the beacon node.
+	fork, err := c.GetFork(ctx, beacon.IdHead)
+	if err != nil {
+		return errors.Wrap(err, "could not get the fork of the beacon node")
+	}
+	cfg, err := params.ByVersion(bytesutil.ToBytes4(fork.CurrentVersion))
+	if err != nil {
+		return errors.Wrap(err, "could not find the config of the network of the beacon node")
+	}
+	if err := params.SetActive(cfg); err != nil {
+		return err
+	}
+	tr, err := traceInclusion(ctx, c, primitives.ValidatorIndex(inclusionTraceFlags.ValidatorIndex), primitives.Epoch(inclusionTraceFlags.Epoch))
+	if err != nil {
+		return err
+	}
+	return printTrace(os.Stdout, tr)
+}
+
+// inclusionTraceClient is the subset of the beacon API client used to trace the inclusion of an attestation.
+type inclusionTraceClient interface {
+	GetAttesterDuties(ctx context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex) (*structs.GetAttesterDutiesResponse, error)
+	GetBlock(ctx context.Context, blockId beacon.StateOrBlockId) ([]byte, error)
+	GetBlockRoot(ctx context.Context, blockId beacon.StateOrBlockId) ([32]byte, error)
+	GetCommittees(ctx context.Context, stateId beacon.StateOrBlockId, slot primitives.Slot) ([]*structs.Committee, error)
+}
+
+// attesterDuty is the committee position of the validator in the traced epoch.
+type attesterDuty struct {
+	validatorIndex   primitives.ValidatorIndex
+	slot             primitives.Slot
+	committeeIndex   primitives.CommitteeIndex
+	committeeLength  uint64
+	committeesAtSlot uint64
+	// position is the index of the validator in its committee, which is the index of its aggregation bit in
+	// attestations for that committee only.
+	position uint64
+}
+
+// tracedBlock is a canonical block of the traced epochs.
+type tracedBlock struct {
+	slot         primitives.Slot
+	root         [32]byte
+	attestations []ethpb.Att
+}
+
+// inclusion is an aggregate included in a block with the bit of the validator set.
+type inclusion struct {
+	blockSlot primitives.Slot
+	blockRoot [32]byte
+	delay     primitives.Slot
+	data      *ethpb.AttestationData
+}
+
+type inclusionTrace struct {
+	epoch primitives.Epoch
+	duty  *attesterDuty
+	// firstSlot and lastSlot bound the slots whose blocks were scanned, the blocks of the traced epoch and of the
+	// following one, where the attestation can be included.
+	firstSlot, lastSlot primitives.Slot
+	blocks              []*tracedBlock
+	// aggregates is the number of aggregates for the slot and committee of the validator found in the blocks.
+	aggregates int
+	inclusions []*inclusion
+	// headRoot and targetRoot are the canonical roots a correct attestation of the validator votes for.
+	headRoot, targetRoot [32]byte
+}
+
+func traceInclusion(ctx context.Context, c inclusionTraceClient, idx primitives.ValidatorIndex, epoch primitives.Epoch) (*inclusionTrace, error) {
+	duty, err := fetchAttesterDuty(ctx, c, idx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	firstSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, err
+	}
+	tr := &inclusionTrace{
+		epoch:     epoch,
+		duty:      duty,
+		firstSlot: firstSlot,
+		lastSlot:  firstSlot + 2*params.BeaconConfig().SlotsPerEpoch - 1,
+	}
+	if tr.blocks, err = fetchBlocks(ctx, c, tr.firstSlot, tr.lastSlot); err != nil {
+		return nil, err
+	}
+	if tr.headRoot, err = rootAtOrBefore(ctx, c, tr.blocks, duty.slot); err != nil {
+		return nil, errors.Wrapf(err, "could not get the head block root at slot %d", duty.slot)
+	}
+	if tr.targetRoot, err = rootAtOrBefore(ctx, c, tr.blocks, firstSlot); err != nil {
+		return nil, errors.Wrapf(err, "could not get the target block root of epoch %d", epoch)
+	}
+
+	var lengths []uint64
+	committeeLengths := func() ([]uint64, error) {
+		if lengths != nil {
+			return lengths, nil
+		}
+		committees, err := c.GetCommittees(ctx, beacon.IdFromSlot(duty.slot), duty.slot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get the committees of slot %d", duty.slot)
+		}
+		lengths = make([]uint64, len(committees))
+		for _, committee := range committees {
+			ci, err := strconv.ParseUint(committee.Index, 10, 64)
+			if err != nil || ci >= uint64(len(lengths)) {
+				return nil, errors.Errorf("invalid committee index %q", committee.Index)
+			}
+			lengths[ci] = uint64(len(committee.Validators))
+		}
+		return lengths, nil
+	}
+	for _, b := range tr.blocks {
+		for _, att := range b.attestations {
+			bit, ok, err := attestationBitIndex(att, duty, committeeLengths)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not locate the validator in an attestation of block at slot %d", b.slot)
+			}
+			if !ok {
+				continue
+			}
+			tr.aggregates++
+			bits := att.GetAggregationBits()
+			if bit >= bits.Len() || !bits.BitAt(bit) {
+				continue
+			}
+			tr.inclusions = append(tr.inclusions, &inclusion{
+				blockSlot: b.slot,
+				blockRoot: b.root,
+				delay:     b.slot - duty.slot,
+				data:      att.GetData(),
+			})
+		}
+	}
+	return tr, nil
+}
+
+func fetchAttesterDuty(ctx context.Context, c inclusionTraceClient, idx primitives.ValidatorIndex, epoch primitives.Epoch) (*attesterDuty, error) {
+	resp, err := c.GetAttesterDuties(ctx, epoch, []primitives.ValidatorIndex{idx})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the attester duty of validator %d in epoch %d", idx, epoch)
+	}
+	if len(resp.Data) == 0 {
+		return nil, errors.Errorf("validator %d has no attester duty in epoch %d, it was not active", idx, epoch)
+	}
+	d := resp.Data[0]
+	values := make([]uint64, 5)
+	for i, f := range []struct{ name, value string }{
+		{"slot", d.Slot},
+		{"committee_index", d.CommitteeIndex},
+		{"committee_length", d.CommitteeLength},
+		{"committees_at_slot", d.CommitteesAtSlot},
+		{"validator_committee_index", d.ValidatorCommitteeIndex},
+	} {
+		if values[i], err = strconv.ParseUint(f.value, 10, 64); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s in attester duty", f.name)
+		}
+	}
+	return &attesterDuty{
+		validatorIndex:   idx,
+		slot:             primitives.Slot(values[0]),
+		committeeIndex:   primitives.CommitteeIndex(values[1]),
+		committeeLength:  values[2],
+		committeesAtSlot: values[3],
+		position:         values[4],
+	}, nil
+}
+
+// fetchBlocks returns the canonical blocks between the given slots, in slot order. Empty slots are skipped.
+func fetchBlocks(ctx context.Context, c inclusionTraceClient, firstSlot, lastSlot primitives.Slot) ([]*tracedBlock, error) {
+	schedule := forks.NewOrderedSchedule(params.BeaconConfig())
+	unmarshalers := make(map[[4]byte]*detect.VersionedUnmarshaler)
+	var blks []*tracedBlock
+	for slot := firstSlot; slot <= lastSlot; slot++ {
+		marshaled, err := c.GetBlock(ctx, beacon.IdFromSlot(slot))
+		if errors.Is(err, client.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get block at slot %d", slot)
+		}
+		v, err := schedule.VersionForEpoch(slots.ToEpoch(slot))
+		if err != nil {
+			return nil, err
+		}
+		vu, ok := unmarshalers[v]
+		if !ok {
+			if vu, err = detect.FromForkVersion(v); err != nil {
+				return nil, err
+			}
+			unmarshalers[v] = vu
+		}
+		b, err := vu.UnmarshalBeaconBlock(marshaled)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal block at slot %d", slot)
+		}
+		root, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		blks = append(blks, &tracedBlock{
+			slot:         b.Block().Slot(),
+			root:         root,
+			attestations: b.Block().Body().Attestations(),
+		})
+	}
+	return blks, nil
+}
+
+// rootAtOrBefore returns the root of the latest canonical block at or before the given slot. The blocks of the
+// traced epochs are used when possible, otherwise the beacon node is asked for the roots of the previous slots.
+func rootAtOrBefore(ctx context.Context, c inclusionTraceClient, blks []*tracedBlock, slot primitives.Slot) ([32]byte, error) {
+	for i := len(blks) - 1; i >= 0; i-- {
+		if blks[i].slot <= slot {
+			return blks[i].root, nil
+		}
+	}
+	for s := slot; ; s-- {
+		root, err := c.GetBlockRoot(ctx, beacon.IdFromSlot(s))
+		if err == nil {
+			return root, nil
+		}
+		if !errors.Is(err, client.ErrNotFound) {
+			return [32]byte{}, err
+		}
+		if s == 0 || slot-s >= params.BeaconConfig().SlotsPerEpoch {
+			return [32]byte{}, errors.Errorf("no block found in the epoch before slot %d", slot)
+		}
+	}
+}
+
+// attestationBitIndex returns the index of the aggregation bit of the validator in the given attestation. It returns
+// false if the attestation is not for the slot and committee of the validator. Since Electra, an attestation can
+// aggregate several committees of its slot, and its aggregation bits are the concatenation of the bits of the
+// committees set in its committee bits, in committee index order.
+func attestationBitIndex(att ethpb.Att, duty *attesterDuty, committeeLengths func() ([]uint64, error)) (uint64, bool, error) {
+	if att.GetData().Slot != duty.slot {
+		return 0, false, nil
+	}
+	if att.Version() < version.Electra {
+		if att.GetData().CommitteeIndex != duty.committeeIndex {
+			return 0, false, nil
+		}
+		return duty.position, true, nil
+	}
+	committeeBits := att.CommitteeBitsVal()
+	if uint64(duty.committeeIndex) >= committeeBits.Len() || !committeeBits.BitAt(uint64(duty.committeeIndex)) {
+		return 0, false, nil
+	}
+	lengths, err := committeeLengths()
+	if err != nil {
+		return 0, false, err
+	}
+	var offset uint64
+	for _, ci := range committeeBits.BitIndices() {
+		if primitives.CommitteeIndex(ci) == duty.committeeIndex {
+			break
+		}
+		if ci >= len(lengths) {
+			return 0, false, errors.Errorf("attestation has committee %d, but slot %d only has %d committees", ci, duty.slot, len(lengths))
+		}
+		offset += lengths[ci]
+	}
+	return offset + duty.position, true, nil
+}
+
+func printTrace(out io.Writer, tr *inclusionTrace) error {
+	d := tr.duty
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	lines := []string{
+		fmt.Sprintf("Validator:\t%d", d.validatorIndex),
+		fmt.Sprintf("Epoch:\t%d", tr.epoch),
+		fmt.Sprintf("Duty:\tslot %d, committee %d of %d, position %d of %d", d.slot, d.committeeIndex, d.committeesAtSlot, d.position, d.committeeLength),
+		fmt.Sprintf("Correct head:\t%#x", tr.headRoot),
+		fmt.Sprintf("Correct target:\tepoch %d, root %#x", tr.epoch, tr.targetRoot),
+		fmt.Sprintf("Scanned:\tslots %d to %d, %d blocks, %d aggregates for the committee", tr.firstSlot, tr.lastSlot, len(tr.blocks), tr.aggregates),
+	}
+	for _, l := range lines {
+		if _, err := fmt.Fprintln(w, l); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(tr.inclusions) == 0 {
+		_, err := fmt.Fprintln(out, "\n"+neverIncludedReason(tr))
+		return err
+	}
+	if _, err := fmt.Fprintf(out, "\nIncluded in %d aggregates:\n", len(tr.inclusions)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "BLOCK SLOT\tBLOCK ROOT\tINCLUSION DELAY\tHEAD\tTARGET\tSOURCE"); err != nil {
+		return err
+	}
+	for _, inc := range tr.inclusions {
+		// Blocks only include attestations whose source is the justified checkpoint of their state.
+		if _, err := fmt.Fprintf(w, "%d\t%#x\t%d\t%s\t%s\t%s\n",
+			inc.blockSlot,
+			inc.blockRoot,
+			inc.delay,
+			voteCorrectness(bytes.Equal(inc.data.BeaconBlockRoot, tr.headRoot[:])),
+			voteCorrectness(inc.data.Target.Epoch == tr.epoch && bytes.Equal(inc.data.Target.Root, tr.targetRoot[:])),
+			fmt.Sprintf("correct (epoch %d)", inc.data.Source.Epoch),
+		); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func voteCorrectness(correct bool) string {
+	if correct {
+		return "correct"
+	}
+	return "wrong"
+}
+
+// neverIncludedReason describes what the scanned blocks show about an attestation that was never included.
+func neverIncludedReason(tr *inclusionTrace) string {
+	d := tr.duty
+	proposed := make(map[primitives.Slot]bool, len(tr.blocks))
+	for _, b := range tr.blocks {
+		proposed[b.slot] = true
+	}
+	var missed []primitives.Slot
+	for s := d.slot + 1; s <= d.slot+params.BeaconConfig().SlotsPerEpoch && s <= tr.lastSlot; s++ {
+		if !proposed[s] {
+			missed = append(missed, s)
+		}
+	}
+	msg := fmt.Sprintf("The attestation was never included in the canonical blocks of epochs %d and %d.", tr.epoch, tr.epoch+1)
+	if tr.aggregates == 0 {
+		msg += fmt.Sprintf("\nNo aggregate for slot %d and committee %d was included, the attestations of the whole committee were lost.", d.slot, d.committeeIndex)
+	} else {
+		msg += fmt.Sprintf("\n%d aggregates for slot %d and committee %d were included, none of them with the bit of the validator set: the attestation was late, not broadcast, or not seen by the aggregators and proposers.", tr.aggregates, d.slot, d.committeeIndex)
+	}
+	if len(missed) > 0 {
+		msg += fmt.Sprintf("\nSlots without a block in the epoch after the duty: %v.", missed)
+	}
+	return msg
+}
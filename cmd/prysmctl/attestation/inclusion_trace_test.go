@@ -0,0 +1,151 @@
+package attestation
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockTraceClient struct {
+	duties *structs.GetAttesterDutiesResponse
+	blocks map[primitives.Slot][]byte
+}
+
+func (m *mockTraceClient) GetAttesterDuties(context.Context, primitives.Epoch, []primitives.ValidatorIndex) (*structs.GetAttesterDutiesResponse, error) {
+	return m.duties, nil
+}
+
+func (m *mockTraceClient) GetBlock(_ context.Context, blockId beacon.StateOrBlockId) ([]byte, error) {
+	for slot, b := range m.blocks {
+		if beacon.IdFromSlot(slot) == blockId {
+			return b, nil
+		}
+	}
+	return nil, client.ErrNotFound
+}
+
+func (m *mockTraceClient) GetBlockRoot(context.Context, beacon.StateOrBlockId) ([32]byte, error) {
+	return [32]byte{}, client.ErrNotFound
+}
+
+func (m *mockTraceClient) GetCommittees(context.Context, beacon.StateOrBlockId, primitives.Slot) ([]*structs.Committee, error) {
+	return nil, errors.New("committees are only needed for Electra attestations")
+}
+
+func aggregationBits(length uint64, set ...uint64) bitfield.Bitlist {
+	bits := bitfield.NewBitlist(length)
+	for _, i := range set {
+		bits.SetBitAt(i, true)
+	}
+	return bits
+}
+
+func TestAttestationBitIndex(t *testing.T) {
+	duty := &attesterDuty{slot: 33, committeeIndex: 3, committeeLength: 7, position: 2}
+	lengths := func() ([]uint64, error) {
+		return []uint64{4, 5, 6, 7}, nil
+	}
+
+	phase0 := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 33, CommitteeIndex: 3}})
+	bit, ok, err := attestationBitIndex(phase0, duty, lengths)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint64(2), bit)
+
+	phase0.Data.CommitteeIndex = 2
+	_, ok, err = attestationBitIndex(phase0, duty, lengths)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	committeeBits := primitives.NewAttestationCommitteeBits()
+	committeeBits.SetBitAt(1, true)
+	committeeBits.SetBitAt(3, true)
+	electra := util.HydrateAttestationElectra(&ethpb.AttestationElectra{Data: &ethpb.AttestationData{Slot: 33}, CommitteeBits: committeeBits})
+	bit, ok, err = attestationBitIndex(electra, duty, lengths)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	// The bits of committee 1 come first.
+	assert.Equal(t, uint64(5+2), bit)
+
+	electra.Data.Slot = 34
+	_, ok, err = attestationBitIndex(electra, duty, lengths)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+}
+
+func TestTraceInclusion(t *testing.T) {
+	newBlock := func(slot primitives.Slot, atts ...*ethpb.Attestation) (*ethpb.SignedBeaconBlock, [32]byte) {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.Body.Attestations = atts
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		return b, root
+	}
+	target, targetRoot := newBlock(32)
+	head, headRoot := newBlock(33)
+	data := util.HydrateAttestationData(&ethpb.AttestationData{
+		Slot:            33,
+		CommitteeIndex:  2,
+		BeaconBlockRoot: headRoot[:],
+		Target:          &ethpb.Checkpoint{Epoch: 1, Root: targetRoot[:]},
+	})
+	// The first aggregate of the committee does not include the validator, the second one does.
+	without, _ := newBlock(34, util.HydrateAttestation(&ethpb.Attestation{AggregationBits: aggregationBits(4, 0, 2), Data: data}))
+	with, withRoot := newBlock(36, util.HydrateAttestation(&ethpb.Attestation{AggregationBits: aggregationBits(4, 1, 3), Data: data}))
+
+	c := &mockTraceClient{
+		duties: &structs.GetAttesterDutiesResponse{Data: []*structs.AttesterDuty{{
+			ValidatorIndex:          "7",
+			Slot:                    "33",
+			CommitteeIndex:          "2",
+			CommitteeLength:         "4",
+			CommitteesAtSlot:        "3",
+			ValidatorCommitteeIndex: "1",
+		}}},
+		blocks: make(map[primitives.Slot][]byte),
+	}
+	for _, b := range []*ethpb.SignedBeaconBlock{target, head, without, with} {
+		marshaled, err := b.MarshalSSZ()
+		require.NoError(t, err)
+		c.blocks[b.Block.Slot] = marshaled
+	}
+
+	tr, err := traceInclusion(context.Background(), c, 7, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(tr.blocks))
+	assert.Equal(t, 2, tr.aggregates)
+	assert.Equal(t, headRoot, tr.headRoot)
+	assert.Equal(t, targetRoot, tr.targetRoot)
+	require.Equal(t, 1, len(tr.inclusions))
+	assert.Equal(t, primitives.Slot(36), tr.inclusions[0].blockSlot)
+	assert.Equal(t, withRoot, tr.inclusions[0].blockRoot)
+	assert.Equal(t, primitives.Slot(3), tr.inclusions[0].delay)
+
+	out := &bytes.Buffer{}
+	require.NoError(t, printTrace(out, tr))
+	assert.StringContains(t, "Included in 1 aggregates", out.String())
+	assert.StringContains(t, "correct  correct  correct (epoch 0)", out.String())
+
+	// Without the second aggregate, the attestation of the validator was never included.
+	delete(c.blocks, 36)
+	tr, err = traceInclusion(context.Background(), c, 7, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(tr.inclusions))
+	out.Reset()
+	require.NoError(t, printTrace(out, tr))
+	assert.StringContains(t, "never included", out.String())
+	assert.StringContains(t, "1 aggregates for slot 33 and committee 2 were included", out.String())
+	assert.StringContains(t, "Slots without a block in the epoch after the duty: [35 36", out.String())
+}
@@ -14,6 +14,7 @@ import (
 var downloadFlags = struct {
 	BeaconNodeHost string
 	Timeout        time.Duration
+	MaxAttempts    int
 }{}
 
 var downloadCmd = &cli.Command{
@@ -39,6 +40,12 @@ var downloadCmd = &cli.Command{
 			Destination: &downloadFlags.Timeout,
 			Value:       time.Minute * 4,
 		},
+		&cli.IntFlag{
+			Name:        "http-max-attempts",
+			Usage:       "maximum number of attempts for each http request that fails with a transient error, such as a 502 from a load balancer",
+			Destination: &downloadFlags.MaxAttempts,
+			Value:       client.DefaultRetryConfig().MaxAttempts,
+		},
 	},
 }
 
@@ -46,7 +53,9 @@ func cliActionDownload(_ *cli.Context) error {
 	ctx := context.Background()
 	f := downloadFlags
 
-	opts := []client.ClientOpt{client.WithTimeout(f.Timeout)}
+	rc := client.DefaultRetryConfig()
+	rc.MaxAttempts = f.MaxAttempts
+	opts := []client.ClientOpt{client.WithTimeout(f.Timeout), client.WithRetry(rc)}
 	client, err := beacon.NewClient(downloadFlags.BeaconNodeHost, opts...)
 	if err != nil {
 		return err
@@ -0,0 +1,13 @@
+package config
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:  "config",
+		Usage: "commands dealing with the configuration of a beacon node",
+		Subcommands: []*cli.Command{
+			effectiveCmd,
+		},
+	},
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/effective"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var effectiveFlags = struct {
+	BeaconNodeHost string
+	Timeout        time.Duration
+	Diff           bool
+}{}
+
+var effectiveCmd = &cli.Command{
+	Name:  "effective",
+	Usage: "Fetch the complete configuration a beacon node is running with, including feature flags and sanitized command line flags, suitable for attaching to bug reports.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionEffective(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not fetch the effective config")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "beacon-node-host",
+			Usage:       "host:port for beacon node to query",
+			Destination: &effectiveFlags.BeaconNodeHost,
+			Value:       "http://localhost:3500",
+		},
+		&cli.DurationFlag{
+			Name:        "http-timeout",
+			Usage:       "timeout for http requests made to beacon-node-host (uses duration format, ex: 2m31s). default: 2m",
+			Destination: &effectiveFlags.Timeout,
+			Value:       time.Minute * 2,
+		},
+		&cli.BoolFlag{
+			Name:        "diff",
+			Usage:       "only print the beacon chain and network config values which differ from the built-in mainnet config",
+			Destination: &effectiveFlags.Diff,
+		},
+	},
+}
+
+func cliActionEffective(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	c, err := beacon.NewClient(effectiveFlags.BeaconNodeHost, client.WithTimeout(effectiveFlags.Timeout))
+	if err != nil {
+		return err
+	}
+	resp, err := c.GetEffectiveConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if resp.Data == nil {
+		return errors.New("beacon node returned an empty config")
+	}
+	if effectiveFlags.Diff {
+		return printMainnetDiff(os.Stdout, resp.Data)
+	}
+	encoded, err := json.MarshalIndent(resp.Data, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal config")
+	}
+	fmt.Println(string(encoded))
+	return nil
+}
+
+// printMainnetDiff writes the beacon chain and network config values of the node which differ
+// from the mainnet config built into this binary.
+func printMainnetDiff(out io.Writer, cfg *structs.EffectiveConfig) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(w, "SECTION\tKEY\tMAINNET\tNODE"); err != nil {
+		return err
+	}
+	sections := []struct {
+		name     string
+		expected map[string]string
+		actual   map[string]string
+	}{
+		{"beacon_config", effective.BeaconConfigFields(params.MainnetConfig()), cfg.BeaconConfig},
+		{"network_config", effective.NetworkConfigFields(params.MainnetNetworkConfig()), cfg.NetworkConfig},
+	}
+	for _, s := range sections {
+		for _, d := range effective.Diff(s.expected, s.actual) {
+			if _, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.name, d.Key, d.Expected, d.Actual); err != nil {
+				return err
+			}
+		}
+	}
+	return w.Flush()
+}
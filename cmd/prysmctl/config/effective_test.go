@@ -0,0 +1,31 @@
+package config
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/effective"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestPrintMainnetDiff(t *testing.T) {
+	cfg := params.MainnetConfig().Copy()
+	cfg.TerminalBlockHashActivationEpoch = 1234
+	network := params.MainnetNetworkConfig()
+	network.BootstrapNodes = []string{"enr:-abc"}
+
+	out := &bytes.Buffer{}
+	require.NoError(t, printMainnetDiff(out, &structs.EffectiveConfig{
+		BeaconConfig:  effective.BeaconConfigFields(cfg),
+		NetworkConfig: effective.NetworkConfigFields(network),
+	}))
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Equal(t, 3, len(lines))
+	assert.StringContains(t, "TerminalBlockHashActivationEpoch", string(lines[1]))
+	assert.StringContains(t, "1234", string(lines[1]))
+	assert.StringContains(t, "BootstrapNodes", string(lines[2]))
+	assert.StringContains(t, "enr:-abc", string(lines[2]))
+}
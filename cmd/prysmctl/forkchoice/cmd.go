@@ -0,0 +1,14 @@
+package forkchoice
+
+import "github.com/urfave/cli/v2"
+
+var Commands = []*cli.Command{
+	{
+		Name:    "fork-choice",
+		Aliases: []string{"fc"},
+		Usage:   "commands for debugging fork choice",
+		Subcommands: []*cli.Command{
+			simulateCmd,
+		},
+	},
+}
@@ -0,0 +1,77 @@
+package forkchoice
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/simulation"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var simulateFlags = struct {
+	Dump     string
+	Scenario string
+}{}
+
+var simulateCmd = &cli.Command{
+	Name:    "simulate",
+	Aliases: []string{"sim"},
+	Usage:   "Restore a fork choice dump, as served by /eth/v1/debug/fork_choice, and print the head after each step of a scenario.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionSimulate(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not simulate fork choice")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "dump",
+			Usage:       "path to the JSON response of /eth/v1/debug/fork_choice",
+			Destination: &simulateFlags.Dump,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "scenario",
+			Usage:       "optional path to a YAML scenario of blocks, attestations, proposer boosts, justified checkpoints and slots to apply to the dump",
+			Destination: &simulateFlags.Scenario,
+		},
+	},
+}
+
+func cliActionSimulate(_ *cli.Context) error {
+	ctx := context.Background()
+	f := simulateFlags
+
+	data, err := os.ReadFile(f.Dump) // #nosec G304
+	if err != nil {
+		return errors.Wrap(err, "could not read fork choice dump")
+	}
+	dump, err := simulation.DumpFromJSON(data)
+	if err != nil {
+		return err
+	}
+	scenario := &simulation.Scenario{}
+	if f.Scenario != "" {
+		data, err = os.ReadFile(f.Scenario) // #nosec G304
+		if err != nil {
+			return errors.Wrap(err, "could not read scenario")
+		}
+		scenario, err = simulation.ScenarioFromYAML(data)
+		if err != nil {
+			return err
+		}
+	}
+
+	s, err := simulation.NewSimulator(ctx, dump, scenario)
+	if err != nil {
+		return err
+	}
+	results, err := s.Run(ctx, scenario)
+	for _, r := range results {
+		fmt.Println(r)
+	}
+	return err
+}
@@ -3,8 +3,11 @@ package main
 import (
 	"os"
 
+	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/attestation"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/checkpointsync"
+	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/config"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/db"
+	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/forkchoice"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/p2p"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/testnet"
 	"github.com/prysmaticlabs/prysm/v5/cmd/prysmctl/validator"
@@ -26,8 +29,11 @@ func main() {
 }
 
 func init() {
+	prysmctlCommands = append(prysmctlCommands, attestation.Commands...)
 	prysmctlCommands = append(prysmctlCommands, checkpointsync.Commands...)
+	prysmctlCommands = append(prysmctlCommands, config.Commands...)
 	prysmctlCommands = append(prysmctlCommands, db.Commands...)
+	prysmctlCommands = append(prysmctlCommands, forkchoice.Commands...)
 	prysmctlCommands = append(prysmctlCommands, p2p.Commands...)
 	prysmctlCommands = append(prysmctlCommands, testnet.Commands...)
 	prysmctlCommands = append(prysmctlCommands, weaksubjectivity.Commands...)
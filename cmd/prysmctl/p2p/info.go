@@ -0,0 +1,196 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	apiclient "github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/urfave/cli/v2"
+)
+
+var infoFlags = struct {
+	Endpoint       string
+	Timeout        time.Duration
+	AdminTokenFile string
+}{}
+
+var endpointFlag = &cli.StringFlag{
+	Name:        "endpoint",
+	Usage:       "URL of the HTTP API of the beacon node to query",
+	Destination: &infoFlags.Endpoint,
+	Value:       "http://localhost:3500",
+}
+
+var httpTimeoutFlag = &cli.DurationFlag{
+	Name:        "http-timeout",
+	Usage:       "timeout for http requests made to the beacon node (uses duration format, ex: 2m31s). default: 2m",
+	Destination: &infoFlags.Timeout,
+	Value:       time.Minute * 2,
+}
+
+var infoCmd = &cli.Command{
+	Name:  "info",
+	Usage: "Print the ENR, peer id, listening and advertised addresses and the discovery status of a beacon node.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionInfo(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not fetch the p2p info of the node")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{endpointFlag, httpTimeoutFlag},
+}
+
+var refreshENRCmd = &cli.Command{
+	Name: "refresh-enr",
+	Usage: "Make a beacon node re-evaluate its external address and re-sign its ENR with a bumped sequence number, " +
+		"e.g. after the IP address of the node changed.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionRefreshENR(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not refresh the ENR of the node")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		endpointFlag,
+		httpTimeoutFlag,
+		&cli.StringFlag{
+			Name:        "admin-token-file",
+			Usage:       "path to the file holding the admin token of the beacon node, see --http-admin-token-file",
+			Destination: &infoFlags.AdminTokenFile,
+			Required:    true,
+		},
+	},
+}
+
+func cliActionInfo(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	c, err := beacon.NewClient(infoFlags.Endpoint, apiclient.WithTimeout(infoFlags.Timeout))
+	if err != nil {
+		return err
+	}
+	resp, err := c.GetIdentity(ctx)
+	if err != nil {
+		return err
+	}
+	if resp.Data == nil {
+		return errors.New("beacon node returned an empty identity")
+	}
+	return printIdentity(os.Stdout, resp.Data)
+}
+
+func cliActionRefreshENR(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	content, err := os.ReadFile(infoFlags.AdminTokenFile) // #nosec G304 -- The path is provided by the user.
+	if err != nil {
+		return errors.Wrap(err, "could not read admin token file")
+	}
+	token := strings.TrimSpace(string(content))
+	c, err := beacon.NewClient(
+		infoFlags.Endpoint,
+		apiclient.WithTimeout(infoFlags.Timeout),
+		apiclient.WithAuthenticationToken(token),
+	)
+	if err != nil {
+		return err
+	}
+	resp, err := c.RefreshENR(ctx)
+	if err != nil {
+		return err
+	}
+	if resp.Data == nil {
+		return errors.New("beacon node returned an empty ENR")
+	}
+	fmt.Printf("ENR: %s\nENR seq: %s\n", resp.Data.Enr, resp.Data.Seq)
+	return nil
+}
+
+// printIdentity writes the network identity of the node. The advertised addresses are the ones
+// other nodes learn from the ENR, which can differ from the listening addresses behind a NAT.
+func printIdentity(out io.Writer, id *structs.Identity) error {
+	node, err := enode.Parse(enode.ValidSchemes, id.Enr)
+	if err != nil {
+		return errors.Wrap(err, "could not parse ENR")
+	}
+	advertised := make([]string, 0, 2)
+	if node.IP() != nil {
+		ipProtocol := "ip4"
+		if node.IP().To4() == nil {
+			ipProtocol = "ip6"
+		}
+		if node.TCP() != 0 {
+			advertised = append(advertised, fmt.Sprintf("/%s/%s/tcp/%d", ipProtocol, node.IP(), node.TCP()))
+		}
+		if node.UDP() != 0 {
+			advertised = append(advertised, fmt.Sprintf("/%s/%s/udp/%d", ipProtocol, node.IP(), node.UDP()))
+		}
+	}
+	discovery, err := discoveryStatus(id.DiscoveryAddresses)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	rows := [][2]string{
+		{"Peer ID", id.PeerId},
+		{"ENR", id.Enr},
+		{"ENR seq", strconv.FormatUint(node.Seq(), 10)},
+		{"Listening addresses", strings.Join(id.P2PAddresses, ", ")},
+		{"Advertised addresses", strings.Join(advertised, ", ")},
+		{"Discovery", discovery},
+	}
+	for _, r := range rows {
+		if _, err := fmt.Fprintf(w, "%s:\t%s\n", r[0], r[1]); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// discoveryStatus describes whether discovery is running, based on the discovery addresses the node reports.
+func discoveryStatus(addresses []string) (string, error) {
+	if len(addresses) == 0 {
+		return "disabled", nil
+	}
+	ports := make([]string, 0, len(addresses))
+	for _, a := range addresses {
+		addr, err := ma.NewMultiaddr(a)
+		if err != nil {
+			return "", errors.Wrapf(err, "could not parse discovery address %s", a)
+		}
+		port, err := addr.ValueForProtocol(ma.P_UDP)
+		if err != nil {
+			return "", errors.Wrapf(err, "discovery address %s has no UDP port", a)
+		}
+		ports = append(ports, port)
+	}
+	return fmt.Sprintf("enabled on udp port %s", strings.Join(dedup(ports), ", ")), nil
+}
+
+func dedup(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	return result
+}
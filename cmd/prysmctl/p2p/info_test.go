@@ -0,0 +1,52 @@
+package p2p
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	ecdsaprysm "github.com/prysmaticlabs/prysm/v5/crypto/ecdsa"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestPrintIdentity(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	record := &enr.Record{}
+	record.SetSeq(12)
+	record.Set(enr.IP(net.IPv4(1, 2, 3, 4)))
+	record.Set(enr.TCP(13000))
+	record.Set(enr.UDP(12000))
+	require.NoError(t, enode.SignV4(record, key))
+	node, err := enode.New(enode.ValidSchemes, record)
+	require.NoError(t, err)
+
+	pubKey, err := ecdsaprysm.ConvertToInterfacePubkey(&key.PublicKey)
+	require.NoError(t, err)
+	peerID, err := peer.IDFromPublicKey(pubKey)
+	require.NoError(t, err)
+
+	id := &structs.Identity{
+		PeerId:             peerID.String(),
+		Enr:                node.String(),
+		P2PAddresses:       []string{"/ip4/10.0.0.1/tcp/13000/p2p/" + peerID.String()},
+		DiscoveryAddresses: []string{"/ip4/10.0.0.1/udp/12000/p2p/" + peerID.String()},
+	}
+	out := &bytes.Buffer{}
+	require.NoError(t, printIdentity(out, id))
+	assert.Equal(t, true, strings.Contains(out.String(), "ENR seq:               12\n"))
+	assert.Equal(t, true, strings.Contains(out.String(), "/ip4/1.2.3.4/tcp/13000, /ip4/1.2.3.4/udp/12000"))
+	assert.Equal(t, true, strings.Contains(out.String(), "Discovery:             enabled on udp port 12000\n"))
+
+	id.DiscoveryAddresses = nil
+	out.Reset()
+	require.NoError(t, printIdentity(out, id))
+	assert.Equal(t, true, strings.Contains(out.String(), "Discovery:             disabled\n"))
+}
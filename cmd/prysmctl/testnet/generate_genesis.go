@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/cmd/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/trie"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -237,6 +238,9 @@ func generateGenesis(ctx context.Context) (state.BeaconState, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := checkGenesisForkEpoch(v, params.BeaconConfig()); err != nil {
+		return nil, err
+	}
 	opts := make([]interop.PremineGenesisOpt, 0)
 	nv := f.NumValidators
 	if f.DepositJsonFile != "" {
@@ -394,6 +398,33 @@ func depositJSONToDepositData(input *depositDataJSON) ([]byte, *ethpb.Deposit_Da
 	}, nil
 }
 
+// checkGenesisForkEpoch makes sure the chain config schedules the fork of the genesis state at genesis. Otherwise the
+// beacon node would not use the schema of the state, and the execution genesis block would not have the fields of
+// the payload header, like the blob gas fields of a deneb header.
+func checkGenesisForkEpoch(v int, cfg *params.BeaconChainConfig) error {
+	var epoch primitives.Epoch
+	switch v {
+	case version.Phase0:
+		return nil
+	case version.Altair:
+		epoch = cfg.AltairForkEpoch
+	case version.Bellatrix:
+		epoch = cfg.BellatrixForkEpoch
+	case version.Capella:
+		epoch = cfg.CapellaForkEpoch
+	case version.Deneb:
+		epoch = cfg.DenebForkEpoch
+	case version.Electra:
+		epoch = cfg.ElectraForkEpoch
+	default:
+		return fmt.Errorf("unsupported fork %s", version.String(v))
+	}
+	if epoch != 0 {
+		return fmt.Errorf("a %s genesis state requires the %s fork epoch of the chain config to be 0, got %d", version.String(v), version.String(v), epoch)
+	}
+	return nil
+}
+
 func writeToOutputFile(
 	fPath string,
 	data interface{},
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/runtime/interop"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
@@ -48,3 +50,14 @@ func createGenesisDepositData(t *testing.T, numKeys int) []*depositDataJSON {
 	}
 	return jsonData
 }
+
+func Test_checkGenesisForkEpoch(t *testing.T) {
+	cfg := params.MainnetConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.DenebForkEpoch = 10
+	require.NoError(t, checkGenesisForkEpoch(version.Phase0, cfg))
+	require.NoError(t, checkGenesisForkEpoch(version.Capella, cfg))
+	require.ErrorContains(t, "deneb fork epoch of the chain config to be 0, got 10", checkGenesisForkEpoch(version.Deneb, cfg))
+}
@@ -151,6 +151,7 @@ var Commands = []*cli.Command{
 				Flags: cmd.WrapFlags([]cli.Flag{
 					flags.WalletDirFlag,
 					flags.WalletPasswordFileFlag,
+					flags.WalletPasswordKeyringFlag,
 					flags.AccountPasswordFileFlag,
 					flags.VoluntaryExitPublicKeysFlag,
 					flags.BeaconRPCProviderFlag,
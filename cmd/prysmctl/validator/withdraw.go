@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/logrusorgru/aurora"
 	"github.com/pkg/errors"
+	apiclient "github.com/prysmaticlabs/prysm/v5/api/client"
 	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
@@ -84,7 +85,7 @@ func getWithdrawalMessagesFromPathFlag(c *cli.Context) ([]*structs.SignedBLSToEx
 }
 
 func callWithdrawalEndpoints(ctx context.Context, host string, request []*structs.SignedBLSToExecutionChange) error {
-	client, err := beacon.NewClient(host)
+	client, err := beacon.NewClient(host, apiclient.WithRetry(apiclient.DefaultRetryConfig()))
 	if err != nil {
 		return err
 	}
@@ -188,7 +189,7 @@ func verifyWithdrawalsInPool(c *cli.Context) error {
 	if !c.IsSet(PathFlag.Name) {
 		return fmt.Errorf("no --%s flag value was provided", PathFlag.Name)
 	}
-	client, err := beacon.NewClient(beaconNodeHost)
+	client, err := beacon.NewClient(beaconNodeHost, apiclient.WithRetry(apiclient.DefaultRetryConfig()))
 	if err != nil {
 		return err
 	}
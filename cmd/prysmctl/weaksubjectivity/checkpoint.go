@@ -12,8 +12,10 @@ import (
 )
 
 var checkpointFlags = struct {
-	BeaconNodeHost string
-	Timeout        time.Duration
+	BeaconNodeHost      string
+	HedgeBeaconNodeHost string
+	Timeout             time.Duration
+	MaxAttempts         int
 }{}
 
 var checkpointCmd = &cli.Command{
@@ -39,6 +41,17 @@ var checkpointCmd = &cli.Command{
 			Destination: &checkpointFlags.Timeout,
 			Value:       time.Minute * 2,
 		},
+		&cli.IntFlag{
+			Name:        "http-max-attempts",
+			Usage:       "maximum number of attempts for each http request that fails with a transient error, such as a 502 from a load balancer",
+			Destination: &checkpointFlags.MaxAttempts,
+			Value:       client.DefaultRetryConfig().MaxAttempts,
+		},
+		&cli.StringFlag{
+			Name:        "hedge-beacon-node-host",
+			Usage:       "optional host:port of a second beacon node; requests that are slow to complete on beacon-node-host are also sent here and the first response wins",
+			Destination: &checkpointFlags.HedgeBeaconNodeHost,
+		},
 	},
 }
 
@@ -46,7 +59,12 @@ func cliActionCheckpoint(_ *cli.Context) error {
 	ctx := context.Background()
 	f := checkpointFlags
 
-	opts := []client.ClientOpt{client.WithTimeout(f.Timeout)}
+	rc := client.DefaultRetryConfig()
+	rc.MaxAttempts = f.MaxAttempts
+	opts := []client.ClientOpt{client.WithTimeout(f.Timeout), client.WithRetry(rc)}
+	if f.HedgeBeaconNodeHost != "" {
+		opts = append(opts, client.WithHedging(f.HedgeBeaconNodeHost, 0))
+	}
 	client, err := beacon.NewClient(checkpointFlags.BeaconNodeHost, opts...)
 	if err != nil {
 		return err
@@ -25,6 +25,7 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				flags.WalletDirFlag,
 				flags.WalletPasswordFileFlag,
+				flags.WalletPasswordKeyringFlag,
 				flags.DeletePublicKeysFlag,
 				features.Mainnet,
 				features.SepoliaTestnet,
@@ -47,12 +48,48 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "derive",
+			Description: "derives new accounts from the seed of an HD wallet, continuing after the accounts already in it, and prints their deposit data",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.WalletPasswordKeyringFlag,
+				flags.MnemonicFileFlag,
+				flags.MnemonicLanguageFlag,
+				flags.Mnemonic25thWordFileFlag,
+				flags.SkipMnemonic25thWordCheckFlag,
+				flags.NumAccountsFlag,
+				flags.AccountNameTemplateFlag,
+				flags.WithdrawalAddressFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := accountsDerive(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not derive accounts")
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "list",
 			Description: "Lists all validator accounts in a user's wallet directory",
 			Flags: cmd.WrapFlags([]cli.Flag{
 				flags.WalletDirFlag,
 				flags.WalletPasswordFileFlag,
+				flags.WalletPasswordKeyringFlag,
 				flags.ShowPrivateKeysFlag,
 				flags.ListValidatorIndices,
 				flags.BeaconRPCProviderFlag,
@@ -91,9 +128,13 @@ var Commands = &cli.Command{
 			Flags: cmd.WrapFlags([]cli.Flag{
 				flags.WalletDirFlag,
 				flags.WalletPasswordFileFlag,
+				flags.WalletPasswordKeyringFlag,
 				flags.BackupDirFlag,
 				flags.BackupPublicKeysFlag,
 				flags.BackupPasswordFileFlag,
+				flags.BackupKDFFlag,
+				flags.BackupKDFRoundsFlag,
+				flags.InsecureKDFFlag,
 				features.Mainnet,
 				features.SepoliaTestnet,
 				features.HoleskyTestnet,
@@ -122,8 +163,12 @@ var Commands = &cli.Command{
 				flags.WalletDirFlag,
 				flags.KeysDirFlag,
 				flags.WalletPasswordFileFlag,
+				flags.WalletPasswordKeyringFlag,
 				flags.AccountPasswordFileFlag,
+				flags.AccountPasswordFileDirFlag,
+				flags.AccountPasswordsFileFlag,
 				flags.ImportPrivateKeyFileFlag,
+				flags.AccountNameTemplateFlag,
 				features.Mainnet,
 				features.SepoliaTestnet,
 				features.HoleskyTestnet,
@@ -145,12 +190,43 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "rename",
+			Description: "gives a validator account in a user's wallet a new name, which is shown instead of its petname",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.WalletPasswordKeyringFlag,
+				flags.RenamePublicKeyFlag,
+				flags.NewAccountNameFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := accountsRename(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not rename account")
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "voluntary-exit",
 			Description: "Performs a voluntary exit on selected accounts",
 			Flags: cmd.WrapFlags([]cli.Flag{
 				flags.WalletDirFlag,
 				flags.WalletPasswordFileFlag,
+				flags.WalletPasswordKeyringFlag,
 				flags.AccountPasswordFileFlag,
 				flags.VoluntaryExitPublicKeysFlag,
 				flags.BeaconRPCProviderFlag,
@@ -1,6 +1,7 @@
 package accounts
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -10,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
 	"github.com/prysmaticlabs/prysm/v5/validator/client"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/urfave/cli/v2"
 )
 
@@ -46,6 +48,7 @@ func accountsBackup(c *cli.Context) error {
 	filteredPubKeys, err := accounts.FilterPublicKeysFromUserInput(
 		c,
 		flags.BackupPublicKeysFlag,
+		km,
 		publicKeys,
 		userprompt.SelectAccountsBackupPromptText,
 	)
@@ -72,8 +75,14 @@ func accountsBackup(c *cli.Context) error {
 		return errors.Wrap(err, "could not determine password for backed up accounts")
 	}
 
+	kdf, err := backupKDFConfig(c)
+	if err != nil {
+		return err
+	}
+
 	opts = append(opts, accounts.WithBackupsDir(backupsDir))
 	opts = append(opts, accounts.WithBackupsPassword(backupsPassword))
+	opts = append(opts, accounts.WithBackupsKDF(kdf))
 
 	acc, err := accounts.NewCLIManager(opts...)
 	if err != nil {
@@ -81,3 +90,22 @@ func accountsBackup(c *cli.Context) error {
 	}
 	return acc.Backup(c.Context)
 }
+
+// backupKDFConfig returns the key derivation function to encrypt backed up accounts with, refusing
+// a number of rounds too low to be secure unless explicitly allowed.
+func backupKDFConfig(c *cli.Context) (keymanager.KDFConfig, error) {
+	kdf := keymanager.KDFConfig{
+		Function: keymanager.KDF(c.String(flags.BackupKDFFlag.Name)),
+		Rounds:   c.Uint64(flags.BackupKDFRoundsFlag.Name),
+	}.WithDefaults()
+	if err := kdf.Validate(); err != nil {
+		return keymanager.KDFConfig{}, errors.Wrap(err, "invalid backup key derivation function")
+	}
+	if !kdf.Secure() && !c.Bool(flags.InsecureKDFFlag.Name) {
+		return keymanager.KDFConfig{}, fmt.Errorf(
+			"%d %s rounds is insecure, the minimum is %d, use --%s to proceed anyway",
+			kdf.Rounds, kdf.Function, keymanager.DefaultKDFRounds, flags.InsecureKDFFlag.Name,
+		)
+	}
+	return kdf, nil
+}
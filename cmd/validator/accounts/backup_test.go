@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"encoding/hex"
 	"encoding/json"
+	"flag"
 	"io"
 	"os"
 	"path/filepath"
@@ -12,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -21,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
 	constant "github.com/prysmaticlabs/prysm/v5/validator/testing"
+	"github.com/urfave/cli/v2"
 )
 
 func TestBackupAccounts_Noninteractive_Derived(t *testing.T) {
@@ -223,3 +226,61 @@ func TestBackupAccounts_Noninteractive_Imported(t *testing.T) {
 	sort.Strings(generatedPubKeys)
 	assert.DeepEqual(t, unzippedPublicKeys, generatedPubKeys)
 }
+
+func TestBackupKDFConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		kdf      string
+		rounds   uint64
+		insecure bool
+		want     keymanager.KDFConfig
+		wantErr  string
+	}{
+		{
+			name:   "pbkdf2",
+			kdf:    "pbkdf2",
+			rounds: 262144,
+			want:   keymanager.KDFConfig{Function: keymanager.KDFPBKDF2, Rounds: 262144},
+		},
+		{
+			name:   "scrypt",
+			kdf:    "scrypt",
+			rounds: 1 << 20,
+			want:   keymanager.KDFConfig{Function: keymanager.KDFScrypt, Rounds: 1 << 20},
+		},
+		{
+			name:    "insecure pbkdf2",
+			kdf:     "pbkdf2",
+			rounds:  1000,
+			wantErr: "1000 pbkdf2 rounds is insecure",
+		},
+		{
+			name:     "insecure pbkdf2 allowed",
+			kdf:      "pbkdf2",
+			rounds:   1000,
+			insecure: true,
+			want:     keymanager.KDFConfig{Function: keymanager.KDFPBKDF2, Rounds: 1000},
+		},
+		{
+			name:    "unsupported kdf",
+			kdf:     "argon2",
+			rounds:  262144,
+			wantErr: "invalid backup key derivation function",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", 0)
+			set.String(flags.BackupKDFFlag.Name, tt.kdf, "")
+			set.Uint64(flags.BackupKDFRoundsFlag.Name, tt.rounds, "")
+			set.Bool(flags.InsecureKDFFlag.Name, tt.insecure, "")
+			kdf, err := backupKDFConfig(cli.NewContext(&cli.App{}, set, nil))
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.want, kdf)
+		})
+	}
+}
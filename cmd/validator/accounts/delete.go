@@ -46,6 +46,7 @@ func accountsDelete(c *cli.Context) error {
 	filteredPubKeys, err := accounts.FilterPublicKeysFromUserInput(
 		c,
 		flags.DeletePublicKeysFlag,
+		km,
 		validatingPublicKeys,
 		userprompt.SelectAccountsDeletePromptText,
 	)
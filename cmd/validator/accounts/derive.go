@@ -0,0 +1,126 @@
+package accounts
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/io/prompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	// #nosec G101 -- Not sensitive data
+	deriveMnemonicPromptText = "Enter the seed phrase the wallet was created or recovered with"
+	// #nosec G101 -- Not sensitive data
+	mnemonicPassphraseYesNoText = "(Advanced) Do you have an optional '25th word' passphrase for your mnemonic? [y/n]"
+	// #nosec G101 -- Not sensitive data
+	mnemonicPassphrasePromptText = "(Advanced) Enter the '25th word' passphrase for your mnemonic"
+)
+
+func accountsDerive(c *cli.Context) error {
+	w, km, err := walletWithKeymanager(c)
+	if err != nil {
+		return err
+	}
+	derivedKM, ok := km.(*derived.Keymanager)
+	if !ok {
+		return fmt.Errorf("wallet of kind %s does not support deriving new accounts, only HD wallets do", w.KeymanagerKind())
+	}
+	opts := []accounts.Option{
+		accounts.WithWallet(w),
+		accounts.WithKeymanager(km),
+		accounts.WithNumAccounts(c.Int(flags.NumAccountsFlag.Name)),
+		accounts.WithAccountNameTemplate(c.String(flags.AccountNameTemplateFlag.Name)),
+	}
+	if c.IsSet(flags.WithdrawalAddressFlag.Name) {
+		withdrawalAddress := c.String(flags.WithdrawalAddressFlag.Name)
+		if !common.IsHexAddress(withdrawalAddress) {
+			return errors.Errorf("--%s is not a valid Ethereum address", flags.WithdrawalAddressFlag.Name)
+		}
+		opts = append(opts, accounts.WithWithdrawalAddress(common.HexToAddress(withdrawalAddress).Bytes()))
+	}
+	hasSeed, err := derivedKM.HasStoredSeed(c.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not read wallet seed")
+	}
+	// Wallets created before the seed was stored need the mnemonic once, the seed is stored afterwards.
+	if !hasSeed {
+		mnemonic, err := inputDeriveMnemonic(c)
+		if err != nil {
+			return errors.Wrap(err, "could not get mnemonic phrase")
+		}
+		opts = append(opts, accounts.WithMnemonic(mnemonic))
+		if c.IsSet(flags.MnemonicLanguageFlag.Name) {
+			opts = append(opts, accounts.WithMnemonicLanguage(c.String(flags.MnemonicLanguageFlag.Name)))
+		}
+		passphrase, err := inputMnemonicPassphrase(c)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, accounts.WithMnemonic25thWord(passphrase))
+	}
+
+	acc, err := accounts.NewCLIManager(opts...)
+	if err != nil {
+		return err
+	}
+	derivedAccounts, err := acc.DeriveAccounts(c.Context)
+	if err != nil {
+		return err
+	}
+	depositData, err := accounts.DepositDataJSON(derivedAccounts)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(depositData))
+	return nil
+}
+
+func inputDeriveMnemonic(c *cli.Context) (string, error) {
+	if c.IsSet(flags.MnemonicFileFlag.Name) {
+		data, err := os.ReadFile(c.String(flags.MnemonicFileFlag.Name)) // #nosec G304 -- ReadFile is safe
+		if err != nil {
+			return "", err
+		}
+		mnemonic := strings.TrimSpace(string(data))
+		if err := accounts.ValidateMnemonic(mnemonic); err != nil {
+			return "", errors.Wrap(err, "mnemonic phrase did not pass validation")
+		}
+		return mnemonic, nil
+	}
+	return prompt.ValidatePrompt(os.Stdin, deriveMnemonicPromptText, accounts.ValidateMnemonic)
+}
+
+func inputMnemonicPassphrase(c *cli.Context) (string, error) {
+	if !c.IsSet(flags.Mnemonic25thWordFileFlag.Name) {
+		if c.IsSet(flags.SkipMnemonic25thWordCheckFlag.Name) {
+			return "", nil
+		}
+		resp, err := prompt.ValidatePrompt(os.Stdin, mnemonicPassphraseYesNoText, prompt.ValidateYesOrNo)
+		if err != nil {
+			return "", errors.Wrap(err, "could not validate choice")
+		}
+		if !strings.EqualFold(resp, "y") {
+			return "", nil
+		}
+	}
+	return prompt.InputPassword(
+		c,
+		flags.Mnemonic25thWordFileFlag,
+		mnemonicPassphrasePromptText,
+		"Confirm mnemonic passphrase",
+		false, /* Should confirm password */
+		func(input string) error {
+			if strings.TrimSpace(input) == "" {
+				return errors.New("input cannot be empty")
+			}
+			return nil
+		},
+	)
+}
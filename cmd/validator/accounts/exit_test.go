@@ -40,6 +40,10 @@ func TestExitAccountsCli_OK(t *testing.T) {
 		Genesis(gomock.Any(), gomock.Any()).
 		Return(&ethpb.Genesis{GenesisTime: genesisTime}, nil)
 
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		Return(&ethpb.MultipleValidatorStatusResponse{}, nil)
+
 	mockValidatorClient.EXPECT().
 		DomainData(gomock.Any(), gomock.Any()).
 		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
@@ -92,7 +96,7 @@ func TestExitAccountsCli_OK(t *testing.T) {
 	var stdin bytes.Buffer
 	stdin.Write([]byte("Y"))
 	rawPubKeys, formattedPubKeys, err := accounts.FilterExitAccountsFromUserInput(
-		cliCtx, &stdin, validatingPublicKeys, false,
+		cliCtx, &stdin, km, validatingPublicKeys, false,
 	)
 	require.NoError(t, err)
 	require.NotNil(t, rawPubKeys)
@@ -136,6 +140,10 @@ func TestExitAccountsCli_OK_AllPublicKeys(t *testing.T) {
 		Genesis(gomock.Any(), gomock.Any()).
 		Return(&ethpb.Genesis{GenesisTime: genesisTime}, nil)
 
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		Return(&ethpb.MultipleValidatorStatusResponse{}, nil)
+
 	mockValidatorClient.EXPECT().
 		DomainData(gomock.Any(), gomock.Any()).
 		Times(2).
@@ -192,7 +200,7 @@ func TestExitAccountsCli_OK_AllPublicKeys(t *testing.T) {
 	var stdin bytes.Buffer
 	stdin.Write([]byte("Y"))
 	rawPubKeys, formattedPubKeys, err := accounts.FilterExitAccountsFromUserInput(
-		cliCtx, &stdin, validatingPublicKeys, false,
+		cliCtx, &stdin, km, validatingPublicKeys, false,
 	)
 	require.NoError(t, err)
 	require.NotNil(t, rawPubKeys)
@@ -238,6 +246,10 @@ func TestExitAccountsCli_OK_ForceExit(t *testing.T) {
 		Genesis(gomock.Any(), gomock.Any()).
 		Return(&ethpb.Genesis{GenesisTime: genesisTime}, nil)
 
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		Return(&ethpb.MultipleValidatorStatusResponse{}, nil)
+
 	mockValidatorClient.EXPECT().
 		DomainData(gomock.Any(), gomock.Any()).
 		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
@@ -287,7 +299,7 @@ func TestExitAccountsCli_OK_ForceExit(t *testing.T) {
 	require.NotNil(t, validatingPublicKeys)
 
 	rawPubKeys, formattedPubKeys, err := accounts.FilterExitAccountsFromUserInput(
-		cliCtx, &bytes.Buffer{}, validatingPublicKeys, true,
+		cliCtx, &bytes.Buffer{}, km, validatingPublicKeys, true,
 	)
 	require.NoError(t, err)
 	require.NotNil(t, rawPubKeys)
@@ -327,6 +339,10 @@ func TestExitAccountsCli_WriteJSON_NoBroadcast(t *testing.T) {
 		Genesis(gomock.Any(), gomock.Any()).
 		Return(&ethpb.Genesis{GenesisTime: genesisTime}, nil)
 
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		Return(&ethpb.MultipleValidatorStatusResponse{}, nil)
+
 	mockValidatorClient.EXPECT().
 		DomainData(gomock.Any(), gomock.Any()).
 		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
@@ -372,7 +388,7 @@ func TestExitAccountsCli_WriteJSON_NoBroadcast(t *testing.T) {
 	require.NotNil(t, validatingPublicKeys)
 
 	rawPubKeys, formattedPubKeys, err := accounts.FilterExitAccountsFromUserInput(
-		cliCtx, &bytes.Buffer{}, validatingPublicKeys, true,
+		cliCtx, &bytes.Buffer{}, km, validatingPublicKeys, true,
 	)
 	require.NoError(t, err)
 	require.NotNil(t, rawPubKeys)
@@ -45,6 +45,9 @@ func accountsImport(c *cli.Context) error {
 	opts = append(opts, accounts.WithPrivateKeyFile(c.String(flags.ImportPrivateKeyFileFlag.Name)))
 	opts = append(opts, accounts.WithReadPasswordFile(c.IsSet(flags.AccountPasswordFileFlag.Name)))
 	opts = append(opts, accounts.WithPasswordFilePath(c.String(flags.AccountPasswordFileFlag.Name)))
+	opts = append(opts, accounts.WithPasswordFileDir(c.String(flags.AccountPasswordFileDirFlag.Name)))
+	opts = append(opts, accounts.WithPasswordsFilePath(c.String(flags.AccountPasswordsFileFlag.Name)))
+	opts = append(opts, accounts.WithAccountNameTemplate(c.String(flags.AccountNameTemplateFlag.Name)))
 
 	keysDir, err := userprompt.InputDirectory(c, userprompt.ImportKeysDirPromptText, flags.KeysDirFlag)
 	if err != nil {
@@ -23,7 +23,6 @@ import (
 )
 
 func TestImport_Noninteractive(t *testing.T) {
-	local.ResetCaches()
 	walletDir, passwordsDir, passwordFilePath := setupWalletAndPasswordsDir(t)
 	keysDir := filepath.Join(t.TempDir(), "keysDir")
 	require.NoError(t, os.MkdirAll(keysDir, os.ModePerm))
@@ -81,7 +80,6 @@ func TestImport_Noninteractive(t *testing.T) {
 
 // TestImport_DuplicateKeys is a regression test that ensures correction function if duplicate keys are being imported
 func TestImport_DuplicateKeys(t *testing.T) {
-	local.ResetCaches()
 	walletDir, passwordsDir, passwordFilePath := setupWalletAndPasswordsDir(t)
 	keysDir := filepath.Join(t.TempDir(), "keysDir")
 	require.NoError(t, os.MkdirAll(keysDir, os.ModePerm))
@@ -130,7 +128,6 @@ func TestImport_DuplicateKeys(t *testing.T) {
 }
 
 func TestImport_Noninteractive_RandomName(t *testing.T) {
-	local.ResetCaches()
 	walletDir, passwordsDir, passwordFilePath := setupWalletAndPasswordsDir(t)
 	keysDir := filepath.Join(t.TempDir(), "keysDir")
 	require.NoError(t, os.MkdirAll(keysDir, os.ModePerm))
@@ -213,7 +210,6 @@ func createRandomNameKeystore(t *testing.T, path string) (*keymanager.Keystore,
 }
 
 func TestImport_Noninteractive_Filepath(t *testing.T) {
-	local.ResetCaches()
 	walletDir, passwordsDir, passwordFilePath := setupWalletAndPasswordsDir(t)
 	keysDir := filepath.Join(t.TempDir(), "keysDir")
 	require.NoError(t, os.MkdirAll(keysDir, os.ModePerm))
@@ -0,0 +1,58 @@
+package accounts
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/io/prompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	"github.com/urfave/cli/v2"
+)
+
+const newAccountNamePromptText = "Enter the new name for the account"
+
+func accountsRename(c *cli.Context) error {
+	w, km, err := walletWithKeymanager(c)
+	if err != nil {
+		return err
+	}
+	validatingPublicKeys, err := km.FetchValidatingPublicKeys(c.Context)
+	if err != nil {
+		return err
+	}
+	if len(validatingPublicKeys) == 0 {
+		return errors.New("wallet is empty, no accounts to rename")
+	}
+	// Filter keys either from CLI flag or from interactive session.
+	filteredPubKeys, err := accounts.FilterPublicKeysFromUserInput(
+		c,
+		flags.RenamePublicKeyFlag,
+		km,
+		validatingPublicKeys,
+		userprompt.SelectAccountsRenamePromptText,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not filter public keys for renaming")
+	}
+	newName := c.String(flags.NewAccountNameFlag.Name)
+	if !c.IsSet(flags.NewAccountNameFlag.Name) {
+		newName, err = prompt.ValidatePrompt(os.Stdin, newAccountNamePromptText, local.ValidateAccountName)
+		if err != nil {
+			return errors.Wrap(err, "could not read new account name")
+		}
+	}
+
+	acc, err := accounts.NewCLIManager(
+		accounts.WithWallet(w),
+		accounts.WithKeymanager(km),
+		accounts.WithFilteredPubKeys(filteredPubKeys),
+		accounts.WithNewAccountName(newName),
+	)
+	if err != nil {
+		return err
+	}
+	return acc.Rename(c.Context)
+}
@@ -0,0 +1,134 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	validatordb "github.com/prysmaticlabs/prysm/v5/validator/db"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// ConvertToFlag defines the representation a validator database is converted to.
+	ConvertToFlag = &cli.StringFlag{
+		Name:  "to",
+		Usage: "Slashing protection database representation to convert to, either `complete` or `minimal`",
+	}
+
+	// ConvertCheckFlag only reports the representation of a validator database, without converting it.
+	ConvertCheckFlag = &cli.BoolFlag{
+		Name:  "check",
+		Usage: "Reports which slashing protection database representation is used and estimates how long converting it takes, without converting it",
+	}
+)
+
+// convertDatabase converts the validator database in the data directory to the requested representation,
+// after exporting its slashing protection history to an EIP-3076 file. With --check, it only reports
+// the representation of the database and an estimate of the conversion time.
+func convertDatabase(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+
+	current, err := validatordb.DetectRepresentation(dataDir)
+	if err != nil {
+		return err
+	}
+	size, err := validatordb.DatabaseSize(dataDir, current)
+	if err != nil {
+		return err
+	}
+	l := log.WithFields(logrus.Fields{
+		"representation":          current,
+		"databasePath":            validatordb.DatabasePath(dataDir, current),
+		"sizeBytes":               size,
+		"estimatedConversionTime": validatordb.EstimateConversionDuration(size),
+	})
+
+	if cliCtx.Bool(ConvertCheckFlag.Name) {
+		l.Info("Checked validator database")
+		return nil
+	}
+
+	if !cliCtx.IsSet(ConvertToFlag.Name) {
+		return fmt.Errorf("--%s must be set to either %s or %s", ConvertToFlag.Name, validatordb.Complete, validatordb.Minimal)
+	}
+	target, err := validatordb.ParseRepresentation(cliCtx.String(ConvertToFlag.Name))
+	if err != nil {
+		return err
+	}
+	if target == current {
+		l.Infof("Validator database is already %s, nothing to convert", target)
+		return nil
+	}
+
+	exportDir := cliCtx.String(flags.SlashingProtectionExportDirFlag.Name)
+	if exportDir == "" {
+		exportDir = dataDir
+	}
+	exportPath, err := exportBeforeConversion(cliCtx, dataDir, current, exportDir)
+	if err != nil {
+		return errors.Wrap(err, "could not export slashing protection history before conversion, the database was not converted")
+	}
+	log.WithField("path", exportPath).Info("Exported slashing protection history")
+
+	l.Infof("Converting validator database to %s", target)
+	start := time.Now()
+	if err := validatordb.ConvertDatabase(cliCtx.Context, dataDir, dataDir, current == validatordb.Minimal); err != nil {
+		return errors.Wrapf(err, "could not convert database, the slashing protection history can be restored from %s", exportPath)
+	}
+	log.WithFields(logrus.Fields{
+		"representation": target,
+		"databasePath":   validatordb.DatabasePath(dataDir, target),
+		"duration":       time.Since(start).Round(time.Millisecond),
+	}).Info("Converted validator database")
+	return nil
+}
+
+// exportBeforeConversion writes the slashing protection history of the database to an EIP-3076 JSON file
+// in the output directory, and returns the path of the file.
+func exportBeforeConversion(cliCtx *cli.Context, dataDir string, r validatordb.Representation, outputDir string) (string, error) {
+	var (
+		validatorDB iface.ValidatorDB
+		err         error
+	)
+	if r == validatordb.Minimal {
+		validatorDB, err = filesystem.NewStore(dataDir, nil)
+	} else {
+		validatorDB, err = kv.NewKVStore(cliCtx.Context, dataDir, nil)
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator DB")
+		}
+	}()
+
+	eipJSON, err := slashingprotection.ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
+	if err != nil {
+		return "", err
+	}
+	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
+	if err != nil {
+		return "", errors.Wrap(err, "could not JSON marshal slashing protection history")
+	}
+	if err := file.MkdirAll(outputDir); err != nil {
+		return "", errors.Wrapf(err, "could not create output directory %s", outputDir)
+	}
+	outputPath := filepath.Join(outputDir, fmt.Sprintf("slashing_protection_before_conversion_%d.json", time.Now().Unix()))
+	if err := file.WriteFile(outputPath, encoded); err != nil {
+		return "", errors.Wrapf(err, "could not write file to path %s", outputPath)
+	}
+	return outputPath, nil
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/runtime/tos"
 	validatordb "github.com/prysmaticlabs/prysm/v5/validator/db"
 	"github.com/sirupsen/logrus"
@@ -82,6 +83,28 @@ var Commands = &cli.Command{
 				},
 			},
 		},
+		{
+			Name:     "convert",
+			Category: "db",
+			Usage:    "Converts the slashing protection database between its complete and minimal representations",
+			Description: `converts the slashing protection database in --datadir to the representation given by --to,
+after exporting its slashing protection history to an EIP-3076 file in --slashing-protection-export-dir
+(defaults to --datadir). With --check, reports the current representation and an estimate of the conversion time.
+The validator client must not be running during the conversion.`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				ConvertToFlag,
+				ConvertCheckFlag,
+				flags.SlashingProtectionExportDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := convertDatabase(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not convert database")
+				}
+				return nil
+			},
+		},
 		{
 			Name:     "convert-complete-to-minimal",
 			Category: "db",
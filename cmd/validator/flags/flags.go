@@ -32,16 +32,42 @@ var (
 	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
 	BeaconRPCProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint.",
+		Usage: "Beacon node RPC provider endpoint. Use unix:///path/to/socket to connect over a unix domain socket.",
 		Value: "127.0.0.1:4000",
 	}
 
 	// BeaconRESTApiProviderFlag defines a beacon node REST API endpoint.
 	BeaconRESTApiProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rest-api-provider",
-		Usage: "Beacon node REST API provider endpoint.",
+		Usage: "Beacon node REST API provider endpoint. Use unix:///path/to/socket to connect over a unix domain socket.",
 		Value: "http://127.0.0.1:3500",
 	}
+	// BeaconRESTApiRetriesFlag defines the number of attempts of GET requests to the beacon node REST API.
+	BeaconRESTApiRetriesFlag = &cli.IntFlag{
+		Name: "beacon-rest-api-retries",
+		Usage: `Number of attempts of GET requests to the beacon node REST API that fail with a connection error or
+		a 429, 500, 502 or 504 response.`,
+		Value: 3,
+	}
+	// BeaconRESTApiRetryDelayFlag defines the backoff before the first retry of a GET request to the beacon node REST API.
+	BeaconRESTApiRetryDelayFlag = &cli.DurationFlag{
+		Name: "beacon-rest-api-retry-delay",
+		Usage: `Backoff before the first retry of a GET request to the beacon node REST API. The backoff doubles with
+		each retry up to 4 times this value, and is randomized by up to half.`,
+		Value: 250 * time.Millisecond,
+	}
+	// BeaconRESTApiHedgeProviderFlag defines a second beacon node REST API endpoint used to hedge GET requests.
+	BeaconRESTApiHedgeProviderFlag = &cli.StringFlag{
+		Name: "beacon-rest-api-hedge-provider",
+		Usage: `Beacon node REST API endpoint that GET requests are also sent to when the beacon node has not answered
+		after --beacon-rest-api-hedge-delay, or failed with an error that would be retried. The first successful response is used.`,
+	}
+	// BeaconRESTApiHedgeDelayFlag defines how long a GET request to the beacon node REST API waits before it is hedged.
+	BeaconRESTApiHedgeDelayFlag = &cli.DurationFlag{
+		Name:  "beacon-rest-api-hedge-delay",
+		Usage: "Time to wait for the beacon node before a GET request is also sent to --beacon-rest-api-hedge-provider.",
+		Value: 500 * time.Millisecond,
+	}
 	// CertFlag defines a flag for the node's TLS certificate.
 	CertFlag = &cli.StringFlag{
 		Name:  "tls-cert",
@@ -81,6 +107,12 @@ var (
 		Name:  "disable-rewards-penalties-logging",
 		Usage: "Disables reward/penalty logging during cluster deployment.",
 	}
+	// EnableRewardsEstimationFlag enables the estimation of rewards and penalties after each epoch.
+	EnableRewardsEstimationFlag = &cli.BoolFlag{
+		Name: "enable-rewards-estimation",
+		Usage: "Estimates the rewards and penalties of each key after every epoch using the beacon node rewards API. " +
+			"Falls back to balance changes when the API is unavailable.",
+	}
 	// GraffitiFlag defines the graffiti value included in proposed blocks
 	GraffitiFlag = &cli.StringFlag{
 		Name:  "graffiti",
@@ -141,8 +173,10 @@ var (
 	}
 	// WalletDirFlag defines the path to a wallet directory for Prysm accounts.
 	WalletDirFlag = &cli.StringFlag{
-		Name:  "wallet-dir",
-		Usage: "Path to a wallet directory on-disk for Prysm validator accounts.",
+		Name: "wallet-dir",
+		Usage: "Path to a wallet directory on-disk for Prysm validator accounts. Several comma-separated wallet " +
+			"directories of the same kind can be given to run the keys of all of them, accounts being imported in the " +
+			"first one. A key must not be in more than one wallet.",
 		Value: filepath.Join(DefaultValidatorDir(), WalletDefaultDirName),
 	}
 	// AccountPasswordFileFlag is path to a file containing a password for a validator account.
@@ -150,10 +184,33 @@ var (
 		Name:  "account-password-file",
 		Usage: "Path to a plain-text, .txt file containing a password for a validator account.",
 	}
+	// AccountPasswordFileDirFlag is the path to a directory containing a password file for each keystore to import.
+	AccountPasswordFileDirFlag = &cli.StringFlag{
+		Name: "account-password-file-dir",
+		Usage: "Path to a directory of plain-text password files for the keystores to import, named either " +
+			"<pubkey>.txt or after the keystore file with a .txt extension. Keystores without a password file " +
+			"in this directory use the password of --account-password-file or the password prompt.",
+	}
+	// AccountPasswordsFileFlag is the path to a file mapping each keystore to import to its password.
+	AccountPasswordsFileFlag = &cli.StringFlag{
+		Name: "account-passwords-file",
+		Usage: "Path to a JSON or YAML file mapping the public keys or file names of the keystores to import to their " +
+			"passwords. Keystores without an entry in this file use --account-password-file-dir, then the password of " +
+			"--account-password-file or the password prompt.",
+	}
 	// WalletPasswordFileFlag is the path to a file containing your wallet password.
 	WalletPasswordFileFlag = &cli.StringFlag{
-		Name:  "wallet-password-file",
-		Usage: "Path to a plain-text, .txt file containing your wallet password.",
+		Name: "wallet-password-file",
+		Usage: "Path to a plain-text, .txt file containing your wallet password. With several wallet directories, the " +
+			"file holds either one password per line in the order of the wallet directories, or a single password " +
+			"shared by all wallets. It can also be a directory holding a <wallet directory name>.txt password file " +
+			"for each wallet.",
+	}
+	// WalletPasswordKeyringFlag reads the wallet password from the OS keyring before the wallet password file or prompt.
+	WalletPasswordKeyringFlag = &cli.BoolFlag{
+		Name: "wallet-password-keyring",
+		Usage: "Reads the wallet password from the OS keyring, where it is stored with `validator wallet set-keyring-password`, " +
+			"before falling back to the wallet password file or prompt.",
 	}
 	// Mnemonic25thWordFileFlag defines a path to a file containing a "25th" word mnemonic passphrase for advanced users.
 	Mnemonic25thWordFileFlag = &cli.StringFlag{
@@ -212,6 +269,32 @@ var (
 		Usage: "Comma separated list of public key hex strings to specify which validator accounts to backup.",
 		Value: "",
 	}
+	// RenamePublicKeyFlag defines the hex string public key of the account a user wants to rename.
+	RenamePublicKeyFlag = &cli.StringFlag{
+		Name:  "rename-public-key",
+		Usage: "Public key hex string of the validator account to rename.",
+		Value: "",
+	}
+	// NewAccountNameFlag defines the new name of the account being renamed.
+	NewAccountNameFlag = &cli.StringFlag{
+		Name:  "new-account-name",
+		Usage: "New name for the validator account being renamed. It must be unique within the wallet.",
+		Value: "",
+	}
+	// AccountNameTemplateFlag defines how to name new accounts instead of using their petnames.
+	AccountNameTemplateFlag = &cli.StringFlag{
+		Name: "account-name-template",
+		Usage: "Template used to name new validator accounts. It may contain the placeholders {petname}, " +
+			"{pubkey} (the first 8 hex characters of the public key) and {index} (the position of the account in the wallet). " +
+			"A numeric suffix is added to names that are already used by another account.",
+		Value: "{petname}",
+	}
+	// WithdrawalAddressFlag defines the execution address the deposit data of derived accounts withdraws to.
+	WithdrawalAddressFlag = &cli.StringFlag{
+		Name: "withdrawal-address",
+		Usage: "Execution address the deposit data of the derived accounts withdraws to. " +
+			"The BLS withdrawal keys of the accounts are used when not set.",
+	}
 	// VoluntaryExitPublicKeysFlag defines a comma-separated list of hex string public keys
 	// for accounts on which a user wants to perform a voluntary exit.
 	VoluntaryExitPublicKeysFlag = &cli.StringFlag{
@@ -244,6 +327,25 @@ var (
 		Usage: "Path to a plain-text, .txt file containing the desired password for your backed up accounts.",
 		Value: "",
 	}
+	// BackupKDFFlag defines the key derivation function used to encrypt backed up accounts.
+	BackupKDFFlag = &cli.StringFlag{
+		Name:  "backup-kdf",
+		Usage: "Key derivation function used to encrypt the keystores of your backed up accounts, either pbkdf2 or scrypt.",
+		Value: "pbkdf2",
+	}
+	// BackupKDFRoundsFlag defines the number of rounds of the key derivation function used to encrypt backed up accounts.
+	BackupKDFRoundsFlag = &cli.Uint64Flag{
+		Name: "backup-kdf-rounds",
+		Usage: "Number of rounds of the key derivation function used to encrypt the keystores of your backed up accounts: " +
+			"the iteration count for pbkdf2, or the cost parameter n, a power of 2, for scrypt. " +
+			"Values lower than the default require --insecure-kdf.",
+		Value: 262144,
+	}
+	// InsecureKDFFlag allows backing up accounts with a number of key derivation function rounds too low to be secure.
+	InsecureKDFFlag = &cli.BoolFlag{
+		Name:  "insecure-kdf",
+		Usage: "Allows backing up accounts with fewer --backup-kdf-rounds than is considered secure. Only use this for testing.",
+	}
 	// BackupDirFlag defines the path for the zip backup of the wallet will be created.
 	BackupDirFlag = &cli.StringFlag{
 		Name:  "backup-dir",
@@ -300,6 +402,15 @@ var (
 		Aliases: []string{"remote-signer-keys"},
 	}
 
+	// Web3SignerPublicKeysRefreshIntervalFlag sets how often the public keys are fetched again from the public keys URL.
+	Web3SignerPublicKeysRefreshIntervalFlag = &cli.DurationFlag{
+		Name: "validators-external-signer-public-keys-refresh-interval",
+		Usage: "Interval at which the public keys are fetched again from the external url set with --" + Web3SignerPublicValidatorKeysFlag.Name +
+			", picking up keys added to or removed from the remote signer without a restart. Disabled when 0.",
+		Value:   0,
+		Aliases: []string{"remote-signer-keys-refresh-interval"},
+	}
+
 	// Web3SignerKeyFileFlag defines a file for keys to persist to.
 	// example:--validators-external-signer-key-file=./path/to/keys.txt
 	Web3SignerKeyFileFlag = &cli.StringFlag{
@@ -308,6 +419,14 @@ var (
 		Value:   "",
 		Aliases: []string{"remote-signer-keys-file"},
 	}
+	// Web3SignerMaxConcurrentRequestsFlag bounds the number of concurrent sign requests sent to web3signer.
+	Web3SignerMaxConcurrentRequestsFlag = &cli.IntFlag{
+		Name: "validators-external-signer-max-concurrent-requests",
+		Usage: "Maximum number of sign requests sent to the remote signer at once. Further requests are queued, " +
+			"with block proposals and attestations ahead of selection proofs and registrations. Set to 0 to disable the limit.",
+		Value:   16,
+		Aliases: []string{"remote-signer-max-concurrent-requests"},
+	}
 
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
@@ -336,14 +455,15 @@ var (
 	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
-		Name:  "graffiti-file",
-		Usage: "Path to a YAML file with graffiti values.",
+		Name: "graffiti-file",
+		Usage: "Path to a YAML file with graffiti values: a default graffiti, ordered and random lists, and specific " +
+			"graffiti by validator index or public key. Takes priority over --graffiti and is reloaded when it changes.",
 	}
 	// ProposerSettingsFlag defines the path or URL to a file with proposer config.
 	ProposerSettingsFlag = &cli.StringFlag{
 		Name: "proposer-settings-file",
 		Usage: `Sets path to a YAML or JSON file containing validator settings used when proposing blocks such as
-		fee recipient and gas limit. File format found in docs.`,
+		fee recipient and gas limit. File format found in docs. The file is reloaded when it changes.`,
 		Value: "",
 	}
 	// ProposerSettingsURLFlag defines the path or URL to a file with proposer config.
@@ -353,6 +473,24 @@ var (
 		fee recipient and gas limit. File format found in docs`,
 		Value: "",
 	}
+	// ProposerSettingsRefreshIntervalFlag sets how often the proposer settings are fetched again from the URL.
+	ProposerSettingsRefreshIntervalFlag = &cli.DurationFlag{
+		Name: "proposer-settings-refresh-interval",
+		Usage: "Interval at which the proposer settings are fetched again from --" + ProposerSettingsURLFlag.Name +
+			", updating the settings of the keys which changed. Disabled when 0.",
+		Value: 0,
+	}
+	// ProposerSettingsExportFileFlag defines the path of the file proposer settings are exported to.
+	ProposerSettingsExportFileFlag = &cli.StringFlag{
+		Name:  "proposer-settings-export-file",
+		Usage: "Path of the JSON file the proposer settings of the validator client are exported to.",
+		Value: "proposer_settings.json",
+	}
+	// ProposerSettingsImportFileFlag defines the path of a file with exported proposer settings to import.
+	ProposerSettingsImportFileFlag = &cli.StringFlag{
+		Name:  "proposer-settings-import-file",
+		Usage: "Path of a JSON file with proposer settings exported by the validator proposer-settings export command.",
+	}
 	// SuggestedFeeRecipientFlag defines the address of the fee recipient.
 	SuggestedFeeRecipientFlag = &cli.StringFlag{
 		Name: "suggested-fee-recipient",
@@ -362,6 +500,11 @@ var (
 		--` + ProposerSettingsFlag.Name + " or --" + ProposerSettingsURLFlag.Name + " flags.",
 		Value: params.BeaconConfig().EthBurnAddressHex,
 	}
+	// SuggestedFeeRecipientIsBurnOkFlag allows the keymanager API to set the burn address as a validator's fee recipient.
+	SuggestedFeeRecipientIsBurnOkFlag = &cli.BoolFlag{
+		Name:  "suggested-fee-recipient-is-burn-ok",
+		Usage: "Allows the keymanager API to set the burn address as a validator's fee recipient.",
+	}
 	// EnableBuilderFlag enables the periodic validator registration API calls that will update the custom builder with validator settings.
 	EnableBuilderFlag = &cli.BoolFlag{
 		Name: "enable-builder",
@@ -377,6 +520,19 @@ var (
 		Usage: "Sets gas limit for the builder to use for constructing a payload for all the validators.",
 		Value: fmt.Sprint(params.BeaconConfig().DefaultBuilderGasLimit),
 	}
+	// MinBuilderBidFlag sets the minimum value of the builder bid for the beacon node to use the builder payload.
+	MinBuilderBidFlag = &cli.Uint64Flag{
+		Name: "min-builder-bid",
+		Usage: "Sets the minimum value in Gwei of the builder bid for the beacon node to use the builder payload rather than the local execution payload, " +
+			"for the validators with the builder enabled which do not set min_bid in their proposer settings. 0 disables the check.",
+	}
+	// MinBuilderBidToLocalRatioFlag sets the minimum ratio of the builder bid to the local payload value for the beacon node to use the builder payload.
+	MinBuilderBidToLocalRatioFlag = &cli.Float64Flag{
+		Name: "min-builder-bid-to-local-ratio",
+		Usage: "Sets the minimum ratio of the builder bid value to the local execution payload value for the beacon node to use the builder payload, " +
+			"for the validators with the builder enabled which do not set min_bid_to_local_ratio in their proposer settings. " +
+			"For example, 1.1 requires the builder bid to be 10% higher. The check is skipped when the execution client does not return the value of its payload. 0 disables the check.",
+	}
 	// ValidatorsRegistrationBatchSizeFlag sets the maximum size for one batch of validator registrations. Use a non-positive value to disable batching.
 	ValidatorsRegistrationBatchSizeFlag = &cli.IntFlag{
 		Name:  "validators-registration-batch-size",
@@ -389,6 +545,68 @@ var (
 		Usage: "To enable the use of prysm validator client in Distributed Validator Cluster",
 		Value: false,
 	}
+	// AcceptGenesisChangeFlag lets the validator client accept a beacon node on another chain than the one it first
+	// connected to.
+	AcceptGenesisChangeFlag = &cli.BoolFlag{
+		Name: "accept-genesis-change",
+		Usage: `Accepts a beacon node whose genesis validators root or fork schedule differs from the one saved in the
+		validator database, and saves the new ones. Without it, the validator client refuses to perform duties with such
+		a beacon node. Only use it when the validator was deliberately moved to another chain.`,
+	}
+	// BlockRequestAttemptsFlag sets how many times the validator client requests a block from the beacon node when proposing.
+	BlockRequestAttemptsFlag = &cli.IntFlag{
+		Name: "block-request-attempts",
+		Usage: `Number of times to request a block from the beacon node when proposing, if the request times out or the
+		beacon node is unavailable. Attempts share the time until one third into the slot, and rotate through the
+		beacon nodes given to --` + BeaconRESTApiProviderFlag.Name + ` when more than one is configured.`,
+		Value: 1,
+	}
+	// LocalBlockFallbackFlag enables a last block request for a locally built block when requesting a block fails.
+	LocalBlockFallbackFlag = &cli.BoolFlag{
+		Name: "local-block-fallback",
+		Usage: `When requesting a block from the beacon node fails, requests a block with the local execution payload
+		from only the randao reveal and graffiti as a last resort, without the builder, in the time left until one
+		third into the slot.`,
+	}
+	// BlockPublishEndpointsFlag defines publish-only beacon API endpoints to which signed blocks are also published.
+	BlockPublishEndpointsFlag = &cli.StringSliceFlag{
+		Name: "block-publish-endpoints",
+		Usage: `Comma-separated beacon API endpoints, such as sentry nodes, to which signed blocks are also published,
+		SSZ encoded, concurrently with the beacon node. A proposal succeeds if any endpoint accepts the block. Blinded
+		blocks are not published to these endpoints, see --blinded-block-publish-endpoints.`,
+	}
+	// BlindedBlockPublishEndpointsFlag defines publish-only beacon API endpoints to which blinded blocks are also published.
+	BlindedBlockPublishEndpointsFlag = &cli.StringSliceFlag{
+		Name: "blinded-block-publish-endpoints",
+		Usage: `Comma-separated beacon API endpoints to which signed blocks, including blinded blocks, are also published.
+		A blinded block can only be unblinded by the relay which built its payload, so these endpoints must use the same
+		relays as the beacon node.`,
+	}
+	// BlockPublishTimeoutFlag sets how long the publish endpoints have to accept a block.
+	BlockPublishTimeoutFlag = &cli.DurationFlag{
+		Name: "block-publish-timeout",
+		Usage: "Time for each endpoint given to --" + BlockPublishEndpointsFlag.Name + " or --blinded-block-publish-endpoints " +
+			"to accept a block, after which publishing to it is abandoned.",
+		Value: 2 * time.Second,
+	}
+	// SlashingProtectionSnapshotsDirFlag enables periodic exports of the slashing protection history to a directory.
+	SlashingProtectionSnapshotsDirFlag = &cli.StringFlag{
+		Name: "slashing-protection-snapshots-dir",
+		Usage: "Directory to periodically export the EIP-3076 slashing protection history of all keys to. " +
+			"Snapshots are disabled when empty.",
+	}
+	// SlashingProtectionSnapshotsIntervalFlag sets how often the slashing protection history is exported.
+	SlashingProtectionSnapshotsIntervalFlag = &cli.DurationFlag{
+		Name:  "slashing-protection-snapshots-interval",
+		Usage: "Interval between two exports of the slashing protection history to --slashing-protection-snapshots-dir.",
+		Value: 24 * time.Hour,
+	}
+	// SlashingProtectionSnapshotsKeepFlag sets how many slashing protection snapshots are kept.
+	SlashingProtectionSnapshotsKeepFlag = &cli.IntFlag{
+		Name:  "slashing-protection-snapshots-keep",
+		Usage: "Number of slashing protection snapshots to keep in --slashing-protection-snapshots-dir, removing the oldest ones.",
+		Value: 7,
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
@@ -0,0 +1,77 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/healthcheck"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/rpc"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for checking the health of a running validator client.
+var Commands = &cli.Command{
+	Name:  "healthcheck",
+	Usage: "Checks the health of the validator client running locally through its HTTP API and exits with code 1 if it is unhealthy.",
+	Description: `Queries the HTTP API of the validator client running with the same --http-host, --http-port and
+--keymanager-token-file, which must be enabled with --rpc or --web. The validator client is healthy when its API
+answers and it is connected to its beacon node. Meant to be used as a container health check.`,
+	Flags: cmd.WrapFlags([]cli.Flag{
+		flags.HTTPServerHost,
+		flags.HTTPServerPort,
+		flags.AuthTokenPathFlag,
+		flags.WalletDirFlag,
+		healthcheck.TimeoutFlag,
+		cmd.ConfigFileFlag,
+	}),
+	Before: func(cliCtx *cli.Context) error {
+		return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+	},
+	Action: func(cliCtx *cli.Context) error {
+		token, err := rpc.ReadAuthToken(authTokenPath(cliCtx))
+		if err != nil {
+			return cli.Exit("unhealthy: "+errors.Wrap(err, "could not read auth token").Error(), 1)
+		}
+		c := healthcheck.NewClient(cliCtx.String(flags.HTTPServerHost.Name), cliCtx.Int(flags.HTTPServerPort.Name), token)
+		return healthcheck.Run(cliCtx, c, check)
+	},
+}
+
+// authTokenPath resolves the auth token file the same way the validator client does.
+func authTokenPath(cliCtx *cli.Context) string {
+	authTokenPath := cliCtx.String(flags.AuthTokenPathFlag.Name)
+	walletDirs := wallet.Dirs(cliCtx.String(flags.WalletDirFlag.Name))
+	if authTokenPath == "" {
+		authTokenPath = flags.AuthTokenPathFlag.Value
+		if len(walletDirs) != 0 {
+			authTokenPath = filepath.Join(walletDirs[0], api.AuthTokenFileName)
+		}
+	}
+	return authTokenPath
+}
+
+func check(ctx context.Context, c *healthcheck.Client) (string, error) {
+	resp := &struct {
+		Beacon    string `json:"beacon"`
+		Validator string `json:"validator"`
+	}{}
+	code, err := c.Get(ctx, api.WebUrlPrefix+"health/version", resp)
+	if err != nil {
+		return "", err
+	}
+	switch code {
+	case http.StatusOK:
+		return fmt.Sprintf("connected to beacon node %s", resp.Beacon), nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return "", fmt.Errorf("auth token was rejected with status %d", code)
+	default:
+		return "", fmt.Errorf("validator API returned status %d, the beacon node may be unreachable", code)
+	}
+}
@@ -0,0 +1,54 @@
+package healthcheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/cmd/healthcheck"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func newClient(t *testing.T, rawURL, authToken string) *healthcheck.Client {
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+	return healthcheck.NewClient(u.Hostname(), port, authToken)
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, api.WebUrlPrefix+"health/version", r.URL.Path)
+		switch r.Header.Get("Authorization") {
+		case "Bearer healthy":
+			_, err := w.Write([]byte(`{"beacon":"Prysm/v5.1.0","validator":"Prysm/v5.1.0"}`))
+			require.NoError(t, err)
+		case "Bearer unhealthy":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer srv.Close()
+
+	state, err := check(ctx, newClient(t, srv.URL, "healthy"))
+	require.NoError(t, err)
+	require.Equal(t, "connected to beacon node Prysm/v5.1.0", state)
+
+	_, err = check(ctx, newClient(t, srv.URL, "unhealthy"))
+	require.ErrorContains(t, "validator API returned status 500", err)
+
+	_, err = check(ctx, newClient(t, srv.URL, "wrong"))
+	require.ErrorContains(t, "auth token was rejected with status 401", err)
+
+	unreachable := newClient(t, srv.URL, "healthy")
+	srv.Close()
+	_, err = check(ctx, unreachable)
+	require.ErrorContains(t, "could not reach", err)
+}
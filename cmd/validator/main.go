@@ -15,6 +15,8 @@ import (
 	accountcommands "github.com/prysmaticlabs/prysm/v5/cmd/validator/accounts"
 	dbcommands "github.com/prysmaticlabs/prysm/v5/cmd/validator/db"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	healthcheckcommands "github.com/prysmaticlabs/prysm/v5/cmd/validator/healthcheck"
+	proposersettingscommands "github.com/prysmaticlabs/prysm/v5/cmd/validator/proposer-settings"
 	slashingprotectioncommands "github.com/prysmaticlabs/prysm/v5/cmd/validator/slashing-protection"
 	walletcommands "github.com/prysmaticlabs/prysm/v5/cmd/validator/wallet"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/web"
@@ -51,9 +53,14 @@ func startNode(ctx *cli.Context) error {
 var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
 	flags.BeaconRESTApiProviderFlag,
+	flags.BeaconRESTApiRetriesFlag,
+	flags.BeaconRESTApiRetryDelayFlag,
+	flags.BeaconRESTApiHedgeProviderFlag,
+	flags.BeaconRESTApiHedgeDelayFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
 	flags.DisablePenaltyRewardLogFlag,
+	flags.EnableRewardsEstimationFlag,
 	flags.InteropStartIndex,
 	flags.InteropNumValidators,
 	flags.EnableRPCFlag,
@@ -70,20 +77,36 @@ var appFlags = []cli.Flag{
 	flags.SlasherRPCProviderFlag,
 	flags.SlasherCertFlag,
 	flags.WalletPasswordFileFlag,
+	flags.WalletPasswordKeyringFlag,
 	flags.WalletDirFlag,
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
 	flags.EnableDistributed,
+	flags.AcceptGenesisChangeFlag,
+	flags.BlockRequestAttemptsFlag,
+	flags.LocalBlockFallbackFlag,
+	flags.BlockPublishEndpointsFlag,
+	flags.BlindedBlockPublishEndpointsFlag,
+	flags.BlockPublishTimeoutFlag,
 	flags.AuthTokenPathFlag,
+	flags.SlashingProtectionSnapshotsDirFlag,
+	flags.SlashingProtectionSnapshotsIntervalFlag,
+	flags.SlashingProtectionSnapshotsKeepFlag,
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
 	flags.Web3SignerKeyFileFlag,
+	flags.Web3SignerMaxConcurrentRequestsFlag,
+	flags.Web3SignerPublicKeysRefreshIntervalFlag,
 	flags.SuggestedFeeRecipientFlag,
+	flags.SuggestedFeeRecipientIsBurnOkFlag,
 	flags.ProposerSettingsURLFlag,
+	flags.ProposerSettingsRefreshIntervalFlag,
 	flags.ProposerSettingsFlag,
 	flags.EnableBuilderFlag,
 	flags.BuilderGasLimitFlag,
+	flags.MinBuilderBidFlag,
+	flags.MinBuilderBidToLocalRatioFlag,
 	flags.ValidatorsRegistrationBatchSizeFlag,
 	////////////////////
 	cmd.DisableMonitoringFlag,
@@ -104,6 +127,7 @@ var appFlags = []cli.Flag{
 	cmd.LogFileName,
 	cmd.ConfigFileFlag,
 	cmd.ChainConfigFileFlag,
+	cmd.ChainPresetFileFlag,
 	cmd.GrpcMaxCallRecvMsgSizeFlag,
 	cmd.ApiTimeoutFlag,
 	debug.PProfFlag,
@@ -138,7 +162,9 @@ func main() {
 			accountcommands.Commands,
 			slashingprotectioncommands.Commands,
 			dbcommands.Commands,
+			proposersettingscommands.Commands,
 			web.Commands,
+			healthcheckcommands.Commands,
 		},
 		Flags: appFlags,
 		Before: func(ctx *cli.Context) error {
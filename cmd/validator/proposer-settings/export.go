@@ -0,0 +1,84 @@
+package proposersettingscmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	validatordb "github.com/prysmaticlabs/prysm/v5/validator/db"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	proposersettings "github.com/prysmaticlabs/prysm/v5/validator/proposer-settings"
+	"github.com/urfave/cli/v2"
+)
+
+// exportProposerSettings writes the effective proposer settings of the validator client to a JSON file.
+// The entries persisted in the validator database are compared to the ones of the proposer settings
+// file or URL, if set, to annotate where each of them comes from.
+func exportProposerSettings(cliCtx *cli.Context) error {
+	if cliCtx.IsSet(flags.ProposerSettingsFlag.Name) && cliCtx.IsSet(flags.ProposerSettingsURLFlag.Name) {
+		return fmt.Errorf("cannot specify both --%s and --%s flags", flags.ProposerSettingsFlag.Name, flags.ProposerSettingsURLFlag.Name)
+	}
+	source, err := proposersettings.LoadSource(
+		cliCtx.Context,
+		cliCtx.String(flags.ProposerSettingsFlag.Name),
+		cliCtx.String(flags.ProposerSettingsURLFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+
+	validatorDB, err := openDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator DB")
+		}
+	}()
+
+	doc, err := proposersettings.Export(cliCtx.Context, validatorDB, source)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.MarshalIndent(doc, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not JSON marshal proposer settings")
+	}
+	outputPath := cliCtx.String(flags.ProposerSettingsExportFileFlag.Name)
+	if err := file.MkdirAll(filepath.Dir(outputPath)); err != nil {
+		return errors.Wrapf(err, "could not create output directory of %s", outputPath)
+	}
+	if err := file.WriteFile(outputPath, encoded); err != nil {
+		return errors.Wrapf(err, "could not write file to path %s", outputPath)
+	}
+	log.WithField("path", outputPath).Info(
+		"Exported proposer settings. They can be restored with the validator proposer-settings import command",
+	)
+	return nil
+}
+
+// openDB opens the validator database of the data directory, whatever its representation.
+func openDB(cliCtx *cli.Context) (iface.ValidatorDB, error) {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	r, err := validatordb.DetectRepresentation(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	var validatorDB iface.ValidatorDB
+	if r == validatordb.Minimal {
+		validatorDB, err = filesystem.NewStore(dataDir, nil)
+	} else {
+		validatorDB, err = kv.NewKVStore(cliCtx.Context, dataDir, nil)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	return validatorDB, nil
+}
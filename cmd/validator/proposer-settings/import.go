@@ -0,0 +1,46 @@
+package proposersettingscmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	proposersettings "github.com/prysmaticlabs/prysm/v5/validator/proposer-settings"
+	"github.com/urfave/cli/v2"
+)
+
+// importProposerSettings saves the proposer settings of a file written by the export command
+// to the validator database, replacing the existing ones.
+func importProposerSettings(cliCtx *cli.Context) error {
+	if !cliCtx.IsSet(flags.ProposerSettingsImportFileFlag.Name) {
+		return fmt.Errorf("--%s must be set", flags.ProposerSettingsImportFileFlag.Name)
+	}
+	inputPath := cliCtx.String(flags.ProposerSettingsImportFileFlag.Name)
+	encoded, err := os.ReadFile(filepath.Clean(inputPath))
+	if err != nil {
+		return errors.Wrapf(err, "could not read file %s", inputPath)
+	}
+	doc := &proposersettings.Document{}
+	if err := json.Unmarshal(encoded, doc); err != nil {
+		return errors.Wrapf(err, "could not JSON unmarshal proposer settings from %s", inputPath)
+	}
+
+	validatorDB, err := openDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator DB")
+		}
+	}()
+
+	if err := proposersettings.Import(cliCtx.Context, validatorDB, doc); err != nil {
+		return err
+	}
+	log.WithField("path", inputPath).Info("Imported proposer settings")
+	return nil
+}
@@ -0,0 +1,68 @@
+package proposersettingscmd
+
+import (
+	"context"
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"github.com/urfave/cli/v2"
+)
+
+func setupCliCtx(tb testing.TB, dataDir, path string) *cli.Context {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	set.String(flags.ProposerSettingsExportFileFlag.Name, path, "")
+	set.String(flags.ProposerSettingsImportFileFlag.Name, path, "")
+	require.NoError(tb, set.Set(cmd.DataDirFlag.Name, dataDir))
+	require.NoError(tb, set.Set(flags.ProposerSettingsExportFileFlag.Name, path))
+	require.NoError(tb, set.Set(flags.ProposerSettingsImportFileFlag.Name, path))
+	return cli.NewContext(&app, set, nil)
+}
+
+func TestImportExportProposerSettingsCli_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "exports", "proposer_settings.json")
+	var key [fieldparams.BLSPubkeyLength]byte
+	key[0] = 1
+	settings := &proposer.Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+			key: {
+				FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")},
+				BuilderConfig:      &proposer.BuilderConfig{Enabled: true, GasLimit: 30000000},
+			},
+		},
+		DefaultConfig: &proposer.Option{
+			FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")},
+		},
+	}
+
+	source := dbtest.SetupDB(t, nil, false)
+	require.NoError(t, source.SaveProposerSettings(ctx, settings))
+	sourceDir := source.DatabasePath()
+	require.NoError(t, source.Close())
+	require.NoError(t, exportProposerSettings(setupCliCtx(t, sourceDir, path)))
+
+	restored := dbtest.SetupDB(t, nil, false)
+	restoredDir := restored.DatabasePath()
+	require.NoError(t, restored.Close())
+	require.NoError(t, importProposerSettings(setupCliCtx(t, restoredDir, path)))
+
+	restoredDB, err := kv.NewKVStore(ctx, restoredDir, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, restoredDB.Close())
+	}()
+	got, err := restoredDB.ProposerSettings(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, settings, got)
+}
@@ -0,0 +1,5 @@
+package proposersettingscmd
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "proposersettingscmd")
@@ -0,0 +1,76 @@
+package proposersettingscmd
+
+import (
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/runtime/tos"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for proposer settings.
+var Commands = &cli.Command{
+	Name:     "proposer-settings",
+	Category: "proposer-settings",
+	Usage:    "Defines commands for exporting and importing your validator client's proposer settings.",
+	Subcommands: []*cli.Command{
+		{
+			Name: "export",
+			Description: `exports the effective proposer settings of your validator client, including the ones set through the keymanager API, ` +
+				`into a JSON file annotating where each entry comes from`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.ProposerSettingsFlag,
+				flags.ProposerSettingsURLFlag,
+				flags.ProposerSettingsExportFileFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := exportProposerSettings(cliCtx); err != nil {
+					logrus.Fatalf("Could not export proposer settings: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "import",
+			Description: `imports proposer settings exported by the export command into the validator database`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.ProposerSettingsImportFileFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := importProposerSettings(cliCtx); err != nil {
+					logrus.Fatalf("Could not import proposer settings: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
@@ -58,6 +58,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.DataDirFlag,
 			flags.WalletDirFlag,
 			flags.WalletPasswordFileFlag,
+			flags.WalletPasswordKeyringFlag,
 			cmd.ClearDB,
 			cmd.ForceClearDB,
 			cmd.EnableBackupWebhookFlag,
@@ -73,6 +74,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.LogFileName,
 			cmd.ConfigFileFlag,
 			cmd.ChainConfigFileFlag,
+			cmd.ChainPresetFileFlag,
 			cmd.GrpcMaxCallRecvMsgSizeFlag,
 			cmd.AcceptTosFlag,
 			cmd.ApiTimeoutFlag,
@@ -106,6 +108,10 @@ var appHelpFlagGroups = []flagGroup{
 			flags.HTTPServerCorsDomain,
 			flags.GRPCHeadersFlag,
 			flags.BeaconRESTApiProviderFlag,
+			flags.BeaconRESTApiRetriesFlag,
+			flags.BeaconRESTApiRetryDelayFlag,
+			flags.BeaconRESTApiHedgeProviderFlag,
+			flags.BeaconRESTApiHedgeDelayFlag,
 		},
 	},
 	{
@@ -113,9 +119,13 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			flags.ProposerSettingsFlag,
 			flags.ProposerSettingsURLFlag,
+			flags.ProposerSettingsRefreshIntervalFlag,
 			flags.SuggestedFeeRecipientFlag,
+			flags.SuggestedFeeRecipientIsBurnOkFlag,
 			flags.EnableBuilderFlag,
 			flags.BuilderGasLimitFlag,
+			flags.MinBuilderBidFlag,
+			flags.MinBuilderBidToLocalRatioFlag,
 			flags.ValidatorsRegistrationBatchSizeFlag,
 			flags.GraffitiFlag,
 			flags.GraffitiFileFlag,
@@ -127,6 +137,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
 			flags.Web3SignerKeyFileFlag,
+			flags.Web3SignerMaxConcurrentRequestsFlag,
+			flags.Web3SignerPublicKeysRefreshIntervalFlag,
 		},
 	},
 	{
@@ -141,9 +153,19 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			flags.EnableWebFlag,
 			flags.DisablePenaltyRewardLogFlag,
+			flags.EnableRewardsEstimationFlag,
 			flags.DisableAccountMetricsFlag,
 			flags.EnableDistributed,
+			flags.AcceptGenesisChangeFlag,
+			flags.BlockRequestAttemptsFlag,
+			flags.LocalBlockFallbackFlag,
+			flags.BlockPublishEndpointsFlag,
+			flags.BlindedBlockPublishEndpointsFlag,
+			flags.BlockPublishTimeoutFlag,
 			flags.AuthTokenPathFlag,
+			flags.SlashingProtectionSnapshotsDirFlag,
+			flags.SlashingProtectionSnapshotsIntervalFlag,
+			flags.SlashingProtectionSnapshotsKeepFlag,
 		},
 	},
 	{
@@ -0,0 +1,46 @@
+package wallet
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/io/keyring"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/urfave/cli/v2"
+)
+
+// walletSetKeyringPassword stores the wallet password in the OS keyring, once checked to unlock the wallet.
+func walletSetKeyringPassword(cliCtx *cli.Context) error {
+	walletDir, err := userprompt.InputDirectory(cliCtx, userprompt.WalletDirPromptText, flags.WalletDirFlag)
+	if err != nil {
+		return err
+	}
+	walletPassword, err := wallet.InputPassword(
+		cliCtx,
+		flags.WalletPasswordFileFlag,
+		wallet.PasswordPromptText,
+		false, /* Do not confirm password */
+		wallet.ValidateExistingPass,
+	)
+	if err != nil {
+		return err
+	}
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{
+		WalletDir:      walletDir,
+		WalletPassword: walletPassword,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	if _, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false}); err != nil {
+		return errors.Wrap(err, "could not unlock wallet with the password")
+	}
+	if err := keyring.SetWalletPassword(walletDir, walletPassword); err != nil {
+		return errors.Wrap(err, "could not store wallet password in the OS keyring")
+	}
+	log.WithField("walletDir", walletDir).Infof(
+		"Stored wallet password in the OS keyring, use --%s to read it", flags.WalletPasswordKeyringFlag.Name,
+	)
+	return nil
+}
@@ -0,0 +1,81 @@
+//go:build !keyring_disabled
+
+package wallet
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/io/keyring"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+	gokeyring "github.com/zalando/go-keyring"
+)
+
+func keyringWalletCtx(walletDir, walletPasswordFile string) *cli.Context {
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.WalletDirFlag.Name, walletDir, "")
+	set.Bool(flags.WalletPasswordKeyringFlag.Name, true, "")
+	if walletPasswordFile != "" {
+		set.String(flags.WalletPasswordFileFlag.Name, walletPasswordFile, "")
+		if err := set.Set(flags.WalletPasswordFileFlag.Name, walletPasswordFile); err != nil {
+			panic(err)
+		}
+	}
+	return cli.NewContext(&cli.App{}, set, nil)
+}
+
+func TestWalletSetKeyringPassword(t *testing.T) {
+	gokeyring.MockInit()
+	hook := logTest.NewGlobal()
+	walletDir, passwordsDir, walletPasswordFile := SetupWalletAndPasswordsDir(t)
+	cliCtx := SetupWalletCtx(t, &TestWalletConfig{
+		walletDir:          walletDir,
+		passwordsDir:       passwordsDir,
+		keymanagerKind:     keymanager.Local,
+		walletPasswordFile: walletPasswordFile,
+	})
+	_, err := wallet.OpenWalletOrElseCli(cliCtx, wallet.OpenOrCreateNewWallet)
+	require.NoError(t, err)
+
+	require.NoError(t, walletSetKeyringPassword(cliCtx))
+	require.LogsContain(t, hook, "Stored wallet password in the OS keyring")
+	stored, err := keyring.WalletPassword(walletDir)
+	require.NoError(t, err)
+	assert.Equal(t, password, stored)
+
+	// The wallet is opened without a password file, from the keyring.
+	w, err := wallet.OpenWalletOrElseCli(keyringWalletCtx(walletDir, ""), wallet.OpenOrCreateNewWallet)
+	require.NoError(t, err)
+	assert.Equal(t, password, w.Password())
+	require.LogsContain(t, hook, "Read wallet password from the OS keyring")
+}
+
+func TestOpenWallet_KeyringUnavailable(t *testing.T) {
+	hook := logTest.NewGlobal()
+	walletDir, passwordsDir, walletPasswordFile := SetupWalletAndPasswordsDir(t)
+	cliCtx := SetupWalletCtx(t, &TestWalletConfig{
+		walletDir:          walletDir,
+		passwordsDir:       passwordsDir,
+		keymanagerKind:     keymanager.Local,
+		walletPasswordFile: walletPasswordFile,
+	})
+	_, err := wallet.OpenWalletOrElseCli(cliCtx, wallet.OpenOrCreateNewWallet)
+	require.NoError(t, err)
+
+	// A locked keyring falls back to the wallet password file.
+	gokeyring.MockInitWithError(errors.New("prompt dismissed"))
+	w, err := wallet.OpenWalletOrElseCli(keyringWalletCtx(walletDir, walletPasswordFile), wallet.OpenOrCreateNewWallet)
+	require.NoError(t, err)
+	assert.Equal(t, password, w.Password())
+	require.LogsContain(t, hook, "Could not read wallet password from the OS keyring")
+	require.LogsContain(t, hook, "Make sure the keyring is unlocked")
+
+	require.ErrorContains(t, "could not store wallet password in the OS keyring", walletSetKeyringPassword(cliCtx))
+}
@@ -85,6 +85,7 @@ func walletRecover(c *cli.Context) error {
 	opts = append(opts, accounts.WithWalletDir(walletDir))
 	opts = append(opts, accounts.WithWalletPassword(walletPassword))
 	opts = append(opts, accounts.WithNumAccounts(int(numAccounts)))
+	opts = append(opts, accounts.WithAccountNameTemplate(c.String(flags.AccountNameTemplateFlag.Name)))
 
 	acc, err := accounts.NewCLIManager(opts...)
 	if err != nil {
@@ -61,6 +61,7 @@ var Commands = &cli.Command{
 				flags.NumAccountsFlag,
 				flags.Mnemonic25thWordFileFlag,
 				flags.SkipMnemonic25thWordCheckFlag,
+				flags.AccountNameTemplateFlag,
 				features.Mainnet,
 				features.SepoliaTestnet,
 				features.HoleskyTestnet,
@@ -82,5 +83,32 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:  "set-keyring-password",
+			Usage: "stores the wallet password in the OS keyring, to be read with --" + flags.WalletPasswordKeyringFlag.Name,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				features.Mainnet,
+				features.SepoliaTestnet,
+				features.HoleskyTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				if err := tos.VerifyTosAcceptedOrPrompt(cliCtx); err != nil {
+					return err
+				}
+				return features.ConfigureValidator(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := walletSetKeyringPassword(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not store wallet password in the OS keyring")
+				}
+				return nil
+			},
+		},
 	},
 }
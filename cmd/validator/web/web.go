@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/runtime/tos"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v5/validator/rpc"
 	"github.com/urfave/cli/v2"
 )
@@ -39,10 +40,12 @@ var Commands = &cli.Command{
 				if err := features.ConfigureValidator(cliCtx); err != nil {
 					return err
 				}
-				walletDirPath := cliCtx.String(flags.WalletDirFlag.Name)
-				if walletDirPath == "" {
+				walletDirs := wallet.Dirs(cliCtx.String(flags.WalletDirFlag.Name))
+				if len(walletDirs) == 0 {
 					log.Fatal("--wallet-dir not specified")
 				}
+				// The auth token of several wallets is kept in the first one.
+				walletDirPath := walletDirs[0]
 				host := cliCtx.String(flags.HTTPServerHost.Name)
 				port := cliCtx.Int(flags.HTTPServerPort.Name)
 				validatorWebAddr := fmt.Sprintf("%s:%d", host, port)
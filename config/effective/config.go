@@ -0,0 +1,78 @@
+package effective
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// BeaconConfigFields returns every field of the beacon chain config, keyed by field name.
+func BeaconConfigFields(cfg *params.BeaconChainConfig) map[string]string {
+	return structFields(reflect.ValueOf(cfg).Elem())
+}
+
+// NetworkConfigFields returns every field of the network config, keyed by field name.
+func NetworkConfigFields(cfg *params.NetworkConfig) map[string]string {
+	return structFields(reflect.ValueOf(cfg).Elem())
+}
+
+// FeatureFields returns every feature flag, keyed by field name.
+func FeatureFields(f *features.Flags) map[string]string {
+	return structFields(reflect.ValueOf(f).Elem())
+}
+
+func structFields(v reflect.Value) map[string]string {
+	fields := make(map[string]string, v.NumField())
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+		fields[t.Field(i).Name] = formatValue(v.Field(i))
+	}
+	return fields
+}
+
+// formatValue renders a config value in a stable way: byte arrays and slices are hex encoded,
+// durations use their string form, and collections list their elements in order, with map entries sorted.
+func formatValue(v reflect.Value) string {
+	switch {
+	case v.Type() == durationType:
+		return time.Duration(v.Int()).String()
+	case (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() == reflect.Uint8:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return hexutil.Encode(b)
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = formatValue(v.Index(i))
+		}
+		return strings.Join(elems, ",")
+	case reflect.Map:
+		entries := make([]string, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			entries = append(entries, formatValue(iter.Key())+"="+formatValue(iter.Value()))
+		}
+		sort.Strings(entries)
+		return strings.Join(entries, ",")
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return ""
+		}
+		return formatValue(v.Elem())
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
@@ -0,0 +1,29 @@
+package effective
+
+import "sort"
+
+// Difference is a config key whose value differs between two configs. A key missing from a config has an empty value.
+type Difference struct {
+	Key      string
+	Expected string
+	Actual   string
+}
+
+// Diff returns the keys whose values differ between the expected and actual configs, sorted by key.
+func Diff(expected, actual map[string]string) []Difference {
+	keys := make(map[string]struct{}, len(expected))
+	for k := range expected {
+		keys[k] = struct{}{}
+	}
+	for k := range actual {
+		keys[k] = struct{}{}
+	}
+	diffs := make([]Difference, 0)
+	for k := range keys {
+		if expected[k] != actual[k] {
+			diffs = append(diffs, Difference{Key: k, Expected: expected[k], Actual: actual[k]})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Key < diffs[j].Key })
+	return diffs
+}
@@ -0,0 +1,4 @@
+// Package effective flattens the configuration a node is running with, the beacon chain config, the network
+// config, the feature flags and the command line flags, into string maps. The maps encode to a stable JSON form
+// which can be attached to bug reports and compared between nodes or against the built-in configs.
+package effective
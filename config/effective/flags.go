@@ -0,0 +1,67 @@
+package effective
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Redacted replaces the value of flags which may hold secrets.
+const Redacted = "<redacted>"
+
+// secretFlagNameParts are parts of the names of flags whose values are never exported.
+var secretFlagNameParts = []string{"secret", "password", "token", "priv-key", "header"}
+
+// FlagValues returns the effective value of every flag of the application, whether set or defaulted.
+// Values of flags which may hold secrets are redacted, and URLs are reduced to their scheme and host,
+// as credentials and API keys are often part of the user info, path or query of endpoint URLs.
+func FlagValues(cliCtx *cli.Context) map[string]string {
+	values := make(map[string]string)
+	if cliCtx.App == nil {
+		return values
+	}
+	for _, f := range cliCtx.App.Flags {
+		names := f.Names()
+		if len(names) == 0 {
+			continue
+		}
+		name := names[0]
+		values[name] = sanitize(name, flagValue(cliCtx.Value(name)))
+	}
+	return values
+}
+
+func flagValue(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case cli.StringSlice:
+		return strings.Join(s.Value(), ",")
+	case *cli.StringSlice:
+		return strings.Join(s.Value(), ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func sanitize(name, value string) string {
+	if value == "" {
+		return value
+	}
+	for _, part := range secretFlagNameParts {
+		if strings.Contains(name, part) {
+			return Redacted
+		}
+	}
+	values := strings.Split(value, ",")
+	for i, v := range values {
+		u, err := url.Parse(v)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			continue
+		}
+		values[i] = (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+	}
+	return strings.Join(values, ",")
+}
@@ -49,6 +49,7 @@ type Flags struct {
 	EnableHistoricalSpaceRepresentation bool // EnableHistoricalSpaceRepresentation enables the saving of registry validators in separate buckets to save space
 	EnableBeaconRESTApi                 bool // EnableBeaconRESTApi enables experimental usage of the beacon REST API by the validator when querying a beacon node
 	DisableCommitteeAwarePacking        bool // DisableCommitteeAwarePacking changes the attestation packing algorithm to one that is not aware of attesting committees.
+	PersistAttestationPool              bool // PersistAttestationPool saves the attestation pool on shutdown and reloads it on a restart within the same epoch.
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
 	EnableFullSSZDataLogging  bool // Enables logging for full ssz data on rejected gossip messages
@@ -72,6 +73,10 @@ type Flags struct {
 	EnableVerboseSigVerification bool // EnableVerboseSigVerification specifies whether to verify individual signature if batch verification fails
 
 	PrepareAllPayloads bool // PrepareAllPayloads informs the engine to prepare a block on every slot.
+	PrepareProposals   bool // PrepareProposals prepares the state and payload in the slot before a tracked validator proposes.
+	// ShadowProposalsPerEpoch is the number of random slots per epoch for which a block is built for a fake proposer
+	// to measure block production, without ever being signed or published.
+	ShadowProposalsPerEpoch uint64
 	// BlobSaveFsync requires blob saving to block on fsync to ensure blobs are durably persisted before passing DA.
 	BlobSaveFsync bool
 
@@ -242,6 +247,14 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(prepareAllPayloads)
 		cfg.PrepareAllPayloads = true
 	}
+	if ctx.IsSet(prepareProposals.Name) {
+		logEnabled(prepareProposals)
+		cfg.PrepareProposals = true
+	}
+	if ctx.IsSet(shadowProposalsPerEpoch.Name) {
+		logEnabled(shadowProposalsPerEpoch)
+		cfg.ShadowProposalsPerEpoch = ctx.Uint64(shadowProposalsPerEpoch.Name)
+	}
 	if ctx.IsSet(disableResourceManager.Name) {
 		logEnabled(disableResourceManager)
 		cfg.DisableResourceManager = true
@@ -266,6 +279,11 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(EnableDiscoveryReboot)
 		cfg.EnableDiscoveryReboot = true
 	}
+	cfg.PersistAttestationPool = true
+	if ctx.Bool(disableAttestationPoolPersistence.Name) {
+		logDisabled(disableAttestationPoolPersistence)
+		cfg.PersistAttestationPool = false
+	}
 
 	cfg.AggregateIntervals = [3]time.Duration{aggregateFirstInterval.Value, aggregateSecondInterval.Value, aggregateThirdInterval.Value}
 	Init(cfg)
@@ -143,6 +143,16 @@ var (
 		Name:  "prepare-all-payloads",
 		Usage: "Informs the engine to prepare all local payloads. Useful for relayers and builders.",
 	}
+	prepareProposals = &cli.BoolFlag{
+		Name: "prepare-proposals",
+		Usage: `(Advanced): In the slot before a tracked validator proposes, advances a copy of the head state and
+	requests the execution payload ahead of time to shorten block production.`,
+	}
+	shadowProposalsPerEpoch = &cli.Uint64Flag{
+		Name: "shadow-proposals-per-epoch",
+		Usage: `(Advanced): Number of random slots per epoch for which the beacon node builds a block for a fake proposer,
+	to measure block production without waiting for a proposal. These blocks are never signed or published.`,
+	}
 	EnableLightClient = &cli.BoolFlag{
 		Name:  "enable-lightclient",
 		Usage: "Enables the light client support in the beacon node",
@@ -174,6 +184,10 @@ var (
 		Name:  "enable-discovery-reboot",
 		Usage: "Experimental: Enables the discovery listener to rebooted in the event of connectivity issues.",
 	}
+	disableAttestationPoolPersistence = &cli.BoolFlag{
+		Name:  "disable-attestation-pool-persistence",
+		Usage: "Disables saving the attestation pool on shutdown and reloading it on a restart within the same epoch.",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -222,6 +236,8 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	enableFullSSZDataLogging,
 	disableVerboseSigVerification,
 	prepareAllPayloads,
+	prepareProposals,
+	shadowProposalsPerEpoch,
 	aggregateFirstInterval,
 	aggregateSecondInterval,
 	aggregateThirdInterval,
@@ -232,6 +248,7 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	EnableQUIC,
 	DisableCommitteeAwarePacking,
 	EnableDiscoveryReboot,
+	disableAttestationPoolPersistence,
 }...)...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
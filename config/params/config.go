@@ -276,6 +276,7 @@ type BeaconChainConfig struct {
 	// PeerDAS
 	NumberOfColumns          uint64 `yaml:"NUMBER_OF_COLUMNS" spec:"true"`            // NumberOfColumns in the extended data matrix.
 	MaxCellsInExtendedMatrix uint64 `yaml:"MAX_CELLS_IN_EXTENDED_MATRIX" spec:"true"` // MaxCellsInExtendedMatrix is the full data of one-dimensional erasure coding extended blobs (in row major format).
+	CustodyRequirement       uint64 `yaml:"CUSTODY_REQUIREMENT" spec:"true"`          // CustodyRequirement is the minimum number of data column sidecar subnets a node custodies.
 }
 
 // InitializeForkSchedule initializes the schedules forks baked into the config.
@@ -0,0 +1,3 @@
+package params
+
+var OptionalConfigKeys = optionalConfigKeys
@@ -4,6 +4,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -13,6 +16,106 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
+// optionalConfigKeys are keys of the published configs and presets which have no matching field in
+// BeaconChainConfig, as Prysm does not use them. They are accepted and ignored when loading a config.
+var optionalConfigKeys = map[string]bool{
+	"BYTES_PER_LOGS_BLOOM":                 true,
+	"EIP6110_FORK_EPOCH":                   true,
+	"EIP6110_FORK_VERSION":                 true,
+	"EIP7002_FORK_EPOCH":                   true,
+	"EIP7002_FORK_VERSION":                 true,
+	"EIP7594_FORK_EPOCH":                   true,
+	"EIP7594_FORK_VERSION":                 true,
+	"EIP7732_FORK_EPOCH":                   true,
+	"EIP7732_FORK_VERSION":                 true,
+	"FIELD_ELEMENTS_PER_BLOB":              true,
+	"KZG_COMMITMENT_INCLUSION_PROOF_DEPTH": true,
+	"MAX_BLOBS_PER_BLOCK":                  true,
+	"MAX_BLOB_COMMITMENTS_PER_BLOCK":       true,
+	"MAX_BYTES_PER_TRANSACTION":            true,
+	"MAX_EXTRA_DATA_BYTES":                 true,
+	"MAX_REQUEST_PAYLOADS":                 true,
+	"MAX_TRANSACTIONS_PER_PAYLOAD":         true,
+	"REORG_HEAD_WEIGHT_THRESHOLD":          true,
+	"SAMPLES_PER_SLOT":                     true,
+	"SHARDING_FORK_EPOCH":                  true,
+	"SHARDING_FORK_VERSION":                true,
+	"TARGET_NUMBER_OF_PEERS":               true,
+	"UPDATE_TIMEOUT":                       true,
+	"WHISK_EPOCHS_PER_SHUFFLING_PHASE":     true,
+	"WHISK_FORK_EPOCH":                     true,
+	"WHISK_FORK_VERSION":                   true,
+	"WHISK_PROPOSER_SELECTION_GAP":         true,
+}
+
+// requiredConfigKeys are the keys a chain config file must set. They identify the network, so falling
+// back to the values of the preset base would silently run the node on another chain.
+var requiredConfigKeys = []string{
+	"PRESET_BASE",
+	"MIN_GENESIS_TIME",
+	"GENESIS_FORK_VERSION",
+	"GENESIS_DELAY",
+	"SECONDS_PER_SLOT",
+	"ALTAIR_FORK_VERSION",
+	"ALTAIR_FORK_EPOCH",
+	"BELLATRIX_FORK_VERSION",
+	"BELLATRIX_FORK_EPOCH",
+	"CAPELLA_FORK_VERSION",
+	"CAPELLA_FORK_EPOCH",
+	"DENEB_FORK_VERSION",
+	"DENEB_FORK_EPOCH",
+	"DEPOSIT_CHAIN_ID",
+	"DEPOSIT_NETWORK_ID",
+	"DEPOSIT_CONTRACT_ADDRESS",
+}
+
+// configFileName is the name of the config file in a chain config directory.
+const configFileName = "config.yaml"
+
+// knownConfigKeys are the yaml keys of the BeaconChainConfig fields.
+var knownConfigKeys = func() map[string]bool {
+	keys := make(map[string]bool)
+	t := reflect.TypeOf(BeaconChainConfig{})
+	for i := 0; i < t.NumField(); i++ {
+		if key, ok := t.Field(i).Tag.Lookup("yaml"); ok {
+			keys[strings.Split(key, ",")[0]] = true
+		}
+	}
+	return keys
+}()
+
+// configKeys returns the values of the yaml file by key. It fails when a key is set more than once,
+// or is neither a config field nor an optional key.
+func configKeys(yamlFile []byte) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	if err := yaml.UnmarshalStrict(yamlFile, &values); err != nil {
+		return nil, errors.Wrap(err, "could not parse chain config yaml file")
+	}
+	var unknown []string
+	for key := range values {
+		if !knownConfigKeys[key] && !optionalConfigKeys[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, errors.Errorf("unknown chain config keys: %s", strings.Join(unknown, ", "))
+	}
+	return values, nil
+}
+
+// presetConfig returns the built-in config of the preset base.
+func presetConfig(presetBase string) (*BeaconChainConfig, error) {
+	switch presetBase {
+	case MainnetName:
+		return MainnetConfig().Copy(), nil
+	case MinimalName:
+		return MinimalSpecConfig().Copy(), nil
+	default:
+		return nil, errors.Errorf("unknown preset base %q, only %s and %s are built in", presetBase, MainnetName, MinimalName)
+	}
+}
+
 func isMinimal(lines []string) bool {
 	for _, l := range lines {
 		if strings.HasPrefix(l, "PRESET_BASE: 'minimal'") ||
@@ -52,7 +155,11 @@ func UnmarshalConfig(yamlFile []byte, conf *BeaconChainConfig) (*BeaconChainConf
 		}
 	}
 	yamlFile = []byte(strings.Join(lines, "\n"))
-	if err := yaml.UnmarshalStrict(yamlFile, conf); err != nil {
+	if _, err := configKeys(yamlFile); err != nil {
+		return nil, err
+	}
+	// Keys were checked above, so only the values not fitting their field are reported here.
+	if err := yaml.Unmarshal(yamlFile, conf); err != nil {
 		var typeError *yaml.TypeError
 		if !errors.As(err, &typeError) {
 			return nil, errors.Wrap(err, "Failed to parse chain config yaml file.")
@@ -77,10 +184,76 @@ func UnmarshalConfigFile(path string, conf *BeaconChainConfig) (*BeaconChainConf
 	return UnmarshalConfig(yamlFile, conf)
 }
 
+// UnmarshalChainConfigFiles loads a chain config laid out as in the consensus specs: a config file, and
+// optionally preset files overriding the values of its PRESET_BASE preset. The path is either the config
+// file, or a directory holding a config.yaml file and, if any, preset files in presets/<PRESET_BASE>/.
+// Unless a config to override is provided, the built-in config of PRESET_BASE is the starting point, and
+// an unknown PRESET_BASE is only accepted along with preset files. Unlike UnmarshalConfigFile, which also
+// loads partial preset files, the config file must set the keys identifying the network.
+func UnmarshalChainConfigFiles(path string, conf *BeaconChainConfig, presetPaths ...string) (*BeaconChainConfig, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not stat chain config path")
+	}
+	configPath := path
+	if info.IsDir() {
+		configPath = filepath.Join(path, configFileName)
+	}
+	yamlFile, err := os.ReadFile(configPath) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to read chain config file.")
+	}
+	keys, err := configKeys(yamlFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid chain config file %s", configPath)
+	}
+	var missing []string
+	for _, key := range requiredConfigKeys {
+		if _, ok := keys[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, errors.Errorf("chain config file %s is missing required keys: %s", configPath, strings.Join(missing, ", "))
+	}
+	presetBase := fmt.Sprint(keys["PRESET_BASE"])
+
+	if info.IsDir() && len(presetPaths) == 0 {
+		presetPaths, err = filepath.Glob(filepath.Join(path, "presets", presetBase, "*.yaml"))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list preset files")
+		}
+		sort.Strings(presetPaths)
+	}
+	if conf == nil {
+		conf, err = presetConfig(presetBase)
+		if err != nil {
+			if len(presetPaths) == 0 {
+				return nil, err
+			}
+			log.WithField("presetBase", presetBase).Warn("Unknown preset base, values missing from the preset files default to the mainnet preset")
+			conf = MainnetConfig().Copy()
+		}
+	}
+	for _, p := range presetPaths {
+		log.WithField("path", p).Debug("Loading chain config preset file")
+		if conf, err = UnmarshalConfigFile(p, conf); err != nil {
+			return nil, errors.Wrapf(err, "invalid preset file %s", p)
+		}
+	}
+	conf, err = UnmarshalConfig(yamlFile, conf)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid chain config file %s", configPath)
+	}
+	conf.InitializeForkSchedule()
+	return conf, nil
+}
+
 // LoadChainConfigFile load, convert hex values into valid param yaml format,
-// unmarshal , and apply beacon chain config file.
-func LoadChainConfigFile(path string, conf *BeaconChainConfig) error {
-	c, err := UnmarshalConfigFile(path, conf)
+// unmarshal , and apply beacon chain config file. See UnmarshalChainConfigFiles
+// for the supported layouts.
+func LoadChainConfigFile(path string, conf *BeaconChainConfig, presetPaths ...string) error {
+	c, err := UnmarshalChainConfigFiles(path, conf, presetPaths...)
 	if err != nil {
 		return err
 	}
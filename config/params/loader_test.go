@@ -3,68 +3,25 @@ package params_test
 import (
 	"bytes"
 	"io"
+	"math"
 	"os"
 	"path"
 	"path/filepath"
 	"reflect"
-	"slices"
-	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/bazelbuild/rules_go/go/tools/bazel"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"gopkg.in/yaml.v2"
 )
 
-// Variables defined in the placeholderFields will not be tested in `TestLoadConfigFile`.
-// These are variables that we don't use in Prysm. (i.e. future hardfork, light client... etc)
-// IMPORTANT: Use one field per line and sort these alphabetically to reduce conflicts.
-var placeholderFields = []string{
-	"BYTES_PER_LOGS_BLOOM", // Compile time constant on ExecutionPayload.logs_bloom.
-	"CUSTODY_REQUIREMENT",
-	"EIP6110_FORK_EPOCH",
-	"EIP6110_FORK_VERSION",
-	"EIP7002_FORK_EPOCH",
-	"EIP7002_FORK_VERSION",
-	"EIP7594_FORK_EPOCH",
-	"EIP7594_FORK_VERSION",
-	"EIP7732_FORK_EPOCH",
-	"EIP7732_FORK_VERSION",
-	"FIELD_ELEMENTS_PER_BLOB",              // Compile time constant.
-	"KZG_COMMITMENT_INCLUSION_PROOF_DEPTH", // Compile time constant on BlobSidecar.commitment_inclusion_proof.
-	"MAX_BLOBS_PER_BLOCK",
-	"MAX_BLOB_COMMITMENTS_PER_BLOCK", // Compile time constant on BeaconBlockBodyDeneb.blob_kzg_commitments.
-	"MAX_BYTES_PER_TRANSACTION",      // Used for ssz of EL transactions. Unused in Prysm.
-	"MAX_EXTRA_DATA_BYTES",           // Compile time constant on ExecutionPayload.extra_data.
-	"MAX_REQUEST_PAYLOADS",           // Compile time constant on BeaconBlockBody.ExecutionRequests
-	"MAX_TRANSACTIONS_PER_PAYLOAD",   // Compile time constant on ExecutionPayload.transactions.
-	"REORG_HEAD_WEIGHT_THRESHOLD",
-	"SAMPLES_PER_SLOT",
-	"TARGET_NUMBER_OF_PEERS",
-	"UPDATE_TIMEOUT",
-	"WHISK_EPOCHS_PER_SHUFFLING_PHASE",
-	"WHISK_FORK_EPOCH",
-	"WHISK_FORK_VERSION",
-	"WHISK_PROPOSER_SELECTION_GAP",
-}
-
-func TestPlaceholderFieldsDistinctSorted(t *testing.T) {
-	m := make(map[string]struct{})
-	for i := 0; i < len(placeholderFields)-1; i++ {
-		if _, ok := m[placeholderFields[i]]; ok {
-			t.Fatalf("duplicate placeholder field %s", placeholderFields[i])
-		}
-		m[placeholderFields[i]] = struct{}{}
-	}
-	if !sort.StringsAreSorted(placeholderFields) {
-		t.Fatal("placeholderFields must be sorted")
-	}
-}
-
 func assertEqualConfigs(t *testing.T, name string, fields []string, expected, actual *params.BeaconChainConfig) {
 	//  Misc params.
 	assert.Equal(t, expected.MaxCommitteesPerSlot, actual.MaxCommitteesPerSlot, "%s: MaxCommitteesPerSlot", name)
@@ -260,6 +217,184 @@ func TestLoadConfigFile_OverwriteCorrectly(t *testing.T) {
 	require.Equal(t, params.MinimalName, params.BeaconConfig().ConfigName)
 }
 
+func TestUnmarshalChainConfigFiles_Kurtosis(t *testing.T) {
+	expected := params.MainnetConfig().Copy()
+	expected.PresetBase = "mainnet"
+	expected.ConfigName = "testnet"
+	expected.MinGenesisActiveValidatorCount = 64
+	expected.MinGenesisTime = 1728000000
+	expected.GenesisForkVersion = []byte{0x10, 0x00, 0x00, 0x38}
+	expected.GenesisDelay = 120
+	expected.AltairForkVersion = []byte{0x20, 0x00, 0x00, 0x38}
+	expected.AltairForkEpoch = 0
+	expected.BellatrixForkVersion = []byte{0x30, 0x00, 0x00, 0x38}
+	expected.BellatrixForkEpoch = 0
+	expected.TerminalTotalDifficulty = "0"
+	expected.TerminalBlockHash = [32]byte{}
+	expected.TerminalBlockHashActivationEpoch = math.MaxUint64
+	expected.CapellaForkVersion = []byte{0x40, 0x00, 0x00, 0x38}
+	expected.CapellaForkEpoch = 0
+	expected.DenebForkVersion = []byte{0x50, 0x00, 0x00, 0x38}
+	expected.DenebForkEpoch = 0
+	expected.ElectraForkVersion = []byte{0x60, 0x00, 0x00, 0x38}
+	expected.ElectraForkEpoch = 100000000
+	expected.SecondsPerSlot = 12
+	expected.SecondsPerETH1Block = 12
+	expected.MinValidatorWithdrawabilityDelay = 1
+	expected.ShardCommitteePeriod = 1
+	expected.Eth1FollowDistance = 12
+	expected.InactivityScoreBias = 4
+	expected.InactivityScoreRecoveryRate = 16
+	expected.EjectionBalance = 16000000000
+	expected.MinPerEpochChurnLimit = 4
+	expected.ChurnLimitQuotient = 65536
+	expected.MaxPerEpochActivationChurnLimit = 8
+	expected.ProposerScoreBoost = 40
+	expected.ReorgParentWeightThreshold = 160
+	expected.ReorgMaxEpochsSinceFinalization = 2
+	expected.DepositChainID = 3151908
+	expected.DepositNetworkID = 3151908
+	expected.DepositContractAddress = "0x4242424242424242424242424242424242424242"
+	expected.GossipMaxSize = 10485760
+	expected.MaxRequestBlocks = 1024
+	expected.EpochsPerSubnetSubscription = 256
+	expected.MinEpochsForBlockRequests = 33024
+	expected.MaxChunkSize = 10485760
+	expected.TtfbTimeout = 5
+	expected.RespTimeout = 10
+	expected.AttestationPropagationSlotRange = 32
+	expected.MaximumGossipClockDisparity = 500
+	expected.MessageDomainInvalidSnappy = [4]byte{0x00, 0x00, 0x00, 0x00}
+	expected.MessageDomainValidSnappy = [4]byte{0x01, 0x00, 0x00, 0x00}
+	expected.SubnetsPerNode = 2
+	expected.AttestationSubnetCount = 64
+	expected.AttestationSubnetExtraBits = 0
+	expected.AttestationSubnetPrefixBits = 6
+	expected.MaxRequestBlocksDeneb = 128
+	expected.MaxRequestBlobSidecars = 768
+	expected.MinEpochsForBlobsSidecarsRequest = 4096
+	expected.BlobsidecarSubnetCount = 6
+	expected.MinPerEpochChurnLimitElectra = 128000000000
+	expected.MaxPerEpochActivationExitChurnLimit = 256000000000
+	expected.NumberOfColumns = 128
+	expected.MaxCellsInExtendedMatrix = 768
+	expected.DataColumnSidecarSubnetCount = 128
+	expected.MaxRequestDataColumnSidecars = 16384
+	expected.InitializeForkSchedule()
+
+	cfg, err := params.UnmarshalChainConfigFiles("testdata/kurtosis/config.yaml", nil)
+	require.NoError(t, err)
+	compareConfigs(t, expected, cfg)
+	require.DeepEqual(t, expected, cfg)
+
+	// Derived values follow the loaded values.
+	assert.Equal(t, primitives.Slot(5), cfg.SqrRootSlotsPerEpoch)
+	assert.Equal(t, 5*time.Second, cfg.TtfbTimeoutDuration())
+	assert.Equal(t, 500*time.Millisecond, cfg.MaximumGossipClockDisparityDuration())
+	assert.Equal(t, primitives.Epoch(100000000), cfg.ForkVersionSchedule[[4]byte{0x60, 0x00, 0x00, 0x38}])
+	assert.Equal(t, version.String(version.Deneb), cfg.ForkVersionNames[[4]byte{0x50, 0x00, 0x00, 0x38}])
+}
+
+func TestUnmarshalChainConfigFiles_Presets(t *testing.T) {
+	expected := params.MinimalSpecConfig().Copy()
+	expected.PresetBase = "minimal"
+	expected.ConfigName = "minimal-devnet"
+	expected.MinGenesisActiveValidatorCount = 128
+	expected.MinGenesisTime = 1728000000
+	expected.GenesisForkVersion = []byte{0x00, 0x00, 0x00, 0x99}
+	expected.GenesisDelay = 60
+	expected.AltairForkVersion = []byte{0x01, 0x00, 0x00, 0x99}
+	expected.AltairForkEpoch = 0
+	expected.BellatrixForkVersion = []byte{0x02, 0x00, 0x00, 0x99}
+	expected.BellatrixForkEpoch = 0
+	expected.CapellaForkVersion = []byte{0x03, 0x00, 0x00, 0x99}
+	expected.CapellaForkEpoch = 1
+	expected.DenebForkVersion = []byte{0x04, 0x00, 0x00, 0x99}
+	expected.DenebForkEpoch = 2
+	expected.ElectraForkVersion = []byte{0x05, 0x00, 0x00, 0x99}
+	expected.ElectraForkEpoch = math.MaxUint64
+	expected.TerminalTotalDifficulty = "0"
+	expected.SecondsPerSlot = 6
+	expected.Eth1FollowDistance = 16
+	expected.DepositChainID = 1337
+	expected.DepositNetworkID = 1337
+	expected.DepositContractAddress = "0x1234567890123456789012345678901234567890"
+	// Values of presets/minimal/phase0.yaml.
+	expected.SlotsPerEpoch = 16
+	expected.SqrRootSlotsPerEpoch = 4
+	expected.MaxCommitteesPerSlot = 2
+	expected.InitializeForkSchedule()
+
+	t.Run("directory", func(t *testing.T) {
+		cfg, err := params.UnmarshalChainConfigFiles("testdata/minimal_devnet", nil)
+		require.NoError(t, err)
+		compareConfigs(t, expected, cfg)
+		require.DeepEqual(t, expected, cfg)
+	})
+	t.Run("config and preset files", func(t *testing.T) {
+		cfg, err := params.UnmarshalChainConfigFiles(
+			"testdata/minimal_devnet/config.yaml", nil, "testdata/minimal_devnet/presets/minimal/phase0.yaml",
+		)
+		require.NoError(t, err)
+		compareConfigs(t, expected, cfg)
+		require.DeepEqual(t, expected, cfg)
+	})
+	t.Run("config file without preset files", func(t *testing.T) {
+		cfg, err := params.UnmarshalChainConfigFiles("testdata/minimal_devnet/config.yaml", nil)
+		require.NoError(t, err)
+		assert.Equal(t, params.MinimalSpecConfig().SlotsPerEpoch, cfg.SlotsPerEpoch)
+		assert.Equal(t, params.MinimalSpecConfig().MaxCommitteesPerSlot, cfg.MaxCommitteesPerSlot)
+		assert.Equal(t, uint64(6), cfg.SecondsPerSlot)
+	})
+}
+
+func TestUnmarshalChainConfigFiles_Errors(t *testing.T) {
+	config, err := os.ReadFile("testdata/minimal_devnet/config.yaml")
+	require.NoError(t, err)
+	writeConfig := func(t *testing.T, contents string) string {
+		fp := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(fp, []byte(contents), 0600))
+		return fp
+	}
+
+	t.Run("unknown key", func(t *testing.T) {
+		fp := writeConfig(t, string(config)+"\nSLOTS_PER_EPOCHS: 8\n")
+		_, err := params.UnmarshalChainConfigFiles(fp, nil)
+		require.ErrorContains(t, "unknown chain config keys: SLOTS_PER_EPOCHS", err)
+	})
+	t.Run("unknown key in preset file", func(t *testing.T) {
+		preset := writeConfig(t, "SLOTS_PER_EPOCHS: 8\n")
+		_, err := params.UnmarshalChainConfigFiles("testdata/minimal_devnet/config.yaml", nil, preset)
+		require.ErrorContains(t, "unknown chain config keys: SLOTS_PER_EPOCHS", err)
+	})
+	t.Run("optional key", func(t *testing.T) {
+		fp := writeConfig(t, string(config)+"\nMAX_BLOBS_PER_BLOCK: 6\n")
+		_, err := params.UnmarshalChainConfigFiles(fp, nil)
+		require.NoError(t, err)
+	})
+	t.Run("duplicate key", func(t *testing.T) {
+		fp := writeConfig(t, string(config)+"\nSECONDS_PER_SLOT: 12\n")
+		_, err := params.UnmarshalChainConfigFiles(fp, nil)
+		require.ErrorContains(t, "already set", err)
+	})
+	t.Run("missing required keys", func(t *testing.T) {
+		fp := writeConfig(t, strings.Replace(strings.Replace(string(config), "DENEB_FORK_EPOCH: 2", "", 1), "DEPOSIT_CHAIN_ID: 1337", "", 1))
+		_, err := params.UnmarshalChainConfigFiles(fp, nil)
+		require.ErrorContains(t, "missing required keys: DENEB_FORK_EPOCH, DEPOSIT_CHAIN_ID", err)
+	})
+	t.Run("unknown preset base", func(t *testing.T) {
+		fp := writeConfig(t, strings.Replace(string(config), "PRESET_BASE: 'minimal'", "PRESET_BASE: 'gnosis'", 1))
+		_, err := params.UnmarshalChainConfigFiles(fp, nil)
+		require.ErrorContains(t, "unknown preset base \"gnosis\"", err)
+
+		// The preset files provide the values of an unknown preset base.
+		cfg, err := params.UnmarshalChainConfigFiles(fp, nil, "testdata/minimal_devnet/presets/minimal/phase0.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "gnosis", cfg.PresetBase)
+		assert.Equal(t, primitives.Slot(16), cfg.SlotsPerEpoch)
+	})
+}
+
 func Test_replaceHexStringWithYAMLFormat(t *testing.T) {
 
 	testLines := []struct {
@@ -400,8 +535,8 @@ func assertYamlFieldsMatch(t *testing.T, name string, fields []string, c1, c2 *p
 			v, ok := ft1.Field(i).Tag.Lookup("yaml")
 			if ok && v == field {
 				if isPlaceholderField(v) {
-					// If you see this error, remove the field from placeholderFields.
-					t.Errorf("beacon config has a placeholder field defined, remove %s from the placeholder fields variable", v)
+					// If you see this error, remove the field from optionalConfigKeys.
+					t.Errorf("beacon config has an optional config key defined, remove %s from optionalConfigKeys", v)
 					continue
 				}
 				found = true
@@ -415,12 +550,14 @@ func assertYamlFieldsMatch(t *testing.T, name string, fields []string, c1, c2 *p
 				break
 			}
 		}
-		if !found && !isPlaceholderField(field) { // Ignore placeholder fields
+		if !found && !isPlaceholderField(field) { // Ignore optional config keys
 			t.Errorf("No struct tag found `yaml:%s`", field)
 		}
 	}
 }
 
+// isPlaceholderField returns whether the field is one of the keys the config loader accepts without a matching
+// BeaconChainConfig field, which are not tested.
 func isPlaceholderField(field string) bool {
-	return slices.Contains(placeholderFields, field)
+	return params.OptionalConfigKeys[field]
 }
@@ -16,6 +16,11 @@ func MainnetConfig() *BeaconChainConfig {
 	return mainnetBeaconConfig
 }
 
+// MainnetNetworkConfig returns a copy of the network configuration of the main network.
+func MainnetNetworkConfig() *NetworkConfig {
+	return mainnetNetworkConfig.Copy()
+}
+
 const (
 	// Genesis Fork Epoch for the mainnet config.
 	genesisForkEpoch = 0
@@ -35,6 +40,7 @@ var mainnetNetworkConfig = &NetworkConfig{
 	ETH2Key:                    "eth2",
 	AttSubnetKey:               "attnets",
 	SyncCommsSubnetKey:         "syncnets",
+	CustodySubnetCountKey:      "csc",
 	MinimumPeersInSubnetSearch: 20,
 	ContractDeploymentBlock:    11184524, // Note: contract was deployed in block 11052984 but no transactions were sent until 11184524.
 	BootstrapNodes: []string{
@@ -297,6 +303,7 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	// PeerDAS
 	NumberOfColumns:          128,
 	MaxCellsInExtendedMatrix: 768,
+	CustodyRequirement:       4,
 
 	// Values related to networking parameters.
 	GossipMaxSize:                   10 * 1 << 20, // 10 MiB
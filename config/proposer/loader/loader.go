@@ -6,9 +6,11 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/config"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
@@ -200,6 +202,13 @@ func (psl *settingsLoader) Load(cliCtx *cli.Context) (*proposer.Settings, error)
 		}
 	}
 
+	// fee recipients set through the keymanager API take precedence over any other source
+	overrides, err := psl.db.FeeRecipientOverrides(cliCtx.Context)
+	if err != nil {
+		return nil, err
+	}
+	loadConfig = applyFeeRecipientOverrides(loadConfig, overrides)
+
 	// exit early if nothing is provided
 	if loadConfig == nil || (loadConfig.ProposerConfig == nil && loadConfig.DefaultConfig == nil) {
 		log.Warn("No proposer settings were provided")
@@ -277,6 +286,42 @@ func (psl *settingsLoader) processProposerSettings(loadedSettings, dbSettings *v
 	return newSettings
 }
 
+// applyFeeRecipientOverrides sets the fee recipients persisted through the keymanager API on the proposer config,
+// creating entries for validators that are not configured yet.
+func applyFeeRecipientOverrides(
+	settings *validatorpb.ProposerSettingsPayload,
+	overrides map[[fieldparams.BLSPubkeyLength]byte][fieldparams.FeeRecipientLength]byte,
+) *validatorpb.ProposerSettingsPayload {
+	if len(overrides) == 0 {
+		return settings
+	}
+	if settings == nil {
+		settings = &validatorpb.ProposerSettingsPayload{}
+	}
+	if settings.ProposerConfig == nil {
+		settings.ProposerConfig = make(map[string]*validatorpb.ProposerOptionPayload)
+	}
+	for pubkey, feeRecipient := range overrides {
+		feeRecipientHex := common.BytesToAddress(feeRecipient[:]).Hex()
+		key := hexutil.Encode(pubkey[:])
+		if option, ok := settings.ProposerConfig[key]; ok && option != nil {
+			option.FeeRecipient = feeRecipientHex
+			continue
+		}
+		option := &validatorpb.ProposerOptionPayload{FeeRecipient: feeRecipientHex}
+		if settings.DefaultConfig != nil && settings.DefaultConfig.Builder != nil {
+			builder := settings.DefaultConfig.Builder
+			option.Builder = &validatorpb.BuilderConfig{
+				Enabled:  builder.Enabled,
+				GasLimit: builder.GasLimit,
+				Relays:   append([]string(nil), builder.Relays...),
+			}
+		}
+		settings.ProposerConfig[key] = option
+	}
+	return settings
+}
+
 func processBuilderConfig(current *validatorpb.BuilderConfig, override *validatorpb.BuilderConfig, gasLimitOnly *validator.Uint64) *validatorpb.BuilderConfig {
 	if current != nil {
 		current.GasLimit = reviewGasLimit(current.GasLimit)
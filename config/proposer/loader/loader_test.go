@@ -1003,3 +1003,50 @@ func Test_ProposerSettingsLoaderWithOnlyBuilder_DoesNotSaveInDB(t *testing.T) {
 		})
 	}
 }
+
+func Test_ProposerSettingsLoader_FeeRecipientOverridesTakePrecedence(t *testing.T) {
+	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
+		t.Run(fmt.Sprintf("minimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
+			app := cli.App{}
+			set := flag.NewFlagSet("test", 0)
+			set.String(flags.ProposerSettingsFlag.Name, "./testdata/good-prepare-beacon-proposer-config.json", "")
+			require.NoError(t, set.Set(flags.ProposerSettingsFlag.Name, "./testdata/good-prepare-beacon-proposer-config.json"))
+			cliCtx := cli.NewContext(&app, set, nil)
+			validatorDB := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, isSlashingProtectionMinimal)
+
+			configuredKey, err := hexutil.Decode("0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a")
+			require.NoError(t, err)
+			newKey, err := hexutil.Decode("0xb057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7b")
+			require.NoError(t, err)
+			overrideFeeRecipient := common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")
+			require.NoError(t, validatorDB.SaveFeeRecipientOverride(cliCtx.Context, bytesutil.ToBytes48(configuredKey), overrideFeeRecipient))
+			require.NoError(t, validatorDB.SaveFeeRecipientOverride(cliCtx.Context, bytesutil.ToBytes48(newKey), overrideFeeRecipient))
+
+			loader, err := NewProposerSettingsLoader(cliCtx, validatorDB)
+			require.NoError(t, err)
+			got, err := loader.Load(cliCtx)
+			require.NoError(t, err)
+
+			want := &proposer.Settings{
+				ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+					bytesutil.ToBytes48(configuredKey): {
+						FeeRecipientConfig: &proposer.FeeRecipientConfig{
+							FeeRecipient: overrideFeeRecipient,
+						},
+					},
+					bytesutil.ToBytes48(newKey): {
+						FeeRecipientConfig: &proposer.FeeRecipientConfig{
+							FeeRecipient: overrideFeeRecipient,
+						},
+					},
+				},
+				DefaultConfig: &proposer.Option{
+					FeeRecipientConfig: &proposer.FeeRecipientConfig{
+						FeeRecipient: common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A"),
+					},
+				},
+			}
+			require.DeepEqual(t, want, got)
+		})
+	}
+}
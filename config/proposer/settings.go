@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"google.golang.org/protobuf/proto"
 )
 
 // SettingFromConsensus converts struct to Settings while verifying the fields
@@ -78,10 +79,14 @@ func verifyOption(key string, option *validatorpb.ProposerOptionPayload) error {
 
 // BuilderConfig is the struct representation of the JSON config file set in the validator through the CLI.
 // GasLimit is a number set to help the network decide on the maximum gas in each block.
+// MinBid, in Gwei, and MinBidToLocalRatio are the values the builder bid must attain for the beacon node to use the
+// builder payload rather than the payload of its execution client. They are ignored when 0.
 type BuilderConfig struct {
-	Enabled  bool             `json:"enabled" yaml:"enabled"`
-	GasLimit validator.Uint64 `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"`
-	Relays   []string         `json:"relays,omitempty" yaml:"relays,omitempty"`
+	Enabled            bool             `json:"enabled" yaml:"enabled"`
+	GasLimit           validator.Uint64 `json:"gas_limit,omitempty" yaml:"gas_limit,omitempty"`
+	Relays             []string         `json:"relays,omitempty" yaml:"relays,omitempty"`
+	MinBid             validator.Uint64 `json:"min_bid,omitempty" yaml:"min_bid,omitempty"`
+	MinBidToLocalRatio float64          `json:"min_bid_to_local_ratio,omitempty" yaml:"min_bid_to_local_ratio,omitempty"`
 }
 
 // BuilderConfigFromConsensus converts protobuf to a builder config used in in-memory storage
@@ -90,8 +95,10 @@ func BuilderConfigFromConsensus(from *validatorpb.BuilderConfig) *BuilderConfig
 		return nil
 	}
 	c := &BuilderConfig{
-		Enabled:  from.Enabled,
-		GasLimit: from.GasLimit,
+		Enabled:            from.Enabled,
+		GasLimit:           from.GasLimit,
+		MinBid:             from.MinBid,
+		MinBidToLocalRatio: from.MinBidToLocalRatio,
 	}
 	if from.Relays != nil {
 		relays := make([]string, len(from.Relays))
@@ -135,6 +142,32 @@ func (ps *Settings) ToConsensus() *validatorpb.ProposerSettingsPayload {
 	return payload
 }
 
+// ChangedKeys compares the proposer settings to the previous ones. It returns the keys whose own settings were
+// added, removed or changed, and whether the default settings changed, in which case the settings of every key
+// without its own settings changed as well.
+func (ps *Settings) ChangedKeys(previous *Settings) (keys [][fieldparams.BLSPubkeyLength]byte, defaultChanged bool) {
+	var current, prev map[[fieldparams.BLSPubkeyLength]byte]*Option
+	var currentDefault, prevDefault *Option
+	if ps != nil {
+		current, currentDefault = ps.ProposeConfig, ps.DefaultConfig
+	}
+	if previous != nil {
+		prev, prevDefault = previous.ProposeConfig, previous.DefaultConfig
+	}
+	for k, o := range current {
+		p, ok := prev[k]
+		if !ok || !o.Equal(p) {
+			keys = append(keys, k)
+		}
+	}
+	for k := range prev {
+		if _, ok := current[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys, !currentDefault.Equal(prevDefault)
+}
+
 // FeeRecipientConfig is a prysm internal representation to see if the fee recipient was set.
 type FeeRecipientConfig struct {
 	FeeRecipient common.Address
@@ -170,6 +203,14 @@ func (po *Option) Clone() *Option {
 	return p
 }
 
+// Equal returns true when both proposer options have the same fee recipient, builder and graffiti settings.
+func (po *Option) Equal(other *Option) bool {
+	if po == nil || other == nil {
+		return po == other
+	}
+	return proto.Equal(po.ToConsensus(), other.ToConsensus())
+}
+
 func (po *Option) ToConsensus() *validatorpb.ProposerOptionPayload {
 	if po == nil {
 		return nil
@@ -224,6 +265,8 @@ func (bc *BuilderConfig) Clone() *BuilderConfig {
 	c := &BuilderConfig{}
 	c.Enabled = bc.Enabled
 	c.GasLimit = bc.GasLimit
+	c.MinBid = bc.MinBid
+	c.MinBidToLocalRatio = bc.MinBidToLocalRatio
 	var relays []string
 	if bc.Relays != nil {
 		relays = make([]string, len(bc.Relays))
@@ -255,5 +298,7 @@ func (bc *BuilderConfig) ToConsensus() *validatorpb.BuilderConfig {
 		c.Relays = relays
 	}
 	c.GasLimit = bc.GasLimit
+	c.MinBid = bc.MinBid
+	c.MinBidToLocalRatio = bc.MinBidToLocalRatio
 	return c
 }
@@ -23,9 +23,11 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 					FeeRecipient: common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"),
 				},
 				BuilderConfig: &BuilderConfig{
-					Enabled:  true,
-					GasLimit: validator.Uint64(40000000),
-					Relays:   []string{"https://example-relay.com"},
+					Enabled:            true,
+					GasLimit:           validator.Uint64(40000000),
+					Relays:             []string{"https://example-relay.com"},
+					MinBid:             validator.Uint64(50000000),
+					MinBidToLocalRatio: 1.1,
 				},
 			},
 		},
@@ -84,6 +86,7 @@ func Test_Proposer_Setting_Cloning(t *testing.T) {
 		require.Equal(t, option.FeeRecipientConfig.FeeRecipient.Hex(), noption.FeeRecipientConfig.FeeRecipient.Hex())
 		require.Equal(t, option.BuilderConfig.GasLimit, option.BuilderConfig.GasLimit)
 		require.Equal(t, option.BuilderConfig.Enabled, option.BuilderConfig.Enabled)
+		require.DeepEqual(t, option.BuilderConfig, noption.BuilderConfig)
 	})
 }
 
@@ -200,3 +203,49 @@ func TestProposerSettings_ShouldBeSaved(t *testing.T) {
 		})
 	}
 }
+
+func TestProposerSettings_ChangedKeys(t *testing.T) {
+	key1 := bytesutil.ToBytes48([]byte{1})
+	key2 := bytesutil.ToBytes48([]byte{2})
+	key3 := bytesutil.ToBytes48([]byte{3})
+	option := func(feeRecipient string, gasLimit uint64) *Option {
+		return &Option{
+			FeeRecipientConfig: &FeeRecipientConfig{FeeRecipient: common.HexToAddress(feeRecipient)},
+			BuilderConfig:      &BuilderConfig{Enabled: true, GasLimit: validator.Uint64(gasLimit)},
+		}
+	}
+	previous := &Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*Option{
+			key1: option("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3", 30000000),
+			key2: option("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3", 30000000),
+		},
+		DefaultConfig: option("0x6e35733c5af9B61374A128e6F85f553aF09ff89A", 30000000),
+	}
+
+	keys, defaultChanged := previous.Clone().ChangedKeys(previous)
+	require.Equal(t, 0, len(keys))
+	require.Equal(t, false, defaultChanged)
+
+	current := previous.Clone()
+	current.ProposeConfig[key1].BuilderConfig.GasLimit = 36000000
+	delete(current.ProposeConfig, key2)
+	current.ProposeConfig[key3] = option("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3", 30000000)
+	keys, defaultChanged = current.ChangedKeys(previous)
+	require.Equal(t, 3, len(keys))
+	changed := make(map[[fieldparams.BLSPubkeyLength]byte]bool)
+	for _, k := range keys {
+		changed[k] = true
+	}
+	require.Equal(t, true, changed[key1] && changed[key2] && changed[key3])
+	require.Equal(t, false, defaultChanged)
+
+	current = previous.Clone()
+	current.DefaultConfig.FeeRecipientConfig.FeeRecipient = common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	keys, defaultChanged = current.ChangedKeys(previous)
+	require.Equal(t, 0, len(keys))
+	require.Equal(t, true, defaultChanged)
+
+	keys, defaultChanged = (*Settings)(nil).ChangedKeys(previous)
+	require.Equal(t, 2, len(keys))
+	require.Equal(t, true, defaultChanged)
+}
@@ -1,6 +1,7 @@
 package forkchoice
 
 import (
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
@@ -34,7 +35,27 @@ type Dump struct {
 	ProposerBoostRoot             []byte
 	PreviousProposerBoostRoot     []byte
 	HeadRoot                      []byte
+	HeadSlot                      primitives.Slot
 	ForkChoiceNodes               []*Node
+	// NodeCount is the number of nodes in forkchoice, MatchingNodeCount and MatchingLeafCount the number of nodes and
+	// leaves selected by the filter of the dump.
+	NodeCount         int
+	MatchingNodeCount int
+	MatchingLeafCount int
+}
+
+// ErrUnknownDescendantRoot is returned when the root the nodes of a dump must descend from is not in forkchoice.
+var ErrUnknownDescendantRoot = errors.New("unknown descendant root")
+
+// DumpFilter selects the nodes of a forkchoice dump. A nil filter selects all the nodes.
+type DumpFilter struct {
+	// StartSlot and EndSlot bound the slots of the nodes. EndSlot is ignored when it is 0.
+	StartSlot primitives.Slot
+	EndSlot   primitives.Slot
+	// DescendantOf restricts the nodes to the block with this root and its descendants, unless it is the zero hash.
+	DescendantOf [32]byte
+	// SummaryOnly counts the selected nodes without adding them to the dump.
+	SummaryOnly bool
 }
 
 type Node struct {
@@ -52,3 +73,25 @@ type Node struct {
 	ParentRoot               []byte
 	ExecutionBlockHash       []byte
 }
+
+// Snapshot is a consistent view of all the nodes of the forkchoice store, taken at once.
+type Snapshot struct {
+	HeadRoot            [32]byte
+	JustifiedCheckpoint *eth.Checkpoint
+	FinalizedCheckpoint *eth.Checkpoint
+	Nodes               []*NodeSnapshot
+}
+
+// NodeSnapshot describes a node of the forkchoice store in a snapshot. BestChild and BestDescendant are the zero
+// hash when the node has no viable descendant.
+type NodeSnapshot struct {
+	Root           [32]byte
+	ParentRoot     [32]byte
+	Slot           primitives.Slot
+	Weight         uint64
+	Validity       NodeValidity
+	JustifiedEpoch primitives.Epoch
+	FinalizedEpoch primitives.Epoch
+	BestChild      [32]byte
+	BestDescendant [32]byte
+}
@@ -27,9 +27,15 @@ import (
 //
 // See: https://github.com/ethereum/consensus-specs/blob/master/specs/validator/0_beacon-chain-validator.md#submit-deposit
 func DepositInput(depositKey, withdrawalKey bls.SecretKey, amountInGwei uint64) (*ethpb.Deposit_Data, [32]byte, error) {
+	return DepositInputWithCredentials(depositKey, WithdrawalCredentialsHash(withdrawalKey), amountInGwei)
+}
+
+// DepositInputWithCredentials is DepositInput for the given withdrawal credentials, such as those of an
+// execution address.
+func DepositInputWithCredentials(depositKey bls.SecretKey, withdrawalCredentials []byte, amountInGwei uint64) (*ethpb.Deposit_Data, [32]byte, error) {
 	depositMessage := &ethpb.DepositMessage{
 		PublicKey:             depositKey.PublicKey().Marshal(),
-		WithdrawalCredentials: WithdrawalCredentialsHash(withdrawalKey),
+		WithdrawalCredentials: withdrawalCredentials,
 		Amount:                amountInGwei,
 	}
 
@@ -79,6 +85,19 @@ func WithdrawalCredentialsHash(withdrawalKey bls.SecretKey) []byte {
 	return append([]byte{params.BeaconConfig().BLSWithdrawalPrefixByte}, h[1:]...)[:32]
 }
 
+// ExecutionAddressWithdrawalCredentials forms the withdrawal credentials of an execution address.
+//
+// The specification is as follows:
+//
+//	withdrawal_credentials[:1] == ETH1_ADDRESS_WITHDRAWAL_PREFIX
+//	withdrawal_credentials[1:12] == b'\x00' * 11
+//	withdrawal_credentials[12:] == execution_address
+func ExecutionAddressWithdrawalCredentials(address []byte) []byte {
+	credentials := make([]byte, 12, 32)
+	credentials[0] = params.BeaconConfig().ETH1AddressWithdrawalPrefixByte
+	return append(credentials, address...)
+}
+
 // VerifyDepositSignature verifies the correctness of Eth1 deposit BLS signature
 func VerifyDepositSignature(dd *ethpb.Deposit_Data, domain []byte) error {
 	ddCopy := dd.Copy()
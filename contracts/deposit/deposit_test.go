@@ -1,6 +1,7 @@
 package deposit_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
@@ -43,6 +44,25 @@ func TestDepositInput_GeneratesPb(t *testing.T) {
 	assert.Equal(t, true, sig.Verify(k1.PublicKey(), root[:]))
 }
 
+func TestDepositInputWithCredentials_ExecutionAddress(t *testing.T) {
+	k, err := bls.RandKey()
+	require.NoError(t, err)
+	address := bytes.Repeat([]byte{0xaa}, 20)
+	credentials := deposit.ExecutionAddressWithdrawalCredentials(address)
+	require.Equal(t, 32, len(credentials))
+	assert.DeepEqual(t, append(append([]byte{params.BeaconConfig().ETH1AddressWithdrawalPrefixByte}, make([]byte, 11)...), address...), credentials)
+
+	result, root, err := deposit.DepositInputWithCredentials(k, credentials, params.BeaconConfig().MaxEffectiveBalance)
+	require.NoError(t, err)
+	assert.DeepEqual(t, credentials, result.WithdrawalCredentials)
+	wantRoot, err := result.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, root)
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, deposit.VerifyDepositSignature(result, domain))
+}
+
 func TestVerifyDepositSignature_ValidSig(t *testing.T) {
 	deposits, _, err := util.DeterministicDepositsAndKeys(1)
 	require.NoError(t, err)
@@ -0,0 +1,149 @@
+package ssz
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/minio/sha256-simd"
+	"github.com/pkg/errors"
+)
+
+// ConcatGeneralizedIndices returns the generalized index of a node in a subtree given the generalized index
+// of the subtree root, followed by the generalized indices of the node in each nested subtree.
+//
+// Spec pseudocode definition:
+//
+//	def concat_generalized_indices(*indices: GeneralizedIndex) -> GeneralizedIndex:
+//	    o = GeneralizedIndex(1)
+//	    for i in indices:
+//	        o = GeneralizedIndex(o * get_power_of_two_floor(i) + (i - get_power_of_two_floor(i)))
+//	    return o
+func ConcatGeneralizedIndices(indices ...uint64) uint64 {
+	o := uint64(1)
+	for _, i := range indices {
+		floor := uint64(1) << (bits.Len64(i) - 1)
+		o = o*floor + (i - floor)
+	}
+	return o
+}
+
+// MultiproofHelperIndices returns the generalized indices of the nodes, in decreasing order, that need to
+// be provided in a multiproof of the nodes at the given generalized indices.
+//
+// Spec pseudocode definition:
+//
+//	def get_helper_indices(indices: Sequence[GeneralizedIndex]) -> Sequence[GeneralizedIndex]:
+//	    all_helper_indices: Set[GeneralizedIndex] = set()
+//	    all_path_indices: Set[GeneralizedIndex] = set()
+//	    for index in indices:
+//	        all_helper_indices = all_helper_indices.union(set(get_branch_indices(index)))
+//	        all_path_indices = all_path_indices.union(set(get_path_indices(index)))
+//	    return sorted(all_helper_indices.difference(all_path_indices), reverse=True)
+func MultiproofHelperIndices(indices []uint64) []uint64 {
+	helpers := make(map[uint64]bool)
+	paths := make(map[uint64]bool)
+	for _, index := range indices {
+		for i := index; i > 1; i /= 2 {
+			helpers[i^1] = true
+			paths[i] = true
+		}
+	}
+	res := make([]uint64, 0, len(helpers))
+	for i := range helpers {
+		if !paths[i] {
+			res = append(res, i)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] > res[j]
+	})
+	return res
+}
+
+// MultiMerkleRoot computes the root of a Merkle tree from the leaves at the given generalized indices and
+// the proof nodes at the helper indices returned by MultiproofHelperIndices.
+//
+// Spec pseudocode definition:
+//
+//	def calculate_multi_merkle_root(leaves: Sequence[Bytes32],
+//	                                proof: Sequence[Bytes32],
+//	                                indices: Sequence[GeneralizedIndex]) -> Root:
+//	    assert len(leaves) == len(indices)
+//	    helper_indices = get_helper_indices(indices)
+//	    assert len(proof) == len(helper_indices)
+//	    objects = {
+//	        **{index: node for index, node in zip(indices, leaves)},
+//	        **{index: node for index, node in zip(helper_indices, proof)}
+//	    }
+//	    keys = sorted(objects.keys(), reverse=True)
+//	    pos = 0
+//	    while pos < len(keys):
+//	        k = keys[pos]
+//	        if k in objects and k ^ 1 in objects and k // 2 not in objects:
+//	            objects[GeneralizedIndex(k // 2)] = hash(
+//	                objects[GeneralizedIndex((k | 1) ^ 1)] +
+//	                objects[GeneralizedIndex(k | 1)]
+//	            )
+//	            keys.append(GeneralizedIndex(k // 2))
+//	        pos += 1
+//	    return objects[GeneralizedIndex(1)]
+func MultiMerkleRoot(leaves [][32]byte, proof [][32]byte, indices []uint64) ([32]byte, error) {
+	if len(leaves) != len(indices) {
+		return [32]byte{}, errors.Errorf("got %d leaves for %d indices", len(leaves), len(indices))
+	}
+	helperIndices := MultiproofHelperIndices(indices)
+	if len(proof) != len(helperIndices) {
+		return [32]byte{}, errors.Errorf("got %d proof nodes, expected %d", len(proof), len(helperIndices))
+	}
+	objects := make(map[uint64][32]byte, len(indices)+len(helperIndices))
+	for i, index := range indices {
+		if index == 0 {
+			return [32]byte{}, errors.New("generalized indices must be positive")
+		}
+		objects[index] = leaves[i]
+	}
+	for i, index := range helperIndices {
+		objects[index] = proof[i]
+	}
+	keys := make([]uint64, 0, len(objects))
+	for k := range objects {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] > keys[j]
+	})
+	for pos := 0; pos < len(keys); pos++ {
+		k := keys[pos]
+		_, hasNode := objects[k]
+		_, hasSibling := objects[k^1]
+		_, hasParent := objects[k/2]
+		if hasNode && hasSibling && !hasParent {
+			left, right := objects[(k|1)^1], objects[k|1]
+			objects[k/2] = sha256.Sum256(append(left[:], right[:]...))
+			keys = append(keys, k/2)
+		}
+	}
+	root, ok := objects[1]
+	if !ok {
+		return [32]byte{}, errors.New("proof does not reach the root")
+	}
+	return root, nil
+}
+
+// VerifyMultiproof checks that the leaves at the given generalized indices belong to the Merkle tree
+// with the given root.
+//
+// Spec pseudocode definition:
+//
+//	def verify_merkle_multiproof(leaves: Sequence[Bytes32],
+//	                             proof: Sequence[Bytes32],
+//	                             indices: Sequence[GeneralizedIndex],
+//	                             root: Root) -> bool:
+//	    return calculate_multi_merkle_root(leaves, proof, indices) == root
+func VerifyMultiproof(root [32]byte, leaves [][32]byte, proof [][32]byte, indices []uint64) bool {
+	computed, err := MultiMerkleRoot(leaves, proof, indices)
+	if err != nil {
+		return false
+	}
+	return computed == root
+}
@@ -0,0 +1,86 @@
+package ssz_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestConcatGeneralizedIndices(t *testing.T) {
+	assert.Equal(t, uint64(1), ssz.ConcatGeneralizedIndices())
+	assert.Equal(t, uint64(11), ssz.ConcatGeneralizedIndices(11))
+	// Field 1 of a container with 8 fields, itself at generalized index 5.
+	assert.Equal(t, uint64(41), ssz.ConcatGeneralizedIndices(5, 9))
+	assert.Equal(t, uint64(41), ssz.ConcatGeneralizedIndices(1, 5, 9, 1))
+}
+
+func TestMultiproof(t *testing.T) {
+	// The leaves of a tree of depth 3 are the hashes of the bytes 0 to 7.
+	leaves := make([][32]byte, 8)
+	for i := range leaves {
+		leaves[i] = sha256.Sum256([]byte{byte(i)})
+	}
+	root := bytesutil.ToBytes32(hexutil.MustDecode("0x0727b310f87099c1ba2ec0ba408def82c308237c8577f0bdfd2643e9cc6b7578"))
+
+	tests := []struct {
+		indices []uint64
+		helpers []uint64
+		proof   []string
+	}{
+		{
+			indices: []uint64{9, 10},
+			helpers: []uint64{11, 8, 3},
+			proof: []string{
+				"0x084fed08b978af4d7d196a7446a86b58009e636b611db16211b65a9aadff29c5",
+				"0x6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d",
+				"0x042ad79b36e16fe6feca0b57ca795547c49a510754ec5ff2117ce3ca1713505d",
+			},
+		},
+		{
+			indices: []uint64{8},
+			helpers: []uint64{9, 5, 3},
+			proof: []string{
+				"0x4bf5122f344554c53bde2ebb8cd2b7e3d1600ad631c385a5d7cce23c7785459a",
+				"0xc2768b34413548c2a4cca10af5c71d399d9e70975a8fd428c1dc27cc0282f273",
+				"0x042ad79b36e16fe6feca0b57ca795547c49a510754ec5ff2117ce3ca1713505d",
+			},
+		},
+		{
+			indices: []uint64{9, 14},
+			helpers: []uint64{15, 8, 6, 5},
+			proof: []string{
+				"0xca358758f6d27e6cf45272937977a748fd88391db679ceda7dc7bf1f005ee879",
+				"0x6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d",
+				"0xf03808f5b8088c61286d505e8e93aa378991d9889ae2d874433ca06acabcd493",
+				"0xc2768b34413548c2a4cca10af5c71d399d9e70975a8fd428c1dc27cc0282f273",
+			},
+		},
+	}
+	for _, tt := range tests {
+		require.DeepEqual(t, tt.helpers, ssz.MultiproofHelperIndices(tt.indices))
+		proven := make([][32]byte, len(tt.indices))
+		for i, index := range tt.indices {
+			proven[i] = leaves[index-8]
+		}
+		proof := make([][32]byte, len(tt.proof))
+		for i, p := range tt.proof {
+			proof[i] = bytesutil.ToBytes32(hexutil.MustDecode(p))
+		}
+		computed, err := ssz.MultiMerkleRoot(proven, proof, tt.indices)
+		require.NoError(t, err)
+		require.Equal(t, root, computed, "indices %v", tt.indices)
+		require.Equal(t, true, ssz.VerifyMultiproof(root, proven, proof, tt.indices))
+
+		// A modified leaf does not verify.
+		proven[0][0] ^= 1
+		require.Equal(t, false, ssz.VerifyMultiproof(root, proven, proof, tt.indices))
+		// Neither does a proof with a missing node.
+		_, err = ssz.MultiMerkleRoot(proven, proof[1:], tt.indices)
+		require.ErrorContains(t, "proof nodes", err)
+	}
+}
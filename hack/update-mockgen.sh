@@ -10,7 +10,8 @@ iface_mock_path="testing/validator-mock"
 # github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1
 # ------------------------------------------------------
 proto_mocks_v1alpha1=(
-      "$mock_path/beacon_service_mock.go BeaconChainClient"
+      "$mock_path/beacon_service_mock.go BeaconChainClient,BeaconChain_StreamValidatorsClient,BeaconChain_StreamValidatorBalancesClient,BeaconChain_StreamAttestationsClient"
+      "$mock_path/beacon_chain_server_mock.go BeaconChain_StreamValidatorsServer,BeaconChain_StreamValidatorBalancesServer,BeaconChain_StreamAttestationsServer"
       "$mock_path/beacon_validator_server_mock.go BeaconNodeValidatorServer,BeaconNodeValidator_WaitForActivationServer,BeaconNodeValidator_WaitForChainStartServer,BeaconNodeValidator_StreamSlotsServer"
       "$mock_path/beacon_validator_client_mock.go BeaconNodeValidatorClient,BeaconNodeValidator_WaitForChainStartClient,BeaconNodeValidator_WaitForActivationClient,BeaconNodeValidator_StreamSlotsClient"
       "$mock_path/node_service_mock.go NodeClient"
@@ -0,0 +1,11 @@
+package file
+
+import "github.com/pkg/errors"
+
+// ErrDiskSpaceUnsupported is returned when the available disk space cannot be computed on the platform.
+var ErrDiskSpaceUnsupported = errors.New("available disk space is not supported on this platform")
+
+// AvailableDiskSpace returns the number of bytes available to unprivileged users on the filesystem of the path.
+func AvailableDiskSpace(dirPath string) (uint64, error) {
+	return availableDiskSpace(dirPath)
+}
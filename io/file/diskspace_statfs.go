@@ -0,0 +1,17 @@
+//go:build linux || darwin
+
+package file
+
+import (
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+func availableDiskSpace(dirPath string) (uint64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(dirPath, &st); err != nil {
+		return 0, errors.Wrapf(err, "could not get filesystem statistics of %s", dirPath)
+	}
+	return uint64(st.Bavail) * uint64(st.Bsize), nil // lint:ignore uintcast -- The block size is never negative.
+}
@@ -0,0 +1,7 @@
+//go:build !linux && !darwin
+
+package file
+
+func availableDiskSpace(_ string) (uint64, error) {
+	return 0, ErrDiskSpaceUnsupported
+}
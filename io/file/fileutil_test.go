@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -602,3 +603,15 @@ func TestWriteLinesToFile(t *testing.T) {
 		}
 	})
 }
+
+func TestAvailableDiskSpace(t *testing.T) {
+	available, err := file.AvailableDiskSpace(t.TempDir())
+	if errors.Is(err, file.ErrDiskSpaceUnsupported) {
+		t.Skip(err)
+	}
+	require.NoError(t, err)
+	assert.Equal(t, true, available > 0)
+
+	_, err = file.AvailableDiskSpace(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorContains(t, "could not get filesystem statistics", err)
+}
@@ -0,0 +1,79 @@
+// Package keyring stores the wallet password in the keyring of the operating system: the secret service on Linux,
+// the Keychain on macOS and the credential manager on Windows. Keyring support is left out of binaries built with the
+// keyring_disabled tag, for platforms where the keyring library is not supported.
+package keyring
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+// service is the name the wallet passwords are stored under in the keyring.
+const service = "prysm-validator-wallet"
+
+var (
+	// ErrNotFound is returned when no password is stored in the keyring for the wallet.
+	ErrNotFound = errors.New("no wallet password is stored in the OS keyring for this wallet")
+	// ErrUnsupported is returned when the binary was built without keyring support, or the platform has no keyring.
+	ErrUnsupported = errors.New("the OS keyring is not supported by this build of Prysm on this platform")
+	// ErrUnavailable is returned when the keyring could not be used, such as when it is locked or no keyring daemon
+	// is running.
+	ErrUnavailable = errors.New("the OS keyring is unavailable")
+)
+
+// backend is the keyring of the operating system.
+type backend interface {
+	Set(service, user, password string) error
+	Get(service, user string) (string, error)
+	Delete(service, user string) error
+}
+
+// WalletPassword returns the password stored in the keyring for the wallet directory.
+func WalletPassword(walletDir string) (string, error) {
+	user, err := walletAccount(walletDir)
+	if err != nil {
+		return "", err
+	}
+	password, err := provider.Get(service, user)
+	if err != nil {
+		return "", keyringError(err)
+	}
+	return password, nil
+}
+
+// SetWalletPassword stores the password of the wallet directory in the keyring, replacing the stored one if any.
+func SetWalletPassword(walletDir, password string) error {
+	user, err := walletAccount(walletDir)
+	if err != nil {
+		return err
+	}
+	if err := provider.Set(service, user, password); err != nil {
+		return keyringError(err)
+	}
+	return nil
+}
+
+// DeleteWalletPassword removes the password of the wallet directory from the keyring.
+func DeleteWalletPassword(walletDir string) error {
+	user, err := walletAccount(walletDir)
+	if err != nil {
+		return err
+	}
+	if err := provider.Delete(service, user); err != nil {
+		return keyringError(err)
+	}
+	return nil
+}
+
+// walletAccount is the account the password of a wallet is stored under: the absolute path of the wallet directory,
+// so that the password of a wallet is found whatever the working directory.
+func walletAccount(walletDir string) (string, error) {
+	if walletDir == "" {
+		return "", errors.New("no wallet directory")
+	}
+	dir, err := file.ExpandPath(walletDir)
+	if err != nil {
+		return "", errors.Wrap(err, "could not expand wallet directory")
+	}
+	return dir, nil
+}
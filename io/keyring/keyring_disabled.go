@@ -0,0 +1,24 @@
+//go:build keyring_disabled
+
+package keyring
+
+var provider backend = disabledKeyring{}
+
+// disabledKeyring is used by binaries built without keyring support.
+type disabledKeyring struct{}
+
+func (disabledKeyring) Set(_, _, _ string) error {
+	return ErrUnsupported
+}
+
+func (disabledKeyring) Get(_, _ string) (string, error) {
+	return "", ErrUnsupported
+}
+
+func (disabledKeyring) Delete(_, _ string) error {
+	return ErrUnsupported
+}
+
+func keyringError(err error) error {
+	return err
+}
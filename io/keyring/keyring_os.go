@@ -0,0 +1,45 @@
+//go:build !keyring_disabled
+
+package keyring
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	gokeyring "github.com/zalando/go-keyring"
+)
+
+var provider backend = osKeyring{}
+
+// osKeyring uses the keyring of the operating system, falling back to ErrUnsupportedPlatform on platforms without one.
+type osKeyring struct{}
+
+func (osKeyring) Set(service, user, password string) error {
+	return gokeyring.Set(service, user, password)
+}
+
+func (osKeyring) Get(service, user string) (string, error) {
+	return gokeyring.Get(service, user)
+}
+
+func (osKeyring) Delete(service, user string) error {
+	return gokeyring.Delete(service, user)
+}
+
+// keyringError explains an error of the keyring, and what can be done about it.
+func keyringError(err error) error {
+	switch {
+	case errors.Is(err, gokeyring.ErrNotFound):
+		return ErrNotFound
+	case errors.Is(err, gokeyring.ErrUnsupportedPlatform):
+		return fmt.Errorf("%w: %v", ErrUnsupported, err)
+	case errors.Is(err, gokeyring.ErrSetDataTooBig):
+		return fmt.Errorf("%w: the wallet password is too long to be stored", ErrUnavailable)
+	}
+	return fmt.Errorf(
+		"%w: %v. Make sure the keyring is unlocked. On Linux, a secret service such as gnome-keyring or KWallet must "+
+			"be running in the D-Bus session, which headless systems usually lack: use a wallet password file instead",
+		ErrUnavailable,
+		err,
+	)
+}
@@ -0,0 +1,56 @@
+//go:build !keyring_disabled
+
+package keyring
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	gokeyring "github.com/zalando/go-keyring"
+)
+
+func TestWalletPassword(t *testing.T) {
+	gokeyring.MockInit()
+	walletDir := t.TempDir()
+
+	_, err := WalletPassword(walletDir)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, SetWalletPassword(walletDir, "Passw0rdz2020%"))
+	password, err := WalletPassword(walletDir)
+	require.NoError(t, err)
+	assert.Equal(t, "Passw0rdz2020%", password)
+
+	// The password is stored for the absolute path of the wallet directory.
+	password, err = WalletPassword(filepath.Join(walletDir, "accounts", ".."))
+	require.NoError(t, err)
+	assert.Equal(t, "Passw0rdz2020%", password)
+	_, err = WalletPassword(t.TempDir())
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, DeleteWalletPassword(walletDir))
+	_, err = WalletPassword(walletDir)
+	require.ErrorIs(t, err, ErrNotFound)
+	require.ErrorIs(t, DeleteWalletPassword(walletDir), ErrNotFound)
+
+	_, err = WalletPassword("")
+	require.ErrorContains(t, "no wallet directory", err)
+}
+
+func TestWalletPassword_Unavailable(t *testing.T) {
+	gokeyring.MockInitWithError(errors.New("The name org.freedesktop.secrets was not provided by any .service files"))
+	walletDir := t.TempDir()
+
+	err := SetWalletPassword(walletDir, "Passw0rdz2020%")
+	require.ErrorIs(t, err, ErrUnavailable)
+	require.ErrorContains(t, "use a wallet password file instead", err)
+	_, err = WalletPassword(walletDir)
+	require.ErrorIs(t, err, ErrUnavailable)
+
+	gokeyring.MockInitWithError(gokeyring.ErrUnsupportedPlatform)
+	_, err = WalletPassword(walletDir)
+	require.ErrorIs(t, err, ErrUnsupported)
+}
@@ -22,7 +22,8 @@ func RespondWithSsz(req *http.Request) bool {
 	currentType, currentPriority := "", 0.0
 	for _, t := range types {
 		values := strings.Split(t, ";")
-		name := values[0]
+		// media ranges may be separated by optional whitespace
+		name := strings.TrimSpace(values[0])
 		if name != api.JsonMediaType && name != api.OctetStreamMediaType {
 			continue
 		}
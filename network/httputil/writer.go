@@ -39,6 +39,52 @@ func WriteJson(w http.ResponseWriter, v any) {
 	}
 }
 
+// WriteJsonDataStream writes a response message in JSON format that has the fields of meta, followed by a "data" array
+// with n elements. Array elements are produced by elem and encoded one at a time straight to the response writer,
+// so that the whole document never has to be held in memory.
+//
+// The response status is sent before the first element is produced, so elem cannot fail. Anything that can fail
+// must be resolved before calling this function, leaving only the encoding of the elements to elem.
+func WriteJsonDataStream(w http.ResponseWriter, meta any, n int, elem func(i int) any) {
+	prefix, err := json.Marshal(meta)
+	if err != nil {
+		HandleError(w, "Could not marshal response metadata: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(prefix) < 2 || prefix[0] != '{' || prefix[len(prefix)-1] != '}' {
+		HandleError(w, "Response metadata is not a JSON object", http.StatusInternalServerError)
+		return
+	}
+	prefix = prefix[:len(prefix)-1]
+	if len(prefix) > 1 {
+		prefix = append(prefix, ',')
+	}
+	prefix = append(prefix, `"data":[`...)
+
+	w.Header().Set("Content-Type", api.JsonMediaType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(prefix); err != nil {
+		log.WithError(err).Error("Could not write response message")
+		return
+	}
+	enc := json.NewEncoder(w)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			if _, err := w.Write([]byte{','}); err != nil {
+				log.WithError(err).Error("Could not write response message")
+				return
+			}
+		}
+		if err := enc.Encode(elem(i)); err != nil {
+			log.WithError(err).Error("Could not write response message")
+			return
+		}
+	}
+	if _, err := w.Write([]byte("]}\n")); err != nil {
+		log.WithError(err).Error("Could not write response message")
+	}
+}
+
 // WriteSsz writes the response message in ssz format
 func WriteSsz(w http.ResponseWriter, respSsz []byte, fileName string) {
 	w.Header().Set("Content-Length", strconv.Itoa(len(respSsz)))
@@ -0,0 +1,56 @@
+package httputil
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestWriteJsonDataStream(t *testing.T) {
+	type meta struct {
+		Finalized bool `json:"finalized"`
+	}
+	type elem struct {
+		Index int `json:"index"`
+	}
+	type response struct {
+		Finalized bool    `json:"finalized"`
+		Data      []*elem `json:"data"`
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		WriteJsonDataStream(writer, &meta{Finalized: true}, 3, func(i int) any {
+			return &elem{Index: i * 2}
+		})
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, api.JsonMediaType, writer.Header().Get("Content-Type"))
+		resp := &response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.Finalized)
+		assert.DeepEqual(t, []*elem{{Index: 0}, {Index: 2}, {Index: 4}}, resp.Data)
+	})
+	t.Run("empty", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		WriteJsonDataStream(writer, struct{}{}, 0, nil)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &response{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		assert.Equal(t, 0, len(resp.Data))
+	})
+	t.Run("metadata is not an object", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		WriteJsonDataStream(writer, []int{1}, 0, nil)
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+	})
+}
@@ -0,0 +1,99 @@
+package network
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// UnixSocketScheme prefixes endpoints served on a unix domain socket, e.g. unix:///var/run/beacon.sock.
+const UnixSocketScheme = "unix://"
+
+// UnixSocketHTTPBaseURL is the base URL of HTTP requests sent over a unix domain socket.
+// Its host is only used for the Host header, the connection always goes to the socket.
+const UnixSocketHTTPBaseURL = "http://localhost"
+
+// UnixSocketPath returns the path of the unix domain socket of a unix:// endpoint.
+// The second return value is false if the endpoint is not a unix socket endpoint.
+func UnixSocketPath(endpoint string) (string, bool) {
+	path, ok := strings.CutPrefix(endpoint, UnixSocketScheme)
+	if !ok || path == "" {
+		return "", false
+	}
+	return path, true
+}
+
+// ListenUnix listens on the unix domain socket at path and sets the permissions of the socket file.
+// A socket file left at path by a process which did not shut down cleanly is removed first.
+// An error is returned if path exists and is not a socket, or if another process still listens on it.
+// The socket file is removed when the returned listener is closed.
+func ListenUnix(path string, perm os.FileMode) (net.Listener, error) {
+	if err := removeStaleUnixSocket(path); err != nil {
+		return nil, err
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not listen on unix socket %s", path)
+	}
+	if err := os.Chmod(path, perm); err != nil {
+		if closeErr := lis.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close unix socket listener")
+		}
+		return nil, errors.Wrapf(err, "could not set permissions of unix socket %s", path)
+	}
+	return lis, nil
+}
+
+func removeStaleUnixSocket(path string) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not stat unix socket %s", path)
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return errors.Errorf("%s already exists and is not a unix socket", path)
+	}
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err == nil {
+		if closeErr := conn.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close unix socket connection")
+		}
+		return errors.Errorf("unix socket %s is already in use", path)
+	}
+	if err := os.Remove(path); err != nil {
+		return errors.Wrapf(err, "could not remove stale unix socket %s", path)
+	}
+	log.WithField("path", path).Warn("Removed stale unix socket")
+	return nil
+}
+
+// UnixSocketTransport returns an HTTP transport sending all requests to the unix domain socket at path,
+// whatever the host of their URL.
+func UnixSocketTransport(path string) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "unix", path)
+	}
+	return transport
+}
+
+// HTTPBaseURLAndTransport returns the base URL of HTTP requests to the endpoint and the transport to send them with.
+// For unix:// endpoints, the base URL is UnixSocketHTTPBaseURL and the transport dials the socket.
+// Otherwise, the endpoint is returned unchanged with a nil transport, meaning http.DefaultTransport.
+func HTTPBaseURLAndTransport(endpoint string) (string, http.RoundTripper) {
+	path, ok := UnixSocketPath(endpoint)
+	if !ok {
+		return endpoint, nil
+	}
+	return UnixSocketHTTPBaseURL, UnixSocketTransport(path)
+}
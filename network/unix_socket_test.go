@@ -0,0 +1,101 @@
+package network
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestUnixSocketPath(t *testing.T) {
+	path, ok := UnixSocketPath("unix:///var/run/beacon.sock")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "/var/run/beacon.sock", path)
+
+	_, ok = UnixSocketPath("unix://")
+	assert.Equal(t, false, ok)
+	_, ok = UnixSocketPath("http://127.0.0.1:3500")
+	assert.Equal(t, false, ok)
+	_, ok = UnixSocketPath("127.0.0.1:4000")
+	assert.Equal(t, false, ok)
+}
+
+func TestListenUnix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beacon.sock")
+
+	lis, err := ListenUnix(path, 0600)
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// The socket is in use.
+	_, err = ListenUnix(path, 0600)
+	assert.ErrorContains(t, "already in use", err)
+
+	// Closing the listener removes the socket file.
+	require.NoError(t, lis.Close())
+	_, err = os.Stat(path)
+	assert.Equal(t, true, os.IsNotExist(err))
+}
+
+func TestListenUnix_RemovesStaleSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beacon.sock")
+
+	// Leave a socket file behind, as a process which did not shut down cleanly would.
+	lis, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	lis.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, lis.Close())
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	lis, err = ListenUnix(path, 0660)
+	require.NoError(t, err)
+	require.NoError(t, lis.Close())
+}
+
+func TestListenUnix_NotASocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beacon.sock")
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0600))
+
+	_, err := ListenUnix(path, 0600)
+	assert.ErrorContains(t, "is not a unix socket", err)
+}
+
+func TestHTTPBaseURLAndTransport(t *testing.T) {
+	baseURL, transport := HTTPBaseURLAndTransport("http://127.0.0.1:3500")
+	assert.Equal(t, "http://127.0.0.1:3500", baseURL)
+	assert.Equal(t, true, transport == nil)
+
+	path := filepath.Join(t.TempDir(), "beacon.sock")
+	lis, err := ListenUnix(path, 0600)
+	require.NoError(t, err)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(r.URL.Path))
+		require.NoError(t, err)
+	})}
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer func() {
+		require.NoError(t, server.Close())
+	}()
+
+	baseURL, transport = HTTPBaseURLAndTransport(UnixSocketScheme + path)
+	assert.Equal(t, UnixSocketHTTPBaseURL, baseURL)
+	client := &http.Client{Transport: transport}
+	resp, err := client.Get(baseURL + "/eth/v1/node/version")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "/eth/v1/node/version", string(body))
+}
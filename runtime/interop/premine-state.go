@@ -22,7 +22,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
-var errUnsupportedVersion = errors.New("schema version not supported by PremineGenesisConfig")
+var (
+	errUnsupportedVersion = errors.New("schema version not supported by PremineGenesisConfig")
+	errNoBlobGas          = errors.New("execution genesis block has no blob gas fields, cancun must be active at genesis")
+)
 
 type PremineGenesisConfig struct {
 	GenesisTime     uint64
@@ -140,18 +143,27 @@ func (s *PremineGenesisConfig) empty() (state.BeaconState, error) {
 		}
 	case version.Capella:
 		e, err = state_native.InitializeFromProtoCapella(&ethpb.BeaconStateCapella{
-			BlockRoots:       bRoots,
-			StateRoots:       sRoots,
-			RandaoMixes:      mixes,
-			Balances:         []uint64{},
-			InactivityScores: []uint64{},
-			Validators:       []*ethpb.Validator{},
+			BlockRoots:          bRoots,
+			StateRoots:          sRoots,
+			RandaoMixes:         mixes,
+			Balances:            []uint64{},
+			InactivityScores:    []uint64{},
+			Validators:          []*ethpb.Validator{},
+			HistoricalSummaries: []*ethpb.HistoricalSummary{},
 		})
 		if err != nil {
 			return nil, err
 		}
 	case version.Deneb:
-		e, err = state_native.InitializeFromProtoDeneb(&ethpb.BeaconStateDeneb{})
+		e, err = state_native.InitializeFromProtoDeneb(&ethpb.BeaconStateDeneb{
+			BlockRoots:          bRoots,
+			StateRoots:          sRoots,
+			RandaoMixes:         mixes,
+			Balances:            []uint64{},
+			InactivityScores:    []uint64{},
+			Validators:          []*ethpb.Validator{},
+			HistoricalSummaries: []*ethpb.HistoricalSummary{},
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -586,6 +598,9 @@ func (s *PremineGenesisConfig) setExecutionPayload(g state.BeaconState) error {
 	}
 
 	gb := s.GB
+	if s.Version >= version.Deneb && (gb.ExcessBlobGas() == nil || gb.BlobGasUsed() == nil) {
+		return errors.Wrapf(errNoBlobGas, "version=%s", version.String(s.Version))
+	}
 
 	var ed interfaces.ExecutionData
 	switch s.Version {
@@ -601,7 +616,7 @@ func (s *PremineGenesisConfig) setExecutionPayload(g state.BeaconState) error {
 			GasLimit:      gb.GasLimit(),
 			GasUsed:       gb.GasUsed(),
 			Timestamp:     gb.Time(),
-			ExtraData:     gb.Extra()[:32],
+			ExtraData:     extraData(gb),
 			BaseFeePerGas: bytesutil.PadTo(bytesutil.ReverseByteOrder(gb.BaseFee().Bytes()), fieldparams.RootLength),
 			BlockHash:     gb.Hash().Bytes(),
 			Transactions:  make([][]byte, 0),
@@ -630,7 +645,7 @@ func (s *PremineGenesisConfig) setExecutionPayload(g state.BeaconState) error {
 			GasLimit:      gb.GasLimit(),
 			GasUsed:       gb.GasUsed(),
 			Timestamp:     gb.Time(),
-			ExtraData:     gb.Extra()[:32],
+			ExtraData:     extraData(gb),
 			BaseFeePerGas: bytesutil.PadTo(bytesutil.ReverseByteOrder(gb.BaseFee().Bytes()), fieldparams.RootLength),
 			BlockHash:     gb.Hash().Bytes(),
 			Transactions:  make([][]byte, 0),
@@ -660,7 +675,7 @@ func (s *PremineGenesisConfig) setExecutionPayload(g state.BeaconState) error {
 			GasLimit:      gb.GasLimit(),
 			GasUsed:       gb.GasUsed(),
 			Timestamp:     gb.Time(),
-			ExtraData:     gb.Extra()[:32],
+			ExtraData:     extraData(gb),
 			BaseFeePerGas: bytesutil.PadTo(bytesutil.ReverseByteOrder(gb.BaseFee().Bytes()), fieldparams.RootLength),
 			BlockHash:     gb.Hash().Bytes(),
 			Transactions:  make([][]byte, 0),
@@ -692,7 +707,7 @@ func (s *PremineGenesisConfig) setExecutionPayload(g state.BeaconState) error {
 			GasLimit:      gb.GasLimit(),
 			GasUsed:       gb.GasUsed(),
 			Timestamp:     gb.Time(),
-			ExtraData:     gb.Extra()[:32],
+			ExtraData:     extraData(gb),
 			BaseFeePerGas: bytesutil.PadTo(bytesutil.ReverseByteOrder(gb.BaseFee().Bytes()), fieldparams.RootLength),
 			BlockHash:     gb.Hash().Bytes(),
 			Transactions:  make([][]byte, 0),
@@ -718,6 +733,16 @@ func (s *PremineGenesisConfig) setExecutionPayload(g state.BeaconState) error {
 	return g.SetLatestExecutionPayloadHeader(ed)
 }
 
+// extraData returns the extra data of the execution genesis block, truncated to the 32 bytes an execution payload can
+// hold: the extra data of a clique genesis block also holds the signers.
+func extraData(gb *types.Block) []byte {
+	extra := gb.Extra()
+	if len(extra) > fieldparams.RootLength {
+		return extra[:fieldparams.RootLength]
+	}
+	return extra
+}
+
 func nZeroRoots(n uint64) [][]byte {
 	roots := make([][]byte, n)
 	zh := params.BeaconConfig().ZeroHash[:]
@@ -2,11 +2,18 @@ package interop
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	state_native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/time"
 )
@@ -24,3 +31,84 @@ func TestPremineGenesis_Electra(t *testing.T) {
 	_, err := NewPreminedGenesis(context.Background(), genesis.Time(), 10, 10, version.Electra, genesis)
 	require.NoError(t, err)
 }
+
+func TestPremineGenesis_CapellaDeneb(t *testing.T) {
+	for _, v := range []int{version.Capella, version.Deneb} {
+		t.Run(version.String(v), func(t *testing.T) {
+			params.SetupTestConfigCleanup(t)
+			cfg := params.MainnetConfig().Copy()
+			cfg.AltairForkEpoch = 0
+			cfg.BellatrixForkEpoch = 0
+			cfg.CapellaForkEpoch = 0
+			cfg.DenebForkEpoch = 0
+			params.OverrideBeaconConfig(cfg)
+
+			// Go through genesis.json as prysmctl does.
+			genesisTime := uint64(1_700_000_000)
+			enc, err := json.Marshal(GethTestnetGenesis(genesisTime, cfg))
+			require.NoError(t, err)
+			gen := &core.Genesis{}
+			require.NoError(t, json.Unmarshal(enc, gen))
+			gb := gen.ToBlock()
+
+			st, err := NewPreminedGenesis(context.Background(), genesisTime, 64, 0, v, gb)
+			require.NoError(t, err)
+			require.Equal(t, v, st.Version())
+			summaries, err := st.HistoricalSummaries()
+			require.NoError(t, err)
+			assert.Equal(t, 0, len(summaries))
+			idx, err := st.NextWithdrawalIndex()
+			require.NoError(t, err)
+			assert.Equal(t, uint64(0), idx)
+			h, err := st.LatestExecutionPayloadHeader()
+			require.NoError(t, err)
+			assert.DeepEqual(t, gb.Hash().Bytes(), h.BlockHash())
+			assert.Equal(t, 32, len(h.ExtraData()))
+
+			// The state is deterministic, and can be initialized from its encoding.
+			again, err := NewPreminedGenesis(context.Background(), genesisTime, 64, 0, v, gb)
+			require.NoError(t, err)
+			assert.DeepEqual(t, st.GenesisValidatorsRoot(), again.GenesisValidatorsRoot())
+			root, err := st.HashTreeRoot(context.Background())
+			require.NoError(t, err)
+			againRoot, err := again.HashTreeRoot(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, root, againRoot)
+			b, err := st.MarshalSSZ()
+			require.NoError(t, err)
+			var decoded state.BeaconState
+			switch v {
+			case version.Capella:
+				pb := &ethpb.BeaconStateCapella{}
+				require.NoError(t, pb.UnmarshalSSZ(b))
+				decoded, err = state_native.InitializeFromProtoCapella(pb)
+			case version.Deneb:
+				pb := &ethpb.BeaconStateDeneb{}
+				require.NoError(t, pb.UnmarshalSSZ(b))
+				decoded, err = state_native.InitializeFromProtoDeneb(pb)
+			}
+			require.NoError(t, err)
+			decodedRoot, err := decoded.HashTreeRoot(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, root, decodedRoot)
+		})
+	}
+}
+
+func TestPremineGenesis_ExecutionGenesisBlock(t *testing.T) {
+	// The extra data of a genesis.json is not always 32 bytes long.
+	genesis := types.NewBlockWithHeader(&types.Header{
+		Time:    uint64(time.Now().Unix()),
+		Extra:   []byte{0x01},
+		BaseFee: big.NewInt(1),
+	})
+	st, err := NewPreminedGenesis(context.Background(), genesis.Time(), 10, 0, version.Capella, genesis)
+	require.NoError(t, err)
+	h, err := st.LatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{0x01}, h.ExtraData())
+
+	// A deneb payload header needs the blob gas fields of cancun.
+	_, err = NewPreminedGenesis(context.Background(), genesis.Time(), 10, 0, version.Deneb, genesis)
+	require.ErrorIs(t, err, errNoBlobGas)
+}
@@ -154,6 +154,8 @@ func (node *Proxy) Start(ctx context.Context) error {
 		proxy.WithLogger(log.New()),
 		proxy.WithLogFile(f),
 		proxy.WithJwtSecret(string(secret)),
+		// All beacon nodes share the same genesis, the first one is queried for the current slot.
+		proxy.WithBeaconNodeAddress(fmt.Sprintf("http://127.0.0.1:%d", e2e.TestParams.Ports.PrysmBeaconNodeHTTPPort)),
 	}
 	nProxy, err := proxy.New(opts...)
 	if err != nil {
@@ -209,6 +211,11 @@ func (node *Proxy) ReleaseBackedUpRequests(rpcMethodName string) {
 	node.engineProxy.ReleaseBackedUpRequests(rpcMethodName)
 }
 
+// SetScenario replaces the scenario of rules applied to engine API requests, nil disables it.
+func (node *Proxy) SetScenario(s *proxy.Scenario) error {
+	return node.engineProxy.SetScenario(s)
+}
+
 func parseJWTSecretFromFile(jwtSecretFile string) ([]byte, error) {
 	enc, err := file.ReadFileAsBytes(jwtSecretFile)
 	if err != nil {
@@ -48,6 +48,7 @@ func e2eMinimal(t *testing.T, cfg *params.BeaconChainConfig, cfgo ...types.E2ECo
 		ev.ValidatorsHaveExited,
 		ev.SubmitWithdrawal,
 		ev.ValidatorsHaveWithdrawn,
+		ev.ExpectedWithdrawalsMatchBlocks,
 		ev.ProcessesDepositsInBlocks,
 		ev.ActivatesDepositedValidators,
 		ev.DepositedValidatorsAreActive,
@@ -126,6 +127,7 @@ func e2eMainnet(t *testing.T, usePrysmSh, useMultiClient bool, cfg *params.Beaco
 		ev.ValidatorsHaveExited,
 		ev.SubmitWithdrawal,
 		ev.ValidatorsHaveWithdrawn,
+		ev.ExpectedWithdrawalsMatchBlocks,
 		ev.DepositedValidatorsAreActive,
 		ev.ColdStateCheckpoint,
 		ev.AltairForkTransition,
@@ -0,0 +1,123 @@
+package evaluators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	e2e "github.com/prysmaticlabs/prysm/v5/testing/endtoend/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/endtoend/policies"
+	e2etypes "github.com/prysmaticlabs/prysm/v5/testing/endtoend/types"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ExpectedWithdrawalsMatchBlocks checks that the withdrawals returned by the expected withdrawals endpoint for the
+// state preceding each canonical block of the previous epoch are the withdrawals included in that block.
+var ExpectedWithdrawalsMatchBlocks = e2etypes.Evaluator{
+	Name: "expected_withdrawals_match_blocks_at_epoch_%d",
+	Policy: func(e primitives.Epoch) bool {
+		return policies.OnwardsNthEpoch(params.BeaconConfig().CapellaForkEpoch + 1)(e)
+	},
+	Evaluation: expectedWithdrawalsMatchBlocks,
+}
+
+func expectedWithdrawalsMatchBlocks(_ *e2etypes.EvaluationContext, conns ...*grpc.ClientConn) error {
+	conn := conns[0]
+	client := ethpb.NewNodeClient(conn)
+	beaconClient := ethpb.NewBeaconChainClient(conn)
+	genesis, err := client.GetGenesis(context.Background(), &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get genesis data")
+	}
+	currEpoch := slots.ToEpoch(slots.CurrentSlot(uint64(genesis.GenesisTime.AsTime().Unix())))
+	if currEpoch == 0 {
+		return nil
+	}
+	blockCtrs, err := beaconClient.ListBeaconBlocks(context.Background(), &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: currEpoch - 1}})
+	if err != nil {
+		return errors.Wrap(err, "failed to get beacon blocks")
+	}
+	for _, ctr := range blockCtrs.BlockContainers {
+		if !ctr.Canonical {
+			continue
+		}
+		b, err := syncCompatibleBlockFromCtr(ctr)
+		if err != nil {
+			return errors.Wrapf(err, "block type doesn't exist for block at epoch %d", currEpoch-1)
+		}
+		// Blinded blocks only carry the root of their withdrawals.
+		if b.IsNil() || b.Version() < version.Capella || b.IsBlinded() {
+			continue
+		}
+		slot := b.Block().Slot()
+		if slot == 0 {
+			continue
+		}
+		payload, err := b.Block().Body().Execution()
+		if err != nil {
+			return errors.Wrapf(err, "could not get execution payload of block at slot %d", slot)
+		}
+		included, err := payload.Withdrawals()
+		if err != nil {
+			return errors.Wrapf(err, "could not get withdrawals of block at slot %d", slot)
+		}
+		// The state at the previous slot, processed to the slot of the block, is the state the block was built on.
+		expected, err := expectedWithdrawals(slot-1, slot)
+		if err != nil {
+			return errors.Wrapf(err, "could not get expected withdrawals for slot %d", slot)
+		}
+		if err := compareWithdrawals(expected, included); err != nil {
+			return errors.Wrapf(err, "expected withdrawals do not match the withdrawals of block at slot %d", slot)
+		}
+	}
+	return nil
+}
+
+func expectedWithdrawals(stateSlot, proposalSlot primitives.Slot) ([]*structs.ExpectedWithdrawal, error) {
+	path := fmt.Sprintf("http://localhost:%d/eth/v1/builder/states/%d/expected_withdrawals?proposal_slot=%d", e2e.TestParams.Ports.PrysmBeaconNodeHTTPPort, stateSlot, proposalSlot)
+	httpResp, err := http.Get(path) // #nosec G107 -- path can't be constant because it depends on port param and slots
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		e := httputil.DefaultJsonError{}
+		if err = json.NewDecoder(httpResp.Body).Decode(&e); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s (status code %d)", e.Message, e.Code)
+	}
+	resp := &structs.ExpectedWithdrawalsResponse{}
+	if err = json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return nil, err
+	}
+	if err = httpResp.Body.Close(); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func compareWithdrawals(expected []*structs.ExpectedWithdrawal, included []*enginev1.Withdrawal) error {
+	if len(expected) != len(included) {
+		return fmt.Errorf("expected %d withdrawals, block has %d", len(expected), len(included))
+	}
+	for i, w := range included {
+		want := fmt.Sprintf("%d:%d:%s:%d", w.Index, w.ValidatorIndex, hexutil.Encode(w.Address), w.Amount)
+		got := fmt.Sprintf("%s:%s:%s:%s", expected[i].Index, expected[i].ValidatorIndex, expected[i].Address, expected[i].Amount)
+		if want != got {
+			return fmt.Errorf("withdrawal %d: endpoint returned %s (index:validator:address:amount), block has %s", i, got, want)
+		}
+	}
+	return nil
+}
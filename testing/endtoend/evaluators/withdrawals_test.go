@@ -0,0 +1,57 @@
+package evaluators
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestCompareWithdrawals(t *testing.T) {
+	address := bytesutil.PadTo([]byte{0xab}, 20)
+	included := []*enginev1.Withdrawal{
+		{Index: 4, ValidatorIndex: 12, Address: address, Amount: 1000},
+		{Index: 5, ValidatorIndex: 13, Address: address, Amount: 2000},
+	}
+	expected := func() []*structs.ExpectedWithdrawal {
+		return []*structs.ExpectedWithdrawal{
+			{Index: "4", ValidatorIndex: "12", Address: hexutil.Encode(address), Amount: "1000"},
+			{Index: "5", ValidatorIndex: "13", Address: hexutil.Encode(address), Amount: "2000"},
+		}
+	}
+
+	t.Run("match", func(t *testing.T) {
+		require.NoError(t, compareWithdrawals(expected(), included))
+	})
+	t.Run("no withdrawals", func(t *testing.T) {
+		require.NoError(t, compareWithdrawals(nil, nil))
+	})
+	t.Run("length mismatch", func(t *testing.T) {
+		err := compareWithdrawals(expected()[:1], included)
+		require.ErrorContains(t, "expected 1 withdrawals, block has 2", err)
+	})
+	t.Run("field mismatch", func(t *testing.T) {
+		for name, modify := range map[string]func(w *structs.ExpectedWithdrawal){
+			"index":           func(w *structs.ExpectedWithdrawal) { w.Index = "6" },
+			"validator index": func(w *structs.ExpectedWithdrawal) { w.ValidatorIndex = "14" },
+			"address":         func(w *structs.ExpectedWithdrawal) { w.Address = hexutil.Encode(bytesutil.PadTo([]byte{0xcd}, 20)) },
+			"amount":          func(w *structs.ExpectedWithdrawal) { w.Amount = "2001" },
+		} {
+			t.Run(name, func(t *testing.T) {
+				e := expected()
+				modify(e[1])
+				err := compareWithdrawals(e, included)
+				require.ErrorContains(t, "withdrawal 1: endpoint returned", err)
+			})
+		}
+	})
+	t.Run("order mismatch", func(t *testing.T) {
+		e := expected()
+		e[0], e[1] = e[1], e[0]
+		err := compareWithdrawals(e, included)
+		require.ErrorContains(t, "withdrawal 0: endpoint returned 5:13:", err)
+	})
+}
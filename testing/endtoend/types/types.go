@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	proxy "github.com/prysmaticlabs/prysm/v5/testing/middleware/engine-api-proxy"
 	"google.golang.org/grpc"
 )
 
@@ -176,6 +177,8 @@ type EngineProxy interface {
 	RemoveRequestInterceptor(rpcMethodName string)
 	// ReleaseBackedUpRequests releases backed up http requests.
 	ReleaseBackedUpRequests(rpcMethodName string)
+	// SetScenario replaces the scenario of rules applied to engine API requests, nil disables it.
+	SetScenario(s *proxy.Scenario) error
 }
 
 // BeaconNodeSet defines an interface for an object that fulfills the duties
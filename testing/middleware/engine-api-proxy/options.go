@@ -14,6 +14,7 @@ type config struct {
 	destinationUrl *url.URL
 	logger         *logrus.Logger
 	secret         string
+	slotFetcher    SlotFetcher
 }
 
 type Option func(p *Proxy) error
@@ -77,3 +78,33 @@ func WithJwtSecret(secret string) Option {
 		return nil
 	}
 }
+
+// WithScenario sets the scenario applied to engine API requests.
+func WithScenario(s *Scenario) Option {
+	return func(p *Proxy) error {
+		if err := s.Validate(); err != nil {
+			return errors.Wrap(err, "invalid scenario")
+		}
+		p.scenario = s
+		return nil
+	}
+}
+
+// WithSlotFetcher sets how the proxy gets the current slot, which scenario rules restricted to slots require.
+func WithSlotFetcher(f SlotFetcher) Option {
+	return func(p *Proxy) error {
+		p.cfg.slotFetcher = f
+		return nil
+	}
+}
+
+// WithBeaconNodeAddress computes the current slot from the genesis time of the beacon node at the address.
+func WithBeaconNodeAddress(addr string) Option {
+	return func(p *Proxy) error {
+		if addr == "" {
+			return errors.New("must provide a beacon node address")
+		}
+		p.cfg.slotFetcher = BeaconSlotFetcher(addr)
+		return nil
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"net"
 	"net/http"
 	"strings"
@@ -34,6 +33,17 @@ type jsonRPCObject struct {
 	Result  interface{}   `json:"result"`
 }
 
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRPCErrorObject struct {
+	Jsonrpc string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Error   *jsonRPCError `json:"error"`
+}
+
 type interceptorConfig struct {
 	responseGen func() interface{}
 	trigger     func() bool
@@ -48,6 +58,7 @@ type Proxy struct {
 	lock             sync.RWMutex
 	interceptors     map[string]*interceptorConfig
 	backedUpRequests map[string][]*http.Request
+	scenario         *Scenario
 }
 
 // New creates a proxy server forwarding requests from a consensus client to an execution client.
@@ -96,14 +107,12 @@ func (p *Proxy) Start(ctx context.Context) error {
 		"forwardingAddress": p.cfg.destinationUrl.String(),
 	}).Infof("Engine proxy now listening on address %s", p.address)
 	go func() {
-		if err := p.srv.ListenAndServe(); err != nil {
+		if err := p.srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			p.cfg.logger.Error(err)
 		}
 	}()
-	for {
-		<-ctx.Done()
-		return p.srv.Shutdown(context.Background())
-	}
+	<-ctx.Done()
+	return p.srv.Shutdown(context.Background())
 }
 
 // ServeHTTP requests from a consensus client to an execution client, modifying in-flight requests
@@ -112,6 +121,7 @@ func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	requestBytes, err := parseRequestBytes(r)
 	if err != nil {
 		p.cfg.logger.WithError(err).Error("Could not parse request")
+		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
 	// Check if we need to intercept the request with a custom response.
@@ -123,6 +133,10 @@ func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if hasIntercepted {
 		return
 	}
+	// Check if a rule of the scenario acts on the request.
+	if p.applyScenario(requestBytes, w, r) {
+		return
+	}
 	// If we are not intercepting the request, we proxy as normal.
 	p.proxyRequest(requestBytes, w, r)
 }
@@ -181,8 +195,9 @@ func (p *Proxy) interceptIfNeeded(requestBytes []byte, w http.ResponseWriter, r
 	if err != nil {
 		return
 	}
-	p.lock.RLock()
-	defer p.lock.RUnlock()
+	// The write lock is required as intercepted requests are backed up.
+	p.lock.Lock()
+	defer p.lock.Unlock()
 	interceptor, shouldIntercept := p.interceptors[jreq.Method]
 	if !shouldIntercept {
 		return
@@ -240,7 +255,7 @@ func (p *Proxy) sendHttpRequest(req *http.Request, requestBytes []byte) (*http.R
 	}
 
 	// Set the modified request as the proxy request body.
-	proxyReq.Body = ioutil.NopCloser(bytes.NewBuffer(requestBytes))
+	proxyReq.Body = io.NopCloser(bytes.NewBuffer(requestBytes))
 
 	// Required proxy headers for forwarding JSON-RPC requests to the execution client.
 	proxyReq.Header.Set("Host", req.Host)
@@ -261,14 +276,14 @@ func (p *Proxy) sendHttpRequest(req *http.Request, requestBytes []byte) (*http.R
 
 // Peek into the bytes of an HTTP request's body.
 func parseRequestBytes(req *http.Request) ([]byte, error) {
-	requestBytes, err := ioutil.ReadAll(req.Body)
+	requestBytes, err := io.ReadAll(req.Body)
 	if err != nil {
 		return nil, err
 	}
 	if err = req.Body.Close(); err != nil {
 		return nil, err
 	}
-	req.Body = ioutil.NopCloser(bytes.NewBuffer(requestBytes))
+	req.Body = io.NopCloser(bytes.NewBuffer(requestBytes))
 	return requestBytes, nil
 }
 
@@ -0,0 +1,420 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"gopkg.in/yaml.v2"
+)
+
+// SlotFetcher returns the current slot, used to restrict scenario rules to a range of slots.
+type SlotFetcher func(ctx context.Context) (primitives.Slot, error)
+
+// Scenario is an ordered list of rules applied to engine API requests going through the proxy.
+// For every request, the first rule which matches and has not been exhausted acts on it.
+// Requests which no rule acts on are proxied as normal.
+type Scenario struct {
+	Rules []*Rule `yaml:"rules"`
+	lock  sync.Mutex
+}
+
+// Rule acts on the engine API requests matching its conditions.
+type Rule struct {
+	Name   string `yaml:"name"`
+	Match  Match  `yaml:"match"`
+	Action Action `yaml:"action"`
+	// matched is the number of requests which matched the conditions of the rule.
+	matched uint64
+	// applied is the number of requests the rule acted on.
+	applied uint64
+}
+
+// Match is the set of conditions a request must meet for a rule to act on it.
+type Match struct {
+	// Method is matched as a prefix of the JSON-RPC method, so engine_newPayload matches every version of the method.
+	Method string `yaml:"method"`
+	// Params are predicates on the params of the request, all of which must hold.
+	Params []ParamPredicate `yaml:"params"`
+	// After skips the first matching requests, so that the rule only acts from the After+1-th one.
+	After uint64 `yaml:"after"`
+	// Times is the number of requests the rule acts on, after which it is exhausted. Zero means no limit.
+	Times uint64 `yaml:"times"`
+	// FromSlot and ToSlot restrict the rule to an inclusive range of slots of the connected beacon node.
+	FromSlot *primitives.Slot `yaml:"fromSlot"`
+	ToSlot   *primitives.Slot `yaml:"toSlot"`
+}
+
+// ParamPredicate holds if the value at a dot separated path of a param of the request equals the expected value.
+// Hex strings are compared case insensitively.
+type ParamPredicate struct {
+	Index  int    `yaml:"index"`
+	Path   string `yaml:"path"`
+	Equals string `yaml:"equals"`
+}
+
+// Action is what a rule does with a request it acts on. The delay applies first, then the request is either
+// dropped, answered with a JSON-RPC error, or forwarded to the execution client with fields of the result replaced.
+// A rule which only delays forwards the request unmodified.
+type Action struct {
+	Delay time.Duration `yaml:"delay"`
+	// Drop closes the connection without responding.
+	Drop bool `yaml:"drop"`
+	// ErrorCode, if set, responds with a JSON-RPC error with the code and ErrorMessage.
+	ErrorCode    int    `yaml:"errorCode"`
+	ErrorMessage string `yaml:"errorMessage"`
+	// Replace sets the values at dot separated paths of the result returned by the execution client.
+	Replace map[string]interface{} `yaml:"replace"`
+}
+
+// LoadScenario reads a scenario from a YAML file.
+func LoadScenario(path string) (*Scenario, error) {
+	b, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read scenario file %s", path)
+	}
+	s := &Scenario{}
+	if err := yaml.UnmarshalStrict(b, s); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal scenario file %s", path)
+	}
+	for _, r := range s.Rules {
+		for k, v := range r.Action.Replace {
+			r.Action.Replace[k] = normalizeYAML(v)
+		}
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Validate checks that every rule of the scenario can be applied.
+func (s *Scenario) Validate() error {
+	for i, r := range s.Rules {
+		if r == nil {
+			return errors.Errorf("rule %d is nil", i)
+		}
+		if !strings.HasPrefix(r.Match.Method, "engine_") {
+			return errors.Errorf("rule %d (%s) must match an engine API method, got %q", i, r.Name, r.Match.Method)
+		}
+		if r.Match.FromSlot != nil && r.Match.ToSlot != nil && *r.Match.FromSlot > *r.Match.ToSlot {
+			return errors.Errorf("rule %d (%s) has an empty slot range", i, r.Name)
+		}
+		a := r.Action
+		if a.Drop && (a.ErrorCode != 0 || len(a.Replace) > 0) || a.ErrorCode != 0 && len(a.Replace) > 0 {
+			return errors.Errorf("rule %d (%s) must either drop, return an error or replace fields", i, r.Name)
+		}
+	}
+	return nil
+}
+
+// Applied returns the number of requests the rule with the given name acted on.
+func (s *Scenario) Applied(name string) uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var applied uint64
+	for _, r := range s.Rules {
+		if r.Name == name {
+			applied += r.applied
+		}
+	}
+	return applied
+}
+
+func (s *Scenario) usesSlots() bool {
+	for _, r := range s.Rules {
+		if r.Match.FromSlot != nil || r.Match.ToSlot != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// rule returns the rule acting on the request, if any, and updates the counters of the matching rules.
+func (s *Scenario) rule(req *jsonRPCObject, slot primitives.Slot, slotKnown bool) *Rule {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, r := range s.Rules {
+		if !r.Match.matches(req, slot, slotKnown) {
+			continue
+		}
+		r.matched++
+		if r.matched <= r.Match.After {
+			continue
+		}
+		if r.Match.Times != 0 && r.applied >= r.Match.Times {
+			continue
+		}
+		r.applied++
+		return r
+	}
+	return nil
+}
+
+func (m *Match) matches(req *jsonRPCObject, slot primitives.Slot, slotKnown bool) bool {
+	if !strings.HasPrefix(req.Method, m.Method) {
+		return false
+	}
+	if m.FromSlot != nil || m.ToSlot != nil {
+		if !slotKnown {
+			return false
+		}
+		if m.FromSlot != nil && slot < *m.FromSlot {
+			return false
+		}
+		if m.ToSlot != nil && slot > *m.ToSlot {
+			return false
+		}
+	}
+	for _, p := range m.Params {
+		if !p.holds(req.Params) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *ParamPredicate) holds(params []interface{}) bool {
+	if p.Index < 0 || p.Index >= len(params) {
+		return false
+	}
+	v, ok := lookupPath(params[p.Index], p.Path)
+	if !ok {
+		return false
+	}
+	var actual string
+	switch t := v.(type) {
+	case nil:
+		actual = "null"
+	case string:
+		actual = t
+	default:
+		actual = fmt.Sprint(t)
+	}
+	if strings.HasPrefix(actual, "0x") {
+		return strings.EqualFold(actual, p.Equals)
+	}
+	return actual == p.Equals
+}
+
+func lookupPath(v interface{}, path string) (interface{}, bool) {
+	if path == "" {
+		return v, true
+	}
+	for _, k := range strings.Split(path, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[k]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+func setPath(v interface{}, path string, value interface{}) error {
+	keys := strings.Split(path, ".")
+	for _, k := range keys[:len(keys)-1] {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("could not set %s: %s is not an object", path, k)
+		}
+		v = m[k]
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return errors.Errorf("could not set %s: parent is not an object", path)
+	}
+	m[keys[len(keys)-1]] = value
+	return nil
+}
+
+// normalizeYAML converts the maps decoded from YAML to maps with string keys, so that they can be JSON encoded.
+func normalizeYAML(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			m[fmt.Sprint(k)] = normalizeYAML(e)
+		}
+		return m
+	case []interface{}:
+		for i, e := range t {
+			t[i] = normalizeYAML(e)
+		}
+		return t
+	default:
+		return v
+	}
+}
+
+// SetScenario replaces the scenario applied to engine API requests. A nil scenario disables scenarios.
+func (p *Proxy) SetScenario(s *Scenario) error {
+	if s != nil {
+		if err := s.Validate(); err != nil {
+			return errors.Wrap(err, "invalid scenario")
+		}
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.scenario = s
+	return nil
+}
+
+// applyScenario lets the first rule of the scenario matching the request act on it,
+// and returns whether the response was written.
+func (p *Proxy) applyScenario(requestBytes []byte, w http.ResponseWriter, r *http.Request) bool {
+	p.lock.RLock()
+	s := p.scenario
+	p.lock.RUnlock()
+	if s == nil || !isEngineAPICall(requestBytes) {
+		return false
+	}
+	jreq, err := unmarshalRPCObject(requestBytes)
+	if err != nil {
+		return false
+	}
+	var (
+		slot      primitives.Slot
+		slotKnown bool
+	)
+	if p.cfg.slotFetcher != nil && s.usesSlots() {
+		slot, err = p.cfg.slotFetcher(r.Context())
+		if err != nil {
+			p.cfg.logger.WithError(err).Error("Could not fetch the current slot, skipping rules restricted to slots")
+		} else {
+			slotKnown = true
+		}
+	}
+	rule := s.rule(jreq, slot, slotKnown)
+	if rule == nil {
+		return false
+	}
+	p.cfg.logger.Infof("Applying scenario rule %q to request for method %s", rule.Name, jreq.Method)
+
+	a := rule.Action
+	if a.Delay > 0 {
+		select {
+		case <-time.After(a.Delay):
+		case <-r.Context().Done():
+			return true
+		}
+	}
+	switch {
+	case a.Drop:
+		dropConnection(w)
+		return true
+	case a.ErrorCode != 0:
+		resp := &jsonRPCErrorObject{
+			Jsonrpc: "2.0",
+			ID:      jreq.ID,
+			Error:   &jsonRPCError{Code: a.ErrorCode, Message: a.ErrorMessage},
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			p.cfg.logger.WithError(err).Error("Could not write error response")
+		}
+		return true
+	case len(a.Replace) > 0:
+		p.proxyRequestWithReplacements(requestBytes, a.Replace, w, r)
+		return true
+	default:
+		return false
+	}
+}
+
+// proxyRequestWithReplacements forwards the request to the execution client, and replaces fields of the result.
+func (p *Proxy) proxyRequestWithReplacements(requestBytes []byte, replace map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	proxyRes, err := p.sendHttpRequest(r, requestBytes)
+	if err != nil {
+		p.cfg.logger.WithError(err).Error("Could not forward request")
+		dropConnection(w)
+		return
+	}
+	defer func() {
+		if err := proxyRes.Body.Close(); err != nil {
+			p.cfg.logger.WithError(err).Error("Could not close proxy response body")
+		}
+	}()
+	resp := make(map[string]interface{})
+	if err := json.NewDecoder(proxyRes.Body).Decode(&resp); err != nil {
+		p.cfg.logger.WithError(err).Error("Could not decode response")
+		dropConnection(w)
+		return
+	}
+	for path, value := range replace {
+		if err := setPath(resp["result"], path, value); err != nil {
+			p.cfg.logger.WithError(err).Error("Could not replace response field")
+		}
+	}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		p.cfg.logger.WithError(err).Error("Could not write response")
+	}
+}
+
+// dropConnection closes the connection of the request without responding.
+func dropConnection(w http.ResponseWriter) {
+	if hj, ok := w.(http.Hijacker); ok {
+		if conn, _, err := hj.Hijack(); err == nil {
+			_ = conn.Close()
+			return
+		}
+	}
+	w.WriteHeader(http.StatusBadGateway)
+}
+
+// BeaconSlotFetcher returns a slot fetcher computing the current slot from the genesis time of the beacon node
+// at the given address, fetched once from its beacon API.
+func BeaconSlotFetcher(beaconNodeAddress string) SlotFetcher {
+	var (
+		lock    sync.Mutex
+		genesis uint64
+	)
+	return func(ctx context.Context) (primitives.Slot, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if genesis == 0 {
+			g, err := fetchGenesisTime(ctx, beaconNodeAddress)
+			if err != nil {
+				return 0, err
+			}
+			genesis = g
+		}
+		return slots.CurrentSlot(genesis), nil
+	}
+}
+
+func fetchGenesisTime(ctx context.Context, beaconNodeAddress string) (uint64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(beaconNodeAddress, "/")+"/eth/v1/beacon/genesis", nil)
+	if err != nil {
+		return 0, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not request genesis")
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return 0, errors.Errorf("could not request genesis: %s", res.Status)
+	}
+	g := &structs.GetGenesisResponse{}
+	if err := json.NewDecoder(res.Body).Decode(g); err != nil {
+		return 0, errors.Wrap(err, "could not decode genesis response")
+	}
+	if g.Data == nil {
+		return 0, errors.New("empty genesis response")
+	}
+	return strconv.ParseUint(g.Data.GenesisTime, 10, 64)
+}
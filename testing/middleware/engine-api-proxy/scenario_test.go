@@ -0,0 +1,171 @@
+package proxy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type payloadStatus struct {
+	Status          string  `json:"status"`
+	LatestValidHash *string `json:"latestValidHash"`
+}
+
+func startScenarioProxy(t *testing.T, ctx context.Context, opts ...Option) *rpc.Client {
+	validHash := "0x01"
+	srv := destinationServerSetup(t, &payloadStatus{Status: "VALID", LatestValidHash: &validHash})
+	t.Cleanup(srv.Close)
+
+	r := rand.NewGenerator()
+	proxy, err := New(append([]Option{WithPort(r.Intn(50000)), WithDestinationAddress(srv.URL)}, opts...)...)
+	require.NoError(t, err)
+	go func() {
+		if err := proxy.Start(ctx); err != nil {
+			t.Log(err)
+		}
+	}()
+	time.Sleep(time.Millisecond * 100)
+
+	rpcClient, err := rpc.DialHTTP("http://" + proxy.Address())
+	require.NoError(t, err)
+	return rpcClient
+}
+
+func TestProxy_Scenario(t *testing.T) {
+	t.Run("replaces fields of a sequence of responses", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		scenario := &Scenario{Rules: []*Rule{{
+			Name:   "syncing",
+			Match:  Match{Method: "engine_newPayload", After: 1, Times: 3},
+			Action: Action{Replace: map[string]interface{}{"status": "SYNCING", "latestValidHash": nil}},
+		}}}
+		rpcClient := startScenarioProxy(t, ctx, WithScenario(scenario))
+
+		want := []string{"VALID", "SYNCING", "SYNCING", "SYNCING", "VALID"}
+		for i, status := range want {
+			res := &payloadStatus{}
+			require.NoError(t, rpcClient.CallContext(ctx, res, "engine_newPayloadV3"))
+			require.Equal(t, status, res.Status, "call %d", i)
+			require.Equal(t, status == "VALID", res.LatestValidHash != nil, "call %d", i)
+		}
+		require.Equal(t, uint64(3), scenario.Applied("syncing"))
+
+		// Other methods are not affected.
+		res := &payloadStatus{}
+		require.NoError(t, rpcClient.CallContext(ctx, res, "engine_forkchoiceUpdatedV3"))
+		require.Equal(t, "VALID", res.Status)
+	})
+	t.Run("returns errors and drops requests", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		scenario := &Scenario{Rules: []*Rule{
+			{
+				Name: "unknown payload",
+				Match: Match{Method: "engine_newPayload", Params: []ParamPredicate{
+					{Index: 0, Path: "blockHash", Equals: "0xab"},
+				}},
+				Action: Action{ErrorCode: -38001, ErrorMessage: "Unknown payload"},
+			},
+			{
+				Name:   "drop",
+				Match:  Match{Method: "engine_getPayload"},
+				Action: Action{Drop: true},
+			},
+		}}
+		rpcClient := startScenarioProxy(t, ctx, WithScenario(scenario))
+
+		err := rpcClient.CallContext(ctx, &payloadStatus{}, "engine_newPayloadV3", map[string]interface{}{"blockHash": "0xAB"})
+		require.ErrorContains(t, "Unknown payload", err)
+		rpcErr, ok := err.(rpc.Error)
+		require.Equal(t, true, ok)
+		require.Equal(t, -38001, rpcErr.ErrorCode())
+
+		res := &payloadStatus{}
+		require.NoError(t, rpcClient.CallContext(ctx, res, "engine_newPayloadV3", map[string]interface{}{"blockHash": "0xcd"}))
+		require.Equal(t, "VALID", res.Status)
+
+		require.NotNil(t, rpcClient.CallContext(ctx, &payloadStatus{}, "engine_getPayloadV3"))
+		require.Equal(t, uint64(1), scenario.Applied("drop"))
+	})
+	t.Run("restricts rules to slots", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		from, to := primitives.Slot(5), primitives.Slot(6)
+		scenario := &Scenario{Rules: []*Rule{{
+			Name:   "invalid",
+			Match:  Match{Method: "engine_newPayload", FromSlot: &from, ToSlot: &to},
+			Action: Action{Replace: map[string]interface{}{"status": "INVALID"}},
+		}}}
+		var current uint64
+		rpcClient := startScenarioProxy(t, ctx, WithScenario(scenario), WithSlotFetcher(func(context.Context) (primitives.Slot, error) {
+			return primitives.Slot(atomic.LoadUint64(&current)), nil
+		}))
+
+		for slot := primitives.Slot(4); slot <= 7; slot++ {
+			atomic.StoreUint64(&current, uint64(slot))
+			res := &payloadStatus{}
+			require.NoError(t, rpcClient.CallContext(ctx, res, "engine_newPayloadV3"))
+			if slot >= from && slot <= to {
+				require.Equal(t, "INVALID", res.Status, "slot %d", slot)
+			} else {
+				require.Equal(t, "VALID", res.Status, "slot %d", slot)
+			}
+		}
+	})
+}
+
+func TestLoadScenario(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "scenario.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`rules:
+  - name: syncing after slot 10
+    match:
+      method: engine_newPayload
+      fromSlot: 10
+      times: 3
+    action:
+      delay: 500ms
+      replace:
+        status: SYNCING
+        latestValidHash: null
+        nested:
+          key: value
+`), 0600))
+
+	s, err := LoadScenario(path)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(s.Rules))
+	r := s.Rules[0]
+	require.Equal(t, "syncing after slot 10", r.Name)
+	require.Equal(t, primitives.Slot(10), *r.Match.FromSlot)
+	require.Equal(t, uint64(3), r.Match.Times)
+	require.Equal(t, 500*time.Millisecond, r.Action.Delay)
+	require.Equal(t, "SYNCING", r.Action.Replace["status"])
+	require.DeepEqual(t, map[string]interface{}{"key": "value"}, r.Action.Replace["nested"])
+
+	require.NoError(t, os.WriteFile(path, []byte(`rules:
+  - match:
+      method: eth_syncing
+`), 0600))
+	_, err = LoadScenario(path)
+	require.ErrorContains(t, "must match an engine API method", err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`rules:
+  - match:
+      method: engine_newPayload
+    action:
+      drop: true
+      errorCode: -32000
+`), 0600))
+	_, err = LoadScenario(path)
+	require.ErrorContains(t, "must either drop, return an error or replace fields", err)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1 (interfaces: BeaconChainClient)
+// Source: github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1 (interfaces: BeaconChainClient,BeaconChain_StreamValidatorsClient,BeaconChain_StreamValidatorBalancesClient,BeaconChain_StreamAttestationsClient)
 //
 // Generated by this command:
 //
-//	mockgen -package=mock -destination=testing/mock/beacon_service_mock.go github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1 BeaconChainClient
+//	mockgen -package=mock -destination=testing/mock/beacon_service_mock.go github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1 BeaconChainClient,BeaconChain_StreamValidatorsClient,BeaconChain_StreamValidatorBalancesClient,BeaconChain_StreamAttestationsClient
 //
 
 // Package mock is a generated GoMock package.
@@ -16,6 +16,7 @@ import (
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	gomock "go.uber.org/mock/gomock"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -422,6 +423,66 @@ func (mr *MockBeaconChainClientMockRecorder) ListValidators(arg0, arg1 any, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListValidators", reflect.TypeOf((*MockBeaconChainClient)(nil).ListValidators), varargs...)
 }
 
+// StreamAttestations mocks base method.
+func (m *MockBeaconChainClient) StreamAttestations(arg0 context.Context, arg1 *eth.ListAttestationsRequest, arg2 ...grpc.CallOption) (eth.BeaconChain_StreamAttestationsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamAttestations", varargs...)
+	ret0, _ := ret[0].(eth.BeaconChain_StreamAttestationsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamAttestations indicates an expected call of StreamAttestations.
+func (mr *MockBeaconChainClientMockRecorder) StreamAttestations(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAttestations", reflect.TypeOf((*MockBeaconChainClient)(nil).StreamAttestations), varargs...)
+}
+
+// StreamValidatorBalances mocks base method.
+func (m *MockBeaconChainClient) StreamValidatorBalances(arg0 context.Context, arg1 *eth.ListValidatorBalancesRequest, arg2 ...grpc.CallOption) (eth.BeaconChain_StreamValidatorBalancesClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamValidatorBalances", varargs...)
+	ret0, _ := ret[0].(eth.BeaconChain_StreamValidatorBalancesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamValidatorBalances indicates an expected call of StreamValidatorBalances.
+func (mr *MockBeaconChainClientMockRecorder) StreamValidatorBalances(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamValidatorBalances", reflect.TypeOf((*MockBeaconChainClient)(nil).StreamValidatorBalances), varargs...)
+}
+
+// StreamValidators mocks base method.
+func (m *MockBeaconChainClient) StreamValidators(arg0 context.Context, arg1 *eth.ListValidatorsRequest, arg2 ...grpc.CallOption) (eth.BeaconChain_StreamValidatorsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamValidators", varargs...)
+	ret0, _ := ret[0].(eth.BeaconChain_StreamValidatorsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamValidators indicates an expected call of StreamValidators.
+func (mr *MockBeaconChainClientMockRecorder) StreamValidators(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamValidators", reflect.TypeOf((*MockBeaconChainClient)(nil).StreamValidators), varargs...)
+}
+
 // SubmitAttesterSlashing mocks base method.
 func (m *MockBeaconChainClient) SubmitAttesterSlashing(arg0 context.Context, arg1 *eth.AttesterSlashing, arg2 ...grpc.CallOption) (*eth.SubmitSlashingResponse, error) {
 	m.ctrl.T.Helper()
@@ -429,7 +490,7 @@ func (m *MockBeaconChainClient) SubmitAttesterSlashing(arg0 context.Context, arg
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SubmitAttesterSlashings", varargs...)
+	ret := m.ctrl.Call(m, "SubmitAttesterSlashing", varargs...)
 	ret0, _ := ret[0].(*eth.SubmitSlashingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
@@ -439,7 +500,7 @@ func (m *MockBeaconChainClient) SubmitAttesterSlashing(arg0 context.Context, arg
 func (mr *MockBeaconChainClientMockRecorder) SubmitAttesterSlashing(arg0, arg1 any, arg2 ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAttesterSlashings", reflect.TypeOf((*MockBeaconChainClient)(nil).SubmitAttesterSlashing), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAttesterSlashing", reflect.TypeOf((*MockBeaconChainClient)(nil).SubmitAttesterSlashing), varargs...)
 }
 
 // SubmitAttesterSlashingElectra mocks base method.
@@ -481,3 +542,372 @@ func (mr *MockBeaconChainClientMockRecorder) SubmitProposerSlashing(arg0, arg1 a
 	varargs := append([]any{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitProposerSlashing", reflect.TypeOf((*MockBeaconChainClient)(nil).SubmitProposerSlashing), varargs...)
 }
+
+// MockBeaconChain_StreamValidatorsClient is a mock of BeaconChain_StreamValidatorsClient interface.
+type MockBeaconChain_StreamValidatorsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChain_StreamValidatorsClientMockRecorder
+}
+
+// MockBeaconChain_StreamValidatorsClientMockRecorder is the mock recorder for MockBeaconChain_StreamValidatorsClient.
+type MockBeaconChain_StreamValidatorsClientMockRecorder struct {
+	mock *MockBeaconChain_StreamValidatorsClient
+}
+
+// NewMockBeaconChain_StreamValidatorsClient creates a new mock instance.
+func NewMockBeaconChain_StreamValidatorsClient(ctrl *gomock.Controller) *MockBeaconChain_StreamValidatorsClient {
+	mock := &MockBeaconChain_StreamValidatorsClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconChain_StreamValidatorsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconChain_StreamValidatorsClient) EXPECT() *MockBeaconChain_StreamValidatorsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBeaconChain_StreamValidatorsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBeaconChain_StreamValidatorsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconChain_StreamValidatorsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBeaconChain_StreamValidatorsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBeaconChain_StreamValidatorsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChain_StreamValidatorsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBeaconChain_StreamValidatorsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBeaconChain_StreamValidatorsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconChain_StreamValidatorsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBeaconChain_StreamValidatorsClient) Recv() (*eth.Validators_ValidatorContainer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*eth.Validators_ValidatorContainer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBeaconChain_StreamValidatorsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconChain_StreamValidatorsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockBeaconChain_StreamValidatorsClient) RecvMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBeaconChain_StreamValidatorsClientMockRecorder) RecvMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChain_StreamValidatorsClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockBeaconChain_StreamValidatorsClient) SendMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBeaconChain_StreamValidatorsClientMockRecorder) SendMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChain_StreamValidatorsClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockBeaconChain_StreamValidatorsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBeaconChain_StreamValidatorsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconChain_StreamValidatorsClient)(nil).Trailer))
+}
+
+// MockBeaconChain_StreamValidatorBalancesClient is a mock of BeaconChain_StreamValidatorBalancesClient interface.
+type MockBeaconChain_StreamValidatorBalancesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChain_StreamValidatorBalancesClientMockRecorder
+}
+
+// MockBeaconChain_StreamValidatorBalancesClientMockRecorder is the mock recorder for MockBeaconChain_StreamValidatorBalancesClient.
+type MockBeaconChain_StreamValidatorBalancesClientMockRecorder struct {
+	mock *MockBeaconChain_StreamValidatorBalancesClient
+}
+
+// NewMockBeaconChain_StreamValidatorBalancesClient creates a new mock instance.
+func NewMockBeaconChain_StreamValidatorBalancesClient(ctrl *gomock.Controller) *MockBeaconChain_StreamValidatorBalancesClient {
+	mock := &MockBeaconChain_StreamValidatorBalancesClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconChain_StreamValidatorBalancesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconChain_StreamValidatorBalancesClient) EXPECT() *MockBeaconChain_StreamValidatorBalancesClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBeaconChain_StreamValidatorBalancesClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBeaconChain_StreamValidatorBalancesClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconChain_StreamValidatorBalancesClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBeaconChain_StreamValidatorBalancesClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBeaconChain_StreamValidatorBalancesClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChain_StreamValidatorBalancesClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBeaconChain_StreamValidatorBalancesClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBeaconChain_StreamValidatorBalancesClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconChain_StreamValidatorBalancesClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBeaconChain_StreamValidatorBalancesClient) Recv() (*eth.ValidatorBalances_Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*eth.ValidatorBalances_Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBeaconChain_StreamValidatorBalancesClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconChain_StreamValidatorBalancesClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockBeaconChain_StreamValidatorBalancesClient) RecvMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBeaconChain_StreamValidatorBalancesClientMockRecorder) RecvMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChain_StreamValidatorBalancesClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockBeaconChain_StreamValidatorBalancesClient) SendMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBeaconChain_StreamValidatorBalancesClientMockRecorder) SendMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChain_StreamValidatorBalancesClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockBeaconChain_StreamValidatorBalancesClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBeaconChain_StreamValidatorBalancesClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconChain_StreamValidatorBalancesClient)(nil).Trailer))
+}
+
+// MockBeaconChain_StreamAttestationsClient is a mock of BeaconChain_StreamAttestationsClient interface.
+type MockBeaconChain_StreamAttestationsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChain_StreamAttestationsClientMockRecorder
+}
+
+// MockBeaconChain_StreamAttestationsClientMockRecorder is the mock recorder for MockBeaconChain_StreamAttestationsClient.
+type MockBeaconChain_StreamAttestationsClientMockRecorder struct {
+	mock *MockBeaconChain_StreamAttestationsClient
+}
+
+// NewMockBeaconChain_StreamAttestationsClient creates a new mock instance.
+func NewMockBeaconChain_StreamAttestationsClient(ctrl *gomock.Controller) *MockBeaconChain_StreamAttestationsClient {
+	mock := &MockBeaconChain_StreamAttestationsClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconChain_StreamAttestationsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconChain_StreamAttestationsClient) EXPECT() *MockBeaconChain_StreamAttestationsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBeaconChain_StreamAttestationsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBeaconChain_StreamAttestationsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconChain_StreamAttestationsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBeaconChain_StreamAttestationsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBeaconChain_StreamAttestationsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChain_StreamAttestationsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBeaconChain_StreamAttestationsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBeaconChain_StreamAttestationsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconChain_StreamAttestationsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBeaconChain_StreamAttestationsClient) Recv() (*eth.Attestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*eth.Attestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBeaconChain_StreamAttestationsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconChain_StreamAttestationsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m *MockBeaconChain_StreamAttestationsClient) RecvMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBeaconChain_StreamAttestationsClientMockRecorder) RecvMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChain_StreamAttestationsClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockBeaconChain_StreamAttestationsClient) SendMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBeaconChain_StreamAttestationsClientMockRecorder) SendMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChain_StreamAttestationsClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method.
+func (m *MockBeaconChain_StreamAttestationsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBeaconChain_StreamAttestationsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconChain_StreamAttestationsClient)(nil).Trailer))
+}
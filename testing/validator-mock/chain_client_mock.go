@@ -41,6 +41,21 @@ func (m *MockChainClient) EXPECT() *MockChainClientMockRecorder {
 	return m.recorder
 }
 
+// AllValidators mocks base method.
+func (m *MockChainClient) AllValidators(arg0 context.Context, arg1 *eth.ListValidatorsRequest) ([]*eth.Validators_ValidatorContainer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllValidators", arg0, arg1)
+	ret0, _ := ret[0].([]*eth.Validators_ValidatorContainer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllValidators indicates an expected call of AllValidators.
+func (mr *MockChainClientMockRecorder) AllValidators(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllValidators", reflect.TypeOf((*MockChainClient)(nil).AllValidators), arg0, arg1)
+}
+
 // ChainHead mocks base method.
 func (m *MockChainClient) ChainHead(arg0 context.Context, arg1 *emptypb.Empty) (*eth.ChainHead, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,70 @@
+/*
+Tool running the engine API proxy between a consensus client and an execution client, applying the rules
+of a scenario file to the engine API requests. Useful to reproduce execution client behaviors, such as
+returning SYNCING for a number of payloads after a given slot, against a live node.
+*/
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	proxy "github.com/prysmaticlabs/prysm/v5/testing/middleware/engine-api-proxy"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	host          = flag.String("host", "127.0.0.1", "host the proxy listens on")
+	port          = flag.Int("port", 8551, "port the proxy listens on")
+	destination   = flag.String("destination", "http://127.0.0.1:8552", "engine API endpoint of the execution client requests are proxied to")
+	jwtSecretPath = flag.String("jwt-secret", "", "path to the hex encoded JWT secret shared with the execution client")
+	scenarioPath  = flag.String("scenario", "", "path to a YAML scenario file of rules applied to engine API requests")
+	beaconNode    = flag.String("beacon-node", "", "beacon API endpoint used to get the current slot, required by rules restricted to slots")
+)
+
+func main() {
+	flag.Parse()
+
+	opts := []proxy.Option{
+		proxy.WithHost(*host),
+		proxy.WithPort(*port),
+		proxy.WithDestinationAddress(*destination),
+	}
+	if *jwtSecretPath != "" {
+		enc, err := file.ReadFileAsBytes(*jwtSecretPath)
+		if err != nil {
+			log.WithError(err).Fatal("Could not read JWT secret")
+		}
+		secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(enc)), "0x"))
+		if err != nil {
+			log.WithError(err).Fatal("Could not decode JWT secret")
+		}
+		opts = append(opts, proxy.WithJwtSecret(string(secret)))
+	}
+	if *scenarioPath != "" {
+		s, err := proxy.LoadScenario(*scenarioPath)
+		if err != nil {
+			log.WithError(err).Fatal("Could not load scenario")
+		}
+		opts = append(opts, proxy.WithScenario(s))
+	}
+	if *beaconNode != "" {
+		opts = append(opts, proxy.WithBeaconNodeAddress(*beaconNode))
+	}
+
+	p, err := proxy.New(opts...)
+	if err != nil {
+		log.WithError(err).Fatal("Could not create proxy")
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	if err := p.Start(ctx); err != nil {
+		log.WithError(err).Fatal("Proxy stopped")
+	}
+}
@@ -28,10 +28,15 @@ const (
 // and export them as a backup.zip file containing the keys as EIP-2335 compliant
 // keystore.json files, which are compatible with importing in other Ethereum consensus clients.
 func (acm *CLIManager) Backup(ctx context.Context) error {
-	keystoresToBackup, err := acm.keymanager.ExtractKeystores(ctx, acm.filteredPubKeys, acm.backupsPassword)
+	keystoresToBackup, err := acm.keymanager.ExtractKeystores(ctx, acm.filteredPubKeys, acm.backupsPassword, acm.backupsKDF)
 	if err != nil {
 		return errors.Wrap(err, "could not extract keys from keymanager")
 	}
+	if namer, ok := acm.keymanager.(keymanager.AccountNamer); ok {
+		for _, pk := range acm.filteredPubKeys {
+			log.WithField("publicKey", fmt.Sprintf("%#x", pk.Marshal())).Infof("Backing up account %s", namer.AccountName(pk.Marshal()))
+		}
+	}
 	return zipKeystoresToOutputDir(keystoresToBackup, acm.backupsDir)
 }
 
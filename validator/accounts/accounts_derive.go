@@ -0,0 +1,93 @@
+package accounts
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+)
+
+// DepositData of a derived account, in the format of the deposit data files of the staking deposit CLI
+// which the launchpad accepts.
+type DepositData struct {
+	PublicKey             string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+	NetworkName           string `json:"network_name"`
+}
+
+// DeriveAccounts derives new accounts from the seed of an HD wallet, following the accounts already in it.
+// Wallets which do not hold their seed yet derive them from the mnemonic, and store the seed.
+func (acm *CLIManager) DeriveAccounts(ctx context.Context) ([]*derived.DerivedAccount, error) {
+	km, ok := acm.keymanager.(*derived.Keymanager)
+	if !ok {
+		return nil, errors.New("only HD wallets support deriving new accounts, import keystores into other wallets instead")
+	}
+	if acm.accountNameTemplate != "" {
+		if err := local.ValidateAccountNameTemplate(acm.accountNameTemplate); err != nil {
+			return nil, err
+		}
+	}
+	var accounts []*derived.DerivedAccount
+	var err error
+	if acm.mnemonic != "" {
+		accounts, err = km.ExtendAccountsFromMnemonic(
+			ctx, acm.mnemonic, acm.mnemonicLanguage, acm.mnemonic25thWord, acm.numAccounts, acm.withdrawalAddress,
+		)
+	} else {
+		accounts, err = km.ExtendAccounts(ctx, acm.numAccounts, acm.withdrawalAddress)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not derive accounts")
+	}
+	pubKeys := make([][]byte, len(accounts))
+	for i, account := range accounts {
+		pubKeys[i] = account.PublicKey
+	}
+	if err := nameNewAccounts(ctx, km, pubKeys, acm.accountNameTemplate); err != nil {
+		return nil, err
+	}
+	log.Infof("Successfully derived %d new accounts. Please use `accounts list` to view details for your accounts", len(accounts))
+	return accounts, nil
+}
+
+// DepositDataJSON encodes the deposit data of derived accounts for the network in use.
+func DepositDataJSON(accounts []*derived.DerivedAccount) ([]byte, error) {
+	cfg := params.BeaconConfig()
+	depositData := make([]*DepositData, len(accounts))
+	for i, account := range accounts {
+		dd := account.DepositData
+		messageRoot, err := (&ethpb.DepositMessage{
+			PublicKey:             dd.PublicKey,
+			WithdrawalCredentials: dd.WithdrawalCredentials,
+			Amount:                dd.Amount,
+		}).HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute deposit message root")
+		}
+		depositData[i] = &DepositData{
+			PublicKey:             hex.EncodeToString(dd.PublicKey),
+			WithdrawalCredentials: hex.EncodeToString(dd.WithdrawalCredentials),
+			Amount:                dd.Amount,
+			Signature:             hex.EncodeToString(dd.Signature),
+			DepositMessageRoot:    hex.EncodeToString(messageRoot[:]),
+			DepositDataRoot:       hex.EncodeToString(account.DepositDataRoot[:]),
+			ForkVersion:           hex.EncodeToString(cfg.GenesisForkVersion),
+			NetworkName:           cfg.ConfigName,
+		}
+	}
+	enc, err := json.MarshalIndent(depositData, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode deposit data")
+	}
+	return enc, nil
+}
@@ -0,0 +1,66 @@
+package accounts
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
+	constant "github.com/prysmaticlabs/prysm/v5/validator/testing"
+)
+
+func TestDeriveAccounts_DepositData(t *testing.T) {
+	walletDir, passwordsDir, passwordFilePath := setupWalletAndPasswordsDir(t)
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:          walletDir,
+		passwordsDir:       passwordsDir,
+		keymanagerKind:     keymanager.Derived,
+		walletPasswordFile: passwordFilePath,
+	})
+	acc, err := NewCLIManager(
+		WithWalletDir(walletDir),
+		WithKeymanagerType(keymanager.Derived),
+		WithWalletPassword("Passwordz0320$"),
+	)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(cliCtx.Context)
+	require.NoError(t, err)
+	km, err := derived.NewKeymanager(cliCtx.Context, &derived.SetupConfig{Wallet: w})
+	require.NoError(t, err)
+	require.NoError(t, km.RecoverAccountsFromMnemonic(cliCtx.Context, constant.TestMnemonic, derived.DefaultMnemonicLanguage, "", 1))
+
+	// The mnemonic is not needed, the seed is stored in the wallet.
+	withdrawalAddress := make([]byte, 20)
+	withdrawalAddress[19] = 0x01
+	acc, err = NewCLIManager(
+		WithWallet(w),
+		WithKeymanager(km),
+		WithNumAccounts(2),
+		WithWithdrawalAddress(withdrawalAddress),
+	)
+	require.NoError(t, err)
+	derivedAccounts, err := acc.DeriveAccounts(cliCtx.Context)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(derivedAccounts))
+	assert.Equal(t, "m/12381/3600/1/0/0", derivedAccounts[0].DerivationPath)
+	assert.Equal(t, "m/12381/3600/2/0/0", derivedAccounts[1].DerivationPath)
+
+	enc, err := DepositDataJSON(derivedAccounts)
+	require.NoError(t, err)
+	var depositData []*DepositData
+	require.NoError(t, json.Unmarshal(enc, &depositData))
+	require.Equal(t, 2, len(depositData))
+	for i, dd := range depositData {
+		assert.Equal(t, hex.EncodeToString(derivedAccounts[i].PublicKey), dd.PublicKey)
+		assert.Equal(t, "010000000000000000000000"+hex.EncodeToString(withdrawalAddress), dd.WithdrawalCredentials)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, dd.Amount)
+		assert.Equal(t, hex.EncodeToString(derivedAccounts[i].DepositData.Signature), dd.Signature)
+		assert.Equal(t, hex.EncodeToString(derivedAccounts[i].DepositDataRoot[:]), dd.DepositDataRoot)
+		assert.Equal(t, hex.EncodeToString(params.BeaconConfig().GenesisForkVersion), dd.ForkVersion)
+		assert.Equal(t, params.BeaconConfig().ConfigName, dd.NetworkName)
+	}
+}
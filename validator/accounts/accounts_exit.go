@@ -1,7 +1,6 @@
 package accounts
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -11,8 +10,10 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -20,6 +21,7 @@ import (
 	beacon_api "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -77,65 +79,110 @@ func (acm *CLIManager) Exit(ctx context.Context) error {
 }
 
 // PerformVoluntaryExit uses gRPC clients to submit a voluntary exit message to a beacon node.
+// Accounts that already exited or cannot exit yet are skipped. All remaining exits are signed before
+// any of them is submitted, and the outcome is reported for each account.
 func PerformVoluntaryExit(
 	ctx context.Context, cfg PerformExitCfg,
 ) (rawExitedKeys [][]byte, formattedExitedKeys []string, err error) {
-	var rawNotExitedKeys [][]byte
 	genesisResponse, err := cfg.NodeClient.Genesis(ctx, &emptypb.Empty{})
 	if err != nil {
-		log.WithError(err).Errorf("voluntary exit failed: %v", err)
+		return nil, nil, errors.Wrap(err, "could not get genesis time")
 	}
+	epoch, err := client.CurrentEpoch(genesisResponse.GenesisTime)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get current epoch")
+	}
+	skipped := skippedExitKeys(ctx, cfg, epoch)
+
+	type signedExit struct {
+		index int
+		sve   *eth.SignedVoluntaryExit
+	}
+	signed := make([]signedExit, 0, len(cfg.RawPubKeys))
 	for i, key := range cfg.RawPubKeys {
-		// When output directory is present, only create the signed exit, but do not propose it.
-		// Otherwise, propose the exit immediately.
-		epoch, err := client.CurrentEpoch(genesisResponse.GenesisTime)
+		if reason, ok := skipped[string(key)]; ok {
+			log.WithField("pubkey", cfg.FormattedPubKeys[i]).Warnf("Skipping voluntary exit: %s", reason)
+			continue
+		}
+		sve, err := client.CreateSignedVoluntaryExit(ctx, cfg.ValidatorClient, cfg.Keymanager.Sign, key, epoch)
 		if err != nil {
-			log.WithError(err).Errorf("voluntary exit failed: %v", err)
+			logExitFailure(err, cfg.FormattedPubKeys[i], "Could not create voluntary exit")
+			continue
 		}
+		signed = append(signed, signedExit{index: i, sve: sve})
+	}
+
+	// When output directory is present, only write the signed exits, but do not propose them.
+	// Otherwise, propose the exits immediately.
+	rawExitedKeys = make([][]byte, 0, len(signed))
+	formattedExitedKeys = make([]string, 0, len(signed))
+	for _, s := range signed {
+		formatted := cfg.FormattedPubKeys[s.index]
 		if len(cfg.OutputDirectory) > 0 {
-			sve, err := client.CreateSignedVoluntaryExit(ctx, cfg.ValidatorClient, cfg.Keymanager.Sign, key, epoch)
-			if err != nil {
-				rawNotExitedKeys = append(rawNotExitedKeys, key)
-				msg := err.Error()
-				if strings.Contains(msg, blocks.ValidatorAlreadyExitedMsg) ||
-					strings.Contains(msg, blocks.ValidatorCannotExitYetMsg) {
-					log.Warningf("Could not create voluntary exit for account %s: %s", cfg.FormattedPubKeys[i], msg)
-				} else {
-					log.WithError(err).Errorf("voluntary exit failed for account %s", cfg.FormattedPubKeys[i])
-				}
-			} else if err := writeSignedVoluntaryExitJSON(sve, cfg.OutputDirectory); err != nil {
+			if err := writeSignedVoluntaryExitJSON(s.sve, cfg.OutputDirectory); err != nil {
 				log.WithError(err).Error("failed to write voluntary exit")
 			}
-		} else if err := client.ProposeExit(ctx, cfg.ValidatorClient, cfg.Keymanager.Sign, key, epoch); err != nil {
-			rawNotExitedKeys = append(rawNotExitedKeys, key)
-
-			msg := err.Error()
-			if strings.Contains(msg, blocks.ValidatorAlreadyExitedMsg) ||
-				strings.Contains(msg, blocks.ValidatorCannotExitYetMsg) {
-				log.Warningf("Could not perform voluntary exit for account %s: %s", cfg.FormattedPubKeys[i], msg)
-			} else {
-				log.WithError(err).Errorf("voluntary exit failed for account %s", cfg.FormattedPubKeys[i])
+		} else {
+			if _, err := cfg.ValidatorClient.ProposeExit(ctx, s.sve); err != nil {
+				logExitFailure(err, formatted, "Could not perform voluntary exit")
+				continue
 			}
+			log.WithFields(logrus.Fields{
+				"pubkey":            formatted,
+				"validatorIndex":    s.sve.Exit.ValidatorIndex,
+				"earliestExitEpoch": helpers.ActivationExitEpoch(epoch),
+			}).Info("Submitted voluntary exit")
 		}
+		rawExitedKeys = append(rawExitedKeys, cfg.RawPubKeys[s.index])
+		formattedExitedKeys = append(formattedExitedKeys, formatted)
 	}
+	log.WithFields(logrus.Fields{
+		"requested": len(cfg.RawPubKeys),
+		"exited":    len(rawExitedKeys),
+		"skipped":   len(skipped),
+		"failed":    len(cfg.RawPubKeys) - len(rawExitedKeys) - len(skipped),
+	}).Info("Voluntary exit summary")
 
-	rawExitedKeys = make([][]byte, 0)
-	formattedExitedKeys = make([]string, 0)
-	for i, key := range cfg.RawPubKeys {
-		found := false
-		for _, notExited := range rawNotExitedKeys {
-			if bytes.Equal(notExited, key) {
-				found = true
-				break
-			}
+	return rawExitedKeys, formattedExitedKeys, nil
+}
+
+// skippedExitKeys returns the reason why an exit cannot be performed, keyed by the public keys of the accounts
+// that already exited, are not active yet or have not been active long enough to exit at the given epoch.
+// If the statuses cannot be fetched, no account is skipped and the beacon node rejects the invalid exits instead.
+func skippedExitKeys(ctx context.Context, cfg PerformExitCfg, epoch primitives.Epoch) map[string]string {
+	skipped := make(map[string]string)
+	resp, err := cfg.ValidatorClient.MultipleValidatorStatus(ctx, &eth.MultipleValidatorStatusRequest{PublicKeys: cfg.RawPubKeys})
+	if err != nil {
+		log.WithError(err).Warn("Could not get validator statuses before performing voluntary exits")
+		return skipped
+	}
+	for i, status := range resp.Statuses {
+		if i >= len(resp.PublicKeys) || status == nil {
+			break
 		}
-		if !found {
-			rawExitedKeys = append(rawExitedKeys, key)
-			formattedExitedKeys = append(formattedExitedKeys, cfg.FormattedPubKeys[i])
+		key := string(resp.PublicKeys[i])
+		switch status.Status {
+		case eth.ValidatorStatus_EXITING, eth.ValidatorStatus_SLASHING, eth.ValidatorStatus_EXITED:
+			skipped[key] = fmt.Sprintf("validator has already exited or is exiting (status %s)", status.Status)
+		case eth.ValidatorStatus_UNKNOWN_STATUS, eth.ValidatorStatus_DEPOSITED, eth.ValidatorStatus_PENDING:
+			skipped[key] = fmt.Sprintf("validator is not active yet (status %s)", status.Status)
+		case eth.ValidatorStatus_ACTIVE:
+			if status.ActivationEpoch+params.BeaconConfig().ShardCommitteePeriod > epoch {
+				skipped[key] = fmt.Sprintf("%s, exits are possible from epoch %d",
+					blocks.ValidatorCannotExitYetMsg, status.ActivationEpoch+params.BeaconConfig().ShardCommitteePeriod)
+			}
 		}
 	}
+	return skipped
+}
 
-	return rawExitedKeys, formattedExitedKeys, nil
+func logExitFailure(err error, formattedPubKey, msg string) {
+	if strings.Contains(err.Error(), blocks.ValidatorAlreadyExitedMsg) ||
+		strings.Contains(err.Error(), blocks.ValidatorCannotExitYetMsg) {
+		log.Warningf("%s for account %s: %s", msg, formattedPubKey, err.Error())
+		return
+	}
+	log.WithError(err).Errorf("voluntary exit failed for account %s", formattedPubKey)
 }
 
 func prepareAllKeys(validatingKeys [][fieldparams.BLSPubkeyLength]byte) (raw [][]byte, formatted []string) {
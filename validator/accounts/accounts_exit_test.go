@@ -1,12 +1,15 @@
 package accounts
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"path"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/build/bazel"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -15,7 +18,9 @@ import (
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
 	"github.com/sirupsen/logrus/hooks/test"
+	"go.uber.org/mock/gomock"
 )
 
 func TestDisplayExitInfo(t *testing.T) {
@@ -73,3 +78,45 @@ func TestWriteSignedVoluntaryExitJSON(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("%d", sve.Exit.ValidatorIndex), svej.Message.ValidatorIndex)
 	require.Equal(t, "0x0102", svej.Signature)
 }
+
+func TestSkippedExitKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := validatormock.NewMockValidatorClient(ctrl)
+
+	keys := [][]byte{{1}, {2}, {3}, {4}}
+	validatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), &eth.MultipleValidatorStatusRequest{PublicKeys: keys}).
+		Return(&eth.MultipleValidatorStatusResponse{
+			PublicKeys: keys,
+			Statuses: []*eth.ValidatorStatusResponse{
+				{Status: eth.ValidatorStatus_ACTIVE},
+				{Status: eth.ValidatorStatus_EXITED},
+				{Status: eth.ValidatorStatus_PENDING},
+				{Status: eth.ValidatorStatus_ACTIVE, ActivationEpoch: 10},
+			},
+		}, nil)
+
+	epoch := params.BeaconConfig().ShardCommitteePeriod + 5
+	skipped := skippedExitKeys(context.Background(), PerformExitCfg{ValidatorClient: validatorClient, RawPubKeys: keys}, epoch)
+	require.Equal(t, 3, len(skipped))
+	_, ok := skipped[string(keys[0])]
+	assert.Equal(t, false, ok)
+	assert.StringContains(t, "already exited", skipped[string(keys[1])])
+	assert.StringContains(t, "not active yet", skipped[string(keys[2])])
+	assert.StringContains(t, blocks.ValidatorCannotExitYetMsg, skipped[string(keys[3])])
+}
+
+func TestSkippedExitKeys_StatusError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := validatormock.NewMockValidatorClient(ctrl)
+	validatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("unavailable"))
+
+	logHook := test.NewGlobal()
+	skipped := skippedExitKeys(context.Background(), PerformExitCfg{ValidatorClient: validatorClient, RawPubKeys: [][]byte{{1}}}, 0)
+	require.Equal(t, 0, len(skipped))
+	assert.LogsContain(t, logHook, "Could not get validator statuses")
+}
@@ -15,16 +15,16 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/prompt"
-	"github.com/prysmaticlabs/prysm/v5/validator/accounts/petnames"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/urfave/cli/v2"
 )
 
 // selectAccounts Ask user to select accounts via an interactive user prompt.
-func selectAccounts(selectionPrompt string, pubKeys [][fieldparams.BLSPubkeyLength]byte) (filteredPubKeys []bls.PublicKey, err error) {
+func selectAccounts(selectionPrompt string, km keymanager.IKeymanager, pubKeys [][fieldparams.BLSPubkeyLength]byte) (filteredPubKeys []bls.PublicKey, err error) {
 	pubKeyStrings := make([]string, len(pubKeys))
 	for i, pk := range pubKeys {
-		name := petnames.DeterministicName(pk[:], "-")
+		name := keymanager.AccountName(km, pk[:])
 		pubKeyStrings[i] = fmt.Sprintf(
 			"%d | %s | %#x", i, au.BrightGreen(name), au.BrightMagenta(bytesutil.Trunc(pk[:])),
 		)
@@ -105,6 +105,7 @@ func selectAccounts(selectionPrompt string, pubKeys [][fieldparams.BLSPubkeyLeng
 func FilterPublicKeysFromUserInput(
 	cliCtx *cli.Context,
 	publicKeysFlag *cli.StringFlag,
+	km keymanager.IKeymanager,
 	validatingPublicKeys [][fieldparams.BLSPubkeyLength]byte,
 	selectionPrompt string,
 ) ([]bls.PublicKey, error) {
@@ -118,7 +119,7 @@ func FilterPublicKeysFromUserInput(
 		}
 		return filterPublicKeys(pubKeyStrings)
 	}
-	return selectAccounts(selectionPrompt, validatingPublicKeys)
+	return selectAccounts(selectionPrompt, km, validatingPublicKeys)
 }
 
 func filterPublicKeys(pubKeyStrings []string) ([]bls.PublicKey, error) {
@@ -145,6 +146,7 @@ func filterPublicKeys(pubKeyStrings []string) ([]bls.PublicKey, error) {
 func FilterExitAccountsFromUserInput(
 	cliCtx *cli.Context,
 	r io.Reader,
+	km keymanager.IKeymanager,
 	validatingPublicKeys [][fieldparams.BLSPubkeyLength]byte,
 	forceExit bool,
 ) (rawPubKeys [][]byte, formattedPubKeys []string, err error) {
@@ -154,6 +156,7 @@ func FilterExitAccountsFromUserInput(
 		filteredPubKeys, err := FilterPublicKeysFromUserInput(
 			cliCtx,
 			flags.VoluntaryExitPublicKeysFlag,
+			km,
 			validatingPublicKeys,
 			userprompt.SelectAccountsVoluntaryExitPromptText,
 		)
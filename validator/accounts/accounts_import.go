@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -19,7 +20,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/io/prompt"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	"github.com/sirupsen/logrus"
 	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"gopkg.in/yaml.v3"
 )
 
 var derivationPathRegex = regexp.MustCompile(`m_12381_3600_(\d+)_(\d+)_(\d+)`)
@@ -70,6 +74,29 @@ type ImportAccountsConfig struct {
 	Keystores       []*keymanager.Keystore
 	Importer        keymanager.Importer
 	AccountPassword string
+	// Passwords optionally holds a password for each keystore, in the same order as Keystores.
+	// Keystores with an empty password are decrypted with AccountPassword.
+	Passwords []string
+}
+
+// keystoreFile is a keystore found in the keys directory, along with the path of the file it was read from.
+type keystoreFile struct {
+	path     string
+	keystore *keymanager.Keystore
+}
+
+// Sources of the password of a keystore to import, reported in place of the password.
+const (
+	passwordSourcePasswordsFile   = "passwords file"
+	passwordSourcePasswordFileDir = "password file directory"
+	passwordSourceAccountFile     = "account password file"
+	passwordSourcePrompt          = "prompt"
+)
+
+// importFailure is a file which could not be imported, along with the reason why.
+type importFailure struct {
+	path   string
+	reason string
 }
 
 // Import can import external, EIP-2335 compliant keystore.json files as
@@ -80,6 +107,11 @@ func (acm *CLIManager) Import(ctx context.Context) error {
 	if !ok {
 		return errors.New("keymanager cannot import keystores")
 	}
+	if acm.accountNameTemplate != "" {
+		if err := local.ValidateAccountNameTemplate(acm.accountNameTemplate); err != nil {
+			return err
+		}
+	}
 	log.Info("importing validator keystores...")
 	// Check if the user wishes to import a one-off, private key directly
 	// as an account into the Prysm validator.
@@ -87,45 +119,118 @@ func (acm *CLIManager) Import(ctx context.Context) error {
 		return importPrivateKeyAsAccount(ctx, acm.wallet, k, acm.privateKeyFile)
 	}
 
-	keystoresImported, err := processDirectory(ctx, acm.keysDir, 0)
+	keystoreFiles, failures, err := findKeystores(acm.keysDir)
 	if err != nil {
 		return errors.Wrap(err, "unable to process directory and import keys")
 	}
 
-	var accountsPassword string
-	if acm.readPasswordFile {
-		data, err := os.ReadFile(acm.passwordFilePath) // #nosec G304
+	var passwordsByKeystore map[string]string
+	if acm.passwordsFilePath != "" {
+		passwordsByKeystore, err = readPasswordsFile(acm.passwordsFilePath)
 		if err != nil {
 			return err
 		}
-		accountsPassword = string(data)
-	} else {
-		accountsPassword, err = prompt.PasswordPrompt(
-			"Enter the password for your imported accounts", prompt.NotEmpty,
-		)
-		if err != nil {
-			return fmt.Errorf("could not read account password: %w", err)
+	}
+	usedPasswords := make(map[string]bool)
+	keystores := make([]*keymanager.Keystore, len(keystoreFiles))
+	passwords := make([]string, len(keystoreFiles))
+	passwordSources := make([]string, len(keystoreFiles))
+	needsAccountsPassword := false
+	for i, kf := range keystoreFiles {
+		keystores[i] = kf.keystore
+		if name, ok := keystorePasswordName(passwordsByKeystore, kf); ok {
+			usedPasswords[name] = true
+			if passwordsByKeystore[name] != "" {
+				passwords[i] = passwordsByKeystore[name]
+				passwordSources[i] = passwordSourcePasswordsFile
+				continue
+			}
+		}
+		if acm.passwordFileDir != "" {
+			passwords[i], err = keystorePasswordFromDir(acm.passwordFileDir, kf)
+			if err != nil {
+				return err
+			}
+		}
+		if passwords[i] != "" {
+			passwordSources[i] = passwordSourcePasswordFileDir
+			continue
+		}
+		needsAccountsPassword = true
+		passwordSources[i] = passwordSourcePrompt
+		if acm.readPasswordFile {
+			passwordSources[i] = passwordSourceAccountFile
+		}
+	}
+	for name := range passwordsByKeystore {
+		if !usedPasswords[name] {
+			log.WithField("keystore", name).Warn("Passwords file has a password for a keystore which is not imported")
+		}
+	}
+
+	var accountsPassword string
+	if needsAccountsPassword {
+		if acm.readPasswordFile {
+			data, err := os.ReadFile(acm.passwordFilePath) // #nosec G304
+			if err != nil {
+				return err
+			}
+			accountsPassword = string(data)
+		} else {
+			accountsPassword, err = prompt.PasswordPrompt(
+				"Enter the password for your imported accounts", prompt.NotEmpty,
+			)
+			if err != nil {
+				return fmt.Errorf("could not read account password: %w", err)
+			}
 		}
 	}
 	fmt.Println("Importing accounts, this may take a while...")
 	statuses, err := ImportAccounts(ctx, &ImportAccountsConfig{
 		Importer:        k,
-		Keystores:       keystoresImported,
+		Keystores:       keystores,
 		AccountPassword: accountsPassword,
+		Passwords:       passwords,
 	})
 	if err != nil {
 		return err
 	}
 	var successfullyImportedAccounts []string
+	var importedPubKeys [][]byte
+	var duplicates []string
 	for i, status := range statuses {
 		switch status.Status {
 		case keymanager.StatusImported:
-			successfullyImportedAccounts = append(successfullyImportedAccounts, keystoresImported[i].Pubkey)
+			successfullyImportedAccounts = append(successfullyImportedAccounts, keystores[i].Pubkey)
+			pubKey, err := hex.DecodeString(strings.TrimPrefix(keystores[i].Pubkey, "0x"))
+			if err != nil {
+				return errors.Wrapf(err, "could not decode public key %s", keystores[i].Pubkey)
+			}
+			importedPubKeys = append(importedPubKeys, pubKey)
 		case keymanager.StatusDuplicate:
-			log.Warnf("Duplicate key %s found in import request, skipped", keystoresImported[i].Pubkey)
+			duplicates = append(duplicates, keystoreFiles[i].path)
+			log.Warnf("Duplicate key %s found in %s, skipped", keystores[i].Pubkey, keystoreFiles[i].path)
 		case keymanager.StatusError:
-			log.Warnf("Could not import keystore for %s: %s", keystoresImported[i].Pubkey, status.Message)
+			failures = append(failures, &importFailure{path: keystoreFiles[i].path, reason: status.Message})
+		}
+	}
+	locator, hasWallets := acm.keymanager.(keymanager.KeyLocator)
+	for i, status := range statuses {
+		fields := logrus.Fields{
+			"path":           keystoreFiles[i].path,
+			"pubkey":         keystores[i].Pubkey,
+			"passwordSource": passwordSources[i],
+			"status":         status.Status,
+		}
+		if pubKey, err := hex.DecodeString(strings.TrimPrefix(keystores[i].Pubkey, "0x")); hasWallets && err == nil {
+			if walletDir, _, ok := locator.LocateKey(ctx, bytesutil.ToBytes48(pubKey)); ok {
+				fields["wallet"] = walletDir
+			}
 		}
+		log.WithFields(fields).Info("Keystore import result")
+	}
+	for _, f := range failures {
+		log.WithField("path", f.path).Warnf("Could not import keystore: %s", f.reason)
 	}
 	if len(successfullyImportedAccounts) == 0 {
 		log.Error("no accounts were successfully imported")
@@ -135,69 +240,175 @@ func (acm *CLIManager) Import(ctx context.Context) error {
 			successfullyImportedAccounts,
 		)
 	}
+	log.WithFields(logrus.Fields{
+		"imported": len(successfullyImportedAccounts),
+		"skipped":  len(duplicates),
+		"failed":   len(failures),
+	}).Info("Finished importing keystores")
+	if err := nameNewAccounts(ctx, acm.keymanager, importedPubKeys, acm.accountNameTemplate); err != nil {
+		return err
+	}
 
 	return nil
 }
 
-// Recursive function to process directories and files.
-func processDirectory(ctx context.Context, dir string, depth int) ([]*keymanager.Keystore, error) {
-	maxdepth := 2
-	if depth > maxdepth {
-		log.Infof("stopped checking folders for keystores after max depth of %d was reached", maxdepth)
-		return nil, nil // Stop recursion after two levels.
-	}
-	log.Infof("checking directory for keystores: %s", dir)
-	isDir, err := file.HasDir(dir)
+// findKeystores returns the EIP-2335 keystores in the directory and all of its subdirectories, or the keystore
+// at the path if it is a file. Files in a directory which are not keystores are skipped, while keystore files
+// which cannot be read are returned as failures rather than aborting the import of the other keystores.
+func findKeystores(path string) ([]*keystoreFile, []*importFailure, error) {
+	isDir, err := file.HasDir(path)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not determine if path is a directory")
+		return nil, nil, errors.Wrap(err, "could not determine if path is a directory")
+	}
+	if !isDir {
+		keystore, err := readKeystoreFile(path)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not import keystore")
+		}
+		return []*keystoreFile{{path: path, keystore: keystore}}, nil, nil
 	}
 
-	keystoresImported := make([]*keymanager.Keystore, 0)
-
-	if isDir {
-		files, err := os.ReadDir(dir)
+	log.Infof("checking directory for keystores: %s", path)
+	keystores := make([]*keystoreFile, 0)
+	var failures []*importFailure
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
 		if err != nil {
-			return nil, errors.Wrap(err, "could not read dir")
+			failures = append(failures, &importFailure{path: p, reason: err.Error()})
+			if d != nil && d.IsDir() && p != path {
+				return filepath.SkipDir
+			}
+			return nil
 		}
-		if len(files) == 0 {
-			return nil, fmt.Errorf("directory %s has no files, cannot import from it", dir)
+		if !d.Type().IsRegular() {
+			return nil
 		}
-		for _, f := range files {
-			fullPath := filepath.Join(dir, f.Name())
-			if f.IsDir() {
-				subKeystores, err := processDirectory(ctx, fullPath, depth+1)
-				if err != nil {
-					return nil, err
-				}
-				keystoresImported = append(keystoresImported, subKeystores...)
-			} else {
-				keystore, err := readKeystoreFile(ctx, fullPath)
-				if err != nil {
-					if strings.Contains(err.Error(), "could not decode keystore json") {
-						continue
-					}
-					return nil, errors.Wrapf(err, "could not import keystore at path: %s", fullPath)
-				}
-				keystoresImported = append(keystoresImported, keystore)
-			}
+		keystoreBytes, err := os.ReadFile(p) // #nosec G304
+		if err != nil {
+			failures = append(failures, &importFailure{path: p, reason: err.Error()})
+			return nil
 		}
-	} else {
-		keystore, err := readKeystoreFile(ctx, dir)
+		if !isEIP2335Keystore(keystoreBytes) {
+			log.Debugf("skipping %s, not an EIP-2335 keystore", p)
+			return nil
+		}
+		keystore, err := decodeKeystore(keystoreBytes)
 		if err != nil {
-			return nil, errors.Wrap(err, "could not import keystore")
+			failures = append(failures, &importFailure{path: p, reason: err.Error()})
+			return nil
 		}
-		keystoresImported = append(keystoresImported, keystore)
+		keystores = append(keystores, &keystoreFile{path: p, keystore: keystore})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not walk directory %s", path)
+	}
+	if len(keystores) == 0 && len(failures) == 0 {
+		return nil, nil, fmt.Errorf("directory %s has no keystores, cannot import from it", path)
 	}
+	return keystores, failures, nil
+}
 
-	return keystoresImported, nil
+// isEIP2335Keystore checks whether the JSON data has the fields of an EIP-2335 keystore, namely
+// a version 4 and a crypto object made of the kdf, checksum and cipher modules.
+func isEIP2335Keystore(data []byte) bool {
+	var keystore struct {
+		Crypto  map[string]json.RawMessage `json:"crypto"`
+		Version uint                       `json:"version"`
+	}
+	if err := json.Unmarshal(data, &keystore); err != nil {
+		return false
+	}
+	if keystore.Version != 4 {
+		return false
+	}
+	for _, module := range []string{"kdf", "checksum", "cipher"} {
+		if _, ok := keystore.Crypto[module]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// keystorePasswordFromDir reads the password of the keystore from the password directory, looking for
+// a file named after the public key of the keystore, or after the keystore file, with a .txt extension.
+// An empty password is returned if there is no such file. Trailing newlines are trimmed from the password.
+func keystorePasswordFromDir(dir string, kf *keystoreFile) (string, error) {
+	pubKey := strings.TrimPrefix(strings.ToLower(kf.keystore.Pubkey), "0x")
+	keystoreName := strings.TrimSuffix(filepath.Base(kf.path), filepath.Ext(kf.path))
+	for _, name := range []string{pubKey, "0x" + pubKey, keystoreName} {
+		passwordFilePath := filepath.Join(dir, name+".txt")
+		exists, err := file.Exists(passwordFilePath, file.Regular)
+		if err != nil {
+			return "", errors.Wrapf(err, "could not check if file exists: %s", passwordFilePath)
+		}
+		if !exists {
+			continue
+		}
+		data, err := os.ReadFile(passwordFilePath) // #nosec G304
+		if err != nil {
+			return "", errors.Wrapf(err, "could not read password file %s", passwordFilePath)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+	return "", nil
+}
+
+// readPasswordsFile reads the passwords of the keystores to import from a JSON or YAML file, which maps the public key
+// or the file name of each keystore to its password.
+func readPasswordsFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read passwords file %s", path)
+	}
+	var passwords map[string]string
+	// YAML is a superset of JSON, so both are decoded the same way.
+	if err := yaml.Unmarshal(data, &passwords); err != nil {
+		// The error may quote the content of the file, which holds passwords.
+		return nil, fmt.Errorf("could not parse passwords file %s, it must map keystore file names or public keys to passwords", path)
+	}
+	return passwords, nil
+}
+
+// keystorePasswordName returns the entry of the passwords file for the keystore, which is named after the public key
+// of the keystore, with or without the 0x prefix, or after the keystore file, with or without its extension.
+func keystorePasswordName(passwords map[string]string, kf *keystoreFile) (string, bool) {
+	if len(passwords) == 0 {
+		return "", false
+	}
+	pubKey := strings.TrimPrefix(strings.ToLower(kf.keystore.Pubkey), "0x")
+	fileName := filepath.Base(kf.path)
+	keystoreName := strings.TrimSuffix(fileName, filepath.Ext(fileName))
+	for name := range passwords {
+		if strings.TrimPrefix(strings.ToLower(name), "0x") == pubKey && pubKey != "" {
+			return name, true
+		}
+	}
+	for _, name := range []string{fileName, keystoreName} {
+		if _, ok := passwords[name]; ok {
+			return name, true
+		}
+	}
+	return "", false
 }
 
 // ImportAccounts can import external, EIP-2335 compliant keystore.json files as
 // new accounts into the Prysm validator wallet.
 func ImportAccounts(ctx context.Context, cfg *ImportAccountsConfig) ([]*keymanager.KeyStatus, error) {
-	if cfg.AccountPassword == "" {
-		statuses := make([]*keymanager.KeyStatus, len(cfg.Keystores))
-		for i, keystore := range cfg.Keystores {
+	if cfg.Passwords != nil && len(cfg.Passwords) != len(cfg.Keystores) {
+		return nil, fmt.Errorf("%d passwords provided for %d keystores", len(cfg.Passwords), len(cfg.Keystores))
+	}
+	statuses := make([]*keymanager.KeyStatus, len(cfg.Keystores))
+	// Keystores without a password are not sent to the importer, the others are imported
+	// and their statuses are put back at the index of the keystore.
+	var keystores []*keymanager.Keystore
+	var passwords []string
+	var indices []int
+	for i, keystore := range cfg.Keystores {
+		password := cfg.AccountPassword
+		if cfg.Passwords != nil && cfg.Passwords[i] != "" {
+			password = cfg.Passwords[i]
+		}
+		if password == "" {
 			statuses[i] = &keymanager.KeyStatus{
 				Status: keymanager.StatusError,
 				Message: fmt.Sprintf(
@@ -205,18 +416,30 @@ func ImportAccounts(ctx context.Context, cfg *ImportAccountsConfig) ([]*keymanag
 					keystore.Pubkey,
 				),
 			}
+			continue
 		}
-		return statuses, nil
+		keystores = append(keystores, keystore)
+		passwords = append(passwords, password)
+		indices = append(indices, i)
 	}
-	passwords := make([]string, len(cfg.Keystores))
-	for i := 0; i < len(cfg.Keystores); i++ {
-		passwords[i] = cfg.AccountPassword
+	if len(keystores) == 0 {
+		return statuses, nil
 	}
-	return cfg.Importer.ImportKeystores(
+	importedStatuses, err := cfg.Importer.ImportKeystores(
 		ctx,
-		cfg.Keystores,
+		keystores,
 		passwords,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if len(importedStatuses) != len(keystores) {
+		return nil, fmt.Errorf("got %d statuses for %d imported keystores", len(importedStatuses), len(keystores))
+	}
+	for i, status := range importedStatuses {
+		statuses[indices[i]] = status
+	}
+	return statuses, nil
 }
 
 // Imports a one-off file containing a private key as a hex string into
@@ -286,11 +509,15 @@ func importPrivateKeyAsAccount(ctx context.Context, wallet *wallet.Wallet, impor
 	return nil
 }
 
-func readKeystoreFile(_ context.Context, keystoreFilePath string) (*keymanager.Keystore, error) {
+func readKeystoreFile(keystoreFilePath string) (*keymanager.Keystore, error) {
 	keystoreBytes, err := os.ReadFile(keystoreFilePath) // #nosec G304
 	if err != nil {
 		return nil, errors.Wrap(err, "could not read keystore file")
 	}
+	return decodeKeystore(keystoreBytes)
+}
+
+func decodeKeystore(keystoreBytes []byte) (*keymanager.Keystore, error) {
 	keystoreFile := &keymanager.Keystore{}
 	if err := json.Unmarshal(keystoreBytes, keystoreFile); err != nil {
 		return nil, errors.Wrap(err, "could not decode keystore json")
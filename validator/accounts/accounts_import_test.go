@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -17,10 +18,10 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
 func TestImportAccounts_NoPassword(t *testing.T) {
-	local.ResetCaches()
 	walletDir, passwordsDir, passwordFilePath := setupWalletAndPasswordsDir(t)
 	keysDir := filepath.Join(t.TempDir(), "keysDir")
 	require.NoError(t, os.MkdirAll(keysDir, os.ModePerm))
@@ -56,8 +57,247 @@ func TestImportAccounts_NoPassword(t *testing.T) {
 	require.Equal(t, resp[0].Status, keymanager.StatusError)
 }
 
+func TestImportAccounts_Passwords(t *testing.T) {
+	walletDir, passwordsDir, passwordFilePath := setupWalletAndPasswordsDir(t)
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:          walletDir,
+		passwordsDir:       passwordsDir,
+		keymanagerKind:     keymanager.Local,
+		walletPasswordFile: passwordFilePath,
+	})
+	acc, err := NewCLIManager(
+		WithWalletDir(walletDir),
+		WithKeymanagerType(keymanager.Local),
+		WithWalletPassword(password),
+	)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(cliCtx.Context)
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	importer, ok := km.(keymanager.Importer)
+	require.Equal(t, true, ok)
+
+	resp, err := ImportAccounts(context.Background(), &ImportAccountsConfig{
+		Keystores: []*keymanager.Keystore{
+			createRandomKeystore(t, "common"),
+			createRandomKeystore(t, "individual"),
+			createRandomKeystore(t, "other"),
+		},
+		Importer:        importer,
+		AccountPassword: "common",
+		Passwords:       []string{"", "individual", "wrong"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(resp))
+	assert.Equal(t, keymanager.StatusImported, resp[0].Status)
+	assert.Equal(t, keymanager.StatusImported, resp[1].Status)
+	assert.Equal(t, keymanager.StatusError, resp[2].Status)
+
+	_, err = ImportAccounts(context.Background(), &ImportAccountsConfig{
+		Keystores:       []*keymanager.Keystore{createRandomKeystore(t, "common")},
+		Importer:        importer,
+		AccountPassword: "common",
+		Passwords:       []string{"", ""},
+	})
+	require.ErrorContains(t, "2 passwords provided for 1 keystores", err)
+}
+
+func TestImport_PasswordFileDir(t *testing.T) {
+	walletDir, passwordsDir, passwordFilePath := setupWalletAndPasswordsDir(t)
+	keysDir := filepath.Join(t.TempDir(), "keysDir")
+	nestedDir := filepath.Join(keysDir, "a", "b", "c")
+	require.NoError(t, os.MkdirAll(nestedDir, os.ModePerm))
+	passwordFileDir := filepath.Join(t.TempDir(), "passwordFileDir")
+	require.NoError(t, os.MkdirAll(passwordFileDir, os.ModePerm))
+
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:          walletDir,
+		passwordsDir:       passwordsDir,
+		keymanagerKind:     keymanager.Local,
+		walletPasswordFile: passwordFilePath,
+	})
+	acc, err := NewCLIManager(
+		WithWalletDir(walletDir),
+		WithKeymanagerType(keymanager.Local),
+		WithWalletPassword(password),
+	)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(cliCtx.Context)
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+
+	// The password of the first keystore is found by public key, the one of the second keystore by file name,
+	// the third keystore uses the common password and the fourth one has the wrong password.
+	byPubKey := createRandomKeystore(t, "byPubKey")
+	writeKeystore(t, filepath.Join(keysDir, "keystore-0.json"), byPubKey)
+	require.NoError(t, os.WriteFile(filepath.Join(passwordFileDir, byPubKey.Pubkey+".txt"), []byte("byPubKey\n"), os.ModePerm))
+	byFileName := createRandomKeystore(t, "byFileName")
+	writeKeystore(t, filepath.Join(nestedDir, "keystore-1.json"), byFileName)
+	require.NoError(t, os.WriteFile(filepath.Join(passwordFileDir, "keystore-1.txt"), []byte("byFileName"), os.ModePerm))
+	common := createRandomKeystore(t, password)
+	writeKeystore(t, filepath.Join(nestedDir, "keystore-2.json"), common)
+	wrongPassword := createRandomKeystore(t, "wrongPassword")
+	writeKeystore(t, filepath.Join(keysDir, "a", "keystore-3.json"), wrongPassword)
+	// Files which are not keystores are skipped.
+	require.NoError(t, os.WriteFile(filepath.Join(keysDir, "deposit_data.json"), []byte(`[{"pubkey":"aa"}]`), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(nestedDir, "config.json"), []byte(`{"version":4}`), os.ModePerm))
+
+	acc, err = NewCLIManager(
+		WithWallet(w),
+		WithKeymanager(km),
+		WithKeysDir(keysDir),
+		WithReadPasswordFile(true),
+		WithPasswordFilePath(passwordFilePath),
+		WithPasswordFileDir(passwordFileDir),
+	)
+	require.NoError(t, err)
+	require.NoError(t, acc.Import(cliCtx.Context))
+
+	keys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	imported := make(map[string]bool)
+	for _, k := range keys {
+		imported[fmt.Sprintf("%x", k)] = true
+	}
+	assert.Equal(t, 3, len(imported))
+	assert.Equal(t, true, imported[byPubKey.Pubkey])
+	assert.Equal(t, true, imported[byFileName.Pubkey])
+	assert.Equal(t, true, imported[common.Pubkey])
+	assert.Equal(t, false, imported[wrongPassword.Pubkey])
+}
+
+func TestImport_PasswordsFile(t *testing.T) {
+	hook := logTest.NewGlobal()
+	walletDir, passwordsDir, passwordFilePath := setupWalletAndPasswordsDir(t)
+	keysDir := filepath.Join(t.TempDir(), "keysDir")
+	require.NoError(t, os.MkdirAll(keysDir, os.ModePerm))
+	passwordFileDir := filepath.Join(t.TempDir(), "passwordFileDir")
+	require.NoError(t, os.MkdirAll(passwordFileDir, os.ModePerm))
+
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:          walletDir,
+		passwordsDir:       passwordsDir,
+		keymanagerKind:     keymanager.Local,
+		walletPasswordFile: passwordFilePath,
+	})
+	acc, err := NewCLIManager(
+		WithWalletDir(walletDir),
+		WithKeymanagerType(keymanager.Local),
+		WithWalletPassword(password),
+	)
+	require.NoError(t, err)
+	w, err := acc.WalletCreate(cliCtx.Context)
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(cliCtx.Context, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+
+	// The passwords file has the password of the first keystore by public key and the one of the second keystore by
+	// file name, the third keystore uses the password directory and the fourth one the common password.
+	byPubKey := createRandomKeystore(t, "passwordByPubKey")
+	writeKeystore(t, filepath.Join(keysDir, "keystore-0.json"), byPubKey)
+	byFileName := createRandomKeystore(t, "passwordByFileName")
+	writeKeystore(t, filepath.Join(keysDir, "keystore-1.json"), byFileName)
+	inDir := createRandomKeystore(t, "passwordInDir")
+	writeKeystore(t, filepath.Join(keysDir, "keystore-2.json"), inDir)
+	require.NoError(t, os.WriteFile(filepath.Join(passwordFileDir, "keystore-2.txt"), []byte("passwordInDir"), os.ModePerm))
+	common := createRandomKeystore(t, password)
+	writeKeystore(t, filepath.Join(keysDir, "keystore-3.json"), common)
+	passwordsFile := filepath.Join(t.TempDir(), "passwords.yaml")
+	passwords := fmt.Sprintf("0x%s: passwordByPubKey\nkeystore-1.json: passwordByFileName\nkeystore-9.json: unused\n", byPubKey.Pubkey)
+	require.NoError(t, os.WriteFile(passwordsFile, []byte(passwords), os.ModePerm))
+
+	acc, err = NewCLIManager(
+		WithWallet(w),
+		WithKeymanager(km),
+		WithKeysDir(keysDir),
+		WithReadPasswordFile(true),
+		WithPasswordFilePath(passwordFilePath),
+		WithPasswordFileDir(passwordFileDir),
+		WithPasswordsFilePath(passwordsFile),
+	)
+	require.NoError(t, err)
+	require.NoError(t, acc.Import(cliCtx.Context))
+
+	keys, err := km.FetchValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(keys))
+	sources := make(map[string]string)
+	for _, e := range hook.AllEntries() {
+		if e.Message == "Keystore import result" {
+			sources[fmt.Sprintf("%v", e.Data["pubkey"])] = fmt.Sprintf("%v", e.Data["passwordSource"])
+		}
+	}
+	assert.Equal(t, passwordSourcePasswordsFile, sources[byPubKey.Pubkey])
+	assert.Equal(t, passwordSourcePasswordsFile, sources[byFileName.Pubkey])
+	assert.Equal(t, passwordSourcePasswordFileDir, sources[inDir.Pubkey])
+	assert.Equal(t, passwordSourceAccountFile, sources[common.Pubkey])
+	require.LogsContain(t, hook, "keystore-9.json")
+	for _, p := range []string{"passwordByPubKey", "passwordByFileName", "passwordInDir", password} {
+		require.LogsDoNotContain(t, hook, p)
+	}
+}
+
+func TestReadPasswordsFile(t *testing.T) {
+	dir := t.TempDir()
+	jsonFile := filepath.Join(dir, "passwords.json")
+	require.NoError(t, os.WriteFile(jsonFile, []byte(`{"keystore-0.json": "secret", "0xabcd": "other secret"}`), os.ModePerm))
+	passwords, err := readPasswordsFile(jsonFile)
+	require.NoError(t, err)
+	require.DeepEqual(t, map[string]string{"keystore-0.json": "secret", "0xabcd": "other secret"}, passwords)
+
+	invalidFile := filepath.Join(dir, "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("- secret"), os.ModePerm))
+	_, err = readPasswordsFile(invalidFile)
+	require.ErrorContains(t, "could not parse passwords file", err)
+	assert.Equal(t, false, strings.Contains(err.Error(), "secret"))
+}
+
+func TestFindKeystores(t *testing.T) {
+	keysDir := t.TempDir()
+	nestedDir := filepath.Join(keysDir, "a", "b", "c", "d")
+	require.NoError(t, os.MkdirAll(nestedDir, os.ModePerm))
+
+	writeKeystore(t, filepath.Join(keysDir, "keystore-0.json"), createRandomKeystore(t, password))
+	writeKeystore(t, filepath.Join(nestedDir, "keystore-1"), createRandomKeystore(t, password))
+	noPubKey := createRandomKeystore(t, password)
+	noPubKey.Pubkey = ""
+	writeKeystore(t, filepath.Join(keysDir, "a", "keystore-2.json"), noPubKey)
+	require.NoError(t, os.WriteFile(filepath.Join(keysDir, "deposit_data.json"), []byte(`[{"pubkey":"aa"}]`), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(nestedDir, "password.txt"), []byte(password), os.ModePerm))
+
+	keystores, failures, err := findKeystores(keysDir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keystores))
+	assert.Equal(t, filepath.Join(nestedDir, "keystore-1"), keystores[0].path)
+	assert.Equal(t, filepath.Join(keysDir, "keystore-0.json"), keystores[1].path)
+	require.Equal(t, 1, len(failures))
+	assert.Equal(t, filepath.Join(keysDir, "a", "keystore-2.json"), failures[0].path)
+
+	_, _, err = findKeystores(filepath.Join(keysDir, "a", "b"))
+	require.NoError(t, err)
+	_, _, err = findKeystores(t.TempDir())
+	require.ErrorContains(t, "has no keystores", err)
+}
+
+func Test_isEIP2335Keystore(t *testing.T) {
+	encoded, err := json.Marshal(createRandomKeystore(t, password))
+	require.NoError(t, err)
+	assert.Equal(t, true, isEIP2335Keystore(encoded))
+	assert.Equal(t, false, isEIP2335Keystore([]byte(`[{"pubkey":"aa"}]`)))
+	assert.Equal(t, false, isEIP2335Keystore([]byte(`{"version":4,"crypto":{"kdf":{}}}`)))
+	assert.Equal(t, false, isEIP2335Keystore([]byte(`{"version":3,"crypto":{"kdf":{},"checksum":{},"cipher":{}}}`)))
+	assert.Equal(t, false, isEIP2335Keystore([]byte(`not json`)))
+}
+
+func writeKeystore(t *testing.T, path string, keystore *keymanager.Keystore) {
+	encoded, err := json.MarshalIndent(keystore, "", "\t")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, encoded, os.ModePerm))
+}
+
 func TestImport_SortByDerivationPath(t *testing.T) {
-	local.ResetCaches()
 	type test struct {
 		name  string
 		input []string
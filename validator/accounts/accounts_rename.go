@@ -0,0 +1,57 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	"github.com/sirupsen/logrus"
+)
+
+// Rename gives the account selected by the user a new name.
+func (acm *CLIManager) Rename(ctx context.Context) error {
+	namer, ok := acm.keymanager.(keymanager.AccountNamer)
+	if !ok {
+		return errors.New("keymanager does not support naming accounts")
+	}
+	if len(acm.filteredPubKeys) != 1 {
+		return fmt.Errorf("exactly one account must be selected to rename, got %d", len(acm.filteredPubKeys))
+	}
+	pubKey := acm.filteredPubKeys[0].Marshal()
+	oldName := namer.AccountName(pubKey)
+	if err := namer.RenameAccount(ctx, pubKey, acm.newAccountName); err != nil {
+		return errors.Wrap(err, "could not rename account")
+	}
+	log.WithFields(logrus.Fields{
+		"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(pubKey)),
+		"oldName":   oldName,
+		"newName":   acm.newAccountName,
+	}).Info("Renamed account")
+	return nil
+}
+
+// nameNewAccounts names newly created or imported accounts using the template, unless it is the default
+// template, in which case the accounts keep their petnames without anything being written to disk.
+func nameNewAccounts(ctx context.Context, km keymanager.IKeymanager, pubKeys [][]byte, template string) error {
+	if template == "" || template == local.DefaultAccountNameTemplate || len(pubKeys) == 0 {
+		return nil
+	}
+	namer, ok := km.(keymanager.AccountNamer)
+	if !ok {
+		return errors.New("keymanager does not support naming accounts")
+	}
+	names, err := namer.NameAccounts(ctx, pubKeys, template)
+	if err != nil {
+		return errors.Wrap(err, "could not name accounts")
+	}
+	for i, name := range names {
+		log.WithFields(logrus.Fields{
+			"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(pubKeys[i])),
+			"name":      name,
+		}).Info("Named account")
+	}
+	return nil
+}
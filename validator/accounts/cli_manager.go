@@ -53,10 +53,13 @@ type CLIManager struct {
 	walletKeyCount       int
 	privateKeyFile       string
 	passwordFilePath     string
+	passwordFileDir      string
+	passwordsFilePath    string
 	keysDir              string
 	mnemonicLanguage     string
 	backupsDir           string
 	backupsPassword      string
+	backupsKDF           keymanager.KDFConfig
 	filteredPubKeys      []bls.PublicKey
 	rawPubKeys           [][]byte
 	formattedPubKeys     []string
@@ -69,6 +72,9 @@ type CLIManager struct {
 	beaconApiEndpoint    string
 	beaconApiTimeout     time.Duration
 	inputReader          io.Reader
+	accountNameTemplate  string
+	newAccountName       string
+	withdrawalAddress    []byte
 }
 
 func (acm *CLIManager) prepareBeaconClients(ctx context.Context) (*iface.ValidatorClient, *iface.NodeClient, error) {
@@ -158,6 +158,22 @@ func WithPasswordFilePath(passwordFilePath string) Option {
 	}
 }
 
+// WithPasswordFileDir specifies the directory the passwords of individual keystores are read from.
+func WithPasswordFileDir(passwordFileDir string) Option {
+	return func(acc *CLIManager) error {
+		acc.passwordFileDir = passwordFileDir
+		return nil
+	}
+}
+
+// WithPasswordsFilePath specifies the JSON or YAML file the passwords of individual keystores are read from.
+func WithPasswordsFilePath(passwordsFilePath string) Option {
+	return func(acc *CLIManager) error {
+		acc.passwordsFilePath = passwordsFilePath
+		return nil
+	}
+}
+
 // WithBackupsDir specifies the directory backups are written to.
 func WithBackupsDir(backupsDir string) Option {
 	return func(acc *CLIManager) error {
@@ -174,6 +190,14 @@ func WithBackupsPassword(backupsPassword string) Option {
 	}
 }
 
+// WithBackupsKDF specifies the key derivation function backups are encrypted with.
+func WithBackupsKDF(kdf keymanager.KDFConfig) Option {
+	return func(acc *CLIManager) error {
+		acc.backupsKDF = kdf
+		return nil
+	}
+}
+
 // WithFilteredPubKeys adds public key strings parsed from CLI.
 func WithFilteredPubKeys(filteredPubKeys []bls.PublicKey) Option {
 	return func(acc *CLIManager) error {
@@ -252,3 +276,27 @@ func WithCustomReader(reader io.Reader) Option {
 		return nil
 	}
 }
+
+// WithAccountNameTemplate specifies the template used to name new accounts.
+func WithAccountNameTemplate(template string) Option {
+	return func(acc *CLIManager) error {
+		acc.accountNameTemplate = template
+		return nil
+	}
+}
+
+// WithNewAccountName specifies the name to give to the account being renamed.
+func WithNewAccountName(name string) Option {
+	return func(acc *CLIManager) error {
+		acc.newAccountName = name
+		return nil
+	}
+}
+
+// WithWithdrawalAddress specifies the execution address the deposit data of new accounts withdraws to.
+func WithWithdrawalAddress(address []byte) Option {
+	return func(acc *CLIManager) error {
+		acc.withdrawalAddress = address
+		return nil
+	}
+}
@@ -15,6 +15,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
 	iface2 "github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 )
 
@@ -104,6 +105,7 @@ func (_ *Wallet) InitializeKeymanager(_ context.Context, _ iface.InitKeymanagerC
 
 type Validator struct {
 	Km               keymanager.IKeymanager
+	DutyRecords      map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord
 	graffiti         string
 	proposerSettings *proposer.Settings
 }
@@ -142,6 +144,18 @@ func (_ *Validator) LogValidatorGainsAndLosses(_ context.Context, _ primitives.S
 	panic("implement me")
 }
 
+func (_ *Validator) LogEstimatedRewards(_ context.Context, _ primitives.Slot) error {
+	panic("implement me")
+}
+
+func (m *Validator) DutyHistory(_ uint64) map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord {
+	return m.DutyRecords
+}
+
+func (_ *Validator) SaveDutyHistory(_ context.Context, _ primitives.Slot) error {
+	panic("implement me")
+}
+
 func (_ *Validator) UpdateDuties(_ context.Context, _ primitives.Slot) error {
 	panic("implement me")
 }
@@ -234,6 +248,14 @@ func (m *Validator) Graffiti(_ context.Context, _ [fieldparams.BLSPubkeyLength]b
 	return []byte(m.graffiti), nil
 }
 
+// GraffitiWithSource for mocking
+func (m *Validator) GraffitiWithSource(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte) ([]byte, iface2.GraffitiSource, error) {
+	if m.graffiti == "" {
+		return []byte{}, iface2.GraffitiSourceNone, nil
+	}
+	return []byte(m.graffiti), iface2.GraffitiSourceProposerSettings, nil
+}
+
 // SetGraffiti for mocking
 func (m *Validator) SetGraffiti(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte, graffiti []byte) error {
 	m.graffiti = string(graffiti)
@@ -269,3 +291,7 @@ func (*Validator) Host() string {
 func (*Validator) ChangeHost() {
 	panic("implement me")
 }
+
+func (*Validator) CheckBeaconNodeChain(_ context.Context) error {
+	panic("implement me")
+}
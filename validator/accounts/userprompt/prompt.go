@@ -23,6 +23,8 @@ const (
 	SelectAccountsDeletePromptText = "Select the account(s) you would like to delete"
 	// SelectAccountsBackupPromptText --
 	SelectAccountsBackupPromptText = "Select the account(s) you wish to backup"
+	// SelectAccountsRenamePromptText --
+	SelectAccountsRenamePromptText = "Select the account you would like to rename"
 	// SelectAccountsVoluntaryExitPromptText --
 	SelectAccountsVoluntaryExitPromptText = "Select the account(s) on which you wish to perform a voluntary exit"
 )
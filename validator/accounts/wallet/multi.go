@@ -0,0 +1,197 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/io/keyring"
+	"github.com/prysmaticlabs/prysm/v5/io/prompt"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/composite"
+	"github.com/urfave/cli/v2"
+)
+
+// Dirs returns the wallet directories of a --wallet-dir value, which lists them separated by commas.
+func Dirs(walletDir string) []string {
+	var dirs []string
+	for _, dir := range strings.Split(walletDir, ",") {
+		if dir = strings.TrimSpace(dir); dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// Wallets returns the wallets merged in the wallet when it was opened from several directories, or the wallet itself.
+func (w *Wallet) Wallets() []*Wallet {
+	if len(w.wallets) == 0 {
+		return []*Wallet{w}
+	}
+	return w.wallets
+}
+
+// openWallets opens the wallets of several directories as a single wallet, whose keymanager merges the keys of all of
+// them. The first wallet is the one accounts are imported in, and its directory is the directory of the wallet. All the
+// wallets must be of the same keymanager kind.
+func openWallets(cliCtx *cli.Context, dirs []string) (*Wallet, error) {
+	wallets := make([]*Wallet, len(dirs))
+	expandedDirs := make([]string, len(dirs))
+	seen := make(map[string]bool, len(dirs))
+	for i, dir := range dirs {
+		expanded, err := file.ExpandPath(dir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not expand wallet directory %s", dir)
+		}
+		if seen[expanded] {
+			return nil, fmt.Errorf("wallet directory %s is listed more than once", dir)
+		}
+		seen[expanded] = true
+		expandedDirs[i] = expanded
+		w, err := OpenWallet(cliCtx.Context, &Config{WalletDir: expanded})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not open wallet %s", dir)
+		}
+		if i > 0 && w.KeymanagerKind() != wallets[0].KeymanagerKind() {
+			return nil, fmt.Errorf(
+				"wallet %s is a %s wallet while wallet %s is a %s wallet, all wallets must be of the same kind",
+				dir, w.KeymanagerKind(), wallets[0].Dir(), wallets[0].KeymanagerKind(),
+			)
+		}
+		wallets[i] = w
+	}
+	passwords, err := inputExistingWalletPasswords(cliCtx, expandedDirs)
+	if err != nil {
+		return nil, err
+	}
+	for i, w := range wallets {
+		w.walletPassword = passwords[i]
+	}
+	primary := wallets[0]
+	return &Wallet{
+		walletDir:      primary.walletDir,
+		accountsPath:   primary.accountsPath,
+		keymanagerKind: primary.keymanagerKind,
+		walletPassword: primary.walletPassword,
+		wallets:        wallets,
+	}, nil
+}
+
+// initializeCompositeKeymanager initializes the keymanager of each merged wallet, and merges them.
+func (w *Wallet) initializeCompositeKeymanager(ctx context.Context, cfg iface.InitKeymanagerConfig) (keymanager.IKeymanager, error) {
+	members := make([]*composite.Member, len(w.wallets))
+	for i, member := range w.wallets {
+		km, err := member.InitializeKeymanager(ctx, cfg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not initialize keymanager of wallet %s", member.Dir())
+		}
+		members[i] = &composite.Member{
+			WalletDir:   member.Dir(),
+			AccountsDir: member.AccountsDir(),
+			Keymanager:  km,
+		}
+	}
+	km, err := composite.NewKeymanager(ctx, members, cfg.ListenForChanges)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not merge wallet keymanagers")
+	}
+	return km, nil
+}
+
+// inputExistingWalletPasswords returns the password of each wallet, in the order of the wallet directories. A password
+// is read from the OS keyring when enabled, then from the wallet password file, and is prompted for otherwise. The
+// wallet password file either holds one password per line, in the order of the wallet directories, or a single
+// password shared by all the wallets. It may also be a directory holding a <wallet directory name>.txt password file
+// for each wallet.
+func inputExistingWalletPasswords(cliCtx *cli.Context, dirs []string) ([]string, error) {
+	passwords := make([]string, len(dirs))
+	if cliCtx.IsSet(flags.WalletPasswordFileFlag.Name) {
+		var err error
+		passwords, err = readWalletPasswordsFile(cliCtx.String(flags.WalletPasswordFileFlag.Name), dirs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i, dir := range dirs {
+		if cliCtx.Bool(flags.WalletPasswordKeyringFlag.Name) {
+			password, err := keyring.WalletPassword(dir)
+			if err == nil {
+				err = ValidateExistingPass(password)
+			}
+			if err == nil {
+				log.WithField("wallet", dir).Info("Read wallet password from the OS keyring")
+				passwords[i] = password
+				continue
+			}
+			log.WithError(err).WithField("wallet", dir).Warn(
+				"Could not read wallet password from the OS keyring, falling back to the wallet password file or prompt",
+			)
+		}
+		if passwords[i] != "" {
+			continue
+		}
+		password, err := prompt.PasswordPrompt(fmt.Sprintf("%s for %s", PasswordPromptText, dir), ValidateExistingPass)
+		if err != nil {
+			return nil, fmt.Errorf("could not read password of wallet %s: %w", dir, err)
+		}
+		passwords[i] = password
+	}
+	return passwords, nil
+}
+
+// readWalletPasswordsFile reads the password of each wallet from the wallet password file, or from the password files
+// named after the wallet directories when it is a directory.
+func readWalletPasswordsFile(path string, dirs []string) ([]string, error) {
+	passwords := make([]string, len(dirs))
+	isDir, err := file.HasDir(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check if %s is a directory", path)
+	}
+	if isDir {
+		names := make(map[string]string, len(dirs))
+		for i, dir := range dirs {
+			name := filepath.Base(dir) + ".txt"
+			if other, ok := names[name]; ok {
+				return nil, fmt.Errorf("wallets %s and %s have the same password file name %s", other, dir, name)
+			}
+			names[name] = dir
+			data, err := file.ReadFileAsBytes(filepath.Join(path, name))
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not read password file of wallet %s", dir)
+			}
+			passwords[i] = strings.TrimRight(string(data), "\r\n")
+		}
+	} else {
+		data, err := file.ReadFileAsBytes(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read wallet password file")
+		}
+		lines := strings.Split(strings.TrimRight(string(data), "\r\n"), "\n")
+		switch len(lines) {
+		case 1:
+			for i := range passwords {
+				passwords[i] = lines[0]
+			}
+		case len(dirs):
+			for i, line := range lines {
+				passwords[i] = strings.TrimRight(line, "\r")
+			}
+		default:
+			return nil, fmt.Errorf(
+				"wallet password file has %d passwords, expected 1 shared by all wallets or 1 per wallet (%d)",
+				len(lines), len(dirs),
+			)
+		}
+	}
+	for i, dir := range dirs {
+		if err := ValidateExistingPass(passwords[i]); err != nil {
+			return nil, errors.Wrapf(err, "password of wallet %s did not pass validation", dir)
+		}
+	}
+	return passwords, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/io/keyring"
 	"github.com/prysmaticlabs/prysm/v5/io/prompt"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
 	accountsprompt "github.com/prysmaticlabs/prysm/v5/validator/accounts/userprompt"
@@ -82,6 +83,8 @@ type Wallet struct {
 	configFilePath string
 	walletPassword string
 	keymanagerKind keymanager.Kind
+	// The wallets merged in this wallet, when it was opened from several directories.
+	wallets []*Wallet
 }
 
 // New creates a struct from config values.
@@ -151,8 +154,12 @@ func IsValid(walletDir string) (bool, error) {
 }
 
 // OpenWalletOrElseCli tries to open the wallet and if it fails or no wallet
-// is found, invokes a callback function.
+// is found, invokes a callback function. When several wallet directories are
+// given, their wallets are opened as a single wallet merging their keys.
 func OpenWalletOrElseCli(cliCtx *cli.Context, otherwise func(cliCtx *cli.Context) (*Wallet, error)) (*Wallet, error) {
+	if dirs := Dirs(cliCtx.String(flags.WalletDirFlag.Name)); len(dirs) > 1 {
+		return openWallets(cliCtx, dirs)
+	}
 	exists, err := Exists(cliCtx.String(flags.WalletDirFlag.Name))
 	if err != nil {
 		return nil, errors.Wrap(err, CheckExistsErrMsg)
@@ -176,13 +183,7 @@ func OpenWalletOrElseCli(cliCtx *cli.Context, otherwise func(cliCtx *cli.Context
 	if err != nil {
 		return nil, err
 	}
-	walletPassword, err := InputPassword(
-		cliCtx,
-		flags.WalletPasswordFileFlag,
-		PasswordPromptText,
-		false, /* Do not confirm password */
-		ValidateExistingPass,
-	)
+	walletPassword, err := inputExistingWalletPassword(cliCtx, walletDir)
 	if err != nil {
 		return nil, err
 	}
@@ -210,13 +211,7 @@ func OpenOrCreateNewWallet(cliCtx *cli.Context) (*Wallet, error) {
 		if !isValid {
 			return nil, errors.New(InvalidWalletErrMsg)
 		}
-		walletPassword, err := InputPassword(
-			cliCtx,
-			flags.WalletPasswordFileFlag,
-			PasswordPromptText,
-			false, /* Do not confirm password */
-			ValidateExistingPass,
-		)
+		walletPassword, err := inputExistingWalletPassword(cliCtx, walletDir)
 		if err != nil {
 			return nil, err
 		}
@@ -330,6 +325,9 @@ func (w *Wallet) Password() string {
 // InitializeKeymanager reads a keymanager config from disk at the wallet path,
 // unmarshals it based on the wallet's keymanager kind, and returns its value.
 func (w *Wallet) InitializeKeymanager(ctx context.Context, cfg iface.InitKeymanagerConfig) (keymanager.IKeymanager, error) {
+	if len(w.wallets) != 0 {
+		return w.initializeCompositeKeymanager(ctx, cfg)
+	}
 	var km keymanager.IKeymanager
 	var err error
 	switch w.KeymanagerKind() {
@@ -495,6 +493,29 @@ func readKeymanagerKindFromWalletPath(walletPath string) (keymanager.Kind, error
 	return 0, errors.New("no keymanager folder (imported, remote, derived) found in wallet path")
 }
 
+// inputExistingWalletPassword returns the password of an existing wallet from the OS keyring when enabled, falling
+// back to the wallet password file or prompt when the keyring has no valid password for the wallet.
+func inputExistingWalletPassword(cliCtx *cli.Context, walletDir string) (string, error) {
+	if cliCtx.Bool(flags.WalletPasswordKeyringFlag.Name) {
+		walletPassword, err := keyring.WalletPassword(walletDir)
+		if err == nil {
+			err = ValidateExistingPass(walletPassword)
+		}
+		if err == nil {
+			log.Info("Read wallet password from the OS keyring")
+			return walletPassword, nil
+		}
+		log.WithError(err).Warn("Could not read wallet password from the OS keyring, falling back to the wallet password file or prompt")
+	}
+	return InputPassword(
+		cliCtx,
+		flags.WalletPasswordFileFlag,
+		PasswordPromptText,
+		false, /* Do not confirm password */
+		ValidateExistingPass,
+	)
+}
+
 // InputPassword prompts for a password and optionally for password confirmation.
 // The password is validated according to custom rules.
 func InputPassword(
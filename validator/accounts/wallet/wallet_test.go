@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -178,3 +179,109 @@ func TestOpenOrCreateNewWallet(t *testing.T) {
 		})
 	}
 }
+
+func TestDirs(t *testing.T) {
+	assert.DeepEqual(t, []string{"/a"}, wallet.Dirs("/a"))
+	assert.DeepEqual(t, []string{"/a", "/b"}, wallet.Dirs("/a, /b,"))
+	assert.Equal(t, 0, len(wallet.Dirs("")))
+}
+
+func TestOpenWalletOrElseCli_MultipleWallets(t *testing.T) {
+	root := t.TempDir()
+	dirA := filepath.Join(root, "wallet-a")
+	dirB := filepath.Join(root, "wallet-b")
+	dirC := filepath.Join(root, "wallet-c")
+	for _, dir := range []string{dirA, dirB} {
+		require.NoError(t, wallet.New(&wallet.Config{WalletDir: dir, KeymanagerKind: keymanager.Local}).SaveWallet())
+	}
+	require.NoError(t, wallet.New(&wallet.Config{WalletDir: dirC, KeymanagerKind: keymanager.Derived}).SaveWallet())
+	passwordsDir := filepath.Join(root, "passwords")
+	require.NoError(t, os.MkdirAll(passwordsDir, params.BeaconIoConfig().ReadWriteExecutePermissions))
+	require.NoError(t, os.WriteFile(filepath.Join(passwordsDir, "wallet-a.txt"), []byte("passwordA\n"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(passwordsDir, "wallet-b.txt"), []byte("passwordB\n"), os.ModePerm))
+	passwordFile := func(content string) string {
+		path := filepath.Join(t.TempDir(), "passwords.txt")
+		require.NoError(t, os.WriteFile(path, []byte(content), os.ModePerm))
+		return path
+	}
+	cliCtx := func(walletDir, passwordPath string) *cli.Context {
+		app := cli.App{}
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.WalletDirFlag.Name, walletDir, "")
+		set.String(flags.WalletPasswordFileFlag.Name, passwordPath, "")
+		require.NoError(t, set.Set(flags.WalletPasswordFileFlag.Name, passwordPath))
+		return cli.NewContext(&app, set, nil)
+	}
+	otherwise := func(*cli.Context) (*wallet.Wallet, error) {
+		return nil, errors.New("no wallet")
+	}
+
+	tests := []struct {
+		name         string
+		walletDir    string
+		passwordPath string
+		passwords    []string
+		wantErr      string
+	}{
+		{
+			name:         "password per line",
+			walletDir:    dirA + "," + dirB,
+			passwordPath: passwordFile("passwordA\npasswordB\n"),
+			passwords:    []string{"passwordA", "passwordB"},
+		},
+		{
+			name:         "shared password",
+			walletDir:    dirA + "," + dirB,
+			passwordPath: passwordFile("shared"),
+			passwords:    []string{"shared", "shared"},
+		},
+		{
+			name:         "password file per wallet",
+			walletDir:    dirA + "," + dirB,
+			passwordPath: passwordsDir,
+			passwords:    []string{"passwordA", "passwordB"},
+		},
+		{
+			name:         "wrong number of passwords",
+			walletDir:    dirA + "," + dirB,
+			passwordPath: passwordFile("passwordA\npasswordB\npasswordC\n"),
+			wantErr:      "wallet password file has 3 passwords",
+		},
+		{
+			name:         "empty password",
+			walletDir:    dirA + "," + dirB,
+			passwordPath: passwordFile("\npasswordB\n"),
+			wantErr:      "did not pass validation",
+		},
+		{
+			name:         "wallet listed twice",
+			walletDir:    dirA + "," + dirA,
+			passwordPath: passwordFile("shared"),
+			wantErr:      "is listed more than once",
+		},
+		{
+			name:         "wallets of different kinds",
+			walletDir:    dirA + "," + dirC,
+			passwordPath: passwordFile("shared"),
+			wantErr:      "all wallets must be of the same kind",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := wallet.OpenWalletOrElseCli(cliCtx(tt.walletDir, tt.passwordPath), otherwise)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, tt.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, dirA, w.Dir())
+			wallets := w.Wallets()
+			require.Equal(t, len(tt.passwords), len(wallets))
+			for i, member := range wallets {
+				assert.Equal(t, tt.passwords[i], member.Password())
+				assert.Equal(t, keymanager.Local, member.KeymanagerKind())
+			}
+			assert.Equal(t, dirB, wallets[1].Dir())
+		})
+	}
+}
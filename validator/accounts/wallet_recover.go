@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 )
 
 const (
@@ -21,6 +22,11 @@ var (
 
 // WalletRecover uses a mnemonic seed phrase to recover a wallet into the path provided.
 func (acm *CLIManager) WalletRecover(ctx context.Context) (*wallet.Wallet, error) {
+	if acm.accountNameTemplate != "" {
+		if err := local.ValidateAccountNameTemplate(acm.accountNameTemplate); err != nil {
+			return nil, err
+		}
+	}
 	// Ensure that the wallet directory does not contain a wallet already
 	dirExists, err := wallet.Exists(acm.walletDir)
 	if err != nil {
@@ -48,6 +54,19 @@ func (acm *CLIManager) WalletRecover(ctx context.Context) (*wallet.Wallet, error
 	if err := km.RecoverAccountsFromMnemonic(ctx, acm.mnemonic, acm.mnemonicLanguage, acm.mnemonic25thWord, acm.numAccounts); err != nil {
 		return nil, err
 	}
+	if acm.accountNameTemplate != "" {
+		pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not fetch recovered public keys")
+		}
+		rawPubKeys := make([][]byte, len(pubKeys))
+		for i := range pubKeys {
+			rawPubKeys[i] = pubKeys[i][:]
+		}
+		if err := nameNewAccounts(ctx, km, rawPubKeys, acm.accountNameTemplate); err != nil {
+			return nil, err
+		}
+	}
 	log.WithField("walletPath", w.AccountsDir()).Infof(
 		"Successfully recovered HD wallet with %d accounts. Please use `accounts list` to view details for your accounts",
 		acm.numAccounts,
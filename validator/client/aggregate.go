@@ -11,7 +11,6 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
-	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
@@ -20,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -53,6 +53,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 	}
 	v.aggregatedSlotCommitteeIDCache.Add(k, true)
 	v.aggregatedSlotCommitteeIDCacheLock.Unlock()
+	v.dutyHistory.update(pubKey, slots.ToEpoch(slot), func(r *common.DutyRecord) { r.AggregationAssigned = true })
 
 	var slotSig []byte
 	if v.distributed {
@@ -65,7 +66,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 			return
 		}
 	} else {
-		slotSig, err = v.signSlotWithSelectionProof(ctx, pubKey, slot)
+		slotSig, err = v.selectionProof(ctx, pubKey, slot)
 		if err != nil {
 			log.WithError(err).Error("Could not sign slot")
 			if v.emitAccountMetrics {
@@ -127,7 +128,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 			},
 		})
 		if err != nil {
-			log.WithError(err).Error("Could not submit signed aggregate and proof to beacon node")
+			v.logRequestError(log, dutyAggregate, err, "Could not submit signed aggregate and proof to beacon node")
 			if v.emitAccountMetrics {
 				ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 			}
@@ -149,7 +150,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 			},
 		})
 		if err != nil {
-			log.WithError(err).Error("Could not submit signed aggregate and proof to beacon node")
+			v.logRequestError(log, dutyAggregate, err, "Could not submit signed aggregate and proof to beacon node")
 			if v.emitAccountMetrics {
 				ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 			}
@@ -164,6 +165,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 		}
 		return
 	}
+	v.dutyHistory.update(pubKey, slots.ToEpoch(slot), func(r *common.DutyRecord) { r.AggregationSubmitted = true })
 	if v.emitAccountMetrics {
 		ValidatorAggSuccessVec.WithLabelValues(fmtKey).Inc()
 	}
@@ -174,24 +176,11 @@ func (v *validator) signSlotWithSelectionProof(ctx context.Context, pubKey [fiel
 	ctx, span := trace.StartSpan(ctx, "validator.signSlotWithSelectionProof")
 	defer span.End()
 
-	domain, err := v.domainData(ctx, slots.ToEpoch(slot), params.BeaconConfig().DomainSelectionProof[:])
+	req, err := v.selectionProofSignRequest(ctx, pubKey, slot)
 	if err != nil {
 		return nil, err
 	}
-
-	var sig bls.Signature
-	sszUint := primitives.SSZUint64(slot)
-	root, err := signing.ComputeSigningRoot(&sszUint, domain.SignatureDomain)
-	if err != nil {
-		return nil, err
-	}
-	sig, err = v.km.Sign(ctx, &validatorpb.SignRequest{
-		PublicKey:       pubKey[:],
-		SigningRoot:     root[:],
-		SignatureDomain: domain.SignatureDomain,
-		Object:          &validatorpb.SignRequest_Slot{Slot: slot},
-		SigningSlot:     slot,
-	})
+	sig, err := v.km.Sign(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -209,6 +198,9 @@ func (v *validator) waitToSlotTwoThirds(ctx context.Context, slot primitives.Slo
 	oneThird := slots.DivideSlotBy(3 /* one third of slot duration */)
 	twoThird := oneThird + oneThird
 	delay := twoThird
+	if d, ok := v.dutyDeadlines(slot); ok && d.Aggregation > 0 {
+		delay = d.Aggregation
+	}
 
 	startTime := slots.StartTime(v.genesisTime, slot)
 	finalTime := startTime.Add(delay)
@@ -281,7 +273,7 @@ func (v *validator) handleSubmitAggSelectionProofError(err error, slot primitive
 	if grpcNotFound || httpNotFound {
 		log.WithField("slot", slot).WithError(err).Warn("No attestations to aggregate")
 	} else {
-		log.WithField("slot", slot).WithError(err).Error("Could not submit aggregate selection proof to beacon node")
+		v.logRequestError(log.WithField("slot", slot), dutyAggregate, err, "Could not submit aggregate selection proof to beacon node")
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(hexPubkey).Inc()
 		}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -23,6 +22,7 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/sirupsen/logrus"
 )
 
@@ -39,19 +39,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 
 	v.waitOneThirdOrValidBlock(ctx, slot)
 
-	var b strings.Builder
-	if err := b.WriteByte(byte(iface.RoleAttester)); err != nil {
-		log.WithError(err).Error("Could not write role byte for lock key")
-		tracing.AnnotateError(span, err)
-		return
-	}
-	_, err := b.Write(pubKey[:])
-	if err != nil {
-		log.WithError(err).Error("Could not write pubkey bytes for lock key")
-		tracing.AnnotateError(span, err)
-		return
-	}
-	lock := async.NewMultilock(b.String())
+	lock := async.NewMultilock(attesterLockKey(pubKey))
 	lock.Lock()
 	defer lock.Unlock()
 
@@ -70,6 +58,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 		log.Debug("Empty committee for validator duty, not attesting")
 		return
 	}
+	v.dutyHistory.update(pubKey, slots.ToEpoch(slot), func(r *common.DutyRecord) { r.AttestationAssigned = true })
 
 	req := &ethpb.AttestationDataRequest{
 		Slot:           slot,
@@ -77,7 +66,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 	}
 	data, err := v.validatorClient.AttestationData(ctx, req)
 	if err != nil {
-		log.WithError(err).Error("Could not request attestation to sign at slot")
+		v.logRequestError(log, dutyAttest, err, "Could not request attestation to sign at slot")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -176,7 +165,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 		attResp, err = v.validatorClient.ProposeAttestation(ctx, attestation)
 	}
 	if err != nil {
-		log.WithError(err).Error("Could not submit attestation to beacon node")
+		v.logRequestError(log, dutyAttest, err, "Could not submit attestation to beacon node")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -207,6 +196,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 		span.SetAttributes(trace.Int64Attribute("committeeIndex", int64(data.CommitteeIndex)))
 	}
 
+	v.dutyHistory.update(pubKey, slots.ToEpoch(slot), func(r *common.DutyRecord) { r.AttestationSubmitted = true })
 	if v.emitAccountMetrics {
 		ValidatorAttestSuccessVec.WithLabelValues(fmtKey).Inc()
 		ValidatorAttestedSlotsGaugeVec.WithLabelValues(fmtKey).Set(float64(slot))
@@ -282,10 +272,25 @@ func (v *validator) setHighestSlot(slot primitives.Slot) {
 	}
 }
 
+// dutyDeadlines returns the duty deadlines of the slot set by the distributed validator middleware, if the validator
+// client is part of a distributed validator and the middleware set them.
+func (v *validator) dutyDeadlines(slot primitives.Slot) (iface.DutyDeadlines, bool) {
+	if !v.distributed {
+		return iface.DutyDeadlines{}, false
+	}
+	p, ok := v.validatorClient.(iface.DutyDeadlinesProvider)
+	if !ok {
+		return iface.DutyDeadlines{}, false
+	}
+	return p.DutyDeadlines(slot)
+}
+
 // waitOneThirdOrValidBlock waits until (a) or (b) whichever comes first:
 //
 //	(a) the validator has received a valid block that is the same slot as input slot
 //	(b) one-third of the slot has transpired (SECONDS_PER_SLOT / 3 seconds after the start of slot)
+//
+// In place of one-third of the slot, a distributed validator waits for the attestation deadline set by its middleware.
 func (v *validator) waitOneThirdOrValidBlock(ctx context.Context, slot primitives.Slot) {
 	ctx, span := trace.StartSpan(ctx, "validator.waitOneThirdOrValidBlock")
 	defer span.End()
@@ -296,6 +301,9 @@ func (v *validator) waitOneThirdOrValidBlock(ctx context.Context, slot primitive
 	}
 
 	delay := slots.DivideSlotBy(3 /* a third of the slot duration */)
+	if d, ok := v.dutyDeadlines(slot); ok && d.Attestation > 0 {
+		delay = d.Attestation
+	}
 	startTime := slots.StartTime(v.genesisTime, slot)
 	finalTime := startTime.Add(delay)
 	wait := prysmTime.Until(finalTime)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"math"
 	"reflect"
 	"strconv"
 
@@ -310,6 +311,22 @@ func (c beaconApiChainClient) Validators(ctx context.Context, in *ethpb.ListVali
 	}, nil
 }
 
+// AllValidators returns every validator matched by the request. The state validators endpoint already returns
+// all of them in one response, so a single page spanning the whole result is requested.
+func (c beaconApiChainClient) AllValidators(ctx context.Context, in *ethpb.ListValidatorsRequest) ([]*ethpb.Validators_ValidatorContainer, error) {
+	resp, err := c.Validators(ctx, &ethpb.ListValidatorsRequest{
+		QueryFilter: in.QueryFilter,
+		Active:      in.Active,
+		PublicKeys:  in.PublicKeys,
+		Indices:     in.Indices,
+		PageSize:    math.MaxInt32,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.ValidatorList, nil
+}
+
 func (c beaconApiChainClient) ValidatorQueue(ctx context.Context, in *empty.Empty) (*ethpb.ValidatorQueue, error) {
 	if c.fallbackClient != nil {
 		return c.fallbackClient.ValidatorQueue(ctx, in)
@@ -580,6 +580,51 @@ func TestListValidators(t *testing.T) {
 	})
 }
 
+func TestAllValidators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	// More validators than a single page of Validators with the default page size.
+	jsonValidators := make([]*structs.ValidatorContainer, 267)
+	for idx := 0; idx < len(jsonValidators); idx++ {
+		jsonValidators[idx] = &structs.ValidatorContainer{
+			Index: "1",
+			Validator: &structs.Validator{
+				Pubkey:                     hexutil.Encode([]byte{2}),
+				WithdrawalCredentials:      hexutil.Encode([]byte{3}),
+				EffectiveBalance:           "4",
+				Slashed:                    true,
+				ActivationEligibilityEpoch: "5",
+				ActivationEpoch:            "6",
+				ExitEpoch:                  "7",
+				WithdrawableEpoch:          "8",
+			},
+		}
+	}
+
+	stateValidatorsProvider := mock.NewMockStateValidatorsProvider(ctrl)
+	stateValidatorsProvider.EXPECT().StateValidatorsForSlot(gomock.Any(), primitives.Slot(0), make([]string, 0), []primitives.ValidatorIndex{}, nil).Return(
+		&structs.GetValidatorsResponse{Data: jsonValidators},
+		nil,
+	)
+
+	beaconChainClient := beaconApiChainClient{stateValidatorsProvider: stateValidatorsProvider}
+	validators, err := beaconChainClient.AllValidators(ctx, &ethpb.ListValidatorsRequest{
+		QueryFilter: &ethpb.ListValidatorsRequest_Genesis{},
+		PublicKeys:  [][]byte{},
+		Indices:     []primitives.ValidatorIndex{},
+		PageSize:    1,
+		PageToken:   "1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, len(jsonValidators), len(validators))
+	for _, v := range validators {
+		assert.Equal(t, primitives.ValidatorIndex(1), v.Index)
+		assert.DeepEqual(t, []byte{2}, v.Validator.PublicKey)
+	}
+}
+
 func TestGetChainHead(t *testing.T) {
 	const finalityCheckpointsEndpoint = "/eth/v1/beacon/states/head/finality_checkpoints"
 	const headBlockHeadersEndpoint = "/eth/v1/beacon/headers/head"
@@ -3,15 +3,18 @@ package beacon_api
 import (
 	"context"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
 	"github.com/prysmaticlabs/prysm/v5/api/client/event"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 )
@@ -26,6 +29,9 @@ type beaconApiValidatorClient struct {
 	beaconBlockConverter    BeaconBlockConverter
 	prysmChainClient        iface.PrysmChainClient
 	isEventStreamRunning    bool
+	distributed             bool
+	dutyDeadlines           map[primitives.Slot]iface.DutyDeadlines
+	dutyDeadlinesLock       sync.RWMutex
 }
 
 func NewBeaconApiValidatorClient(jsonRestHandler JsonRestHandler, opts ...ValidatorClientOpt) iface.ValidatorClient {
@@ -92,7 +98,7 @@ func (c *beaconApiValidatorClient) BeaconBlock(ctx context.Context, in *ethpb.Bl
 	defer span.End()
 
 	return wrapInMetrics[*ethpb.GenericBeaconBlock]("BeaconBlock", func() (*ethpb.GenericBeaconBlock, error) {
-		return c.beaconBlock(ctx, in.Slot, in.RandaoReveal, in.Graffiti)
+		return c.beaconBlock(ctx, in)
 	})
 }
 
@@ -264,8 +270,10 @@ func (c *beaconApiValidatorClient) WaitForChainStart(ctx context.Context, _ *emp
 }
 
 func (c *beaconApiValidatorClient) StartEventStream(ctx context.Context, topics []string, eventsChannel chan<- *event.Event) {
-	client := &http.Client{} // event stream should not be subject to the same settings as other api calls, so we won't use c.jsonRestHandler.HttpClient()
-	eventStream, err := event.NewEventStream(ctx, client, c.jsonRestHandler.Host(), topics)
+	// event stream should not be subject to the same settings as other api calls, so we won't use c.jsonRestHandler.HttpClient()
+	host, transport := network.HTTPBaseURLAndTransport(c.jsonRestHandler.Host())
+	client := &http.Client{Transport: transport}
+	eventStream, err := event.NewEventStream(ctx, client, host, topics)
 	if err != nil {
 		eventsChannel <- &event.Event{
 			EventType: event.EventError,
@@ -304,9 +312,12 @@ func wrapInMetrics[Resp any](action string, f func() (Resp, error)) (Resp, error
 	now := time.Now()
 	resp, err := f()
 	httpActionCount.WithLabelValues(action).Inc()
-	if err == nil {
+	switch {
+	case err == nil:
 		httpActionLatency.WithLabelValues(action).Observe(time.Since(now).Seconds())
-	} else {
+	case errors.Is(err, client.ErrNodeSyncing):
+		nodeSyncingHTTPActionCount.WithLabelValues(action).Inc()
+	default:
 		failedHTTPActionCount.WithLabelValues(action).Inc()
 	}
 	return resp, err
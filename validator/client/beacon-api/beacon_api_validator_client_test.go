@@ -142,7 +142,7 @@ func TestBeaconApiValidatorClient_ProposeBeaconBlockValid(t *testing.T) {
 		"/eth/v1/beacon/blocks",
 		map[string]string{"Eth-Consensus-Version": "phase0"},
 		gomock.Any(),
-		nil,
+		gomock.Any(),
 	).Return(
 		nil,
 	).Times(2)
@@ -178,7 +178,7 @@ func TestBeaconApiValidatorClient_ProposeBeaconBlockError(t *testing.T) {
 		"/eth/v1/beacon/blocks",
 		map[string]string{"Eth-Consensus-Version": "phase0"},
 		gomock.Any(),
-		nil,
+		gomock.Any(),
 	).Return(
 		errors.New("foo error"),
 	).Times(2)
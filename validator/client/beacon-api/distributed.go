@@ -0,0 +1,90 @@
+package beacon_api
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+// WithDistributed is used when the validator client runs behind a distributed validator middleware. The client then
+// reads the duty deadlines the middleware sets in attester duties, and only checks the liveness of the validator's
+// own indices during doppelganger checks.
+func WithDistributed() ValidatorClientOpt {
+	return func(c *beaconApiValidatorClient) {
+		c.distributed = true
+	}
+}
+
+// DutyDeadlines returns the duty deadlines set by the distributed validator middleware for the slot, if any.
+func (c *beaconApiValidatorClient) DutyDeadlines(slot primitives.Slot) (iface.DutyDeadlines, bool) {
+	c.dutyDeadlinesLock.RLock()
+	defer c.dutyDeadlinesLock.RUnlock()
+	d, ok := c.dutyDeadlines[slot]
+	return d, ok
+}
+
+// recordDutyDeadlines records the duty deadlines set by a distributed validator middleware in an attester duty.
+// When several duties of the slot set deadlines, the earliest ones are kept so that no duty is performed late.
+func (c *beaconApiValidatorClient) recordDutyDeadlines(slot primitives.Slot, duty *structs.AttesterDuty) error {
+	if !c.distributed || (duty.AttestationDeadlineMs == "" && duty.AggregationDeadlineMs == "") {
+		return nil
+	}
+	attestation, err := parseDutyDeadline(duty.AttestationDeadlineMs)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse attestation deadline `%s`", duty.AttestationDeadlineMs)
+	}
+	aggregation, err := parseDutyDeadline(duty.AggregationDeadlineMs)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse aggregation deadline `%s`", duty.AggregationDeadlineMs)
+	}
+
+	c.dutyDeadlinesLock.Lock()
+	defer c.dutyDeadlinesLock.Unlock()
+	if c.dutyDeadlines == nil {
+		c.dutyDeadlines = make(map[primitives.Slot]iface.DutyDeadlines)
+	}
+	d := c.dutyDeadlines[slot]
+	d.Attestation = earliestDeadline(d.Attestation, attestation)
+	d.Aggregation = earliestDeadline(d.Aggregation, aggregation)
+	c.dutyDeadlines[slot] = d
+
+	// Duties are fetched for the current and the next epoch, so the deadlines of slots more than two epochs
+	// before the recorded slot are no longer needed.
+	retention := 2 * params.BeaconConfig().SlotsPerEpoch
+	if slot > retention {
+		for s := range c.dutyDeadlines {
+			if s < slot-retention {
+				delete(c.dutyDeadlines, s)
+			}
+		}
+	}
+	return nil
+}
+
+// parseDutyDeadline parses a deadline in milliseconds into the slot. An empty deadline is returned as zero.
+func parseDutyDeadline(ms string) (time.Duration, error) {
+	if ms == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseUint(ms, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	deadline := time.Duration(v) * time.Millisecond
+	if slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second; deadline >= slotDuration {
+		return 0, errors.Errorf("deadline is not within the slot duration of %s", slotDuration)
+	}
+	return deadline, nil
+}
+
+func earliestDeadline(a, b time.Duration) time.Duration {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
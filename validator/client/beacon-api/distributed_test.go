@@ -0,0 +1,165 @@
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+const (
+	ownStringPubKey     = "0x80000e851c0f53c3246ff726d7ff7766661ca5e12a07c45c114d208d54f0f8233d4380b2e9aff759d69795d1df905526"
+	foreignStringPubKey = "0x80002662ecb857da7a37ed468291cb248979eca5131db56c20843262f7909220c296e18f59af1726ef86ec15c08b8317"
+)
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v interface{}) {
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	w.Header().Set("Content-Type", api.JsonMediaType)
+	_, err = w.Write(b)
+	require.NoError(t, err)
+}
+
+// newMiddlewareServer mocks the Beacon API of a distributed validator middleware. The middleware sets duty deadlines
+// in attester duties and answers for the validators of the whole cluster, not only the requested ones.
+func newMiddlewareServer(t *testing.T, livenessIndexes *[]string) *httptest.Server {
+	var lock sync.Mutex
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/validator/duties/attester/1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, &structs.GetAttesterDutiesResponse{
+			Data: []*structs.AttesterDuty{
+				{ValidatorIndex: "1", Slot: "33", CommitteeIndex: "0", AttestationDeadlineMs: "3000", AggregationDeadlineMs: "7000"},
+				{ValidatorIndex: "2", Slot: "33", CommitteeIndex: "0", AttestationDeadlineMs: "2500"},
+			},
+		})
+	})
+	mux.HandleFunc("/eth/v1/validator/duties/proposer/1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, &structs.GetProposerDutiesResponse{Data: []*structs.ProposerDuty{}})
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/committees", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, &structs.GetCommitteesResponse{
+			Data: []*structs.Committee{{Index: "0", Slot: "33", Validators: []string{"1", "2"}}},
+		})
+	})
+	mux.HandleFunc("/eth/v1/node/syncing", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, &structs.SyncStatusResponse{Data: &structs.SyncStatusResponseData{IsSyncing: false}})
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/fork", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, &structs.GetStateForkResponse{Data: &structs.Fork{CurrentVersion: "0x01000000"}})
+	})
+	mux.HandleFunc("/eth/v1/beacon/headers", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, &structs.GetBlockHeadersResponse{
+			Data: []*structs.SignedBeaconBlockHeaderContainer{{
+				Header: &structs.SignedBeaconBlockHeader{Message: &structs.BeaconBlockHeader{Slot: "320"}},
+			}},
+		})
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/validators", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, &structs.GetValidatorsResponse{
+			Data: []*structs.ValidatorContainer{
+				{Index: "1", Status: "active_ongoing", Validator: &structs.Validator{Pubkey: ownStringPubKey}},
+				{Index: "2", Status: "active_ongoing", Validator: &structs.Validator{Pubkey: foreignStringPubKey}},
+			},
+		})
+	})
+	mux.HandleFunc("/eth/v1/validator/liveness/", func(w http.ResponseWriter, r *http.Request) {
+		var indexes []string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&indexes))
+		lock.Lock()
+		*livenessIndexes = append(*livenessIndexes, indexes...)
+		lock.Unlock()
+		resp := &structs.GetLivenessResponse{Data: make([]*structs.Liveness, len(indexes))}
+		for i, index := range indexes {
+			// The other node of the cluster running the foreign validator attested.
+			resp.Data[i] = &structs.Liveness{Index: index, IsLive: index == "2"}
+		}
+		writeJSON(t, w, resp)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestDutiesForEpoch_Distributed(t *testing.T) {
+	var livenessIndexes []string
+	server := newMiddlewareServer(t, &livenessIndexes)
+	defer server.Close()
+	handler := NewBeaconApiJsonRestHandler(http.Client{Timeout: 5 * time.Second}, server.URL)
+	vals := []validatorForDuty{{index: 1, status: ethpb.ValidatorStatus_ACTIVE}}
+
+	t.Run("distributed", func(t *testing.T) {
+		c, ok := NewBeaconApiValidatorClient(handler, WithDistributed()).(*beaconApiValidatorClient)
+		require.Equal(t, true, ok)
+		duties, err := c.dutiesForEpoch(context.Background(), 1, vals, false)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(duties))
+		assert.Equal(t, primitives.Slot(33), duties[0].AttesterSlot)
+
+		// The earliest deadlines of the slot are kept.
+		d, ok := c.DutyDeadlines(33)
+		require.Equal(t, true, ok)
+		assert.DeepEqual(t, iface.DutyDeadlines{Attestation: 2500 * time.Millisecond, Aggregation: 7 * time.Second}, d)
+		_, ok = c.DutyDeadlines(34)
+		assert.Equal(t, false, ok)
+	})
+	t.Run("not distributed", func(t *testing.T) {
+		c, ok := NewBeaconApiValidatorClient(handler).(*beaconApiValidatorClient)
+		require.Equal(t, true, ok)
+		_, err := c.dutiesForEpoch(context.Background(), 1, vals, false)
+		require.NoError(t, err)
+		_, ok = c.DutyDeadlines(33)
+		assert.Equal(t, false, ok)
+	})
+}
+
+func TestRecordDutyDeadlines_Invalid(t *testing.T) {
+	c := &beaconApiValidatorClient{distributed: true}
+	err := c.recordDutyDeadlines(1, &structs.AttesterDuty{AttestationDeadlineMs: "foo"})
+	assert.ErrorContains(t, "failed to parse attestation deadline", err)
+	err = c.recordDutyDeadlines(1, &structs.AttesterDuty{AggregationDeadlineMs: "12000"})
+	assert.ErrorContains(t, "deadline is not within the slot duration", err)
+}
+
+func TestCheckDoppelGanger_Distributed(t *testing.T) {
+	ownPubKey, err := hexutil.Decode(ownStringPubKey)
+	require.NoError(t, err)
+	req := &ethpb.DoppelGangerRequest{
+		ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{{PublicKey: ownPubKey, Epoch: 1}},
+	}
+
+	t.Run("distributed", func(t *testing.T) {
+		var livenessIndexes []string
+		server := newMiddlewareServer(t, &livenessIndexes)
+		defer server.Close()
+		c := NewBeaconApiValidatorClient(NewBeaconApiJsonRestHandler(http.Client{Timeout: 5 * time.Second}, server.URL), WithDistributed())
+
+		resp, err := c.CheckDoppelGanger(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Responses))
+		assert.Equal(t, false, resp.Responses[0].DuplicateExists)
+		// Only the liveness of the own validator is requested, for the previous and the current epoch.
+		assert.DeepEqual(t, []string{"1", "1"}, livenessIndexes)
+	})
+	t.Run("not distributed", func(t *testing.T) {
+		var livenessIndexes []string
+		server := newMiddlewareServer(t, &livenessIndexes)
+		defer server.Close()
+		c := NewBeaconApiValidatorClient(NewBeaconApiJsonRestHandler(http.Client{Timeout: 5 * time.Second}, server.URL))
+
+		resp, err := c.CheckDoppelGanger(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(resp.Responses))
+		assert.Equal(t, false, resp.Responses[0].DuplicateExists)
+		assert.DeepEqual(t, []string{"1", "2", "1", "2"}, livenessIndexes)
+	})
+}
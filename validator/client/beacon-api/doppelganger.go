@@ -133,9 +133,9 @@ func (c *beaconApiValidatorClient) checkDoppelGanger(ctx context.Context, in *et
 
 	validators := stateValidators.Data
 	stringPubKeyToIndex := make(map[string]string, len(validators))
-	indexes := make([]string, len(validators))
+	indexes := make([]string, 0, len(validators))
 
-	for i, v := range validators {
+	for _, v := range validators {
 		if v == nil {
 			return nil, errors.New("validator container is nil")
 		}
@@ -146,8 +146,14 @@ func (c *beaconApiValidatorClient) checkDoppelGanger(ctx context.Context, in *et
 			return nil, errors.New("validator is nil")
 		}
 
+		// A distributed validator middleware aggregates the nodes of the cluster and can answer for validators
+		// other than the requested ones, whose liveness must not be attributed to this validator client.
+		if _, ok := stringPubKeyToDoppelGangerInfo[v.Validator.Pubkey]; c.distributed && !ok {
+			continue
+		}
+
 		stringPubKeyToIndex[v.Validator.Pubkey] = index
-		indexes[i] = index
+		indexes = append(indexes, index)
 	}
 
 	// Get validators liveness for the last epoch.
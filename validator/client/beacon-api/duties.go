@@ -121,6 +121,9 @@ func (c *beaconApiValidatorClient) dutiesForEpoch(
 			if err != nil {
 				return errors.Wrapf(err, "failed to parse attester committee index `%s`", attesterDuty.CommitteeIndex)
 			}
+			if err := c.recordDutyDeadlines(primitives.Slot(slot), attesterDuty); err != nil {
+				return err
+			}
 			attesterDutiesMapping[primitives.ValidatorIndex(validatorIndex)] = committeeIndexSlotPair{
 				slot:           primitives.Slot(slot),
 				committeeIndex: primitives.CommitteeIndex(committeeIndex),
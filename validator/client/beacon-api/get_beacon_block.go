@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	neturl "net/url"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
@@ -22,11 +23,22 @@ type abstractProduceBlockResponseJson struct {
 	Data    json.RawMessage `json:"data"`
 }
 
-func (c *beaconApiValidatorClient) beaconBlock(ctx context.Context, slot primitives.Slot, randaoReveal, graffiti []byte) (*ethpb.GenericBeaconBlock, error) {
+func (c *beaconApiValidatorClient) beaconBlock(ctx context.Context, in *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+	slot := in.Slot
 	queryParams := neturl.Values{}
-	queryParams.Add("randao_reveal", hexutil.Encode(randaoReveal))
-	if len(graffiti) > 0 {
-		queryParams.Add("graffiti", hexutil.Encode(graffiti))
+	queryParams.Add("randao_reveal", hexutil.Encode(in.RandaoReveal))
+	if len(in.Graffiti) > 0 {
+		queryParams.Add("graffiti", hexutil.Encode(in.Graffiti))
+	}
+	if in.BuilderBoostFactor != nil {
+		queryParams.Add("builder_boost_factor", strconv.FormatUint(in.BuilderBoostFactor.Value, 10))
+	}
+	// The thresholds of the builder bid are only supported by Prysm beacon nodes, other beacon nodes ignore them.
+	if in.MinBuilderBid > 0 {
+		queryParams.Add("min_builder_bid", strconv.FormatUint(in.MinBuilderBid, 10))
+	}
+	if in.MinBuilderBidToLocalRatio > 0 {
+		queryParams.Add("min_builder_bid_to_local_ratio", strconv.FormatFloat(in.MinBuilderBidToLocalRatio, 'f', -1, 64))
 	}
 
 	var ver string
@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/network"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 )
 
@@ -24,16 +28,56 @@ type JsonRestHandler interface {
 type BeaconApiJsonRestHandler struct {
 	client http.Client
 	host   string
+	// transport is the transport of the client passed to the constructor,
+	// restored when switching from a unix socket host to a network host.
+	transport http.RoundTripper
+	retry     client.RetryConfig
+	// hedgeClient sends the hedged GET requests to hedgeHost, when a hedge host is configured.
+	hedgeClient http.Client
+	hedgeHost   string
+	hedgeDelay  time.Duration
 }
 
-// NewBeaconApiJsonRestHandler returns a JsonRestHandler
-func NewBeaconApiJsonRestHandler(client http.Client, host string) JsonRestHandler {
-	return &BeaconApiJsonRestHandler{
-		client: client,
-		host:   host,
+// JsonRestHandlerOpt is an option of NewBeaconApiJsonRestHandler.
+type JsonRestHandlerOpt func(*BeaconApiJsonRestHandler)
+
+// WithRetry retries GET requests that fail with a connection error or a transient status, waiting for an
+// exponential backoff with jitter between attempts. 503 responses are not retried: they are how a beacon node
+// reports that it is syncing, which validator duties already handle by backing off.
+func WithRetry(cfg client.RetryConfig) JsonRestHandlerOpt {
+	return func(c *BeaconApiJsonRestHandler) {
+		c.retry = cfg
+	}
+}
+
+// WithHedging sends a GET request to the hedge host as well when the request to the current host has not
+// completed after delay, or failed with an error that would be retried. The first successful response is used.
+// Requests are not hedged while the hedge host is the current host.
+func WithHedging(host string, delay time.Duration) JsonRestHandlerOpt {
+	return func(c *BeaconApiJsonRestHandler) {
+		c.hedgeHost = host
+		c.hedgeDelay = delay
 	}
 }
 
+// NewBeaconApiJsonRestHandler returns a JsonRestHandler.
+// The host can be a unix:// endpoint, in which case requests are sent over the unix domain socket.
+func NewBeaconApiJsonRestHandler(client http.Client, host string, opts ...JsonRestHandlerOpt) JsonRestHandler {
+	h := &BeaconApiJsonRestHandler{
+		client:    client,
+		transport: client.Transport,
+	}
+	for _, o := range opts {
+		o(h)
+	}
+	h.SetHost(host)
+	if h.hedgeHost != "" {
+		h.hedgeClient = client
+		h.hedgeClient.Transport = h.transportFor(h.hedgeHost)
+	}
+	return h
+}
+
 // HttpClient returns the underlying HTTP client of the handler
 func (c *BeaconApiJsonRestHandler) HttpClient() *http.Client {
 	return &c.client
@@ -46,16 +90,56 @@ func (c *BeaconApiJsonRestHandler) Host() string {
 
 // Get sends a GET request and decodes the response body as a JSON object into the passed in object.
 // If an HTTP error is returned, the body is decoded as a DefaultJsonError JSON object and returned as the first return value.
+// Failed requests are retried and slow requests hedged as configured with WithRetry and WithHedging.
 func (c *BeaconApiJsonRestHandler) Get(ctx context.Context, endpoint string, resp interface{}) error {
-	url := c.host + endpoint
+	host := c.host
+	if c.hedgeHost == "" || c.hedgeHost == host {
+		return c.getWithRetry(ctx, &c.client, host, endpoint, resp)
+	}
+	// Both requests may be in flight at once, so each decodes into its own buffer and only the
+	// response that is used is decoded into resp.
+	raw, err := client.Hedge(ctx, c.hedgeDelay, func(err error) bool { return isRetryableGetError(ctx, err) },
+		func(ctx context.Context) (json.RawMessage, error) {
+			var raw json.RawMessage
+			err := c.getWithRetry(ctx, &c.client, host, endpoint, &raw)
+			return raw, err
+		},
+		func(ctx context.Context) (json.RawMessage, error) {
+			var raw json.RawMessage
+			err := c.getWithRetry(ctx, &c.hedgeClient, c.hedgeHost, endpoint, &raw)
+			return raw, err
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if resp == nil || len(raw) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw, resp); err != nil {
+		return errors.Wrapf(err, "failed to decode response body into json for %s", endpoint)
+	}
+	return nil
+}
+
+func (c *BeaconApiJsonRestHandler) getWithRetry(ctx context.Context, hc *http.Client, host, endpoint string, resp interface{}) error {
+	return c.retry.Do(ctx, func(err error) bool { return isRetryableGetError(ctx, err) }, func(ctx context.Context) error {
+		return get(ctx, hc, hostURL(host, endpoint), resp)
+	})
+}
+
+func get(ctx context.Context, hc *http.Client, url string, resp interface{}) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create request for endpoint %s", url)
 	}
 
-	httpResp, err := c.client.Do(req)
+	httpResp, err := hc.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to perform request for endpoint %s", url)
+		if ctx.Err() != nil {
+			return errors.Wrapf(err, "failed to perform request for endpoint %s", url)
+		}
+		return errors.Wrapf(fmt.Errorf("%w: %w", client.ErrConnectionIssue, err), "failed to perform request for endpoint %s", url)
 	}
 	defer func() {
 		if err := httpResp.Body.Close(); err != nil {
@@ -66,6 +150,22 @@ func (c *BeaconApiJsonRestHandler) Get(ctx context.Context, endpoint string, res
 	return decodeResp(httpResp, resp)
 }
 
+// isRetryableGetError reports whether a failed GET request is worth sending again: the beacon node could not be
+// reached, or it answered with a transient status other than 503.
+func isRetryableGetError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, client.ErrConnectionIssue) {
+		return true
+	}
+	var jsonErr *httputil.DefaultJsonError
+	if errors.As(err, &jsonErr) {
+		return jsonErr.Code != http.StatusServiceUnavailable && client.IsRetryableStatus(jsonErr.Code)
+	}
+	return false
+}
+
 // Post sends a POST request and decodes the response body as a JSON object into the passed in object.
 // If an HTTP error is returned, the body is decoded as a DefaultJsonError JSON object and returned as the first return value.
 func (c *BeaconApiJsonRestHandler) Post(
@@ -79,7 +179,7 @@ func (c *BeaconApiJsonRestHandler) Post(
 		return errors.New("data is nil")
 	}
 
-	url := c.host + apiEndpoint
+	url := c.url(apiEndpoint)
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, data)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create request for endpoint %s", url)
@@ -103,18 +203,27 @@ func (c *BeaconApiJsonRestHandler) Post(
 	return decodeResp(httpResp, resp)
 }
 
+// statusResponse is implemented by responses whose body depends on the status code of a successful response.
+// decodeResp leaves decoding the body of 2XX responses to them.
+type statusResponse interface {
+	decodeStatus(code int, body []byte) error
+}
+
 func decodeResp(httpResp *http.Response, resp interface{}) error {
 	body, err := io.ReadAll(httpResp.Body)
 	if err != nil {
 		return errors.Wrapf(err, "failed to read response body for %s", httpResp.Request.URL)
 	}
 
+	if r, ok := resp.(statusResponse); ok && strings.HasPrefix(httpResp.Status, "2") {
+		return r.decodeStatus(httpResp.StatusCode, body)
+	}
 	if !strings.Contains(httpResp.Header.Get("Content-Type"), api.JsonMediaType) {
 		// 2XX codes are a success
 		if strings.HasPrefix(httpResp.Status, "2") {
 			return nil
 		}
-		return &httputil.DefaultJsonError{Code: httpResp.StatusCode, Message: string(body)}
+		return httpError(httpResp, &httputil.DefaultJsonError{Code: httpResp.StatusCode, Message: string(body)})
 	}
 
 	decoder := json.NewDecoder(bytes.NewBuffer(body))
@@ -124,7 +233,7 @@ func decodeResp(httpResp *http.Response, resp interface{}) error {
 		if err = decoder.Decode(errorJson); err != nil {
 			return errors.Wrapf(err, "failed to decode response body into error json for %s", httpResp.Request.URL)
 		}
-		return errorJson
+		return httpError(httpResp, errorJson)
 	}
 	// resp is nil for requests that do not return anything.
 	if resp != nil {
@@ -136,6 +245,38 @@ func decodeResp(httpResp *http.Response, resp interface{}) error {
 	return nil
 }
 
+// httpError returns the error for a non-2XX response. A 503 is how the Beacon API reports that the node is syncing,
+// so it is returned as a *client.NodeSyncingError, which validator duties treat as a reason to back off rather than a failure.
+func httpError(httpResp *http.Response, errJson *httputil.DefaultJsonError) error {
+	if httpResp.StatusCode != http.StatusServiceUnavailable {
+		return errJson
+	}
+	return &client.NodeSyncingError{
+		RetryAfter: client.ParseRetryAfter(httpResp.Header.Get("Retry-After"), time.Now()),
+		Err:        errJson,
+	}
+}
+
+// SetHost sets the host requests are sent to. Requests to a unix:// host are sent over the unix domain socket.
 func (c *BeaconApiJsonRestHandler) SetHost(host string) {
 	c.host = host
+	c.client.Transport = c.transportFor(host)
+}
+
+func (c *BeaconApiJsonRestHandler) transportFor(host string) http.RoundTripper {
+	if path, ok := network.UnixSocketPath(host); ok {
+		return network.UnixSocketTransport(path)
+	}
+	return c.transport
+}
+
+func (c *BeaconApiJsonRestHandler) url(endpoint string) string {
+	return hostURL(c.host, endpoint)
+}
+
+func hostURL(host, endpoint string) string {
+	if _, ok := network.UnixSocketPath(host); ok {
+		return network.UnixSocketHTTPBaseURL + endpoint
+	}
+	return host + endpoint
 }
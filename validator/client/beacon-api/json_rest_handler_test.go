@@ -7,12 +7,18 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/network"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -95,6 +101,200 @@ func TestPost(t *testing.T) {
 	assert.DeepEqual(t, genesisJson, resp)
 }
 
+func TestGet_UnixSocket(t *testing.T) {
+	ctx := context.Background()
+	const endpoint = "/example/rest/api/endpoint"
+	genesisJson := &structs.GetGenesisResponse{
+		Data: &structs.Genesis{
+			GenesisTime:           "123",
+			GenesisValidatorsRoot: "0x456",
+			GenesisForkVersion:    "0x789",
+		},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		marshalledJson, err := json.Marshal(genesisJson)
+		require.NoError(t, err)
+
+		w.Header().Set("Content-Type", api.JsonMediaType)
+		_, err = w.Write(marshalledJson)
+		require.NoError(t, err)
+	})
+	socketPath := filepath.Join(t.TempDir(), "beacon.sock")
+	lis, err := network.ListenUnix(socketPath, 0600)
+	require.NoError(t, err)
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: time.Second}
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer func() {
+		require.NoError(t, server.Close())
+	}()
+
+	jsonRestHandler := NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second * 5}, network.UnixSocketScheme+socketPath)
+	resp := &structs.GetGenesisResponse{}
+	require.NoError(t, jsonRestHandler.Get(ctx, endpoint, resp))
+	assert.DeepEqual(t, genesisJson, resp)
+
+	// Switching to a network host restores the original transport.
+	httpServer := httptest.NewServer(mux)
+	defer httpServer.Close()
+	jsonRestHandler.SetHost(httpServer.URL)
+	resp = &structs.GetGenesisResponse{}
+	require.NoError(t, jsonRestHandler.Get(ctx, endpoint, resp))
+	assert.DeepEqual(t, genesisJson, resp)
+}
+
+func TestGet_Retry(t *testing.T) {
+	ctx := context.Background()
+	const endpoint = "/example/rest/api/endpoint"
+	genesisJson := &structs.GetGenesisResponse{Data: &structs.Genesis{GenesisTime: "123"}}
+	retryCfg := client.RetryConfig{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	newServer := func(failures int, status int) (*httptest.Server, *atomic.Int32) {
+		calls := &atomic.Int32{}
+		mux := http.NewServeMux()
+		mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			if int(calls.Add(1)) <= failures {
+				w.Header().Set("Content-Type", api.JsonMediaType)
+				w.WriteHeader(status)
+				_, err := w.Write([]byte(`{"code":` + strconv.Itoa(status) + `,"message":"failure"}`))
+				require.NoError(t, err)
+				return
+			}
+			marshalledJson, err := json.Marshal(genesisJson)
+			require.NoError(t, err)
+			w.Header().Set("Content-Type", api.JsonMediaType)
+			_, err = w.Write(marshalledJson)
+			require.NoError(t, err)
+		})
+		return httptest.NewServer(mux), calls
+	}
+
+	t.Run("transient status is retried", func(t *testing.T) {
+		server, calls := newServer(2, http.StatusBadGateway)
+		defer server.Close()
+		jsonRestHandler := NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second * 5}, server.URL, WithRetry(retryCfg))
+		resp := &structs.GetGenesisResponse{}
+		require.NoError(t, jsonRestHandler.Get(ctx, endpoint, resp))
+		assert.DeepEqual(t, genesisJson, resp)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		server, calls := newServer(3, http.StatusBadGateway)
+		defer server.Close()
+		jsonRestHandler := NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second * 5}, server.URL, WithRetry(retryCfg))
+		err := jsonRestHandler.Get(ctx, endpoint, &structs.GetGenesisResponse{})
+		assert.ErrorContains(t, "giving up after 3 attempts", err)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+	t.Run("syncing node is not retried", func(t *testing.T) {
+		server, calls := newServer(1, http.StatusServiceUnavailable)
+		defer server.Close()
+		jsonRestHandler := NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second * 5}, server.URL, WithRetry(retryCfg))
+		err := jsonRestHandler.Get(ctx, endpoint, &structs.GetGenesisResponse{})
+		jsonErr := &httputil.DefaultJsonError{}
+		require.Equal(t, true, errors.As(err, &jsonErr))
+		assert.Equal(t, http.StatusServiceUnavailable, jsonErr.Code)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+	t.Run("not found is not retried", func(t *testing.T) {
+		server, calls := newServer(1, http.StatusNotFound)
+		defer server.Close()
+		jsonRestHandler := NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second * 5}, server.URL, WithRetry(retryCfg))
+		require.NotNil(t, jsonRestHandler.Get(ctx, endpoint, &structs.GetGenesisResponse{}))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+	t.Run("unreachable node is retried", func(t *testing.T) {
+		server, _ := newServer(0, http.StatusOK)
+		server.Close()
+		jsonRestHandler := NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second * 5}, server.URL, WithRetry(retryCfg))
+		err := jsonRestHandler.Get(ctx, endpoint, &structs.GetGenesisResponse{})
+		assert.Equal(t, true, errors.Is(err, client.ErrConnectionIssue))
+		assert.ErrorContains(t, "giving up after 3 attempts", err)
+	})
+}
+
+func TestGet_Hedging(t *testing.T) {
+	ctx := context.Background()
+	const endpoint = "/example/rest/api/endpoint"
+	newServer := func(genesisTime string, delay time.Duration, status int) (*httptest.Server, *atomic.Int32) {
+		calls := &atomic.Int32{}
+		mux := http.NewServeMux()
+		mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+			w.Header().Set("Content-Type", api.JsonMediaType)
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				_, err := w.Write([]byte(`{"code":` + strconv.Itoa(status) + `,"message":"failure"}`))
+				require.NoError(t, err)
+				return
+			}
+			marshalledJson, err := json.Marshal(&structs.GetGenesisResponse{Data: &structs.Genesis{GenesisTime: genesisTime}})
+			require.NoError(t, err)
+			_, err = w.Write(marshalledJson)
+			require.NoError(t, err)
+		})
+		return httptest.NewServer(mux), calls
+	}
+
+	t.Run("fast primary is not hedged", func(t *testing.T) {
+		primary, _ := newServer("1", 0, http.StatusOK)
+		defer primary.Close()
+		hedge, hedgeCalls := newServer("2", 0, http.StatusOK)
+		defer hedge.Close()
+		jsonRestHandler := NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second * 5}, primary.URL, WithHedging(hedge.URL, time.Second))
+		resp := &structs.GetGenesisResponse{}
+		require.NoError(t, jsonRestHandler.Get(ctx, endpoint, resp))
+		assert.Equal(t, "1", resp.Data.GenesisTime)
+		assert.Equal(t, int32(0), hedgeCalls.Load())
+	})
+	t.Run("slow primary is hedged", func(t *testing.T) {
+		primary, _ := newServer("1", 2*time.Second, http.StatusOK)
+		defer primary.Close()
+		hedge, hedgeCalls := newServer("2", 0, http.StatusOK)
+		defer hedge.Close()
+		jsonRestHandler := NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second * 5}, primary.URL, WithHedging(hedge.URL, 10*time.Millisecond))
+		resp := &structs.GetGenesisResponse{}
+		require.NoError(t, jsonRestHandler.Get(ctx, endpoint, resp))
+		assert.Equal(t, "2", resp.Data.GenesisTime)
+		assert.Equal(t, int32(1), hedgeCalls.Load())
+	})
+	t.Run("failed primary is hedged without waiting", func(t *testing.T) {
+		primary, _ := newServer("1", 0, http.StatusBadGateway)
+		defer primary.Close()
+		hedge, _ := newServer("2", 0, http.StatusOK)
+		defer hedge.Close()
+		jsonRestHandler := NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second * 5}, primary.URL, WithHedging(hedge.URL, time.Minute))
+		resp := &structs.GetGenesisResponse{}
+		require.NoError(t, jsonRestHandler.Get(ctx, endpoint, resp))
+		assert.Equal(t, "2", resp.Data.GenesisTime)
+	})
+	t.Run("not found is not hedged", func(t *testing.T) {
+		primary, _ := newServer("1", 0, http.StatusNotFound)
+		defer primary.Close()
+		hedge, hedgeCalls := newServer("2", 0, http.StatusOK)
+		defer hedge.Close()
+		jsonRestHandler := NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second * 5}, primary.URL, WithHedging(hedge.URL, time.Minute))
+		require.NotNil(t, jsonRestHandler.Get(ctx, endpoint, &structs.GetGenesisResponse{}))
+		assert.Equal(t, int32(0), hedgeCalls.Load())
+	})
+	t.Run("hedge host is the current host", func(t *testing.T) {
+		primary, calls := newServer("1", 0, http.StatusOK)
+		defer primary.Close()
+		jsonRestHandler := NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second * 5}, primary.URL, WithHedging(primary.URL, 0))
+		resp := &structs.GetGenesisResponse{}
+		require.NoError(t, jsonRestHandler.Get(ctx, endpoint, resp))
+		assert.Equal(t, "1", resp.Data.GenesisTime)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
 func Test_decodeResp(t *testing.T) {
 	type j struct {
 		Foo string `json:"foo"`
@@ -197,6 +397,35 @@ func Test_decodeResp(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, errJson.Code)
 		assert.Equal(t, "error", errJson.Message)
 	})
+	t.Run("202 JSON with status response", func(t *testing.T) {
+		body := bytes.Buffer{}
+		b, err := json.Marshal(&server.PublishBlockError{Code: http.StatusAccepted, Message: "foo"})
+		require.NoError(t, err)
+		body.Write(b)
+		r := &http.Response{
+			Status:     "202",
+			StatusCode: http.StatusAccepted,
+			Body:       io.NopCloser(&body),
+			Header:     map[string][]string{"Content-Type": {api.JsonMediaType}},
+		}
+		resp := &publishBlockResponse{}
+		require.NoError(t, decodeResp(r, resp))
+		assert.Equal(t, true, resp.accepted)
+		require.NotNil(t, resp.publishErr)
+		assert.Equal(t, "foo", resp.publishErr.Message)
+	})
+	t.Run("200 JSON without body with status response", func(t *testing.T) {
+		body := bytes.Buffer{}
+		r := &http.Response{
+			Status:     "200",
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(&body),
+			Header:     map[string][]string{"Content-Type": {api.JsonMediaType}},
+		}
+		resp := &publishBlockResponse{}
+		require.NoError(t, decodeResp(r, resp))
+		assert.Equal(t, false, resp.accepted)
+	})
 	t.Run("200 JSON cannot decode", func(t *testing.T) {
 		body := bytes.Buffer{}
 		_, err := body.WriteString("foo")
@@ -226,4 +455,37 @@ func Test_decodeResp(t *testing.T) {
 		err = decodeResp(r, nil)
 		assert.ErrorContains(t, "failed to decode response body into error json", err)
 	})
+	t.Run("503 JSON", func(t *testing.T) {
+		body := bytes.Buffer{}
+		b, err := json.Marshal(&httputil.DefaultJsonError{Code: http.StatusServiceUnavailable, Message: "syncing"})
+		require.NoError(t, err)
+		body.Write(b)
+		r := &http.Response{
+			Status:     "503",
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(&body),
+			Header:     map[string][]string{"Content-Type": {api.JsonMediaType}, "Retry-After": {"6"}},
+		}
+		err = decodeResp(r, nil)
+		require.ErrorIs(t, err, client.ErrNodeSyncing)
+		syncingErr := &client.NodeSyncingError{}
+		require.Equal(t, true, errors.As(err, &syncingErr))
+		assert.Equal(t, 6*time.Second, syncingErr.RetryAfter)
+		errJson := &httputil.DefaultJsonError{}
+		require.Equal(t, true, errors.As(err, &errJson))
+		assert.Equal(t, "syncing", errJson.Message)
+	})
+	t.Run("503 non-JSON without Retry-After", func(t *testing.T) {
+		body := bytes.Buffer{}
+		r := &http.Response{
+			Status:     "503",
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       io.NopCloser(&body),
+			Header:     map[string][]string{"Content-Type": {api.OctetStreamMediaType}},
+		}
+		err := decodeResp(r, nil)
+		syncingErr := &client.NodeSyncingError{}
+		require.Equal(t, true, errors.As(err, &syncingErr))
+		assert.Equal(t, time.Duration(0), syncingErr.RetryAfter)
+	})
 }
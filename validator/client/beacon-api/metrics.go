@@ -31,4 +31,12 @@ var (
 		},
 		[]string{"action"},
 	)
+	nodeSyncingHTTPActionCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "node_syncing_http_action_count",
+			Help:      "Number of HTTP actions performed against the beacon node which failed because the node is syncing",
+		},
+		[]string{"action"},
+	)
 )
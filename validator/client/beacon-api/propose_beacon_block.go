@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
@@ -133,7 +134,8 @@ func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *e
 	}
 
 	headers := map[string]string{"Eth-Consensus-Version": consensusVersion}
-	err = c.jsonRestHandler.Post(ctx, endpoint, headers, bytes.NewBuffer(marshalledSignedBeaconBlockJson), nil)
+	resp := &publishBlockResponse{}
+	err = c.jsonRestHandler.Post(ctx, endpoint, headers, bytes.NewBuffer(marshalledSignedBeaconBlockJson), resp)
 	errJson := &httputil.DefaultJsonError{}
 	if err != nil {
 		if !errors.As(err, &errJson) {
@@ -145,10 +147,39 @@ func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *e
 		}
 		return nil, errJson
 	}
+	if resp.accepted {
+		if resp.publishErr != nil {
+			return nil, errors.Wrap(resp.publishErr, "block was successfully broadcast but failed validation")
+		}
+		return nil, errors.New("block was successfully broadcast but failed validation")
+	}
 
 	return &ethpb.ProposeResponse{BlockRoot: beaconBlockRoot[:]}, nil
 }
 
+// publishBlockResponse is the response of the block publishing endpoints. Any 2XX other than 202 means that the
+// block was published. A 202 means that the block was broadcast but failed validation, its body, when there is
+// one, carries the reason.
+type publishBlockResponse struct {
+	accepted   bool
+	publishErr *server.PublishBlockError
+}
+
+func (r *publishBlockResponse) decodeStatus(code int, body []byte) error {
+	if code != http.StatusAccepted {
+		return nil
+	}
+	r.accepted = true
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	r.publishErr = &server.PublishBlockError{}
+	if err := json.Unmarshal(body, r.publishErr); err != nil {
+		return errors.Wrap(err, "failed to decode 202 response body")
+	}
+	return nil
+}
+
 func marshallBeaconBlockPhase0(block *ethpb.SignedBeaconBlock) ([]byte, error) {
 	signedBeaconBlockJson := &structs.SignedBeaconBlock{
 		Signature: hexutil.Encode(block.Signature),
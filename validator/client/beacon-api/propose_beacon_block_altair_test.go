@@ -62,7 +62,7 @@ func TestProposeBeaconBlock_Altair(t *testing.T) {
 		"/eth/v1/beacon/blocks",
 		headers,
 		bytes.NewBuffer(marshalledBlock),
-		nil,
+		gomock.Any(),
 	)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
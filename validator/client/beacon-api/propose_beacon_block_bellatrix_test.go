@@ -79,7 +79,7 @@ func TestProposeBeaconBlock_Bellatrix(t *testing.T) {
 		"/eth/v1/beacon/blocks",
 		headers,
 		bytes.NewBuffer(marshalledBlock),
-		nil,
+		gomock.Any(),
 	)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
@@ -80,7 +80,7 @@ func TestProposeBeaconBlock_BlindedBellatrix(t *testing.T) {
 		"/eth/v1/beacon/blinded_blocks",
 		headers,
 		bytes.NewBuffer(marshalledBlock),
-		nil,
+		gomock.Any(),
 	)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
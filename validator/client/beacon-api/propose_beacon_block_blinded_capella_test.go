@@ -82,7 +82,7 @@ func TestProposeBeaconBlock_BlindedCapella(t *testing.T) {
 		"/eth/v1/beacon/blinded_blocks",
 		headers,
 		bytes.NewBuffer(marshalledBlock),
-		nil,
+		gomock.Any(),
 	)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
@@ -35,7 +35,7 @@ func TestProposeBeaconBlock_BlindedDeneb(t *testing.T) {
 		"/eth/v1/beacon/blinded_blocks",
 		headers,
 		bytes.NewBuffer(denebBytes),
-		nil,
+		gomock.Any(),
 	)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
@@ -36,7 +36,7 @@ func TestProposeBeaconBlock_Deneb(t *testing.T) {
 		"/eth/v1/beacon/blocks",
 		headers,
 		bytes.NewBuffer(denebBytes),
-		nil,
+		gomock.Any(),
 	)
 
 	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
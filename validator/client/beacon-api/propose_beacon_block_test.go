@@ -2,13 +2,16 @@ package beacon_api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api/mock"
 	"go.uber.org/mock/gomock"
 )
@@ -105,7 +108,7 @@ func TestProposeBeaconBlock_Error(t *testing.T) {
 					testCase.endpoint,
 					headers,
 					gomock.Any(),
-					nil,
+					gomock.Any(),
 				).Return(
 					testSuite.returnedError,
 				).Times(1)
@@ -118,6 +121,71 @@ func TestProposeBeaconBlock_Error(t *testing.T) {
 	}
 }
 
+func TestProposeBeaconBlock_BroadcastButNotIntegrated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().Post(
+		gomock.Any(),
+		"/eth/v1/beacon/blocks",
+		gomock.Any(),
+		gomock.Any(),
+		&publishBlockResponse{},
+	).SetArg(
+		4,
+		publishBlockResponse{
+			accepted: true,
+			publishErr: &server.PublishBlockError{
+				Code:    http.StatusAccepted,
+				Message: "Could not publish block: block was broadcast but import failed: invalid state root",
+				Stage:   "import",
+				Reason:  "invalid state root",
+			},
+		},
+	).Return(
+		nil,
+	).Times(1)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	_, err := validatorClient.proposeBeaconBlock(context.Background(), &ethpb.GenericSignedBeaconBlock{
+		Block: generateSignedPhase0Block(),
+	})
+	assert.ErrorContains(t, "block was successfully broadcast but failed validation", err)
+	assert.ErrorContains(t, "stage import: invalid state root", err)
+}
+
+func TestPublishBlockResponse_DecodeStatus(t *testing.T) {
+	body, err := json.Marshal(&server.PublishBlockError{Code: http.StatusAccepted, Message: "foo", Stage: "import", Reason: "bar"})
+	require.NoError(t, err)
+
+	t.Run("200 with body", func(t *testing.T) {
+		resp := &publishBlockResponse{}
+		require.NoError(t, resp.decodeStatus(http.StatusOK, []byte(`{"data":"foo"}`)))
+		assert.Equal(t, false, resp.accepted)
+		assert.Equal(t, true, resp.publishErr == nil)
+	})
+	t.Run("200 with non-JSON body", func(t *testing.T) {
+		resp := &publishBlockResponse{}
+		require.NoError(t, resp.decodeStatus(http.StatusOK, []byte("foo")))
+		assert.Equal(t, false, resp.accepted)
+	})
+	t.Run("202 without body", func(t *testing.T) {
+		resp := &publishBlockResponse{}
+		require.NoError(t, resp.decodeStatus(http.StatusAccepted, nil))
+		assert.Equal(t, true, resp.accepted)
+		assert.Equal(t, true, resp.publishErr == nil)
+	})
+	t.Run("202 with body", func(t *testing.T) {
+		resp := &publishBlockResponse{}
+		require.NoError(t, resp.decodeStatus(http.StatusAccepted, body))
+		assert.Equal(t, true, resp.accepted)
+		require.NotNil(t, resp.publishErr)
+		assert.Equal(t, "import", resp.publishErr.Stage)
+		assert.Equal(t, "bar", resp.publishErr.Reason)
+	})
+}
+
 func TestProposeBeaconBlock_UnsupportedBlockType(t *testing.T) {
 	validatorClient := &beaconApiValidatorClient{}
 	_, err := validatorClient.proposeBeaconBlock(context.Background(), &ethpb.GenericSignedBeaconBlock{})
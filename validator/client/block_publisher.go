@@ -0,0 +1,266 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	publishBlockPath        = "/eth/v2/beacon/blocks"
+	publishBlindedBlockPath = "/eth/v2/beacon/blinded_blocks"
+)
+
+// blockPublisher publishes signed blocks, SSZ encoded, to publish-only beacon API endpoints such as sentry nodes,
+// concurrently with the beacon node the validator client is connected to. Each endpoint has until the publish timeout
+// to accept the block, so that an unresponsive endpoint never holds the proposal.
+type blockPublisher struct {
+	endpoints []publishEndpoint
+	timeout   time.Duration
+	client    *http.Client
+}
+
+// publishEndpoint is a publish-only beacon API endpoint.
+type publishEndpoint struct {
+	url string
+	// blinded is true when the endpoint shares the relay configuration of the beacon node. Blinded blocks can only
+	// be unblinded by the relay which built their payload, so they are only published to such endpoints.
+	blinded bool
+}
+
+// publishOutcome is the result of publishing a block to a publish endpoint.
+type publishOutcome struct {
+	endpoint string
+	err      error
+	elapsed  time.Duration
+}
+
+// newBlockPublisher validates the publish endpoints and returns a publisher for them, or nil when none is configured.
+// Blinded block endpoints also receive full blocks. An endpoint may only be listed once, and may not be one of the
+// beacon nodes the validator client is connected to, which already receive the block.
+func newBlockPublisher(endpoints, blindedEndpoints, beaconNodeHosts []string, timeout time.Duration) (*blockPublisher, error) {
+	if len(endpoints) == 0 && len(blindedEndpoints) == 0 {
+		return nil, nil
+	}
+	if timeout <= 0 {
+		return nil, errors.Errorf("block publish timeout must be positive, got %s", timeout)
+	}
+	hosts := make(map[string]bool, len(beaconNodeHosts))
+	for _, h := range beaconNodeHosts {
+		hosts[strings.TrimSuffix(h, "/")] = true
+	}
+	p := &blockPublisher{
+		timeout: timeout,
+		client:  &http.Client{},
+	}
+	seen := make(map[string]bool)
+	add := func(e string, blinded bool) error {
+		u, err := url.ParseRequestURI(e)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return errors.Errorf("block publish endpoint %s is not an http(s) URL", logs.MaskCredentialsLogging(e))
+		}
+		e = strings.TrimSuffix(e, "/")
+		if seen[e] {
+			return errors.Errorf("block publish endpoint %s is listed more than once", logs.MaskCredentialsLogging(e))
+		}
+		if hosts[e] {
+			return errors.Errorf("block publish endpoint %s is also a beacon node of the validator client", logs.MaskCredentialsLogging(e))
+		}
+		seen[e] = true
+		p.endpoints = append(p.endpoints, publishEndpoint{url: e, blinded: blinded})
+		return nil
+	}
+	for _, e := range endpoints {
+		if err := add(e, false); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range blindedEndpoints {
+		if err := add(e, true); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// publish starts publishing the block to the endpoints which can accept it, and returns a function waiting for the
+// outcomes. The block is encoded and sent in the background, so that the caller can publish it to the beacon node
+// at the same time.
+func (p *blockPublisher) publish(ctx context.Context, blk *ethpb.GenericSignedBeaconBlock) func() []publishOutcome {
+	endpoints := make([]publishEndpoint, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		if e.blinded || !isBlindedGenericBlock(blk) {
+			endpoints = append(endpoints, e)
+		}
+	}
+	outcomes := make([]publishOutcome, len(endpoints))
+	if len(endpoints) == 0 {
+		return func() []publishOutcome { return outcomes }
+	}
+
+	start := prysmTime.Now()
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		body, path, consensusVersion, err := sszPublishRequest(blk)
+		for i, e := range endpoints {
+			outcomes[i] = publishOutcome{endpoint: e.url, err: err}
+			if err != nil {
+				continue
+			}
+			wg.Add(1)
+			go func(i int, e publishEndpoint) {
+				defer wg.Done()
+				outcomes[i].err = p.post(ctx, e.url+path, consensusVersion, body)
+				outcomes[i].elapsed = prysmTime.Since(start)
+			}(i, e)
+		}
+	}()
+	return func() []publishOutcome {
+		wg.Wait()
+		cancel()
+		return outcomes
+	}
+}
+
+// post sends the SSZ encoded block to the endpoint. A 202 response means the block was broadcast but failed
+// validation, which is not counted as accepted, the same as for the beacon node.
+func (p *blockPublisher) post(ctx context.Context, endpoint, consensusVersion string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Content-Type", api.OctetStreamMediaType)
+	req.Header.Set(api.VersionHeader, consensusVersion)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not publish block")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	msg, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return errors.Wrapf(err, "could not read response with status %d", resp.StatusCode)
+	}
+	return errors.Errorf("block not accepted, status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+}
+
+// logPublishOutcomes logs the outcome of publishing the block to each publish endpoint, and returns how many
+// endpoints accepted it.
+func logPublishOutcomes(log *logrus.Entry, outcomes []publishOutcome) int {
+	accepted := 0
+	for _, o := range outcomes {
+		l := log.WithFields(logrus.Fields{
+			"endpoint": logs.MaskCredentialsLogging(o.endpoint),
+			"elapsed":  o.elapsed,
+		})
+		if o.err != nil {
+			l.WithError(o.err).Warn("Publish endpoint did not accept block")
+			continue
+		}
+		accepted++
+		l.Debug("Publish endpoint accepted block")
+	}
+	return accepted
+}
+
+// proposeBeaconBlock publishes the signed block to the beacon node and, concurrently, to the publish endpoints if any
+// are configured. The proposal succeeds if any of them accepted the block.
+func (v *validator) proposeBeaconBlock(
+	ctx context.Context,
+	blk interfaces.ReadOnlySignedBeaconBlock,
+	genericBlk *ethpb.GenericSignedBeaconBlock,
+	log *logrus.Entry,
+) (*ethpb.ProposeResponse, error) {
+	if v.blockPublisher == nil {
+		return v.validatorClient.ProposeBeaconBlock(ctx, genericBlk)
+	}
+	wait := v.blockPublisher.publish(ctx, genericBlk)
+	resp, err := v.validatorClient.ProposeBeaconBlock(ctx, genericBlk)
+	outcomes := wait()
+	accepted := logPublishOutcomes(log, outcomes)
+	if err == nil {
+		return resp, nil
+	}
+	if accepted == 0 {
+		return nil, err
+	}
+	log.WithError(err).WithFields(logrus.Fields{
+		"accepted":  accepted,
+		"endpoints": len(outcomes),
+	}).Warn("Beacon node did not accept block, but publish endpoints did")
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block root")
+	}
+	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
+}
+
+func isBlindedGenericBlock(blk *ethpb.GenericSignedBeaconBlock) bool {
+	switch blk.Block.(type) {
+	case *ethpb.GenericSignedBeaconBlock_BlindedBellatrix, *ethpb.GenericSignedBeaconBlock_BlindedCapella,
+		*ethpb.GenericSignedBeaconBlock_BlindedDeneb, *ethpb.GenericSignedBeaconBlock_BlindedElectra:
+		return true
+	}
+	return false
+}
+
+// sszPublishRequest returns the SSZ encoded block, along with the path and consensus version to publish it with.
+// Blocks from Deneb are published along with their blobs, unless they are blinded.
+func sszPublishRequest(blk *ethpb.GenericSignedBeaconBlock) ([]byte, string, string, error) {
+	var m ssz.Marshaler
+	var v int
+	path := publishBlockPath
+	switch b := blk.Block.(type) {
+	case *ethpb.GenericSignedBeaconBlock_Phase0:
+		m, v = b.Phase0, version.Phase0
+	case *ethpb.GenericSignedBeaconBlock_Altair:
+		m, v = b.Altair, version.Altair
+	case *ethpb.GenericSignedBeaconBlock_Bellatrix:
+		m, v = b.Bellatrix, version.Bellatrix
+	case *ethpb.GenericSignedBeaconBlock_BlindedBellatrix:
+		m, v, path = b.BlindedBellatrix, version.Bellatrix, publishBlindedBlockPath
+	case *ethpb.GenericSignedBeaconBlock_Capella:
+		m, v = b.Capella, version.Capella
+	case *ethpb.GenericSignedBeaconBlock_BlindedCapella:
+		m, v, path = b.BlindedCapella, version.Capella, publishBlindedBlockPath
+	case *ethpb.GenericSignedBeaconBlock_Deneb:
+		m, v = b.Deneb, version.Deneb
+	case *ethpb.GenericSignedBeaconBlock_BlindedDeneb:
+		m, v, path = b.BlindedDeneb, version.Deneb, publishBlindedBlockPath
+	case *ethpb.GenericSignedBeaconBlock_Electra:
+		m, v = b.Electra, version.Electra
+	case *ethpb.GenericSignedBeaconBlock_BlindedElectra:
+		m, v, path = b.BlindedElectra, version.Electra, publishBlindedBlockPath
+	default:
+		return nil, "", "", errors.Errorf("unsupported block type %T", blk.Block)
+	}
+	body, err := m.MarshalSSZ()
+	if err != nil {
+		return nil, "", "", errors.Wrapf(err, "could not encode %s block", version.String(v))
+	}
+	return body, path, version.String(v), nil
+}
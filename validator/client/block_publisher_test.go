@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"go.uber.org/mock/gomock"
+)
+
+// publishServer is a publish endpoint which records the blocks it receives, and either accepts them or never answers.
+type publishServer struct {
+	srv      *httptest.Server
+	hang     bool
+	received atomic.Uint64
+	path     atomic.Value
+	body     atomic.Value
+}
+
+func newPublishServer(t *testing.T, hang bool) *publishServer {
+	s := &publishServer{hang: hang}
+	s.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, api.OctetStreamMediaType, r.Header.Get("Content-Type"))
+		assert.Equal(t, "bellatrix", r.Header.Get(api.VersionHeader))
+		s.received.Add(1)
+		s.path.Store(r.URL.Path)
+		s.body.Store(body)
+		if s.hang {
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+func TestNewBlockPublisher(t *testing.T) {
+	p, err := newBlockPublisher(nil, nil, []string{"http://localhost:3500"}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, (*blockPublisher)(nil), p)
+
+	p, err = newBlockPublisher([]string{"http://sentry:3500/"}, []string{"https://relayed:3500"}, []string{"http://localhost:3500"}, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(p.endpoints))
+	assert.Equal(t, publishEndpoint{url: "http://sentry:3500"}, p.endpoints[0])
+	assert.Equal(t, publishEndpoint{url: "https://relayed:3500", blinded: true}, p.endpoints[1])
+
+	_, err = newBlockPublisher([]string{"sentry:3500"}, nil, nil, time.Second)
+	require.ErrorContains(t, "is not an http(s) URL", err)
+	_, err = newBlockPublisher([]string{"http://sentry:3500"}, []string{"http://sentry:3500/"}, nil, time.Second)
+	require.ErrorContains(t, "is listed more than once", err)
+	_, err = newBlockPublisher([]string{"http://localhost:3500"}, nil, []string{"http://localhost:3500"}, time.Second)
+	require.ErrorContains(t, "is also a beacon node", err)
+	_, err = newBlockPublisher([]string{"http://sentry:3500"}, nil, nil, 0)
+	require.ErrorContains(t, "block publish timeout must be positive", err)
+}
+
+func TestBlockPublisher_Publish(t *testing.T) {
+	accepting, hanging, relayed := newPublishServer(t, false), newPublishServer(t, true), newPublishServer(t, false)
+	p, err := newBlockPublisher(
+		[]string{accepting.srv.URL, hanging.srv.URL},
+		[]string{relayed.srv.URL},
+		nil,
+		100*time.Millisecond,
+	)
+	require.NoError(t, err)
+
+	blk := &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_Bellatrix{Bellatrix: util.NewBeaconBlockBellatrix()}}
+	outcomes := p.publish(context.Background(), blk)()
+	require.Equal(t, 3, len(outcomes))
+	require.NoError(t, outcomes[0].err)
+	require.ErrorIs(t, outcomes[1].err, context.DeadlineExceeded)
+	require.NoError(t, outcomes[2].err)
+	want, err := blk.GetBellatrix().MarshalSSZ()
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, accepting.body.Load())
+	assert.Equal(t, publishBlockPath, accepting.path.Load())
+
+	// Blinded blocks are only published to the endpoints sharing the relay configuration of the beacon node.
+	blinded := &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_BlindedBellatrix{BlindedBellatrix: util.NewBlindedBeaconBlockBellatrix()}}
+	outcomes = p.publish(context.Background(), blinded)()
+	require.Equal(t, 1, len(outcomes))
+	require.NoError(t, outcomes[0].err)
+	assert.Equal(t, relayed.srv.URL, outcomes[0].endpoint)
+	assert.Equal(t, publishBlindedBlockPath, relayed.path.Load())
+	assert.Equal(t, uint64(1), accepting.received.Load())
+	assert.Equal(t, uint64(2), relayed.received.Load())
+}
+
+func TestProposeBlock_PublishEndpointAccepts(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t, false)
+	defer finish()
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	sentry := newPublishServer(t, false)
+	validator.blockPublisher, _ = newBlockPublisher([]string{sentry.srv.URL}, nil, nil, time.Second)
+
+	m.validatorClient.EXPECT().DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).Times(2)
+	m.validatorClient.EXPECT().BeaconBlock(gomock.Any(), gomock.AssignableToTypeOf(&ethpb.BlockRequest{})).
+		Return(&ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: util.NewBeaconBlockBellatrix().Block}}, nil)
+	m.validatorClient.EXPECT().ProposeBeaconBlock(gomock.Any(), gomock.AssignableToTypeOf(&ethpb.GenericSignedBeaconBlock{})).
+		Return(nil, errors.New("uh oh"))
+
+	validator.ProposeBlock(context.Background(), 1, pubKey)
+	assert.Equal(t, uint64(1), sentry.received.Load())
+	require.LogsContain(t, hook, "Beacon node did not accept block, but publish endpoints did")
+	require.LogsContain(t, hook, "Submitted new block")
+	require.LogsDoNotContain(t, hook, "Failed to propose block")
+}
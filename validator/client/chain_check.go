@@ -0,0 +1,138 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ErrWrongChain is returned when the beacon node is not on the chain saved in the validator database, such as after
+// switching to a beacon node of another network.
+var ErrWrongChain = errors.New("beacon node is not on the chain of the validator database")
+
+// CheckBeaconNodeChain verifies that the beacon node is on the chain the validator first connected to, with the same
+// genesis validators root and the same fork at the current epoch, so that nothing is signed for another chain. The
+// check is done once per beacon node, and again after switching to another beacon node.
+func (v *validator) CheckBeaconNodeChain(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "validator.CheckBeaconNodeChain")
+	defer span.End()
+
+	if v.beaconNodeChainChecked.Load() {
+		return nil
+	}
+	res, err := v.validatorClient.WaitForChainStart(ctx, &emptypb.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis of beacon node")
+	}
+	if err := v.checkGenesis(ctx, res.GenesisValidatorsRoot, res.GenesisTime); err != nil {
+		return err
+	}
+
+	// The beacon node only exposes its fork through the signing domains, which must be the ones of the fork scheduled
+	// at the current epoch.
+	epoch := slots.ToEpoch(slots.CurrentSlot(res.GenesisTime))
+	version, err := forks.NewOrderedSchedule(params.BeaconConfig()).VersionForEpoch(epoch)
+	if err != nil {
+		return errors.Wrap(err, "could not get fork version")
+	}
+	domainType := params.BeaconConfig().DomainBeaconAttester
+	want, err := signing.ComputeDomain(domainType, version[:], res.GenesisValidatorsRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not compute signing domain")
+	}
+	domain, err := v.validatorClient.DomainData(ctx, &ethpb.DomainRequest{Epoch: epoch, Domain: domainType[:]})
+	if err != nil {
+		return errors.Wrap(err, "could not get signing domain of beacon node")
+	}
+	if !bytes.Equal(domain.SignatureDomain, want) {
+		log.Errorf(`The signing domain of the beacon node does not match the fork version %#x
+			scheduled at epoch %d. The beacon node and the validator client are configured for different forks, so the
+			validator client will not perform any duty with this beacon node.`, version, epoch)
+		return errors.Wrapf(ErrWrongChain, "signing domain of beacon node (%#x) does not match the one of fork version %#x", domain.SignatureDomain, version)
+	}
+
+	v.beaconNodeChainChecked.Store(true)
+	return nil
+}
+
+// checkGenesis compares the genesis validators root of the beacon node and the fork schedule of the validator client
+// with the ones saved in the validator database, which are the ones the validator first connected with. They are
+// saved on the first connection, and replaced when they differ only if the validator was started to accept a genesis
+// change. Forks scheduled after the current epoch may change, such as when a new fork is scheduled.
+func (v *validator) checkGenesis(ctx context.Context, genValRoot []byte, genesisTime uint64) error {
+	savedRoot, err := v.db.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get current genesis validators root")
+	}
+	savedSchedule, err := v.db.ForkSchedule(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get saved fork schedule")
+	}
+	schedule := params.BeaconConfig().ForkVersionSchedule
+	epoch := slots.ToEpoch(slots.CurrentSlot(genesisTime))
+
+	var mismatch error
+	if len(savedRoot) != 0 && !bytes.Equal(savedRoot, genValRoot) {
+		mismatch = errors.Wrapf(
+			ErrWrongChain,
+			"genesis validators root from beacon node (%#x) does not match root saved in validator db (%#x)",
+			genValRoot,
+			savedRoot,
+		)
+	} else if len(savedSchedule) != 0 && !reflect.DeepEqual(activeForks(savedSchedule, epoch), activeForks(schedule, epoch)) {
+		mismatch = errors.Wrapf(ErrWrongChain, "fork schedule does not match the one saved in validator db at epoch %d", epoch)
+	}
+	if mismatch != nil && !v.acceptGenesisChange {
+		log.Errorf(`The genesis validators root or fork schedule of the beacon node does not
+			match what is in your validator database. This could indicate that the beacon node is on another network, or
+			that this is a database meant for another network, so the validator client will not perform any duty with this
+			beacon node. If you deliberately moved this validator to another network, please run --%s to accept the new
+			genesis. If not, please file an issue at https://github.com/prysmaticlabs/prysm/issues`,
+			flags.AcceptGenesisChangeFlag.Name,
+		)
+		return mismatch
+	}
+
+	if mismatch != nil {
+		log.WithError(mismatch).Warn("Accepting the genesis change, replacing the genesis validators root and fork schedule of the validator db")
+		if err := v.db.ReplaceGenesisValidatorsRoot(ctx, genValRoot); err != nil {
+			return errors.Wrap(err, "could not replace genesis validators root")
+		}
+		if v.domainDataCache != nil {
+			v.domainDataCache.Clear()
+		}
+	} else if len(savedRoot) == 0 {
+		if err := v.db.SaveGenesisValidatorsRoot(ctx, genValRoot); err != nil {
+			return errors.Wrap(err, "could not save genesis validators root")
+		}
+	}
+	if !reflect.DeepEqual(savedSchedule, schedule) {
+		if err := v.db.SaveForkSchedule(ctx, schedule); err != nil {
+			return errors.Wrap(err, "could not save fork schedule")
+		}
+	}
+	return nil
+}
+
+// activeForks returns the forks of the schedule activated at the epoch.
+func activeForks(schedule map[[fieldparams.VersionLength]byte]primitives.Epoch, epoch primitives.Epoch) map[[fieldparams.VersionLength]byte]primitives.Epoch {
+	active := make(map[[fieldparams.VersionLength]byte]primitives.Epoch)
+	for version, e := range schedule {
+		if e <= epoch {
+			active[version] = e
+		}
+	}
+	return active
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	dbTest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"go.uber.org/mock/gomock"
+)
+
+// expectBeaconNodeChain makes the beacon node answer the chain checks with the genesis validators root, started at the
+// current epoch 0, and with the signing domain of the given fork version.
+func expectBeaconNodeChain(t *testing.T, client *validatormock.MockValidatorClient, genValRoot []byte, version []byte) {
+	client.EXPECT().WaitForChainStart(gomock.Any(), gomock.Any()).Return(&ethpb.ChainStartResponse{
+		Started:               true,
+		GenesisTime:           uint64(time.Now().Unix()),
+		GenesisValidatorsRoot: genValRoot,
+	}, nil)
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainBeaconAttester, version, genValRoot)
+	require.NoError(t, err)
+	client.EXPECT().DomainData(gomock.Any(), gomock.Any()).Return(&ethpb.DomainResponse{SignatureDomain: domain}, nil)
+}
+
+func TestCheckBeaconNodeChain(t *testing.T) {
+	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
+		t.Run(fmt.Sprintf("SlashingProtectionMinimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
+			ctx := context.Background()
+			hook := logTest.NewGlobal()
+			ctrl := gomock.NewController(t)
+			client := validatormock.NewMockValidatorClient(ctrl)
+			db := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, isSlashingProtectionMinimal)
+			v := &validator{
+				validatorClient: client,
+				db:              db,
+				beaconNodeHosts: []string{"http://localhost:3500", "http://localhost:3501"},
+			}
+			genValRoot := bytesutil.PadTo([]byte("validators"), fieldparams.RootLength)
+
+			// The genesis of the first beacon node is saved.
+			expectBeaconNodeChain(t, client, genValRoot, params.BeaconConfig().GenesisForkVersion)
+			require.NoError(t, v.CheckBeaconNodeChain(ctx))
+			savedRoot, err := db.GenesisValidatorsRoot(ctx)
+			require.NoError(t, err)
+			assert.DeepEqual(t, genValRoot, savedRoot)
+			schedule, err := db.ForkSchedule(ctx)
+			require.NoError(t, err)
+			assert.DeepEqual(t, params.BeaconConfig().ForkVersionSchedule, schedule)
+
+			// The beacon node is only checked once.
+			require.NoError(t, v.CheckBeaconNodeChain(ctx))
+
+			// A beacon node of another network is refused after failing over, every time it is checked.
+			client.EXPECT().SetHost("http://localhost:3501")
+			v.ChangeHost()
+			otherRoot := bytesutil.PadTo([]byte("other validators"), fieldparams.RootLength)
+			for i := 0; i < 2; i++ {
+				client.EXPECT().WaitForChainStart(gomock.Any(), gomock.Any()).Return(&ethpb.ChainStartResponse{
+					Started:               true,
+					GenesisTime:           uint64(time.Now().Unix()),
+					GenesisValidatorsRoot: otherRoot,
+				}, nil)
+				require.ErrorIs(t, v.CheckBeaconNodeChain(ctx), ErrWrongChain)
+			}
+			require.LogsContain(t, hook, "--accept-genesis-change")
+			savedRoot, err = db.GenesisValidatorsRoot(ctx)
+			require.NoError(t, err)
+			assert.DeepEqual(t, genValRoot, savedRoot)
+
+			// Failing back over to a beacon node of the network is accepted again.
+			client.EXPECT().SetHost("http://localhost:3500")
+			v.ChangeHost()
+			expectBeaconNodeChain(t, client, genValRoot, params.BeaconConfig().GenesisForkVersion)
+			require.NoError(t, v.CheckBeaconNodeChain(ctx))
+		})
+	}
+}
+
+func TestCheckBeaconNodeChain_WrongFork(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := validatormock.NewMockValidatorClient(ctrl)
+	v := &validator{
+		validatorClient: client,
+		db:              dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false),
+	}
+	genValRoot := bytesutil.PadTo([]byte("validators"), fieldparams.RootLength)
+
+	// The beacon node signs with the domain of another fork than the one scheduled by the validator client.
+	expectBeaconNodeChain(t, client, genValRoot, params.BeaconConfig().AltairForkVersion)
+	require.ErrorIs(t, v.CheckBeaconNodeChain(ctx), ErrWrongChain)
+	assert.Equal(t, false, v.beaconNodeChainChecked.Load())
+}
+
+func TestCheckGenesis_ForkSchedule(t *testing.T) {
+	ctx := context.Background()
+	genValRoot := bytesutil.PadTo([]byte("validators"), fieldparams.RootLength)
+	schedule := params.BeaconConfig().ForkVersionSchedule
+
+	t.Run("fork scheduled later is updated", func(t *testing.T) {
+		db := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+		v := &validator{db: db}
+		saved := make(map[[fieldparams.VersionLength]byte]primitives.Epoch)
+		for version, epoch := range schedule {
+			saved[version] = epoch
+		}
+		saved[[fieldparams.VersionLength]byte{0xff}] = params.BeaconConfig().FarFutureEpoch
+		require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, genValRoot))
+		require.NoError(t, db.SaveForkSchedule(ctx, saved))
+
+		require.NoError(t, v.checkGenesis(ctx, genValRoot, uint64(time.Now().Unix())))
+		got, err := db.ForkSchedule(ctx)
+		require.NoError(t, err)
+		assert.DeepEqual(t, schedule, got)
+	})
+
+	t.Run("activated fork differs", func(t *testing.T) {
+		db := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+		v := &validator{db: db}
+		saved := map[[fieldparams.VersionLength]byte]primitives.Epoch{{0xff}: 0}
+		require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, genValRoot))
+		require.NoError(t, db.SaveForkSchedule(ctx, saved))
+
+		require.ErrorIs(t, v.checkGenesis(ctx, genValRoot, uint64(time.Now().Unix())), ErrWrongChain)
+		got, err := db.ForkSchedule(ctx)
+		require.NoError(t, err)
+		assert.DeepEqual(t, saved, got)
+	})
+
+	t.Run("genesis change accepted", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		db := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+		v := &validator{db: db, acceptGenesisChange: true}
+		require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, bytesutil.PadTo([]byte("old validators"), fieldparams.RootLength)))
+		require.NoError(t, db.SaveForkSchedule(ctx, map[[fieldparams.VersionLength]byte]primitives.Epoch{{0xff}: 0}))
+
+		require.NoError(t, v.checkGenesis(ctx, genValRoot, uint64(time.Now().Unix())))
+		require.LogsContain(t, hook, "Accepting the genesis change")
+		savedRoot, err := db.GenesisValidatorsRoot(ctx)
+		require.NoError(t, err)
+		assert.DeepEqual(t, genValRoot, savedRoot)
+		got, err := db.ForkSchedule(ctx)
+		require.NoError(t, err)
+		assert.DeepEqual(t, schedule, got)
+	})
+}
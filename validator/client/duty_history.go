@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+// DutyHistoryEpochs is the number of epochs of duty history kept for each validator public key.
+const DutyHistoryEpochs = 64
+
+// dutyHistory keeps the outcome of the duties of each validator public key over the latest epochs, in a ring buffer
+// indexed by epoch, for the performance summary of the web API. It is saved to the validator database once per epoch
+// so that it survives restarts.
+type dutyHistory struct {
+	records map[[fieldparams.BLSPubkeyLength]byte]*[DutyHistoryEpochs]common.DutyRecord
+	lock    sync.RWMutex
+}
+
+func newDutyHistory(saved map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord) *dutyHistory {
+	h := &dutyHistory{records: make(map[[fieldparams.BLSPubkeyLength]byte]*[DutyHistoryEpochs]common.DutyRecord)}
+	for pubKey, records := range saved {
+		for _, r := range records {
+			h.update(pubKey, r.Epoch, func(rec *common.DutyRecord) { *rec = r })
+		}
+	}
+	return h
+}
+
+// update applies the change to the record of the public key at the epoch. The record is reset first if it holds an
+// older epoch, and the change is ignored if it holds a newer one.
+func (h *dutyHistory) update(pubKey [fieldparams.BLSPubkeyLength]byte, epoch primitives.Epoch, change func(r *common.DutyRecord)) {
+	if h == nil {
+		return
+	}
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	ring, ok := h.records[pubKey]
+	if !ok {
+		ring = &[DutyHistoryEpochs]common.DutyRecord{}
+		h.records[pubKey] = ring
+	}
+	r := &ring[epoch%DutyHistoryEpochs]
+	if r.Epoch > epoch {
+		return
+	}
+	if r.Epoch < epoch {
+		*r = common.DutyRecord{Epoch: epoch}
+	}
+	change(r)
+}
+
+// history returns the records of each public key over the epochs up to and including the given one, oldest first.
+// Epochs without any duty are left out.
+func (h *dutyHistory) history(epoch primitives.Epoch, epochs uint64) map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord {
+	history := make(map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord)
+	if h == nil {
+		return history
+	}
+	epochs = min(epochs, DutyHistoryEpochs)
+	oldest := primitives.Epoch(0)
+	if uint64(epoch) >= epochs {
+		oldest = epoch - primitives.Epoch(epochs) + 1
+	}
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	for pubKey, ring := range h.records {
+		var records []common.DutyRecord
+		for _, r := range ring {
+			if r.Epoch < oldest || r.Epoch > epoch || r == (common.DutyRecord{Epoch: r.Epoch}) {
+				continue
+			}
+			records = append(records, r)
+		}
+		if len(records) == 0 {
+			continue
+		}
+		sort.Slice(records, func(i, j int) bool { return records[i].Epoch < records[j].Epoch })
+		history[pubKey] = records
+	}
+	return history
+}
+
+// DutyHistory returns the outcome of the duties of each validator public key over the given number of epochs up to
+// the current one, oldest first.
+func (v *validator) DutyHistory(epochs uint64) map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord {
+	if v.genesisTime == 0 {
+		return make(map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord)
+	}
+	return v.dutyHistory.history(slots.ToEpoch(slots.CurrentSlot(v.genesisTime)), epochs)
+}
+
+// SaveDutyHistory saves the duty history to the validator database at the end of each epoch.
+func (v *validator) SaveDutyHistory(ctx context.Context, slot primitives.Slot) error {
+	if v.dutyHistory == nil || !slots.IsEpochEnd(slot) {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.SaveDutyHistory")
+	defer span.End()
+	if err := v.db.SaveDutyHistory(ctx, v.dutyHistory.history(slots.ToEpoch(slot), DutyHistoryEpochs)); err != nil {
+		return errors.Wrap(err, "could not save duty history")
+	}
+	return nil
+}
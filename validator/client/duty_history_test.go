@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	dbTest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+)
+
+func TestDutyHistory(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	h := newDutyHistory(map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord{
+		pubKey: {{Epoch: 1, AttestationAssigned: true, AttestationSubmitted: true}},
+	})
+	h.update(pubKey, 2, func(r *common.DutyRecord) { r.AttestationAssigned = true })
+	h.update(pubKey, 2, func(r *common.DutyRecord) { r.ProposalsAssigned++ })
+	h.update(pubKey, 2, func(r *common.DutyRecord) { r.ProposalsAssigned++ })
+	assert.DeepEqual(t, map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord{
+		pubKey: {
+			{Epoch: 1, AttestationAssigned: true, AttestationSubmitted: true},
+			{Epoch: 2, AttestationAssigned: true, ProposalsAssigned: 2},
+		},
+	}, h.history(2, 32))
+	assert.Equal(t, 1, len(h.history(2, 1)[pubKey]))
+	assert.Equal(t, 0, len(h.history(0, 32)))
+
+	// A newer epoch replaces the record it wraps around to in the ring, and older epochs are not recorded over it.
+	wrapped := primitives.Epoch(1 + DutyHistoryEpochs)
+	h.update(pubKey, wrapped, func(r *common.DutyRecord) { r.AggregationAssigned = true })
+	h.update(pubKey, 1, func(r *common.DutyRecord) { r.AggregationSubmitted = true })
+	assert.DeepEqual(t, []common.DutyRecord{
+		{Epoch: 2, AttestationAssigned: true, ProposalsAssigned: 2},
+		{Epoch: wrapped, AggregationAssigned: true},
+	}, h.history(wrapped, DutyHistoryEpochs)[pubKey])
+
+	// Recording on a validator without duty history does nothing.
+	var none *dutyHistory
+	none.update(pubKey, 1, func(r *common.DutyRecord) { r.AttestationAssigned = true })
+	assert.Equal(t, 0, len(none.history(1, 32)))
+}
+
+func TestSaveDutyHistory(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+	v := &validator{db: db, dutyHistory: newDutyHistory(nil)}
+	v.dutyHistory.update(pubKey, 1, func(r *common.DutyRecord) { r.AttestationAssigned = true })
+
+	// The duty history is only saved at the end of an epoch.
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, v.SaveDutyHistory(ctx, slotsPerEpoch+1))
+	saved, err := db.DutyHistory(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(saved))
+
+	require.NoError(t, v.SaveDutyHistory(ctx, 2*slotsPerEpoch-1))
+	saved, err = db.DutyHistory(ctx)
+	require.NoError(t, err)
+	want := map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord{pubKey: {{Epoch: 1, AttestationAssigned: true}}}
+	assert.DeepEqual(t, want, saved)
+
+	// The saved duty history is loaded back on restart.
+	assert.DeepEqual(t, want, newDutyHistory(saved).history(1, DutyHistoryEpochs))
+}
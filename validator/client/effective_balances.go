@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// logEffectiveBalances logs the effective balances which changed since the previous epoch and, when account metrics
+// are enabled, reports how far each balance is from the hysteresis thresholds of the next epoch transition.
+// The caller must hold prevEpochBalancesLock.
+func (v *validator) logEffectiveBalances(resp *ethpb.ValidatorPerformanceResponse, slot primitives.Slot, credentials map[[fieldparams.BLSPubkeyLength]byte][]byte) {
+	gweiPerEth := float64(params.BeaconConfig().GweiPerEth)
+	epoch := slots.ToEpoch(slot)
+	// Only Electra changes the limit of the effective balance.
+	fork := version.Deneb
+	if epoch >= params.BeaconConfig().ElectraForkEpoch {
+		fork = version.Electra
+	}
+	if v.effectiveBalances == nil {
+		v.effectiveBalances = make(map[[fieldparams.BLSPubkeyLength]byte]uint64)
+	}
+	for i, pubKey := range resp.PublicKeys {
+		if i >= len(resp.CurrentEffectiveBalances) || i >= len(resp.BalancesAfterEpochTransition) {
+			continue
+		}
+		pubKeyBytes := bytesutil.ToBytes48(pubKey)
+		effectiveBalance := resp.CurrentEffectiveBalances[i]
+		balance := resp.BalancesAfterEpochTransition[i]
+		if prev, ok := v.effectiveBalances[pubKeyBytes]; ok && prev != effectiveBalance {
+			log.WithFields(logrus.Fields{
+				"pubkey":              fmt.Sprintf("%#x", bytesutil.Trunc(pubKey)),
+				"epoch":               epoch,
+				"oldEffectiveBalance": float64(prev) / gweiPerEth,
+				"newEffectiveBalance": float64(effectiveBalance) / gweiPerEth,
+				"balance":             float64(balance) / gweiPerEth,
+			}).Info("Effective balance changed")
+		}
+		v.effectiveBalances[pubKeyBytes] = effectiveBalance
+
+		if !v.emitAccountMetrics {
+			continue
+		}
+		fmtKey := fmt.Sprintf("%#x", pubKey)
+		ValidatorEffectiveBalanceGaugeVec.WithLabelValues(fmtKey).Set(float64(effectiveBalance) / gweiPerEth)
+		creds, ok := credentials[pubKeyBytes]
+		if !ok {
+			// The limit of the effective balance depends on the withdrawal credentials from Electra.
+			continue
+		}
+		h := helpers.ComputeEffectiveBalanceHysteresis(balance, effectiveBalance, helpers.EffectiveBalanceLimit(creds, fork))
+		if h.WillChange {
+			ValidatorEffectiveBalanceWillChangeGaugeVec.WithLabelValues(fmtKey).Set(1)
+		} else {
+			ValidatorEffectiveBalanceWillChangeGaugeVec.WithLabelValues(fmtKey).Set(0)
+		}
+		if h.CanDecrease {
+			ValidatorEffectiveBalanceThresholdDistanceGaugeVec.WithLabelValues(fmtKey, "down").Set(float64(h.DownwardDistance))
+		} else {
+			ValidatorEffectiveBalanceThresholdDistanceGaugeVec.DeleteLabelValues(fmtKey, "down")
+		}
+		if h.CanIncrease {
+			ValidatorEffectiveBalanceThresholdDistanceGaugeVec.WithLabelValues(fmtKey, "up").Set(float64(h.UpwardDistance))
+		} else {
+			ValidatorEffectiveBalanceThresholdDistanceGaugeVec.DeleteLabelValues(fmtKey, "up")
+		}
+	}
+}
+
+// withdrawalCredentials fetches the withdrawal credentials of the validators from the head state of the beacon node.
+func (v *validator) withdrawalCredentials(ctx context.Context, pubKeys [][]byte) (map[[fieldparams.BLSPubkeyLength]byte][]byte, error) {
+	credentials := make(map[[fieldparams.BLSPubkeyLength]byte][]byte, len(pubKeys))
+	validators, err := v.chainClient.AllValidators(ctx, &ethpb.ListValidatorsRequest{PublicKeys: pubKeys})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list validators")
+	}
+	for _, c := range validators {
+		if c.Validator == nil {
+			continue
+		}
+		credentials[bytesutil.ToBytes48(c.Validator.PublicKey)] = c.Validator.WithdrawalCredentials
+	}
+	return credentials, nil
+}
@@ -2,8 +2,10 @@ package grpc_api
 
 import (
 	"context"
+	"io"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	"google.golang.org/grpc"
@@ -25,6 +27,24 @@ func (c *grpcChainClient) Validators(ctx context.Context, in *ethpb.ListValidato
 	return c.beaconChainClient.ListValidators(ctx, in)
 }
 
+func (c *grpcChainClient) AllValidators(ctx context.Context, in *ethpb.ListValidatorsRequest) ([]*ethpb.Validators_ValidatorContainer, error) {
+	stream, err := c.beaconChainClient.StreamValidators(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	validators := make([]*ethpb.Validators_ValidatorContainer, 0)
+	for {
+		v, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return validators, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, v)
+	}
+}
+
 func (c *grpcChainClient) ValidatorQueue(ctx context.Context, in *empty.Empty) (*ethpb.ValidatorQueue, error) {
 	return c.beaconChainClient.GetValidatorQueue(ctx, in)
 }
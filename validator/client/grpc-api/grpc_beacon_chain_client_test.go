@@ -0,0 +1,52 @@
+package grpc_api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	mock2 "github.com/prysmaticlabs/prysm/v5/testing/mock"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAllValidators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	want := []*eth.Validators_ValidatorContainer{
+		{Index: 1, Validator: &eth.Validator{PublicKey: []byte{1}}},
+		{Index: 2, Validator: &eth.Validator{PublicKey: []byte{2}}},
+	}
+	req := &eth.ListValidatorsRequest{Active: true}
+	beaconChainClient := mock2.NewMockBeaconChainClient(ctrl)
+	stream := mock2.NewMockBeaconChain_StreamValidatorsClient(ctrl)
+	beaconChainClient.EXPECT().StreamValidators(gomock.Any(), req).Return(stream, nil)
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(want[0], nil),
+		stream.EXPECT().Recv().Return(want[1], nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+
+	chainClient := &grpcChainClient{beaconChainClient}
+	got, err := chainClient.AllValidators(context.Background(), req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, got)
+}
+
+func TestAllValidators_RecvFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	beaconChainClient := mock2.NewMockBeaconChainClient(ctrl)
+	stream := mock2.NewMockBeaconChain_StreamValidatorsClient(ctrl)
+	beaconChainClient.EXPECT().StreamValidators(gomock.Any(), gomock.Any()).Return(stream, nil)
+	stream.EXPECT().Recv().Return(nil, errors.New("connection reset"))
+
+	chainClient := &grpcChainClient{beaconChainClient}
+	_, err := chainClient.AllValidators(context.Background(), &eth.ListValidatorsRequest{})
+	assert.ErrorContains(t, "connection reset", err)
+}
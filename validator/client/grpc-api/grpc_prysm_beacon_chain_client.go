@@ -22,13 +22,13 @@ type grpcPrysmChainClient struct {
 }
 
 func (g grpcPrysmChainClient) ValidatorCount(ctx context.Context, _ string, statuses []validator.Status) ([]iface.ValidatorCount, error) {
-	resp, err := g.chainClient.Validators(ctx, &ethpb.ListValidatorsRequest{PageSize: 0})
+	resp, err := g.chainClient.AllValidators(ctx, &ethpb.ListValidatorsRequest{})
 	if err != nil {
 		return nil, errors.Wrap(err, "list validators failed")
 	}
 
 	var vals []*ethpb.Validator
-	for _, val := range resp.ValidatorList {
+	for _, val := range resp {
 		vals = append(vals, val.Validator)
 	}
 
@@ -284,15 +284,15 @@ func TestGetValidatorCount(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			listValidatorResp := &ethpb.Validators{}
+			var listValidatorResp []*ethpb.Validators_ValidatorContainer
 			for _, val := range st.Validators() {
-				listValidatorResp.ValidatorList = append(listValidatorResp.ValidatorList, &ethpb.Validators_ValidatorContainer{
+				listValidatorResp = append(listValidatorResp, &ethpb.Validators_ValidatorContainer{
 					Validator: val,
 				})
 			}
 
 			chainClient := mock.NewMockChainClient(ctrl)
-			chainClient.EXPECT().Validators(
+			chainClient.EXPECT().AllValidators(
 				gomock.Any(),
 				gomock.Any(),
 			).Return(
@@ -11,6 +11,8 @@ type ChainClient interface {
 	ChainHead(ctx context.Context, in *empty.Empty) (*ethpb.ChainHead, error)
 	ValidatorBalances(ctx context.Context, in *ethpb.ListValidatorBalancesRequest) (*ethpb.ValidatorBalances, error)
 	Validators(ctx context.Context, in *ethpb.ListValidatorsRequest) (*ethpb.Validators, error)
+	// AllValidators returns every validator matched by the request. Its pagination fields are ignored.
+	AllValidators(ctx context.Context, in *ethpb.ListValidatorsRequest) ([]*ethpb.Validators_ValidatorContainer, error)
 	ValidatorQueue(ctx context.Context, in *empty.Empty) (*ethpb.ValidatorQueue, error)
 	ValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest) (*ethpb.ValidatorPerformanceResponse, error)
 	ValidatorParticipation(ctx context.Context, in *ethpb.GetValidatorParticipationRequest) (*ethpb.ValidatorParticipationResponse, error)
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 )
 
@@ -33,16 +34,34 @@ const (
 	RoleSyncCommitteeAggregator
 )
 
+// GraffitiSource identifies where the graffiti of a validator is configured.
+type GraffitiSource string
+
+const (
+	// GraffitiSourceNone means that no graffiti is configured for the validator.
+	GraffitiSourceNone GraffitiSource = ""
+	// GraffitiSourceProposerSettings means that the graffiti comes from the proposer settings, which hold the graffiti set through the keymanager API.
+	GraffitiSourceProposerSettings GraffitiSource = "proposer_settings"
+	// GraffitiSourceFlag means that the graffiti comes from the --graffiti flag.
+	GraffitiSourceFlag GraffitiSource = "flag"
+	// GraffitiSourceFile means that the graffiti comes from the graffiti file.
+	GraffitiSourceFile GraffitiSource = "graffiti_file"
+)
+
 // Validator interface defines the primary methods of a validator client.
 type Validator interface {
 	Done()
 	WaitForChainStart(ctx context.Context) error
+	CheckBeaconNodeChain(ctx context.Context) error
 	WaitForSync(ctx context.Context) error
 	WaitForActivation(ctx context.Context, accountsChangedChan chan [][fieldparams.BLSPubkeyLength]byte) error
 	CanonicalHeadSlot(ctx context.Context) (primitives.Slot, error)
 	NextSlot() <-chan primitives.Slot
 	SlotDeadline(slot primitives.Slot) time.Time
 	LogValidatorGainsAndLosses(ctx context.Context, slot primitives.Slot) error
+	LogEstimatedRewards(ctx context.Context, slot primitives.Slot) error
+	DutyHistory(epochs uint64) map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord
+	SaveDutyHistory(ctx context.Context, slot primitives.Slot) error
 	UpdateDuties(ctx context.Context, slot primitives.Slot) error
 	RolesAt(ctx context.Context, slot primitives.Slot) (map[[fieldparams.BLSPubkeyLength]byte][]ValidatorRole, error) // validator pubKey -> roles
 	SubmitAttestation(ctx context.Context, slot primitives.Slot, pubKey [fieldparams.BLSPubkeyLength]byte)
@@ -65,6 +84,7 @@ type Validator interface {
 	ProposerSettings() *proposer.Settings
 	SetProposerSettings(context.Context, *proposer.Settings) error
 	Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error)
+	GraffitiWithSource(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, GraffitiSource, error)
 	SetGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, graffiti []byte) error
 	DeleteGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error
 	HealthTracker() *beacon.NodeHealthTracker
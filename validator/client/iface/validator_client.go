@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -120,6 +121,19 @@ func (s *SyncCommitteeSelection) UnmarshalJSON(input []byte) error {
 	return nil
 }
 
+// DutyDeadlines are the times into a slot at which a distributed validator middleware expects validators to attest
+// and aggregate. A zero value means the middleware did not set the deadline.
+type DutyDeadlines struct {
+	Attestation time.Duration
+	Aggregation time.Duration
+}
+
+// DutyDeadlinesProvider is implemented by validator clients which learn the duty deadlines of slots from the
+// duties returned by a distributed validator middleware.
+type DutyDeadlinesProvider interface {
+	DutyDeadlines(slot primitives.Slot) (DutyDeadlines, bool)
+}
+
 type ValidatorClient interface {
 	Duties(ctx context.Context, in *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error)
 	DomainData(ctx context.Context, in *ethpb.DomainRequest) (*ethpb.DomainResponse, error)
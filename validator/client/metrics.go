@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/sirupsen/logrus"
 )
 
@@ -57,6 +58,30 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorBlockRequestAttemptsVec used to count block requests to the beacon node by result.
+	ValidatorBlockRequestAttemptsVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "block_request_attempts_total",
+			Help:      "Number of block requests made to the beacon node while proposing, by result.",
+		},
+		[]string{
+			"result",
+		},
+	)
+	// ValidatorRequestFailuresVec used to count failed requests to the beacon node made while performing duties,
+	// telling apart the failures of a syncing beacon node from genuine errors.
+	ValidatorRequestFailuresVec = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_request_failures_total",
+			Help:      "Number of failed requests to the beacon node made while performing duties, by duty and reason.",
+		},
+		[]string{
+			"duty",
+			"reason",
+		},
+	)
 	// ValidatorProposeFailVec used to count failed proposals.
 	ValidatorProposeFailVec = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -78,6 +103,41 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorEffectiveBalanceGaugeVec used to keep track of validator effective balances by public key.
+	ValidatorEffectiveBalanceGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "effective_balance",
+			Help:      "current validator effective balance.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorEffectiveBalanceThresholdDistanceGaugeVec used to track how far validator balances are from the
+	// hysteresis thresholds which change the effective balance.
+	ValidatorEffectiveBalanceThresholdDistanceGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "effective_balance_threshold_distance_gwei",
+			Help:      "Balance in Gwei to lose (direction=down) or gain (direction=up) before the effective balance changes at an epoch transition.",
+		},
+		[]string{
+			"pubkey",
+			"direction",
+		},
+	)
+	// ValidatorEffectiveBalanceWillChangeGaugeVec used to track whether effective balances change at the next epoch transition.
+	ValidatorEffectiveBalanceWillChangeGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "effective_balance_will_change",
+			Help:      "1 if the current balance changes the effective balance at the next epoch transition, 0 otherwise.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
 	// ValidatorAttestedSlotsGaugeVec used to keep track of validator attested slots by public key.
 	ValidatorAttestedSlotsGaugeVec = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -192,6 +252,53 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorEstimatedRewardsGaugeVec used to track the estimated rewards of the last processed epoch by public key and duty.
+	ValidatorEstimatedRewardsGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "estimated_rewards_gwei",
+			Help:      "Estimated rewards in Gwei of the last processed epoch, negative for penalties.",
+		},
+		[]string{
+			"pubkey",
+			"duty",
+		},
+	)
+	// ValidatorEstimatedRewardsAggregateGaugeVec used to track the estimated rewards of the last processed epoch of all keys by duty.
+	ValidatorEstimatedRewardsAggregateGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "estimated_rewards_aggregate_gwei",
+			Help:      "Estimated rewards in Gwei of the last processed epoch summed over all keys, negative for penalties.",
+		},
+		[]string{
+			"duty",
+		},
+	)
+	// ValidatorEstimatedRewardsFallbackCount used to count epochs estimated from balance changes.
+	ValidatorEstimatedRewardsFallbackCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "estimated_rewards_fallback_total",
+			Help:      "Number of epochs for which rewards were estimated from balance changes because the rewards API was unavailable.",
+		},
+	)
+	// ValidatorSelectionProofsGauge used to track the number of attestation selection proofs needed in the last slot.
+	ValidatorSelectionProofsGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "selection_proofs_per_slot",
+			Help:      "Number of attestation selection proofs needed in the last slot, which is the number of signing requests without batching.",
+		},
+	)
+	// ValidatorSelectionProofSignRequestsGauge used to track the number of signing requests sent for attestation selection proofs in the last slot.
+	ValidatorSelectionProofSignRequestsGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "selection_proof_sign_requests_per_slot",
+			Help:      "Number of signing requests sent to the keymanager for attestation selection proofs in the last slot.",
+		},
+	)
 	// ValidatorInactivityScoreGaugeVec used to track validator inactivity scores.
 	ValidatorInactivityScoreGaugeVec = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -233,6 +340,13 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot primiti
 	if err != nil {
 		return err
 	}
+	var credentials map[[fieldparams.BLSPubkeyLength]byte][]byte
+	if v.emitAccountMetrics {
+		credentials, err = v.withdrawalCredentials(ctx, resp.PublicKeys)
+		if err != nil {
+			log.WithError(err).Warn("Could not fetch withdrawal credentials, effective balance thresholds are not reported")
+		}
+	}
 
 	if v.emitAccountMetrics {
 		// There is no distinction between unknown and pending validators here.
@@ -254,6 +368,7 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot primiti
 	for i, pubKey := range resp.PublicKeys {
 		v.logForEachValidator(i, pubKey, resp, slot, prevEpoch)
 	}
+	v.logEffectiveBalances(resp, slot, credentials)
 	v.prevEpochBalancesLock.Unlock()
 
 	v.UpdateLogAggregateStats(resp, slot)
@@ -298,6 +413,11 @@ func (v *validator) logForEachValidator(index int, pubKey []byte, resp *ethpb.Va
 	} else {
 		log.WithField("pubkey", truncatedKey).Warn("Missing correctly voted head")
 	}
+	// The inclusion distance is only reported by the beacon node before Altair, and is the far future slot when the
+	// attestation was not included.
+	if index < len(resp.InclusionDistances) && resp.InclusionDistances[index] != params.BeaconConfig().FarFutureSlot {
+		v.dutyHistory.update(pubKeyBytes, prevEpoch, func(r *common.DutyRecord) { r.InclusionDistance = resp.InclusionDistances[index] })
+	}
 
 	if _, ok := v.startBalances[pubKeyBytes]; !ok {
 		v.startBalances[pubKeyBytes] = balBeforeEpoch
@@ -1,16 +1,23 @@
 package client
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"go.uber.org/mock/gomock"
 )
 
 func TestUpdateLogAggregateStats(t *testing.T) {
@@ -163,3 +170,61 @@ func TestUpdateLogAltairAggregateStats(t *testing.T) {
 		"correctlyVotedHeadPct=\"86%\" correctlyVotedSourcePct=\"100%\" "+
 		"correctlyVotedTargetPct=\"71%\" numberOfEpochs=3 pctChangeCombinedBalance=\"0.20555%\"")
 }
+
+func TestLogEffectiveBalances(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ElectraForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	v := &validator{
+		emitAccountMetrics: true,
+		effectiveBalances:  make(map[[fieldparams.BLSPubkeyLength]byte]uint64),
+	}
+	eth1Key := bytesutil.ToBytes48([]byte("eth1"))
+	compoundingKey := bytesutil.ToBytes48([]byte("compounding"))
+	credentials := map[[fieldparams.BLSPubkeyLength]byte][]byte{
+		eth1Key:        {cfg.ETH1AddressWithdrawalPrefixByte},
+		compoundingKey: {cfg.CompoundingWithdrawalPrefixByte},
+	}
+	v.effectiveBalances[compoundingKey] = 32_000_000_000
+	resp := &ethpb.ValidatorPerformanceResponse{
+		PublicKeys:                   [][]byte{eth1Key[:], compoundingKey[:]},
+		CurrentEffectiveBalances:     []uint64{32_000_000_000, 33_000_000_000},
+		BalancesAfterEpochTransition: []uint64{34_500_000_000, 34_500_000_000},
+	}
+	hook := logTest.NewGlobal()
+	v.logEffectiveBalances(resp, params.BeaconConfig().SlotsPerEpoch*10, credentials)
+	require.LogsContain(t, hook, "Effective balance changed")
+	require.LogsContain(t, hook, "newEffectiveBalance=33 oldEffectiveBalance=32")
+	assert.Equal(t, uint64(32_000_000_000), v.effectiveBalances[eth1Key])
+
+	gauge := func(c prometheus.Collector) float64 {
+		m := &dto.Metric{}
+		require.NoError(t, c.(prometheus.Metric).Write(m))
+		return m.GetGauge().GetValue()
+	}
+	eth1Fmt, compoundingFmt := fmt.Sprintf("%#x", eth1Key), fmt.Sprintf("%#x", compoundingKey)
+	assert.Equal(t, float64(33), gauge(ValidatorEffectiveBalanceGaugeVec.WithLabelValues(compoundingFmt)))
+	// The effective balance of the validator with eth1 credentials is at its limit.
+	assert.Equal(t, float64(0), gauge(ValidatorEffectiveBalanceWillChangeGaugeVec.WithLabelValues(eth1Fmt)))
+	assert.Equal(t, false, ValidatorEffectiveBalanceThresholdDistanceGaugeVec.DeleteLabelValues(eth1Fmt, "up"))
+	assert.Equal(t, float64(2_750_000_001), gauge(ValidatorEffectiveBalanceThresholdDistanceGaugeVec.WithLabelValues(eth1Fmt, "down")))
+	// 34.5 ETH is more than 33 + 1.25 ETH, the effective balance of the compounding validator increases again.
+	assert.Equal(t, float64(1), gauge(ValidatorEffectiveBalanceWillChangeGaugeVec.WithLabelValues(compoundingFmt)))
+	assert.Equal(t, float64(0), gauge(ValidatorEffectiveBalanceThresholdDistanceGaugeVec.WithLabelValues(compoundingFmt, "up")))
+}
+
+func TestWithdrawalCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	chainClient := validatormock.NewMockChainClient(ctrl)
+	v := &validator{chainClient: chainClient}
+	pubKeys := [][]byte{bytesutil.PadTo([]byte{1}, 48), bytesutil.PadTo([]byte{2}, 48)}
+	chainClient.EXPECT().AllValidators(gomock.Any(), &ethpb.ListValidatorsRequest{PublicKeys: pubKeys}).Return([]*ethpb.Validators_ValidatorContainer{
+		{Validator: &ethpb.Validator{PublicKey: pubKeys[0], WithdrawalCredentials: []byte{1}}},
+		{Validator: &ethpb.Validator{PublicKey: pubKeys[1], WithdrawalCredentials: []byte{2}}},
+	}, nil)
+	credentials, err := v.withdrawalCredentials(context.Background(), pubKeys)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(credentials))
+	assert.DeepEqual(t, []byte{2}, credentials[bytesutil.ToBytes48(pubKeys[1])])
+}
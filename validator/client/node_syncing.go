@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Duties performed by the validator, as reported in the beacon node request failures metric.
+const (
+	dutyUpdateDuties          = "update_duties"
+	dutyAttest                = "attest"
+	dutyPropose               = "propose"
+	dutyAggregate             = "aggregate"
+	dutySyncCommitteeMessage  = "sync_committee_message"
+	dutySyncCommitteeContrib  = "sync_committee_contribution"
+	requestFailureNodeSyncing = "node_syncing"
+	requestFailureError       = "error"
+)
+
+// nodeSyncingBackoff returns how long to wait before making duty requests to a beacon node which reported it is
+// syncing. The delay of the Retry-After header is used when present, otherwise one slot, and is capped to one epoch
+// so that a node recovering earlier than it announced does not leave the validator idle for long.
+func nodeSyncingBackoff(retryAfter time.Duration) time.Duration {
+	slot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	if retryAfter <= 0 {
+		return slot
+	}
+	if epoch := slot * time.Duration(params.BeaconConfig().SlotsPerEpoch); retryAfter > epoch {
+		return epoch
+	}
+	return retryAfter
+}
+
+// handleNodeSyncing starts backing off from duty requests to the current beacon node if the error was returned by a
+// syncing beacon node, in which case it returns true.
+func (v *validator) handleNodeSyncing(err error) bool {
+	var syncingErr *client.NodeSyncingError
+	if !errors.As(err, &syncingErr) {
+		return false
+	}
+	host := v.nodeSyncingHost()
+	until := prysmTime.Now().Add(nodeSyncingBackoff(syncingErr.RetryAfter))
+	v.nodeSyncingLock.Lock()
+	defer v.nodeSyncingLock.Unlock()
+	if v.nodeSyncingUntil == nil {
+		v.nodeSyncingUntil = make(map[string]time.Time)
+	}
+	if until.After(v.nodeSyncingUntil[host]) {
+		v.nodeSyncingUntil[host] = until
+	}
+	return true
+}
+
+// isNodeSyncing returns true while backing off from duty requests after the current beacon node reported it is
+// syncing.
+func (v *validator) isNodeSyncing() bool {
+	host := v.nodeSyncingHost()
+	v.nodeSyncingLock.RLock()
+	defer v.nodeSyncingLock.RUnlock()
+	return prysmTime.Now().Before(v.nodeSyncingUntil[host])
+}
+
+// nodeSyncingHost returns the configured beacon node which duty requests are currently sent to, as the key of its back
+// off.
+func (v *validator) nodeSyncingHost() string {
+	if v.currentHostIndex >= uint64(len(v.beaconNodeHosts)) {
+		return ""
+	}
+	return v.beaconNodeHosts[v.currentHostIndex]
+}
+
+// clearNodeSyncing stops backing off from duty requests to the beacon node.
+func (v *validator) clearNodeSyncing(host string) {
+	v.nodeSyncingLock.Lock()
+	defer v.nodeSyncingLock.Unlock()
+	delete(v.nodeSyncingUntil, host)
+}
+
+// probeNodeSyncing returns true when duties should be skipped in the slot because the current beacon node is syncing.
+// A syncing status reported by any request only starts the back off: the node is asked whether it is still syncing
+// each slot, and duties are performed as soon as it is not, or when it cannot tell.
+func (v *validator) probeNodeSyncing(ctx context.Context, slot primitives.Slot) bool {
+	if !v.isNodeSyncing() {
+		return false
+	}
+	host := v.nodeSyncingHost()
+	status, err := v.nodeClient.SyncStatus(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("slot", slot).Debug("Could not check whether the beacon node is still syncing")
+		return false
+	}
+	if !status.Syncing {
+		log.WithField("slot", slot).Info("Beacon node is no longer syncing, resuming duties")
+		v.clearNodeSyncing(host)
+		return false
+	}
+	return true
+}
+
+// logRequestError logs a failed request to the beacon node made while performing a duty and counts it by reason.
+// A syncing beacon node is expected to fail requests until it catches up, so this is logged as a warning and
+// further duty requests are deferred until the back off expires.
+func (v *validator) logRequestError(log *logrus.Entry, duty string, err error, msg string) {
+	if v.handleNodeSyncing(err) {
+		ValidatorRequestFailuresVec.WithLabelValues(duty, requestFailureNodeSyncing).Inc()
+		log.WithError(err).Warn(msg + ", beacon node is syncing")
+		return
+	}
+	ValidatorRequestFailuresVec.WithLabelValues(duty, requestFailureError).Inc()
+	log.WithError(err).Error(msg)
+}
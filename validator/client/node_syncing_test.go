@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNodeSyncingBackoff(t *testing.T) {
+	slot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	epoch := slot * time.Duration(params.BeaconConfig().SlotsPerEpoch)
+	assert.Equal(t, slot, nodeSyncingBackoff(0))
+	assert.Equal(t, 3*time.Second, nodeSyncingBackoff(3*time.Second))
+	assert.Equal(t, epoch, nodeSyncingBackoff(time.Hour))
+}
+
+func TestUpdateDuties_NodeSyncing(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := validatormock.NewMockValidatorClient(ctrl)
+	nodeClient := validatormock.NewMockNodeClient(ctrl)
+
+	duties := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{
+				CommitteeIndex: 1,
+			},
+		},
+	}
+	v := validator{
+		validatorClient: validatorClient,
+		nodeClient:      nodeClient,
+		km:              newMockKeymanager(t, randKeypair(t)),
+		duties:          duties,
+	}
+	slot := params.BeaconConfig().SlotsPerEpoch
+
+	validatorClient.EXPECT().Duties(gomock.Any(), gomock.Any()).Return(nil, &client.NodeSyncingError{RetryAfter: time.Minute})
+	err := v.UpdateDuties(context.Background(), slot)
+	require.ErrorIs(t, err, client.ErrNodeSyncing)
+	assert.Equal(t, duties, v.duties, "Assignments should be kept while the beacon node is syncing")
+	assert.Equal(t, true, v.dutiesInvalidated)
+	assert.Equal(t, true, v.isNodeSyncing())
+	require.LogsContain(t, hook, "beacon node is syncing")
+	require.LogsDoNotContain(t, hook, "level=error")
+
+	// Duties are not requested while backing off, and no duty is performed while the node says it is syncing.
+	require.NoError(t, v.UpdateDuties(context.Background(), slot+1))
+	nodeClient.EXPECT().SyncStatus(gomock.Any(), gomock.Any()).Return(&ethpb.SyncStatus{Syncing: true}, nil)
+	roles, err := v.RolesAt(context.Background(), slot+1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roles))
+	assert.Equal(t, true, v.isNodeSyncing())
+
+	// The node is probed each slot, and the back off ends as soon as it is no longer syncing.
+	nodeClient.EXPECT().SyncStatus(gomock.Any(), gomock.Any()).Return(&ethpb.SyncStatus{Syncing: false}, nil)
+	roles, err = v.RolesAt(context.Background(), slot+2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(roles))
+	assert.Equal(t, false, v.isNodeSyncing())
+	require.LogsContain(t, hook, "Beacon node is no longer syncing")
+
+	// The assignments are updated once the back off ended.
+	validatorClient.EXPECT().Duties(gomock.Any(), gomock.Any()).Return(&ethpb.DutiesResponse{}, nil)
+	validatorClient.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	require.NoError(t, v.UpdateDuties(context.Background(), slot+3))
+	assert.Equal(t, false, v.dutiesInvalidated)
+	assert.Equal(t, false, v.isNodeSyncing())
+}
+
+func TestRolesAt_NodeSyncingProbeFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := validatormock.NewMockValidatorClient(ctrl)
+	nodeClient := validatormock.NewMockNodeClient(ctrl)
+	slot := params.BeaconConfig().SlotsPerEpoch
+	v := validator{
+		validatorClient: validatorClient,
+		nodeClient:      nodeClient,
+		km:              newMockKeymanager(t, randKeypair(t)),
+		duties: &ethpb.DutiesResponse{
+			CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{AttesterSlot: slot + 1, PublicKey: make([]byte, 48)}},
+		},
+		distributed: true,
+	}
+
+	// A 503 from another call does not drop the attestation duties when the node cannot tell whether it is syncing.
+	require.Equal(t, true, v.handleNodeSyncing(&client.NodeSyncingError{}))
+	nodeClient.EXPECT().SyncStatus(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+	roles, err := v.RolesAt(context.Background(), slot+1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(roles))
+	for _, r := range roles {
+		assert.Equal(t, iface.RoleAttester, r[0])
+	}
+}
+
+func TestNodeSyncing_PerHost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := validatormock.NewMockValidatorClient(ctrl)
+	hosts := []string{"http://localhost:3500", "http://localhost:3501"}
+	validatorClient.EXPECT().SetHost(gomock.Any()).AnyTimes()
+	v := validator{
+		validatorClient: validatorClient,
+		beaconNodeHosts: hosts,
+	}
+
+	// The back off applies to the beacon node which reported it is syncing.
+	require.Equal(t, true, v.handleNodeSyncing(&client.NodeSyncingError{RetryAfter: time.Minute}))
+	assert.Equal(t, true, v.isNodeSyncing())
+	v.ChangeHost()
+	assert.Equal(t, false, v.isNodeSyncing())
+
+	// A back off from an earlier switch is cleared when switching back to the node.
+	require.Equal(t, true, v.handleNodeSyncing(&client.NodeSyncingError{RetryAfter: time.Minute}))
+	v.ChangeHost()
+	assert.Equal(t, false, v.isNodeSyncing())
+	v.ChangeHost()
+	assert.Equal(t, false, v.isNodeSyncing())
+}
+
+func TestNodeSyncing_BeaconNodeRecovers(t *testing.T) {
+	const syncingRequests = 3
+	var requests int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/validator/attestation_data", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= syncingRequests {
+			w.Header().Set("Retry-After", "2")
+			httputil.HandleError(w, "Beacon node is currently syncing and not serving request on that endpoint", http.StatusServiceUnavailable)
+			return
+		}
+		root := "0x" + strings.Repeat("ab", 32)
+		w.Header().Set("Content-Type", api.JsonMediaType)
+		require.NoError(t, json.NewEncoder(w).Encode(&structs.GetAttestationDataResponse{
+			Data: &structs.AttestationData{
+				Slot:            "1",
+				CommitteeIndex:  "0",
+				BeaconBlockRoot: root,
+				Source:          &structs.Checkpoint{Epoch: "0", Root: root},
+				Target:          &structs.Checkpoint{Epoch: "0", Root: root},
+			},
+		}))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	v := validator{
+		validatorClient: beaconApi.NewBeaconApiValidatorClient(beaconApi.NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second}, srv.URL)),
+	}
+	host := v.nodeSyncingHost()
+	req := &ethpb.AttestationDataRequest{Slot: 1}
+	for i := 0; i < syncingRequests; i++ {
+		_, err := v.validatorClient.AttestationData(context.Background(), req)
+		require.ErrorIs(t, err, client.ErrNodeSyncing)
+		require.Equal(t, true, v.handleNodeSyncing(err))
+		assert.Equal(t, true, v.isNodeSyncing())
+		assert.Equal(t, true, time.Until(v.nodeSyncingUntil[host]) > time.Second, "The back off should follow the Retry-After header")
+		// Let the back off expire.
+		v.clearNodeSyncing(host)
+	}
+	data, err := v.validatorClient.AttestationData(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), uint64(data.Slot))
+	assert.Equal(t, int32(syncingRequests+1), atomic.LoadInt32(&requests))
+	assert.Equal(t, false, v.isNodeSyncing())
+}
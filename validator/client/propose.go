@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"time"
+	"unicode/utf8"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/protobuf/ptypes/timestamp"
@@ -27,6 +28,8 @@ import (
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/proto"
 )
@@ -51,7 +54,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeBlock")
 	defer span.End()
 
-	lock := async.NewMultilock(fmt.Sprint(iface.RoleProposer), string(pubKey[:]))
+	lock := async.NewMultilock(proposerLockKeys(pubKey)...)
 	lock.Lock()
 	defer lock.Unlock()
 
@@ -61,6 +64,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 
 	// Sign randao reveal, it's used to request block from beacon node
 	epoch := primitives.Epoch(slot / params.BeaconConfig().SlotsPerEpoch)
+	v.dutyHistory.update(pubKey, epoch, func(r *common.DutyRecord) { r.ProposalsAssigned++ })
 	randaoReveal, err := v.signRandaoReveal(ctx, pubKey, epoch, slot)
 	if err != nil {
 		log.WithError(err).Error("Failed to sign randao reveal")
@@ -79,13 +83,16 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 	}
 
 	// Request block from beacon node
-	b, err := v.validatorClient.BeaconBlock(ctx, &ethpb.BlockRequest{
-		Slot:         slot,
-		RandaoReveal: randaoReveal,
-		Graffiti:     g,
-	})
+	minBuilderBid, minBuilderBidRatio := v.builderBidThresholds(pubKey)
+	b, err := v.requestBlock(ctx, &ethpb.BlockRequest{
+		Slot:                      slot,
+		RandaoReveal:              randaoReveal,
+		Graffiti:                  truncateGraffiti(g),
+		MinBuilderBid:             minBuilderBid,
+		MinBuilderBidToLocalRatio: minBuilderBidRatio,
+	}, log)
 	if err != nil {
-		log.WithField("slot", slot).WithError(err).Error("Failed to request block from beacon node")
+		v.logRequestError(log.WithField("slot", slot), dutyPropose, err, "Failed to request block from beacon node")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -162,9 +169,9 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 		}
 	}
 
-	blkResp, err := v.validatorClient.ProposeBeaconBlock(ctx, genericSignedBlock)
+	blkResp, err := v.proposeBeaconBlock(ctx, blk, genericSignedBlock, log)
 	if err != nil {
-		log.WithField("slot", slot).WithError(err).Error("Failed to propose block")
+		v.logRequestError(log.WithField("slot", slot), dutyPropose, err, "Failed to propose block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
@@ -181,9 +188,11 @@ func (v *validator) ProposeBlock(ctx context.Context, slot primitives.Slot, pubK
 		log.WithError(err).Error("Failed to log proposed block")
 	}
 
+	v.dutyHistory.update(pubKey, epoch, func(r *common.DutyRecord) { r.ProposalsSubmitted++ })
 	if v.emitAccountMetrics {
 		ValidatorProposeSuccessVec.WithLabelValues(fmtKey).Inc()
 	}
+	v.rewardsEstimator.trackProposal(slot, blk.Block().ProposerIndex())
 }
 
 func logProposedBlock(log *logrus.Entry, blk interfaces.SignedBeaconBlock, blkRoot []byte) error {
@@ -441,71 +450,165 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
+// builderBidThresholds returns the minimum builder bid, in Gwei, and the minimum ratio of the builder bid to the local
+// payload value for the beacon node to use the builder payload in the proposal of the validator. The values of the
+// proposer settings of the validator take precedence over the --min-builder-bid and --min-builder-bid-to-local-ratio
+// flags. Both are 0 when the builder is not enabled for the validator.
+func (v *validator) builderBidThresholds(pubKey [fieldparams.BLSPubkeyLength]byte) (uint64, float64) {
+	var builderConfig *proposer.BuilderConfig
+	if ps := v.ProposerSettings(); ps != nil {
+		if option, ok := ps.ProposeConfig[pubKey]; ok && option != nil && option.BuilderConfig != nil {
+			builderConfig = option.BuilderConfig
+		} else if ps.DefaultConfig != nil {
+			builderConfig = ps.DefaultConfig.BuilderConfig
+		}
+	}
+	if builderConfig == nil || !builderConfig.Enabled {
+		return 0, 0
+	}
+	minBid, minRatio := uint64(builderConfig.MinBid), builderConfig.MinBidToLocalRatio
+	if minBid == 0 {
+		minBid = v.minBuilderBid
+	}
+	if minRatio == 0 {
+		minRatio = v.minBuilderBidRatio
+	}
+	return minBid, minRatio
+}
+
 // Graffiti gets the graffiti from cli or file for the validator public key.
 func (v *validator) Graffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "validator.Graffiti")
 	defer span.End()
 
+	g, _, err := v.resolveGraffiti(ctx, pubKey, true)
+	return g, err
+}
+
+// GraffitiWithSource returns the graffiti configured for the validator public key together with where it is
+// configured. Unlike Graffiti, it does not consume the next entry of the ordered list of the graffiti file.
+func (v *validator) GraffitiWithSource(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, iface.GraffitiSource, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.GraffitiWithSource")
+	defer span.End()
+
+	return v.resolveGraffiti(ctx, pubKey, false)
+}
+
+func (v *validator) resolveGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, advanceOrdered bool) ([]byte, iface.GraffitiSource, error) {
 	if v.proposerSettings != nil {
 		// Check proposer settings for specific key first
 		if v.proposerSettings.ProposeConfig != nil {
 			option, ok := v.proposerSettings.ProposeConfig[pubKey]
 			if ok && option.GraffitiConfig != nil {
-				return []byte(option.GraffitiConfig.Graffiti), nil
+				return []byte(option.GraffitiConfig.Graffiti), iface.GraffitiSourceProposerSettings, nil
 			}
 		}
 		// Check proposer settings for default settings second
 		if v.proposerSettings.DefaultConfig != nil {
 			if v.proposerSettings.DefaultConfig.GraffitiConfig != nil {
-				return []byte(v.proposerSettings.DefaultConfig.GraffitiConfig.Graffiti), nil
+				return []byte(v.proposerSettings.DefaultConfig.GraffitiConfig.Graffiti), iface.GraffitiSourceProposerSettings, nil
 			}
 		}
 	}
 
-	// When specified, use default graffiti from the command line.
-	if len(v.graffiti) != 0 {
-		return bytesutil.PadTo(v.graffiti, 32), nil
-	}
-
 	if v.graffitiStruct == nil {
-		return nil, errors.New("graffitiStruct can't be nil")
+		// When specified, use default graffiti from the command line.
+		if len(v.graffiti) != 0 {
+			return bytesutil.PadTo(v.graffiti, 32), iface.GraffitiSourceFlag, nil
+		}
+		return nil, iface.GraffitiSourceNone, errors.New("graffitiStruct can't be nil")
 	}
 
-	// When specified, individual validator specified graffiti takes the third priority.
+	v.graffitiLock.Lock()
+	defer v.graffitiLock.Unlock()
+	v.reloadGraffitiFile(ctx)
+
+	// When specified, individual validator specified graffiti takes the second priority.
+	if g, ok := v.graffitiStruct.SpecificPubkeys[pubKey]; ok {
+		return bytesutil.PadTo([]byte(g), 32), iface.GraffitiSourceFile, nil
+	}
 	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
 	if err != nil {
-		return nil, err
+		return nil, iface.GraffitiSourceNone, err
 	}
 	g, ok := v.graffitiStruct.Specific[idx.Index]
 	if ok {
-		return bytesutil.PadTo([]byte(g), 32), nil
+		return bytesutil.PadTo([]byte(g), 32), iface.GraffitiSourceFile, nil
 	}
 
-	// When specified, a graffiti from the ordered list in the file take fourth priority.
+	// When specified, a graffiti from the ordered list in the file take third priority.
 	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
-		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
-		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
-		err := v.db.SaveGraffitiOrderedIndex(ctx, v.graffitiOrderedIndex)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to update graffiti ordered index")
+		ordered := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
+		if advanceOrdered {
+			v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
+			err := v.db.SaveGraffitiOrderedIndex(ctx, v.graffitiOrderedIndex)
+			if err != nil {
+				return nil, iface.GraffitiSourceNone, errors.Wrap(err, "failed to update graffiti ordered index")
+			}
 		}
-		return bytesutil.PadTo([]byte(graffiti), 32), nil
+		return bytesutil.PadTo([]byte(ordered), 32), iface.GraffitiSourceFile, nil
 	}
 
-	// When specified, a graffiti from the random list in the file take Fifth priority.
+	// When specified, a graffiti from the random list in the file take fourth priority.
 	if len(v.graffitiStruct.Random) != 0 {
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
 		i := r.Uint64() % uint64(len(v.graffitiStruct.Random))
-		return bytesutil.PadTo([]byte(v.graffitiStruct.Random[i]), 32), nil
+		return bytesutil.PadTo([]byte(v.graffitiStruct.Random[i]), 32), iface.GraffitiSourceFile, nil
 	}
 
-	// Finally, default graffiti if specified in the file will be used.
+	// Default graffiti if specified in the file will be used next.
 	if v.graffitiStruct.Default != "" {
-		return bytesutil.PadTo([]byte(v.graffitiStruct.Default), 32), nil
+		return bytesutil.PadTo([]byte(v.graffitiStruct.Default), 32), iface.GraffitiSourceFile, nil
+	}
+
+	// Finally, use default graffiti from the command line.
+	if len(v.graffiti) != 0 {
+		return bytesutil.PadTo(v.graffiti, 32), iface.GraffitiSourceFlag, nil
+	}
+
+	return []byte{}, iface.GraffitiSourceNone, nil
+}
+
+// reloadGraffitiFile picks up the changes made to the graffiti file since it was last read, so that graffiti can be
+// updated without restarting the validator client. The ordered index is reset when the file changes. On error, the
+// graffiti read previously keeps being used. The caller must hold the graffiti lock.
+func (v *validator) reloadGraffitiFile(ctx context.Context) {
+	if v.graffitiFilePath == "" {
+		return
+	}
+	g, err := graffiti.ReloadGraffitiFile(v.graffitiFilePath, v.graffitiStruct)
+	if err != nil {
+		log.WithError(err).Warn("Could not reload graffiti file, using the graffiti read previously")
+		return
+	}
+	if g == v.graffitiStruct {
+		return
 	}
+	index, err := v.db.GraffitiOrderedIndex(ctx, g.Hash)
+	if err != nil {
+		log.WithError(err).Warn("Could not read graffiti ordered index, using the graffiti read previously")
+		return
+	}
+	v.graffitiStruct = g
+	v.graffitiOrderedIndex = index
+	log.WithField("path", v.graffitiFilePath).Info("Reloaded graffiti file")
+}
 
-	return []byte{}, nil
+// truncateGraffiti shortens graffiti longer than the 32 bytes of the block body field. UTF-8 graffiti is cut
+// on a character boundary so that the block does not end with a partial character.
+func truncateGraffiti(g []byte) []byte {
+	if len(g) <= fieldparams.RootLength {
+		return g
+	}
+	if !utf8.Valid(g) {
+		return g[:fieldparams.RootLength]
+	}
+	end := fieldparams.RootLength
+	for end > 0 && !utf8.RuneStart(g[end]) {
+		end--
+	}
+	return g[:end]
 }
 
 func (v *validator) SetGraffiti(ctx context.Context, pubkey [fieldparams.BLSPubkeyLength]byte, graffiti []byte) error {
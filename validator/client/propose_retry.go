@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	blockRequestSuccess              = "success"
+	blockRequestRetryable            = "retryable_failure"
+	blockRequestFatal                = "fatal_failure"
+	blockRequestLocalFallbackSuccess = "local_fallback_success"
+	blockRequestLocalFallbackFailure = "local_fallback_failure"
+)
+
+// blockRequestWindowEnd returns the time by which the block for the slot must be requested so that it can still
+// be signed and broadcast in time for attesters to see it, which is one third into the slot.
+var blockRequestWindowEnd = func(genesis uint64, slot primitives.Slot) time.Time {
+	return slots.StartTime(genesis, slot).Add(slots.DivideSlotBy(3))
+}
+
+// isRetryableBlockRequestError returns true if the block request failed because the beacon node was
+// unavailable or did not answer in time, in which case asking again, possibly another beacon node, may succeed.
+func isRetryableBlockRequestError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	if st, ok := status.FromError(errors.Cause(err)); ok {
+		switch st.Code() {
+		case codes.DeadlineExceeded, codes.Unavailable:
+			return true
+		}
+	}
+	var jsonErr *httputil.DefaultJsonError
+	if errors.As(err, &jsonErr) {
+		return jsonErr.Code == http.StatusServiceUnavailable || jsonErr.Code == http.StatusGatewayTimeout
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// requestBlock requests a block for the slot from the beacon node. If the request fails and the local block
+// fallback is enabled, a block built with the local execution payload is requested as a last resort.
+func (v *validator) requestBlock(ctx context.Context, req *ethpb.BlockRequest, log *logrus.Entry) (*ethpb.GenericBeaconBlock, error) {
+	b, err := v.requestBlockWithRetries(ctx, req, log)
+	if err == nil || !v.localBlockFallback || ctx.Err() != nil || errors.Is(err, ErrWrongChain) {
+		return b, err
+	}
+	log.WithError(err).WithField("slot", req.Slot).Warn("Block request failed, requesting a block with the local execution payload")
+	b, fallbackErr := v.requestLocalBlock(ctx, req, log)
+	if fallbackErr != nil {
+		return nil, errors.Wrapf(fallbackErr, "local block fallback failed after block request error: %v", err)
+	}
+	return b, nil
+}
+
+// requestLocalBlock requests a block built from only the randao reveal and graffiti of the request, with the
+// local execution payload. The builder is not asked for a bid and the builder bid thresholds are left out, so
+// that neither can make the request fail. The request may use the time left until the block request window closes.
+func (v *validator) requestLocalBlock(ctx context.Context, req *ethpb.BlockRequest, log *logrus.Entry) (*ethpb.GenericBeaconBlock, error) {
+	remaining := prysmTime.Until(blockRequestWindowEnd(v.genesisTime, req.Slot))
+	if remaining <= 0 {
+		return nil, errors.New("block request window closed")
+	}
+	localReq := &ethpb.BlockRequest{
+		Slot:               req.Slot,
+		RandaoReveal:       req.RandaoReveal,
+		Graffiti:           req.Graffiti,
+		SkipMevBoost:       true,
+		BuilderBoostFactor: &wrapperspb.UInt64Value{Value: 0},
+	}
+	start := prysmTime.Now()
+	ctx, cancel := context.WithTimeout(ctx, remaining)
+	defer cancel()
+	b, err := v.validatorClient.BeaconBlock(ctx, localReq)
+	result := blockRequestLocalFallbackSuccess
+	if err != nil {
+		result = blockRequestLocalFallbackFailure
+	}
+	if v.emitAccountMetrics {
+		ValidatorBlockRequestAttemptsVec.WithLabelValues(result).Inc()
+	}
+	fallbackLog := log.WithFields(logrus.Fields{
+		"slot":    req.Slot,
+		"timeout": remaining,
+		"elapsed": prysmTime.Since(start),
+	})
+	if err != nil {
+		fallbackLog.WithError(err).Warn("Local block request failed")
+		return nil, err
+	}
+	fallbackLog.Info("Received block with the local execution payload from beacon node")
+	return b, nil
+}
+
+// requestBlockWithRetries requests a block for the slot from the beacon node. When more than one attempt is
+// configured, retryable failures are retried with the remaining time until the block request window closes split
+// evenly between the remaining attempts, switching to the next beacon node between attempts if one is configured.
+func (v *validator) requestBlockWithRetries(ctx context.Context, req *ethpb.BlockRequest, log *logrus.Entry) (*ethpb.GenericBeaconBlock, error) {
+	attempts := v.blockRequestAttempts
+	if attempts <= 1 {
+		b, err := v.validatorClient.BeaconBlock(ctx, req)
+		if v.emitAccountMetrics {
+			ValidatorBlockRequestAttemptsVec.WithLabelValues(blockRequestResult(err)).Inc()
+		}
+		return b, err
+	}
+
+	windowEnd := blockRequestWindowEnd(v.genesisTime, req.Slot)
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		remaining := prysmTime.Until(windowEnd)
+		if remaining <= 0 {
+			if err == nil {
+				return nil, errors.New("block request window closed before the first attempt")
+			}
+			return nil, errors.Wrapf(err, "block request window closed after %d attempts", attempt-1)
+		}
+		attemptTimeout := remaining / time.Duration(attempts-attempt+1)
+		start := prysmTime.Now()
+
+		var b *ethpb.GenericBeaconBlock
+		attemptCtx, cancel := context.WithTimeout(ctx, attemptTimeout)
+		b, err = v.validatorClient.BeaconBlock(attemptCtx, req)
+		cancel()
+
+		result := blockRequestResult(err)
+		if v.emitAccountMetrics {
+			ValidatorBlockRequestAttemptsVec.WithLabelValues(result).Inc()
+		}
+		attemptLog := log.WithFields(logrus.Fields{
+			"slot":     req.Slot,
+			"attempt":  attempt,
+			"attempts": attempts,
+			"timeout":  attemptTimeout,
+			"elapsed":  prysmTime.Since(start),
+		})
+		switch result {
+		case blockRequestSuccess:
+			if attempt > 1 {
+				attemptLog.Info("Received block from beacon node after retrying")
+			}
+			return b, nil
+		case blockRequestFatal:
+			attemptLog.WithError(err).Warn("Block request failed with a non-retryable error")
+			return nil, err
+		}
+		attemptLog.WithError(err).Warn("Block request failed with a retryable error")
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		if attempt < attempts && len(v.beaconNodeHosts) > 1 {
+			v.ChangeHost()
+			if err := v.CheckBeaconNodeChain(ctx); err != nil {
+				return nil, errors.Wrap(err, "could not check the chain of the next beacon node")
+			}
+		}
+	}
+	return nil, errors.Wrapf(err, "block request failed after %d attempts", attempts)
+}
+
+func blockRequestResult(err error) string {
+	switch {
+	case err == nil:
+		return blockRequestSuccess
+	case isRetryableBlockRequestError(err):
+		return blockRequestRetryable
+	default:
+		return blockRequestFatal
+	}
+}
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	dbTest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestIsRetryableBlockRequestError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "context deadline", err: errors.Wrap(context.DeadlineExceeded, "request failed"), want: true},
+		{name: "grpc deadline", err: status.Error(codes.DeadlineExceeded, "deadline"), want: true},
+		{name: "grpc unavailable", err: status.Error(codes.Unavailable, "unavailable"), want: true},
+		{name: "grpc internal", err: status.Error(codes.Internal, "internal"), want: false},
+		{name: "http unavailable", err: errors.Wrap(&httputil.DefaultJsonError{Code: http.StatusServiceUnavailable}, "request failed"), want: true},
+		{name: "http bad request", err: &httputil.DefaultJsonError{Code: http.StatusBadRequest}, want: false},
+		{name: "other", err: errors.New("block rejected by local protection"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isRetryableBlockRequestError(tt.err))
+		})
+	}
+}
+
+func TestRequestBlock_Retries(t *testing.T) {
+	ctx := context.Background()
+	log := logrus.WithField("test", t.Name())
+	resetWindow := blockRequestWindowEnd
+	blockRequestWindowEnd = func(uint64, primitives.Slot) time.Time {
+		return time.Now().Add(time.Second)
+	}
+	defer func() {
+		blockRequestWindowEnd = resetWindow
+	}()
+	req := &ethpb.BlockRequest{Slot: 1}
+	blk := &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: util.NewBeaconBlock().Block}}
+
+	genValRoot := bytesutil.PadTo([]byte("validators"), fieldparams.RootLength)
+
+	t.Run("retryable failure then success rotates host", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		ctrl := gomock.NewController(t)
+		client := validatormock.NewMockValidatorClient(ctrl)
+		db := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+		require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, genValRoot))
+		v := &validator{
+			validatorClient:      client,
+			db:                   db,
+			blockRequestAttempts: 3,
+			beaconNodeHosts:      []string{"http://localhost:3500", "http://localhost:3501"},
+		}
+		gomock.InOrder(
+			client.EXPECT().BeaconBlock(gomock.Any(), req).Return(nil, status.Error(codes.Unavailable, "unavailable")),
+			client.EXPECT().SetHost("http://localhost:3501"),
+			client.EXPECT().BeaconBlock(gomock.Any(), req).Return(blk, nil),
+		)
+		expectBeaconNodeChain(t, client, genValRoot, params.BeaconConfig().GenesisForkVersion)
+		b, err := v.requestBlock(ctx, req, log)
+		require.NoError(t, err)
+		require.Equal(t, blk, b)
+		assert.LogsContain(t, hook, "Block request failed with a retryable error")
+		assert.LogsContain(t, hook, "Received block from beacon node after retrying")
+	})
+
+	t.Run("next beacon node on another chain", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := validatormock.NewMockValidatorClient(ctrl)
+		db := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+		require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, genValRoot))
+		v := &validator{
+			validatorClient:      client,
+			db:                   db,
+			blockRequestAttempts: 3,
+			beaconNodeHosts:      []string{"http://localhost:3500", "http://localhost:3501"},
+		}
+		client.EXPECT().BeaconBlock(gomock.Any(), req).Return(nil, status.Error(codes.Unavailable, "unavailable")).Times(1)
+		client.EXPECT().SetHost("http://localhost:3501")
+		client.EXPECT().WaitForChainStart(gomock.Any(), gomock.Any()).Return(&ethpb.ChainStartResponse{
+			Started:               true,
+			GenesisTime:           uint64(time.Now().Unix()),
+			GenesisValidatorsRoot: bytesutil.PadTo([]byte("other validators"), fieldparams.RootLength),
+		}, nil)
+		_, err := v.requestBlock(ctx, req, log)
+		require.ErrorIs(t, err, ErrWrongChain)
+	})
+
+	t.Run("non-retryable failure is not retried", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := validatormock.NewMockValidatorClient(ctrl)
+		v := &validator{
+			validatorClient:      client,
+			blockRequestAttempts: 3,
+			beaconNodeHosts:      []string{"http://localhost:3500"},
+		}
+		client.EXPECT().BeaconBlock(gomock.Any(), req).Return(nil, status.Error(codes.Internal, "internal")).Times(1)
+		_, err := v.requestBlock(ctx, req, log)
+		require.ErrorContains(t, "internal", err)
+	})
+
+	t.Run("attempts exhausted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := validatormock.NewMockValidatorClient(ctrl)
+		v := &validator{
+			validatorClient:      client,
+			blockRequestAttempts: 3,
+			beaconNodeHosts:      []string{"http://localhost:3500"},
+		}
+		client.EXPECT().BeaconBlock(gomock.Any(), req).DoAndReturn(
+			func(ctx context.Context, _ *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+				deadline, ok := ctx.Deadline()
+				require.Equal(t, true, ok)
+				require.Equal(t, true, time.Until(deadline) <= time.Second)
+				return nil, status.Error(codes.DeadlineExceeded, "deadline")
+			}).Times(3)
+		_, err := v.requestBlock(ctx, req, log)
+		require.ErrorContains(t, "block request failed after 3 attempts", err)
+	})
+
+	t.Run("local block fallback", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		ctrl := gomock.NewController(t)
+		client := validatormock.NewMockValidatorClient(ctrl)
+		v := &validator{
+			validatorClient:      client,
+			blockRequestAttempts: 1,
+			localBlockFallback:   true,
+		}
+		builderReq := &ethpb.BlockRequest{
+			Slot:                      1,
+			RandaoReveal:              []byte{'r'},
+			Graffiti:                  []byte{'g'},
+			MinBuilderBid:             5,
+			MinBuilderBidToLocalRatio: 1.25,
+		}
+		gomock.InOrder(
+			client.EXPECT().BeaconBlock(gomock.Any(), builderReq).Return(nil, status.Error(codes.Internal, "internal")),
+			client.EXPECT().BeaconBlock(gomock.Any(), &ethpb.BlockRequest{
+				Slot:               1,
+				RandaoReveal:       []byte{'r'},
+				Graffiti:           []byte{'g'},
+				SkipMevBoost:       true,
+				BuilderBoostFactor: &wrapperspb.UInt64Value{Value: 0},
+			}).Return(blk, nil),
+		)
+		b, err := v.requestBlock(ctx, builderReq, log)
+		require.NoError(t, err)
+		require.Equal(t, blk, b)
+		assert.LogsContain(t, hook, "requesting a block with the local execution payload")
+		assert.LogsContain(t, hook, "Received block with the local execution payload from beacon node")
+	})
+
+	t.Run("local block fallback fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := validatormock.NewMockValidatorClient(ctrl)
+		v := &validator{
+			validatorClient:      client,
+			blockRequestAttempts: 1,
+			localBlockFallback:   true,
+		}
+		client.EXPECT().BeaconBlock(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Internal, "internal")).Times(2)
+		_, err := v.requestBlock(ctx, req, log)
+		require.ErrorContains(t, "local block fallback failed after block request error", err)
+	})
+
+	t.Run("no local block fallback after switching to another chain", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := validatormock.NewMockValidatorClient(ctrl)
+		db := dbTest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+		require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, genValRoot))
+		v := &validator{
+			validatorClient:      client,
+			db:                   db,
+			blockRequestAttempts: 3,
+			localBlockFallback:   true,
+			beaconNodeHosts:      []string{"http://localhost:3500", "http://localhost:3501"},
+		}
+		client.EXPECT().BeaconBlock(gomock.Any(), req).Return(nil, status.Error(codes.Unavailable, "unavailable")).Times(1)
+		client.EXPECT().SetHost("http://localhost:3501")
+		client.EXPECT().WaitForChainStart(gomock.Any(), gomock.Any()).Return(&ethpb.ChainStartResponse{
+			Started:               true,
+			GenesisTime:           uint64(time.Now().Unix()),
+			GenesisValidatorsRoot: bytesutil.PadTo([]byte("other validators"), fieldparams.RootLength),
+		}, nil)
+		_, err := v.requestBlock(ctx, req, log)
+		require.ErrorIs(t, err, ErrWrongChain)
+	})
+}
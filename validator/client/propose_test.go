@@ -1,10 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -27,6 +30,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	testing2 "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -984,7 +988,16 @@ func TestGetGraffiti_Ok(t *testing.T) {
 	}{
 		{name: "use default cli graffiti",
 			v: &validator{
-				graffiti: []byte{'b'},
+				validatorClient: m.validatorClient,
+				graffiti:        []byte{'b'},
+				graffitiStruct:  &graffiti.Graffiti{},
+			},
+			want: bytesutil.PadTo([]byte{'b'}, 32),
+		},
+		{name: "file graffiti overrides cli graffiti",
+			v: &validator{
+				validatorClient: m.validatorClient,
+				graffiti:        []byte{'b'},
 				graffitiStruct: &graffiti.Graffiti{
 					Default: "c",
 					Random:  []string{"d", "e"},
@@ -994,7 +1007,18 @@ func TestGetGraffiti_Ok(t *testing.T) {
 					},
 				},
 			},
-			want: bytesutil.PadTo([]byte{'b'}, 32),
+			want: bytesutil.PadTo([]byte{'g'}, 32),
+		},
+		{name: "use validator file graffiti, has validator pubkey",
+			v: &validator{
+				validatorClient: m.validatorClient,
+				graffitiStruct: &graffiti.Graffiti{
+					Default:         "c",
+					Specific:        map[primitives.ValidatorIndex]string{2: "g"},
+					SpecificPubkeys: map[[fieldparams.BLSPubkeyLength]byte]string{pubKey: "h"},
+				},
+			},
+			want: bytesutil.PadTo([]byte{'h'}, 32),
 		},
 		{name: "use default file graffiti",
 			v: &validator{
@@ -1076,7 +1100,7 @@ func TestGetGraffiti_Ok(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if !strings.Contains(tt.name, "use default cli graffiti") && tt.v.proposerSettings == nil {
+			if !strings.Contains(tt.name, "has validator pubkey") && tt.v.proposerSettings == nil {
 				m.validatorClient.EXPECT().
 					ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
 					Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
@@ -1088,6 +1112,69 @@ func TestGetGraffiti_Ok(t *testing.T) {
 	}
 }
 
+func TestBuilderBidThresholds(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	tests := []struct {
+		name      string
+		settings  *proposer.Settings
+		wantBid   uint64
+		wantRatio float64
+	}{
+		{
+			name:      "no proposer settings",
+			wantBid:   0,
+			wantRatio: 0,
+		},
+		{
+			name: "builder not enabled",
+			settings: &proposer.Settings{
+				DefaultConfig: &proposer.Option{
+					BuilderConfig: &proposer.BuilderConfig{Enabled: false, MinBid: 3},
+				},
+			},
+			wantBid:   0,
+			wantRatio: 0,
+		},
+		{
+			name: "falls back to flags",
+			settings: &proposer.Settings{
+				DefaultConfig: &proposer.Option{
+					BuilderConfig: &proposer.BuilderConfig{Enabled: true},
+				},
+			},
+			wantBid:   10,
+			wantRatio: 1.1,
+		},
+		{
+			name: "proposer config takes precedence",
+			settings: &proposer.Settings{
+				ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+					pubKey: {
+						BuilderConfig: &proposer.BuilderConfig{Enabled: true, MinBid: 3, MinBidToLocalRatio: 1.5},
+					},
+				},
+				DefaultConfig: &proposer.Option{
+					BuilderConfig: &proposer.BuilderConfig{Enabled: true, MinBid: 7},
+				},
+			},
+			wantBid:   3,
+			wantRatio: 1.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &validator{
+				proposerSettings:   tt.settings,
+				minBuilderBid:      10,
+				minBuilderBidRatio: 1.1,
+			}
+			bid, ratio := v.builderBidThresholds(pubKey)
+			assert.Equal(t, tt.wantBid, bid)
+			assert.Equal(t, tt.wantRatio, ratio)
+		})
+	}
+}
+
 func TestGetGraffitiOrdered_Ok(t *testing.T) {
 	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
 		t.Run(fmt.Sprintf("SlashingProtectionMinimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
@@ -1119,6 +1206,43 @@ func TestGetGraffitiOrdered_Ok(t *testing.T) {
 	}
 }
 
+func TestGraffiti_ReloadsGraffitiFile(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	ctrl := gomock.NewController(t)
+	validatorClient := validatormock.NewMockValidatorClient(ctrl)
+	validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		AnyTimes().
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+	path := filepath.Join(t.TempDir(), "graffiti.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("ordered:\n  - a\n  - b\n"), 0600))
+	g, err := graffiti.ParseGraffitiFile(path)
+	require.NoError(t, err)
+	v := &validator{
+		db:               testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, false),
+		validatorClient:  validatorClient,
+		graffitiStruct:   g,
+		graffitiFilePath: path,
+	}
+	ctx := context.Background()
+
+	got, err := v.Graffiti(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo([]byte("a"), 32), got)
+
+	// A new file restarts the ordered list.
+	require.NoError(t, os.WriteFile(path, []byte("ordered:\n  - c\n  - d\n"), 0600))
+	got, err = v.Graffiti(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo([]byte("c"), 32), got)
+
+	// The graffiti read previously is kept when the file can't be read.
+	require.NoError(t, os.Remove(path))
+	got, err = v.Graffiti(ctx, pubKey)
+	require.NoError(t, err)
+	require.DeepEqual(t, bytesutil.PadTo([]byte("d"), 32), got)
+}
+
 func Test_validator_DeleteGraffiti(t *testing.T) {
 	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
 	tests := []struct {
@@ -1273,3 +1397,70 @@ func Test_validator_SetGraffiti(t *testing.T) {
 		})
 	}
 }
+
+func TestGraffitiWithSource(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{'a'}
+	ctrl := gomock.NewController(t)
+	validatorClient := validatormock.NewMockValidatorClient(ctrl)
+	validatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]}).
+		AnyTimes().
+		Return(&ethpb.ValidatorIndexResponse{Index: 2}, nil)
+	v := &validator{
+		db:              testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, false),
+		validatorClient: validatorClient,
+		graffitiStruct: &graffiti.Graffiti{
+			Ordered:  []string{"b", "c"},
+			Specific: map[primitives.ValidatorIndex]string{2: "specific"},
+		},
+	}
+	ctx := context.Background()
+
+	g, source, err := v.GraffitiWithSource(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, iface.GraffitiSourceFile, source)
+	require.DeepEqual(t, bytesutil.PadTo([]byte("specific"), 32), g)
+
+	// Graffiti longer than the block body field is stored as is and only truncated when proposing.
+	long := "a" + strings.Repeat("é", 20)
+	require.NoError(t, v.SetGraffiti(ctx, pubKey, []byte(long)))
+	g, source, err = v.GraffitiWithSource(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, iface.GraffitiSourceProposerSettings, source)
+	require.Equal(t, long, string(g))
+	settings, err := v.db.ProposerSettings(ctx)
+	require.NoError(t, err)
+	require.Equal(t, long, settings.ProposeConfig[pubKey].GraffitiConfig.Graffiti)
+	require.Equal(t, "a"+strings.Repeat("é", 15), string(truncateGraffiti(g)))
+
+	// Peeking at the ordered list does not consume its entries.
+	require.NoError(t, v.DeleteGraffiti(ctx, pubKey))
+	v.graffitiStruct.Specific = nil
+	for i := 0; i < 2; i++ {
+		g, source, err = v.GraffitiWithSource(ctx, pubKey)
+		require.NoError(t, err)
+		require.Equal(t, iface.GraffitiSourceFile, source)
+		require.DeepEqual(t, bytesutil.PadTo([]byte("b"), 32), g)
+	}
+	require.Equal(t, uint64(0), v.graffitiOrderedIndex)
+}
+
+func TestTruncateGraffiti(t *testing.T) {
+	tests := []struct {
+		name     string
+		graffiti []byte
+		want     []byte
+	}{
+		{name: "empty", graffiti: []byte{}, want: []byte{}},
+		{name: "exactly 32 bytes", graffiti: bytes.Repeat([]byte{'a'}, 32), want: bytes.Repeat([]byte{'a'}, 32)},
+		{name: "ascii", graffiti: bytes.Repeat([]byte{'a'}, 40), want: bytes.Repeat([]byte{'a'}, 32)},
+		{name: "character on the boundary", graffiti: []byte(strings.Repeat("a", 31) + "€€"), want: []byte(strings.Repeat("a", 31))},
+		{name: "character ending on the boundary", graffiti: []byte(strings.Repeat("a", 29) + "€€"), want: []byte(strings.Repeat("a", 29) + "€")},
+		{name: "not utf-8", graffiti: bytes.Repeat([]byte{0xff}, 40), want: bytes.Repeat([]byte{0xff}, 32)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.DeepEqual(t, tt.want, truncateGraffiti(tt.graffiti))
+		})
+	}
+}
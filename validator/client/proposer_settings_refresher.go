@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/async"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/sirupsen/logrus"
+)
+
+// proposerSettingsFileDebounce is how long the proposer settings file must be left unchanged before it is reloaded,
+// so that a file being written is not read halfway.
+const proposerSettingsFileDebounce = time.Second
+
+// ProposerSettingsRefresherConfig for the proposer settings refresher.
+type ProposerSettingsRefresherConfig struct {
+	ValidatorService *ValidatorService
+	// Load loads and validates the proposer settings from their sources the same way as on startup.
+	Load func() (*proposer.Settings, error)
+	// File is the proposer settings file, reloaded whenever it changes when set.
+	File string
+	// URL is the proposer settings URL, fetched again at every Interval when set.
+	URL      string
+	Interval time.Duration
+}
+
+// ProposerSettingsRefresher reloads the proposer settings whenever the proposer settings file changes, or
+// periodically from the proposer settings URL, without restarting the validator client. The settings of the keys
+// which changed are updated: their fee recipients are sent to the beacon node with the proposer preparations of the
+// next slot, and their builder registrations are signed and submitted again. Settings which cannot be loaded or are
+// invalid are rejected and the previous ones are kept.
+type ProposerSettingsRefresher struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *ProposerSettingsRefresherConfig
+	// reloadLock prevents concurrent reloads from interleaving their updates.
+	reloadLock sync.Mutex
+}
+
+// NewProposerSettingsRefresher creates the proposer settings refresher.
+func NewProposerSettingsRefresher(ctx context.Context, cfg *ProposerSettingsRefresherConfig) (*ProposerSettingsRefresher, error) {
+	if cfg.File == "" && cfg.URL == "" {
+		return nil, errors.New("no proposer settings file or URL to refresh the proposer settings from")
+	}
+	if cfg.URL != "" && cfg.Interval <= 0 {
+		return nil, errors.New("proposer settings refresh interval must be positive")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &ProposerSettingsRefresher{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}, nil
+}
+
+// Start watching the proposer settings file or refreshing the settings from the URL.
+func (r *ProposerSettingsRefresher) Start() {
+	if r.cfg.File != "" {
+		log.WithField("file", r.cfg.File).Info("Reloading proposer settings when the file changes")
+		go r.watchFile()
+	}
+	if r.cfg.URL != "" {
+		log.WithField("interval", r.cfg.Interval).Info("Refreshing proposer settings from URL periodically")
+		go r.poll()
+	}
+}
+
+// Stop the service.
+func (r *ProposerSettingsRefresher) Stop() error {
+	r.cancel()
+	return nil
+}
+
+// Status of the service.
+func (*ProposerSettingsRefresher) Status() error {
+	return nil
+}
+
+// watchFile reloads the proposer settings when the file changes. The directory of the file is watched rather than
+// the file itself, as editors and configuration management tools usually replace the file, which ends a watch on it.
+func (r *ProposerSettingsRefresher) watchFile() {
+	path := filepath.Clean(r.cfg.File)
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize proposer settings file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close proposer settings file watcher")
+		}
+	}()
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		log.WithError(err).Errorf("Could not watch directory of proposer settings file %s", path)
+		return
+	}
+
+	changes := make(chan interface{}, 100)
+	go async.Debounce(r.ctx, proposerSettingsFileDebounce, changes, func(interface{}) {
+		r.reload()
+	})
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != path || event.Op == fsnotify.Chmod {
+				continue
+			}
+			changes <- event
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.WithError(err).Errorf("Could not watch for changes of proposer settings file %s", path)
+		case <-r.ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *ProposerSettingsRefresher) poll() {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			r.reload()
+		}
+	}
+}
+
+// reload loads the proposer settings again and updates the settings of the validator when they changed.
+func (r *ProposerSettingsRefresher) reload() {
+	r.reloadLock.Lock()
+	defer r.reloadLock.Unlock()
+
+	if r.cfg.ValidatorService.validator == nil {
+		log.Warn("Could not reload proposer settings: validator is not started")
+		return
+	}
+	settings, err := r.cfg.Load()
+	if err != nil {
+		log.WithError(err).Error("Rejected reloaded proposer settings, keeping the previous ones")
+		return
+	}
+	if settings == nil {
+		log.Error("Rejected empty reloaded proposer settings, keeping the previous ones")
+		return
+	}
+	keys, defaultChanged := settings.ChangedKeys(r.cfg.ValidatorService.ProposerSettings())
+	if len(keys) == 0 && !defaultChanged {
+		log.Debug("Reloaded proposer settings are unchanged")
+		return
+	}
+	// Only the changed keys have different settings. The proposer preparations of every key are sent at each slot,
+	// while builder registrations are only signed and submitted again for the keys whose registration changed.
+	if err := r.cfg.ValidatorService.SetProposerSettings(r.ctx, settings); err != nil {
+		log.WithError(err).Error("Could not update proposer settings")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"changedKeys":    len(keys),
+		"defaultChanged": defaultChanged,
+	}).Info("Updated reloaded proposer settings")
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestNewProposerSettingsRefresher(t *testing.T) {
+	_, err := NewProposerSettingsRefresher(context.Background(), &ProposerSettingsRefresherConfig{})
+	require.ErrorContains(t, "no proposer settings file or URL", err)
+	_, err = NewProposerSettingsRefresher(context.Background(), &ProposerSettingsRefresherConfig{URL: "http://localhost"})
+	require.ErrorContains(t, "refresh interval must be positive", err)
+	_, err = NewProposerSettingsRefresher(context.Background(), &ProposerSettingsRefresherConfig{File: "proposer-settings.json"})
+	require.NoError(t, err)
+}
+
+func TestProposerSettingsRefresher_Reload(t *testing.T) {
+	key := bytesutil.ToBytes48([]byte{1})
+	settings := func(feeRecipient string) *proposer.Settings {
+		return &proposer.Settings{
+			ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+				key: {FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress(feeRecipient)}},
+			},
+		}
+	}
+	var loaded *proposer.Settings
+	var loadErr error
+	vs := &ValidatorService{validator: &testutil.FakeValidator{}}
+	r, err := NewProposerSettingsRefresher(context.Background(), &ProposerSettingsRefresherConfig{
+		ValidatorService: vs,
+		Load: func() (*proposer.Settings, error) {
+			return loaded, loadErr
+		},
+		URL:      "http://localhost",
+		Interval: time.Minute,
+	})
+	require.NoError(t, err)
+	hook := logTest.NewGlobal()
+
+	loaded = settings("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	r.reload()
+	require.LogsContain(t, hook, "Updated reloaded proposer settings")
+	require.DeepEqual(t, loaded, vs.ProposerSettings())
+
+	hook.Reset()
+	loaded = settings("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	r.reload()
+	require.LogsContain(t, hook, "Reloaded proposer settings are unchanged")
+
+	hook.Reset()
+	previous := vs.ProposerSettings()
+	loadErr = errors.New("invalid fee recipient")
+	loaded = nil
+	r.reload()
+	require.LogsContain(t, hook, "Rejected reloaded proposer settings")
+	require.DeepEqual(t, previous, vs.ProposerSettings())
+
+	hook.Reset()
+	loadErr = nil
+	r.reload()
+	require.LogsContain(t, hook, "Rejected empty reloaded proposer settings")
+	require.DeepEqual(t, previous, vs.ProposerSettings())
+
+	loaded = settings("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+	r.reload()
+	assert.Equal(t, common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A"), vs.ProposerSettings().ProposeConfig[key].FeeRecipientConfig.FeeRecipient)
+}
+
+func TestProposerSettingsRefresher_WatchFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposer-settings.json")
+	require.NoError(t, os.WriteFile(path, []byte("{}"), 0600))
+	reloaded := make(chan struct{}, 1)
+	r, err := NewProposerSettingsRefresher(context.Background(), &ProposerSettingsRefresherConfig{
+		ValidatorService: &ValidatorService{validator: &testutil.FakeValidator{}},
+		Load: func() (*proposer.Settings, error) {
+			select {
+			case reloaded <- struct{}{}:
+			default:
+			}
+			return nil, nil
+		},
+		File: path,
+	})
+	require.NoError(t, err)
+	r.Start()
+	defer func() {
+		require.NoError(t, r.Stop())
+	}()
+
+	// Other files of the directory are ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(filepath.Dir(path), "other.json"), []byte("{}"), 0600))
+	// The file is written until the watcher started, leaving time for the writes to be debounced.
+	ticker := time.NewTicker(2 * proposerSettingsFileDebounce)
+	defer ticker.Stop()
+	timeout := time.After(10 * proposerSettingsFileDebounce)
+	for {
+		select {
+		case <-reloaded:
+			return
+		case <-ticker.C:
+			require.NoError(t, os.WriteFile(path, []byte("{}"), 0600))
+		case <-timeout:
+			t.Fatal("Proposer settings were not reloaded after the file changed")
+		}
+	}
+}
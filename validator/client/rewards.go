@@ -0,0 +1,384 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
+	"github.com/sirupsen/logrus"
+)
+
+// rewardsBatchSize is the maximum number of validators sent in a single rewards API request.
+const rewardsBatchSize = 500
+
+const (
+	rewardsMethodAPI          = "rewards_api"
+	rewardsMethodBalanceDelta = "balance_delta"
+)
+
+var errRewardsAPIUnavailable = errors.New("beacon node rewards API is unavailable")
+
+// validatorRewards holds the estimated rewards of a single validator over one epoch, in Gwei.
+// Penalties are represented by negative values.
+type validatorRewards struct {
+	attestations  int64
+	syncCommittee int64
+	proposals     int64
+	balanceDelta  int64
+}
+
+func (r *validatorRewards) total() int64 {
+	return r.attestations + r.syncCommittee + r.proposals + r.balanceDelta
+}
+
+// epochRewards is the outcome of a rewards estimation for all tracked validators.
+type epochRewards struct {
+	epoch   primitives.Epoch
+	method  string
+	rewards map[primitives.ValidatorIndex]*validatorRewards
+}
+
+func (e *epochRewards) forValidator(idx primitives.ValidatorIndex) *validatorRewards {
+	r, ok := e.rewards[idx]
+	if !ok {
+		r = &validatorRewards{}
+		e.rewards[idx] = r
+	}
+	return r
+}
+
+// rewardsEstimator queries the beacon node rewards API for the duties performed by the validator client.
+// Proposals and sync committee messages are recorded as they are submitted, so that only the blocks
+// relevant to the client's keys are requested once their epoch is processed.
+type rewardsEstimator struct {
+	handler       beaconApi.JsonRestHandler
+	proposals     map[primitives.Slot]primitives.ValidatorIndex
+	syncCommittee map[primitives.Slot][]primitives.ValidatorIndex
+	lock          sync.Mutex
+}
+
+func newRewardsEstimator(handler beaconApi.JsonRestHandler) *rewardsEstimator {
+	return &rewardsEstimator{
+		handler:       handler,
+		proposals:     make(map[primitives.Slot]primitives.ValidatorIndex),
+		syncCommittee: make(map[primitives.Slot][]primitives.ValidatorIndex),
+	}
+}
+
+// trackProposal records a block proposed by the validator at the given slot.
+func (e *rewardsEstimator) trackProposal(slot primitives.Slot, idx primitives.ValidatorIndex) {
+	if e == nil {
+		return
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.proposals[slot] = idx
+}
+
+// trackSyncCommitteeMessage records a sync committee message submitted by the validator at the given slot.
+// The message is rewarded by the sync aggregate of the block in the following slot.
+func (e *rewardsEstimator) trackSyncCommitteeMessage(slot primitives.Slot, idx primitives.ValidatorIndex) {
+	if e == nil {
+		return
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.syncCommittee[slot+1] = append(e.syncCommittee[slot+1], idx)
+}
+
+// takeDuties removes and returns the proposals and sync committee messages rewarded in or before the given epoch.
+func (e *rewardsEstimator) takeDuties(epoch primitives.Epoch) (map[primitives.Slot]primitives.ValidatorIndex, map[primitives.Slot][]primitives.ValidatorIndex) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	proposals := make(map[primitives.Slot]primitives.ValidatorIndex)
+	for slot, idx := range e.proposals {
+		if slots.ToEpoch(slot) <= epoch {
+			proposals[slot] = idx
+			delete(e.proposals, slot)
+		}
+	}
+	syncCommittee := make(map[primitives.Slot][]primitives.ValidatorIndex)
+	for slot, indices := range e.syncCommittee {
+		if slots.ToEpoch(slot) <= epoch {
+			syncCommittee[slot] = indices
+			delete(e.syncCommittee, slot)
+		}
+	}
+	return proposals, syncCommittee
+}
+
+// estimate computes the attestation, sync committee and proposal rewards of the validators for the given epoch.
+// errRewardsAPIUnavailable is returned when the beacon node does not serve attestation rewards.
+func (e *rewardsEstimator) estimate(ctx context.Context, epoch primitives.Epoch, indices []primitives.ValidatorIndex) (*epochRewards, error) {
+	result := &epochRewards{
+		epoch:   epoch,
+		method:  rewardsMethodAPI,
+		rewards: make(map[primitives.ValidatorIndex]*validatorRewards, len(indices)),
+	}
+	// Duties are taken up front so that they do not accumulate while the API is unavailable.
+	proposals, syncCommittee := e.takeDuties(epoch)
+	for start := 0; start < len(indices); start += rewardsBatchSize {
+		end := start + rewardsBatchSize
+		if end > len(indices) {
+			end = len(indices)
+		}
+		resp := &structs.AttestationRewardsResponse{}
+		endpoint := fmt.Sprintf("/eth/v1/beacon/rewards/attestations/%d", epoch)
+		if err := e.post(ctx, endpoint, indices[start:end], resp); err != nil {
+			if isRewardsAPIUnavailable(err) {
+				return nil, errors.Wrap(errRewardsAPIUnavailable, err.Error())
+			}
+			return nil, errors.Wrapf(err, "could not get attestation rewards for epoch %d", epoch)
+		}
+		for _, r := range resp.Data.TotalRewards {
+			idx, err := strconv.ParseUint(r.ValidatorIndex, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not parse validator index %s", r.ValidatorIndex)
+			}
+			total, err := sumGwei(r.Head, r.Target, r.Source, r.Inactivity)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not parse attestation rewards of validator %d", idx)
+			}
+			result.forValidator(primitives.ValidatorIndex(idx)).attestations += total
+		}
+	}
+
+	for slot, idx := range proposals {
+		resp := &structs.BlockRewardsResponse{}
+		if err := e.handler.Get(ctx, fmt.Sprintf("/eth/v1/beacon/rewards/blocks/%d", slot), resp); err != nil {
+			if isNotFound(err) {
+				log.WithFields(logrus.Fields{"slot": slot, "validatorIndex": idx}).Warn("Proposed block is not canonical, no proposal rewards")
+				continue
+			}
+			return nil, errors.Wrapf(err, "could not get block rewards for slot %d", slot)
+		}
+		if resp.Data == nil || resp.Data.ProposerIndex != strconv.FormatUint(uint64(idx), 10) {
+			log.WithFields(logrus.Fields{"slot": slot, "validatorIndex": idx}).Warn("Proposed block is not canonical, no proposal rewards")
+			continue
+		}
+		total, err := sumGwei(resp.Data.Total)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse block rewards for slot %d", slot)
+		}
+		result.forValidator(idx).proposals += total
+	}
+	for slot, members := range syncCommittee {
+		resp := &structs.SyncCommitteeRewardsResponse{}
+		endpoint := fmt.Sprintf("/eth/v1/beacon/rewards/sync_committee/%d", slot)
+		if err := e.post(ctx, endpoint, members, resp); err != nil {
+			if isNotFound(err) {
+				// No block was included in the slot, so the sync committee messages were not rewarded.
+				continue
+			}
+			return nil, errors.Wrapf(err, "could not get sync committee rewards for slot %d", slot)
+		}
+		for _, r := range resp.Data {
+			idx, err := strconv.ParseUint(r.ValidatorIndex, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not parse validator index %s", r.ValidatorIndex)
+			}
+			total, err := sumGwei(r.Reward)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not parse sync committee rewards of validator %d", idx)
+			}
+			result.forValidator(primitives.ValidatorIndex(idx)).syncCommittee += total
+		}
+	}
+	return result, nil
+}
+
+func (e *rewardsEstimator) post(ctx context.Context, endpoint string, indices []primitives.ValidatorIndex, resp interface{}) error {
+	ids := make([]string, len(indices))
+	for i, idx := range indices {
+		ids[i] = strconv.FormatUint(uint64(idx), 10)
+	}
+	body, err := json.Marshal(ids)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal validator indices")
+	}
+	return e.handler.Post(ctx, endpoint, nil, bytes.NewBuffer(body), resp)
+}
+
+func isNotFound(err error) bool {
+	var jsonErr *httputil.DefaultJsonError
+	return errors.As(err, &jsonErr) && jsonErr.Code == http.StatusNotFound
+}
+
+func isRewardsAPIUnavailable(err error) bool {
+	var jsonErr *httputil.DefaultJsonError
+	if !errors.As(err, &jsonErr) {
+		return false
+	}
+	return jsonErr.Code == http.StatusNotFound || jsonErr.Code == http.StatusMethodNotAllowed || jsonErr.Code == http.StatusNotImplemented
+}
+
+func sumGwei(values ...string) (int64, error) {
+	var total int64
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+// LogEstimatedRewards estimates the rewards and penalties of the validator client's keys at the end of each epoch
+// and logs them per key and in aggregate. Attestation, sync committee and proposal rewards are obtained from the
+// beacon node rewards API for the most recent epoch it serves. When the API is unavailable, the estimation
+// degrades to the balance changes of the previous epoch.
+func (v *validator) LogEstimatedRewards(ctx context.Context, slot primitives.Slot) error {
+	if v.rewardsEstimator == nil || !slots.IsEpochEnd(slot) {
+		return nil
+	}
+	// The beacon node only serves attestation rewards for epochs that are at least two epochs old.
+	currentEpoch := slots.ToEpoch(slot)
+	if currentEpoch < 2 {
+		return nil
+	}
+
+	indexToPubkey := make(map[primitives.ValidatorIndex][fieldparams.BLSPubkeyLength]byte)
+	for pk, s := range v.pubkeyToStatus {
+		if s == nil || s.status == nil {
+			continue
+		}
+		switch s.status.Status {
+		case ethpb.ValidatorStatus_ACTIVE, ethpb.ValidatorStatus_EXITING, ethpb.ValidatorStatus_SLASHING:
+			indexToPubkey[s.index] = pk
+		}
+	}
+	if len(indexToPubkey) == 0 {
+		return nil
+	}
+	indices := make([]primitives.ValidatorIndex, 0, len(indexToPubkey))
+	for idx := range indexToPubkey {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	result, err := v.rewardsEstimator.estimate(ctx, currentEpoch-2, indices)
+	if errors.Is(err, errRewardsAPIUnavailable) {
+		log.WithError(err).Debug("Estimating rewards from balance changes")
+		ValidatorEstimatedRewardsFallbackCount.Inc()
+		result, err = v.balanceDeltaRewards(ctx, currentEpoch-1, indexToPubkey)
+	}
+	if err != nil {
+		return err
+	}
+	v.logEpochRewards(result, indexToPubkey)
+	return nil
+}
+
+// balanceDeltaRewards estimates the rewards of the previous epoch from the balance changes of the epoch transition.
+func (v *validator) balanceDeltaRewards(
+	ctx context.Context,
+	epoch primitives.Epoch,
+	indexToPubkey map[primitives.ValidatorIndex][fieldparams.BLSPubkeyLength]byte,
+) (*epochRewards, error) {
+	pubkeyToIndex := make(map[[fieldparams.BLSPubkeyLength]byte]primitives.ValidatorIndex, len(indexToPubkey))
+	pubKeys := make([][]byte, 0, len(indexToPubkey))
+	for idx, pk := range indexToPubkey {
+		pubkeyToIndex[pk] = idx
+		pubKeys = append(pubKeys, bytesutil.SafeCopyBytes(pk[:]))
+	}
+	resp, err := v.chainClient.ValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{PublicKeys: pubKeys})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator performance")
+	}
+	result := &epochRewards{
+		epoch:   epoch,
+		method:  rewardsMethodBalanceDelta,
+		rewards: make(map[primitives.ValidatorIndex]*validatorRewards, len(resp.PublicKeys)),
+	}
+	for i, pk := range resp.PublicKeys {
+		idx, ok := pubkeyToIndex[bytesutil.ToBytes48(pk)]
+		if !ok || i >= len(resp.BalancesBeforeEpochTransition) || i >= len(resp.BalancesAfterEpochTransition) {
+			continue
+		}
+		delta := int64(resp.BalancesAfterEpochTransition[i]) - int64(resp.BalancesBeforeEpochTransition[i]) // lint:ignore uintcast -- Balances fit in int64.
+		result.forValidator(idx).balanceDelta = delta
+	}
+	return result, nil
+}
+
+func (v *validator) logEpochRewards(result *epochRewards, indexToPubkey map[primitives.ValidatorIndex][fieldparams.BLSPubkeyLength]byte) {
+	indices := make([]primitives.ValidatorIndex, 0, len(result.rewards))
+	for idx := range result.rewards {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	aggregate := &validatorRewards{}
+	for _, idx := range indices {
+		r := result.rewards[idx]
+		aggregate.attestations += r.attestations
+		aggregate.syncCommittee += r.syncCommittee
+		aggregate.proposals += r.proposals
+		aggregate.balanceDelta += r.balanceDelta
+
+		pk, ok := indexToPubkey[idx]
+		if !ok {
+			continue
+		}
+		log.WithFields(rewardsFields(result, r)).WithFields(logrus.Fields{
+			"pubkey":         fmt.Sprintf("%#x", bytesutil.Trunc(pk[:])),
+			"validatorIndex": idx,
+		}).Info("Estimated epoch rewards")
+		if v.emitAccountMetrics {
+			setRewardsMetrics(ValidatorEstimatedRewardsGaugeVec.MustCurryWith(map[string]string{"pubkey": fmt.Sprintf("%#x", pk)}), result.method, r)
+		}
+	}
+
+	log.WithFields(rewardsFields(result, aggregate)).WithField("validators", len(indices)).Info("Estimated epoch rewards summary")
+	if v.emitAccountMetrics {
+		setRewardsMetrics(ValidatorEstimatedRewardsAggregateGaugeVec, result.method, aggregate)
+	}
+}
+
+func rewardsFields(result *epochRewards, r *validatorRewards) logrus.Fields {
+	gweiPerEth := float64(params.BeaconConfig().GweiPerEth)
+	fields := logrus.Fields{
+		"epoch":    result.epoch,
+		"method":   result.method,
+		"totalEth": float64(r.total()) / gweiPerEth,
+	}
+	if result.method == rewardsMethodAPI {
+		fields["attestationsGwei"] = r.attestations
+		fields["syncCommitteeGwei"] = r.syncCommittee
+		fields["proposalsGwei"] = r.proposals
+	} else {
+		fields["balanceDeltaGwei"] = r.balanceDelta
+	}
+	return fields
+}
+
+func setRewardsMetrics(vec *prometheus.GaugeVec, method string, r *validatorRewards) {
+	if method == rewardsMethodAPI {
+		vec.WithLabelValues("attestation").Set(float64(r.attestations))
+		vec.WithLabelValues("sync_committee").Set(float64(r.syncCommittee))
+		vec.WithLabelValues("proposal").Set(float64(r.proposals))
+	} else {
+		vec.WithLabelValues("balance_delta").Set(float64(r.balanceDelta))
+	}
+	vec.WithLabelValues("total").Set(float64(r.total()))
+}
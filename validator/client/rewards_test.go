@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"go.uber.org/mock/gomock"
+)
+
+func newRewardsTestEstimator(t *testing.T, handler http.HandlerFunc) *rewardsEstimator {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return newRewardsEstimator(beaconApi.NewBeaconApiJsonRestHandler(http.Client{}, srv.URL))
+}
+
+func decodeIndices(t *testing.T, r *http.Request) []string {
+	var ids []string
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&ids))
+	return ids
+}
+
+func TestRewardsEstimator_Estimate(t *testing.T) {
+	epoch := primitives.Epoch(3)
+	start, err := slots.EpochStart(epoch)
+	require.NoError(t, err)
+
+	var attestationRequests uint64
+	e := newRewardsTestEstimator(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/eth/v1/beacon/rewards/attestations/3":
+			atomic.AddUint64(&attestationRequests, 1)
+			ids := decodeIndices(t, r)
+			require.Equal(t, true, len(ids) <= rewardsBatchSize)
+			resp := &structs.AttestationRewardsResponse{}
+			for _, id := range ids {
+				resp.Data.TotalRewards = append(resp.Data.TotalRewards, structs.TotalAttestationReward{
+					ValidatorIndex: id, Head: "10", Target: "20", Source: "30", Inactivity: "-5",
+				})
+			}
+			httputil.WriteJson(w, resp)
+		case r.URL.Path == "/eth/v1/beacon/rewards/blocks/"+strconv.Itoa(int(start)+1):
+			httputil.WriteJson(w, &structs.BlockRewardsResponse{Data: &structs.BlockRewards{ProposerIndex: "7", Total: "1000"}})
+		case r.URL.Path == "/eth/v1/beacon/rewards/blocks/"+strconv.Itoa(int(start)+2):
+			// The block of another proposer took the slot.
+			httputil.WriteJson(w, &structs.BlockRewardsResponse{Data: &structs.BlockRewards{ProposerIndex: "9", Total: "1000"}})
+		case r.URL.Path == "/eth/v1/beacon/rewards/sync_committee/"+strconv.Itoa(int(start)+4):
+			ids := decodeIndices(t, r)
+			require.DeepEqual(t, []string{"7"}, ids)
+			httputil.WriteJson(w, &structs.SyncCommitteeRewardsResponse{Data: []structs.SyncCommitteeReward{{ValidatorIndex: "7", Reward: "-3"}}})
+		case strings.HasPrefix(r.URL.Path, "/eth/v1/beacon/rewards/"):
+			httputil.HandleError(w, "not found", http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	e.trackProposal(start+1, 7)
+	e.trackProposal(start+2, 8)
+	e.trackSyncCommitteeMessage(start+3, 7)
+	// The block of the next slot is missing.
+	e.trackSyncCommitteeMessage(start+4, 7)
+	// Duties of later epochs are kept for the next estimation.
+	e.trackProposal(start+params.BeaconConfig().SlotsPerEpoch, 7)
+
+	indices := make([]primitives.ValidatorIndex, rewardsBatchSize+1)
+	for i := range indices {
+		indices[i] = primitives.ValidatorIndex(i)
+	}
+	res, err := e.estimate(context.Background(), epoch, indices)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), atomic.LoadUint64(&attestationRequests))
+	require.Equal(t, rewardsMethodAPI, res.method)
+	require.Equal(t, len(indices), len(res.rewards))
+	require.Equal(t, int64(55), res.rewards[0].total())
+	require.Equal(t, int64(55), res.rewards[rewardsBatchSize].total())
+	require.DeepEqual(t, &validatorRewards{attestations: 55, proposals: 1000, syncCommittee: -3}, res.rewards[7])
+	require.DeepEqual(t, &validatorRewards{attestations: 55}, res.rewards[8])
+	require.Equal(t, 1, len(e.proposals))
+	require.Equal(t, 0, len(e.syncCommittee))
+}
+
+func TestLogEstimatedRewards(t *testing.T) {
+	pubKey := [48]byte{1}
+	slot := params.BeaconConfig().SlotsPerEpoch*4 - 1
+
+	newValidator := func(t *testing.T, handler http.HandlerFunc) (*validator, *validatormock.MockChainClient) {
+		ctrl := gomock.NewController(t)
+		chainClient := validatormock.NewMockChainClient(ctrl)
+		return &validator{
+			chainClient:      chainClient,
+			rewardsEstimator: newRewardsTestEstimator(t, handler),
+			pubkeyToStatus: map[[48]byte]*validatorStatus{
+				pubKey: {
+					publicKey: pubKey[:],
+					status:    &ethpb.ValidatorStatusResponse{Status: ethpb.ValidatorStatus_ACTIVE},
+					index:     3,
+				},
+				{2}: {
+					publicKey: []byte{2},
+					status:    &ethpb.ValidatorStatusResponse{Status: ethpb.ValidatorStatus_PENDING},
+					index:     4,
+				},
+			},
+		}, chainClient
+	}
+
+	t.Run("rewards API", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		v, _ := newValidator(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/eth/v1/beacon/rewards/attestations/1", r.URL.Path)
+			require.DeepEqual(t, []string{"3"}, decodeIndices(t, r))
+			resp := &structs.AttestationRewardsResponse{}
+			resp.Data.TotalRewards = []structs.TotalAttestationReward{{ValidatorIndex: "3", Head: "1", Target: "2", Source: "3", Inactivity: "0"}}
+			httputil.WriteJson(w, resp)
+		})
+		require.NoError(t, v.LogEstimatedRewards(context.Background(), slot))
+		require.LogsContain(t, hook, "Estimated epoch rewards summary")
+		require.LogsContain(t, hook, "attestationsGwei=6")
+		require.LogsContain(t, hook, "method=rewards_api")
+	})
+	t.Run("falls back to balance changes", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		v, chainClient := newValidator(t, func(w http.ResponseWriter, r *http.Request) {
+			httputil.HandleError(w, "not found", http.StatusNotFound)
+		})
+		chainClient.EXPECT().ValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+			PublicKeys:                    [][]byte{pubKey[:]},
+			BalancesBeforeEpochTransition: []uint64{32000000000},
+			BalancesAfterEpochTransition:  []uint64{31999999990},
+		}, nil)
+		require.NoError(t, v.LogEstimatedRewards(context.Background(), slot))
+		require.LogsContain(t, hook, "balanceDeltaGwei=-10")
+		require.LogsContain(t, hook, "method=balance_delta")
+	})
+	t.Run("not at epoch end", func(t *testing.T) {
+		v, _ := newValidator(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		})
+		require.NoError(t, v.LogEstimatedRewards(context.Background(), slot-1))
+	})
+}
@@ -43,7 +43,11 @@ func run(ctx context.Context, v iface.Validator) {
 	if err != nil {
 		return // Exit if context is canceled.
 	}
-	if err := v.UpdateDuties(ctx, headSlot); err != nil {
+	// Duties are only performed once the beacon node is known to be on the chain of the validator database.
+	chainErr := v.CheckBeaconNodeChain(ctx)
+	if chainErr != nil {
+		handleChainError(chainErr)
+	} else if err := v.UpdateDuties(ctx, headSlot); err != nil {
 		handleAssignmentError(err, headSlot)
 	}
 	eventsChan := make(chan *event.Event, 1)
@@ -62,8 +66,10 @@ func run(ctx context.Context, v iface.Validator) {
 		log.Warn("Validator client started without proposer settings such as fee recipient" +
 			" and will continue to use settings provided in the beacon node.")
 	}
-	if err := v.PushProposerSettings(ctx, km, headSlot, true); err != nil {
-		log.WithError(err).Fatal("Failed to update proposer settings")
+	if chainErr == nil {
+		if err := v.PushProposerSettings(ctx, km, headSlot, true); err != nil {
+			log.WithError(err).Fatal("Failed to update proposer settings")
+		}
 	}
 	for {
 		ctx, span := prysmTrace.StartSpan(ctx, "validator.processSlot")
@@ -85,6 +91,13 @@ func run(ctx context.Context, v iface.Validator) {
 			log := log.WithField("slot", slot)
 			log.WithField("deadline", deadline).Debug("Set deadline for proposals and attestations")
 
+			if err := v.CheckBeaconNodeChain(ctx); err != nil {
+				handleChainError(err)
+				cancel()
+				span.End()
+				continue
+			}
+
 			// Keep trying to update assignments if they are nil or if we are past an
 			// epoch transition in the beacon node's state.
 			if err := v.UpdateDuties(ctx, slot); err != nil {
@@ -123,6 +136,10 @@ func run(ctx context.Context, v iface.Validator) {
 					log.WithError(err).Error("Failed to re initialize validator and get head slot")
 					continue
 				}
+				if err := v.CheckBeaconNodeChain(ctx); err != nil {
+					handleChainError(err)
+					continue
+				}
 				if err := v.UpdateDuties(ctx, headSlot); err != nil {
 					handleAssignmentError(err, headSlot)
 					continue
@@ -180,6 +197,10 @@ func initializeValidatorAndGetHeadSlot(ctx context.Context, v iface.Validator) (
 				log.WithError(err).Warn("Could not determine if beacon chain started")
 				continue
 			}
+			if errors.Is(err, ErrWrongChain) {
+				handleChainError(err)
+				continue
+			}
 
 			log.WithError(err).Fatal("Could not determine if beacon chain started")
 		}
@@ -269,6 +290,12 @@ func performRoles(slotCtx context.Context, allRoles map[[48]byte][]iface.Validat
 		if err := v.LogValidatorGainsAndLosses(slotCtx, slot); err != nil {
 			log.WithError(err).Error("Could not report validator's rewards/penalties")
 		}
+		if err := v.LogEstimatedRewards(slotCtx, slot); err != nil {
+			log.WithError(err).Error("Could not estimate validator's rewards/penalties")
+		}
+		if err := v.SaveDutyHistory(slotCtx, slot); err != nil {
+			log.WithError(err).Error("Could not save validator's duty history")
+		}
 	}()
 }
 
@@ -279,6 +306,8 @@ func isConnectionError(err error) bool {
 func handleAssignmentError(err error, slot primitives.Slot) {
 	if errors.Is(err, ErrValidatorsAllExited) {
 		log.Warn(ErrValidatorsAllExited)
+	} else if errors.Is(err, client.ErrNodeSyncing) {
+		log.WithField("slot", slot).Debug("Beacon node is syncing, assignments will be updated once it is synced")
 	} else if errCode, ok := status.FromError(err); ok && errCode.Code() == codes.NotFound {
 		log.WithField(
 			"epoch", slot/params.BeaconConfig().SlotsPerEpoch,
@@ -288,6 +317,25 @@ func handleAssignmentError(err error, slot primitives.Slot) {
 	}
 }
 
+// handleChainError logs why no duty is performed when the chain of the beacon node could not be checked. A beacon
+// node on another chain is retried, as the check is done again with the next beacon node when failing over.
+func handleChainError(err error) {
+	if errors.Is(err, ErrWrongChain) {
+		log.WithError(err).Error("Beacon node is on another chain, not performing duties until connected to a beacon node on the chain of the validator database")
+		return
+	}
+	log.WithError(err).Error("Could not check the chain of the beacon node, not performing duties")
+}
+
+// eventTopics returns the topics of the event stream. Beacon API validators also listen to duty invalidations,
+// which make them update their duties within an epoch when a reorg changes them.
+func eventTopics() []string {
+	if features.Get().EnableBeaconRESTApi {
+		return append([]string{event.EventDutiesInvalidated}, event.DefaultEventTopics...)
+	}
+	return event.DefaultEventTopics
+}
+
 func runHealthCheckRoutine(ctx context.Context, v iface.Validator, eventsChan chan<- *event.Event) {
 	log.Info("Starting health check routine for beacon node apis")
 	healthCheckTicker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
@@ -305,6 +353,10 @@ func runHealthCheckRoutine(ctx context.Context, v iface.Validator, eventsChan ch
 				if !tracker.CheckHealth(ctx) {
 					continue // Skip to the next ticker
 				}
+				if err := v.CheckBeaconNodeChain(ctx); err != nil {
+					handleChainError(err)
+					continue
+				}
 
 				km, err := v.Keymanager()
 				if err != nil {
@@ -324,7 +376,7 @@ func runHealthCheckRoutine(ctx context.Context, v iface.Validator, eventsChan ch
 			// in case of node returning healthy but event stream died
 			if isHealthy && !v.EventStreamIsRunning() {
 				log.Info("Event stream reconnecting...")
-				go v.StartEventStream(ctx, event.DefaultEventTopics, eventsChan)
+				go v.StartEventStream(ctx, eventTopics(), eventsChan)
 			}
 		}
 	}()
@@ -370,3 +370,37 @@ func TestUpdateProposerSettingsAt_EpochEndOk(t *testing.T) {
 	// can't test "Failed to update proposer settings" because of log.fatal
 	assert.LogsContain(t, hook, "Mock updated proposer settings")
 }
+
+func TestWrongChain_NoDuties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	hook := logTest.NewGlobal()
+	node := healthTesting.NewMockHealthClient(ctrl)
+	tracker := beacon.NewNodeHealthTracker(node)
+	node.EXPECT().IsHealthy(gomock.Any()).Return(true).AnyTimes()
+	// avoid race condition between the cancellation of the context in the go stream from slot and the setting of IsHealthy
+	_ = tracker.CheckHealth(context.Background())
+	v := &testutil.FakeValidator{
+		Km:                      &mockKeymanager{accountsChangedFeed: &event.Feed{}},
+		Tracker:                 tracker,
+		CheckBeaconNodeChainErr: errors.Wrap(ErrWrongChain, "genesis validators root does not match"),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ticker := make(chan primitives.Slot)
+	v.NextSlotRet = ticker
+	v.RolesAtRet = []iface.ValidatorRole{iface.RoleAttester, iface.RoleProposer}
+	go func() {
+		ticker <- primitives.Slot(55)
+
+		cancel()
+	}()
+	run(ctx, v)
+	// The chain is checked before the first duties, then at the slot.
+	assert.Equal(t, 2, v.CheckBeaconNodeChainCalled)
+	assert.Equal(t, false, v.UpdateDutiesCalled, "UpdateDuties should not be called")
+	assert.Equal(t, false, v.RoleAtCalled, "RolesAt should not be called")
+	assert.Equal(t, false, v.AttestToBlockHeadCalled, "SubmitAttestation should not be called")
+	assert.Equal(t, false, v.ProposeBlockCalled, "ProposeBlock should not be called")
+	require.LogsContain(t, hook, "Beacon node is on another chain")
+}
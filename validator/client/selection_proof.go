@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+)
+
+// selectionProofKey identifies a selection proof by the key signing it, its slot and its domain, along with the sync
+// subcommittee index for sync committee selection proofs.
+type selectionProofKey struct {
+	slot   primitives.Slot
+	pubKey [fieldparams.BLSPubkeyLength]byte
+	domain [4]byte
+	index  uint64
+}
+
+func attestationSelectionProofKey(pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) selectionProofKey {
+	return selectionProofKey{slot: slot, pubKey: pubKey, domain: params.BeaconConfig().DomainSelectionProof}
+}
+
+func syncSelectionProofKey(pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, index uint64) selectionProofKey {
+	return selectionProofKey{slot: slot, pubKey: pubKey, domain: params.BeaconConfig().DomainSyncCommitteeSelectionProof, index: index}
+}
+
+// signSelectionProofs signs the attestation selection proofs of the given keys for a slot and caches them
+// for the aggregator check and the aggregation duty. When the keymanager supports it, all proofs are
+// signed in a single request.
+func (v *validator) signSelectionProofs(ctx context.Context, slot primitives.Slot, pubKeys [][fieldparams.BLSPubkeyLength]byte) error {
+	ctx, span := trace.StartSpan(ctx, "validator.signSelectionProofs")
+	defer span.End()
+
+	v.pruneSelectionProofs(slot)
+	ValidatorSelectionProofsGauge.Set(float64(len(pubKeys)))
+
+	// Proofs signed earlier in the slot are not signed again.
+	unsigned := make([][fieldparams.BLSPubkeyLength]byte, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if _, ok := v.cachedSelectionProof(attestationSelectionProofKey(pubKey, slot)); !ok {
+			unsigned = append(unsigned, pubKey)
+		}
+	}
+	pubKeys = unsigned
+
+	batchSigner, ok := v.km.(keymanager.BatchSigner)
+	if !ok || len(pubKeys) <= 1 {
+		ValidatorSelectionProofSignRequestsGauge.Set(float64(len(pubKeys)))
+		for _, pubKey := range pubKeys {
+			if _, err := v.selectionProof(ctx, pubKey, slot); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	reqs := make([]*validatorpb.SignRequest, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		req, err := v.selectionProofSignRequest(ctx, pubKey, slot)
+		if err != nil {
+			return err
+		}
+		reqs[i] = req
+	}
+	ValidatorSelectionProofSignRequestsGauge.Set(1)
+	sigs, err := batchSigner.SignBatch(ctx, reqs)
+	if err != nil {
+		return errors.Wrap(err, "could not sign selection proofs")
+	}
+	if len(sigs) != len(reqs) {
+		return errors.Errorf("expected %d selection proof signatures, got %d", len(reqs), len(sigs))
+	}
+
+	for i, pubKey := range pubKeys {
+		v.cacheSelectionProof(attestationSelectionProofKey(pubKey, slot), sigs[i].Marshal())
+	}
+	return nil
+}
+
+// selectionProof returns the cached attestation selection proof of the key for the slot, signing it if needed.
+func (v *validator) selectionProof(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) ([]byte, error) {
+	k := attestationSelectionProofKey(pubKey, slot)
+	if proof, ok := v.cachedSelectionProof(k); ok {
+		return proof, nil
+	}
+	proof, err := v.signSlotWithSelectionProof(ctx, pubKey, slot)
+	if err != nil {
+		return nil, err
+	}
+	v.cacheSelectionProof(k, proof)
+	return proof, nil
+}
+
+// syncSelectionProof returns the cached sync committee selection proof of the key for the slot and subcommittee,
+// signing it if needed.
+func (v *validator) syncSelectionProof(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, index uint64, slot primitives.Slot) ([]byte, error) {
+	k := syncSelectionProofKey(pubKey, slot, index)
+	if proof, ok := v.cachedSelectionProof(k); ok {
+		return proof, nil
+	}
+	proof, err := v.signSyncSelectionData(ctx, pubKey, index, slot)
+	if err != nil {
+		return nil, err
+	}
+	v.cacheSelectionProof(k, proof)
+	return proof, nil
+}
+
+func (v *validator) cachedSelectionProof(k selectionProofKey) ([]byte, bool) {
+	v.selectionProofCacheLock.Lock()
+	defer v.selectionProofCacheLock.Unlock()
+	proof, ok := v.selectionProofCache[k]
+	return proof, ok
+}
+
+func (v *validator) cacheSelectionProof(k selectionProofKey, proof []byte) {
+	v.selectionProofCacheLock.Lock()
+	defer v.selectionProofCacheLock.Unlock()
+	if v.selectionProofCache == nil {
+		v.selectionProofCache = make(map[selectionProofKey][]byte)
+	}
+	v.selectionProofCache[k] = proof
+}
+
+// pruneSelectionProofs drops the cached selection proofs of slots more than an epoch before the slot. Proofs of the
+// last epoch are kept, as duties may be evaluated again for their slots, such as when they are fetched again after a
+// reorg, and signing them again costs a round trip to remote signers.
+func (v *validator) pruneSelectionProofs(slot primitives.Slot) {
+	v.selectionProofCacheLock.Lock()
+	defer v.selectionProofCacheLock.Unlock()
+	for k := range v.selectionProofCache {
+		if k.slot+params.BeaconConfig().SlotsPerEpoch < slot {
+			delete(v.selectionProofCache, k)
+		}
+	}
+}
+
+// selectionProofSignRequest builds the request to sign the slot with the selection proof domain.
+func (v *validator) selectionProofSignRequest(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) (*validatorpb.SignRequest, error) {
+	domain, err := v.domainData(ctx, slots.ToEpoch(slot), params.BeaconConfig().DomainSelectionProof[:])
+	if err != nil {
+		return nil, err
+	}
+	sszUint := primitives.SSZUint64(slot)
+	root, err := signing.ComputeSigningRoot(&sszUint, domain.SignatureDomain)
+	if err != nil {
+		return nil, err
+	}
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey[:],
+		SigningRoot:     root[:],
+		SignatureDomain: domain.SignatureDomain,
+		Object:          &validatorpb.SignRequest_Slot{Slot: slot},
+		SigningSlot:     slot,
+	}, nil
+}
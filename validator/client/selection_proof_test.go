@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"go.uber.org/mock/gomock"
+)
+
+type batchSigningKeymanager struct {
+	*mockKeymanager
+	signRequests  int
+	batchRequests int
+}
+
+func (m *batchSigningKeymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	m.signRequests++
+	return m.mockKeymanager.Sign(ctx, req)
+}
+
+func (m *batchSigningKeymanager) SignBatch(ctx context.Context, reqs []*validatorpb.SignRequest) ([]bls.Signature, error) {
+	m.batchRequests++
+	sigs := make([]bls.Signature, len(reqs))
+	for i, req := range reqs {
+		sig, err := m.mockKeymanager.Sign(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = sig
+	}
+	return sigs, nil
+}
+
+func TestRolesAt_SignsSelectionProofsInBatch(t *testing.T) {
+	v, m, _, finish := setup(t, false)
+	defer finish()
+	km := &batchSigningKeymanager{mockKeymanager: genMockKeymanager(t, 3)}
+	v.km = km
+	m.validatorClient.EXPECT().DomainData(gomock.Any(), gomock.Any()).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).AnyTimes()
+
+	slot := primitives.Slot(1)
+	v.duties = &ethpb.DutiesResponse{}
+	for i, pubKey := range km.keys {
+		// The last key attests in another slot and needs no selection proof.
+		attesterSlot := slot
+		if i == len(km.keys)-1 {
+			attesterSlot = slot + 1
+		}
+		v.duties.CurrentEpochDuties = append(v.duties.CurrentEpochDuties, &ethpb.DutiesResponse_Duty{
+			AttesterSlot:   attesterSlot,
+			PublicKey:      pubKey[:],
+			ValidatorIndex: primitives.ValidatorIndex(i),
+		})
+	}
+
+	_, err := v.RolesAt(context.Background(), slot)
+	require.NoError(t, err)
+	require.Equal(t, 1, km.batchRequests)
+	require.Equal(t, 0, km.signRequests)
+	require.Equal(t, 2, len(v.selectionProofCache))
+
+	// The aggregation duty reuses the proofs.
+	proof, err := v.selectionProof(context.Background(), km.keys[0], slot)
+	require.NoError(t, err)
+	expected, err := v.signSlotWithSelectionProof(context.Background(), km.keys[0], slot)
+	require.NoError(t, err)
+	require.DeepEqual(t, expected, proof)
+	require.Equal(t, 1, km.signRequests)
+
+	// Proofs of slots more than an epoch old are pruned.
+	_, err = v.RolesAt(context.Background(), slot+params.BeaconConfig().SlotsPerEpoch+1)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(v.selectionProofCache))
+}
+
+func TestSignSelectionProofs_FallsBackToPerKeySigning(t *testing.T) {
+	v, m, validatorKey, finish := setup(t, false)
+	defer finish()
+	m.validatorClient.EXPECT().DomainData(gomock.Any(), gomock.Any()).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
+
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	require.NoError(t, v.signSelectionProofs(context.Background(), 1, [][fieldparams.BLSPubkeyLength]byte{pubKey}))
+	proof, ok := v.selectionProofCache[attestationSelectionProofKey(pubKey, 1)]
+	require.Equal(t, true, ok)
+
+	cached, err := v.selectionProof(context.Background(), pubKey, 1)
+	require.NoError(t, err)
+	require.DeepEqual(t, proof, cached)
+}
+
+func TestSelectionProofs_SignedOncePerSlot(t *testing.T) {
+	v, m, _, finish := setup(t, false)
+	defer finish()
+	km := &batchSigningKeymanager{mockKeymanager: genMockKeymanager(t, 1)}
+	v.km = km
+	m.validatorClient.EXPECT().DomainData(gomock.Any(), gomock.Any()).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).AnyTimes()
+	ctx := context.Background()
+	pubKey := km.keys[0]
+	slot := primitives.Slot(5)
+
+	// Duties evaluated again for the same slot, such as after a reorg, reuse the signed proofs.
+	for i := 0; i < 3; i++ {
+		_, err := v.selectionProof(ctx, pubKey, slot)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, km.signRequests)
+
+	indexRes := &ethpb.SyncSubcommitteeIndexResponse{Indices: []primitives.CommitteeIndex{0}}
+	var proofs [][]byte
+	for i := 0; i < 3; i++ {
+		p, err := v.selectionProofs(ctx, slot, pubKey, indexRes, 0)
+		require.NoError(t, err)
+		if proofs != nil {
+			require.DeepEqual(t, proofs, p)
+		}
+		proofs = p
+	}
+	require.Equal(t, 2, km.signRequests)
+
+	// Proofs of the last epoch are kept.
+	require.NoError(t, v.signSelectionProofs(ctx, slot+params.BeaconConfig().SlotsPerEpoch, nil))
+	_, err := v.selectionProof(ctx, pubKey, slot)
+	require.NoError(t, err)
+	require.Equal(t, 2, km.signRequests)
+}
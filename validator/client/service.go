@@ -12,6 +12,7 @@ import (
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
 	grpcutil "github.com/prysmaticlabs/prysm/v5/api/grpc"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
@@ -27,6 +28,7 @@ import (
 	nodeclientfactory "github.com/prysmaticlabs/prysm/v5/validator/client/node-client-factory"
 	validatorclientfactory "github.com/prysmaticlabs/prysm/v5/validator/client/validator-client-factory"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	validatorHelpers "github.com/prysmaticlabs/prysm/v5/validator/helpers"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
@@ -49,6 +51,7 @@ type ValidatorService struct {
 	walletInitializedFeed   *event.Feed
 	graffiti                []byte
 	graffitiStruct          *graffiti.Graffiti
+	graffitiFilePath        string
 	interopKeysConfig       *local.InteropKeymanagerConfig
 	web3SignerConfig        *remoteweb3signer.SetupConfig
 	proposerSettings        *proposer.Settings
@@ -56,33 +59,58 @@ type ValidatorService struct {
 	useWeb                  bool
 	emitAccountMetrics      bool
 	logValidatorPerformance bool
+	estimateRewards         bool
 	distributed             bool
+	acceptGenesisChange     bool
+	blockRequestAttempts    int
+	localBlockFallback      bool
+	minBuilderBid           uint64
+	minBuilderBidRatio      float64
+	beaconApiRetries        int
+	beaconApiRetryDelay     time.Duration
+	beaconApiHedgeEndpoint  string
+	beaconApiHedgeDelay     time.Duration
+	blockPublisher          *blockPublisher
 }
 
 // Config for the validator service.
 type Config struct {
-	Validator               iface.Validator
-	DB                      db.Database
-	Wallet                  *wallet.Wallet
-	WalletInitializedFeed   *event.Feed
-	GRPCMaxCallRecvMsgSize  int
-	GRPCRetries             uint
-	GRPCRetryDelay          time.Duration
-	GRPCHeaders             []string
-	BeaconNodeGRPCEndpoint  string
-	BeaconNodeCert          string
-	BeaconApiEndpoint       string
-	BeaconApiTimeout        time.Duration
-	Graffiti                string
-	GraffitiStruct          *graffiti.Graffiti
-	InteropKmConfig         *local.InteropKeymanagerConfig
-	Web3SignerConfig        *remoteweb3signer.SetupConfig
-	ProposerSettings        *proposer.Settings
-	ValidatorsRegBatchSize  int
-	UseWeb                  bool
-	LogValidatorPerformance bool
-	EmitAccountMetrics      bool
-	Distributed             bool
+	Validator                    iface.Validator
+	DB                           db.Database
+	Wallet                       *wallet.Wallet
+	WalletInitializedFeed        *event.Feed
+	GRPCMaxCallRecvMsgSize       int
+	GRPCRetries                  uint
+	GRPCRetryDelay               time.Duration
+	GRPCHeaders                  []string
+	BeaconNodeGRPCEndpoint       string
+	BeaconNodeCert               string
+	BeaconApiEndpoint            string
+	BeaconApiTimeout             time.Duration
+	BeaconApiRetries             int
+	BeaconApiRetryDelay          time.Duration
+	BeaconApiHedgeEndpoint       string
+	BeaconApiHedgeDelay          time.Duration
+	Graffiti                     string
+	GraffitiStruct               *graffiti.Graffiti
+	GraffitiFilePath             string
+	InteropKmConfig              *local.InteropKeymanagerConfig
+	Web3SignerConfig             *remoteweb3signer.SetupConfig
+	ProposerSettings             *proposer.Settings
+	ValidatorsRegBatchSize       int
+	UseWeb                       bool
+	LogValidatorPerformance      bool
+	EmitAccountMetrics           bool
+	EstimateRewards              bool
+	Distributed                  bool
+	AcceptGenesisChange          bool
+	BlockRequestAttempts         int
+	LocalBlockFallback           bool
+	MinBuilderBid                uint64
+	MinBuilderBidToLocalRatio    float64
+	BlockPublishEndpoints        []string
+	BlindedBlockPublishEndpoints []string
+	BlockPublishTimeout          time.Duration
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -98,6 +126,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		walletInitializedFeed:   cfg.WalletInitializedFeed,
 		graffiti:                []byte(cfg.Graffiti),
 		graffitiStruct:          cfg.GraffitiStruct,
+		graffitiFilePath:        cfg.GraffitiFilePath,
 		interopKeysConfig:       cfg.InteropKmConfig,
 		web3SignerConfig:        cfg.Web3SignerConfig,
 		proposerSettings:        cfg.ProposerSettings,
@@ -105,9 +134,27 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		useWeb:                  cfg.UseWeb,
 		emitAccountMetrics:      cfg.EmitAccountMetrics,
 		logValidatorPerformance: cfg.LogValidatorPerformance,
+		estimateRewards:         cfg.EstimateRewards,
 		distributed:             cfg.Distributed,
+		acceptGenesisChange:     cfg.AcceptGenesisChange,
+		blockRequestAttempts:    cfg.BlockRequestAttempts,
+		localBlockFallback:      cfg.LocalBlockFallback,
+		minBuilderBid:           cfg.MinBuilderBid,
+		minBuilderBidRatio:      cfg.MinBuilderBidToLocalRatio,
+		beaconApiRetries:        cfg.BeaconApiRetries,
+		beaconApiRetryDelay:     cfg.BeaconApiRetryDelay,
+		beaconApiHedgeEndpoint:  cfg.BeaconApiHedgeEndpoint,
+		beaconApiHedgeDelay:     cfg.BeaconApiHedgeDelay,
 	}
 
+	beaconNodeHosts := strings.Split(strings.ReplaceAll(cfg.BeaconApiEndpoint, " ", ""), ",")
+	publisher, err := newBlockPublisher(cfg.BlockPublishEndpoints, cfg.BlindedBlockPublishEndpoints, beaconNodeHosts, cfg.BlockPublishTimeout)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "invalid block publish endpoints")
+	}
+	s.blockPublisher = publisher
+
 	dialOpts := ConstructDialOptions(
 		cfg.GRPCMaxCallRecvMsgSize,
 		cfg.BeaconNodeCert,
@@ -166,6 +213,11 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
+	savedDutyHistory, err := v.db.DutyHistory(v.ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not read duty history from disk, starting with an empty one")
+	}
+
 	u := strings.ReplaceAll(v.conn.GetBeaconApiUrl(), " ", "")
 	hosts := strings.Split(u, ",")
 	if len(hosts) == 0 {
@@ -175,14 +227,25 @@ func (v *ValidatorService) Start() {
 	restHandler := beaconApi.NewBeaconApiJsonRestHandler(
 		http.Client{Timeout: v.conn.GetBeaconApiTimeout()},
 		hosts[0],
+		beaconApi.WithRetry(client.RetryConfig{
+			MaxAttempts:    v.beaconApiRetries,
+			InitialBackoff: v.beaconApiRetryDelay,
+			MaxBackoff:     4 * v.beaconApiRetryDelay,
+		}),
+		beaconApi.WithHedging(v.beaconApiHedgeEndpoint, v.beaconApiHedgeDelay),
 	)
 
-	validatorClient := validatorclientfactory.NewValidatorClient(v.conn, restHandler)
+	var validatorClientOpts []beaconApi.ValidatorClientOpt
+	if v.distributed {
+		validatorClientOpts = append(validatorClientOpts, beaconApi.WithDistributed())
+	}
+	validatorClient := validatorclientfactory.NewValidatorClient(v.conn, restHandler, validatorClientOpts...)
 
 	valStruct := &validator{
 		slotFeed:                       new(event.Feed),
 		startBalances:                  make(map[[fieldparams.BLSPubkeyLength]byte]uint64),
 		prevEpochBalances:              make(map[[fieldparams.BLSPubkeyLength]byte]uint64),
+		effectiveBalances:              make(map[[fieldparams.BLSPubkeyLength]byte]uint64),
 		blacklistedPubkeys:             slashablePublicKeys,
 		pubkeyToStatus:                 make(map[[fieldparams.BLSPubkeyLength]byte]*validatorStatus),
 		wallet:                         v.wallet,
@@ -190,6 +253,7 @@ func (v *ValidatorService) Start() {
 		walletInitializedFeed:          v.walletInitializedFeed,
 		graffiti:                       v.graffiti,
 		graffitiStruct:                 v.graffitiStruct,
+		graffitiFilePath:               v.graffitiFilePath,
 		graffitiOrderedIndex:           graffitiOrderedIndex,
 		beaconNodeHosts:                hosts,
 		currentHostIndex:               0,
@@ -215,6 +279,16 @@ func (v *ValidatorService) Start() {
 		emitAccountMetrics:             v.emitAccountMetrics,
 		useWeb:                         v.useWeb,
 		distributed:                    v.distributed,
+		acceptGenesisChange:            v.acceptGenesisChange,
+		blockRequestAttempts:           v.blockRequestAttempts,
+		localBlockFallback:             v.localBlockFallback,
+		minBuilderBid:                  v.minBuilderBid,
+		minBuilderBidRatio:             v.minBuilderBidRatio,
+		blockPublisher:                 v.blockPublisher,
+		dutyHistory:                    newDutyHistory(savedDutyHistory),
+	}
+	if v.estimateRewards {
+		valStruct.rewardsEstimator = newRewardsEstimator(restHandler)
 	}
 
 	v.validator = valStruct
@@ -265,13 +339,16 @@ func (v *ValidatorService) ProposerSettings() *proposer.Settings {
 
 // SetProposerSettings sets the proposer settings on the validator service as well as the underlying validator
 func (v *ValidatorService) SetProposerSettings(ctx context.Context, settings *proposer.Settings) error {
+	// passes settings down to be updated in database and saved in memory.
+	// updates to validator proposer settings will be in the validator object and not validator service.
+	if err := v.validator.SetProposerSettings(ctx, settings); err != nil {
+		return err
+	}
+
 	// validator service proposer settings is only used for pass through from node -> validator service -> validator.
 	// in memory use of proposer settings happens on validator.
 	v.proposerSettings = settings
-
-	// passes settings down to be updated in database and saved in memory.
-	// updates to validator proposer settings will be in the validator object and not validator service.
-	return v.validator.SetProposerSettings(ctx, settings)
+	return nil
 }
 
 // ConstructDialOptions constructs a list of grpc dial options
@@ -335,6 +412,23 @@ func (v *ValidatorService) Graffiti(ctx context.Context, pubKey [fieldparams.BLS
 	return v.validator.Graffiti(ctx, pubKey)
 }
 
+// GraffitiWithSource returns the graffiti configured for the validator public key together with where it is configured.
+func (v *ValidatorService) GraffitiWithSource(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, iface.GraffitiSource, error) {
+	if v.validator == nil {
+		return nil, iface.GraffitiSourceNone, errors.New("validator is unavailable")
+	}
+	return v.validator.GraffitiWithSource(ctx, pubKey)
+}
+
+// DutyHistory returns the outcome of the duties of each validator public key over the given number of epochs up to the
+// current one, oldest first.
+func (v *ValidatorService) DutyHistory(epochs uint64) (map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord, error) {
+	if v.validator == nil {
+		return nil, errors.New("validator is unavailable")
+	}
+	return v.validator.DutyHistory(epochs), nil
+}
+
 func (v *ValidatorService) SetGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, graffiti []byte) error {
 	if v.validator == nil {
 		return errors.New("validator is unavailable")
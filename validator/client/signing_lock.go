@@ -0,0 +1,30 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/v5/async"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+// proposerLockKeys returns the multilock keys held while proposing a block with the public key.
+func proposerLockKeys(pubKey [fieldparams.BLSPubkeyLength]byte) []string {
+	return []string{fmt.Sprint(iface.RoleProposer), string(pubKey[:])}
+}
+
+// attesterLockKey returns the multilock key held while attesting with the public key.
+func attesterLockKey(pubKey [fieldparams.BLSPubkeyLength]byte) string {
+	return string(append([]byte{byte(iface.RoleAttester)}, pubKey[:]...))
+}
+
+// NewSigningLock returns a lock which, while held, prevents blocks and attestations from being signed
+// with any of the given public keys, so that their slashing protection history cannot change.
+func NewSigningLock(pubKeys ...[fieldparams.BLSPubkeyLength]byte) *async.Lock {
+	keys := make([]string, 0, 3*len(pubKeys))
+	for _, pk := range pubKeys {
+		keys = append(keys, proposerLockKeys(pk)...)
+		keys = append(keys, attesterLockKey(pk))
+	}
+	return async.NewMultilock(keys...)
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/db"
+	slashingprotection "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+const (
+	slashingProtectionSnapshotPrefix = "slashing-protection-"
+	slashingProtectionSnapshotSuffix = ".json"
+	// Snapshot names sort in the order they were taken.
+	slashingProtectionSnapshotTimeFormat = "20060102T150405.000000000Z"
+)
+
+var lastSlashingProtectionSnapshotTime = promauto.NewGauge(prometheus.GaugeOpts{
+	Namespace: "validator",
+	Name:      "slashing_protection_last_snapshot_timestamp_seconds",
+	Help:      "Unix time of the last successful export of the slashing protection history to the snapshots directory.",
+})
+
+// SlashingProtectionSnapshotsConfig for the slashing protection snapshots service.
+type SlashingProtectionSnapshotsConfig struct {
+	DB       db.Database
+	Dir      string
+	Interval time.Duration
+	// Keep is the number of most recent snapshots kept in Dir.
+	Keep int
+}
+
+// SlashingProtectionSnapshots periodically exports the EIP-3076 slashing protection history of all keys
+// to a directory, keeping only the most recent snapshots, so that the history can be recovered even if the
+// database is lost.
+type SlashingProtectionSnapshots struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *SlashingProtectionSnapshotsConfig
+}
+
+// NewSlashingProtectionSnapshots creates the slashing protection snapshots service.
+func NewSlashingProtectionSnapshots(ctx context.Context, cfg *SlashingProtectionSnapshotsConfig) (*SlashingProtectionSnapshots, error) {
+	if cfg.Interval <= 0 {
+		return nil, errors.New("slashing protection snapshots interval must be positive")
+	}
+	if cfg.Keep <= 0 {
+		return nil, errors.New("at least one slashing protection snapshot must be kept")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &SlashingProtectionSnapshots{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}, nil
+}
+
+// Start exporting snapshots, right away and then at every interval.
+func (s *SlashingProtectionSnapshots) Start() {
+	log.WithField("dir", s.cfg.Dir).WithField("interval", s.cfg.Interval).Info("Exporting slashing protection snapshots")
+	go s.run()
+}
+
+// Stop the service.
+func (s *SlashingProtectionSnapshots) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the service.
+func (*SlashingProtectionSnapshots) Status() error {
+	return nil
+}
+
+func (s *SlashingProtectionSnapshots) run() {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	s.export()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.export()
+		}
+	}
+}
+
+// export takes a snapshot, logging the outcome. A failed export must not stop the following ones.
+func (s *SlashingProtectionSnapshots) export() {
+	path, err := s.snapshot(s.ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not export slashing protection snapshot")
+		return
+	}
+	log.WithField("path", path).Info("Exported slashing protection snapshot")
+}
+
+// snapshot exports the slashing protection history of all keys to a new file of the snapshots directory,
+// then removes the oldest snapshots. It returns the path of the new snapshot.
+func (s *SlashingProtectionSnapshots) snapshot(ctx context.Context) (string, error) {
+	// The history is read from a single view of the database rather than holding the signing locks of the keys,
+	// so that it is consistent across keys and between the blocks and attestations of a key without delaying duties.
+	var interchange *format.EIPSlashingProtectionFormat
+	if err := s.cfg.DB.ViewSlashingProtection(ctx, func(r db.SlashingProtectionReader) error {
+		var err error
+		interchange, err = slashingprotection.ExportStandardProtectionJSON(ctx, r)
+		return err
+	}); err != nil {
+		return "", errors.Wrap(err, "could not export slashing protection history")
+	}
+	encoded, err := json.MarshalIndent(interchange, "", "\t")
+	if err != nil {
+		return "", errors.Wrap(err, "could not marshal slashing protection history")
+	}
+
+	if err := file.MkdirAll(s.cfg.Dir); err != nil {
+		return "", errors.Wrapf(err, "could not create directory %s", s.cfg.Dir)
+	}
+	now := time.Now()
+	name := slashingProtectionSnapshotPrefix + now.UTC().Format(slashingProtectionSnapshotTimeFormat) + slashingProtectionSnapshotSuffix
+	path := filepath.Join(s.cfg.Dir, name)
+	if err := writeFileSynced(s.cfg.Dir, path, encoded); err != nil {
+		return "", errors.Wrapf(err, "could not write %s", path)
+	}
+	lastSlashingProtectionSnapshotTime.Set(float64(now.Unix()))
+
+	if err := s.rotate(); err != nil {
+		log.WithError(err).Warn("Could not remove old slashing protection snapshots")
+	}
+	return path, nil
+}
+
+// rotate removes the oldest snapshots of the directory beyond the number of snapshots to keep.
+func (s *SlashingProtectionSnapshots) rotate() error {
+	entries, err := os.ReadDir(s.cfg.Dir)
+	if err != nil {
+		return err
+	}
+	// Entries are sorted by name, hence from the oldest snapshot to the most recent one.
+	var snapshots []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.Type().IsRegular() && strings.HasPrefix(name, slashingProtectionSnapshotPrefix) && strings.HasSuffix(name, slashingProtectionSnapshotSuffix) {
+			snapshots = append(snapshots, name)
+		}
+	}
+	for i := 0; i < len(snapshots)-s.cfg.Keep; i++ {
+		if err := os.Remove(filepath.Join(s.cfg.Dir, snapshots[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFileSynced writes the data to a temporary file of the directory, flushes it to disk and renames it to
+// the path, so that a snapshot is either complete or absent.
+func writeFileSynced(dir, path string, data []byte) error {
+	f, err := os.CreateTemp(dir, ".slashing-protection-*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer func() {
+		// Nothing to remove once renamed.
+		_ = os.Remove(tmp)
+	}()
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return err
+	}
+	d, err := os.Open(dir) // #nosec G304
+	if err != nil {
+		return err
+	}
+	if err := d.Sync(); err != nil {
+		_ = d.Close()
+		return err
+	}
+	return d.Close()
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	testing2 "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+func TestSlashingProtectionSnapshots(t *testing.T) {
+	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
+		t.Run(fmt.Sprintf("SlashingProtectionMinimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
+			ctx := context.Background()
+			pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+			valDB := testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, isSlashingProtectionMinimal)
+			require.NoError(t, valDB.SaveGenesisValidatorsRoot(ctx, bytesutil.PadTo([]byte{1}, fieldparams.RootLength)))
+			require.NoError(t, valDB.SaveProposalHistoryForSlot(ctx, pubKey, 10, make([]byte, fieldparams.RootLength)))
+
+			dir := filepath.Join(t.TempDir(), "snapshots")
+			s, err := NewSlashingProtectionSnapshots(ctx, &SlashingProtectionSnapshotsConfig{
+				DB:       valDB,
+				Dir:      dir,
+				Interval: time.Hour,
+				Keep:     2,
+			})
+			require.NoError(t, err)
+
+			// A file of the directory which is not a snapshot must be left alone.
+			require.NoError(t, os.MkdirAll(dir, 0700))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("notes"), 0600))
+
+			var paths []string
+			for i := 0; i < 3; i++ {
+				path, err := s.snapshot(ctx)
+				require.NoError(t, err)
+				paths = append(paths, path)
+			}
+
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			names := make([]string, len(entries))
+			for i, e := range entries {
+				names[i] = e.Name()
+			}
+			assert.DeepEqual(t, []string{"notes.txt", filepath.Base(paths[1]), filepath.Base(paths[2])}, names)
+
+			encoded, err := os.ReadFile(paths[2])
+			require.NoError(t, err)
+			interchange := &format.EIPSlashingProtectionFormat{}
+			require.NoError(t, json.Unmarshal(encoded, interchange))
+			require.Equal(t, 1, len(interchange.Data))
+			assert.Equal(t, fmt.Sprintf("%#x", pubKey[:]), interchange.Data[0].Pubkey)
+			require.Equal(t, 1, len(interchange.Data[0].SignedBlocks))
+			assert.Equal(t, "10", interchange.Data[0].SignedBlocks[0].Slot)
+		})
+	}
+}
+
+func TestSlashingProtectionSnapshots_ExportsAtStart(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	valDB := testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, false)
+	require.NoError(t, valDB.SaveGenesisValidatorsRoot(ctx, bytesutil.PadTo([]byte{1}, fieldparams.RootLength)))
+	dir := t.TempDir()
+	s, err := NewSlashingProtectionSnapshots(ctx, &SlashingProtectionSnapshotsConfig{
+		DB:       valDB,
+		Dir:      dir,
+		Interval: time.Hour,
+		Keep:     2,
+	})
+	require.NoError(t, err)
+
+	// The first snapshot must not wait for the interval to elapse.
+	s.Start()
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+	for i := 0; ; i++ {
+		matches, err := filepath.Glob(filepath.Join(dir, slashingProtectionSnapshotPrefix+"*"+slashingProtectionSnapshotSuffix))
+		require.NoError(t, err)
+		if len(matches) == 1 {
+			break
+		}
+		require.Equal(t, true, i < 100, "No snapshot exported at start")
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestSlashingProtectionSnapshots_ExportFailure(t *testing.T) {
+	ctx := context.Background()
+	// Without a genesis validators root, the history cannot be exported.
+	valDB := testing2.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{{1}}, false)
+	dir := t.TempDir()
+	s, err := NewSlashingProtectionSnapshots(ctx, &SlashingProtectionSnapshotsConfig{
+		DB:       valDB,
+		Dir:      dir,
+		Interval: time.Hour,
+		Keep:     2,
+	})
+	require.NoError(t, err)
+
+	_, err = s.snapshot(ctx)
+	require.ErrorContains(t, "could not export slashing protection history", err)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(entries))
+}
+
+func TestNewSlashingProtectionSnapshots_InvalidConfig(t *testing.T) {
+	_, err := NewSlashingProtectionSnapshots(context.Background(), &SlashingProtectionSnapshotsConfig{Interval: 0, Keep: 1})
+	require.ErrorContains(t, "interval must be positive", err)
+	_, err = NewSlashingProtectionSnapshots(context.Background(), &SlashingProtectionSnapshotsConfig{Interval: time.Hour, Keep: 0})
+	require.ErrorContains(t, "at least one", err)
+}
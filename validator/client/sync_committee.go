@@ -34,7 +34,7 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot primiti
 
 	res, err := v.validatorClient.SyncMessageBlockRoot(ctx, &emptypb.Empty{})
 	if err != nil {
-		log.WithError(err).Error("Could not request sync message block root to sign")
+		v.logRequestError(log, dutySyncCommitteeMessage, err, "Could not request sync message block root to sign")
 		tracing.AnnotateError(span, err)
 		return
 	}
@@ -78,7 +78,7 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot primiti
 		Signature:      sig.Marshal(),
 	}
 	if _, err := v.validatorClient.SubmitSyncMessage(ctx, msg); err != nil {
-		log.WithError(err).Error("Could not submit sync committee message")
+		v.logRequestError(log, dutySyncCommitteeMessage, err, "Could not submit sync committee message")
 		return
 	}
 
@@ -92,6 +92,7 @@ func (v *validator) SubmitSyncCommitteeMessage(ctx context.Context, slot primiti
 		"validatorIndex":     msg.ValidatorIndex,
 	}).Info("Submitted new sync message")
 	atomic.AddUint64(&v.syncCommitteeStats.totalMessagesSubmitted, 1)
+	v.rewardsEstimator.trackSyncCommitteeMessage(msg.Slot, msg.ValidatorIndex)
 }
 
 // SubmitSignedContributionAndProof submits the signed sync committee contribution and proof to the beacon chain.
@@ -144,7 +145,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 			SubnetId:  subnet,
 		})
 		if err != nil {
-			log.WithError(err).Error("Could not get sync committee contribution")
+			v.logRequestError(log, dutySyncCommitteeContrib, err, "Could not get sync committee contribution")
 			return
 		}
 		if contribution.AggregationBits.Count() == 0 {
@@ -171,7 +172,7 @@ func (v *validator) SubmitSignedContributionAndProof(ctx context.Context, slot p
 			Message:   contributionAndProof,
 			Signature: sig,
 		}); err != nil {
-			log.WithError(err).Error("Could not submit signed contribution and proof")
+			v.logRequestError(log, dutySyncCommitteeContrib, err, "Could not submit signed contribution and proof")
 			return
 		}
 
@@ -194,6 +195,7 @@ func (v *validator) selectionProofs(ctx context.Context, slot primitives.Slot, p
 	ctx, span := trace.StartSpan(ctx, "validator.selectionProofs")
 	defer span.End()
 
+	v.pruneSelectionProofs(slot)
 	selectionProofs := make([][]byte, len(indexRes.Indices))
 	cfg := params.BeaconConfig()
 	size := cfg.SyncCommitteeSize
@@ -202,7 +204,7 @@ func (v *validator) selectionProofs(ctx context.Context, slot primitives.Slot, p
 	for i, index := range indexRes.Indices {
 		subSize := size / subCount
 		subnet := uint64(index) / subSize
-		selectionProof, err := v.signSyncSelectionData(ctx, pubKey, subnet, slot)
+		selectionProof, err := v.syncSelectionProof(ctx, pubKey, subnet, slot)
 		if err != nil {
 			return nil, err
 		}
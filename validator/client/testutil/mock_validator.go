@@ -15,6 +15,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	log "github.com/sirupsen/logrus"
 )
@@ -33,11 +34,13 @@ type FakeValidator struct {
 	AttestToBlockHeadCalled           bool
 	ProposeBlockCalled                bool
 	LogValidatorGainsAndLossesCalled  bool
+	LogEstimatedRewardsCalled         bool
 	SaveProtectionsCalled             bool
 	DeleteProtectionCalled            bool
 	SlotDeadlineCalled                bool
 	HandleKeyReloadCalled             bool
 	WaitForChainStartCalled           int
+	CheckBeaconNodeChainCalled        int
 	WaitForSyncCalled                 int
 	WaitForActivationCalled           int
 	CanonicalHeadSlotCalled           int
@@ -51,6 +54,7 @@ type FakeValidator struct {
 	PublicKey                         string
 	UpdateDutiesRet                   error
 	ProposerSettingsErr               error
+	CheckBeaconNodeChainErr           error
 	RolesAtRet                        []iface.ValidatorRole
 	Balances                          map[[fieldparams.BLSPubkeyLength]byte]uint64
 	IndexToPubkeyMap                  map[uint64][fieldparams.BLSPubkeyLength]byte
@@ -88,6 +92,12 @@ func (fv *FakeValidator) WaitForChainStart(_ context.Context) error {
 	return nil
 }
 
+// CheckBeaconNodeChain for mocking.
+func (fv *FakeValidator) CheckBeaconNodeChain(_ context.Context) error {
+	fv.CheckBeaconNodeChainCalled++
+	return fv.CheckBeaconNodeChainErr
+}
+
 // WaitForActivation for mocking.
 func (fv *FakeValidator) WaitForActivation(_ context.Context, accountChan chan [][fieldparams.BLSPubkeyLength]byte) error {
 	fv.WaitForActivationCalled++
@@ -155,6 +165,22 @@ func (fv *FakeValidator) LogValidatorGainsAndLosses(_ context.Context, _ primiti
 	return nil
 }
 
+// LogEstimatedRewards for mocking.
+func (fv *FakeValidator) LogEstimatedRewards(_ context.Context, _ primitives.Slot) error {
+	fv.LogEstimatedRewardsCalled = true
+	return nil
+}
+
+// DutyHistory for mocking.
+func (*FakeValidator) DutyHistory(_ uint64) map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord {
+	return nil
+}
+
+// SaveDutyHistory for mocking.
+func (*FakeValidator) SaveDutyHistory(_ context.Context, _ primitives.Slot) error {
+	return nil
+}
+
 // ResetAttesterProtectionData for mocking.
 func (fv *FakeValidator) ResetAttesterProtectionData() {
 	fv.DeleteProtectionCalled = true
@@ -296,6 +322,14 @@ func (fv *FakeValidator) Graffiti(_ context.Context, _ [fieldparams.BLSPubkeyLen
 	return []byte(fv.graffiti), nil
 }
 
+// GraffitiWithSource for mocking
+func (fv *FakeValidator) GraffitiWithSource(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte) ([]byte, iface.GraffitiSource, error) {
+	if fv.graffiti == "" {
+		return []byte{}, iface.GraffitiSourceNone, nil
+	}
+	return []byte(fv.graffiti), iface.GraffitiSourceProposerSettings, nil
+}
+
 // SetGraffiti for mocking
 func (fv *FakeValidator) SetGraffiti(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte, graffiti []byte) error {
 	fv.graffiti = string(graffiti)
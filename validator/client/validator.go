@@ -3,7 +3,6 @@
 package client
 
 import (
-	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/hex"
@@ -14,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
@@ -27,7 +27,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
-	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -67,12 +66,15 @@ var (
 
 type validator struct {
 	duties                             *ethpb.DutiesResponse
+	dutiesInvalidated                  bool
+	dutyDependentRoots                 *dutyDependentRoots
 	ticker                             slots.Ticker
 	genesisTime                        uint64
 	highestValidSlot                   primitives.Slot
 	slotFeed                           *event.Feed
 	startBalances                      map[[fieldparams.BLSPubkeyLength]byte]uint64
 	prevEpochBalances                  map[[fieldparams.BLSPubkeyLength]byte]uint64
+	effectiveBalances                  map[[fieldparams.BLSPubkeyLength]byte]uint64
 	blacklistedPubkeys                 map[[fieldparams.BLSPubkeyLength]byte]bool
 	pubkeyToStatus                     map[[fieldparams.BLSPubkeyLength]byte]*validatorStatus
 	wallet                             *wallet.Wallet
@@ -80,7 +82,9 @@ type validator struct {
 	walletInitializedFeed              *event.Feed
 	graffiti                           []byte
 	graffitiStruct                     *graffiti.Graffiti
+	graffitiFilePath                   string
 	graffitiOrderedIndex               uint64
+	graffitiLock                       sync.Mutex
 	beaconNodeHosts                    []string
 	currentHostIndex                   uint64
 	validatorClient                    iface.ValidatorClient
@@ -95,6 +99,7 @@ type validator struct {
 	validatorsRegBatchSize             int
 	interopKeysConfig                  *local.InteropKeymanagerConfig
 	attSelections                      map[attSelectionKey]iface.BeaconCommitteeSelection
+	selectionProofCache                map[selectionProofKey][]byte
 	aggregatedSlotCommitteeIDCache     *lru.Cache
 	domainDataCache                    *ristretto.Cache
 	voteStats                          voteStats
@@ -103,8 +108,17 @@ type validator struct {
 	submittedAggregates                map[submittedAttKey]*submittedAtt
 	logValidatorPerformance            bool
 	emitAccountMetrics                 bool
+	rewardsEstimator                   *rewardsEstimator
 	useWeb                             bool
 	distributed                        bool
+	acceptGenesisChange                bool
+	beaconNodeChainChecked             atomic.Bool
+	blockRequestAttempts               int
+	localBlockFallback                 bool
+	minBuilderBid                      uint64
+	minBuilderBidRatio                 float64
+	blockPublisher                     *blockPublisher
+	dutyHistory                        *dutyHistory
 	domainDataLock                     sync.RWMutex
 	attLogsLock                        sync.Mutex
 	aggregatedSlotCommitteeIDCacheLock sync.Mutex
@@ -112,7 +126,17 @@ type validator struct {
 	prevEpochBalancesLock              sync.RWMutex
 	blacklistedPubkeysLock             sync.RWMutex
 	attSelectionLock                   sync.Mutex
+	selectionProofCacheLock            sync.Mutex
 	dutiesLock                         sync.RWMutex
+	nodeSyncingUntil                   map[string]time.Time
+	nodeSyncingLock                    sync.RWMutex
+}
+
+// dutyDependentRoots are the duty dependent roots of the head announced by the beacon node during an epoch.
+type dutyDependentRoots struct {
+	epoch    primitives.Epoch
+	previous string
+	current  string
 }
 
 type validatorStatus struct {
@@ -279,32 +303,8 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 
 	v.genesisTime = chainStartRes.GenesisTime
 
-	curGenValRoot, err := v.db.GenesisValidatorsRoot(ctx)
-	if err != nil {
-		return errors.Wrap(err, "could not get current genesis validators root")
-	}
-
-	if len(curGenValRoot) == 0 {
-		if err := v.db.SaveGenesisValidatorsRoot(ctx, chainStartRes.GenesisValidatorsRoot); err != nil {
-			return errors.Wrap(err, "could not save genesis validators root")
-		}
-
-		v.setTicker()
-		return nil
-	}
-
-	if !bytes.Equal(curGenValRoot, chainStartRes.GenesisValidatorsRoot) {
-		log.Errorf(`The genesis validators root received from the beacon node does not match what is in
-			your validator database. This could indicate that this is a database meant for another network. If
-			you were previously running this validator database on another network, please run --%s to
-			clear the database. If not, please file an issue at https://github.com/prysmaticlabs/prysm/issues`,
-			cmd.ClearDB.Name,
-		)
-		return fmt.Errorf(
-			"genesis validators root from beacon node (%#x) does not match root saved in validator db (%#x)",
-			chainStartRes.GenesisValidatorsRoot,
-			curGenValRoot,
-		)
+	if err := v.checkGenesis(ctx, chainStartRes.GenesisValidatorsRoot, chainStartRes.GenesisTime); err != nil {
+		return err
 	}
 
 	v.setTicker()
@@ -532,8 +532,15 @@ func retrieveLatestRecord(recs []*dbCommon.AttestationRecord) *dbCommon.Attestat
 // list of upcoming assignments needs to be updated. For example, at the
 // beginning of a new epoch.
 func (v *validator) UpdateDuties(ctx context.Context, slot primitives.Slot) error {
-	if !slots.IsEpochStart(slot) && v.duties != nil {
-		// Do nothing if not epoch start AND assignments already exist.
+	if !slots.IsEpochStart(slot) && v.duties != nil && !v.dutiesInvalidated {
+		// Do nothing if not epoch start AND assignments already exist and are still valid.
+		return nil
+	}
+	if v.isNodeSyncing() {
+		// Keep the assignments while the beacon node is syncing and update them once the back off expires.
+		v.dutiesLock.Lock()
+		v.dutiesInvalidated = true
+		v.dutiesLock.Unlock()
 		return nil
 	}
 	// Set deadline to end of epoch.
@@ -575,14 +582,21 @@ func (v *validator) UpdateDuties(ctx context.Context, slot primitives.Slot) erro
 	resp, err := v.validatorClient.Duties(ctx, req)
 	if err != nil {
 		v.dutiesLock.Lock()
-		v.duties = nil // Clear assignments so we know to retry the request.
+		if errors.Is(err, client.ErrNodeSyncing) {
+			// Keep the assignments of a syncing beacon node, so that they are not lost if the node keeps
+			// failing, and retry the request once the back off expires.
+			v.dutiesInvalidated = true
+		} else {
+			v.duties = nil // Clear assignments so we know to retry the request.
+		}
 		v.dutiesLock.Unlock()
-		log.WithError(err).Error("error getting validator duties")
+		v.logRequestError(log, dutyUpdateDuties, err, "error getting validator duties")
 		return err
 	}
 
 	v.dutiesLock.Lock()
 	v.duties = resp
+	v.dutiesInvalidated = false
 	v.logDuties(slot, v.duties.CurrentEpochDuties, v.duties.NextEpochDuties)
 	v.dutiesLock.Unlock()
 
@@ -703,6 +717,11 @@ func (v *validator) RolesAt(ctx context.Context, slot primitives.Slot) (map[[fie
 	ctx, span := trace.StartSpan(ctx, "validator.RolesAt")
 	defer span.End()
 
+	if v.probeNodeSyncing(ctx, slot) {
+		log.WithField("slot", slot).Debug("Beacon node is syncing, not performing duties")
+		return make(map[[fieldparams.BLSPubkeyLength]byte][]iface.ValidatorRole), nil
+	}
+
 	v.dutiesLock.RLock()
 	defer v.dutiesLock.RUnlock()
 
@@ -714,6 +733,21 @@ func (v *validator) RolesAt(ctx context.Context, slot primitives.Slot) (map[[fie
 		syncCommitteeValidators = make(map[primitives.ValidatorIndex][fieldparams.BLSPubkeyLength]byte)
 	)
 
+	// Sign the selection proofs of all keys attesting in the slot up front, so that they can be
+	// sent to the keymanager in a single request. Keys without an attestation duty in the slot are
+	// never asked for a proof.
+	if !v.distributed {
+		var attesters [][fieldparams.BLSPubkeyLength]byte
+		for _, duty := range v.duties.CurrentEpochDuties {
+			if duty != nil && duty.AttesterSlot == slot {
+				attesters = append(attesters, bytesutil.ToBytes48(duty.PublicKey))
+			}
+		}
+		if err := v.signSelectionProofs(ctx, slot, attesters); err != nil {
+			log.WithError(err).Warn("Could not sign selection proofs in a batch, falling back to signing them per key")
+		}
+	}
+
 	for validator, duty := range v.duties.CurrentEpochDuties {
 		var roles []iface.ValidatorRole
 
@@ -834,7 +868,7 @@ func (v *validator) isAggregator(
 			return false, err
 		}
 	} else {
-		slotSig, err = v.signSlotWithSelectionProof(ctx, pubKey, slot)
+		slotSig, err = v.selectionProof(ctx, pubKey, slot)
 		if err != nil {
 			return false, err
 		}
@@ -1177,12 +1211,50 @@ func (v *validator) ProcessEvent(event *eventClient.Event) {
 			log.WithError(err).Error("Failed to parse slot")
 		}
 		v.setHighestSlot(primitives.Slot(uintSlot))
+	case eventClient.EventDutiesInvalidated:
+		ev := &structs.DutiesInvalidatedEvent{}
+		if err := json.Unmarshal(event.Data, ev); err != nil {
+			log.WithError(err).Error("Failed to unmarshal duties invalidated event into JSON")
+			return
+		}
+		epoch, err := strconv.ParseUint(ev.Epoch, 10, 64)
+		if err != nil {
+			log.WithError(err).Error("Failed to parse epoch")
+			return
+		}
+		v.checkDutyDependentRoots(&dutyDependentRoots{
+			epoch:    primitives.Epoch(epoch),
+			previous: ev.PreviousDutyDependentRoot,
+			current:  ev.CurrentDutyDependentRoot,
+		})
 	default:
 		// just keep going and log the error
 		log.WithField("type", event.EventType).WithField("data", string(event.Data)).Warn("Received an unknown event")
 	}
 }
 
+// checkDutyDependentRoots marks the duties for an update at the next slot when the duty dependent roots of the
+// current epoch change, for example after a reorg. The roots announced for a new epoch are only recorded, as
+// duties are updated at the start of every epoch anyway.
+func (v *validator) checkDutyDependentRoots(roots *dutyDependentRoots) {
+	v.dutiesLock.Lock()
+	defer v.dutiesLock.Unlock()
+	known := v.dutyDependentRoots
+	if known != nil && roots.epoch < known.epoch {
+		return
+	}
+	v.dutyDependentRoots = roots
+	if known == nil || roots.epoch != known.epoch || *roots == *known {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":                     roots.epoch,
+		"previousDutyDependentRoot": roots.previous,
+		"currentDutyDependentRoot":  roots.current,
+	}).Info("Duty dependent roots changed, updating duties")
+	v.dutiesInvalidated = true
+}
+
 func (v *validator) EventStreamIsRunning() bool {
 	return v.validatorClient.EventStreamIsRunning()
 }
@@ -1204,6 +1276,10 @@ func (v *validator) ChangeHost() {
 	log.Infof("Beacon node at %s is not responding, switching to %s...", v.beaconNodeHosts[v.currentHostIndex], v.beaconNodeHosts[next])
 	v.validatorClient.SetHost(v.beaconNodeHosts[next])
 	v.currentHostIndex = next
+	// The new beacon node must be checked to be on the same chain before performing duties with it. A back off from
+	// an earlier switch to it is stale, while the previous node keeps its own.
+	v.beaconNodeChainChecked.Store(false)
+	v.clearNodeSyncing(v.beaconNodeHosts[next])
 }
 
 func (v *validator) filterAndCacheActiveKeys(ctx context.Context, pubkeys [][fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) ([][fieldparams.BLSPubkeyLength]byte, error) {
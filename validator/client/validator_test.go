@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/protobuf/ptypes/empty"
+	eventClient "github.com/prysmaticlabs/prysm/v5/api/client/event"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
@@ -142,7 +143,7 @@ func (m *mockKeymanager) SimulateAccountChanges(newKeys [][fieldparams.BLSPubkey
 }
 
 func (*mockKeymanager) ExtractKeystores(
-	_ context.Context, _ []bls.PublicKey, _ string,
+	_ context.Context, _ []bls.PublicKey, _ string, _ keymanager.KDFConfig,
 ) ([]*keymanager.Keystore, error) {
 	return nil, errors.New("extracting keys not supported on mock keymanager")
 }
@@ -412,6 +413,41 @@ func TestUpdateDuties_DoesNothingWhenNotEpochStart_AlreadyExistingAssignments(t
 	assert.NoError(t, v.UpdateDuties(context.Background(), slot), "Could not update assignments")
 }
 
+func TestUpdateDuties_DutyDependentRootsChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+
+	v := validator{
+		validatorClient: client,
+		km:              newMockKeymanager(t, randKeypair(t)),
+		duties:          &ethpb.DutiesResponse{},
+	}
+	event := func(epoch, current string) *eventClient.Event {
+		return &eventClient.Event{
+			EventType: eventClient.EventDutiesInvalidated,
+			Data:      []byte(`{"slot":"1","epoch":"` + epoch + `","previous_duty_dependent_root":"0x01","current_duty_dependent_root":"` + current + `"}`),
+		}
+	}
+	// The first roots and the roots of a new epoch are only recorded.
+	v.ProcessEvent(event("1", "0x02"))
+	v.ProcessEvent(event("1", "0x02"))
+	v.ProcessEvent(event("2", "0x03"))
+	require.Equal(t, false, v.dutiesInvalidated)
+	require.NoError(t, v.UpdateDuties(context.Background(), params.BeaconConfig().SlotsPerEpoch*2+1))
+
+	v.ProcessEvent(event("2", "0x04"))
+	require.Equal(t, true, v.dutiesInvalidated)
+	// Late events of a previous epoch are ignored.
+	v.ProcessEvent(event("1", "0x05"))
+	require.Equal(t, primitives.Epoch(2), v.dutyDependentRoots.epoch)
+
+	client.EXPECT().Duties(gomock.Any(), gomock.Any()).Return(&ethpb.DutiesResponse{}, nil)
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	require.NoError(t, v.UpdateDuties(context.Background(), params.BeaconConfig().SlotsPerEpoch*2+2))
+	require.Equal(t, false, v.dutiesInvalidated)
+}
+
 func TestUpdateDuties_ReturnsError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
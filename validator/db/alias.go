@@ -6,3 +6,6 @@ import "github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 // key-value or relational database in practice. This is the full database interface which should
 // not be used often. Prefer a more restrictive interface in this package.
 type Database = iface.ValidatorDB
+
+// SlashingProtectionReader reads the slashing protection history of all keys.
+type SlashingProtectionReader = iface.SlashingProtectionReader
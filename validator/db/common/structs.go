@@ -26,3 +26,16 @@ type AttestationRecord struct {
 	Target      primitives.Epoch
 	SigningRoot []byte
 }
+
+// DutyRecord is the outcome of the duties of a validator public key over an epoch, kept for its performance summary.
+type DutyRecord struct {
+	Epoch                primitives.Epoch `json:"epoch" yaml:"epoch"`
+	AttestationAssigned  bool             `json:"attestation_assigned" yaml:"attestationAssigned,omitempty"`
+	AttestationSubmitted bool             `json:"attestation_submitted" yaml:"attestationSubmitted,omitempty"`
+	// InclusionDistance is the inclusion distance of the attestation reported by the beacon node, 0 when unknown.
+	InclusionDistance    primitives.Slot `json:"inclusion_distance" yaml:"inclusionDistance,omitempty"`
+	AggregationAssigned  bool            `json:"aggregation_assigned" yaml:"aggregationAssigned,omitempty"`
+	AggregationSubmitted bool            `json:"aggregation_submitted" yaml:"aggregationSubmitted,omitempty"`
+	ProposalsAssigned    uint64          `json:"proposals_assigned" yaml:"proposalsAssigned,omitempty"`
+	ProposalsSubmitted   uint64          `json:"proposals_submitted" yaml:"proposalsSubmitted,omitempty"`
+}
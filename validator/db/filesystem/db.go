@@ -15,6 +15,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
@@ -53,6 +54,12 @@ type (
 		GenesisValidatorsRoot *string                              `yaml:"genesisValidatorsRoot,omitempty"`
 		ProposerSettings      *validatorpb.ProposerSettingsPayload `yaml:"proposerSettings,omitempty"`
 		Graffiti              *Graffiti                            `yaml:"graffiti,omitempty"`
+		// FeeRecipientOverrides maps hex encoded public keys to hex encoded fee recipients set through the keymanager API.
+		FeeRecipientOverrides map[string]string `yaml:"feeRecipientOverrides,omitempty"`
+		// ForkSchedule maps hex encoded fork versions to their activation epochs.
+		ForkSchedule map[string]uint64 `yaml:"forkSchedule,omitempty"`
+		// DutyHistory maps hex encoded public keys to the outcome of their latest duties.
+		DutyHistory map[string][]common.DutyRecord `yaml:"dutyHistory,omitempty"`
 	}
 
 	// ValidatorSlashingProtection contains the latest signed block slot, the last signed attestation.
@@ -114,6 +121,12 @@ func (s *Store) ClearDB() error {
 	return nil
 }
 
+// ViewSlashingProtection calls fn with the database itself. The minimal slashing protection history of each key
+// is kept in its own file, which is written at once, and only ever moves forward.
+func (s *Store) ViewSlashingProtection(_ context.Context, fn func(iface.SlashingProtectionReader) error) error {
+	return fn(s)
+}
+
 // Backup creates a backup of the database.
 func (s *Store) Backup(_ context.Context, outputDir string, permissionOverride bool) error {
 	// Get backups directory path.
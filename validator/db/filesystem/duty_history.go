@@ -0,0 +1,68 @@
+package filesystem
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+// DutyHistory returns the saved outcome of the latest duties, keyed by public key.
+func (s *Store) DutyHistory(_ context.Context) (map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord, error) {
+	// Get configuration.
+	configuration, err := s.configuration()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get configuration")
+	}
+
+	history := make(map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord)
+
+	// If configuration is nil, there is no saved duty history.
+	if configuration == nil {
+		return history, nil
+	}
+
+	for pubkeyHex, records := range configuration.DutyHistory {
+		pubkey, err := hexutil.Decode(pubkeyHex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", pubkeyHex)
+		}
+
+		if len(pubkey) != fieldparams.BLSPubkeyLength {
+			return nil, errors.Errorf("invalid public key length %d for %s", len(pubkey), pubkeyHex)
+		}
+
+		history[bytesutil.ToBytes48(pubkey)] = records
+	}
+
+	return history, nil
+}
+
+// SaveDutyHistory saves the outcome of the latest duties for each public key, replacing the saved one.
+func (s *Store) SaveDutyHistory(_ context.Context, history map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord) error {
+	// Get configuration.
+	configuration, err := s.configuration()
+	if err != nil {
+		return errors.Wrap(err, "could not get configuration")
+	}
+
+	// If configuration is nil, create new config.
+	if configuration == nil {
+		configuration = &Configuration{}
+	}
+
+	configuration.DutyHistory = make(map[string][]common.DutyRecord, len(history))
+	for pubKey, records := range history {
+		configuration.DutyHistory[hexutil.Encode(pubKey[:])] = records
+	}
+
+	// Save the configuration.
+	if err := s.saveConfiguration(configuration); err != nil {
+		return errors.Wrap(err, "could not save configuration")
+	}
+
+	return nil
+}
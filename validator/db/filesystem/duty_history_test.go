@@ -0,0 +1,47 @@
+package filesystem
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+func TestStore_DutyHistory(t *testing.T) {
+	ctx := context.Background()
+
+	// Create a new store.
+	store, err := NewStore(t.TempDir(), nil)
+	require.NoError(t, err)
+
+	// A store without configuration has no duty history.
+	history, err := store.DutyHistory(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(history))
+
+	// Save and get the duty history.
+	expected := map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord{
+		{1}: {
+			{Epoch: 9, AttestationAssigned: true, AttestationSubmitted: true, InclusionDistance: 1},
+			{Epoch: 10, AttestationAssigned: true, AggregationAssigned: true, ProposalsAssigned: 1, ProposalsSubmitted: 1},
+		},
+		{2}: {{Epoch: 10, AttestationAssigned: true}},
+	}
+	require.NoError(t, store.SaveDutyHistory(ctx, expected))
+	history, err = store.DutyHistory(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, expected, history)
+
+	// The rest of the configuration is kept along with the duty history.
+	require.NoError(t, store.SaveGenesisValidatorsRoot(ctx, []byte{1}))
+	expected = map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord{{2}: {{Epoch: 11, AttestationAssigned: true}}}
+	require.NoError(t, store.SaveDutyHistory(ctx, expected))
+	history, err = store.DutyHistory(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, expected, history)
+	root, err := store.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{1}, root)
+}
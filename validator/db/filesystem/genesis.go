@@ -5,6 +5,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 )
 
 func (s *Store) GenesisValidatorsRoot(_ context.Context) ([]byte, error) {
@@ -73,3 +76,65 @@ func (s *Store) SaveGenesisValidatorsRoot(_ context.Context, genValRoot []byte)
 
 	return nil
 }
+
+// ReplaceGenesisValidatorsRoot saves the genesis validators root to db, overwriting any existing one.
+func (s *Store) ReplaceGenesisValidatorsRoot(ctx context.Context, genValRoot []byte) error {
+	return s.SaveGenesisValidatorsRoot(ctx, genValRoot)
+}
+
+// ForkSchedule returns the saved fork schedule, as the activation epochs keyed by fork version.
+func (s *Store) ForkSchedule(_ context.Context) (map[[fieldparams.VersionLength]byte]primitives.Epoch, error) {
+	// Get configuration.
+	configuration, err := s.configuration()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get configuration")
+	}
+
+	schedule := make(map[[fieldparams.VersionLength]byte]primitives.Epoch)
+
+	// If configuration is nil, there is no saved fork schedule.
+	if configuration == nil {
+		return schedule, nil
+	}
+
+	for versionHex, epoch := range configuration.ForkSchedule {
+		version, err := hexutil.Decode(versionHex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode fork version %s", versionHex)
+		}
+
+		if len(version) != fieldparams.VersionLength {
+			return nil, errors.Errorf("invalid fork version length %d for %s", len(version), versionHex)
+		}
+
+		schedule[bytesutil.ToBytes4(version)] = primitives.Epoch(epoch)
+	}
+
+	return schedule, nil
+}
+
+// SaveForkSchedule saves the fork schedule, replacing the saved one.
+func (s *Store) SaveForkSchedule(_ context.Context, schedule map[[fieldparams.VersionLength]byte]primitives.Epoch) error {
+	// Get configuration.
+	configuration, err := s.configuration()
+	if err != nil {
+		return errors.Wrap(err, "could not get configuration")
+	}
+
+	// If configuration is nil, create new config.
+	if configuration == nil {
+		configuration = &Configuration{}
+	}
+
+	configuration.ForkSchedule = make(map[string]uint64, len(schedule))
+	for version, epoch := range schedule {
+		configuration.ForkSchedule[hexutil.Encode(version[:])] = uint64(epoch)
+	}
+
+	// Save the configuration.
+	if err := s.saveConfiguration(configuration); err != nil {
+		return errors.Wrap(err, "could not save configuration")
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
 
@@ -100,3 +102,33 @@ func TestStore_SaveGenesisValidatorsRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_ForkSchedule(t *testing.T) {
+	ctx := context.Background()
+
+	// Create a new store.
+	store, err := NewStore(t.TempDir(), nil)
+	require.NoError(t, err)
+
+	// A store without configuration has no fork schedule.
+	schedule, err := store.ForkSchedule(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(schedule))
+
+	// Save and get the fork schedule.
+	expected := map[[fieldparams.VersionLength]byte]primitives.Epoch{{0}: 0, {1, 2, 3, 4}: 10}
+	require.NoError(t, store.SaveForkSchedule(ctx, expected))
+	schedule, err = store.ForkSchedule(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, expected, schedule)
+
+	// The genesis validators root is kept along with the fork schedule, and can be replaced.
+	require.NoError(t, store.SaveGenesisValidatorsRoot(ctx, []byte{1}))
+	require.NoError(t, store.ReplaceGenesisValidatorsRoot(ctx, []byte{2}))
+	root, err := store.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{2}, root)
+	schedule, err = store.ForkSchedule(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, expected, schedule)
+}
@@ -3,8 +3,11 @@ package filesystem
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 )
 
 // ErrNoProposerSettingsFound is an error thrown when no settings are found.
@@ -91,3 +94,97 @@ func (s *Store) SaveProposerSettings(_ context.Context, proposerSettings *propos
 
 	return nil
 }
+
+// FeeRecipientOverrides returns the fee recipients set through the keymanager API, keyed by public key.
+func (s *Store) FeeRecipientOverrides(_ context.Context) (map[[fieldparams.BLSPubkeyLength]byte][fieldparams.FeeRecipientLength]byte, error) {
+	// Get configuration.
+	configuration, err := s.configuration()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get configuration")
+	}
+
+	overrides := make(map[[fieldparams.BLSPubkeyLength]byte][fieldparams.FeeRecipientLength]byte)
+
+	// If configuration is nil, there is no override.
+	if configuration == nil {
+		return overrides, nil
+	}
+
+	for pubkeyHex, feeRecipientHex := range configuration.FeeRecipientOverrides {
+		pubkey, err := hexutil.Decode(pubkeyHex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", pubkeyHex)
+		}
+
+		if len(pubkey) != fieldparams.BLSPubkeyLength {
+			return nil, errors.Errorf("invalid public key length %d for %s", len(pubkey), pubkeyHex)
+		}
+
+		feeRecipient, err := hexutil.Decode(feeRecipientHex)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode fee recipient %s", feeRecipientHex)
+		}
+
+		if len(feeRecipient) != fieldparams.FeeRecipientLength {
+			return nil, errors.Errorf("invalid fee recipient length %d for %s", len(feeRecipient), feeRecipientHex)
+		}
+
+		overrides[bytesutil.ToBytes48(pubkey)] = bytesutil.ToBytes20(feeRecipient)
+	}
+
+	return overrides, nil
+}
+
+// SaveFeeRecipientOverride saves a fee recipient set through the keymanager API for a public key.
+func (s *Store) SaveFeeRecipientOverride(
+	_ context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	feeRecipient [fieldparams.FeeRecipientLength]byte,
+) error {
+	// Get configuration.
+	configuration, err := s.configuration()
+	if err != nil {
+		return errors.Wrap(err, "could not get configuration")
+	}
+
+	// If configuration is nil, create new config.
+	if configuration == nil {
+		configuration = &Configuration{}
+	}
+
+	if configuration.FeeRecipientOverrides == nil {
+		configuration.FeeRecipientOverrides = make(map[string]string)
+	}
+
+	configuration.FeeRecipientOverrides[hexutil.Encode(pubKey[:])] = hexutil.Encode(feeRecipient[:])
+
+	// Save the configuration.
+	if err := s.saveConfiguration(configuration); err != nil {
+		return errors.Wrap(err, "could not save configuration")
+	}
+
+	return nil
+}
+
+// DeleteFeeRecipientOverride deletes the fee recipient set through the keymanager API for a public key.
+func (s *Store) DeleteFeeRecipientOverride(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	// Get configuration.
+	configuration, err := s.configuration()
+	if err != nil {
+		return errors.Wrap(err, "could not get configuration")
+	}
+
+	// If there is no override, there is nothing to delete.
+	if configuration == nil || configuration.FeeRecipientOverrides == nil {
+		return nil
+	}
+
+	delete(configuration.FeeRecipientOverrides, hexutil.Encode(pubKey[:]))
+
+	// Save the configuration.
+	if err := s.saveConfiguration(configuration); err != nil {
+		return errors.Wrap(err, "could not save configuration")
+	}
+
+	return nil
+}
@@ -227,3 +227,41 @@ func TestStore_SaveProposerSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_FeeRecipientOverrides(t *testing.T) {
+	ctx := context.Background()
+
+	// Create a new store.
+	store, err := NewStore(t.TempDir(), nil)
+	require.NoError(t, err, "NewStore should not return an error")
+
+	// No override is stored on a fresh store.
+	overrides, err := store.FeeRecipientOverrides(ctx)
+	require.NoError(t, err, "FeeRecipientOverrides should not return an error")
+	require.Equal(t, 0, len(overrides))
+
+	pubkey1 := getPubkeyFromString(t, "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a")
+	pubkey2 := getPubkeyFromString(t, "0xb057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7b")
+	feeRecipient1 := getFeeRecipientFromString(t, "0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	feeRecipient2 := getFeeRecipientFromString(t, "0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+
+	// Save overrides.
+	require.NoError(t, store.SaveFeeRecipientOverride(ctx, pubkey1, feeRecipient1))
+	require.NoError(t, store.SaveFeeRecipientOverride(ctx, pubkey2, feeRecipient2))
+
+	overrides, err = store.FeeRecipientOverrides(ctx)
+	require.NoError(t, err, "FeeRecipientOverrides should not return an error")
+	require.DeepEqual(t, map[[fieldparams.BLSPubkeyLength]byte][fieldparams.FeeRecipientLength]byte{
+		pubkey1: feeRecipient1,
+		pubkey2: feeRecipient2,
+	}, overrides)
+
+	// Delete an override.
+	require.NoError(t, store.DeleteFeeRecipientOverride(ctx, pubkey1))
+
+	overrides, err = store.FeeRecipientOverrides(ctx)
+	require.NoError(t, err, "FeeRecipientOverrides should not return an error")
+	require.DeepEqual(t, map[[fieldparams.BLSPubkeyLength]byte][fieldparams.FeeRecipientLength]byte{
+		pubkey2: feeRecipient2,
+	}, overrides)
+}
@@ -28,6 +28,9 @@ type ValidatorDB interface {
 	// Genesis information related methods.
 	GenesisValidatorsRoot(ctx context.Context) ([]byte, error)
 	SaveGenesisValidatorsRoot(ctx context.Context, genValRoot []byte) error
+	ReplaceGenesisValidatorsRoot(ctx context.Context, genValRoot []byte) error
+	ForkSchedule(ctx context.Context) (map[[fieldparams.VersionLength]byte]primitives.Epoch, error)
+	SaveForkSchedule(ctx context.Context, schedule map[[fieldparams.VersionLength]byte]primitives.Epoch) error
 
 	// Proposer protection related methods.
 	HighestSignedProposal(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (primitives.Slot, bool, error)
@@ -80,6 +83,26 @@ type ValidatorDB interface {
 	ProposerSettingsExists(ctx context.Context) (bool, error)
 	SaveProposerSettings(ctx context.Context, settings *proposer.Settings) error
 
+	// Fee recipients set through the keymanager API, they take precedence over the proposer settings
+	FeeRecipientOverrides(ctx context.Context) (map[[fieldparams.BLSPubkeyLength]byte][fieldparams.FeeRecipientLength]byte, error)
+	SaveFeeRecipientOverride(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, feeRecipient [fieldparams.FeeRecipientLength]byte) error
+	DeleteFeeRecipientOverride(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error
+
+	// Duty history of the validator public keys, for their performance summary
+	DutyHistory(ctx context.Context) (map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord, error)
+	SaveDutyHistory(ctx context.Context, history map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord) error
+
 	// EIP-3076 slashing protection related methods
 	ImportStandardProtectionJSON(ctx context.Context, r io.Reader) error
+	ViewSlashingProtection(ctx context.Context, fn func(SlashingProtectionReader) error) error
+}
+
+// SlashingProtectionReader defines the methods reading the slashing protection history of all keys,
+// as exported in the EIP-3076 interchange format.
+type SlashingProtectionReader interface {
+	GenesisValidatorsRoot(ctx context.Context) ([]byte, error)
+	ProposedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error)
+	ProposalHistoryForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) ([]*common.Proposal, error)
+	AttestedPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error)
+	AttestationHistoryForPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*common.AttestationRecord, error)
 }
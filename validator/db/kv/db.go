@@ -69,6 +69,8 @@ type Store struct {
 	batchedAttestationsChan            chan *AttestationRecordSaveRequest
 	batchAttestationsFlushedFeed       *event.Feed
 	batchedAttestationsFlushInProgress abool.AtomicBool
+	// tx is the read transaction of the views of ViewSlashingProtection, which all their reads use.
+	tx *bolt.Tx
 }
 
 // Close closes the underlying boltdb database.
@@ -81,9 +83,20 @@ func (s *Store) update(fn func(*bolt.Tx) error) error {
 	return s.db.Update(fn)
 }
 func (s *Store) view(fn func(*bolt.Tx) error) error {
+	if s.tx != nil {
+		return fn(s.tx)
+	}
 	return s.db.View(fn)
 }
 
+// ViewSlashingProtection calls fn with a view of the slashing protection history of all keys read from a single
+// transaction, so that signatures saved meanwhile are either entirely part of the view or not at all.
+func (s *Store) ViewSlashingProtection(_ context.Context, fn func(iface.SlashingProtectionReader) error) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		return fn(&Store{db: s.db, databasePath: s.databasePath, tx: tx})
+	})
+}
+
 // ClearDB removes any previously stored data at the configured data directory.
 func (s *Store) ClearDB() error {
 	if err := s.Close(); err != nil {
@@ -160,6 +173,9 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			migrationsBucket,
 			graffitiBucket,
 			proposerSettingsBucket,
+			feeRecipientOverridesBucket,
+			forkScheduleBucket,
+			dutyHistoryBucket,
 		)
 	}); err != nil {
 		return nil, err
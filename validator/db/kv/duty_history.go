@@ -0,0 +1,62 @@
+package kv
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+// DutyHistory returns the saved outcome of the latest duties, by public key.
+func (s *Store) DutyHistory(ctx context.Context) (map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord, error) {
+	_, span := trace.StartSpan(ctx, "validator.db.DutyHistory")
+	defer span.End()
+	history := make(map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(dutyHistoryBucket).ForEach(func(k, v []byte) error {
+			if len(k) != fieldparams.BLSPubkeyLength {
+				return errors.Errorf("invalid duty history key %#x", k)
+			}
+			var records []common.DutyRecord
+			if err := json.Unmarshal(v, &records); err != nil {
+				return errors.Wrapf(err, "could not decode duty history of %#x", k)
+			}
+			history[bytesutil.ToBytes48(k)] = records
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// SaveDutyHistory saves the outcome of the latest duties by public key, replacing the saved one.
+func (s *Store) SaveDutyHistory(ctx context.Context, history map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord) error {
+	_, span := trace.StartSpan(ctx, "validator.db.SaveDutyHistory")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(dutyHistoryBucket); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(dutyHistoryBucket)
+		if err != nil {
+			return err
+		}
+		for pubKey, records := range history {
+			enc, err := json.Marshal(records)
+			if err != nil {
+				return errors.Wrapf(err, "could not encode duty history of %#x", pubKey)
+			}
+			if err := bkt.Put(pubKey[:], enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
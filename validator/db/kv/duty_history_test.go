@@ -0,0 +1,38 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+func TestStore_DutyHistory(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{})
+
+	history, err := db.DutyHistory(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(history))
+
+	want := map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord{
+		{1}: {
+			{Epoch: 9, AttestationAssigned: true, AttestationSubmitted: true, InclusionDistance: 1},
+			{Epoch: 10, AttestationAssigned: true, AggregationAssigned: true, ProposalsAssigned: 1, ProposalsSubmitted: 1},
+		},
+		{2}: {{Epoch: 10, AttestationAssigned: true}},
+	}
+	require.NoError(t, db.SaveDutyHistory(ctx, want))
+	history, err = db.DutyHistory(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, history)
+
+	// Saving the duty history replaces the saved one.
+	want = map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord{{2}: {{Epoch: 11, AttestationAssigned: true}}}
+	require.NoError(t, db.SaveDutyHistory(ctx, want))
+	history, err = db.DutyHistory(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, history)
+}
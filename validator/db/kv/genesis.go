@@ -5,6 +5,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -21,10 +25,17 @@ func (s *Store) SaveGenesisValidatorsRoot(_ context.Context, genValRoot []byte)
 	return err
 }
 
+// ReplaceGenesisValidatorsRoot saves the genesis validators root to db, overwriting any existing one.
+func (s *Store) ReplaceGenesisValidatorsRoot(_ context.Context, genValRoot []byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(genesisInfoBucket).Put(genesisValidatorsRootKey, genValRoot)
+	})
+}
+
 // GenesisValidatorsRoot retrieves the genesis validators root from db.
 func (s *Store) GenesisValidatorsRoot(_ context.Context) ([]byte, error) {
 	var genValRoot []byte
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.view(func(tx *bolt.Tx) error {
 		bkt := tx.Bucket(genesisInfoBucket)
 		enc := bkt.Get(genesisValidatorsRootKey)
 		if len(enc) == 0 {
@@ -35,3 +46,40 @@ func (s *Store) GenesisValidatorsRoot(_ context.Context) ([]byte, error) {
 	})
 	return genValRoot, err
 }
+
+// ForkSchedule retrieves the saved fork schedule, as the activation epochs keyed by fork version.
+func (s *Store) ForkSchedule(_ context.Context) (map[[fieldparams.VersionLength]byte]primitives.Epoch, error) {
+	schedule := make(map[[fieldparams.VersionLength]byte]primitives.Epoch)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(forkScheduleBucket).ForEach(func(k, v []byte) error {
+			if len(k) != fieldparams.VersionLength || len(v) != 8 {
+				return errors.Errorf("invalid fork schedule entry for version %#x", k)
+			}
+			schedule[bytesutil.ToBytes4(k)] = primitives.Epoch(bytesutil.BytesToUint64BigEndian(v))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// SaveForkSchedule saves the fork schedule to db, replacing the saved one.
+func (s *Store) SaveForkSchedule(_ context.Context, schedule map[[fieldparams.VersionLength]byte]primitives.Epoch) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(forkScheduleBucket); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(forkScheduleBucket)
+		if err != nil {
+			return err
+		}
+		for version, epoch := range schedule {
+			if err := bkt.Put(version[:], bytesutil.Uint64ToBytesBigEndian(uint64(epoch))); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
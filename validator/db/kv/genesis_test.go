@@ -6,6 +6,7 @@ import (
 
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
 
@@ -70,3 +71,35 @@ func TestStore_GenesisValidatorsRoot_ReadAndWrite(t *testing.T) {
 		})
 	}
 }
+
+func TestStore_ReplaceGenesisValidatorsRoot(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{})
+	require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, params.BeaconConfig().ZeroHash[:]))
+	require.NoError(t, db.ReplaceGenesisValidatorsRoot(ctx, []byte{5}))
+	got, err := db.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{5}, got)
+}
+
+func TestStore_ForkSchedule(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{})
+
+	schedule, err := db.ForkSchedule(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(schedule))
+
+	want := map[[fieldparams.VersionLength]byte]primitives.Epoch{{0}: 0, {1}: 10, {2}: params.BeaconConfig().FarFutureEpoch}
+	require.NoError(t, db.SaveForkSchedule(ctx, want))
+	schedule, err = db.ForkSchedule(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, schedule)
+
+	// Saving a fork schedule replaces the saved one.
+	want = map[[fieldparams.VersionLength]byte]primitives.Epoch{{0}: 0, {1}: 10, {2}: 20}
+	require.NoError(t, db.SaveForkSchedule(ctx, want))
+	schedule, err = db.ForkSchedule(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, want, schedule)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
 )
 
 func TestNewProposalHistoryForSlot_ReturnsNilIfNoHistory(t *testing.T) {
@@ -453,3 +454,28 @@ func Test_slashableProposalCheck_RemoteProtection(t *testing.T) {
 	err = db.SlashableProposalCheck(context.Background(), pubkey, sBlock, [32]byte{2}, false, nil)
 	require.NoError(t, err, "Expected allowed block not to throw error")
 }
+
+func TestStore_ViewSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubkey})
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubkey, 1, make([]byte, fieldparams.RootLength)))
+
+	require.NoError(t, db.ViewSlashingProtection(ctx, func(r iface.SlashingProtectionReader) error {
+		// A proposal saved while the view is open is not part of it.
+		saved := make(chan error)
+		go func() {
+			saved <- db.SaveProposalHistoryForSlot(ctx, pubkey, 2, make([]byte, fieldparams.RootLength))
+		}()
+		require.NoError(t, <-saved)
+		proposals, err := r.ProposalHistoryForPubKey(ctx, pubkey)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(proposals))
+		assert.Equal(t, primitives.Slot(1), proposals[0].Slot)
+		return nil
+	}))
+
+	proposals, err := db.ProposalHistoryForPubKey(ctx, pubkey)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(proposals))
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
 	bolt "go.etcd.io/bbolt"
@@ -68,3 +70,42 @@ func (s *Store) SaveProposerSettings(ctx context.Context, settings *proposer.Set
 		return bkt.Put(proposerSettingsKey, m)
 	})
 }
+
+// FeeRecipientOverrides returns the fee recipients set through the keymanager API, by public key.
+func (s *Store) FeeRecipientOverrides(ctx context.Context) (map[[fieldparams.BLSPubkeyLength]byte][fieldparams.FeeRecipientLength]byte, error) {
+	_, span := trace.StartSpan(ctx, "validator.db.FeeRecipientOverrides")
+	defer span.End()
+	overrides := make(map[[fieldparams.BLSPubkeyLength]byte][fieldparams.FeeRecipientLength]byte)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(feeRecipientOverridesBucket)
+		return bkt.ForEach(func(k, v []byte) error {
+			if len(k) != fieldparams.BLSPubkeyLength || len(v) != fieldparams.FeeRecipientLength {
+				return errors.Errorf("invalid fee recipient override for %#x", k)
+			}
+			overrides[bytesutil.ToBytes48(k)] = bytesutil.ToBytes20(v)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// SaveFeeRecipientOverride saves the fee recipient set through the keymanager API for the given public key.
+func (s *Store) SaveFeeRecipientOverride(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, feeRecipient [fieldparams.FeeRecipientLength]byte) error {
+	_, span := trace.StartSpan(ctx, "validator.db.SaveFeeRecipientOverride")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(feeRecipientOverridesBucket).Put(pubKey[:], feeRecipient[:])
+	})
+}
+
+// DeleteFeeRecipientOverride deletes the fee recipient set through the keymanager API for the given public key.
+func (s *Store) DeleteFeeRecipientOverride(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	_, span := trace.StartSpan(ctx, "validator.db.DeleteFeeRecipientOverride")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(feeRecipientOverridesBucket).Delete(pubKey[:])
+	})
+}
@@ -107,3 +107,34 @@ func TestStore_ProposerSettings_ReadAndWrite(t *testing.T) {
 		require.DeepEqual(t, op, option)
 	})
 }
+
+func TestStore_FeeRecipientOverrides(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{})
+
+	overrides, err := db.FeeRecipientOverrides(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(overrides))
+
+	key1 := bytesutil.ToBytes48([]byte{1})
+	key2 := bytesutil.ToBytes48([]byte{2})
+	feeRecipient1 := common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")
+	feeRecipient2 := common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")
+	require.NoError(t, db.SaveFeeRecipientOverride(ctx, key1, feeRecipient1))
+	require.NoError(t, db.SaveFeeRecipientOverride(ctx, key2, feeRecipient1))
+	require.NoError(t, db.SaveFeeRecipientOverride(ctx, key2, feeRecipient2))
+
+	overrides, err = db.FeeRecipientOverrides(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, map[[fieldparams.BLSPubkeyLength]byte][fieldparams.FeeRecipientLength]byte{
+		key1: feeRecipient1,
+		key2: feeRecipient2,
+	}, overrides)
+
+	require.NoError(t, db.DeleteFeeRecipientOverride(ctx, key1))
+	overrides, err = db.FeeRecipientOverrides(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, map[[fieldparams.BLSPubkeyLength]byte][fieldparams.FeeRecipientLength]byte{
+		key2: feeRecipient2,
+	}, overrides)
+}
@@ -4,6 +4,9 @@ var (
 	// Genesis information bucket key.
 	genesisInfoBucket = []byte("genesis-info-bucket")
 
+	// Fork schedule of the chain the validator first connected to, epochs keyed by fork version.
+	forkScheduleBucket = []byte("fork-schedule")
+
 	// Validator slashing protection from double proposals.
 	historicProposalsBucket            = []byte("proposal-history-bucket-interchange")
 	deprecatedAttestationHistoryBucket = []byte("attestation-history-bucket-interchange")
@@ -41,6 +44,12 @@ var (
 	// ProposerSettings stores the encoded proposer settings file
 	proposerSettingsBucket = []byte("proposer-settings-bucket")
 	proposerSettingsKey    = []byte("proposer-settings")
+
+	// Fee recipients set through the keymanager API, keyed by public key
+	feeRecipientOverridesBucket = []byte("fee-recipient-overrides")
+
+	// Duty history of the validator public keys, JSON encoded and keyed by public key
+	dutyHistoryBucket = []byte("duty-history")
 )
 
 // Attestations:
@@ -0,0 +1,111 @@
+package db
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+)
+
+// Representation is the way the slashing protection history is stored in a validator database.
+type Representation string
+
+const (
+	// Complete databases keep the full attestation and proposal history of every validator, in a BoltDB file.
+	Complete Representation = "complete"
+	// Minimal databases only keep the highest source and target epochs and the highest proposed slot
+	// of every validator, in a directory of YAML files.
+	Minimal Representation = "minimal"
+)
+
+const (
+	// conversionBytesPerSecond is a conservative estimate of how much of the source database
+	// is processed per second during a conversion, used to estimate how long a conversion takes.
+	conversionBytesPerSecond = 4 << 20
+	// minConversionDuration is the estimated duration of converting a tiny database.
+	minConversionDuration = time.Second
+)
+
+// ParseRepresentation parses the name of a database representation.
+func ParseRepresentation(name string) (Representation, error) {
+	switch r := Representation(name); r {
+	case Complete, Minimal:
+		return r, nil
+	default:
+		return "", errors.Errorf("unknown database representation %q, must be %q or %q", name, Complete, Minimal)
+	}
+}
+
+// DatabasePath returns the path of the database with the given representation in the data directory.
+func DatabasePath(dataDir string, r Representation) string {
+	if r == Minimal {
+		return filepath.Join(dataDir, filesystem.DatabaseDirName)
+	}
+	return filepath.Join(dataDir, kv.ProtectionDbFileName)
+}
+
+// DetectRepresentation returns the representation of the validator database in the data directory.
+// It returns an error if there is no database, or if there are both a complete and a minimal database.
+func DetectRepresentation(dataDir string) (Representation, error) {
+	minimalExists, err := file.Exists(DatabasePath(dataDir, Minimal), file.Directory)
+	if err != nil {
+		return "", errors.Wrap(err, "could not check if minimal database exists")
+	}
+	completeExists, err := file.Exists(DatabasePath(dataDir, Complete), file.Regular)
+	if err != nil {
+		return "", errors.Wrap(err, "could not check if complete database exists")
+	}
+	switch {
+	case minimalExists && completeExists:
+		return "", errors.Errorf(
+			"both complete (%s) and minimal (%s) databases exist, please delete one of them",
+			DatabasePath(dataDir, Complete), DatabasePath(dataDir, Minimal),
+		)
+	case minimalExists:
+		return Minimal, nil
+	case completeExists:
+		return Complete, nil
+	default:
+		return "", errors.Errorf("no validator database found in %s", dataDir)
+	}
+}
+
+// DatabaseSize returns the size on disk, in bytes, of the database with the given representation in the data directory.
+func DatabaseSize(dataDir string, r Representation) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(DatabasePath(dataDir, r), func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "could not compute database size")
+	}
+	return size, nil
+}
+
+// EstimateConversionDuration returns a rough estimate of how long converting a database of the given size takes.
+func EstimateConversionDuration(size int64) time.Duration {
+	d := time.Duration(float64(size) / conversionBytesPerSecond * float64(time.Second))
+	if d < minConversionDuration {
+		return minConversionDuration
+	}
+	return d.Round(time.Second)
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
+)
+
+func TestParseRepresentation(t *testing.T) {
+	r, err := ParseRepresentation("complete")
+	require.NoError(t, err)
+	require.Equal(t, Complete, r)
+	r, err = ParseRepresentation("minimal")
+	require.NoError(t, err)
+	require.Equal(t, Minimal, r)
+	_, err = ParseRepresentation("full")
+	require.ErrorContains(t, "unknown database representation", err)
+}
+
+func TestDetectRepresentation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no database", func(t *testing.T) {
+		_, err := DetectRepresentation(t.TempDir())
+		require.ErrorContains(t, "no validator database found", err)
+	})
+	t.Run("complete", func(t *testing.T) {
+		dataDir := t.TempDir()
+		store, err := kv.NewKVStore(ctx, dataDir, nil)
+		require.NoError(t, err)
+		require.NoError(t, store.Close())
+
+		r, err := DetectRepresentation(dataDir)
+		require.NoError(t, err)
+		require.Equal(t, Complete, r)
+		size, err := DatabaseSize(dataDir, r)
+		require.NoError(t, err)
+		require.Equal(t, true, size > 0)
+	})
+	t.Run("minimal", func(t *testing.T) {
+		dataDir := t.TempDir()
+		store, err := filesystem.NewStore(dataDir, nil)
+		require.NoError(t, err)
+		require.NoError(t, store.SaveGenesisValidatorsRoot(ctx, []byte{1}))
+		require.NoError(t, store.Close())
+
+		r, err := DetectRepresentation(dataDir)
+		require.NoError(t, err)
+		require.Equal(t, Minimal, r)
+		size, err := DatabaseSize(dataDir, r)
+		require.NoError(t, err)
+		require.Equal(t, true, size > 0)
+	})
+	t.Run("both", func(t *testing.T) {
+		dataDir := t.TempDir()
+		complete, err := kv.NewKVStore(ctx, dataDir, nil)
+		require.NoError(t, err)
+		require.NoError(t, complete.Close())
+		minimal, err := filesystem.NewStore(dataDir, nil)
+		require.NoError(t, err)
+		require.NoError(t, minimal.SaveGenesisValidatorsRoot(ctx, []byte{1}))
+		require.NoError(t, minimal.Close())
+
+		_, err = DetectRepresentation(dataDir)
+		require.ErrorContains(t, "both complete", err)
+	})
+}
+
+func TestEstimateConversionDuration(t *testing.T) {
+	require.Equal(t, minConversionDuration, EstimateConversionDuration(0))
+	require.Equal(t, 10*time.Second, EstimateConversionDuration(10*conversionBytesPerSecond))
+	// Large databases do not overflow.
+	require.Equal(t, true, EstimateConversionDuration(1<<40) > time.Hour)
+}
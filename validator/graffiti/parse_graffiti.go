@@ -3,11 +3,15 @@ package graffiti
 import (
 	"encoding/hex"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"gopkg.in/yaml.v2"
 )
 
@@ -23,6 +27,17 @@ type Graffiti struct {
 	Ordered  []string                             `yaml:"ordered,omitempty"`
 	Random   []string                             `yaml:"random,omitempty"`
 	Specific map[primitives.ValidatorIndex]string `yaml:"specific,omitempty"`
+	// SpecificPubkeys holds the entries of the specific mapping keyed by validator public key instead of index.
+	SpecificPubkeys map[[fieldparams.BLSPubkeyLength]byte]string `yaml:"-"`
+}
+
+// graffitiFile is the YAML layout of a graffiti file, whose specific mapping accepts both
+// validator indices and 0x-prefixed validator public keys.
+type graffitiFile struct {
+	Default  string            `yaml:"default,omitempty"`
+	Ordered  []string          `yaml:"ordered,omitempty"`
+	Random   []string          `yaml:"random,omitempty"`
+	Specific map[string]string `yaml:"specific,omitempty"`
 }
 
 // ParseGraffitiFile parses the graffiti file and returns the graffiti struct.
@@ -31,8 +46,8 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 	if err != nil {
 		return nil, err
 	}
-	g := &Graffiti{}
-	if err := yaml.UnmarshalStrict(yamlFile, g); err != nil {
+	raw := &graffitiFile{}
+	if err := yaml.UnmarshalStrict(yamlFile, raw); err != nil {
 		var typeError *yaml.TypeError
 		if !errors.As(err, &typeError) {
 			return nil, err
@@ -41,8 +56,33 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 		}
 	}
 
-	for i, o := range g.Specific {
-		g.Specific[i] = ParseHexGraffiti(o)
+	g := &Graffiti{
+		Default: ParseHexGraffiti(raw.Default),
+		Ordered: raw.Ordered,
+		Random:  raw.Random,
+	}
+	for k, v := range raw.Specific {
+		if strings.HasPrefix(k, hex0xPrefix) {
+			pubKey, err := hexutil.Decode(k)
+			if err != nil || len(pubKey) != fieldparams.BLSPubkeyLength {
+				log.WithField("key", k).Error("Ignoring specific graffiti of an invalid validator public key")
+				continue
+			}
+			if g.SpecificPubkeys == nil {
+				g.SpecificPubkeys = make(map[[fieldparams.BLSPubkeyLength]byte]string)
+			}
+			g.SpecificPubkeys[bytesutil.ToBytes48(pubKey)] = ParseHexGraffiti(v)
+			continue
+		}
+		index, err := strconv.ParseUint(k, 10, 64)
+		if err != nil {
+			log.WithField("key", k).Error("Ignoring specific graffiti of an invalid validator index")
+			continue
+		}
+		if g.Specific == nil {
+			g.Specific = make(map[primitives.ValidatorIndex]string)
+		}
+		g.Specific[primitives.ValidatorIndex(index)] = ParseHexGraffiti(v)
 	}
 
 	for i, v := range g.Ordered {
@@ -53,12 +93,24 @@ func ParseGraffitiFile(f string) (*Graffiti, error) {
 		g.Random[i] = ParseHexGraffiti(v)
 	}
 
-	g.Default = ParseHexGraffiti(g.Default)
 	g.Hash = hash.Hash(yamlFile)
 
 	return g, nil
 }
 
+// ReloadGraffitiFile parses the graffiti file again if its content changed since the graffiti was parsed
+// from it, and returns the graffiti as is otherwise.
+func ReloadGraffitiFile(f string, g *Graffiti) (*Graffiti, error) {
+	yamlFile, err := os.ReadFile(f) // #nosec G304
+	if err != nil {
+		return g, err
+	}
+	if g != nil && hash.Hash(yamlFile) == g.Hash {
+		return g, nil
+	}
+	return ParseGraffitiFile(f)
+}
+
 // ParseHexGraffiti checks if a graffiti input is being represented in hex and converts it to ASCII if so
 func ParseHexGraffiti(rawGraffiti string) string {
 	splitGraffiti := strings.SplitN(rawGraffiti, ":", 2)
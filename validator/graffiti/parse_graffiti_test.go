@@ -1,12 +1,16 @@
 package graffiti
 
 import (
+	"bytes"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
 
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
@@ -218,3 +222,51 @@ func TestParseHexGraffiti(t *testing.T) {
 		})
 	}
 }
+
+func TestParseGraffitiFile_ValidatorPubkeys(t *testing.T) {
+	pubKey := bytes.Repeat([]byte{0xab}, fieldparams.BLSPubkeyLength)
+	input := []byte(fmt.Sprintf(`
+specific:
+  1234: Yolo
+  %#x: "hex:0x4d656f77"
+  0x1234: Ignored`, pubKey))
+
+	someFileName := filepath.Join(t.TempDir(), "somefile.txt")
+	require.NoError(t, os.WriteFile(someFileName, input, os.ModePerm))
+
+	got, err := ParseGraffitiFile(someFileName)
+	require.NoError(t, err)
+
+	wanted := &Graffiti{
+		Hash: hash.Hash(input),
+		Specific: map[primitives.ValidatorIndex]string{
+			1234: "Yolo",
+		},
+		SpecificPubkeys: map[[fieldparams.BLSPubkeyLength]byte]string{
+			bytesutil.ToBytes48(pubKey): "Meow",
+		},
+	}
+	require.DeepEqual(t, wanted, got)
+}
+
+func TestReloadGraffitiFile(t *testing.T) {
+	someFileName := filepath.Join(t.TempDir(), "somefile.txt")
+	require.NoError(t, os.WriteFile(someFileName, []byte(`default: "Mr T was here"`), os.ModePerm))
+	g, err := ParseGraffitiFile(someFileName)
+	require.NoError(t, err)
+
+	got, err := ReloadGraffitiFile(someFileName, g)
+	require.NoError(t, err)
+	require.Equal(t, g, got)
+
+	require.NoError(t, os.WriteFile(someFileName, []byte(`default: "Mr T left"`), os.ModePerm))
+	got, err = ReloadGraffitiFile(someFileName, g)
+	require.NoError(t, err)
+	require.NotEqual(t, g.Hash, got.Hash)
+	require.Equal(t, "Mr T left", got.Default)
+
+	require.NoError(t, os.Remove(someFileName))
+	got, err = ReloadGraffitiFile(someFileName, g)
+	require.NotNil(t, err)
+	require.Equal(t, g, got)
+}
@@ -156,12 +156,40 @@ func (db *ValidatorDBMock) UpdateProposerSettingsForPubkey(context.Context, [fie
 func (db *ValidatorDBMock) SaveProposerSettings(ctx context.Context, settings *proposer.Settings) error {
 	panic("not implemented")
 }
+func (db *ValidatorDBMock) ReplaceGenesisValidatorsRoot(ctx context.Context, genValRoot []byte) error {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) ForkSchedule(ctx context.Context) (map[[fieldparams.VersionLength]byte]primitives.Epoch, error) {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) SaveForkSchedule(ctx context.Context, schedule map[[fieldparams.VersionLength]byte]primitives.Epoch) error {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) FeeRecipientOverrides(ctx context.Context) (map[[fieldparams.BLSPubkeyLength]byte][fieldparams.FeeRecipientLength]byte, error) {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) SaveFeeRecipientOverride(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, feeRecipient [fieldparams.FeeRecipientLength]byte) error {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) DeleteFeeRecipientOverride(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) DutyHistory(ctx context.Context) (map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord, error) {
+	panic("not implemented")
+}
+func (db *ValidatorDBMock) SaveDutyHistory(ctx context.Context, history map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord) error {
+	panic("not implemented")
+}
 
 // EIP-3076 slashing protection related methods
 func (db *ValidatorDBMock) ImportStandardProtectionJSON(ctx context.Context, r io.Reader) error {
 	panic("not implemented")
 }
 
+func (db *ValidatorDBMock) ViewSlashingProtection(ctx context.Context, fn func(iface.SlashingProtectionReader) error) error {
+	panic("not implemented")
+}
+
 func Test_validateMetadata(t *testing.T) {
 	goodRoot := [32]byte{1}
 	goodStr := make([]byte, hex.EncodedLen(len(goodRoot)))
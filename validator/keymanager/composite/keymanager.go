@@ -0,0 +1,416 @@
+package composite
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+)
+
+// Member is a wallet merged in a composite keymanager, along with the keymanager of its keys.
+type Member struct {
+	WalletDir   string
+	AccountsDir string
+	Keymanager  keymanager.IKeymanager
+}
+
+// Keymanager merges the keys of the keymanagers of several wallets behind a single keymanager, so that a validator
+// client can run the keys of several wallet directories. Requests about a key are routed to the keymanager of the
+// wallet holding it, and keys imported through it are imported in the first wallet.
+type Keymanager struct {
+	members             []*Member
+	owners              map[[fieldparams.BLSPubkeyLength]byte]int
+	ownersLock          sync.RWMutex
+	accountsChangedFeed *event.Feed
+}
+
+// NewKeymanager merges the keymanagers of the wallets, which must not share any key. When listening for changes, the
+// keys of every wallet are sent to the subscribers of the composite keymanager when the keys of one wallet change.
+func NewKeymanager(ctx context.Context, members []*Member, listenForChanges bool) (*Keymanager, error) {
+	if len(members) == 0 {
+		return nil, errors.New("no wallet to merge")
+	}
+	km := &Keymanager{
+		members:             members,
+		accountsChangedFeed: new(event.Feed),
+	}
+	conflicts, err := km.refreshOwners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) != 0 {
+		return nil, errors.Errorf("validator keys are in more than one wallet: %s", strings.Join(conflicts, ", "))
+	}
+	if listenForChanges {
+		for _, m := range members {
+			go km.listenForAccountChanges(ctx, m)
+		}
+	}
+	return km, nil
+}
+
+// refreshOwners indexes the wallet holding each key. A key in several wallets is routed to the first of them, and
+// described in the returned conflicts.
+func (km *Keymanager) refreshOwners(ctx context.Context) ([]string, error) {
+	owners := make(map[[fieldparams.BLSPubkeyLength]byte]int)
+	var conflicts []string
+	for i, m := range km.members {
+		keys, err := m.Keymanager.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch validating public keys of wallet %s", m.WalletDir)
+		}
+		for _, k := range keys {
+			if owner, ok := owners[k]; ok {
+				conflicts = append(conflicts, fmt.Sprintf("%#x in %s and %s", k, km.members[owner].WalletDir, m.WalletDir))
+				continue
+			}
+			owners[k] = i
+		}
+	}
+	km.ownersLock.Lock()
+	km.owners = owners
+	km.ownersLock.Unlock()
+	return conflicts, nil
+}
+
+// owner returns the index of the wallet holding the key.
+func (km *Keymanager) owner(publicKey [fieldparams.BLSPubkeyLength]byte) (int, bool) {
+	km.ownersLock.RLock()
+	defer km.ownersLock.RUnlock()
+	i, ok := km.owners[publicKey]
+	return i, ok
+}
+
+func (km *Keymanager) listenForAccountChanges(ctx context.Context, m *Member) {
+	keysChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
+	sub := m.Keymanager.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case <-keysChan:
+			keys, err := km.FetchValidatingPublicKeys(ctx)
+			if err != nil {
+				log.WithError(err).Error("Could not fetch validating public keys after the keys of a wallet changed")
+				continue
+			}
+			km.accountsChangedFeed.Send(keys)
+		case err := <-sub.Err():
+			if err != nil {
+				log.WithError(err).Error("Could not listen for account changes")
+			}
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// LocateKey returns the directory of the wallet holding the key, and the index of the key in that wallet.
+func (km *Keymanager) LocateKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (string, int, bool) {
+	i, ok := km.owner(publicKey)
+	if !ok {
+		return "", 0, false
+	}
+	keys, err := km.members[i].Keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return "", 0, false
+	}
+	for index, k := range keys {
+		if k == publicKey {
+			return km.members[i].WalletDir, index, true
+		}
+	}
+	return "", 0, false
+}
+
+// FetchValidatingPublicKeys returns the keys of every wallet, in the order of the wallets.
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	conflicts, err := km.refreshOwners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range conflicts {
+		log.WithField("conflict", c).Error("Validator key is in more than one wallet, only the first wallet is used")
+	}
+	km.ownersLock.RLock()
+	defer km.ownersLock.RUnlock()
+	var keys [][fieldparams.BLSPubkeyLength]byte
+	for i, m := range km.members {
+		memberKeys, err := m.Keymanager.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch validating public keys of wallet %s", m.WalletDir)
+		}
+		for _, k := range memberKeys {
+			if km.owners[k] == i {
+				keys = append(keys, k)
+			}
+		}
+	}
+	return keys, nil
+}
+
+// FetchValidatingPrivateKeys returns the private keys of every wallet able to, in the order of
+// FetchValidatingPublicKeys.
+func (km *Keymanager) FetchValidatingPrivateKeys(ctx context.Context) ([][32]byte, error) {
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	privateKeys := make(map[[fieldparams.BLSPubkeyLength]byte][32]byte, len(keys))
+	for _, m := range km.members {
+		fetcher, ok := m.Keymanager.(keymanager.KeysFetcher)
+		if !ok {
+			return nil, errors.Errorf("keymanager of wallet %s cannot fetch private keys", m.WalletDir)
+		}
+		pubKeys, err := fetcher.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch validating public keys of wallet %s", m.WalletDir)
+		}
+		privKeys, err := fetcher.FetchValidatingPrivateKeys(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch validating private keys of wallet %s", m.WalletDir)
+		}
+		if len(pubKeys) != len(privKeys) {
+			return nil, errors.Errorf("wallet %s returned %d private keys for %d public keys", m.WalletDir, len(privKeys), len(pubKeys))
+		}
+		for i, k := range pubKeys {
+			if _, ok := privateKeys[k]; !ok {
+				privateKeys[k] = privKeys[i]
+			}
+		}
+	}
+	result := make([][32]byte, len(keys))
+	for i, k := range keys {
+		result[i] = privateKeys[k]
+	}
+	return result, nil
+}
+
+// memberOf returns the keymanager of the wallet holding the key, refreshing the index of the wallets once if the key
+// is unknown, in case it was just added to a wallet.
+func (km *Keymanager) memberOf(ctx context.Context, publicKey []byte) (*Member, error) {
+	if publicKey == nil {
+		return nil, errors.New("nil public key in request")
+	}
+	pk := bytesutil.ToBytes48(publicKey)
+	i, ok := km.owner(pk)
+	if !ok {
+		if _, err := km.refreshOwners(ctx); err != nil {
+			return nil, err
+		}
+		if i, ok = km.owner(pk); !ok {
+			return nil, fmt.Errorf("no wallet holds the public key %#x", bytesutil.Trunc(publicKey))
+		}
+	}
+	return km.members[i], nil
+}
+
+// Sign signs a message with the keymanager of the wallet holding the key.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	m, err := km.memberOf(ctx, req.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return m.Keymanager.Sign(ctx, req)
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel to listen for the keys of every wallet when
+// the keys of one of them change.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ExtractKeystores extracts the keystores of the keys from the keymanagers of the wallets holding them, in the order
+// of the keys.
+func (km *Keymanager) ExtractKeystores(
+	ctx context.Context, publicKeys []bls.PublicKey, password string, kdf keymanager.KDFConfig,
+) ([]*keymanager.Keystore, error) {
+	byMember := make(map[*Member][]int)
+	for i, pk := range publicKeys {
+		m, err := km.memberOf(ctx, pk.Marshal())
+		if err != nil {
+			return nil, err
+		}
+		byMember[m] = append(byMember[m], i)
+	}
+	keystores := make([]*keymanager.Keystore, len(publicKeys))
+	for m, indices := range byMember {
+		keys := make([]bls.PublicKey, len(indices))
+		for j, i := range indices {
+			keys[j] = publicKeys[i]
+		}
+		extracted, err := m.Keymanager.ExtractKeystores(ctx, keys, password, kdf)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not extract keystores of wallet %s", m.WalletDir)
+		}
+		for j, i := range indices {
+			keystores[i] = extracted[j]
+		}
+	}
+	return keystores, nil
+}
+
+// ImportKeystores imports the keystores in the first wallet. Keystores of keys held by another wallet are reported as
+// duplicates, and the statuses tell the wallet of the key.
+func (km *Keymanager) ImportKeystores(
+	ctx context.Context, keystores []*keymanager.Keystore, passwords []string,
+) ([]*keymanager.KeyStatus, error) {
+	importer, ok := km.members[0].Keymanager.(keymanager.Importer)
+	if !ok {
+		return nil, errors.Errorf("keymanager of wallet %s cannot import keystores", km.members[0].WalletDir)
+	}
+	if len(passwords) != len(keystores) {
+		return importer.ImportKeystores(ctx, keystores, passwords)
+	}
+	if _, err := km.refreshOwners(ctx); err != nil {
+		return nil, err
+	}
+	statuses := make([]*keymanager.KeyStatus, len(keystores))
+	toImport := make([]int, 0, len(keystores))
+	for i, k := range keystores {
+		pk, err := hex.DecodeString(strings.TrimPrefix(k.Pubkey, "0x"))
+		if err == nil && len(pk) == fieldparams.BLSPubkeyLength {
+			if owner, ok := km.owner(bytesutil.ToBytes48(pk)); ok {
+				statuses[i] = &keymanager.KeyStatus{
+					Status:  keymanager.StatusDuplicate,
+					Message: fmt.Sprintf("key is in wallet %s", km.members[owner].WalletDir),
+				}
+				continue
+			}
+		}
+		toImport = append(toImport, i)
+	}
+	if len(toImport) != 0 {
+		ks := make([]*keymanager.Keystore, len(toImport))
+		pws := make([]string, len(toImport))
+		for j, i := range toImport {
+			ks[j], pws[j] = keystores[i], passwords[i]
+		}
+		imported, err := importer.ImportKeystores(ctx, ks, pws)
+		if err != nil {
+			return nil, err
+		}
+		for j, i := range toImport {
+			statuses[i] = imported[j]
+			if statuses[i].Status == keymanager.StatusImported && statuses[i].Message == "" {
+				statuses[i].Message = fmt.Sprintf("key imported in wallet %s", km.members[0].WalletDir)
+			}
+		}
+	}
+	if _, err := km.refreshOwners(ctx); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// DeleteKeystores deletes the keys from the wallets holding them. The statuses are in the order of the keys.
+func (km *Keymanager) DeleteKeystores(ctx context.Context, publicKeys [][]byte) ([]*keymanager.KeyStatus, error) {
+	if _, err := km.refreshOwners(ctx); err != nil {
+		return nil, err
+	}
+	statuses := make([]*keymanager.KeyStatus, len(publicKeys))
+	byMember := make(map[int][]int)
+	for i, pk := range publicKeys {
+		owner, ok := km.owner(bytesutil.ToBytes48(pk))
+		if !ok {
+			statuses[i] = &keymanager.KeyStatus{Status: keymanager.StatusNotFound}
+			continue
+		}
+		byMember[owner] = append(byMember[owner], i)
+	}
+	for owner, indices := range byMember {
+		keys := make([][]byte, len(indices))
+		for j, i := range indices {
+			keys[j] = publicKeys[i]
+		}
+		deleted, err := km.members[owner].Keymanager.DeleteKeystores(ctx, keys)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not delete keystores of wallet %s", km.members[owner].WalletDir)
+		}
+		for j, i := range indices {
+			statuses[i] = deleted[j]
+		}
+	}
+	if _, err := km.refreshOwners(ctx); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// ListKeymanagerAccounts lists the accounts of every wallet, under the directory of their wallet.
+func (km *Keymanager) ListKeymanagerAccounts(ctx context.Context, cfg keymanager.ListKeymanagerAccountConfig) error {
+	au := aurora.NewAurora(true)
+	for _, m := range km.members {
+		fmt.Printf("%s %s\n", au.BrightMagenta("(wallet)").Bold(), m.WalletDir)
+		memberCfg := cfg
+		memberCfg.WalletAccountsDir = m.AccountsDir
+		if err := m.Keymanager.ListKeymanagerAccounts(ctx, memberCfg); err != nil {
+			return errors.Wrapf(err, "could not list accounts of wallet %s", m.WalletDir)
+		}
+	}
+	return nil
+}
+
+// AccountName returns the name of the account in the wallet holding the key.
+func (km *Keymanager) AccountName(publicKey []byte) string {
+	if i, ok := km.owner(bytesutil.ToBytes48(publicKey)); ok {
+		if namer, ok := km.members[i].Keymanager.(keymanager.AccountNamer); ok {
+			return namer.AccountName(publicKey)
+		}
+	}
+	return ""
+}
+
+// NameAccounts names the accounts in the wallets holding the keys. The names are in the order of the keys.
+func (km *Keymanager) NameAccounts(ctx context.Context, publicKeys [][]byte, template string) ([]string, error) {
+	byMember := make(map[*Member][]int)
+	for i, pk := range publicKeys {
+		m, err := km.memberOf(ctx, pk)
+		if err != nil {
+			return nil, err
+		}
+		byMember[m] = append(byMember[m], i)
+	}
+	names := make([]string, len(publicKeys))
+	for m, indices := range byMember {
+		namer, ok := m.Keymanager.(keymanager.AccountNamer)
+		if !ok {
+			return nil, errors.Errorf("keymanager of wallet %s cannot name accounts", m.WalletDir)
+		}
+		keys := make([][]byte, len(indices))
+		for j, i := range indices {
+			keys[j] = publicKeys[i]
+		}
+		named, err := namer.NameAccounts(ctx, keys, template)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not name accounts of wallet %s", m.WalletDir)
+		}
+		for j, i := range indices {
+			names[i] = named[j]
+		}
+	}
+	return names, nil
+}
+
+// RenameAccount renames the account in the wallet holding the key.
+func (km *Keymanager) RenameAccount(ctx context.Context, publicKey []byte, name string) error {
+	m, err := km.memberOf(ctx, publicKey)
+	if err != nil {
+		return err
+	}
+	namer, ok := m.Keymanager.(keymanager.AccountNamer)
+	if !ok {
+		return errors.Errorf("keymanager of wallet %s cannot name accounts", m.WalletDir)
+	}
+	return namer.RenameAccount(ctx, publicKey, name)
+}
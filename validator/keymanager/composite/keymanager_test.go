@@ -0,0 +1,154 @@
+package composite_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	mock "github.com/prysmaticlabs/prysm/v5/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/composite"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const password = "secretPassw0rd$1999"
+
+// newMember returns a wallet holding the keys, along with its local keymanager.
+func newMember(t *testing.T, dir string, keys ...bls.SecretKey) *composite.Member {
+	ctx := context.Background()
+	km, err := local.NewKeymanager(ctx, &local.SetupConfig{
+		Wallet: &mock.Wallet{Files: make(map[string]map[string][]byte), WalletPassword: password, WalletDir: dir},
+	})
+	require.NoError(t, err)
+	if len(keys) != 0 {
+		privKeys := make([][]byte, len(keys))
+		pubKeys := make([][]byte, len(keys))
+		for i, k := range keys {
+			privKeys[i] = k.Marshal()
+			pubKeys[i] = k.PublicKey().Marshal()
+		}
+		require.NoError(t, km.ImportKeypairs(ctx, privKeys, pubKeys))
+	}
+	return &composite.Member{WalletDir: dir, Keymanager: km}
+}
+
+func randKeys(t *testing.T, n int) []bls.SecretKey {
+	keys := make([]bls.SecretKey, n)
+	for i := range keys {
+		var err error
+		keys[i], err = bls.RandKey()
+		require.NoError(t, err)
+	}
+	return keys
+}
+
+func pubKey(k bls.SecretKey) [fieldparams.BLSPubkeyLength]byte {
+	return bytesutil.ToBytes48(k.PublicKey().Marshal())
+}
+
+func TestNewKeymanager_RejectsDuplicateKeys(t *testing.T) {
+	keys := randKeys(t, 3)
+	_, err := composite.NewKeymanager(context.Background(), []*composite.Member{
+		newMember(t, "wallet-a", keys[0], keys[1]),
+		newMember(t, "wallet-b", keys[2], keys[1]),
+	}, false)
+	require.ErrorContains(t, "validator keys are in more than one wallet", err)
+	require.ErrorContains(t, "in wallet-a and wallet-b", err)
+}
+
+func TestKeymanager_FetchAndSign(t *testing.T) {
+	ctx := context.Background()
+	keys := randKeys(t, 3)
+	km, err := composite.NewKeymanager(ctx, []*composite.Member{
+		newMember(t, "wallet-a", keys[0], keys[1]),
+		newMember(t, "wallet-b", keys[2]),
+	}, false)
+	require.NoError(t, err)
+
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubKey(keys[0]), pubKey(keys[1]), pubKey(keys[2])}, pubKeys)
+	privKeys, err := km.FetchValidatingPrivateKeys(ctx)
+	require.NoError(t, err)
+	for i, k := range keys {
+		assert.Equal(t, bytesutil.ToBytes32(k.Marshal()), privKeys[i])
+	}
+
+	// Signing requests are routed to the wallet holding the key.
+	for _, k := range keys {
+		sig, err := km.Sign(ctx, &validatorpb.SignRequest{PublicKey: k.PublicKey().Marshal(), SigningRoot: []byte("root")})
+		require.NoError(t, err)
+		assert.Equal(t, true, sig.Verify(k.PublicKey(), []byte("root")))
+	}
+	unknown := randKeys(t, 1)[0]
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: unknown.PublicKey().Marshal(), SigningRoot: []byte("root")})
+	require.ErrorContains(t, "no wallet holds the public key", err)
+
+	dir, index, ok := km.LocateKey(ctx, pubKey(keys[2]))
+	require.Equal(t, true, ok)
+	assert.Equal(t, "wallet-b", dir)
+	assert.Equal(t, 0, index)
+	dir, index, ok = km.LocateKey(ctx, pubKey(keys[1]))
+	require.Equal(t, true, ok)
+	assert.Equal(t, "wallet-a", dir)
+	assert.Equal(t, 1, index)
+	_, _, ok = km.LocateKey(ctx, pubKey(unknown))
+	assert.Equal(t, false, ok)
+}
+
+func TestKeymanager_ImportAndDeleteKeystores(t *testing.T) {
+	ctx := context.Background()
+	keys := randKeys(t, 3)
+	km, err := composite.NewKeymanager(ctx, []*composite.Member{
+		newMember(t, "wallet-a", keys[0]),
+		newMember(t, "wallet-b", keys[1]),
+	}, false)
+	require.NoError(t, err)
+
+	// New keys are imported in the first wallet, keys of another wallet are duplicates.
+	statuses, err := km.ImportKeystores(ctx, []*keymanager.Keystore{keystore(t, keys[2]), keystore(t, keys[1])}, []string{password, password})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(statuses))
+	assert.Equal(t, keymanager.StatusImported, statuses[0].Status)
+	assert.Equal(t, "key imported in wallet wallet-a", statuses[0].Message)
+	assert.Equal(t, keymanager.StatusDuplicate, statuses[1].Status)
+	assert.Equal(t, "key is in wallet wallet-b", statuses[1].Message)
+	dir, _, ok := km.LocateKey(ctx, pubKey(keys[2]))
+	require.Equal(t, true, ok)
+	assert.Equal(t, "wallet-a", dir)
+
+	// Keys are deleted from the wallet holding them.
+	unknown := randKeys(t, 1)[0]
+	statuses, err = km.DeleteKeystores(ctx, [][]byte{keys[1].PublicKey().Marshal(), unknown.PublicKey().Marshal(), keys[0].PublicKey().Marshal()})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(statuses))
+	assert.Equal(t, keymanager.StatusDeleted, statuses[0].Status)
+	assert.Equal(t, keymanager.StatusNotFound, statuses[1].Status)
+	assert.Equal(t, keymanager.StatusDeleted, statuses[2].Status)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubKey(keys[2])}, pubKeys)
+}
+
+func keystore(t *testing.T, k bls.SecretKey) *keymanager.Keystore {
+	encryptor := keystorev4.New()
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	cryptoFields, err := encryptor.Encrypt(k.Marshal(), password)
+	require.NoError(t, err)
+	return &keymanager.Keystore{
+		Crypto:      cryptoFields,
+		Pubkey:      fmt.Sprintf("%x", k.PublicKey().Marshal()),
+		ID:          id.String(),
+		Version:     encryptor.Version(),
+		Description: encryptor.Name(),
+	}
+}
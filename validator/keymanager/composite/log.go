@@ -0,0 +1,5 @@
+package composite
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "composite-keymanager")
@@ -8,11 +8,16 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/contracts/deposit"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	"github.com/sirupsen/logrus"
 	util "github.com/wealdtech/go-eth2-util"
 )
 
@@ -23,8 +28,17 @@ const (
 	// keys for Prysm Ethereum validators. According to EIP-2334, the format is as follows:
 	// m / purpose / coin_type / account_index / withdrawal_key / validating_key
 	ValidatingKeyDerivationPathTemplate = "m/12381/3600/%d/0/0"
+	// WithdrawalKeyDerivationPathTemplate defining the hierarchical path for the withdrawal
+	// key of a validating key, according to EIP-2334.
+	WithdrawalKeyDerivationPathTemplate = "m/12381/3600/%d/0"
+	// accountGapLimit is the number of consecutive derivation indices without an account in the wallet
+	// after which the search for accounts already derived from a mnemonic stops.
+	accountGapLimit = 100
 )
 
+// ErrMnemonicMismatch is returned when extending a wallet whose accounts were not derived from the given mnemonic.
+var ErrMnemonicMismatch = errors.New("none of the accounts in the wallet were derived from the given mnemonic")
+
 // SetupConfig includes configuration values for initializing
 // a keymanager, such as passwords, the wallet, and more.
 type SetupConfig struct {
@@ -35,6 +49,7 @@ type SetupConfig struct {
 // Keymanager implementation for derived, HD keymanager using EIP-2333 and EIP-2334.
 type Keymanager struct {
 	localKM *local.Keymanager
+	wallet  iface.Wallet
 }
 
 // NewKeymanager instantiates a new derived keymanager from configuration options.
@@ -51,12 +66,14 @@ func NewKeymanager(
 	}
 	return &Keymanager{
 		localKM: localKM,
+		wallet:  cfg.Wallet,
 	}, nil
 }
 
 // RecoverAccountsFromMnemonic given a mnemonic phrase, is able to regenerate N accounts
 // from a derived seed, encrypt them according to the EIP-2334 JSON standard, and write them
-// to disk. Then, the mnemonic is never stored nor used by the validator.
+// to disk. The seed is stored in the wallet, encrypted with the wallet password, so further
+// accounts can be derived later on. The mnemonic itself is never stored.
 func (km *Keymanager) RecoverAccountsFromMnemonic(
 	ctx context.Context, mnemonic, mnemonicLanguage, mnemonicPassphrase string, numAccounts int,
 ) error {
@@ -76,16 +93,157 @@ func (km *Keymanager) RecoverAccountsFromMnemonic(
 		privKeys[i] = privKey.Marshal()
 		pubKeys[i] = privKey.PublicKey().Marshal()
 	}
+	if err := km.saveSeed(ctx, seed); err != nil {
+		return err
+	}
 	return km.localKM.ImportKeypairs(ctx, privKeys, pubKeys)
 }
 
+// DerivedAccount is an account derived by ExtendAccounts, along with the data of its deposit.
+type DerivedAccount struct {
+	PublicKey       []byte
+	DerivationPath  string
+	DepositData     *ethpb.Deposit_Data
+	DepositDataRoot [32]byte
+}
+
+// ExtendAccounts derives the next numAccounts validating keys from the seed stored in the wallet, following
+// the highest derivation index already present in the wallet, and imports them. Indices of accounts already
+// in the wallet are never derived again, so deleted accounts in between are not restored. The deposit data
+// of each new account withdraws to withdrawalAddress, or to the BLS withdrawal key of the account when it is
+// empty. ErrNoStoredSeed is returned for wallets which do not hold their seed, use ExtendAccountsFromMnemonic
+// for those.
+func (km *Keymanager) ExtendAccounts(ctx context.Context, numAccounts int, withdrawalAddress []byte) ([]*DerivedAccount, error) {
+	if numAccounts <= 0 {
+		return nil, errors.New("must derive at least 1 account")
+	}
+	seed, err := km.storedSeed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	next, err := km.nextDerivationIndex(ctx, seed)
+	if err != nil {
+		return nil, err
+	}
+	return km.deriveAccounts(ctx, seed, next, numAccounts, withdrawalAddress)
+}
+
+// ExtendAccountsFromMnemonic is ExtendAccounts for wallets which do not hold their seed yet. The seed is
+// derived from the mnemonic, which has to be the one the accounts in the wallet were derived from, and
+// stored in the wallet so the mnemonic is not needed anymore afterwards.
+func (km *Keymanager) ExtendAccountsFromMnemonic(
+	ctx context.Context,
+	mnemonic, mnemonicLanguage, mnemonicPassphrase string,
+	numAccounts int,
+	withdrawalAddress []byte,
+) ([]*DerivedAccount, error) {
+	if numAccounts <= 0 {
+		return nil, errors.New("must derive at least 1 account")
+	}
+	seed, err := seedFromMnemonic(mnemonic, mnemonicLanguage, mnemonicPassphrase)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not derive seed from mnemonic")
+	}
+	next, err := km.nextDerivationIndex(ctx, seed)
+	if err != nil {
+		return nil, err
+	}
+	if err := km.saveSeed(ctx, seed); err != nil {
+		return nil, err
+	}
+	return km.deriveAccounts(ctx, seed, next, numAccounts, withdrawalAddress)
+}
+
+// nextDerivationIndex returns the index following the highest index of the accounts in the wallet derived
+// from the seed.
+func (km *Keymanager) nextDerivationIndex(ctx context.Context, seed []byte) (int, error) {
+	existing, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not fetch validating public keys")
+	}
+	inWallet := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(existing))
+	for _, pubKey := range existing {
+		inWallet[pubKey] = true
+	}
+
+	next, found := 0, 0
+	for i := 0; found < len(existing) && i < next+accountGapLimit; i++ {
+		privKey, err := util.PrivateKeyFromSeedAndPath(seed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, i))
+		if err != nil {
+			return 0, err
+		}
+		if inWallet[bytesutil.ToBytes48(privKey.PublicKey().Marshal())] {
+			found++
+			next = i + 1
+		}
+	}
+	if len(existing) > 0 && found == 0 {
+		return 0, ErrMnemonicMismatch
+	}
+	return next, nil
+}
+
+// deriveAccounts derives and imports numAccounts accounts from the seed, starting at index next.
+func (km *Keymanager) deriveAccounts(
+	ctx context.Context, seed []byte, next, numAccounts int, withdrawalAddress []byte,
+) ([]*DerivedAccount, error) {
+	privKeys := make([][]byte, numAccounts)
+	pubKeys := make([][]byte, numAccounts)
+	accounts := make([]*DerivedAccount, numAccounts)
+	for i := 0; i < numAccounts; i++ {
+		path := fmt.Sprintf(ValidatingKeyDerivationPathTemplate, next+i)
+		privKey, err := util.PrivateKeyFromSeedAndPath(seed, path)
+		if err != nil {
+			return nil, err
+		}
+		validatingKey, err := bls.SecretKeyFromBytes(privKey.Marshal())
+		if err != nil {
+			return nil, err
+		}
+		withdrawalCredentials := deposit.ExecutionAddressWithdrawalCredentials(withdrawalAddress)
+		if len(withdrawalAddress) == 0 {
+			withdrawalPrivKey, err := util.PrivateKeyFromSeedAndPath(seed, fmt.Sprintf(WithdrawalKeyDerivationPathTemplate, next+i))
+			if err != nil {
+				return nil, err
+			}
+			withdrawalKey, err := bls.SecretKeyFromBytes(withdrawalPrivKey.Marshal())
+			if err != nil {
+				return nil, err
+			}
+			withdrawalCredentials = deposit.WithdrawalCredentialsHash(withdrawalKey)
+		}
+		depositData, depositDataRoot, err := deposit.DepositInputWithCredentials(
+			validatingKey, withdrawalCredentials, params.BeaconConfig().MaxEffectiveBalance,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create deposit data of account %s", path)
+		}
+		privKeys[i] = privKey.Marshal()
+		pubKeys[i] = privKey.PublicKey().Marshal()
+		accounts[i] = &DerivedAccount{
+			PublicKey:       pubKeys[i],
+			DerivationPath:  path,
+			DepositData:     depositData,
+			DepositDataRoot: depositDataRoot,
+		}
+		log.WithFields(logrus.Fields{
+			"publicKey":      fmt.Sprintf("%#x", pubKeys[i]),
+			"derivationPath": path,
+		}).Info("Derived new account")
+	}
+	if err := km.localKM.ImportKeypairs(ctx, privKeys, pubKeys); err != nil {
+		return nil, errors.Wrap(err, "could not import derived accounts")
+	}
+	return accounts, nil
+}
+
 // ExtractKeystores retrieves the secret keys for specified public keys
-// in the function input, encrypts them using the specified password,
-// and returns their respective EIP-2335 keystores.
+// in the function input, encrypts them using the specified password
+// and key derivation function, and returns their respective EIP-2335 keystores.
 func (km *Keymanager) ExtractKeystores(
-	ctx context.Context, publicKeys []bls.PublicKey, password string,
+	ctx context.Context, publicKeys []bls.PublicKey, password string, kdf keymanager.KDFConfig,
 ) ([]*keymanager.Keystore, error) {
-	return km.localKM.ExtractKeystores(ctx, publicKeys, password)
+	return km.localKM.ExtractKeystores(ctx, publicKeys, password, kdf)
 }
 
 // ValidatingAccountNames for the derived keymanager.
@@ -93,11 +251,31 @@ func (km *Keymanager) ValidatingAccountNames(_ context.Context) ([]string, error
 	return km.localKM.ValidatingAccountNames()
 }
 
+// AccountName for the derived keymanager.
+func (km *Keymanager) AccountName(publicKey []byte) string {
+	return km.localKM.AccountName(publicKey)
+}
+
+// NameAccounts for the derived keymanager.
+func (km *Keymanager) NameAccounts(ctx context.Context, publicKeys [][]byte, template string) ([]string, error) {
+	return km.localKM.NameAccounts(ctx, publicKeys, template)
+}
+
+// RenameAccount for the derived keymanager.
+func (km *Keymanager) RenameAccount(ctx context.Context, publicKey []byte, name string) error {
+	return km.localKM.RenameAccount(ctx, publicKey, name)
+}
+
 // Sign signs a message using a validator key.
 func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
 	return km.localKM.Sign(ctx, req)
 }
 
+// SignBatch signs several messages using the validator keys.
+func (km *Keymanager) SignBatch(ctx context.Context, reqs []*validatorpb.SignRequest) ([]bls.Signature, error) {
+	return km.localKM.SignBatch(ctx, reqs)
+}
+
 // FetchValidatingPublicKeys fetches the list of validating public keys from the keymanager.
 func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
 	return km.localKM.FetchValidatingPublicKeys(ctx)
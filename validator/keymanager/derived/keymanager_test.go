@@ -1,17 +1,22 @@
 package derived
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/contracts/deposit"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	mock "github.com/prysmaticlabs/prysm/v5/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	constant "github.com/prysmaticlabs/prysm/v5/validator/testing"
 	"github.com/tyler-smith/go-bip39"
 	util "github.com/wealdtech/go-eth2-util"
@@ -61,6 +66,108 @@ func TestDerivedKeymanager_MnemnonicPassphrase_DifferentResults(t *testing.T) {
 	}
 }
 
+func TestDerivedKeymanager_ExtendAccounts(t *testing.T) {
+	ctx := context.Background()
+	newKeymanager := func(t *testing.T) *Keymanager {
+		km, err := NewKeymanager(ctx, &SetupConfig{
+			Wallet: &mock.Wallet{
+				Files:            make(map[string]map[string][]byte),
+				AccountPasswords: make(map[string]string),
+				WalletPassword:   password,
+			},
+		})
+		require.NoError(t, err)
+		return km
+	}
+	seed, err := seedFromMnemonic(constant.TestMnemonic, DefaultMnemonicLanguage, "")
+	require.NoError(t, err)
+	pubKeyAt := func(t *testing.T, i int) []byte {
+		privKey, err := util.PrivateKeyFromSeedAndPath(seed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, i))
+		require.NoError(t, err)
+		return privKey.PublicKey().Marshal()
+	}
+	pubKeysOf := func(accounts []*DerivedAccount) [][]byte {
+		pubKeys := make([][]byte, len(accounts))
+		for i, account := range accounts {
+			pubKeys[i] = account.PublicKey
+		}
+		return pubKeys
+	}
+
+	t.Run("continues after the highest derived index", func(t *testing.T) {
+		km := newKeymanager(t)
+		require.NoError(t, km.RecoverAccountsFromMnemonic(ctx, constant.TestMnemonic, DefaultMnemonicLanguage, "", 3))
+		// Index 1 was deleted, it is not derived again.
+		_, err := km.DeleteKeystores(ctx, [][]byte{pubKeyAt(t, 1)})
+		require.NoError(t, err)
+
+		accounts, err := km.ExtendAccounts(ctx, 2, nil)
+		require.NoError(t, err)
+		require.DeepEqual(t, [][]byte{pubKeyAt(t, 3), pubKeyAt(t, 4)}, pubKeysOf(accounts))
+		assert.Equal(t, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, 3), accounts[0].DerivationPath)
+		accounts, err = km.ExtendAccounts(ctx, 1, nil)
+		require.NoError(t, err)
+		require.DeepEqual(t, [][]byte{pubKeyAt(t, 5)}, pubKeysOf(accounts))
+
+		all, err := km.FetchValidatingPublicKeys(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 5, len(all))
+	})
+	t.Run("deposit data", func(t *testing.T) {
+		km := newKeymanager(t)
+		require.NoError(t, km.RecoverAccountsFromMnemonic(ctx, constant.TestMnemonic, DefaultMnemonicLanguage, "", 1))
+		domain, err := signing.ComputeDomain(params.BeaconConfig().DomainDeposit, nil, nil)
+		require.NoError(t, err)
+
+		accounts, err := km.ExtendAccounts(ctx, 1, nil)
+		require.NoError(t, err)
+		withdrawalKey, err := util.PrivateKeyFromSeedAndPath(seed, fmt.Sprintf(WithdrawalKeyDerivationPathTemplate, 1))
+		require.NoError(t, err)
+		blsWithdrawalKey, err := bls.SecretKeyFromBytes(withdrawalKey.Marshal())
+		require.NoError(t, err)
+		depositData := accounts[0].DepositData
+		assert.DeepEqual(t, pubKeyAt(t, 1), depositData.PublicKey)
+		assert.DeepEqual(t, deposit.WithdrawalCredentialsHash(blsWithdrawalKey), depositData.WithdrawalCredentials)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, depositData.Amount)
+		require.NoError(t, deposit.VerifyDepositSignature(depositData, domain))
+		root, err := depositData.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, root, accounts[0].DepositDataRoot)
+
+		address := bytes.Repeat([]byte{0x01}, 20)
+		accounts, err = km.ExtendAccounts(ctx, 1, address)
+		require.NoError(t, err)
+		depositData = accounts[0].DepositData
+		assert.DeepEqual(t, deposit.ExecutionAddressWithdrawalCredentials(address), depositData.WithdrawalCredentials)
+		require.NoError(t, deposit.VerifyDepositSignature(depositData, domain))
+	})
+	t.Run("no stored seed", func(t *testing.T) {
+		km := newKeymanager(t)
+		hasSeed, err := km.HasStoredSeed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, false, hasSeed)
+		_, err = km.ExtendAccounts(ctx, 1, nil)
+		require.ErrorIs(t, err, ErrNoStoredSeed)
+
+		accounts, err := km.ExtendAccountsFromMnemonic(ctx, constant.TestMnemonic, DefaultMnemonicLanguage, "", 1, nil)
+		require.NoError(t, err)
+		require.DeepEqual(t, [][]byte{pubKeyAt(t, 0)}, pubKeysOf(accounts))
+		// The seed is stored, the mnemonic is not needed anymore.
+		hasSeed, err = km.HasStoredSeed(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, true, hasSeed)
+		accounts, err = km.ExtendAccounts(ctx, 1, nil)
+		require.NoError(t, err)
+		require.DeepEqual(t, [][]byte{pubKeyAt(t, 1)}, pubKeysOf(accounts))
+	})
+	t.Run("other mnemonic", func(t *testing.T) {
+		km := newKeymanager(t)
+		require.NoError(t, km.RecoverAccountsFromMnemonic(ctx, constant.TestMnemonic, DefaultMnemonicLanguage, "", 1))
+		_, err := km.ExtendAccountsFromMnemonic(ctx, constant.TestMnemonic, DefaultMnemonicLanguage, "mnemonicpass", 1, nil)
+		require.ErrorIs(t, err, ErrMnemonicMismatch)
+	})
+}
+
 func TestDerivedKeymanager_RecoverSeedRoundTrip(t *testing.T) {
 	mnemonicEntropy := make([]byte, 32)
 	n, err := rand.NewGenerator().Read(mnemonicEntropy)
@@ -204,7 +311,7 @@ func TestDerivedKeymanager_Sign_NoPublicKeyInCache(t *testing.T) {
 	req := &validatorpb.SignRequest{
 		PublicKey: []byte("hello world"),
 	}
-	dr := &Keymanager{}
+	dr := &Keymanager{localKM: &local.Keymanager{}}
 	_, err := dr.Sign(context.Background(), req)
 	assert.ErrorContains(t, "no signing key found", err)
 }
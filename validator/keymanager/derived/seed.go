@@ -0,0 +1,77 @@
+package derived
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// SeedKeystoreFileName is the name of the file holding the encrypted seed of an HD wallet,
+// next to the accounts keystore.
+const SeedKeystoreFileName = "seed.keystore.json"
+
+// ErrNoStoredSeed is returned when the wallet does not hold the seed its accounts were derived from,
+// as is the case for wallets created before the seed was stored.
+var ErrNoStoredSeed = errors.New("wallet does not hold the seed of its accounts")
+
+// HasStoredSeed returns whether the wallet holds the seed its accounts are derived from.
+func (km *Keymanager) HasStoredSeed(ctx context.Context) (bool, error) {
+	_, err := km.storedSeed(ctx)
+	if errors.Is(err, ErrNoStoredSeed) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// saveSeed encrypts the seed with the wallet password and writes it to the wallet.
+func (km *Keymanager) saveSeed(ctx context.Context, seed []byte) error {
+	encryptor := keystorev4.New()
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	cryptoFields, err := encryptor.Encrypt(seed, km.wallet.Password())
+	if err != nil {
+		return errors.Wrap(err, "could not encrypt seed")
+	}
+	encoded, err := json.MarshalIndent(&local.AccountsKeystoreRepresentation{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Name:    encryptor.Name(),
+	}, "", "\t")
+	if err != nil {
+		return err
+	}
+	if _, err := km.wallet.WriteFileAtPath(ctx, local.AccountsPath, SeedKeystoreFileName, encoded); err != nil {
+		return errors.Wrap(err, "could not write seed keystore")
+	}
+	return nil
+}
+
+// storedSeed reads the seed from the wallet and decrypts it with the wallet password.
+func (km *Keymanager) storedSeed(ctx context.Context) ([]byte, error) {
+	encoded, err := km.wallet.ReadFileAtPath(ctx, local.AccountsPath, SeedKeystoreFileName)
+	if err != nil && strings.Contains(err.Error(), "no files found") {
+		return nil, ErrNoStoredSeed
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "could not read seed keystore %s", SeedKeystoreFileName)
+	}
+	keystoreFile := &local.AccountsKeystoreRepresentation{}
+	if err := json.Unmarshal(encoded, keystoreFile); err != nil {
+		return nil, errors.Wrapf(err, "could not decode seed keystore %s", SeedKeystoreFileName)
+	}
+	seed, err := keystorev4.New().Decrypt(keystoreFile.Crypto, km.wallet.Password())
+	if err != nil && strings.Contains(err.Error(), keymanager.IncorrectPasswordErrMsg) {
+		return nil, errors.Wrap(err, "wrong password for wallet entered")
+	} else if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt seed keystore")
+	}
+	return seed, nil
+}
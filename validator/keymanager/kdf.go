@@ -0,0 +1,62 @@
+package keymanager
+
+import (
+	"fmt"
+	"math"
+)
+
+// KDF is the key derivation function used to encrypt the secret key of an EIP-2335 keystore.
+type KDF string
+
+const (
+	// KDFPBKDF2 encrypts keystores with PBKDF2-HMAC-SHA256, with Rounds iterations.
+	KDFPBKDF2 KDF = "pbkdf2"
+	// KDFScrypt encrypts keystores with scrypt, with Rounds as the CPU/memory cost parameter n.
+	KDFScrypt KDF = "scrypt"
+)
+
+// DefaultKDFRounds is the number of rounds used to encrypt keystores when none is specified.
+// It is the value used by the EIP-2335 test vectors, and the lowest value considered secure.
+const DefaultKDFRounds = 262144
+
+// KDFConfig defines how the secret keys of EIP-2335 keystores are encrypted.
+// The zero value encrypts keystores with PBKDF2 and DefaultKDFRounds rounds.
+type KDFConfig struct {
+	Function KDF
+	Rounds   uint64
+}
+
+// WithDefaults returns the config with the default function and number of rounds filled in when unset.
+func (c KDFConfig) WithDefaults() KDFConfig {
+	if c.Function == "" {
+		c.Function = KDFPBKDF2
+	}
+	if c.Rounds == 0 {
+		c.Rounds = DefaultKDFRounds
+	}
+	return c
+}
+
+// Validate checks the key derivation function is supported, and the number of rounds is valid for it.
+func (c KDFConfig) Validate() error {
+	c = c.WithDefaults()
+	if c.Rounds > math.MaxInt32 {
+		return fmt.Errorf("%d KDF rounds is too high, the maximum is %d", c.Rounds, math.MaxInt32)
+	}
+	switch c.Function {
+	case KDFPBKDF2:
+		return nil
+	case KDFScrypt:
+		if c.Rounds < 2 || c.Rounds&(c.Rounds-1) != 0 {
+			return fmt.Errorf("scrypt rounds must be a power of 2 greater than 1, got %d", c.Rounds)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported KDF %q, must be one of %q or %q", c.Function, KDFPBKDF2, KDFScrypt)
+	}
+}
+
+// Secure returns whether the number of rounds is high enough for the keystores to be safely shared.
+func (c KDFConfig) Secure() bool {
+	return c.WithDefaults().Rounds >= DefaultKDFRounds
+}
@@ -0,0 +1,59 @@
+package keymanager_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+)
+
+func TestKDFConfig_WithDefaults(t *testing.T) {
+	assert.DeepEqual(t, keymanager.KDFConfig{Function: keymanager.KDFPBKDF2, Rounds: keymanager.DefaultKDFRounds}, keymanager.KDFConfig{}.WithDefaults())
+	c := keymanager.KDFConfig{Function: keymanager.KDFScrypt, Rounds: 1024}
+	assert.DeepEqual(t, c, c.WithDefaults())
+}
+
+func TestKDFConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  keymanager.KDFConfig
+		wantErr string
+	}{
+		{name: "defaults", config: keymanager.KDFConfig{}},
+		{name: "pbkdf2", config: keymanager.KDFConfig{Function: keymanager.KDFPBKDF2, Rounds: 300000}},
+		{name: "scrypt", config: keymanager.KDFConfig{Function: keymanager.KDFScrypt, Rounds: 1 << 18}},
+		{
+			name:    "scrypt rounds not a power of 2",
+			config:  keymanager.KDFConfig{Function: keymanager.KDFScrypt, Rounds: 300000},
+			wantErr: "scrypt rounds must be a power of 2",
+		},
+		{
+			name:    "too many rounds",
+			config:  keymanager.KDFConfig{Function: keymanager.KDFPBKDF2, Rounds: 1 << 32},
+			wantErr: "KDF rounds is too high",
+		},
+		{
+			name:    "unsupported function",
+			config:  keymanager.KDFConfig{Function: "argon2"},
+			wantErr: "unsupported KDF",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestKDFConfig_Secure(t *testing.T) {
+	assert.Equal(t, true, keymanager.KDFConfig{}.Secure())
+	assert.Equal(t, true, keymanager.KDFConfig{Function: keymanager.KDFScrypt, Rounds: 1 << 20}.Secure())
+	assert.Equal(t, false, keymanager.KDFConfig{Function: keymanager.KDFPBKDF2, Rounds: 100000}.Secure())
+	assert.Equal(t, false, keymanager.KDFConfig{Function: keymanager.KDFScrypt, Rounds: 1 << 14}.Secure())
+}
@@ -13,25 +13,28 @@ import (
 )
 
 // ExtractKeystores retrieves the secret keys for specified public keys
-// in the function input, encrypts them using the specified password,
-// and returns their respective EIP-2335 keystores.
-func (*Keymanager) ExtractKeystores(
-	_ context.Context, publicKeys []bls.PublicKey, password string,
+// in the function input, encrypts them using the specified password
+// and key derivation function, and returns their respective EIP-2335 keystores.
+func (km *Keymanager) ExtractKeystores(
+	_ context.Context, publicKeys []bls.PublicKey, password string, kdf keymanager.KDFConfig,
 ) ([]*keymanager.Keystore, error) {
-	lock.Lock()
-	defer lock.Unlock()
+	if err := kdf.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid key derivation function")
+	}
+	km.keysLock.RLock()
+	defer km.keysLock.RUnlock()
 	encryptor := keystorev4.New()
 	keystores := make([]*keymanager.Keystore, len(publicKeys))
 	for i, pk := range publicKeys {
 		pubKeyBytes := pk.Marshal()
-		secretKey, ok := secretKeysCache[bytesutil.ToBytes48(pubKeyBytes)]
+		secretKey, ok := km.secretKeysCache[bytesutil.ToBytes48(pubKeyBytes)]
 		if !ok {
 			return nil, fmt.Errorf(
 				"secret key for public key %#x not found in cache",
 				pubKeyBytes,
 			)
 		}
-		cryptoFields, err := encryptor.Encrypt(secretKey.Marshal(), password)
+		cryptoFields, err := encryptSecretKey(secretKey.Marshal(), password, kdf)
 		if err != nil {
 			return nil, errors.Wrapf(
 				err,
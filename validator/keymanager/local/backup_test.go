@@ -10,23 +10,25 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	mock "github.com/prysmaticlabs/prysm/v5/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
 func TestLocalKeymanager_ExtractKeystores(t *testing.T) {
-	secretKeysCache = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)
-	dr := &Keymanager{}
+	dr := &Keymanager{secretKeysCache: make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)}
 	validatingKeys := make([]bls.SecretKey, 10)
 	for i := 0; i < len(validatingKeys); i++ {
 		secretKey, err := bls.RandKey()
 		require.NoError(t, err)
 		validatingKeys[i] = secretKey
-		secretKeysCache[bytesutil.ToBytes48(secretKey.PublicKey().Marshal())] = secretKey
+		dr.secretKeysCache[bytesutil.ToBytes48(secretKey.PublicKey().Marshal())] = secretKey
 	}
 	ctx := context.Background()
 	password := "password"
 
 	// Extracting 0 public keys should return 0 keystores.
-	keystores, err := dr.ExtractKeystores(ctx, nil, password)
+	keystores, err := dr.ExtractKeystores(ctx, nil, password, keymanager.KDFConfig{})
 	require.NoError(t, err)
 	assert.Equal(t, 0, len(keystores))
 
@@ -39,6 +41,7 @@ func TestLocalKeymanager_ExtractKeystores(t *testing.T) {
 			validatingKeys[7].PublicKey(),
 		},
 		password,
+		keymanager.KDFConfig{},
 	)
 	require.NoError(t, err)
 	receivedPubKeys := make([][]byte, len(keystores))
@@ -53,3 +56,82 @@ func TestLocalKeymanager_ExtractKeystores(t *testing.T) {
 		validatingKeys[7].PublicKey().Marshal(),
 	})
 }
+
+func TestLocalKeymanager_ExtractKeystores_KDF(t *testing.T) {
+	tests := []struct {
+		name   string
+		kdf    keymanager.KDFConfig
+		params map[string]interface{}
+	}{
+		{
+			name:   "default",
+			kdf:    keymanager.KDFConfig{},
+			params: map[string]interface{}{"c": float64(keymanager.DefaultKDFRounds), "prf": "hmac-sha256"},
+		},
+		{
+			name:   "pbkdf2",
+			kdf:    keymanager.KDFConfig{Function: keymanager.KDFPBKDF2, Rounds: 1000},
+			params: map[string]interface{}{"c": float64(1000), "prf": "hmac-sha256"},
+		},
+		{
+			name:   "scrypt",
+			kdf:    keymanager.KDFConfig{Function: keymanager.KDFScrypt, Rounds: 1024},
+			params: map[string]interface{}{"n": float64(1024), "r": float64(8), "p": float64(1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secretKey, err := bls.RandKey()
+			require.NoError(t, err)
+			extractor := &Keymanager{secretKeysCache: map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey{
+				bytesutil.ToBytes48(secretKey.PublicKey().Marshal()): secretKey,
+			}}
+			ctx := context.Background()
+			password := "pässwörd\u0007"
+
+			keystores, err := extractor.ExtractKeystores(ctx, []bls.PublicKey{secretKey.PublicKey()}, password, tt.kdf)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(keystores))
+
+			kdf, ok := keystores[0].Crypto["kdf"].(map[string]interface{})
+			require.Equal(t, true, ok)
+			assert.Equal(t, string(tt.kdf.WithDefaults().Function), kdf["function"])
+			params, ok := kdf["params"].(map[string]interface{})
+			require.Equal(t, true, ok)
+			for k, v := range tt.params {
+				assert.Equal(t, v, params[k], k)
+			}
+
+			// The keystore decrypts with the keystorev4 decryptor other consensus clients rely on.
+			decrypted, err := keystorev4.New().Decrypt(keystores[0].Crypto, password)
+			require.NoError(t, err)
+			assert.DeepEqual(t, secretKey.Marshal(), decrypted)
+			_, err = keystorev4.New().Decrypt(keystores[0].Crypto, "wrong password")
+			require.ErrorContains(t, "invalid checksum", err)
+
+			// The keystore can be imported back.
+			km := &Keymanager{
+				wallet: &mock.Wallet{
+					Files:          make(map[string]map[string][]byte),
+					WalletPassword: password,
+				},
+				accountsStore: &accountStore{},
+			}
+			statuses, err := km.ImportKeystores(ctx, keystores, []string{password})
+			require.NoError(t, err)
+			require.Equal(t, 1, len(statuses))
+			assert.Equal(t, keymanager.StatusImported, statuses[0].Status)
+			pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(pubKeys))
+			assert.DeepEqual(t, bytesutil.ToBytes48(secretKey.PublicKey().Marshal()), pubKeys[0])
+		})
+	}
+}
+
+func TestLocalKeymanager_ExtractKeystores_InvalidKDF(t *testing.T) {
+	_, err := (&Keymanager{}).ExtractKeystores(
+		context.Background(), nil, "password", keymanager.KDFConfig{Function: keymanager.KDFScrypt, Rounds: 1000},
+	)
+	require.ErrorContains(t, "scrypt rounds must be a power of 2", err)
+}
@@ -67,6 +67,9 @@ func (km *Keymanager) DeleteKeystores(
 	if err := km.SaveStoreAndReInitialize(ctx, storeCopy); err != nil {
 		return nil, err
 	}
+	if err := km.forgetAccountNames(deletedKeys); err != nil {
+		log.WithError(err).Warn("Could not remove names of deleted accounts")
+	}
 
 	log.WithFields(logrus.Fields{
 		"publicKeys": CreatePrintoutOfKeys(deletedKeys),
@@ -0,0 +1,38 @@
+package local
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+)
+
+func TestNormalizePassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		want     string
+	}{
+		{
+			name:     "ascii",
+			password: "password",
+			want:     "70617373776f7264",
+		},
+		{
+			// The password of the EIP-2335 test vectors.
+			name:     "eip-2335 test vector",
+			password: "\U0001d531\U0001d522\U0001d530\U0001d531\U0001d52d\U0001d51e\U0001d530\U0001d530\U0001d534\U0001d52c\U0001d52f\U0001d521\U0001f511",
+			want:     "7465737470617373776f7264f09f9491",
+		},
+		{
+			name:     "control characters",
+			password: "pass\u0007word\u007f\n",
+			want:     "70617373776f7264",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hex.EncodeToString([]byte(normalizePassword(tt.password))))
+		})
+	}
+}
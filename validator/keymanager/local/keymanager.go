@@ -19,17 +19,10 @@ import (
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v5/runtime/interop"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
-	"github.com/prysmaticlabs/prysm/v5/validator/accounts/petnames"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
-var (
-	lock              sync.RWMutex
-	orderedPublicKeys = make([][fieldparams.BLSPubkeyLength]byte, 0)
-	secretKeysCache   = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)
-)
-
 const (
 	// KeystoreFileNameFormat exposes the filename the keystore should be formatted in.
 	KeystoreFileNameFormat = "keystore-%d.json"
@@ -44,6 +37,12 @@ type Keymanager struct {
 	wallet              iface.Wallet
 	accountsStore       *accountStore
 	accountsChangedFeed *event.Feed
+	names               map[[fieldparams.BLSPubkeyLength]byte]string
+	namesLock           sync.RWMutex
+	// The caches of the keys in the accounts store, which speed up FetchValidatingPublicKeys and Sign.
+	orderedPublicKeys [][fieldparams.BLSPubkeyLength]byte
+	secretKeysCache   map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey
+	keysLock          sync.RWMutex
 }
 
 // SetupConfig includes configuration values for initializing
@@ -77,14 +76,6 @@ type AccountsKeystoreRepresentation struct {
 	Name    string                 `json:"name"`
 }
 
-// ResetCaches for the keymanager.
-func ResetCaches() {
-	lock.Lock()
-	orderedPublicKeys = make([][fieldparams.BLSPubkeyLength]byte, 0)
-	secretKeysCache = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)
-	lock.Unlock()
-}
-
 // NewKeymanager instantiates a new local keymanager from configuration options.
 func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	k := &Keymanager{
@@ -96,6 +87,9 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 	if err := k.initializeAccountKeystore(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to initialize account store")
 	}
+	if err := k.loadAccountNames(); err != nil {
+		return nil, errors.Wrap(err, "failed to load account names")
+	}
 
 	if cfg.ListenForChanges {
 		// We begin a goroutine to listen for file changes to our
@@ -125,15 +119,14 @@ func NewInteropKeymanager(_ context.Context, offset, numValidatorKeys uint64) (*
 	if err != nil {
 		return nil, errors.Wrap(err, "could not generate interop keys")
 	}
-	lock.Lock()
 	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, numValidatorKeys)
+	k.secretKeysCache = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey, numValidatorKeys)
 	for i := uint64(0); i < numValidatorKeys; i++ {
 		publicKey := bytesutil.ToBytes48(publicKeys[i].Marshal())
 		pubKeys[i] = publicKey
-		secretKeysCache[publicKey] = secretKeys[i]
+		k.secretKeysCache[publicKey] = secretKeys[i]
 	}
-	orderedPublicKeys = pubKeys
-	lock.Unlock()
+	k.orderedPublicKeys = pubKeys
 	return k, nil
 }
 
@@ -145,24 +138,24 @@ func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BL
 }
 
 // ValidatingAccountNames for a local keymanager.
-func (_ *Keymanager) ValidatingAccountNames() ([]string, error) {
-	lock.RLock()
-	names := make([]string, len(orderedPublicKeys))
-	for i, pubKey := range orderedPublicKeys {
-		names[i] = petnames.DeterministicName(bytesutil.FromBytes48(pubKey), "-")
-	}
-	lock.RUnlock()
+func (km *Keymanager) ValidatingAccountNames() ([]string, error) {
+	km.namesLock.RLock()
+	defer km.namesLock.RUnlock()
+	km.keysLock.RLock()
+	names := make([]string, len(km.orderedPublicKeys))
+	for i, pubKey := range km.orderedPublicKeys {
+		names[i] = km.accountName(pubKey)
+	}
+	km.keysLock.RUnlock()
 	return names, nil
 }
 
 // Initialize public and secret key caches that are used to speed up the functions
 // FetchValidatingPublicKeys and Sign
 func (km *Keymanager) initializeKeysCachesFromKeystore() error {
-	lock.Lock()
-	defer lock.Unlock()
 	count := len(km.accountsStore.PrivateKeys)
-	orderedPublicKeys = make([][fieldparams.BLSPubkeyLength]byte, count)
-	secretKeysCache = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey, count)
+	orderedPublicKeys := make([][fieldparams.BLSPubkeyLength]byte, count)
+	secretKeysCache := make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey, count)
 	for i, publicKey := range km.accountsStore.PublicKeys {
 		publicKey48 := bytesutil.ToBytes48(publicKey)
 		orderedPublicKeys[i] = publicKey48
@@ -172,33 +165,36 @@ func (km *Keymanager) initializeKeysCachesFromKeystore() error {
 		}
 		secretKeysCache[publicKey48] = secretKey
 	}
+	km.keysLock.Lock()
+	km.orderedPublicKeys = orderedPublicKeys
+	km.secretKeysCache = secretKeysCache
+	km.keysLock.Unlock()
 	return nil
 }
 
 // FetchValidatingPublicKeys fetches the list of active public keys from the local account keystores.
-func (_ *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
 	_, span := trace.StartSpan(ctx, "keymanager.FetchValidatingPublicKeys")
 	defer span.End()
 
-	lock.RLock()
-	keys := orderedPublicKeys
-	result := make([][fieldparams.BLSPubkeyLength]byte, len(keys))
-	copy(result, keys)
-	lock.RUnlock()
+	km.keysLock.RLock()
+	result := make([][fieldparams.BLSPubkeyLength]byte, len(km.orderedPublicKeys))
+	copy(result, km.orderedPublicKeys)
+	km.keysLock.RUnlock()
 	return result, nil
 }
 
 // FetchValidatingPrivateKeys fetches the list of private keys from the secret keys cache
 func (km *Keymanager) FetchValidatingPrivateKeys(ctx context.Context) ([][32]byte, error) {
-	lock.RLock()
-	defer lock.RUnlock()
-	privKeys := make([][32]byte, len(secretKeysCache))
 	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not retrieve public keys")
 	}
+	km.keysLock.RLock()
+	defer km.keysLock.RUnlock()
+	privKeys := make([][32]byte, len(pubKeys))
 	for i, pk := range pubKeys {
-		seckey, ok := secretKeysCache[pk]
+		seckey, ok := km.secretKeysCache[pk]
 		if !ok {
 			return nil, errors.New("Could not fetch private key")
 		}
@@ -208,20 +204,44 @@ func (km *Keymanager) FetchValidatingPrivateKeys(ctx context.Context) ([][32]byt
 }
 
 // Sign signs a message using a validator key.
-func (_ *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
 	publicKey := req.PublicKey
 	if publicKey == nil {
 		return nil, errors.New("nil public key in request")
 	}
-	lock.RLock()
-	secretKey, ok := secretKeysCache[bytesutil.ToBytes48(publicKey)]
-	lock.RUnlock()
+	km.keysLock.RLock()
+	secretKey, ok := km.secretKeysCache[bytesutil.ToBytes48(publicKey)]
+	km.keysLock.RUnlock()
 	if !ok {
 		return nil, errors.New("no signing key found in keys cache")
 	}
 	return secretKey.Sign(req.SigningRoot), nil
 }
 
+// SignBatch signs several messages using the validator keys, looking the keys up in a single pass over the keys cache.
+func (km *Keymanager) SignBatch(_ context.Context, reqs []*validatorpb.SignRequest) ([]bls.Signature, error) {
+	secretKeys := make([]bls.SecretKey, len(reqs))
+	km.keysLock.RLock()
+	for i, req := range reqs {
+		if req.PublicKey == nil {
+			km.keysLock.RUnlock()
+			return nil, errors.New("nil public key in request")
+		}
+		secretKey, ok := km.secretKeysCache[bytesutil.ToBytes48(req.PublicKey)]
+		if !ok {
+			km.keysLock.RUnlock()
+			return nil, fmt.Errorf("no signing key found in keys cache for public key %#x", bytesutil.Trunc(req.PublicKey))
+		}
+		secretKeys[i] = secretKey
+	}
+	km.keysLock.RUnlock()
+	sigs := make([]bls.Signature, len(reqs))
+	for i, req := range reqs {
+		sigs[i] = secretKeys[i].Sign(req.SigningRoot)
+	}
+	return sigs, nil
+}
+
 func (km *Keymanager) initializeAccountKeystore(ctx context.Context) error {
 	encoded, err := km.wallet.ReadFileAtPath(ctx, AccountsPath, AccountsKeystoreFileName)
 	if err != nil && strings.Contains(err.Error(), "no files found") {
@@ -337,8 +357,6 @@ func CreateAccountsKeystoreRepresentation(
 
 // CreateEmptyKeyStoreRepresentationForNewWallet creates a placeholder accounts keystore for a new Prysm Local Wallet.
 func CreateEmptyKeyStoreRepresentationForNewWallet(ctx context.Context, walletPassword string) (*AccountsKeystoreRepresentation, error) {
-	// make sure everything is clean when creating this.
-	ResetCaches()
 	return CreateAccountsKeystoreRepresentation(ctx, &accountStore{}, walletPassword)
 }
 
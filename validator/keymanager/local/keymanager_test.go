@@ -163,8 +163,33 @@ func TestLocalKeymanager_Sign_NoPublicKeyInCache(t *testing.T) {
 	req := &validatorpb.SignRequest{
 		PublicKey: []byte("hello world"),
 	}
-	secretKeysCache = make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)
 	dr := &Keymanager{}
 	_, err := dr.Sign(context.Background(), req)
 	assert.ErrorContains(t, "no signing key found in keys cache", err)
 }
+
+func TestLocalKeymanager_SignBatch(t *testing.T) {
+	dr := &Keymanager{secretKeysCache: make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)}
+	reqs := make([]*validatorpb.SignRequest, 3)
+	pubKeys := make([]bls.PublicKey, len(reqs))
+	for i := range reqs {
+		secretKey, err := bls.RandKey()
+		require.NoError(t, err)
+		pubKeys[i] = secretKey.PublicKey()
+		dr.secretKeysCache[bytesutil.ToBytes48(pubKeys[i].Marshal())] = secretKey
+		reqs[i] = &validatorpb.SignRequest{
+			PublicKey:   pubKeys[i].Marshal(),
+			SigningRoot: []byte{byte(i)},
+		}
+	}
+	sigs, err := dr.SignBatch(context.Background(), reqs)
+	require.NoError(t, err)
+	require.Equal(t, len(reqs), len(sigs))
+	for i, sig := range sigs {
+		require.Equal(t, true, sig.Verify(pubKeys[i], reqs[i].SigningRoot))
+	}
+
+	reqs = append(reqs, &validatorpb.SignRequest{PublicKey: []byte("hello world")})
+	_, err = dr.SignBatch(context.Background(), reqs)
+	assert.ErrorContains(t, "no signing key found in keys cache", err)
+}
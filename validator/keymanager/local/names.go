@@ -0,0 +1,299 @@
+package local
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/petnames"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// AccountNamesFileName is the name of the file, kept next to the accounts keystore, that holds the names
+	// assigned to accounts. Accounts without an entry are named by their petname, so wallets created before
+	// account names could be assigned keep the same names.
+	AccountNamesFileName = "account-names.json"
+	// DefaultAccountNameTemplate names accounts by their petname.
+	DefaultAccountNameTemplate = "{petname}"
+	// PubkeyAccountNameTemplate names accounts by the first 8 hex characters of their public key.
+	PubkeyAccountNameTemplate = "{pubkey}"
+
+	pubkeyNameLength = 8
+	maxNameLength    = 64
+)
+
+var (
+	// ErrAccountNameTaken is returned when renaming an account to a name already used by another account.
+	ErrAccountNameTaken = errors.New("account name is already used by another account in the wallet")
+	// ErrAccountNotFound is returned when naming an account that is not in the wallet.
+	ErrAccountNotFound = errors.New("account not found in wallet")
+
+	validAccountName    = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+	templatePlaceholder = regexp.MustCompile(`\{[^{}]*\}`)
+)
+
+// accountNamesFile is the on-disk representation of the names assigned to accounts,
+// keyed by 0x-prefixed hex public key.
+type accountNamesFile struct {
+	Names map[string]string `json:"names"`
+}
+
+// AccountName returns the name of the account with the given public key. This is the name assigned when
+// the account was imported or renamed, if any, otherwise the petname derived from the public key.
+func (km *Keymanager) AccountName(publicKey []byte) string {
+	km.namesLock.RLock()
+	defer km.namesLock.RUnlock()
+	return km.accountName(bytesutil.ToBytes48(publicKey))
+}
+
+func (km *Keymanager) accountName(publicKey [fieldparams.BLSPubkeyLength]byte) string {
+	return accountNameFrom(km.names, publicKey)
+}
+
+// NameAccounts names the accounts with the given public keys using a template, and saves the names to disk.
+// The template may contain the placeholders {petname}, {pubkey} (the first 8 hex characters of the
+// public key) and {index} (the position of the account in the wallet). When the resulting name is already
+// used by another account, a numeric suffix is appended to make it unique and a warning is logged.
+// The names given to the accounts are returned in the same order as the public keys.
+func (km *Keymanager) NameAccounts(_ context.Context, publicKeys [][]byte, template string) ([]string, error) {
+	if err := ValidateAccountNameTemplate(template); err != nil {
+		return nil, err
+	}
+	km.keysLock.RLock()
+	indices := make(map[[fieldparams.BLSPubkeyLength]byte]int, len(km.orderedPublicKeys))
+	for i, pk := range km.orderedPublicKeys {
+		indices[pk] = i
+	}
+	km.keysLock.RUnlock()
+
+	km.namesLock.Lock()
+	defer km.namesLock.Unlock()
+	updated := km.copyNames()
+	taken := make(map[string][fieldparams.BLSPubkeyLength]byte, len(indices))
+	for pk := range indices {
+		taken[km.accountName(pk)] = pk
+	}
+	names := make([]string, len(publicKeys))
+	for i, publicKey := range publicKeys {
+		pk := bytesutil.ToBytes48(publicKey)
+		index, ok := indices[pk]
+		if !ok {
+			return nil, errors.Wrapf(ErrAccountNotFound, "%#x", publicKey)
+		}
+		name := formatAccountName(template, pk, index)
+		if err := ValidateAccountName(name); err != nil {
+			return nil, errors.Wrapf(err, "template %q gives an invalid name for account %#x", template, publicKey)
+		}
+		unique := name
+		for n := 2; ; n++ {
+			owner, used := taken[unique]
+			if !used || owner == pk {
+				break
+			}
+			unique = name + "-" + strconv.Itoa(n)
+		}
+		if unique != name {
+			log.WithFields(logrus.Fields{
+				"name":      name,
+				"publicKey": fmt.Sprintf("%#x", bytesutil.Trunc(publicKey)),
+				"usedName":  unique,
+			}).Warn("Account name is already used by another account, adding a suffix")
+		}
+		if previous := accountNameFrom(updated, pk); taken[previous] == pk {
+			delete(taken, previous)
+		}
+		taken[unique] = pk
+		updated[pk] = unique
+		names[i] = unique
+	}
+	if err := km.saveAccountNames(updated); err != nil {
+		return nil, err
+	}
+	km.names = updated
+	return names, nil
+}
+
+// RenameAccount gives the account with the given public key a new name, and saves it to disk.
+// Names must be unique within the wallet.
+func (km *Keymanager) RenameAccount(_ context.Context, publicKey []byte, name string) error {
+	if err := ValidateAccountName(name); err != nil {
+		return err
+	}
+	pk := bytesutil.ToBytes48(publicKey)
+	km.keysLock.RLock()
+	keys := make([][fieldparams.BLSPubkeyLength]byte, len(km.orderedPublicKeys))
+	copy(keys, km.orderedPublicKeys)
+	km.keysLock.RUnlock()
+
+	km.namesLock.Lock()
+	defer km.namesLock.Unlock()
+	found := false
+	for _, k := range keys {
+		if k == pk {
+			found = true
+			continue
+		}
+		if km.accountName(k) == name {
+			return errors.Wrapf(ErrAccountNameTaken, "%s is the name of account %#x", name, bytesutil.Trunc(k[:]))
+		}
+	}
+	if !found {
+		return errors.Wrapf(ErrAccountNotFound, "%#x", publicKey)
+	}
+	updated := km.copyNames()
+	updated[pk] = name
+	if err := km.saveAccountNames(updated); err != nil {
+		return err
+	}
+	km.names = updated
+	return nil
+}
+
+// forgetAccountNames removes the names of deleted accounts, so that they are not reused if the same
+// keys are imported again later.
+func (km *Keymanager) forgetAccountNames(publicKeys [][]byte) error {
+	km.namesLock.Lock()
+	defer km.namesLock.Unlock()
+	updated := km.copyNames()
+	for _, publicKey := range publicKeys {
+		delete(updated, bytesutil.ToBytes48(publicKey))
+	}
+	if len(updated) == len(km.names) {
+		return nil
+	}
+	if err := km.saveAccountNames(updated); err != nil {
+		return err
+	}
+	km.names = updated
+	return nil
+}
+
+func accountNameFrom(names map[[fieldparams.BLSPubkeyLength]byte]string, pk [fieldparams.BLSPubkeyLength]byte) string {
+	if name, ok := names[pk]; ok {
+		return name
+	}
+	return petnames.DeterministicName(pk[:], "-")
+}
+
+func (km *Keymanager) copyNames() map[[fieldparams.BLSPubkeyLength]byte]string {
+	names := make(map[[fieldparams.BLSPubkeyLength]byte]string, len(km.names))
+	for k, v := range km.names {
+		names[k] = v
+	}
+	return names
+}
+
+func (km *Keymanager) accountNamesPath() string {
+	return filepath.Join(km.wallet.AccountsDir(), AccountsPath, AccountNamesFileName)
+}
+
+// loadAccountNames reads the names assigned to accounts from disk. A missing file means
+// that all accounts are named by their petnames.
+func (km *Keymanager) loadAccountNames() error {
+	if km.wallet == nil {
+		return nil
+	}
+	path := km.accountNamesPath()
+	exists, err := file.Exists(path, file.Regular)
+	if err != nil {
+		return errors.Wrapf(err, "could not check if file exists: %s", path)
+	}
+	if !exists {
+		return nil
+	}
+	enc, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return errors.Wrapf(err, "could not read account names file %s", path)
+	}
+	f := &accountNamesFile{}
+	if err := json.Unmarshal(enc, f); err != nil {
+		return errors.Wrapf(err, "could not decode account names file %s", path)
+	}
+	names := make(map[[fieldparams.BLSPubkeyLength]byte]string, len(f.Names))
+	for key, name := range f.Names {
+		pk, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil || len(pk) != fieldparams.BLSPubkeyLength {
+			return fmt.Errorf("invalid public key %s in account names file %s", key, path)
+		}
+		names[bytesutil.ToBytes48(pk)] = name
+	}
+	km.namesLock.Lock()
+	km.names = names
+	km.namesLock.Unlock()
+	return nil
+}
+
+// saveAccountNames writes the account names to a temporary file which is then moved into place,
+// so that the names file on disk is never left partially written.
+func (km *Keymanager) saveAccountNames(names map[[fieldparams.BLSPubkeyLength]byte]string) error {
+	if km.wallet == nil {
+		return errors.New("cannot name accounts without a wallet")
+	}
+	f := &accountNamesFile{Names: make(map[string]string, len(names))}
+	for pk, name := range names {
+		f.Names[fmt.Sprintf("%#x", pk)] = name
+	}
+	enc, err := json.MarshalIndent(f, "", "\t")
+	if err != nil {
+		return err
+	}
+	path := km.accountNamesPath()
+	if err := file.MkdirAll(filepath.Dir(path)); err != nil {
+		return errors.Wrapf(err, "could not create directory for %s", path)
+	}
+	tmp := path + ".tmp"
+	if err := file.WriteFile(tmp, enc); err != nil {
+		return errors.Wrapf(err, "could not write %s", tmp)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Wrapf(err, "could not move %s into place", tmp)
+	}
+	return nil
+}
+
+// ValidateAccountNameTemplate checks that a template only contains known placeholders.
+func ValidateAccountNameTemplate(template string) error {
+	if template == "" {
+		return errors.New("account name template cannot be empty")
+	}
+	for _, p := range templatePlaceholder.FindAllString(template, -1) {
+		switch p {
+		case "{petname}", "{pubkey}", "{index}":
+		default:
+			return fmt.Errorf("unknown placeholder %s in account name template, must be one of {petname}, {pubkey} or {index}", p)
+		}
+	}
+	return nil
+}
+
+// ValidateAccountName checks that a name only contains letters, digits, dots, dashes and underscores,
+// starts with a letter or digit, and is at most 64 characters long.
+func ValidateAccountName(name string) error {
+	if len(name) > maxNameLength {
+		return fmt.Errorf("account name %q is longer than %d characters", name, maxNameLength)
+	}
+	if !validAccountName.MatchString(name) {
+		return fmt.Errorf("account name %q must start with a letter or digit and only contain letters, digits, '.', '-' and '_'", name)
+	}
+	return nil
+}
+
+func formatAccountName(template string, pk [fieldparams.BLSPubkeyLength]byte, index int) string {
+	r := strings.NewReplacer(
+		"{petname}", petnames.DeterministicName(pk[:], "-"),
+		"{pubkey}", hex.EncodeToString(pk[:])[:pubkeyNameLength],
+		"{index}", strconv.Itoa(index),
+	)
+	return r.Replace(template)
+}
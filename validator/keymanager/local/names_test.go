@@ -0,0 +1,110 @@
+package local
+
+import (
+	"context"
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/petnames"
+	mock "github.com/prysmaticlabs/prysm/v5/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+)
+
+func TestLocalKeymanager_AccountNames(t *testing.T) {
+	wallet := &mock.Wallet{
+		Files:            make(map[string]map[string][]byte),
+		WalletPassword:   password,
+		InnerAccountsDir: t.TempDir(),
+	}
+	km := &Keymanager{
+		wallet:        wallet,
+		accountsStore: &accountStore{},
+	}
+	ctx := context.Background()
+	numAccounts := 3
+	keystores := make([]*keymanager.Keystore, numAccounts)
+	passwords := make([]string, numAccounts)
+	for i := 0; i < numAccounts; i++ {
+		keystores[i] = createRandomKeystore(t, password)
+		passwords[i] = password
+	}
+	_, err := km.ImportKeystores(ctx, keystores, passwords)
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	pubKeys := make([][]byte, len(keys))
+	for i := range keys {
+		pubKeys[i] = keys[i][:]
+	}
+	namesPath := filepath.Join(wallet.AccountsDir(), AccountsPath, AccountNamesFileName)
+
+	t.Run("petnames by default", func(t *testing.T) {
+		names, err := km.ValidatingAccountNames()
+		require.NoError(t, err)
+		for i, pk := range pubKeys {
+			require.Equal(t, petnames.DeterministicName(pk, "-"), names[i])
+			require.Equal(t, names[i], km.AccountName(pk))
+		}
+		exists, err := file.Exists(namesPath, file.Regular)
+		require.NoError(t, err)
+		require.Equal(t, false, exists)
+	})
+	t.Run("pubkey template", func(t *testing.T) {
+		names, err := km.NameAccounts(ctx, pubKeys, PubkeyAccountNameTemplate)
+		require.NoError(t, err)
+		for i, pk := range pubKeys {
+			require.Equal(t, hex.EncodeToString(pk)[:8], names[i])
+			require.Equal(t, names[i], km.AccountName(pk))
+		}
+	})
+	t.Run("collisions get a suffix", func(t *testing.T) {
+		names, err := km.NameAccounts(ctx, pubKeys, "validator")
+		require.NoError(t, err)
+		require.DeepEqual(t, []string{"validator", "validator-2", "validator-3"}, names)
+		// Naming the same accounts again is stable.
+		names, err = km.NameAccounts(ctx, pubKeys, "validator")
+		require.NoError(t, err)
+		require.DeepEqual(t, []string{"validator", "validator-2", "validator-3"}, names)
+	})
+	t.Run("index template", func(t *testing.T) {
+		names, err := km.NameAccounts(ctx, pubKeys[1:], "node1-{index}")
+		require.NoError(t, err)
+		require.DeepEqual(t, []string{"node1-1", "node1-2"}, names)
+	})
+	t.Run("invalid templates", func(t *testing.T) {
+		_, err := km.NameAccounts(ctx, pubKeys, "{unknown}")
+		require.ErrorContains(t, "unknown placeholder", err)
+		_, err = km.NameAccounts(ctx, pubKeys, "bad name {index}")
+		require.ErrorContains(t, "gives an invalid name", err)
+	})
+	t.Run("rename", func(t *testing.T) {
+		err := km.RenameAccount(ctx, pubKeys[0], "node1-1")
+		require.ErrorIs(t, err, ErrAccountNameTaken)
+		err = km.RenameAccount(ctx, make([]byte, 48), "alice")
+		require.ErrorIs(t, err, ErrAccountNotFound)
+		require.ErrorContains(t, "must start with a letter or digit", km.RenameAccount(ctx, pubKeys[0], "-alice"))
+
+		require.NoError(t, km.RenameAccount(ctx, pubKeys[0], "alice"))
+		require.Equal(t, "alice", km.AccountName(pubKeys[0]))
+	})
+	t.Run("names are persisted", func(t *testing.T) {
+		reloaded := &Keymanager{wallet: wallet}
+		require.NoError(t, reloaded.loadAccountNames())
+		require.Equal(t, "alice", reloaded.AccountName(pubKeys[0]))
+		require.Equal(t, "node1-1", reloaded.AccountName(pubKeys[1]))
+		require.Equal(t, "node1-2", reloaded.AccountName(pubKeys[2]))
+	})
+	t.Run("deleted accounts lose their name", func(t *testing.T) {
+		_, err := km.DeleteKeystores(ctx, [][]byte{pubKeys[0]})
+		require.NoError(t, err)
+		require.Equal(t, petnames.DeterministicName(pubKeys[0], "-"), km.AccountName(pubKeys[0]))
+
+		reloaded := &Keymanager{wallet: wallet}
+		require.NoError(t, reloaded.loadAccountNames())
+		require.Equal(t, petnames.DeterministicName(pubKeys[0], "-"), reloaded.AccountName(pubKeys[0]))
+		require.Equal(t, "node1-1", reloaded.AccountName(pubKeys[1]))
+	})
+}
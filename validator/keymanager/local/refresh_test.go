@@ -74,14 +74,14 @@ func TestLocalKeymanager_reloadAccountsFromKeystore(t *testing.T) {
 
 	// Check that the public keys were added to the public keys cache.
 	for i, keyBytes := range pubKeys {
-		require.Equal(t, bytesutil.ToBytes48(keyBytes), orderedPublicKeys[i])
+		require.Equal(t, bytesutil.ToBytes48(keyBytes), dr.orderedPublicKeys[i])
 	}
 
 	// Check that the secret keys were added to the secret keys cache.
-	lock.RLock()
-	defer lock.RUnlock()
+	dr.keysLock.RLock()
+	defer dr.keysLock.RUnlock()
 	for i, keyBytes := range privKeys {
-		privKey, ok := secretKeysCache[bytesutil.ToBytes48(pubKeys[i])]
+		privKey, ok := dr.secretKeysCache[bytesutil.ToBytes48(pubKeys[i])]
 		require.Equal(t, true, ok)
 		require.Equal(t, bytesutil.ToBytes48(keyBytes), bytesutil.ToBytes48(privKey.Marshal()))
 	}
@@ -50,10 +50,18 @@ type SetupConfig struct {
 	// caution: this option is susceptible to slashing if the web3signer's validator keys are shared across validators
 	PublicKeysURL string
 
+	// PublicKeysURLRefreshInterval is the interval at which the public keys are fetched again from PublicKeysURL,
+	// picking up the keys added to or removed from the remote signer. Zero doesn't refresh the keys.
+	PublicKeysURLRefreshInterval time.Duration
+
 	// Either URL or keylist must be set.
 	// a static list of public keys to be passed by the user to determine what accounts should sign.
 	// This will provide a layer of safety against slashing if the web3signer is shared across validators.
 	ProvidedPublicKeys []string
+
+	// MaxConcurrentSignRequests bounds the number of sign requests sent to the remote signer at once, queueing the
+	// others by priority. Zero doesn't limit sign requests.
+	MaxConcurrentSignRequests int
 }
 
 // Keymanager defines the web3signer keymanager.
@@ -61,12 +69,17 @@ type Keymanager struct {
 	client                internal.HttpSignerClient
 	genesisValidatorsRoot []byte
 	providedPublicKeys    [][48]byte          // (source of truth) flag loaded + file loaded + api loaded keys
+	publicKeysSet         map[[48]byte]bool   // the provided public keys, which are the only keys allowed to sign
 	flagLoadedKeysMap     map[string][48]byte // stores what was provided from flag ( as opposed to from file )
+	publicKeysURL         string
 	accountsChangedFeed   *event.Feed
 	validator             *validator.Validate
 	retriesRemaining      int
 	keyFilePath           string
+	signLimiter           *keymanager.SignLimiter
 	lock                  sync.RWMutex
+	// refreshLock prevents concurrent refreshes of the public keys from the public keys URL.
+	refreshLock sync.Mutex
 }
 
 // NewKeymanager instantiates a new web3signer key manager.
@@ -88,6 +101,8 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		validator:             validator.New(),
 		retriesRemaining:      maxRetries,
 		keyFilePath:           cfg.KeyFilePath,
+		publicKeysURL:         cfg.PublicKeysURL,
+		signLimiter:           keymanager.NewSignLimiter(cfg.MaxConcurrentSignRequests),
 	}
 
 	keyFileExists := false
@@ -114,19 +129,9 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		ppk = cfg.ProvidedPublicKeys
 	}
 
-	// use a map to remove duplicates
-	flagLoadedKeys := make(map[string][48]byte)
-
-	// Populate the map with existing keys
-	for _, key := range ppk {
-		decodedKey, err := hexutil.Decode(key)
-		if err != nil {
-			return nil, errors.Wrapf(err, "could not decode public key %s", key)
-		}
-		if len(decodedKey) != fieldparams.BLSPubkeyLength {
-			return nil, fmt.Errorf("public key %s has invalid length (expected %d, got %d)", decodedKey, fieldparams.BLSPubkeyLength, len(decodedKey))
-		}
-		flagLoadedKeys[key] = bytesutil.ToBytes48(decodedKey)
+	flagLoadedKeys, err := decodePublicKeys(ppk)
+	if err != nil {
+		return nil, err
 	}
 	km.flagLoadedKeysMap = flagLoadedKeys
 
@@ -145,7 +150,7 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 			}
 		}
 		km.lock.Lock()
-		km.providedPublicKeys = maps.Values(fileKeys)
+		km.setPublicKeys(maps.Values(fileKeys))
 		km.lock.Unlock()
 		// create a file watcher
 		go func() {
@@ -156,13 +161,119 @@ func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
 		}()
 	} else {
 		km.lock.Lock()
-		km.providedPublicKeys = maps.Values(flagLoadedKeys)
+		km.setPublicKeys(maps.Values(flagLoadedKeys))
 		km.lock.Unlock()
 	}
 
+	if cfg.PublicKeysURL != "" && cfg.PublicKeysURLRefreshInterval > 0 {
+		go km.refreshPublicKeysFromURL(ctx, cfg.PublicKeysURLRefreshInterval)
+	}
+
 	return km, nil
 }
 
+// decodePublicKeys decodes hex encoded public keys, using a map to remove duplicates.
+func decodePublicKeys(keys []string) (map[string][48]byte, error) {
+	decoded := make(map[string][48]byte)
+	for _, key := range keys {
+		decodedKey, err := hexutil.Decode(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", key)
+		}
+		if len(decodedKey) != fieldparams.BLSPubkeyLength {
+			return nil, fmt.Errorf("public key %s has invalid length (expected %d, got %d)", decodedKey, fieldparams.BLSPubkeyLength, len(decodedKey))
+		}
+		decoded[key] = bytesutil.ToBytes48(decodedKey)
+	}
+	return decoded, nil
+}
+
+// refreshPublicKeysFromURL refreshes the public keys from the public keys URL at every interval.
+func (km *Keymanager) refreshPublicKeysFromURL(ctx context.Context, interval time.Duration) {
+	log.WithField("interval", interval).Info("Refreshing public keys from remote signer periodically")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, _, err := km.RefreshPublicKeys(ctx); err != nil {
+				log.WithError(err).Warn("Could not refresh public keys from remote signer")
+			}
+		}
+	}
+}
+
+// RefreshPublicKeys fetches the public keys from the public keys URL again and applies the keys added to or removed
+// from the remote signer since the previous fetch. Keys provided through the key file or the keymanager API are kept
+// unless the remote signer removed them. Subscribers to account changes are notified of the new keys, and removed keys
+// can no longer sign once it returns. It returns the added and removed keys.
+func (km *Keymanager) RefreshPublicKeys(ctx context.Context) (added, removed [][48]byte, err error) {
+	if km.publicKeysURL == "" {
+		return nil, nil, errors.New("no public keys URL to refresh the public keys from")
+	}
+	km.refreshLock.Lock()
+	defer km.refreshLock.Unlock()
+
+	fetched, err := km.client.GetPublicKeys(ctx, km.publicKeysURL)
+	if err != nil {
+		erroredResponsesTotal.Inc()
+		return nil, nil, errors.Wrapf(err, "could not get public keys from remote server URL %v", km.publicKeysURL)
+	}
+	urlKeys, err := decodePublicKeys(fetched)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	km.lock.RLock()
+	previous := km.flagLoadedKeysMap
+	combinedKeys := make(map[string][48]byte, len(km.providedPublicKeys))
+	for _, key := range km.providedPublicKeys {
+		combinedKeys[hexutil.Encode(key[:])] = key
+	}
+	km.lock.RUnlock()
+	for encoded, key := range previous {
+		if _, ok := urlKeys[encoded]; !ok {
+			removed = append(removed, key)
+			delete(combinedKeys, hexutil.Encode(key[:]))
+		}
+	}
+	for encoded, key := range urlKeys {
+		if _, ok := previous[encoded]; !ok {
+			added = append(added, key)
+			combinedKeys[hexutil.Encode(key[:])] = key
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return nil, nil, nil
+	}
+
+	km.lock.Lock()
+	km.flagLoadedKeysMap = urlKeys
+	km.lock.Unlock()
+	// The keys take effect whether or not they can be saved, as the public keys URL is their source.
+	km.updatePublicKeys(maps.Values(combinedKeys))
+	if km.keyFilePath != "" {
+		if err := km.writePublicKeysFile(combinedKeys); err != nil {
+			log.WithError(err).Warn("Could not save refreshed public keys to file")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"added":   len(added),
+		"removed": len(removed),
+		"total":   len(combinedKeys),
+	}).Info("Refreshed public keys from remote signer")
+	return added, removed, nil
+}
+
+// flagLoadedKeys returns a copy of the keys provided through the flag or fetched from the public keys URL.
+func (km *Keymanager) flagLoadedKeys() map[string][48]byte {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	return maps.Clone(km.flagLoadedKeysMap)
+}
+
 func (km *Keymanager) refreshRemoteKeysFromFileChangesWithRetry(ctx context.Context, retryDelay time.Duration) error {
 	if ctx.Err() != nil {
 		return ctx.Err()
@@ -172,7 +283,7 @@ func (km *Keymanager) refreshRemoteKeysFromFileChangesWithRetry(ctx context.Cont
 	}
 	err := km.refreshRemoteKeysFromFileChanges(ctx)
 	if err != nil {
-		km.updatePublicKeys(maps.Values(km.flagLoadedKeysMap)) // update the keys to flag provided defaults
+		km.updatePublicKeys(maps.Values(km.flagLoadedKeys())) // update the keys to flag provided defaults
 		km.retriesRemaining--
 		log.WithError(err).Debug("Error occurred on key refresh")
 		log.WithFields(logrus.Fields{"path": km.keyFilePath, "retriesRemaining": km.retriesRemaining, "retryDelay": retryDelay}).Warnf("Could not refresh keys. Retrying...")
@@ -241,11 +352,20 @@ func (km *Keymanager) readKeyFile() ([][48]byte, map[string][48]byte, error) {
 	return keys, seenKeys, nil
 }
 
+// savePublicKeysToFile writes the public keys to the key file and updates the keys of the keymanager.
 func (km *Keymanager) savePublicKeysToFile(providedPublicKeys map[string][48]byte) error {
+	if err := km.writePublicKeysFile(providedPublicKeys); err != nil {
+		return err
+	}
+	km.updatePublicKeys(maps.Values(providedPublicKeys))
+	return nil
+}
+
+// writePublicKeysFile writes the public keys to the key file, without updating the keys of the keymanager.
+func (km *Keymanager) writePublicKeysFile(providedPublicKeys map[string][48]byte) error {
 	if km.keyFilePath == "" {
 		return errors.New("no key file provided")
 	}
-	pubkeys := make([][48]byte, 0)
 	// Open the file with write and truncate permissions
 	f, err := os.OpenFile(km.keyFilePath, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0600)
 	if err != nil {
@@ -263,9 +383,7 @@ func (km *Keymanager) savePublicKeysToFile(providedPublicKeys map[string][48]byt
 		if _, err := f.WriteString(key + "\n"); err != nil {
 			return fmt.Errorf("error writing key %s to file: %w", value, err)
 		}
-		pubkeys = append(pubkeys, value)
 	}
-	km.updatePublicKeys(pubkeys)
 	return nil
 }
 
@@ -301,7 +419,7 @@ func (km *Keymanager) refreshRemoteKeysFromFileChanges(ctx context.Context) erro
 		if err != nil {
 			return errors.Wrap(err, "could not read key file")
 		}
-		maps.Copy(fk, km.flagLoadedKeysMap)
+		maps.Copy(fk, km.flagLoadedKeys())
 		if err = km.savePublicKeysToFile(fk); err != nil {
 			return errors.Wrap(err, "could not save public keys to file")
 		}
@@ -334,7 +452,7 @@ func (km *Keymanager) refreshRemoteKeysFromFileChanges(ctx context.Context) erro
 				// prioritize file keys over flag keys
 				if len(fileKeys) == 0 {
 					log.Warnln("Remote signer key file no longer has keys, defaulting to flag provided keys")
-					fileKeys = maps.Values(km.flagLoadedKeysMap)
+					fileKeys = maps.Values(km.flagLoadedKeys())
 				}
 				currentKeys, err := km.FetchValidatingPublicKeys(ctx)
 				if err != nil {
@@ -361,11 +479,20 @@ func (km *Keymanager) refreshRemoteKeysFromFileChanges(ctx context.Context) erro
 func (km *Keymanager) updatePublicKeys(keys [][48]byte) {
 	km.lock.Lock()
 	defer km.lock.Unlock()
-	km.providedPublicKeys = keys
+	km.setPublicKeys(keys)
 	km.accountsChangedFeed.Send(keys)
 	log.WithField("count", len(km.providedPublicKeys)).Debug("Updated public keys")
 }
 
+// setPublicKeys sets the public keys allowed to sign. The caller must hold the lock.
+func (km *Keymanager) setPublicKeys(keys [][48]byte) {
+	km.providedPublicKeys = keys
+	km.publicKeysSet = make(map[[48]byte]bool, len(keys))
+	for _, key := range keys {
+		km.publicKeysSet[key] = true
+	}
+}
+
 // FetchValidatingPublicKeys fetches the validating public keys
 func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
 	km.lock.RLock()
@@ -381,6 +508,18 @@ func (km *Keymanager) Sign(ctx context.Context, request *validatorpb.SignRequest
 		erroredResponsesTotal.Inc()
 		return nil, err
 	}
+	// Keys removed from the keymanager stop signing right away, even for duties assigned before their removal.
+	km.lock.RLock()
+	known := km.publicKeysSet[bytesutil.ToBytes48(request.PublicKey)]
+	km.lock.RUnlock()
+	if !known {
+		return nil, fmt.Errorf("public key %#x is not a validating key of the keymanager", request.PublicKey)
+	}
+	release, err := km.signLimiter.Acquire(ctx, keymanager.SignRequestPriority(request))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not wait for a signing slot")
+	}
+	defer release()
 	signature, err := km.client.Sign(ctx, hexutil.Encode(request.PublicKey), signRequest)
 	if err != nil {
 		erroredResponsesTotal.Inc()
@@ -400,6 +539,8 @@ func getSignRequestJson(ctx context.Context, validator *validator.Validate, requ
 		return nil, fmt.Errorf("invalid genesis validators root length, genesis root: %v", genesisValidatorsRoot)
 	}
 	switch request.Object.(type) {
+	case nil:
+		return nil, errors.New("sign request has no object to sign")
 	case *validatorpb.SignRequest_Block:
 		return handleBlock(ctx, validator, request, genesisValidatorsRoot)
 	case *validatorpb.SignRequest_AttestationData:
@@ -657,7 +798,7 @@ func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BL
 
 // ExtractKeystores is not supported for the remote-web3signer keymanager type.
 func (*Keymanager) ExtractKeystores(
-	_ context.Context, _ []bls.PublicKey, _ string,
+	_ context.Context, _ []bls.PublicKey, _ string, _ keymanager.KDFConfig,
 ) ([]*keymanager.Keystore, error) {
 	return nil, errors.New("extracting keys is not supported for a web3signer keymanager")
 }
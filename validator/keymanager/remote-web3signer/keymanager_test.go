@@ -11,10 +11,13 @@ import (
 	"path"
 	"path/filepath"
 	"slices"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-playground/validator/v10"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
@@ -367,7 +370,8 @@ func TestKeymanager_Sign(t *testing.T) {
 	config := &SetupConfig{
 		BaseEndpoint:          "http://example.com",
 		GenesisValidatorsRoot: root,
-		PublicKeysURL:         "http://example2.com/api/v1/eth2/publicKeys",
+		// The public key of the mock sign requests.
+		ProvidedPublicKeys: []string{hexutil.Encode(make([]byte, 48))},
 	}
 	km, err := NewKeymanager(ctx, config)
 	if err != nil {
@@ -493,6 +497,26 @@ func TestKeymanager_Sign(t *testing.T) {
 
 }
 
+func TestKeymanager_Sign_UnsupportedObject(t *testing.T) {
+	ctx := context.Background()
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	km := &Keymanager{
+		client:                &MockClient{},
+		genesisValidatorsRoot: root,
+		validator:             validator.New(),
+	}
+
+	request := mock.GetMockSignRequest("SYNC_COMMITTEE_MESSAGE")
+	request.Object = nil
+	_, err = km.Sign(ctx, request)
+	require.ErrorContains(t, "sign request has no object to sign", err)
+
+	request.Object = &validatorpb.SignRequest_BlockElectra{}
+	_, err = km.Sign(ctx, request)
+	require.ErrorContains(t, "web3signer sign request type *validatorpb.SignRequest_BlockElectra not supported", err)
+}
+
 func TestKeymanager_FetchValidatingPublicKeys_HappyPath_WithKeyList(t *testing.T) {
 	ctx := context.Background()
 	decodedKey, err := hexutil.Decode("0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820")
@@ -721,3 +745,109 @@ func TestKeymanager_DeletePublicKeys_WithFile(t *testing.T) {
 	require.Equal(t, len(keys), 1)
 	require.Equal(t, hexutil.Encode(keys[0][:]), publicKeys[1])
 }
+
+func TestKeymanager_RefreshPublicKeys(t *testing.T) {
+	urlKey := bytesutil.ToBytes48([]byte{1})
+	apiKey := bytesutil.ToBytes48([]byte{2})
+	newKey := bytesutil.ToBytes48([]byte{3})
+	client := &MockClient{PublicKeys: []string{hexutil.Encode(urlKey[:])}}
+	km := &Keymanager{
+		client:              client,
+		accountsChangedFeed: new(event.Feed),
+	}
+	_, _, err := km.RefreshPublicKeys(context.Background())
+	require.ErrorContains(t, "no public keys URL", err)
+
+	km.publicKeysURL = "http://example.com/api/v1/eth2/publicKeys"
+	km.flagLoadedKeysMap, err = decodePublicKeys(client.PublicKeys)
+	require.NoError(t, err)
+	// A key added through the keymanager API is kept by the refresh.
+	km.setPublicKeys([][48]byte{urlKey, apiKey})
+
+	added, removed, err := km.RefreshPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, len(added))
+	require.Equal(t, 0, len(removed))
+
+	client.PublicKeys = []string{hexutil.Encode(newKey[:])}
+	added, removed, err = km.RefreshPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{newKey}, added)
+	require.DeepEqual(t, [][48]byte{urlKey}, removed)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keys))
+	require.Equal(t, true, slices.Contains(keys, apiKey) && slices.Contains(keys, newKey))
+}
+
+func TestKeymanager_RefreshPublicKeys_KeyFile(t *testing.T) {
+	urlKey := bytesutil.ToBytes48([]byte{1})
+	newKey := bytesutil.ToBytes48([]byte{3})
+	client := &MockClient{PublicKeys: []string{hexutil.Encode(newKey[:])}}
+	keyFilePath := filepath.Join(t.TempDir(), "keyfile.txt")
+	km := &Keymanager{
+		client:              client,
+		accountsChangedFeed: new(event.Feed),
+		publicKeysURL:       "http://example.com/api/v1/eth2/publicKeys",
+		keyFilePath:         keyFilePath,
+		flagLoadedKeysMap:   map[string][48]byte{hexutil.Encode(urlKey[:]): urlKey},
+	}
+	km.setPublicKeys([][48]byte{urlKey})
+
+	added, removed, err := km.RefreshPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{newKey}, added)
+	require.DeepEqual(t, [][48]byte{urlKey}, removed)
+	// The refreshed keys sign right away, and are saved to the key file.
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{newKey}, keys)
+	_, fileKeys, err := km.readKeyFile()
+	require.NoError(t, err)
+	require.DeepEqual(t, map[string][48]byte{hexutil.Encode(newKey[:]): newKey}, fileKeys)
+}
+
+func TestKeymanager_RefreshPublicKeysFromURL(t *testing.T) {
+	root, err := hexutil.Decode("0x270d43e74ce340de4bca2b1936beca0f4f5408d9e78aec4850920baf659d5b69")
+	require.NoError(t, err)
+	oldKey := make([]byte, 48)
+	newKey := bytes.Repeat([]byte{1}, 48)
+	var mu sync.Mutex
+	signerKeys := []string{hexutil.Encode(oldKey)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(signerKeys))
+	}))
+	defer srv.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		BaseEndpoint:                 "http://example.com",
+		GenesisValidatorsRoot:        root,
+		PublicKeysURL:                srv.URL + "/api/v1/eth2/publicKeys",
+		PublicKeysURLRefreshInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	changes := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(changes)
+	defer sub.Unsubscribe()
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{bytesutil.ToBytes48(oldKey)}, keys)
+
+	// The remote signer replaces its key mid-run.
+	mu.Lock()
+	signerKeys = []string{hexutil.Encode(newKey)}
+	mu.Unlock()
+	select {
+	case keys := <-changes:
+		require.DeepEqual(t, [][48]byte{bytesutil.ToBytes48(newKey)}, keys)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Public keys were not refreshed")
+	}
+	// The removed key stops signing, without the sign request reaching the remote signer.
+	_, err = km.Sign(ctx, mock.GetMockSignRequest("AGGREGATION_SLOT"))
+	require.ErrorContains(t, "is not a validating key of the keymanager", err)
+}
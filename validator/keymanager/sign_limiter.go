@@ -0,0 +1,173 @@
+package keymanager
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+)
+
+// SignPriority is the priority class of a sign request when signing requests are queued.
+type SignPriority int
+
+const (
+	// SignPriorityLow is the priority of signatures computed ahead of time, such as selection proofs and
+	// validator registrations.
+	SignPriorityLow SignPriority = iota
+	// SignPriorityNormal is the priority of aggregates, contributions and exits.
+	SignPriorityNormal
+	// SignPriorityHigh is the priority of signatures due in the current slot, such as block proposals, randao reveals,
+	// attestations and sync committee messages.
+	SignPriorityHigh
+	numSignPriorities
+)
+
+// String returns the name of the priority class.
+func (p SignPriority) String() string {
+	switch p {
+	case SignPriorityLow:
+		return "low"
+	case SignPriorityNormal:
+		return "normal"
+	case SignPriorityHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	signQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_sign_queue_depth",
+		Help: "Number of sign requests waiting for a signing slot, by priority.",
+	}, []string{"priority"})
+	signInFlight = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "validator_sign_requests_in_flight",
+		Help: "Number of sign requests being processed by limited keymanagers.",
+	})
+	signQueueWait = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "validator_sign_queue_wait_seconds",
+		Help:    "Time sign requests waited for a signing slot, by priority.",
+		Buckets: []float64{0.001, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 4},
+	}, []string{"priority"})
+)
+
+// SignRequestPriority returns the priority class of a sign request.
+func SignRequestPriority(req *validatorpb.SignRequest) SignPriority {
+	switch req.GetObject().(type) {
+	case *validatorpb.SignRequest_Block, *validatorpb.SignRequest_BlockAltair, *validatorpb.SignRequest_BlockBellatrix,
+		*validatorpb.SignRequest_BlindedBlockBellatrix, *validatorpb.SignRequest_BlockCapella,
+		*validatorpb.SignRequest_BlindedBlockCapella, *validatorpb.SignRequest_BlockDeneb,
+		*validatorpb.SignRequest_BlindedBlockDeneb, *validatorpb.SignRequest_BlockElectra,
+		*validatorpb.SignRequest_BlindedBlockElectra, *validatorpb.SignRequest_Epoch,
+		*validatorpb.SignRequest_AttestationData, *validatorpb.SignRequest_SyncMessageBlockRoot:
+		return SignPriorityHigh
+	case *validatorpb.SignRequest_Slot, *validatorpb.SignRequest_SyncAggregatorSelectionData,
+		*validatorpb.SignRequest_Registration:
+		return SignPriorityLow
+	default:
+		return SignPriorityNormal
+	}
+}
+
+// SignLimiter bounds the number of concurrent sign requests of a keymanager, such as requests to a remote signer.
+// Requests over the limit wait in a queue, and are let through by priority, then in arrival order.
+// A nil SignLimiter doesn't limit sign requests.
+type SignLimiter struct {
+	lock     sync.Mutex
+	max      int
+	inFlight int
+	queues   [numSignPriorities][]*signWaiter
+}
+
+type signWaiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+// NewSignLimiter returns a limiter allowing maxConcurrent sign requests at once, or nil when maxConcurrent is not
+// positive, which doesn't limit sign requests.
+func NewSignLimiter(maxConcurrent int) *SignLimiter {
+	if maxConcurrent <= 0 {
+		return nil
+	}
+	return &SignLimiter{max: maxConcurrent}
+}
+
+// Acquire blocks until a sign request of the given priority can be processed, or the context is canceled.
+// The returned function must be called once the request is processed.
+func (l *SignLimiter) Acquire(ctx context.Context, priority SignPriority) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	if priority < 0 || priority >= numSignPriorities {
+		priority = SignPriorityNormal
+	}
+	start := time.Now()
+	l.lock.Lock()
+	if l.inFlight < l.max && l.queued() == 0 {
+		l.inFlight++
+		l.lock.Unlock()
+		signInFlight.Inc()
+		signQueueWait.WithLabelValues(priority.String()).Observe(0)
+		return l.release, nil
+	}
+	w := &signWaiter{ready: make(chan struct{})}
+	l.queues[priority] = append(l.queues[priority], w)
+	l.lock.Unlock()
+	signQueueDepth.WithLabelValues(priority.String()).Inc()
+	defer signQueueDepth.WithLabelValues(priority.String()).Dec()
+
+	select {
+	case <-w.ready:
+		signQueueWait.WithLabelValues(priority.String()).Observe(time.Since(start).Seconds())
+		return l.release, nil
+	case <-ctx.Done():
+		l.lock.Lock()
+		if w.granted {
+			// The request was let through as the context got canceled, hand the slot over to the next one.
+			l.lock.Unlock()
+			l.release()
+			return nil, ctx.Err()
+		}
+		q := l.queues[priority]
+		for i := range q {
+			if q[i] == w {
+				l.queues[priority] = append(q[:i], q[i+1:]...)
+				break
+			}
+		}
+		l.lock.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// release ends a sign request and lets the next queued request through.
+func (l *SignLimiter) release() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for p := numSignPriorities - 1; p >= 0; p-- {
+		if len(l.queues[p]) == 0 {
+			continue
+		}
+		w := l.queues[p][0]
+		l.queues[p] = l.queues[p][1:]
+		// The slot is handed over, so the number of requests in flight is unchanged.
+		w.granted = true
+		close(w.ready)
+		return
+	}
+	l.inFlight--
+	signInFlight.Dec()
+}
+
+func (l *SignLimiter) queued() int {
+	n := 0
+	for _, q := range l.queues {
+		n += len(q)
+	}
+	return n
+}
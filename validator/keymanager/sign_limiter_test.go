@@ -0,0 +1,106 @@
+package keymanager_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+)
+
+func TestSignRequestPriority(t *testing.T) {
+	assert.Equal(t, keymanager.SignPriorityHigh, keymanager.SignRequestPriority(&validatorpb.SignRequest{Object: &validatorpb.SignRequest_BlockDeneb{}}))
+	assert.Equal(t, keymanager.SignPriorityHigh, keymanager.SignRequestPriority(&validatorpb.SignRequest{Object: &validatorpb.SignRequest_BlockElectra{}}))
+	assert.Equal(t, keymanager.SignPriorityHigh, keymanager.SignRequestPriority(&validatorpb.SignRequest{Object: &validatorpb.SignRequest_BlindedBlockElectra{}}))
+	assert.Equal(t, keymanager.SignPriorityHigh, keymanager.SignRequestPriority(&validatorpb.SignRequest{Object: &validatorpb.SignRequest_AttestationData{}}))
+	assert.Equal(t, keymanager.SignPriorityNormal, keymanager.SignRequestPriority(&validatorpb.SignRequest{Object: &validatorpb.SignRequest_AggregateAttestationAndProof{}}))
+	assert.Equal(t, keymanager.SignPriorityLow, keymanager.SignRequestPriority(&validatorpb.SignRequest{Object: &validatorpb.SignRequest_Slot{}}))
+	assert.Equal(t, keymanager.SignPriorityLow, keymanager.SignRequestPriority(&validatorpb.SignRequest{Object: &validatorpb.SignRequest_Registration{}}))
+}
+
+func TestSignLimiter_Unlimited(t *testing.T) {
+	l := keymanager.NewSignLimiter(0)
+	require.Equal(t, (*keymanager.SignLimiter)(nil), l)
+	release, err := l.Acquire(context.Background(), keymanager.SignPriorityLow)
+	require.NoError(t, err)
+	release()
+}
+
+func TestSignLimiter_BoundsInFlight(t *testing.T) {
+	const maxConcurrent, requests = 4, 500
+	l := keymanager.NewSignLimiter(maxConcurrent)
+	var inFlight, peak atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(p keymanager.SignPriority) {
+			defer wg.Done()
+			release, err := l.Acquire(context.Background(), p)
+			require.NoError(t, err)
+			n := inFlight.Add(1)
+			for {
+				old := peak.Load()
+				if n <= old || peak.CompareAndSwap(old, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			inFlight.Add(-1)
+			release()
+		}(keymanager.SignPriority(i % 3))
+	}
+	wg.Wait()
+	assert.Equal(t, true, peak.Load() <= maxConcurrent, "peak of %d requests in flight", peak.Load())
+	assert.Equal(t, int64(0), inFlight.Load())
+}
+
+func TestSignLimiter_Priority(t *testing.T) {
+	ctx := context.Background()
+	l := keymanager.NewSignLimiter(1)
+	release, err := l.Acquire(ctx, keymanager.SignPriorityLow)
+	require.NoError(t, err)
+
+	order := make(chan keymanager.SignPriority, 3)
+	var wg sync.WaitGroup
+	for _, p := range []keymanager.SignPriority{keymanager.SignPriorityLow, keymanager.SignPriorityNormal, keymanager.SignPriorityHigh} {
+		wg.Add(1)
+		go func(p keymanager.SignPriority) {
+			defer wg.Done()
+			r, err := l.Acquire(ctx, p)
+			require.NoError(t, err)
+			order <- p
+			r()
+		}(p)
+		// Queue the requests in increasing priority.
+		time.Sleep(10 * time.Millisecond)
+	}
+	release()
+	wg.Wait()
+	close(order)
+	var got []keymanager.SignPriority
+	for p := range order {
+		got = append(got, p)
+	}
+	assert.DeepEqual(t, []keymanager.SignPriority{keymanager.SignPriorityHigh, keymanager.SignPriorityNormal, keymanager.SignPriorityLow}, got)
+}
+
+func TestSignLimiter_Canceled(t *testing.T) {
+	l := keymanager.NewSignLimiter(1)
+	release, err := l.Acquire(context.Background(), keymanager.SignPriorityHigh)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.Acquire(ctx, keymanager.SignPriorityHigh)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The canceled request doesn't hold a slot.
+	release()
+	release, err = l.Acquire(context.Background(), keymanager.SignPriorityLow)
+	require.NoError(t, err)
+	release()
+}
@@ -9,6 +9,7 @@ import (
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/petnames"
 )
 
 // IKeymanager defines a general keymanager interface for Prysm wallets.
@@ -37,6 +38,12 @@ type Signer interface {
 	Sign(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
 }
 
+// BatchSigner is an optional capability of keymanagers able to sign several messages in a single request.
+// Signatures are returned in the order of the requests.
+type BatchSigner interface {
+	SignBatch(context.Context, []*validatorpb.SignRequest) ([]bls.Signature, error)
+}
+
 // Importer can import new keystores into the keymanager.
 type Importer interface {
 	ImportKeystores(
@@ -56,7 +63,7 @@ type KeyChangeSubscriber interface {
 
 // KeyStoreExtractor allows keys to be extracted from the keymanager.
 type KeyStoreExtractor interface {
-	ExtractKeystores(ctx context.Context, publicKeys []bls.PublicKey, password string) ([]*Keystore, error)
+	ExtractKeystores(ctx context.Context, publicKeys []bls.PublicKey, password string, kdf KDFConfig) ([]*Keystore, error)
 }
 
 // PublicKeyAdder allows adding public keys to the keymanager.
@@ -64,6 +71,23 @@ type PublicKeyAdder interface {
 	AddPublicKeys(publicKeys []string) ([]*KeyStatus, error)
 }
 
+// AccountNamer allows assigning names to accounts, which are stored alongside the keys
+// and take precedence over the default petnames derived from the public keys.
+type AccountNamer interface {
+	AccountName(publicKey []byte) string
+	NameAccounts(ctx context.Context, publicKeys [][]byte, template string) ([]string, error)
+	RenameAccount(ctx context.Context, publicKey []byte, name string) error
+}
+
+// AccountName returns the name of the account with the given public key: the name assigned through the
+// keymanager if it is an AccountNamer, otherwise the petname derived from the public key.
+func AccountName(km IKeymanager, publicKey []byte) string {
+	if namer, ok := km.(AccountNamer); ok {
+		return namer.AccountName(publicKey)
+	}
+	return petnames.DeterministicName(publicKey, "-")
+}
+
 // KeyStatus is a json representation of the status fields for the keymanager apis
 type KeyStatus struct {
 	Status  KeyStatusType `json:"status"`
@@ -88,6 +112,18 @@ type PublicKeyDeleter interface {
 	DeletePublicKeys(publicKeys []string) ([]*KeyStatus, error)
 }
 
+// PublicKeysRefresher allows fetching the public keys of the keymanager again from their remote source, returning
+// the keys which were added and removed.
+type PublicKeysRefresher interface {
+	RefreshPublicKeys(ctx context.Context) (added, removed [][fieldparams.BLSPubkeyLength]byte, err error)
+}
+
+// KeyLocator is implemented by keymanagers merging the keys of several wallets, to tell which wallet holds a key.
+// The index is the position of the key among the keys of that wallet.
+type KeyLocator interface {
+	LocateKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) (walletDir string, index int, ok bool)
+}
+
 type ListKeymanagerAccountConfig struct {
 	ShowPrivateKeys          bool
 	WalletAccountsDir        string
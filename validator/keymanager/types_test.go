@@ -1,13 +1,16 @@
 package keymanager_test
 
 import (
+	"context"
 	"encoding/json"
 	"strings"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/petnames"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/composite"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
@@ -16,6 +19,7 @@ import (
 var (
 	_ = keymanager.IKeymanager(&local.Keymanager{})
 	_ = keymanager.IKeymanager(&derived.Keymanager{})
+	_ = keymanager.IKeymanager(&composite.Keymanager{})
 
 	// More granular assertions.
 	_ = keymanager.KeysFetcher(&local.Keymanager{})
@@ -24,6 +28,10 @@ var (
 	_ = keymanager.Importer(&derived.Keymanager{})
 	_ = keymanager.Deleter(&local.Keymanager{})
 	_ = keymanager.Deleter(&derived.Keymanager{})
+	_ = keymanager.KeysFetcher(&composite.Keymanager{})
+	_ = keymanager.Importer(&composite.Keymanager{})
+	_ = keymanager.Deleter(&composite.Keymanager{})
+	_ = keymanager.KeyLocator(&composite.Keymanager{})
 
 	_ = keymanager.PublicKeyAdder(&remoteweb3signer.Keymanager{})
 	_ = keymanager.PublicKeyDeleter(&remoteweb3signer.Keymanager{})
@@ -36,3 +44,33 @@ func TestKeystoreContainsPath(t *testing.T) {
 	require.NoError(t, err, "Unexpected error marshalling keystore")
 	assert.Equal(t, true, strings.Contains(string(encoded), "path"))
 }
+
+// namedKeymanager is a keymanager naming a single account.
+type namedKeymanager struct {
+	keymanager.IKeymanager
+	publicKey []byte
+	name      string
+}
+
+func (km *namedKeymanager) AccountName(publicKey []byte) string {
+	if string(publicKey) == string(km.publicKey) {
+		return km.name
+	}
+	return petnames.DeterministicName(publicKey, "-")
+}
+
+func (*namedKeymanager) NameAccounts(context.Context, [][]byte, string) ([]string, error) {
+	return nil, nil
+}
+
+func (*namedKeymanager) RenameAccount(context.Context, []byte, string) error {
+	return nil
+}
+
+func TestAccountName(t *testing.T) {
+	publicKey := make([]byte, 48)
+	named := &namedKeymanager{publicKey: publicKey, name: "alice"}
+	assert.Equal(t, "alice", keymanager.AccountName(named, publicKey))
+	// Keymanagers without account names use the petname of the public key.
+	assert.Equal(t, petnames.DeterministicName(publicKey, "-"), keymanager.AccountName(&remoteweb3signer.Keymanager{}, publicKey))
+}
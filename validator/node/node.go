@@ -6,6 +6,7 @@ package node
 import (
 	"context"
 	"fmt"
+	"math"
 	"net/http"
 	"net/url"
 	"os"
@@ -49,6 +50,10 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
+// maxStartupConversionDuration is the longest the validator client converts its slashing protection
+// database for at startup. Larger databases have to be converted offline with 'validator db convert'.
+const maxStartupConversionDuration = time.Minute
+
 // ValidatorClient defines an instance of an Ethereum validator that manages
 // the entire lifecycle of services attached to it participating in proof of stake.
 type ValidatorClient struct {
@@ -106,7 +111,7 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 
 	if cliCtx.IsSet(cmd.ChainConfigFileFlag.Name) {
 		chainConfigFileName := cliCtx.String(cmd.ChainConfigFileFlag.Name)
-		if err := params.LoadChainConfigFile(chainConfigFileName, nil); err != nil {
+		if err := params.LoadChainConfigFile(chainConfigFileName, nil, cliCtx.StringSlice(cmd.ChainPresetFileFlag.Name)...); err != nil {
 			return nil, err
 		}
 	}
@@ -265,6 +270,12 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context, router *http.Se
 	if err := c.registerValidatorService(cliCtx); err != nil {
 		return err
 	}
+	if err := c.registerSlashingProtectionSnapshots(); err != nil {
+		return err
+	}
+	if err := c.registerProposerSettingsRefresher(); err != nil {
+		return err
+	}
 	if cliCtx.Bool(flags.EnableRPCFlag.Name) {
 		if err := c.registerRPCService(router); err != nil {
 			return err
@@ -305,6 +316,12 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context, router *http.Ser
 	if err := c.registerValidatorService(cliCtx); err != nil {
 		return err
 	}
+	if err := c.registerSlashingProtectionSnapshots(); err != nil {
+		return err
+	}
+	if err := c.registerProposerSettingsRefresher(); err != nil {
+		return err
+	}
 
 	if err := c.registerRPCService(router); err != nil {
 		return err
@@ -388,6 +405,20 @@ func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
 	// If a minimal database exists AND complete slashing protection is requested, convert the minimal
 	// database to a complete one and use the complete database.
 	if !isMinimalSlashingProtectionRequested && minimalDatabaseExists {
+		// Do not stall startup on converting a large database, let the user convert it offline instead.
+		size, err := db.DatabaseSize(fileSystemDataDir, db.Minimal)
+		if err != nil {
+			return errors.Wrap(err, "could not get minimal slashing protection database size")
+		}
+		if estimate := db.EstimateConversionDuration(size); estimate > maxStartupConversionDuration {
+			return errors.Errorf(
+				"complete slashing protection database requested, while minimal slashing protection database currently used. "+
+					"Converting it would take about %s, please stop the validator client and convert it offline with "+
+					"'validator db convert --to=%s --datadir=%s', or run with --%s",
+				estimate, db.Complete, fileSystemDataDir, features.EnableMinimalSlashingProtection.Name,
+			)
+		}
+
 		log.Warning("Complete slashing protection database requested, while minimal slashing protection database currently used. Converting.")
 
 		if err := db.ConvertDatabase(cliCtx.Context, fileSystemDataDir, kvDataDir, true); err != nil {
@@ -400,8 +431,9 @@ func (c *ValidatorClient) initializeDB(cliCtx *cli.Context) error {
 	if isMinimalSlashingProtectionRequested && completeDatabaseExists {
 		log.Warningf(`Minimal slashing protection database requested, while complete slashing protection database currently used.
 		Will continue to use complete slashing protection database.
-		Please convert your database by using 'validator db convert-complete-to-minimal --source-data-dir %s --target-data-dir %s'`,
-			kvDataDir, fileSystemDataDir,
+		Please convert your database by using 'validator db convert-complete-to-minimal --source-data-dir %s --target-data-dir %s'
+		Run 'validator db convert --check --datadir=%s' to estimate how long the conversion takes.`,
+			kvDataDir, fileSystemDataDir, kvDataDir,
 		)
 
 		useMinimalSlashingProtection = false
@@ -468,12 +500,13 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 
 	// Configure graffiti.
 	graffitiStruct := &g.Graffiti{}
-	if c.cliCtx.IsSet(flags.GraffitiFileFlag.Name) {
-		graffitiFilePath := c.cliCtx.String(flags.GraffitiFileFlag.Name)
-
-		graffitiStruct, err = g.ParseGraffitiFile(graffitiFilePath)
+	graffitiFilePath := c.cliCtx.String(flags.GraffitiFileFlag.Name)
+	if graffitiFilePath != "" {
+		parsed, err := g.ParseGraffitiFile(graffitiFilePath)
 		if err != nil {
 			log.WithError(err).Warn("Could not parse graffiti file")
+		} else {
+			graffitiStruct = parsed
 		}
 	}
 
@@ -487,28 +520,47 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	minBuilderBidRatio := c.cliCtx.Float64(flags.MinBuilderBidToLocalRatioFlag.Name)
+	if !(minBuilderBidRatio >= 0) || math.IsInf(minBuilderBidRatio, 1) {
+		return fmt.Errorf("--%s must be a non-negative number", flags.MinBuilderBidToLocalRatioFlag.Name)
+	}
+
 	validatorService, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
-		DB:                      c.db,
-		Wallet:                  c.wallet,
-		WalletInitializedFeed:   c.walletInitializedFeed,
-		GRPCMaxCallRecvMsgSize:  c.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
-		GRPCRetries:             c.cliCtx.Uint(flags.GRPCRetriesFlag.Name),
-		GRPCRetryDelay:          c.cliCtx.Duration(flags.GRPCRetryDelayFlag.Name),
-		GRPCHeaders:             strings.Split(c.cliCtx.String(flags.GRPCHeadersFlag.Name), ","),
-		BeaconNodeGRPCEndpoint:  c.cliCtx.String(flags.BeaconRPCProviderFlag.Name),
-		BeaconNodeCert:          c.cliCtx.String(flags.CertFlag.Name),
-		BeaconApiEndpoint:       c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
-		BeaconApiTimeout:        time.Second * 30,
-		Graffiti:                g.ParseHexGraffiti(c.cliCtx.String(flags.GraffitiFlag.Name)),
-		GraffitiStruct:          graffitiStruct,
-		InteropKmConfig:         interopKmConfig,
-		Web3SignerConfig:        web3signerConfig,
-		ProposerSettings:        ps,
-		ValidatorsRegBatchSize:  c.cliCtx.Int(flags.ValidatorsRegistrationBatchSizeFlag.Name),
-		UseWeb:                  c.cliCtx.Bool(flags.EnableWebFlag.Name),
-		LogValidatorPerformance: !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name),
-		EmitAccountMetrics:      !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name),
-		Distributed:             c.cliCtx.Bool(flags.EnableDistributed.Name),
+		DB:                           c.db,
+		Wallet:                       c.wallet,
+		WalletInitializedFeed:        c.walletInitializedFeed,
+		GRPCMaxCallRecvMsgSize:       c.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		GRPCRetries:                  c.cliCtx.Uint(flags.GRPCRetriesFlag.Name),
+		GRPCRetryDelay:               c.cliCtx.Duration(flags.GRPCRetryDelayFlag.Name),
+		GRPCHeaders:                  strings.Split(c.cliCtx.String(flags.GRPCHeadersFlag.Name), ","),
+		BeaconNodeGRPCEndpoint:       c.cliCtx.String(flags.BeaconRPCProviderFlag.Name),
+		BeaconNodeCert:               c.cliCtx.String(flags.CertFlag.Name),
+		BeaconApiEndpoint:            c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		BeaconApiTimeout:             time.Second * 30,
+		BeaconApiRetries:             c.cliCtx.Int(flags.BeaconRESTApiRetriesFlag.Name),
+		BeaconApiRetryDelay:          c.cliCtx.Duration(flags.BeaconRESTApiRetryDelayFlag.Name),
+		BeaconApiHedgeEndpoint:       c.cliCtx.String(flags.BeaconRESTApiHedgeProviderFlag.Name),
+		BeaconApiHedgeDelay:          c.cliCtx.Duration(flags.BeaconRESTApiHedgeDelayFlag.Name),
+		Graffiti:                     g.ParseHexGraffiti(c.cliCtx.String(flags.GraffitiFlag.Name)),
+		GraffitiStruct:               graffitiStruct,
+		GraffitiFilePath:             graffitiFilePath,
+		InteropKmConfig:              interopKmConfig,
+		Web3SignerConfig:             web3signerConfig,
+		ProposerSettings:             ps,
+		ValidatorsRegBatchSize:       c.cliCtx.Int(flags.ValidatorsRegistrationBatchSizeFlag.Name),
+		UseWeb:                       c.cliCtx.Bool(flags.EnableWebFlag.Name),
+		LogValidatorPerformance:      !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name),
+		EmitAccountMetrics:           !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name),
+		EstimateRewards:              c.cliCtx.Bool(flags.EnableRewardsEstimationFlag.Name),
+		Distributed:                  c.cliCtx.Bool(flags.EnableDistributed.Name),
+		AcceptGenesisChange:          c.cliCtx.Bool(flags.AcceptGenesisChangeFlag.Name),
+		BlockRequestAttempts:         c.cliCtx.Int(flags.BlockRequestAttemptsFlag.Name),
+		LocalBlockFallback:           c.cliCtx.Bool(flags.LocalBlockFallbackFlag.Name),
+		MinBuilderBid:                c.cliCtx.Uint64(flags.MinBuilderBidFlag.Name),
+		MinBuilderBidToLocalRatio:    minBuilderBidRatio,
+		BlockPublishEndpoints:        c.cliCtx.StringSlice(flags.BlockPublishEndpointsFlag.Name),
+		BlindedBlockPublishEndpoints: c.cliCtx.StringSlice(flags.BlindedBlockPublishEndpointsFlag.Name),
+		BlockPublishTimeout:          c.cliCtx.Duration(flags.BlockPublishTimeoutFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -529,8 +581,9 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 			return nil, fmt.Errorf("web3signer url must be in the format of http(s)://host:port url used: %v", urlStr)
 		}
 		web3signerConfig = &remoteweb3signer.SetupConfig{
-			BaseEndpoint:          u.String(),
-			GenesisValidatorsRoot: nil,
+			BaseEndpoint:              u.String(),
+			GenesisValidatorsRoot:     nil,
+			MaxConcurrentSignRequests: cliCtx.Int(flags.Web3SignerMaxConcurrentRequestsFlag.Name),
 		}
 		if cliCtx.IsSet(flags.WalletPasswordFileFlag.Name) {
 			log.Warnf("%s was provided while using web3signer and will be ignored", flags.WalletPasswordFileFlag.Name)
@@ -541,6 +594,7 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 				pURL, err := url.ParseRequestURI(publicKeysSlice[0])
 				if err == nil && pURL.Scheme != "" && pURL.Host != "" {
 					web3signerConfig.PublicKeysURL = publicKeysSlice[0]
+					web3signerConfig.PublicKeysURLRefreshInterval = cliCtx.Duration(flags.Web3SignerPublicKeysRefreshIntervalFlag.Name)
 				} else {
 					web3signerConfig.ProvidedPublicKeys = strings.Split(publicKeysSlice[0], ",")
 				}
@@ -568,6 +622,56 @@ func proposerSettings(cliCtx *cli.Context, db iface.ValidatorDB) (*proposer.Sett
 	return l.Load(cliCtx)
 }
 
+// registerSlashingProtectionSnapshots registers the service periodically exporting the slashing protection
+// history, when a snapshots directory is set.
+func (c *ValidatorClient) registerSlashingProtectionSnapshots() error {
+	dir := c.cliCtx.String(flags.SlashingProtectionSnapshotsDirFlag.Name)
+	if dir == "" {
+		return nil
+	}
+	s, err := client.NewSlashingProtectionSnapshots(c.cliCtx.Context, &client.SlashingProtectionSnapshotsConfig{
+		DB:       c.db,
+		Dir:      dir,
+		Interval: c.cliCtx.Duration(flags.SlashingProtectionSnapshotsIntervalFlag.Name),
+		Keep:     c.cliCtx.Int(flags.SlashingProtectionSnapshotsKeepFlag.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize slashing protection snapshots")
+	}
+	return c.services.RegisterService(s)
+}
+
+// registerProposerSettingsRefresher registers the service reloading the proposer settings when the proposer settings
+// file changes, or periodically from the proposer settings URL when a refresh interval is set.
+func (c *ValidatorClient) registerProposerSettingsRefresher() error {
+	file := c.cliCtx.String(flags.ProposerSettingsFlag.Name)
+	url := c.cliCtx.String(flags.ProposerSettingsURLFlag.Name)
+	interval := c.cliCtx.Duration(flags.ProposerSettingsRefreshIntervalFlag.Name)
+	if url != "" && interval <= 0 {
+		url = ""
+	}
+	if file == "" && url == "" {
+		return nil
+	}
+	var vs *client.ValidatorService
+	if err := c.services.FetchService(&vs); err != nil {
+		return err
+	}
+	r, err := client.NewProposerSettingsRefresher(c.cliCtx.Context, &client.ProposerSettingsRefresherConfig{
+		ValidatorService: vs,
+		Load: func() (*proposer.Settings, error) {
+			return proposerSettings(c.cliCtx, c.db)
+		},
+		File:     file,
+		URL:      url,
+		Interval: interval,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not initialize proposer settings refresher")
+	}
+	return c.services.RegisterService(r)
+}
+
 func (c *ValidatorClient) registerRPCService(router *http.ServeMux) error {
 	var vs *client.ValidatorService
 	if err := c.services.FetchService(&vs); err != nil {
@@ -575,6 +679,10 @@ func (c *ValidatorClient) registerRPCService(router *http.ServeMux) error {
 	}
 	authTokenPath := c.cliCtx.String(flags.AuthTokenPathFlag.Name)
 	walletDir := c.cliCtx.String(flags.WalletDirFlag.Name)
+	// the auth token of several wallets is kept in the first one
+	if dirs := wallet.Dirs(walletDir); len(dirs) > 1 {
+		walletDir = dirs[0]
+	}
 	// if no auth token path flag was passed try to set a default value
 	if authTokenPath == "" {
 		authTokenPath = flags.AuthTokenPathFlag.Value
@@ -619,6 +727,9 @@ func (c *ValidatorClient) registerRPCService(router *http.ServeMux) error {
 		WalletInitializedFeed:  c.walletInitializedFeed,
 		ValidatorService:       vs,
 		AuthTokenPath:          authTokenPath,
+		ProposerSettingsFile:   c.cliCtx.String(flags.ProposerSettingsFlag.Name),
+		ProposerSettingsURL:    c.cliCtx.String(flags.ProposerSettingsURLFlag.Name),
+		AllowBurnFeeRecipient:  c.cliCtx.Bool(flags.SuggestedFeeRecipientIsBurnOkFlag.Name),
 		Middlewares:            middlewares,
 		Router:                 router,
 	})
@@ -627,6 +738,10 @@ func (c *ValidatorClient) registerRPCService(router *http.ServeMux) error {
 
 func setWalletPasswordFilePath(cliCtx *cli.Context) error {
 	walletDir := cliCtx.String(flags.WalletDirFlag.Name)
+	if len(wallet.Dirs(walletDir)) > 1 {
+		// Several wallets read their passwords from the wallet password file, the keyring or the prompt.
+		return nil
+	}
 	defaultWalletPasswordFilePath := filepath.Join(walletDir, wallet.DefaultWalletPasswordFile)
 	exists, err := file.Exists(defaultWalletPasswordFilePath, file.Regular)
 	if err != nil {
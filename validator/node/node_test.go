@@ -213,6 +213,7 @@ func TestWeb3SignerConfig(t *testing.T) {
 		baseURL          string
 		publicKeysOrURLs []string
 		persistentFile   string
+		maxConcurrent    int
 	}
 	tests := []struct {
 		name       string
@@ -280,6 +281,17 @@ func TestWeb3SignerConfig(t *testing.T) {
 				KeyFilePath:  "/remote/key/file.txt",
 			},
 		},
+		{
+			name: "happy path with max concurrent requests",
+			args: &args{
+				baseURL:       "http://localhost:8545",
+				maxConcurrent: 8,
+			},
+			want: &remoteweb3signer.SetupConfig{
+				BaseEndpoint:              "http://localhost:8545",
+				MaxConcurrentSignRequests: 8,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -287,6 +299,7 @@ func TestWeb3SignerConfig(t *testing.T) {
 			set := flag.NewFlagSet(tt.name, 0)
 			set.String("validators-external-signer-url", tt.args.baseURL, "baseUrl")
 			set.String(flags.Web3SignerKeyFileFlag.Name, "", "")
+			set.Int(flags.Web3SignerMaxConcurrentRequestsFlag.Name, tt.args.maxConcurrent, "")
 			c := &cli.StringSliceFlag{
 				Name: "validators-external-signer-public-keys",
 			}
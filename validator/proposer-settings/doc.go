@@ -0,0 +1,5 @@
+// Package proposersettings exports the effective proposer settings of a validator client, including
+// the overrides made at runtime through the keymanager API and persisted in the validator database,
+// into a normalized JSON document, and imports such a document back into a validator database.
+// This makes it possible to restore the proposer settings of a validator client after losing its database.
+package proposersettings
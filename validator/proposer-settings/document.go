@@ -0,0 +1,94 @@
+package proposersettings
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+)
+
+// Version of the proposer settings export format.
+const Version = 1
+
+// Provenance describes where an entry of the exported proposer settings comes from.
+type Provenance string
+
+const (
+	// File entries are identical to the ones in the proposer settings file.
+	File Provenance = "file"
+	// URL entries are identical to the ones served from the proposer settings URL.
+	URL Provenance = "url"
+	// Database entries are only found in the validator database, where the overrides made
+	// through the keymanager API are persisted.
+	Database Provenance = "db"
+)
+
+// Document is a normalized export of the proposer settings of a validator client.
+// Its JSON encoding is a superset of the proposer settings file format, so it can
+// also be used as a --proposer-settings-file.
+type Document struct {
+	Version        int               `json:"version"`
+	ProposerConfig map[string]*Entry `json:"proposer_config,omitempty"`
+	DefaultConfig  *Entry            `json:"default_config,omitempty"`
+}
+
+// Entry is the proposer settings of a single validator, or the default ones, along with their provenance.
+type Entry struct {
+	FeeRecipient string                  `json:"fee_recipient,omitempty"`
+	Builder      *proposer.BuilderConfig `json:"builder,omitempty"`
+	Graffiti     *string                 `json:"graffiti,omitempty"`
+	Provenance   Provenance              `json:"provenance"`
+}
+
+func newEntry(o *proposer.Option, p Provenance) *Entry {
+	if o == nil {
+		return nil
+	}
+	e := &Entry{Provenance: p}
+	if o.FeeRecipientConfig != nil {
+		e.FeeRecipient = o.FeeRecipientConfig.FeeRecipient.Hex()
+	}
+	if o.BuilderConfig != nil {
+		e.Builder = o.BuilderConfig.Clone()
+	}
+	if o.GraffitiConfig != nil {
+		graffiti := o.GraffitiConfig.Graffiti
+		e.Graffiti = &graffiti
+	}
+	return e
+}
+
+func (e *Entry) toConsensus() *validatorpb.ProposerOptionPayload {
+	if e == nil {
+		return nil
+	}
+	return &validatorpb.ProposerOptionPayload{
+		FeeRecipient: e.FeeRecipient,
+		Builder:      e.Builder.ToConsensus(),
+		Graffiti:     e.Graffiti,
+	}
+}
+
+// Settings converts the document to the proposer settings it describes, dropping the provenance annotations.
+func (d *Document) Settings() (*proposer.Settings, error) {
+	if d == nil {
+		return nil, errors.New("proposer settings document is nil")
+	}
+	if d.Version != Version {
+		return nil, errors.Errorf("unsupported proposer settings document version %d, expected %d", d.Version, Version)
+	}
+	payload := &validatorpb.ProposerSettingsPayload{DefaultConfig: d.DefaultConfig.toConsensus()}
+	if len(d.ProposerConfig) != 0 {
+		payload.ProposerConfig = make(map[string]*validatorpb.ProposerOptionPayload, len(d.ProposerConfig))
+		for key, e := range d.ProposerConfig {
+			if e == nil {
+				return nil, errors.Errorf("proposer settings of %s are empty", key)
+			}
+			payload.ProposerConfig[key] = e.toConsensus()
+		}
+	}
+	settings, err := proposer.SettingFromConsensus(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid proposer settings")
+	}
+	return settings, nil
+}
@@ -0,0 +1,105 @@
+package proposersettings
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+	"google.golang.org/protobuf/proto"
+)
+
+// Source is the proposer settings a validator client is started with, either from a file or from a URL.
+type Source struct {
+	Provenance Provenance
+	Settings   *proposer.Settings
+}
+
+// LoadSource loads the proposer settings from the file at path or, if path is empty, from the URL.
+// It returns nil if both are empty.
+func LoadSource(ctx context.Context, path, url string) (*Source, error) {
+	var (
+		payload    *validatorpb.ProposerSettingsPayload
+		provenance Provenance
+	)
+	switch {
+	case path != "":
+		if err := config.UnmarshalFromFile(path, &payload); err != nil {
+			return nil, errors.Wrapf(err, "could not read proposer settings file %s", path)
+		}
+		provenance = File
+	case url != "":
+		if err := config.UnmarshalFromURL(ctx, url, &payload); err != nil {
+			return nil, errors.Wrapf(err, "could not fetch proposer settings from %s", url)
+		}
+		provenance = URL
+	default:
+		return nil, nil
+	}
+	if payload == nil {
+		return nil, errors.New("proposer settings are empty")
+	}
+	settings, err := proposer.SettingFromConsensus(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid proposer settings")
+	}
+	return &Source{Provenance: provenance, Settings: settings}, nil
+}
+
+// Export returns the effective proposer settings of a validator client: the ones persisted in the
+// validator database if any, otherwise the ones of the source. Each entry is annotated with the
+// provenance of the source if it is identical to the entry of the source, and as coming from the
+// database otherwise. The source may be nil.
+func Export(ctx context.Context, validatorDB iface.ValidatorDB, source *Source) (*Document, error) {
+	exists, err := validatorDB.ProposerSettingsExists(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check if proposer settings exist in the database")
+	}
+
+	var settings *proposer.Settings
+	switch {
+	case exists:
+		settings, err = validatorDB.ProposerSettings(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get proposer settings from the database")
+		}
+	case source != nil:
+		settings = source.Settings
+	default:
+		return nil, errors.New("no proposer settings found in the database, a proposer settings file or URL")
+	}
+
+	doc := &Document{
+		Version:       Version,
+		DefaultConfig: newEntry(settings.DefaultConfig, source.provenance(nil, settings.DefaultConfig)),
+	}
+	if len(settings.ProposeConfig) != 0 {
+		doc.ProposerConfig = make(map[string]*Entry, len(settings.ProposeConfig))
+		for key, option := range settings.ProposeConfig {
+			if option == nil {
+				continue
+			}
+			doc.ProposerConfig[hexutil.Encode(key[:])] = newEntry(option, source.provenance(&key, option))
+		}
+	}
+	return doc, nil
+}
+
+// provenance of the option of a validator, or of the default option if key is nil.
+func (s *Source) provenance(key *[fieldparams.BLSPubkeyLength]byte, option *proposer.Option) Provenance {
+	if s == nil || s.Settings == nil {
+		return Database
+	}
+	sourceOption := s.Settings.DefaultConfig
+	if key != nil {
+		sourceOption = s.Settings.ProposeConfig[*key]
+	}
+	if sourceOption == nil || !proto.Equal(sourceOption.ToConsensus(), option.ToConsensus()) {
+		return Database
+	}
+	return s.Provenance
+}
@@ -0,0 +1,120 @@
+package proposersettings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+)
+
+func testSettings() (*proposer.Settings, [][fieldparams.BLSPubkeyLength]byte) {
+	keys := make([][fieldparams.BLSPubkeyLength]byte, 3)
+	for i := range keys {
+		keys[i][0] = byte(i + 1)
+	}
+	graffiti := "from file"
+	settings := &proposer.Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+			keys[0]: {
+				FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")},
+				BuilderConfig:      &proposer.BuilderConfig{Enabled: true, GasLimit: 30000000, Relays: []string{"https://relay.example"}},
+			},
+			keys[1]: {
+				FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")},
+				GraffitiConfig:     &proposer.GraffitiConfig{Graffiti: graffiti},
+			},
+		},
+		DefaultConfig: &proposer.Option{
+			FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")},
+			BuilderConfig:      &proposer.BuilderConfig{Enabled: false, GasLimit: validator.Uint64(36000000)},
+		},
+	}
+	return settings, keys
+}
+
+func TestExportImport_RoundTrip(t *testing.T) {
+	for _, minimal := range []bool{false, true} {
+		t.Run(fmt.Sprintf("minimal:%v", minimal), func(t *testing.T) {
+			ctx := context.Background()
+			fileSettings, keys := testSettings()
+
+			// The database holds the settings of the file, with API overrides for one key and a key not in the file.
+			dbSettings := fileSettings.Clone()
+			dbSettings.ProposeConfig[keys[1]].GraffitiConfig = &proposer.GraffitiConfig{Graffiti: "from api"}
+			dbSettings.ProposeConfig[keys[2]] = &proposer.Option{
+				FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress("0x9c6d0a30faB2b8E3bA0A1B6b4f7b7bE2ab7D8E01")},
+			}
+			source := dbtest.SetupDB(t, nil, minimal)
+			require.NoError(t, source.SaveProposerSettings(ctx, dbSettings))
+
+			doc, err := Export(ctx, source, &Source{Provenance: File, Settings: fileSettings})
+			require.NoError(t, err)
+			require.Equal(t, Version, doc.Version)
+			require.Equal(t, 3, len(doc.ProposerConfig))
+			require.Equal(t, File, doc.ProposerConfig[hexutil.Encode(keys[0][:])].Provenance)
+			require.Equal(t, Database, doc.ProposerConfig[hexutil.Encode(keys[1][:])].Provenance)
+			require.Equal(t, Database, doc.ProposerConfig[hexutil.Encode(keys[2][:])].Provenance)
+			require.Equal(t, File, doc.DefaultConfig.Provenance)
+
+			encoded, err := json.MarshalIndent(doc, "", "\t")
+			require.NoError(t, err)
+			decoded := &Document{}
+			require.NoError(t, json.Unmarshal(encoded, decoded))
+
+			want, err := source.ProposerSettings(ctx)
+			require.NoError(t, err)
+			// Only one bolt database can be open at a time, as each registers the same metrics collector.
+			require.NoError(t, source.Close())
+
+			restored := dbtest.SetupDB(t, nil, minimal)
+			require.NoError(t, Import(ctx, restored, decoded))
+			got, err := restored.ProposerSettings(ctx)
+			require.NoError(t, err)
+			require.Equal(t, len(want.ProposeConfig), len(got.ProposeConfig))
+			for key, option := range want.ProposeConfig {
+				require.DeepEqual(t, option, got.ProposeConfig[key])
+			}
+			require.DeepEqual(t, want.DefaultConfig, got.DefaultConfig)
+		})
+	}
+}
+
+func TestExport_FromSource(t *testing.T) {
+	ctx := context.Background()
+	db := dbtest.SetupDB(t, nil, false)
+
+	_, err := Export(ctx, db, nil)
+	require.ErrorContains(t, "no proposer settings found", err)
+
+	settings, keys := testSettings()
+	encoded, err := json.Marshal(settings.ToConsensus())
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "proposer-settings.json")
+	require.NoError(t, file.WriteFile(path, encoded))
+
+	source, err := LoadSource(ctx, path, "")
+	require.NoError(t, err)
+	doc, err := Export(ctx, db, source)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(doc.ProposerConfig))
+	for _, key := range keys[:2] {
+		require.Equal(t, File, doc.ProposerConfig[hexutil.Encode(key[:])].Provenance)
+	}
+	require.Equal(t, File, doc.DefaultConfig.Provenance)
+}
+
+func TestImport_UnsupportedVersion(t *testing.T) {
+	db := dbtest.SetupDB(t, nil, false)
+	err := Import(context.Background(), db, &Document{Version: Version + 1})
+	require.ErrorContains(t, "unsupported proposer settings document version", err)
+}
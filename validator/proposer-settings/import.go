@@ -0,0 +1,23 @@
+package proposersettings
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/iface"
+)
+
+// Import saves the proposer settings of the document to the validator database, replacing the existing ones.
+func Import(ctx context.Context, validatorDB iface.ValidatorDB, doc *Document) error {
+	settings, err := doc.Settings()
+	if err != nil {
+		return err
+	}
+	if !settings.ShouldBeSaved() {
+		return errors.New("proposer settings document has neither proposer config nor default fee recipient")
+	}
+	if err := validatorDB.SaveProposerSettings(ctx, settings); err != nil {
+		return errors.Wrap(err, "could not save proposer settings to the database")
+	}
+	return nil
+}
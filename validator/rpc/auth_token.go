@@ -36,6 +36,22 @@ func CreateAuthToken(authPath, validatorWebAddr string) error {
 	return nil
 }
 
+// ReadAuthToken returns the auth token stored in the file at the given path, such as the one
+// written by CreateAuthToken or on launch of the validator client.
+func ReadAuthToken(authPath string) (string, error) {
+	f, err := os.Open(filepath.Clean(authPath))
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error(err)
+		}
+	}()
+	_, token, err := readAuthTokenFile(f)
+	return token, err
+}
+
 // Upon launch of the validator client, we initialize an auth token by either creating
 // one from scratch or reading it from a file. This token can then be shown to the
 // user via stdout and the validator client should then attempt to open the default
@@ -21,7 +21,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
-	"github.com/prysmaticlabs/prysm/v5/validator/accounts/petnames"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/derived"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
@@ -75,14 +74,19 @@ func (s *Server) ListAccounts(w http.ResponseWriter, r *http.Request) {
 		httputil.HandleError(w, errors.Errorf("Could not retrieve public keys: %v", err).Error(), http.StatusInternalServerError)
 		return
 	}
+	locator, hasWallets := km.(keymanager.KeyLocator)
 	accs := make([]*Account, len(keys))
 	for i := 0; i < len(keys); i++ {
 		accs[i] = &Account{
 			ValidatingPublicKey: hexutil.Encode(keys[i][:]),
-			AccountName:         petnames.DeterministicName(keys[i][:], "-"),
+			AccountName:         keymanager.AccountName(km, keys[i][:]),
+		}
+		index := i
+		if hasWallets {
+			accs[i].Wallet, index, _ = locator.LocateKey(ctx, keys[i])
 		}
 		if s.wallet.KeymanagerKind() == keymanager.Derived {
-			accs[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
+			accs[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, index)
 		}
 	}
 	if r.URL.Query().Get("all") == "true" {
@@ -162,13 +166,13 @@ func (s *Server) BackupAccounts(w http.ResponseWriter, r *http.Request) {
 	var keystoresToBackup []*keymanager.Keystore
 	switch km := km.(type) {
 	case *local.Keymanager:
-		keystoresToBackup, err = km.ExtractKeystores(ctx, pubKeys, req.BackupPassword)
+		keystoresToBackup, err = km.ExtractKeystores(ctx, pubKeys, req.BackupPassword, keymanager.KDFConfig{})
 		if err != nil {
 			httputil.HandleError(w, errors.Wrap(err, "Could not backup accounts for local keymanager").Error(), http.StatusInternalServerError)
 			return
 		}
 	case *derived.Keymanager:
-		keystoresToBackup, err = km.ExtractKeystores(ctx, pubKeys, req.BackupPassword)
+		keystoresToBackup, err = km.ExtractKeystores(ctx, pubKeys, req.BackupPassword, keymanager.KDFConfig{})
 		if err != nil {
 			httputil.HandleError(w, errors.Wrap(err, "Could not backup accounts for derived keymanager").Error(), http.StatusInternalServerError)
 			return
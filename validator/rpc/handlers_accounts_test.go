@@ -256,6 +256,10 @@ func TestServer_VoluntaryExit(t *testing.T) {
 		Genesis(gomock.Any(), gomock.Any()).
 		Return(&ethpb.Genesis{GenesisTime: genesisTime}, nil)
 
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		Return(&ethpb.MultipleValidatorStatusResponse{}, nil)
+
 	mockValidatorClient.EXPECT().
 		DomainData(gomock.Any(), gomock.Any()).
 		Times(2).
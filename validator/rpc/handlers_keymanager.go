@@ -58,13 +58,18 @@ func (s *Server) ListKeystores(w http.ResponseWriter, r *http.Request) {
 		httputil.HandleError(w, errors.Wrap(err, "Could not retrieve keystores").Error(), http.StatusInternalServerError)
 		return
 	}
+	locator, hasWallets := km.(keymanager.KeyLocator)
 	keystoreResponse := make([]*Keystore, len(pubKeys))
 	for i := 0; i < len(pubKeys); i++ {
 		keystoreResponse[i] = &Keystore{
 			ValidatingPubkey: hexutil.Encode(pubKeys[i][:]),
 		}
+		index := i
+		if hasWallets {
+			keystoreResponse[i].Wallet, index, _ = locator.LocateKey(ctx, pubKeys[i])
+		}
 		if s.wallet.KeymanagerKind() == keymanager.Derived {
-			keystoreResponse[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
+			keystoreResponse[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, index)
 		}
 	}
 	response := &ListKeystoresResponse{
@@ -204,24 +209,43 @@ func (s *Server) DeleteKeystores(w http.ResponseWriter, r *http.Request) {
 	}
 	deleter, ok := km.(keymanager.Deleter)
 	if !ok {
-		sts := make([]*keymanager.KeyStatus, len(req.Pubkeys))
-		for i := 0; i < len(req.Pubkeys); i++ {
-			sts[i] = &keymanager.KeyStatus{
-				Status:  keymanager.StatusError,
-				Message: fmt.Sprintf("Keymanager kind %T cannot delete local keys", km),
-			}
-		}
-		httputil.WriteJson(w, &DeleteKeystoresResponse{Data: sts})
+		writeDeleteKeystoresErrors(w, len(req.Pubkeys), fmt.Sprintf("Keymanager kind %T cannot delete local keys", km))
+		return
+	}
+	if s.wallet != nil && s.wallet.KeymanagerKind() == keymanager.Web3Signer {
+		writeDeleteKeystoresErrors(
+			w,
+			len(req.Pubkeys),
+			"Wrong wallet type: web3-signer. Only Imported or Derived wallets can delete accounts, use the remote keys API to delete remote keys",
+		)
 		return
 	}
 	bytePubKeys := make([][]byte, len(req.Pubkeys))
+	lockKeys := make([][fieldparams.BLSPubkeyLength]byte, len(req.Pubkeys))
 	for i, pubkey := range req.Pubkeys {
 		key, ok := shared.ValidateHex(w, fmt.Sprintf("pubkeys[%d]", i), pubkey, fieldparams.BLSPubkeyLength)
 		if !ok {
 			return
 		}
 		bytePubKeys[i] = key
+		lockKeys[i] = bytesutil.ToBytes48(key)
+	}
+
+	// Prevent the keys from signing anything until they are deleted, so that the exported
+	// slashing protection history is complete.
+	lock := client.NewSigningLock(lockKeys...)
+	lock.Lock()
+	defer lock.Unlock()
+
+	// Export the slashing protection history before deleting anything, so that keys are never
+	// deleted without their history being returned.
+	exportedHistory, err := slashingprotection.ExportStandardProtectionJSON(ctx, s.db, bytePubKeys...)
+	if err != nil {
+		log.WithError(err).Warn("Could not get slashing protection history for keys to delete")
+		writeDeleteKeystoresErrors(w, len(req.Pubkeys), "Could not export slashing protection history, no keys were deleted")
+		return
 	}
+
 	statuses, err := deleter.DeleteKeystores(ctx, bytePubKeys)
 	if err != nil {
 		httputil.HandleError(w, errors.Wrap(err, "Could not delete keys").Error(), http.StatusInternalServerError)
@@ -234,17 +258,9 @@ func (s *Server) DeleteKeystores(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	exportedHistory, err := s.slashingProtectionHistoryForDeletedKeys(ctx, bytePubKeys, statuses)
+	exportedHistory, err = slashingProtectionHistoryForDeletedKeys(exportedHistory, bytePubKeys, statuses)
 	if err != nil {
-		log.WithError(err).Warn("Could not get slashing protection history for deleted keys")
-		sts := make([]*keymanager.KeyStatus, len(req.Pubkeys))
-		for i := 0; i < len(req.Pubkeys); i++ {
-			sts[i] = &keymanager.KeyStatus{
-				Status:  keymanager.StatusError,
-				Message: "Could not export slashing protection history as existing non duplicate keys were deleted",
-			}
-		}
-		httputil.WriteJson(w, &DeleteKeystoresResponse{Data: sts})
+		httputil.HandleError(w, errors.Wrap(err, "Could not filter slashing protection history").Error(), http.StatusInternalServerError)
 		return
 	}
 	jsonHist, err := json.Marshal(exportedHistory)
@@ -301,19 +317,43 @@ func (s *Server) publicKeysInDB(ctx context.Context) (map[[fieldparams.BLSPubkey
 
 // Exports slashing protection data for a list of DELETED or NOT_ACTIVE keys only to be used
 // as part of the DeleteKeystores endpoint.
-func (s *Server) slashingProtectionHistoryForDeletedKeys(
-	ctx context.Context, pubKeys [][]byte, statuses []*keymanager.KeyStatus,
+func slashingProtectionHistoryForDeletedKeys(
+	history *format.EIPSlashingProtectionFormat, pubKeys [][]byte, statuses []*keymanager.KeyStatus,
 ) (*format.EIPSlashingProtectionFormat, error) {
 	// We select the keys that were DELETED or NOT_ACTIVE from the previous action
 	// and use that to filter our slashing protection export.
-	filteredKeys := make([][]byte, 0, len(pubKeys))
+	filteredKeys := make(map[string]bool, len(pubKeys))
 	for i, pk := range pubKeys {
 		if statuses[i].Status == keymanager.StatusDeleted ||
 			statuses[i].Status == keymanager.StatusNotActive {
-			filteredKeys = append(filteredKeys, pk)
+			filteredKeys[hexutil.Encode(pk)] = true
 		}
 	}
-	return slashingprotection.ExportStandardProtectionJSON(ctx, s.db, filteredKeys...)
+	filtered := &format.EIPSlashingProtectionFormat{
+		Metadata: history.Metadata,
+		Data:     make([]*format.ProtectionData, 0, len(filteredKeys)),
+	}
+	for _, data := range history.Data {
+		pk, err := hexutil.Decode(data.Pubkey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", data.Pubkey)
+		}
+		if filteredKeys[hexutil.Encode(pk)] {
+			filtered.Data = append(filtered.Data, data)
+		}
+	}
+	return filtered, nil
+}
+
+func writeDeleteKeystoresErrors(w http.ResponseWriter, numKeys int, message string) {
+	sts := make([]*keymanager.KeyStatus, numKeys)
+	for i := 0; i < numKeys; i++ {
+		sts[i] = &keymanager.KeyStatus{
+			Status:  keymanager.StatusError,
+			Message: message,
+		}
+	}
+	httputil.WriteJson(w, &DeleteKeystoresResponse{Data: sts})
 }
 
 // SetVoluntaryExit creates a signed voluntary exit message and returns a VoluntaryExit object.
@@ -546,6 +586,52 @@ func (s *Server) DeleteRemoteKeys(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJson(w, RemoteKeysResponse{Data: data})
 }
 
+// RefreshRemoteKeys fetches the public keys of the web3signer keymanager again from the remote signer, picking up the
+// keys added to or removed from it without a restart.
+func (s *Server) RefreshRemoteKeys(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.RefreshRemoteKeys")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	if !s.walletInitialized {
+		httputil.HandleError(w, "Prysm Wallet not initialized. Please create a new wallet.", http.StatusServiceUnavailable)
+		return
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if s.wallet.KeymanagerKind() != keymanager.Web3Signer {
+		httputil.HandleError(w, "Prysm Wallet is not of type Web3Signer. Please execute validator client with web3signer flags.", http.StatusInternalServerError)
+		return
+	}
+	refresher, ok := km.(keymanager.PublicKeysRefresher)
+	if !ok {
+		httputil.HandleError(w, "Keymanager kind cannot refresh public keys.", http.StatusInternalServerError)
+		return
+	}
+	added, removed, err := refresher.RefreshPublicKeys(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not refresh remote keys: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &RefreshRemoteKeysResponse{
+		Added:   make([]string, len(added)),
+		Removed: make([]string, len(removed)),
+	}
+	for i, k := range added {
+		resp.Added[i] = hexutil.Encode(k[:])
+	}
+	for i, k := range removed {
+		resp.Removed[i] = hexutil.Encode(k[:])
+	}
+	httputil.WriteJson(w, resp)
+}
+
 // ListFeeRecipientByPubkey returns the public key to eth address mapping object to the end user.
 func (s *Server) ListFeeRecipientByPubkey(w http.ResponseWriter, r *http.Request) {
 	_, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ListFeeRecipientByPubkey")
@@ -617,7 +703,20 @@ func (s *Server) SetFeeRecipientByPubkey(w http.ResponseWriter, r *http.Request)
 		return
 	}
 	feeRecipient := common.BytesToAddress(ethAddress)
-	settings := s.validatorService.ProposerSettings()
+	if feeRecipient == common.HexToAddress(params.BeaconConfig().EthBurnAddressHex) && !s.allowBurnFeeRecipient {
+		httputil.HandleError(
+			w,
+			fmt.Sprintf("Fee recipient %s is the burn address, restart the validator client with --%s to allow it", feeRecipient.Hex(), flags.SuggestedFeeRecipientIsBurnOkFlag.Name),
+			http.StatusBadRequest,
+		)
+		return
+	}
+	if s.db == nil {
+		httputil.HandleError(w, "Could not find validator database", http.StatusInternalServerError)
+		return
+	}
+	// Update a copy of the settings, which replaces the settings in use once saved.
+	settings := s.validatorService.ProposerSettings().Clone()
 	switch {
 	case settings == nil:
 		settings = &proposer.Settings{
@@ -663,6 +762,11 @@ func (s *Server) SetFeeRecipientByPubkey(w http.ResponseWriter, r *http.Request)
 			}
 		}
 	}
+	// persist the fee recipient so that it takes precedence over file or URL settings on restart
+	if err := s.db.SaveFeeRecipientOverride(ctx, bytesutil.ToBytes48(pubkey), feeRecipient); err != nil {
+		httputil.HandleError(w, "Could not save fee recipient: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
 	// save the settings
 	if err := s.validatorService.SetProposerSettings(ctx, settings); err != nil {
 		httputil.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
@@ -686,14 +790,22 @@ func (s *Server) DeleteFeeRecipientByPubkey(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	settings := s.validatorService.ProposerSettings()
+	// Update a copy of the settings, which replaces the settings in use once saved.
+	settings := s.validatorService.ProposerSettings().Clone()
 	if settings != nil && settings.ProposeConfig != nil {
 		proposerOption, found := settings.ProposeConfig[bytesutil.ToBytes48(pubkey)]
-		if found {
+		if found && proposerOption != nil {
 			proposerOption.FeeRecipientConfig = nil
 		}
 	}
 
+	if s.db != nil {
+		if err := s.db.DeleteFeeRecipientOverride(ctx, bytesutil.ToBytes48(pubkey)); err != nil {
+			httputil.HandleError(w, "Could not delete fee recipient: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
 	// save the settings
 	if err := s.validatorService.SetProposerSettings(ctx, settings); err != nil {
 		httputil.HandleError(w, "Could not set proposer settings: "+err.Error(), http.StatusInternalServerError)
@@ -863,7 +975,7 @@ func (s *Server) GetGraffiti(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	graffiti, err := s.validatorService.Graffiti(ctx, bytesutil.ToBytes48(pubkey))
+	graffiti, source, err := s.validatorService.GraffitiWithSource(ctx, bytesutil.ToBytes48(pubkey))
 	if err != nil {
 		if strings.Contains(err.Error(), "unavailable") {
 			httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
@@ -876,7 +988,8 @@ func (s *Server) GetGraffiti(w http.ResponseWriter, r *http.Request) {
 	httputil.WriteJson(w, &GetGraffitiResponse{
 		Data: &GraffitiData{
 			Pubkey:   rawPubkey,
-			Graffiti: string(graffiti),
+			Graffiti: string(bytes.TrimRight(graffiti, "\x00")),
+			Source:   string(source),
 		},
 	})
 }
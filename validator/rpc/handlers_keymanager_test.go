@@ -16,6 +16,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -263,6 +264,38 @@ func TestServer_ImportKeystores(t *testing.T) {
 			require.Equal(t, keymanager.StatusError, st.Status)
 		}
 	})
+	t.Run("each keystore decrypted with its own password", func(t *testing.T) {
+		keystorePasswords := []string{"password-one", "password-two", "password-three"}
+		passwords := []string{"password-one", "password-two", "password-one"}
+		encodedKeystores := make([]string, len(keystorePasswords))
+		for i, password := range keystorePasswords {
+			enc, err := json.Marshal(createRandomKeystore(t, password))
+			require.NoError(t, err)
+			encodedKeystores[i] = string(enc)
+		}
+
+		request := &ImportKeystoresRequest{
+			Keystores: encodedKeystores,
+			Passwords: passwords,
+		}
+
+		var buf bytes.Buffer
+		err = json.NewEncoder(&buf).Encode(request)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/eth/v1/keystores", &buf)
+		wr := httptest.NewRecorder()
+		wr.Body = &bytes.Buffer{}
+		s.ImportKeystores(wr, req)
+		require.Equal(t, http.StatusOK, wr.Code)
+		resp := &ImportKeystoresResponse{}
+		require.NoError(t, json.Unmarshal(wr.Body.Bytes(), resp))
+		require.Equal(t, 3, len(resp.Data))
+		require.Equal(t, keymanager.StatusImported, resp.Data[0].Status)
+		require.Equal(t, keymanager.StatusImported, resp.Data[1].Status)
+		require.Equal(t, keymanager.StatusError, resp.Data[2].Status)
+		require.StringContains(t, "incorrect password", resp.Data[2].Message)
+	})
 
 	for _, isSlashingProtectionMinimal := range []bool{false, true} {
 		t.Run(fmt.Sprintf("returns proper statuses for keystores in request/isSlashingProtectionMininal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
@@ -539,7 +572,17 @@ func TestServer_DeleteKeystores(t *testing.T) {
 			require.Equal(t, len(keys), len(resp.Data))
 			slashingProtectionData := &format.EIPSlashingProtectionFormat{}
 			require.NoError(t, json.Unmarshal([]byte(resp.SlashingProtection), slashingProtectionData))
-			require.Equal(t, true, len(slashingProtectionData.Data) > 0)
+
+			// The slashing protection data is scoped to the keys that were deleted or are not active.
+			exportable := make(map[string]bool)
+			for i := 0; i < len(tc.keys); i++ {
+				if tc.wantStatuses[i] == keymanager.StatusDeleted || tc.wantStatuses[i] == keymanager.StatusNotActive {
+					exportable[keys[i]] = true
+				}
+			}
+			for _, dt := range slashingProtectionData.Data {
+				require.Equal(t, true, exportable[dt.Pubkey], fmt.Sprintf("Unexpected slashing protection data for key %s", dt.Pubkey))
+			}
 
 			for i := 0; i < len(tc.keys); i++ {
 				require.Equal(
@@ -618,9 +661,14 @@ func TestServer_DeleteKeystores_FailedSlashingProtectionExport(t *testing.T) {
 			require.NoError(t, json.Unmarshal(wr.Body.Bytes(), resp))
 			require.Equal(t, 1, len(resp.Data))
 			require.Equal(t, keymanager.StatusError, resp.Data[0].Status)
-			require.Equal(t, "Could not export slashing protection history as existing non duplicate keys were deleted",
+			require.Equal(t, "Could not export slashing protection history, no keys were deleted",
 				resp.Data[0].Message,
 			)
+
+			// No key was deleted since the slashing protection history could not be exported.
+			remainingKeys, err := dr.FetchValidatingPublicKeys(ctx)
+			require.NoError(t, err)
+			require.Equal(t, numAccounts, len(remainingKeys))
 		})
 	}
 }
@@ -664,9 +712,13 @@ func TestServer_DeleteKeystores_WrongKeymanagerKind(t *testing.T) {
 	wr := httptest.NewRecorder()
 	wr.Body = &bytes.Buffer{}
 	s.DeleteKeystores(wr, req)
-	require.Equal(t, http.StatusInternalServerError, wr.Code)
-	require.StringContains(t, "Wrong wallet type", wr.Body.String())
-	require.StringContains(t, "Only Imported or Derived wallets can delete accounts", wr.Body.String())
+	require.Equal(t, http.StatusOK, wr.Code)
+	resp := &DeleteKeystoresResponse{}
+	require.NoError(t, json.Unmarshal(wr.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data))
+	require.Equal(t, keymanager.StatusError, resp.Data[0].Status)
+	require.StringContains(t, "Wrong wallet type", resp.Data[0].Message)
+	require.StringContains(t, "Only Imported or Derived wallets can delete accounts", resp.Data[0].Message)
 }
 
 func setupServerWithWallet(t testing.TB) *Server {
@@ -1490,6 +1542,60 @@ func TestServer_DeleteRemoteKeys(t *testing.T) {
 	})
 }
 
+func TestServer_RefreshRemoteKeys(t *testing.T) {
+	ctx := context.Background()
+	oldKey := "0x93247f2209abcacf57b75a51dafae777f9dd38bc7053d1af526f220a7489a6d3a2753e5f3e8b1cfe39b56f43611df74a"
+	newKey := "0xa2b5aaad9c6efefe7bb9b1243a043404f3362937cfb6b31833929833173f476630ea2cfeb0d9ddf15f97ca8685948820"
+	signerKeys := []string{oldKey}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(signerKeys))
+	}))
+	defer srv.Close()
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	newDir := filepath.Join(t.TempDir(), "new")
+	set.String(flags.WalletDirFlag.Name, newDir, "")
+	w := wallet.NewWalletForWeb3Signer(cli.NewContext(&app, set, nil))
+	root := make([]byte, fieldparams.RootLength)
+	root[0] = 1
+	config := &remoteweb3signer.SetupConfig{
+		BaseEndpoint:          "http://example.com",
+		GenesisValidatorsRoot: root,
+		PublicKeysURL:         srv.URL + "/api/v1/eth2/publicKeys",
+	}
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false, Web3SignerConfig: config})
+	require.NoError(t, err)
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Wallet: w,
+		Validator: &mock.Validator{
+			Km: km,
+		},
+		Web3SignerConfig: config,
+	})
+	require.NoError(t, err)
+	s := &Server{
+		walletInitialized: true,
+		wallet:            w,
+		validatorService:  vs,
+	}
+
+	signerKeys = []string{newKey}
+	req := httptest.NewRequest(http.MethodPost, "/v2/validator/remote-keys/refresh", nil)
+	rec := httptest.NewRecorder()
+	rec.Body = &bytes.Buffer{}
+	s.RefreshRemoteKeys(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	resp := &RefreshRemoteKeysResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+	require.DeepEqual(t, []string{newKey}, resp.Added)
+	require.DeepEqual(t, []string{oldKey}, resp.Removed)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	require.Equal(t, newKey, hexutil.Encode(keys[0][:]))
+}
+
 func TestServer_ListFeeRecipientByPubkey(t *testing.T) {
 	ctx := context.Background()
 	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
@@ -1786,11 +1892,66 @@ func TestServer_FeeRecipientByPubkey(t *testing.T) {
 				assert.Equal(t, http.StatusAccepted, w.Code)
 
 				assert.Equal(t, tt.want.valEthAddress, s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(byteval)].FeeRecipientConfig.FeeRecipient.Hex())
+
+				overrides, err := validatorDB.FeeRecipientOverrides(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, common.HexToAddress(tt.want.valEthAddress), common.Address(overrides[bytesutil.ToBytes48(byteval)]))
 			})
 		}
 	}
 }
 
+// failingOverrideDB is a validator database which fails to save fee recipient overrides.
+type failingOverrideDB struct {
+	DBIface.ValidatorDB
+}
+
+func (*failingOverrideDB) SaveFeeRecipientOverride(context.Context, [fieldparams.BLSPubkeyLength]byte, [fieldparams.FeeRecipientLength]byte) error {
+	return errors.New("could not write")
+}
+
+func TestServer_SetFeeRecipientByPubkey_SaveFails(t *testing.T) {
+	ctx := context.Background()
+	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
+	byteval, err := hexutil.Decode(pubkey)
+	require.NoError(t, err)
+	feeRecipient := common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9738D5")
+
+	m := &mock.Validator{}
+	require.NoError(t, m.SetProposerSettings(ctx, &proposer.Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+			bytesutil.ToBytes48(byteval): {
+				FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: feeRecipient},
+			},
+		},
+	}))
+	validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+	vs, err := client.NewValidatorService(ctx, &client.Config{
+		Validator: m,
+		DB:        validatorDB,
+	})
+	require.NoError(t, err)
+	s := &Server{
+		validatorService: vs,
+		db:               &failingOverrideDB{ValidatorDB: validatorDB},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, json.NewEncoder(&buf).Encode(&SetFeeRecipientByPubkeyRequest{
+		Ethaddress: "0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9",
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/eth/v1/validator/{pubkey}/feerecipient", &buf)
+	req.SetPathValue("pubkey", pubkey)
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	s.SetFeeRecipientByPubkey(w, req)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	require.StringContains(t, "Could not save fee recipient", w.Body.String())
+
+	// The settings in use are left untouched.
+	assert.Equal(t, feeRecipient, s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(byteval)].FeeRecipientConfig.FeeRecipient)
+	assert.Equal(t, feeRecipient, m.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(byteval)].FeeRecipientConfig.FeeRecipient)
+}
+
 func TestServer_SetFeeRecipientByPubkey_InvalidPubKey(t *testing.T) {
 	s := &Server{
 		validatorService: &client.ValidatorService{},
@@ -1827,6 +1988,52 @@ func TestServer_SetFeeRecipientByPubkey_InvalidFeeRecipient(t *testing.T) {
 	require.StringContains(t, "Invalid ethaddress", w.Body.String())
 }
 
+func TestServer_SetFeeRecipientByPubkey_BurnAddress(t *testing.T) {
+	ctx := context.Background()
+	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
+	byteval, err := hexutil.Decode(pubkey)
+	require.NoError(t, err)
+
+	for _, allowBurn := range []bool{false, true} {
+		t.Run(fmt.Sprintf("allowBurnFeeRecipient:%v", allowBurn), func(t *testing.T) {
+			validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{}, false)
+			vs, err := client.NewValidatorService(ctx, &client.Config{
+				Validator: &mock.Validator{},
+				DB:        validatorDB,
+			})
+			require.NoError(t, err)
+			s := &Server{
+				validatorService:      vs,
+				db:                    validatorDB,
+				allowBurnFeeRecipient: allowBurn,
+			}
+			request := &SetFeeRecipientByPubkeyRequest{
+				Ethaddress: params.BeaconConfig().EthBurnAddressHex,
+			}
+
+			var buf bytes.Buffer
+			require.NoError(t, json.NewEncoder(&buf).Encode(request))
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/eth/v1/validator/{pubkey}/feerecipient"), &buf)
+			req.SetPathValue("pubkey", pubkey)
+			w := httptest.NewRecorder()
+			w.Body = &bytes.Buffer{}
+			s.SetFeeRecipientByPubkey(w, req)
+
+			overrides, err := validatorDB.FeeRecipientOverrides(ctx)
+			require.NoError(t, err)
+			_, ok := overrides[bytesutil.ToBytes48(byteval)]
+			if !allowBurn {
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+				require.StringContains(t, "burn address", w.Body.String())
+				assert.Equal(t, false, ok)
+				return
+			}
+			assert.Equal(t, http.StatusAccepted, w.Code)
+			assert.Equal(t, true, ok)
+		})
+	}
+}
+
 func TestServer_DeleteFeeRecipientByPubkey(t *testing.T) {
 	ctx := context.Background()
 	pubkey := "0xaf2e7ba294e03438ea819bd4033c6c1bf6b04320ee2075b77273c08d02f8a61bcc303c2c06bd3713cb442072ae591493"
@@ -1875,6 +2082,7 @@ func TestServer_DeleteFeeRecipientByPubkey(t *testing.T) {
 					DB:        validatorDB,
 				})
 				require.NoError(t, err)
+				require.NoError(t, validatorDB.SaveFeeRecipientOverride(ctx, bytesutil.ToBytes48(byteval), common.HexToAddress("0x055Fb65722E7b2455012BFEBf6177F1D2e9738D5")))
 				s := &Server{
 					validatorService: vs,
 					db:               validatorDB,
@@ -1886,6 +2094,11 @@ func TestServer_DeleteFeeRecipientByPubkey(t *testing.T) {
 				s.DeleteFeeRecipientByPubkey(w, req)
 				assert.Equal(t, http.StatusNoContent, w.Code)
 				assert.Equal(t, true, s.validatorService.ProposerSettings().ProposeConfig[bytesutil.ToBytes48(byteval)].FeeRecipientConfig == nil)
+
+				overrides, err := validatorDB.FeeRecipientOverrides(ctx)
+				require.NoError(t, err)
+				_, ok := overrides[bytesutil.ToBytes48(byteval)]
+				assert.Equal(t, false, ok)
 			})
 		}
 	}
@@ -1953,6 +2166,7 @@ func TestServer_Graffiti(t *testing.T) {
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
 	assert.Equal(t, resp.Data.Graffiti, request.Graffiti)
 	assert.Equal(t, resp.Data.Pubkey, pubkey)
+	assert.Equal(t, "proposer_settings", resp.Data.Source)
 
 	req = httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/eth/v1/validator/{pubkey}/graffiti"), nil)
 	req.SetPathValue("pubkey", pubkey)
@@ -0,0 +1,104 @@
+package rpc
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/validator/client"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+// defaultDutyHistoryEpochs is the number of epochs of duty history returned when none is requested.
+const defaultDutyHistoryEpochs = 32
+
+// GetDutyPerformanceHistory returns, for each validator public key, the outcome of its duties over the requested
+// number of epochs up to the current one, along with a summary of them. The history is kept by the validator client
+// for the last client.DutyHistoryEpochs epochs.
+func (s *Server) GetDutyPerformanceHistory(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.web.GetDutyPerformanceHistory")
+	defer span.End()
+
+	epochs := uint64(defaultDutyHistoryEpochs)
+	if e := r.URL.Query().Get("epochs"); e != "" {
+		var err error
+		epochs, err = strconv.ParseUint(e, 10, 64)
+		if err != nil || epochs == 0 || epochs > client.DutyHistoryEpochs {
+			httputil.HandleError(w, fmt.Sprintf("epochs must be between 1 and %d", client.DutyHistoryEpochs), http.StatusBadRequest)
+			return
+		}
+	}
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready", http.StatusServiceUnavailable)
+		return
+	}
+	history, err := s.validatorService.DutyHistory(epochs)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not get duty history").Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	validators := make([]*ValidatorDutyPerformance, 0, len(history))
+	for pubKey, records := range history {
+		validators = append(validators, &ValidatorDutyPerformance{
+			Pubkey:  fmt.Sprintf("%#x", pubKey),
+			Summary: summarizeDuties(records),
+			History: dutyRecordsFromDB(records),
+		})
+	}
+	sort.Slice(validators, func(i, j int) bool { return validators[i].Pubkey < validators[j].Pubkey })
+	httputil.WriteJson(w, &DutyPerformanceHistoryResponse{
+		Epochs:     epochs,
+		Validators: validators,
+	})
+}
+
+func summarizeDuties(records []common.DutyRecord) *DutyPerformanceSummary {
+	summary := &DutyPerformanceSummary{}
+	var inclusionDistances, included uint64
+	for _, r := range records {
+		if r.AttestationAssigned {
+			summary.AttestationsAssigned++
+		}
+		if r.AttestationSubmitted {
+			summary.AttestationsSubmitted++
+		}
+		if r.InclusionDistance != 0 {
+			inclusionDistances += uint64(r.InclusionDistance)
+			included++
+		}
+		if r.AggregationAssigned {
+			summary.AggregationsAssigned++
+		}
+		if r.AggregationSubmitted {
+			summary.AggregationsSubmitted++
+		}
+		summary.ProposalsAssigned += r.ProposalsAssigned
+		summary.ProposalsSubmitted += r.ProposalsSubmitted
+	}
+	if included != 0 {
+		summary.AverageInclusionDistance = float64(inclusionDistances) / float64(included)
+	}
+	return summary
+}
+
+func dutyRecordsFromDB(records []common.DutyRecord) []*DutyRecord {
+	result := make([]*DutyRecord, len(records))
+	for i, r := range records {
+		result[i] = &DutyRecord{
+			Epoch:                uint64(r.Epoch),
+			AttestationAssigned:  r.AttestationAssigned,
+			AttestationSubmitted: r.AttestationSubmitted,
+			InclusionDistance:    uint64(r.InclusionDistance),
+			AggregationAssigned:  r.AggregationAssigned,
+			AggregationSubmitted: r.AggregationSubmitted,
+			ProposalsAssigned:    r.ProposalsAssigned,
+			ProposalsSubmitted:   r.ProposalsSubmitted,
+		}
+	}
+	return result
+}
@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	mock "github.com/prysmaticlabs/prysm/v5/validator/accounts/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/client"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
+)
+
+func TestGetDutyPerformanceHistory(t *testing.T) {
+	vs, err := client.NewValidatorService(context.Background(), &client.Config{
+		Validator: &mock.Validator{
+			DutyRecords: map[[fieldparams.BLSPubkeyLength]byte][]common.DutyRecord{
+				{2}: {{Epoch: 10, AttestationAssigned: true}},
+				{1}: {
+					{Epoch: 9, AttestationAssigned: true, AttestationSubmitted: true, InclusionDistance: 1},
+					{Epoch: 10, AttestationAssigned: true, AttestationSubmitted: true, InclusionDistance: 2, AggregationAssigned: true, AggregationSubmitted: true},
+					{Epoch: 11, ProposalsAssigned: 1, ProposalsSubmitted: 1},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	s := &Server{validatorService: vs}
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/validator/performance/history?epochs=16", nil)
+	wr := httptest.NewRecorder()
+	wr.Body = &bytes.Buffer{}
+	s.GetDutyPerformanceHistory(wr, req)
+	require.Equal(t, http.StatusOK, wr.Code)
+	resp := &DutyPerformanceHistoryResponse{}
+	require.NoError(t, json.Unmarshal(wr.Body.Bytes(), resp))
+	assert.Equal(t, uint64(16), resp.Epochs)
+	require.Equal(t, 2, len(resp.Validators))
+	var first [fieldparams.BLSPubkeyLength]byte
+	first[0] = 1
+	assert.Equal(t, fmt.Sprintf("%#x", first), resp.Validators[0].Pubkey)
+	assert.DeepEqual(t, &DutyPerformanceSummary{
+		AttestationsAssigned:     2,
+		AttestationsSubmitted:    2,
+		AverageInclusionDistance: 1.5,
+		AggregationsAssigned:     1,
+		AggregationsSubmitted:    1,
+		ProposalsAssigned:        1,
+		ProposalsSubmitted:       1,
+	}, resp.Validators[0].Summary)
+	require.Equal(t, 3, len(resp.Validators[0].History))
+	assert.DeepEqual(t, &DutyRecord{Epoch: 11, ProposalsAssigned: 1, ProposalsSubmitted: 1}, resp.Validators[0].History[2])
+	assert.DeepEqual(t, &DutyPerformanceSummary{AttestationsAssigned: 1}, resp.Validators[1].Summary)
+
+	for _, epochs := range []string{"0", "65", "all"} {
+		req = httptest.NewRequest(http.MethodGet, "/v2/validator/performance/history?epochs="+epochs, nil)
+		wr = httptest.NewRecorder()
+		wr.Body = &bytes.Buffer{}
+		s.GetDutyPerformanceHistory(wr, req)
+		require.Equal(t, http.StatusBadRequest, wr.Code)
+		require.StringContains(t, "epochs must be between 1 and 64", wr.Body.String())
+	}
+}
@@ -0,0 +1,44 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	proposersettings "github.com/prysmaticlabs/prysm/v5/validator/proposer-settings"
+)
+
+// ExportProposerSettings handles the rpc call returning the effective proposer settings of the validator client,
+// including the ones set through the keymanager API, with the provenance of each entry. The export can be
+// restored with the validator proposer-settings import command.
+func (s *Server) ExportProposerSettings(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.ExportProposerSettings")
+	defer span.End()
+
+	if s.db == nil {
+		httputil.HandleError(w, "could not find validator database", http.StatusInternalServerError)
+		return
+	}
+
+	source, err := proposersettings.LoadSource(ctx, s.proposerSettingsFile, s.proposerSettingsURL)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "could not load proposer settings").Error(), http.StatusInternalServerError)
+		return
+	}
+	doc, err := proposersettings.Export(ctx, s.db, source)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "could not export proposer settings").Error(), http.StatusInternalServerError)
+		return
+	}
+
+	encoded, err := json.MarshalIndent(doc, "", "\t")
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "could not JSON marshal proposer settings").Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &ExportProposerSettingsResponse{
+		File: string(encoded),
+	})
+}
@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	proposersettings "github.com/prysmaticlabs/prysm/v5/validator/proposer-settings"
+)
+
+func TestExportProposerSettings(t *testing.T) {
+	ctx := context.Background()
+	var fileKey, apiKey [fieldparams.BLSPubkeyLength]byte
+	fileKey[0], apiKey[0] = 1, 2
+	fileSettings := &proposer.Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+			fileKey: {FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9")}},
+		},
+		DefaultConfig: &proposer.Option{
+			FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress("0x6e35733c5af9B61374A128e6F85f553aF09ff89A")},
+		},
+	}
+	encoded, err := json.Marshal(fileSettings.ToConsensus())
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "proposer-settings.json")
+	require.NoError(t, file.WriteFile(path, encoded))
+
+	s := &Server{proposerSettingsFile: path}
+	req := httptest.NewRequest(http.MethodGet, "/v2/validator/proposer-settings/export", nil)
+	wr := httptest.NewRecorder()
+	wr.Body = &bytes.Buffer{}
+	s.ExportProposerSettings(wr, req)
+	require.Equal(t, http.StatusInternalServerError, wr.Code)
+	require.StringContains(t, "could not find validator database", wr.Body.String())
+
+	// The keymanager API adds the settings of a validator that is not in the file.
+	dbSettings := fileSettings.Clone()
+	dbSettings.ProposeConfig[apiKey] = &proposer.Option{
+		FeeRecipientConfig: &proposer.FeeRecipientConfig{FeeRecipient: common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3")},
+	}
+	s.db = dbtest.SetupDB(t, nil, false)
+	require.NoError(t, s.db.SaveProposerSettings(ctx, dbSettings))
+
+	wr = httptest.NewRecorder()
+	wr.Body = &bytes.Buffer{}
+	s.ExportProposerSettings(wr, req)
+	require.Equal(t, http.StatusOK, wr.Code)
+	resp := &ExportProposerSettingsResponse{}
+	require.NoError(t, json.Unmarshal(wr.Body.Bytes(), resp))
+	doc := &proposersettings.Document{}
+	require.NoError(t, json.Unmarshal([]byte(resp.File), doc))
+	require.Equal(t, proposersettings.File, doc.ProposerConfig[hexutil.Encode(fileKey[:])].Provenance)
+	require.Equal(t, proposersettings.Database, doc.ProposerConfig[hexutil.Encode(apiKey[:])].Provenance)
+	require.Equal(t, proposersettings.File, doc.DefaultConfig.Provenance)
+}
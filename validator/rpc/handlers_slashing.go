@@ -3,13 +3,18 @@ package rpc
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
 	slashing "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
 )
 
 // ExportSlashingProtection handles the rpc call returning the json slashing history.
@@ -82,3 +87,76 @@ func (s *Server) ImportSlashingProtection(w http.ResponseWriter, r *http.Request
 	}
 	log.Info("Slashing protection JSON successfully imported")
 }
+
+// ExportSlashingProtectionInterchange returns the slashing protection history of the validator as an EIP-3076
+// interchange file, which can be imported as is by other clients. The history of all keys in the database is
+// returned, unless public keys are given with the pubkeys query parameter or in the route.
+// The database serializes the export with the signing of the running validator, so the history
+// returned includes every message signed before the request.
+func (s *Server) ExportSlashingProtectionInterchange(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ExportSlashingProtectionInterchange")
+	defer span.End()
+
+	if s.db == nil {
+		httputil.HandleError(w, "could not find validator database", http.StatusInternalServerError)
+		return
+	}
+	var pubKeys [][]byte
+	if r.PathValue("pubkey") != "" {
+		_, pubKey, ok := shared.HexFromRoute(w, r, "pubkey", fieldparams.BLSPubkeyLength)
+		if !ok {
+			return
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	for i, key := range r.URL.Query()["pubkeys"] {
+		pubKey, ok := shared.ValidateHex(w, fmt.Sprintf("pubkeys[%d]", i), key, fieldparams.BLSPubkeyLength)
+		if !ok {
+			return
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	interchange, err := slashing.ExportStandardProtectionJSON(ctx, s.db, pubKeys...)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "could not export slashing protection history").Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, interchange)
+}
+
+// ImportSlashingProtectionInterchange imports an EIP-3076 interchange file sent as the request body into the
+// validator database while the validator is running. The history is merged with the existing one, so that the
+// validator never signs at or below the highest slots and source and target epochs of either.
+func (s *Server) ImportSlashingProtectionInterchange(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.keymanagerAPI.ImportSlashingProtectionInterchange")
+	defer span.End()
+
+	if s.db == nil {
+		httputil.HandleError(w, "could not find validator database", http.StatusInternalServerError)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		httputil.HandleError(w, "Could not read request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) == 0 {
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	}
+	interchange := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(body, interchange); err != nil {
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := helpers.ValidateMetadata(ctx, s.db, interchange); err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "invalid slashing protection interchange metadata").Error(), http.StatusBadRequest)
+		return
+	}
+	if err := s.db.ImportStandardProtectionJSON(ctx, bytes.NewReader(body)); err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "could not import slashing protection history").Error(), http.StatusInternalServerError)
+		return
+	}
+	log.WithField("numKeys", len(interchange.Data)).Info("Slashing protection interchange successfully imported")
+}
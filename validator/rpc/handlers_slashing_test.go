@@ -234,3 +234,72 @@ func TestImportExportSlashingProtection_RoundTrip(t *testing.T) {
 
 	require.DeepEqual(t, mockJSON.Metadata, receivedJSON.Metadata)
 }
+
+func TestSlashingProtectionInterchange(t *testing.T) {
+	pubKeys, err := mocks.CreateRandomPubKeys(2)
+	require.NoError(t, err)
+	validatorDB, err := filesystem.NewStore(t.TempDir(), &filesystem.Config{PubKeys: pubKeys})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+	s := &Server{db: validatorDB}
+
+	interchange := func(source, target, slot string) []byte {
+		history, err := mocks.MockSlashingProtectionJSON(pubKeys, nil, nil)
+		require.NoError(t, err)
+		for _, data := range history.Data {
+			data.SignedAttestations = []*format.SignedAttestation{{SourceEpoch: source, TargetEpoch: target}}
+			data.SignedBlocks = []*format.SignedBlock{{Slot: slot}}
+		}
+		enc, err := json.Marshal(history)
+		require.NoError(t, err)
+		return enc
+	}
+	importInterchange := func(body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/eth/v1/slashing_protection", bytes.NewReader(body))
+		wr := httptest.NewRecorder()
+		s.ImportSlashingProtectionInterchange(wr, req)
+		return wr
+	}
+	exportInterchange := func(req *http.Request) *format.EIPSlashingProtectionFormat {
+		wr := httptest.NewRecorder()
+		s.ExportSlashingProtectionInterchange(wr, req)
+		require.Equal(t, http.StatusOK, wr.Code, wr.Body.String())
+		resp := &format.EIPSlashingProtectionFormat{}
+		require.NoError(t, json.Unmarshal(wr.Body.Bytes(), resp))
+		return resp
+	}
+
+	require.Equal(t, http.StatusOK, importInterchange(interchange("5", "6", "40")).Code)
+	// An older history does not lower the minimal slashing protection.
+	require.Equal(t, http.StatusOK, importInterchange(interchange("2", "3", "20")).Code)
+
+	resp := exportInterchange(httptest.NewRequest(http.MethodGet, "/eth/v1/slashing_protection", nil))
+	require.Equal(t, 2, len(resp.Data))
+	for _, data := range resp.Data {
+		require.Equal(t, "6", data.SignedAttestations[len(data.SignedAttestations)-1].TargetEpoch)
+		require.Equal(t, "40", data.SignedBlocks[len(data.SignedBlocks)-1].Slot)
+	}
+
+	pubKey := fmt.Sprintf("%#x", pubKeys[1])
+	resp = exportInterchange(httptest.NewRequest(http.MethodGet, "/eth/v1/slashing_protection?pubkeys="+pubKey, nil))
+	require.Equal(t, 1, len(resp.Data))
+	require.Equal(t, pubKey, resp.Data[0].Pubkey)
+	req := httptest.NewRequest(http.MethodGet, "/eth/v1/validator/{pubkey}/slashing_protection", nil)
+	req.SetPathValue("pubkey", pubKey)
+	resp = exportInterchange(req)
+	require.Equal(t, 1, len(resp.Data))
+	require.Equal(t, pubKey, resp.Data[0].Pubkey)
+
+	history, err := mocks.MockSlashingProtectionJSON(pubKeys, nil, nil)
+	require.NoError(t, err)
+	history.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{2})
+	enc, err := json.Marshal(history)
+	require.NoError(t, err)
+	wr := importInterchange(enc)
+	require.Equal(t, http.StatusBadRequest, wr.Code)
+	require.StringContains(t, "invalid slashing protection interchange metadata", wr.Body.String())
+	wr = importInterchange(nil)
+	require.Equal(t, http.StatusBadRequest, wr.Code)
+}
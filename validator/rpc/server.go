@@ -41,6 +41,9 @@ type Config struct {
 	WalletInitializedFeed  *event.Feed
 	ValidatorService       *client.ValidatorService
 	AuthTokenPath          string
+	ProposerSettingsFile   string
+	ProposerSettingsURL    string
+	AllowBurnFeeRecipient  bool
 	Middlewares            []middleware.Middleware
 	Router                 *http.ServeMux
 }
@@ -73,6 +76,9 @@ type Server struct {
 	walletInitializedFeed     *event.Feed
 	walletInitialized         bool
 	validatorService          *client.ValidatorService
+	proposerSettingsFile      string
+	proposerSettingsURL       string
+	allowBurnFeeRecipient     bool
 	router                    *http.ServeMux
 	logStreamer               logs.Streamer
 	logStreamerBufferSize     int
@@ -103,6 +109,9 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		beaconApiTimeout:       cfg.BeaconApiTimeout,
 		beaconApiEndpoint:      cfg.BeaconApiEndpoint,
 		beaconNodeEndpoint:     cfg.BeaconNodeGRPCEndpoint,
+		proposerSettingsFile:   cfg.ProposerSettingsFile,
+		proposerSettingsURL:    cfg.ProposerSettingsURL,
+		allowBurnFeeRecipient:  cfg.AllowBurnFeeRecipient,
 		router:                 cfg.Router,
 	}
 
@@ -191,6 +200,9 @@ func (s *Server) InitializeRoutes() error {
 	s.router.HandleFunc("GET /eth/v1/validator/{pubkey}/graffiti", s.GetGraffiti)
 	s.router.HandleFunc("POST /eth/v1/validator/{pubkey}/graffiti", s.SetGraffiti)
 	s.router.HandleFunc("DELETE /eth/v1/validator/{pubkey}/graffiti", s.DeleteGraffiti)
+	s.router.HandleFunc("GET /eth/v1/slashing_protection", s.ExportSlashingProtectionInterchange)
+	s.router.HandleFunc("POST /eth/v1/slashing_protection", s.ImportSlashingProtectionInterchange)
+	s.router.HandleFunc("GET /eth/v1/validator/{pubkey}/slashing_protection", s.ExportSlashingProtectionInterchange)
 
 	// auth endpoint
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"initialize", s.Initialize)
@@ -216,6 +228,12 @@ func (s *Server) InitializeRoutes() error {
 	// slashing protection endpoints
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"slashing-protection/export", s.ExportSlashingProtection)
 	s.router.HandleFunc("POST "+api.WebUrlPrefix+"slashing-protection/import", s.ImportSlashingProtection)
+	// remote keys endpoints
+	s.router.HandleFunc("POST "+api.WebUrlPrefix+"remote-keys/refresh", s.RefreshRemoteKeys)
+	// proposer settings endpoints
+	s.router.HandleFunc("GET "+api.WebUrlPrefix+"proposer-settings/export", s.ExportProposerSettings)
+	// performance endpoints
+	s.router.HandleFunc("GET "+api.WebUrlPrefix+"performance/history", s.GetDutyPerformanceHistory)
 
 	log.Info("Initialized REST API routes")
 	return nil
@@ -30,6 +30,9 @@ func TestServer_InitializeRoutes(t *testing.T) {
 		"/v2/validator/wallet/recover":               {http.MethodPost},
 		"/v2/validator/slashing-protection/export":   {http.MethodGet},
 		"/v2/validator/slashing-protection/import":   {http.MethodPost},
+		"/v2/validator/proposer-settings/export":     {http.MethodGet},
+		"/v2/validator/performance/history":          {http.MethodGet},
+		"/v2/validator/remote-keys/refresh":          {http.MethodPost},
 		"/v2/validator/accounts":                     {http.MethodGet},
 		"/v2/validator/accounts/backup":              {http.MethodPost},
 		"/v2/validator/accounts/voluntary-exit":      {http.MethodPost},
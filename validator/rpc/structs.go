@@ -22,6 +22,7 @@ type ListKeystoresResponse struct {
 type Keystore struct {
 	ValidatingPubkey string `json:"validating_pubkey"`
 	DerivationPath   string `json:"derivation_path"`
+	Wallet           string `json:"wallet,omitempty"`
 }
 
 type ImportKeystoresRequest struct {
@@ -85,6 +86,11 @@ type RemoteKeysResponse struct {
 	Data []*keymanager.KeyStatus `json:"data"`
 }
 
+type RefreshRemoteKeysResponse struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+}
+
 // Fee Recipient keymanager api
 type FeeRecipient struct {
 	Pubkey     string `json:"pubkey"`
@@ -107,6 +113,9 @@ type GetGraffitiResponse struct {
 type GraffitiData struct {
 	Pubkey   string `json:"pubkey"`
 	Graffiti string `json:"graffiti"`
+	// Source tells whether the graffiti comes from the proposer settings, which hold the graffiti set through
+	// this API, the --graffiti flag or the graffiti file.
+	Source string `json:"source,omitempty"`
 }
 
 type BeaconStatusResponse struct {
@@ -169,6 +178,44 @@ type ExportSlashingProtectionResponse struct {
 	File string `json:"file"`
 }
 
+type ExportProposerSettingsResponse struct {
+	File string `json:"file"`
+}
+
+type DutyPerformanceHistoryResponse struct {
+	Epochs     uint64                      `json:"epochs"`
+	Validators []*ValidatorDutyPerformance `json:"validators"`
+}
+
+type ValidatorDutyPerformance struct {
+	Pubkey  string                  `json:"pubkey"`
+	Summary *DutyPerformanceSummary `json:"summary"`
+	History []*DutyRecord           `json:"history"`
+}
+
+// DutyPerformanceSummary totals the duties of a validator over the requested epochs. The average inclusion distance
+// is over the attestations for which the beacon node reported one, and is 0 when there are none.
+type DutyPerformanceSummary struct {
+	AttestationsAssigned     uint64  `json:"attestations_assigned"`
+	AttestationsSubmitted    uint64  `json:"attestations_submitted"`
+	AverageInclusionDistance float64 `json:"average_inclusion_distance"`
+	AggregationsAssigned     uint64  `json:"aggregations_assigned"`
+	AggregationsSubmitted    uint64  `json:"aggregations_submitted"`
+	ProposalsAssigned        uint64  `json:"proposals_assigned"`
+	ProposalsSubmitted       uint64  `json:"proposals_submitted"`
+}
+
+type DutyRecord struct {
+	Epoch                uint64 `json:"epoch"`
+	AttestationAssigned  bool   `json:"attestation_assigned"`
+	AttestationSubmitted bool   `json:"attestation_submitted"`
+	InclusionDistance    uint64 `json:"inclusion_distance"`
+	AggregationAssigned  bool   `json:"aggregation_assigned"`
+	AggregationSubmitted bool   `json:"aggregation_submitted"`
+	ProposalsAssigned    uint64 `json:"proposals_assigned"`
+	ProposalsSubmitted   uint64 `json:"proposals_submitted"`
+}
+
 type BackupAccountsRequest struct {
 	PublicKeys     []string `json:"public_keys"`
 	BackupPassword string   `json:"backup_password"`
@@ -193,6 +240,7 @@ type Account struct {
 	AccountName         string `json:"account_name"`
 	DepositTxData       string `json:"deposit_tx_data"`
 	DerivationPath      string `json:"derivation_path"`
+	Wallet              string `json:"wallet,omitempty"`
 }
 
 type VoluntaryExitResponse struct {